@@ -45,11 +45,12 @@ const (
 	DoubleTypeID                       // double
 	StringTypeID                       // string
 	BinaryTypeID                       // binary
+	UUIDTypeID                         // uuid
 )
 
 // BaseType is a reference to a Thrift base type.
 //
-// 	bool, byte, i16, i32, i64, double, string, binary
+// 	bool, byte, i16, i32, i64, double, string, binary, uuid
 //
 // All references to base types in the document may be followed by type
 // annotations.
@@ -98,6 +99,8 @@ func (bt BaseType) String() string {
 		name = "string"
 	case BinaryTypeID:
 		name = "binary"
+	case UUIDTypeID:
+		name = "uuid"
 	default:
 		panic(fmt.Sprintf("unknown base type %v", bt.ID))
 	}
@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/thriftrw/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+// generator is an api.ServiceGenerator that generates a command line client
+// for each root service.
+type generator struct{}
+
+var _ api.ServiceGenerator = generator{}
+
+// cliFunction is a function of a service along with the service and module
+// that declared it. Inherited functions are declared by a parent service,
+// possibly in a different module.
+type cliFunction struct {
+	Module   *api.Module
+	Service  *api.Service
+	Function *api.Function
+}
+
+type cliData struct {
+	Package   string
+	Service   *api.Service
+	Functions []cliFunction
+}
+
+func (generator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	files := make(map[string][]byte)
+	for _, serviceID := range req.RootServices {
+		service := req.Services[serviceID]
+		module := req.Modules[service.ModuleID]
+
+		pkg := strings.ToLower(service.Name) + "cli"
+		data := cliData{
+			Package:   pkg,
+			Service:   service,
+			Functions: serviceFunctions(req, service),
+		}
+
+		filePath := filepath.Join(module.Directory, pkg, "main.go")
+		contents, err := plugin.GoFileFromTemplate(filePath, cliTemplate, data,
+			plugin.GoFileImportPath(path.Join(module.ImportPath, pkg)))
+		if err != nil {
+			return nil, err
+		}
+		files[filePath] = contents
+	}
+	return &api.GenerateServiceResponse{Files: files}, nil
+}
+
+// serviceFunctions returns the functions of the given service, including
+// those inherited from its parents.
+func serviceFunctions(req *api.GenerateServiceRequest, service *api.Service) []cliFunction {
+	var funcs []cliFunction
+	seen := make(map[string]struct{})
+	for s := service; s != nil; {
+		module := req.Modules[s.ModuleID]
+		for _, f := range s.Functions {
+			if _, ok := seen[f.ThriftName]; ok {
+				continue
+			}
+			seen[f.ThriftName] = struct{}{}
+			funcs = append(funcs, cliFunction{Module: module, Service: s, Function: f})
+		}
+
+		if s.ParentID == nil {
+			break
+		}
+		s = req.Services[*s.ParentID]
+	}
+	return funcs
+}
+
+const cliTemplate = `
+// Code generated by thriftrw-plugin-cli
+// @generated
+
+// Command <.Package> calls the <.Service.ThriftName> service from the command line.
+//
+//	<.Package> [flags] METHOD [ARGS]
+//
+// Run it with -h for a list of methods and flags.
+package main
+
+<$context := import "context">
+<$json := import "encoding/json">
+<$thriftcli := import "go.uber.org/thriftrw/thriftcli">
+
+func main() {
+	<$thriftcli>.Main("<.Service.ThriftName>", methods)
+}
+
+var methods = []<$thriftcli>.Method{
+	<- range .Functions>
+	<- $f := .Function>
+	<- $prefix := printf "%s.%s_%s_" (import .Module.ImportPath) .Service.Name $f.Name>
+	{
+		Service: "<.Service.ThriftName>",
+		Name:    "<$f.ThriftName>",
+		Args:    &<$prefix>Args{},
+		<- if $f.GetOneWay>
+		OneWay:  true,
+		<- end>
+		Call: func(ctx <$context>.Context, c <$thriftcli>.Caller, in []byte) (interface{}, error) {
+			var args <$prefix>Args
+			if err := <$json>.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			<- if $f.GetOneWay>
+			return nil, c.CallOneWay(ctx, &args)
+			<- else>
+			var result <$prefix>Result
+			if err := c.Call(ctx, &args, &result); err != nil {
+				return nil, err
+			}
+			<if $f.ReturnType ->
+			_, err := <$prefix>Helper.UnwrapResponse(&result)
+			<- else ->
+			err := <$prefix>Helper.UnwrapResponse(&result)
+			<- end>
+			return &result, err
+			<- end>
+		},
+	},
+	<- end>
+}
+`
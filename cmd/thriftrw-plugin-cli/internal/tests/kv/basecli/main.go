@@ -0,0 +1,40 @@
+// Code generated by thriftrw-plugin-cli
+// @generated
+
+// Command basecli calls the Base service from the command line.
+//
+//	basecli [flags] METHOD [ARGS]
+//
+// Run it with -h for a list of methods and flags.
+package main
+
+import (
+	context "context"
+	json "encoding/json"
+	kv "go.uber.org/thriftrw/cmd/thriftrw-plugin-cli/internal/tests/kv"
+	thriftcli "go.uber.org/thriftrw/thriftcli"
+)
+
+func main() {
+	thriftcli.Main("Base", methods)
+}
+
+var methods = []thriftcli.Method{
+	{
+		Service: "Base",
+		Name:    "healthy",
+		Args:    &kv.Base_Healthy_Args{},
+		Call: func(ctx context.Context, c thriftcli.Caller, in []byte) (interface{}, error) {
+			var args kv.Base_Healthy_Args
+			if err := json.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			var result kv.Base_Healthy_Result
+			if err := c.Call(ctx, &args, &result); err != nil {
+				return nil, err
+			}
+			_, err := kv.Base_Healthy_Helper.UnwrapResponse(&result)
+			return &result, err
+		},
+	},
+}
@@ -0,0 +1,104 @@
+// Code generated by thriftrw-plugin-cli
+// @generated
+
+// Command keyvaluecli calls the KeyValue service from the command line.
+//
+//	keyvaluecli [flags] METHOD [ARGS]
+//
+// Run it with -h for a list of methods and flags.
+package main
+
+import (
+	context "context"
+	json "encoding/json"
+	kv "go.uber.org/thriftrw/cmd/thriftrw-plugin-cli/internal/tests/kv"
+	thriftcli "go.uber.org/thriftrw/thriftcli"
+)
+
+func main() {
+	thriftcli.Main("KeyValue", methods)
+}
+
+var methods = []thriftcli.Method{
+	{
+		Service: "KeyValue",
+		Name:    "flush",
+		Args:    &kv.KeyValue_Flush_Args{},
+		OneWay:  true,
+		Call: func(ctx context.Context, c thriftcli.Caller, in []byte) (interface{}, error) {
+			var args kv.KeyValue_Flush_Args
+			if err := json.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			return nil, c.CallOneWay(ctx, &args)
+		},
+	},
+	{
+		Service: "KeyValue",
+		Name:    "getValue",
+		Args:    &kv.KeyValue_GetValue_Args{},
+		Call: func(ctx context.Context, c thriftcli.Caller, in []byte) (interface{}, error) {
+			var args kv.KeyValue_GetValue_Args
+			if err := json.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			var result kv.KeyValue_GetValue_Result
+			if err := c.Call(ctx, &args, &result); err != nil {
+				return nil, err
+			}
+			_, err := kv.KeyValue_GetValue_Helper.UnwrapResponse(&result)
+			return &result, err
+		},
+	},
+	{
+		Service: "KeyValue",
+		Name:    "listItems",
+		Args:    &kv.KeyValue_ListItems_Args{},
+		Call: func(ctx context.Context, c thriftcli.Caller, in []byte) (interface{}, error) {
+			var args kv.KeyValue_ListItems_Args
+			if err := json.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			var result kv.KeyValue_ListItems_Result
+			if err := c.Call(ctx, &args, &result); err != nil {
+				return nil, err
+			}
+			_, err := kv.KeyValue_ListItems_Helper.UnwrapResponse(&result)
+			return &result, err
+		},
+	},
+	{
+		Service: "KeyValue",
+		Name:    "setValue",
+		Args:    &kv.KeyValue_SetValue_Args{},
+		Call: func(ctx context.Context, c thriftcli.Caller, in []byte) (interface{}, error) {
+			var args kv.KeyValue_SetValue_Args
+			if err := json.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			var result kv.KeyValue_SetValue_Result
+			if err := c.Call(ctx, &args, &result); err != nil {
+				return nil, err
+			}
+			err := kv.KeyValue_SetValue_Helper.UnwrapResponse(&result)
+			return &result, err
+		},
+	},
+	{
+		Service: "Base",
+		Name:    "healthy",
+		Args:    &kv.Base_Healthy_Args{},
+		Call: func(ctx context.Context, c thriftcli.Caller, in []byte) (interface{}, error) {
+			var args kv.Base_Healthy_Args
+			if err := json.Unmarshal(in, &args); err != nil {
+				return nil, err
+			}
+			var result kv.Base_Healthy_Result
+			if err := c.Call(ctx, &args, &result); err != nil {
+				return nil, err
+			}
+			_, err := kv.Base_Healthy_Helper.UnwrapResponse(&result)
+			return &result, err
+		},
+	},
+}
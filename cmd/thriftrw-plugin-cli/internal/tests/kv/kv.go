@@ -0,0 +1,3208 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package kv
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Item struct {
+	Key   string `json:"key,required"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// ToWire translates a Item struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Item) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Value != nil {
+		w, err = wire.NewValueBinary(v.Value), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Item struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Item struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Item
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Item) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Item is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Item struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Item struct could not be encoded.
+func (v *Item) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Value); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Item struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Item struct could not be generated from the wire
+// representation.
+func (v *Item) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Item is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Item
+// struct.
+func (v *Item) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+		i++
+	}
+
+	return fmt.Sprintf("Item{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Item match the
+// provided Item.
+//
+// This function performs a deep comparison.
+func (v *Item) Equals(rhs *Item) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && bytes.Equal(v.Value, rhs.Value))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Item.
+func (v *Item) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	if v.Value != nil {
+		enc.AddString("value", base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Item) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Item) GetValue() (o []byte) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Item) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+type KeyDoesNotExist struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyDoesNotExist struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyDoesNotExist) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyDoesNotExist struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyDoesNotExist struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyDoesNotExist
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyDoesNotExist) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyDoesNotExist struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyDoesNotExist struct could not be encoded.
+func (v *KeyDoesNotExist) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyDoesNotExist struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyDoesNotExist struct could not be generated from the wire
+// representation.
+func (v *KeyDoesNotExist) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyDoesNotExist
+// struct.
+func (v *KeyDoesNotExist) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyDoesNotExist{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*KeyDoesNotExist) ErrorName() string {
+	return "KeyDoesNotExist"
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this KeyDoesNotExist match the
+// provided KeyDoesNotExist.
+//
+// This function performs a deep comparison.
+func (v *KeyDoesNotExist) Equals(rhs *KeyDoesNotExist) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyDoesNotExist.
+func (v *KeyDoesNotExist) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyDoesNotExist) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyDoesNotExist) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+func (v *KeyDoesNotExist) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "kv",
+	Package:  "go.uber.org/thriftrw/cmd/thriftrw-plugin-cli/internal/tests/kv",
+	FilePath: "kv.thrift",
+	SHA1:     "6e6eab533f714843e4f26a2becdd3eb10f102e86",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "exception KeyDoesNotExist {\n    1: optional string key\n}\n\nstruct Item {\n    1: required string key\n    2: optional binary value\n}\n\nservice Base {\n    string healthy()\n}\n\nservice KeyValue extends Base {\n    binary getValue(1: string key) throws (1: KeyDoesNotExist doesNotExist)\n    void setValue(1: string key, 2: binary value)\n    list<Item> listItems(1: optional i32 limit, 2: string type)\n    oneway void flush()\n}\n"
+
+// Base_Healthy_Args represents the arguments for the Base.healthy function.
+//
+// The arguments for healthy are sent and received over the wire as this struct.
+type Base_Healthy_Args struct {
+}
+
+// ToWire translates a Base_Healthy_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Base_Healthy_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Base_Healthy_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Base_Healthy_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Base_Healthy_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Base_Healthy_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Base_Healthy_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Base_Healthy_Args struct could not be encoded.
+func (v *Base_Healthy_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Base_Healthy_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Base_Healthy_Args struct could not be generated from the wire
+// representation.
+func (v *Base_Healthy_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Base_Healthy_Args
+// struct.
+func (v *Base_Healthy_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Base_Healthy_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Base_Healthy_Args match the
+// provided Base_Healthy_Args.
+//
+// This function performs a deep comparison.
+func (v *Base_Healthy_Args) Equals(rhs *Base_Healthy_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Base_Healthy_Args.
+func (v *Base_Healthy_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "healthy" for this struct.
+func (v *Base_Healthy_Args) MethodName() string {
+	return "healthy"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Base_Healthy_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Base_Healthy_Helper provides functions that aid in handling the
+// parameters and return values of the Base.healthy
+// function.
+var Base_Healthy_Helper = struct {
+	// Args accepts the parameters of healthy in-order and returns
+	// the arguments struct for the function.
+	Args func() *Base_Healthy_Args
+
+	// IsException returns true if the given error can be thrown
+	// by healthy.
+	//
+	// An error can be thrown by healthy only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for healthy
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// healthy into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by healthy
+	//
+	//   value, err := healthy(args)
+	//   result, err := Base_Healthy_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from healthy: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(string, error) (*Base_Healthy_Result, error)
+
+	// UnwrapResponse takes the result struct for healthy
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if healthy threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Base_Healthy_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Base_Healthy_Result) (string, error)
+}{}
+
+func init() {
+	Base_Healthy_Helper.Args = func() *Base_Healthy_Args {
+		return &Base_Healthy_Args{}
+	}
+
+	Base_Healthy_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Base_Healthy_Helper.WrapResponse = func(success string, err error) (*Base_Healthy_Result, error) {
+		if err == nil {
+			return &Base_Healthy_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Base_Healthy_Helper.UnwrapResponse = func(result *Base_Healthy_Result) (success string, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Base_Healthy_Result represents the result of a Base.healthy function call.
+//
+// The result of a healthy execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Base_Healthy_Result struct {
+	// Value returned by healthy after a successful execution.
+	Success *string `json:"success,omitempty"`
+}
+
+// ToWire translates a Base_Healthy_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Base_Healthy_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueString(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Base_Healthy_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Base_Healthy_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Base_Healthy_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Base_Healthy_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Base_Healthy_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Base_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Base_Healthy_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Base_Healthy_Result struct could not be encoded.
+func (v *Base_Healthy_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Base_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Base_Healthy_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Base_Healthy_Result struct could not be generated from the wire
+// representation.
+func (v *Base_Healthy_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Base_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Base_Healthy_Result
+// struct.
+func (v *Base_Healthy_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Base_Healthy_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Base_Healthy_Result match the
+// provided Base_Healthy_Result.
+//
+// This function performs a deep comparison.
+func (v *Base_Healthy_Result) Equals(rhs *Base_Healthy_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Base_Healthy_Result.
+func (v *Base_Healthy_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Base_Healthy_Result) GetSuccess() (o string) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Base_Healthy_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "healthy" for this struct.
+func (v *Base_Healthy_Result) MethodName() string {
+	return "healthy"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Base_Healthy_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_Flush_Args represents the arguments for the KeyValue.flush function.
+//
+// The arguments for flush are sent and received over the wire as this struct.
+type KeyValue_Flush_Args struct {
+}
+
+// ToWire translates a KeyValue_Flush_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Flush_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_Flush_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Flush_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Flush_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Flush_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Flush_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Flush_Args struct could not be encoded.
+func (v *KeyValue_Flush_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_Flush_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Flush_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Flush_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Flush_Args
+// struct.
+func (v *KeyValue_Flush_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("KeyValue_Flush_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_Flush_Args match the
+// provided KeyValue_Flush_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Flush_Args) Equals(rhs *KeyValue_Flush_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Flush_Args.
+func (v *KeyValue_Flush_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "flush" for this struct.
+func (v *KeyValue_Flush_Args) MethodName() string {
+	return "flush"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *KeyValue_Flush_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// KeyValue_Flush_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.flush
+// function.
+var KeyValue_Flush_Helper = struct {
+	// Args accepts the parameters of flush in-order and returns
+	// the arguments struct for the function.
+	Args func() *KeyValue_Flush_Args
+}{}
+
+func init() {
+	KeyValue_Flush_Helper.Args = func() *KeyValue_Flush_Args {
+		return &KeyValue_Flush_Args{}
+	}
+
+}
+
+// KeyValue_GetValue_Args represents the arguments for the KeyValue.getValue function.
+//
+// The arguments for getValue are sent and received over the wire as this struct.
+type KeyValue_GetValue_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyValue_GetValue_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_GetValue_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_GetValue_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_GetValue_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_GetValue_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_GetValue_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_GetValue_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_GetValue_Args struct could not be encoded.
+func (v *KeyValue_GetValue_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_GetValue_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_GetValue_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_GetValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_GetValue_Args
+// struct.
+func (v *KeyValue_GetValue_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_GetValue_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_GetValue_Args match the
+// provided KeyValue_GetValue_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_GetValue_Args) Equals(rhs *KeyValue_GetValue_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetValue_Args.
+func (v *KeyValue_GetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyValue_GetValue_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getValue" for this struct.
+func (v *KeyValue_GetValue_Args) MethodName() string {
+	return "getValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_GetValue_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_GetValue_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.getValue
+// function.
+var KeyValue_GetValue_Helper = struct {
+	// Args accepts the parameters of getValue in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *KeyValue_GetValue_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getValue.
+	//
+	// An error can be thrown by getValue only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getValue
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getValue into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getValue
+	//
+	//   value, err := getValue(args)
+	//   result, err := KeyValue_GetValue_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getValue: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func([]byte, error) (*KeyValue_GetValue_Result, error)
+
+	// UnwrapResponse takes the result struct for getValue
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getValue threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := KeyValue_GetValue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_GetValue_Result) ([]byte, error)
+}{}
+
+func init() {
+	KeyValue_GetValue_Helper.Args = func(
+		key *string,
+	) *KeyValue_GetValue_Args {
+		return &KeyValue_GetValue_Args{
+			Key: key,
+		}
+	}
+
+	KeyValue_GetValue_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *KeyDoesNotExist:
+			return true
+		default:
+			return false
+		}
+	}
+
+	KeyValue_GetValue_Helper.WrapResponse = func(success []byte, err error) (*KeyValue_GetValue_Result, error) {
+		if err == nil {
+			return &KeyValue_GetValue_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *KeyDoesNotExist:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for KeyValue_GetValue_Result.DoesNotExist")
+			}
+			return &KeyValue_GetValue_Result{DoesNotExist: e}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_GetValue_Helper.UnwrapResponse = func(result *KeyValue_GetValue_Result) (success []byte, err error) {
+		if result.DoesNotExist != nil {
+			err = result.DoesNotExist
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// KeyValue_GetValue_Result represents the result of a KeyValue.getValue function call.
+//
+// The result of a getValue execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type KeyValue_GetValue_Result struct {
+	// Value returned by getValue after a successful execution.
+	Success      []byte           `json:"success,omitempty"`
+	DoesNotExist *KeyDoesNotExist `json:"doesNotExist,omitempty"`
+}
+
+// ToWire translates a KeyValue_GetValue_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_GetValue_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueBinary(v.Success), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.DoesNotExist != nil {
+		w, err = v.DoesNotExist.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _KeyDoesNotExist_Read(w wire.Value) (*KeyDoesNotExist, error) {
+	var v KeyDoesNotExist
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a KeyValue_GetValue_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_GetValue_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_GetValue_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				v.Success, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.DoesNotExist, err = _KeyDoesNotExist_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_GetValue_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_GetValue_Result struct could not be encoded.
+func (v *KeyValue_GetValue_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Success); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DoesNotExist != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DoesNotExist.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _KeyDoesNotExist_Decode(sr stream.Reader) (*KeyDoesNotExist, error) {
+	var v KeyDoesNotExist
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a KeyValue_GetValue_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_GetValue_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_GetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			v.Success, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.DoesNotExist, err = _KeyDoesNotExist_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_GetValue_Result
+// struct.
+func (v *KeyValue_GetValue_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.DoesNotExist != nil {
+		fields[i] = fmt.Sprintf("DoesNotExist: %v", v.DoesNotExist)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_GetValue_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_GetValue_Result match the
+// provided KeyValue_GetValue_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_GetValue_Result) Equals(rhs *KeyValue_GetValue_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && bytes.Equal(v.Success, rhs.Success))) {
+		return false
+	}
+	if !((v.DoesNotExist == nil && rhs.DoesNotExist == nil) || (v.DoesNotExist != nil && rhs.DoesNotExist != nil && v.DoesNotExist.Equals(rhs.DoesNotExist))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", base64.StdEncoding.EncodeToString(v.Success))
+	}
+	if v.DoesNotExist != nil {
+		err = multierr.Append(err, enc.AddObject("doesNotExist", v.DoesNotExist))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Result) GetSuccess() (o []byte) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *KeyValue_GetValue_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetDoesNotExist returns the value of DoesNotExist if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Result) GetDoesNotExist() (o *KeyDoesNotExist) {
+	if v != nil && v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	return
+}
+
+// IsSetDoesNotExist returns true if DoesNotExist is not nil.
+func (v *KeyValue_GetValue_Result) IsSetDoesNotExist() bool {
+	return v != nil && v.DoesNotExist != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getValue" for this struct.
+func (v *KeyValue_GetValue_Result) MethodName() string {
+	return "getValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_GetValue_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_ListItems_Args represents the arguments for the KeyValue.listItems function.
+//
+// The arguments for listItems are sent and received over the wire as this struct.
+type KeyValue_ListItems_Args struct {
+	Limit *int32  `json:"limit,omitempty"`
+	Type  *string `json:"type,omitempty"`
+}
+
+// ToWire translates a KeyValue_ListItems_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_ListItems_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Limit != nil {
+		w, err = wire.NewValueI32(*(v.Limit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Type != nil {
+		w, err = wire.NewValueString(*(v.Type)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_ListItems_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_ListItems_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_ListItems_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_ListItems_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Limit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_ListItems_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_ListItems_Args struct could not be encoded.
+func (v *KeyValue_ListItems_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Limit != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Limit)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Type)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_ListItems_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_ListItems_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_ListItems_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Limit = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_ListItems_Args
+// struct.
+func (v *KeyValue_ListItems_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Limit != nil {
+		fields[i] = fmt.Sprintf("Limit: %v", *(v.Limit))
+		i++
+	}
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_ListItems_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this KeyValue_ListItems_Args match the
+// provided KeyValue_ListItems_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_ListItems_Args) Equals(rhs *KeyValue_ListItems_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Limit, rhs.Limit) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_ListItems_Args.
+func (v *KeyValue_ListItems_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Limit != nil {
+		enc.AddInt32("limit", *v.Limit)
+	}
+	if v.Type != nil {
+		enc.AddString("type", *v.Type)
+	}
+	return err
+}
+
+// GetLimit returns the value of Limit if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_ListItems_Args) GetLimit() (o int32) {
+	if v != nil && v.Limit != nil {
+		return *v.Limit
+	}
+
+	return
+}
+
+// IsSetLimit returns true if Limit is not nil.
+func (v *KeyValue_ListItems_Args) IsSetLimit() bool {
+	return v != nil && v.Limit != nil
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_ListItems_Args) GetType() (o string) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *KeyValue_ListItems_Args) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "listItems" for this struct.
+func (v *KeyValue_ListItems_Args) MethodName() string {
+	return "listItems"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_ListItems_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_ListItems_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.listItems
+// function.
+var KeyValue_ListItems_Helper = struct {
+	// Args accepts the parameters of listItems in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		limit *int32,
+		type2 *string,
+	) *KeyValue_ListItems_Args
+
+	// IsException returns true if the given error can be thrown
+	// by listItems.
+	//
+	// An error can be thrown by listItems only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for listItems
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// listItems into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by listItems
+	//
+	//   value, err := listItems(args)
+	//   result, err := KeyValue_ListItems_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from listItems: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func([]*Item, error) (*KeyValue_ListItems_Result, error)
+
+	// UnwrapResponse takes the result struct for listItems
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if listItems threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := KeyValue_ListItems_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_ListItems_Result) ([]*Item, error)
+}{}
+
+func init() {
+	KeyValue_ListItems_Helper.Args = func(
+		limit *int32,
+		type2 *string,
+	) *KeyValue_ListItems_Args {
+		return &KeyValue_ListItems_Args{
+			Limit: limit,
+			Type:  type2,
+		}
+	}
+
+	KeyValue_ListItems_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	KeyValue_ListItems_Helper.WrapResponse = func(success []*Item, err error) (*KeyValue_ListItems_Result, error) {
+		if err == nil {
+			return &KeyValue_ListItems_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_ListItems_Helper.UnwrapResponse = func(result *KeyValue_ListItems_Result) (success []*Item, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// KeyValue_ListItems_Result represents the result of a KeyValue.listItems function call.
+//
+// The result of a listItems execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type KeyValue_ListItems_Result struct {
+	// Value returned by listItems after a successful execution.
+	Success []*Item `json:"success,omitempty"`
+}
+
+type _List_Item_ValueList []*Item
+
+func (v _List_Item_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Item', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Item_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Item_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Item_ValueList) Close() {}
+
+// ToWire translates a KeyValue_ListItems_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_ListItems_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueList(_List_Item_ValueList(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_ListItems_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Item_Read(w wire.Value) (*Item, error) {
+	var v Item
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Item_Read(l wire.ValueList) ([]*Item, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Item, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Item_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a KeyValue_ListItems_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_ListItems_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_ListItems_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_ListItems_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TList {
+				v.Success, err = _List_Item_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_ListItems_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+func _List_Item_Encode(val []*Item, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Item
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Item', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a KeyValue_ListItems_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_ListItems_Result struct could not be encoded.
+func (v *KeyValue_ListItems_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Item_Encode(v.Success, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("KeyValue_ListItems_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Item_Decode(sr stream.Reader) (*Item, error) {
+	var v Item
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Item_Decode(sr stream.Reader) ([]*Item, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Item, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Item_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a KeyValue_ListItems_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_ListItems_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_ListItems_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TList:
+			v.Success, err = _List_Item_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_ListItems_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_ListItems_Result
+// struct.
+func (v *KeyValue_ListItems_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_ListItems_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Item_Equals(lhs, rhs []*Item) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this KeyValue_ListItems_Result match the
+// provided KeyValue_ListItems_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_ListItems_Result) Equals(rhs *KeyValue_ListItems_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && _List_Item_Equals(v.Success, rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_Item_Zapper []*Item
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Item_Zapper.
+func (l _List_Item_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_ListItems_Result.
+func (v *KeyValue_ListItems_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddArray("success", (_List_Item_Zapper)(v.Success)))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_ListItems_Result) GetSuccess() (o []*Item) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *KeyValue_ListItems_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "listItems" for this struct.
+func (v *KeyValue_ListItems_Result) MethodName() string {
+	return "listItems"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_ListItems_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_SetValue_Args represents the arguments for the KeyValue.setValue function.
+//
+// The arguments for setValue are sent and received over the wire as this struct.
+type KeyValue_SetValue_Args struct {
+	Key   *string `json:"key,omitempty"`
+	Value []byte  `json:"value,omitempty"`
+}
+
+// ToWire translates a KeyValue_SetValue_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_SetValue_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Value != nil {
+		w, err = wire.NewValueBinary(v.Value), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_SetValue_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_SetValue_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_SetValue_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_SetValue_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_SetValue_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_SetValue_Args struct could not be encoded.
+func (v *KeyValue_SetValue_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Value); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_SetValue_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_SetValue_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_SetValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_SetValue_Args
+// struct.
+func (v *KeyValue_SetValue_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_SetValue_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_SetValue_Args match the
+// provided KeyValue_SetValue_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_SetValue_Args) Equals(rhs *KeyValue_SetValue_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && bytes.Equal(v.Value, rhs.Value))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.Value != nil {
+		enc.AddString("value", base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_SetValue_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyValue_SetValue_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_SetValue_Args) GetValue() (o []byte) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *KeyValue_SetValue_Args) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "setValue" for this struct.
+func (v *KeyValue_SetValue_Args) MethodName() string {
+	return "setValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_SetValue_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_SetValue_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.setValue
+// function.
+var KeyValue_SetValue_Helper = struct {
+	// Args accepts the parameters of setValue in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+		value []byte,
+	) *KeyValue_SetValue_Args
+
+	// IsException returns true if the given error can be thrown
+	// by setValue.
+	//
+	// An error can be thrown by setValue only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for setValue
+	// given the error returned by it. The provided error may
+	// be nil if setValue did not fail.
+	//
+	// This allows mapping errors returned by setValue into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// setValue
+	//
+	//   err := setValue(args)
+	//   result, err := KeyValue_SetValue_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from setValue: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*KeyValue_SetValue_Result, error)
+
+	// UnwrapResponse takes the result struct for setValue
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if setValue threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := KeyValue_SetValue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_SetValue_Result) error
+}{}
+
+func init() {
+	KeyValue_SetValue_Helper.Args = func(
+		key *string,
+		value []byte,
+	) *KeyValue_SetValue_Args {
+		return &KeyValue_SetValue_Args{
+			Key:   key,
+			Value: value,
+		}
+	}
+
+	KeyValue_SetValue_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	KeyValue_SetValue_Helper.WrapResponse = func(err error) (*KeyValue_SetValue_Result, error) {
+		if err == nil {
+			return &KeyValue_SetValue_Result{}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_SetValue_Helper.UnwrapResponse = func(result *KeyValue_SetValue_Result) (err error) {
+		return
+	}
+
+}
+
+// KeyValue_SetValue_Result represents the result of a KeyValue.setValue function call.
+//
+// The result of a setValue execution is sent and received over the wire as this struct.
+type KeyValue_SetValue_Result struct {
+}
+
+// ToWire translates a KeyValue_SetValue_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_SetValue_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_SetValue_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_SetValue_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_SetValue_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_SetValue_Result) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_SetValue_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_SetValue_Result struct could not be encoded.
+func (v *KeyValue_SetValue_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_SetValue_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_SetValue_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_SetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_SetValue_Result
+// struct.
+func (v *KeyValue_SetValue_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("KeyValue_SetValue_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_SetValue_Result match the
+// provided KeyValue_SetValue_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_SetValue_Result) Equals(rhs *KeyValue_SetValue_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValue_Result.
+func (v *KeyValue_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "setValue" for this struct.
+func (v *KeyValue_SetValue_Result) MethodName() string {
+	return "setValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_SetValue_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
@@ -0,0 +1,19 @@
+exception KeyDoesNotExist {
+    1: optional string key
+}
+
+struct Item {
+    1: required string key
+    2: optional binary value
+}
+
+service Base {
+    string healthy()
+}
+
+service KeyValue extends Base {
+    binary getValue(1: string key) throws (1: KeyDoesNotExist doesNotExist)
+    void setValue(1: string key, 2: binary value)
+    list<Item> listItems(1: optional i32 limit, 2: string type)
+    oneway void flush()
+}
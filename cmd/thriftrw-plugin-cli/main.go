@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// thriftrw-plugin-cli is a ThriftRW plugin that generates command line
+// clients for Thrift services.
+//
+// For each service, the plugin generates a main package named after the
+// service with the suffix "cli" alongside the generated code for the Thrift
+// file. The command has a subcommand for every function of the service,
+// which accepts its arguments as JSON and prints its result as JSON. See
+// go.uber.org/thriftrw/thriftcli for details.
+//
+// Use it by passing "--plugin=cli" to thriftrw.
+//
+//	thriftrw --plugin=cli kv.thrift
+//	go run ./kv/keyvaluecli -peer 127.0.0.1:9090 getValue '{"key": "foo"}'
+package main
+
+import (
+	"log"
+
+	"go.uber.org/thriftrw/plugin"
+)
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+	plugin.Main(&plugin.Plugin{
+		Name:             "cli",
+		ServiceGenerator: generator{},
+	})
+}
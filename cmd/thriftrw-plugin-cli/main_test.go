@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+)
+
+func TestCodeIsUpToDate(t *testing.T) {
+	// If this test fails, regenerate internal/tests/kv with thriftrw and
+	// this plugin, and commit the changes.
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "kv.thrift"))
+	require.NoError(t, err)
+
+	outputDir := t.TempDir()
+	require.NoError(t, gen.Generate(module, &gen.Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/cmd/thriftrw-plugin-cli/internal/tests",
+		ThriftRoot:    thriftRoot,
+		NoRecurse:     true,
+		Plugin:        gen.CodeGenerator{ServiceGenerator: generator{}},
+	}))
+
+	for _, name := range []string{
+		"kv/basecli/main.go",
+		"kv/keyvaluecli/main.go",
+	} {
+		want, err := os.ReadFile(filepath.Join("internal/tests", name))
+		require.NoError(t, err)
+
+		got, err := os.ReadFile(filepath.Join(outputDir, name))
+		require.NoError(t, err)
+
+		assert.Equal(t, string(want), string(got), "%v is out of date", name)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"go/token"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+// generator is an api.ServiceGenerator that generates gRPC bindings for each
+// root service.
+type generator struct{}
+
+var _ api.ServiceGenerator = generator{}
+
+// grpcFunction is a function of a service along with the service and module
+// that declared it. Inherited functions are declared by a parent service,
+// possibly in a different module.
+type grpcFunction struct {
+	Module   *api.Module
+	Service  *api.Service
+	Function *api.Function
+}
+
+type grpcData struct {
+	Package     string
+	Service     *api.Service
+	ServiceName string
+	ThriftFile  string
+	Functions   []grpcFunction
+}
+
+func (generator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	files := make(map[string][]byte)
+	for _, serviceID := range req.RootServices {
+		service := req.Services[serviceID]
+		module := req.Modules[service.ModuleID]
+
+		pkg := strings.ToLower(service.Name) + "grpc"
+		data := grpcData{
+			Package:     pkg,
+			Service:     service,
+			ServiceName: serviceName(module, service),
+			ThriftFile:  filepath.Base(module.ThriftFilePath),
+			Functions:   serviceFunctions(req, service),
+		}
+
+		opts := append([]plugin.TemplateOption{
+			plugin.GoFileImportPath(path.Join(module.ImportPath, pkg)),
+		}, templateOptions...)
+
+		filePath := filepath.Join(module.Directory, pkg, strings.ToLower(service.Name)+".go")
+		contents, err := plugin.GoFileFromTemplate(filePath, grpcTemplate, data, opts...)
+		if err != nil {
+			return nil, err
+		}
+		files[filePath] = contents
+	}
+	return &api.GenerateServiceResponse{Files: files}, nil
+}
+
+// serviceName returns the fully qualified gRPC name of the given service.
+// The Thrift file name stands in for the Protobuf package.
+//
+//	KeyValue in kv.thrift => kv.KeyValue
+func serviceName(m *api.Module, s *api.Service) string {
+	return strings.TrimSuffix(filepath.Base(m.ThriftFilePath), ".thrift") + "." + s.ThriftName
+}
+
+// serviceFunctions returns the functions of the given service, including
+// those inherited from its parents. gRPC has no inheritance, so inherited
+// functions are served as part of the child service.
+func serviceFunctions(req *api.GenerateServiceRequest, service *api.Service) []grpcFunction {
+	var funcs []grpcFunction
+	seen := make(map[string]struct{})
+	for s := service; s != nil; {
+		module := req.Modules[s.ModuleID]
+		for _, f := range s.Functions {
+			if _, ok := seen[f.ThriftName]; ok {
+				continue
+			}
+			seen[f.ThriftName] = struct{}{}
+			funcs = append(funcs, grpcFunction{Module: module, Service: s, Function: f})
+		}
+
+		if s.ParentID == nil {
+			break
+		}
+		s = req.Services[*s.ParentID]
+	}
+	return funcs
+}
+
+// paramName converts the Go name of an argument into a name suitable for a
+// function parameter.
+//
+//	Key     => key
+//	ID      => id
+//	URLPath => urlPath
+//	Type    => type_
+func paramName(name string) string {
+	runes := []rune(name)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		// Keep the last capital of an initialism that is followed by
+		// another word: URLPath => urlPath.
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	name = string(runes)
+	switch {
+	case token.Lookup(name).IsKeyword():
+		name += "_"
+	case name == "ctx", name == "c", name == "opts", name == "success", name == "err", name == "result":
+		// Reserved by the generated method signatures.
+		name += "_"
+	}
+	return name
+}
+
+// lowerFirst lowercases the first letter of the given name.
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+var templateOptions = []plugin.TemplateOption{
+	plugin.TemplateFunc("param", paramName),
+	plugin.TemplateFunc("lowerFirst", lowerFirst),
+}
+
+const grpcTemplate = `
+// Code generated by thriftrw-plugin-grpc
+// @generated
+
+// Package <.Package> provides gRPC bindings for the <.Service.ThriftName> service.
+package <.Package>
+
+<$context := import "context">
+<$grpc := import "google.golang.org/grpc">
+<$encoding := import "google.golang.org/grpc/encoding">
+<$thriftgrpc := import "go.uber.org/thriftrw/thriftgrpc">
+
+<$svc := .Service.Name>
+<$client := printf "%sClient" (lowerFirst $svc)>
+
+func init() {
+	<$encoding>.RegisterCodec(<$thriftgrpc>.Codec{})
+}
+
+// <$svc>Client is the client API for the <.Service.ThriftName> service.
+type <$svc>Client interface {
+	<- range .Functions>
+	<- $f := .Function>
+	<$f.Name>(ctx <$context>.Context<range $f.Arguments>, <param .Name> <formatType .Type><end>, opts ...<$grpc>.CallOption) (<if $f.ReturnType><formatType $f.ReturnType>, <end>error)
+	<- end>
+}
+
+type <$client> struct {
+	cc <$grpc>.ClientConnInterface
+}
+
+// New<$svc>Client builds a new client for the <.Service.ThriftName> service.
+func New<$svc>Client(cc <$grpc>.ClientConnInterface) <$svc>Client {
+	return &<$client>{cc: cc}
+}
+<range .Functions>
+<- $f := .Function>
+<- $prefix := printf "%s.%s_%s_" (import .Module.ImportPath) .Service.Name $f.Name>
+func (c *<$client>) <$f.Name>(ctx <$context>.Context<range $f.Arguments>, <param .Name> <formatType .Type><end>, opts ...<$grpc>.CallOption) (<if $f.ReturnType>success <formatType $f.ReturnType>, <end>err error) {
+	args := <$prefix>Helper.Args(<range $i, $a := $f.Arguments><if $i>, <end><param $a.Name><end>)
+	opts = append([]<$grpc>.CallOption{<$grpc>.CallContentSubtype(<$thriftgrpc>.ContentSubtype)}, opts...)
+	<- if $f.GetOneWay>
+	err = c.cc.Invoke(ctx, "/<$.ServiceName>/<$f.ThriftName>", args, &<$thriftgrpc>.Empty{}, opts...)
+	return
+	<- else>
+	var result <$prefix>Result
+	if err = c.cc.Invoke(ctx, "/<$.ServiceName>/<$f.ThriftName>", args, &result, opts...); err != nil {
+		return
+	}
+	<if $f.ReturnType>
+	success, err = <$prefix>Helper.UnwrapResponse(&result)
+	<- else>
+	err = <$prefix>Helper.UnwrapResponse(&result)
+	<- end>
+	return
+	<- end>
+}
+<end>
+
+// <$svc>Server is the server API for the <.Service.ThriftName> service.
+//
+// Exceptions declared in the Thrift file are returned to clients as part of
+// the result. Other errors fail the gRPC call.
+type <$svc>Server interface {
+	<- range .Functions>
+	<- $f := .Function>
+	<$f.Name>(ctx <$context>.Context<range $f.Arguments>, <param .Name> <formatType .Type><end>) <if $f.ReturnType>(<formatType $f.ReturnType>, error)<else>error<end>
+	<- end>
+}
+
+// Register<$svc>Server registers the given implementation of the
+// <.Service.ThriftName> service with a gRPC server.
+func Register<$svc>Server(s <$grpc>.ServiceRegistrar, srv <$svc>Server) {
+	s.RegisterService(&<$svc>_ServiceDesc, srv)
+}
+<range .Functions>
+<- $f := .Function>
+<- $prefix := printf "%s.%s_%s_" (import .Module.ImportPath) .Service.Name $f.Name>
+func _<$svc>_<$f.Name>_Handler(srv interface{}, ctx <$context>.Context, dec func(interface{}) error, interceptor <$grpc>.UnaryServerInterceptor) (interface{}, error) {
+	in := new(<$prefix>Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx <$context>.Context, req interface{}) (interface{}, error) {
+		<- if $f.Arguments>
+		args := req.(*<$prefix>Args)
+		<- end>
+		<- if $f.GetOneWay>
+		return &<$thriftgrpc>.Empty{}, srv.(<$svc>Server).<$f.Name>(ctx<range $f.Arguments>, args.<.Name><end>)
+		<- else>
+		return <$prefix>Helper.WrapResponse(srv.(<$svc>Server).<$f.Name>(ctx<range $f.Arguments>, args.<.Name><end>))
+		<- end>
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &<$grpc>.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/<$.ServiceName>/<$f.ThriftName>",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+<end>
+
+// <$svc>_ServiceDesc is the grpc.ServiceDesc for the <.Service.ThriftName> service.
+var <$svc>_ServiceDesc = <$grpc>.ServiceDesc{
+	ServiceName: "<.ServiceName>",
+	HandlerType: (*<$svc>Server)(nil),
+	Methods: []<$grpc>.MethodDesc{
+		<- range .Functions>
+		{
+			MethodName: "<.Function.ThriftName>",
+			Handler:    _<$svc>_<.Function.Name>_Handler,
+		},
+		<- end>
+	},
+	Streams:  []<$grpc>.StreamDesc{},
+	Metadata: "<.ThriftFile>",
+}
+`
@@ -0,0 +1,89 @@
+// Code generated by thriftrw-plugin-grpc
+// @generated
+
+// Package basegrpc provides gRPC bindings for the Base service.
+package basegrpc
+
+import (
+	context "context"
+	kv "go.uber.org/thriftrw/cmd/thriftrw-plugin-grpc/testdata/kv"
+	thriftgrpc "go.uber.org/thriftrw/thriftgrpc"
+	grpc "google.golang.org/grpc"
+	encoding "google.golang.org/grpc/encoding"
+)
+
+func init() {
+	encoding.RegisterCodec(thriftgrpc.Codec{})
+}
+
+// BaseClient is the client API for the Base service.
+type BaseClient interface {
+	Healthy(ctx context.Context, opts ...grpc.CallOption) (string, error)
+}
+
+type baseClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewBaseClient builds a new client for the Base service.
+func NewBaseClient(cc grpc.ClientConnInterface) BaseClient {
+	return &baseClient{cc: cc}
+}
+
+func (c *baseClient) Healthy(ctx context.Context, opts ...grpc.CallOption) (success string, err error) {
+	args := kv.Base_Healthy_Helper.Args()
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(thriftgrpc.ContentSubtype)}, opts...)
+	var result kv.Base_Healthy_Result
+	if err = c.cc.Invoke(ctx, "/kv.Base/healthy", args, &result, opts...); err != nil {
+		return
+	}
+
+	success, err = kv.Base_Healthy_Helper.UnwrapResponse(&result)
+	return
+}
+
+// BaseServer is the server API for the Base service.
+//
+// Exceptions declared in the Thrift file are returned to clients as part of
+// the result. Other errors fail the gRPC call.
+type BaseServer interface {
+	Healthy(ctx context.Context) (string, error)
+}
+
+// RegisterBaseServer registers the given implementation of the
+// Base service with a gRPC server.
+func RegisterBaseServer(s grpc.ServiceRegistrar, srv BaseServer) {
+	s.RegisterService(&Base_ServiceDesc, srv)
+}
+
+func _Base_Healthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kv.Base_Healthy_Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return kv.Base_Healthy_Helper.WrapResponse(srv.(BaseServer).Healthy(ctx))
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kv.Base/healthy",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Base_ServiceDesc is the grpc.ServiceDesc for the Base service.
+var Base_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kv.Base",
+	HandlerType: (*BaseServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "healthy",
+			Handler:    _Base_Healthy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kv.thrift",
+}
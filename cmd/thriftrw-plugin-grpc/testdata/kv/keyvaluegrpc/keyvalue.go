@@ -0,0 +1,231 @@
+// Code generated by thriftrw-plugin-grpc
+// @generated
+
+// Package keyvaluegrpc provides gRPC bindings for the KeyValue service.
+package keyvaluegrpc
+
+import (
+	context "context"
+	kv "go.uber.org/thriftrw/cmd/thriftrw-plugin-grpc/testdata/kv"
+	thriftgrpc "go.uber.org/thriftrw/thriftgrpc"
+	grpc "google.golang.org/grpc"
+	encoding "google.golang.org/grpc/encoding"
+)
+
+func init() {
+	encoding.RegisterCodec(thriftgrpc.Codec{})
+}
+
+// KeyValueClient is the client API for the KeyValue service.
+type KeyValueClient interface {
+	Flush(ctx context.Context, opts ...grpc.CallOption) error
+	GetValue(ctx context.Context, key *string, opts ...grpc.CallOption) ([]byte, error)
+	ListItems(ctx context.Context, limit *int32, type_ *string, opts ...grpc.CallOption) ([]*kv.Item, error)
+	SetValue(ctx context.Context, key *string, value []byte, opts ...grpc.CallOption) error
+	Healthy(ctx context.Context, opts ...grpc.CallOption) (string, error)
+}
+
+type keyValueClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewKeyValueClient builds a new client for the KeyValue service.
+func NewKeyValueClient(cc grpc.ClientConnInterface) KeyValueClient {
+	return &keyValueClient{cc: cc}
+}
+
+func (c *keyValueClient) Flush(ctx context.Context, opts ...grpc.CallOption) (err error) {
+	args := kv.KeyValue_Flush_Helper.Args()
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(thriftgrpc.ContentSubtype)}, opts...)
+	err = c.cc.Invoke(ctx, "/kv.KeyValue/flush", args, &thriftgrpc.Empty{}, opts...)
+	return
+}
+
+func (c *keyValueClient) GetValue(ctx context.Context, key *string, opts ...grpc.CallOption) (success []byte, err error) {
+	args := kv.KeyValue_GetValue_Helper.Args(key)
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(thriftgrpc.ContentSubtype)}, opts...)
+	var result kv.KeyValue_GetValue_Result
+	if err = c.cc.Invoke(ctx, "/kv.KeyValue/getValue", args, &result, opts...); err != nil {
+		return
+	}
+
+	success, err = kv.KeyValue_GetValue_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c *keyValueClient) ListItems(ctx context.Context, limit *int32, type_ *string, opts ...grpc.CallOption) (success []*kv.Item, err error) {
+	args := kv.KeyValue_ListItems_Helper.Args(limit, type_)
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(thriftgrpc.ContentSubtype)}, opts...)
+	var result kv.KeyValue_ListItems_Result
+	if err = c.cc.Invoke(ctx, "/kv.KeyValue/listItems", args, &result, opts...); err != nil {
+		return
+	}
+
+	success, err = kv.KeyValue_ListItems_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c *keyValueClient) SetValue(ctx context.Context, key *string, value []byte, opts ...grpc.CallOption) (err error) {
+	args := kv.KeyValue_SetValue_Helper.Args(key, value)
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(thriftgrpc.ContentSubtype)}, opts...)
+	var result kv.KeyValue_SetValue_Result
+	if err = c.cc.Invoke(ctx, "/kv.KeyValue/setValue", args, &result, opts...); err != nil {
+		return
+	}
+
+	err = kv.KeyValue_SetValue_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c *keyValueClient) Healthy(ctx context.Context, opts ...grpc.CallOption) (success string, err error) {
+	args := kv.Base_Healthy_Helper.Args()
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(thriftgrpc.ContentSubtype)}, opts...)
+	var result kv.Base_Healthy_Result
+	if err = c.cc.Invoke(ctx, "/kv.KeyValue/healthy", args, &result, opts...); err != nil {
+		return
+	}
+
+	success, err = kv.Base_Healthy_Helper.UnwrapResponse(&result)
+	return
+}
+
+// KeyValueServer is the server API for the KeyValue service.
+//
+// Exceptions declared in the Thrift file are returned to clients as part of
+// the result. Other errors fail the gRPC call.
+type KeyValueServer interface {
+	Flush(ctx context.Context) error
+	GetValue(ctx context.Context, key *string) ([]byte, error)
+	ListItems(ctx context.Context, limit *int32, type_ *string) ([]*kv.Item, error)
+	SetValue(ctx context.Context, key *string, value []byte) error
+	Healthy(ctx context.Context) (string, error)
+}
+
+// RegisterKeyValueServer registers the given implementation of the
+// KeyValue service with a gRPC server.
+func RegisterKeyValueServer(s grpc.ServiceRegistrar, srv KeyValueServer) {
+	s.RegisterService(&KeyValue_ServiceDesc, srv)
+}
+
+func _KeyValue_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kv.KeyValue_Flush_Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &thriftgrpc.Empty{}, srv.(KeyValueServer).Flush(ctx)
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kv.KeyValue/flush",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValue_GetValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kv.KeyValue_GetValue_Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		args := req.(*kv.KeyValue_GetValue_Args)
+		return kv.KeyValue_GetValue_Helper.WrapResponse(srv.(KeyValueServer).GetValue(ctx, args.Key))
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kv.KeyValue/getValue",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValue_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kv.KeyValue_ListItems_Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		args := req.(*kv.KeyValue_ListItems_Args)
+		return kv.KeyValue_ListItems_Helper.WrapResponse(srv.(KeyValueServer).ListItems(ctx, args.Limit, args.Type))
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kv.KeyValue/listItems",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValue_SetValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kv.KeyValue_SetValue_Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		args := req.(*kv.KeyValue_SetValue_Args)
+		return kv.KeyValue_SetValue_Helper.WrapResponse(srv.(KeyValueServer).SetValue(ctx, args.Key, args.Value))
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kv.KeyValue/setValue",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValue_Healthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kv.Base_Healthy_Args)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return kv.Base_Healthy_Helper.WrapResponse(srv.(KeyValueServer).Healthy(ctx))
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kv.KeyValue/healthy",
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KeyValue_ServiceDesc is the grpc.ServiceDesc for the KeyValue service.
+var KeyValue_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kv.KeyValue",
+	HandlerType: (*KeyValueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "flush",
+			Handler:    _KeyValue_Flush_Handler,
+		},
+		{
+			MethodName: "getValue",
+			Handler:    _KeyValue_GetValue_Handler,
+		},
+		{
+			MethodName: "listItems",
+			Handler:    _KeyValue_ListItems_Handler,
+		},
+		{
+			MethodName: "setValue",
+			Handler:    _KeyValue_SetValue_Handler,
+		},
+		{
+			MethodName: "healthy",
+			Handler:    _KeyValue_Healthy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kv.thrift",
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+const (
+	handlerFile = "handler.go"
+	routerFile  = "router.go"
+)
+
+// generator is an api.ServiceGenerator that generates handler scaffolding
+// for each root service.
+type generator struct {
+	// OutputDir is the directory to which thriftrw writes generated files.
+	// Handler skeletons that already exist under this directory are left
+	// untouched. If empty, skeletons are always generated.
+	OutputDir string
+}
+
+var _ api.ServiceGenerator = (*generator)(nil)
+
+// scaffoldFunction is a function of a service along with the service and
+// module that declared it. Inherited functions are declared by a parent
+// service, possibly in a different module.
+type scaffoldFunction struct {
+	Module   *api.Module
+	Service  *api.Service
+	Function *api.Function
+}
+
+type scaffoldData struct {
+	Package   string
+	Service   *api.Service
+	Functions []scaffoldFunction
+}
+
+func (g *generator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	files := make(map[string][]byte)
+	for _, serviceID := range req.RootServices {
+		service := req.Services[serviceID]
+		module := req.Modules[service.ModuleID]
+
+		pkg := strings.ToLower(service.Name) + "handler"
+		data := scaffoldData{
+			Package:   pkg,
+			Service:   service,
+			Functions: serviceFunctions(req, service),
+		}
+
+		opts := append([]plugin.TemplateOption{
+			plugin.GoFileImportPath(path.Join(module.ImportPath, pkg)),
+		}, templateOptions...)
+
+		dir := filepath.Join(module.Directory, pkg)
+
+		handlerPath := filepath.Join(dir, handlerFile)
+		exists, err := g.exists(handlerPath)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			files[handlerPath], err = plugin.GoFileFromTemplate(
+				handlerPath, handlerTemplate, data, opts...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		routerPath := filepath.Join(dir, routerFile)
+		files[routerPath], err = plugin.GoFileFromTemplate(
+			routerPath, routerTemplate, data, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &api.GenerateServiceResponse{Files: files}, nil
+}
+
+// exists reports whether the given file, relative to the output directory,
+// already exists.
+func (g *generator) exists(name string) (bool, error) {
+	if g.OutputDir == "" {
+		return false, nil
+	}
+
+	_, err := os.Stat(filepath.Join(g.OutputDir, name))
+	switch {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, fmt.Errorf("could not check for existing handler %q: %v", name, err)
+	}
+}
+
+// serviceFunctions returns the functions of the given service, including
+// those inherited from its parents.
+func serviceFunctions(req *api.GenerateServiceRequest, service *api.Service) []scaffoldFunction {
+	var funcs []scaffoldFunction
+	seen := make(map[string]struct{})
+	for s := service; s != nil; {
+		module := req.Modules[s.ModuleID]
+		for _, f := range s.Functions {
+			if _, ok := seen[f.ThriftName]; ok {
+				continue
+			}
+			seen[f.ThriftName] = struct{}{}
+			funcs = append(funcs, scaffoldFunction{Module: module, Service: s, Function: f})
+		}
+
+		if s.ParentID == nil {
+			break
+		}
+		s = req.Services[*s.ParentID]
+	}
+	return funcs
+}
+
+// paramName converts the Go name of an argument into a name suitable for a
+// function parameter.
+//
+//	Key     => key
+//	ID      => id
+//	URLPath => urlPath
+//	Type    => type_
+func paramName(name string) string {
+	runes := []rune(name)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		// Keep the last capital of an initialism that is followed by
+		// another word: URLPath => urlPath.
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	name = string(runes)
+	switch {
+	case token.Lookup(name).IsKeyword():
+		name += "_"
+	case name == "ctx", name == "h", name == "success", name == "err":
+		// Reserved by the generated method signatures.
+		name += "_"
+	}
+	return name
+}
+
+var templateOptions = []plugin.TemplateOption{
+	plugin.TemplateFunc("param", paramName),
+}
+
+const handlerTemplate = `
+// Package <.Package> implements the <.Service.ThriftName> service.
+//
+// This file was scaffolded by thriftrw-plugin-scaffold. It will not be
+// overwritten when the code is regenerated: fill in the TODOs.
+package <.Package>
+
+<$context := import "context">
+
+// Handler implements the <.Service.ThriftName> service.
+type Handler struct{}
+
+// New builds a new Handler.
+func New() *Handler {
+	return &Handler{}
+}
+
+<range .Functions>
+<- $f := .Function>
+// <$f.Name> implements <.Service.ThriftName>.<$f.ThriftName>.
+func (h *Handler) <$f.Name>(ctx <$context>.Context<range $f.Arguments>, <param .Name> <formatType .Type><end>) (<if $f.ReturnType>success <formatType $f.ReturnType>, <end>err error) {
+	// TODO: Implement <.Service.ThriftName>.<$f.ThriftName>.
+	return <if $f.ReturnType>success, <end>ErrNotImplemented
+}
+<end>
+`
+
+const routerTemplate = `
+// Code generated by thriftrw-plugin-scaffold
+// @generated
+
+package <.Package>
+
+<$context := import "context">
+<$errors := import "errors">
+<$fmt := import "fmt">
+<$wire := import "go.uber.org/thriftrw/wire">
+
+// ErrNotImplemented is returned by Handler methods that have not been
+// implemented yet.
+var ErrNotImplemented = <$errors>.New("not implemented")
+
+// Handle decodes the arguments for the given method of the <.Service.ThriftName>
+// service from body, calls the matching method of h, and returns the encoded
+// result.
+//
+// Oneway methods return an empty value.
+func (h *Handler) Handle(ctx <$context>.Context, method string, body <$wire>.Value) (<$wire>.Value, error) {
+	switch method {
+	<- range .Functions>
+	<- $f := .Function>
+	<- $prefix := printf "%s.%s_%s_" (import .Module.ImportPath) .Service.Name $f.Name>
+	case "<$f.ThriftName>":
+		var args <$prefix>Args
+		if err := args.FromWire(body); err != nil {
+			return <$wire>.Value{}, err
+		}
+		<- if $f.GetOneWay>
+		err := h.<$f.Name>(ctx<range $f.Arguments>, args.<.Name><end>)
+		return <$wire>.Value{}, err
+		<- else>
+		result, err := <$prefix>Helper.WrapResponse(h.<$f.Name>(ctx<range $f.Arguments>, args.<.Name><end>))
+		if err != nil {
+			return <$wire>.Value{}, err
+		}
+		return result.ToWire()
+		<- end>
+	<- end>
+	default:
+		return <$wire>.Value{}, <$fmt>.Errorf("unknown method %q for service %q", method, "<.Service.ThriftName>")
+	}
+}
+`
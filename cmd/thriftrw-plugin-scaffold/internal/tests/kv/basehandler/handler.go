@@ -0,0 +1,21 @@
+// Package basehandler implements the Base service.
+//
+// This file was scaffolded by thriftrw-plugin-scaffold. It will not be
+// overwritten when the code is regenerated: fill in the TODOs.
+package basehandler
+
+import context "context"
+
+// Handler implements the Base service.
+type Handler struct{}
+
+// New builds a new Handler.
+func New() *Handler {
+	return &Handler{}
+}
+
+// Healthy implements Base.healthy.
+func (h *Handler) Healthy(ctx context.Context) (success string, err error) {
+	// TODO: Implement Base.healthy.
+	return success, ErrNotImplemented
+}
@@ -0,0 +1,38 @@
+// Code generated by thriftrw-plugin-scaffold
+// @generated
+
+package basehandler
+
+import (
+	context "context"
+	errors "errors"
+	fmt "fmt"
+	kv "go.uber.org/thriftrw/cmd/thriftrw-plugin-scaffold/internal/tests/kv"
+	wire "go.uber.org/thriftrw/wire"
+)
+
+// ErrNotImplemented is returned by Handler methods that have not been
+// implemented yet.
+var ErrNotImplemented = errors.New("not implemented")
+
+// Handle decodes the arguments for the given method of the Base
+// service from body, calls the matching method of h, and returns the encoded
+// result.
+//
+// Oneway methods return an empty value.
+func (h *Handler) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	switch method {
+	case "healthy":
+		var args kv.Base_Healthy_Args
+		if err := args.FromWire(body); err != nil {
+			return wire.Value{}, err
+		}
+		result, err := kv.Base_Healthy_Helper.WrapResponse(h.Healthy(ctx))
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return result.ToWire()
+	default:
+		return wire.Value{}, fmt.Errorf("unknown method %q for service %q", method, "Base")
+	}
+}
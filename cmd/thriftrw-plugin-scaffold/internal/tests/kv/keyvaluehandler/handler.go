@@ -0,0 +1,48 @@
+// Package keyvaluehandler implements the KeyValue service.
+//
+// This file was scaffolded by thriftrw-plugin-scaffold. It will not be
+// overwritten when the code is regenerated: fill in the TODOs.
+package keyvaluehandler
+
+import (
+	context "context"
+	kv "go.uber.org/thriftrw/cmd/thriftrw-plugin-scaffold/internal/tests/kv"
+)
+
+// Handler implements the KeyValue service.
+type Handler struct{}
+
+// New builds a new Handler.
+func New() *Handler {
+	return &Handler{}
+}
+
+// Flush implements KeyValue.flush.
+func (h *Handler) Flush(ctx context.Context) (err error) {
+	// TODO: Implement KeyValue.flush.
+	return ErrNotImplemented
+}
+
+// GetValue implements KeyValue.getValue.
+func (h *Handler) GetValue(ctx context.Context, key *string) (success []byte, err error) {
+	// TODO: Implement KeyValue.getValue.
+	return success, ErrNotImplemented
+}
+
+// ListItems implements KeyValue.listItems.
+func (h *Handler) ListItems(ctx context.Context, limit *int32, type_ *string) (success []*kv.Item, err error) {
+	// TODO: Implement KeyValue.listItems.
+	return success, ErrNotImplemented
+}
+
+// SetValue implements KeyValue.setValue.
+func (h *Handler) SetValue(ctx context.Context, key *string, value []byte) (err error) {
+	// TODO: Implement KeyValue.setValue.
+	return ErrNotImplemented
+}
+
+// Healthy implements Base.healthy.
+func (h *Handler) Healthy(ctx context.Context) (success string, err error) {
+	// TODO: Implement Base.healthy.
+	return success, ErrNotImplemented
+}
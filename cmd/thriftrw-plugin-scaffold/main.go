@@ -0,0 +1,71 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// thriftrw-plugin-scaffold is a ThriftRW plugin that generates skeleton
+// handler implementations for Thrift services.
+//
+// For each service, the plugin generates a package named after the service
+// with the suffix "handler" alongside the generated code for the Thrift
+// file. The package holds two files:
+//
+//   - handler.go defines a Handler type with a method for every function of
+//     the service, each returning ErrNotImplemented. This file is yours to
+//     edit: it is only written if it does not already exist.
+//   - router.go is regenerated on every run and wires Handler to the
+//     generated argument and result types of the service.
+//
+// Use it by passing "--plugin=scaffold" to thriftrw. If thriftrw was given
+// an --out directory, pass the same directory to the plugin so that it can
+// find existing handlers.
+//
+//	thriftrw --out gen --plugin='scaffold --out=gen' kv.thrift
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jessevdk/go-flags"
+
+	"go.uber.org/thriftrw/plugin"
+)
+
+var opts struct {
+	OutputDirectory string `long:"out" short:"o" value-name:"DIR" default:"." description:"Directory to which thriftrw writes generated files. Handlers that already exist in this directory are not overwritten."`
+}
+
+func run() error {
+	if _, err := flags.Parse(&opts); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
+
+	plugin.Main(&plugin.Plugin{
+		Name:             "scaffold",
+		ServiceGenerator: &generator{OutputDir: opts.OutputDirectory},
+	})
+	return nil
+}
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/thriftrw/cmd/thriftrw-plugin-scaffold/internal/tests/kv"
+	"go.uber.org/thriftrw/cmd/thriftrw-plugin-scaffold/internal/tests/kv/keyvaluehandler"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+// generate runs thriftrw with the scaffold plugin on internal/tests/thrift/kv.thrift.
+func generate(t *testing.T, outputDir string, g *generator) {
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "kv.thrift"))
+	require.NoError(t, err)
+
+	require.NoError(t, gen.Generate(module, &gen.Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/cmd/thriftrw-plugin-scaffold/internal/tests",
+		ThriftRoot:    thriftRoot,
+		NoRecurse:     true,
+		Plugin:        gen.CodeGenerator{ServiceGenerator: g},
+	}))
+}
+
+func TestCodeIsUpToDate(t *testing.T) {
+	// If this test fails, regenerate internal/tests/kv with thriftrw and
+	// this plugin, and commit the changes.
+	outputDir := t.TempDir()
+	generate(t, outputDir, &generator{OutputDir: outputDir})
+
+	for _, name := range []string{
+		"kv/basehandler/handler.go",
+		"kv/basehandler/router.go",
+		"kv/keyvaluehandler/handler.go",
+		"kv/keyvaluehandler/router.go",
+	} {
+		want, err := os.ReadFile(filepath.Join("internal/tests", name))
+		require.NoError(t, err)
+
+		got, err := os.ReadFile(filepath.Join(outputDir, name))
+		require.NoError(t, err)
+
+		assert.Equal(t, string(want), string(got), "%v is out of date", name)
+	}
+}
+
+func TestSkipsExistingHandler(t *testing.T) {
+	outputDir := t.TempDir()
+	handlerPath := filepath.Join(outputDir, "kv/keyvaluehandler/handler.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(handlerPath), 0o755))
+	require.NoError(t, os.WriteFile(handlerPath, []byte("package keyvaluehandler\n"), 0o644))
+
+	generate(t, outputDir, &generator{OutputDir: outputDir})
+
+	got, err := os.ReadFile(handlerPath)
+	require.NoError(t, err)
+	assert.Equal(t, "package keyvaluehandler\n", string(got),
+		"existing handler must not be overwritten")
+
+	assert.FileExists(t, filepath.Join(outputDir, "kv/keyvaluehandler/router.go"),
+		"router must always be generated")
+	assert.FileExists(t, filepath.Join(outputDir, "kv/basehandler/handler.go"),
+		"missing handlers must be generated")
+}
+
+func TestHandler(t *testing.T) {
+	h := keyvaluehandler.New()
+	ctx := context.Background()
+
+	toWire := func(t *testing.T, v interface{ ToWire() (wire.Value, error) }) wire.Value {
+		w, err := v.ToWire()
+		require.NoError(t, err)
+		return w
+	}
+
+	tests := []struct {
+		method string
+		args   interface{ ToWire() (wire.Value, error) }
+	}{
+		{"healthy", kv.Base_Healthy_Helper.Args()},
+		{"getValue", kv.KeyValue_GetValue_Helper.Args(ptr.String("foo"))},
+		{"setValue", kv.KeyValue_SetValue_Helper.Args(ptr.String("foo"), []byte("bar"))},
+		{"listItems", kv.KeyValue_ListItems_Helper.Args(ptr.Int32(10), nil)},
+		{"flush", kv.KeyValue_Flush_Helper.Args()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			_, err := h.Handle(ctx, tt.method, toWire(t, tt.args))
+			assert.ErrorIs(t, err, keyvaluehandler.ErrNotImplemented)
+		})
+	}
+
+	t.Run("unknown method", func(t *testing.T) {
+		_, err := h.Handle(ctx, "deleteValue", wire.NewValueStruct(wire.Struct{}))
+		assert.EqualError(t, err, `unknown method "deleteValue" for service "KeyValue"`)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := h.Handle(ctx, "getValue", wire.NewValueI32(42))
+		assert.Error(t, err)
+	})
+}
+
+func TestParamName(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{"Key", "key"},
+		{"ID", "id"},
+		{"KeyID", "keyID"},
+		{"URLPath", "urlPath"},
+		{"Type", "type_"},
+		{"Ctx", "ctx_"},
+		{"Err", "err_"},
+		{"X", "x"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, paramName(tt.give), "paramName(%q)", tt.give)
+	}
+}
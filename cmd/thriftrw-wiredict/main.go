@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wiredict"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil && !errors.Is(err, flag.ErrHelp) {
+		log.Fatalf("%+v", err)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	flag := flag.NewFlagSet("thriftrw-wiredict", flag.ContinueOnError)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.Output(), "usage: thriftrw-wiredict -type NAME -o FILE [OPTIONS] FILE.thrift PAYLOAD...\n")
+		flag.PrintDefaults()
+	}
+	typeName := flag.String("type", "",
+		"name of the Thrift type of the payloads; types from included files may be referenced as include.Type")
+	output := flag.String("o", "", "file to write the dictionary to")
+	size := flag.Int("size", 16*1024, "maximum size of the dictionary in bytes")
+	framed := flag.Bool("framed", false,
+		"payload files contain multiple payloads, each prefixed with its 4-byte big-endian length")
+	if err := flag.Parse(args); err != nil {
+		return err
+	}
+
+	if *typeName == "" || *output == "" || flag.NArg() < 2 {
+		flag.Usage()
+		return errors.New("a -type, a -o, a Thrift file, and at least one payload file are required")
+	}
+	if *size <= 0 {
+		return fmt.Errorf("-size must be positive, got %d", *size)
+	}
+
+	module, err := compile.Compile(flag.Arg(0))
+	if err != nil {
+		return fmt.Errorf("could not compile %q: %v", flag.Arg(0), err)
+	}
+
+	spec, err := lookupType(module, *typeName)
+	if err != nil {
+		return err
+	}
+
+	b := wiredict.NewBuilder(spec)
+	var payloads [][]byte
+	for _, path := range flag.Args()[1:] {
+		ps, err := readPayloads(path, *framed)
+		if err != nil {
+			return err
+		}
+		for _, p := range ps {
+			if err := b.Add(p); err != nil {
+				return fmt.Errorf("%v: %v", path, err)
+			}
+		}
+		payloads = append(payloads, ps...)
+	}
+
+	dict := b.Dictionary(*size)
+	if err := ioutil.WriteFile(*output, dict, 0644); err != nil {
+		return err
+	}
+
+	return writeReport(stdout, *typeName, b, dict, payloads)
+}
+
+// lookupType finds a type named "Foo" or "include.Foo" in the given module.
+func lookupType(m *compile.Module, name string) (compile.TypeSpec, error) {
+	scope := compile.Scope(m)
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		inc, err := m.LookupInclude(name[:i])
+		if err != nil {
+			return nil, fmt.Errorf("unknown include %q in %q", name[:i], name)
+		}
+		scope, name = inc, name[i+1:]
+	}
+
+	spec, err := scope.LookupType(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown type %q: %v", name, err)
+	}
+	return spec, nil
+}
+
+func readPayloads(path string, framed bool) ([][]byte, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !framed {
+		return [][]byte{contents}, nil
+	}
+
+	var payloads [][]byte
+	for offset := 0; offset < len(contents); {
+		if len(contents)-offset < 4 {
+			return nil, fmt.Errorf("%v: truncated frame header at offset %d", path, offset)
+		}
+		size := int(binary.BigEndian.Uint32(contents[offset:]))
+		offset += 4
+		if len(contents)-offset < size {
+			return nil, fmt.Errorf("%v: truncated frame at offset %d", path, offset-4)
+		}
+		payloads = append(payloads, contents[offset:offset+size])
+		offset += size
+	}
+	return payloads, nil
+}
+
+// writeReport reports the size of the dictionary, and the size of the
+// payloads when compressed with and without it.
+func writeReport(w io.Writer, typeName string, b *wiredict.Builder, dict []byte, payloads [][]byte) error {
+	codec, err := wiredict.NewCodec(wiredict.Dictionary{ID: 1, Type: typeName, Data: dict})
+	if err != nil {
+		return err
+	}
+
+	var plain, withDict int64
+	for _, p := range payloads {
+		c, err := codec.Compress("", p)
+		if err != nil {
+			return err
+		}
+		plain += int64(len(c))
+
+		c, err = codec.Compress(typeName, p)
+		if err != nil {
+			return err
+		}
+		withDict += int64(len(c))
+	}
+
+	fmt.Fprintf(w, "%d payloads, %d bytes\n", b.Payloads(), b.TotalBytes())
+	fmt.Fprintf(w, "dictionary: %d bytes\n", len(dict))
+	fmt.Fprintf(w, "compressed without dictionary: %d bytes\n", plain)
+	fmt.Fprintf(w, "compressed with dictionary: %d bytes\n", withDict)
+	return nil
+}
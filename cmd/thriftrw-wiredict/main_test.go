@@ -0,0 +1,186 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const _testIDL = `
+include "./common.thrift"
+
+struct User {
+	1: required string name
+	2: optional common.Address address
+}
+`
+
+const _testCommonIDL = `
+struct Address {
+	1: required string city
+}
+`
+
+// user returns the Binary encoding of User{name: name, address: {city: "xyz"}}.
+func user(name string) []byte {
+	b := []byte{0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, byte(len(name))}
+	b = append(b, name...)
+	return append(b,
+		0x0c, 0x00, 0x02,
+		0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x03, 'x', 'y', 'z',
+		0x00,
+		0x00,
+	)
+}
+
+func writeFiles(t *testing.T, dir string, files map[string][]byte) {
+	for name, contents := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), contents, 0644))
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+
+	var framed []byte
+	for i := 0; i < 20; i++ {
+		payload := user(fmt.Sprintf("user%d", i))
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(payload)))
+		framed = append(framed, size[:]...)
+		framed = append(framed, payload...)
+	}
+
+	writeFiles(t, dir, map[string][]byte{
+		"user.thrift":   []byte(_testIDL),
+		"common.thrift": []byte(_testCommonIDL),
+		"a.bin":         user("ab"),
+		"b.bin":         user("cd"),
+		"framed.bin":    framed,
+	})
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	t.Run("single", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, run([]string{
+			"-type", "User", "-o", path("single.dict"),
+			path("user.thrift"), path("a.bin"), path("b.bin"),
+		}, &out))
+
+		dict, err := ioutil.ReadFile(path("single.dict"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{
+			0x0c, 0x00, 0x02, // address
+			0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x03, 'x', 'y', 'z', // city
+		}, dict)
+		assert.Contains(t, out.String(), "2 payloads, 48 bytes\ndictionary: 13 bytes\n")
+	})
+
+	t.Run("framed", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, run([]string{
+			"-type", "User", "-framed", "-size", "8", "-o", path("framed.dict"),
+			path("user.thrift"), path("framed.bin"),
+		}, &out))
+
+		dict, err := ioutil.ReadFile(path("framed.dict"))
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(dict), 8)
+		assert.Contains(t, out.String(), "20 payloads, ")
+		assert.Contains(t, out.String(), "compressed with dictionary: ")
+	})
+
+	t.Run("included type", func(t *testing.T) {
+		var out bytes.Buffer
+		addr := []byte{0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 'x', 0x00}
+		writeFiles(t, dir, map[string][]byte{"addr.bin": addr})
+		require.NoError(t, run([]string{
+			"-type", "common.Address", "-o", path("addr.dict"),
+			path("user.thrift"), path("addr.bin"),
+		}, &out))
+		assert.Contains(t, out.String(), "1 payloads, 9 bytes")
+	})
+}
+
+func TestRunErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string][]byte{
+		"user.thrift":   []byte(_testIDL),
+		"common.thrift": []byte(_testCommonIDL),
+		"bad.bin":       {0x0b, 0x00},
+		"badframe.bin":  {0x00, 0x00, 0x00, 0x10, 0x00},
+	})
+	path := func(name string) string { return filepath.Join(dir, name) }
+	out := path("out.dict")
+
+	tests := []struct {
+		desc    string
+		args    []string
+		wantErr string
+	}{
+		{
+			desc:    "missing type",
+			args:    []string{"-o", out, path("user.thrift"), path("bad.bin")},
+			wantErr: "a -type, a -o, a Thrift file, and at least one payload file are required",
+		},
+		{
+			desc:    "missing output",
+			args:    []string{"-type", "User", path("user.thrift"), path("bad.bin")},
+			wantErr: "a -type, a -o, a Thrift file, and at least one payload file are required",
+		},
+		{
+			desc:    "invalid size",
+			args:    []string{"-type", "User", "-o", out, "-size", "0", path("user.thrift"), path("bad.bin")},
+			wantErr: "-size must be positive, got 0",
+		},
+		{
+			desc:    "unknown type",
+			args:    []string{"-type", "Foo", "-o", out, path("user.thrift"), path("bad.bin")},
+			wantErr: `unknown type "Foo"`,
+		},
+		{
+			desc:    "invalid payload",
+			args:    []string{"-type", "User", "-o", out, path("user.thrift"), path("bad.bin")},
+			wantErr: "bad.bin: could not sample payload for User",
+		},
+		{
+			desc:    "truncated frame",
+			args:    []string{"-type", "User", "-o", out, "-framed", path("user.thrift"), path("badframe.bin")},
+			wantErr: "truncated frame at offset 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, ioutil.Discard)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wireprofile"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil && !errors.Is(err, flag.ErrHelp) {
+		log.Fatalf("%+v", err)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	flag := flag.NewFlagSet("thriftrw-wireprofile", flag.ContinueOnError)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.Output(), "usage: thriftrw-wireprofile -type NAME [OPTIONS] FILE.thrift PAYLOAD...\n")
+		flag.PrintDefaults()
+	}
+	typeName := flag.String("type", "",
+		"name of the Thrift type of the payloads; types from included files may be referenced as include.Type")
+	framed := flag.Bool("framed", false,
+		"payload files contain multiple payloads, each prefixed with its 4-byte big-endian length")
+	top := flag.Int("top", 0, "report only the N largest fields; 0 reports all fields")
+	if err := flag.Parse(args); err != nil {
+		return err
+	}
+
+	if *typeName == "" || flag.NArg() < 2 {
+		flag.Usage()
+		return errors.New("a -type, a Thrift file, and at least one payload file are required")
+	}
+
+	module, err := compile.Compile(flag.Arg(0))
+	if err != nil {
+		return fmt.Errorf("could not compile %q: %v", flag.Arg(0), err)
+	}
+
+	spec, err := lookupType(module, *typeName)
+	if err != nil {
+		return err
+	}
+
+	profile := wireprofile.New(spec)
+	for _, path := range flag.Args()[1:] {
+		if err := addFile(profile, path, *framed); err != nil {
+			return err
+		}
+	}
+
+	return writeReport(stdout, profile, *top)
+}
+
+// lookupType finds a type named "Foo" or "include.Foo" in the given module.
+func lookupType(m *compile.Module, name string) (compile.TypeSpec, error) {
+	scope := compile.Scope(m)
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		inc, err := m.LookupInclude(name[:i])
+		if err != nil {
+			return nil, fmt.Errorf("unknown include %q in %q", name[:i], name)
+		}
+		scope, name = inc, name[i+1:]
+	}
+
+	spec, err := scope.LookupType(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown type %q: %v", name, err)
+	}
+	return spec, nil
+}
+
+func addFile(p *wireprofile.Profile, path string, framed bool) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if !framed {
+		if err := p.Add(contents); err != nil {
+			return fmt.Errorf("%v: %v", path, err)
+		}
+		return nil
+	}
+
+	for offset := 0; offset < len(contents); {
+		if len(contents)-offset < 4 {
+			return fmt.Errorf("%v: truncated frame header at offset %d", path, offset)
+		}
+		size := int(binary.BigEndian.Uint32(contents[offset:]))
+		offset += 4
+		if len(contents)-offset < size {
+			return fmt.Errorf("%v: truncated frame at offset %d", path, offset-4)
+		}
+		if err := p.Add(contents[offset : offset+size]); err != nil {
+			return fmt.Errorf("%v: frame at offset %d: %v", path, offset-4, err)
+		}
+		offset += size
+	}
+	return nil
+}
+
+func writeReport(w io.Writer, p *wireprofile.Profile, top int) error {
+	fmt.Fprintf(w, "%d payloads, %d bytes\n\n", p.Payloads(), p.TotalBytes())
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIELD\tBYTES\tPERCENT\tCOUNT")
+	for i, s := range p.Report() {
+		if top > 0 && i >= top {
+			break
+		}
+		fmt.Fprintf(tw, "%v\t%d\t%.2f%%\t%d\n", s.Path, s.Bytes, s.Percent, s.Count)
+	}
+	return tw.Flush()
+}
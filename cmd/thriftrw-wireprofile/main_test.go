@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const _testIDL = `
+include "./common.thrift"
+
+struct User {
+	1: required string name
+	2: optional common.Address address
+}
+`
+
+const _testCommonIDL = `
+struct Address {
+	1: required string city
+}
+`
+
+// Binary encoding of User{name: "ab", address: {city: "xyz"}}.
+var _testPayload = []byte{
+	0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 'a', 'b',
+	0x0c, 0x00, 0x02,
+	0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x03, 'x', 'y', 'z',
+	0x00,
+	0x00,
+}
+
+func writeFiles(t *testing.T, dir string, files map[string][]byte) {
+	for name, contents := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), contents, 0644))
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+
+	var framed []byte
+	for i := 0; i < 2; i++ {
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(_testPayload)))
+		framed = append(framed, size[:]...)
+		framed = append(framed, _testPayload...)
+	}
+
+	writeFiles(t, dir, map[string][]byte{
+		"user.thrift":   []byte(_testIDL),
+		"common.thrift": []byte(_testCommonIDL),
+		"single.bin":    _testPayload,
+		"framed.bin":    framed,
+	})
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	t.Run("single", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, run([]string{"-type", "User", path("user.thrift"), path("single.bin")}, &out))
+		assert.Equal(t, "1 payloads, 24 bytes\n\n"+
+			"FIELD         BYTES  PERCENT  COUNT\n"+
+			"address       14     58.33%   1\n"+
+			"address.city  10     41.67%   1\n"+
+			"name          9      37.50%   1\n", out.String())
+	})
+
+	t.Run("framed top", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, run([]string{"-type", "User", "-framed", "-top", "1", path("user.thrift"), path("framed.bin")}, &out))
+		assert.Equal(t, "2 payloads, 48 bytes\n\n"+
+			"FIELD    BYTES  PERCENT  COUNT\n"+
+			"address  28     58.33%   2\n", out.String())
+	})
+
+	t.Run("included type", func(t *testing.T) {
+		var out bytes.Buffer
+		addr := []byte{0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 'x', 0x00}
+		writeFiles(t, dir, map[string][]byte{"addr.bin": addr})
+		require.NoError(t, run([]string{"-type", "common.Address", path("user.thrift"), path("addr.bin")}, &out))
+		assert.Contains(t, out.String(), "city")
+	})
+}
+
+func TestRunErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string][]byte{
+		"user.thrift":   []byte(_testIDL),
+		"common.thrift": []byte(_testCommonIDL),
+		"bad.bin":       {0x0b, 0x00},
+		"badframe.bin":  {0x00, 0x00, 0x00, 0x10, 0x00},
+	})
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	tests := []struct {
+		desc    string
+		args    []string
+		wantErr string
+	}{
+		{
+			desc:    "missing type",
+			args:    []string{path("user.thrift"), path("bad.bin")},
+			wantErr: "a -type, a Thrift file, and at least one payload file are required",
+		},
+		{
+			desc:    "unknown type",
+			args:    []string{"-type", "Foo", path("user.thrift"), path("bad.bin")},
+			wantErr: `unknown type "Foo"`,
+		},
+		{
+			desc:    "unknown include",
+			args:    []string{"-type", "foo.Foo", path("user.thrift"), path("bad.bin")},
+			wantErr: `unknown include "foo" in "foo.Foo"`,
+		},
+		{
+			desc:    "invalid payload",
+			args:    []string{"-type", "User", path("user.thrift"), path("bad.bin")},
+			wantErr: "could not profile payload for User",
+		},
+		{
+			desc:    "truncated frame",
+			args:    []string{"-type", "User", "-framed", path("user.thrift"), path("badframe.bin")},
+			wantErr: "truncated frame at offset 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, ioutil.Discard)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
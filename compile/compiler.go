@@ -21,11 +21,13 @@
 package compile
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/internal/preprocess"
 )
 
 // Compile parses and compiles the Thrift file at the given path and any other
@@ -48,6 +50,7 @@ func Compile(path string, opts ...Option) (*Module, error) {
 				Reason: err,
 			}
 		}
+		m.Warnings = c.lints[m.ThriftPath].lint(m)
 		return nil
 	})
 	return m, err
@@ -59,14 +62,23 @@ type compiler struct {
 	fs FS
 	// nonStrict will compile Thrift files that do not pass strict validation.
 	nonStrict bool
+	// implicitFieldIDs allows fields without field identifiers.
+	implicitFieldIDs bool
+	// preprocess expands templates and macros in Thrift files before
+	// parsing them, with the given defines.
+	preprocess bool
+	defines    map[string]string
 	// Map from file path to Module representing that file.
 	Modules map[string]*Module
+	// Map from file path to what is needed to report warnings for it.
+	lints map[string]*moduleLint
 }
 
 func newCompiler() compiler {
 	return compiler{
 		fs:      realFS{},
 		Modules: make(map[string]*Module),
+		lints:   make(map[string]*moduleLint),
 	}
 }
 
@@ -133,6 +145,13 @@ func (c compiler) load(p string) (*Module, error) {
 		return nil, fileReadError{Path: p, Reason: err}
 	}
 
+	if c.preprocess {
+		cfg := preprocess.Config{Defines: c.defines, Read: c.fs.Read}
+		if s, err = cfg.Expand(p, s); err != nil {
+			return nil, preprocessError{Path: p, Reason: err}
+		}
+	}
+
 	prog, err := idl.Parse(s)
 	if err != nil {
 		return nil, parseError{Path: p, Reason: err}
@@ -171,9 +190,34 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 	// This is not shared with the Go namespace because we will capitalize
 	// names and possibly allow overriding them with annotations.
 	thriftNS := newNamespace(caseSensitive)
+	lint := newModuleLint()
+	c.lints[m.ThriftPath] = lint
+	implicitFieldIDs := c.nonStrict || c.implicitFieldIDs
 
 	// Process all included modules first.
 	for _, h := range prog.Headers {
+		if ns, ok := h.(*ast.Namespace); ok {
+			if m.Namespaces == nil {
+				m.Namespaces = make(map[string]string)
+			}
+			m.Namespaces[ns.Scope] = ns.Name
+
+			switch ns.Scope {
+			case suppressKey:
+				if m.suppressed == nil {
+					m.suppressed = make(suppressions)
+				}
+				m.suppressed[WarningKind(ns.Name)] = struct{}{}
+			case implicitFieldIDsKey:
+				allow, err := parseImplicitFieldIDs(ns)
+				if err != nil {
+					return err
+				}
+				implicitFieldIDs = allow
+			}
+			continue
+		}
+
 		header, ok := h.(*ast.Include)
 		if !ok {
 			continue
@@ -192,8 +236,11 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 		}
 
 		m.Includes[include.Name] = include
+		lint.Includes[include.Name] = header.Line
 	}
 
+	lint.gatherReferences(prog)
+
 	for _, d := range prog.Definitions {
 		if err := thriftNS.claim(d.Info().Name, d.Info().Line); err != nil {
 			return definitionError{Definition: d, Reason: err}
@@ -206,6 +253,7 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 				return definitionError{Definition: d, Reason: err}
 			}
 			m.Constants[constant.Name] = constant
+			lint.gatherConstant(definition, constant)
 		case *ast.Typedef:
 			typedef, err := compileTypedef(m.ThriftPath, definition)
 			if err != nil {
@@ -220,28 +268,49 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 			m.Types[enum.ThriftName()] = enum
 		case *ast.Struct:
 			requiredness := explicitRequiredness
-			allowNegativeIDs := false
 			if c.nonStrict {
 				requiredness = defaultToOptional
-				allowNegativeIDs = true
 			}
-			s, err := compileStruct(m.ThriftPath, definition, requiredness, allowNegativeIDs)
+			s, err := compileStruct(m.ThriftPath, definition, requiredness, implicitFieldIDs)
 			if err != nil {
 				return definitionError{Definition: d, Reason: err}
 			}
 			m.Types[s.ThriftName()] = s
+			lint.gatherStruct(m.ThriftPath, definition, s)
 		case *ast.Service:
-			service, err := compileService(m.ThriftPath, definition)
+			service, err := compileService(m.ThriftPath, definition, implicitFieldIDs)
 			if err != nil {
 				return definitionError{Definition: d, Reason: err}
 			}
 			m.Services[service.Name] = service
+			lint.gatherService(m.ThriftPath, definition, service)
 		}
 	}
 
 	return nil
 }
 
+// implicitFieldIDsKey is the namespace scope used to allow or deny fields
+// without field identifiers in a Thrift file.
+const implicitFieldIDsKey = "thriftrw.implicit_field_ids"
+
+// parseImplicitFieldIDs parses a thriftrw.implicit_field_ids namespace,
+// returning whether implicit field identifiers are allowed.
+func parseImplicitFieldIDs(ns *ast.Namespace) (bool, error) {
+	switch ns.Name {
+	case "allow":
+		return true, nil
+	case "deny":
+		return false, nil
+	default:
+		return false, compileError{
+			Target: implicitFieldIDsKey,
+			Line:   ns.Line,
+			Reason: fmt.Errorf(`unknown policy %q: must be "allow" or "deny"`, ns.Name),
+		}
+	}
+}
+
 // include loads the file specified by the given include in the given Module.
 //
 // The path to the file is relative to the ThriftPath of the given module.
@@ -86,3 +86,312 @@ func TestCompileNonStrict(t *testing.T) {
 	require.NoError(t, err, "Failed to find UUID field in struct")
 	assert.False(t, uuidField.Required, "Unspecified requiredness should be treated as optional")
 }
+
+func TestImplicitFieldIDs(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		opts    []Option
+		wantIDs []int16
+		wantErr string
+	}{
+		{
+			desc: "denied by default",
+			src: `
+				struct S {
+					1: optional string a
+					optional string b
+				}
+			`,
+			wantErr: `field "b" does not have a field identifier: add one, ` +
+				`for example, "2: string b", or allow implicit field ` +
+				`identifiers with "namespace thriftrw.implicit_field_ids allow"`,
+		},
+		{
+			desc: "allowed by option",
+			src: `
+				struct S {
+					1: optional string a
+					optional string b
+					optional string c
+				}
+			`,
+			opts:    []Option{ImplicitFieldIDs()},
+			wantIDs: []int16{1, -1, -2},
+		},
+		{
+			desc: "assigned after explicit negative IDs",
+			src: `
+				struct S {
+					optional string a
+					-5: optional string b
+					optional string c
+				}
+			`,
+			opts:    []Option{ImplicitFieldIDs()},
+			wantIDs: []int16{-1, -5, -6},
+		},
+		{
+			desc: "allowed by namespace",
+			src: `
+				namespace thriftrw.implicit_field_ids allow
+				struct S { optional string a }
+			`,
+			wantIDs: []int16{-1},
+		},
+		{
+			desc: "denied by namespace",
+			src: `
+				namespace thriftrw.implicit_field_ids deny
+				struct S { optional string a }
+			`,
+			opts:    []Option{ImplicitFieldIDs()},
+			wantErr: `field "a" does not have a field identifier`,
+		},
+		{
+			desc: "function parameters",
+			src: `
+				namespace thriftrw.implicit_field_ids allow
+				struct S { optional string a }
+				service Svc { void ping(string msg) }
+			`,
+			wantIDs: []int16{-1},
+		},
+		{
+			desc: "unknown policy",
+			src: `
+				namespace thriftrw.implicit_field_ids sometimes
+			`,
+			wantErr: `cannot compile "thriftrw.implicit_field_ids" on line 2: ` +
+				`unknown policy "sometimes": must be "allow" or "deny"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/", map[string]string{"/main.thrift": tt.src}}
+			module, err := Compile("main.thrift", append(tt.opts, Filesystem(fs))...)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			s, err := module.LookupType("S")
+			require.NoError(t, err)
+
+			var ids []int16
+			for _, f := range s.(*StructSpec).Fields {
+				ids = append(ids, f.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+
+			if svc, err := module.LookupService("Svc"); err == nil {
+				assert.Equal(t, int16(-1), svc.Functions["ping"].ArgsSpec[0].ID)
+			}
+		})
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	fs := dummyFS{"/", map[string]string{
+		"/main.thrift": `
+			namespace go payments.ledger
+			namespace py payments.v1
+			include "./other.thrift"
+		`,
+		"/other.thrift": `struct S {}`,
+	}}
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"go": "payments.ledger",
+		"py": "payments.v1",
+	}, module.Namespaces)
+	assert.Nil(t, module.Includes["other"].Module.Namespaces)
+}
+
+func TestUUID(t *testing.T) {
+	t.Run("base type", func(t *testing.T) {
+		fs := dummyFS{"/", map[string]string{"/main.thrift": `
+			typedef uuid RequestID
+
+			struct S {
+				1: required uuid uuid
+				2: optional RequestID requestID
+			}
+
+			const uuid Nil = "00000000-0000-0000-0000-000000000000"
+		`}}
+		module, err := Compile("main.thrift", Filesystem(fs))
+		require.NoError(t, err)
+
+		s, err := module.LookupType("S")
+		require.NoError(t, err)
+		fields := s.(*StructSpec).Fields
+		assert.IsType(t, &UUIDSpec{}, fields[0].Type)
+		assert.IsType(t, &UUIDSpec{}, RootTypeSpec(fields[1].Type))
+		assert.Equal(t, wire.TBinary, fields[1].Type.TypeCode())
+	})
+
+	t.Run("typedef named uuid", func(t *testing.T) {
+		fs := dummyFS{"/", map[string]string{"/main.thrift": `
+			typedef string uuid
+
+			struct S {
+				1: required uuid id
+				2: optional list<uuid> ids
+			}
+
+			const uuid Root = "root"
+		`}}
+		module, err := Compile("main.thrift", Filesystem(fs))
+		require.NoError(t, err)
+
+		uuid, err := module.LookupType("uuid")
+		require.NoError(t, err)
+		s, err := module.LookupType("S")
+		require.NoError(t, err)
+		fields := s.(*StructSpec).Fields
+		assert.Equal(t, uuid, fields[0].Type)
+		assert.Equal(t, uuid, fields[1].Type.(*ListSpec).ValueSpec)
+		assert.Equal(t, wire.TBinary, uuid.TypeCode())
+		assert.IsType(t, &StringSpec{}, RootTypeSpec(module.Constants["Root"].Type))
+	})
+
+	t.Run("struct named uuid", func(t *testing.T) {
+		fs := dummyFS{"/", map[string]string{"/main.thrift": `
+			struct uuid {
+				1: required i64 high
+				2: required i64 low
+			}
+
+			struct S {
+				1: optional uuid id
+			}
+		`}}
+		module, err := Compile("main.thrift", Filesystem(fs))
+		require.NoError(t, err)
+
+		s, err := module.LookupType("S")
+		require.NoError(t, err)
+		assert.Equal(t, wire.TStruct, s.(*StructSpec).Fields[0].Type.TypeCode())
+	})
+
+	t.Run("typedef of base type named uuid", func(t *testing.T) {
+		fs := dummyFS{"/", map[string]string{"/main.thrift": `
+			typedef uuid uuid
+		`}}
+		module, err := Compile("main.thrift", Filesystem(fs))
+		require.NoError(t, err)
+
+		uuid, err := module.LookupType("uuid")
+		require.NoError(t, err)
+		assert.IsType(t, &UUIDSpec{}, RootTypeSpec(uuid))
+	})
+
+	t.Run("annotated reference to type named uuid", func(t *testing.T) {
+		fs := dummyFS{"/", map[string]string{"/main.thrift": `
+			typedef string uuid
+
+			struct S {
+				1: required uuid (go.type = "example.com/uuid.UUID") id
+			}
+		`}}
+		_, err := Compile("main.thrift", Filesystem(fs))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `annotations are not allowed on references to "uuid"`)
+	})
+}
+
+func TestI8(t *testing.T) {
+	// i8 is an alias for byte, as it is in Apache Thrift.
+	fs := dummyFS{"/", map[string]string{"/main.thrift": `
+		typedef i8 Tiny
+
+		const i8 Max = 127
+		const list<i8> Bytes = [1, 2]
+
+		struct S {
+			1: required i8 a
+			2: optional byte b
+			3: optional map<i8, list<i8>> c
+			4: optional Tiny d
+		}
+
+		service Svc {
+			i8 get(1: i8 key)
+		}
+	`}}
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err)
+
+	s, err := module.LookupType("S")
+	require.NoError(t, err)
+	fields := s.(*StructSpec).Fields
+	assert.IsType(t, &I8Spec{}, fields[0].Type)
+	assert.Equal(t, fields[0].Type, fields[1].Type)
+	c := fields[2].Type.(*MapSpec)
+	assert.IsType(t, &I8Spec{}, c.KeySpec)
+	assert.IsType(t, &I8Spec{}, c.ValueSpec.(*ListSpec).ValueSpec)
+	assert.IsType(t, &I8Spec{}, RootTypeSpec(fields[3].Type))
+	assert.Equal(t, wire.TI8, fields[3].Type.TypeCode())
+
+	max, err := module.LookupConstant("Max")
+	require.NoError(t, err)
+	assert.IsType(t, &I8Spec{}, max.Type)
+	assert.Equal(t, ConstantInt(127), max.Value)
+
+	svc, err := module.LookupService("Svc")
+	require.NoError(t, err)
+	get := svc.Functions["get"]
+	assert.IsType(t, &I8Spec{}, get.ArgsSpec[0].Type)
+	assert.IsType(t, &I8Spec{}, get.ResultSpec.ReturnType)
+}
+
+func TestPreprocess(t *testing.T) {
+	fs := dummyFS{"/", map[string]string{
+		"/templates.thrift": `
+			#@template Endpoint(Region)
+			struct ${Region}Endpoint {
+				1: required string host = "${Region}.${domain}"
+			}
+			#@end
+		`,
+		"/main.thrift": `
+			#@include "templates.thrift"
+			#@expand Endpoint(us_east)
+			#@expand Endpoint(eu_west)
+		`,
+	}}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		module, err := Compile("main.thrift", Filesystem(fs))
+		require.NoError(t, err)
+		assert.Empty(t, module.Types)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		module, err := Compile("main.thrift", Filesystem(fs),
+			Preprocess(map[string]string{"domain": "example.com"}))
+		require.NoError(t, err)
+
+		for _, region := range []string{"us_east", "eu_west"} {
+			spec, err := module.LookupType(region + "Endpoint")
+			require.NoError(t, err, "type for %v", region)
+			fields := spec.(*StructSpec).Fields
+			require.Len(t, fields, 1)
+			assert.Equal(t,
+				ConstantString(region+".example.com"), fields[0].Default)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Compile("main.thrift", Filesystem(fs), Preprocess(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `could not preprocess file "/main.thrift"`)
+		assert.Contains(t, err.Error(), `undefined macro "domain"`)
+	})
+}
@@ -21,6 +21,7 @@
 package compile
 
 import (
+	"errors"
 	"fmt"
 
 	"go.uber.org/thriftrw/ast"
@@ -30,8 +31,12 @@ import (
 type Constant struct {
 	linkOnce
 
+	// linking is true while the value of the constant is being linked.
+	linking bool
+
 	Name  string
 	File  string
+	Line  int
 	Doc   string
 	Type  TypeSpec
 	Value ConstantValue
@@ -47,6 +52,7 @@ func compileConstant(file string, src *ast.Constant) (*Constant, error) {
 	return &Constant{
 		Name:  src.Name,
 		File:  file,
+		Line:  src.Line,
 		Type:  typ,
 		Doc:   src.Doc,
 		Value: compileConstantValue(src.Value),
@@ -63,7 +69,20 @@ func (c *Constant) Link(scope Scope) (err error) {
 		return compileError{Target: c.Name, Reason: err}
 	}
 
+	c.linking = true
+	defer func() { c.linking = false }()
+
 	if c.Value, err = c.Value.Link(scope, c.Type); err != nil {
+		var cycle constantReferenceCycleError
+		if errors.As(err, &cycle) && !cycle.closed() {
+			// Report the cycle without the errors of the constants in it so
+			// that it's reported in full by the constant which closes it.
+			cycle.Nodes = append([]*Constant{c}, cycle.Nodes...)
+			if !cycle.closed() {
+				return cycle
+			}
+			err = cycle
+		}
 		return compileError{Target: c.Name, Reason: err}
 	}
 
@@ -62,6 +62,7 @@ func TestCompileConstant(t *testing.T) {
 			&Constant{
 				Name:  "version",
 				File:  "test.thrift",
+				Line:  1,
 				Type:  &I32Spec{},
 				Value: ConstantInt(1),
 			},
@@ -72,6 +73,7 @@ func TestCompileConstant(t *testing.T) {
 			&Constant{
 				Name:  "foo",
 				File:  "test.thrift",
+				Line:  1,
 				Type:  &StringSpec{},
 				Value: ConstantString("hello world"),
 			},
@@ -82,6 +84,7 @@ func TestCompileConstant(t *testing.T) {
 			&Constant{
 				Name: "foo",
 				File: "test.thrift",
+				Line: 1,
 				Type: &ListSpec{ValueSpec: &StringSpec{}},
 				Value: ConstantList{
 					ConstantString("hello"),
@@ -95,6 +98,7 @@ func TestCompileConstant(t *testing.T) {
 			&Constant{
 				Name: "foo",
 				File: "test.thrift",
+				Line: 1,
 				Type: &ListSpec{ValueSpec: &StringSpec{}},
 				Value: ConstantList{
 					ConstantString("x"),
@@ -180,3 +184,155 @@ func TestCompileConstantFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestConstantReferenceCycles(t *testing.T) {
+	tests := []struct {
+		desc  string
+		files map[string]string
+
+		// The cycle may be reported from any of its constants so only
+		// the message and the constants in it are checked.
+		wantNames []string
+	}{
+		{
+			desc: "self",
+			files: map[string]string{
+				"/main.thrift": `const i32 a = a`,
+			},
+			wantNames: []string{"a"},
+		},
+		{
+			desc: "through another constant",
+			files: map[string]string{
+				"/main.thrift": `
+					typedef i32 Number
+					const Number a = b
+					const i32 b = a
+				`,
+			},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			desc: "through containers",
+			files: map[string]string{
+				"/main.thrift": `
+					const list<list<i32>> a = [b]
+					const list<i32> b = [1, a]
+				`,
+			},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			desc: "through a field default",
+			files: map[string]string{
+				"/main.thrift": `
+					struct Node {
+						1: optional i32 value
+						2: optional Node child = empty
+					}
+					const Node empty = {"value": 1}
+				`,
+			},
+			wantNames: []string{"empty"},
+		},
+		{
+			desc: "across modules",
+			files: map[string]string{
+				"/main.thrift": `
+					include "./shared.thrift"
+					const i32 a = shared.b
+				`,
+				"/shared.thrift": `
+					include "./main.thrift"
+					const i32 b = main.a
+				`,
+			},
+			wantNames: []string{"a (/main.thrift)", "b (/shared.thrift)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Compile("/main.thrift", Filesystem(dummyFS{"/", tt.files}))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "found a constant reference cycle:")
+			for _, name := range tt.wantNames {
+				assert.Contains(t, err.Error(), name)
+			}
+		})
+	}
+}
+
+func TestConstantReferenceCycleError(t *testing.T) {
+	a := &Constant{Name: "a", File: "a.thrift"}
+	b := &Constant{Name: "b", File: "a.thrift"}
+	c := &Constant{Name: "c", File: "c.thrift"}
+
+	assert.EqualError(t,
+		constantReferenceCycleError{Nodes: []*Constant{a, b, a}},
+		"found a constant reference cycle:\n"+
+			"    a\n"+
+			" -> b\n"+
+			" -> a")
+	assert.EqualError(t,
+		constantReferenceCycleError{Nodes: []*Constant{a, c, a}},
+		"found a constant reference cycle:\n"+
+			"    a (a.thrift)\n"+
+			" -> c (c.thrift)\n"+
+			" -> a (a.thrift)")
+}
+
+func TestConstantReferencesRetained(t *testing.T) {
+	files := map[string]string{
+		"/main.thrift": `
+			include "./shared.thrift"
+
+			typedef i32 Number
+			struct Point {
+				1: required i32 x
+				2: required Number y
+			}
+
+			struct Defaults {
+				1: optional list<i32> values = [shared.answer, 1]
+				2: optional map<shared.Color, Point> points = {
+					shared.favorite: {"x": shared.answer, "y": shared.answer},
+				}
+				3: optional Point origin = shared.origin
+			}
+		`,
+		"/shared.thrift": `
+			include "./main.thrift"
+
+			enum Color { Red, Green }
+			const i32 answer = 42
+			const Color favorite = Color.Green
+			const main.Point origin = {"x": 0, "y": answer}
+		`,
+	}
+
+	m, err := Compile("/main.thrift", Filesystem(dummyFS{"/", files}))
+	require.NoError(t, err)
+
+	shared := m.Includes["shared"].Module
+	answer := ConstReference{Target: shared.Constants["answer"]}
+	favorite := ConstReference{Target: shared.Constants["favorite"]}
+	origin := ConstReference{Target: shared.Constants["origin"]}
+
+	spec, err := m.LookupType("Defaults")
+	require.NoError(t, err)
+	fields := spec.(*StructSpec).Fields
+
+	assert.Equal(t, ConstantList{answer, ConstantInt(1)}, fields[0].Default,
+		"references in lists must be retained")
+
+	points := fields[1].Default.(ConstantMap)
+	require.Len(t, points, 1)
+	assert.Equal(t, favorite, points[0].Key, "references in map keys must be retained")
+	assert.Equal(t, map[string]ConstantValue{
+		"x": answer,
+		"y": answer, // typedefs of the same type
+	}, points[0].Value.(*ConstantStruct).Fields, "references in nested structs must be retained")
+
+	assert.Equal(t, origin, fields[2].Default)
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 
 	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/thriftuuid"
 )
 
 // ConstantValue represents a compiled constant value or a reference to one.
@@ -127,10 +128,18 @@ func (c ConstantInt) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 // Link for ConstantString.
 func (c ConstantString) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	// TODO(abg): Are binary literals a thing?
-	if _, ok := RootTypeSpec(t).(*StringSpec); !ok {
+	switch RootTypeSpec(t).(type) {
+	case *StringSpec:
+		return c, nil
+	case *UUIDSpec:
+		// UUIDs are written in their canonical textual form.
+		if _, err := thriftuuid.Parse(string(c)); err != nil {
+			return nil, constantValueCastError{Value: c, Type: t, Reason: err}
+		}
+		return c, nil
+	default:
 		return nil, constantValueCastError{Value: c, Type: t}
 	}
-	return c, nil
 }
 
 // Link for ConstantDouble.
@@ -327,13 +336,63 @@ type ConstReference struct {
 }
 
 // Link for ConstReference.
+//
+// References to constants whose types resolve to the same type as the
+// given type are retained so that the constant is not duplicated in their
+// place. Other references are replaced by the value of the constant cast to
+// the given type.
 func (c ConstReference) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
-	if t == c.Target.Type {
+	if c.Target.linking {
+		// The constant refers back to itself while it is being linked.
+		return nil, constantReferenceCycleError{Nodes: []*Constant{c.Target}}
+	}
+	if sameType(RootTypeSpec(t), RootTypeSpec(c.Target.Type)) {
 		return c, nil
 	}
 	return c.Target.Value.Link(scope, t)
 }
 
+// sameType returns true if the two types are the same type or the same
+// primitive type. Distinct TypeSpecs are compiled for each mention of a
+// primitive type so they cannot be compared directly.
+func sameType(a, b TypeSpec) bool {
+	if a == b {
+		return true
+	}
+
+	switch a.(type) {
+	case *BoolSpec:
+		_, ok := b.(*BoolSpec)
+		return ok
+	case *I8Spec:
+		_, ok := b.(*I8Spec)
+		return ok
+	case *I16Spec:
+		_, ok := b.(*I16Spec)
+		return ok
+	case *I32Spec:
+		_, ok := b.(*I32Spec)
+		return ok
+	case *I64Spec:
+		_, ok := b.(*I64Spec)
+		return ok
+	case *DoubleSpec:
+		_, ok := b.(*DoubleSpec)
+		return ok
+	case *StringSpec:
+		_, ok := b.(*StringSpec)
+		return ok
+	case *BinarySpec:
+		_, ok := b.(*BinarySpec)
+		return ok
+	case *UUIDSpec:
+		_, ok := b.(*UUIDSpec)
+		return ok
+	default:
+		return false
+	}
+}
+
 // EnumItemReference represents a reference to an item of an enum defined in the
 // THrift file.
 type EnumItemReference struct {
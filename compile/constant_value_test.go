@@ -334,6 +334,24 @@ func TestCastConstants(t *testing.T) {
 			},
 			wantError: "100 is not a string: all keys must be strings",
 		},
+		{
+			desc: "ConstantString: uuid",
+			typ:  &UUIDSpec{},
+			give: ConstantString("123e4567-e89b-12d3-a456-426614174000"),
+			want: ConstantString("123e4567-e89b-12d3-a456-426614174000"),
+		},
+		{
+			desc:      "ConstantString: invalid uuid",
+			typ:       &UUIDSpec{},
+			give:      ConstantString("123e4567"),
+			wantError: `invalid UUID "123e4567"`,
+		},
+		{
+			desc:      "ConstantInt: uuid",
+			typ:       &UUIDSpec{},
+			give:      ConstantInt(42),
+			wantError: `cannot cast 42 to "uuid"`,
+		},
 		{
 			desc: "ConstantSet",
 			typ:  &SetSpec{ValueSpec: &I32Spec{}},
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/thriftreflect"
+)
+
+// CompileEmbedded compiles the Thrift IDL embedded in a generated package.
+//
+// Generated packages expose their IDL, and that of their includes, as
+// ThriftModule. This compiles it into a Module without access to the
+// original Thrift files on disk.
+//
+//	m, err := compile.CompileEmbedded(keyvalue.ThriftModule)
+//
+// The ThriftPath of the returned Module, and of the modules it includes, is
+// the FilePath of the corresponding ThriftModule relative to "/".
+func CompileEmbedded(tm *thriftreflect.ThriftModule, opts ...Option) (*Module, error) {
+	fs := make(embeddedFS)
+	fs.add(tm)
+
+	opts = append(opts, Filesystem(fs))
+	return Compile(fs.path(tm.FilePath), opts...)
+}
+
+// embeddedFS is an in-memory filesystem containing the IDL embedded in
+// generated packages, keyed by absolute path.
+type embeddedFS map[string]string
+
+func (fs embeddedFS) add(tm *thriftreflect.ThriftModule) {
+	p := fs.path(tm.FilePath)
+	if _, ok := fs[p]; ok {
+		return
+	}
+
+	fs[p] = tm.Raw
+	for _, inc := range tm.Includes {
+		fs.add(inc)
+	}
+}
+
+func (embeddedFS) path(p string) string {
+	return filepath.Join(string(filepath.Separator), p)
+}
+
+func (fs embeddedFS) Read(filename string) ([]byte, error) {
+	if raw, ok := fs[filename]; ok {
+		return []byte(raw), nil
+	}
+	return nil, fmt.Errorf("IDL for %q is not embedded", filename)
+}
+
+func (fs embeddedFS) Abs(p string) (string, error) {
+	return fs.path(p), nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/thriftreflect"
+)
+
+func TestCompileEmbedded(t *testing.T) {
+	common := &thriftreflect.ThriftModule{
+		Name:     "common",
+		FilePath: "shared/common.thrift",
+		Raw:      "typedef string Key",
+	}
+	kv := &thriftreflect.ThriftModule{
+		Name:     "kv",
+		FilePath: "idl/kv.thrift",
+		Includes: []*thriftreflect.ThriftModule{common},
+		Raw: `
+			include "../shared/common.thrift"
+
+			struct Item {
+				1: required common.Key key
+				2: optional binary value
+			}
+
+			service KeyValue {
+				Item get(1: common.Key key)
+			}
+		`,
+	}
+
+	m, err := CompileEmbedded(kv)
+	require.NoError(t, err)
+
+	assert.Equal(t, "kv", m.Name)
+	assert.Equal(t, "/idl/kv.thrift", m.ThriftPath)
+
+	spec, err := m.LookupType("Item")
+	require.NoError(t, err)
+	item, ok := spec.(*StructSpec)
+	require.True(t, ok, "Item must be a struct, got %T", spec)
+	require.Len(t, item.Fields, 2)
+	assert.Equal(t, "Key", item.Fields[0].Type.ThriftName())
+
+	_, err = m.LookupService("KeyValue")
+	assert.NoError(t, err)
+
+	inc, ok := m.Includes["common"]
+	require.True(t, ok, "common must be included")
+	assert.Equal(t, "/shared/common.thrift", inc.Module.ThriftPath)
+}
+
+func TestCompileEmbeddedMissingInclude(t *testing.T) {
+	_, err := CompileEmbedded(&thriftreflect.ThriftModule{
+		Name:     "kv",
+		FilePath: "kv.thrift",
+		Raw:      `include "./common.thrift"`,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `IDL for "/common.thrift" is not embedded`)
+}
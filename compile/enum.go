@@ -29,6 +29,7 @@ import (
 type EnumSpec struct {
 	Name        string
 	File        string
+	Line        int
 	Items       []EnumItem
 	Annotations Annotations
 	Doc         string
@@ -91,6 +92,7 @@ func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 	return &EnumSpec{
 		Name:        src.Name,
 		File:        file,
+		Line:        src.Line,
 		Doc:         src.Doc,
 		Items:       items,
 		Annotations: annotations,
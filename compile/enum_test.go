@@ -55,6 +55,7 @@ func TestCompileEnumSuccess(t *testing.T) {
 			&EnumSpec{
 				Name: "Role",
 				File: "test.thrift",
+				Line: 1,
 				Items: []EnumItem{
 					{Name: "Disabled", Value: 0},
 					{Name: "User", Value: 1},
@@ -69,6 +70,7 @@ func TestCompileEnumSuccess(t *testing.T) {
 			&EnumSpec{
 				Name: "CommentStatus",
 				File: "test.thrift",
+				Line: 1,
 				Items: []EnumItem{
 					{Name: "Visible", Value: 12345},
 					{Name: "Hidden", Value: 54321},
@@ -81,6 +83,7 @@ func TestCompileEnumSuccess(t *testing.T) {
 			&EnumSpec{
 				Name: "foo",
 				File: "test.thrift",
+				Line: 1,
 				Items: []EnumItem{
 					{Name: "A", Value: 0},
 					{Name: "B", Value: 1},
@@ -96,6 +99,7 @@ func TestCompileEnumSuccess(t *testing.T) {
 			&EnumSpec{
 				Name: "bar",
 				File: "test.thrift",
+				Line: 1,
 				Items: []EnumItem{
 					{Name: "A", Value: 0},
 					{Name: "B", Value: 0},
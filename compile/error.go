@@ -47,6 +47,16 @@ func (e parseError) Error() string {
 	return fmt.Sprintf("could not parse file %q: %v", e.Path, e.Reason)
 }
 
+// preprocessError is raised when a Thrift file cannot be preprocessed.
+type preprocessError struct {
+	Path   string
+	Reason error
+}
+
+func (e preprocessError) Error() string {
+	return fmt.Sprintf("could not preprocess file %q: %v", e.Path, e.Reason)
+}
+
 type fileCompileError struct {
 	Path   string
 	Reason error
@@ -119,6 +129,8 @@ func (e compileError) Error() string {
 	return msg
 }
 
+func (e compileError) Unwrap() error { return e.Reason }
+
 // referenceError is raised when there's an error resolving a reference.
 type referenceError struct {
 	Target    string
@@ -141,6 +153,8 @@ func (e referenceError) Error() string {
 	return msg
 }
 
+func (e referenceError) Unwrap() error { return e.Reason }
+
 type unrecognizedModuleError struct {
 	Name   string
 	Reason error
@@ -230,6 +244,22 @@ func (e fieldIDOutOfBoundsError) Error() string {
 			"field IDs must be in the range [1, 32767]", e.ID, e.Name)
 }
 
+// missingFieldIDError is raised when a field does not have an identifier and
+// implicit field identifiers are not allowed.
+type missingFieldIDError struct {
+	Field       *ast.Field
+	SuggestedID int
+}
+
+func (e missingFieldIDError) Error() string {
+	return fmt.Sprintf(
+		"field %q does not have a field identifier: add one, for example, %q, "+
+			"or allow implicit field identifiers with %q",
+		e.Field.Name,
+		fmt.Sprintf("%d: %v %v", e.SuggestedID, e.Field.Type, e.Field.Name),
+		"namespace "+implicitFieldIDsKey+" allow")
+}
+
 type oneWayCannotReturnError struct {
 	Name string
 }
@@ -311,6 +341,8 @@ func (e constantValueCastError) Error() string {
 	return s
 }
 
+func (e constantValueCastError) Unwrap() error { return e.Reason }
+
 // Failure to cast a specific field of a struct literal.
 type constantStructFieldCastError struct {
 	FieldName string
@@ -321,6 +353,61 @@ func (e constantStructFieldCastError) Error() string {
 	return fmt.Sprintf("failed to cast field %q: %v", e.FieldName, e.Reason)
 }
 
+func (e constantStructFieldCastError) Unwrap() error { return e.Reason }
+
+// constantReferenceCycleError is raised when a constant refers back to
+// itself, directly, through other constants, or through the default values
+// of struct fields.
+type constantReferenceCycleError struct {
+	// Nodes is the chain of references from the constant that closes the
+	// cycle back to it. Cycles are built up from the constant referred to
+	// as they are unwound.
+	Nodes []*Constant
+}
+
+// closed returns true if all constants in the cycle are known.
+func (e constantReferenceCycleError) closed() bool {
+	return len(e.Nodes) > 1 && e.Nodes[0] == e.Nodes[len(e.Nodes)-1]
+}
+
+func (e constantReferenceCycleError) Error() string {
+	// Outputs:
+	//
+	// 	found a constant reference cycle:
+	// 	    foo (a.thrift)
+	// 	 -> bar (b.thrift)
+	// 	 -> foo (a.thrift)
+	//
+	// File names are omitted if all constants are from the same file.
+
+	files := make(map[string]struct{})
+	for _, c := range e.Nodes {
+		if c.File != "" {
+			files[c.File] = struct{}{}
+		}
+	}
+	includeFileName := len(files) > 1
+
+	lines := make([]string, 0, len(e.Nodes)+1)
+	lines = append(lines, "found a constant reference cycle:")
+	for i, c := range e.Nodes {
+		line := " "
+		if i == 0 {
+			line += "   "
+		} else {
+			line += "-> "
+		}
+
+		if c.File != "" && includeFileName {
+			line += fmt.Sprintf("%v (%v)", c.Name, c.File)
+		} else {
+			line += c.Name
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
 type annotationConflictError struct {
 	Reason error
 }
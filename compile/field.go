@@ -183,6 +183,15 @@ func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 	usedIDs := make(map[int16]string)
 	nextNegativeID := -1
 
+	// The identifier suggested for fields without one follows the largest
+	// identifier in use.
+	suggestedID := 1
+	for _, astField := range src {
+		if !astField.IDUnset && astField.ID >= suggestedID {
+			suggestedID = astField.ID + 1
+		}
+	}
+
 	fields := make([]*FieldSpec, 0, len(src))
 	for _, astField := range src {
 		if err := fieldsNS.claim(astField.Name, astField.Line); err != nil {
@@ -202,6 +211,14 @@ func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 			}
 		}
 
+		if astField.IDUnset && !options.allowNegativeIDs {
+			return nil, compileError{
+				Target: astField.Name,
+				Line:   astField.Line,
+				Reason: missingFieldIDError{Field: astField, SuggestedID: suggestedID},
+			}
+		}
+
 		field, err := compileField(astField, options)
 		if err != nil {
 			return nil, compileError{
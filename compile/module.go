@@ -42,6 +42,16 @@ type Module struct {
 	Services  map[string]*ServiceSpec
 
 	Raw []byte // The raw IDL input.
+
+	// Namespaces maps the scopes of namespace directives in the Thrift
+	// file, such as "go" or "py", to their values. This is nil if the file
+	// has no namespace directives.
+	Namespaces map[string]string
+
+	// Warnings found while compiling the module, ordered by line.
+	Warnings []*Warning
+
+	suppressed suppressions
 }
 
 // GetName for Module
@@ -49,6 +59,12 @@ func (m *Module) GetName() string {
 	return m.Name
 }
 
+// SuppressesWarning returns true if warnings of the given kind are
+// suppressed for the whole module with a thriftrw.suppress namespace.
+func (m *Module) SuppressesWarning(k WarningKind) bool {
+	return m.suppressed.has(k)
+}
+
 // LookupType for Module.
 func (m *Module) LookupType(name string) (TypeSpec, error) {
 	if t, ok := m.Types[name]; ok {
@@ -62,3 +62,47 @@ func NonStrict() Option {
 		c.nonStrict = true
 	}
 }
+
+// Preprocess expands templates and macros in Thrift files before they are
+// parsed, so that families of nearly identical definitions may be generated
+// from one template. Directives are lines starting with "#@":
+//
+//	#@template Config(Region)
+//	struct ${Region}Config {
+//	  1: required string endpoint
+//	}
+//	#@end
+//
+//	#@expand Config(US)
+//	#@expand Config(EU)
+//
+// Lines of the form "#@define NAME value" define macros, and ${NAME} is
+// replaced by the value of a macro. The given defines are available to all
+// files and take precedence over those in the files. "#@include" makes the
+// templates and macros of another file available.
+func Preprocess(defines map[string]string) Option {
+	return func(c *compiler) {
+		c.preprocess = true
+		c.defines = defines
+	}
+}
+
+// ImplicitFieldIDs allows fields without field identifiers, as Apache Thrift
+// does. Such fields are assigned negative identifiers in the order in which
+// they are declared, starting at -1, and a warning is reported for each of
+// them. Negative field identifiers are also allowed.
+//
+// Thrift files may override this with,
+//
+//	namespace thriftrw.implicit_field_ids allow
+//
+// or,
+//
+//	namespace thriftrw.implicit_field_ids deny
+//
+// NonStrict implies ImplicitFieldIDs.
+func ImplicitFieldIDs() Option {
+	return func(c *compiler) {
+		c.implicitFieldIDs = true
+	}
+}
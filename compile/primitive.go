@@ -83,6 +83,13 @@ type (
 
 		Annotations Annotations
 	}
+
+	// UUIDSpec is the TypeSpec for uuid types in a Thrift file.
+	UUIDSpec struct {
+		nativeThriftType
+
+		Annotations Annotations
+	}
 )
 
 // TypeCode returns TBool.
@@ -110,6 +117,10 @@ func (*StringSpec) TypeCode() wire.Type { return wire.TBinary }
 // TypeCode returns TBinary.
 func (*BinarySpec) TypeCode() wire.Type { return wire.TBinary }
 
+// TypeCode returns TBinary since UUIDs are sent as 16 bytes of binary data
+// over the wire.
+func (*UUIDSpec) TypeCode() wire.Type { return wire.TBinary }
+
 // ThriftName returns "bool".
 func (*BoolSpec) ThriftName() string { return "bool" }
 
@@ -134,6 +145,9 @@ func (*StringSpec) ThriftName() string { return "string" }
 // ThriftName returns "binary".
 func (*BinarySpec) ThriftName() string { return "binary" }
 
+// ThriftName returns "uuid".
+func (*UUIDSpec) ThriftName() string { return "uuid" }
+
 // Link is a no-op for primitives.
 func (t *BoolSpec) Link(Scope) (TypeSpec, error) { return t, nil }
 
@@ -158,6 +172,25 @@ func (t *StringSpec) Link(Scope) (TypeSpec, error) { return t, nil }
 // Link is a no-op for primitives.
 func (t *BinarySpec) Link(Scope) (TypeSpec, error) { return t, nil }
 
+// Link resolves to the type named uuid if the Thrift file defines one.
+// Definitions take priority over the base type so that Thrift files which
+// defined their own uuid before it was a base type keep compiling.
+func (t *UUIDSpec) Link(scope Scope) (TypeSpec, error) {
+	defined, err := scope.LookupType("uuid")
+	if err != nil {
+		return t, nil
+	}
+	if typedef, ok := defined.(*TypedefSpec); ok && typedef.Target == TypeSpec(t) {
+		// typedef uuid uuid
+		return t, nil
+	}
+	if len(t.Annotations) > 0 {
+		return nil, fmt.Errorf("annotations are not allowed on references to %q: "+
+			"annotate its definition instead", "uuid")
+	}
+	return defined.Link(scope)
+}
+
 // ForEachTypeReference is a no-op for primitives.
 func (*BoolSpec) ForEachTypeReference(func(TypeSpec) error) error { return nil }
 
@@ -182,6 +215,9 @@ func (*StringSpec) ForEachTypeReference(func(TypeSpec) error) error { return nil
 // ForEachTypeReference is a no-op for primitives.
 func (*BinarySpec) ForEachTypeReference(func(TypeSpec) error) error { return nil }
 
+// ForEachTypeReference is a no-op for primitives.
+func (*UUIDSpec) ForEachTypeReference(func(TypeSpec) error) error { return nil }
+
 // ThriftAnnotations returns the Thrift annotations specified with the
 // reference to this type.
 func (t *BoolSpec) ThriftAnnotations() Annotations { return t.Annotations }
@@ -214,6 +250,10 @@ func (t *StringSpec) ThriftAnnotations() Annotations { return t.Annotations }
 // reference to this type.
 func (t *BinarySpec) ThriftAnnotations() Annotations { return t.Annotations }
 
+// ThriftAnnotations returns the Thrift annotations specified with the
+// reference to this type.
+func (t *UUIDSpec) ThriftAnnotations() Annotations { return t.Annotations }
+
 // compileBaseType compiles a base type reference in the AST to a primitive
 // TypeSpec.
 func compileBaseType(t ast.BaseType) (TypeSpec, error) {
@@ -239,6 +279,8 @@ func compileBaseType(t ast.BaseType) (TypeSpec, error) {
 		return &StringSpec{Annotations: annots}, nil
 	case ast.BinaryTypeID:
 		return &BinarySpec{Annotations: annots}, nil
+	case ast.UUIDTypeID:
+		return &UUIDSpec{Annotations: annots}, nil
 	default:
 		panic(fmt.Sprintf("unknown base type %v", t))
 	}
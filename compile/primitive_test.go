@@ -75,6 +75,11 @@ func TestCompileBaseType(t *testing.T) {
 			give: ast.BaseType{ID: ast.BinaryTypeID},
 			want: &BinarySpec{},
 		},
+		{
+			desc: "uuid",
+			give: ast.BaseType{ID: ast.UUIDTypeID},
+			want: &UUIDSpec{},
+		},
 
 		// With annotations (success)
 		{
@@ -171,6 +176,16 @@ func TestCompileBaseType(t *testing.T) {
 			},
 			want: &BinarySpec{Annotations: Annotations{"max_length": "42"}},
 		},
+		{
+			desc: `uuid (go.type = "example.com/uuid.UUID")`,
+			give: ast.BaseType{
+				ID: ast.UUIDTypeID,
+				Annotations: []*ast.Annotation{
+					{Name: "go.type", Value: "example.com/uuid.UUID"},
+				},
+			},
+			want: &UUIDSpec{Annotations: Annotations{"go.type": "example.com/uuid.UUID"}},
+		},
 
 		// With annotations (failure)
 		{
@@ -31,14 +31,16 @@ type ServiceSpec struct {
 
 	Name        string
 	File        string
+	Line        int
 	Parent      *ServiceSpec
 	Functions   map[string]*FunctionSpec
+	Doc         string
 	Annotations Annotations
 
 	parentSrc *ast.ServiceReference
 }
 
-func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
+func compileService(file string, src *ast.Service, allowNegativeIDs bool) (*ServiceSpec, error) {
 	serviceNS := newNamespace(caseInsensitive)
 
 	functions := make(map[string]*FunctionSpec)
@@ -51,7 +53,7 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 			}
 		}
 
-		function, err := compileFunction(astFunction)
+		function, err := compileFunction(astFunction, allowNegativeIDs)
 		if err != nil {
 			return nil, compileError{
 				Target: src.Name + "." + astFunction.Name,
@@ -75,7 +77,9 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 	return &ServiceSpec{
 		Name:        src.Name,
 		File:        file,
+		Line:        src.Line,
 		Functions:   functions,
+		Doc:         src.Doc,
 		Annotations: annotations,
 		parentSrc:   src.Parent,
 	}, nil
@@ -162,14 +166,16 @@ type FunctionSpec struct {
 	linkOnce
 
 	Name        string
+	Line        int
 	ArgsSpec    ArgsSpec
 	ResultSpec  *ResultSpec // nil if OneWay is true
 	OneWay      bool
+	Doc         string
 	Annotations Annotations
 }
 
-func compileFunction(src *ast.Function) (*FunctionSpec, error) {
-	args, err := compileArgSpec(src.Parameters)
+func compileFunction(src *ast.Function, allowNegativeIDs bool) (*FunctionSpec, error) {
+	args, err := compileArgSpec(src.Parameters, allowNegativeIDs)
 	if err != nil {
 		return nil, compileError{
 			Target: src.Name,
@@ -185,7 +191,7 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 			return nil, oneWayCannotReturnError{Name: src.Name}
 		}
 	} else {
-		result, err = compileResultSpec(src.ReturnType, src.Exceptions)
+		result, err = compileResultSpec(src.ReturnType, src.Exceptions, allowNegativeIDs)
 		if err != nil {
 			return nil, compileError{
 				Target: src.Name,
@@ -206,10 +212,12 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 
 	return &FunctionSpec{
 		Name:        src.Name,
+		Line:        src.Line,
 		ArgsSpec:    args,
 		ResultSpec:  result,
 		Annotations: annotations,
 		OneWay:      src.OneWay,
+		Doc:         src.Doc,
 	}, nil
 }
 
@@ -237,6 +245,16 @@ func (f *FunctionSpec) MethodName() string {
 	return f.Name
 }
 
+// ThriftName is the name of the function as it appears in the Thrift file.
+func (f *FunctionSpec) ThriftName() string {
+	return f.Name
+}
+
+// ThriftAnnotations returns all associated annotations.
+func (f *FunctionSpec) ThriftAnnotations() Annotations {
+	return f.Annotations
+}
+
 // CallType returns the envelope type that is used when making enveloped
 // requests for this function.
 func (f *FunctionSpec) CallType() wire.EnvelopeType {
@@ -249,10 +267,13 @@ func (f *FunctionSpec) CallType() wire.EnvelopeType {
 // ArgsSpec contains information about a Function's arguments.
 type ArgsSpec FieldGroup
 
-func compileArgSpec(args []*ast.Field) (ArgsSpec, error) {
+func compileArgSpec(args []*ast.Field, allowNegativeIDs bool) (ArgsSpec, error) {
 	fields, err := compileFields(
 		args,
-		fieldOptions{requiredness: defaultToOptional},
+		fieldOptions{
+			requiredness:     defaultToOptional,
+			allowNegativeIDs: allowNegativeIDs,
+		},
 	)
 	return ArgsSpec(fields), err
 }
@@ -268,7 +289,7 @@ type ResultSpec struct {
 	Exceptions FieldGroup
 }
 
-func compileResultSpec(returnType ast.Type, exceptions []*ast.Field) (*ResultSpec, error) {
+func compileResultSpec(returnType ast.Type, exceptions []*ast.Field, allowNegativeIDs bool) (*ResultSpec, error) {
 	var excFields FieldGroup
 
 	if len(exceptions) > 0 {
@@ -278,6 +299,7 @@ func compileResultSpec(returnType ast.Type, exceptions []*ast.Field) (*ResultSpe
 			fieldOptions{
 				requiredness:         noRequiredFields,
 				disallowDefaultValue: true,
+				allowNegativeIDs:     allowNegativeIDs,
 			},
 		)
 		if err != nil {
@@ -62,9 +62,11 @@ func TestCompileService(t *testing.T) {
 	keyValueSpec := &ServiceSpec{
 		Name: "KeyValue",
 		File: "test.thrift",
+		Line: 2,
 		Functions: map[string]*FunctionSpec{
 			"setValue": {
 				Name: "setValue",
+				Line: 3,
 				ArgsSpec: ArgsSpec{
 					{
 						ID:   1,
@@ -81,6 +83,7 @@ func TestCompileService(t *testing.T) {
 			},
 			"getValue": {
 				Name: "getValue",
+				Line: 4,
 				ArgsSpec: ArgsSpec{
 					{
 						ID:   1,
@@ -110,9 +113,11 @@ func TestCompileService(t *testing.T) {
 	annotatedSpec := &ServiceSpec{
 		Name: "AnnotatedService",
 		File: "test.thrift",
+		Line: 2,
 		Functions: map[string]*FunctionSpec{
 			"setValue": {
 				Name: "setValue",
+				Line: 3,
 				ArgsSpec: ArgsSpec{
 					{
 						ID:   1,
@@ -149,6 +154,7 @@ func TestCompileService(t *testing.T) {
 			&ServiceSpec{
 				Name:      "Foo",
 				File:      "test.thrift",
+				Line:      1,
 				Functions: make(map[string]*FunctionSpec),
 			},
 		},
@@ -180,6 +186,40 @@ func TestCompileService(t *testing.T) {
 			scope(),
 			annotatedSpec,
 		},
+		{
+			"service docs",
+			`
+				/** Stores values. */
+				service Store {
+					/**
+					 * Removes the value for the given key.
+					 */
+					void remove(1: string key)
+				}
+			`,
+			scope(),
+			&ServiceSpec{
+				Name: "Store",
+				File: "test.thrift",
+				Line: 3,
+				Functions: map[string]*FunctionSpec{
+					"remove": {
+						Name: "remove",
+						Line: 7,
+						ArgsSpec: ArgsSpec{
+							{
+								ID:   1,
+								Name: "key",
+								Type: &StringSpec{},
+							},
+						},
+						ResultSpec: &ResultSpec{},
+						Doc:        "Removes the value for the given key.",
+					},
+				},
+				Doc: "Stores values.",
+			},
+		},
 		{
 			"service inheritance",
 			`
@@ -191,10 +231,12 @@ func TestCompileService(t *testing.T) {
 			&ServiceSpec{
 				Name:   "BulkKeyValue",
 				File:   "test.thrift",
+				Line:   2,
 				Parent: keyValueSpec,
 				Functions: map[string]*FunctionSpec{
 					"setValues": {
 						Name: "setValues",
+						Line: 3,
 						ArgsSpec: ArgsSpec{
 							{
 								ID:   1,
@@ -217,6 +259,7 @@ func TestCompileService(t *testing.T) {
 			&ServiceSpec{
 				Name:      "AnotherKeyValue",
 				File:      "test.thrift",
+				Line:      1,
 				Parent:    keyValueSpec,
 				Functions: make(map[string]*FunctionSpec),
 			},
@@ -231,7 +274,7 @@ func TestCompileService(t *testing.T) {
 		scope := scopeOrDefault(tt.scope)
 
 		src := parseService(tt.src)
-		spec, err := compileService("test.thrift", src)
+		spec, err := compileService("test.thrift", src, false)
 		if assert.NoError(t, err, tt.desc) {
 			if assert.NoError(t, spec.Link(scope), tt.desc) {
 				assert.Equal(t, tt.spec, spec, tt.desc)
@@ -345,7 +388,7 @@ func TestCompileServiceFailure(t *testing.T) {
 
 	for _, tt := range tests {
 		src := parseService(tt.src)
-		_, err := compileService("test.thrift", src)
+		_, err := compileService("test.thrift", src, false)
 		if assert.Error(t, err, tt.desc) {
 			for _, msg := range tt.messages {
 				assert.Contains(t, err.Error(), msg, tt.desc)
@@ -483,7 +526,7 @@ func TestLinkServiceFailure(t *testing.T) {
 		src := parseService(tt.src)
 		scope := scopeOrDefault(tt.scope)
 
-		spec, err := compileService("test.thrift", src)
+		spec, err := compileService("test.thrift", src, false)
 		if assert.NoError(t, err, tt.desc) {
 			if err := spec.Link(scope); assert.Error(t, err) {
 				for _, msg := range tt.messages {
@@ -31,6 +31,7 @@ type StructSpec struct {
 
 	Name        string
 	File        string
+	Line        int
 	Type        ast.StructureType
 	Fields      FieldGroup
 	Doc         string
@@ -70,6 +71,7 @@ func compileStruct(file string, src *ast.Struct, requiredness fieldRequiredness,
 	return &StructSpec{
 		Name:        src.Name,
 		File:        file,
+		Line:        src.Line,
 		Type:        src.Type,
 		Fields:      fields,
 		Doc:         src.Doc,
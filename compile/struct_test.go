@@ -60,6 +60,7 @@ func TestCompileStructSuccess(t *testing.T) {
 			&StructSpec{
 				Name: "Health",
 				File: "test.thrift",
+				Line: 1,
 				Type: ast.StructType,
 				Fields: FieldGroup{
 					{
@@ -80,6 +81,7 @@ func TestCompileStructSuccess(t *testing.T) {
 			&StructSpec{
 				Name: "Health",
 				File: "test.thrift",
+				Line: 1,
 				Type: ast.StructType,
 				Fields: FieldGroup{
 					{
@@ -103,6 +105,7 @@ func TestCompileStructSuccess(t *testing.T) {
 			&StructSpec{
 				Name: "Foo",
 				File: "test.thrift",
+				Line: 1,
 				Type: ast.StructType,
 				Fields: FieldGroup{
 					{
@@ -133,6 +136,7 @@ func TestCompileStructSuccess(t *testing.T) {
 			&StructSpec{
 				Name: "KeyNotFoundError",
 				File: "test.thrift",
+				Line: 1,
 				Type: ast.ExceptionType,
 				Fields: FieldGroup{
 					{
@@ -161,6 +165,7 @@ func TestCompileStructSuccess(t *testing.T) {
 			&StructSpec{
 				Name: "Body",
 				File: "test.thrift",
+				Line: 1,
 				Type: ast.UnionType,
 				Fields: FieldGroup{
 					{
@@ -191,6 +196,7 @@ func TestCompileStructSuccess(t *testing.T) {
 			&StructSpec{
 				Name: "AutoAssignedIDs",
 				File: "test.thrift",
+				Line: 1,
 				Type: ast.StructType,
 				Fields: FieldGroup{
 					{
@@ -31,6 +31,7 @@ type TypedefSpec struct {
 
 	Name        string
 	File        string
+	Line        int
 	Target      TypeSpec
 	Annotations Annotations
 	Doc         string
@@ -57,6 +58,7 @@ func compileTypedef(file string, src *ast.Typedef) (*TypedefSpec, error) {
 	return &TypedefSpec{
 		Name:        src.Name,
 		File:        file,
+		Line:        src.Line,
 		Target:      typ,
 		Annotations: annotations,
 		Doc:         src.Doc,
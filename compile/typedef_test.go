@@ -58,6 +58,7 @@ func TestCompileTypedef(t *testing.T) {
 			&TypedefSpec{
 				Name:        "timestamp",
 				File:        "test.thrift",
+				Line:        1,
 				Target:      &I64Spec{Annotations: Annotations{"js.type": "Long"}},
 				Annotations: Annotations{"foo": "bar"},
 			},
@@ -73,6 +74,7 @@ func TestCompileTypedef(t *testing.T) {
 			&TypedefSpec{
 				Name: "Foo",
 				File: "test.thrift",
+				Line: 1,
 				Target: &TypedefSpec{
 					Name:   "Bar",
 					File:   "test.thrift",
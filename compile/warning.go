@@ -0,0 +1,345 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// suppressKey is the annotation, and the namespace scope, used to suppress
+// warnings.
+//
+//	struct Legacy {
+//		byte flags = 255
+//	} (thriftrw.suppress = "lossy_default")
+//
+//	namespace thriftrw.suppress unused_include
+const suppressKey = "thriftrw.suppress"
+
+// WarningKind identifies a class of Warnings. Kinds are listed in the
+// thriftrw.suppress annotation to suppress warnings of that kind.
+type WarningKind string
+
+const (
+	// UnusedInclude is reported for included Thrift files which are not
+	// referenced by the file including them.
+	UnusedInclude WarningKind = "unused_include"
+
+	// ImplicitFieldID is reported for fields without a field identifier.
+	// These are allowed only with ImplicitFieldIDs, and the identifiers
+	// assigned to them change if the fields are reordered.
+	ImplicitFieldID WarningKind = "implicit_field_id"
+
+	// LossyDefault is reported for integer default values and constants
+	// which do not survive conversion to their type, such as values out of
+	// range for an i8 or too large to be represented exactly by a double.
+	LossyDefault WarningKind = "lossy_default"
+
+	// allWarnings suppresses warnings of all kinds.
+	allWarnings WarningKind = "all"
+)
+
+// Severity is the severity of a Warning.
+type Severity int
+
+const (
+	// SeverityInfo is used for warnings about schema hygiene which do not
+	// affect the generated code.
+	SeverityInfo Severity = iota + 1
+
+	// SeverityWarning is used for warnings about likely mistakes.
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// Warning is a problem found in a Thrift file which did not stop it from
+// compiling.
+//
+// Warnings about fields may be suppressed with the thriftrw.suppress
+// annotation on the field or the enclosing struct, function or service,
+// listing the kinds of warnings to suppress separated by commas, or "all".
+// Warnings may be suppressed for a whole Thrift file with one namespace per
+// kind.
+//
+//	namespace thriftrw.suppress unused_include
+type Warning struct {
+	Kind     WarningKind
+	Severity Severity
+
+	// Path is the absolute path to the Thrift file and Line is the line in
+	// it that the warning is about, or 0 if the warning is about the whole
+	// file.
+	Path string
+	Line int
+
+	Message string
+}
+
+func (w *Warning) String() string {
+	pos := w.Path
+	if w.Line > 0 {
+		pos += ":" + strconv.Itoa(w.Line)
+	}
+	return fmt.Sprintf("%v: %v: %v [%v]", pos, w.Severity, w.Message, w.Kind)
+}
+
+// suppressions is a set of suppressed warning kinds.
+type suppressions map[WarningKind]struct{}
+
+// parseSuppressions parses the value of a thriftrw.suppress annotation.
+func parseSuppressions(s string) suppressions {
+	kinds := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	sup := make(suppressions, len(kinds))
+	for _, k := range kinds {
+		sup[WarningKind(k)] = struct{}{}
+	}
+	return sup
+}
+
+func (s suppressions) has(k WarningKind) bool {
+	_, ok := s[k]
+	_, all := s[allWarnings]
+	return ok || all
+}
+
+// suppressed checks whether warnings of the given kind are suppressed by
+// the thriftrw.suppress annotation in any of the given annotations.
+func suppressed(k WarningKind, anns ...Annotations) bool {
+	for _, a := range anns {
+		if v, ok := a[suppressKey]; ok && parseSuppressions(v).has(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// moduleLint collects what the compiler learns about a module while
+// gathering it that is needed to report warnings after it is linked.
+type moduleLint struct {
+	Warnings []*Warning
+
+	// Lines of includes, keyed by include name.
+	Includes map[string]int
+
+	// Names of includes referenced by the module.
+	UsedIncludes map[string]struct{}
+
+	// Integer literals used as default values or constants.
+	Ints []intLiteral
+}
+
+// intLiteral is an integer literal whose type is known only after linking.
+type intLiteral struct {
+	Target string // name of the field or constant
+	Line   int
+	Value  int64
+
+	// Type points to the TypeSpec of the field or constant so that it may
+	// be inspected after linking.
+	Type *TypeSpec
+}
+
+func newModuleLint() *moduleLint {
+	return &moduleLint{
+		Includes:     make(map[string]int),
+		UsedIncludes: make(map[string]struct{}),
+	}
+}
+
+// gatherReferences records which includes are referenced by the given
+// program.
+func (l *moduleLint) gatherReferences(prog *ast.Program) {
+	use := func(name string) {
+		if include, _ := splitInclude(name); include != "" {
+			l.UsedIncludes[include] = struct{}{}
+		}
+	}
+
+	ast.Walk(ast.VisitorFunc(func(_ ast.Walker, n ast.Node) {
+		switch n := n.(type) {
+		case ast.TypeReference:
+			use(n.Name)
+		case ast.ConstantReference:
+			use(n.Name)
+		case *ast.Service:
+			if n.Parent != nil {
+				use(n.Parent.Name)
+			}
+		}
+	}), prog)
+}
+
+// gatherStruct records warnings for the given struct and the default values
+// of its fields.
+func (l *moduleLint) gatherStruct(path string, src *ast.Struct, s *StructSpec) {
+	l.gatherFieldIDs(path, "field", src.Name, src.Fields, s.Fields, []Annotations{s.Annotations})
+
+	for i, f := range src.Fields {
+		spec := s.Fields[i]
+		if v, ok := f.Default.(ast.ConstantInteger); ok &&
+			!suppressed(LossyDefault, spec.Annotations, s.Annotations) {
+			l.Ints = append(l.Ints, intLiteral{
+				Target: src.Name + "." + f.Name,
+				Line:   f.Line,
+				Value:  int64(v),
+				Type:   &spec.Type,
+			})
+		}
+	}
+}
+
+// gatherService records warnings for the parameters and exceptions of the
+// functions of the given service.
+func (l *moduleLint) gatherService(path string, src *ast.Service, s *ServiceSpec) {
+	for _, f := range src.Functions {
+		spec := s.Functions[f.Name]
+		anns := []Annotations{spec.Annotations, s.Annotations}
+		l.gatherFieldIDs(path, "parameter", src.Name+"."+f.Name, f.Parameters, FieldGroup(spec.ArgsSpec), anns)
+		if spec.ResultSpec != nil {
+			l.gatherFieldIDs(path, "exception", src.Name+"."+f.Name, f.Exceptions, spec.ResultSpec.Exceptions, anns)
+		}
+	}
+}
+
+// gatherFieldIDs records warnings for fields which were assigned
+// identifiers implicitly.
+func (l *moduleLint) gatherFieldIDs(path, kind, parent string, src []*ast.Field, fields FieldGroup, anns []Annotations) {
+	for i, f := range src {
+		if !f.IDUnset || suppressed(ImplicitFieldID, append([]Annotations{fields[i].Annotations}, anns...)...) {
+			continue
+		}
+		l.Warnings = append(l.Warnings, &Warning{
+			Kind:     ImplicitFieldID,
+			Severity: SeverityWarning,
+			Path:     path,
+			Line:     f.Line,
+			Message: fmt.Sprintf(
+				"%v %q of %q has no field identifier and was assigned %d",
+				kind, f.Name, parent, f.ID),
+		})
+	}
+}
+
+// gatherConstant records the value of the given constant if it is an
+// integer.
+func (l *moduleLint) gatherConstant(src *ast.Constant, c *Constant) {
+	if v, ok := src.Value.(ast.ConstantInteger); ok {
+		l.Ints = append(l.Ints, intLiteral{
+			Target: src.Name,
+			Line:   src.Line,
+			Value:  int64(v),
+			Type:   &c.Type,
+		})
+	}
+}
+
+// lint returns the warnings for the given linked module, ordered by line.
+func (l *moduleLint) lint(m *Module) []*Warning {
+	warnings := l.Warnings
+
+	for _, i := range l.Ints {
+		if reason := lossyInt(i.Value, *i.Type); reason != "" {
+			warnings = append(warnings, &Warning{
+				Kind:     LossyDefault,
+				Severity: SeverityWarning,
+				Path:     m.ThriftPath,
+				Line:     i.Line,
+				Message:  fmt.Sprintf("value %d of %q %v", i.Value, i.Target, reason),
+			})
+		}
+	}
+
+	for name, line := range l.Includes {
+		if _, ok := l.UsedIncludes[name]; !ok {
+			warnings = append(warnings, &Warning{
+				Kind:     UnusedInclude,
+				Severity: SeverityInfo,
+				Path:     m.ThriftPath,
+				Line:     line,
+				Message:  fmt.Sprintf("included module %q is never used", name),
+			})
+		}
+	}
+
+	filtered := warnings[:0]
+	for _, w := range warnings {
+		if !m.SuppressesWarning(w.Kind) {
+			filtered = append(filtered, w)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Line != filtered[j].Line {
+			return filtered[i].Line < filtered[j].Line
+		}
+		return filtered[i].Message < filtered[j].Message
+	})
+	return filtered
+}
+
+// lossyInt explains why the given integer does not survive conversion to
+// the given type, or returns an empty string if it does.
+func lossyInt(v int64, t TypeSpec) string {
+	var min, max int64
+	switch RootTypeSpec(t).(type) {
+	case *I8Spec:
+		min, max = math.MinInt8, math.MaxInt8
+	case *I16Spec:
+		min, max = math.MinInt16, math.MaxInt16
+	case *I32Spec, *EnumSpec:
+		min, max = math.MinInt32, math.MaxInt32
+	case *DoubleSpec:
+		if v > 1<<53 || v < -(1<<53) {
+			return "cannot be represented exactly by a double"
+		}
+		return ""
+	default:
+		return ""
+	}
+
+	if v < min || v > max {
+		return fmt.Sprintf("is out of range for %v", RootTypeSpec(t).ThriftName())
+	}
+	return ""
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarnings(t *testing.T) {
+	shared := `
+		typedef string UUID
+		typedef double Ratio
+		enum Color { RED, GREEN }
+		const i32 ANSWER = 42
+		service Base {}
+	`
+
+	tests := []struct {
+		desc      string
+		src       string
+		nonStrict bool
+		want      []*Warning
+	}{
+		{
+			desc: "include used by type",
+			src: `
+				include "./shared.thrift"
+				struct S { 1: optional list<shared.UUID> ids }
+			`,
+		},
+		{
+			desc: "include used by constant",
+			src: `
+				include "./shared.thrift"
+				const i32 X = shared.ANSWER
+			`,
+		},
+		{
+			desc: "include used by default value",
+			src: `
+				include "./shared.thrift"
+				struct S { 1: optional i32 color = shared.Color.GREEN }
+			`,
+		},
+		{
+			desc: "include used by service",
+			src: `
+				include "./shared.thrift"
+				service S extends shared.Base {}
+			`,
+		},
+		{
+			desc: "unused include",
+			src: `
+				include "./shared.thrift"
+				struct S { 1: optional string id }
+			`,
+			want: []*Warning{{
+				Kind:     UnusedInclude,
+				Severity: SeverityInfo,
+				Path:     "/main.thrift",
+				Line:     2,
+				Message:  `included module "shared" is never used`,
+			}},
+		},
+		{
+			desc: "unused include suppressed",
+			src: `
+				include "./shared.thrift"
+				namespace thriftrw.suppress unused_include
+			`,
+		},
+		{
+			desc: "implicit field ID",
+			src: `
+				struct S {
+					1: string a
+					string b
+				}
+			`,
+			nonStrict: true,
+			want: []*Warning{{
+				Kind:     ImplicitFieldID,
+				Severity: SeverityWarning,
+				Path:     "/main.thrift",
+				Line:     4,
+				Message:  `field "b" of "S" has no field identifier and was assigned -1`,
+			}},
+		},
+		{
+			desc: "implicit field ID suppressed on field",
+			src: `
+				struct S {
+					string b (thriftrw.suppress = "implicit_field_id")
+				}
+			`,
+			nonStrict: true,
+		},
+		{
+			desc: "lossy defaults",
+			src: `
+				include "./shared.thrift"
+				struct S {
+					1: optional byte a = 127
+					2: optional byte b = 128
+					3: optional i16 c = -32769
+					4: optional shared.Ratio d = 9007199254740993
+					5: optional double e = 9007199254740992
+				}
+				const i32 X = 4294967296
+			`,
+			want: []*Warning{
+				{
+					Kind:     LossyDefault,
+					Severity: SeverityWarning,
+					Path:     "/main.thrift",
+					Line:     5,
+					Message:  `value 128 of "S.b" is out of range for byte`,
+				},
+				{
+					Kind:     LossyDefault,
+					Severity: SeverityWarning,
+					Path:     "/main.thrift",
+					Line:     6,
+					Message:  `value -32769 of "S.c" is out of range for i16`,
+				},
+				{
+					Kind:     LossyDefault,
+					Severity: SeverityWarning,
+					Path:     "/main.thrift",
+					Line:     7,
+					Message:  `value 9007199254740993 of "S.d" cannot be represented exactly by a double`,
+				},
+				{
+					Kind:     LossyDefault,
+					Severity: SeverityWarning,
+					Path:     "/main.thrift",
+					Line:     10,
+					Message:  `value 4294967296 of "X" is out of range for i32`,
+				},
+			},
+		},
+		{
+			desc: "lossy default suppressed on struct",
+			src: `
+				struct S {
+					1: optional byte b = 128
+				} (thriftrw.suppress = "implicit_field_id, lossy_default")
+			`,
+		},
+		{
+			desc: "all suppressed",
+			src: `
+				include "./shared.thrift"
+				namespace thriftrw.suppress all
+				const byte X = 1000
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/", map[string]string{
+				"/main.thrift":   tt.src,
+				"/shared.thrift": shared,
+			}}
+
+			opts := []Option{Filesystem(fs)}
+			if tt.nonStrict {
+				opts = append(opts, NonStrict())
+			}
+
+			m, err := Compile("main.thrift", opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m.Warnings)
+
+			if inc, ok := m.Includes["shared"]; ok {
+				assert.Empty(t, inc.Module.Warnings, "included module must not have warnings")
+			}
+		})
+	}
+}
+
+func TestWarningString(t *testing.T) {
+	w := &Warning{
+		Kind:     LossyDefault,
+		Severity: SeverityWarning,
+		Path:     "/foo.thrift",
+		Line:     12,
+		Message:  "great sadness",
+	}
+	assert.Equal(t, "/foo.thrift:12: warning: great sadness [lossy_default]", w.String())
+
+	w.Line = 0
+	w.Severity = SeverityInfo
+	assert.Equal(t, "/foo.thrift: info: great sadness [lossy_default]", w.String())
+
+	assert.Equal(t, "Severity(42)", Severity(42).String())
+}
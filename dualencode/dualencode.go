@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dualencode compares the two ways in which ThriftRW-generated code
+// encodes structs: ToWire followed by the Thrift Binary protocol, and
+// streaming Encode.
+//
+// Code generated with the --dual-encode option calls Encode from the Encode
+// method of every struct. Each top-level call encodes the struct both ways
+// and reports to the registered MismatchHandler if the results differ,
+// before writing the struct to its destination with the streaming path.
+// This triples the cost of encoding, so use it only to build confidence in
+// streaming Encode before relying on it exclusively.
+package dualencode
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Mismatch describes a struct for which the two encoding paths disagreed.
+type Mismatch struct {
+	// Name of the Go type of the struct.
+	TypeName string
+
+	// Output of ToWire encoded with the Thrift Binary protocol, and the
+	// error returned by either step, if any.
+	Wire    []byte
+	WireErr error
+
+	// Output of streaming Encode with the Thrift Binary protocol, and the
+	// error it returned, if any.
+	Stream    []byte
+	StreamErr error
+}
+
+func (m *Mismatch) Error() string {
+	return fmt.Sprintf(
+		"dualencode: ToWire and Encode disagree for %v: "+
+			"ToWire produced %x (error: %v), Encode produced %x (error: %v)",
+		m.TypeName, m.Wire, m.WireErr, m.Stream, m.StreamErr)
+}
+
+// MismatchHandler is called with each Mismatch found by Encode.
+type MismatchHandler func(*Mismatch)
+
+// Panic is the default MismatchHandler. It panics with the Mismatch.
+func Panic(m *Mismatch) {
+	panic(m)
+}
+
+var (
+	_handlerMu sync.RWMutex
+	_handler   MismatchHandler = Panic
+)
+
+// SetMismatchHandler installs the MismatchHandler used by Encode, for
+// example, to log mismatches instead of panicking. It replaces any
+// previously installed handler.
+//
+// Passing nil restores the default handler, Panic.
+func SetMismatchHandler(h MismatchHandler) {
+	if h == nil {
+		h = Panic
+	}
+
+	_handlerMu.Lock()
+	_handler = h
+	_handlerMu.Unlock()
+}
+
+func handler() MismatchHandler {
+	_handlerMu.RLock()
+	defer _handlerMu.RUnlock()
+	return _handler
+}
+
+// nestedWriter marks the writers passed to encode functions by Encode so
+// that structs nested inside the struct being compared are not compared
+// again.
+type nestedWriter struct{ stream.Writer }
+
+// Encode compares the output of toWire with that of encode for the struct
+// with the given type name, and writes it to sw with encode.
+//
+// This is intended to be called by generated code only.
+func Encode(sw stream.Writer, typeName string, toWire func() (wire.Value, error), encode func(stream.Writer) error) error {
+	if _, ok := sw.(nestedWriter); ok {
+		return encode(sw)
+	}
+
+	if m := compare(typeName, toWire, encode); m != nil {
+		handler()(m)
+	}
+	return encode(nestedWriter{sw})
+}
+
+// compare encodes a struct both ways, returning a Mismatch if the results
+// differ, or nil otherwise.
+func compare(typeName string, toWire func() (wire.Value, error), encode func(stream.Writer) error) *Mismatch {
+	var wbuf, sbuf bytes.Buffer
+
+	w, werr := toWire()
+	if werr == nil {
+		werr = binary.Default.Encode(w, &wbuf)
+	}
+
+	sw := binary.NewStreamWriter(&sbuf)
+	serr := encode(nestedWriter{sw})
+	if cerr := sw.Close(); serr == nil {
+		serr = cerr
+	}
+
+	if (werr == nil) == (serr == nil) && (werr != nil || equivalent(wbuf.Bytes(), sbuf.Bytes())) {
+		return nil
+	}
+
+	return &Mismatch{
+		TypeName:  typeName,
+		Wire:      wbuf.Bytes(),
+		WireErr:   werr,
+		Stream:    sbuf.Bytes(),
+		StreamErr: serr,
+	}
+}
+
+// equivalent reports whether the given encoded structs are the same.
+// Encodings of the same struct may differ in the order of the items of
+// their maps, so differing bytes are decoded and compared as values.
+func equivalent(l, r []byte) bool {
+	if bytes.Equal(l, r) {
+		return true
+	}
+
+	lv, err := binary.Default.Decode(bytes.NewReader(l), wire.TStruct)
+	if err != nil {
+		return false
+	}
+	rv, err := binary.Default.Decode(bytes.NewReader(r), wire.TStruct)
+	if err != nil {
+		return false
+	}
+	return wire.ValuesAreEqual(lv, rv)
+}
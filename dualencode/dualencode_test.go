@@ -0,0 +1,275 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dualencode
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStruct encodes a struct with a single string field both ways. The
+// fields of the struct sent by each path may be changed to simulate bugs.
+type fakeStruct struct {
+	wireValue   string
+	streamValue string
+	wireErr     error
+	streamErr   error
+
+	// Number of times encode has been called.
+	encodes int
+}
+
+func (f *fakeStruct) ToWire() (wire.Value, error) {
+	if f.wireErr != nil {
+		return wire.Value{}, f.wireErr
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(f.wireValue)},
+	}}), nil
+}
+
+func (f *fakeStruct) encode(sw stream.Writer) error {
+	f.encodes++
+	if f.streamErr != nil {
+		return f.streamErr
+	}
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(f.streamValue); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+	return sw.WriteStructEnd()
+}
+
+// encodeTo runs Encode for the given struct, returning the bytes it wrote
+// and the mismatches it reported.
+func encodeTo(t *testing.T, f *fakeStruct) ([]byte, []*Mismatch, error) {
+	var mismatches []*Mismatch
+	SetMismatchHandler(func(m *Mismatch) {
+		mismatches = append(mismatches, m)
+	})
+	defer SetMismatchHandler(nil)
+
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	err := Encode(sw, "Fake", f.ToWire, f.encode)
+	require.NoError(t, sw.Close())
+	return buf.Bytes(), mismatches, err
+}
+
+func TestEncodeMatch(t *testing.T) {
+	f := &fakeStruct{wireValue: "foo", streamValue: "foo"}
+	got, mismatches, err := encodeTo(t, f)
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+	assert.Equal(t, []byte{
+		0x0b,       // type:1 = string
+		0x00, 0x01, // id:2 = 1
+		0x00, 0x00, 0x00, 0x03, // length = 3
+		'f', 'o', 'o', // "foo"
+		0x00, // stop
+	}, got)
+}
+
+func TestEncodeMismatch(t *testing.T) {
+	tests := []struct {
+		desc          string
+		give          *fakeStruct
+		wantWireErr   error
+		wantStreamErr error
+		wantErr       error
+	}{
+		{
+			desc: "different output",
+			give: &fakeStruct{wireValue: "foo", streamValue: "bar"},
+		},
+		{
+			desc:        "only ToWire fails",
+			give:        &fakeStruct{wireErr: errors.New("great sadness"), streamValue: "foo"},
+			wantWireErr: errors.New("great sadness"),
+		},
+		{
+			desc:          "only Encode fails",
+			give:          &fakeStruct{wireValue: "foo", streamErr: errors.New("great sadness")},
+			wantStreamErr: errors.New("great sadness"),
+			wantErr:       errors.New("great sadness"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, mismatches, err := encodeTo(t, tt.give)
+			assert.Equal(t, tt.wantErr, err)
+			require.Len(t, mismatches, 1)
+
+			m := mismatches[0]
+			assert.Equal(t, "Fake", m.TypeName)
+			assert.Equal(t, tt.wantWireErr, m.WireErr)
+			assert.Equal(t, tt.wantStreamErr, m.StreamErr)
+			assert.Contains(t, m.Error(), "dualencode: ToWire and Encode disagree for Fake")
+		})
+	}
+}
+
+func TestEncodeBothFail(t *testing.T) {
+	f := &fakeStruct{wireErr: errors.New("foo"), streamErr: errors.New("bar")}
+	_, mismatches, err := encodeTo(t, f)
+	assert.EqualError(t, err, "bar")
+	assert.Empty(t, mismatches, "failures on both paths must not be reported")
+}
+
+func TestEncodeNested(t *testing.T) {
+	inner := &fakeStruct{wireValue: "foo", streamValue: "bar"}
+	outer := func(sw stream.Writer) error {
+		return Encode(sw, "Inner", inner.ToWire, inner.encode)
+	}
+	toWire := func() (wire.Value, error) {
+		var buf bytes.Buffer
+		sw := binary.NewStreamWriter(&buf)
+		defer sw.Close()
+		err := outer(sw)
+		return wire.NewValueStruct(wire.Struct{}), err
+	}
+
+	var mismatches []*Mismatch
+	SetMismatchHandler(func(m *Mismatch) {
+		mismatches = append(mismatches, m)
+	})
+	defer SetMismatchHandler(nil)
+
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	defer sw.Close()
+	require.NoError(t, Encode(sw, "Outer", toWire, outer))
+
+	// The inner struct is only compared when it is encoded on its own,
+	// from toWire.
+	require.Len(t, mismatches, 2)
+	assert.Equal(t, "Inner", mismatches[0].TypeName)
+	assert.Equal(t, "Outer", mismatches[1].TypeName)
+}
+
+func TestEncodeMapOrder(t *testing.T) {
+	items := []wire.MapItem{
+		{Key: wire.NewValueString("a"), Value: wire.NewValueI32(1)},
+		{Key: wire.NewValueString("b"), Value: wire.NewValueI32(2)},
+	}
+
+	toWire := func() (wire.Value, error) {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, items))},
+		}}), nil
+	}
+	encode := func(sw stream.Writer) error {
+		reversed := []wire.MapItem{items[1], items[0]}
+		v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, reversed))},
+		}})
+
+		var buf bytes.Buffer
+		if err := binary.Default.Encode(v, &buf); err != nil {
+			return err
+		}
+		sr := binary.NewStreamReader(bytes.NewReader(buf.Bytes()))
+		defer sr.Close()
+		return copyStruct(sr, sw)
+	}
+
+	assert.Nil(t, compare("Fake", toWire, encode))
+}
+
+// copyStruct copies a struct with a single map<string, i32> field from sr
+// to sw.
+func copyStruct(sr stream.Reader, sw stream.Writer) error {
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+	fh, _, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldBegin(fh); err != nil {
+		return err
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return err
+		}
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return err
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+	if err := sw.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+	return sw.WriteStructEnd()
+}
+
+func TestDefaultHandlerPanics(t *testing.T) {
+	SetMismatchHandler(func(*Mismatch) {})
+	SetMismatchHandler(nil)
+
+	f := &fakeStruct{wireValue: "foo", streamValue: "bar"}
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	defer sw.Close()
+
+	assert.PanicsWithError(t,
+		`dualencode: ToWire and Encode disagree for Fake: ToWire produced 0b000100000003666f6f00 (error: <nil>), Encode produced 0b00010000000362617200 (error: <nil>)`,
+		func() { Encode(sw, "Fake", f.ToWire, f.encode) })
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package enumcheck provides an analyzer which reports switch statements
+// over enums generated by ThriftRW that do not handle every value of the
+// enum.
+//
+// Enums are recognized by the <Enum>_Values function which ThriftRW
+// generates alongside every enum. Cases are compared by value, so a case
+// for any of the names of a value declared more than once covers it.
+//
+// A default clause does not make a switch exhaustive: enums decoded off the
+// wire may hold values unknown to the Thrift file the code was generated
+// from, so a default clause is needed to handle those, and values added to
+// the enum later should still be reported.
+//
+//	switch s {
+//	case kv.Status_Ok:
+//		...
+//	default: // unrecognized values
+//		...
+//	} // missing cases in switch of type kv.Status: Status_Failed
+//
+// Use cmd/thriftrw-enumcheck to run the analyzer standalone, or add
+// Analyzer to a multichecker.
+package enumcheck
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer reports switch statements over ThriftRW enums with missing cases.
+var Analyzer = &analysis.Analyzer{
+	Name:     "enumcheck",
+	Doc:      "report switch statements over ThriftRW enums that do not handle every value",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.SwitchStmt)(nil)}, func(n ast.Node) {
+		checkSwitch(pass, n.(*ast.SwitchStmt))
+	})
+	return nil, nil
+}
+
+func checkSwitch(pass *analysis.Pass, stmt *ast.SwitchStmt) {
+	if stmt.Tag == nil {
+		return
+	}
+
+	enum, ok := pass.TypesInfo.TypeOf(stmt.Tag).(*types.Named)
+	if !ok {
+		return
+	}
+
+	members := enumMembers(enum)
+	if len(members) == 0 {
+		return
+	}
+
+	covered := make(map[string]struct{})
+	for _, s := range stmt.Body.List {
+		for _, expr := range s.(*ast.CaseClause).List {
+			if v := pass.TypesInfo.Types[expr].Value; v != nil {
+				covered[v.ExactString()] = struct{}{}
+			}
+		}
+	}
+
+	var missing []string
+	for _, m := range members {
+		if _, ok := covered[m.Val().ExactString()]; !ok {
+			missing = append(missing, m.Name())
+		}
+	}
+	if len(missing) > 0 {
+		qualifier := func(p *types.Package) string {
+			if p == pass.Pkg {
+				return ""
+			}
+			return p.Name()
+		}
+		pass.Reportf(stmt.Pos(), "missing cases in switch of type %v: %v",
+			types.TypeString(enum, qualifier), strings.Join(missing, ", "))
+	}
+}
+
+// enumMembers returns the constants of the given type in the package which
+// declares it, ordered by value, if it is an enum generated by ThriftRW.
+// Only the first constant declared for each value is returned.
+func enumMembers(t *types.Named) []*types.Const {
+	obj := t.Obj()
+	if obj.Pkg() == nil || !isEnum(t) {
+		return nil
+	}
+
+	var members []*types.Const
+	seen := make(map[string]struct{})
+	scope := obj.Pkg().Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), t) {
+			continue
+		}
+
+		v := c.Val().ExactString()
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		members = append(members, c)
+	}
+
+	// Scope.Names is sorted by name, so ties on duplicate values are
+	// broken by name.
+	sort.SliceStable(members, func(i, j int) bool {
+		return constant.Compare(members[i].Val(), token.LSS, members[j].Val())
+	})
+	return members
+}
+
+// isEnum reports whether the package which declares the given type also
+// declares the <Enum>_Values function that ThriftRW generates for enums.
+func isEnum(t *types.Named) bool {
+	if basic, ok := t.Underlying().(*types.Basic); !ok || basic.Kind() != types.Int32 {
+		return false
+	}
+
+	obj := t.Obj()
+	fn, ok := obj.Pkg().Scope().Lookup(obj.Name() + "_Values").(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	slice, ok := sig.Results().At(0).Type().(*types.Slice)
+	return ok && types.Identical(slice.Elem(), t)
+}
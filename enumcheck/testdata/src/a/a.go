@@ -0,0 +1,55 @@
+package a
+
+import "enums"
+
+func exhaustive(s enums.Status) {
+	switch s {
+	case enums.Status_Success, enums.Status_Failed:
+	case enums.Status_Unknown:
+	}
+}
+
+func missing(s enums.Status) {
+	switch s { // want `missing cases in switch of type enums.Status: Status_Unknown`
+	case enums.Status_Ok, enums.Status_Failed:
+	default:
+	}
+}
+
+func literals(s enums.Status) {
+	switch s { // want `missing cases in switch of type enums.Status: Status_Failed, Status_Unknown`
+	case 0:
+	}
+}
+
+func local(l Level) {
+	switch l { // want `missing cases in switch of type Level: Level_High`
+	case Level_Low:
+	}
+}
+
+func notEnums(c enums.Color, e enums.Empty, i int32) {
+	switch c {
+	case enums.Color_Red:
+	}
+
+	switch e {
+	}
+
+	switch i {
+	case 1:
+	}
+
+	switch {
+	case i > 0:
+	}
+}
+
+type Level int32
+
+const (
+	Level_Low  Level = 0
+	Level_High Level = 1
+)
+
+func Level_Values() []Level { return []Level{Level_Low, Level_High} }
@@ -0,0 +1,27 @@
+// Package enums mimics the code generated by ThriftRW for enums.
+package enums
+
+type Status int32
+
+const (
+	Status_Ok      Status = 0
+	Status_Failed  Status = 1
+	Status_Success Status = 0
+	Status_Unknown Status = 2
+)
+
+func Status_Values() []Status {
+	return []Status{Status_Ok, Status_Failed, Status_Success, Status_Unknown}
+}
+
+type Empty int32
+
+func Empty_Values() []Empty { return []Empty{} }
+
+// Color is not a ThriftRW enum because it has no Color_Values function.
+type Color int32
+
+const (
+	Color_Red  Color = 0
+	Color_Blue Color = 1
+)
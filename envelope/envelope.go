@@ -38,7 +38,7 @@ type Enveloper interface {
 }
 
 // Write writes an Envelope to the given writer.
-func Write(p protocol.Protocol, w io.Writer, seqID int32, e Enveloper) error {
+func Write(p protocol.Protocol, w io.Writer, seqID int32, e Enveloper, opts ...WriteOption) error {
 	body, err := e.ToWire()
 	if err != nil {
 		return err
@@ -47,14 +47,20 @@ func Write(p protocol.Protocol, w io.Writer, seqID int32, e Enveloper) error {
 		SeqID: seqID,
 		Name:  e.MethodName(),
 		Type:  e.EnvelopeType(),
-		Value: body,
+		Value: applyWriteOptions(body, opts),
 	}, w)
 }
 
 // ReadReply reads enveloped responses from the given reader.
+//
+// A MissingEnvelopeError is returned if the response is a bare struct
+// without an envelope.
 func ReadReply(p protocol.Protocol, r io.ReaderAt) (_ wire.Value, seqID int32, _ error) {
 	envelope, err := p.DecodeEnveloped(r)
 	if err != nil {
+		if _, serr := p.Decode(r, wire.TStruct); serr == nil {
+			err = &MissingEnvelopeError{Reason: err}
+		}
 		return wire.Value{}, 0, err
 	}
 
@@ -73,3 +79,84 @@ func ReadReply(p protocol.Protocol, r io.ReaderAt) (_ wire.Value, seqID int32, _
 
 	return envelope.Value, envelope.SeqID, ex
 }
+
+// WriteNoEnvelope writes the body of the given Enveloper to the given writer
+// without an envelope.
+//
+// This is intended for legacy peers which exchange bare request and response
+// structs. The method name and envelope type of the Enveloper are not
+// written.
+func WriteNoEnvelope(p protocol.Protocol, w io.Writer, e Enveloper, opts ...WriteOption) error {
+	body, err := e.ToWire()
+	if err != nil {
+		return err
+	}
+	return p.Encode(applyWriteOptions(body, opts), w)
+}
+
+// ReadNoEnvelope reads a bare request or response struct, written without an
+// envelope, from the given reader.
+//
+// An UnexpectedEnvelopeError is returned if the peer sent an envelope.
+func ReadNoEnvelope(p protocol.Protocol, r io.ReaderAt) (wire.Value, error) {
+	body, err := p.Decode(r, wire.TStruct)
+	if err == nil && !maybeEnvelope(body, r) {
+		return body, nil
+	}
+
+	// The payload either failed to decode as a struct or it could be the
+	// start of an envelope. Check whether the peer sent an envelope.
+	if envelope, eerr := p.DecodeEnveloped(r); eerr == nil {
+		return wire.Value{}, &UnexpectedEnvelopeError{
+			Name:  envelope.Name,
+			Type:  envelope.Type,
+			SeqID: envelope.SeqID,
+		}
+	}
+	return body, err
+}
+
+// maybeEnvelope reports whether a payload which decoded into the given
+// struct could also be an envelope.
+//
+// Some envelope encodings start with bytes which also form a valid empty
+// struct, so more bytes following an empty struct indicate that the payload
+// may be an envelope.
+func maybeEnvelope(body wire.Value, r io.ReaderAt) bool {
+	if len(body.GetStruct().Fields) > 0 {
+		return false
+	}
+
+	var b [1]byte
+	n, _ := r.ReadAt(b[:], 1)
+	return n > 0
+}
+
+// UnexpectedEnvelopeError is returned by ReadNoEnvelope if the peer sent an
+// envelope when a bare struct was expected.
+type UnexpectedEnvelopeError struct {
+	Name  string
+	Type  wire.EnvelopeType
+	SeqID int32
+}
+
+func (e *UnexpectedEnvelopeError) Error() string {
+	return fmt.Sprintf(
+		"expected a message without an envelope, got an enveloped %v for %q: "+
+			"the peer may be using envelopes", e.Type, e.Name)
+}
+
+// MissingEnvelopeError is returned by ReadReply if the peer sent a bare
+// struct when an envelope was expected.
+type MissingEnvelopeError struct {
+	// Reason is the error encountered while decoding the envelope.
+	Reason error
+}
+
+func (e *MissingEnvelopeError) Error() string {
+	return fmt.Sprintf(
+		"expected an envelope, got a message without an envelope: "+
+			"the peer may not be using envelopes: %v", e.Reason)
+}
+
+func (e *MissingEnvelopeError) Unwrap() error { return e.Reason }
@@ -129,6 +129,18 @@ func TestReadReply(t *testing.T) {
 			wantSeqID: 1234,
 			wantErr:   "unknown envelope",
 		},
+		{
+			desc: "Missing envelope",
+			bs: []byte{
+				// <struct>
+				0x0b,       // type:1 = string
+				0x00, 0x01, // id:2 = 1
+				0x00, 0x00, 0x00, 0x03, // length = 3
+				'f', 'o', 'o', // "foo"
+				0x00, // stop
+			},
+			wantErr: "expected an envelope, got a message without an envelope",
+		},
 		{
 			desc: "Valid reply",
 			bs: []byte{
@@ -209,3 +221,119 @@ func TestReadReply(t *testing.T) {
 		assert.Equal(t, tt.wantSeqID, seqID, "%v: seqID mismatch", tt.desc)
 	}
 }
+
+func TestWriteNoEnvelope(t *testing.T) {
+	enveloper := fakeEnveloper{
+		Name: "getValue",
+		Type: wire.Call,
+		Value: wire.NewValueStruct(wire.Struct{
+			Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+			},
+		}),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var buff bytes.Buffer
+		require.NoError(t, WriteNoEnvelope(binary.Default, &buff, enveloper))
+		assert.Equal(t,
+			[]byte{
+				// <struct>
+				0x0b,       // type:1 = string
+				0x00, 0x01, // id:2 = 1
+				0x00, 0x00, 0x00, 0x03, // length = 3
+				'f', 'o', 'o', // "foo"
+				0x00, // stop
+			}, buff.Bytes())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		errEnveloper := enveloper
+		errEnveloper.Err = fmt.Errorf("great sadness")
+
+		var buff bytes.Buffer
+		assert.EqualError(t, WriteNoEnvelope(binary.Default, &buff, errEnveloper), "great sadness")
+	})
+}
+
+func TestReadNoEnvelope(t *testing.T) {
+	tests := []struct {
+		desc    string
+		bs      []byte
+		want    wire.Value
+		wantErr error
+	}{
+		{
+			desc: "struct",
+			bs: []byte{
+				0x0b,       // type:1 = string
+				0x00, 0x01, // id:2 = 1
+				0x00, 0x00, 0x00, 0x03, // length = 3
+				'f', 'o', 'o', // "foo"
+				0x00, // stop
+			},
+			want: wire.NewValueStruct(wire.Struct{
+				Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("foo")},
+				},
+			}),
+		},
+		{
+			desc: "empty struct",
+			bs:   []byte{0x00},
+			want: wire.NewValueStruct(wire.Struct{}),
+		},
+		{
+			desc: "strict envelope",
+			bs: []byte{
+				0x80, 0x01, 0x00, 0x02, // version|type:4 = 2 | reply
+				0x00, 0x00, 0x00, 0x03, // name length = 3
+				'a', 'b', 'c', // "abc"
+				0x00, 0x00, 0x04, 0xd2, // seqID:4 = 1234
+				0x00, // stop
+			},
+			wantErr: &UnexpectedEnvelopeError{Name: "abc", Type: wire.Reply, SeqID: 1234},
+		},
+		{
+			desc: "non-strict envelope",
+			bs: []byte{
+				0x00, 0x00, 0x00, 0x03, // name length = 3
+				'a', 'b', 'c', // "abc"
+				0x01,                   // type:1 = call
+				0x00, 0x00, 0x04, 0xd2, // seqID:4 = 1234
+				0x00, // stop
+			},
+			wantErr: &UnexpectedEnvelopeError{Name: "abc", Type: wire.Call, SeqID: 1234},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := ReadNoEnvelope(binary.Default, bytes.NewReader(tt.bs))
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ReadNoEnvelope(binary.Default, bytes.NewReader([]byte{0x0b, 0x00}))
+		require.Error(t, err)
+		_, ok := err.(*UnexpectedEnvelopeError)
+		assert.False(t, ok, "must not report an envelope for invalid input")
+	})
+}
+
+func TestEnvelopeErrors(t *testing.T) {
+	err := &UnexpectedEnvelopeError{Name: "getValue", Type: wire.Call}
+	assert.EqualError(t, err, `expected a message without an envelope, got an enveloped Call for "getValue": the peer may be using envelopes`)
+
+	reason := fmt.Errorf("great sadness")
+	merr := &MissingEnvelopeError{Reason: reason}
+	assert.EqualError(t, merr, "expected an envelope, got a message without an envelope: the peer may not be using envelopes: great sadness")
+	assert.Equal(t, reason, merr.Unwrap())
+}
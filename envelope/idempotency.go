@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// IdempotencyTokenFieldID is the ID of the field of request structs in which
+// idempotency tokens are carried.
+//
+// Thrift files assign positive IDs to fields, and implicitly numbered fields
+// get negative IDs counting up from -1, so this ID is reserved in practice.
+// Peers which do not know about idempotency tokens skip the field as they do
+// any other unknown field.
+//
+// This in-struct field is the only way tokens are carried. Tokens are not
+// written to or read from THeader headers, which this package does not
+// support.
+const IdempotencyTokenFieldID int16 = math.MinInt16
+
+// WriteOption customizes Write and WriteNoEnvelope.
+type WriteOption func(*writeOptions)
+
+type writeOptions struct {
+	idempotencyToken string
+}
+
+// WithIdempotencyToken attaches the given idempotency token to the request
+// being written, in the field with IdempotencyTokenFieldID.
+//
+// Transports which deliver requests at least once may retry a request with
+// the same token, and servers may use IdempotencyToken to recognize and
+// drop repeated requests. Tokens should be generated once per logical call
+// with NewIdempotencyToken and reused for every attempt at it. An empty token
+// is not written.
+func WithIdempotencyToken(token string) WriteOption {
+	return func(o *writeOptions) {
+		o.idempotencyToken = token
+	}
+}
+
+// NewIdempotencyToken returns a new random idempotency token.
+func NewIdempotencyToken() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// IdempotencyToken returns the idempotency token attached to the given
+// request body by WithIdempotencyToken, if any. Only the field with
+// IdempotencyTokenFieldID is consulted.
+func IdempotencyToken(body wire.Value) (token string, ok bool) {
+	if body.Type() != wire.TStruct {
+		return "", false
+	}
+	for _, f := range body.GetStruct().Fields {
+		if f.ID == IdempotencyTokenFieldID && f.Value.Type() == wire.TBinary {
+			return f.Value.GetString(), true
+		}
+	}
+	return "", false
+}
+
+// applyWriteOptions applies the given options to the body of a request.
+func applyWriteOptions(body wire.Value, opts []WriteOption) wire.Value {
+	if len(opts) == 0 {
+		return body
+	}
+
+	var o writeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.idempotencyToken == "" || body.Type() != wire.TStruct {
+		return body
+	}
+
+	// Copy the fields rather than appending to them so that the body
+	// returned by the Enveloper is left untouched.
+	fields := body.GetStruct().Fields
+	withToken := make([]wire.Field, 0, len(fields)+1)
+	for _, f := range fields {
+		if f.ID != IdempotencyTokenFieldID {
+			withToken = append(withToken, f)
+		}
+	}
+	withToken = append(withToken, wire.Field{
+		ID:    IdempotencyTokenFieldID,
+		Value: wire.NewValueString(o.idempotencyToken),
+	})
+	return wire.NewValueStruct(wire.Struct{Fields: withToken})
+}
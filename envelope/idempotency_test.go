@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "go.uber.org/thriftrw/envelope"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyToken(t *testing.T) {
+	body := wire.NewValueStruct(wire.Struct{
+		Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+		},
+	})
+	enveloper := fakeEnveloper{Name: "getValue", Type: wire.Call, Value: body}
+
+	t.Run("enveloped", func(t *testing.T) {
+		var buff bytes.Buffer
+		require.NoError(t, Write(binary.Default, &buff, 1, enveloper, WithIdempotencyToken("abc")))
+
+		e, err := binary.Default.DecodeEnveloped(bytes.NewReader(buff.Bytes()))
+		require.NoError(t, err)
+
+		token, ok := IdempotencyToken(e.Value)
+		assert.True(t, ok)
+		assert.Equal(t, "abc", token)
+		assert.Len(t, e.Value.GetStruct().Fields, 2)
+	})
+
+	t.Run("no envelope", func(t *testing.T) {
+		var buff bytes.Buffer
+		require.NoError(t, WriteNoEnvelope(binary.Default, &buff, enveloper, WithIdempotencyToken("abc")))
+
+		v, err := ReadNoEnvelope(binary.Default, bytes.NewReader(buff.Bytes()))
+		require.NoError(t, err)
+
+		token, ok := IdempotencyToken(v)
+		assert.True(t, ok)
+		assert.Equal(t, "abc", token)
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		for _, opts := range [][]WriteOption{nil, {WithIdempotencyToken("")}} {
+			var buff bytes.Buffer
+			require.NoError(t, WriteNoEnvelope(binary.Default, &buff, enveloper, opts...))
+
+			v, err := ReadNoEnvelope(binary.Default, bytes.NewReader(buff.Bytes()))
+			require.NoError(t, err)
+
+			_, ok := IdempotencyToken(v)
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("replaces token", func(t *testing.T) {
+		withToken := fakeEnveloper{
+			Name: "getValue",
+			Type: wire.Call,
+			Value: wire.NewValueStruct(wire.Struct{
+				Fields: []wire.Field{
+					{ID: IdempotencyTokenFieldID, Value: wire.NewValueString("old")},
+				},
+			}),
+		}
+
+		var buff bytes.Buffer
+		require.NoError(t, WriteNoEnvelope(binary.Default, &buff, withToken, WithIdempotencyToken("new")))
+
+		v, err := ReadNoEnvelope(binary.Default, bytes.NewReader(buff.Bytes()))
+		require.NoError(t, err)
+		assert.Len(t, v.GetStruct().Fields, 1)
+
+		token, _ := IdempotencyToken(v)
+		assert.Equal(t, "new", token)
+	})
+
+	assert.Len(t, body.GetStruct().Fields, 1, "body must not be modified")
+
+	_, ok := IdempotencyToken(wire.NewValueI32(42))
+	assert.False(t, ok, "non-struct bodies do not carry tokens")
+}
+
+func TestNewIdempotencyToken(t *testing.T) {
+	a, err := NewIdempotencyToken()
+	require.NoError(t, err)
+	b, err := NewIdempotencyToken()
+	require.NoError(t, err)
+
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+}
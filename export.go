@@ -0,0 +1,124 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/export"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// exporters are the formats supported by "thriftrw export", keyed by name.
+var exporters = map[string]func(*compile.Module, *export.Options) (*export.Result, error){
+	"avro":       export.Avro,
+	"graphql":    export.GraphQL,
+	"jsonschema": export.JSONSchema,
+	"openapi":    export.OpenAPI,
+	"proto":      export.Proto,
+}
+
+type exportOptions struct {
+	OutputDirectory string `long:"out" short:"o" value-name:"DIR" default:"." description:"Directory to which the exported files will be written."`
+	NoRecurse       bool   `long:"no-recurse" description:"Don't export included Thrift files."`
+
+	Args struct {
+		Format     string `positional-arg-name:"FORMAT" description:"Format to export to."`
+		ThriftFile string `positional-arg-name:"FILE" description:"Thrift file to export."`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// exportFormats returns the names of the supported export formats.
+func exportFormats() string {
+	names := make([]string, 0, len(exporters))
+	for name := range exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// exportIDL implements "thriftrw export", which translates a Thrift file
+// into the schema language of another system as a first pass for
+// migrations. Constructs which cannot be translated are reported as
+// warnings.
+func exportIDL(args []string) error {
+	var opts exportOptions
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw export"
+	parser.Usage = "[OPTIONS] FORMAT FILE\n\nFORMAT is one of: " + exportFormats()
+
+	if _, err := parser.ParseArgs(args); err != nil {
+		if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+			parser.WriteHelp(os.Stdout)
+			return nil
+		}
+		return err
+	}
+
+	res, err := runExport(&opts)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range res.Warnings {
+		log.Printf("warning: %v", w)
+	}
+	return nil
+}
+
+// runExport exports the Thrift file named by the given options and writes
+// the exported files to the output directory.
+func runExport(opts *exportOptions) (*export.Result, error) {
+	exporter, ok := exporters[opts.Args.Format]
+	if !ok {
+		return nil, fmt.Errorf("unknown export format %q: must be one of %v", opts.Args.Format, exportFormats())
+	}
+
+	thriftFile := opts.Args.ThriftFile
+	module, err := compile.Compile(thriftFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to compile %q: %+v", thriftFile, err)
+	}
+
+	res, err := exporter(module, &export.Options{NoRecurse: opts.NoRecurse})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range res.Files {
+		path := filepath.Join(opts.OutputDirectory, f.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, f.Contents, 0644); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunExport(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+		return path
+	}
+
+	write("shared.thrift", "struct Point { 1: required double x }")
+	main := write("main.thrift", `
+		include "./shared.thrift"
+		const i32 Limit = 10
+		struct Shape { 1: optional list<shared.Point> points }
+	`)
+
+	tests := []struct {
+		desc      string
+		format    string
+		noRecurse bool
+		wantFiles []string
+		wantWarns int
+		wantErr   string
+	}{
+		{
+			desc:      "proto",
+			format:    "proto",
+			wantFiles: []string{"main.proto", "shared.proto"},
+			wantWarns: 1, // constant
+		},
+		{
+			desc:      "no recurse",
+			format:    "proto",
+			noRecurse: true,
+			wantFiles: []string{"main.proto"},
+			wantWarns: 1,
+		},
+		{
+			desc:      "openapi",
+			format:    "openapi",
+			wantFiles: []string{"main.openapi.json"},
+		},
+		{
+			desc:      "jsonschema",
+			format:    "jsonschema",
+			wantFiles: []string{"Shape.schema.json", "shared.Point.schema.json"},
+		},
+		{
+			desc:      "avro",
+			format:    "avro",
+			wantFiles: []string{"main.Shape.avsc", "shared.Point.avsc"},
+		},
+		{
+			desc:      "graphql",
+			format:    "graphql",
+			wantFiles: []string{"main.graphql"},
+			wantWarns: 1, // constant
+		},
+		{
+			desc:    "unknown format",
+			format:  "xml",
+			wantErr: `unknown export format "xml": must be one of avro, graphql, jsonschema, openapi, proto`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var opts exportOptions
+			opts.OutputDirectory = filepath.Join(t.TempDir(), "out")
+			opts.NoRecurse = tt.noRecurse
+			opts.Args.Format = tt.format
+			opts.Args.ThriftFile = main
+
+			res, err := runExport(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Len(t, res.Warnings, tt.wantWarns)
+
+			entries, err := os.ReadDir(opts.OutputDirectory)
+			require.NoError(t, err)
+			var got []string
+			for _, e := range entries {
+				got = append(got, e.Name())
+			}
+			assert.Equal(t, tt.wantFiles, got)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ta "go.uber.org/thriftrw/gen/internal/tests/aggregate-errors"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/validate"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    *ta.User
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			give: &ta.User{
+				Name:    "alice",
+				Home:    &ta.Address{Street: "Main St", Country: &ta.Country{Code: "US"}},
+				Contact: &ta.Contact{Email: ptr.String("alice@example.com")},
+			},
+		},
+		{
+			desc:    "single violation",
+			give:    &ta.User{Name: "alice"},
+			wantErr: "field Home of User is required",
+		},
+		{
+			desc:    "nested violation",
+			give:    &ta.User{Name: "alice", Home: &ta.Address{Street: "Main St"}},
+			wantErr: "home: field Country of Address is required",
+		},
+		{
+			desc: "multiple violations",
+			give: &ta.User{
+				Work: &ta.Address{},
+				Contact: &ta.Contact{
+					Email: ptr.String("alice@example.com"),
+					Phone: ptr.String("555-0100"),
+				},
+			},
+			wantErr: "3 violations: " +
+				"field Home of User is required; " +
+				"work: field Country of Address is required; " +
+				"contact: Contact should have exactly one field: got 2 fields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Run("ToWire", func(t *testing.T) {
+				_, err := tt.give.ToWire()
+				checkAggregateError(t, tt.wantErr, err)
+			})
+
+			t.Run("Encode", func(t *testing.T) {
+				var buf bytes.Buffer
+				err := tt.give.Encode(binary.NewStreamWriter(&buf))
+				checkAggregateError(t, tt.wantErr, err)
+			})
+
+			t.Run("Collect", func(t *testing.T) {
+				checkAggregateError(t, tt.wantErr, validate.Collect(tt.give))
+			})
+		})
+	}
+}
+
+func TestAggregateErrorsViolations(t *testing.T) {
+	err := validate.Collect(&ta.UserError{User: &ta.User{Contact: &ta.Contact{}}})
+
+	var agg *validate.AggregateError
+	require.True(t, errors.As(err, &agg), "expected an AggregateError, got %v", err)
+	require.Len(t, agg.Violations, 2)
+	assert.Equal(t, "user", agg.Violations[0].Path)
+	assert.EqualError(t, agg.Violations[0].Err, "field Home of User is required")
+	assert.Equal(t, "user.contact", agg.Violations[1].Path)
+	assert.EqualError(t, agg.Violations[1].Err, "Contact should have exactly one field: got 0 fields")
+}
+
+func TestAggregateErrorsFieldConflict(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Foo",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "collectViolations", Type: &compile.StringSpec{}},
+		},
+	}
+	err := structure(NewGenerator(&GeneratorOptions{AggregateErrors: true}), spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`field "collectViolations" conflicts with the CollectViolations method`)
+}
+
+func checkAggregateError(t *testing.T, want string, err error) {
+	if want == "" {
+		assert.NoError(t, err)
+	} else {
+		assert.EqualError(t, err, want)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// appendTo generates an AppendTo method for the given struct, union, or
+// exception which encodes it into a byte slice with a binary.AppendWriter.
+func appendTo(g Generator, name string, spec *compile.StructSpec) error {
+	for _, f := range spec.Fields {
+		fname, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if fname == "AppendTo" {
+			return fmt.Errorf("field %q conflicts with the generated AppendTo method", f.Name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+		<$v := newVar "v">
+		<$buf := newVar "buf">
+		<$w := newVar "w">
+		// AppendTo appends the encoding of this <.Name> with the Binary
+		// protocol to buf, growing it as needed, and returns the extended
+		// slice. buf is returned as-is if the <.Name> could not be encoded.
+		func (<$v> *<.Name>) AppendTo(<$buf> []byte) ([]byte, error) {
+			<$w> := <$binary>.NewAppendWriter(<$buf>)
+			defer <$w>.Close()
+			<- if .Grow>
+			<$w>.Grow(<$v>.EncodedSize())
+			<- end>
+			if err := <$v>.Encode(<$w>); err != nil {
+				return <$buf>, err
+			}
+			return <$w>.Bytes(), nil
+		}
+		`,
+		struct {
+			Name string
+			Grow bool
+		}{Name: name, Grow: checkEncodedSize(g) && hasEncodedSize(spec)},
+	)
+}
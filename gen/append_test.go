@@ -0,0 +1,161 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tat "go.uber.org/thriftrw/gen/internal/tests/append-to"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/ptr"
+)
+
+type appendable interface {
+	AppendTo([]byte) ([]byte, error)
+	Encode(stream.Writer) error
+}
+
+func TestAppendToMatchesEncode(t *testing.T) {
+	tests := []struct {
+		desc string
+		give appendable
+	}{
+		{"struct", &tat.Point{X: 1, Y: 2}},
+		{"empty optional fields", &tat.Trace{ID: "abc"}},
+		{
+			"nested",
+			&tat.Trace{
+				ID:      "abc",
+				Path:    []*tat.Point{{X: 1}, {Y: 2}},
+				Tags:    map[string]string{"k": "v"},
+				Payload: []byte{1, 2, 3},
+			},
+		},
+		{"union", &tat.Shape{Name: ptr.String("circle")}},
+		{"exception", &tat.TraceFailed{Message: "oops"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var want bytes.Buffer
+			sw := binary.NewStreamWriter(&want)
+			require.NoError(t, tt.give.Encode(sw))
+			require.NoError(t, sw.Close())
+
+			got, err := tt.give.AppendTo(nil)
+			require.NoError(t, err)
+			assert.Equal(t, want.Bytes(), got)
+			assert.Equal(t, len(got), cap(got), "buffer must be grown exactly once with EncodedSize")
+
+			got, err = tt.give.AppendTo([]byte("prefix"))
+			require.NoError(t, err)
+			assert.Equal(t, append([]byte("prefix"), want.Bytes()...), got)
+		})
+	}
+}
+
+func TestAppendToError(t *testing.T) {
+	buf := []byte("prefix")
+	got, err := (&tat.Shape{}).AppendTo(buf)
+	require.Error(t, err)
+	assert.Equal(t, []byte("prefix"), got)
+}
+
+func TestAppendToErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		opts    Options
+		wantErr string
+	}{
+		{
+			desc:    "field named appendTo",
+			src:     "struct S {\n1: optional i32 appendTo\n}\n",
+			opts:    Options{AppendTo: true},
+			wantErr: `field "appendTo" conflicts with the generated AppendTo method`,
+		},
+		{
+			desc:    "no streaming",
+			src:     "struct S {\n1: optional i32 a\n}\n",
+			opts:    Options{AppendTo: true, NoStreaming: true},
+			wantErr: "AppendTo cannot be combined with NoStreaming",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot := t.TempDir()
+			path := filepath.Join(thriftRoot, "s.thrift")
+			require.NoError(t, os.WriteFile(path, []byte(tt.src), 0o644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			opts := tt.opts
+			opts.OutputDir = t.TempDir()
+			opts.PackagePrefix = "example.com/gen"
+			opts.ThriftRoot = thriftRoot
+			err = Generate(module, &opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func BenchmarkAppendTo(b *testing.B) {
+	v := &tat.Trace{
+		ID:      "abc",
+		Tags:    map[string]string{"k": "v", "l": "w"},
+		Payload: make([]byte, 256),
+	}
+
+	b.Run("AppendTo", func(b *testing.B) {
+		buf := make([]byte, 0, 1024)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var err error
+			if buf, err = v.AppendTo(buf[:0]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Encode", func(b *testing.B) {
+		var buf bytes.Buffer
+		buf.Grow(1024)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf.Reset()
+			sw := binary.NewStreamWriter(&buf)
+			if err := v.Encode(sw); err != nil {
+				b.Fatal(err)
+			}
+			sw.Close()
+		}
+	})
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Structs nested deeper than this in sample values are left out if they are
+// optional, so that samples of recursive types are finite.
+const sampleMaxDepth = 3
+
+// Length of sample lists. Sets and maps have a single item since samples of
+// the same type are identical.
+const sampleListLength = 3
+
+// Sample of strings and binaries of typedefs bound to Go types with
+// go.typeconv. These are most often fixed-size identifiers such as UUIDs,
+// so the sample has 16 bytes.
+const sampleBoundString = "0123456789abcdef"
+
+// benchmarks generates a Benchmark<Name> function for each struct, union,
+// and exception in the given types, which round-trips a representative
+// value of the type through each of its serialization methods. It returns
+// false if there was nothing to benchmark.
+func benchmarks(g Generator, types map[string]compile.TypeSpec) (bool, error) {
+	var specs []*compile.StructSpec
+	var values []compile.ConstantValue
+	for _, name := range sortStringKeys(types) {
+		spec, ok := types[name].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+		v, ok := sampleValue(spec, 0)
+		if !ok {
+			// Required fields make the type infinitely recursive.
+			continue
+		}
+		specs = append(specs, spec)
+		values = append(values, v)
+	}
+	if len(specs) == 0 {
+		return false, nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$testing := import "testing">
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		type _benchmarkValue interface {
+			ToWire() (<$wire>.Value, error)
+			FromWire(<$wire>.Value) error
+			<- if not (checkNoStreaming)>
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+			Encode(<$stream>.Writer) error
+			Decode(<$stream>.Reader) error
+			<- end>
+		}
+
+		// _benchmarkRoundTrip runs sub-benchmarks for each serialization
+		// method of the given value. newValue returns an empty value of the
+		// same type to decode into.
+		func _benchmarkRoundTrip(b *<$testing>.B, give _benchmarkValue, newValue func() _benchmarkValue) {
+			w, err := give.ToWire()
+			if err != nil {
+				b.Fatal(err)
+			}
+			var buff <$bytes>.Buffer
+			if err := <$binary>.Default.Encode(w, &buff); err != nil {
+				b.Fatal(err)
+			}
+			encoded := buff.Bytes()
+
+			b.Run("ToWire", func(b *<$testing>.B) {
+				b.SetBytes(int64(len(encoded)))
+				b.ReportAllocs()
+				for i := 0; i <lessthan> b.N; i++ {
+					buff.Reset()
+					w, err := give.ToWire()
+					if err != nil {
+						b.Fatal(err)
+					}
+					if err := <$binary>.Default.Encode(w, &buff); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+
+			b.Run("FromWire", func(b *<$testing>.B) {
+				b.SetBytes(int64(len(encoded)))
+				b.ReportAllocs()
+				for i := 0; i <lessthan> b.N; i++ {
+					w, err := <$binary>.Default.Decode(<$bytes>.NewReader(encoded), <$wire>.TStruct)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if err := newValue().FromWire(w); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			<- if not (checkNoStreaming)>
+
+			b.Run("Encode", func(b *<$testing>.B) {
+				b.SetBytes(int64(len(encoded)))
+				b.ReportAllocs()
+				for i := 0; i <lessthan> b.N; i++ {
+					var out <$bytes>.Buffer
+					sw := <$binary>.Default.Writer(&out)
+					if err := give.Encode(sw); err != nil {
+						b.Fatal(err)
+					}
+					if err := sw.Close(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+
+			b.Run("Decode", func(b *<$testing>.B) {
+				b.SetBytes(int64(len(encoded)))
+				b.ReportAllocs()
+				for i := 0; i <lessthan> b.N; i++ {
+					sr := <$binary>.Default.Reader(<$bytes>.NewReader(encoded))
+					if err := newValue().Decode(sr); err != nil {
+						b.Fatal(err)
+					}
+					if err := sr.Close(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			<- end>
+		}
+
+		<range $i, $spec := .Specs>
+			// Benchmark<goName $spec> measures the cost of serializing a
+			// representative <goName $spec>.
+			func Benchmark<goName $spec>(b *<$testing>.B) {
+				give := <constantValue (index $.Values $i) $spec>
+				_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+					return new(<goName $spec>)
+				})
+			}
+		<end>
+		`,
+		struct {
+			Specs  []*compile.StructSpec
+			Values []compile.ConstantValue
+		}{Specs: specs, Values: values},
+		TemplateFunc("checkNoStreaming", checkNoStreaming),
+		TemplateFunc("constantValue", ConstantValue),
+	)
+	return true, wrapGenerateError("benchmarks", err)
+}
+
+// sampleValue builds a representative value of the given type, or returns
+// false if the type has no finite values. depth is the number of structs
+// the value is nested in.
+func sampleValue(t compile.TypeSpec, depth int) (compile.ConstantValue, bool) {
+	root := compile.RootTypeSpec(t)
+	if hasBoundTypedef(t) {
+		switch root.(type) {
+		case *compile.StringSpec, *compile.BinarySpec:
+			return compile.ConstantString(sampleBoundString), true
+		}
+	}
+
+	switch spec := root.(type) {
+	case *compile.BoolSpec:
+		return compile.ConstantBool(true), true
+	case *compile.I8Spec:
+		return compile.ConstantInt(42), true
+	case *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
+		return compile.ConstantInt(4242), true
+	case *compile.DoubleSpec:
+		return compile.ConstantDouble(3.1415), true
+	case *compile.StringSpec, *compile.BinarySpec:
+		return compile.ConstantString("representative value"), true
+	case *compile.UUIDSpec:
+		return compile.ConstantString("c3b8a5c2-0f4e-4b1e-9e41-52c3c6f2a6d1"), true
+	case *compile.EnumSpec:
+		if len(spec.Items) == 0 {
+			return compile.ConstantInt(0), true
+		}
+		return compile.EnumItemReference{Enum: spec, Item: &spec.Items[0]}, true
+	case *compile.ListSpec:
+		v, ok := sampleValue(spec.ValueSpec, depth)
+		if !ok {
+			return compile.ConstantList{}, true
+		}
+		list := make(compile.ConstantList, sampleListLength)
+		for i := range list {
+			list[i] = v
+		}
+		return list, true
+	case *compile.SetSpec:
+		v, ok := sampleValue(spec.ValueSpec, depth)
+		if !ok {
+			return compile.ConstantSet{}, true
+		}
+		return compile.ConstantSet{v}, true
+	case *compile.MapSpec:
+		k, ok := sampleValue(spec.KeySpec, depth)
+		if !ok {
+			return compile.ConstantMap{}, true
+		}
+		v, ok := sampleValue(spec.ValueSpec, depth)
+		if !ok {
+			return compile.ConstantMap{}, true
+		}
+		return compile.ConstantMap{{Key: k, Value: v}}, true
+	case *compile.StructSpec:
+		return sampleStruct(spec, depth)
+	default:
+		return nil, false
+	}
+}
+
+// hasBoundTypedef returns true if the given type is a typedef bound to a Go
+// type with go.typeconv, or a typedef of one.
+func hasBoundTypedef(t compile.TypeSpec) bool {
+	for {
+		spec, ok := t.(*compile.TypedefSpec)
+		if !ok {
+			return false
+		}
+		if isBoundTypedef(spec) {
+			return true
+		}
+		t = spec.Target
+	}
+}
+
+func sampleStruct(spec *compile.StructSpec, depth int) (compile.ConstantValue, bool) {
+	// Recursion through required fields cannot terminate.
+	if depth > 2*sampleMaxDepth {
+		return nil, false
+	}
+
+	fields := make(map[string]compile.ConstantValue)
+	for _, f := range spec.Fields {
+		if encryptKey(f) != "" {
+			// Encrypted fields cannot be serialized without keys.
+			if f.Required {
+				return nil, false
+			}
+			continue
+		}
+		if !f.Required && depth >= sampleMaxDepth && !isPrimitiveType(f.Type) {
+			continue
+		}
+		v, ok := sampleValue(f.Type, depth+1)
+		if !ok {
+			if f.Required {
+				return nil, false
+			}
+			continue
+		}
+		fields[f.Name] = v
+
+		// Unions hold exactly one field.
+		if spec.Type == ast.UnionType {
+			break
+		}
+	}
+	if spec.Type == ast.UnionType && len(fields) == 0 {
+		return nil, false
+	}
+	return &compile.ConstantStruct{Fields: fields}, true
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/thriftcrypt"
+)
+
+func TestSampleValue(t *testing.T) {
+	point := &compile.StructSpec{
+		Name: "Point",
+		Type: ast.StructType,
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "x", Type: &compile.DoubleSpec{}, Required: true},
+			{ID: 2, Name: "y", Type: &compile.DoubleSpec{}, Required: true},
+		},
+	}
+
+	forever := &compile.StructSpec{Name: "Forever", Type: ast.StructType}
+	forever.Fields = compile.FieldGroup{
+		{ID: 1, Name: "again", Type: forever, Required: true},
+	}
+
+	t.Run("struct", func(t *testing.T) {
+		v, ok := sampleValue(point, 0)
+		require.True(t, ok)
+		assert.Equal(t, &compile.ConstantStruct{Fields: map[string]compile.ConstantValue{
+			"x": compile.ConstantDouble(3.1415),
+			"y": compile.ConstantDouble(3.1415),
+		}}, v)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		shape := &compile.StructSpec{
+			Name: "Shape",
+			Type: ast.UnionType,
+			Fields: compile.FieldGroup{
+				{ID: 1, Name: "point", Type: point},
+				{ID: 2, Name: "label", Type: &compile.StringSpec{}},
+			},
+		}
+		v, ok := sampleValue(shape, 0)
+		require.True(t, ok)
+		assert.Len(t, v.(*compile.ConstantStruct).Fields, 1, "unions must set one field")
+	})
+
+	t.Run("infinitely recursive", func(t *testing.T) {
+		_, ok := sampleValue(forever, 0)
+		assert.False(t, ok)
+	})
+
+	t.Run("list of infinitely recursive", func(t *testing.T) {
+		v, ok := sampleValue(&compile.ListSpec{ValueSpec: forever}, 0)
+		require.True(t, ok)
+		assert.Equal(t, compile.ConstantList{}, v)
+	})
+
+	t.Run("bound typedef", func(t *testing.T) {
+		uuid := &compile.TypedefSpec{
+			Name:   "UUID",
+			Target: &compile.BinarySpec{},
+			Annotations: compile.Annotations{
+				goTypeKey:     "example.com/uuid.UUID",
+				goTypeConvKey: "example.com/uuid.ToBytes/FromBytes",
+			},
+		}
+		ids := &compile.TypedefSpec{Name: "ID", Target: uuid}
+		name := &compile.TypedefSpec{Name: "Name", Target: &compile.StringSpec{}}
+		for _, spec := range []compile.TypeSpec{ids, name} {
+			_, err := spec.Link(compile.EmptyScope("foo"))
+			require.NoError(t, err)
+		}
+
+		for _, spec := range []compile.TypeSpec{uuid, ids} {
+			v, ok := sampleValue(spec, 0)
+			require.True(t, ok)
+			assert.Len(t, string(v.(compile.ConstantString)), 16)
+		}
+
+		v, ok := sampleValue(name, 0)
+		require.True(t, ok)
+		assert.Equal(t, compile.ConstantString("representative value"), v)
+	})
+
+	t.Run("required encrypted field", func(t *testing.T) {
+		secret := &compile.StructSpec{
+			Name: "Secret",
+			Type: ast.StructType,
+			Fields: compile.FieldGroup{
+				{
+					ID:          1,
+					Name:        "token",
+					Type:        &compile.StringSpec{},
+					Required:    true,
+					Annotations: compile.Annotations{thriftcrypt.Annotation: "tokens"},
+				},
+			},
+		}
+		_, ok := sampleValue(secret, 0)
+		assert.False(t, ok)
+	})
+}
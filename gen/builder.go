@@ -0,0 +1,185 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// builderGenerator generates a <Name>Builder type for a struct, with a
+// chained setter for each field and a Build method which checks that the
+// struct is complete.
+type builderGenerator struct {
+	Name     string
+	Spec     *compile.StructSpec
+	IsUnion  bool
+	Presence presenceLayout
+
+	// HasDefaults is true if a Default_<Name> constructor was generated.
+	HasDefaults bool
+
+	// HasRequired is true if Build must check that some fields were set.
+	HasRequired bool
+}
+
+func newBuilderGenerator(name string, spec *compile.StructSpec, isUnion bool, presence presenceLayout) (builderGenerator, error) {
+	// Setters are named after fields so they must not collide with Build.
+	ns := NewNamespace()
+	if err := ns.Reserve("Build"); err != nil {
+		return builderGenerator{}, err
+	}
+
+	var hasDefaults, hasRequired bool
+	for _, f := range spec.Fields {
+		name, err := goName(f)
+		if err != nil {
+			return builderGenerator{}, err
+		}
+		if err := ns.Reserve(name); err != nil {
+			return builderGenerator{}, fmt.Errorf(
+				"could not generate a builder: setter for field %q conflicts with another method: %v", f.Name, err)
+		}
+		if f.Default != nil {
+			hasDefaults = true
+		}
+		if builderRequired(f) {
+			hasRequired = true
+		}
+	}
+
+	return builderGenerator{
+		Name:        name,
+		Spec:        spec,
+		IsUnion:     isUnion,
+		Presence:    presence,
+		HasDefaults: hasDefaults,
+		HasRequired: hasRequired,
+	}, nil
+}
+
+func (b builderGenerator) Generate(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$b := newVar "b">
+		<$v := newVar "v">
+		<$value := newVar "value">
+		<$name := .Name>
+
+		// <$name>Builder builds <$name> values with chained setters. Use
+		// New<$name>Builder to construct one.
+		type <$name>Builder struct {
+			v <$name>
+			<- range .Spec.Fields>
+			<- if tracksSet .>
+			has<goName .> bool
+			<- end>
+			<- end>
+		}
+
+		// New<$name>Builder returns a builder for <$name> values
+		<- if .HasDefaults> with
+		// fields set to the default values defined for them in the Thrift file.
+		<- else>.
+		<- end>
+		func New<$name>Builder() *<$name>Builder {
+			<- if .HasDefaults>
+			return &<$name>Builder{v: *Default_<$name>()}
+			<- else>
+			return &<$name>Builder{}
+			<- end>
+		}
+
+		<range .Spec.Fields>
+		<$fname := goName .>
+		// <$fname> sets the <$fname> field of the <$name>.
+		func (<$b> *<$name>Builder) <$fname>(<$value> <typeReference .Type>) *<$name>Builder {
+			<- if $.Presence.Has .>
+			<$b>.v.Set<$fname>(<$value>)
+			<- else if and (not .Required) (isPrimitiveType .Type)>
+			<$b>.v.<$fname> = &<$value>
+			<- else>
+			<$b>.v.<$fname> = <$value>
+			<- end>
+			<- if tracksSet .>
+			<$b>.has<$fname> = true
+			<- end>
+			return <$b>
+		}
+		<end>
+
+		// Build returns the <$name> built so far.
+		<- if .IsUnion>
+		//
+		// An error is returned unless exactly one field was set.
+		<- else if .HasRequired>
+		//
+		// An error is returned if a required field was not set.
+		<- end>
+		func (<$b> *<$name>Builder) Build() (*<$name>, error) {
+			<- if .IsUnion>
+			<- $count := newVar "count">
+			<$count> := 0
+			<- range .Spec.Fields>
+			if <$.Presence.Ref (printf "%s.v" $b) .> != nil {
+				<$count>++
+			}
+			<- end>
+			if <$count> != 1 {
+				return nil, <import "fmt">.Errorf("<$name> should have exactly one field: got %v fields", <$count>)
+			}
+			<- else>
+			<- range .Spec.Fields>
+			<- if tracksSet .>
+			if !<$b>.has<goName .> {
+				return nil, <import "errors">.New("field <goName .> of <$name> is required")
+			}
+			<- else if builderRequired .>
+			if <$b>.v.<goName .> == nil {
+				return nil, <import "errors">.New("field <goName .> of <$name> is required")
+			}
+			<- end>
+			<- end>
+			<- end>
+			<- if or .IsUnion .HasRequired>
+			<end>
+			<$v> := <$b>.v
+			return &<$v>, nil
+		}
+		`, b,
+		TemplateFunc("builderRequired", builderRequired),
+		TemplateFunc("tracksSet", builderTracksSet),
+	)
+}
+
+// builderRequired returns whether Build must check that the given field was
+// set. Required fields with default values are always set.
+func builderRequired(f *compile.FieldSpec) bool {
+	return f.Required && f.Default == nil
+}
+
+// builderTracksSet returns whether the builder must record calls to the
+// setter for the given required field because its zero value is valid on
+// the wire. Other required fields are set if they are not nil.
+func builderTracksSet(f *compile.FieldSpec) bool {
+	return builderRequired(f) && (isPrimitiveType(f.Type) || isListType(f.Type))
+}
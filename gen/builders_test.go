@@ -0,0 +1,163 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tb "go.uber.org/thriftrw/gen/internal/tests/builders"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	addr, err := tb.NewAddressBuilder().Street("1 Main St").Build()
+	require.NoError(t, err)
+
+	got, err := tb.NewProfileBuilder().
+		ID("alice").
+		Address(addr).
+		Name("Alice").
+		Age(42).
+		Emails(nil).
+		Tags([]string{"a", "b"}).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, &tb.Profile{
+		ID:      "alice",
+		Address: &tb.Address{Street: "1 Main St"},
+		Name:    ptr.String("Alice"),
+		Age:     ptr.Int32(42),
+		Role:    tb.RoleUser.Ptr(),
+		Tags:    []string{"a", "b"},
+		Active:  ptr.Bool(true),
+	}, got)
+
+	w, err := got.ToWire()
+	require.NoError(t, err, "built value must be valid")
+	var decoded tb.Profile
+	require.NoError(t, decoded.FromWire(w))
+	assert.True(t, got.Equals(&decoded))
+}
+
+func TestBuilderOverridesDefaults(t *testing.T) {
+	got, err := tb.NewProfileBuilder().
+		ID("bob").
+		Address(&tb.Address{Street: "2 Main St"}).
+		Emails([]string{"bob@example.com"}).
+		Role(tb.RoleAdmin).
+		Active(false).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, tb.RoleAdmin, got.GetRole())
+	assert.False(t, got.GetActive())
+}
+
+func TestBuilderReturnsCopies(t *testing.T) {
+	b := tb.NewAddressBuilder().Street("1 Main St")
+	first, err := b.Build()
+	require.NoError(t, err)
+
+	second, err := b.City("Springfield").Build()
+	require.NoError(t, err)
+
+	assert.Nil(t, first.City, "later setters must not modify built values")
+	assert.Equal(t, "Springfield", second.GetCity())
+}
+
+func TestBuilderRequiredFields(t *testing.T) {
+	addr := &tb.Address{Street: "1 Main St"}
+
+	tests := []struct {
+		desc    string
+		build   func() (*tb.Profile, error)
+		wantErr string
+	}{
+		{
+			desc: "missing primitive",
+			build: func() (*tb.Profile, error) {
+				return tb.NewProfileBuilder().Address(addr).Emails(nil).Build()
+			},
+			wantErr: "field ID of Profile is required",
+		},
+		{
+			desc: "missing struct",
+			build: func() (*tb.Profile, error) {
+				return tb.NewProfileBuilder().ID("alice").Emails(nil).Build()
+			},
+			wantErr: "field Address of Profile is required",
+		},
+		{
+			desc: "nil struct",
+			build: func() (*tb.Profile, error) {
+				return tb.NewProfileBuilder().ID("alice").Address(nil).Emails(nil).Build()
+			},
+			wantErr: "field Address of Profile is required",
+		},
+		{
+			desc: "missing list",
+			build: func() (*tb.Profile, error) {
+				return tb.NewProfileBuilder().ID("alice").Address(addr).Build()
+			},
+			wantErr: "field Emails of Profile is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := tt.build()
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := tb.NewProfileNotFoundBuilder().Message("gone").Build()
+	assert.EqualError(t, err, "field ID of ProfileNotFound is required")
+
+	_, err = tb.NewEmptyBuilder().Build()
+	assert.NoError(t, err)
+}
+
+func TestBuilderUnion(t *testing.T) {
+	got, err := tb.NewContactBuilder().Phone(5551234).Build()
+	require.NoError(t, err)
+	assert.Equal(t, &tb.Contact{Phone: ptr.Int64(5551234)}, got)
+
+	_, err = tb.NewContactBuilder().Build()
+	assert.EqualError(t, err, "Contact should have exactly one field: got 0 fields")
+
+	_, err = tb.NewContactBuilder().Email("a@example.com").Phone(5551234).Build()
+	assert.EqualError(t, err, "Contact should have exactly one field: got 2 fields")
+}
+
+func TestBuilderConflictingField(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Job",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "build", Type: &compile.StringSpec{}},
+		},
+	}
+	_, err := newBuilderGenerator("Job", spec, false, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `setter for field "build" conflicts with another method`)
+}
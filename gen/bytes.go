@@ -0,0 +1,218 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// goBytesKey is a Thrift annotation which represents a string as a Go
+	// []byte instead of a Go string.
+	//
+	//	struct Message {
+	//	    1: required string body (go.bytes)
+	//	}
+	//
+	// The annotation may also be placed on the type itself, as in
+	// list<string (go.bytes)>.
+	goBytesKey = "go.bytes"
+
+	// goStringKey is a Thrift annotation which represents a binary as a Go
+	// string instead of a Go []byte. It may be placed wherever go.bytes may.
+	goStringKey = "go.string"
+)
+
+// resolveBytes replaces the types of fields, function results, and the
+// types they contain which are annotated with go.bytes or go.string with binary and
+// string types respectively, so that code generation only has to look at
+// types. Strings and binaries are encoded the same way on the wire so this
+// does not change how values are encoded. String and binary types are
+// compiled separately for each reference so this does not affect other
+// fields.
+func resolveBytes(m *compile.Module) error {
+	for _, name := range sortStringKeys(m.Types) {
+		var err error
+		switch t := m.Types[name].(type) {
+		case *compile.TypedefSpec:
+			err = resolveBytesTypedef(t)
+		case *compile.StructSpec:
+			err = resolveBytesFields(t.Fields)
+		}
+		if err != nil {
+			return wrapGenerateError(name, err)
+		}
+	}
+
+	for _, name := range sortStringKeys(m.Constants) {
+		c := m.Constants[name]
+		t, err := retypeBytes(nil, c.Type)
+		if err != nil {
+			return wrapGenerateError(name, err)
+		}
+		c.Type = t
+	}
+
+	for _, serviceName := range sortStringKeys(m.Services) {
+		s := m.Services[serviceName]
+		for _, name := range sortStringKeys(s.Functions) {
+			if err := resolveBytesFunction(s.Functions[name]); err != nil {
+				return wrapGenerateError(serviceName+"."+name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveBytesTypedef replaces the types contained in the target of the
+// given typedef. Typedefs remember the type they resolve to so the target
+// itself cannot be replaced.
+func resolveBytesTypedef(t *compile.TypedefSpec) error {
+	target, err := retypeBytes(t.Annotations, t.Target)
+	if err != nil {
+		return err
+	}
+	if target != t.Target {
+		key, _, _ := bytesAnnotation(t.Annotations)
+		if key == "" {
+			key, _, _ = bytesAnnotation(t.Target.ThriftAnnotations())
+		}
+		return fmt.Errorf(
+			"%v annotation is not supported on typedefs of %v: annotate the fields that use %v instead",
+			key, t.Target.ThriftName(), t.Name)
+	}
+	return nil
+}
+
+func resolveBytesFunction(f *compile.FunctionSpec) error {
+	if err := resolveBytesFields(compile.FieldGroup(f.ArgsSpec)); err != nil {
+		return err
+	}
+	if f.ResultSpec == nil {
+		return nil
+	}
+	if f.ResultSpec.ReturnType != nil {
+		t, err := retypeBytes(nil, f.ResultSpec.ReturnType)
+		if err != nil {
+			return err
+		}
+		f.ResultSpec.ReturnType = t
+	}
+	return resolveBytesFields(f.ResultSpec.Exceptions)
+}
+
+func resolveBytesFields(fields compile.FieldGroup) error {
+	for _, f := range fields {
+		t, err := retypeBytes(f.Annotations, f.Type)
+		if err != nil {
+			return wrapGenerateError(f.ThriftName(), err)
+		}
+		f.Type = t
+	}
+	return nil
+}
+
+// retypeBytes returns the type to use in place of the given type, given the
+// annotations of the field or typedef which refers to it. Types contained
+// in lists, sets, and maps are replaced in place.
+//
+// Annotations placed on the type itself take precedence. go.bytes on a
+// binary and go.string on a string have no effect.
+func retypeBytes(annotations compile.Annotations, spec compile.TypeSpec) (compile.TypeSpec, error) {
+	var err error
+	switch s := spec.(type) {
+	case *compile.ListSpec:
+		s.ValueSpec, err = retypeBytes(nil, s.ValueSpec)
+	case *compile.SetSpec:
+		s.ValueSpec, err = retypeBytes(nil, s.ValueSpec)
+	case *compile.MapSpec:
+		if s.KeySpec, err = retypeBytes(nil, s.KeySpec); err == nil {
+			s.ValueSpec, err = retypeBytes(nil, s.ValueSpec)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Annotations of named types apply where they are declared.
+	var own compile.Annotations
+	switch spec.(type) {
+	case *compile.TypedefSpec, *compile.EnumSpec, *compile.StructSpec:
+	default:
+		own = spec.ThriftAnnotations()
+	}
+
+	key, ok, err := bytesAnnotation(own)
+	if err == nil && !ok {
+		key, ok, err = bytesAnnotation(annotations)
+	}
+	if err != nil || !ok {
+		return spec, err
+	}
+
+	switch s := spec.(type) {
+	case *compile.StringSpec:
+		if key == goBytesKey {
+			return &compile.BinarySpec{Annotations: s.Annotations}, nil
+		}
+		return s, nil
+	case *compile.BinarySpec:
+		if key == goStringKey {
+			return &compile.StringSpec{Annotations: s.Annotations}, nil
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("%v annotation is only supported on string and binary: found %v",
+			key, spec.ThriftName())
+	}
+}
+
+// bytesAnnotation returns which of go.bytes and go.string is set to true in
+// the given annotations, if any. The annotations may be specified without a
+// value.
+func bytesAnnotation(annotations compile.Annotations) (key string, ok bool, err error) {
+	for _, k := range []string{goBytesKey, goStringKey} {
+		v, found := annotations[k]
+		if !found {
+			continue
+		}
+
+		set := true
+		if v != "" {
+			set, err = strconv.ParseBool(v)
+			if err != nil {
+				return "", false, fmt.Errorf("invalid %v annotation: %q is not a boolean", k, v)
+			}
+		}
+		if !set {
+			continue
+		}
+		if ok {
+			return "", false, fmt.Errorf("%v and %v annotations cannot be combined", goBytesKey, goStringKey)
+		}
+		key, ok = k, true
+	}
+	return key, ok, nil
+}
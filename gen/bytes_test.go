@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tb "go.uber.org/thriftrw/gen/internal/tests/bytes"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestBytesAnnotationFieldTypes(t *testing.T) {
+	tests := []struct {
+		field string
+		want  interface{}
+	}{
+		{"Body", []byte(nil)},
+		{"Subject", []byte(nil)},
+		{"Checksum", ""},
+		{"Signature", (*string)(nil)},
+		{"Chunks", [][]byte(nil)},
+		{"Counts", map[string]int32(nil)},
+		{"MoreChunks", tb.Chunks(nil)},
+		{"Tags", map[string]struct{}(nil)},
+		{"Footer", []byte(nil)},
+		{"Title", (*string)(nil)},
+		{"Data", []byte(nil)},
+	}
+
+	typ := reflect.TypeOf(tb.Message{})
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			f, ok := typ.FieldByName(tt.field)
+			require.True(t, ok, "field %v not found", tt.field)
+			assert.Equal(t, reflect.TypeOf(tt.want), f.Type)
+		})
+	}
+}
+
+func TestBytesAnnotationWire(t *testing.T) {
+	v := tb.Message{
+		Body:       []byte("body"),
+		Checksum:   "abc",
+		Signature:  ptr.String("sig"),
+		Chunks:     [][]byte{[]byte("a"), []byte("b")},
+		Counts:     map[string]int32{"x": 1},
+		MoreChunks: tb.Chunks{[]byte("c")},
+		Tags:       map[string]struct{}{"t": {}},
+		Footer:     []byte("footer"),
+	}
+
+	w, err := v.ToWire()
+	require.NoError(t, err)
+
+	// Strings and binaries share a wire representation so the annotations
+	// must not change how values are encoded.
+	want := map[int16]wire.Value{
+		1: wire.NewValueBinary([]byte("body")),
+		3: wire.NewValueString("abc"),
+		4: wire.NewValueString("sig"),
+		9: wire.NewValueBinary([]byte("footer")),
+	}
+	for _, f := range w.GetStruct().Fields {
+		if expected, ok := want[f.ID]; ok {
+			assert.True(t, wire.ValuesAreEqual(expected, f.Value), "field %v: got %v", f.ID, f.Value)
+		}
+	}
+
+	var got tb.Message
+	require.NoError(t, got.FromWire(w))
+	assert.Equal(t, v, got)
+}
+
+func TestBytesAnnotationDefaults(t *testing.T) {
+	assert.Equal(t, []byte(tb.DefaultBody), tb.Default_Message().Footer)
+
+	w := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("body")},
+		{ID: 3, Value: wire.NewValueBinary([]byte("abc"))},
+	}})
+
+	var v tb.Message
+	require.NoError(t, v.FromWire(w))
+	assert.Equal(t, tb.Message{
+		Body:     []byte("body"),
+		Checksum: "abc",
+		Footer:   []byte(tb.DefaultBody),
+	}, v)
+}
+
+func TestBytesAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc:    "not a string",
+			src:     "struct S {\n1: optional i32 a (go.bytes)\n}\n",
+			wantErr: "go.bytes annotation is only supported on string and binary: found i32",
+		},
+		{
+			desc:    "both annotations",
+			src:     "struct S {\n1: optional string a (go.bytes, go.string)\n}\n",
+			wantErr: "go.bytes and go.string annotations cannot be combined",
+		},
+		{
+			desc:    "invalid value",
+			src:     "struct S {\n1: optional string a (go.bytes = \"maybe\")\n}\n",
+			wantErr: `invalid go.bytes annotation: "maybe" is not a boolean`,
+		},
+		{
+			desc:    "typedef",
+			src:     "typedef string ID (go.bytes)\n",
+			wantErr: "go.bytes annotation is not supported on typedefs of string: annotate the fields that use ID instead",
+		},
+		{
+			desc:    "typedef target",
+			src:     "typedef binary (go.string) Token\n",
+			wantErr: "go.string annotation is not supported on typedefs of binary: annotate the fields that use Token instead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot := t.TempDir()
+			path := filepath.Join(thriftRoot, "s.thrift")
+			require.NoError(t, os.WriteFile(path, []byte(tt.src), 0o644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     t.TempDir(),
+				PackagePrefix: "example.com/gen",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
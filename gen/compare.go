@@ -0,0 +1,291 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// compareStruct generates Compare and Less methods for the given struct,
+// union, or exception if all its fields are ordered.
+func compareStruct(g Generator, name string, spec *compile.StructSpec, presence presenceLayout) error {
+	if !isOrdered(spec) {
+		return nil
+	}
+
+	for _, f := range spec.Fields {
+		fname, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if fname == "Compare" || fname == "Less" {
+			return fmt.Errorf("field %q conflicts with the generated %v method", f.Name, fname)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$rhs := newVar "rhs">
+		<$c := newVar "c">
+		// Compare returns -1, 0, or 1 if this <.Name> orders before, the
+		// same as, or after the provided <.Name>.
+		//
+		// Fields are compared in the order in which they are declared.
+		// Unset fields order before set ones, and nil before non-nil.
+		func (<$v> *<.Name>) Compare(<$rhs> *<.Name>) int {
+			if <$v> == nil || <$rhs> == nil {
+				return <compareNil $v $rhs>
+			}
+			<range .Fields>
+				<- $lhsField := $.Presence.Ref $v . ->
+				<- $rhsField := $.Presence.Ref $rhs . ->
+				<- if .Required ->
+					if <$c> := <compare .Type $lhsField $rhsField>; <$c> != 0 {
+						return <$c>
+					}
+				<- else ->
+					if <$c> := <comparePtr .Type $lhsField $rhsField>; <$c> != 0 {
+						return <$c>
+					}
+				<- end>
+			<end>
+			return 0
+		}
+
+		// Less returns true if this <.Name> orders before the provided
+		// <.Name>.
+		func (<$v> *<.Name>) Less(<$rhs> *<.Name>) bool {
+			return <$v>.Compare(<$rhs>) <lessthan> 0
+		}
+		`,
+		struct {
+			Name     string
+			Fields   compile.FieldGroup
+			Presence presenceLayout
+		}{Name: name, Fields: spec.Fields, Presence: presence},
+		TemplateFunc("compareNil", curryGenerator(compareNil, g)),
+		TemplateFunc("compare", curryGenerator(compareValue, g)),
+		TemplateFunc("comparePtr", curryGenerator(comparePtr, g)),
+	)
+}
+
+// compareTypedef generates Compare and Less methods for the given typedef
+// if its values are ordered.
+func compareTypedef(g Generator, spec *compile.TypedefSpec) error {
+	if !isOrdered(spec) {
+		return nil
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$typedefType := typeReference .>
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+		// Compare returns -1, 0, or 1 if this <typeName .> orders before,
+		// the same as, or after the provided <typeName .>.
+		func (<$lhs> <$typedefType>) Compare(<$rhs> <$typedefType>) int {
+			<- $lhsCast := printf "(%v)(%v)" (typeReference .Target) $lhs ->
+			<- $rhsCast := printf "(%v)(%v)" (typeReference .Target) $rhs ->
+			return <compare .Target $lhsCast $rhsCast>
+		}
+
+		// Less returns true if this <typeName .> orders before the provided
+		// <typeName .>.
+		func (<$lhs> <$typedefType>) Less(<$rhs> <$typedefType>) bool {
+			return <$lhs>.Compare(<$rhs>) <lessthan> 0
+		}
+		`,
+		spec,
+		TemplateFunc("compare", curryGenerator(compareValue, g)),
+	)
+}
+
+// compareNil returns an expression which orders lhs and rhs, references of
+// which at least one is nil.
+func compareNil(g Generator, lhs, rhs string) (string, error) {
+	return compareValue(g, &compile.BoolSpec{}, lhs+" != nil", rhs+" != nil")
+}
+
+// compareValue returns an expression of type int which is -1, 0, or 1 if
+// lhs orders before, the same as, or after rhs, values of the given type.
+func compareValue(g Generator, spec compile.TypeSpec, lhs, rhs string) (string, error) {
+	var body string
+	switch spec.(type) {
+	case *compile.StructSpec, *compile.TypedefSpec:
+		// Generated types have a Compare method.
+		if strings.HasPrefix(lhs, "*") {
+			lhs = "(" + lhs + ")"
+		}
+		return fmt.Sprintf("%s.Compare(%s)", lhs, rhs), nil
+	case *compile.BinarySpec:
+		return fmt.Sprintf("%s.Compare(%s, %s)", g.Import("bytes"), lhs, rhs), nil
+	case *compile.UUIDSpec:
+		return fmt.Sprintf("%s.Compare(%s.Bytes(), %s.Bytes())",
+			g.Import("bytes"), uuidValue(g, spec, lhs), uuidValue(g, spec, rhs)), nil
+	case *compile.BoolSpec:
+		body = `
+			switch {
+			case <$lhs> == <$rhs>:
+				return 0
+			case <$rhs>:
+				return -1
+			default:
+				return 1
+			}
+		`
+	case *compile.DoubleSpec:
+		body = `
+			<$math := import "math">
+			switch {
+			case <$lhs> <lessthan> <$rhs>:
+				return -1
+			case <$lhs> > <$rhs>:
+				return 1
+			case <$lhs> == <$rhs>:
+				return 0
+			}
+			// NaN orders before all other values.
+			return <compare .Bool (printf "!%v.IsNaN(%v)" $math $lhs) (printf "!%v.IsNaN(%v)" $math $rhs)>
+		`
+	case *compile.ListSpec:
+		body = `
+			<$i := newVar "i" ->
+			<$c := newVar "c" ->
+			for <$i> := 0; <$i> <lessthan> len(<$lhs>) && <$i> <lessthan> len(<$rhs>); <$i>++ {
+				<- $l := printf "%v[%v]" $lhs $i ->
+				<- $r := printf "%v[%v]" $rhs $i>
+				if <$c> := <compare .Spec.ValueSpec $l $r>; <$c> != 0 {
+					return <$c>
+				}
+			}
+			switch {
+			case len(<$lhs>) <lessthan> len(<$rhs>):
+				return -1
+			case len(<$lhs>) > len(<$rhs>):
+				return 1
+			default:
+				return 0
+			}
+		`
+	default:
+		// Integers, strings, and enums.
+		body = `
+			switch {
+			case <$lhs> <lessthan> <$rhs>:
+				return -1
+			case <$lhs> > <$rhs>:
+				return 1
+			default:
+				return 0
+			}
+		`
+	}
+
+	name := fmt.Sprintf("_%s_Compare", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+		func <.Name>(<$lhs>, <$rhs> <typeReference .Spec>) int {
+			`+strings.TrimSpace(body)+`
+		}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+			Bool compile.TypeSpec
+		}{Name: name, Spec: spec, Bool: &compile.BoolSpec{}},
+		TemplateFunc("compare", curryGenerator(compareValue, g)),
+	)
+	return fmt.Sprintf("%s(%s, %s)", name, lhs, rhs), wrapGenerateError(spec.ThriftName(), err)
+}
+
+// comparePtr is the same as compareValue except lhs and rhs are references
+// to values of the given type, which are nil if unset.
+//
+// Unset values order before set ones, even if those are empty, just as they
+// are not equal per Equals.
+func comparePtr(g Generator, spec compile.TypeSpec, lhs, rhs string) (string, error) {
+	name := fmt.Sprintf("_%s_ComparePtr", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+		<- $ptr := "" ->
+		<- if isPrimitiveType .Spec ->
+			<- $ptr = "*" ->
+		<- end>
+		func <.Name>(<$lhs>, <$rhs> <$ptr><typeReference .Spec>) int {
+			if <$lhs> == nil || <$rhs> == nil {
+				return <compareNil $lhs $rhs>
+			}
+			<- $x := printf "%v%v" $ptr $lhs ->
+			<- $y := printf "%v%v" $ptr $rhs>
+			return <compare .Spec $x $y>
+		}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("compareNil", curryGenerator(compareNil, g)),
+		TemplateFunc("compare", curryGenerator(compareValue, g)),
+	)
+	return fmt.Sprintf("%s(%s, %s)", name, lhs, rhs), wrapGenerateError(spec.ThriftName(), err)
+}
+
+// isOrdered returns true if values of the given type are totally ordered:
+// they are primitives, binary, UUIDs, enums, or lists, structs, and typedefs
+// of ordered types. Sets and maps have no order, and typedefs bound to Go
+// types or map containers are not ours to compare.
+func isOrdered(spec compile.TypeSpec) bool {
+	return isOrderedType(spec, make(map[*compile.StructSpec]struct{}))
+}
+
+func isOrderedType(spec compile.TypeSpec, seen map[*compile.StructSpec]struct{}) bool {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return !isBoundTypedef(s) && isOrderedType(s.Target, seen)
+	case *compile.ListSpec:
+		return isOrderedType(s.ValueSpec, seen)
+	case *compile.SetSpec, *compile.MapSpec:
+		return false
+	case *compile.StructSpec:
+		if _, ok := seen[s]; ok {
+			// Recursive references are ordered if the rest of the struct
+			// is.
+			return true
+		}
+		seen[s] = struct{}{}
+		for _, f := range s.Fields {
+			if !isOrderedType(f.Type, seen) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tc "go.uber.org/thriftrw/gen/internal/tests/compare"
+	"go.uber.org/thriftrw/ptr"
+)
+
+func TestCompareStructs(t *testing.T) {
+	// Each value orders strictly before the next.
+	tests := []struct {
+		desc   string
+		values []*tc.Task
+	}{
+		{
+			desc: "nil and required fields",
+			values: []*tc.Task{
+				nil,
+				{Name: "a", Priority: tc.PriorityHigh},
+				{Name: "b", Priority: tc.PriorityLow},
+				{Name: "b", Priority: tc.PriorityMedium},
+			},
+		},
+		{
+			desc: "optional primitives",
+			values: []*tc.Task{
+				{Name: "a"},
+				{Name: "a", Done: ptr.Bool(false)},
+				{Name: "a", Done: ptr.Bool(true)},
+				{Name: "a", Done: ptr.Bool(true), Weight: ptr.Float64(math.NaN())},
+				{Name: "a", Done: ptr.Bool(true), Weight: ptr.Float64(math.Inf(-1))},
+				{Name: "a", Done: ptr.Bool(true), Weight: ptr.Float64(1.5)},
+			},
+		},
+		{
+			desc: "binary",
+			values: []*tc.Task{
+				{Name: "a", Payload: []byte{}},
+				{Name: "a", Payload: []byte{0}},
+				{Name: "a", Payload: []byte{0, 1}},
+				{Name: "a", Payload: []byte{1}},
+			},
+		},
+		{
+			desc: "nested structs and lists",
+			values: []*tc.Task{
+				{Name: "a", Since: &tc.Version{Major: 1, Minor: 2}},
+				{Name: "a", Since: &tc.Version{Major: 1, Minor: 2, Patch: ptr.Int32(0)}},
+				{Name: "a", Since: &tc.Version{Major: 1, Minor: 10}},
+				{Name: "a", Since: &tc.Version{Major: 1, Minor: 10}, Path: tc.Path{1}},
+				{Name: "a", Since: &tc.Version{Major: 1, Minor: 10}, Path: tc.Path{1, 2}},
+				{Name: "a", Since: &tc.Version{Major: 1, Minor: 10}, Path: tc.Path{2}},
+				{
+					Name:     "a",
+					Since:    &tc.Version{Major: 1, Minor: 10},
+					Path:     tc.Path{2},
+					Releases: []*tc.Release{{Major: 1}},
+				},
+				{
+					Name:     "a",
+					Since:    &tc.Version{Major: 1, Minor: 10},
+					Path:     tc.Path{2},
+					Releases: []*tc.Release{{Major: 1}},
+					Parent:   &tc.Task{Name: "parent"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			for i, lhs := range tt.values {
+				assert.Zero(t, lhs.Compare(lhs), "%v must equal itself", lhs)
+				for _, rhs := range tt.values[i+1:] {
+					assert.Equal(t, -1, lhs.Compare(rhs), "%v must order before %v", lhs, rhs)
+					assert.Equal(t, 1, rhs.Compare(lhs), "%v must order after %v", rhs, lhs)
+					assert.True(t, lhs.Less(rhs), "%v must be less than %v", lhs, rhs)
+					assert.False(t, rhs.Less(lhs), "%v must not be less than %v", rhs, lhs)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareSort(t *testing.T) {
+	versions := []*tc.Version{
+		{Major: 2, Minor: 0},
+		{Major: 1, Minor: 10, Patch: ptr.Int32(1)},
+		{Major: 1, Minor: 2, Label: ptr.String("beta")},
+		{Major: 1, Minor: 2},
+		{Major: 1, Minor: 10},
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Less(versions[j])
+	})
+	assert.Equal(t, []*tc.Version{
+		{Major: 1, Minor: 2},
+		{Major: 1, Minor: 2, Label: ptr.String("beta")},
+		{Major: 1, Minor: 10},
+		{Major: 1, Minor: 10, Patch: ptr.Int32(1)},
+		{Major: 2, Minor: 0},
+	}, versions)
+}
+
+func TestComparePresenceBits(t *testing.T) {
+	var unset, zero, one tc.Counts
+	zero.SetCount(0)
+	one.SetCount(1)
+
+	assert.Equal(t, -1, unset.Compare(&zero), "unset fields must order before set ones")
+	assert.Equal(t, -1, zero.Compare(&one))
+	assert.Zero(t, zero.Compare(zero.Copy()))
+}
+
+func TestCompareNilAndEmpty(t *testing.T) {
+	tests := []struct {
+		desc  string
+		empty *tc.Task
+	}{
+		{desc: "binary", empty: &tc.Task{Payload: []byte{}}},
+		{desc: "typedef", empty: &tc.Task{Path: tc.Path{}}},
+		{desc: "list", empty: &tc.Task{Releases: []*tc.Release{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			unset := &tc.Task{}
+			require.False(t, unset.Equals(tt.empty))
+			assert.Equal(t, -1, unset.Compare(tt.empty), "unset fields must order before empty ones")
+			assert.Equal(t, 1, tt.empty.Compare(unset))
+			assert.Zero(t, tt.empty.Compare(tt.empty.Copy()))
+		})
+	}
+}
+
+func TestCompareUnions(t *testing.T) {
+	byName := &tc.Key{Name: ptr.String("a")}
+	byID := &tc.Key{ID: ptr.Int64(1)}
+	assert.Zero(t, byName.Compare(&tc.Key{Name: ptr.String("a")}))
+	assert.Equal(t, 1, byName.Compare(byID), "earlier fields which are set must order after")
+	assert.Equal(t, -1, byID.Compare(byName))
+}
+
+func TestCompareTypedefs(t *testing.T) {
+	assert.True(t, tc.Name("a").Less("b"))
+	assert.Equal(t, -1, tc.Path{1, 2}.Compare(tc.Path{1, 3}))
+	assert.Equal(t, 1, (&tc.Release{Major: 2}).Compare(&tc.Release{Major: 1}))
+}
+
+func TestCompareUnordered(t *testing.T) {
+	// Sets and maps have no order.
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf((*tc.Tagged)(nil)),
+		reflect.TypeOf(tc.Scores(nil)),
+	} {
+		_, ok := typ.MethodByName("Compare")
+		assert.False(t, ok, "%v must not have a Compare method", typ)
+	}
+}
+
+func TestCompareConflict(t *testing.T) {
+	thriftRoot := t.TempDir()
+	path := filepath.Join(thriftRoot, "c.thrift")
+	require.NoError(t, os.WriteFile(path, []byte("struct S {\n1: optional i32 less\n}\n"), 0o644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     t.TempDir(),
+		PackagePrefix: "example.com/gen",
+		ThriftRoot:    thriftRoot,
+		Compare:       true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `field "less" conflicts with the generated Less method`)
+}
@@ -22,6 +22,7 @@ package gen
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 
 	"go.uber.org/thriftrw/compile"
@@ -29,8 +30,12 @@ import (
 
 // Constant generates code for `const` expressions in Thrift files.
 func Constant(g Generator, c *compile.Constant) error {
+	if checkConstantFunctions(g) && isMutableType(c.Type) {
+		return constantFunction(g, c)
+	}
+
 	err := g.DeclareFromTemplate(
-		`<formatDoc .Doc><if canBeConstant .Type>const<else>var<end> <constantName .Name> <typeReference .Type> = <constantValue .Value .Type>`,
+		`<formatDoc (sourceDoc .Doc .File .Line)><if canBeConstant .Type>const<else>var<end> <constantName .Name> <typeReference .Type> = <constantValue .Value .Type>`,
 		c,
 		TemplateFunc("constantValue", ConstantValue),
 		TemplateFunc("canBeConstant", canBeConstant),
@@ -39,11 +44,41 @@ func Constant(g Generator, c *compile.Constant) error {
 	return wrapGenerateError(c.Name, err)
 }
 
+// constantFunction generates a function which returns a new copy of the
+// value of the given constant on every call.
+func constantFunction(g Generator, c *compile.Constant) error {
+	err := g.DeclareFromTemplate(
+		`
+		<- with sourceDoc .Doc .File .Line>
+		<- formatDoc .>//
+		<end ->
+		// <constantName .Name> returns a new copy of this constant on every call,
+		// so callers may modify it.
+		func <constantName .Name>() <typeReference .Type> {
+			return <constantValue .Value .Type>
+		}`,
+		c,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantName", constantName),
+	)
+	return wrapGenerateError(c.Name, err)
+}
+
+// isMutableType returns true if values of the given type may be modified
+// through copies of them: they are lists, sets, maps, binary, or structs.
+func isMutableType(t compile.TypeSpec) bool {
+	return isReferenceType(t) || isStructType(t)
+}
+
 // ConstantValue generates an expression containing the given constant value of
 // the given type.
 //
 // The constant must already have been linked to the given type.
 func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (string, error) {
+	if b, ok := t.(*compile.TypedefSpec); ok && isBoundTypedef(b) {
+		return boundConstantValue(g, c, b)
+	}
+
 	switch v := c.(type) {
 	case compile.ConstantBool:
 		return constantBool(g, v, t)
@@ -58,6 +93,12 @@ func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (st
 	case compile.ConstantSet:
 		return constantSet(g, v, t)
 	case compile.ConstantString:
+		if isUUIDType(t) {
+			return uuidConstant(g, v, t)
+		}
+		if _, ok := compile.RootTypeSpec(t).(*compile.BinarySpec); ok {
+			return castConstant(g, t, strconv.Quote(string(v)))
+		}
 		return strconv.Quote(string(v)), nil
 	case *compile.ConstantStruct:
 		return constantStruct(g, v, t)
@@ -65,14 +106,49 @@ func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (st
 		return enumItemReference(g, v, t)
 	case compile.ConstReference:
 		if canBeConstant(v.Target.Type) {
-			return g.LookupConstantName(v.Target)
+			return constReference(g, v, t)
 		}
-		return ConstantValue(g, v.Target.Value, v.Target.Type)
+		return ConstantValue(g, v.Target.Value, t)
 	default:
 		panic(fmt.Sprintf("Unknown constant value %v (%T)", c, c))
 	}
 }
 
+// constReference generates a reference to the given constant, converting it
+// to the given type if it was declared with a different type which resolves
+// to the same type, such as a typedef.
+func constReference(g Generator, v compile.ConstReference, t compile.TypeSpec) (string, error) {
+	s, err := g.LookupConstantName(v.Target)
+	if err != nil {
+		return "", err
+	}
+
+	want, err := typeName(g, t)
+	if err != nil {
+		return "", err
+	}
+	got, err := typeName(g, v.Target.Type)
+	if err != nil {
+		return "", err
+	}
+	if want != got {
+		s = fmt.Sprintf("%v(%v)", want, s)
+	}
+	return s, nil
+}
+
+// boundConstantValue generates an expression which converts the given
+// constant to the Go type that the given typedef is bound to.
+func boundConstantValue(g Generator, c compile.ConstantValue, t *compile.TypedefSpec) (string, error) {
+	var tg typedefGenerator
+	conv, err := tg.boundConstant(g, t)
+	if err != nil {
+		return "", err
+	}
+	s, err := ConstantValue(g, c, t.Target)
+	return fmt.Sprintf("%v(%v)", conv, s), err
+}
+
 func castConstant(g Generator, t compile.TypeSpec, s string) (string, error) {
 	n, err := typeName(g, t)
 	if err != nil {
@@ -103,6 +179,13 @@ func constantDouble(g Generator, v compile.ConstantDouble, t compile.TypeSpec) (
 
 func constantInt(g Generator, v compile.ConstantInt, t compile.TypeSpec) (_ string, err error) {
 	s := fmt.Sprint(int(v))
+	if root := compile.RootTypeSpec(t); isUnsigned(root) {
+		// Negative values are reinterpreted as their two's complement, as
+		// they are on the wire.
+		bits := intBits(root)
+		s = fmt.Sprint(uint64(v) & (math.MaxUint64 >> (64 - bits)))
+	}
+
 	switch t.(type) {
 	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
 		// do nothing
@@ -131,15 +214,19 @@ func constantList(g Generator, v compile.ConstantList, t compile.TypeSpec) (stri
 
 func constantMap(g Generator, v compile.ConstantMap, t compile.TypeSpec) (string, error) {
 	mapSpec := compile.RootTypeSpec(t).(*compile.MapSpec)
+	if isMapContainer(mapSpec) {
+		return containerConstant(g, v, t)
+	}
 	keySpec := mapSpec.KeySpec
 	valueSpec := mapSpec.ValueSpec
 	return g.TextTemplate(
 		`
+		<- $mapSpec := .MapSpec ->
 		<- $keyType := .KeySpec ->
 		<- $valueType := .ValueSpec ->
 		<- typeReference .Spec>{
 			<range .Value>
-				<- if isHashable $keyType ->
+				<- if mapUsesMap $mapSpec ->
 					<constantValue .Key $keyType>: <constantValue .Value $valueType>,
 				<- else ->
 					{
@@ -149,11 +236,12 @@ func constantMap(g Generator, v compile.ConstantMap, t compile.TypeSpec) (string
 				<- end>
 			<end>
 		}`, struct {
+			MapSpec   *compile.MapSpec
 			Spec      compile.TypeSpec
 			KeySpec   compile.TypeSpec
 			ValueSpec compile.TypeSpec
 			Value     compile.ConstantMap
-		}{Spec: t, KeySpec: keySpec, ValueSpec: valueSpec, Value: v},
+		}{MapSpec: mapSpec, Spec: t, KeySpec: keySpec, ValueSpec: valueSpec, Value: v},
 		TemplateFunc("constantValue", ConstantValue))
 }
 
@@ -182,29 +270,75 @@ func constantSet(g Generator, v compile.ConstantSet, t compile.TypeSpec) (string
 }
 
 func constantStruct(g Generator, v *compile.ConstantStruct, t compile.TypeSpec) (string, error) {
-	fields := compile.RootTypeSpec(t).(*compile.StructSpec).Fields
+	spec := compile.RootTypeSpec(t).(*compile.StructSpec)
+	presence, err := newPresenceLayout(g, spec)
+	if err != nil {
+		return "", err
+	}
+
+	// Fields stored with presence bits can only be set through their
+	// setters so structs with such fields are built in a function literal.
+	// Typedefs of structs do not have the setters so these are built as the
+	// root struct and converted.
+	var fields, setters []constantField
+	for _, name := range sortStringKeys(v.Fields) {
+		fspec, err := spec.Fields.FindByName(name)
+		if err != nil {
+			return "", err
+		}
+		f := constantField{Spec: fspec, Value: v.Fields[name]}
+		if presence.Has(f.Spec) {
+			setters = append(setters, f)
+		} else {
+			fields = append(fields, f)
+		}
+	}
+
 	return g.TextTemplate(
 		`
-		<- $fields := .Fields ->
+		<- $v := newVar "v" ->
+		<- if .Setters ->
+		func() *<typeName .Spec> {
+			<$v> := &<typeName .Root>{
+		<- else ->
 		&<typeName .Spec>{
-			<range $name, $value := .Value.Fields>
-				<- $field := $fields.FindByName $name ->
-				<- if and (not $field.Required) (isPrimitiveType $field.Type) ->
-					<goName $field>: <constantValuePtr $value $field.Type>,
+		<- end>
+			<range .Fields>
+				<- if and (not .Spec.Required) (isPrimitiveType .Spec.Type) ->
+					<goName .Spec>: <constantValuePtr .Value .Spec.Type>,
 				<- else ->
-					<goName $field>: <constantValue $value $field.Type>,
+					<goName .Spec>: <constantValue .Value .Spec.Type>,
 				<- end>
 			<end>
-		}`, struct {
-			Spec   compile.TypeSpec
-			Fields compile.FieldGroup
-			Value  *compile.ConstantStruct
-		}{Spec: t, Fields: fields, Value: v},
+		}
+		<- if .Setters>
+			<range .Setters ->
+				<$v>.Set<goName .Spec>(<constantValue .Value .Spec.Type>)
+			<end ->
+			<if .Typedef ->
+				return (*<typeName .Spec>)(<$v>)
+			<- else ->
+				return <$v>
+			<- end>
+		}()
+		<- end>`, struct {
+			Spec    compile.TypeSpec
+			Root    *compile.StructSpec
+			Typedef bool
+			Fields  []constantField
+			Setters []constantField
+		}{Spec: t, Root: spec, Typedef: t != spec, Fields: fields, Setters: setters},
 		TemplateFunc("constantValue", ConstantValue),
 		TemplateFunc("constantValuePtr", ConstantValuePtr),
 	)
 }
 
+// constantField is a field of a constant struct.
+type constantField struct {
+	Spec  *compile.FieldSpec
+	Value compile.ConstantValue
+}
+
 func enumItemReference(g Generator, v compile.EnumItemReference, t compile.TypeSpec) (_ string, err error) {
 	s, err := g.TextTemplate(`<enumItemName (typeName .Enum) .Item>`, v)
 	if err != nil {
@@ -221,6 +355,12 @@ func enumItemReference(g Generator, v compile.EnumItemReference, t compile.TypeS
 func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec) (string, error) {
 	var ptrFunc string
 
+	if isUnsigned(t) {
+		ptrFunc = fmt.Sprintf("%v.Uint%d", g.Import("go.uber.org/thriftrw/ptr"), intBits(t))
+		s, err := ConstantValue(g, c, t)
+		return fmt.Sprintf("%v(%v)", ptrFunc, s), err
+	}
+
 	switch t.(type) {
 	case *compile.BoolSpec:
 		ptrFunc = fmt.Sprintf("%v.Bool", g.Import("go.uber.org/thriftrw/ptr"))
@@ -236,7 +376,12 @@ func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec)
 		ptrFunc = fmt.Sprintf("%v.Float64", g.Import("go.uber.org/thriftrw/ptr"))
 	case *compile.StringSpec:
 		ptrFunc = fmt.Sprintf("%v.String", g.Import("go.uber.org/thriftrw/ptr"))
-	case *compile.EnumSpec, *compile.TypedefSpec:
+	case *compile.EnumSpec, *compile.TypedefSpec, *compile.UUIDSpec:
+		if !isPrimitiveType(t) {
+			// Typedefs of structs and containers are referenced directly.
+			return ConstantValue(g, c, t)
+		}
+
 		ptrFunc = fmt.Sprintf("_%s_ptr", g.MangleType(t))
 		err := g.EnsureDeclared(
 			`func <.Name>(v <typeReference .Spec>) *<typeReference .Spec> {
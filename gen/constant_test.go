@@ -23,6 +23,7 @@ package gen
 import (
 	"testing"
 
+	tcf "go.uber.org/thriftrw/gen/internal/tests/constant-functions"
 	tk "go.uber.org/thriftrw/gen/internal/tests/constants"
 	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
@@ -356,3 +357,61 @@ func TestConstantsMutation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, g.Edges[0].StartPoint.X, originalX)
 }
+
+func TestConstantFunctionsReturnCopies(t *testing.T) {
+	colors := tcf.PrimaryColors()
+	colors[0] = "purple"
+	assert.Equal(t, []string{"red", "green", "blue"}, tcf.PrimaryColors())
+
+	primes := tcf.Primes()
+	delete(primes, 2)
+	assert.Len(t, tcf.Primes(), 4)
+
+	ports := tcf.Ports()
+	ports["http"] = 8080
+	assert.Equal(t, int32(80), tcf.Ports()["http"])
+
+	origin := tcf.Origin()
+	origin.X = 42
+	assert.Equal(t, &tcf.Point{X: 0, Y: 0}, tcf.Origin())
+
+	square := tcf.UnitSquare()
+	square.Polygon[0].X = 42
+	assert.Equal(t, float64(0), tcf.UnitSquare().Polygon[0].X)
+
+	users := tcf.Users()
+	users[0] = "eve"
+	assert.Equal(t, tcf.Names{"alice", "bob"}, tcf.Users())
+
+	assert.Equal(t, int32(100), tcf.MaxPoints)
+	assert.Equal(t, "hello", tcf.Greeting)
+}
+
+func TestConstantFunctionsAsDefaults(t *testing.T) {
+	canvas := tcf.Default_Canvas()
+	canvas.Colors[0] = "purple"
+	canvas.Center.X = 42
+
+	assert.Equal(t, []string{"red", "green", "blue"}, tcf.PrimaryColors())
+	assert.Equal(t, &tcf.Canvas{
+		Colors: []string{"red", "green", "blue"},
+		Center: &tcf.Point{X: 0, Y: 0},
+	}, tcf.Default_Canvas())
+}
+
+func TestDefaultsFromConstants(t *testing.T) {
+	assert.Equal(t, &tk.DefaultsFromConstants{
+		Numbers: []int32{42, 1},
+		Enums: map[te.EnumDefault]struct{}{
+			te.EnumDefaultBar: {},
+			te.EnumDefaultFoo: {},
+		},
+		Points: map[string]*ts.Point{
+			"some": {X: 1, Y: 2},
+		},
+		Point:  &ts.Point{X: 0, Y: 0},
+		MyEnum: td.MyEnum(te.EnumWithValuesY).Ptr(),
+		UUID:   &td.UUID{High: 1234, Low: 5678},
+		Times:  []td.Timestamp{0, 42},
+	}, tk.Default_DefaultsFromConstants())
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// copyGenerator is responsible for generating code that makes deep copies
+// of Thrift types.
+type copyGenerator struct {
+	mapG  mapGenerator
+	setG  setGenerator
+	listG listGenerator
+}
+
+// Copy generates an expression which evaluates to a deep copy of v, a value
+// of the given type.
+func (c *copyGenerator) Copy(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if isPrimitiveType(spec) {
+		return v, nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.BinarySpec:
+		name, err := c.copyBinary(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.MapSpec:
+		name, err := c.mapG.Copy(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.ListSpec:
+		name, err := c.listG.Copy(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.SetSpec:
+		name, err := c.setG.Copy(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	default:
+		// Custom defined type
+		return fmt.Sprintf("%s.Copy()", v), nil
+	}
+}
+
+// CopyPtr is the same as Copy except v is expected to be a reference to a
+// value of the given type.
+func (c *copyGenerator) CopyPtr(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if !isPrimitiveType(spec) {
+		// Everything else is a reference type which Copy handles.
+		return c.Copy(g, spec, v)
+	}
+
+	name := copyPtrFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$type := typeReference .Spec>
+			<$v := newVar "v">
+			func <.Name>(<$v> *<$type>) *<$type> {
+				if <$v> == nil {
+					return nil
+				}
+				<$x := newVar "x">
+				<$x> := *<$v>
+				return &<$x>
+			}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+	)
+	return fmt.Sprintf("%s(%s)", name, v), err
+}
+
+func (c *copyGenerator) copyBinary(g Generator, spec *compile.BinarySpec) (string, error) {
+	name := copyFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$v := newVar "v">
+			func <.Name>(<$v> []byte) []byte {
+				if <$v> == nil {
+					return nil
+				}
+				<$o := newVar "o">
+				<$o> := make([]byte, len(<$v>))
+				copy(<$o>, <$v>)
+				return <$o>
+			}
+		`,
+		struct{ Name string }{Name: name},
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
+	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
+	tuf "go.uber.org/thriftrw/gen/internal/tests/unknown-fields"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyNil(t *testing.T) {
+	var s *ts.Point
+	assert.Nil(t, s.Copy())
+}
+
+func TestCopyPrimitives(t *testing.T) {
+	give := &ts.PrimitiveOptionalStruct{
+		BoolField:   ptr.Bool(true),
+		Int32Field:  ptr.Int32(42),
+		StringField: ptr.String("foo"),
+		BinaryField: []byte{},
+	}
+
+	got := give.Copy()
+	require.Equal(t, give, got)
+	assert.NotNil(t, got.BinaryField, "empty binary must not become nil")
+	assert.Nil(t, got.Int64Field)
+
+	*got.Int32Field = 1
+	*got.StringField = "bar"
+	assert.Equal(t, int32(42), *give.Int32Field)
+	assert.Equal(t, "foo", *give.StringField)
+}
+
+func TestCopyContainers(t *testing.T) {
+	give := &tc.ContainersOfContainers{
+		ListOfLists: [][]int32{{1, 2}, {3}},
+		ListOfSets:  []map[int32]struct{}{{1: {}}},
+		ListOfMaps:  []map[int32]int32{{1: 2}},
+		SetOfLists:  [][]string{{"a"}},
+		MapOfMapToInt: []struct {
+			Key   map[string]int32
+			Value int64
+		}{{Key: map[string]int32{"a": 1}, Value: 2}},
+		MapOfListToSet: []struct {
+			Key   []int32
+			Value map[int64]struct{}
+		}{{Key: []int32{1}, Value: map[int64]struct{}{2: {}}}},
+	}
+
+	got := give.Copy()
+	require.True(t, give.Equals(got))
+
+	got.ListOfLists[0][0] = 100
+	got.ListOfSets[0][100] = struct{}{}
+	got.ListOfMaps[0][1] = 100
+	got.SetOfLists[0][0] = "z"
+	got.MapOfMapToInt[0].Key["a"] = 100
+	got.MapOfListToSet[0].Key[0] = 100
+	got.MapOfListToSet[0].Value[100] = struct{}{}
+
+	assert.Equal(t, int32(1), give.ListOfLists[0][0])
+	assert.Len(t, give.ListOfSets[0], 1)
+	assert.Equal(t, int32(2), give.ListOfMaps[0][1])
+	assert.Equal(t, "a", give.SetOfLists[0][0])
+	assert.Equal(t, int32(1), give.MapOfMapToInt[0].Key["a"])
+	assert.Equal(t, int32(1), give.MapOfListToSet[0].Key[0])
+	assert.Len(t, give.MapOfListToSet[0].Value, 1)
+	assert.Nil(t, got.SetOfSets, "nil containers must remain nil")
+}
+
+func TestCopyStructs(t *testing.T) {
+	give := &ts.Frame{
+		TopLeft: &ts.Point{X: 1, Y: 2},
+		Size:    &ts.Size{Width: 3, Height: 4},
+	}
+
+	got := give.Copy()
+	require.Equal(t, give, got)
+
+	got.TopLeft.X = 100
+	assert.Equal(t, 1.0, give.TopLeft.X)
+}
+
+func TestCopyUnion(t *testing.T) {
+	give := &tu.ArbitraryValue{ListValue: []*tu.ArbitraryValue{
+		{StringValue: ptr.String("foo")},
+		{MapValue: map[string]*tu.ArbitraryValue{
+			"bar": {Int64Value: ptr.Int64(42)},
+		}},
+	}}
+
+	got := give.Copy()
+	require.Equal(t, give, got)
+
+	*got.ListValue[1].MapValue["bar"].Int64Value = 1
+	assert.Equal(t, int64(42), *give.ListValue[1].MapValue["bar"].Int64Value)
+}
+
+func TestCopyException(t *testing.T) {
+	give := &tx.DoesNotExistException{Key: "foo", Error2: ptr.String("bar")}
+
+	got := give.Copy()
+	require.Equal(t, give, got)
+
+	*got.Error2 = "baz"
+	assert.Equal(t, "bar", *give.Error2)
+}
+
+func TestCopyTypedefs(t *testing.T) {
+	uuid := &td.UUID{High: 1, Low: 2}
+	give := &td.Transition{
+		FromState: "a",
+		ToState:   "b",
+		Events: td.EventGroup{
+			{UUID: uuid, Time: (*td.Timestamp)(ptr.Int64(3))},
+		},
+	}
+
+	got := give.Copy()
+	require.Equal(t, give, got)
+
+	got.Events[0].UUID.High = 100
+	*got.Events[0].Time = 100
+	assert.Equal(t, int64(1), give.Events[0].UUID.High)
+	assert.Equal(t, td.Timestamp(3), *give.Events[0].Time)
+
+	pdf := td.PDF("foo")
+	gotPDF := pdf.Copy()
+	gotPDF[0] = 'x'
+	assert.Equal(t, td.PDF("foo"), pdf)
+}
+
+func TestCopyUnknownFields(t *testing.T) {
+	var old tuf.UserV1
+	decodeThrift(t, encodeThrift(t, &tuf.UserV2{Name: "alice", Age: ptr.Int32(42)}, false), &old, true)
+
+	cp := old.Copy()
+	cp.Name = "bob"
+
+	var got tuf.UserV2
+	decodeThrift(t, encodeThrift(t, cp, true), &got, true)
+	assert.Equal(t, &tuf.UserV2{Name: "bob", Age: ptr.Int32(42)}, &got)
+	assert.Equal(t, "alice", old.Name)
+}
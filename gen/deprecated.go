@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// deprecatedKey is a Thrift annotation which marks types, fields, enum
+// items, services, and functions as deprecated. Its value says what to use
+// instead.
+//
+//	struct User {
+//	    1: optional string name (deprecated = "use fullName")
+//	    2: optional string fullName
+//	}
+//
+// The generated declarations get a "Deprecated:" paragraph in their
+// documentation so that linters and editors warn about their use.
+const deprecatedKey = "deprecated"
+
+// deprecationNotice returns the "Deprecated:" paragraph for a definition
+// with the given annotations, or an empty string if it is not deprecated.
+func deprecationNotice(annotations compile.Annotations) string {
+	v, ok := annotations[deprecatedKey]
+	if !ok {
+		return ""
+	}
+
+	// The notice must be a single paragraph.
+	v = strings.Join(strings.Fields(v), " ")
+	if v == "" {
+		v = "Do not use."
+	}
+	return "Deprecated: " + v
+}
+
+// deprecatedDoc appends the deprecation notice for a definition with the
+// given annotations to its documentation.
+func deprecatedDoc(doc string, annotations compile.Annotations) string {
+	return appendDoc(doc, deprecationNotice(annotations))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/dualencode"
+	td "go.uber.org/thriftrw/gen/internal/tests/dual-encode"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDualEncode(t *testing.T) {
+	var mismatches []*dualencode.Mismatch
+	dualencode.SetMismatchHandler(func(m *dualencode.Mismatch) {
+		mismatches = append(mismatches, m)
+	})
+	defer dualencode.SetMismatchHandler(nil)
+
+	tests := []struct {
+		desc string
+		give thriftType
+	}{
+		{desc: "empty order", give: &td.Order{ID: "1"}},
+		{
+			desc: "full order",
+			give: &td.Order{
+				ID: "2",
+				Items: []*td.Item{
+					{Name: "apple", Count: ptr.Int32(3)},
+					{Name: "pear"},
+				},
+				Totals: map[string]int64{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5},
+				Tags:   map[string]struct{}{"x": {}, "y": {}, "z": {}},
+				Primary: &td.Item{
+					Name: "apple",
+				},
+			},
+		},
+		{desc: "union", give: &td.Payment{Card: ptr.String("4111")}},
+		{desc: "exception", give: &td.OrderError{Message: "not found"}},
+		{desc: "args", give: &td.Orders_GetOrder_Args{ID: ptr.String("1")}},
+		{
+			desc: "result",
+			give: &td.Orders_GetOrder_Result{NotFound: &td.OrderError{Message: "not found"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mismatches = nil
+
+			w, err := tt.give.ToWire()
+			require.NoError(t, err)
+
+			var want, got bytes.Buffer
+			require.NoError(t, binary.Default.Encode(w, &want))
+
+			sw := binary.NewStreamWriter(&got)
+			require.NoError(t, tt.give.Encode(sw))
+			require.NoError(t, sw.Close())
+
+			assert.Empty(t, mismatches)
+			if !bytes.Equal(want.Bytes(), got.Bytes()) {
+				// Maps and sets may be written in a different order.
+				gotV, err := binary.Default.Decode(bytes.NewReader(got.Bytes()), w.Type())
+				require.NoError(t, err)
+				assert.True(t, wire.ValuesAreEqual(w, gotV), "Encode must match ToWire")
+			}
+		})
+	}
+}
+
+func TestDualEncodeInvalid(t *testing.T) {
+	var mismatches []*dualencode.Mismatch
+	dualencode.SetMismatchHandler(func(m *dualencode.Mismatch) {
+		mismatches = append(mismatches, m)
+	})
+	defer dualencode.SetMismatchHandler(nil)
+
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	defer sw.Close()
+
+	err := (&td.Payment{}).Encode(sw)
+	assert.EqualError(t, err, "Payment should have exactly one field: got 0 fields")
+	assert.Empty(t, mismatches, "failures of both paths must not be reported")
+}
@@ -29,7 +29,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
+	tss "go.uber.org/thriftrw/gen/internal/tests/services"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
 	"go.uber.org/thriftrw/thriftreflect"
 )
@@ -80,3 +83,21 @@ func TestIDLEmbeddingInclude(t *testing.T) {
 		assert.Equal(t, te.ThriftModule, tm.Includes[0])
 	}
 }
+
+func TestCompileEmbeddedIDL(t *testing.T) {
+	m, err := compile.CompileEmbedded(tss.ThriftModule)
+	require.NoError(t, err)
+
+	fromDisk, err := compile.Compile("internal/tests/thrift/services.thrift")
+	require.NoError(t, err)
+
+	// Everything but the file paths must match the module compiled from
+	// disk.
+	assert.Equal(t, sortStringKeys(fromDisk.Services), sortStringKeys(m.Services))
+	assert.Equal(t, sortStringKeys(fromDisk.Types), sortStringKeys(m.Types))
+	assert.Equal(t, sortStringKeys(fromDisk.Includes), sortStringKeys(m.Includes))
+
+	spec, err := m.LookupService("KeyValue")
+	require.NoError(t, err)
+	assert.Contains(t, spec.Functions, "getValue")
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen/internal/tests/domain"
+	tes "go.uber.org/thriftrw/gen/internal/tests/encoded-size"
+	"go.uber.org/thriftrw/gen/internal/tests/ordered"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/thriftuuid"
+	"go.uber.org/thriftrw/wire"
+)
+
+type sizedValue interface {
+	EncodedSize() int
+	ToWire() (wire.Value, error)
+	Encode(stream.Writer) error
+}
+
+func TestEncodedSizeMatchesEncoding(t *testing.T) {
+	ordered1 := new(ordered.StringInt32Map)
+	ordered1.Put("b", 2)
+	ordered1.Put("a", 1)
+	ordered2 := new(ordered.Int32StringMap)
+	ordered2.Put(1, "one")
+
+	origin := tes.Origin(tes.Point{X: 1, Y: 2})
+	tests := []struct {
+		desc string
+		give sizedValue
+	}{
+		{"empty struct", &tes.Point{}},
+		{"enum", tes.ColorGreen},
+		{"typedef", tes.Name("alice")},
+		{"typedef of fixed size", tes.Count(42)},
+		{"typedef of list", tes.Names{"a", "bc"}},
+		{"typedef of struct", &origin},
+		{
+			"primitives unset",
+			&tes.Primitives{B: true, Medium: 4294967295},
+		},
+		{
+			"primitives set",
+			&tes.Primitives{
+				B:      true,
+				Tiny:   ptr.Int8(1),
+				Small:  ptr.Int16(2),
+				Medium: 3,
+				Large:  ptr.Int64(4),
+				D:      ptr.Float64(5),
+				S:      ptr.String("hello"),
+				Bin:    []byte{1, 2, 3},
+				U:      &thriftuuid.UUID{1},
+				Color:  tes.ColorRed.Ptr(),
+			},
+		},
+		{"containers unset", &tes.Containers{}},
+		{
+			"containers empty",
+			&tes.Containers{
+				Ints:         []int32{},
+				Strings:      []string{},
+				Points:       []*tes.Point{},
+				Longs:        map[int64]struct{}{},
+				Names:        map[string]struct{}{},
+				PointSet:     []*tes.Point{},
+				Fixed:        map[int32]int64{},
+				ByName:       map[string]int32{},
+				ByID:         map[int32]string{},
+				Nested:       map[string][]string{},
+				Ordered:      new(ordered.StringInt32Map),
+				OrderedNames: new(ordered.Int32StringMap),
+			},
+		},
+		{
+			"containers set",
+			&tes.Containers{
+				Ints:     []int32{1, 2, 3},
+				Strings:  []string{"a", "", "abc"},
+				Points:   []*tes.Point{{X: 1}, {Y: 2}},
+				Longs:    map[int64]struct{}{1: {}, 2: {}},
+				Names:    map[string]struct{}{"x": {}, "yz": {}},
+				PointSet: []*tes.Point{{X: 3}},
+				Fixed:    map[int32]int64{1: 2, 3: 4},
+				ByName:   map[string]int32{"a": 1, "bcd": 2},
+				ByID:     map[int32]string{1: "a", 2: "bcd"},
+				Nested:   map[string][]string{"a": {"b", "cd"}, "e": {}},
+				Labels: []struct {
+					Key   *tes.Point
+					Value string
+				}{{Key: &tes.Point{X: 1}, Value: "one"}},
+				Ordered:      ordered1,
+				OrderedNames: ordered2,
+				Pairs: []struct {
+					Key   string
+					Value int32
+				}{{Key: "a", Value: 1}, {Key: "bc", Value: 2}},
+			},
+		},
+		{
+			"typedefs",
+			&tes.Typedefs{
+				Name:      "alice",
+				Nickname:  tes.Name("al").Ptr(),
+				Count:     tes.Count(1).Ptr(),
+				Aliases:   tes.Names{"a", "b"},
+				Origin:    &origin,
+				ID:        &domain.UUID{1, 2, 3},
+				CreatedAt: time.Unix(1, 0),
+				DeletedAt: func() *time.Time { t := time.Unix(2, 0); return &t }(),
+			},
+		},
+		{"defaults unset", &tes.Defaults{}},
+		{
+			"defaults set",
+			&tes.Defaults{
+				Greeting: ptr.String("hi"),
+				Retries:  ptr.Int32(5),
+				Tags:     []string{},
+				Mode:     ptr.String("slow"),
+			},
+		},
+		{"omitted default", &tes.Defaults{Mode: ptr.String("fast")}},
+		{"presence bits unset", &tes.Presence{}},
+		{
+			"presence bits set",
+			func() *tes.Presence {
+				var p tes.Presence
+				p.SetA(1)
+				p.SetB("hello")
+				return &p
+			}(),
+		},
+		{"union", &tes.Shape{Name: ptr.String("circle")}},
+		{"union of struct", &tes.Shape{Point: &tes.Point{X: 1}}},
+		{"exception", &tes.Failure{Message: "oops"}},
+		{"field named size", &tes.Sized{Size: ptr.Int32(1), Name: ptr.String("a")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertEncodedSize(t, tt.give)
+		})
+	}
+}
+
+func TestEncodedSizeUnknownFields(t *testing.T) {
+	w := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("name")},
+		{ID: 2, Value: wire.NewValueI64(42)},
+		{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("a"),
+			wire.NewValueString("bc"),
+		}))},
+	}})
+
+	var v tes.Unknown
+	require.NoError(t, v.FromWire(w))
+	assertEncodedSize(t, &v)
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(w, &buf))
+	assert.Equal(t, buf.Len(), v.EncodedSize())
+}
+
+func assertEncodedSize(t *testing.T, v sizedValue) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	require.NoError(t, v.Encode(sw))
+	require.NoError(t, sw.Close())
+	assert.Equal(t, buf.Len(), v.EncodedSize(), "EncodedSize must match the length of Encode")
+
+	w, err := v.ToWire()
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, binary.Default.Encode(w, &buf))
+	assert.Equal(t, buf.Len(), v.EncodedSize(), "EncodedSize must match the length of ToWire")
+}
+
+func TestEncodedSizeSkipped(t *testing.T) {
+	type sized interface{ EncodedSize() int }
+
+	tests := []struct {
+		desc string
+		give interface{}
+	}{
+		{"encrypted field", &tes.Secret{}},
+		{"typedef of struct with encrypted field", &tes.SecretAlias{}},
+		{"struct holding encrypted field", &tes.Secrets{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, ok := tt.give.(sized)
+			assert.False(t, ok, "%T must not have an EncodedSize method", tt.give)
+		})
+	}
+}
+
+func TestEncodedSizeGenerate(t *testing.T) {
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		file string
+		src  string
+	}{
+		{desc: "structs", file: "structs.thrift"},
+		{desc: "encrypted fields", file: "encrypt.thrift"},
+		{
+			desc: "field named EncodedSize",
+			src:  "struct S {\n1: optional i32 encodedSize\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			root := thriftRoot
+			path := filepath.Join(root, tt.file)
+			if tt.src != "" {
+				root = t.TempDir()
+				path = filepath.Join(root, "s.thrift")
+				require.NoError(t, os.WriteFile(path, []byte(tt.src), 0o644))
+			}
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			require.NoError(t, Generate(module, &Options{
+				OutputDir:     t.TempDir(),
+				PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+				ThriftRoot:    root,
+				NoRecurse:     true,
+				EncodedSize:   true,
+			}))
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/thriftcrypt"
+)
+
+const _thriftcryptPath = "go.uber.org/thriftrw/thriftcrypt"
+
+// encryptKey returns the alias of the key with which the given field is
+// encrypted, or an empty string if it is not encrypted.
+func encryptKey(f *compile.FieldSpec) string {
+	return f.Annotations[thriftcrypt.Annotation]
+}
+
+// hasEncryptedFields returns true if any of the given fields are encrypted.
+func hasEncryptedFields(fields compile.FieldGroup) bool {
+	for _, f := range fields {
+		if _, ok := f.Annotations[thriftcrypt.Annotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEncryptedValues returns true if values of the given type hold
+// encrypted fields, directly or through their fields and items. Such values
+// cannot be encoded or decoded until a thriftcrypt.Provider is registered.
+func hasEncryptedValues(spec compile.TypeSpec) bool {
+	return findEncryptedValues(spec, make(map[*compile.StructSpec]struct{}))
+}
+
+func findEncryptedValues(spec compile.TypeSpec, seen map[*compile.StructSpec]struct{}) bool {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return findEncryptedValues(s.Target, seen)
+	case *compile.ListSpec:
+		return findEncryptedValues(s.ValueSpec, seen)
+	case *compile.SetSpec:
+		return findEncryptedValues(s.ValueSpec, seen)
+	case *compile.MapSpec:
+		return findEncryptedValues(s.KeySpec, seen) || findEncryptedValues(s.ValueSpec, seen)
+	case *compile.StructSpec:
+		if _, ok := seen[s]; ok {
+			return false
+		}
+		seen[s] = struct{}{}
+		if hasEncryptedFields(s.Fields) {
+			return true
+		}
+		for _, f := range s.Fields {
+			if findEncryptedValues(f.Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkEncryptedFields verifies the encrypt annotations on the given fields.
+func checkEncryptedFields(fields compile.FieldGroup) error {
+	for _, f := range fields {
+		if v, ok := f.Annotations[thriftcrypt.Annotation]; ok && v == "" {
+			return fmt.Errorf(
+				"invalid %v annotation on field %q: the key alias must not be empty",
+				thriftcrypt.Annotation, f.Name)
+		}
+	}
+	return nil
+}
+
+// fieldTypeCode returns the wire type with which the given field is sent.
+// Encrypted fields are sent as binary.
+func fieldTypeCode(g Generator, f *compile.FieldSpec) string {
+	if encryptKey(f) != "" {
+		return g.Import("go.uber.org/thriftrw/wire") + ".TBinary"
+	}
+	return TypeCode(g, f.Type)
+}
+
+// sealWire generates statements which replace w, the Thrift-level
+// representation of the given field, with its encrypted form. Nothing is
+// generated if the field is not encrypted.
+func sealWire(g Generator, f *compile.FieldSpec, w string) string {
+	key := encryptKey(f)
+	if key == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n%v, err = %v.Seal(%q, %v)\nif err != nil {\nreturn %v, err\n}",
+		w, g.Import(_thriftcryptPath), key, w, w)
+}
+
+// openWire generates statements which decrypt the value of field, a
+// wire.Field holding the given field. Nothing is generated if the field is
+// not encrypted.
+func openWire(g Generator, f *compile.FieldSpec, field string) string {
+	key := encryptKey(f)
+	if key == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n%v.Value, err = %v.Open(%q, %v.Value, %v)\nif err != nil {\nreturn err\n}\n",
+		field, g.Import(_thriftcryptPath), key, field, TypeCode(g, f.Type))
+}
+
+// encodeField generates an expression which writes v, the value of the
+// given field, to the stream writer sw, encrypting it if needed. If ptr is
+// set, v is a pointer to the value.
+func encodeField(g Generator, f *compile.FieldSpec, v, sw string, ptr bool) (string, error) {
+	key := encryptKey(f)
+	w := sw
+	if key != "" {
+		w = "esw"
+	}
+
+	expr, err := g.TextTemplate(
+		`<if .Ptr><encodePtr .Spec .V .W><else><encode .Spec .V .W><end>`,
+		struct {
+			Spec compile.TypeSpec
+			V, W string
+			Ptr  bool
+		}{Spec: f.Type, V: v, W: w, Ptr: ptr},
+	)
+	if err != nil || key == "" {
+		return expr, err
+	}
+
+	return fmt.Sprintf("%v.EncodeSealed(%v, %q, func(%v %v.Writer) error {\nreturn %v\n})",
+		g.Import(_thriftcryptPath), sw, key, w, g.Import("go.uber.org/thriftrw/protocol/stream"), expr), nil
+}
+
+// decodeField generates statements which decode the given field from the
+// stream reader sr into lhs, decrypting it if needed, and set err. If ptr is
+// set, lhs holds a pointer to the value for primitive types.
+func decodeField(g Generator, f *compile.FieldSpec, lhs, sr string, ptr bool) (string, error) {
+	key := encryptKey(f)
+	r := sr
+	if key != "" {
+		r = "dsr"
+	}
+
+	stmt, err := g.TextTemplate(
+		`<if .Ptr><decodePtr .Spec .LHS .R><else><.LHS>, err = <decode .Spec .R><end>`,
+		struct {
+			Spec   compile.TypeSpec
+			LHS, R string
+			Ptr    bool
+		}{Spec: f.Type, LHS: lhs, R: r, Ptr: ptr},
+	)
+	if err != nil || key == "" {
+		return stmt, err
+	}
+
+	return fmt.Sprintf("err = %v.DecodeSealed(%v, %q, func(%v %v.Reader) (err error) {\n%v\nreturn err\n})",
+		g.Import(_thriftcryptPath), sr, key, r, g.Import("go.uber.org/thriftrw/protocol/stream"), stmt), nil
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	te "go.uber.org/thriftrw/gen/internal/tests/encrypt"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/thriftcrypt"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reverseProvider "encrypts" bytes by prefixing them with the key alias and
+// reversing them.
+type reverseProvider struct{}
+
+func (reverseProvider) Encrypt(keyAlias string, plaintext []byte) ([]byte, error) {
+	return reverseBytes(append([]byte(keyAlias+":"), plaintext...)), nil
+}
+
+func (reverseProvider) Decrypt(keyAlias string, ciphertext []byte) ([]byte, error) {
+	bs := reverseBytes(ciphertext)
+	prefix := []byte(keyAlias + ":")
+	if !bytes.HasPrefix(bs, prefix) {
+		return nil, errors.New("wrong key")
+	}
+	return bs[len(prefix):], nil
+}
+
+func reverseBytes(bs []byte) []byte {
+	out := make([]byte, len(bs))
+	for i, b := range bs {
+		out[len(bs)-1-i] = b
+	}
+	return out
+}
+
+// sealed builds the binary value expected in place of an encrypted field
+// holding the given value.
+func sealed(t *testing.T, keyAlias string, v wire.Value) wire.Value {
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(v, &buf))
+	bs, err := reverseProvider{}.Encrypt(keyAlias, buf.Bytes())
+	require.NoError(t, err)
+	return wire.NewValueBinary(bs)
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	thriftcrypt.RegisterProvider(reverseProvider{})
+	defer thriftcrypt.RegisterProvider(nil)
+
+	shipping := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("1 Main St")},
+	}})
+
+	tests := []struct {
+		desc string
+		x    thriftType
+		v    wire.Value
+	}{
+		{
+			desc: "required fields",
+			x: &te.Payment{
+				ID:         "p1",
+				CardNumber: "4111111111111111",
+				Currency:   ptr.String("USD"),
+				Shipping:   &te.Address{Street: "1 Main St"},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("p1")},
+				{ID: 2, Value: sealed(t, "payments", wire.NewValueString("4111111111111111"))},
+				{ID: 7, Value: sealed(t, "payments", wire.NewValueString("USD"))},
+				{ID: 8, Value: sealed(t, "addresses", shipping)},
+			}}),
+		},
+		{
+			desc: "all fields",
+			x: &te.Payment{
+				ID:         "p2",
+				CardNumber: "4111111111111111",
+				Cvv:        ptr.Int32(123),
+				Billing:    &te.Address{Street: "2 Side St", Zip: ptr.String("94103")},
+				Notes:      []string{"a", "b"},
+				Token:      []byte{1, 2, 3},
+				Currency:   ptr.String("EUR"),
+				Shipping:   &te.Address{Street: "1 Main St"},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("p2")},
+				{ID: 2, Value: sealed(t, "payments", wire.NewValueString("4111111111111111"))},
+				{ID: 3, Value: sealed(t, "payments", wire.NewValueI32(123))},
+				{ID: 4, Value: sealed(t, "addresses", wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("2 Side St")},
+					{ID: 2, Value: wire.NewValueString("94103")},
+				}}))},
+				{ID: 5, Value: sealed(t, "payments", wire.NewValueList(
+					wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+						wire.NewValueString("a"),
+						wire.NewValueString("b"),
+					}),
+				))},
+				{ID: 6, Value: sealed(t, "tokens", wire.NewValueBinary([]byte{1, 2, 3}))},
+				{ID: 7, Value: sealed(t, "payments", wire.NewValueString("EUR"))},
+				{ID: 8, Value: sealed(t, "addresses", shipping)},
+			}}),
+		},
+		{
+			desc: "union",
+			x:    &te.Secret{Password: ptr.String("hunter2")},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: sealed(t, "secrets", wire.NewValueString("hunter2"))},
+			}}),
+		},
+		{
+			desc: "union without encryption",
+			x:    &te.Secret{Hint: ptr.String("pets")},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 2, Value: wire.NewValueString("pets")},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		testRoundTripCombos(t, tt.x, tt.v, tt.desc)
+	}
+}
+
+func TestEncryptLazy(t *testing.T) {
+	thriftcrypt.RegisterProvider(reverseProvider{})
+	defer thriftcrypt.RegisterProvider(nil)
+
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	give := &te.LazyPayment{ID: "p1", CardNumber: ptr.String("4111111111111111")}
+	require.NoError(t, give.Encode(sw))
+	require.NoError(t, sw.Close())
+	assert.NotContains(t, buf.String(), "4111111111111111")
+
+	var lazy te.LazyPayment_Lazy
+	lazy.Reset(buf.Bytes())
+
+	got, err := lazy.GetCardNumber()
+	require.NoError(t, err)
+	assert.Equal(t, "4111111111111111", got)
+}
+
+func TestEncryptErrors(t *testing.T) {
+	give := &te.Payment{
+		ID:         "p1",
+		CardNumber: "4111111111111111",
+		Shipping:   &te.Address{Street: "1 Main St"},
+	}
+
+	t.Run("no provider", func(t *testing.T) {
+		thriftcrypt.RegisterProvider(nil)
+
+		_, err := give.ToWire()
+		assert.Equal(t, thriftcrypt.ErrNoProvider, err)
+
+		var buf bytes.Buffer
+		sw := binary.NewStreamWriter(&buf)
+		defer sw.Close()
+		assert.Equal(t, thriftcrypt.ErrNoProvider, give.Encode(sw))
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		thriftcrypt.RegisterProvider(reverseProvider{})
+		defer thriftcrypt.RegisterProvider(nil)
+
+		w := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("p1")},
+			{ID: 2, Value: sealed(t, "addresses", wire.NewValueString("4111111111111111"))},
+			{ID: 8, Value: sealed(t, "addresses", wire.NewValueStruct(wire.Struct{}))},
+		}})
+
+		var got te.Payment
+		err := got.FromWire(w)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `could not decrypt with key "payments": wrong key`)
+	})
+
+	t.Run("plaintext field", func(t *testing.T) {
+		thriftcrypt.RegisterProvider(reverseProvider{})
+		defer thriftcrypt.RegisterProvider(nil)
+
+		w := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("p1")},
+			{ID: 3, Value: wire.NewValueI32(123)},
+			{ID: 2, Value: sealed(t, "payments", wire.NewValueString("4111111111111111"))},
+			{ID: 8, Value: sealed(t, "addresses", wire.NewValueStruct(wire.Struct{
+				Fields: []wire.Field{{ID: 1, Value: wire.NewValueString("1 Main St")}},
+			}))},
+		}})
+
+		var got te.Payment
+		require.NoError(t, got.FromWire(w))
+		assert.Nil(t, got.Cvv, "unencrypted values of encrypted fields must be ignored")
+	})
+}
+
+func TestEncryptAnnotationErrors(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Foo",
+		Fields: compile.FieldGroup{
+			{
+				ID:          1,
+				Name:        "secret",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"encrypt": ""},
+			},
+		},
+	}
+
+	err := structure(NewGenerator(&GeneratorOptions{}), spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`invalid encrypt annotation on field "secret": the key alias must not be empty`)
+}
@@ -85,21 +85,18 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 	// TODO(abg) define an error type in the library for unrecognized enums.
 	err := g.DeclareFromTemplate(
 		`
-		<$bytes := import "bytes">
 		<$fmt := import "fmt">
-		<$json := import "encoding/json">
 		<$math := import "math">
 
-		<$stream := import "go.uber.org/thriftrw/protocol/stream">
 		<$wire := import "go.uber.org/thriftrw/wire">
 
 		<$enumName := goName .Spec>
-		<formatDoc .Spec.Doc>type <$enumName> int32
+		<formatDoc (deprecatedDoc (sourceDoc .Spec.Doc .Spec.File .Spec.Line) .Spec.Annotations)>type <$enumName> int32
 
 		<if .Spec.Items>
 			const (
 			<range .Spec.Items>
-				<- formatDoc .Doc><enumItemName $enumName .> <$enumName> = <.Value>
+				<- formatDoc (deprecatedDoc .Doc .Annotations)><enumItemName $enumName .> <$enumName> = <.Value>
 			<end>
 			)
 		<end>
@@ -113,7 +110,29 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			}
 		}
 
+		// <$enumName>_Names returns the names of all recognized values of
+		// <$enumName>, in the same order as <$enumName>_Values.
+		func <$enumName>_Names() []string {
+			return []string{
+				<range .Spec.Items>
+					<- printf "%q" (enumItemLabelName .)>,
+				<end>
+			}
+		}
+
 		<$v := newVar "v">
+		// IsValid returns true if this <$enumName> is one of its recognized
+		// values.
+		func (<$v> <$enumName>) IsValid() bool {
+			<if len .Spec.Items ->
+				switch int32(<$v>) {
+				case <range $i, $item := .UniqueItems><if $i>, <end><$item.Value><end>:
+					return true
+				}
+			<end ->
+			return false
+		}
+
 		<$value := newVar "value">
 		// UnmarshalText tries to decode <$enumName> from a byte slice
 		// containing its name.
@@ -193,6 +212,24 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			return &<$v>
 		}
 
+		// Set sets <$enumName> from its name or integer value.
+		//
+		// This implements flag.Value, allowing <$enumName> to be used as a
+		// command line flag.
+		func (<$v> *<$enumName>) Set(<$value> string) error {
+			return <$v>.UnmarshalText([]byte(<$value>))
+		}
+
+		// Type returns the name of this enum type for use in the help
+		// messages of command line flags.
+		//
+		// This implements pflag.Value.
+		func (<$v> <$enumName>) Type() string {
+			return "<$enumName>"
+		}
+
+		<if not (checkNoStreaming) ->
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
 		<$sw := newVar "sw">
 		// Encode encodes <$enumName> directly to bytes.
 		//
@@ -203,6 +240,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 		func (<$v> <$enumName>) Encode(<$sw> <$stream>.Writer) error {
 			return <$sw>.WriteInt32(int32(<$v>))
 		}
+		<- end>
 
 		// ToWire translates <$enumName> into a Thrift-level intermediate
 		// representation. This intermediate representation may be serialized
@@ -232,6 +270,8 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			return nil
 		}
 
+		<if not (checkNoStreaming) ->
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
 		<$sr := newVar "sr">
 		// Decode reads off the encoded <$enumName> directly off of the wire.
 		//
@@ -251,6 +291,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			*<$v> = (<$enumName>)(<$i>)
 			return nil
 		}
+		<- end>
 
 		// String returns a readable string representation of <$enumName>.
 		func (<$v> <$enumName>) String() string {
@@ -308,10 +349,38 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 		//
 		// This implements json.Unmarshaler.
 		func (<$v> *<$enumName>) UnmarshalJSON(<$text> []byte) error {
+		<- if checkTinyGo ->
+			<- $strconv := import "strconv" ->
+			<- $s := newVar "s" ->
+			<- $x := newVar "x" ->
+
+			<$s> := string(<import "bytes">.TrimSpace(<$text>))
+			if len(<$s>) <">"> 0 && <$s>[0] == '"' {
+				<$w>, err := <$strconv>.Unquote(<$s>)
+				if err != nil {
+					return err
+				}
+				return <$v>.UnmarshalText([]byte(<$w>))
+			}
+
+			<$x>, err := <$strconv>.ParseInt(<$s>, 10, 64)
+			if err != nil {
+				return <$fmt>.Errorf("invalid JSON value %q to unmarshal into %q", <$text>, "<$enumName>")
+			}
+			if <$x> <">"> <$math>.MaxInt32 {
+				return <$fmt>.Errorf("enum overflow from JSON %q for %q", <$text>, "<$enumName>")
+			}
+			if <$x> <"<"> <$math>.MinInt32 {
+				return <$fmt>.Errorf("enum underflow from JSON %q for %q", <$text>, "<$enumName>")
+			}
+			*<$v> = (<$enumName>)(<$x>)
+			return nil
+		<- else ->
+			<- $json := import "encoding/json" ->
 			<- $d := newVar "d" ->
 			<- $t := newVar "t" ->
 
-			<$d> := <$json>.NewDecoder(<$bytes>.NewReader(<$text>))
+			<$d> := <$json>.NewDecoder(<import "bytes">.NewReader(<$text>))
 			<$d>.UseNumber()
 			<$t>, err := <$d>.Token()
 			if err != nil {
@@ -338,6 +407,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			default:
 				return <$fmt>.Errorf("invalid JSON value %q (%T) to unmarshal into %q", <$t>, <$t>, "<$enumName>")
 			}
+		<- end>
 		}
 		`,
 		struct {
@@ -349,9 +419,36 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 		},
 		TemplateFunc("enumItemLabelName", entityLabel),
 		TemplateFunc("checkNoZap", checkNoZap),
+		TemplateFunc("checkNoStreaming", checkNoStreaming),
+		TemplateFunc("checkTinyGo", checkTinyGo),
 		TemplateFunc("checkEnumTextMarshalStrict", checkEnumTextMarshalStrict),
 	)
+	if err != nil {
+		return wrapGenerateError(spec.Name, err)
+	}
+
+	if checkYAML(g) {
+		if err := enumYAML(g, spec, items); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if checkQuickGenerators(g) {
+		if err := quickEnum(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if checkEncodedSize(g) {
+		if err := sizeEnum(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
 
+	sg, ok, err := newSQLGenerator(spec)
+	if err == nil && ok {
+		err = sg.Generate(g)
+	}
 	return wrapGenerateError(spec.Name, err)
 }
 
@@ -22,7 +22,9 @@ package gen
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -361,6 +363,45 @@ func TestEnumValuesCanBeListed(t *testing.T) {
 	assert.Equal(t, values, []te.EnumDefault{te.EnumDefaultFoo, te.EnumDefaultBar, te.EnumDefaultBaz})
 }
 
+func TestEnumNamesCanBeListed(t *testing.T) {
+	assert.Equal(t, []string{"Foo", "Bar", "Baz"}, te.EnumDefault_Names())
+	assert.Empty(t, te.EmptyEnum_Names())
+
+	// Names match the values at the same position.
+	names := te.EnumWithLabel_Names()
+	values := te.EnumWithLabel_Values()
+	require.Len(t, names, len(values))
+	for i, v := range values {
+		text, err := v.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, string(text), names[i], "name of %v", v)
+	}
+}
+
+func TestEnumIsValid(t *testing.T) {
+	tests := []struct {
+		desc string
+		give interface{ IsValid() bool }
+		want bool
+	}{
+		{desc: "first", give: te.EnumDefaultFoo, want: true},
+		{desc: "last", give: te.EnumDefaultBaz, want: true},
+		{desc: "unknown", give: te.EnumDefault(3), want: false},
+		{desc: "negative", give: te.EnumDefault(-1), want: false},
+		{desc: "explicit value", give: te.EnumWithValuesY, want: true},
+		{desc: "between explicit values", give: te.EnumWithValues(124), want: false},
+		{desc: "duplicate value", give: te.EnumWithDuplicateValuesR, want: true},
+		{desc: "negative value", give: te.EnumWithDuplicateValuesQ, want: true},
+		{desc: "empty enum", give: te.EmptyEnum(0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.IsValid())
+		})
+	}
+}
+
 func TestUnmarshalTextReturnsValue(t *testing.T) {
 	var v te.EnumDefault
 	err := v.UnmarshalText([]byte("Foo"))
@@ -379,6 +420,25 @@ func TestUnmarshalTextReturnsErrorOnInvalidValue(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEnumFlag(t *testing.T) {
+	v := te.EnumDefaultFoo
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&v, "enum", "usage")
+
+	require.NoError(t, fs.Parse([]string{"-enum", "Baz"}))
+	assert.Equal(t, te.EnumDefaultBaz, v)
+	assert.Equal(t, "Baz", fs.Lookup("enum").Value.String())
+	assert.Equal(t, "EnumDefault", v.Type())
+
+	require.NoError(t, fs.Parse([]string{"-enum", "1"}))
+	assert.Equal(t, te.EnumDefaultBar, v)
+
+	err := fs.Parse([]string{"-enum", "blah"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown enum value "blah" for "EnumDefault"`)
+}
+
 func TestTextMarshaler(t *testing.T) {
 	tests := []struct {
 		title    string
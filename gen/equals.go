@@ -29,14 +29,20 @@ import (
 // EqualsGenerator is responsible for generating code that knows how
 // to compare the equality of two Thrift types and their Value representations.
 type equalsGenerator struct {
-	mapG  mapGenerator
-	setG  setGenerator
-	listG listGenerator
+	mapG     mapGenerator
+	setG     setGenerator
+	listG    listGenerator
+	typedefG typedefGenerator
 }
 
 // Equals generates a string comparing rhs to the given lhs.
 // Equals generates an expression of type bool.
 func (e *equalsGenerator) Equals(g Generator, spec compile.TypeSpec, lhs, rhs string) (string, error) {
+	if t, ok := spec.(*compile.TypedefSpec); ok && isBoundTypedef(t) {
+		equals, err := e.typedefG.boundEquals(g, t)
+		return fmt.Sprintf("%s(%s, %s)", equals, lhs, rhs), err
+	}
+
 	if isPrimitiveType(spec) {
 		if _, isEnum := spec.(*compile.EnumSpec); !isEnum {
 			return fmt.Sprintf("(%s == %s)", lhs, rhs), nil
@@ -21,7 +21,11 @@
 package gen
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
 
 	"github.com/fatih/structtag"
 	"go.uber.org/thriftrw/compile"
@@ -32,11 +36,37 @@ const (
 	// in the generated go struct's tag
 	goTagKey = "go.tag"
 
+	// annotations with this prefix each add a single tag to the generated
+	// go struct field, keyed by the remainder of the annotation name
+	goTagPrefix = "go.tag."
+
+	// value of this annotation on a struct is a template for tags added to
+	// each of its fields
+	fieldTagTemplateKey = "go.field_tag_template"
+
 	// key for tag set on all generated go structs used by encoding/json
 	jsonTagKey = "json"
 
+	// key for tag set on generated go structs with --yaml, mirroring the
+	// json tag
+	yamlTagKey = "yaml"
+
 	omitempty    = "omitempty"
 	notOmitempty = "!omitempty"
+
+	// value of this annotation on a struct or an optional field with a
+	// default value overrides whether the field is omitted on the wire
+	// when it is set to its default value
+	omitDefaultKey = "go.omit_default"
+
+	// value of this annotation on a struct overrides whether fields that
+	// are not recognized when it is decoded are retained and written back
+	// out when it is encoded
+	preserveUnknownFieldsKey = "go.preserve_unknown_fields"
+
+	// name of the hidden member of generated structs which holds the fields
+	// that were not recognized; this must match the templates below
+	unknownFieldsName = "unknownFields"
 )
 
 var reservedIdentifiers = map[string]struct{}{
@@ -46,6 +76,9 @@ var reservedIdentifiers = map[string]struct{}{
 	"Decode":   {},
 	"String":   {},
 	"Equals":   {},
+	"Copy":     {},
+	"Hash":     {},
+	"Reset":    {},
 }
 
 // fieldGroupGenerator is responsible for generating code for FieldGroups.
@@ -64,6 +97,48 @@ type fieldGroupGenerator struct {
 	// This field group represents a Thrift exception.
 	IsException bool
 
+	// If true, optional fields whose value matches their default value are
+	// not written to the wire unless the field specifies otherwise with
+	// the go.omit_default annotation.
+	OmitDefaults bool
+
+	// Templates for tags added to every field in this group, in increasing
+	// order of precedence. Tags specified on the field itself take
+	// precedence over these.
+	TagTemplates []*template.Template
+
+	// If true, fields which are not recognized when decoding are retained
+	// in a hidden member and written back out when encoding.
+	PreserveUnknownFields bool
+
+	// If true, ToWire and Encode report all violations found in the struct
+	// through its CollectViolations method before encoding it.
+	AggregateErrors bool
+
+	// If true, Encode compares its output with that of ToWire through the
+	// dualencode package.
+	DualEncode bool
+
+	// If true, a Set<Field> method is generated for each field.
+	Setters bool
+
+	// If true, this field group is a union declared in a Thrift file. Its
+	// Set<Field> methods, generated for every field, unset all other
+	// fields, and it has Lookup<Field> and Which methods.
+	UnionAccessors bool
+
+	// If true, FromWire and Decode run under pprof labels naming this
+	// type, delegating to the unexported fromWire and decode methods.
+	PprofLabels bool
+
+	// Optional primitive fields in this layout are stored by value and
+	// tracked by a hidden presence bitset instead of being pointers.
+	Presence presenceLayout
+
+	// If true, fields are given a yaml tag mirroring their json tag unless
+	// one is specified explicitly.
+	YAMLTags bool
+
 	Doc string
 }
 
@@ -81,6 +156,22 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
+	for _, field := range f.Fields {
+		if _, err := f.omitDefault(field); err != nil {
+			return err
+		}
+	}
+
+	if err := checkEncryptedFields(f.Fields); err != nil {
+		return err
+	}
+
+	if f.PreserveUnknownFields {
+		if err := f.Reserve(unknownFieldsName); err != nil {
+			return err
+		}
+	}
+
 	if err := f.DefineStruct(g); err != nil {
 		return err
 	}
@@ -97,12 +188,26 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
-	if err := f.Encode(g); err != nil {
-		return err
+	if f.PprofLabels {
+		if err := f.pprofFromWire(g); err != nil {
+			return err
+		}
 	}
 
-	if err := f.Decode(g); err != nil {
-		return err
+	if !checkNoStreaming(g) {
+		if err := f.Encode(g); err != nil {
+			return err
+		}
+
+		if err := f.Decode(g); err != nil {
+			return err
+		}
+
+		if f.PprofLabels {
+			if err := f.pprofDecode(g); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := f.String(g); err != nil {
@@ -119,12 +224,30 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
+	if err := f.Copy(g); err != nil {
+		return err
+	}
+
+	if err := f.Hash(g); err != nil {
+		return err
+	}
+
+	if err := f.Reset(g); err != nil {
+		return err
+	}
+
 	if !checkNoZap(g) {
 		if err := f.Zap(g); err != nil {
 			return err
 		}
 	}
 
+	if !checkTinyGo(g) {
+		if err := f.JSON(g); err != nil {
+			return err
+		}
+	}
+
 	return f.Accessors(g)
 }
 
@@ -132,21 +255,35 @@ func (f fieldGroupGenerator) DefineStruct(g Generator) error {
 	return g.DeclareFromTemplate(
 		`<formatDoc .Doc>type <.Name> struct {
 			<range .Fields>
-				<- if .Required ->
-					<formatDoc .Doc><declFieldName .> <typeReference .Type> <tag .>
+				<- if or .Required ($.Presence.Has .) ->
+					<formatDoc (deprecatedDoc .Doc .Annotations)><declFieldName .> <typeReference .Type> <tag .>
 				<- else ->
-					<formatDoc .Doc><declFieldName .> <typeReferencePtr .Type> <tag .>
+					<formatDoc (deprecatedDoc .Doc .Annotations)><declFieldName .> <typeReferencePtr .Type> <tag .>
 				<- end>
 			<end>
+			<- if .PreserveUnknownFields>
+
+				unknownFields <import "go.uber.org/thriftrw/protocol/binary">.UnknownFields
+			<- end>
+			<- if .Presence>
+
+				presence [<.Presence.Words>]uint64
+			<- end>
 		}`,
 		f,
-		TemplateFunc("tag", generateTags),
+		TemplateFunc("tag", f.generateTags),
 		TemplateFunc("declFieldName", f.declFieldName),
 	)
 }
 
-// generateTags parses the annotation on the thrift field and creates the resulting go tag
-func generateTags(f *compile.FieldSpec) (string, error) {
+// generateTags parses the annotations on the thrift field and creates the
+// resulting go tag.
+//
+// Tags are combined from, in increasing order of precedence, the default JSON
+// tag, the tag templates of the field group, the go.tag annotation, and
+// go.tag.<key> annotations. With YAMLTags, a yaml tag mirroring the resulting
+// JSON tag is added if none of these specified one.
+func (f fieldGroupGenerator) generateTags(field *compile.FieldSpec) (string, error) {
 	tags, err := structtag.Parse("") // no tags
 	if err != nil {
 		return "", fmt.Errorf("failed to parse tag: %v", err)
@@ -154,31 +291,141 @@ func generateTags(f *compile.FieldSpec) (string, error) {
 
 	// Default to the field name or label as the name used in the JSON
 	// representation.
-	if err := tags.Set(compileJSONTag(f, entityLabel(f))); err != nil {
+	if err := tags.Set(compileJSONTag(field, entityLabel(field))); err != nil {
 		return "", fmt.Errorf("failed to set tag: %v", err)
 	}
 
+	for _, tmpl := range f.TagTemplates {
+		tag, err := executeTagTemplate(tmpl, field)
+		if err != nil {
+			return "", err
+		}
+		if err := setTags(tags, field, tag, true /* skipEmpty */); err != nil {
+			return "", err
+		}
+	}
+
 	// Process go.tags and overwrite JSON tag if specified in Thrift
 	// annotation.
-	if goAnnotation := f.Annotations[goTagKey]; goAnnotation != "" {
-		goTags, err := structtag.Parse(goAnnotation)
-		if err != nil {
-			return "", fmt.Errorf("failed to parse tags %q: %v", goAnnotation, err)
+	if goAnnotation := field.Annotations[goTagKey]; goAnnotation != "" {
+		if err := setTags(tags, field, goAnnotation, false /* skipEmpty */); err != nil {
+			return "", err
 		}
+	}
 
-		for _, t := range goTags.Tags() {
-			if t.Key == jsonTagKey {
-				t = compileJSONTag(f, t.Name, t.Options...)
-			}
-			if err := tags.Set(t); err != nil {
-				return "", fmt.Errorf("failed to set tag: %v", err)
-			}
+	for _, name := range sortStringKeys(field.Annotations) {
+		key := strings.TrimPrefix(name, goTagPrefix)
+		if key == name {
+			continue
+		}
+		if key == "" || strings.ContainsAny(key, " \t:\"") {
+			return "", fmt.Errorf("invalid tag key %q in annotation %q", key, name)
+		}
+
+		tag := fmt.Sprintf("%s:%q", key, field.Annotations[name])
+		if err := setTags(tags, field, tag, false /* skipEmpty */); err != nil {
+			return "", err
+		}
+	}
+
+	if f.YAMLTags {
+		if err := setYAMLTag(tags); err != nil {
+			return "", err
 		}
 	}
 
 	return fmt.Sprintf("`%s`", tags.String()), nil
 }
 
+// setTags parses the given struct tag and sets all tags in it, overwriting
+// existing tags with the same keys. If skipEmpty is set, tags with empty
+// values are ignored.
+func setTags(tags *structtag.Tags, field *compile.FieldSpec, tag string, skipEmpty bool) error {
+	parsed, err := structtag.Parse(tag)
+	if err != nil {
+		return fmt.Errorf("failed to parse tags %q: %v", tag, err)
+	}
+
+	for _, t := range parsed.Tags() {
+		if skipEmpty && t.Name == "" && len(t.Options) == 0 {
+			continue
+		}
+		if t.Key == jsonTagKey {
+			t = compileJSONTag(field, t.Name, t.Options...)
+		}
+		if err := tags.Set(t); err != nil {
+			return fmt.Errorf("failed to set tag: %v", err)
+		}
+	}
+	return nil
+}
+
+// setYAMLTag adds a yaml tag with the same name as the json tag, omitting
+// the field if it is empty whenever the json tag does. Tags which already
+// have a yaml tag are left as-is.
+func setYAMLTag(tags *structtag.Tags) error {
+	if _, err := tags.Get(yamlTagKey); err == nil {
+		return nil
+	}
+
+	j, err := tags.Get(jsonTagKey)
+	if err != nil {
+		return nil // no json tag to mirror
+	}
+
+	t := &structtag.Tag{Key: yamlTagKey, Name: j.Name}
+	if j.Name != "-" && j.HasOption(omitempty) {
+		t.Options = []string{omitempty}
+	}
+	if err := tags.Set(t); err != nil {
+		return fmt.Errorf("failed to set tag: %v", err)
+	}
+	return nil
+}
+
+// fieldTagData is the data available to tag templates.
+type fieldTagData struct {
+	Name       string // Name of the generated Go field
+	ThriftName string // Name of the field in the Thrift file
+	Label      string // Label of the field, used in its JSON representation
+	ID         int16
+	Required   bool
+}
+
+// parseTagTemplates parses the given tag templates. source describes where
+// the templates came from.
+func parseTagTemplates(source string, texts ...string) ([]*template.Template, error) {
+	tmpls := make([]*template.Template, 0, len(texts))
+	for _, text := range texts {
+		tmpl, err := template.New(source).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag template %q in %v: %v", text, source, err)
+		}
+		tmpls = append(tmpls, tmpl)
+	}
+	return tmpls, nil
+}
+
+func executeTagTemplate(tmpl *template.Template, field *compile.FieldSpec) (string, error) {
+	name, err := goName(field)
+	if err != nil {
+		return "", err
+	}
+
+	var buff bytes.Buffer
+	err = tmpl.Execute(&buff, fieldTagData{
+		Name:       name,
+		ThriftName: field.Name,
+		Label:      entityLabel(field),
+		ID:         field.ID,
+		Required:   field.Required,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to execute tag template from %v: %v", tmpl.Name(), err)
+	}
+	return buff.String(), nil
+}
+
 func compileJSONTag(f *compile.FieldSpec, name string, opts ...string) *structtag.Tag {
 	t := &structtag.Tag{
 		Key:     jsonTagKey,
@@ -278,13 +525,19 @@ func (f fieldGroupGenerator) DefineDefaultConstructor(g Generator) error {
 			var v <.Name>
 			<- range .Fields ->
 				<- $fname := goName . ->
-				<- if isNotNil .Default>
+				<- if and (isNotNil .Default) ($.Presence.Has .)>
+					<$v>.<$fname> = <constantValue .Default .Type>
+					<$.Presence.Set $v .>
+				<- else if isNotNil .Default>
 					<$v>.<$fname> = <constantValuePtr .Default .Type>
 				<- end ->
 			<end>
 			return &v
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+	)
 }
 
 func (f fieldGroupGenerator) ToWire(g Generator) error {
@@ -322,24 +575,35 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 				<- end>
 			)
 
+			<if .AggregateErrors ->
+				if err := <import "go.uber.org/thriftrw/validate">.Collect(<$v>); err != nil {
+					return <$wire>.Value{}, err
+				}
+
+			<end ->
+
 			<$structName := .Name>
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
+				<- $f := $.Presence.Ref $v . ->
 				<- if .Required ->
 					<- if and (not (isPrimitiveType .Type)) (not (isListType .Type)) ->
 						if <$f> == nil {
-							return <$wVal>, <import "errors">.New("field <$fname> of <$structName> is required")
+							return <$wVal>, <requiredFieldError $structName $fname>
 						}
 					<- end>
 						<$wVal>, err = <toWire .Type $f>
 						if err != nil {
 							return <$wVal>, err
 						}
+						<- sealWire . $wVal>
 						<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 						<$i>++
 				<- else ->
-					<- if isNotNil .Default ->
+					<- if omitDefault . ->
+						if <$f> != nil && !<isDefault . $f> {
+							<$wVal>, err = <toWirePtr .Type $f>
+					<- else if isNotNil .Default ->
 						<- $fval := printf "%s%s" $v $fname ->
 						<$fval> := <$f>
 						if <$fval> == nil {
@@ -354,6 +618,7 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 							if err != nil {
 								return <$wVal>, err
 							}
+							<- sealWire . $wVal>
 							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 							<$i>++
 						}
@@ -373,9 +638,24 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 				<end>
 			<end>
 
-			return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$fields>[:<$i>]}), nil
+			<if .PreserveUnknownFields ->
+				<- $all := newVar "all" ->
+				<$all>, err := <$v>.unknownFields.ToWire(<$fields>[:<$i>])
+				if err != nil {
+					return <$wire>.Value{}, err
+				}
+				return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$all>}), nil
+			<- else ->
+				return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$fields>[:<$i>]}), nil
+			<- end>
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("omitDefault", f.omitDefault),
+		TemplateFunc("isDefault", isDefault),
+		TemplateFunc("sealWire", curryGenerator(sealWire, g)),
+		TemplateFunc("requiredFieldError", curryGenerator(requiredFieldError, g)),
+	)
 }
 
 func (f fieldGroupGenerator) FromWire(g Generator) error {
@@ -385,7 +665,7 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 
 		<$v := newVar "v">
 		<$w := newVar "w">
-		// FromWire deserializes a <.Name> struct from its Thrift-level
+		// <if .PprofLabels>fromWire<else>FromWire<end> deserializes a <.Name> struct from its Thrift-level
 		// representation. The Thrift-level representation may be obtained
 		// from a ThriftRW protocol implementation.
 		//
@@ -402,25 +682,29 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 		//     return nil, err
 		//   }
 		//   return &<$v>, nil
-		func (<$v> *<.Name>) FromWire(<$w> <$wire>.Value) error {
+		func (<$v> *<.Name>) <if .PprofLabels>fromWire<else>FromWire<end>(<$w> <$wire>.Value) error {
 			<if len .Fields> var err error <end>
 			<$f := newVar "field">
 
 			<$isSet := newNamespace>
 			<range .Fields>
-				<- if .Required ->
+				<- if checkRequired . ->
 					<$isSet.NewName (printf "%sIsSet" .Name)> := false
 				<- end>
 			<end>
 
+			<if .PreserveUnknownFields ->
+				<$v>.unknownFields = nil
+			<end ->
 			for _, <$f> := range <$w>.GetStruct().Fields {
 				switch <$f>.ID {
 				<range .Fields ->
 				case <.ID>:
-					if <$f>.Value.Type() == <typeCode .Type> {
+					if <$f>.Value.Type() == <fieldTypeCode .> {
+						<- openWire . $f>
 						<- $lhs := printf "%s.%s" $v (goName .) ->
 						<- $value := printf "%s.Value" $f ->
-						<- if .Required ->
+						<- if or .Required ($.Presence.Has .) ->
 							<$lhs>, err = <fromWire .Type $value>
 						<- else ->
 							<fromWirePtr .Type $lhs $value>
@@ -428,10 +712,22 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 						if err != nil {
 							return err
 						}
-						<if .Required ->
+						<if checkRequired . ->
 							<$isSet.Rotate (printf "%sIsSet" .Name)> = true
+						<- else if $.Presence.Has . ->
+							<$.Presence.Set $v .>
 						<- end>
 					}
+					<- if $.PreserveUnknownFields> else if err := <$v>.unknownFields.FromWire(<$f>); err != nil {
+						return err
+					}
+					<- end>
+				<end ->
+				<- if .PreserveUnknownFields ->
+				default:
+					if err := <$v>.unknownFields.FromWire(<$f>); err != nil {
+						return err
+					}
 				<end ->
 				}
 			}
@@ -440,14 +736,19 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 			<range .Fields>
 				<$fname := goName .>
 				<$f := printf "%s.%s" $v $fname>
-				<if isNotNil .Default>
+				<if and (isNotNil .Default) ($.Presence.Has .)>
+					if <$.Presence.IsUnset $v .> {
+						<$f> = <constantValue .Default .Type>
+						<$.Presence.Set $v .>
+					}
+				<else if isNotNil .Default>
 					if <$f> == nil {
 						<$f> = <constantValuePtr .Default .Type>
 					}
 				<else>
-					<if .Required>
+					<if checkRequired .>
 						if !<$isSet.Rotate (printf "%sIsSet" .Name)> {
-							return <import "errors">.New("field <$fname> of <$structName> is required")
+							<missingRequired $structName $fname>
 						}
 					<end>
 				<end>
@@ -458,7 +759,7 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 				<$count := newVar "count">
 				<$count> := 0
 				<range .Fields ->
-					if <$v>.<goName .> != nil {
+					if <$.Presence.Ref $v .> != nil {
 						<$count>++
 					}
 				<end>
@@ -474,7 +775,14 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 			<end>
 			return nil
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("fieldTypeCode", curryGenerator(fieldTypeCode, g)),
+		TemplateFunc("openWire", curryGenerator(openWire, g)),
+		TemplateFunc("checkRequired", curryGenerator(checkRequired, g)),
+		TemplateFunc("missingRequired", curryGenerator(missingRequired, g)),
+	)
 }
 
 func (f fieldGroupGenerator) Encode(g Generator) error {
@@ -488,7 +796,25 @@ func (f fieldGroupGenerator) Encode(g Generator) error {
 		// through an intermediary type.
 		//
 		// An error is returned if a <.Name> struct could not be encoded.
+		<- if .DualEncode>
+		//
+		// This also encodes the struct with ToWire and reports differences
+		// between the two to the dualencode package.
 		func (<$v> *<.Name>) Encode(<$sw> <$stream>.Writer) error {
+			return <import "go.uber.org/thriftrw/dualencode">.Encode(<$sw>, "<.Name>", <$v>.ToWire, <$v>.encode)
+		}
+
+		// encode serializes a <.Name> struct directly into bytes.
+		func (<$v> *<.Name>) encode(<$sw> <$stream>.Writer) error {
+		<- else>
+		func (<$v> *<.Name>) Encode(<$sw> <$stream>.Writer) error {
+		<- end>
+			<if .AggregateErrors ->
+				if err := <import "go.uber.org/thriftrw/validate">.Collect(<$v>); err != nil {
+					return err
+				}
+
+			<end ->
 			if err := <$sw>.WriteStructBegin(); err != nil {
 				return err
 			}
@@ -496,25 +822,33 @@ func (f fieldGroupGenerator) Encode(g Generator) error {
 			<$structName := .Name>
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
-				<$t := typeCode .Type>
+				<- $f := $.Presence.Ref $v . ->
+				<$t := fieldTypeCode .>
 				<- if .Required ->
 					<- if and (not (isPrimitiveType .Type)) (not (isListType .Type)) ->
 						if <$f> == nil {
-							return <import "errors">.New("field <$fname> of <$structName> is required")
+							return <requiredFieldError $structName $fname>
 						}
 					<- end>
 						if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <$t>,}); err != nil {
 							return err
 						}
-						if err := <encode .Type $f $sw>; err != nil {
+						if err := <encodeField . $f $sw false>; err != nil {
 							return err
 						}
 						if err := <$sw>.WriteFieldEnd(); err != nil {
 							return err
 						}
 				<- else ->
-					<- if isNotNil .Default ->
+					<- if omitDefault . ->
+						if <$f> != nil && !<isDefault . $f> {
+							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <$t>,}); err != nil {
+								return err
+							}
+							if err := <encodeField . $f $sw true>; err != nil {
+								return err
+							}
+					<- else if isNotNil .Default ->
 						<- $fval := printf "%s%s" $v $fname ->
 						<$fval> := <$f>
 						if <$fval> == nil {
@@ -524,7 +858,7 @@ func (f fieldGroupGenerator) Encode(g Generator) error {
 							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <$t>,}); err != nil {
 								return err
 							}
-							if err := <encodePtr .Type $fval $sw>; err != nil {
+							if err := <encodeField . $fval $sw true>; err != nil {
 								return err
 							}
 					<- else ->
@@ -532,7 +866,7 @@ func (f fieldGroupGenerator) Encode(g Generator) error {
 							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <$t>,}); err != nil {
 								return err
 							}
-							if err := <encodePtr .Type $f $sw>; err != nil {
+							if err := <encodeField . $f $sw true>; err != nil {
 								return err
 							}
 					<- end>
@@ -549,7 +883,7 @@ func (f fieldGroupGenerator) Encode(g Generator) error {
 				<$count := newVar "count">
 				<$count> := 0
 				<range .Fields ->
-					if <$v>.<goName .> != nil {
+					if <$.Presence.Ref $v .> != nil {
 						<$count>++
 					}
 				<end>
@@ -564,9 +898,22 @@ func (f fieldGroupGenerator) Encode(g Generator) error {
 				<end>
 			<end>
 
+			<if .PreserveUnknownFields ->
+				if err := <$v>.unknownFields.Encode(<$sw>); err != nil {
+					return err
+				}
+
+			<end ->
 			return <$sw>.WriteStructEnd()
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("omitDefault", f.omitDefault),
+		TemplateFunc("isDefault", isDefault),
+		TemplateFunc("fieldTypeCode", curryGenerator(fieldTypeCode, g)),
+		TemplateFunc("encodeField", curryGenerator(encodeField, g)),
+		TemplateFunc("requiredFieldError", curryGenerator(requiredFieldError, g)),
+	)
 }
 
 func (f fieldGroupGenerator) Decode(g Generator) error {
@@ -576,15 +923,15 @@ func (f fieldGroupGenerator) Decode(g Generator) error {
 
 		<$sr := newVar "sr">
 		<$v := newVar "v">
-		// Decode deserializes a <.Name> struct directly from its Thrift-level
+		// <if .PprofLabels>decode<else>Decode<end> deserializes a <.Name> struct directly from its Thrift-level
 		// representation, without going through an intemediary type.
 		//
 		// An error is returned if a <.Name> struct could not be generated from the wire
 		// representation.
-		func (<$v> *<.Name>) Decode(<$sr> <$stream>.Reader) error {
+		func (<$v> *<.Name>) <if .PprofLabels>decode<else>Decode<end>(<$sr> <$stream>.Reader) error {
 			<$isSet := newNamespace>
 			<range .Fields>
-				<- if .Required ->
+				<- if checkRequired . ->
 					<$isSet.NewName (printf "%sIsSet" .Name)> := false
 				<- end>
 			<end>
@@ -593,6 +940,10 @@ func (f fieldGroupGenerator) Decode(g Generator) error {
 				return err
 			}
 
+			<if .PreserveUnknownFields ->
+				<$v>.unknownFields = nil
+
+			<end ->
 			<$fh := newVar "fh">
 			<$ok := newVar "ok">
 			<$fh>, <$ok>, err := <$sr>.ReadFieldBegin()
@@ -603,24 +954,28 @@ func (f fieldGroupGenerator) Decode(g Generator) error {
 			for <$ok> {
 				switch {
 				<range .Fields ->
-				case <$fh>.ID == <.ID> && <$fh>.Type == <typeCode .Type>:
-						<- $lhs := printf "%s.%s" $v (goName .) ->
-						<- if .Required ->
-							<$lhs>, err = <decode .Type $sr>
-						<- else ->
-							<decodePtr .Type $lhs $sr>
-						<- end>
+				case <$fh>.ID == <.ID> && <$fh>.Type == <fieldTypeCode .>:
+						<- $lhs := printf "%s.%s" $v (goName .)>
+						<decodeField . $lhs $sr (not (or .Required ($.Presence.Has .)))>
 						if err != nil {
 							return err
 						}
-						<if .Required ->
+						<if checkRequired . ->
 							<$isSet.Rotate (printf "%sIsSet" .Name)> = true
+						<- else if $.Presence.Has . ->
+							<$.Presence.Set $v .>
 						<- end>
 				<end ->
 				default:
+					<- if .PreserveUnknownFields>
+					if err := <$v>.unknownFields.Decode(<$fh>, <$sr>); err != nil {
+						return err
+					}
+					<- else>
 					if err := <$sr>.Skip(<$fh>.Type); err != nil {
 						return err
 					}
+					<- end>
 				}
 
 				if err := <$sr>.ReadFieldEnd(); err != nil {
@@ -640,14 +995,19 @@ func (f fieldGroupGenerator) Decode(g Generator) error {
 			<range .Fields>
 				<$fname := goName .>
 				<$f := printf "%s.%s" $v $fname>
-				<if isNotNil .Default>
+				<if and (isNotNil .Default) ($.Presence.Has .)>
+					if <$.Presence.IsUnset $v .> {
+						<$f> = <constantValue .Default .Type>
+						<$.Presence.Set $v .>
+					}
+				<else if isNotNil .Default>
 					if <$f> == nil {
 						<$f> = <constantValuePtr .Default .Type>
 					}
 				<else>
-					<if .Required>
+					<if checkRequired .>
 						if !<$isSet.Rotate (printf "%sIsSet" .Name)> {
-							return <import "errors">.New("field <$fname> of <$structName> is required")
+							<missingRequired $structName $fname>
 						}
 					<end>
 				<end>
@@ -658,7 +1018,7 @@ func (f fieldGroupGenerator) Decode(g Generator) error {
 				<$count := newVar "count">
 				<$count> := 0
 				<range .Fields ->
-					if <$v>.<goName .> != nil {
+					if <$.Presence.Ref $v .> != nil {
 						<$count>++
 					}
 				<end>
@@ -674,7 +1034,14 @@ func (f fieldGroupGenerator) Decode(g Generator) error {
 			<end>
 			return nil
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("fieldTypeCode", curryGenerator(fieldTypeCode, g)),
+		TemplateFunc("decodeField", curryGenerator(decodeField, g)),
+		TemplateFunc("checkRequired", curryGenerator(checkRequired, g)),
+		TemplateFunc("missingRequired", curryGenerator(missingRequired, g)),
+	)
 }
 
 func (f fieldGroupGenerator) String(g Generator) error {
@@ -698,17 +1065,22 @@ func (f fieldGroupGenerator) String(g Generator) error {
 			<$i> := 0
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
+				<- $f := $.Presence.Ref $v . ->
 
 				<- if not .Required ->
 					if <$f> != nil {
-						<if isPrimitiveType .Type ->
+						<if isRedacted . ->
+							<$fields>[<$i>] = "<$fname>: <redactedValue>"
+						<- else if isPrimitiveType .Type ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", *(<$f>))
 						<- else ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
 						<- end>
 						<$i>++
 					}
+				<- else if isRedacted . ->
+					<$fields>[<$i>] = "<$fname>: <redactedValue>"
+					<$i>++
 				<- else ->
 					<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
 					<$i>++
@@ -717,7 +1089,10 @@ func (f fieldGroupGenerator) String(g Generator) error {
 
 			return <$fmt>.Sprintf("<.Name>{%v}", <$strings>.Join(<$fields>[:<$i>], ", "))
 		}
-		`, f)
+		`, f,
+		TemplateFunc("isRedacted", isRedacted),
+		TemplateFunc("redactedValue", func() string { return redactedValue }),
+	)
 }
 
 func (f fieldGroupGenerator) ErrorName(g Generator) error {
@@ -748,8 +1123,8 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 			}
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $lhsField := printf "%s.%s" $v $fname ->
-				<- $rhsField := printf "%s.%s" $rhs $fname ->
+				<- $lhsField := $.Presence.Ref $v . ->
+				<- $rhsField := $.Presence.Ref $rhs . ->
 
 				<- if .Required ->
 					if !<equals .Type $lhsField $rhsField> {
@@ -766,6 +1141,143 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 		`, f)
 }
 
+func (f fieldGroupGenerator) Copy(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$o := newVar "o">
+		// Copy returns a deep copy of this <.Name>.
+		func (<$v> *<.Name>) Copy() *<.Name> {
+			if <$v> == nil {
+				return nil
+			}
+
+			var <$o> <.Name>
+			<range .Fields>
+				<- $fname := goName . ->
+				<- $f := printf "%s.%s" $v $fname ->
+				<- if or .Required ($.Presence.Has .) ->
+					<$o>.<$fname> = <copy .Type $f>
+				<- else ->
+					<$o>.<$fname> = <copyPtr .Type $f>
+				<- end>
+			<end ->
+			<if .PreserveUnknownFields ->
+				<$o>.unknownFields = <$v>.unknownFields.Copy()
+			<end ->
+			<if .Presence ->
+				<$o>.presence = <$v>.presence
+			<end ->
+			return &<$o>
+		}
+		`, f)
+}
+
+func (f fieldGroupGenerator) Hash(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+		<$v := newVar "v">
+		<$h := newVar "h">
+		// Hash returns a hash of this <.Name> which is stable across
+		// processes. Values which are equal per Equals have the same hash.
+		func (<$v> *<.Name>) Hash() uint64 {
+			if <$v> == nil {
+				return 0
+			}
+
+			<$h> := <$thrifthash>.New()
+			<range .Fields>
+				<- $f := $.Presence.Ref $v . ->
+				<- if or .Required (not (isPrimitiveType .Type)) ->
+					<$h>.Field(<.ID>)
+					<hash .Type $h $f>
+				<- else ->
+					if <$f> != nil {
+						<$h>.Field(<.ID>)
+						<hashPtr .Type $h $f>
+					}
+				<- end>
+			<end ->
+			return <$h>.Sum64()
+		}
+		`, f)
+}
+
+func (f fieldGroupGenerator) Reset(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$k := newVar "k">
+		// Reset zeroes all fields of this <.Name> so that it may be reused.
+		<- if .HasReusableFields>
+		//
+		// Required lists, sets, maps, and binary fields are emptied rather
+		// than released so that their capacity may be reused.
+		<- end>
+		func (<$v> *<.Name>) Reset() {
+			<range .Fields ->
+				<- if and .Required (eq (reuseKind .Type) "map") ->
+					for <$k> := range <$v>.<goName .> {
+						delete(<$v>.<goName .>, <$k>)
+					}
+				<end ->
+			<- end ->
+			*<$v> = <.Name>{
+				<- range .Fields>
+					<- if .Required>
+						<- $f := printf "%s.%s" $v (goName .)>
+						<- if eq (reuseKind .Type) "slice">
+							<goName .>: <$f>[:0],
+						<- else if eq (reuseKind .Type) "map">
+							<goName .>: <$f>,
+						<- end>
+					<- end>
+				<- end>
+			}
+		}
+		`, f, TemplateFunc("reuseKind", reuseKind))
+}
+
+// HasReusableFields returns true if Reset retains the backing storage of
+// any of the fields of this group.
+func (f fieldGroupGenerator) HasReusableFields() bool {
+	for _, field := range f.Fields {
+		if field.Required && reuseKind(field.Type) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// reuseKind returns "slice" or "map" if values of the given type are
+// represented as Go slices or maps whose storage Reset may reuse, and an
+// empty string otherwise.
+func reuseKind(spec compile.TypeSpec) string {
+	if isBoundTypedef(spec) {
+		return ""
+	}
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BinarySpec, *compile.ListSpec:
+		return "slice"
+	case *compile.SetSpec:
+		if setUsesMap(s) {
+			return "map"
+		}
+		return "slice"
+	case *compile.MapSpec:
+		if isMapContainer(s) {
+			return ""
+		}
+		if mapUsesMap(s) {
+			return "map"
+		}
+		return "slice"
+	default:
+		return ""
+	}
+}
+
 func (f fieldGroupGenerator) Zap(g Generator) error {
 	return g.DeclareFromTemplate(
 		`
@@ -781,8 +1293,16 @@ func (f fieldGroupGenerator) Zap(g Generator) error {
 			}
 			<range .Fields>
 				<- if not (zapOptOut .) ->
-					<- $fval := printf "%s.%s" $v (goName .) ->
-					<- if .Required ->
+					<- $fval := $.Presence.Ref $v . ->
+					<- if isRedacted . ->
+						<- if .Required ->
+							<$enc>.AddString("<fieldLabel .>", "<redactedValue>")
+						<- else ->
+							if <$fval> != nil {
+								<$enc>.AddString("<fieldLabel .>", "<redactedValue>")
+							}
+						<- end>
+					<- else if .Required ->
 						<zapEncodeBegin .Type ->
 							<$enc>.Add<zapEncoder .Type>("<fieldLabel .>", <zapMarshaler .Type $fval>)
 						<- zapEncodeEnd .Type>
@@ -800,6 +1320,8 @@ func (f fieldGroupGenerator) Zap(g Generator) error {
 		`, f,
 		TemplateFunc("zapOptOut", zapOptOut),
 		TemplateFunc("fieldLabel", entityLabel),
+		TemplateFunc("isRedacted", isRedacted),
+		TemplateFunc("redactedValue", func() string { return redactedValue }),
 	)
 }
 
@@ -811,7 +1333,9 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 		`
 		<$v := newVar "v">
 		<$o := newVar "o">
+		<$x := newVar "x">
 		<$name := .Name>
+		<$setters := .Setters>
 
 		<range .Fields>
 			<$fname := goName .>
@@ -820,12 +1344,22 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 			<reserveFieldOrMethod (printf "Get%v" $fname)>
 			// Get<$fname> returns the value of <$fname> if it is set or its
 			// <if isNotNil .Default>default<else>zero<end> value if it is unset.
+			<- with deprecation .Annotations>
+			//
+			// <.>
+			<- end>
 			func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
 				<- if .Required ->
 				  if <$v> != nil {
 				    <$o> = <$v>.<$fname>
 				  }
 				  return
+				<- else if $.Presence.Has . ->
+				  if <$v> != nil && <$.Presence.IsSet $v .> {
+				    return <$v>.<$fname>
+				  }
+				  <if isNotNil .Default><$o> = <constantValue .Default .Type><end>
+				  return
 				<- else ->
 				  if <$v> != nil && <$v>.<$fname> != nil {
 					<- if and (not .Required) (isPrimitiveType .Type) ->
@@ -841,19 +1375,113 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 
 			<if shouldGenerateIsSet .>
 				<reserveFieldOrMethod (printf "IsSet%v" $fname)>
+				<- if $.Presence.Has .>
+				// IsSet<$fname> returns true if <$fname> is set.
+				func (<$v> *<$name>) IsSet<$fname>() bool {
+					return <$v> != nil && <$.Presence.IsSet $v .>
+				}
+				<- else>
 				// IsSet<$fname> returns true if <$fname> is not nil.
 				func (<$v> *<$name>) IsSet<$fname>() bool {
 					return <$v> != nil && <$v>.<$fname> != nil
 				}
+				<- end>
+			<end>
+
+			<if $.Presence.Has .>
+				<reserveFieldOrMethod (printf "Has%v" $fname)>
+				// Has<$fname> returns true if <$fname> is set.
+				func (<$v> *<$name>) Has<$fname>() bool {
+					return <$v> != nil && <$.Presence.IsSet $v .>
+				}
+
+				<reserveFieldOrMethod (printf "Clear%v" $fname)>
+				// Clear<$fname> unsets <$fname>.
+				func (<$v> *<$name>) Clear<$fname>() {
+					var <$x> <typeReference .Type>
+					<$v>.<$fname> = <$x>
+					<$.Presence.Clear $v .>
+				}
+
+				// ref<$fname> returns a pointer to <$fname> if it is set, and
+				// nil otherwise.
+				func (<$v> *<$name>) ref<$fname>() *<typeReference .Type> {
+					if <$.Presence.IsSet $v .> {
+						return &<$v>.<$fname>
+					}
+					return nil
+				}
 			<end>
+
+			<if $.UnionAccessors>
+				<reserveFieldOrMethod (printf "Lookup%v" $fname)>
+				// Lookup<$fname> returns the value of <$fname> and true if it is
+				// set, or its zero value and false if another field is set.
+				func (<$v> *<$name>) Lookup<$fname>() (<$o> <typeReference .Type>, _ bool) {
+					<- if $.Presence.Has .>
+					if <$v> != nil && <$.Presence.IsSet $v .> {
+						return <$v>.<$fname>, true
+					}
+					<- else if isPrimitiveType .Type>
+					if <$v> != nil && <$v>.<$fname> != nil {
+						return *<$v>.<$fname>, true
+					}
+					<- else>
+					if <$v> != nil && <$v>.<$fname> != nil {
+						return <$v>.<$fname>, true
+					}
+					<- end>
+					return <$o>, false
+				}
+			<end>
+
+			<if or $setters ($.Presence.Has .) $.UnionAccessors>
+				<reserveFieldOrMethod (printf "Set%v" $fname)>
+				<- if $.UnionAccessors>
+				// Set<$fname> sets the value of <$fname> and unsets all other
+				// fields of this <$name>.
+				<- else>
+				// Set<$fname> sets the value of <$fname>.
+				<- end>
+				func (<$v> *<$name>) Set<$fname>(<$x> <typeReference .Type>) {
+					<- if $.UnionAccessors>
+					*<$v> = <$name>{}
+					<- end>
+					<- if $.Presence.Has .>
+					<$v>.<$fname> = <$x>
+					<$.Presence.Set $v .>
+					<- else if and (not .Required) (isPrimitiveType .Type)>
+					<$v>.<$fname> = &<$x>
+					<- else>
+					<$v>.<$fname> = <$x>
+					<- end>
+				}
+			<end>
+		<end>
+
+		<if .UnionAccessors>
+			<reserveFieldOrMethod "Which">
+			// Which returns the ID of the field of this <$name> which is set, or
+			// 0 if no field is set.
+			func (<$v> *<$name>) Which() int16 {
+				if <$v> == nil {
+					return 0
+				}
+				<- range .Fields>
+				<- if $.Presence.Has .>
+				if <$.Presence.IsSet $v .> {
+				<- else>
+				if <$v>.<goName .> != nil {
+				<- end>
+					return <.ID>
+				}
+				<- end>
+				return 0
+			}
 		<end>
 		`, f,
 		TemplateFunc("constantValue", ConstantValue),
-		TemplateFunc("shouldGenerateIsSet", func(f *compile.FieldSpec) bool {
-			// Generate IsSet functions for a field only if the field is
-			// optional or the field value itself is nillable.
-			return !f.Required || isReferenceType(f.Type) || isStructType(f.Type)
-		}),
+		TemplateFunc("shouldGenerateIsSet", hasIsSet),
 		TemplateFunc("reserveFieldOrMethod", func(name string) (string, error) {
 			// we return an empty string for the sake of the templating system
 			err := fieldsAndMethods.Reserve(name)
@@ -862,6 +1490,49 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 	)
 }
 
+// hasIsSet returns true if an IsSet accessor is generated for the given
+// field. This is the case only if the field is optional or the field value
+// itself is nillable.
+func hasIsSet(f *compile.FieldSpec) bool {
+	return !f.Required || isReferenceType(f.Type) || isStructType(f.Type)
+}
+
+// omitDefault returns true if the given field should be left off the wire
+// when it is set to its default value.
+func (f fieldGroupGenerator) omitDefault(field *compile.FieldSpec) (bool, error) {
+	if field.Required || field.Default == nil {
+		return false, nil
+	}
+
+	v, ok := field.Annotations[omitDefaultKey]
+	if !ok {
+		return f.OmitDefaults, nil
+	}
+
+	omit, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf(
+			"invalid %v annotation on field %q: %q is not a boolean", omitDefaultKey, field.Name, v)
+	}
+	return omit, nil
+}
+
+// isDefault generates an expression of type bool which reports whether the
+// given non-nil reference to the value of an optional field matches the
+// default value of that field.
+func isDefault(g Generator, field *compile.FieldSpec, ref string) (string, error) {
+	def, err := ConstantValue(g, field.Default, field.Type)
+	if err != nil {
+		return "", err
+	}
+
+	if isPrimitiveType(field.Type) {
+		ref = "(*" + ref + ")"
+	}
+	var e equalsGenerator
+	return e.Equals(g, field.Type, ref, def)
+}
+
 func verifyUniqueFieldLabels(fs compile.FieldGroup) error {
 	used := make(map[string]*compile.FieldSpec, len(fs))
 	for _, f := range fs {
@@ -22,10 +22,12 @@ package gen
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
 	"go.uber.org/thriftrw/compile"
+	tft "go.uber.org/thriftrw/gen/internal/tests/field-tags"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -137,3 +139,140 @@ func TestCompileJSONTag(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateTags(t *testing.T) {
+	tests := []struct {
+		desc        string
+		templates   []string
+		required    bool
+		annotations compile.Annotations
+		want        string
+		wantErr     string
+	}{
+		{
+			desc: "default",
+			want: "`json:\"userName,omitempty\"`",
+		},
+		{
+			desc: "tag annotations",
+			annotations: compile.Annotations{
+				"go.tag.validate": "max=64",
+				"go.tag.gorm":     "column:user_name",
+			},
+			want: "`json:\"userName,omitempty\" gorm:\"column:user_name\" validate:\"max=64\"`",
+		},
+		{
+			desc:      "templates",
+			templates: []string{`db:"{{.ThriftName}}" validate:"{{if .Required}}required{{end}}"`},
+			required:  true,
+			want:      "`json:\"userName,required\" db:\"userName\" validate:\"required\"`",
+		},
+		{
+			desc:      "empty template values are dropped",
+			templates: []string{`db:"{{.ThriftName}}" validate:"{{if .Required}}required{{end}}"`},
+			want:      "`json:\"userName,omitempty\" db:\"userName\"`",
+		},
+		{
+			desc: "later templates take precedence",
+			templates: []string{
+				`db:"{{.ThriftName}}" col:"{{.ID}}"`,
+				`db:"{{.Name}}"`,
+			},
+			want: "`json:\"userName,omitempty\" db:\"UserName\" col:\"1\"`",
+		},
+		{
+			desc:      "field annotations take precedence",
+			templates: []string{`db:"{{.ThriftName}}" json:"{{.Label}}_"`},
+			annotations: compile.Annotations{
+				"go.tag":    `db:"pk" xml:"user"`,
+				"go.tag.db": "id",
+			},
+			want: "`json:\"userName_,omitempty\" db:\"id\" xml:\"user\"`",
+		},
+		{
+			desc:        "invalid tag key",
+			annotations: compile.Annotations{"go.tag.": "foo"},
+			wantErr:     `invalid tag key "" in annotation "go.tag."`,
+		},
+		{
+			desc:        "tag key with colon",
+			annotations: compile.Annotations{"go.tag.a:b": "foo"},
+			wantErr:     `invalid tag key "a:b" in annotation "go.tag.a:b"`,
+		},
+		{
+			desc:      "template error",
+			templates: []string{`db:"{{.Missing}}"`},
+			wantErr:   "failed to execute tag template",
+		},
+		{
+			desc:      "template output is not a tag",
+			templates: []string{`{{.ThriftName}}`},
+			wantErr:   `failed to parse tags "userName"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			tmpls, err := parseTagTemplates("test", tt.templates...)
+			require.NoError(t, err)
+
+			fg := fieldGroupGenerator{TagTemplates: tmpls}
+			got, err := fg.generateTags(&compile.FieldSpec{
+				ID:          1,
+				Name:        "userName",
+				Type:        &compile.StringSpec{},
+				Required:    tt.required,
+				Annotations: tt.annotations,
+			})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseTagTemplatesError(t *testing.T) {
+	_, err := parseTagTemplates("--field-tag-template", `db:"{{.Name"`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid tag template "db:\"{{.Name\"" in --field-tag-template`)
+}
+
+func TestFieldTagAnnotations(t *testing.T) {
+	tests := []struct {
+		give interface{}
+		want map[string]reflect.StructTag
+	}{
+		{
+			give: tft.User{},
+			want: map[string]reflect.StructTag{
+				"Name":  `json:"name,required" db:"name" validate:"required,max=64" gorm:"column:user_name"`,
+				"Email": `json:"mail,omitempty" db:"email" xml:"email,attr"`,
+				"Age":   `json:"age,omitempty" db:"age"`,
+			},
+		},
+		{
+			give: tft.Overrides{},
+			want: map[string]reflect.StructTag{
+				"ID":   `json:"id,required" db:"pk"`,
+				"Note": `json:"note,omitempty" db:"-"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		typ := reflect.TypeOf(tt.give)
+		t.Run(typ.Name(), func(t *testing.T) {
+			for name, want := range tt.want {
+				f, ok := typ.FieldByName(name)
+				if assert.True(t, ok, "field %v not found", name) {
+					assert.Equal(t, want, f.Tag, "tag of %v", name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// fuzzTargets generates a Fuzz<Name>RoundTrip function for each struct,
+// union, and exception in the given types, which decodes arbitrary bytes
+// into the type and checks that values which decode successfully encode
+// and decode again to an equal value. It returns false if there was
+// nothing to fuzz.
+//
+// Representative values of the types, where they exist, are added to the
+// seed corpus so that "go test" exercises each target once. Types holding
+// encrypted fields are not seeded since they cannot be encoded without a
+// thriftcrypt.Provider.
+func fuzzTargets(g Generator, types map[string]compile.TypeSpec) (bool, error) {
+	var specs []*compile.StructSpec
+	var seeds []compile.ConstantValue // nil if the type has no finite values
+	var encrypted bool
+	for _, name := range sortStringKeys(types) {
+		spec, ok := types[name].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+		var v compile.ConstantValue
+		if hasEncryptedValues(spec) {
+			encrypted = true
+		} else {
+			v, _ = sampleValue(spec, 0)
+		}
+		specs = append(specs, spec)
+		seeds = append(seeds, v)
+	}
+	if len(specs) == 0 {
+		return false, nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$testing := import "testing">
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		type _fuzzValue interface {
+			ToWire() (<$wire>.Value, error)
+			FromWire(<$wire>.Value) error
+			<- if not (checkNoStreaming)>
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+			Encode(<$stream>.Writer) error
+			Decode(<$stream>.Reader) error
+			<- end>
+			String() string
+		}
+
+		// _fuzzSeed adds the Binary encoding of the given value to the seed
+		// corpus, along with an empty struct.
+		func _fuzzSeed(f *<$testing>.F, give _fuzzValue) {
+			f.Add([]byte{0})
+			if give == nil {
+				return
+			}
+			w, err := give.ToWire()
+			if err != nil {
+				f.Fatal(err)
+			}
+			var buff <$bytes>.Buffer
+			if err := <$binary>.Default.Encode(w, &buff); err != nil {
+				f.Fatal(err)
+			}
+			f.Add(buff.Bytes())
+		}
+
+		// _fuzzRoundTrip decodes data into a value returned by newValue. If
+		// that succeeds, it checks that the value encodes with each
+		// serialization method, and that decoding the result produces a
+		// value equal to it.
+		func _fuzzRoundTrip(
+			t *<$testing>.T,
+			data []byte,
+			newValue func() _fuzzValue,
+			equal func(_fuzzValue, _fuzzValue) bool,
+		) {
+			w, err := <$binary>.Default.Decode(<$bytes>.NewReader(data), <$wire>.TStruct)
+			if err != nil {
+				return
+			}
+			give := newValue()
+			if err := give.FromWire(w); err != nil {
+				return
+			}
+
+			check := func(method string, encoded []byte) {
+				w, err := <$binary>.Default.Decode(<$bytes>.NewReader(encoded), <$wire>.TStruct)
+				if err != nil {
+					t.Fatalf("%v: cannot decode %v: %v", method, give, err)
+				}
+				got := newValue()
+				if err := got.FromWire(w); err != nil {
+					t.Fatalf("%v: cannot decode %v: %v", method, give, err)
+				}
+				// Equals reports values holding NaN as different from
+				// themselves, so values are also compared by their string
+				// representations.
+				if !equal(give, got) && give.String() != got.String() {
+					t.Fatalf("%v: %v changed to %v after a round trip", method, give, got)
+				}
+			}
+
+			w, err = give.ToWire()
+			<- if missingRequiredZero>
+			<- $requiredfield := import "go.uber.org/thriftrw/requiredfield">
+			var missing *<$requiredfield>.MissingError
+			if <import "errors">.As(err, &missing) {
+				// Required fields missing from data are left nil, and
+				// values which hold them cannot be encoded.
+				return
+			}
+			<- end>
+			<- if .Encrypted>
+			if <import "errors">.Is(err, <import "go.uber.org/thriftrw/thriftcrypt">.ErrNoProvider) {
+				// Values with encrypted fields cannot be encoded without a
+				// provider. Those decoded from data only hold the defaults
+				// and zero values of such fields.
+				return
+			}
+			<- end>
+			if err != nil {
+				t.Fatalf("ToWire: cannot encode %v: %v", give, err)
+			}
+			var buff <$bytes>.Buffer
+			if err := <$binary>.Default.Encode(w, &buff); err != nil {
+				t.Fatalf("ToWire: cannot encode %v: %v", give, err)
+			}
+			check("ToWire", buff.Bytes())
+			<- if not (checkNoStreaming)>
+
+			var out <$bytes>.Buffer
+			sw := <$binary>.Default.Writer(&out)
+			if err := give.Encode(sw); err != nil {
+				t.Fatalf("Encode: cannot encode %v: %v", give, err)
+			}
+			if err := sw.Close(); err != nil {
+				t.Fatalf("Encode: cannot encode %v: %v", give, err)
+			}
+			check("Encode", out.Bytes())
+			<- end>
+		}
+
+		<range $i, $spec := .Specs>
+			<$seed := index $.Seeds $i>
+			// Fuzz<goName $spec>RoundTrip decodes arbitrary bytes into a <goName $spec>
+			// and checks that values which decode successfully survive a round trip.
+			func Fuzz<goName $spec>RoundTrip(f *<$testing>.F) {
+				<- if $seed>
+				_fuzzSeed(f, <constantValue $seed $spec>)
+				<- else>
+				_fuzzSeed(f, nil)
+				<- end>
+				f.Fuzz(func(t *<$testing>.T, data []byte) {
+					_fuzzRoundTrip(t, data,
+						func() _fuzzValue { return new(<goName $spec>) },
+						func(a, b _fuzzValue) bool {
+							return a.(*<goName $spec>).Equals(b.(*<goName $spec>))
+						},
+					)
+				})
+			}
+		<end>
+		`,
+		struct {
+			Specs     []*compile.StructSpec
+			Seeds     []compile.ConstantValue
+			Encrypted bool
+		}{Specs: specs, Seeds: seeds, Encrypted: encrypted},
+		TemplateFunc("checkNoStreaming", checkNoStreaming),
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("missingRequiredZero", func() bool {
+			return checkMissingRequired(g) == MissingRequiredZero
+		}),
+	)
+	return true, wrapGenerateError("fuzz targets", err)
+}
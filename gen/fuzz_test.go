@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestFuzzTargetsFiles(t *testing.T) {
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc     string
+		file     string
+		wantFuzz bool
+	}{
+		{desc: "structs", file: "fuzz.thrift", wantFuzz: true},
+		{desc: "no structs", file: "stringdef.thrift", wantFuzz: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m, err := compile.Compile(filepath.Join(thriftRoot, tt.file))
+			require.NoError(t, err)
+
+			outputDir := t.TempDir()
+			require.NoError(t, Generate(m, &Options{
+				OutputDir:     outputDir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+				ThriftRoot:    thriftRoot,
+				NoRecurse:     true,
+				FuzzTargets:   true,
+			}))
+
+			name := tt.file[:len(tt.file)-len(".thrift")]
+			_, err = os.Stat(filepath.Join(outputDir, name, name+"_fuzz_test.go"))
+			if tt.wantFuzz {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, os.IsNotExist(err), "fuzz targets must not be generated without structs")
+			}
+		})
+	}
+}
+
+func TestTestFilesVet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go vet in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	// The output must be inside this module for go vet to resolve imports
+	// of the runtime library and of other test packages.
+	outputDir, err := os.MkdirTemp("internal/tests", "thriftrw-vet-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	outputDir, err = filepath.Abs(outputDir)
+	require.NoError(t, err)
+
+	files := []string{
+		"bound_types.thrift",
+		"containers.thrift",
+		"exceptions.thrift",
+		"structs.thrift",
+		"unions.thrift",
+	}
+	var pkgs []string
+	for _, file := range files {
+		m, err := compile.Compile(filepath.Join(thriftRoot, file))
+		require.NoError(t, err)
+
+		require.NoError(t, Generate(m, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:    thriftRoot,
+			NoRecurse:     true,
+			Benchmarks:    true,
+			GoldenCorpus:  true,
+			FuzzTargets:   true,
+		}), "failed to generate code for %q", file)
+
+		name := strings.TrimSuffix(file, ".thrift")
+		for _, suffix := range []string{"_bench_test.go", "_golden_test.go", "_fuzz_test.go"} {
+			assert.FileExists(t, filepath.Join(outputDir, name, name+suffix))
+		}
+		pkgs = append(pkgs, filepath.Join(outputDir, name))
+	}
+
+	out, err := exec.Command(goTool, append([]string{"vet"}, pkgs...)...).CombinedOutput()
+	assert.NoError(t, err, "go vet failed:\n%s", out)
+}
+
+func TestFuzzTargetsMissingRequiredZero(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go test in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	outputDir, err := os.MkdirTemp("internal/tests", "thriftrw-fuzz-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	outputDir, err = filepath.Abs(outputDir)
+	require.NoError(t, err)
+
+	// Decoding the empty struct seeded into every target leaves all
+	// required fields unset, so the targets must tell values which cannot
+	// be encoded because of that, or for lack of an encryption provider,
+	// apart from real failures.
+	files := []string{
+		"encrypt.thrift",
+		"missing-required-zero.thrift",
+		"structs.thrift",
+	}
+	var pkgs []string
+	for _, file := range files {
+		m, err := compile.Compile(filepath.Join(thriftRoot, file))
+		require.NoError(t, err)
+
+		require.NoError(t, Generate(m, &Options{
+			OutputDir:       outputDir,
+			PackagePrefix:   "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:      thriftRoot,
+			NoRecurse:       true,
+			FuzzTargets:     true,
+			MissingRequired: MissingRequiredZero,
+		}), "failed to generate code for %q", file)
+		pkgs = append(pkgs, filepath.Join(outputDir, strings.TrimSuffix(file, ".thrift")))
+	}
+
+	args := append([]string{"test", "-run", "^Fuzz"}, pkgs...)
+	out, err := exec.Command(goTool, args...).CombinedOutput()
+	assert.NoError(t, err, "go test failed:\n%s", out)
+}
@@ -31,6 +31,7 @@ import (
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/internal/plugin"
 	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
 
 	"go.uber.org/multierr"
 )
@@ -38,8 +39,70 @@ import (
 // CodeGenerator lists possible code generators for a plugin.
 type CodeGenerator struct {
 	ServiceGenerator api.ServiceGenerator
+
+	// NameResolver, if set, may override the Go names of types, fields,
+	// and service functions.
+	NameResolver api.NameResolver
 }
 
+// Targets for which code may be generated. See Options.Target.
+const (
+	// TargetGo generates code for the standard Go toolchain.
+	TargetGo = "go"
+
+	// TargetTinyGo generates code that builds with TinyGo, including its
+	// WebAssembly targets. Zap logging code is omitted, enums are decoded
+	// from JSON without encoding/json, and lists are encoded sequentially.
+	TargetTinyGo = "tinygo"
+)
+
+// Layouts of generated files. See Options.OutputLayout.
+const (
+	// OutputLayoutMultiFile writes the code generated for each Thrift file
+	// to one .go file, and code generated by plugins to the files of their
+	// choosing.
+	OutputLayoutMultiFile = "multi-file"
+
+	// OutputLayoutSingleFile merges Go files generated by plugins into the
+	// file generated for a Thrift file if they belong to its package, so
+	// that each Thrift file generates exactly one .go file in its package.
+	// Files generated by plugins in other packages are left as they are
+	// since Go requires a directory for each package.
+	OutputLayoutSingleFile = "single-file"
+)
+
+// Parts of the code to which generation may be limited. See Options.Only.
+const (
+	// OnlyTypes generates constants and types, and no code for services.
+	OnlyTypes = "types"
+
+	// OnlyClients generates the types and service helpers used by clients,
+	// and asks plugins to skip code for servers.
+	OnlyClients = "clients"
+
+	// OnlyServers generates the types, service helpers, and procedures used
+	// by servers, and asks plugins to skip code for clients.
+	OnlyServers = "servers"
+)
+
+// Ways of handling required fields missing from structs being decoded. See
+// Options.MissingRequired.
+const (
+	// MissingRequiredError fails decoding with an error.
+	MissingRequiredError = "error"
+
+	// MissingRequiredHook reports missing required fields to the handler
+	// installed with requiredfield.SetHandler, which decides whether
+	// decoding fails. By default, it does.
+	MissingRequiredHook = "hook"
+
+	// MissingRequiredZero leaves missing required fields zero-valued.
+	// Required struct, map, and set fields are left nil, so values with
+	// missing fields cannot be encoded until those fields are set: encoding
+	// them fails with a *requiredfield.MissingError.
+	MissingRequiredZero = "zero"
+)
+
 // Options controls how code gets generated.
 type Options struct {
 	// OutputDir is the directory into which all generated code is written.
@@ -86,9 +149,215 @@ type Options struct {
 	// Name of the file to be generated by ThriftRW.
 	OutputFile string
 
+	// Mappings which override the directories, package names, and file
+	// names of the code generated for Thrift files, which are otherwise
+	// derived from their paths relative to ThriftRoot.
+	PackageMappings []PackageMapping
+
+	// Generate a <name>_bench_test.go file alongside the code for each
+	// Thrift file with a Benchmark<Name> function for each struct, union,
+	// and exception, which round-trips a representative value of the type
+	// through each of its serialization methods.
+	Benchmarks bool
+
+	// Generate a <name>_golden_test.go file alongside the code for each
+	// Thrift file with a TestGolden<Name> function for each struct, union,
+	// and exception, which checks that a representative value of the type
+	// still encodes to the bytes recorded at generation time with Binary,
+	// and with TJSONProtocol if ThriftJSON is also set.
+	GoldenCorpus bool
+
+	// Generate a <name>_fuzz_test.go file alongside the code for each
+	// Thrift file with a Fuzz<Name>RoundTrip function for each struct,
+	// union, and exception, which decodes arbitrary bytes into the type and
+	// checks that values which decode successfully survive a round trip.
+	FuzzTargets bool
+
+	// Layout of generated files: OutputLayoutMultiFile or
+	// OutputLayoutSingleFile. Defaults to OutputLayoutMultiFile.
+	OutputLayout string
+
 	// Generates an error on MarshalText and MarshalJSON if the enum value is
 	// unrecognized.
 	EnumTextMarshalStrict bool
+
+	// Leave optional fields off the wire when they are set to their
+	// default values. This may be overridden for individual structs and
+	// fields with the go.omit_default annotation.
+	OmitDefaults bool
+
+	// Templates for struct tags added to every field of every struct. The
+	// templates are executed with details about each field, and tags which
+	// end up with empty values are dropped.
+	//
+	// Structs may specify additional templates with the
+	// go.field_tag_template annotation.
+	FieldTagTemplates []string
+
+	// Generate net/http handlers for each service function. Handlers are
+	// served at "/Service/method" and map exceptions to HTTP status codes
+	// with the http.status annotation.
+	HTTPHandlers bool
+
+	// Generate an interface for implementations of each service and a
+	// function returning thriftrpc.Procedures backed by it, named
+	// "Service::method".
+	Procedures bool
+
+	// Generate a NewRouter function for each Thrift file with services,
+	// which serves all of them from one thriftrpc.Router keyed by the
+	// method names in request envelopes. Requires Procedures.
+	Router bool
+
+	// Prefix the method names routed by Router with the name of their
+	// service, as in "Service:method". Without this, methods with the same
+	// name in different services fail code generation.
+	RouterPrefix bool
+
+	// Retain fields of structs that are not recognized when decoding them,
+	// and write them back out when encoding them. This may be overridden
+	// for individual structs with the go.preserve_unknown_fields
+	// annotation.
+	PreserveUnknownFields bool
+
+	// Generate a <Name>_Lazy type for each struct, which decodes the
+	// fields of the struct from its binary encoding only when they are
+	// first accessed. This may be overridden for individual structs with
+	// the go.lazy annotation.
+	LazyStructs bool
+
+	// Report all missing required fields and invalid unions found in a
+	// struct and its nested structs when encoding it, instead of only the
+	// first one.
+	AggregateErrors bool
+
+	// Generate New<Name>WithDefaults constructors for structs, along with
+	// Must<Name>FromWire and Must<Name>FromJSON functions which panic if
+	// decoding fails, to trim boilerplate from tests.
+	TestHelpers bool
+
+	// Generate Encode methods for structs which also encode them with
+	// ToWire and report differences between the two to the dualencode
+	// package. This is meant for use while migrating to streaming Encode.
+	DualEncode bool
+
+	// Generate a <Name>Builder type for each struct with a chained setter
+	// for each field and a Build method which checks that required fields
+	// were set.
+	Builders bool
+
+	// Generate a Set<Field> method for each field of each struct, wrapping
+	// values of optional fields in pointers as needed.
+	Setters bool
+
+	// Store optional primitive fields of structs by value and track whether
+	// they are set with a hidden bitset, instead of storing them as
+	// pointers. Structs may override this with the go.presence_bits
+	// annotation.
+	PresenceBits bool
+
+	// Add the Thrift file and line on which types, constants, and service
+	// functions were defined to their generated documentation.
+	SourceComments bool
+
+	// Generate a <Name>TypeSpec variable describing each type, and a
+	// TypeSpecs map holding all of them, for use by generic middleware.
+	TypeSpecs bool
+
+	// Generate a <Name>ServiceSpec variable describing the functions and
+	// annotations of each service, and a ServiceSpecs map holding all of
+	// them, for service catalogs.
+	ServiceSpecs bool
+
+	// Restrict generated code to depend only on the Go standard library and
+	// ThriftRW packages which do the same. This implies NoZap. Generation
+	// fails if any generated file, including those generated by plugins,
+	// imports other packages.
+	StdlibOnly bool
+
+	// How to handle required fields missing from structs being decoded:
+	// MissingRequiredError, MissingRequiredHook, or MissingRequiredZero.
+	// Defaults to MissingRequiredError.
+	//
+	// Use MissingRequiredHook or MissingRequiredZero to accept payloads
+	// from producers which lag behind changes to the schema. Encoding
+	// still fails if a required field is unset.
+	MissingRequired string
+
+	// Limit generated code to OnlyTypes, OnlyClients, or OnlyServers, so
+	// that consumers of only part of a service do not carry code for the
+	// rest. By default, code for all of them is generated.
+	Only string
+
+	// Go names to use for Thrift types, fields, and service functions,
+	// keyed by their Thrift names. Fields and functions are specified as
+	// "Type.field" and "Service.function". These take precedence over names
+	// chosen by plugins and go.name annotations.
+	GoNames map[string]string
+
+	// Do not generate the streaming Encode and Decode methods, leaving
+	// ToWire and FromWire as the only way to serialize types. This trims
+	// generated code for builds which only use wire.Value. It cannot be
+	// combined with DualEncode or LazyStructs, which rely on streaming.
+	NoStreaming bool
+
+	// Run the FromWire and Decode methods of structs under pprof labels
+	// naming the Thrift type and the method, so that CPU profiles
+	// attribute the cost of deserialization to each type. This may be
+	// overridden for individual structs with the go.pprof_labels
+	// annotation.
+	PprofLabels bool
+
+	// Generate MarshalThriftJSON and UnmarshalThriftJSON methods for
+	// structs, which encode them in Apache Thrift's TJSONProtocol so that
+	// they may be exchanged with Apache Thrift implementations in other
+	// languages. These rely on the streaming Encode and Decode methods, so
+	// this cannot be combined with NoStreaming.
+	ThriftJSON bool
+
+	// Add yaml tags mirroring the json tags of struct fields, and generate
+	// MarshalYAML and UnmarshalYAML methods for enums and unions, so that
+	// generated types may be read from and written to YAML.
+	YAML bool
+
+	// Generate Generate methods for structs, unions, exceptions, and enums
+	// which implement testing/quick.Generator, producing random values
+	// which are valid on the wire for property-based tests.
+	QuickGenerators bool
+
+	// Generate Compare and Less methods for structs, unions, exceptions,
+	// and typedefs whose values are totally ordered, so that they may be
+	// sorted without hand-written comparisons.
+	Compare bool
+
+	// Generate constants of lists, sets, maps, binary, structs, unions,
+	// and exceptions as functions which return a new value on every call
+	// rather than as variables which all callers share and may modify.
+	ConstantFunctions bool
+
+	// Generate EncodedSize methods for structs, unions, exceptions, enums,
+	// and typedefs which return the length of their encoding with the
+	// Binary protocol without encoding them, so that buffers may be
+	// allocated and frame sizes checked up front. Types with encrypted
+	// fields or a field named EncodedSize, and types which hold them, are
+	// skipped.
+	EncodedSize bool
+
+	// Generate AppendTo methods for structs, unions, and exceptions which
+	// append their encoding with the Binary protocol to a byte slice,
+	// skipping the io.Writer that Encode writes to. These rely on the
+	// streaming Encode methods, so this cannot be combined with
+	// NoStreaming. Combined with EncodedSize, the slice is grown once
+	// before encoding.
+	AppendTo bool
+
+	// Toolchain for which code is generated: TargetGo or TargetTinyGo.
+	// Defaults to TargetGo.
+	Target string
+
+	// Progress, if set, is called synchronously with events reporting the
+	// progress of code generation. See also GenerateAsync.
+	Progress func(Event)
 }
 
 // Generate generates code based on the given options.
@@ -105,25 +374,159 @@ func Generate(m *compile.Module, o *Options) error {
 			o.OutputDir)
 	}
 
+	if o.Router && !o.Procedures {
+		return fmt.Errorf("Router requires Procedures")
+	}
+	if o.RouterPrefix && !o.Router {
+		return fmt.Errorf("RouterPrefix requires Router")
+	}
+	if o.NoStreaming {
+		if o.DualEncode {
+			return fmt.Errorf("DualEncode cannot be combined with NoStreaming: it requires Encode methods")
+		}
+		if o.LazyStructs {
+			return fmt.Errorf("LazyStructs cannot be combined with NoStreaming: they require Decode methods")
+		}
+		if o.ThriftJSON {
+			return fmt.Errorf("ThriftJSON cannot be combined with NoStreaming: it requires Encode and Decode methods")
+		}
+		if o.AppendTo {
+			return fmt.Errorf("AppendTo cannot be combined with NoStreaming: it requires Encode methods")
+		}
+	}
+
+	switch o.OutputLayout {
+	case "", OutputLayoutMultiFile, OutputLayoutSingleFile:
+	default:
+		return fmt.Errorf("unknown output layout %q: must be %q or %q",
+			o.OutputLayout, OutputLayoutMultiFile, OutputLayoutSingleFile)
+	}
+
+	switch o.Target {
+	case "", TargetGo:
+	case TargetTinyGo:
+		if o.HTTPHandlers {
+			return fmt.Errorf("HTTPHandlers are not supported for target %q: net/http is unavailable", o.Target)
+		}
+		if o.PprofLabels {
+			return fmt.Errorf("PprofLabels are not supported for target %q: runtime/pprof is unavailable", o.Target)
+		}
+		if o.ThriftJSON {
+			return fmt.Errorf("ThriftJSON is not supported for target %q: it requires encoding/json", o.Target)
+		}
+		if o.QuickGenerators {
+			return fmt.Errorf("QuickGenerators are not supported for target %q: they require reflect and testing/quick", o.Target)
+		}
+	default:
+		return fmt.Errorf("unknown target %q: must be %q or %q", o.Target, TargetGo, TargetTinyGo)
+	}
+
+	switch o.MissingRequired {
+	case "", MissingRequiredError, MissingRequiredHook, MissingRequiredZero:
+	default:
+		return fmt.Errorf("unknown value %q for MissingRequired: must be %q, %q, or %q",
+			o.MissingRequired, MissingRequiredError, MissingRequiredHook, MissingRequiredZero)
+	}
+
+	switch o.Only {
+	case "", OnlyServers:
+	case OnlyTypes, OnlyClients:
+		if o.HTTPHandlers {
+			return fmt.Errorf("HTTPHandlers cannot be generated with Only %q: they are used by servers", o.Only)
+		}
+		if o.Procedures {
+			return fmt.Errorf("Procedures cannot be generated with Only %q: they are used by servers", o.Only)
+		}
+		if o.ServiceSpecs && o.Only == OnlyTypes {
+			return fmt.Errorf("ServiceSpecs cannot be generated with Only %q", o.Only)
+		}
+	default:
+		return fmt.Errorf("unknown value %q for Only: must be %q, %q, or %q",
+			o.Only, OnlyTypes, OnlyClients, OnlyServers)
+	}
+
+	// go.unsigned, go.bytes, and go.string annotations on fields and
+	// typedefs apply to types which may be referenced from any module.
+	err := m.Walk(func(m *compile.Module) error {
+		if err := resolveUnsigned(m); err != nil {
+			return generateError{Name: m.ThriftPath, Reason: err}
+		}
+		if err := resolveBytes(m); err != nil {
+			return generateError{Name: m.ThriftPath, Reason: err}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Fields annotated with go.skip may be referenced from any module.
+	if err := resolveSkipped(m); err != nil {
+		return err
+	}
+
+	var resolvers []api.NameResolver
+	if o.Plugin.NameResolver != nil {
+		resolvers = append(resolvers, o.Plugin.NameResolver)
+	}
+	var goNames *goNameMap
+	if len(o.GoNames) > 0 {
+		goNames = newGoNameMap(o.GoNames)
+		resolvers = append(resolvers, goNames)
+	}
+	if len(resolvers) > 0 {
+		err := m.Walk(func(m *compile.Module) error {
+			if err := resolveNames(m, resolvers...); err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if goNames != nil {
+			if err := goNames.checkUsed(); err != nil {
+				return err
+			}
+		}
+	}
+
+	layouts, err := resolvePackageLayouts(m, o.ThriftRoot, o.PackageMappings)
+	if err != nil {
+		return err
+	}
+
 	importer := thriftPackageImporter{
 		ImportPrefix: o.PackagePrefix,
 		ThriftRoot:   o.ThriftRoot,
+		Layouts:      layouts,
 	}
 
 	// Mapping of filenames relative to OutputDir to their contents.
 	files := make(map[string][]byte)
+
+	// Mapping of package directories relative to OutputDir to the files
+	// generated for Thrift files in them.
+	moduleFiles := make(map[string]string)
 	genBuilder := newGenerateServiceBuilder(importer)
+	progress := progress{o: o}
 
 	generate := func(m *compile.Module) error {
-		path, contents, err := generateModule(m, importer, genBuilder, o)
+		progress.report(Event{Type: ModuleStarted, Module: m.ThriftPath})
+		for _, w := range m.Warnings {
+			progress.warn(w)
+		}
+		path, generated, err := generateModule(m, importer, genBuilder, o)
 		if err != nil {
 			return generateError{Name: m.ThriftPath, Reason: err}
 		}
 
-		if err := addFile(files, path, contents); err != nil {
+		if err := mergeFiles(files, generated); err != nil {
 			return generateError{Name: m.ThriftPath, Reason: err}
 		}
+		moduleFiles[filepath.Dir(path)] = path
 
+		progress.report(Event{Type: ModuleFinished, Module: m.ThriftPath})
 		return nil
 	}
 
@@ -141,6 +544,14 @@ func Generate(m *compile.Module, o *Options) error {
 	// should not be generated, since code for multiple modules cannot
 	// be compiled into a single file.
 	if o.NoRecurse || len(o.OutputFile) > 0 {
+		if !o.NoRecurse && len(m.Includes) > 0 && !m.SuppressesWarning(SkippedIncludes) {
+			progress.warn(&compile.Warning{
+				Kind:     SkippedIncludes,
+				Severity: compile.SeverityWarning,
+				Path:     m.ThriftPath,
+				Message:  "code is not generated for included Thrift files when an output file is specified",
+			})
+		}
 		if err := generate(m); err != nil {
 			return err
 		}
@@ -150,21 +561,58 @@ func Generate(m *compile.Module, o *Options) error {
 		}
 	}
 
+	// Plugins generate code only for services, so they have nothing to do
+	// if only types were requested.
 	plug := o.Plugin.ServiceGenerator
-	if plug == nil {
+	if o.Only == OnlyTypes {
+		plug = nil
+	}
+	hasPlugin := plug != nil
+	if !hasPlugin {
 		plug = plugin.EmptyServiceGenerator
+	} else {
+		progress.report(Event{Type: PluginStarted})
 	}
 
-	res, err := plug.Generate(genBuilder.Build())
+	req := genBuilder.Build()
+	switch o.Only {
+	case OnlyClients:
+		req.NoServers = ptr.Bool(true)
+	case OnlyServers:
+		req.NoClients = ptr.Bool(true)
+	}
+
+	res, err := plug.Generate(req)
 	if err != nil {
 		return err
 	}
+	if hasPlugin {
+		progress.report(Event{Type: PluginFinished})
+	}
 
-	if err := mergeFiles(files, res.Files); err != nil {
+	if o.OutputLayout == OutputLayoutSingleFile {
+		err = mergeSingleFile(files, moduleFiles, res.Files)
+	} else {
+		err = mergeFiles(files, res.Files)
+	}
+	if err != nil {
 		return err
 	}
 
-	for relPath, contents := range files {
+	if o.StdlibOnly {
+		checker, err := newStdlibChecker(m, importer)
+		if err != nil {
+			return err
+		}
+		for _, relPath := range sortStringKeys(files) {
+			if err := checker.Check(relPath, files[relPath]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, relPath := range sortStringKeys(files) {
+		contents := files[relPath]
 		fullPath := filepath.Join(o.OutputDir, relPath)
 		directory := filepath.Dir(fullPath)
 
@@ -175,6 +623,7 @@ func Generate(m *compile.Module, o *Options) error {
 		if err := ioutil.WriteFile(fullPath, contents, 0644); err != nil {
 			return fmt.Errorf("failed to write %q: %v", fullPath, err)
 		}
+		progress.report(Event{Type: FileWritten, Path: fullPath})
 	}
 
 	return nil
@@ -203,12 +652,34 @@ type ThriftPackageImporter interface {
 type thriftPackageImporter struct {
 	ImportPrefix string
 	ThriftRoot   string
+
+	// Layouts of packages for Thrift files which are not derived from
+	// their paths, keyed by Thrift path. See PackageMapping.
+	Layouts map[string]packageLayout
 }
 
 func (i thriftPackageImporter) RelativePackage(file string) (string, error) {
+	if layout, ok := i.Layouts[file]; ok {
+		return filepath.FromSlash(layout.Dir), nil
+	}
 	return filepath.Rel(i.ThriftRoot, strings.TrimSuffix(file, ".thrift"))
 }
 
+// layout returns the layout of the package for the given Thrift file.
+func (i thriftPackageImporter) layout(file string) (packageLayout, error) {
+	if layout, ok := i.Layouts[file]; ok {
+		return layout, nil
+	}
+	dir, err := i.RelativePackage(file)
+	if err != nil {
+		return packageLayout{}, err
+	}
+	return packageLayout{
+		Dir:     filepath.ToSlash(dir),
+		Package: normalizePackageName(dir),
+	}, nil
+}
+
 func (i thriftPackageImporter) RelativeThriftFilePath(file string) (string, error) {
 	return filepath.Rel(i.ThriftRoot, file)
 }
@@ -239,28 +710,32 @@ func addFile(dest map[string][]byte, path string, contents []byte) error {
 }
 
 // generateModule generates the code for the given Thrift file and returns the
-// path to the output file relative to OutputDir and the contents of the file.
+// path to the output file relative to OutputDir, and the contents of it and
+// any other files generated for the Thrift file keyed by their paths.
 func generateModule(
 	m *compile.Module,
 	i thriftPackageImporter,
 	builder *generateServiceBuilder,
 	o *Options,
-) (outputFilepath string, contents []byte, err error) {
+) (outputFilepath string, files map[string][]byte, err error) {
 	// packageRelPath is the path relative to outputDir into which we'll be
 	// writing the package for this Thrift file. For $thriftRoot/foo/bar.thrift,
 	// packageRelPath is foo/bar, and packageDir is $outputDir/foo/bar. All
 	// files for bar.thrift will be written to the $outputDir/foo/bar/ tree. The
 	// package will be importable via $importPrefix/foo/bar.
-	packageRelPath, err := i.RelativePackage(m.ThriftPath)
+	layout, err := i.layout(m.ThriftPath)
 	if err != nil {
 		return "", nil, err
 	}
+	packageRelPath := filepath.FromSlash(layout.Dir)
 	// TODO(abg): Prefer top-level package name from `namespace go` directive.
 	outputFilename := filepath.Base(packageRelPath)
 
 	// Output file name defaults to the package name.
 	outputFilename = outputFilename + ".go"
-	if len(o.OutputFile) > 0 {
+	if len(layout.File) > 0 {
+		outputFilename = layout.File
+	} else if len(o.OutputFile) > 0 {
 		outputFilename = o.OutputFile
 	}
 	outputFilepath = filepath.Join(packageRelPath, outputFilename)
@@ -272,14 +747,39 @@ func generateModule(
 		return "", nil, err
 	}
 
-	// converts package name from ab-def to ab_def for golang code generation
-	normalizedPackageName := normalizePackageName(filepath.Base(packageRelPath))
 	g := NewGenerator(&GeneratorOptions{
 		Importer:              i,
 		ImportPath:            importPath,
-		PackageName:           normalizedPackageName,
-		NoZap:                 o.NoZap,
+		PackageName:           layout.Package,
+		NoZap:                 o.NoZap || o.StdlibOnly || o.Target == TargetTinyGo,
 		EnumTextMarshalStrict: o.EnumTextMarshalStrict,
+		OmitDefaults:          o.OmitDefaults,
+		FieldTagTemplates:     o.FieldTagTemplates,
+		HTTPHandlers:          o.HTTPHandlers,
+		Procedures:            o.Procedures,
+		Router:                o.Router,
+		RouterPrefix:          o.RouterPrefix,
+		TinyGo:                o.Target == TargetTinyGo,
+		PreserveUnknownFields: o.PreserveUnknownFields,
+		LazyStructs:           o.LazyStructs,
+		AggregateErrors:       o.AggregateErrors,
+		TestHelpers:           o.TestHelpers,
+		DualEncode:            o.DualEncode,
+		Builders:              o.Builders,
+		Setters:               o.Setters,
+		PresenceBits:          o.PresenceBits,
+		SourceComments:        o.SourceComments,
+		Only:                  o.Only,
+		MissingRequired:       o.MissingRequired,
+		NoStreaming:           o.NoStreaming,
+		PprofLabels:           o.PprofLabels,
+		ThriftJSON:            o.ThriftJSON,
+		YAML:                  o.YAML,
+		QuickGenerators:       o.QuickGenerators,
+		Compare:               o.Compare,
+		ConstantFunctions:     o.ConstantFunctions,
+		EncodedSize:           o.EncodedSize,
+		AppendTo:              o.AppendTo,
 	})
 
 	if len(m.Constants) > 0 {
@@ -298,6 +798,12 @@ func generateModule(
 		}
 	}
 
+	if o.TypeSpecs && len(m.Types) > 0 {
+		if err := typeSpecs(g, m.Types); err != nil {
+			return "", nil, err
+		}
+	}
+
 	if !o.NoEmbedIDL {
 		if err := embedIDL(g, i, m); err != nil {
 			return "", nil, err
@@ -315,7 +821,7 @@ func generateModule(
 
 	// Services must be generated last because names of user-defined types take
 	// precedence over the names we pick for the service types.
-	if len(m.Services) > 0 {
+	if len(m.Services) > 0 && o.Only != OnlyTypes {
 		for _, serviceName := range sortStringKeys(m.Services) {
 			service := m.Services[serviceName]
 
@@ -334,12 +840,66 @@ func generateModule(
 		if err = Services(g, m.Services); err != nil {
 			return "", nil, fmt.Errorf("could not generate code for services %v", err)
 		}
+
+		if o.ServiceSpecs {
+			if err := serviceSpecs(g, i, m.Services); err != nil {
+				return "", nil, err
+			}
+		}
 	}
 
 	buff := new(bytes.Buffer)
 	if err := g.Write(buff, nil); err != nil {
 		return "", nil, fmt.Errorf("could not write output for file %q: %v", outputFilename, err)
 	}
+	files = map[string][]byte{outputFilepath: buff.Bytes()}
+
+	// Benchmarks go into a test file of the same package, written with the
+	// same generator so that they share helpers declared above.
+	if o.Benchmarks {
+		ok, err := benchmarks(g, m.Types)
+		if err != nil {
+			return "", nil, err
+		}
+		if ok {
+			benchFilepath := strings.TrimSuffix(outputFilepath, ".go") + "_bench_test.go"
+			buff := new(bytes.Buffer)
+			if err := g.Write(buff, nil); err != nil {
+				return "", nil, fmt.Errorf("could not write output for file %q: %v", benchFilepath, err)
+			}
+			files[benchFilepath] = buff.Bytes()
+		}
+	}
+
+	if o.GoldenCorpus {
+		ok, err := goldenCorpus(g, m.Types)
+		if err != nil {
+			return "", nil, err
+		}
+		if ok {
+			goldenFilepath := strings.TrimSuffix(outputFilepath, ".go") + "_golden_test.go"
+			buff := new(bytes.Buffer)
+			if err := g.Write(buff, nil); err != nil {
+				return "", nil, fmt.Errorf("could not write output for file %q: %v", goldenFilepath, err)
+			}
+			files[goldenFilepath] = buff.Bytes()
+		}
+	}
+
+	if o.FuzzTargets {
+		ok, err := fuzzTargets(g, m.Types)
+		if err != nil {
+			return "", nil, err
+		}
+		if ok {
+			fuzzFilepath := strings.TrimSuffix(outputFilepath, ".go") + "_fuzz_test.go"
+			buff := new(bytes.Buffer)
+			if err := g.Write(buff, nil); err != nil {
+				return "", nil, fmt.Errorf("could not write output for file %q: %v", fuzzFilepath, err)
+			}
+			files[fuzzFilepath] = buff.Bytes()
+		}
+	}
 
-	return outputFilepath, buff.Bytes(), nil
+	return outputFilepath, files, nil
 }
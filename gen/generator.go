@@ -129,6 +129,8 @@ type generator struct {
 	w              WireGenerator
 	s              StreamGenerator
 	e              equalsGenerator
+	c              copyGenerator
+	h              hashGenerator
 	z              zapGenerator
 	noZap          bool
 	decls          []ast.Decl
@@ -137,6 +139,33 @@ type generator struct {
 
 	fset                  *token.FileSet
 	enumTextMarshalStrict bool
+	omitDefaults          bool
+	fieldTagTemplates     []string
+	httpHandlers          bool
+	procedures            bool
+	router                bool
+	routerPrefix          bool
+	tinyGo                bool
+	preserveUnknownFields bool
+	lazyStructs           bool
+	aggregateErrors       bool
+	testHelpers           bool
+	dualEncode            bool
+	builders              bool
+	setters               bool
+	presenceBits          bool
+	sourceComments        bool
+	only                  string
+	missingRequired       string
+	noStreaming           bool
+	pprofLabels           bool
+	thriftJSON            bool
+	yaml                  bool
+	quickGenerators       bool
+	compare               bool
+	constantFunctions     bool
+	encodedSize           bool
+	appendTo              bool
 
 	// TODO use something to group related decls together
 }
@@ -149,6 +178,110 @@ type GeneratorOptions struct {
 
 	NoZap                 bool
 	EnumTextMarshalStrict bool
+
+	// OmitDefaults leaves optional fields off the wire if they are set to
+	// their default values.
+	OmitDefaults bool
+
+	// FieldTagTemplates are templates for tags added to every field of
+	// every struct.
+	FieldTagTemplates []string
+
+	// HTTPHandlers generates net/http handlers for service functions.
+	HTTPHandlers bool
+
+	// Procedures generates thriftrpc procedures for services.
+	Procedures bool
+
+	// Router generates a NewRouter function serving all services of a
+	// file, with method names prefixed by service names if RouterPrefix
+	// is set.
+	Router       bool
+	RouterPrefix bool
+
+	// TinyGo generates code that avoids encoding/json, goroutines, and
+	// other features that are unavailable or costly under TinyGo.
+	TinyGo bool
+
+	// PreserveUnknownFields retains fields of structs that are not
+	// recognized when decoding and writes them back out when encoding.
+	PreserveUnknownFields bool
+
+	// LazyStructs generates a <Name>_Lazy type for each struct which
+	// decodes its fields only when they are first accessed.
+	LazyStructs bool
+
+	// AggregateErrors generates CollectViolations methods for structs and
+	// makes ToWire and Encode report all violations they find at once.
+	AggregateErrors bool
+
+	// TestHelpers generates New<Name>WithDefaults, Must<Name>FromWire, and
+	// Must<Name>FromJSON functions for structs.
+	TestHelpers bool
+
+	// DualEncode makes the Encode methods of structs compare their output
+	// with that of ToWire.
+	DualEncode bool
+
+	// Builders generates a <Name>Builder type with chained setters for
+	// structs.
+	Builders bool
+
+	// Setters generates Set<Field> methods for the fields of structs.
+	Setters bool
+
+	// PresenceBits stores optional primitive fields of structs by value
+	// with a presence bitset instead of as pointers.
+	PresenceBits bool
+
+	// SourceComments adds the Thrift file and line on which definitions
+	// were found to their documentation.
+	SourceComments bool
+
+	// Only limits service code to that used by clients (OnlyClients) or
+	// servers (OnlyServers).
+	Only string
+
+	// MissingRequired specifies how decoding handles missing required
+	// fields: MissingRequiredError, MissingRequiredHook, or
+	// MissingRequiredZero.
+	MissingRequired string
+
+	// NoStreaming skips the streaming Encode and Decode methods of types.
+	NoStreaming bool
+
+	// PprofLabels runs the FromWire and Decode methods of structs under
+	// pprof labels naming their type.
+	PprofLabels bool
+
+	// ThriftJSON generates MarshalThriftJSON and UnmarshalThriftJSON
+	// methods for structs.
+	ThriftJSON bool
+
+	// YAML adds yaml tags to the fields of structs and generates
+	// MarshalYAML and UnmarshalYAML methods for enums and unions.
+	YAML bool
+
+	// QuickGenerators generates Generate methods for structs, unions,
+	// exceptions, and enums which implement testing/quick.Generator.
+	QuickGenerators bool
+
+	// Compare generates Compare and Less methods for structs, unions,
+	// exceptions, and typedefs whose values are totally ordered.
+	Compare bool
+
+	// ConstantFunctions generates functions which return a new value on
+	// every call for constants of mutable types instead of variables.
+	ConstantFunctions bool
+
+	// EncodedSize generates EncodedSize methods for structs, unions,
+	// exceptions, enums, and typedefs which return the length of their
+	// encoding.
+	EncodedSize bool
+
+	// AppendTo generates AppendTo methods for structs, unions, and
+	// exceptions which append their encoding to a byte slice.
+	AppendTo bool
 }
 
 // NewGenerator sets up a new generator for Go code.
@@ -165,6 +298,33 @@ func NewGenerator(o *GeneratorOptions) Generator {
 		fset:                  token.NewFileSet(),
 		noZap:                 o.NoZap,
 		enumTextMarshalStrict: o.EnumTextMarshalStrict,
+		omitDefaults:          o.OmitDefaults,
+		fieldTagTemplates:     o.FieldTagTemplates,
+		httpHandlers:          o.HTTPHandlers,
+		procedures:            o.Procedures,
+		router:                o.Router,
+		routerPrefix:          o.RouterPrefix,
+		tinyGo:                o.TinyGo,
+		preserveUnknownFields: o.PreserveUnknownFields,
+		lazyStructs:           o.LazyStructs,
+		aggregateErrors:       o.AggregateErrors,
+		testHelpers:           o.TestHelpers,
+		dualEncode:            o.DualEncode,
+		builders:              o.Builders,
+		setters:               o.Setters,
+		presenceBits:          o.PresenceBits,
+		sourceComments:        o.SourceComments,
+		only:                  o.Only,
+		missingRequired:       o.MissingRequired,
+		noStreaming:           o.NoStreaming,
+		pprofLabels:           o.PprofLabels,
+		thriftJSON:            o.ThriftJSON,
+		yaml:                  o.YAML,
+		quickGenerators:       o.QuickGenerators,
+		compare:               o.Compare,
+		constantFunctions:     o.ConstantFunctions,
+		encodedSize:           o.EncodedSize,
+		appendTo:              o.AppendTo,
 	}
 }
 
@@ -183,6 +343,220 @@ func checkEnumTextMarshalStrict(g Generator) bool {
 	return false
 }
 
+// checkOmitDefaults returns whether the OmitDefaults flag is passed.
+func checkOmitDefaults(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.omitDefaults
+	}
+	return false
+}
+
+// checkFieldTagTemplates returns the tag templates passed with the
+// FieldTagTemplates option.
+func checkFieldTagTemplates(g Generator) []string {
+	if gen, ok := g.(*generator); ok {
+		return gen.fieldTagTemplates
+	}
+	return nil
+}
+
+// checkHTTPHandlers returns whether the HTTPHandlers flag is passed.
+func checkHTTPHandlers(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.httpHandlers
+	}
+	return false
+}
+
+// checkProcedures returns whether the Procedures flag is passed.
+func checkProcedures(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.procedures
+	}
+	return false
+}
+
+// checkRouter returns whether the Router flag is passed, and whether
+// routes are prefixed with service names.
+func checkRouter(g Generator) (router, prefix bool) {
+	if gen, ok := g.(*generator); ok {
+		return gen.router, gen.routerPrefix
+	}
+	return false, false
+}
+
+// checkTinyGo returns whether code is being generated for TinyGo.
+func checkTinyGo(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.tinyGo
+	}
+	return false
+}
+
+// checkPreserveUnknownFields returns whether the PreserveUnknownFields flag
+// is passed.
+func checkPreserveUnknownFields(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.preserveUnknownFields
+	}
+	return false
+}
+
+// checkLazyStructs returns whether the LazyStructs flag is passed.
+func checkLazyStructs(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.lazyStructs
+	}
+	return false
+}
+
+// checkAggregateErrors returns whether the AggregateErrors flag is passed.
+func checkAggregateErrors(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.aggregateErrors
+	}
+	return false
+}
+
+// checkTestHelpers returns whether the TestHelpers flag is passed.
+func checkTestHelpers(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.testHelpers
+	}
+	return false
+}
+
+// checkBuilders returns whether the Builders flag is passed.
+func checkBuilders(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.builders
+	}
+	return false
+}
+
+// checkSetters returns whether the Setters flag is passed.
+func checkSetters(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.setters
+	}
+	return false
+}
+
+// checkPresenceBits returns whether the PresenceBits flag is passed.
+func checkPresenceBits(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.presenceBits
+	}
+	return false
+}
+
+// checkSourceComments returns whether the SourceComments flag is passed.
+func checkSourceComments(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.sourceComments
+	}
+	return false
+}
+
+// checkOnly returns the part of service code, OnlyClients or OnlyServers,
+// to which generated code is limited, or an empty string if it is not.
+func checkOnly(g Generator) string {
+	if gen, ok := g.(*generator); ok {
+		return gen.only
+	}
+	return ""
+}
+
+// checkMissingRequired returns how decoding handles missing required fields:
+// MissingRequiredError, MissingRequiredHook, or MissingRequiredZero.
+func checkMissingRequired(g Generator) string {
+	if gen, ok := g.(*generator); ok && gen.missingRequired != "" {
+		return gen.missingRequired
+	}
+	return MissingRequiredError
+}
+
+// checkNoStreaming returns whether the NoStreaming flag is passed.
+func checkNoStreaming(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.noStreaming
+	}
+	return false
+}
+
+// checkPprofLabels returns whether the PprofLabels flag is passed.
+func checkPprofLabels(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.pprofLabels
+	}
+	return false
+}
+
+// checkThriftJSON returns whether the ThriftJSON flag is passed.
+func checkThriftJSON(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.thriftJSON
+	}
+	return false
+}
+
+// checkYAML returns whether the YAML flag is passed.
+func checkYAML(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.yaml
+	}
+	return false
+}
+
+// checkQuickGenerators returns whether the QuickGenerators flag is passed.
+func checkQuickGenerators(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.quickGenerators
+	}
+	return false
+}
+
+// checkCompare returns whether the Compare flag is passed.
+func checkCompare(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.compare
+	}
+	return false
+}
+
+// checkConstantFunctions returns whether the ConstantFunctions flag is
+// passed.
+func checkConstantFunctions(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.constantFunctions
+	}
+	return false
+}
+
+// checkEncodedSize returns whether the EncodedSize flag is passed.
+func checkEncodedSize(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.encodedSize
+	}
+	return false
+}
+
+// checkAppendTo returns whether the AppendTo flag is passed.
+func checkAppendTo(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.appendTo
+	}
+	return false
+}
+
+// checkDualEncode returns whether the DualEncode flag is passed.
+func checkDualEncode(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.dualEncode
+	}
+	return false
+}
+
 func (g *generator) MangleType(t compile.TypeSpec) string {
 	return g.mangler.MangleType(t)
 }
@@ -229,15 +603,21 @@ func (g *generator) TextTemplate(s string, data interface{}, opts ...TemplateOpt
 		"lessthan":         lessThanSymbol,
 		"enumItemName":     enumItemName,
 		"formatDoc":        formatDoc,
+		"deprecatedDoc":    deprecatedDoc,
+		"deprecation":      deprecationNotice,
+		"sourceDoc":        curryGenerator(sourceDoc, g),
 		"goCase":           goCase,
 		"goName":           goName,
+		"functionName":     functionName,
 		"import":           g.Import,
 		"isHashable":       isHashable,
 		"setUsesMap":       setUsesMap,
+		"mapUsesMap":       mapUsesMap,
 		"isListType":       isListType,
 		"isPrimitiveType":  isPrimitiveType,
 		"isStringType":     isStringType,
 		"isStructType":     isStructType,
+		"isUUIDType":       isUUIDType,
 		"newNamespace":     g.Namespace.Child,
 		"newVar":           g.Namespace.Child().NewName,
 		"typeName":         curryGenerator(typeName, g),
@@ -254,6 +634,10 @@ func (g *generator) TextTemplate(s string, data interface{}, opts ...TemplateOpt
 		"typeCode":         curryGenerator(TypeCode, g),
 		"equals":           curryGenerator(g.e.Equals, g),
 		"equalsPtr":        curryGenerator(g.e.EqualsPtr, g),
+		"copy":             curryGenerator(g.c.Copy, g),
+		"copyPtr":          curryGenerator(g.c.CopyPtr, g),
+		"hash":             curryGenerator(g.h.Hash, g),
+		"hashPtr":          curryGenerator(g.h.HashPtr, g),
 		"zapEncodeBegin":   curryGenerator(g.z.zapEncodeBegin, g),
 		"zapEncodeEnd":     g.z.zapEncodeEnd,
 		"zapEncoder":       curryGenerator(g.z.zapEncoder, g),
@@ -422,6 +806,9 @@ func (g *generator) declare(ignoreConflicts bool, s string, data interface{}, op
 // It returns the annotated name if available (after some sanity check) or
 // returns the Thrift name through goCase.
 //
+// functionName(FunctionSpec): Returns the name of the Go method generated for
+// a service function.
+//
 // import(str): Accepts a string and returns the name that should be used in
 // the template to refer to that imported module. This helps avoid naming
 // conflicts with imports.
@@ -476,10 +863,44 @@ func (g *generator) declare(ignoreConflicts bool, s string, data interface{}, op
 //
 //  <equalsPtr $someType $lhs $rhs>
 //
-// formatDoc(string): Formats a docblock. Generates a trailing newline so use
-// this NEXT to the thing being documented.
+// copy(TypeSpec, v): Returns an expression which evaluates to a deep copy
+// of v, a value of the given TypeSpec.
+//
+//  <copy $someType $v>
+//
+// copyPtr(TypeSpec, v): Same as copy except v is a reference to a value of
+// the given TypeSpec.
+//
+//  <copyPtr $someType $v>
+//
+// hash(TypeSpec, h, v): Returns a statement which writes v, a value of the
+// given TypeSpec, to the thrifthash.Hasher h.
+//
+//  <hash $someType $h $v>
+//
+// hashPtr(TypeSpec, h, v): Same as hash except v is a reference to a value
+// of the given TypeSpec.
+//
+//  <hashPtr $someType $h $v>
+//
+// formatDoc(string): Formats a docblock, dropping trailing whitespace from
+// its lines. Generates a trailing newline so use this NEXT to the thing being
+// documented.
 //
 //   <formatDoc .Doc>type Foo
+//
+// sourceDoc(string, string, int): Adds the Thrift file and line on which
+// something was defined to its docblock if SourceComments is set.
+//
+//   <formatDoc (sourceDoc .Doc .File .Line)>type Foo
+//
+// deprecatedDoc(string, Annotations): Adds a "Deprecated:" paragraph to a
+// docblock if the annotations mark the definition as deprecated.
+//
+//   <formatDoc (deprecatedDoc .Doc .Annotations)>type Foo
+//
+// deprecation(Annotations): Returns the "Deprecated:" paragraph for a
+// definition with the given annotations, or an empty string.
 func (g *generator) DeclareFromTemplate(s string, data interface{}, opts ...TemplateOption) error {
 	return g.declare(false, s, data, opts...)
 }
@@ -504,7 +925,7 @@ func (g *generator) Write(w io.Writer, _ *token.FileSet) error {
 		Tabwidth: 8,
 	}
 
-	if importDecl := g.importDecl(); importDecl != nil {
+	if importDecl := g.importDecl(g.decls); importDecl != nil {
 		if err := cfg.Fprint(w, g.fset, importDecl); err != nil {
 			return err
 		}
@@ -548,6 +969,7 @@ func formatDoc(s string) string {
 	}
 	lines := strings.Split(s, "\n")
 	for i, l := range lines {
+		l = strings.TrimRight(l, " \t")
 		if len(l) == 0 {
 			lines[i] = "//"
 		} else {
@@ -0,0 +1,232 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestThriftDocsInGodoc(t *testing.T) {
+	docs := generatedDocs(t, "kv", `
+		/** Default port to listen on. */
+		const i32 defaultPort = 8080
+
+		/** Consistency of reads. */
+		enum Consistency {
+			/** Reads may be stale. */
+			EVENTUAL,
+		}
+
+		/** Key identifies a value. */
+		typedef string Key
+
+		/**
+		 * Entry is a key and its value.
+		 */
+		struct Entry {
+			/**
+			 * Key of the entry.   
+			 */
+			1: required Key key
+		}
+
+		/** KeyValue stores entries. */
+		service KeyValue {
+			/** Returns the entry for a key. */
+			Entry get(1: Key key)
+		}
+	`)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"DefaultPort", "Default port to listen on."},
+		{"Consistency", "Consistency of reads."},
+		{"ConsistencyEventual", "Reads may be stale."},
+		{"Key", "Key identifies a value."},
+		{"Entry", "Entry is a key and its value."},
+		{"Entry.Key", "Key of the entry."},
+		{"KeyValue_Interface", "KeyValue stores entries."},
+		{"KeyValue_Interface.Get", "Returns the entry for a key."},
+		{"KeyValue_Get_Args", "Returns the entry for a key."},
+		{"KeyValue_Get_Helper", "Returns the entry for a key."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := docs[tt.name]
+			require.True(t, ok, "%v was not declared", tt.name)
+			assert.Contains(t, got, tt.want)
+		})
+	}
+}
+
+func TestDeprecatedInGodoc(t *testing.T) {
+	docs := generatedDocs(t, "widgets", `
+		enum Color {
+			RED,
+			CRIMSON (deprecated = "use RED"),
+		} (deprecated = "use Paint")
+
+		typedef string WidgetID (deprecated = "use  the id field ")
+
+		/** Widget is a thing. */
+		struct Widget {
+			1: required string id
+			/** Name of the widget. */
+			2: optional string name (deprecated = "use label")
+			3: optional string label
+			4: optional Color color (deprecated)
+		}
+
+		exception OldError {
+			1: optional string message
+		} (deprecated = "no longer thrown")
+
+		service Widgets {
+			/** Returns the widget with the given name. */
+			Widget getByName(1: string name) (deprecated = "use get")
+		} (deprecated = "use WidgetStore")
+	`)
+
+	tests := []struct {
+		name string
+		want string // last paragraph of the doc
+	}{
+		{"Color", "Deprecated: use Paint"},
+		{"ColorCrimson", "Deprecated: use RED"},
+		{"WidgetID", "Deprecated: use the id field"},
+		{"Widget.Name", "Deprecated: use label"},
+		{"Widget.Color", "Deprecated: Do not use."},
+		{"Widget.GetName", "Deprecated: use label"},
+		{"OldError", "Deprecated: no longer thrown"},
+		{"Widgets_Interface", "Deprecated: use WidgetStore"},
+		{"Widgets_Interface.GetByName", "Deprecated: use get"},
+		{"Widgets_GetByName_Args", "Deprecated: use get"},
+		{"Widgets_GetByName_Helper", "Deprecated: use get"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := docs[tt.name]
+			require.True(t, ok, "%v was not declared", tt.name)
+			paragraphs := strings.Split(strings.TrimSpace(got), "\n\n")
+			assert.Equal(t, tt.want, paragraphs[len(paragraphs)-1])
+		})
+	}
+
+	for _, name := range []string{"ColorRed", "Widget.ID", "Widget.Label", "Widget.GetLabel"} {
+		assert.NotContains(t, docs[name], "Deprecated", "%v must not be deprecated", name)
+	}
+}
+
+// generatedDocs generates code with procedures for a Thrift file with the
+// given name and contents, and returns the docs of the declarations in it.
+// Top-level declarations are keyed by their names, and fields, interface
+// methods, and methods by "Type.Name".
+func generatedDocs(t *testing.T, name, idl string) map[string]string {
+	thriftRoot := t.TempDir()
+	path := filepath.Join(thriftRoot, name+".thrift")
+	require.NoError(t, os.WriteFile(path, []byte(idl), 0o644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	outputDir := t.TempDir()
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "example.com/gen",
+		ThriftRoot:    thriftRoot,
+		Procedures:    true,
+	}))
+
+	src, err := os.ReadFile(filepath.Join(outputDir, name, name+".go"))
+	require.NoError(t, err)
+	for _, line := range strings.Split(string(src), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "//") {
+			assert.Equal(t, strings.TrimRight(line, " \t"), line,
+				"comments must not have trailing whitespace")
+		}
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), name+".go", src, parser.ParseComments)
+	require.NoError(t, err)
+
+	docs := make(map[string]string)
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil {
+				typ := decl.Recv.List[0].Type
+				if star, ok := typ.(*ast.StarExpr); ok {
+					typ = star.X
+				}
+				name = typ.(*ast.Ident).Name + "." + name
+			}
+			docs[name] = decl.Doc.Text()
+
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					d := spec.Doc
+					if d == nil {
+						d = decl.Doc
+					}
+					for _, name := range spec.Names {
+						docs[name.Name] = d.Text()
+					}
+
+				case *ast.TypeSpec:
+					docs[spec.Name.Name] = decl.Doc.Text()
+					var fields *ast.FieldList
+					switch typ := spec.Type.(type) {
+					case *ast.StructType:
+						fields = typ.Fields
+					case *ast.InterfaceType:
+						fields = typ.Methods
+					}
+					if fields == nil {
+						continue
+					}
+					for _, field := range fields.List {
+						for _, name := range field.Names {
+							docs[spec.Name.Name+"."+name.Name] = field.Doc.Text()
+						}
+					}
+				}
+			}
+		}
+	}
+	return docs
+}
@@ -0,0 +1,510 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/protocol/tjson"
+	"go.uber.org/thriftrw/thriftuuid"
+)
+
+// errGoldenUnordered is returned when encoding a golden value which holds a
+// set or map with several items. Generated code encodes these in no
+// particular order.
+var errGoldenUnordered = errors.New("sets and maps with several items are encoded in no particular order")
+
+// goldenCase is a struct, union, or exception with a representative value
+// and its encodings.
+type goldenCase struct {
+	Spec  *compile.StructSpec
+	Value compile.ConstantValue
+
+	Binary     []byte
+	ThriftJSON []byte // nil if unavailable
+}
+
+// goldenCorpus generates a TestGolden<Name> function for each struct, union,
+// and exception in the given types, which checks that a representative value
+// of the type encodes to the bytes the generator encoded it to. It returns
+// false if there was nothing to test.
+//
+// The generator encodes these values from the Thrift definitions alone,
+// rather than with generated code, so that the tests catch changes to the
+// encodings of generated code and of the runtime library alike.
+func goldenCorpus(g Generator, types map[string]compile.TypeSpec) (bool, error) {
+	var cases []goldenCase
+	for _, name := range sortStringKeys(types) {
+		spec, ok := types[name].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+		v, ok := sampleValue(spec, 0)
+		if !ok {
+			continue
+		}
+
+		bin, err := goldenEncode(g, binary.Default, v, spec)
+		if err == errGoldenUnordered {
+			continue
+		}
+		if err != nil {
+			return false, wrapGenerateError(spec.ThriftName(), err)
+		}
+
+		c := goldenCase{Spec: spec, Value: v, Binary: bin}
+		if checkThriftJSON(g) {
+			// TJSONProtocol cannot represent every value, such as maps
+			// keyed by structs. These are only tested with Binary.
+			if b, err := goldenEncode(g, tjson.Default, v, spec); err == nil {
+				c.ThriftJSON = b
+			}
+		}
+		cases = append(cases, c)
+	}
+	if len(cases) == 0 {
+		return false, nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$testing := import "testing">
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		type _goldenValue interface {
+			ToWire() (<$wire>.Value, error)
+			FromWire(<$wire>.Value) error
+			<- if not (checkNoStreaming)>
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+			Encode(<$stream>.Writer) error
+			Decode(<$stream>.Reader) error
+			<- end>
+			<- if checkThriftJSON>
+			MarshalThriftJSON() ([]byte, error)
+			UnmarshalThriftJSON([]byte) error
+			<- end>
+		}
+
+		func _goldenToWire(v _goldenValue) ([]byte, error) {
+			w, err := v.ToWire()
+			if err != nil {
+				return nil, err
+			}
+			var buff <$bytes>.Buffer
+			err = <$binary>.Default.Encode(w, &buff)
+			return buff.Bytes(), err
+		}
+		<- if not (checkNoStreaming)>
+
+		func _goldenEncode(v _goldenValue) ([]byte, error) {
+			var buff <$bytes>.Buffer
+			sw := <$binary>.Default.Writer(&buff)
+			if err := v.Encode(sw); err != nil {
+				return nil, err
+			}
+			err := sw.Close()
+			return buff.Bytes(), err
+		}
+		<- end>
+
+		// _goldenCheck checks that give encodes to the golden bytes with each
+		// serialization method, and that decoding the golden bytes and
+		// encoding the result reproduces them. newValue returns an empty
+		// value of the same type to decode into. wantThriftJSON is nil if
+		// the value is not tested with TJSONProtocol.
+		func _goldenCheck(t *<$testing>.T, give _goldenValue, newValue func() _goldenValue, wantBinary, wantThriftJSON []byte) {
+			t.Helper()
+
+			check := func(t *<$testing>.T, got []byte, err error, want []byte) {
+				t.Helper()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !<$bytes>.Equal(got, want) {
+					t.Errorf("encoding changed:\n got %q\nwant %q", got, want)
+				}
+			}
+
+			t.Run("ToWire", func(t *<$testing>.T) {
+				got, err := _goldenToWire(give)
+				check(t, got, err, wantBinary)
+			})
+
+			t.Run("FromWire", func(t *<$testing>.T) {
+				w, err := <$binary>.Default.Decode(<$bytes>.NewReader(wantBinary), <$wire>.TStruct)
+				if err != nil {
+					t.Fatal(err)
+				}
+				v := newValue()
+				if err := v.FromWire(w); err != nil {
+					t.Fatal(err)
+				}
+				got, err := _goldenToWire(v)
+				check(t, got, err, wantBinary)
+			})
+			<- if not (checkNoStreaming)>
+
+			t.Run("Encode", func(t *<$testing>.T) {
+				got, err := _goldenEncode(give)
+				check(t, got, err, wantBinary)
+			})
+
+			t.Run("Decode", func(t *<$testing>.T) {
+				sr := <$binary>.Default.Reader(<$bytes>.NewReader(wantBinary))
+				v := newValue()
+				if err := v.Decode(sr); err != nil {
+					t.Fatal(err)
+				}
+				if err := sr.Close(); err != nil {
+					t.Fatal(err)
+				}
+				got, err := _goldenEncode(v)
+				check(t, got, err, wantBinary)
+			})
+			<- end>
+			<- if checkThriftJSON>
+
+			if wantThriftJSON == nil {
+				return
+			}
+
+			t.Run("MarshalThriftJSON", func(t *<$testing>.T) {
+				got, err := give.MarshalThriftJSON()
+				check(t, got, err, wantThriftJSON)
+			})
+
+			t.Run("UnmarshalThriftJSON", func(t *<$testing>.T) {
+				v := newValue()
+				if err := v.UnmarshalThriftJSON(wantThriftJSON); err != nil {
+					t.Fatal(err)
+				}
+				got, err := v.MarshalThriftJSON()
+				check(t, got, err, wantThriftJSON)
+			})
+			<- end>
+		}
+
+		<range .Cases>
+			<$name := goName .Spec>
+			var _<$name>_goldenBinary = <goldenBytes .Binary>
+			<- if .ThriftJSON>
+
+			var _<$name>_goldenThriftJSON = <goldenBytes .ThriftJSON>
+			<- end>
+
+			// TestGolden<$name> checks that the encoding of a representative
+			// <$name> has not changed.
+			func TestGolden<$name>(t *<$testing>.T) {
+				give := <constantValue .Value .Spec>
+				_goldenCheck(t, give, func() _goldenValue {
+					return new(<$name>)
+				}, _<$name>_goldenBinary, <if .ThriftJSON>_<$name>_goldenThriftJSON<else>nil<end>)
+			}
+		<end>
+		`,
+		struct {
+			Cases []goldenCase
+		}{Cases: cases},
+		TemplateFunc("checkNoStreaming", checkNoStreaming),
+		TemplateFunc("checkThriftJSON", checkThriftJSON),
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("goldenBytes", goldenBytes),
+	)
+	return true, wrapGenerateError("golden corpus", err)
+}
+
+// goldenEncode encodes the given value of a struct with the given protocol
+// as generated code would.
+func goldenEncode(g Generator, p stream.Protocol, v compile.ConstantValue, spec *compile.StructSpec) ([]byte, error) {
+	var buff bytes.Buffer
+	sw := p.Writer(&buff)
+	e := goldenEncoder{g: g, sw: sw}
+	if err := e.encode(v, spec); err != nil {
+		return nil, err
+	}
+	err := sw.Close()
+	return buff.Bytes(), err
+}
+
+type goldenEncoder struct {
+	g  Generator
+	sw stream.Writer
+}
+
+func (e goldenEncoder) encode(v compile.ConstantValue, t compile.TypeSpec) error {
+	if ref, ok := v.(compile.ConstReference); ok {
+		return e.encode(ref.Target.Value, t)
+	}
+
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		b, ok := v.(compile.ConstantBool)
+		if !ok {
+			break
+		}
+		return e.sw.WriteBool(bool(b))
+	case *compile.I8Spec:
+		i, ok := v.(compile.ConstantInt)
+		if !ok {
+			break
+		}
+		return e.sw.WriteInt8(int8(i))
+	case *compile.I16Spec:
+		i, ok := v.(compile.ConstantInt)
+		if !ok {
+			break
+		}
+		return e.sw.WriteInt16(int16(i))
+	case *compile.I32Spec:
+		i, ok := v.(compile.ConstantInt)
+		if !ok {
+			break
+		}
+		return e.sw.WriteInt32(int32(i))
+	case *compile.I64Spec:
+		i, ok := v.(compile.ConstantInt)
+		if !ok {
+			break
+		}
+		return e.sw.WriteInt64(int64(i))
+	case *compile.DoubleSpec:
+		d, ok := v.(compile.ConstantDouble)
+		if !ok {
+			break
+		}
+		return e.sw.WriteDouble(float64(d))
+	case *compile.StringSpec:
+		s, ok := v.(compile.ConstantString)
+		if !ok {
+			break
+		}
+		return e.sw.WriteString(string(s))
+	case *compile.BinarySpec:
+		s, ok := v.(compile.ConstantString)
+		if !ok {
+			break
+		}
+		return e.sw.WriteBinary([]byte(s))
+	case *compile.UUIDSpec:
+		s, ok := v.(compile.ConstantString)
+		if !ok {
+			break
+		}
+		u, err := thriftuuid.Parse(string(s))
+		if err != nil {
+			return err
+		}
+		return e.sw.WriteBinary(u[:])
+	case *compile.EnumSpec:
+		switch c := v.(type) {
+		case compile.EnumItemReference:
+			return e.sw.WriteInt32(c.Item.Value)
+		case compile.ConstantInt:
+			return e.sw.WriteInt32(int32(c))
+		}
+	case *compile.ListSpec:
+		l, ok := v.(compile.ConstantList)
+		if !ok {
+			break
+		}
+		return e.encodeList(spec.ValueSpec, l)
+	case *compile.SetSpec:
+		s, ok := v.(compile.ConstantSet)
+		if !ok {
+			break
+		}
+		if len(s) > 1 {
+			return errGoldenUnordered
+		}
+		if err := e.sw.WriteSetBegin(stream.SetHeader{Type: spec.ValueSpec.TypeCode(), Length: len(s)}); err != nil {
+			return err
+		}
+		for _, item := range s {
+			if err := e.encode(item, spec.ValueSpec); err != nil {
+				return err
+			}
+		}
+		return e.sw.WriteSetEnd()
+	case *compile.MapSpec:
+		m, ok := v.(compile.ConstantMap)
+		if !ok {
+			break
+		}
+		if len(m) > 1 {
+			return errGoldenUnordered
+		}
+		err := e.sw.WriteMapBegin(stream.MapHeader{
+			KeyType:   spec.KeySpec.TypeCode(),
+			ValueType: spec.ValueSpec.TypeCode(),
+			Length:    len(m),
+		})
+		if err != nil {
+			return err
+		}
+		for _, item := range m {
+			if err := e.encode(item.Key, spec.KeySpec); err != nil {
+				return err
+			}
+			if err := e.encode(item.Value, spec.ValueSpec); err != nil {
+				return err
+			}
+		}
+		return e.sw.WriteMapEnd()
+	case *compile.StructSpec:
+		s, ok := v.(*compile.ConstantStruct)
+		if !ok {
+			break
+		}
+		return e.encodeStruct(spec, s)
+	}
+	return fmt.Errorf("cannot encode constant %v of type %T as %v", v, v, t.ThriftName())
+}
+
+func (e goldenEncoder) encodeList(t compile.TypeSpec, l compile.ConstantList) error {
+	if err := e.sw.WriteListBegin(stream.ListHeader{Type: t.TypeCode(), Length: len(l)}); err != nil {
+		return err
+	}
+	for _, item := range l {
+		if err := e.encode(item, t); err != nil {
+			return err
+		}
+	}
+	return e.sw.WriteListEnd()
+}
+
+// encodeStruct encodes the fields of a struct in the order in which they
+// were declared. As in generated code, unset fields of structs are encoded
+// with their default values, and fields set to their default values are
+// left out if defaults are omitted.
+func (e goldenEncoder) encodeStruct(spec *compile.StructSpec, s *compile.ConstantStruct) error {
+	omitDefaults := checkOmitDefaults(e.g)
+	if v, ok := spec.Annotations[omitDefaultKey]; ok {
+		var err error
+		omitDefaults, err = strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %v annotation: %q is not a boolean", omitDefaultKey, v)
+		}
+	}
+	fg := fieldGroupGenerator{OmitDefaults: omitDefaults}
+
+	if err := e.sw.WriteStructBegin(); err != nil {
+		return err
+	}
+	for _, f := range spec.Fields {
+		v, ok := s.Fields[f.Name]
+
+		omit, err := fg.omitDefault(f)
+		if err != nil {
+			return err
+		}
+		switch {
+		case omit && ok:
+			same, err := e.sameEncoding(v, f.Default, f.Type)
+			if err != nil {
+				return err
+			}
+			if same {
+				continue
+			}
+		case !ok && f.Default != nil && spec.Type != ast.UnionType && !omit:
+			v = f.Default
+		case !ok:
+			continue
+		}
+
+		if err := e.sw.WriteFieldBegin(stream.FieldHeader{ID: f.ID, Type: f.Type.TypeCode()}); err != nil {
+			return err
+		}
+		if err := e.encode(v, f.Type); err != nil {
+			return err
+		}
+		if err := e.sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+	return e.sw.WriteStructEnd()
+}
+
+// sameEncoding reports whether the given values of the given type have the
+// same Binary encoding.
+func (e goldenEncoder) sameEncoding(a, b compile.ConstantValue, t compile.TypeSpec) (bool, error) {
+	encode := func(v compile.ConstantValue) ([]byte, error) {
+		var buff bytes.Buffer
+		sw := binary.Default.Writer(&buff)
+		err := goldenEncoder{g: e.g, sw: sw}.encode(v, t)
+		return buff.Bytes(), err
+	}
+
+	ab, err := encode(a)
+	if err != nil {
+		return false, err
+	}
+	bb, err := encode(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ab, bb), nil
+}
+
+// goldenBytes renders the given bytes as a Go expression of type []byte,
+// split across lines. Text is rendered in raw string literals.
+func goldenBytes(b []byte) string {
+	const textChunk, binaryChunk = 72, 32
+
+	s := string(b)
+	raw := strconv.CanBackquote(s)
+
+	var chunks []string
+	for len(s) > 0 {
+		n := len(s)
+		switch {
+		case raw && n > textChunk:
+			n = textChunk
+			for !utf8.RuneStart(s[n]) {
+				n--
+			}
+		case !raw && n > binaryChunk:
+			n = binaryChunk
+		}
+
+		if raw {
+			chunks = append(chunks, "`"+s[:n]+"`")
+		} else {
+			chunks = append(chunks, strconv.QuoteToASCII(s[:n]))
+		}
+		s = s[n:]
+	}
+	if len(chunks) == 0 {
+		return "[]byte{}"
+	}
+	return "[]byte(" + strings.Join(chunks, " +\n") + ")"
+}
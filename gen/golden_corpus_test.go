@@ -0,0 +1,124 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/tjson"
+)
+
+func TestGoldenEncode(t *testing.T) {
+	tags := &compile.FieldSpec{
+		ID:      1,
+		Name:    "tags",
+		Type:    &compile.SetSpec{ValueSpec: &compile.StringSpec{}},
+		Default: compile.ConstantSet{compile.ConstantString("a"), compile.ConstantString("b")},
+	}
+	count := &compile.FieldSpec{
+		ID:      2,
+		Name:    "count",
+		Type:    &compile.I32Spec{},
+		Default: compile.ConstantInt(1),
+	}
+	structOf := func(typ ast.StructureType, fields ...*compile.FieldSpec) *compile.StructSpec {
+		return &compile.StructSpec{Name: "Foo", Type: typ, Fields: fields}
+	}
+	value := func(fields map[string]compile.ConstantValue) *compile.ConstantStruct {
+		return &compile.ConstantStruct{Fields: fields}
+	}
+
+	t.Run("defaults of unset fields", func(t *testing.T) {
+		got, err := goldenEncode(nil, tjson.Default, value(nil), structOf(ast.StructType, count))
+		require.NoError(t, err)
+		assert.Equal(t, `{"2":{"i32":1}}`, string(got))
+	})
+
+	t.Run("defaults of unions", func(t *testing.T) {
+		got, err := goldenEncode(nil, tjson.Default, value(nil), structOf(ast.UnionType, count))
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, string(got))
+	})
+
+	t.Run("omitted defaults", func(t *testing.T) {
+		omitted := *count
+		omitted.Annotations = compile.Annotations{omitDefaultKey: "true"}
+		spec := structOf(ast.StructType, &omitted)
+
+		got, err := goldenEncode(nil, tjson.Default, value(map[string]compile.ConstantValue{
+			"count": compile.ConstantInt(1),
+		}), spec)
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, string(got))
+
+		got, err = goldenEncode(nil, tjson.Default, value(map[string]compile.ConstantValue{
+			"count": compile.ConstantInt(2),
+		}), spec)
+		require.NoError(t, err)
+		assert.Equal(t, `{"2":{"i32":2}}`, string(got))
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		_, err := goldenEncode(nil, binary.Default, value(nil), structOf(ast.StructType, tags))
+		assert.Equal(t, errGoldenUnordered, err)
+
+		_, err = goldenEncode(nil, binary.Default, value(map[string]compile.ConstantValue{
+			"tags": compile.ConstantSet{compile.ConstantString("a")},
+		}), structOf(ast.StructType, tags))
+		assert.NoError(t, err, "sets with one item are ordered")
+	})
+
+	t.Run("mismatched constant", func(t *testing.T) {
+		_, err := goldenEncode(nil, binary.Default, value(map[string]compile.ConstantValue{
+			"count": compile.ConstantString("one"),
+		}), structOf(ast.StructType, count))
+		assert.EqualError(t, err, `cannot encode constant one of type compile.ConstantString as i32`)
+	})
+}
+
+func TestGoldenBytes(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want string
+	}{
+		{desc: "empty", give: "", want: "[]byte{}"},
+		{desc: "text", give: `{"1":{"str":"é"}}`, want: "[]byte(`{\"1\":{\"str\":\"é\"}}`)"},
+		{desc: "binary", give: "\x0b\x00\x01é", want: `[]byte("\v\x00\x01\u00e9")`},
+		{
+			desc: "long text",
+			give: strings.Repeat("a", 80),
+			want: "[]byte(`" + strings.Repeat("a", 72) + "` +\n`aaaaaaaa`)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, goldenBytes([]byte(tt.give)))
+		})
+	}
+}
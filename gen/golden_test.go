@@ -46,6 +46,173 @@ var enumTextMarshalStrictFiles = map[string]struct{}{
 	"enum-text-marshal-strict": {},
 }
 
+// Set of files that are passed a --omit-defaults flag in code generation
+var omitDefaultsFiles = map[string]struct{}{
+	"omit-defaults": {},
+}
+
+// Set of files that are passed a --http-handlers flag in code generation
+var httpHandlersFiles = map[string]struct{}{
+	"http-handlers": {},
+}
+
+// Set of files that are passed a --procedures flag in code generation
+var proceduresFiles = map[string]struct{}{
+	"only-servers":    {},
+	"procedures":      {},
+	"router":          {},
+	"router-prefix":   {},
+	"source-comments": {},
+}
+
+// Set of files that are passed a --router flag in code generation, mapped
+// to whether they are also passed a --router-prefix flag.
+var routerFiles = map[string]bool{
+	"router":        false,
+	"router-prefix": true,
+}
+
+// Set of files that are passed a --preserve-unknown-fields flag in code
+// generation
+var preserveUnknownFieldsFiles = map[string]struct{}{
+	"unknown-fields": {},
+}
+
+// Set of files that are passed a --aggregate-errors flag in code generation
+var aggregateErrorsFiles = map[string]struct{}{
+	"aggregate-errors": {},
+}
+
+// Set of files that are passed a --test-helpers flag in code generation
+var testHelpersFiles = map[string]struct{}{
+	"test-helpers": {},
+}
+
+// Set of files that are passed a --dual-encode flag in code generation
+var dualEncodeFiles = map[string]struct{}{
+	"dual-encode": {},
+}
+
+// Set of files that are passed a --builders flag in code generation
+var buildersFiles = map[string]struct{}{
+	"builders":      {},
+	"presence-bits": {},
+}
+
+// Set of files that are passed a --presence-bits flag in code generation
+var presenceBitsFiles = map[string]struct{}{
+	"presence-bits": {},
+}
+
+// Set of files that are passed a --source-comments flag in code generation
+var sourceCommentsFiles = map[string]struct{}{
+	"source-comments": {},
+}
+
+// Set of files that are passed a --setters flag in code generation
+var settersFiles = map[string]struct{}{
+	"setters": {},
+}
+
+// Set of files that are passed a --type-specs flag in code generation
+var typeSpecsFiles = map[string]struct{}{
+	"type-specs": {},
+}
+
+// Set of files that are passed a --service-specs flag in code generation
+var serviceSpecsFiles = map[string]struct{}{
+	"service-specs": {},
+}
+
+// Set of files that are passed a --lazy-structs flag in code generation
+var lazyStructsFiles = map[string]struct{}{
+	"lazy": {},
+}
+
+// Files that are generated with --only, mapped to its value
+var onlyFiles = map[string]string{
+	"only-types":   OnlyTypes,
+	"only-clients": OnlyClients,
+	"only-servers": OnlyServers,
+}
+
+// Files that are generated with --missing-required, mapped to its value
+var missingRequiredFiles = map[string]string{
+	"missing-required-hook": MissingRequiredHook,
+	"missing-required-zero": MissingRequiredZero,
+}
+
+// Set of files that are passed a --no-streaming flag in code generation
+var noStreamingFiles = map[string]struct{}{
+	"no-streaming": {},
+}
+
+// Set of files that are passed a --pprof-labels flag in code generation
+var pprofLabelsFiles = map[string]struct{}{
+	"pprof-labels": {},
+}
+
+// Set of files that are passed a --benchmarks flag in code generation
+var benchmarksFiles = map[string]struct{}{
+	"benchmarks":  {},
+	"bound_types": {},
+}
+
+// Set of files that are passed a --thrift-json flag in code generation
+var thriftJSONFiles = map[string]struct{}{
+	"thrift-json":   {},
+	"golden-corpus": {},
+}
+
+// Set of files that are passed a --yaml flag in code generation
+var yamlFiles = map[string]struct{}{
+	"yaml": {},
+}
+
+// Set of files that are passed a --quick-generators flag in code generation
+var quickGeneratorsFiles = map[string]struct{}{
+	"quick-generators": {},
+}
+
+// Set of files that are passed a --compare flag in code generation
+var compareFiles = map[string]struct{}{
+	"compare": {},
+}
+
+// Set of files that are passed a --constant-functions flag in code generation
+var constantFunctionsFiles = map[string]struct{}{
+	"constant-functions": {},
+}
+
+// Set of files that are passed a --encoded-size flag in code generation
+var encodedSizeFiles = map[string]struct{}{
+	"encoded-size": {},
+	"append-to":    {},
+}
+
+// Set of files that are passed a --append-to flag in code generation
+var appendToFiles = map[string]struct{}{
+	"append-to": {},
+}
+
+// Set of files that are passed a --golden-corpus flag in code generation
+var goldenCorpusFiles = map[string]struct{}{
+	"bound_types":   {},
+	"golden-corpus": {},
+}
+
+// Set of files that are passed a --fuzz-targets flag in code generation
+var fuzzTargetsFiles = map[string]struct{}{
+	"bound_types":           {},
+	"fuzz":                  {},
+	"missing-required-zero": {},
+}
+
+// Set of files that are generated with --target tinygo
+var tinyGoFiles = map[string]struct{}{
+	"tinygo": {},
+}
+
 func TestCodeIsUpToDate(t *testing.T) {
 	// This test just verifies that the generated code in internal/tests/ is up to
 	// date. If this test failed, run 'make' in the internal/tests/ directory and
@@ -74,6 +241,37 @@ func TestCodeIsUpToDate(t *testing.T) {
 
 		_, nozap := noZapFiles[pkgRelPath]
 		_, enumTextMarshalStrict := enumTextMarshalStrictFiles[pkgRelPath]
+		_, omitDefaults := omitDefaultsFiles[pkgRelPath]
+		_, httpHandlers := httpHandlersFiles[pkgRelPath]
+		_, procedures := proceduresFiles[pkgRelPath]
+		routerPrefix, router := routerFiles[pkgRelPath]
+		_, preserveUnknownFields := preserveUnknownFieldsFiles[pkgRelPath]
+		_, lazyStructs := lazyStructsFiles[pkgRelPath]
+		_, aggregateErrors := aggregateErrorsFiles[pkgRelPath]
+		_, testHelpers := testHelpersFiles[pkgRelPath]
+		_, dualEncode := dualEncodeFiles[pkgRelPath]
+		_, builders := buildersFiles[pkgRelPath]
+		_, typeSpecs := typeSpecsFiles[pkgRelPath]
+		_, serviceSpecs := serviceSpecsFiles[pkgRelPath]
+		_, setters := settersFiles[pkgRelPath]
+		_, presenceBits := presenceBitsFiles[pkgRelPath]
+		_, sourceComments := sourceCommentsFiles[pkgRelPath]
+		_, noStreaming := noStreamingFiles[pkgRelPath]
+		_, pprofLabels := pprofLabelsFiles[pkgRelPath]
+		_, benchmarks := benchmarksFiles[pkgRelPath]
+		_, thriftJSON := thriftJSONFiles[pkgRelPath]
+		_, goldenCorpus := goldenCorpusFiles[pkgRelPath]
+		_, fuzzTargets := fuzzTargetsFiles[pkgRelPath]
+		_, yaml := yamlFiles[pkgRelPath]
+		_, quickGenerators := quickGeneratorsFiles[pkgRelPath]
+		_, compare := compareFiles[pkgRelPath]
+		_, constantFunctions := constantFunctionsFiles[pkgRelPath]
+		_, encodedSize := encodedSizeFiles[pkgRelPath]
+		_, appendTo := appendToFiles[pkgRelPath]
+		target := TargetGo
+		if _, ok := tinyGoFiles[pkgRelPath]; ok {
+			target = TargetTinyGo
+		}
 		err = Generate(module, &Options{
 			OutputDir:             outputDir,
 			PackagePrefix:         "go.uber.org/thriftrw/gen/internal/tests",
@@ -81,6 +279,37 @@ func TestCodeIsUpToDate(t *testing.T) {
 			NoRecurse:             true,
 			NoZap:                 nozap,
 			EnumTextMarshalStrict: enumTextMarshalStrict,
+			OmitDefaults:          omitDefaults,
+			HTTPHandlers:          httpHandlers,
+			Procedures:            procedures,
+			Router:                router,
+			RouterPrefix:          routerPrefix,
+			PreserveUnknownFields: preserveUnknownFields,
+			LazyStructs:           lazyStructs,
+			AggregateErrors:       aggregateErrors,
+			TestHelpers:           testHelpers,
+			DualEncode:            dualEncode,
+			Builders:              builders,
+			TypeSpecs:             typeSpecs,
+			ServiceSpecs:          serviceSpecs,
+			Setters:               setters,
+			PresenceBits:          presenceBits,
+			SourceComments:        sourceComments,
+			Only:                  onlyFiles[pkgRelPath],
+			MissingRequired:       missingRequiredFiles[pkgRelPath],
+			NoStreaming:           noStreaming,
+			PprofLabels:           pprofLabels,
+			Benchmarks:            benchmarks,
+			ThriftJSON:            thriftJSON,
+			GoldenCorpus:          goldenCorpus,
+			FuzzTargets:           fuzzTargets,
+			YAML:                  yaml,
+			QuickGenerators:       quickGenerators,
+			Compare:               compare,
+			ConstantFunctions:     constantFunctions,
+			EncodedSize:           encodedSize,
+			AppendTo:              appendTo,
+			Target:                target,
 		})
 		require.NoError(t, err, "failed to generate code for %q", thriftFile)
 
@@ -20,6 +20,14 @@
 
 package gen
 
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
 const (
 	// goTypeKey is a Thrift annotation that allows overriding the type of
 	// a typedef target type or a struct field type. By default, thrift set type
@@ -29,7 +37,75 @@ const (
 	//
 	//     (go.type = "slice")
 	//
-	// Currently, only thrift set to go slice type overriding is supported.
-	goTypeKey = "go.type"
-	sliceType = "slice"
+	// Similarly, the following annotation on a map type causes thriftrw to
+	// generate a slice of key-value pairs, as it does for maps whose keys
+	// are not hashable.
+	//
+	//     (go.type = "keyvalue-slice")
+	//
+	// Typedefs of such maps get Get and Has methods to look up keys.
+	//
+	// Maps may also be annotated with a Go type, named by its import path
+	// and name, to use it as the container for the map.
+	//
+	//     (go.type = "example.com/ordered.StringMap")
+	//
+	// Fields then hold a pointer to that type. See mapContainer.
+	goTypeKey         = "go.type"
+	sliceType         = "slice"
+	keyValueSliceType = "keyvalue-slice"
 )
+
+// mapContainer is a Go type which stores the items of maps annotated with
+// it instead of a Go map. The zero value of the type must be ready to use,
+// and pointers to it must implement the following methods, where K and V
+// are the Go types of the keys and values of the map.
+//
+//	Len() int
+//	Get(key K) (value V, ok bool)
+//	Put(key K, value V)
+//	ForEach(f func(key K, value V) error) error
+//
+// ForEach must stop and return the error if f returns one, and it decides
+// the order in which items of the map are encoded.
+type mapContainer struct {
+	ImportPath string
+	Name       string
+}
+
+// mapContainerType returns the container type that the given map is
+// annotated to use, or nil if it does not use one.
+func mapContainerType(spec *compile.MapSpec) (*mapContainer, error) {
+	v := spec.Annotations[goTypeKey]
+	if !strings.Contains(v, ".") {
+		return nil, nil
+	}
+
+	importPath, name, ok := splitGoName(v)
+	if !ok {
+		return nil, fmt.Errorf(
+			"invalid %v annotation %q: expected a Go type as in %q",
+			goTypeKey, v, "example.com/ordered.StringMap")
+	}
+	return &mapContainer{ImportPath: importPath, Name: name}, nil
+}
+
+// splitGoName splits a reference to an exported Go declaration, like
+// "example.com/ordered.StringMap", into its import path and name.
+func splitGoName(v string) (importPath, name string, ok bool) {
+	i := strings.LastIndexByte(v, '.')
+	if i < 0 {
+		return "", "", false
+	}
+
+	importPath, name = v[:i], v[i+1:]
+	ok = importPath != "" && token.IsIdentifier(name) && token.IsExported(name)
+	return importPath, name, ok
+}
+
+// isMapContainer returns true if the given map is annotated to use a
+// container type. The annotation may still be invalid; mapContainerType
+// reports that.
+func isMapContainer(spec *compile.MapSpec) bool {
+	return strings.Contains(spec.Annotations[goTypeKey], ".")
+}
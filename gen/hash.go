@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// hashGenerator is responsible for generating code that hashes Thrift
+// values with a thrifthash.Hasher.
+type hashGenerator struct {
+	mapG     mapGenerator
+	setG     setGenerator
+	listG    listGenerator
+	typedefG typedefGenerator
+}
+
+// Hash generates a statement which writes v, a value of the given type, to
+// the thrifthash.Hasher h.
+func (hg *hashGenerator) Hash(g Generator, spec compile.TypeSpec, h, v string) (string, error) {
+	if t, ok := spec.(*compile.TypedefSpec); ok && isBoundTypedef(t) {
+		name, err := hg.typedefG.boundHash(g, t)
+		return fmt.Sprintf("%s.Uint64(%s(%s))", h, name, v), err
+	}
+
+	if isUUIDType(spec) {
+		return fmt.Sprintf("%s.Binary(%s.Bytes())", h, uuidValue(g, spec, v)), nil
+	}
+
+	if isPrimitiveType(spec) {
+		return hashPrimitive(spec, h, v), nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.BinarySpec:
+		return fmt.Sprintf("%s.Binary(%s)", h, v), nil
+	case *compile.MapSpec:
+		name, err := hg.mapG.Hash(g, s)
+		return fmt.Sprintf("%s.Uint64(%s(%s))", h, name, v), err
+	case *compile.ListSpec:
+		name, err := hg.listG.Hash(g, s)
+		return fmt.Sprintf("%s.Uint64(%s(%s))", h, name, v), err
+	case *compile.SetSpec:
+		name, err := hg.setG.Hash(g, s)
+		return fmt.Sprintf("%s.Uint64(%s(%s))", h, name, v), err
+	default:
+		// Custom defined type
+		return fmt.Sprintf("%s.Uint64(%s.Hash())", h, v), nil
+	}
+}
+
+// HashPtr is the same as Hash except v is expected to be a reference to a
+// value of the given type.
+func (hg *hashGenerator) HashPtr(g Generator, spec compile.TypeSpec, h, v string) (string, error) {
+	if !isPrimitiveType(spec) {
+		// Everything else is a reference type which Hash handles.
+		return hg.Hash(g, spec, h, v)
+	}
+	return hg.Hash(g, spec, h, "*"+v)
+}
+
+// hashPrimitive generates a statement which writes v, a value of the given
+// primitive type, to the thrifthash.Hasher h. Enums and typedefs are
+// converted to their underlying type.
+func hashPrimitive(spec compile.TypeSpec, h, v string) string {
+	root := compile.RootTypeSpec(spec)
+
+	var method, typ string
+	switch root.(type) {
+	case *compile.BoolSpec:
+		method, typ = "Bool", "bool"
+	case *compile.I8Spec:
+		method, typ = "Int8", "int8"
+	case *compile.I16Spec:
+		method, typ = "Int16", "int16"
+	case *compile.I32Spec, *compile.EnumSpec:
+		method, typ = "Int32", "int32"
+	case *compile.I64Spec:
+		method, typ = "Int64", "int64"
+	case *compile.DoubleSpec:
+		method, typ = "Double", "float64"
+	case *compile.StringSpec:
+		method, typ = "String", "string"
+	default:
+		panic(fmt.Sprintf("unknown primitive type (%T) %v", root, root))
+	}
+
+	if _, isEnum := root.(*compile.EnumSpec); isEnum || spec != root || isUnsigned(root) {
+		v = fmt.Sprintf("%s(%s)", typ, v)
+	}
+	return fmt.Sprintf("%s.%s(%s)", h, method, v)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"math"
+	"testing"
+
+	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashNil(t *testing.T) {
+	var s *ts.Point
+	assert.Equal(t, uint64(0), s.Hash())
+}
+
+func TestHashPrimitives(t *testing.T) {
+	empty := &ts.PrimitiveOptionalStruct{}
+	zero := &ts.PrimitiveOptionalStruct{Int32Field: ptr.Int32(0)}
+	one := &ts.PrimitiveOptionalStruct{Int32Field: ptr.Int32(1)}
+	other := &ts.PrimitiveOptionalStruct{Int64Field: ptr.Int64(1)}
+
+	assert.NotEqual(t, empty.Hash(), zero.Hash(), "unset fields must differ from zero values")
+	assert.NotEqual(t, zero.Hash(), one.Hash())
+	assert.NotEqual(t, one.Hash(), other.Hash(), "fields must be told apart by their IDs")
+	assert.Equal(t, one.Hash(), (&ts.PrimitiveOptionalStruct{Int32Field: ptr.Int32(1)}).Hash())
+
+	assert.Equal(t,
+		(&ts.PrimitiveOptionalStruct{BinaryField: []byte{}}).Hash(), empty.Hash(),
+		"nil and empty binary fields are equal")
+
+	assert.Equal(t,
+		(&ts.Point{X: math.Copysign(0, -1)}).Hash(), (&ts.Point{}).Hash(),
+		"positive and negative zero are equal")
+}
+
+func TestHashContainers(t *testing.T) {
+	give := &tc.ContainersOfContainers{
+		ListOfSets: []map[int32]struct{}{{1: {}, 2: {}, 3: {}}},
+		SetOfLists: [][]string{{"a"}, {"b", "c"}},
+		MapOfListToSet: []struct {
+			Key   []int32
+			Value map[int64]struct{}
+		}{
+			{Key: []int32{1}, Value: map[int64]struct{}{2: {}}},
+			{Key: []int32{3}, Value: map[int64]struct{}{4: {}}},
+		},
+	}
+
+	reordered := &tc.ContainersOfContainers{
+		ListOfSets: []map[int32]struct{}{{3: {}, 2: {}, 1: {}}},
+		SetOfLists: [][]string{{"b", "c"}, {"a"}},
+		MapOfListToSet: []struct {
+			Key   []int32
+			Value map[int64]struct{}
+		}{
+			{Key: []int32{3}, Value: map[int64]struct{}{4: {}}},
+			{Key: []int32{1}, Value: map[int64]struct{}{2: {}}},
+		},
+	}
+	assert.True(t, give.Equals(reordered))
+	assert.Equal(t, give.Hash(), reordered.Hash(), "sets and maps are unordered")
+
+	reordered.SetOfLists[1] = []string{"a", "b"}
+	assert.NotEqual(t, give.Hash(), reordered.Hash())
+
+	swapped := &tc.ContainersOfContainers{ListOfLists: [][]int32{{2, 1}}}
+	assert.NotEqual(t, (&tc.ContainersOfContainers{ListOfLists: [][]int32{{1, 2}}}).Hash(), swapped.Hash(),
+		"lists are ordered")
+}
+
+func TestHashUnion(t *testing.T) {
+	give := &tu.ArbitraryValue{MapValue: map[string]*tu.ArbitraryValue{
+		"foo": {StringValue: ptr.String("bar")},
+		"baz": {ListValue: []*tu.ArbitraryValue{{BoolValue: ptr.Bool(true)}}},
+	}}
+
+	assert.Equal(t, give.Hash(), give.Copy().Hash())
+	assert.NotEqual(t, give.Hash(), (&tu.ArbitraryValue{StringValue: ptr.String("bar")}).Hash())
+}
+
+func TestHashTypedefs(t *testing.T) {
+	assert.Equal(t, td.State("a").Hash(), td.State("a").Hash())
+	assert.NotEqual(t, td.State("a").Hash(), td.State("b").Hash())
+	assert.Equal(t, td.PDF(nil).Hash(), td.PDF{}.Hash())
+
+	give := td.StateMap{"a": 1, "b": 2}
+	assert.Equal(t, give.Hash(), td.StateMap{"b": 2, "a": 1}.Hash())
+	assert.NotEqual(t, give.Hash(), td.StateMap{"a": 2, "b": 1}.Hash())
+
+	transition := &td.Transition{FromState: "a", ToState: "b"}
+	assert.NotEqual(t, transition.Hash(), (&td.Transition{FromState: "b", ToState: "a"}).Hash())
+}
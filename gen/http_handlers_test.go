@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	th "go.uber.org/thriftrw/gen/internal/tests/http-handlers"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/thrifthttp"
+	"go.uber.org/thriftrw/wire"
+)
+
+type keyValue struct {
+	items  map[string][]byte
+	forgot chan string
+}
+
+func (kv *keyValue) GetValue(ctx context.Context, key *string) ([]byte, error) {
+	v, ok := kv.items[*key]
+	if !ok {
+		return nil, &th.KeyDoesNotExist{Key: key}
+	}
+	return v, nil
+}
+
+func (kv *keyValue) SetValue(ctx context.Context, key string, value []byte) error {
+	if key == "" {
+		return errors.New("empty key")
+	}
+	kv.items[key] = value
+	return nil
+}
+
+func (kv *keyValue) Forget(ctx context.Context, key *string) error {
+	kv.forgot <- *key
+	return nil
+}
+
+func TestHTTPHandlers(t *testing.T) {
+	kv := &keyValue{items: map[string][]byte{"foo": []byte("bar")}, forgot: make(chan string, 1)}
+
+	mux := http.NewServeMux()
+	thrifthttp.Register(mux,
+		th.KeyValue_GetValue_HTTPHandler(kv.GetValue),
+		th.KeyValue_SetValue_HTTPHandler(kv.SetValue),
+		th.KeyValue_Forget_HTTPHandler(kv.Forget),
+	)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	post := func(t *testing.T, path string, body []byte) (*http.Response, []byte) {
+		res, err := http.Post(server.URL+path, thrifthttp.ContentType, bytes.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		b, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, b
+	}
+
+	encode := func(t *testing.T, args envelope.Enveloper) []byte {
+		v, err := args.ToWire()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, binary.Default.Encode(v, &buf))
+		return buf.Bytes()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		res, body := post(t, "/KeyValue/getValue", encode(t, th.KeyValue_GetValue_Helper.Args(ptr.String("foo"))))
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		v, err := binary.Default.Decode(bytes.NewReader(body), wire.TStruct)
+		require.NoError(t, err)
+
+		var result th.KeyValue_GetValue_Result
+		require.NoError(t, result.FromWire(v))
+		assert.Equal(t, []byte("bar"), result.Success)
+	})
+
+	t.Run("enveloped", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, envelope.Write(binary.Default, &buf, 42,
+			th.KeyValue_GetValue_Helper.Args(ptr.String("foo"))))
+
+		res, body := post(t, "/KeyValue/getValue", buf.Bytes())
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		v, seqID, err := envelope.ReadReply(binary.Default, bytes.NewReader(body))
+		require.NoError(t, err)
+		assert.Equal(t, int32(42), seqID)
+
+		var result th.KeyValue_GetValue_Result
+		require.NoError(t, result.FromWire(v))
+		assert.Equal(t, []byte("bar"), result.Success)
+	})
+
+	t.Run("annotated exception", func(t *testing.T) {
+		res, body := post(t, "/KeyValue/getValue", encode(t, th.KeyValue_GetValue_Helper.Args(ptr.String("baz"))))
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+		v, err := binary.Default.Decode(bytes.NewReader(body), wire.TStruct)
+		require.NoError(t, err)
+
+		var result th.KeyValue_GetValue_Result
+		require.NoError(t, result.FromWire(v))
+		assert.Equal(t, &th.KeyDoesNotExist{Key: ptr.String("baz")}, result.DoesNotExist)
+	})
+
+	t.Run("undeclared error", func(t *testing.T) {
+		res, body := post(t, "/KeyValue/setValue", encode(t, th.KeyValue_SetValue_Helper.Args("", nil)))
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, string(body), "empty key")
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		// setValue requires a key.
+		res, _ := post(t, "/KeyValue/setValue", []byte{0x00})
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		res, _ := post(t, "/KeyValue/forget", encode(t, th.KeyValue_Forget_Helper.Args(ptr.String("foo"))))
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		assert.Equal(t, "foo", <-kv.forgot)
+	})
+}
+
+func TestHTTPStatusAnnotation(t *testing.T) {
+	tests := []struct {
+		desc    string
+		status  string
+		wantErr string
+	}{
+		{desc: "not a number", status: "foo", wantErr: `invalid http.status annotation on Oops: "foo" is not an HTTP status code`},
+		{desc: "out of range", status: "42", wantErr: `invalid http.status annotation on Oops: "42" is not an HTTP status code`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			spec := &compile.StructSpec{
+				Name:        "Oops",
+				Type:        ast.ExceptionType,
+				Annotations: compile.Annotations{httpStatusKey: tt.status},
+			}
+			_, err := exceptionHTTPStatus(spec)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
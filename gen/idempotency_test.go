@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	ti "go.uber.org/thriftrw/gen/internal/tests/idempotency"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestIdempotencyTokenArgsFromWire(t *testing.T) {
+	give := ti.Ledger_Transfer_Helper.Args(&ti.Transfer{
+		Source:      "alice",
+		Destination: "bob",
+		Amount:      100,
+	})
+
+	decode := func(t *testing.T, opts ...envelope.WriteOption) wire.Value {
+		var buff bytes.Buffer
+		require.NoError(t, envelope.Write(binary.Default, &buff, 1, give, opts...))
+		e, err := binary.Default.DecodeEnveloped(bytes.NewReader(buff.Bytes()))
+		require.NoError(t, err)
+		return e.Value
+	}
+
+	t.Run("token", func(t *testing.T) {
+		args, token, err := ti.Ledger_Transfer_Helper.ArgsFromWire(
+			decode(t, envelope.WithIdempotencyToken("transfer-1")))
+		require.NoError(t, err)
+		assert.Equal(t, give, args)
+		assert.Equal(t, "transfer-1", token)
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		args, token, err := ti.Ledger_Transfer_Helper.ArgsFromWire(decode(t))
+		require.NoError(t, err)
+		assert.Equal(t, give, args)
+		assert.Empty(t, token)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, _, err := ti.Ledger_Transfer_Helper.ArgsFromWire(wire.NewValueStruct(wire.Struct{
+			// Transfer is missing its required fields.
+			Fields: []wire.Field{{ID: 1, Value: wire.NewValueStruct(wire.Struct{})}},
+		}))
+		assert.Error(t, err)
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		entry := "hello"
+		v, err := ti.Ledger_Record_Helper.Args(&entry).ToWire()
+		require.NoError(t, err)
+
+		args, _, err := ti.Ledger_Record_Helper.ArgsFromWire(v)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", args.GetEntry())
+	})
+}
+
+func TestIdempotencyTokenInvalidAnnotation(t *testing.T) {
+	s := &compile.ServiceSpec{Name: "Ledger"}
+	f := &compile.FunctionSpec{
+		Name:        "transfer",
+		Annotations: compile.Annotations{idempotencyTokenKey: "sometimes"},
+	}
+
+	err := functionHelper(nil /* generator */, s, f)
+	assert.EqualError(t, err, `invalid rpc.idempotency_token annotation: "sometimes" is not a boolean`)
+}
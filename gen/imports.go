@@ -83,17 +83,24 @@ func (i importer) Import(path string) string {
 }
 
 // importDecl builds an import declation from the given list of imports.
-func (i importer) importDecl() ast.Decl {
-	imports := i.imports
-	if len(imports) == 0 {
-		return nil
-	}
+//
+// Named imports which are not referenced by the given declarations are left
+// out. Helpers declared with EnsureDeclared import their dependencies even
+// if an earlier file of the package already declared them.
+func (i importer) importDecl(decls []ast.Decl) ast.Decl {
+	used := referencedNames(decls)
 
-	specs := make([]ast.Spec, 0, len(imports))
-	for _, iname := range sortStringKeys(imports) {
-		imp := imports[iname]
+	var specs []ast.Spec
+	for _, iname := range sortStringKeys(i.imports) {
+		imp := i.imports[iname]
+		if imp.Name != nil && imp.Name.Name != "_" && imp.Name.Name != "." && !used[imp.Name.Name] {
+			continue
+		}
 		specs = append(specs, imp)
 	}
+	if len(specs) == 0 {
+		return nil
+	}
 
 	decl := &ast.GenDecl{Tok: token.IMPORT, Specs: specs}
 	if len(specs) > 1 {
@@ -103,3 +110,20 @@ func (i importer) importDecl() ast.Decl {
 
 	return decl
 }
+
+// referencedNames returns the names of packages referenced as qualifiers in
+// the given declarations.
+func referencedNames(decls []ast.Decl) map[string]bool {
+	names := make(map[string]bool)
+	for _, decl := range decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					names[x.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	return names
+}
@@ -21,10 +21,14 @@
 package gen
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestImport(t *testing.T) {
@@ -81,3 +85,27 @@ func TestImport(t *testing.T) {
 		}
 	}
 }
+
+func TestImportDeclSkipsUnused(t *testing.T) {
+	imp := newImporter(NewNamespace())
+	imp.Import("fmt")
+	imp.Import("time")
+	require.NoError(t, imp.AddImportSpec(&ast.ImportSpec{
+		Name: ast.NewIdent("_"),
+		Path: stringLiteral("embed"),
+	}))
+
+	f, err := parser.ParseFile(token.NewFileSet(), "x.go", `package x
+		func foo() { fmt.Println() }
+	`, 0)
+	require.NoError(t, err)
+
+	var paths []string
+	decl := imp.importDecl(f.Decls).(*ast.GenDecl)
+	for _, spec := range decl.Specs {
+		paths = append(paths, spec.(*ast.ImportSpec).Path.Value)
+	}
+	assert.Equal(t, []string{`"embed"`, `"fmt"`}, paths)
+
+	assert.Nil(t, newImporter(NewNamespace()).importDecl(f.Decls))
+}
@@ -0,0 +1,2579 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package aggregate_errors
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	validate "go.uber.org/thriftrw/validate"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Address struct {
+	Street  string   `json:"street,required"`
+	City    *string  `json:"city,omitempty"`
+	Country *Country `json:"country,required"`
+}
+
+// ToWire translates a Address struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Address) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if err := validate.Collect(v); err != nil {
+		return wire.Value{}, err
+	}
+
+	w, err = wire.NewValueString(v.Street), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.City != nil {
+		w, err = wire.NewValueString(*(v.City)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Country == nil {
+		return w, errors.New("field Country of Address is required")
+	}
+	w, err = v.Country.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Country_Read(w wire.Value) (*Country, error) {
+	var v Country
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Address struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Address struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Address
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Address) FromWire(w wire.Value) error {
+	var err error
+
+	streetIsSet := false
+
+	countryIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Street, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				streetIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.City = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Country, err = _Country_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				countryIsSet = true
+			}
+		}
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	if !countryIsSet {
+		return errors.New("field Country of Address is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Address struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Address struct could not be encoded.
+func (v *Address) Encode(sw stream.Writer) error {
+	if err := validate.Collect(v); err != nil {
+		return err
+	}
+
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Street); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.City != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.City)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Country == nil {
+		return errors.New("field Country of Address is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Country.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Country_Decode(sr stream.Reader) (*Country, error) {
+	var v Country
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Address struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Address struct could not be generated from the wire
+// representation.
+func (v *Address) Decode(sr stream.Reader) error {
+
+	streetIsSet := false
+
+	countryIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Street, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			streetIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.City = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Country, err = _Country_Decode(sr)
+			if err != nil {
+				return err
+			}
+			countryIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	if !countryIsSet {
+		return errors.New("field Country of Address is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Address
+// struct.
+func (v *Address) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Street: %v", v.Street)
+	i++
+	if v.City != nil {
+		fields[i] = fmt.Sprintf("City: %v", *(v.City))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Country: %v", v.Country)
+	i++
+
+	return fmt.Sprintf("Address{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Address match the
+// provided Address.
+//
+// This function performs a deep comparison.
+func (v *Address) Equals(rhs *Address) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Street == rhs.Street) {
+		return false
+	}
+	if !_String_EqualsPtr(v.City, rhs.City) {
+		return false
+	}
+	if !v.Country.Equals(rhs.Country) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Address.
+func (v *Address) Copy() *Address {
+	if v == nil {
+		return nil
+	}
+
+	var o Address
+	o.Street = v.Street
+	o.City = _String_CopyPtr(v.City)
+	o.Country = v.Country.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Address which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Address) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Street)
+	if v.City != nil {
+		h.Field(2)
+		h.String(*v.City)
+	}
+	h.Field(3)
+	h.Uint64(v.Country.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Address so that it may be reused.
+func (v *Address) Reset() {
+	*v = Address{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Address.
+func (v *Address) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("street", v.Street)
+	if v.City != nil {
+		enc.AddString("city", *v.City)
+	}
+	err = multierr.Append(err, enc.AddObject("country", v.Country))
+	return err
+}
+
+// GetStreet returns the value of Street if it is set or its
+// zero value if it is unset.
+func (v *Address) GetStreet() (o string) {
+	if v != nil {
+		o = v.Street
+	}
+	return
+}
+
+// GetCity returns the value of City if it is set or its
+// zero value if it is unset.
+func (v *Address) GetCity() (o string) {
+	if v != nil && v.City != nil {
+		return *v.City
+	}
+
+	return
+}
+
+// IsSetCity returns true if City is not nil.
+func (v *Address) IsSetCity() bool {
+	return v != nil && v.City != nil
+}
+
+// GetCountry returns the value of Country if it is set or its
+// zero value if it is unset.
+func (v *Address) GetCountry() (o *Country) {
+	if v != nil {
+		o = v.Country
+	}
+	return
+}
+
+// IsSetCountry returns true if Country is not nil.
+func (v *Address) IsSetCountry() bool {
+	return v != nil && v.Country != nil
+}
+
+// CollectViolations records every missing required field and invalid
+// union in this Address and the structs nested in it.
+func (v *Address) CollectViolations(c *validate.Collector) {
+	if v == nil {
+		return
+	}
+
+	if v.Country == nil {
+		c.Add(errors.New("field Country of Address is required"))
+	} else {
+		c.Field("country", v.Country)
+	}
+}
+
+type Contact struct {
+	Email *string `json:"email,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+}
+
+// ToWire translates a Contact struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Contact) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if err := validate.Collect(v); err != nil {
+		return wire.Value{}, err
+	}
+
+	if v.Email != nil {
+		w, err = wire.NewValueString(*(v.Email)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Phone != nil {
+		w, err = wire.NewValueString(*(v.Phone)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Contact should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Contact struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Contact struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Contact
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Contact) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Email = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Phone = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Contact struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Contact struct could not be encoded.
+func (v *Contact) Encode(sw stream.Writer) error {
+	if err := validate.Collect(v); err != nil {
+		return err
+	}
+
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Email != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Email)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Phone != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Phone)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Contact struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Contact struct could not be generated from the wire
+// representation.
+func (v *Contact) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Email = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Phone = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Contact
+// struct.
+func (v *Contact) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
+		i++
+	}
+	if v.Phone != nil {
+		fields[i] = fmt.Sprintf("Phone: %v", *(v.Phone))
+		i++
+	}
+
+	return fmt.Sprintf("Contact{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Contact match the
+// provided Contact.
+//
+// This function performs a deep comparison.
+func (v *Contact) Equals(rhs *Contact) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Phone, rhs.Phone) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Contact.
+func (v *Contact) Copy() *Contact {
+	if v == nil {
+		return nil
+	}
+
+	var o Contact
+	o.Email = _String_CopyPtr(v.Email)
+	o.Phone = _String_CopyPtr(v.Phone)
+	return &o
+}
+
+// Hash returns a hash of this Contact which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Contact) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Email != nil {
+		h.Field(1)
+		h.String(*v.Email)
+	}
+	if v.Phone != nil {
+		h.Field(2)
+		h.String(*v.Phone)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Contact so that it may be reused.
+func (v *Contact) Reset() {
+	*v = Contact{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Contact.
+func (v *Contact) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
+	}
+	if v.Phone != nil {
+		enc.AddString("phone", *v.Phone)
+	}
+	return err
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+func (v *Contact) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
+	}
+
+	return
+}
+
+// IsSetEmail returns true if Email is not nil.
+func (v *Contact) IsSetEmail() bool {
+	return v != nil && v.Email != nil
+}
+
+// LookupEmail returns the value of Email and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Contact) LookupEmail() (o string, _ bool) {
+	if v != nil && v.Email != nil {
+		return *v.Email, true
+	}
+	return o, false
+}
+
+// SetEmail sets the value of Email and unsets all other
+// fields of this Contact.
+func (v *Contact) SetEmail(x string) {
+	*v = Contact{}
+	v.Email = &x
+}
+
+// GetPhone returns the value of Phone if it is set or its
+// zero value if it is unset.
+func (v *Contact) GetPhone() (o string) {
+	if v != nil && v.Phone != nil {
+		return *v.Phone
+	}
+
+	return
+}
+
+// IsSetPhone returns true if Phone is not nil.
+func (v *Contact) IsSetPhone() bool {
+	return v != nil && v.Phone != nil
+}
+
+// LookupPhone returns the value of Phone and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Contact) LookupPhone() (o string, _ bool) {
+	if v != nil && v.Phone != nil {
+		return *v.Phone, true
+	}
+	return o, false
+}
+
+// SetPhone sets the value of Phone and unsets all other
+// fields of this Contact.
+func (v *Contact) SetPhone(x string) {
+	*v = Contact{}
+	v.Phone = &x
+}
+
+// Which returns the ID of the field of this Contact which is set, or
+// 0 if no field is set.
+func (v *Contact) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Email != nil {
+		return 1
+	}
+	if v.Phone != nil {
+		return 2
+	}
+	return 0
+}
+
+// Contact_Visitor visits the field of a Contact which is set.
+//
+// Fields added to Contact add methods to Contact_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Contact_Visitor interface {
+	// VisitEmail is called with the value of Email if it is set.
+	VisitEmail(string) error
+
+	// VisitPhone is called with the value of Phone if it is set.
+	VisitPhone(string) error
+
+	// Default is called if no field of Contact is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Contact
+// which is set, or Default if none is, and returns its error.
+func (v *Contact) Match(visitor Contact_Visitor) error {
+	if v != nil {
+		if v.Email != nil {
+			return visitor.VisitEmail(*v.Email)
+		}
+		if v.Phone != nil {
+			return visitor.VisitPhone(*v.Phone)
+		}
+	}
+	return visitor.Default()
+}
+
+// CollectViolations records every missing required field and invalid
+// union in this Contact and the structs nested in it.
+func (v *Contact) CollectViolations(c *validate.Collector) {
+	if v == nil {
+		return
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if count != 1 {
+		c.Add(fmt.Errorf("Contact should have exactly one field: got %v fields", count))
+	}
+}
+
+type Country struct {
+	Code string `json:"code,required"`
+}
+
+// ToWire translates a Country struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Country) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if err := validate.Collect(v); err != nil {
+		return wire.Value{}, err
+	}
+
+	w, err = wire.NewValueString(v.Code), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Country struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Country struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Country
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Country) FromWire(w wire.Value) error {
+	var err error
+
+	codeIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Code, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				codeIsSet = true
+			}
+		}
+	}
+
+	if !codeIsSet {
+		return errors.New("field Code of Country is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Country struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Country struct could not be encoded.
+func (v *Country) Encode(sw stream.Writer) error {
+	if err := validate.Collect(v); err != nil {
+		return err
+	}
+
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Code); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Country struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Country struct could not be generated from the wire
+// representation.
+func (v *Country) Decode(sr stream.Reader) error {
+
+	codeIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Code, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			codeIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !codeIsSet {
+		return errors.New("field Code of Country is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Country
+// struct.
+func (v *Country) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Code: %v", v.Code)
+	i++
+
+	return fmt.Sprintf("Country{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Country match the
+// provided Country.
+//
+// This function performs a deep comparison.
+func (v *Country) Equals(rhs *Country) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Code == rhs.Code) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Country.
+func (v *Country) Copy() *Country {
+	if v == nil {
+		return nil
+	}
+
+	var o Country
+	o.Code = v.Code
+	return &o
+}
+
+// Hash returns a hash of this Country which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Country) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Code)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Country so that it may be reused.
+func (v *Country) Reset() {
+	*v = Country{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Country.
+func (v *Country) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("code", v.Code)
+	return err
+}
+
+// GetCode returns the value of Code if it is set or its
+// zero value if it is unset.
+func (v *Country) GetCode() (o string) {
+	if v != nil {
+		o = v.Code
+	}
+	return
+}
+
+// CollectViolations records every missing required field and invalid
+// union in this Country and the structs nested in it.
+func (v *Country) CollectViolations(c *validate.Collector) {
+	if v == nil {
+		return
+	}
+}
+
+type Location Address
+
+// ToWire translates Location into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Location) ToWire() (wire.Value, error) {
+	x := (*Address)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Location.
+func (v *Location) String() string {
+	x := (*Address)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *Location) Encode(sw stream.Writer) error {
+	x := (*Address)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes Location from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Location) FromWire(w wire.Value) error {
+	return (*Address)(v).FromWire(w)
+}
+
+// Decode deserializes Location directly off the wire.
+func (v *Location) Decode(sr stream.Reader) error {
+	return (*Address)(v).Decode(sr)
+}
+
+// Equals returns true if this Location is equal to the provided
+// Location.
+func (lhs *Location) Equals(rhs *Location) bool {
+	return (*Address)(lhs).Equals((*Address)(rhs))
+}
+
+// Copy returns a deep copy of this Location.
+func (v *Location) Copy() *Location {
+	x := (*Address)(v)
+	return (*Location)(x.Copy())
+}
+
+// Hash returns a hash of this Location which is stable across
+// processes.
+func (v *Location) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Address)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *Location) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Address)(v)).MarshalLogObject(enc)
+}
+
+type User struct {
+	Name     string     `json:"name,required"`
+	Home     *Address   `json:"home,required"`
+	Work     *Address   `json:"work,omitempty"`
+	Contact  *Contact   `json:"contact,omitempty"`
+	Tags     []string   `json:"tags,required"`
+	Location *Location  `json:"location,omitempty"`
+	Previous []*Address `json:"previous,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _List_Address_ValueList []*Address
+
+func (v _List_Address_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Address', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Address_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Address_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Address_ValueList) Close() {}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if err := validate.Collect(v); err != nil {
+		return wire.Value{}, err
+	}
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Home == nil {
+		return w, errors.New("field Home of User is required")
+	}
+	w, err = v.Home.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Work != nil {
+		w, err = v.Work.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Contact != nil {
+		w, err = v.Contact.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+	if v.Location != nil {
+		w, err = v.Location.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Previous != nil {
+		w, err = wire.NewValueList(_List_Address_ValueList(v.Previous)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Address_Read(w wire.Value) (*Address, error) {
+	var v Address
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Contact_Read(w wire.Value) (*Contact, error) {
+	var v Contact
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Location_Read(w wire.Value) (*Location, error) {
+	var x Location
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _List_Address_Read(l wire.ValueList) ([]*Address, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Address, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Address_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	homeIsSet := false
+
+	tagsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Home, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				homeIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Work, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Contact, err = _Contact_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				tagsIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.Location, err = _Location_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TList {
+				v.Previous, err = _List_Address_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	if !homeIsSet {
+		return errors.New("field Home of User is required")
+	}
+
+	if !tagsIsSet {
+		return errors.New("field Tags of User is required")
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_Address_Encode(val []*Address, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Address
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Address', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a User struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a User struct could not be encoded.
+func (v *User) Encode(sw stream.Writer) error {
+	if err := validate.Collect(v); err != nil {
+		return err
+	}
+
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Home == nil {
+		return errors.New("field Home of User is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Home.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Work != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Work.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Contact != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Contact.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_String_Encode(v.Tags, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Location != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Location.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Previous != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Address_Encode(v.Previous, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Address_Decode(sr stream.Reader) (*Address, error) {
+	var v Address
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Contact_Decode(sr stream.Reader) (*Contact, error) {
+	var v Contact
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Location_Decode(sr stream.Reader) (*Location, error) {
+	var x Location
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _List_Address_Decode(sr stream.Reader) ([]*Address, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Address, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Address_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a User struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a User struct could not be generated from the wire
+// representation.
+func (v *User) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+	homeIsSet := false
+
+	tagsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Home, err = _Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+			homeIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Work, err = _Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.Contact, err = _Contact_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			tagsIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.Location, err = _Location_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TList:
+			v.Previous, err = _List_Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	if !homeIsSet {
+		return errors.New("field Home of User is required")
+	}
+
+	if !tagsIsSet {
+		return errors.New("field Tags of User is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Home: %v", v.Home)
+	i++
+	if v.Work != nil {
+		fields[i] = fmt.Sprintf("Work: %v", v.Work)
+		i++
+	}
+	if v.Contact != nil {
+		fields[i] = fmt.Sprintf("Contact: %v", v.Contact)
+		i++
+	}
+	fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+	i++
+	if v.Location != nil {
+		fields[i] = fmt.Sprintf("Location: %v", v.Location)
+		i++
+	}
+	if v.Previous != nil {
+		fields[i] = fmt.Sprintf("Previous: %v", v.Previous)
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Address_Equals(lhs, rhs []*Address) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !v.Home.Equals(rhs.Home) {
+		return false
+	}
+	if !((v.Work == nil && rhs.Work == nil) || (v.Work != nil && rhs.Work != nil && v.Work.Equals(rhs.Work))) {
+		return false
+	}
+	if !((v.Contact == nil && rhs.Contact == nil) || (v.Contact != nil && rhs.Contact != nil && v.Contact.Equals(rhs.Contact))) {
+		return false
+	}
+	if !_List_String_Equals(v.Tags, rhs.Tags) {
+		return false
+	}
+	if !((v.Location == nil && rhs.Location == nil) || (v.Location != nil && rhs.Location != nil && v.Location.Equals(rhs.Location))) {
+		return false
+	}
+	if !((v.Previous == nil && rhs.Previous == nil) || (v.Previous != nil && rhs.Previous != nil && _List_Address_Equals(v.Previous, rhs.Previous))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Address_Copy(v []*Address) []*Address {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Address, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this User.
+func (v *User) Copy() *User {
+	if v == nil {
+		return nil
+	}
+
+	var o User
+	o.Name = v.Name
+	o.Home = v.Home.Copy()
+	o.Work = v.Work.Copy()
+	o.Contact = v.Contact.Copy()
+	o.Tags = _List_String_Copy(v.Tags)
+	o.Location = v.Location.Copy()
+	o.Previous = _List_Address_Copy(v.Previous)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _List_Address_Hash(v []*Address) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this User which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *User) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(v.Home.Hash())
+	h.Field(3)
+	h.Uint64(v.Work.Hash())
+	h.Field(4)
+	h.Uint64(v.Contact.Hash())
+	h.Field(5)
+	h.Uint64(_List_String_Hash(v.Tags))
+	h.Field(6)
+	h.Uint64(v.Location.Hash())
+	h.Field(7)
+	h.Uint64(_List_Address_Hash(v.Previous))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this User so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *User) Reset() {
+	*v = User{
+		Tags: v.Tags[:0],
+	}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _List_Address_Zapper []*Address
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Address_Zapper.
+func (l _List_Address_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	err = multierr.Append(err, enc.AddObject("home", v.Home))
+	if v.Work != nil {
+		err = multierr.Append(err, enc.AddObject("work", v.Work))
+	}
+	if v.Contact != nil {
+		err = multierr.Append(err, enc.AddObject("contact", v.Contact))
+	}
+	err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	if v.Location != nil {
+		err = multierr.Append(err, enc.AddObject("location", v.Location))
+	}
+	if v.Previous != nil {
+		err = multierr.Append(err, enc.AddArray("previous", (_List_Address_Zapper)(v.Previous)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *User) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetHome returns the value of Home if it is set or its
+// zero value if it is unset.
+func (v *User) GetHome() (o *Address) {
+	if v != nil {
+		o = v.Home
+	}
+	return
+}
+
+// IsSetHome returns true if Home is not nil.
+func (v *User) IsSetHome() bool {
+	return v != nil && v.Home != nil
+}
+
+// GetWork returns the value of Work if it is set or its
+// zero value if it is unset.
+func (v *User) GetWork() (o *Address) {
+	if v != nil && v.Work != nil {
+		return v.Work
+	}
+
+	return
+}
+
+// IsSetWork returns true if Work is not nil.
+func (v *User) IsSetWork() bool {
+	return v != nil && v.Work != nil
+}
+
+// GetContact returns the value of Contact if it is set or its
+// zero value if it is unset.
+func (v *User) GetContact() (o *Contact) {
+	if v != nil && v.Contact != nil {
+		return v.Contact
+	}
+
+	return
+}
+
+// IsSetContact returns true if Contact is not nil.
+func (v *User) IsSetContact() bool {
+	return v != nil && v.Contact != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *User) GetTags() (o []string) {
+	if v != nil {
+		o = v.Tags
+	}
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *User) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetLocation returns the value of Location if it is set or its
+// zero value if it is unset.
+func (v *User) GetLocation() (o *Location) {
+	if v != nil && v.Location != nil {
+		return v.Location
+	}
+
+	return
+}
+
+// IsSetLocation returns true if Location is not nil.
+func (v *User) IsSetLocation() bool {
+	return v != nil && v.Location != nil
+}
+
+// GetPrevious returns the value of Previous if it is set or its
+// zero value if it is unset.
+func (v *User) GetPrevious() (o []*Address) {
+	if v != nil && v.Previous != nil {
+		return v.Previous
+	}
+
+	return
+}
+
+// IsSetPrevious returns true if Previous is not nil.
+func (v *User) IsSetPrevious() bool {
+	return v != nil && v.Previous != nil
+}
+
+// CollectViolations records every missing required field and invalid
+// union in this User and the structs nested in it.
+func (v *User) CollectViolations(c *validate.Collector) {
+	if v == nil {
+		return
+	}
+
+	if v.Home == nil {
+		c.Add(errors.New("field Home of User is required"))
+	} else {
+		c.Field("home", v.Home)
+	}
+
+	c.Field("work", v.Work)
+
+	c.Field("contact", v.Contact)
+
+	c.Field("location", v.Location)
+}
+
+type UserError struct {
+	User *User `json:"user,required"`
+}
+
+// ToWire translates a UserError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UserError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if err := validate.Collect(v); err != nil {
+		return wire.Value{}, err
+	}
+
+	if v.User == nil {
+		return w, errors.New("field User of UserError is required")
+	}
+	w, err = v.User.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _User_Read(w wire.Value) (*User, error) {
+	var v User
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a UserError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UserError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UserError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UserError) FromWire(w wire.Value) error {
+	var err error
+
+	userIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.User, err = _User_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				userIsSet = true
+			}
+		}
+	}
+
+	if !userIsSet {
+		return errors.New("field User of UserError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a UserError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a UserError struct could not be encoded.
+func (v *UserError) Encode(sw stream.Writer) error {
+	if err := validate.Collect(v); err != nil {
+		return err
+	}
+
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.User == nil {
+		return errors.New("field User of UserError is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.User.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _User_Decode(sr stream.Reader) (*User, error) {
+	var v User
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a UserError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a UserError struct could not be generated from the wire
+// representation.
+func (v *UserError) Decode(sr stream.Reader) error {
+
+	userIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.User, err = _User_Decode(sr)
+			if err != nil {
+				return err
+			}
+			userIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !userIsSet {
+		return errors.New("field User of UserError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UserError
+// struct.
+func (v *UserError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("User: %v", v.User)
+	i++
+
+	return fmt.Sprintf("UserError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*UserError) ErrorName() string {
+	return "UserError"
+}
+
+// Equals returns true if all the fields of this UserError match the
+// provided UserError.
+//
+// This function performs a deep comparison.
+func (v *UserError) Equals(rhs *UserError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.User.Equals(rhs.User) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this UserError.
+func (v *UserError) Copy() *UserError {
+	if v == nil {
+		return nil
+	}
+
+	var o UserError
+	o.User = v.User.Copy()
+	return &o
+}
+
+// Hash returns a hash of this UserError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *UserError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.User.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this UserError so that it may be reused.
+func (v *UserError) Reset() {
+	*v = UserError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UserError.
+func (v *UserError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("user", v.User))
+	return err
+}
+
+// GetUser returns the value of User if it is set or its
+// zero value if it is unset.
+func (v *UserError) GetUser() (o *User) {
+	if v != nil {
+		o = v.User
+	}
+	return
+}
+
+// IsSetUser returns true if User is not nil.
+func (v *UserError) IsSetUser() bool {
+	return v != nil && v.User != nil
+}
+
+// CollectViolations records every missing required field and invalid
+// union in this UserError and the structs nested in it.
+func (v *UserError) CollectViolations(c *validate.Collector) {
+	if v == nil {
+		return
+	}
+
+	if v.User == nil {
+		c.Add(errors.New("field User of UserError is required"))
+	} else {
+		c.Field("user", v.User)
+	}
+}
+
+func (v *UserError) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "aggregate-errors",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/aggregate-errors",
+	FilePath: "aggregate-errors.thrift",
+	SHA1:     "554aab3db0495a84fec2ac20054d9367bdeffefe",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Country {\n    1: required string code\n}\n\nstruct Address {\n    1: required string street\n    2: optional string city\n    3: required Country country\n}\n\ntypedef Address Location\n\nunion Contact {\n    1: string email\n    2: string phone\n}\n\nstruct User {\n    1: required string name\n    2: required Address home\n    3: optional Address work\n    4: optional Contact contact\n    5: required list<string> tags\n    6: optional Location location\n    7: optional list<Address> previous\n}\n\nexception UserError {\n    1: required User user\n}\n"
@@ -0,0 +1,1958 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package append_to
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Point with the Binary protocol, without encoding it. The result
+// is only meaningful if the Point can be encoded.
+func (v *Point) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + 8
+	n += 3 + 8
+	return n
+}
+
+// AppendTo appends the encoding of this Point with the Binary
+// protocol to buf, growing it as needed, and returns the extended
+// slice. buf is returned as-is if the Point could not be encoded.
+func (v *Point) AppendTo(buf []byte) ([]byte, error) {
+	w := binary.NewAppendWriter(buf)
+	defer w.Close()
+	w.Grow(v.EncodedSize())
+	if err := v.Encode(w); err != nil {
+		return buf, err
+	}
+	return w.Bytes(), nil
+}
+
+type Shape struct {
+	Point *Point  `json:"point,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Point = v.Point.Copy()
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Shape) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPoint(x *Point) {
+	*v = Shape{}
+	v.Point = x
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Shape) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// LookupName returns the value of Name and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupName() (o string, _ bool) {
+	if v != nil && v.Name != nil {
+		return *v.Name, true
+	}
+	return o, false
+}
+
+// SetName sets the value of Name and unsets all other
+// fields of this Shape.
+func (v *Shape) SetName(x string) {
+	*v = Shape{}
+	v.Name = &x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Name != nil {
+		return 2
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitName is called with the value of Name if it is set.
+	VisitName(string) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Name != nil {
+			return visitor.VisitName(*v.Name)
+		}
+	}
+	return visitor.Default()
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Shape with the Binary protocol, without encoding it. The result
+// is only meaningful if the Shape can be encoded.
+func (v *Shape) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	if v.Point != nil {
+		n += 3 + v.Point.EncodedSize()
+	}
+	if v.Name != nil {
+		n += 3 + 4 + len(*v.Name)
+	}
+	return n
+}
+
+// AppendTo appends the encoding of this Shape with the Binary
+// protocol to buf, growing it as needed, and returns the extended
+// slice. buf is returned as-is if the Shape could not be encoded.
+func (v *Shape) AppendTo(buf []byte) ([]byte, error) {
+	w := binary.NewAppendWriter(buf)
+	defer w.Close()
+	w.Grow(v.EncodedSize())
+	if err := v.Encode(w); err != nil {
+		return buf, err
+	}
+	return w.Bytes(), nil
+}
+
+type Trace struct {
+	ID      string            `json:"id,required"`
+	Path    []*Point          `json:"path,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Payload []byte            `json:"payload,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+type _Map_String_String_MapItemList map[string]string
+
+func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) Close() {}
+
+// ToWire translates a Trace struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Trace) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Path != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Path)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Payload != nil {
+		w, err = wire.NewValueBinary(v.Payload), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Trace struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Trace struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Trace
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Trace) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Path, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Tags, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.Payload, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Trace is required")
+	}
+
+	return nil
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_String_Encode(val map[string]string, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Trace struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Trace struct could not be encoded.
+func (v *Trace) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Path != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Path, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Payload != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Payload); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]string, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Trace struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Trace struct could not be generated from the wire
+// representation.
+func (v *Trace) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Path, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Tags, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			v.Payload, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Trace is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Trace
+// struct.
+func (v *Trace) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Path != nil {
+		fields[i] = fmt.Sprintf("Path: %v", v.Path)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Payload != nil {
+		fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
+		i++
+	}
+
+	return fmt.Sprintf("Trace{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Trace match the
+// provided Trace.
+//
+// This function performs a deep comparison.
+func (v *Trace) Equals(rhs *Trace) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !((v.Path == nil && rhs.Path == nil) || (v.Path != nil && rhs.Path != nil && _List_Point_Equals(v.Path, rhs.Path))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Map_String_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Payload == nil && rhs.Payload == nil) || (v.Payload != nil && rhs.Payload != nil && bytes.Equal(v.Payload, rhs.Payload))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Trace.
+func (v *Trace) Copy() *Trace {
+	if v == nil {
+		return nil
+	}
+
+	var o Trace
+	o.ID = v.ID
+	o.Path = _List_Point_Copy(v.Path)
+	o.Tags = _Map_String_String_Copy(v.Tags)
+	o.Payload = _Binary_Copy(v.Payload)
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Trace which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Trace) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	h.Field(2)
+	h.Uint64(_List_Point_Hash(v.Path))
+	h.Field(3)
+	h.Uint64(_Map_String_String_Hash(v.Tags))
+	h.Field(4)
+	h.Binary(v.Payload)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Trace so that it may be reused.
+func (v *Trace) Reset() {
+	*v = Trace{}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Trace.
+func (v *Trace) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Path != nil {
+		err = multierr.Append(err, enc.AddArray("path", (_List_Point_Zapper)(v.Path)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddObject("tags", (_Map_String_String_Zapper)(v.Tags)))
+	}
+	if v.Payload != nil {
+		enc.AddString("payload", base64.StdEncoding.EncodeToString(v.Payload))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Trace) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetPath returns the value of Path if it is set or its
+// zero value if it is unset.
+func (v *Trace) GetPath() (o []*Point) {
+	if v != nil && v.Path != nil {
+		return v.Path
+	}
+
+	return
+}
+
+// IsSetPath returns true if Path is not nil.
+func (v *Trace) IsSetPath() bool {
+	return v != nil && v.Path != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Trace) GetTags() (o map[string]string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Trace) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetPayload returns the value of Payload if it is set or its
+// zero value if it is unset.
+func (v *Trace) GetPayload() (o []byte) {
+	if v != nil && v.Payload != nil {
+		return v.Payload
+	}
+
+	return
+}
+
+// IsSetPayload returns true if Payload is not nil.
+func (v *Trace) IsSetPayload() bool {
+	return v != nil && v.Payload != nil
+}
+
+func _List_Point_EncodedSize(v []*Point) int {
+	n := 5
+	for _, x := range v {
+		n += x.EncodedSize()
+	}
+	return n
+}
+
+func _Map_String_String_EncodedSize(v map[string]string) int {
+	n := 6
+	for k, x := range v {
+		n += 4 + len(k) + 4 + len(x)
+	}
+	return n
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Trace with the Binary protocol, without encoding it. The result
+// is only meaningful if the Trace can be encoded.
+func (v *Trace) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + 4 + len(v.ID)
+	if v.Path != nil {
+		n += 3 + _List_Point_EncodedSize(v.Path)
+	}
+	if v.Tags != nil {
+		n += 3 + _Map_String_String_EncodedSize(v.Tags)
+	}
+	if v.Payload != nil {
+		n += 3 + 4 + len(v.Payload)
+	}
+	return n
+}
+
+// AppendTo appends the encoding of this Trace with the Binary
+// protocol to buf, growing it as needed, and returns the extended
+// slice. buf is returned as-is if the Trace could not be encoded.
+func (v *Trace) AppendTo(buf []byte) ([]byte, error) {
+	w := binary.NewAppendWriter(buf)
+	defer w.Close()
+	w.Grow(v.EncodedSize())
+	if err := v.Encode(w); err != nil {
+		return buf, err
+	}
+	return w.Bytes(), nil
+}
+
+type TraceFailed struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a TraceFailed struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TraceFailed) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TraceFailed struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TraceFailed struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TraceFailed
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TraceFailed) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of TraceFailed is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a TraceFailed struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TraceFailed struct could not be encoded.
+func (v *TraceFailed) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TraceFailed struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TraceFailed struct could not be generated from the wire
+// representation.
+func (v *TraceFailed) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of TraceFailed is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TraceFailed
+// struct.
+func (v *TraceFailed) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("TraceFailed{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*TraceFailed) ErrorName() string {
+	return "TraceFailed"
+}
+
+// Equals returns true if all the fields of this TraceFailed match the
+// provided TraceFailed.
+//
+// This function performs a deep comparison.
+func (v *TraceFailed) Equals(rhs *TraceFailed) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this TraceFailed.
+func (v *TraceFailed) Copy() *TraceFailed {
+	if v == nil {
+		return nil
+	}
+
+	var o TraceFailed
+	o.Message = v.Message
+	return &o
+}
+
+// Hash returns a hash of this TraceFailed which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *TraceFailed) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this TraceFailed so that it may be reused.
+func (v *TraceFailed) Reset() {
+	*v = TraceFailed{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TraceFailed.
+func (v *TraceFailed) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *TraceFailed) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// TraceFailed with the Binary protocol, without encoding it. The result
+// is only meaningful if the TraceFailed can be encoded.
+func (v *TraceFailed) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + 4 + len(v.Message)
+	return n
+}
+
+// AppendTo appends the encoding of this TraceFailed with the Binary
+// protocol to buf, growing it as needed, and returns the extended
+// slice. buf is returned as-is if the TraceFailed could not be encoded.
+func (v *TraceFailed) AppendTo(buf []byte) ([]byte, error) {
+	w := binary.NewAppendWriter(buf)
+	defer w.Close()
+	w.Grow(v.EncodedSize())
+	if err := v.Encode(w); err != nil {
+		return buf, err
+	}
+	return w.Bytes(), nil
+}
+
+func (v *TraceFailed) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "append-to",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/append-to",
+	FilePath: "append-to.thrift",
+	SHA1:     "153ff0f58dbc780adf3370a82e2e912dbc7006ae",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Trace {\n    1: required string id\n    2: optional list<Point> path\n    3: optional map<string, string> tags\n    4: optional binary payload\n}\n\nunion Shape {\n    1: Point point\n    2: string name\n}\n\nexception TraceFailed {\n    1: required string message\n}\n"
@@ -0,0 +1,610 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package benchmarks
+
+import (
+	bytes "bytes"
+	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	testing "testing"
+)
+
+func _Timestamp_ptr(v Timestamp) *Timestamp {
+	return &v
+}
+
+func _UUID_ptr(v thriftuuid.UUID) *thriftuuid.UUID {
+	return &v
+}
+
+type _benchmarkValue interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+
+	Encode(stream.Writer) error
+	Decode(stream.Reader) error
+}
+
+// _benchmarkRoundTrip runs sub-benchmarks for each serialization
+// method of the given value. newValue returns an empty value of the
+// same type to decode into.
+func _benchmarkRoundTrip(b *testing.B, give _benchmarkValue, newValue func() _benchmarkValue) {
+	w, err := give.ToWire()
+	if err != nil {
+		b.Fatal(err)
+	}
+	var buff bytes.Buffer
+	if err := binary.Default.Encode(w, &buff); err != nil {
+		b.Fatal(err)
+	}
+	encoded := buff.Bytes()
+
+	b.Run("ToWire", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buff.Reset()
+			w, err := give.ToWire()
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := binary.Default.Encode(w, &buff); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("FromWire", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			w, err := binary.Default.Decode(bytes.NewReader(encoded), wire.TStruct)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := newValue().FromWire(w); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Encode", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var out bytes.Buffer
+			sw := binary.Default.Writer(&out)
+			if err := give.Encode(sw); err != nil {
+				b.Fatal(err)
+			}
+			if err := sw.Close(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Decode", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sr := binary.Default.Reader(bytes.NewReader(encoded))
+			if err := newValue().Decode(sr); err != nil {
+				b.Fatal(err)
+			}
+			if err := sr.Close(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkContainers measures the cost of serializing a
+// representative Containers.
+func BenchmarkContainers(b *testing.B) {
+	give := &Containers{
+		Colors: map[Color]struct{}{
+			ColorRed: struct{}{},
+		},
+		Labels: []struct {
+			Key   *Point
+			Value string
+		}{
+			{
+				Key: &Point{
+					X: 3.1415,
+					Y: 3.1415,
+				},
+				Value: "representative value",
+			},
+		},
+		Others: []enums.EnumDefault{
+			enums.EnumDefaultFoo,
+			enums.EnumDefaultFoo,
+			enums.EnumDefaultFoo,
+		},
+		Points: []*Point{
+			&Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+			&Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+			&Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+		},
+		Scores: map[string][]int32{
+			"representative value": []int32{
+				4242,
+				4242,
+				4242,
+			},
+		},
+		Tags: map[string]struct{}{
+			"representative value": struct{}{},
+		},
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(Containers)
+	})
+}
+
+// BenchmarkNode measures the cost of serializing a
+// representative Node.
+func BenchmarkNode(b *testing.B) {
+	give := &Node{
+		Children: []*Node{
+			&Node{
+				Children: []*Node{
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+				},
+				CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+				Name:      "representative value",
+				Sibling: &Node{
+					Children: []*Node{
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+					Sibling: &Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+			},
+			&Node{
+				Children: []*Node{
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+				},
+				CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+				Name:      "representative value",
+				Sibling: &Node{
+					Children: []*Node{
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+					Sibling: &Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+			},
+			&Node{
+				Children: []*Node{
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+							&Node{
+								CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+								Name:      "representative value",
+							},
+						},
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+						Sibling: &Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+				},
+				CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+				Name:      "representative value",
+				Sibling: &Node{
+					Children: []*Node{
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+					Sibling: &Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+			},
+		},
+		CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+		Name:      "representative value",
+		Sibling: &Node{
+			Children: []*Node{
+				&Node{
+					Children: []*Node{
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+					Sibling: &Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+				&Node{
+					Children: []*Node{
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+					Sibling: &Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+				&Node{
+					Children: []*Node{
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+						&Node{
+							CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+							Name:      "representative value",
+						},
+					},
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+					Sibling: &Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+			},
+			CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+			Name:      "representative value",
+			Sibling: &Node{
+				Children: []*Node{
+					&Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+					&Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+					&Node{
+						CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+						Name:      "representative value",
+					},
+				},
+				CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+				Name:      "representative value",
+				Sibling: &Node{
+					CreatedAt: _Timestamp_ptr(Timestamp(4242)),
+					Name:      "representative value",
+				},
+			},
+		},
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(Node)
+	})
+}
+
+// BenchmarkPoint measures the cost of serializing a
+// representative Point.
+func BenchmarkPoint(b *testing.B) {
+	give := &Point{
+		X: 3.1415,
+		Y: 3.1415,
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(Point)
+	})
+}
+
+// BenchmarkPrimitives measures the cost of serializing a
+// representative Primitives.
+func BenchmarkPrimitives(b *testing.B) {
+	give := &Primitives{
+		BinaryField: []byte("representative value"),
+		BoolField:   true,
+		ByteField:   42,
+		DoubleField: ptr.Float64(3.1415),
+		Int16Field:  ptr.Int16(4242),
+		Int32Field:  ptr.Int32(4242),
+		Int64Field:  ptr.Int64(4242),
+		StringField: ptr.String("representative value"),
+		UuidField:   _UUID_ptr(thriftuuid.UUID{0xc3, 0xb8, 0xa5, 0xc2, 0x0f, 0x4e, 0x4b, 0x1e, 0x9e, 0x41, 0x52, 0xc3, 0xc6, 0xf2, 0xa6, 0xd1}),
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(Primitives)
+	})
+}
+
+// BenchmarkShape measures the cost of serializing a
+// representative Shape.
+func BenchmarkShape(b *testing.B) {
+	give := &Shape{
+		Point: &Point{
+			X: 3.1415,
+			Y: 3.1415,
+		},
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(Shape)
+	})
+}
+
+// BenchmarkShapeError measures the cost of serializing a
+// representative ShapeError.
+func BenchmarkShapeError(b *testing.B) {
+	give := &ShapeError{
+		Message: "representative value",
+		Shape: &Shape{
+			Point: &Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+		},
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(ShapeError)
+	})
+}
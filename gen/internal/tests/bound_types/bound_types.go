@@ -0,0 +1,3518 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package bound_types
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	domain "go.uber.org/thriftrw/gen/internal/tests/domain"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+	time "time"
+)
+
+func _Timestamp_FromConstant(x int64) time.Time {
+	v, err := domain.MillisToTime(x)
+	if err != nil {
+		panic(fmt.Sprintf("invalid Timestamp constant %v: %v", x, err))
+	}
+	return v
+}
+
+var Epoch time.Time = _Timestamp_FromConstant(0)
+
+type Event struct {
+	ID        domain.UUID   `json:"id,required"`
+	ParentID  *domain.UUID  `json:"parentID,omitempty"`
+	CreatedAt time.Time     `json:"createdAt,required"`
+	DeletedAt *time.Time    `json:"deletedAt,omitempty"`
+	Related   []domain.UUID `json:"related,omitempty"`
+	Tags      []domain.UUID `json:"tags,omitempty"`
+	Seen      []struct {
+		Key   domain.UUID
+		Value time.Time
+	} `json:"seen,omitempty"`
+	ByName    map[string]domain.UUID `json:"byName,omitempty"`
+	ExpiresAt *time.Time             `json:"expiresAt,omitempty"`
+}
+
+func _Timestamp_ptr(v time.Time) *time.Time {
+	return &v
+}
+
+// Default_Event constructs a new Event struct,
+// pre-populating any fields with defined default values.
+func Default_Event() *Event {
+	var v Event
+	v.ExpiresAt = _Timestamp_ptr(_Timestamp_FromConstant(86400000))
+	return &v
+}
+
+func _UUID_ToWire(v domain.UUID) (wire.Value, error) {
+	x, err := domain.UUIDToBytes(v)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueBinary(x), error(nil)
+}
+
+func _Timestamp_ToWire(v time.Time) (wire.Value, error) {
+	x, err := domain.TimeToMillis(v)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueI64(x), error(nil)
+}
+
+type _List_UUID_ValueList []domain.UUID
+
+func (v _List_UUID_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := _UUID_ToWire(x)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_UUID_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_UUID_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_UUID_ValueList) Close() {}
+
+type _Set_UUID_sliceType_ValueList []domain.UUID
+
+func (v _Set_UUID_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := _UUID_ToWire(x)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_UUID_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_UUID_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_UUID_sliceType_ValueList) Close() {}
+
+type _Map_UUID_Timestamp_MapItemList []struct {
+	Key   domain.UUID
+	Value time.Time
+}
+
+func (m _Map_UUID_Timestamp_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		kw, err := _UUID_ToWire(k)
+		if err != nil {
+			return err
+		}
+
+		vw, err := _Timestamp_ToWire(v)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_UUID_Timestamp_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_UUID_Timestamp_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_UUID_Timestamp_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_UUID_Timestamp_MapItemList) Close() {}
+
+type _Map_String_UUID_MapItemList map[string]domain.UUID
+
+func (m _Map_String_UUID_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := _UUID_ToWire(v)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_UUID_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_UUID_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_UUID_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_UUID_MapItemList) Close() {}
+
+// ToWire translates a Event struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Event) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = _UUID_ToWire(v.ID)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.ParentID != nil {
+		w, err = _UUID_ToWire(*(v.ParentID))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	w, err = _Timestamp_ToWire(v.CreatedAt)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.DeletedAt != nil {
+		w, err = _Timestamp_ToWire(*(v.DeletedAt))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Related != nil {
+		w, err = wire.NewValueList(_List_UUID_ValueList(v.Related)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_UUID_sliceType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Seen != nil {
+		w, err = wire.NewValueMap(_Map_UUID_Timestamp_MapItemList(v.Seen)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.ByName != nil {
+		w, err = wire.NewValueMap(_Map_String_UUID_MapItemList(v.ByName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	vExpiresAt := v.ExpiresAt
+	if vExpiresAt == nil {
+		vExpiresAt = _Timestamp_ptr(_Timestamp_FromConstant(86400000))
+	}
+	{
+		w, err = _Timestamp_ToWire(*(vExpiresAt))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UUID_Read(w wire.Value) (domain.UUID, error) {
+	x, err := w.GetBinary(), error(nil)
+	if err != nil {
+		var x domain.UUID
+		return x, err
+	}
+	return domain.BytesToUUID(x)
+}
+
+func _Timestamp_Read(w wire.Value) (time.Time, error) {
+	x, err := w.GetI64(), error(nil)
+	if err != nil {
+		var x time.Time
+		return x, err
+	}
+	return domain.MillisToTime(x)
+}
+
+func _List_UUID_Read(l wire.ValueList) ([]domain.UUID, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]domain.UUID, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _UUID_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_UUID_sliceType_Read(s wire.ValueList) ([]domain.UUID, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]domain.UUID, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _UUID_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_UUID_Timestamp_Read(m wire.MapItemList) ([]struct {
+	Key   domain.UUID
+	Value time.Time
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   domain.UUID
+		Value time.Time
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _UUID_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Timestamp_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   domain.UUID
+			Value time.Time
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_UUID_Read(m wire.MapItemList) (map[string]domain.UUID, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]domain.UUID, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _UUID_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Event struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Event struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Event
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Event) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	createdAtIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = _UUID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x domain.UUID
+				x, err = _UUID_Read(field.Value)
+				v.ParentID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				v.CreatedAt, err = _Timestamp_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				createdAtIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = _Timestamp_Read(field.Value)
+				v.DeletedAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Related, err = _List_UUID_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_UUID_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Seen, err = _Map_UUID_Timestamp_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.ByName, err = _Map_String_UUID_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = _Timestamp_Read(field.Value)
+				v.ExpiresAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Event is required")
+	}
+
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of Event is required")
+	}
+
+	if v.ExpiresAt == nil {
+		v.ExpiresAt = _Timestamp_ptr(_Timestamp_FromConstant(86400000))
+	}
+
+	return nil
+}
+
+func _UUID_Encode(v domain.UUID, sw stream.Writer) error {
+	x, err := domain.UUIDToBytes(v)
+	if err != nil {
+		return err
+	}
+	return sw.WriteBinary(x)
+}
+
+func _Timestamp_Encode(v time.Time, sw stream.Writer) error {
+	x, err := domain.TimeToMillis(v)
+	if err != nil {
+		return err
+	}
+	return sw.WriteInt64(x)
+}
+
+func _List_UUID_Encode(val []domain.UUID, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []domain.UUID
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := _UUID_Encode(v, writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Set_UUID_sliceType_Encode(val []domain.UUID, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+
+		if err := _UUID_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_UUID_Timestamp_Encode(val []struct {
+	Key   domain.UUID
+	Value time.Time
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if err := _UUID_Encode(key, sw); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(value, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_UUID_Encode(val map[string]domain.UUID, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := _UUID_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Event struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Event struct could not be encoded.
+func (v *Event) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := _UUID_Encode(v.ID, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.ParentID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := _UUID_Encode(*(v.ParentID), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI64}); err != nil {
+		return err
+	}
+	if err := _Timestamp_Encode(v.CreatedAt, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.DeletedAt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(*(v.DeletedAt), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Related != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_UUID_Encode(v.Related, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_UUID_sliceType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Seen != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_UUID_Timestamp_Encode(v.Seen, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ByName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_UUID_Encode(v.ByName, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vExpiresAt := v.ExpiresAt
+	if vExpiresAt == nil {
+		vExpiresAt = _Timestamp_ptr(_Timestamp_FromConstant(86400000))
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(*(vExpiresAt), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _UUID_Decode(sr stream.Reader) (domain.UUID, error) {
+	x, err := sr.ReadBinary()
+	if err != nil {
+		var x domain.UUID
+		return x, err
+	}
+	return domain.BytesToUUID(x)
+}
+
+func _Timestamp_Decode(sr stream.Reader) (time.Time, error) {
+	x, err := sr.ReadInt64()
+	if err != nil {
+		var x time.Time
+		return x, err
+	}
+	return domain.MillisToTime(x)
+}
+
+func _List_UUID_Decode(sr stream.Reader) ([]domain.UUID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]domain.UUID, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_UUID_sliceType_Decode(sr stream.Reader) ([]domain.UUID, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([]domain.UUID, 0, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_UUID_Timestamp_Decode(sr stream.Reader) ([]struct {
+	Key   domain.UUID
+	Value time.Time
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   domain.UUID
+		Value time.Time
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Timestamp_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   domain.UUID
+			Value time.Time
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_UUID_Decode(sr stream.Reader) (map[string]domain.UUID, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]domain.UUID, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Event struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Event struct could not be generated from the wire
+// representation.
+func (v *Event) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	createdAtIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x domain.UUID
+			x, err = _UUID_Decode(sr)
+			v.ParentID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI64:
+			v.CreatedAt, err = _Timestamp_Decode(sr)
+			if err != nil {
+				return err
+			}
+			createdAtIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI64:
+			var x time.Time
+			x, err = _Timestamp_Decode(sr)
+			v.DeletedAt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Related, err = _List_UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_UUID_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Seen, err = _Map_UUID_Timestamp_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.ByName, err = _Map_String_UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TI64:
+			var x time.Time
+			x, err = _Timestamp_Decode(sr)
+			v.ExpiresAt = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Event is required")
+	}
+
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of Event is required")
+	}
+
+	if v.ExpiresAt == nil {
+		v.ExpiresAt = _Timestamp_ptr(_Timestamp_FromConstant(86400000))
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Event
+// struct.
+func (v *Event) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.ParentID != nil {
+		fields[i] = fmt.Sprintf("ParentID: %v", *(v.ParentID))
+		i++
+	}
+	fields[i] = fmt.Sprintf("CreatedAt: %v", v.CreatedAt)
+	i++
+	if v.DeletedAt != nil {
+		fields[i] = fmt.Sprintf("DeletedAt: %v", *(v.DeletedAt))
+		i++
+	}
+	if v.Related != nil {
+		fields[i] = fmt.Sprintf("Related: %v", v.Related)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Seen != nil {
+		fields[i] = fmt.Sprintf("Seen: %v", v.Seen)
+		i++
+	}
+	if v.ByName != nil {
+		fields[i] = fmt.Sprintf("ByName: %v", v.ByName)
+		i++
+	}
+	if v.ExpiresAt != nil {
+		fields[i] = fmt.Sprintf("ExpiresAt: %v", *(v.ExpiresAt))
+		i++
+	}
+
+	return fmt.Sprintf("Event{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _UUID_Equals(lhs, rhs domain.UUID) bool {
+	x, err := domain.UUIDToBytes(lhs)
+	if err != nil {
+		return false
+	}
+	y, err := domain.UUIDToBytes(rhs)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
+
+func _UUID_EqualsPtr(lhs, rhs *domain.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return _UUID_Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Timestamp_Equals(lhs, rhs time.Time) bool {
+	x, err := domain.TimeToMillis(lhs)
+	if err != nil {
+		return false
+	}
+	y, err := domain.TimeToMillis(rhs)
+	if err != nil {
+		return false
+	}
+	return (x == y)
+}
+
+func _Timestamp_EqualsPtr(lhs, rhs *time.Time) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return _Timestamp_Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_UUID_Equals(lhs, rhs []domain.UUID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_UUID_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_UUID_sliceType_Equals(lhs, rhs []domain.UUID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if _UUID_Equals(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_UUID_Timestamp_Equals(lhs, rhs []struct {
+	Key   domain.UUID
+	Value time.Time
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !_UUID_Equals(lk, rk) {
+				continue
+			}
+
+			if !_Timestamp_Equals(lv, rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_UUID_Equals(lhs, rhs map[string]domain.UUID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_UUID_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Event match the
+// provided Event.
+//
+// This function performs a deep comparison.
+func (v *Event) Equals(rhs *Event) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_UUID_Equals(v.ID, rhs.ID) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.ParentID, rhs.ParentID) {
+		return false
+	}
+	if !_Timestamp_Equals(v.CreatedAt, rhs.CreatedAt) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.DeletedAt, rhs.DeletedAt) {
+		return false
+	}
+	if !((v.Related == nil && rhs.Related == nil) || (v.Related != nil && rhs.Related != nil && _List_UUID_Equals(v.Related, rhs.Related))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_UUID_sliceType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Seen == nil && rhs.Seen == nil) || (v.Seen != nil && rhs.Seen != nil && _Map_UUID_Timestamp_Equals(v.Seen, rhs.Seen))) {
+		return false
+	}
+	if !((v.ByName == nil && rhs.ByName == nil) || (v.ByName != nil && rhs.ByName != nil && _Map_String_UUID_Equals(v.ByName, rhs.ByName))) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.ExpiresAt, rhs.ExpiresAt) {
+		return false
+	}
+
+	return true
+}
+
+func _UUID_CopyPtr(v *domain.UUID) *domain.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Timestamp_CopyPtr(v *time.Time) *time.Time {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_UUID_Copy(v []domain.UUID) []domain.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]domain.UUID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_UUID_sliceType_Copy(v []domain.UUID) []domain.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]domain.UUID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_UUID_Timestamp_Copy(v []struct {
+	Key   domain.UUID
+	Value time.Time
+}) []struct {
+	Key   domain.UUID
+	Value time.Time
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   domain.UUID
+		Value time.Time
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_String_UUID_Copy(v map[string]domain.UUID) map[string]domain.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]domain.UUID, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Event.
+func (v *Event) Copy() *Event {
+	if v == nil {
+		return nil
+	}
+
+	var o Event
+	o.ID = v.ID
+	o.ParentID = _UUID_CopyPtr(v.ParentID)
+	o.CreatedAt = v.CreatedAt
+	o.DeletedAt = _Timestamp_CopyPtr(v.DeletedAt)
+	o.Related = _List_UUID_Copy(v.Related)
+	o.Tags = _Set_UUID_sliceType_Copy(v.Tags)
+	o.Seen = _Map_UUID_Timestamp_Copy(v.Seen)
+	o.ByName = _Map_String_UUID_Copy(v.ByName)
+	o.ExpiresAt = _Timestamp_CopyPtr(v.ExpiresAt)
+	return &o
+}
+
+func _UUID_Hash(v domain.UUID) uint64 {
+	h := thrifthash.New()
+	if x, err := domain.UUIDToBytes(v); err == nil {
+		h.Binary(x)
+	}
+	return h.Sum64()
+}
+
+func _Timestamp_Hash(v time.Time) uint64 {
+	h := thrifthash.New()
+	if x, err := domain.TimeToMillis(v); err == nil {
+		h.Int64(x)
+	}
+	return h.Sum64()
+}
+
+func _List_UUID_Hash(v []domain.UUID) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(_UUID_Hash(x))
+	}
+	return h.Sum64()
+}
+
+func _Set_UUID_sliceType_Hash(v []domain.UUID) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_UUID_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_UUID_Timestamp_Hash(v []struct {
+	Key   domain.UUID
+	Value time.Time
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_UUID_Hash(x.Key))
+		h.Uint64(_Timestamp_Hash(x.Value))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_UUID_Hash(v map[string]domain.UUID) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(_UUID_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Event which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Event) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_UUID_Hash(v.ID))
+	if v.ParentID != nil {
+		h.Field(2)
+		h.Uint64(_UUID_Hash(*v.ParentID))
+	}
+	h.Field(3)
+	h.Uint64(_Timestamp_Hash(v.CreatedAt))
+	if v.DeletedAt != nil {
+		h.Field(4)
+		h.Uint64(_Timestamp_Hash(*v.DeletedAt))
+	}
+	h.Field(5)
+	h.Uint64(_List_UUID_Hash(v.Related))
+	h.Field(6)
+	h.Uint64(_Set_UUID_sliceType_Hash(v.Tags))
+	h.Field(7)
+	h.Uint64(_Map_UUID_Timestamp_Hash(v.Seen))
+	h.Field(8)
+	h.Uint64(_Map_String_UUID_Hash(v.ByName))
+	if v.ExpiresAt != nil {
+		h.Field(9)
+		h.Uint64(_Timestamp_Hash(*v.ExpiresAt))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Event so that it may be reused.
+func (v *Event) Reset() {
+	*v = Event{}
+}
+
+func _UUID_ZapValue(v domain.UUID) []byte {
+	x, _ := domain.UUIDToBytes(v)
+	return x
+}
+
+func _Timestamp_ZapValue(v time.Time) int64 {
+	x, _ := domain.TimeToMillis(v)
+	return x
+}
+
+type _List_UUID_Zapper []domain.UUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_UUID_Zapper.
+func (l _List_UUID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(base64.StdEncoding.EncodeToString(_UUID_ZapValue(v)))
+	}
+	return err
+}
+
+type _Set_UUID_sliceType_Zapper []domain.UUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_UUID_sliceType_Zapper.
+func (s _Set_UUID_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		enc.AppendString(base64.StdEncoding.EncodeToString(_UUID_ZapValue(v)))
+	}
+	return err
+}
+
+type _Map_UUID_Timestamp_Item_Zapper struct {
+	Key   domain.UUID
+	Value time.Time
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UUID_Timestamp_Item_Zapper.
+func (v _Map_UUID_Timestamp_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", base64.StdEncoding.EncodeToString(_UUID_ZapValue(v.Key)))
+	enc.AddInt64("value", _Timestamp_ZapValue(v.Value))
+	return err
+}
+
+type _Map_UUID_Timestamp_Zapper []struct {
+	Key   domain.UUID
+	Value time.Time
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UUID_Timestamp_Zapper.
+func (m _Map_UUID_Timestamp_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_UUID_Timestamp_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_String_UUID_Zapper map[string]domain.UUID
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_UUID_Zapper.
+func (m _Map_String_UUID_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(_UUID_ZapValue(v)))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Event.
+func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", base64.StdEncoding.EncodeToString(_UUID_ZapValue(v.ID)))
+	if v.ParentID != nil {
+		enc.AddString("parentID", base64.StdEncoding.EncodeToString(_UUID_ZapValue(*v.ParentID)))
+	}
+	enc.AddInt64("createdAt", _Timestamp_ZapValue(v.CreatedAt))
+	if v.DeletedAt != nil {
+		enc.AddInt64("deletedAt", _Timestamp_ZapValue(*v.DeletedAt))
+	}
+	if v.Related != nil {
+		err = multierr.Append(err, enc.AddArray("related", (_List_UUID_Zapper)(v.Related)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_UUID_sliceType_Zapper)(v.Tags)))
+	}
+	if v.Seen != nil {
+		err = multierr.Append(err, enc.AddArray("seen", (_Map_UUID_Timestamp_Zapper)(v.Seen)))
+	}
+	if v.ByName != nil {
+		err = multierr.Append(err, enc.AddObject("byName", (_Map_String_UUID_Zapper)(v.ByName)))
+	}
+	if v.ExpiresAt != nil {
+		enc.AddInt64("expiresAt", _Timestamp_ZapValue(*v.ExpiresAt))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Event) GetID() (o domain.UUID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetParentID returns the value of ParentID if it is set or its
+// zero value if it is unset.
+func (v *Event) GetParentID() (o domain.UUID) {
+	if v != nil && v.ParentID != nil {
+		return *v.ParentID
+	}
+
+	return
+}
+
+// IsSetParentID returns true if ParentID is not nil.
+func (v *Event) IsSetParentID() bool {
+	return v != nil && v.ParentID != nil
+}
+
+// GetCreatedAt returns the value of CreatedAt if it is set or its
+// zero value if it is unset.
+func (v *Event) GetCreatedAt() (o time.Time) {
+	if v != nil {
+		o = v.CreatedAt
+	}
+	return
+}
+
+// GetDeletedAt returns the value of DeletedAt if it is set or its
+// zero value if it is unset.
+func (v *Event) GetDeletedAt() (o time.Time) {
+	if v != nil && v.DeletedAt != nil {
+		return *v.DeletedAt
+	}
+
+	return
+}
+
+// IsSetDeletedAt returns true if DeletedAt is not nil.
+func (v *Event) IsSetDeletedAt() bool {
+	return v != nil && v.DeletedAt != nil
+}
+
+// GetRelated returns the value of Related if it is set or its
+// zero value if it is unset.
+func (v *Event) GetRelated() (o []domain.UUID) {
+	if v != nil && v.Related != nil {
+		return v.Related
+	}
+
+	return
+}
+
+// IsSetRelated returns true if Related is not nil.
+func (v *Event) IsSetRelated() bool {
+	return v != nil && v.Related != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Event) GetTags() (o []domain.UUID) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Event) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetSeen returns the value of Seen if it is set or its
+// zero value if it is unset.
+func (v *Event) GetSeen() (o []struct {
+	Key   domain.UUID
+	Value time.Time
+}) {
+	if v != nil && v.Seen != nil {
+		return v.Seen
+	}
+
+	return
+}
+
+// IsSetSeen returns true if Seen is not nil.
+func (v *Event) IsSetSeen() bool {
+	return v != nil && v.Seen != nil
+}
+
+// GetByName returns the value of ByName if it is set or its
+// zero value if it is unset.
+func (v *Event) GetByName() (o map[string]domain.UUID) {
+	if v != nil && v.ByName != nil {
+		return v.ByName
+	}
+
+	return
+}
+
+// IsSetByName returns true if ByName is not nil.
+func (v *Event) IsSetByName() bool {
+	return v != nil && v.ByName != nil
+}
+
+// GetExpiresAt returns the value of ExpiresAt if it is set or its
+// default value if it is unset.
+func (v *Event) GetExpiresAt() (o time.Time) {
+	if v != nil && v.ExpiresAt != nil {
+		return *v.ExpiresAt
+	}
+	o = _Timestamp_FromConstant(86400000)
+	return
+}
+
+// IsSetExpiresAt returns true if ExpiresAt is not nil.
+func (v *Event) IsSetExpiresAt() bool {
+	return v != nil && v.ExpiresAt != nil
+}
+
+type EventRef struct {
+	ID *domain.UUID `json:"id,omitempty"`
+	At *time.Time   `json:"at,omitempty"`
+}
+
+// ToWire translates a EventRef struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *EventRef) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = _UUID_ToWire(*(v.ID))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.At != nil {
+		w, err = _Timestamp_ToWire(*(v.At))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("EventRef should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a EventRef struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a EventRef struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v EventRef
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *EventRef) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x domain.UUID
+				x, err = _UUID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = _Timestamp_Read(field.Value)
+				v.At = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.ID != nil {
+		count++
+	}
+	if v.At != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("EventRef should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a EventRef struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a EventRef struct could not be encoded.
+func (v *EventRef) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := _UUID_Encode(*(v.ID), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.At != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(*(v.At), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.ID != nil {
+		count++
+	}
+	if v.At != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("EventRef should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a EventRef struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a EventRef struct could not be generated from the wire
+// representation.
+func (v *EventRef) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x domain.UUID
+			x, err = _UUID_Decode(sr)
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x time.Time
+			x, err = _Timestamp_Decode(sr)
+			v.At = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.ID != nil {
+		count++
+	}
+	if v.At != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("EventRef should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a EventRef
+// struct.
+func (v *EventRef) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.At != nil {
+		fields[i] = fmt.Sprintf("At: %v", *(v.At))
+		i++
+	}
+
+	return fmt.Sprintf("EventRef{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this EventRef match the
+// provided EventRef.
+//
+// This function performs a deep comparison.
+func (v *EventRef) Equals(rhs *EventRef) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.At, rhs.At) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this EventRef.
+func (v *EventRef) Copy() *EventRef {
+	if v == nil {
+		return nil
+	}
+
+	var o EventRef
+	o.ID = _UUID_CopyPtr(v.ID)
+	o.At = _Timestamp_CopyPtr(v.At)
+	return &o
+}
+
+// Hash returns a hash of this EventRef which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *EventRef) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.ID != nil {
+		h.Field(1)
+		h.Uint64(_UUID_Hash(*v.ID))
+	}
+	if v.At != nil {
+		h.Field(2)
+		h.Uint64(_Timestamp_Hash(*v.At))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this EventRef so that it may be reused.
+func (v *EventRef) Reset() {
+	*v = EventRef{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EventRef.
+func (v *EventRef) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", base64.StdEncoding.EncodeToString(_UUID_ZapValue(*v.ID)))
+	}
+	if v.At != nil {
+		enc.AddInt64("at", _Timestamp_ZapValue(*v.At))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *EventRef) GetID() (o domain.UUID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *EventRef) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// LookupID returns the value of ID and true if it is
+// set, or its zero value and false if another field is set.
+func (v *EventRef) LookupID() (o domain.UUID, _ bool) {
+	if v != nil && v.ID != nil {
+		return *v.ID, true
+	}
+	return o, false
+}
+
+// SetID sets the value of ID and unsets all other
+// fields of this EventRef.
+func (v *EventRef) SetID(x domain.UUID) {
+	*v = EventRef{}
+	v.ID = &x
+}
+
+// GetAt returns the value of At if it is set or its
+// zero value if it is unset.
+func (v *EventRef) GetAt() (o time.Time) {
+	if v != nil && v.At != nil {
+		return *v.At
+	}
+
+	return
+}
+
+// IsSetAt returns true if At is not nil.
+func (v *EventRef) IsSetAt() bool {
+	return v != nil && v.At != nil
+}
+
+// LookupAt returns the value of At and true if it is
+// set, or its zero value and false if another field is set.
+func (v *EventRef) LookupAt() (o time.Time, _ bool) {
+	if v != nil && v.At != nil {
+		return *v.At, true
+	}
+	return o, false
+}
+
+// SetAt sets the value of At and unsets all other
+// fields of this EventRef.
+func (v *EventRef) SetAt(x time.Time) {
+	*v = EventRef{}
+	v.At = &x
+}
+
+// Which returns the ID of the field of this EventRef which is set, or
+// 0 if no field is set.
+func (v *EventRef) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.ID != nil {
+		return 1
+	}
+	if v.At != nil {
+		return 2
+	}
+	return 0
+}
+
+// EventRef_Visitor visits the field of a EventRef which is set.
+//
+// Fields added to EventRef add methods to EventRef_Visitor, so that
+// implementations which do not handle them fail to compile.
+type EventRef_Visitor interface {
+	// VisitID is called with the value of ID if it is set.
+	VisitID(domain.UUID) error
+
+	// VisitAt is called with the value of At if it is set.
+	VisitAt(time.Time) error
+
+	// Default is called if no field of EventRef is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this EventRef
+// which is set, or Default if none is, and returns its error.
+func (v *EventRef) Match(visitor EventRef_Visitor) error {
+	if v != nil {
+		if v.ID != nil {
+			return visitor.VisitID(*v.ID)
+		}
+		if v.At != nil {
+			return visitor.VisitAt(*v.At)
+		}
+	}
+	return visitor.Default()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "bound_types",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/bound_types",
+	FilePath: "bound_types.thrift",
+	SHA1:     "2093b9c0df69c86e1d83291871fbd7680333f7e2",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef binary UUID (\n    go.type = \"go.uber.org/thriftrw/gen/internal/tests/domain.UUID\",\n    go.typeconv = \"go.uber.org/thriftrw/gen/internal/tests/domain.UUIDToBytes/BytesToUUID\",\n)\n\ntypedef i64 Timestamp (\n    go.type = \"time.Time\",\n    go.typeconv = \"go.uber.org/thriftrw/gen/internal/tests/domain.TimeToMillis/MillisToTime\",\n)\n\nstruct Event {\n    1: required UUID id\n    2: optional UUID parentID\n    3: required Timestamp createdAt\n    4: optional Timestamp deletedAt\n    5: optional list<UUID> related\n    6: optional set<UUID> tags\n    7: optional map<UUID, Timestamp> seen\n    8: optional map<string, UUID> byName\n    9: optional Timestamp expiresAt = 86400000\n}\n\nunion EventRef {\n    1: UUID id\n    2: Timestamp at\n}\n\nconst Timestamp Epoch = 0\n\nservice Events {\n    Event getEvent(1: UUID id)\n    list<UUID> listEvents(1: Timestamp since, 2: optional Timestamp before)\n}\n"
+
+// Events_GetEvent_Args represents the arguments for the Events.getEvent function.
+//
+// The arguments for getEvent are sent and received over the wire as this struct.
+type Events_GetEvent_Args struct {
+	ID *domain.UUID `json:"id,omitempty"`
+}
+
+// ToWire translates a Events_GetEvent_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Events_GetEvent_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = _UUID_ToWire(*(v.ID))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Events_GetEvent_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Events_GetEvent_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Events_GetEvent_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Events_GetEvent_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x domain.UUID
+				x, err = _UUID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Events_GetEvent_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Events_GetEvent_Args struct could not be encoded.
+func (v *Events_GetEvent_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := _UUID_Encode(*(v.ID), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Events_GetEvent_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Events_GetEvent_Args struct could not be generated from the wire
+// representation.
+func (v *Events_GetEvent_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x domain.UUID
+			x, err = _UUID_Decode(sr)
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Events_GetEvent_Args
+// struct.
+func (v *Events_GetEvent_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+
+	return fmt.Sprintf("Events_GetEvent_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Events_GetEvent_Args match the
+// provided Events_GetEvent_Args.
+//
+// This function performs a deep comparison.
+func (v *Events_GetEvent_Args) Equals(rhs *Events_GetEvent_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Events_GetEvent_Args.
+func (v *Events_GetEvent_Args) Copy() *Events_GetEvent_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Events_GetEvent_Args
+	o.ID = _UUID_CopyPtr(v.ID)
+	return &o
+}
+
+// Hash returns a hash of this Events_GetEvent_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Events_GetEvent_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.ID != nil {
+		h.Field(1)
+		h.Uint64(_UUID_Hash(*v.ID))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Events_GetEvent_Args so that it may be reused.
+func (v *Events_GetEvent_Args) Reset() {
+	*v = Events_GetEvent_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Events_GetEvent_Args.
+func (v *Events_GetEvent_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", base64.StdEncoding.EncodeToString(_UUID_ZapValue(*v.ID)))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Events_GetEvent_Args) GetID() (o domain.UUID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Events_GetEvent_Args) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getEvent" for this struct.
+func (v *Events_GetEvent_Args) MethodName() string {
+	return "getEvent"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Events_GetEvent_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Events_GetEvent_Helper provides functions that aid in handling the
+// parameters and return values of the Events.getEvent
+// function.
+var Events_GetEvent_Helper = struct {
+	// Args accepts the parameters of getEvent in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id *domain.UUID,
+	) *Events_GetEvent_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getEvent.
+	//
+	// An error can be thrown by getEvent only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getEvent
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getEvent into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getEvent
+	//
+	//   value, err := getEvent(args)
+	//   result, err := Events_GetEvent_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getEvent: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Event, error) (*Events_GetEvent_Result, error)
+
+	// UnwrapResponse takes the result struct for getEvent
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getEvent threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Events_GetEvent_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Events_GetEvent_Result) (*Event, error)
+}{}
+
+func init() {
+	Events_GetEvent_Helper.Args = func(
+		id *domain.UUID,
+	) *Events_GetEvent_Args {
+		return &Events_GetEvent_Args{
+			ID: id,
+		}
+	}
+
+	Events_GetEvent_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Events_GetEvent_Helper.WrapResponse = func(success *Event, err error) (*Events_GetEvent_Result, error) {
+		if err == nil {
+			return &Events_GetEvent_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Events_GetEvent_Helper.UnwrapResponse = func(result *Events_GetEvent_Result) (success *Event, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Events_GetEvent_Result represents the result of a Events.getEvent function call.
+//
+// The result of a getEvent execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Events_GetEvent_Result struct {
+	// Value returned by getEvent after a successful execution.
+	Success *Event `json:"success,omitempty"`
+}
+
+// ToWire translates a Events_GetEvent_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Events_GetEvent_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Events_GetEvent_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Event_Read(w wire.Value) (*Event, error) {
+	var v Event
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Events_GetEvent_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Events_GetEvent_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Events_GetEvent_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Events_GetEvent_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Event_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Events_GetEvent_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Events_GetEvent_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Events_GetEvent_Result struct could not be encoded.
+func (v *Events_GetEvent_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Events_GetEvent_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Event_Decode(sr stream.Reader) (*Event, error) {
+	var v Event
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Events_GetEvent_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Events_GetEvent_Result struct could not be generated from the wire
+// representation.
+func (v *Events_GetEvent_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Event_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Events_GetEvent_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Events_GetEvent_Result
+// struct.
+func (v *Events_GetEvent_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Events_GetEvent_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Events_GetEvent_Result match the
+// provided Events_GetEvent_Result.
+//
+// This function performs a deep comparison.
+func (v *Events_GetEvent_Result) Equals(rhs *Events_GetEvent_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Events_GetEvent_Result.
+func (v *Events_GetEvent_Result) Copy() *Events_GetEvent_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Events_GetEvent_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Events_GetEvent_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Events_GetEvent_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Events_GetEvent_Result so that it may be reused.
+func (v *Events_GetEvent_Result) Reset() {
+	*v = Events_GetEvent_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Events_GetEvent_Result.
+func (v *Events_GetEvent_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Events_GetEvent_Result) GetSuccess() (o *Event) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Events_GetEvent_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getEvent" for this struct.
+func (v *Events_GetEvent_Result) MethodName() string {
+	return "getEvent"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Events_GetEvent_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Events_ListEvents_Args represents the arguments for the Events.listEvents function.
+//
+// The arguments for listEvents are sent and received over the wire as this struct.
+type Events_ListEvents_Args struct {
+	Since  *time.Time `json:"since,omitempty"`
+	Before *time.Time `json:"before,omitempty"`
+}
+
+// ToWire translates a Events_ListEvents_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Events_ListEvents_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Since != nil {
+		w, err = _Timestamp_ToWire(*(v.Since))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Before != nil {
+		w, err = _Timestamp_ToWire(*(v.Before))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Events_ListEvents_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Events_ListEvents_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Events_ListEvents_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Events_ListEvents_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = _Timestamp_Read(field.Value)
+				v.Since = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = _Timestamp_Read(field.Value)
+				v.Before = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Events_ListEvents_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Events_ListEvents_Args struct could not be encoded.
+func (v *Events_ListEvents_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Since != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(*(v.Since), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Before != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(*(v.Before), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Events_ListEvents_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Events_ListEvents_Args struct could not be generated from the wire
+// representation.
+func (v *Events_ListEvents_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			var x time.Time
+			x, err = _Timestamp_Decode(sr)
+			v.Since = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x time.Time
+			x, err = _Timestamp_Decode(sr)
+			v.Before = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Events_ListEvents_Args
+// struct.
+func (v *Events_ListEvents_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Since != nil {
+		fields[i] = fmt.Sprintf("Since: %v", *(v.Since))
+		i++
+	}
+	if v.Before != nil {
+		fields[i] = fmt.Sprintf("Before: %v", *(v.Before))
+		i++
+	}
+
+	return fmt.Sprintf("Events_ListEvents_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Events_ListEvents_Args match the
+// provided Events_ListEvents_Args.
+//
+// This function performs a deep comparison.
+func (v *Events_ListEvents_Args) Equals(rhs *Events_ListEvents_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.Since, rhs.Since) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.Before, rhs.Before) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Events_ListEvents_Args.
+func (v *Events_ListEvents_Args) Copy() *Events_ListEvents_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Events_ListEvents_Args
+	o.Since = _Timestamp_CopyPtr(v.Since)
+	o.Before = _Timestamp_CopyPtr(v.Before)
+	return &o
+}
+
+// Hash returns a hash of this Events_ListEvents_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Events_ListEvents_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Since != nil {
+		h.Field(1)
+		h.Uint64(_Timestamp_Hash(*v.Since))
+	}
+	if v.Before != nil {
+		h.Field(2)
+		h.Uint64(_Timestamp_Hash(*v.Before))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Events_ListEvents_Args so that it may be reused.
+func (v *Events_ListEvents_Args) Reset() {
+	*v = Events_ListEvents_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Events_ListEvents_Args.
+func (v *Events_ListEvents_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Since != nil {
+		enc.AddInt64("since", _Timestamp_ZapValue(*v.Since))
+	}
+	if v.Before != nil {
+		enc.AddInt64("before", _Timestamp_ZapValue(*v.Before))
+	}
+	return err
+}
+
+// GetSince returns the value of Since if it is set or its
+// zero value if it is unset.
+func (v *Events_ListEvents_Args) GetSince() (o time.Time) {
+	if v != nil && v.Since != nil {
+		return *v.Since
+	}
+
+	return
+}
+
+// IsSetSince returns true if Since is not nil.
+func (v *Events_ListEvents_Args) IsSetSince() bool {
+	return v != nil && v.Since != nil
+}
+
+// GetBefore returns the value of Before if it is set or its
+// zero value if it is unset.
+func (v *Events_ListEvents_Args) GetBefore() (o time.Time) {
+	if v != nil && v.Before != nil {
+		return *v.Before
+	}
+
+	return
+}
+
+// IsSetBefore returns true if Before is not nil.
+func (v *Events_ListEvents_Args) IsSetBefore() bool {
+	return v != nil && v.Before != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "listEvents" for this struct.
+func (v *Events_ListEvents_Args) MethodName() string {
+	return "listEvents"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Events_ListEvents_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Events_ListEvents_Helper provides functions that aid in handling the
+// parameters and return values of the Events.listEvents
+// function.
+var Events_ListEvents_Helper = struct {
+	// Args accepts the parameters of listEvents in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		since *time.Time,
+		before *time.Time,
+	) *Events_ListEvents_Args
+
+	// IsException returns true if the given error can be thrown
+	// by listEvents.
+	//
+	// An error can be thrown by listEvents only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for listEvents
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// listEvents into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by listEvents
+	//
+	//   value, err := listEvents(args)
+	//   result, err := Events_ListEvents_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from listEvents: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func([]domain.UUID, error) (*Events_ListEvents_Result, error)
+
+	// UnwrapResponse takes the result struct for listEvents
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if listEvents threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Events_ListEvents_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Events_ListEvents_Result) ([]domain.UUID, error)
+}{}
+
+func init() {
+	Events_ListEvents_Helper.Args = func(
+		since *time.Time,
+		before *time.Time,
+	) *Events_ListEvents_Args {
+		return &Events_ListEvents_Args{
+			Since:  since,
+			Before: before,
+		}
+	}
+
+	Events_ListEvents_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Events_ListEvents_Helper.WrapResponse = func(success []domain.UUID, err error) (*Events_ListEvents_Result, error) {
+		if err == nil {
+			return &Events_ListEvents_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Events_ListEvents_Helper.UnwrapResponse = func(result *Events_ListEvents_Result) (success []domain.UUID, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Events_ListEvents_Result represents the result of a Events.listEvents function call.
+//
+// The result of a listEvents execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Events_ListEvents_Result struct {
+	// Value returned by listEvents after a successful execution.
+	Success []domain.UUID `json:"success,omitempty"`
+}
+
+// ToWire translates a Events_ListEvents_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Events_ListEvents_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueList(_List_UUID_ValueList(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Events_ListEvents_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Events_ListEvents_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Events_ListEvents_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Events_ListEvents_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Events_ListEvents_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TList {
+				v.Success, err = _List_UUID_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Events_ListEvents_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Events_ListEvents_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Events_ListEvents_Result struct could not be encoded.
+func (v *Events_ListEvents_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_UUID_Encode(v.Success, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Events_ListEvents_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Events_ListEvents_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Events_ListEvents_Result struct could not be generated from the wire
+// representation.
+func (v *Events_ListEvents_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TList:
+			v.Success, err = _List_UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Events_ListEvents_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Events_ListEvents_Result
+// struct.
+func (v *Events_ListEvents_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Events_ListEvents_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Events_ListEvents_Result match the
+// provided Events_ListEvents_Result.
+//
+// This function performs a deep comparison.
+func (v *Events_ListEvents_Result) Equals(rhs *Events_ListEvents_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && _List_UUID_Equals(v.Success, rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Events_ListEvents_Result.
+func (v *Events_ListEvents_Result) Copy() *Events_ListEvents_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Events_ListEvents_Result
+	o.Success = _List_UUID_Copy(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Events_ListEvents_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Events_ListEvents_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(_List_UUID_Hash(v.Success))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Events_ListEvents_Result so that it may be reused.
+func (v *Events_ListEvents_Result) Reset() {
+	*v = Events_ListEvents_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Events_ListEvents_Result.
+func (v *Events_ListEvents_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddArray("success", (_List_UUID_Zapper)(v.Success)))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Events_ListEvents_Result) GetSuccess() (o []domain.UUID) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Events_ListEvents_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "listEvents" for this struct.
+func (v *Events_ListEvents_Result) MethodName() string {
+	return "listEvents"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Events_ListEvents_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
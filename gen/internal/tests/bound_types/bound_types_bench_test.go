@@ -0,0 +1,154 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package bound_types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	domain "go.uber.org/thriftrw/gen/internal/tests/domain"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	wire "go.uber.org/thriftrw/wire"
+	testing "testing"
+	time "time"
+)
+
+func _UUID_FromConstant(x []byte) domain.UUID {
+	v, err := domain.BytesToUUID(x)
+	if err != nil {
+		panic(fmt.Sprintf("invalid UUID constant %v: %v", x, err))
+	}
+	return v
+}
+
+func _UUID_ptr(v domain.UUID) *domain.UUID {
+	return &v
+}
+
+type _benchmarkValue interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+
+	Encode(stream.Writer) error
+	Decode(stream.Reader) error
+}
+
+// _benchmarkRoundTrip runs sub-benchmarks for each serialization
+// method of the given value. newValue returns an empty value of the
+// same type to decode into.
+func _benchmarkRoundTrip(b *testing.B, give _benchmarkValue, newValue func() _benchmarkValue) {
+	w, err := give.ToWire()
+	if err != nil {
+		b.Fatal(err)
+	}
+	var buff bytes.Buffer
+	if err := binary.Default.Encode(w, &buff); err != nil {
+		b.Fatal(err)
+	}
+	encoded := buff.Bytes()
+
+	b.Run("ToWire", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buff.Reset()
+			w, err := give.ToWire()
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := binary.Default.Encode(w, &buff); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("FromWire", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			w, err := binary.Default.Decode(bytes.NewReader(encoded), wire.TStruct)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := newValue().FromWire(w); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Encode", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var out bytes.Buffer
+			sw := binary.Default.Writer(&out)
+			if err := give.Encode(sw); err != nil {
+				b.Fatal(err)
+			}
+			if err := sw.Close(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Decode", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sr := binary.Default.Reader(bytes.NewReader(encoded))
+			if err := newValue().Decode(sr); err != nil {
+				b.Fatal(err)
+			}
+			if err := sr.Close(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkEvent measures the cost of serializing a
+// representative Event.
+func BenchmarkEvent(b *testing.B) {
+	give := &Event{
+		ByName: map[string]domain.UUID{
+			"representative value": _UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+		CreatedAt: _Timestamp_FromConstant(4242),
+		DeletedAt: _Timestamp_ptr(_Timestamp_FromConstant(4242)),
+		ExpiresAt: _Timestamp_ptr(_Timestamp_FromConstant(4242)),
+		ID:        _UUID_FromConstant([]byte("0123456789abcdef")),
+		ParentID:  _UUID_ptr(_UUID_FromConstant([]byte("0123456789abcdef"))),
+		Related: []domain.UUID{
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+		Seen: []struct {
+			Key   domain.UUID
+			Value time.Time
+		}{
+			{
+				Key:   _UUID_FromConstant([]byte("0123456789abcdef")),
+				Value: _Timestamp_FromConstant(4242),
+			},
+		},
+		Tags: []domain.UUID{
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(Event)
+	})
+}
+
+// BenchmarkEventRef measures the cost of serializing a
+// representative EventRef.
+func BenchmarkEventRef(b *testing.B) {
+	give := &EventRef{
+		ID: _UUID_ptr(_UUID_FromConstant([]byte("0123456789abcdef"))),
+	}
+	_benchmarkRoundTrip(b, give, func() _benchmarkValue {
+		return new(EventRef)
+	})
+}
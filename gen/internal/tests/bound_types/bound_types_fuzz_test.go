@@ -0,0 +1,152 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package bound_types
+
+import (
+	bytes "bytes"
+	domain "go.uber.org/thriftrw/gen/internal/tests/domain"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	wire "go.uber.org/thriftrw/wire"
+	testing "testing"
+	time "time"
+)
+
+type _fuzzValue interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+
+	Encode(stream.Writer) error
+	Decode(stream.Reader) error
+	String() string
+}
+
+// _fuzzSeed adds the Binary encoding of the given value to the seed
+// corpus, along with an empty struct.
+func _fuzzSeed(f *testing.F, give _fuzzValue) {
+	f.Add([]byte{0})
+	if give == nil {
+		return
+	}
+	w, err := give.ToWire()
+	if err != nil {
+		f.Fatal(err)
+	}
+	var buff bytes.Buffer
+	if err := binary.Default.Encode(w, &buff); err != nil {
+		f.Fatal(err)
+	}
+	f.Add(buff.Bytes())
+}
+
+// _fuzzRoundTrip decodes data into a value returned by newValue. If
+// that succeeds, it checks that the value encodes with each
+// serialization method, and that decoding the result produces a
+// value equal to it.
+func _fuzzRoundTrip(
+	t *testing.T,
+	data []byte,
+	newValue func() _fuzzValue,
+	equal func(_fuzzValue, _fuzzValue) bool,
+) {
+	w, err := binary.Default.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return
+	}
+	give := newValue()
+	if err := give.FromWire(w); err != nil {
+		return
+	}
+
+	check := func(method string, encoded []byte) {
+		w, err := binary.Default.Decode(bytes.NewReader(encoded), wire.TStruct)
+		if err != nil {
+			t.Fatalf("%v: cannot decode %v: %v", method, give, err)
+		}
+		got := newValue()
+		if err := got.FromWire(w); err != nil {
+			t.Fatalf("%v: cannot decode %v: %v", method, give, err)
+		}
+
+		if !equal(give, got) && give.String() != got.String() {
+			t.Fatalf("%v: %v changed to %v after a round trip", method, give, got)
+		}
+	}
+
+	w, err = give.ToWire()
+	if err != nil {
+		t.Fatalf("ToWire: cannot encode %v: %v", give, err)
+	}
+	var buff bytes.Buffer
+	if err := binary.Default.Encode(w, &buff); err != nil {
+		t.Fatalf("ToWire: cannot encode %v: %v", give, err)
+	}
+	check("ToWire", buff.Bytes())
+
+	var out bytes.Buffer
+	sw := binary.Default.Writer(&out)
+	if err := give.Encode(sw); err != nil {
+		t.Fatalf("Encode: cannot encode %v: %v", give, err)
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatalf("Encode: cannot encode %v: %v", give, err)
+	}
+	check("Encode", out.Bytes())
+}
+
+// FuzzEventRoundTrip decodes arbitrary bytes into a Event
+// and checks that values which decode successfully survive a round trip.
+func FuzzEventRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &Event{
+		ByName: map[string]domain.UUID{
+			"representative value": _UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+		CreatedAt: _Timestamp_FromConstant(4242),
+		DeletedAt: _Timestamp_ptr(_Timestamp_FromConstant(4242)),
+		ExpiresAt: _Timestamp_ptr(_Timestamp_FromConstant(4242)),
+		ID:        _UUID_FromConstant([]byte("0123456789abcdef")),
+		ParentID:  _UUID_ptr(_UUID_FromConstant([]byte("0123456789abcdef"))),
+		Related: []domain.UUID{
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+		Seen: []struct {
+			Key   domain.UUID
+			Value time.Time
+		}{
+			{
+				Key:   _UUID_FromConstant([]byte("0123456789abcdef")),
+				Value: _Timestamp_FromConstant(4242),
+			},
+		},
+		Tags: []domain.UUID{
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Event) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Event).Equals(b.(*Event))
+			},
+		)
+	})
+}
+
+// FuzzEventRefRoundTrip decodes arbitrary bytes into a EventRef
+// and checks that values which decode successfully survive a round trip.
+func FuzzEventRefRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &EventRef{
+		ID: _UUID_ptr(_UUID_FromConstant([]byte("0123456789abcdef"))),
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(EventRef) },
+			func(a, b _fuzzValue) bool {
+				return a.(*EventRef).Equals(b.(*EventRef))
+			},
+		)
+	})
+}
@@ -0,0 +1,155 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package bound_types
+
+import (
+	bytes "bytes"
+	domain "go.uber.org/thriftrw/gen/internal/tests/domain"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	wire "go.uber.org/thriftrw/wire"
+	testing "testing"
+	time "time"
+)
+
+type _goldenValue interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+
+	Encode(stream.Writer) error
+	Decode(stream.Reader) error
+}
+
+func _goldenToWire(v _goldenValue) ([]byte, error) {
+	w, err := v.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	err = binary.Default.Encode(w, &buff)
+	return buff.Bytes(), err
+}
+
+func _goldenEncode(v _goldenValue) ([]byte, error) {
+	var buff bytes.Buffer
+	sw := binary.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	err := sw.Close()
+	return buff.Bytes(), err
+}
+
+// _goldenCheck checks that give encodes to the golden bytes with each
+// serialization method, and that decoding the golden bytes and
+// encoding the result reproduces them. newValue returns an empty
+// value of the same type to decode into. wantThriftJSON is nil if
+// the value is not tested with TJSONProtocol.
+func _goldenCheck(t *testing.T, give _goldenValue, newValue func() _goldenValue, wantBinary, wantThriftJSON []byte) {
+	t.Helper()
+
+	check := func(t *testing.T, got []byte, err error, want []byte) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("encoding changed:\n got %q\nwant %q", got, want)
+		}
+	}
+
+	t.Run("ToWire", func(t *testing.T) {
+		got, err := _goldenToWire(give)
+		check(t, got, err, wantBinary)
+	})
+
+	t.Run("FromWire", func(t *testing.T) {
+		w, err := binary.Default.Decode(bytes.NewReader(wantBinary), wire.TStruct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := newValue()
+		if err := v.FromWire(w); err != nil {
+			t.Fatal(err)
+		}
+		got, err := _goldenToWire(v)
+		check(t, got, err, wantBinary)
+	})
+
+	t.Run("Encode", func(t *testing.T) {
+		got, err := _goldenEncode(give)
+		check(t, got, err, wantBinary)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		sr := binary.Default.Reader(bytes.NewReader(wantBinary))
+		v := newValue()
+		if err := v.Decode(sr); err != nil {
+			t.Fatal(err)
+		}
+		if err := sr.Close(); err != nil {
+			t.Fatal(err)
+		}
+		got, err := _goldenEncode(v)
+		check(t, got, err, wantBinary)
+	})
+}
+
+var _Event_goldenBinary = []byte("\v\x00\x01\x00\x00\x00\x100123456789abcdef\v\x00\x02\x00\x00\x00\x1001" +
+	"23456789abcdef\n\x00\x03\x00\x00\x00\x00\x00\x00\x10\x92\n\x00\x04\x00\x00\x00\x00" +
+	"\x00\x00\x10\x92\x0f\x00\x05\v\x00\x00\x00\x03\x00\x00\x00\x100123456789abcdef" +
+	"\x00\x00\x00\x100123456789abcdef\x00\x00\x00\x1001234567" +
+	"89abcdef\x0e\x00\x06\v\x00\x00\x00\x01\x00\x00\x00\x100123456789ab" +
+	"cdef\r\x00\a\v\n\x00\x00\x00\x01\x00\x00\x00\x100123456789abcde" +
+	"f\x00\x00\x00\x00\x00\x00\x10\x92\r\x00\b\v\v\x00\x00\x00\x01\x00\x00\x00\x14representa" +
+	"tive value\x00\x00\x00\x100123456789abcdef\n\x00" +
+	"\t\x00\x00\x00\x00\x00\x00\x10\x92\x00")
+
+// TestGoldenEvent checks that the encoding of a representative
+// Event has not changed.
+func TestGoldenEvent(t *testing.T) {
+	give := &Event{
+		ByName: map[string]domain.UUID{
+			"representative value": _UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+		CreatedAt: _Timestamp_FromConstant(4242),
+		DeletedAt: _Timestamp_ptr(_Timestamp_FromConstant(4242)),
+		ExpiresAt: _Timestamp_ptr(_Timestamp_FromConstant(4242)),
+		ID:        _UUID_FromConstant([]byte("0123456789abcdef")),
+		ParentID:  _UUID_ptr(_UUID_FromConstant([]byte("0123456789abcdef"))),
+		Related: []domain.UUID{
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+		Seen: []struct {
+			Key   domain.UUID
+			Value time.Time
+		}{
+			{
+				Key:   _UUID_FromConstant([]byte("0123456789abcdef")),
+				Value: _Timestamp_FromConstant(4242),
+			},
+		},
+		Tags: []domain.UUID{
+			_UUID_FromConstant([]byte("0123456789abcdef")),
+		},
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Event)
+	}, _Event_goldenBinary, nil)
+}
+
+var _EventRef_goldenBinary = []byte("\v\x00\x01\x00\x00\x00\x100123456789abcdef\x00")
+
+// TestGoldenEventRef checks that the encoding of a representative
+// EventRef has not changed.
+func TestGoldenEventRef(t *testing.T) {
+	give := &EventRef{
+		ID: _UUID_ptr(_UUID_FromConstant([]byte("0123456789abcdef"))),
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(EventRef)
+	}, _EventRef_goldenBinary, nil)
+}
@@ -0,0 +1,3259 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package builders
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Address struct {
+	Street string  `json:"street,required"`
+	City   *string `json:"city,omitempty"`
+}
+
+// ToWire translates a Address struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Address) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Street), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.City != nil {
+		w, err = wire.NewValueString(*(v.City)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Address struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Address struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Address
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Address) FromWire(w wire.Value) error {
+	var err error
+
+	streetIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Street, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				streetIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.City = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Address struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Address struct could not be encoded.
+func (v *Address) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Street); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.City != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.City)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Address struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Address struct could not be generated from the wire
+// representation.
+func (v *Address) Decode(sr stream.Reader) error {
+
+	streetIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Street, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			streetIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.City = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Address
+// struct.
+func (v *Address) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Street: %v", v.Street)
+	i++
+	if v.City != nil {
+		fields[i] = fmt.Sprintf("City: %v", *(v.City))
+		i++
+	}
+
+	return fmt.Sprintf("Address{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Address match the
+// provided Address.
+//
+// This function performs a deep comparison.
+func (v *Address) Equals(rhs *Address) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Street == rhs.Street) {
+		return false
+	}
+	if !_String_EqualsPtr(v.City, rhs.City) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Address.
+func (v *Address) Copy() *Address {
+	if v == nil {
+		return nil
+	}
+
+	var o Address
+	o.Street = v.Street
+	o.City = _String_CopyPtr(v.City)
+	return &o
+}
+
+// Hash returns a hash of this Address which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Address) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Street)
+	if v.City != nil {
+		h.Field(2)
+		h.String(*v.City)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Address so that it may be reused.
+func (v *Address) Reset() {
+	*v = Address{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Address.
+func (v *Address) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("street", v.Street)
+	if v.City != nil {
+		enc.AddString("city", *v.City)
+	}
+	return err
+}
+
+// GetStreet returns the value of Street if it is set or its
+// zero value if it is unset.
+func (v *Address) GetStreet() (o string) {
+	if v != nil {
+		o = v.Street
+	}
+	return
+}
+
+// GetCity returns the value of City if it is set or its
+// zero value if it is unset.
+func (v *Address) GetCity() (o string) {
+	if v != nil && v.City != nil {
+		return *v.City
+	}
+
+	return
+}
+
+// IsSetCity returns true if City is not nil.
+func (v *Address) IsSetCity() bool {
+	return v != nil && v.City != nil
+}
+
+// AddressBuilder builds Address values with chained setters. Use
+// NewAddressBuilder to construct one.
+type AddressBuilder struct {
+	v         Address
+	hasStreet bool
+}
+
+// NewAddressBuilder returns a builder for Address values.
+func NewAddressBuilder() *AddressBuilder {
+	return &AddressBuilder{}
+}
+
+// Street sets the Street field of the Address.
+func (b *AddressBuilder) Street(value string) *AddressBuilder {
+	b.v.Street = value
+	b.hasStreet = true
+	return b
+}
+
+// City sets the City field of the Address.
+func (b *AddressBuilder) City(value string) *AddressBuilder {
+	b.v.City = &value
+	return b
+}
+
+// Build returns the Address built so far.
+//
+// An error is returned if a required field was not set.
+func (b *AddressBuilder) Build() (*Address, error) {
+	if !b.hasStreet {
+		return nil, errors.New("field Street of Address is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type Contact struct {
+	Email   *string  `json:"email,omitempty"`
+	Phone   *int64   `json:"phone,omitempty"`
+	Address *Address `json:"address,omitempty"`
+}
+
+// ToWire translates a Contact struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Contact) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Email != nil {
+		w, err = wire.NewValueString(*(v.Email)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Phone != nil {
+		w, err = wire.NewValueI64(*(v.Phone)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Address != nil {
+		w, err = v.Address.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Contact should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Address_Read(w wire.Value) (*Address, error) {
+	var v Address
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Contact struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Contact struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Contact
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Contact) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Email = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Phone = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Address, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if v.Address != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Contact struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Contact struct could not be encoded.
+func (v *Contact) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Email != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Email)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Phone != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Phone)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Address != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Address.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if v.Address != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Address_Decode(sr stream.Reader) (*Address, error) {
+	var v Address
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Contact struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Contact struct could not be generated from the wire
+// representation.
+func (v *Contact) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Email = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Phone = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Address, err = _Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if v.Address != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Contact
+// struct.
+func (v *Contact) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
+		i++
+	}
+	if v.Phone != nil {
+		fields[i] = fmt.Sprintf("Phone: %v", *(v.Phone))
+		i++
+	}
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", v.Address)
+		i++
+	}
+
+	return fmt.Sprintf("Contact{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Contact match the
+// provided Contact.
+//
+// This function performs a deep comparison.
+func (v *Contact) Equals(rhs *Contact) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Phone, rhs.Phone) {
+		return false
+	}
+	if !((v.Address == nil && rhs.Address == nil) || (v.Address != nil && rhs.Address != nil && v.Address.Equals(rhs.Address))) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Contact.
+func (v *Contact) Copy() *Contact {
+	if v == nil {
+		return nil
+	}
+
+	var o Contact
+	o.Email = _String_CopyPtr(v.Email)
+	o.Phone = _I64_CopyPtr(v.Phone)
+	o.Address = v.Address.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Contact which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Contact) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Email != nil {
+		h.Field(1)
+		h.String(*v.Email)
+	}
+	if v.Phone != nil {
+		h.Field(2)
+		h.Int64(*v.Phone)
+	}
+	h.Field(3)
+	h.Uint64(v.Address.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Contact so that it may be reused.
+func (v *Contact) Reset() {
+	*v = Contact{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Contact.
+func (v *Contact) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
+	}
+	if v.Phone != nil {
+		enc.AddInt64("phone", *v.Phone)
+	}
+	if v.Address != nil {
+		err = multierr.Append(err, enc.AddObject("address", v.Address))
+	}
+	return err
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+func (v *Contact) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
+	}
+
+	return
+}
+
+// IsSetEmail returns true if Email is not nil.
+func (v *Contact) IsSetEmail() bool {
+	return v != nil && v.Email != nil
+}
+
+// LookupEmail returns the value of Email and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Contact) LookupEmail() (o string, _ bool) {
+	if v != nil && v.Email != nil {
+		return *v.Email, true
+	}
+	return o, false
+}
+
+// SetEmail sets the value of Email and unsets all other
+// fields of this Contact.
+func (v *Contact) SetEmail(x string) {
+	*v = Contact{}
+	v.Email = &x
+}
+
+// GetPhone returns the value of Phone if it is set or its
+// zero value if it is unset.
+func (v *Contact) GetPhone() (o int64) {
+	if v != nil && v.Phone != nil {
+		return *v.Phone
+	}
+
+	return
+}
+
+// IsSetPhone returns true if Phone is not nil.
+func (v *Contact) IsSetPhone() bool {
+	return v != nil && v.Phone != nil
+}
+
+// LookupPhone returns the value of Phone and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Contact) LookupPhone() (o int64, _ bool) {
+	if v != nil && v.Phone != nil {
+		return *v.Phone, true
+	}
+	return o, false
+}
+
+// SetPhone sets the value of Phone and unsets all other
+// fields of this Contact.
+func (v *Contact) SetPhone(x int64) {
+	*v = Contact{}
+	v.Phone = &x
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *Contact) GetAddress() (o *Address) {
+	if v != nil && v.Address != nil {
+		return v.Address
+	}
+
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+func (v *Contact) IsSetAddress() bool {
+	return v != nil && v.Address != nil
+}
+
+// LookupAddress returns the value of Address and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Contact) LookupAddress() (o *Address, _ bool) {
+	if v != nil && v.Address != nil {
+		return v.Address, true
+	}
+	return o, false
+}
+
+// SetAddress sets the value of Address and unsets all other
+// fields of this Contact.
+func (v *Contact) SetAddress(x *Address) {
+	*v = Contact{}
+	v.Address = x
+}
+
+// Which returns the ID of the field of this Contact which is set, or
+// 0 if no field is set.
+func (v *Contact) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Email != nil {
+		return 1
+	}
+	if v.Phone != nil {
+		return 2
+	}
+	if v.Address != nil {
+		return 3
+	}
+	return 0
+}
+
+// Contact_Visitor visits the field of a Contact which is set.
+//
+// Fields added to Contact add methods to Contact_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Contact_Visitor interface {
+	// VisitEmail is called with the value of Email if it is set.
+	VisitEmail(string) error
+
+	// VisitPhone is called with the value of Phone if it is set.
+	VisitPhone(int64) error
+
+	// VisitAddress is called with the value of Address if it is set.
+	VisitAddress(*Address) error
+
+	// Default is called if no field of Contact is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Contact
+// which is set, or Default if none is, and returns its error.
+func (v *Contact) Match(visitor Contact_Visitor) error {
+	if v != nil {
+		if v.Email != nil {
+			return visitor.VisitEmail(*v.Email)
+		}
+		if v.Phone != nil {
+			return visitor.VisitPhone(*v.Phone)
+		}
+		if v.Address != nil {
+			return visitor.VisitAddress(v.Address)
+		}
+	}
+	return visitor.Default()
+}
+
+// ContactBuilder builds Contact values with chained setters. Use
+// NewContactBuilder to construct one.
+type ContactBuilder struct {
+	v Contact
+}
+
+// NewContactBuilder returns a builder for Contact values.
+func NewContactBuilder() *ContactBuilder {
+	return &ContactBuilder{}
+}
+
+// Email sets the Email field of the Contact.
+func (b *ContactBuilder) Email(value string) *ContactBuilder {
+	b.v.Email = &value
+	return b
+}
+
+// Phone sets the Phone field of the Contact.
+func (b *ContactBuilder) Phone(value int64) *ContactBuilder {
+	b.v.Phone = &value
+	return b
+}
+
+// Address sets the Address field of the Contact.
+func (b *ContactBuilder) Address(value *Address) *ContactBuilder {
+	b.v.Address = value
+	return b
+}
+
+// Build returns the Contact built so far.
+//
+// An error is returned unless exactly one field was set.
+func (b *ContactBuilder) Build() (*Contact, error) {
+	count := 0
+	if b.v.Email != nil {
+		count++
+	}
+	if b.v.Phone != nil {
+		count++
+	}
+	if b.v.Address != nil {
+		count++
+	}
+	if count != 1 {
+		return nil, fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type Empty struct {
+}
+
+// ToWire translates a Empty struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Empty) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Empty struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Empty struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Empty
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Empty) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Empty struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Empty struct could not be encoded.
+func (v *Empty) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Empty struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Empty struct could not be generated from the wire
+// representation.
+func (v *Empty) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Empty
+// struct.
+func (v *Empty) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Empty{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Empty match the
+// provided Empty.
+//
+// This function performs a deep comparison.
+func (v *Empty) Equals(rhs *Empty) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Empty.
+func (v *Empty) Copy() *Empty {
+	if v == nil {
+		return nil
+	}
+
+	var o Empty
+	return &o
+}
+
+// Hash returns a hash of this Empty which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Empty) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Empty so that it may be reused.
+func (v *Empty) Reset() {
+	*v = Empty{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Empty.
+func (v *Empty) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// EmptyBuilder builds Empty values with chained setters. Use
+// NewEmptyBuilder to construct one.
+type EmptyBuilder struct {
+	v Empty
+}
+
+// NewEmptyBuilder returns a builder for Empty values.
+func NewEmptyBuilder() *EmptyBuilder {
+	return &EmptyBuilder{}
+}
+
+// Build returns the Empty built so far.
+func (b *EmptyBuilder) Build() (*Empty, error) {
+	v := b.v
+	return &v, nil
+}
+
+type Profile struct {
+	ID              string            `json:"id,required"`
+	Address         *Address          `json:"address,required"`
+	Name            *string           `json:"name,omitempty"`
+	Age             *int32            `json:"age,omitempty"`
+	Role            *Role             `json:"role,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Avatar          []byte            `json:"avatar,omitempty"`
+	PreviousAddress *Address          `json:"previousAddress,omitempty"`
+	Active          *bool             `json:"active,omitempty"`
+	Emails          []string          `json:"emails,required"`
+}
+
+func _Role_ptr(v Role) *Role {
+	return &v
+}
+
+// Default_Profile constructs a new Profile struct,
+// pre-populating any fields with defined default values.
+func Default_Profile() *Profile {
+	var v Profile
+	v.Role = _Role_ptr(RoleUser)
+	v.Active = ptr.Bool(true)
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_String_MapItemList map[string]string
+
+func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) Close() {}
+
+// ToWire translates a Profile struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Profile) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Address == nil {
+		return w, errors.New("field Address of Profile is required")
+	}
+	w, err = v.Address.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Age != nil {
+		w, err = wire.NewValueI32(*(v.Age)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	vRole := v.Role
+	if vRole == nil {
+		vRole = _Role_ptr(RoleUser)
+	}
+	{
+		w, err = vRole.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Avatar != nil {
+		w, err = wire.NewValueBinary(v.Avatar), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.PreviousAddress != nil {
+		w, err = v.PreviousAddress.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	vActive := v.Active
+	if vActive == nil {
+		vActive = ptr.Bool(true)
+	}
+	{
+		w, err = wire.NewValueBool(*(vActive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	w, err = wire.NewValueList(_List_String_ValueList(v.Emails)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 11, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Role_Read(w wire.Value) (Role, error) {
+	var v Role
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Profile struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Profile struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Profile
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Profile) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+	addressIsSet := false
+
+	emailsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Address, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				addressIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Age = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				var x Role
+				x, err = _Role_Read(field.Value)
+				v.Role = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.Avatar, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TStruct {
+				v.PreviousAddress, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Active = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TList {
+				v.Emails, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				emailsIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Profile is required")
+	}
+
+	if !addressIsSet {
+		return errors.New("field Address of Profile is required")
+	}
+
+	if v.Role == nil {
+		v.Role = _Role_ptr(RoleUser)
+	}
+
+	if v.Active == nil {
+		v.Active = ptr.Bool(true)
+	}
+
+	if !emailsIsSet {
+		return errors.New("field Emails of Profile is required")
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_String_Encode(val map[string]string, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Profile struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Profile struct could not be encoded.
+func (v *Profile) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Address == nil {
+		return errors.New("field Address of Profile is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Address.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Age != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Age)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vRole := v.Role
+	if vRole == nil {
+		vRole = _Role_ptr(RoleUser)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := vRole.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Labels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Avatar != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Avatar); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PreviousAddress != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.PreviousAddress.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vActive := v.Active
+	if vActive == nil {
+		vActive = ptr.Bool(true)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(vActive)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_String_Encode(v.Emails, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Role_Decode(sr stream.Reader) (Role, error) {
+	var v Role
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]string, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Profile struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Profile struct could not be generated from the wire
+// representation.
+func (v *Profile) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+	addressIsSet := false
+
+	emailsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Address, err = _Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+			addressIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Age = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TI32:
+			var x Role
+			x, err = _Role_Decode(sr)
+			v.Role = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.Avatar, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TStruct:
+			v.PreviousAddress, err = _Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Active = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 11 && fh.Type == wire.TList:
+			v.Emails, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			emailsIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Profile is required")
+	}
+
+	if !addressIsSet {
+		return errors.New("field Address of Profile is required")
+	}
+
+	if v.Role == nil {
+		v.Role = _Role_ptr(RoleUser)
+	}
+
+	if v.Active == nil {
+		v.Active = ptr.Bool(true)
+	}
+
+	if !emailsIsSet {
+		return errors.New("field Emails of Profile is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Profile
+// struct.
+func (v *Profile) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	fields[i] = fmt.Sprintf("Address: %v", v.Address)
+	i++
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+		i++
+	}
+	if v.Role != nil {
+		fields[i] = fmt.Sprintf("Role: %v", *(v.Role))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+	if v.Avatar != nil {
+		fields[i] = fmt.Sprintf("Avatar: %v", v.Avatar)
+		i++
+	}
+	if v.PreviousAddress != nil {
+		fields[i] = fmt.Sprintf("PreviousAddress: %v", v.PreviousAddress)
+		i++
+	}
+	if v.Active != nil {
+		fields[i] = fmt.Sprintf("Active: %v", *(v.Active))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Emails: %v", v.Emails)
+	i++
+
+	return fmt.Sprintf("Profile{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Role_EqualsPtr(lhs, rhs *Role) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Profile match the
+// provided Profile.
+//
+// This function performs a deep comparison.
+func (v *Profile) Equals(rhs *Profile) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !v.Address.Equals(rhs.Address) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Age, rhs.Age) {
+		return false
+	}
+	if !_Role_EqualsPtr(v.Role, rhs.Role) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_String_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+	if !((v.Avatar == nil && rhs.Avatar == nil) || (v.Avatar != nil && rhs.Avatar != nil && bytes.Equal(v.Avatar, rhs.Avatar))) {
+		return false
+	}
+	if !((v.PreviousAddress == nil && rhs.PreviousAddress == nil) || (v.PreviousAddress != nil && rhs.PreviousAddress != nil && v.PreviousAddress.Equals(rhs.PreviousAddress))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Active, rhs.Active) {
+		return false
+	}
+	if !_List_String_Equals(v.Emails, rhs.Emails) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Role_CopyPtr(v *Role) *Role {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Profile.
+func (v *Profile) Copy() *Profile {
+	if v == nil {
+		return nil
+	}
+
+	var o Profile
+	o.ID = v.ID
+	o.Address = v.Address.Copy()
+	o.Name = _String_CopyPtr(v.Name)
+	o.Age = _I32_CopyPtr(v.Age)
+	o.Role = _Role_CopyPtr(v.Role)
+	o.Tags = _List_String_Copy(v.Tags)
+	o.Labels = _Map_String_String_Copy(v.Labels)
+	o.Avatar = _Binary_Copy(v.Avatar)
+	o.PreviousAddress = v.PreviousAddress.Copy()
+	o.Active = _Bool_CopyPtr(v.Active)
+	o.Emails = _List_String_Copy(v.Emails)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Profile which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Profile) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	h.Field(2)
+	h.Uint64(v.Address.Hash())
+	if v.Name != nil {
+		h.Field(3)
+		h.String(*v.Name)
+	}
+	if v.Age != nil {
+		h.Field(4)
+		h.Int32(*v.Age)
+	}
+	if v.Role != nil {
+		h.Field(5)
+		h.Int32(int32(*v.Role))
+	}
+	h.Field(6)
+	h.Uint64(_List_String_Hash(v.Tags))
+	h.Field(7)
+	h.Uint64(_Map_String_String_Hash(v.Labels))
+	h.Field(8)
+	h.Binary(v.Avatar)
+	h.Field(9)
+	h.Uint64(v.PreviousAddress.Hash())
+	if v.Active != nil {
+		h.Field(10)
+		h.Bool(*v.Active)
+	}
+	h.Field(11)
+	h.Uint64(_List_String_Hash(v.Emails))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Profile so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Profile) Reset() {
+	*v = Profile{
+		Emails: v.Emails[:0],
+	}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Profile.
+func (v *Profile) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	err = multierr.Append(err, enc.AddObject("address", v.Address))
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Age != nil {
+		enc.AddInt32("age", *v.Age)
+	}
+	if v.Role != nil {
+		err = multierr.Append(err, enc.AddObject("role", *v.Role))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddObject("labels", (_Map_String_String_Zapper)(v.Labels)))
+	}
+	if v.Avatar != nil {
+		enc.AddString("avatar", base64.StdEncoding.EncodeToString(v.Avatar))
+	}
+	if v.PreviousAddress != nil {
+		err = multierr.Append(err, enc.AddObject("previousAddress", v.PreviousAddress))
+	}
+	if v.Active != nil {
+		enc.AddBool("active", *v.Active)
+	}
+	err = multierr.Append(err, enc.AddArray("emails", (_List_String_Zapper)(v.Emails)))
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetAddress() (o *Address) {
+	if v != nil {
+		o = v.Address
+	}
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+func (v *Profile) IsSetAddress() bool {
+	return v != nil && v.Address != nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Profile) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetAge returns the value of Age if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetAge() (o int32) {
+	if v != nil && v.Age != nil {
+		return *v.Age
+	}
+
+	return
+}
+
+// IsSetAge returns true if Age is not nil.
+func (v *Profile) IsSetAge() bool {
+	return v != nil && v.Age != nil
+}
+
+// GetRole returns the value of Role if it is set or its
+// default value if it is unset.
+func (v *Profile) GetRole() (o Role) {
+	if v != nil && v.Role != nil {
+		return *v.Role
+	}
+	o = RoleUser
+	return
+}
+
+// IsSetRole returns true if Role is not nil.
+func (v *Profile) IsSetRole() bool {
+	return v != nil && v.Role != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Profile) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetLabels() (o map[string]string) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Profile) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetAvatar returns the value of Avatar if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetAvatar() (o []byte) {
+	if v != nil && v.Avatar != nil {
+		return v.Avatar
+	}
+
+	return
+}
+
+// IsSetAvatar returns true if Avatar is not nil.
+func (v *Profile) IsSetAvatar() bool {
+	return v != nil && v.Avatar != nil
+}
+
+// GetPreviousAddress returns the value of PreviousAddress if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetPreviousAddress() (o *Address) {
+	if v != nil && v.PreviousAddress != nil {
+		return v.PreviousAddress
+	}
+
+	return
+}
+
+// IsSetPreviousAddress returns true if PreviousAddress is not nil.
+func (v *Profile) IsSetPreviousAddress() bool {
+	return v != nil && v.PreviousAddress != nil
+}
+
+// GetActive returns the value of Active if it is set or its
+// default value if it is unset.
+func (v *Profile) GetActive() (o bool) {
+	if v != nil && v.Active != nil {
+		return *v.Active
+	}
+	o = true
+	return
+}
+
+// IsSetActive returns true if Active is not nil.
+func (v *Profile) IsSetActive() bool {
+	return v != nil && v.Active != nil
+}
+
+// GetEmails returns the value of Emails if it is set or its
+// zero value if it is unset.
+func (v *Profile) GetEmails() (o []string) {
+	if v != nil {
+		o = v.Emails
+	}
+	return
+}
+
+// IsSetEmails returns true if Emails is not nil.
+func (v *Profile) IsSetEmails() bool {
+	return v != nil && v.Emails != nil
+}
+
+// ProfileBuilder builds Profile values with chained setters. Use
+// NewProfileBuilder to construct one.
+type ProfileBuilder struct {
+	v         Profile
+	hasID     bool
+	hasEmails bool
+}
+
+// NewProfileBuilder returns a builder for Profile values with
+// fields set to the default values defined for them in the Thrift file.
+func NewProfileBuilder() *ProfileBuilder {
+	return &ProfileBuilder{v: *Default_Profile()}
+}
+
+// ID sets the ID field of the Profile.
+func (b *ProfileBuilder) ID(value string) *ProfileBuilder {
+	b.v.ID = value
+	b.hasID = true
+	return b
+}
+
+// Address sets the Address field of the Profile.
+func (b *ProfileBuilder) Address(value *Address) *ProfileBuilder {
+	b.v.Address = value
+	return b
+}
+
+// Name sets the Name field of the Profile.
+func (b *ProfileBuilder) Name(value string) *ProfileBuilder {
+	b.v.Name = &value
+	return b
+}
+
+// Age sets the Age field of the Profile.
+func (b *ProfileBuilder) Age(value int32) *ProfileBuilder {
+	b.v.Age = &value
+	return b
+}
+
+// Role sets the Role field of the Profile.
+func (b *ProfileBuilder) Role(value Role) *ProfileBuilder {
+	b.v.Role = &value
+	return b
+}
+
+// Tags sets the Tags field of the Profile.
+func (b *ProfileBuilder) Tags(value []string) *ProfileBuilder {
+	b.v.Tags = value
+	return b
+}
+
+// Labels sets the Labels field of the Profile.
+func (b *ProfileBuilder) Labels(value map[string]string) *ProfileBuilder {
+	b.v.Labels = value
+	return b
+}
+
+// Avatar sets the Avatar field of the Profile.
+func (b *ProfileBuilder) Avatar(value []byte) *ProfileBuilder {
+	b.v.Avatar = value
+	return b
+}
+
+// PreviousAddress sets the PreviousAddress field of the Profile.
+func (b *ProfileBuilder) PreviousAddress(value *Address) *ProfileBuilder {
+	b.v.PreviousAddress = value
+	return b
+}
+
+// Active sets the Active field of the Profile.
+func (b *ProfileBuilder) Active(value bool) *ProfileBuilder {
+	b.v.Active = &value
+	return b
+}
+
+// Emails sets the Emails field of the Profile.
+func (b *ProfileBuilder) Emails(value []string) *ProfileBuilder {
+	b.v.Emails = value
+	b.hasEmails = true
+	return b
+}
+
+// Build returns the Profile built so far.
+//
+// An error is returned if a required field was not set.
+func (b *ProfileBuilder) Build() (*Profile, error) {
+	if !b.hasID {
+		return nil, errors.New("field ID of Profile is required")
+	}
+	if b.v.Address == nil {
+		return nil, errors.New("field Address of Profile is required")
+	}
+	if !b.hasEmails {
+		return nil, errors.New("field Emails of Profile is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type ProfileNotFound struct {
+	ID      string  `json:"id,required"`
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a ProfileNotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ProfileNotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ProfileNotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ProfileNotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ProfileNotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ProfileNotFound) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of ProfileNotFound is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a ProfileNotFound struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ProfileNotFound struct could not be encoded.
+func (v *ProfileNotFound) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Message)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ProfileNotFound struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ProfileNotFound struct could not be generated from the wire
+// representation.
+func (v *ProfileNotFound) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of ProfileNotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ProfileNotFound
+// struct.
+func (v *ProfileNotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("ProfileNotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*ProfileNotFound) ErrorName() string {
+	return "ProfileNotFound"
+}
+
+// Equals returns true if all the fields of this ProfileNotFound match the
+// provided ProfileNotFound.
+//
+// This function performs a deep comparison.
+func (v *ProfileNotFound) Equals(rhs *ProfileNotFound) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this ProfileNotFound.
+func (v *ProfileNotFound) Copy() *ProfileNotFound {
+	if v == nil {
+		return nil
+	}
+
+	var o ProfileNotFound
+	o.ID = v.ID
+	o.Message = _String_CopyPtr(v.Message)
+	return &o
+}
+
+// Hash returns a hash of this ProfileNotFound which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ProfileNotFound) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Message != nil {
+		h.Field(2)
+		h.String(*v.Message)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ProfileNotFound so that it may be reused.
+func (v *ProfileNotFound) Reset() {
+	*v = ProfileNotFound{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ProfileNotFound.
+func (v *ProfileNotFound) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *ProfileNotFound) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *ProfileNotFound) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *ProfileNotFound) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// ProfileNotFoundBuilder builds ProfileNotFound values with chained setters. Use
+// NewProfileNotFoundBuilder to construct one.
+type ProfileNotFoundBuilder struct {
+	v     ProfileNotFound
+	hasID bool
+}
+
+// NewProfileNotFoundBuilder returns a builder for ProfileNotFound values.
+func NewProfileNotFoundBuilder() *ProfileNotFoundBuilder {
+	return &ProfileNotFoundBuilder{}
+}
+
+// ID sets the ID field of the ProfileNotFound.
+func (b *ProfileNotFoundBuilder) ID(value string) *ProfileNotFoundBuilder {
+	b.v.ID = value
+	b.hasID = true
+	return b
+}
+
+// Message sets the Message field of the ProfileNotFound.
+func (b *ProfileNotFoundBuilder) Message(value string) *ProfileNotFoundBuilder {
+	b.v.Message = &value
+	return b
+}
+
+// Build returns the ProfileNotFound built so far.
+//
+// An error is returned if a required field was not set.
+func (b *ProfileNotFoundBuilder) Build() (*ProfileNotFound, error) {
+	if !b.hasID {
+		return nil, errors.New("field ID of ProfileNotFound is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+func (v *ProfileNotFound) Error() string {
+	return v.String()
+}
+
+type Role int32
+
+const (
+	RoleUser  Role = 0
+	RoleAdmin Role = 1
+)
+
+// Role_Values returns all recognized values of Role.
+func Role_Values() []Role {
+	return []Role{
+		RoleUser,
+		RoleAdmin,
+	}
+}
+
+// Role_Names returns the names of all recognized values of
+// Role, in the same order as Role_Values.
+func Role_Names() []string {
+	return []string{
+		"USER",
+		"ADMIN",
+	}
+}
+
+// IsValid returns true if this Role is one of its recognized
+// values.
+func (v Role) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Role from a byte slice
+// containing its name.
+//
+//   var v Role
+//   err := v.UnmarshalText([]byte("USER"))
+func (v *Role) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "USER":
+		*v = RoleUser
+		return nil
+	case "ADMIN":
+		*v = RoleAdmin
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Role", err)
+		}
+		*v = Role(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Role to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Role) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("USER"), nil
+	case 1:
+		return []byte("ADMIN"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Role.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Role) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "USER")
+	case 1:
+		enc.AddString("name", "ADMIN")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Role) Ptr() *Role {
+	return &v
+}
+
+// Set sets Role from its name or integer value.
+//
+// This implements flag.Value, allowing Role to be used as a
+// command line flag.
+func (v *Role) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Role) Type() string {
+	return "Role"
+}
+
+// Encode encodes Role directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Role
+//   return v.Encode(sWriter)
+func (v Role) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Role into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Role) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Role from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Role(0), err
+//   }
+//
+//   var v Role
+//   if err := v.FromWire(x); err != nil {
+//     return Role(0), err
+//   }
+//   return v, nil
+func (v *Role) FromWire(w wire.Value) error {
+	*v = (Role)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Role directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Role
+//   if err := v.Decode(sReader); err != nil {
+//     return Role(0), err
+//   }
+//   return v, nil
+func (v *Role) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Role)(i)
+	return nil
+}
+
+// String returns a readable string representation of Role.
+func (v Role) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "USER"
+	case 1:
+		return "ADMIN"
+	}
+	return fmt.Sprintf("Role(%d)", w)
+}
+
+// Equals returns true if this Role value matches the provided
+// value.
+func (v Role) Equals(rhs Role) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Role into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Role) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"USER\""), nil
+	case 1:
+		return ([]byte)("\"ADMIN\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Role from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Role) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Role")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Role")
+		}
+		*v = (Role)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Role")
+	}
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "builders",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/builders",
+	FilePath: "builders.thrift",
+	SHA1:     "80431501ea42000e56bee99b782e7b8229f87069",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Role {\n    USER,\n    ADMIN,\n}\n\nstruct Address {\n    1: required string street\n    2: optional string city\n}\n\nstruct Profile {\n    1: required string id\n    2: required Address address\n    3: optional string name\n    4: optional i32 age\n    5: optional Role role = Role.USER\n    6: optional list<string> tags\n    7: optional map<string, string> labels\n    8: optional binary avatar\n    9: optional Address previousAddress\n    10: required bool active = true\n    11: required list<string> emails\n}\n\nunion Contact {\n    1: string email\n    2: i64 phone\n    3: Address address\n}\n\nexception ProfileNotFound {\n    1: required string id\n    2: optional string message\n}\n\nstruct Empty {}\n"
@@ -0,0 +1,2206 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package bytes
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+const DefaultBody string = "hello"
+
+type _List_Binary_ValueList [][]byte
+
+func (v _List_Binary_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[][]byte', index [%v]: value is nil", i)
+		}
+		w, err := wire.NewValueBinary(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Binary_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Binary_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_Binary_ValueList) Close() {}
+
+func _List_Binary_Encode(val [][]byte, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    [][]byte
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[][]byte', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := writer.WriteBinary(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_Binary_Read(l wire.ValueList) ([][]byte, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([][]byte, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_Binary_Decode(sr stream.Reader) ([][]byte, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([][]byte, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Binary_Equals(lhs, rhs [][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _List_Binary_Copy(v [][]byte) [][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(v))
+	for i, x := range v {
+		o[i] = _Binary_Copy(x)
+	}
+	return o
+}
+
+func _List_Binary_Hash(v [][]byte) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Binary(x)
+	}
+	return h.Sum64()
+}
+
+type _List_Binary_Zapper [][]byte
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Binary_Zapper.
+func (l _List_Binary_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
+type Chunks [][]byte
+
+// ToWire translates Chunks into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Chunks) ToWire() (wire.Value, error) {
+	x := ([][]byte)(v)
+	return wire.NewValueList(_List_Binary_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Chunks.
+func (v Chunks) String() string {
+	x := ([][]byte)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Chunks) Encode(sw stream.Writer) error {
+	x := ([][]byte)(v)
+	return _List_Binary_Encode(x, sw)
+}
+
+// FromWire deserializes Chunks from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Chunks) FromWire(w wire.Value) error {
+	x, err := _List_Binary_Read(w.GetList())
+	*v = (Chunks)(x)
+	return err
+}
+
+// Decode deserializes Chunks directly off the wire.
+func (v *Chunks) Decode(sr stream.Reader) error {
+	x, err := _List_Binary_Decode(sr)
+	*v = (Chunks)(x)
+	return err
+}
+
+// Equals returns true if this Chunks is equal to the provided
+// Chunks.
+func (lhs Chunks) Equals(rhs Chunks) bool {
+	return _List_Binary_Equals(([][]byte)(lhs), ([][]byte)(rhs))
+}
+
+// Copy returns a deep copy of this Chunks.
+func (v Chunks) Copy() Chunks {
+	x := ([][]byte)(v)
+	return (Chunks)(_List_Binary_Copy(x))
+}
+
+// Hash returns a hash of this Chunks which is stable across
+// processes.
+func (v Chunks) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_Binary_Hash(([][]byte)(v)))
+	return h.Sum64()
+}
+
+func (v Chunks) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Binary_Zapper)(([][]byte)(v))).MarshalLogArray(enc)
+}
+
+type Message struct {
+	Body       []byte              `json:"body,required"`
+	Subject    []byte              `json:"subject,omitempty"`
+	Checksum   string              `json:"checksum,required"`
+	Signature  *string             `json:"signature,omitempty"`
+	Chunks     [][]byte            `json:"chunks,omitempty"`
+	Counts     map[string]int32    `json:"counts,omitempty"`
+	MoreChunks Chunks              `json:"moreChunks,omitempty"`
+	Tags       map[string]struct{} `json:"tags,omitempty"`
+	Footer     []byte              `json:"footer,omitempty"`
+	Title      *string             `json:"title,omitempty"`
+	Data       []byte              `json:"data,omitempty"`
+}
+
+// Default_Message constructs a new Message struct,
+// pre-populating any fields with defined default values.
+func Default_Message() *Message {
+	var v Message
+	v.Footer = []byte(DefaultBody)
+	return &v
+}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+// ToWire translates a Message struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Message) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Body == nil {
+		return w, errors.New("field Body of Message is required")
+	}
+	w, err = wire.NewValueBinary(v.Body), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Subject != nil {
+		w, err = wire.NewValueBinary(v.Subject), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	w, err = wire.NewValueString(v.Checksum), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.Signature != nil {
+		w, err = wire.NewValueString(*(v.Signature)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Chunks != nil {
+		w, err = wire.NewValueList(_List_Binary_ValueList(v.Chunks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Counts != nil {
+		w, err = wire.NewValueMap(_Map_String_I32_MapItemList(v.Counts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.MoreChunks != nil {
+		w, err = v.MoreChunks.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	vFooter := v.Footer
+	if vFooter == nil {
+		vFooter = []byte(DefaultBody)
+	}
+	{
+		w, err = wire.NewValueBinary(vFooter), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Title != nil {
+		w, err = wire.NewValueString(*(v.Title)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w, err = wire.NewValueBinary(v.Data), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Chunks_Read(w wire.Value) (Chunks, error) {
+	var x Chunks
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Message struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Message struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Message
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Message) FromWire(w wire.Value) error {
+	var err error
+
+	bodyIsSet := false
+
+	checksumIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Body, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+				bodyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Subject, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.Checksum, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				checksumIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Signature = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Chunks, err = _List_Binary_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Counts, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TList {
+				v.MoreChunks, err = _Chunks_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				v.Footer, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Title = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TBinary {
+				v.Data, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !bodyIsSet {
+		return errors.New("field Body of Message is required")
+	}
+
+	if !checksumIsSet {
+		return errors.New("field Checksum of Message is required")
+	}
+
+	if v.Footer == nil {
+		v.Footer = []byte(DefaultBody)
+	}
+
+	return nil
+}
+
+func _Map_String_I32_Encode(val map[string]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a Message struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Message struct could not be encoded.
+func (v *Message) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Body == nil {
+		return errors.New("field Body of Message is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteBinary(v.Body); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Subject != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Subject); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Checksum); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Signature != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Signature)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Chunks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Binary_Encode(v.Chunks, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Counts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_Encode(v.Counts, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MoreChunks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := v.MoreChunks.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vFooter := v.Footer
+	if vFooter == nil {
+		vFooter = []byte(DefaultBody)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(vFooter); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Title != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Title)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Data != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Data); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Map_String_I32_Decode(sr stream.Reader) (map[string]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Chunks_Decode(sr stream.Reader) (Chunks, error) {
+	var x Chunks
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Message struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Message struct could not be generated from the wire
+// representation.
+func (v *Message) Decode(sr stream.Reader) error {
+
+	bodyIsSet := false
+
+	checksumIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Body, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			bodyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Subject, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.Checksum, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			checksumIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Signature = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Chunks, err = _List_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.Counts, err = _Map_String_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TList:
+			v.MoreChunks, err = _Chunks_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBinary:
+			v.Footer, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Title = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 11 && fh.Type == wire.TBinary:
+			v.Data, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !bodyIsSet {
+		return errors.New("field Body of Message is required")
+	}
+
+	if !checksumIsSet {
+		return errors.New("field Checksum of Message is required")
+	}
+
+	if v.Footer == nil {
+		v.Footer = []byte(DefaultBody)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Message
+// struct.
+func (v *Message) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	fields[i] = fmt.Sprintf("Body: %v", v.Body)
+	i++
+	if v.Subject != nil {
+		fields[i] = fmt.Sprintf("Subject: %v", v.Subject)
+		i++
+	}
+	fields[i] = fmt.Sprintf("Checksum: %v", v.Checksum)
+	i++
+	if v.Signature != nil {
+		fields[i] = fmt.Sprintf("Signature: %v", *(v.Signature))
+		i++
+	}
+	if v.Chunks != nil {
+		fields[i] = fmt.Sprintf("Chunks: %v", v.Chunks)
+		i++
+	}
+	if v.Counts != nil {
+		fields[i] = fmt.Sprintf("Counts: %v", v.Counts)
+		i++
+	}
+	if v.MoreChunks != nil {
+		fields[i] = fmt.Sprintf("MoreChunks: %v", v.MoreChunks)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Footer != nil {
+		fields[i] = fmt.Sprintf("Footer: %v", v.Footer)
+		i++
+	}
+	if v.Title != nil {
+		fields[i] = fmt.Sprintf("Title: %v", *(v.Title))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+
+	return fmt.Sprintf("Message{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Message match the
+// provided Message.
+//
+// This function performs a deep comparison.
+func (v *Message) Equals(rhs *Message) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !bytes.Equal(v.Body, rhs.Body) {
+		return false
+	}
+	if !((v.Subject == nil && rhs.Subject == nil) || (v.Subject != nil && rhs.Subject != nil && bytes.Equal(v.Subject, rhs.Subject))) {
+		return false
+	}
+	if !(v.Checksum == rhs.Checksum) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Signature, rhs.Signature) {
+		return false
+	}
+	if !((v.Chunks == nil && rhs.Chunks == nil) || (v.Chunks != nil && rhs.Chunks != nil && _List_Binary_Equals(v.Chunks, rhs.Chunks))) {
+		return false
+	}
+	if !((v.Counts == nil && rhs.Counts == nil) || (v.Counts != nil && rhs.Counts != nil && _Map_String_I32_Equals(v.Counts, rhs.Counts))) {
+		return false
+	}
+	if !((v.MoreChunks == nil && rhs.MoreChunks == nil) || (v.MoreChunks != nil && rhs.MoreChunks != nil && v.MoreChunks.Equals(rhs.MoreChunks))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_mapType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Footer == nil && rhs.Footer == nil) || (v.Footer != nil && rhs.Footer != nil && bytes.Equal(v.Footer, rhs.Footer))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Title, rhs.Title) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && bytes.Equal(v.Data, rhs.Data))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Map_String_I32_Copy(v map[string]int32) map[string]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Message.
+func (v *Message) Copy() *Message {
+	if v == nil {
+		return nil
+	}
+
+	var o Message
+	o.Body = _Binary_Copy(v.Body)
+	o.Subject = _Binary_Copy(v.Subject)
+	o.Checksum = v.Checksum
+	o.Signature = _String_CopyPtr(v.Signature)
+	o.Chunks = _List_Binary_Copy(v.Chunks)
+	o.Counts = _Map_String_I32_Copy(v.Counts)
+	o.MoreChunks = v.MoreChunks.Copy()
+	o.Tags = _Set_String_mapType_Copy(v.Tags)
+	o.Footer = _Binary_Copy(v.Footer)
+	o.Title = _String_CopyPtr(v.Title)
+	o.Data = _Binary_Copy(v.Data)
+	return &o
+}
+
+func _Map_String_I32_Hash(v map[string]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Message which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Message) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Binary(v.Body)
+	h.Field(2)
+	h.Binary(v.Subject)
+	h.Field(3)
+	h.String(v.Checksum)
+	if v.Signature != nil {
+		h.Field(4)
+		h.String(*v.Signature)
+	}
+	h.Field(5)
+	h.Uint64(_List_Binary_Hash(v.Chunks))
+	h.Field(6)
+	h.Uint64(_Map_String_I32_Hash(v.Counts))
+	h.Field(7)
+	h.Uint64(v.MoreChunks.Hash())
+	h.Field(8)
+	h.Uint64(_Set_String_mapType_Hash(v.Tags))
+	h.Field(9)
+	h.Binary(v.Footer)
+	if v.Title != nil {
+		h.Field(10)
+		h.String(*v.Title)
+	}
+	h.Field(11)
+	h.Binary(v.Data)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Message so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Message) Reset() {
+	*v = Message{
+		Body: v.Body[:0],
+	}
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return err
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Message.
+func (v *Message) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("body", base64.StdEncoding.EncodeToString(v.Body))
+	if v.Subject != nil {
+		enc.AddString("subject", base64.StdEncoding.EncodeToString(v.Subject))
+	}
+	enc.AddString("checksum", v.Checksum)
+	if v.Signature != nil {
+		enc.AddString("signature", *v.Signature)
+	}
+	if v.Chunks != nil {
+		err = multierr.Append(err, enc.AddArray("chunks", (_List_Binary_Zapper)(v.Chunks)))
+	}
+	if v.Counts != nil {
+		err = multierr.Append(err, enc.AddObject("counts", (_Map_String_I32_Zapper)(v.Counts)))
+	}
+	if v.MoreChunks != nil {
+		err = multierr.Append(err, enc.AddArray("moreChunks", (_List_Binary_Zapper)(v.MoreChunks)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_String_mapType_Zapper)(v.Tags)))
+	}
+	if v.Footer != nil {
+		enc.AddString("footer", base64.StdEncoding.EncodeToString(v.Footer))
+	}
+	if v.Title != nil {
+		enc.AddString("title", *v.Title)
+	}
+	if v.Data != nil {
+		enc.AddString("data", base64.StdEncoding.EncodeToString(v.Data))
+	}
+	return err
+}
+
+// GetBody returns the value of Body if it is set or its
+// zero value if it is unset.
+func (v *Message) GetBody() (o []byte) {
+	if v != nil {
+		o = v.Body
+	}
+	return
+}
+
+// IsSetBody returns true if Body is not nil.
+func (v *Message) IsSetBody() bool {
+	return v != nil && v.Body != nil
+}
+
+// GetSubject returns the value of Subject if it is set or its
+// zero value if it is unset.
+func (v *Message) GetSubject() (o []byte) {
+	if v != nil && v.Subject != nil {
+		return v.Subject
+	}
+
+	return
+}
+
+// IsSetSubject returns true if Subject is not nil.
+func (v *Message) IsSetSubject() bool {
+	return v != nil && v.Subject != nil
+}
+
+// GetChecksum returns the value of Checksum if it is set or its
+// zero value if it is unset.
+func (v *Message) GetChecksum() (o string) {
+	if v != nil {
+		o = v.Checksum
+	}
+	return
+}
+
+// GetSignature returns the value of Signature if it is set or its
+// zero value if it is unset.
+func (v *Message) GetSignature() (o string) {
+	if v != nil && v.Signature != nil {
+		return *v.Signature
+	}
+
+	return
+}
+
+// IsSetSignature returns true if Signature is not nil.
+func (v *Message) IsSetSignature() bool {
+	return v != nil && v.Signature != nil
+}
+
+// GetChunks returns the value of Chunks if it is set or its
+// zero value if it is unset.
+func (v *Message) GetChunks() (o [][]byte) {
+	if v != nil && v.Chunks != nil {
+		return v.Chunks
+	}
+
+	return
+}
+
+// IsSetChunks returns true if Chunks is not nil.
+func (v *Message) IsSetChunks() bool {
+	return v != nil && v.Chunks != nil
+}
+
+// GetCounts returns the value of Counts if it is set or its
+// zero value if it is unset.
+func (v *Message) GetCounts() (o map[string]int32) {
+	if v != nil && v.Counts != nil {
+		return v.Counts
+	}
+
+	return
+}
+
+// IsSetCounts returns true if Counts is not nil.
+func (v *Message) IsSetCounts() bool {
+	return v != nil && v.Counts != nil
+}
+
+// GetMoreChunks returns the value of MoreChunks if it is set or its
+// zero value if it is unset.
+func (v *Message) GetMoreChunks() (o Chunks) {
+	if v != nil && v.MoreChunks != nil {
+		return v.MoreChunks
+	}
+
+	return
+}
+
+// IsSetMoreChunks returns true if MoreChunks is not nil.
+func (v *Message) IsSetMoreChunks() bool {
+	return v != nil && v.MoreChunks != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Message) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Message) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetFooter returns the value of Footer if it is set or its
+// default value if it is unset.
+func (v *Message) GetFooter() (o []byte) {
+	if v != nil && v.Footer != nil {
+		return v.Footer
+	}
+	o = []byte(DefaultBody)
+	return
+}
+
+// IsSetFooter returns true if Footer is not nil.
+func (v *Message) IsSetFooter() bool {
+	return v != nil && v.Footer != nil
+}
+
+// GetTitle returns the value of Title if it is set or its
+// zero value if it is unset.
+func (v *Message) GetTitle() (o string) {
+	if v != nil && v.Title != nil {
+		return *v.Title
+	}
+
+	return
+}
+
+// IsSetTitle returns true if Title is not nil.
+func (v *Message) IsSetTitle() bool {
+	return v != nil && v.Title != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *Message) GetData() (o []byte) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
+}
+
+// IsSetData returns true if Data is not nil.
+func (v *Message) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "bytes",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/bytes",
+	FilePath: "bytes.thrift",
+	SHA1:     "6594f597d532de5b610637fe278fdb066b85f38c",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef list<string (go.bytes)> Chunks\n\nconst string defaultBody = \"hello\"\n\nstruct Message {\n    1: required string body (go.bytes)\n    2: optional string subject (go.bytes)\n    3: required binary checksum (go.string)\n    4: optional binary signature (go.string)\n    5: optional list<string (go.bytes)> chunks\n    6: optional map<binary (go.string), i32> counts\n    7: optional Chunks moreChunks\n    8: optional set<binary (go.string)> tags\n    9: optional string footer = defaultBody (go.bytes)\n    10: optional string title\n    11: optional binary data\n}\n\nservice Messages {\n    binary (go.string) send(1: required string body (go.bytes))\n}\n"
+
+// Messages_Send_Args represents the arguments for the Messages.send function.
+//
+// The arguments for send are sent and received over the wire as this struct.
+type Messages_Send_Args struct {
+	Body []byte `json:"body,required"`
+}
+
+// ToWire translates a Messages_Send_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Messages_Send_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Body == nil {
+		return w, errors.New("field Body of Messages_Send_Args is required")
+	}
+	w, err = wire.NewValueBinary(v.Body), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Messages_Send_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Messages_Send_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Messages_Send_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Messages_Send_Args) FromWire(w wire.Value) error {
+	var err error
+
+	bodyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Body, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+				bodyIsSet = true
+			}
+		}
+	}
+
+	if !bodyIsSet {
+		return errors.New("field Body of Messages_Send_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Messages_Send_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Messages_Send_Args struct could not be encoded.
+func (v *Messages_Send_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Body == nil {
+		return errors.New("field Body of Messages_Send_Args is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteBinary(v.Body); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Messages_Send_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Messages_Send_Args struct could not be generated from the wire
+// representation.
+func (v *Messages_Send_Args) Decode(sr stream.Reader) error {
+
+	bodyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Body, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			bodyIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !bodyIsSet {
+		return errors.New("field Body of Messages_Send_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Messages_Send_Args
+// struct.
+func (v *Messages_Send_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Body: %v", v.Body)
+	i++
+
+	return fmt.Sprintf("Messages_Send_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Messages_Send_Args match the
+// provided Messages_Send_Args.
+//
+// This function performs a deep comparison.
+func (v *Messages_Send_Args) Equals(rhs *Messages_Send_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !bytes.Equal(v.Body, rhs.Body) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Messages_Send_Args.
+func (v *Messages_Send_Args) Copy() *Messages_Send_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Messages_Send_Args
+	o.Body = _Binary_Copy(v.Body)
+	return &o
+}
+
+// Hash returns a hash of this Messages_Send_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Messages_Send_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Binary(v.Body)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Messages_Send_Args so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Messages_Send_Args) Reset() {
+	*v = Messages_Send_Args{
+		Body: v.Body[:0],
+	}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Messages_Send_Args.
+func (v *Messages_Send_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("body", base64.StdEncoding.EncodeToString(v.Body))
+	return err
+}
+
+// GetBody returns the value of Body if it is set or its
+// zero value if it is unset.
+func (v *Messages_Send_Args) GetBody() (o []byte) {
+	if v != nil {
+		o = v.Body
+	}
+	return
+}
+
+// IsSetBody returns true if Body is not nil.
+func (v *Messages_Send_Args) IsSetBody() bool {
+	return v != nil && v.Body != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "send" for this struct.
+func (v *Messages_Send_Args) MethodName() string {
+	return "send"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Messages_Send_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Messages_Send_Helper provides functions that aid in handling the
+// parameters and return values of the Messages.send
+// function.
+var Messages_Send_Helper = struct {
+	// Args accepts the parameters of send in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		body []byte,
+	) *Messages_Send_Args
+
+	// IsException returns true if the given error can be thrown
+	// by send.
+	//
+	// An error can be thrown by send only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for send
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// send into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by send
+	//
+	//   value, err := send(args)
+	//   result, err := Messages_Send_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from send: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(string, error) (*Messages_Send_Result, error)
+
+	// UnwrapResponse takes the result struct for send
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if send threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Messages_Send_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Messages_Send_Result) (string, error)
+}{}
+
+func init() {
+	Messages_Send_Helper.Args = func(
+		body []byte,
+	) *Messages_Send_Args {
+		return &Messages_Send_Args{
+			Body: body,
+		}
+	}
+
+	Messages_Send_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Messages_Send_Helper.WrapResponse = func(success string, err error) (*Messages_Send_Result, error) {
+		if err == nil {
+			return &Messages_Send_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Messages_Send_Helper.UnwrapResponse = func(result *Messages_Send_Result) (success string, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Messages_Send_Result represents the result of a Messages.send function call.
+//
+// The result of a send execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Messages_Send_Result struct {
+	// Value returned by send after a successful execution.
+	Success *string `json:"success,omitempty"`
+}
+
+// ToWire translates a Messages_Send_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Messages_Send_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueString(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Messages_Send_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Messages_Send_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Messages_Send_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Messages_Send_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Messages_Send_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Messages_Send_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Messages_Send_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Messages_Send_Result struct could not be encoded.
+func (v *Messages_Send_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Messages_Send_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Messages_Send_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Messages_Send_Result struct could not be generated from the wire
+// representation.
+func (v *Messages_Send_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Messages_Send_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Messages_Send_Result
+// struct.
+func (v *Messages_Send_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Messages_Send_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Messages_Send_Result match the
+// provided Messages_Send_Result.
+//
+// This function performs a deep comparison.
+func (v *Messages_Send_Result) Equals(rhs *Messages_Send_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Messages_Send_Result.
+func (v *Messages_Send_Result) Copy() *Messages_Send_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Messages_Send_Result
+	o.Success = _String_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Messages_Send_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Messages_Send_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.String(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Messages_Send_Result so that it may be reused.
+func (v *Messages_Send_Result) Reset() {
+	*v = Messages_Send_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Messages_Send_Result.
+func (v *Messages_Send_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Messages_Send_Result) GetSuccess() (o string) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Messages_Send_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "send" for this struct.
+func (v *Messages_Send_Result) MethodName() string {
+	return "send"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Messages_Send_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
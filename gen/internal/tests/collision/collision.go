@@ -11,6 +11,7 @@ import (
 	multierr "go.uber.org/multierr"
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -321,6 +322,65 @@ func (v *AccessorConflict) Equals(rhs *AccessorConflict) bool {
 	return true
 }
 
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this AccessorConflict.
+func (v *AccessorConflict) Copy() *AccessorConflict {
+	if v == nil {
+		return nil
+	}
+
+	var o AccessorConflict
+	o.Name = _String_CopyPtr(v.Name)
+	o.GetName2 = _String_CopyPtr(v.GetName2)
+	o.IsSetName2 = _Bool_CopyPtr(v.IsSetName2)
+	return &o
+}
+
+// Hash returns a hash of this AccessorConflict which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *AccessorConflict) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	if v.GetName2 != nil {
+		h.Field(2)
+		h.String(*v.GetName2)
+	}
+	if v.IsSetName2 != nil {
+		h.Field(3)
+		h.Bool(*v.IsSetName2)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this AccessorConflict so that it may be reused.
+func (v *AccessorConflict) Reset() {
+	*v = AccessorConflict{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of AccessorConflict.
 func (v *AccessorConflict) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -613,6 +673,42 @@ func (v *AccessorNoConflict) Equals(rhs *AccessorNoConflict) bool {
 	return true
 }
 
+// Copy returns a deep copy of this AccessorNoConflict.
+func (v *AccessorNoConflict) Copy() *AccessorNoConflict {
+	if v == nil {
+		return nil
+	}
+
+	var o AccessorNoConflict
+	o.Getname = _String_CopyPtr(v.Getname)
+	o.GetName = _String_CopyPtr(v.GetName)
+	return &o
+}
+
+// Hash returns a hash of this AccessorNoConflict which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *AccessorNoConflict) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Getname != nil {
+		h.Field(1)
+		h.String(*v.Getname)
+	}
+	if v.GetName != nil {
+		h.Field(2)
+		h.String(*v.GetName)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this AccessorNoConflict so that it may be reused.
+func (v *AccessorNoConflict) Reset() {
+	*v = AccessorNoConflict{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of AccessorNoConflict.
 func (v *AccessorNoConflict) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -707,6 +803,14 @@ func (lhs LittlePotatoe) Equals(rhs LittlePotatoe) bool {
 	return ((int64)(lhs) == (int64)(rhs))
 }
 
+// Hash returns a hash of this LittlePotatoe which is stable across
+// processes.
+func (v LittlePotatoe) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int64((int64)(v))
+	return h.Sum64()
+}
+
 type MyEnum int32
 
 const (
@@ -728,6 +832,28 @@ func MyEnum_Values() []MyEnum {
 	}
 }
 
+// MyEnum_Names returns the names of all recognized values of
+// MyEnum, in the same order as MyEnum_Values.
+func MyEnum_Names() []string {
+	return []string{
+		"X",
+		"Y",
+		"Z",
+		"FooBar",
+		"foo_bar",
+	}
+}
+
+// IsValid returns true if this MyEnum is one of its recognized
+// values.
+func (v MyEnum) IsValid() bool {
+	switch int32(v) {
+	case 123, 456, 789, 790, 791:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode MyEnum from a byte slice
 // containing its name.
 //
@@ -808,6 +934,22 @@ func (v MyEnum) Ptr() *MyEnum {
 	return &v
 }
 
+// Set sets MyEnum from its name or integer value.
+//
+// This implements flag.Value, allowing MyEnum to be used as a
+// command line flag.
+func (v *MyEnum) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v MyEnum) Type() string {
+	return "MyEnum"
+}
+
 // Encode encodes MyEnum directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -1615,6 +1757,110 @@ func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
 	return true
 }
 
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this PrimitiveContainers.
+func (v *PrimitiveContainers) Copy() *PrimitiveContainers {
+	if v == nil {
+		return nil
+	}
+
+	var o PrimitiveContainers
+	o.A = _List_String_Copy(v.A)
+	o.B = _Set_String_mapType_Copy(v.B)
+	o.C = _Map_String_String_Copy(v.C)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this PrimitiveContainers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PrimitiveContainers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_String_Hash(v.A))
+	h.Field(3)
+	h.Uint64(_Set_String_mapType_Hash(v.B))
+	h.Field(5)
+	h.Uint64(_Map_String_String_Hash(v.C))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PrimitiveContainers so that it may be reused.
+func (v *PrimitiveContainers) Reset() {
+	*v = PrimitiveContainers{}
+}
+
 type _List_String_Zapper []string
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -1943,6 +2189,38 @@ func (v *StructCollision) Equals(rhs *StructCollision) bool {
 	return true
 }
 
+// Copy returns a deep copy of this StructCollision.
+func (v *StructCollision) Copy() *StructCollision {
+	if v == nil {
+		return nil
+	}
+
+	var o StructCollision
+	o.CollisionField = v.CollisionField
+	o.CollisionField2 = v.CollisionField2
+	return &o
+}
+
+// Hash returns a hash of this StructCollision which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *StructCollision) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.CollisionField)
+	h.Field(2)
+	h.String(v.CollisionField2)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this StructCollision so that it may be reused.
+func (v *StructCollision) Reset() {
+	*v = StructCollision{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructCollision.
 func (v *StructCollision) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2239,6 +2517,42 @@ func (v *UnionCollision) Equals(rhs *UnionCollision) bool {
 	return true
 }
 
+// Copy returns a deep copy of this UnionCollision.
+func (v *UnionCollision) Copy() *UnionCollision {
+	if v == nil {
+		return nil
+	}
+
+	var o UnionCollision
+	o.CollisionField = _Bool_CopyPtr(v.CollisionField)
+	o.CollisionField2 = _String_CopyPtr(v.CollisionField2)
+	return &o
+}
+
+// Hash returns a hash of this UnionCollision which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *UnionCollision) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.CollisionField != nil {
+		h.Field(1)
+		h.Bool(*v.CollisionField)
+	}
+	if v.CollisionField2 != nil {
+		h.Field(2)
+		h.String(*v.CollisionField2)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this UnionCollision so that it may be reused.
+func (v *UnionCollision) Reset() {
+	*v = UnionCollision{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of UnionCollision.
 func (v *UnionCollision) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2269,6 +2583,22 @@ func (v *UnionCollision) IsSetCollisionField() bool {
 	return v != nil && v.CollisionField != nil
 }
 
+// LookupCollisionField returns the value of CollisionField and true if it is
+// set, or its zero value and false if another field is set.
+func (v *UnionCollision) LookupCollisionField() (o bool, _ bool) {
+	if v != nil && v.CollisionField != nil {
+		return *v.CollisionField, true
+	}
+	return o, false
+}
+
+// SetCollisionField sets the value of CollisionField and unsets all other
+// fields of this UnionCollision.
+func (v *UnionCollision) SetCollisionField(x bool) {
+	*v = UnionCollision{}
+	v.CollisionField = &x
+}
+
 // GetCollisionField2 returns the value of CollisionField2 if it is set or its
 // zero value if it is unset.
 func (v *UnionCollision) GetCollisionField2() (o string) {
@@ -2284,6 +2614,68 @@ func (v *UnionCollision) IsSetCollisionField2() bool {
 	return v != nil && v.CollisionField2 != nil
 }
 
+// LookupCollisionField2 returns the value of CollisionField2 and true if it is
+// set, or its zero value and false if another field is set.
+func (v *UnionCollision) LookupCollisionField2() (o string, _ bool) {
+	if v != nil && v.CollisionField2 != nil {
+		return *v.CollisionField2, true
+	}
+	return o, false
+}
+
+// SetCollisionField2 sets the value of CollisionField2 and unsets all other
+// fields of this UnionCollision.
+func (v *UnionCollision) SetCollisionField2(x string) {
+	*v = UnionCollision{}
+	v.CollisionField2 = &x
+}
+
+// Which returns the ID of the field of this UnionCollision which is set, or
+// 0 if no field is set.
+func (v *UnionCollision) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.CollisionField != nil {
+		return 1
+	}
+	if v.CollisionField2 != nil {
+		return 2
+	}
+	return 0
+}
+
+// UnionCollision_Visitor visits the field of a UnionCollision which is set.
+//
+// Fields added to UnionCollision add methods to UnionCollision_Visitor, so that
+// implementations which do not handle them fail to compile.
+type UnionCollision_Visitor interface {
+	// VisitCollisionField is called with the value of CollisionField if it is set.
+	VisitCollisionField(bool) error
+
+	// VisitCollisionField2 is called with the value of CollisionField2 if it is set.
+	VisitCollisionField2(string) error
+
+	// Default is called if no field of UnionCollision is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this UnionCollision
+// which is set, or Default if none is, and returns its error.
+func (v *UnionCollision) Match(visitor UnionCollision_Visitor) error {
+	if v != nil {
+		if v.CollisionField != nil {
+			return visitor.VisitCollisionField(*v.CollisionField)
+		}
+		if v.CollisionField2 != nil {
+			return visitor.VisitCollisionField2(*v.CollisionField2)
+		}
+	}
+	return visitor.Default()
+}
+
 type WithDefault struct {
 	Pouet *StructCollision2 `json:"pouet,omitempty"`
 }
@@ -2514,6 +2906,35 @@ func (v *WithDefault) Equals(rhs *WithDefault) bool {
 	return true
 }
 
+// Copy returns a deep copy of this WithDefault.
+func (v *WithDefault) Copy() *WithDefault {
+	if v == nil {
+		return nil
+	}
+
+	var o WithDefault
+	o.Pouet = v.Pouet.Copy()
+	return &o
+}
+
+// Hash returns a hash of this WithDefault which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *WithDefault) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Pouet.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this WithDefault so that it may be reused.
+func (v *WithDefault) Reset() {
+	*v = WithDefault{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of WithDefault.
 func (v *WithDefault) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2593,6 +3014,14 @@ func (lhs LittlePotatoe2) Equals(rhs LittlePotatoe2) bool {
 	return ((float64)(lhs) == (float64)(rhs))
 }
 
+// Hash returns a hash of this LittlePotatoe2 which is stable across
+// processes.
+func (v LittlePotatoe2) Hash() uint64 {
+	h := thrifthash.New()
+	h.Double((float64)(v))
+	return h.Sum64()
+}
+
 type MyEnum2 int32
 
 const (
@@ -2610,6 +3039,26 @@ func MyEnum2_Values() []MyEnum2 {
 	}
 }
 
+// MyEnum2_Names returns the names of all recognized values of
+// MyEnum2, in the same order as MyEnum2_Values.
+func MyEnum2_Names() []string {
+	return []string{
+		"X",
+		"Y",
+		"Z",
+	}
+}
+
+// IsValid returns true if this MyEnum2 is one of its recognized
+// values.
+func (v MyEnum2) IsValid() bool {
+	switch int32(v) {
+	case 12, 34, 56:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode MyEnum2 from a byte slice
 // containing its name.
 //
@@ -2676,6 +3125,22 @@ func (v MyEnum2) Ptr() *MyEnum2 {
 	return &v
 }
 
+// Set sets MyEnum2 from its name or integer value.
+//
+// This implements flag.Value, allowing MyEnum2 to be used as a
+// command line flag.
+func (v *MyEnum2) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v MyEnum2) Type() string {
+	return "MyEnum2"
+}
+
 // Encode encodes MyEnum2 directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -3037,6 +3502,38 @@ func (v *StructCollision2) Equals(rhs *StructCollision2) bool {
 	return true
 }
 
+// Copy returns a deep copy of this StructCollision2.
+func (v *StructCollision2) Copy() *StructCollision2 {
+	if v == nil {
+		return nil
+	}
+
+	var o StructCollision2
+	o.CollisionField = v.CollisionField
+	o.CollisionField2 = v.CollisionField2
+	return &o
+}
+
+// Hash returns a hash of this StructCollision2 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *StructCollision2) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.CollisionField)
+	h.Field(2)
+	h.String(v.CollisionField2)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this StructCollision2 so that it may be reused.
+func (v *StructCollision2) Reset() {
+	*v = StructCollision2{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructCollision2.
 func (v *StructCollision2) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3333,6 +3830,42 @@ func (v *UnionCollision2) Equals(rhs *UnionCollision2) bool {
 	return true
 }
 
+// Copy returns a deep copy of this UnionCollision2.
+func (v *UnionCollision2) Copy() *UnionCollision2 {
+	if v == nil {
+		return nil
+	}
+
+	var o UnionCollision2
+	o.CollisionField = _Bool_CopyPtr(v.CollisionField)
+	o.CollisionField2 = _String_CopyPtr(v.CollisionField2)
+	return &o
+}
+
+// Hash returns a hash of this UnionCollision2 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *UnionCollision2) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.CollisionField != nil {
+		h.Field(1)
+		h.Bool(*v.CollisionField)
+	}
+	if v.CollisionField2 != nil {
+		h.Field(2)
+		h.String(*v.CollisionField2)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this UnionCollision2 so that it may be reused.
+func (v *UnionCollision2) Reset() {
+	*v = UnionCollision2{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of UnionCollision2.
 func (v *UnionCollision2) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3363,6 +3896,22 @@ func (v *UnionCollision2) IsSetCollisionField() bool {
 	return v != nil && v.CollisionField != nil
 }
 
+// LookupCollisionField returns the value of CollisionField and true if it is
+// set, or its zero value and false if another field is set.
+func (v *UnionCollision2) LookupCollisionField() (o bool, _ bool) {
+	if v != nil && v.CollisionField != nil {
+		return *v.CollisionField, true
+	}
+	return o, false
+}
+
+// SetCollisionField sets the value of CollisionField and unsets all other
+// fields of this UnionCollision2.
+func (v *UnionCollision2) SetCollisionField(x bool) {
+	*v = UnionCollision2{}
+	v.CollisionField = &x
+}
+
 // GetCollisionField2 returns the value of CollisionField2 if it is set or its
 // zero value if it is unset.
 func (v *UnionCollision2) GetCollisionField2() (o string) {
@@ -3378,6 +3927,68 @@ func (v *UnionCollision2) IsSetCollisionField2() bool {
 	return v != nil && v.CollisionField2 != nil
 }
 
+// LookupCollisionField2 returns the value of CollisionField2 and true if it is
+// set, or its zero value and false if another field is set.
+func (v *UnionCollision2) LookupCollisionField2() (o string, _ bool) {
+	if v != nil && v.CollisionField2 != nil {
+		return *v.CollisionField2, true
+	}
+	return o, false
+}
+
+// SetCollisionField2 sets the value of CollisionField2 and unsets all other
+// fields of this UnionCollision2.
+func (v *UnionCollision2) SetCollisionField2(x string) {
+	*v = UnionCollision2{}
+	v.CollisionField2 = &x
+}
+
+// Which returns the ID of the field of this UnionCollision2 which is set, or
+// 0 if no field is set.
+func (v *UnionCollision2) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.CollisionField != nil {
+		return 1
+	}
+	if v.CollisionField2 != nil {
+		return 2
+	}
+	return 0
+}
+
+// UnionCollision2_Visitor visits the field of a UnionCollision2 which is set.
+//
+// Fields added to UnionCollision2 add methods to UnionCollision2_Visitor, so that
+// implementations which do not handle them fail to compile.
+type UnionCollision2_Visitor interface {
+	// VisitCollisionField is called with the value of CollisionField if it is set.
+	VisitCollisionField(bool) error
+
+	// VisitCollisionField2 is called with the value of CollisionField2 if it is set.
+	VisitCollisionField2(string) error
+
+	// Default is called if no field of UnionCollision2 is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this UnionCollision2
+// which is set, or Default if none is, and returns its error.
+func (v *UnionCollision2) Match(visitor UnionCollision2_Visitor) error {
+	if v != nil {
+		if v.CollisionField != nil {
+			return visitor.VisitCollisionField(*v.CollisionField)
+		}
+		if v.CollisionField2 != nil {
+			return visitor.VisitCollisionField2(*v.CollisionField2)
+		}
+	}
+	return visitor.Default()
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "collision",
@@ -0,0 +1,4616 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package compare
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Counts struct {
+	Count int32 `json:"count,omitempty"`
+	Name  Name  `json:"name,omitempty"`
+
+	presence [1]uint64
+}
+
+// ToWire translates a Counts struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Counts) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.refCount() != nil {
+		w, err = wire.NewValueI32(*(v.refCount())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.refName() != nil {
+		w, err = v.refName().ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Name_Read(w wire.Value) (Name, error) {
+	var x Name
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Counts struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Counts struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Counts
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Counts) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Count, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _Name_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Counts struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Counts struct could not be encoded.
+func (v *Counts) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.refCount() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refCount())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refName() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.refName().Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Name_Decode(sr stream.Reader) (Name, error) {
+	var x Name
+	err := x.Decode(sr)
+	return x, err
+}
+
+// Decode deserializes a Counts struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Counts struct could not be generated from the wire
+// representation.
+func (v *Counts) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.Count, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Name, err = _Name_Decode(sr)
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 1)
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Counts
+// struct.
+func (v *Counts) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.refCount() != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.refCount()))
+		i++
+	}
+	if v.refName() != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.refName()))
+		i++
+	}
+
+	return fmt.Sprintf("Counts{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Name_EqualsPtr(lhs, rhs *Name) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Counts match the
+// provided Counts.
+//
+// This function performs a deep comparison.
+func (v *Counts) Equals(rhs *Counts) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refCount(), rhs.refCount()) {
+		return false
+	}
+	if !_Name_EqualsPtr(v.refName(), rhs.refName()) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Counts.
+func (v *Counts) Copy() *Counts {
+	if v == nil {
+		return nil
+	}
+
+	var o Counts
+	o.Count = v.Count
+	o.Name = v.Name
+	o.presence = v.presence
+	return &o
+}
+
+// Hash returns a hash of this Counts which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Counts) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.refCount() != nil {
+		h.Field(1)
+		h.Int32(*v.refCount())
+	}
+	if v.refName() != nil {
+		h.Field(2)
+		h.String(string(*v.refName()))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Counts so that it may be reused.
+func (v *Counts) Reset() {
+	*v = Counts{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Counts.
+func (v *Counts) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.refCount() != nil {
+		enc.AddInt32("count", *v.refCount())
+	}
+	if v.refName() != nil {
+		enc.AddString("name", (string)(*v.refName()))
+	}
+	return err
+}
+
+// MarshalJSON serializes a Counts into JSON without its
+// unset optional fields.
+//
+// This has a value receiver so that Counts values are marshaled this way in
+// addition to pointers to them.
+func (v Counts) MarshalJSON() ([]byte, error) {
+	type plain Counts // without this method
+
+	r := struct {
+		*plain
+		Count *int32 `json:"count,omitempty"`
+		Name  *Name  `json:"name,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Count = v.refCount()
+	r.Name = v.refName()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Counts from JSON, recording which of
+// its optional fields are set.
+func (v *Counts) UnmarshalJSON(data []byte) error {
+	type plain Counts // without this method
+
+	r := struct {
+		*plain
+		Count *int32 `json:"count,omitempty"`
+		Name  *Name  `json:"name,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.Count != nil {
+		v.SetCount(*r.Count)
+	}
+	if r.Name != nil {
+		v.SetName(*r.Name)
+	}
+	return nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *Counts) GetCount() (o int32) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is set.
+func (v *Counts) IsSetCount() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasCount returns true if Count is set.
+func (v *Counts) HasCount() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearCount unsets Count.
+func (v *Counts) ClearCount() {
+	var x int32
+	v.Count = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refCount returns a pointer to Count if it is set, and
+// nil otherwise.
+func (v *Counts) refCount() *int32 {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.Count
+	}
+	return nil
+}
+
+// SetCount sets the value of Count.
+func (v *Counts) SetCount(x int32) {
+	v.Count = x
+	v.presence[0] |= (1 << 0)
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Counts) GetName() (o Name) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is set.
+func (v *Counts) IsSetName() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// HasName returns true if Name is set.
+func (v *Counts) HasName() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// ClearName unsets Name.
+func (v *Counts) ClearName() {
+	var x Name
+	v.Name = x
+	v.presence[0] &^= (1 << 1)
+}
+
+// refName returns a pointer to Name if it is set, and
+// nil otherwise.
+func (v *Counts) refName() *Name {
+	if v.presence[0]&(1<<1) != 0 {
+		return &v.Name
+	}
+	return nil
+}
+
+// SetName sets the value of Name.
+func (v *Counts) SetName(x Name) {
+	v.Name = x
+	v.presence[0] |= (1 << 1)
+}
+
+func _Bool_Compare(lhs, rhs bool) int {
+	switch {
+	case lhs == rhs:
+		return 0
+	case rhs:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func _I32_Compare(lhs, rhs int32) int {
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func _I32_ComparePtr(lhs, rhs *int32) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return _I32_Compare(*lhs, *rhs)
+}
+
+func _Name_ComparePtr(lhs, rhs *Name) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return (*lhs).Compare(*rhs)
+}
+
+// Compare returns -1, 0, or 1 if this Counts orders before, the
+// same as, or after the provided Counts.
+//
+// Fields are compared in the order in which they are declared.
+// Unset fields order before set ones, and nil before non-nil.
+func (v *Counts) Compare(rhs *Counts) int {
+	if v == nil || rhs == nil {
+		return _Bool_Compare(v != nil, rhs != nil)
+	}
+	if c := _I32_ComparePtr(v.refCount(), rhs.refCount()); c != 0 {
+		return c
+	}
+	if c := _Name_ComparePtr(v.refName(), rhs.refName()); c != 0 {
+		return c
+	}
+
+	return 0
+}
+
+// Less returns true if this Counts orders before the provided
+// Counts.
+func (v *Counts) Less(rhs *Counts) bool {
+	return v.Compare(rhs) < 0
+}
+
+type Key struct {
+	Name    *string  `json:"name,omitempty"`
+	ID      *int64   `json:"id,omitempty"`
+	Version *Version `json:"version,omitempty"`
+}
+
+// ToWire translates a Key struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Key) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.ID != nil {
+		w, err = wire.NewValueI64(*(v.ID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = v.Version.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Key should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Version_Read(w wire.Value) (*Version, error) {
+	var v Version
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Key struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Key struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Key
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Key) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Version, err = _Version_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Name != nil {
+		count++
+	}
+	if v.ID != nil {
+		count++
+	}
+	if v.Version != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Key should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Key struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Key struct could not be encoded.
+func (v *Key) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Version.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Name != nil {
+		count++
+	}
+	if v.ID != nil {
+		count++
+	}
+	if v.Version != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Key should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Version_Decode(sr stream.Reader) (*Version, error) {
+	var v Version
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Key struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Key struct could not be generated from the wire
+// representation.
+func (v *Key) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Version, err = _Version_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Name != nil {
+		count++
+	}
+	if v.ID != nil {
+		count++
+	}
+	if v.Version != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Key should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Key
+// struct.
+func (v *Key) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", v.Version)
+		i++
+	}
+
+	return fmt.Sprintf("Key{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Key match the
+// provided Key.
+//
+// This function performs a deep comparison.
+func (v *Key) Equals(rhs *Key) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !((v.Version == nil && rhs.Version == nil) || (v.Version != nil && rhs.Version != nil && v.Version.Equals(rhs.Version))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Key.
+func (v *Key) Copy() *Key {
+	if v == nil {
+		return nil
+	}
+
+	var o Key
+	o.Name = _String_CopyPtr(v.Name)
+	o.ID = _I64_CopyPtr(v.ID)
+	o.Version = v.Version.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Key which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Key) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	if v.ID != nil {
+		h.Field(2)
+		h.Int64(*v.ID)
+	}
+	h.Field(3)
+	h.Uint64(v.Version.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Key so that it may be reused.
+func (v *Key) Reset() {
+	*v = Key{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Key.
+func (v *Key) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.ID != nil {
+		enc.AddInt64("id", *v.ID)
+	}
+	if v.Version != nil {
+		err = multierr.Append(err, enc.AddObject("version", v.Version))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Key) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Key) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// LookupName returns the value of Name and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Key) LookupName() (o string, _ bool) {
+	if v != nil && v.Name != nil {
+		return *v.Name, true
+	}
+	return o, false
+}
+
+// SetName sets the value of Name and unsets all other
+// fields of this Key.
+func (v *Key) SetName(x string) {
+	*v = Key{}
+	v.Name = &x
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Key) GetID() (o int64) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Key) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// LookupID returns the value of ID and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Key) LookupID() (o int64, _ bool) {
+	if v != nil && v.ID != nil {
+		return *v.ID, true
+	}
+	return o, false
+}
+
+// SetID sets the value of ID and unsets all other
+// fields of this Key.
+func (v *Key) SetID(x int64) {
+	*v = Key{}
+	v.ID = &x
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *Key) GetVersion() (o *Version) {
+	if v != nil && v.Version != nil {
+		return v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *Key) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// LookupVersion returns the value of Version and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Key) LookupVersion() (o *Version, _ bool) {
+	if v != nil && v.Version != nil {
+		return v.Version, true
+	}
+	return o, false
+}
+
+// SetVersion sets the value of Version and unsets all other
+// fields of this Key.
+func (v *Key) SetVersion(x *Version) {
+	*v = Key{}
+	v.Version = x
+}
+
+// Which returns the ID of the field of this Key which is set, or
+// 0 if no field is set.
+func (v *Key) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Name != nil {
+		return 1
+	}
+	if v.ID != nil {
+		return 2
+	}
+	if v.Version != nil {
+		return 3
+	}
+	return 0
+}
+
+// Key_Visitor visits the field of a Key which is set.
+//
+// Fields added to Key add methods to Key_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Key_Visitor interface {
+	// VisitName is called with the value of Name if it is set.
+	VisitName(string) error
+
+	// VisitID is called with the value of ID if it is set.
+	VisitID(int64) error
+
+	// VisitVersion is called with the value of Version if it is set.
+	VisitVersion(*Version) error
+
+	// Default is called if no field of Key is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Key
+// which is set, or Default if none is, and returns its error.
+func (v *Key) Match(visitor Key_Visitor) error {
+	if v != nil {
+		if v.Name != nil {
+			return visitor.VisitName(*v.Name)
+		}
+		if v.ID != nil {
+			return visitor.VisitID(*v.ID)
+		}
+		if v.Version != nil {
+			return visitor.VisitVersion(v.Version)
+		}
+	}
+	return visitor.Default()
+}
+
+func _String_Compare(lhs, rhs string) int {
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func _String_ComparePtr(lhs, rhs *string) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return _String_Compare(*lhs, *rhs)
+}
+
+func _I64_Compare(lhs, rhs int64) int {
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func _I64_ComparePtr(lhs, rhs *int64) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return _I64_Compare(*lhs, *rhs)
+}
+
+func _Version_ComparePtr(lhs, rhs *Version) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return lhs.Compare(rhs)
+}
+
+// Compare returns -1, 0, or 1 if this Key orders before, the
+// same as, or after the provided Key.
+//
+// Fields are compared in the order in which they are declared.
+// Unset fields order before set ones, and nil before non-nil.
+func (v *Key) Compare(rhs *Key) int {
+	if v == nil || rhs == nil {
+		return _Bool_Compare(v != nil, rhs != nil)
+	}
+	if c := _String_ComparePtr(v.Name, rhs.Name); c != 0 {
+		return c
+	}
+	if c := _I64_ComparePtr(v.ID, rhs.ID); c != 0 {
+		return c
+	}
+	if c := _Version_ComparePtr(v.Version, rhs.Version); c != 0 {
+		return c
+	}
+
+	return 0
+}
+
+// Less returns true if this Key orders before the provided
+// Key.
+func (v *Key) Less(rhs *Key) bool {
+	return v.Compare(rhs) < 0
+}
+
+type Name string
+
+// NamePtr returns a pointer to a Name
+func (v Name) Ptr() *Name {
+	return &v
+}
+
+// ToWire translates Name into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Name) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Name.
+func (v Name) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Name) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Name from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Name) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Name)(x)
+	return err
+}
+
+// Decode deserializes Name directly off the wire.
+func (v *Name) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Name)(x)
+	return err
+}
+
+// Equals returns true if this Name is equal to the provided
+// Name.
+func (lhs Name) Equals(rhs Name) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Name which is stable across
+// processes.
+func (v Name) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+// Compare returns -1, 0, or 1 if this Name orders before,
+// the same as, or after the provided Name.
+func (lhs Name) Compare(rhs Name) int {
+	return _String_Compare((string)(lhs), (string)(rhs))
+}
+
+// Less returns true if this Name orders before the provided
+// Name.
+func (lhs Name) Less(rhs Name) bool {
+	return lhs.Compare(rhs) < 0
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+func _List_I32_Encode(val []int32, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []int32
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteInt32(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int32, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+type Path []int32
+
+// ToWire translates Path into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Path) ToWire() (wire.Value, error) {
+	x := ([]int32)(v)
+	return wire.NewValueList(_List_I32_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Path.
+func (v Path) String() string {
+	x := ([]int32)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Path) Encode(sw stream.Writer) error {
+	x := ([]int32)(v)
+	return _List_I32_Encode(x, sw)
+}
+
+// FromWire deserializes Path from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Path) FromWire(w wire.Value) error {
+	x, err := _List_I32_Read(w.GetList())
+	*v = (Path)(x)
+	return err
+}
+
+// Decode deserializes Path directly off the wire.
+func (v *Path) Decode(sr stream.Reader) error {
+	x, err := _List_I32_Decode(sr)
+	*v = (Path)(x)
+	return err
+}
+
+// Equals returns true if this Path is equal to the provided
+// Path.
+func (lhs Path) Equals(rhs Path) bool {
+	return _List_I32_Equals(([]int32)(lhs), ([]int32)(rhs))
+}
+
+// Copy returns a deep copy of this Path.
+func (v Path) Copy() Path {
+	x := ([]int32)(v)
+	return (Path)(_List_I32_Copy(x))
+}
+
+// Hash returns a hash of this Path which is stable across
+// processes.
+func (v Path) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_I32_Hash(([]int32)(v)))
+	return h.Sum64()
+}
+
+func (v Path) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_I32_Zapper)(([]int32)(v))).MarshalLogArray(enc)
+}
+
+func _List_I32_Compare(lhs, rhs []int32) int {
+	for i := 0; i < len(lhs) && i < len(rhs); i++ {
+		if c := _I32_Compare(lhs[i], rhs[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(lhs) < len(rhs):
+		return -1
+	case len(lhs) > len(rhs):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Compare returns -1, 0, or 1 if this Path orders before,
+// the same as, or after the provided Path.
+func (lhs Path) Compare(rhs Path) int {
+	return _List_I32_Compare(([]int32)(lhs), ([]int32)(rhs))
+}
+
+// Less returns true if this Path orders before the provided
+// Path.
+func (lhs Path) Less(rhs Path) bool {
+	return lhs.Compare(rhs) < 0
+}
+
+type Priority int32
+
+const (
+	PriorityLow    Priority = 0
+	PriorityMedium Priority = 1
+	PriorityHigh   Priority = 2
+)
+
+// Priority_Values returns all recognized values of Priority.
+func Priority_Values() []Priority {
+	return []Priority{
+		PriorityLow,
+		PriorityMedium,
+		PriorityHigh,
+	}
+}
+
+// Priority_Names returns the names of all recognized values of
+// Priority, in the same order as Priority_Values.
+func Priority_Names() []string {
+	return []string{
+		"LOW",
+		"MEDIUM",
+		"HIGH",
+	}
+}
+
+// IsValid returns true if this Priority is one of its recognized
+// values.
+func (v Priority) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Priority from a byte slice
+// containing its name.
+//
+//   var v Priority
+//   err := v.UnmarshalText([]byte("LOW"))
+func (v *Priority) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "LOW":
+		*v = PriorityLow
+		return nil
+	case "MEDIUM":
+		*v = PriorityMedium
+		return nil
+	case "HIGH":
+		*v = PriorityHigh
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Priority", err)
+		}
+		*v = Priority(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Priority to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Priority) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("LOW"), nil
+	case 1:
+		return []byte("MEDIUM"), nil
+	case 2:
+		return []byte("HIGH"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Priority.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Priority) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "LOW")
+	case 1:
+		enc.AddString("name", "MEDIUM")
+	case 2:
+		enc.AddString("name", "HIGH")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Priority) Ptr() *Priority {
+	return &v
+}
+
+// Set sets Priority from its name or integer value.
+//
+// This implements flag.Value, allowing Priority to be used as a
+// command line flag.
+func (v *Priority) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Priority) Type() string {
+	return "Priority"
+}
+
+// Encode encodes Priority directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Priority
+//   return v.Encode(sWriter)
+func (v Priority) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Priority into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Priority) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Priority from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Priority(0), err
+//   }
+//
+//   var v Priority
+//   if err := v.FromWire(x); err != nil {
+//     return Priority(0), err
+//   }
+//   return v, nil
+func (v *Priority) FromWire(w wire.Value) error {
+	*v = (Priority)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Priority directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Priority
+//   if err := v.Decode(sReader); err != nil {
+//     return Priority(0), err
+//   }
+//   return v, nil
+func (v *Priority) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Priority)(i)
+	return nil
+}
+
+// String returns a readable string representation of Priority.
+func (v Priority) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "LOW"
+	case 1:
+		return "MEDIUM"
+	case 2:
+		return "HIGH"
+	}
+	return fmt.Sprintf("Priority(%d)", w)
+}
+
+// Equals returns true if this Priority value matches the provided
+// value.
+func (v Priority) Equals(rhs Priority) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Priority into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Priority) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"LOW\""), nil
+	case 1:
+		return ([]byte)("\"MEDIUM\""), nil
+	case 2:
+		return ([]byte)("\"HIGH\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Priority from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Priority) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Priority")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Priority")
+		}
+		*v = (Priority)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Priority")
+	}
+}
+
+type Release Version
+
+// ToWire translates Release into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Release) ToWire() (wire.Value, error) {
+	x := (*Version)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Release.
+func (v *Release) String() string {
+	x := (*Version)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *Release) Encode(sw stream.Writer) error {
+	x := (*Version)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes Release from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Release) FromWire(w wire.Value) error {
+	return (*Version)(v).FromWire(w)
+}
+
+// Decode deserializes Release directly off the wire.
+func (v *Release) Decode(sr stream.Reader) error {
+	return (*Version)(v).Decode(sr)
+}
+
+// Equals returns true if this Release is equal to the provided
+// Release.
+func (lhs *Release) Equals(rhs *Release) bool {
+	return (*Version)(lhs).Equals((*Version)(rhs))
+}
+
+// Copy returns a deep copy of this Release.
+func (v *Release) Copy() *Release {
+	x := (*Version)(v)
+	return (*Release)(x.Copy())
+}
+
+// Hash returns a hash of this Release which is stable across
+// processes.
+func (v *Release) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Version)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *Release) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Version)(v)).MarshalLogObject(enc)
+}
+
+// Compare returns -1, 0, or 1 if this Release orders before,
+// the same as, or after the provided Release.
+func (lhs *Release) Compare(rhs *Release) int {
+	return (*Version)(lhs).Compare((*Version)(rhs))
+}
+
+// Less returns true if this Release orders before the provided
+// Release.
+func (lhs *Release) Less(rhs *Release) bool {
+	return lhs.Compare(rhs) < 0
+}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+func _Map_String_I32_Encode(val map[string]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I32_Decode(sr stream.Reader) (map[string]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I32_Copy(v map[string]int32) map[string]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_I32_Hash(v map[string]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return err
+}
+
+type Scores map[string]int32
+
+// ToWire translates Scores into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Scores) ToWire() (wire.Value, error) {
+	x := (map[string]int32)(v)
+	return wire.NewValueMap(_Map_String_I32_MapItemList(x)), error(nil)
+}
+
+// String returns a readable string representation of Scores.
+func (v Scores) String() string {
+	x := (map[string]int32)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Scores) Encode(sw stream.Writer) error {
+	x := (map[string]int32)(v)
+	return _Map_String_I32_Encode(x, sw)
+}
+
+// FromWire deserializes Scores from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Scores) FromWire(w wire.Value) error {
+	x, err := _Map_String_I32_Read(w.GetMap())
+	*v = (Scores)(x)
+	return err
+}
+
+// Decode deserializes Scores directly off the wire.
+func (v *Scores) Decode(sr stream.Reader) error {
+	x, err := _Map_String_I32_Decode(sr)
+	*v = (Scores)(x)
+	return err
+}
+
+// Equals returns true if this Scores is equal to the provided
+// Scores.
+func (lhs Scores) Equals(rhs Scores) bool {
+	return _Map_String_I32_Equals((map[string]int32)(lhs), (map[string]int32)(rhs))
+}
+
+// Copy returns a deep copy of this Scores.
+func (v Scores) Copy() Scores {
+	x := (map[string]int32)(v)
+	return (Scores)(_Map_String_I32_Copy(x))
+}
+
+// Hash returns a hash of this Scores which is stable across
+// processes.
+func (v Scores) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_String_I32_Hash((map[string]int32)(v)))
+	return h.Sum64()
+}
+
+func (v Scores) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((_Map_String_I32_Zapper)((map[string]int32)(v))).MarshalLogObject(enc)
+}
+
+type Tagged struct {
+	Name string              `json:"name,required"`
+	Tags map[string]struct{} `json:"tags,omitempty"`
+}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+// ToWire translates a Tagged struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Tagged) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Tagged struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Tagged struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Tagged
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Tagged) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Tagged is required")
+	}
+
+	return nil
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a Tagged struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Tagged struct could not be encoded.
+func (v *Tagged) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Tagged struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Tagged struct could not be generated from the wire
+// representation.
+func (v *Tagged) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Tagged is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Tagged
+// struct.
+func (v *Tagged) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+
+	return fmt.Sprintf("Tagged{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Tagged match the
+// provided Tagged.
+//
+// This function performs a deep comparison.
+func (v *Tagged) Equals(rhs *Tagged) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_mapType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Tagged.
+func (v *Tagged) Copy() *Tagged {
+	if v == nil {
+		return nil
+	}
+
+	var o Tagged
+	o.Name = v.Name
+	o.Tags = _Set_String_mapType_Copy(v.Tags)
+	return &o
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Tagged which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Tagged) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(_Set_String_mapType_Hash(v.Tags))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Tagged so that it may be reused.
+func (v *Tagged) Reset() {
+	*v = Tagged{}
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Tagged.
+func (v *Tagged) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_String_mapType_Zapper)(v.Tags)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Tagged) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Tagged) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Tagged) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+type Task struct {
+	Name     Name             `json:"name,required"`
+	Priority Priority         `json:"priority,required"`
+	Done     *bool            `json:"done,omitempty"`
+	Weight   *float64         `json:"weight,omitempty"`
+	Payload  []byte           `json:"payload,omitempty"`
+	ID       *thriftuuid.UUID `json:"id,omitempty"`
+	Since    *Version         `json:"since,omitempty"`
+	Path     Path             `json:"path,omitempty"`
+	Releases []*Release       `json:"releases,omitempty"`
+	Parent   *Task            `json:"parent,omitempty"`
+}
+
+type _List_Release_ValueList []*Release
+
+func (v _List_Release_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Release', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Release_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Release_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Release_ValueList) Close() {}
+
+// ToWire translates a Task struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Task) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.Name.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = v.Priority.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Done != nil {
+		w, err = wire.NewValueBool(*(v.Done)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Weight != nil {
+		w, err = wire.NewValueDouble(*(v.Weight)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Payload != nil {
+		w, err = wire.NewValueBinary(v.Payload), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.ID != nil {
+		w, err = wire.NewValueBinary((*(v.ID)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Since != nil {
+		w, err = v.Since.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Path != nil {
+		w, err = v.Path.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Releases != nil {
+		w, err = wire.NewValueList(_List_Release_ValueList(v.Releases)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Parent != nil {
+		w, err = v.Parent.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Priority_Read(w wire.Value) (Priority, error) {
+	var v Priority
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _UUID_Read(w wire.Value) (thriftuuid.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return thriftuuid.UUID(u), err
+}
+
+func _Path_Read(w wire.Value) (Path, error) {
+	var x Path
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Release_Read(w wire.Value) (*Release, error) {
+	var x Release
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _List_Release_Read(l wire.ValueList) ([]*Release, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Release, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Release_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Task_Read(w wire.Value) (*Task, error) {
+	var v Task
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Task struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Task struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Task
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Task) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	priorityIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _Name_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Priority, err = _Priority_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				priorityIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Done = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Weight = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				v.Payload, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.Since, err = _Version_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TList {
+				v.Path, err = _Path_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TList {
+				v.Releases, err = _List_Release_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Parent, err = _Task_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Task is required")
+	}
+
+	if !priorityIsSet {
+		return errors.New("field Priority of Task is required")
+	}
+
+	return nil
+}
+
+func _List_Release_Encode(val []*Release, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Release
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Release', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Task struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Task struct could not be encoded.
+func (v *Task) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.Name.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := v.Priority.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Done != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Done)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Weight != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.Weight)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Payload != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Payload); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.ID)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Since != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Since.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Path != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := v.Path.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Releases != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Release_Encode(v.Releases, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Parent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Parent.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Priority_Decode(sr stream.Reader) (Priority, error) {
+	var v Priority
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _UUID_Decode(sr stream.Reader) (thriftuuid.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return thriftuuid.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return thriftuuid.UUID(u), err
+}
+
+func _Path_Decode(sr stream.Reader) (Path, error) {
+	var x Path
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Release_Decode(sr stream.Reader) (*Release, error) {
+	var x Release
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _List_Release_Decode(sr stream.Reader) ([]*Release, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Release, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Release_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Task_Decode(sr stream.Reader) (*Task, error) {
+	var v Task
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Task struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Task struct could not be generated from the wire
+// representation.
+func (v *Task) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+	priorityIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = _Name_Decode(sr)
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Priority, err = _Priority_Decode(sr)
+			if err != nil {
+				return err
+			}
+			priorityIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Done = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Weight = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			v.Payload, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.Since, err = _Version_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TList:
+			v.Path, err = _Path_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TList:
+			v.Releases, err = _List_Release_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.Parent, err = _Task_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Task is required")
+	}
+
+	if !priorityIsSet {
+		return errors.New("field Priority of Task is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Task
+// struct.
+func (v *Task) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [10]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Priority: %v", v.Priority)
+	i++
+	if v.Done != nil {
+		fields[i] = fmt.Sprintf("Done: %v", *(v.Done))
+		i++
+	}
+	if v.Weight != nil {
+		fields[i] = fmt.Sprintf("Weight: %v", *(v.Weight))
+		i++
+	}
+	if v.Payload != nil {
+		fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.Since != nil {
+		fields[i] = fmt.Sprintf("Since: %v", v.Since)
+		i++
+	}
+	if v.Path != nil {
+		fields[i] = fmt.Sprintf("Path: %v", v.Path)
+		i++
+	}
+	if v.Releases != nil {
+		fields[i] = fmt.Sprintf("Releases: %v", v.Releases)
+		i++
+	}
+	if v.Parent != nil {
+		fields[i] = fmt.Sprintf("Parent: %v", v.Parent)
+		i++
+	}
+
+	return fmt.Sprintf("Task{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _UUID_EqualsPtr(lhs, rhs *thriftuuid.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Release_Equals(lhs, rhs []*Release) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Task match the
+// provided Task.
+//
+// This function performs a deep comparison.
+func (v *Task) Equals(rhs *Task) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !v.Priority.Equals(rhs.Priority) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Done, rhs.Done) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.Weight, rhs.Weight) {
+		return false
+	}
+	if !((v.Payload == nil && rhs.Payload == nil) || (v.Payload != nil && rhs.Payload != nil && bytes.Equal(v.Payload, rhs.Payload))) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !((v.Since == nil && rhs.Since == nil) || (v.Since != nil && rhs.Since != nil && v.Since.Equals(rhs.Since))) {
+		return false
+	}
+	if !((v.Path == nil && rhs.Path == nil) || (v.Path != nil && rhs.Path != nil && v.Path.Equals(rhs.Path))) {
+		return false
+	}
+	if !((v.Releases == nil && rhs.Releases == nil) || (v.Releases != nil && rhs.Releases != nil && _List_Release_Equals(v.Releases, rhs.Releases))) {
+		return false
+	}
+	if !((v.Parent == nil && rhs.Parent == nil) || (v.Parent != nil && rhs.Parent != nil && v.Parent.Equals(rhs.Parent))) {
+		return false
+	}
+
+	return true
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _UUID_CopyPtr(v *thriftuuid.UUID) *thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_Release_Copy(v []*Release) []*Release {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Release, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Task.
+func (v *Task) Copy() *Task {
+	if v == nil {
+		return nil
+	}
+
+	var o Task
+	o.Name = v.Name
+	o.Priority = v.Priority
+	o.Done = _Bool_CopyPtr(v.Done)
+	o.Weight = _Double_CopyPtr(v.Weight)
+	o.Payload = _Binary_Copy(v.Payload)
+	o.ID = _UUID_CopyPtr(v.ID)
+	o.Since = v.Since.Copy()
+	o.Path = v.Path.Copy()
+	o.Releases = _List_Release_Copy(v.Releases)
+	o.Parent = v.Parent.Copy()
+	return &o
+}
+
+func _List_Release_Hash(v []*Release) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Task which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Task) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Name))
+	h.Field(2)
+	h.Int32(int32(v.Priority))
+	if v.Done != nil {
+		h.Field(3)
+		h.Bool(*v.Done)
+	}
+	if v.Weight != nil {
+		h.Field(4)
+		h.Double(*v.Weight)
+	}
+	h.Field(5)
+	h.Binary(v.Payload)
+	if v.ID != nil {
+		h.Field(6)
+		h.Binary((*v.ID).Bytes())
+	}
+	h.Field(7)
+	h.Uint64(v.Since.Hash())
+	h.Field(8)
+	h.Uint64(v.Path.Hash())
+	h.Field(9)
+	h.Uint64(_List_Release_Hash(v.Releases))
+	h.Field(10)
+	h.Uint64(v.Parent.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Task so that it may be reused.
+func (v *Task) Reset() {
+	*v = Task{}
+}
+
+type _List_Release_Zapper []*Release
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Release_Zapper.
+func (l _List_Release_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Task.
+func (v *Task) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", (string)(v.Name))
+	err = multierr.Append(err, enc.AddObject("priority", v.Priority))
+	if v.Done != nil {
+		enc.AddBool("done", *v.Done)
+	}
+	if v.Weight != nil {
+		enc.AddFloat64("weight", *v.Weight)
+	}
+	if v.Payload != nil {
+		enc.AddString("payload", base64.StdEncoding.EncodeToString(v.Payload))
+	}
+	if v.ID != nil {
+		enc.AddString("id", (*v.ID).String())
+	}
+	if v.Since != nil {
+		err = multierr.Append(err, enc.AddObject("since", v.Since))
+	}
+	if v.Path != nil {
+		err = multierr.Append(err, enc.AddArray("path", (_List_I32_Zapper)(v.Path)))
+	}
+	if v.Releases != nil {
+		err = multierr.Append(err, enc.AddArray("releases", (_List_Release_Zapper)(v.Releases)))
+	}
+	if v.Parent != nil {
+		err = multierr.Append(err, enc.AddObject("parent", v.Parent))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Task) GetName() (o Name) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *Task) GetPriority() (o Priority) {
+	if v != nil {
+		o = v.Priority
+	}
+	return
+}
+
+// GetDone returns the value of Done if it is set or its
+// zero value if it is unset.
+func (v *Task) GetDone() (o bool) {
+	if v != nil && v.Done != nil {
+		return *v.Done
+	}
+
+	return
+}
+
+// IsSetDone returns true if Done is not nil.
+func (v *Task) IsSetDone() bool {
+	return v != nil && v.Done != nil
+}
+
+// GetWeight returns the value of Weight if it is set or its
+// zero value if it is unset.
+func (v *Task) GetWeight() (o float64) {
+	if v != nil && v.Weight != nil {
+		return *v.Weight
+	}
+
+	return
+}
+
+// IsSetWeight returns true if Weight is not nil.
+func (v *Task) IsSetWeight() bool {
+	return v != nil && v.Weight != nil
+}
+
+// GetPayload returns the value of Payload if it is set or its
+// zero value if it is unset.
+func (v *Task) GetPayload() (o []byte) {
+	if v != nil && v.Payload != nil {
+		return v.Payload
+	}
+
+	return
+}
+
+// IsSetPayload returns true if Payload is not nil.
+func (v *Task) IsSetPayload() bool {
+	return v != nil && v.Payload != nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Task) GetID() (o thriftuuid.UUID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Task) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// GetSince returns the value of Since if it is set or its
+// zero value if it is unset.
+func (v *Task) GetSince() (o *Version) {
+	if v != nil && v.Since != nil {
+		return v.Since
+	}
+
+	return
+}
+
+// IsSetSince returns true if Since is not nil.
+func (v *Task) IsSetSince() bool {
+	return v != nil && v.Since != nil
+}
+
+// GetPath returns the value of Path if it is set or its
+// zero value if it is unset.
+func (v *Task) GetPath() (o Path) {
+	if v != nil && v.Path != nil {
+		return v.Path
+	}
+
+	return
+}
+
+// IsSetPath returns true if Path is not nil.
+func (v *Task) IsSetPath() bool {
+	return v != nil && v.Path != nil
+}
+
+// GetReleases returns the value of Releases if it is set or its
+// zero value if it is unset.
+func (v *Task) GetReleases() (o []*Release) {
+	if v != nil && v.Releases != nil {
+		return v.Releases
+	}
+
+	return
+}
+
+// IsSetReleases returns true if Releases is not nil.
+func (v *Task) IsSetReleases() bool {
+	return v != nil && v.Releases != nil
+}
+
+// GetParent returns the value of Parent if it is set or its
+// zero value if it is unset.
+func (v *Task) GetParent() (o *Task) {
+	if v != nil && v.Parent != nil {
+		return v.Parent
+	}
+
+	return
+}
+
+// IsSetParent returns true if Parent is not nil.
+func (v *Task) IsSetParent() bool {
+	return v != nil && v.Parent != nil
+}
+
+func _Priority_Compare(lhs, rhs Priority) int {
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func _Bool_ComparePtr(lhs, rhs *bool) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return _Bool_Compare(*lhs, *rhs)
+}
+
+func _Double_Compare(lhs, rhs float64) int {
+
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	case lhs == rhs:
+		return 0
+	}
+
+	return _Bool_Compare(!math.IsNaN(lhs), !math.IsNaN(rhs))
+}
+
+func _Double_ComparePtr(lhs, rhs *float64) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return _Double_Compare(*lhs, *rhs)
+}
+
+func _Binary_ComparePtr(lhs, rhs []byte) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return bytes.Compare(lhs, rhs)
+}
+
+func _UUID_ComparePtr(lhs, rhs *thriftuuid.UUID) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return bytes.Compare((*lhs).Bytes(), (*rhs).Bytes())
+}
+
+func _Path_ComparePtr(lhs, rhs Path) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return lhs.Compare(rhs)
+}
+
+func _List_Release_Compare(lhs, rhs []*Release) int {
+	for i := 0; i < len(lhs) && i < len(rhs); i++ {
+		if c := lhs[i].Compare(rhs[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(lhs) < len(rhs):
+		return -1
+	case len(lhs) > len(rhs):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func _List_Release_ComparePtr(lhs, rhs []*Release) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return _List_Release_Compare(lhs, rhs)
+}
+
+func _Task_ComparePtr(lhs, rhs *Task) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return lhs.Compare(rhs)
+}
+
+// Compare returns -1, 0, or 1 if this Task orders before, the
+// same as, or after the provided Task.
+//
+// Fields are compared in the order in which they are declared.
+// Unset fields order before set ones, and nil before non-nil.
+func (v *Task) Compare(rhs *Task) int {
+	if v == nil || rhs == nil {
+		return _Bool_Compare(v != nil, rhs != nil)
+	}
+	if c := v.Name.Compare(rhs.Name); c != 0 {
+		return c
+	}
+	if c := _Priority_Compare(v.Priority, rhs.Priority); c != 0 {
+		return c
+	}
+	if c := _Bool_ComparePtr(v.Done, rhs.Done); c != 0 {
+		return c
+	}
+	if c := _Double_ComparePtr(v.Weight, rhs.Weight); c != 0 {
+		return c
+	}
+	if c := _Binary_ComparePtr(v.Payload, rhs.Payload); c != 0 {
+		return c
+	}
+	if c := _UUID_ComparePtr(v.ID, rhs.ID); c != 0 {
+		return c
+	}
+	if c := _Version_ComparePtr(v.Since, rhs.Since); c != 0 {
+		return c
+	}
+	if c := _Path_ComparePtr(v.Path, rhs.Path); c != 0 {
+		return c
+	}
+	if c := _List_Release_ComparePtr(v.Releases, rhs.Releases); c != 0 {
+		return c
+	}
+	if c := _Task_ComparePtr(v.Parent, rhs.Parent); c != 0 {
+		return c
+	}
+
+	return 0
+}
+
+// Less returns true if this Task orders before the provided
+// Task.
+func (v *Task) Less(rhs *Task) bool {
+	return v.Compare(rhs) < 0
+}
+
+type TaskError struct {
+	Message string `json:"message,required"`
+	Key     *Key   `json:"key,omitempty"`
+}
+
+// ToWire translates a TaskError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TaskError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Key != nil {
+		w, err = v.Key.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Key_Read(w wire.Value) (*Key, error) {
+	var v Key
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a TaskError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TaskError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TaskError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Key, err = _Key_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of TaskError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a TaskError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskError struct could not be encoded.
+func (v *TaskError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Key_Decode(sr stream.Reader) (*Key, error) {
+	var v Key
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a TaskError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskError struct could not be generated from the wire
+// representation.
+func (v *TaskError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Key, err = _Key_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of TaskError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskError
+// struct.
+func (v *TaskError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", v.Key)
+		i++
+	}
+
+	return fmt.Sprintf("TaskError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*TaskError) ErrorName() string {
+	return "TaskError"
+}
+
+// Equals returns true if all the fields of this TaskError match the
+// provided TaskError.
+//
+// This function performs a deep comparison.
+func (v *TaskError) Equals(rhs *TaskError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !((v.Key == nil && rhs.Key == nil) || (v.Key != nil && rhs.Key != nil && v.Key.Equals(rhs.Key))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this TaskError.
+func (v *TaskError) Copy() *TaskError {
+	if v == nil {
+		return nil
+	}
+
+	var o TaskError
+	o.Message = v.Message
+	o.Key = v.Key.Copy()
+	return &o
+}
+
+// Hash returns a hash of this TaskError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *TaskError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	h.Field(2)
+	h.Uint64(v.Key.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this TaskError so that it may be reused.
+func (v *TaskError) Reset() {
+	*v = TaskError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskError.
+func (v *TaskError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	if v.Key != nil {
+		err = multierr.Append(err, enc.AddObject("key", v.Key))
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *TaskError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *TaskError) GetKey() (o *Key) {
+	if v != nil && v.Key != nil {
+		return v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *TaskError) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+func _Key_ComparePtr(lhs, rhs *Key) int {
+	if lhs == nil || rhs == nil {
+		return _Bool_Compare(lhs != nil, rhs != nil)
+	}
+	return lhs.Compare(rhs)
+}
+
+// Compare returns -1, 0, or 1 if this TaskError orders before, the
+// same as, or after the provided TaskError.
+//
+// Fields are compared in the order in which they are declared.
+// Unset fields order before set ones, and nil before non-nil.
+func (v *TaskError) Compare(rhs *TaskError) int {
+	if v == nil || rhs == nil {
+		return _Bool_Compare(v != nil, rhs != nil)
+	}
+	if c := _String_Compare(v.Message, rhs.Message); c != 0 {
+		return c
+	}
+	if c := _Key_ComparePtr(v.Key, rhs.Key); c != 0 {
+		return c
+	}
+
+	return 0
+}
+
+// Less returns true if this TaskError orders before the provided
+// TaskError.
+func (v *TaskError) Less(rhs *TaskError) bool {
+	return v.Compare(rhs) < 0
+}
+
+func (v *TaskError) Error() string {
+	return v.String()
+}
+
+type Version struct {
+	Major int32   `json:"major,required"`
+	Minor int32   `json:"minor,required"`
+	Patch *int32  `json:"patch,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+// ToWire translates a Version struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Version) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI32(v.Major), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Minor), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Patch != nil {
+		w, err = wire.NewValueI32(*(v.Patch)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Label != nil {
+		w, err = wire.NewValueString(*(v.Label)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Version struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Version struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Version
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Version) FromWire(w wire.Value) error {
+	var err error
+
+	majorIsSet := false
+	minorIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Major, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				majorIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Minor, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				minorIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Patch = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Label = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !majorIsSet {
+		return errors.New("field Major of Version is required")
+	}
+
+	if !minorIsSet {
+		return errors.New("field Minor of Version is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Version struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Version struct could not be encoded.
+func (v *Version) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Major); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Minor); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Patch != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Patch)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Label != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Label)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Version struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Version struct could not be generated from the wire
+// representation.
+func (v *Version) Decode(sr stream.Reader) error {
+
+	majorIsSet := false
+	minorIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.Major, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			majorIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Minor, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			minorIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Patch = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Label = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !majorIsSet {
+		return errors.New("field Major of Version is required")
+	}
+
+	if !minorIsSet {
+		return errors.New("field Minor of Version is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Version
+// struct.
+func (v *Version) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Major: %v", v.Major)
+	i++
+	fields[i] = fmt.Sprintf("Minor: %v", v.Minor)
+	i++
+	if v.Patch != nil {
+		fields[i] = fmt.Sprintf("Patch: %v", *(v.Patch))
+		i++
+	}
+	if v.Label != nil {
+		fields[i] = fmt.Sprintf("Label: %v", *(v.Label))
+		i++
+	}
+
+	return fmt.Sprintf("Version{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Version match the
+// provided Version.
+//
+// This function performs a deep comparison.
+func (v *Version) Equals(rhs *Version) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Major == rhs.Major) {
+		return false
+	}
+	if !(v.Minor == rhs.Minor) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Patch, rhs.Patch) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Label, rhs.Label) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Version.
+func (v *Version) Copy() *Version {
+	if v == nil {
+		return nil
+	}
+
+	var o Version
+	o.Major = v.Major
+	o.Minor = v.Minor
+	o.Patch = _I32_CopyPtr(v.Patch)
+	o.Label = _String_CopyPtr(v.Label)
+	return &o
+}
+
+// Hash returns a hash of this Version which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Version) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(v.Major)
+	h.Field(2)
+	h.Int32(v.Minor)
+	if v.Patch != nil {
+		h.Field(3)
+		h.Int32(*v.Patch)
+	}
+	if v.Label != nil {
+		h.Field(4)
+		h.String(*v.Label)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Version so that it may be reused.
+func (v *Version) Reset() {
+	*v = Version{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Version.
+func (v *Version) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt32("major", v.Major)
+	enc.AddInt32("minor", v.Minor)
+	if v.Patch != nil {
+		enc.AddInt32("patch", *v.Patch)
+	}
+	if v.Label != nil {
+		enc.AddString("label", *v.Label)
+	}
+	return err
+}
+
+// GetMajor returns the value of Major if it is set or its
+// zero value if it is unset.
+func (v *Version) GetMajor() (o int32) {
+	if v != nil {
+		o = v.Major
+	}
+	return
+}
+
+// GetMinor returns the value of Minor if it is set or its
+// zero value if it is unset.
+func (v *Version) GetMinor() (o int32) {
+	if v != nil {
+		o = v.Minor
+	}
+	return
+}
+
+// GetPatch returns the value of Patch if it is set or its
+// zero value if it is unset.
+func (v *Version) GetPatch() (o int32) {
+	if v != nil && v.Patch != nil {
+		return *v.Patch
+	}
+
+	return
+}
+
+// IsSetPatch returns true if Patch is not nil.
+func (v *Version) IsSetPatch() bool {
+	return v != nil && v.Patch != nil
+}
+
+// GetLabel returns the value of Label if it is set or its
+// zero value if it is unset.
+func (v *Version) GetLabel() (o string) {
+	if v != nil && v.Label != nil {
+		return *v.Label
+	}
+
+	return
+}
+
+// IsSetLabel returns true if Label is not nil.
+func (v *Version) IsSetLabel() bool {
+	return v != nil && v.Label != nil
+}
+
+// Compare returns -1, 0, or 1 if this Version orders before, the
+// same as, or after the provided Version.
+//
+// Fields are compared in the order in which they are declared.
+// Unset fields order before set ones, and nil before non-nil.
+func (v *Version) Compare(rhs *Version) int {
+	if v == nil || rhs == nil {
+		return _Bool_Compare(v != nil, rhs != nil)
+	}
+	if c := _I32_Compare(v.Major, rhs.Major); c != 0 {
+		return c
+	}
+	if c := _I32_Compare(v.Minor, rhs.Minor); c != 0 {
+		return c
+	}
+	if c := _I32_ComparePtr(v.Patch, rhs.Patch); c != 0 {
+		return c
+	}
+	if c := _String_ComparePtr(v.Label, rhs.Label); c != 0 {
+		return c
+	}
+
+	return 0
+}
+
+// Less returns true if this Version orders before the provided
+// Version.
+func (v *Version) Less(rhs *Version) bool {
+	return v.Compare(rhs) < 0
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "compare",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/compare",
+	FilePath: "compare.thrift",
+	SHA1:     "26f5ad837f5add08e31193db465ceb0138e92d13",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Priority {\n    LOW,\n    MEDIUM,\n    HIGH,\n}\n\ntypedef string Name\ntypedef list<i32> Path\ntypedef Version Release\n\nstruct Version {\n    1: required i32 major\n    2: required i32 minor\n    3: optional i32 patch\n    4: optional string label\n}\n\nstruct Task {\n    1: required Name name\n    2: required Priority priority\n    3: optional bool done\n    4: optional double weight\n    5: optional binary payload\n    6: optional uuid id\n    7: optional Version since\n    8: optional Path path\n    9: optional list<Release> releases\n    10: optional Task parent\n}\n\nunion Key {\n    1: string name\n    2: i64 id\n    3: Version version\n}\n\nexception TaskError {\n    1: required string message\n    2: optional Key key\n}\n\nstruct Counts {\n    1: optional i32 count\n    2: optional Name name\n} (go.presence_bits = \"true\")\n\nstruct Tagged {\n    1: required string name\n    2: optional set<string> tags\n}\n\ntypedef map<string, i32> Scores\n"
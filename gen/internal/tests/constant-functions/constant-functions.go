@@ -0,0 +1,1654 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package constant_functions
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+const Greeting string = "hello"
+
+// Constants of immutable types are still generated as constants.
+const MaxPoints int32 = 100
+
+// Origin returns a new copy of this constant on every call,
+// so callers may modify it.
+func Origin() *Point {
+	return &Point{
+		X: 0,
+		Y: 0,
+	}
+}
+
+// Ports returns a new copy of this constant on every call,
+// so callers may modify it.
+func Ports() map[string]int32 {
+	return map[string]int32{
+		"http":  80,
+		"https": 443,
+	}
+}
+
+// Names of the primary colors.
+//
+// PrimaryColors returns a new copy of this constant on every call,
+// so callers may modify it.
+func PrimaryColors() []string {
+	return []string{
+		"red",
+		"green",
+		"blue",
+	}
+}
+
+// Primes returns a new copy of this constant on every call,
+// so callers may modify it.
+func Primes() map[int32]struct{} {
+	return map[int32]struct{}{
+		2: struct{}{},
+		3: struct{}{},
+		5: struct{}{},
+		7: struct{}{},
+	}
+}
+
+// UnitSquare returns a new copy of this constant on every call,
+// so callers may modify it.
+func UnitSquare() *Shape {
+	return &Shape{
+		Polygon: []*Point{
+			&Point{
+				X: 0,
+				Y: 0,
+			},
+			&Point{
+				X: 1,
+				Y: 0,
+			},
+			&Point{
+				X: 1,
+				Y: 1,
+			},
+			&Point{
+				X: 0,
+				Y: 1,
+			},
+		},
+	}
+}
+
+// Users returns a new copy of this constant on every call,
+// so callers may modify it.
+func Users() Names {
+	return Names{
+		"alice",
+		"bob",
+	}
+}
+
+type Canvas struct {
+	Colors []string `json:"colors,omitempty"`
+	Center *Point   `json:"center,omitempty"`
+}
+
+// Default_Canvas constructs a new Canvas struct,
+// pre-populating any fields with defined default values.
+func Default_Canvas() *Canvas {
+	var v Canvas
+	v.Colors = []string{
+		"red",
+		"green",
+		"blue",
+	}
+	v.Center = &Point{
+		X: 0,
+		Y: 0,
+	}
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Canvas struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Canvas) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	vColors := v.Colors
+	if vColors == nil {
+		vColors = []string{
+			"red",
+			"green",
+			"blue",
+		}
+	}
+	{
+		w, err = wire.NewValueList(_List_String_ValueList(vColors)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	vCenter := v.Center
+	if vCenter == nil {
+		vCenter = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+	{
+		w, err = vCenter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Canvas struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Canvas struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Canvas
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Canvas) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Colors, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Center, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Colors == nil {
+		v.Colors = []string{
+			"red",
+			"green",
+			"blue",
+		}
+	}
+
+	if v.Center == nil {
+		v.Center = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Canvas struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Canvas struct could not be encoded.
+func (v *Canvas) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	vColors := v.Colors
+	if vColors == nil {
+		vColors = []string{
+			"red",
+			"green",
+			"blue",
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(vColors, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vCenter := v.Center
+	if vCenter == nil {
+		vCenter = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := vCenter.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Canvas struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Canvas struct could not be generated from the wire
+// representation.
+func (v *Canvas) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Colors, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Center, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Colors == nil {
+		v.Colors = []string{
+			"red",
+			"green",
+			"blue",
+		}
+	}
+
+	if v.Center == nil {
+		v.Center = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Canvas
+// struct.
+func (v *Canvas) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Colors != nil {
+		fields[i] = fmt.Sprintf("Colors: %v", v.Colors)
+		i++
+	}
+	if v.Center != nil {
+		fields[i] = fmt.Sprintf("Center: %v", v.Center)
+		i++
+	}
+
+	return fmt.Sprintf("Canvas{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Canvas match the
+// provided Canvas.
+//
+// This function performs a deep comparison.
+func (v *Canvas) Equals(rhs *Canvas) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Colors == nil && rhs.Colors == nil) || (v.Colors != nil && rhs.Colors != nil && _List_String_Equals(v.Colors, rhs.Colors))) {
+		return false
+	}
+	if !((v.Center == nil && rhs.Center == nil) || (v.Center != nil && rhs.Center != nil && v.Center.Equals(rhs.Center))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Canvas.
+func (v *Canvas) Copy() *Canvas {
+	if v == nil {
+		return nil
+	}
+
+	var o Canvas
+	o.Colors = _List_String_Copy(v.Colors)
+	o.Center = v.Center.Copy()
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Canvas which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Canvas) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_String_Hash(v.Colors))
+	h.Field(2)
+	h.Uint64(v.Center.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Canvas so that it may be reused.
+func (v *Canvas) Reset() {
+	*v = Canvas{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Canvas.
+func (v *Canvas) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Colors != nil {
+		err = multierr.Append(err, enc.AddArray("colors", (_List_String_Zapper)(v.Colors)))
+	}
+	if v.Center != nil {
+		err = multierr.Append(err, enc.AddObject("center", v.Center))
+	}
+	return err
+}
+
+// GetColors returns the value of Colors if it is set or its
+// default value if it is unset.
+func (v *Canvas) GetColors() (o []string) {
+	if v != nil && v.Colors != nil {
+		return v.Colors
+	}
+	o = []string{
+		"red",
+		"green",
+		"blue",
+	}
+	return
+}
+
+// IsSetColors returns true if Colors is not nil.
+func (v *Canvas) IsSetColors() bool {
+	return v != nil && v.Colors != nil
+}
+
+// GetCenter returns the value of Center if it is set or its
+// default value if it is unset.
+func (v *Canvas) GetCenter() (o *Point) {
+	if v != nil && v.Center != nil {
+		return v.Center
+	}
+	o = &Point{
+		X: 0,
+		Y: 0,
+	}
+	return
+}
+
+// IsSetCenter returns true if Center is not nil.
+func (v *Canvas) IsSetCenter() bool {
+	return v != nil && v.Center != nil
+}
+
+type Names []string
+
+// ToWire translates Names into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Names) ToWire() (wire.Value, error) {
+	x := ([]string)(v)
+	return wire.NewValueList(_List_String_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Names.
+func (v Names) String() string {
+	x := ([]string)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Names) Encode(sw stream.Writer) error {
+	x := ([]string)(v)
+	return _List_String_Encode(x, sw)
+}
+
+// FromWire deserializes Names from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Names) FromWire(w wire.Value) error {
+	x, err := _List_String_Read(w.GetList())
+	*v = (Names)(x)
+	return err
+}
+
+// Decode deserializes Names directly off the wire.
+func (v *Names) Decode(sr stream.Reader) error {
+	x, err := _List_String_Decode(sr)
+	*v = (Names)(x)
+	return err
+}
+
+// Equals returns true if this Names is equal to the provided
+// Names.
+func (lhs Names) Equals(rhs Names) bool {
+	return _List_String_Equals(([]string)(lhs), ([]string)(rhs))
+}
+
+// Copy returns a deep copy of this Names.
+func (v Names) Copy() Names {
+	x := ([]string)(v)
+	return (Names)(_List_String_Copy(x))
+}
+
+// Hash returns a hash of this Names which is stable across
+// processes.
+func (v Names) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_String_Hash(([]string)(v)))
+	return h.Sum64()
+}
+
+func (v Names) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_String_Zapper)(([]string)(v))).MarshalLogArray(enc)
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Shape struct {
+	Point   *Point   `json:"point,omitempty"`
+	Polygon []*Point `json:"polygon,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Polygon != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Polygon)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Polygon, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Polygon != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Polygon, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Polygon, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Polygon != nil {
+		fields[i] = fmt.Sprintf("Polygon: %v", v.Polygon)
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !((v.Polygon == nil && rhs.Polygon == nil) || (v.Polygon != nil && rhs.Polygon != nil && _List_Point_Equals(v.Polygon, rhs.Polygon))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Point = v.Point.Copy()
+	o.Polygon = _List_Point_Copy(v.Polygon)
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	h.Field(2)
+	h.Uint64(_List_Point_Hash(v.Polygon))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Polygon != nil {
+		err = multierr.Append(err, enc.AddArray("polygon", (_List_Point_Zapper)(v.Polygon)))
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Shape) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPoint(x *Point) {
+	*v = Shape{}
+	v.Point = x
+}
+
+// GetPolygon returns the value of Polygon if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPolygon() (o []*Point) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon
+	}
+
+	return
+}
+
+// IsSetPolygon returns true if Polygon is not nil.
+func (v *Shape) IsSetPolygon() bool {
+	return v != nil && v.Polygon != nil
+}
+
+// LookupPolygon returns the value of Polygon and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPolygon() (o []*Point, _ bool) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon, true
+	}
+	return o, false
+}
+
+// SetPolygon sets the value of Polygon and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPolygon(x []*Point) {
+	*v = Shape{}
+	v.Polygon = x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Polygon != nil {
+		return 2
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitPolygon is called with the value of Polygon if it is set.
+	VisitPolygon([]*Point) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Polygon != nil {
+			return visitor.VisitPolygon(v.Polygon)
+		}
+	}
+	return visitor.Default()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "constant-functions",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/constant-functions",
+	FilePath: "constant-functions.thrift",
+	SHA1:     "dfae4cd8e053d4e165f50e1ec8731a434bfaba99",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Point {\n    1: required double x\n    2: required double y\n}\n\nunion Shape {\n    1: Point point\n    2: list<Point> polygon\n}\n\ntypedef list<string> Names\n\n/** Names of the primary colors. */\nconst list<string> primaryColors = [\"red\", \"green\", \"blue\"]\n\nconst set<i32> primes = [2, 3, 5, 7]\n\nconst map<string, i32> ports = {\"http\": 80, \"https\": 443}\n\nconst Point origin = {\"x\": 0.0, \"y\": 0.0}\n\nconst Shape unitSquare = {\n    \"polygon\": [\n        {\"x\": 0.0, \"y\": 0.0},\n        {\"x\": 1.0, \"y\": 0.0},\n        {\"x\": 1.0, \"y\": 1.0},\n        {\"x\": 0.0, \"y\": 1.0},\n    ]\n}\n\nconst Names users = [\"alice\", \"bob\"]\n\n/** Constants of immutable types are still generated as constants. */\nconst i32 maxPoints = 100\n\nconst string greeting = \"hello\"\n\nstruct Canvas {\n    1: optional list<string> colors = primaryColors\n    2: optional Point center = origin\n}\n"
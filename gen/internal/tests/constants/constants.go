@@ -4,6 +4,9 @@
 package constants
 
 import (
+	bytes "bytes"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
 	containers "go.uber.org/thriftrw/gen/internal/tests/containers"
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
 	exceptions "go.uber.org/thriftrw/gen/internal/tests/exceptions"
@@ -11,8 +14,16 @@ import (
 	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	unions "go.uber.org/thriftrw/gen/internal/tests/unions"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
 )
 
 const Home enums.RecordType = enums.RecordTypeHomeAddress
@@ -340,12 +351,1664 @@ var UUID *typedefs.UUID = &typedefs.UUID{
 	Low:  5678,
 }
 
+// Default values may refer to constants from included modules inside
+// containers and nested structs.
+type DefaultsFromConstants struct {
+	Numbers []int32                        `json:"numbers,omitempty"`
+	Enums   map[enums.EnumDefault]struct{} `json:"enums,omitempty"`
+	Points  map[string]*structs.Point      `json:"points,omitempty"`
+	Point   *structs.Point                 `json:"point,omitempty"`
+	MyEnum  *typedefs.MyEnum               `json:"myEnum,omitempty"`
+	UUID    *typedefs.UUID                 `json:"uuid,omitempty"`
+	Times   []typedefs.Timestamp           `json:"times,omitempty"`
+}
+
+func _MyEnum_ptr(v typedefs.MyEnum) *typedefs.MyEnum {
+	return &v
+}
+
+// Default_DefaultsFromConstants constructs a new DefaultsFromConstants struct,
+// pre-populating any fields with defined default values.
+func Default_DefaultsFromConstants() *DefaultsFromConstants {
+	var v DefaultsFromConstants
+	v.Numbers = []int32{
+		other_constants.Answer,
+		1,
+	}
+	v.Enums = map[enums.EnumDefault]struct{}{
+		other_constants.Favorite: struct{}{},
+		enums.EnumDefaultFoo:     struct{}{},
+	}
+	v.Points = map[string]*structs.Point{
+		"some": &structs.Point{
+			X: 1,
+			Y: 2,
+		},
+	}
+	v.Point = &structs.Point{
+		X: other_constants.Origin,
+		Y: other_constants.Origin,
+	}
+	v.MyEnum = _MyEnum_ptr(MyEnum)
+	v.UUID = &typedefs.UUID{
+		High: 1234,
+		Low:  5678,
+	}
+	v.Times = []typedefs.Timestamp{
+		BeginningOfTime,
+		typedefs.Timestamp(42),
+	}
+	return &v
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _Set_EnumDefault_mapType_ValueList map[enums.EnumDefault]struct{}
+
+func (v _Set_EnumDefault_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_EnumDefault_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_EnumDefault_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_EnumDefault_mapType_ValueList) Close() {}
+
+type _Map_String_Point_MapItemList map[string]*structs.Point
+
+func (m _Map_String_Point_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*structs.Point', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Point_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Point_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Point_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Point_MapItemList) Close() {}
+
+type _List_Timestamp_ValueList []typedefs.Timestamp
+
+func (v _List_Timestamp_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Timestamp_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Timestamp_ValueList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_List_Timestamp_ValueList) Close() {}
+
+// ToWire translates a DefaultsFromConstants struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DefaultsFromConstants) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	vNumbers := v.Numbers
+	if vNumbers == nil {
+		vNumbers = []int32{
+			other_constants.Answer,
+			1,
+		}
+	}
+	{
+		w, err = wire.NewValueList(_List_I32_ValueList(vNumbers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	vEnums := v.Enums
+	if vEnums == nil {
+		vEnums = map[enums.EnumDefault]struct{}{
+			other_constants.Favorite: struct{}{},
+			enums.EnumDefaultFoo:     struct{}{},
+		}
+	}
+	{
+		w, err = wire.NewValueSet(_Set_EnumDefault_mapType_ValueList(vEnums)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	vPoints := v.Points
+	if vPoints == nil {
+		vPoints = map[string]*structs.Point{
+			"some": &structs.Point{
+				X: 1,
+				Y: 2,
+			},
+		}
+	}
+	{
+		w, err = wire.NewValueMap(_Map_String_Point_MapItemList(vPoints)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	vPoint := v.Point
+	if vPoint == nil {
+		vPoint = &structs.Point{
+			X: other_constants.Origin,
+			Y: other_constants.Origin,
+		}
+	}
+	{
+		w, err = vPoint.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	vMyEnum := v.MyEnum
+	if vMyEnum == nil {
+		vMyEnum = _MyEnum_ptr(MyEnum)
+	}
+	{
+		w, err = vMyEnum.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	vUUID := v.UUID
+	if vUUID == nil {
+		vUUID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+	{
+		w, err = vUUID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	vTimes := v.Times
+	if vTimes == nil {
+		vTimes = []typedefs.Timestamp{
+			BeginningOfTime,
+			typedefs.Timestamp(42),
+		}
+	}
+	{
+		w, err = wire.NewValueList(_List_Timestamp_ValueList(vTimes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _EnumDefault_Read(w wire.Value) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Set_EnumDefault_mapType_Read(s wire.ValueList) (map[enums.EnumDefault]struct{}, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[enums.EnumDefault]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _EnumDefault_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Point_Read(w wire.Value) (*structs.Point, error) {
+	var v structs.Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_Point_Read(m wire.MapItemList) (map[string]*structs.Point, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*structs.Point, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Point_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _MyEnum_Read(w wire.Value) (typedefs.MyEnum, error) {
+	var x typedefs.MyEnum
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _UUID_Read(w wire.Value) (*typedefs.UUID, error) {
+	var x typedefs.UUID
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _Timestamp_Read(w wire.Value) (typedefs.Timestamp, error) {
+	var x typedefs.Timestamp
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_Timestamp_Read(l wire.ValueList) ([]typedefs.Timestamp, error) {
+	if l.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]typedefs.Timestamp, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Timestamp_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DefaultsFromConstants struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DefaultsFromConstants struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DefaultsFromConstants
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DefaultsFromConstants) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Numbers, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TSet {
+				v.Enums, err = _Set_EnumDefault_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Points, err = _Map_String_Point_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				var x typedefs.MyEnum
+				x, err = _MyEnum_Read(field.Value)
+				v.MyEnum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.UUID, err = _UUID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TList {
+				v.Times, err = _List_Timestamp_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Numbers == nil {
+		v.Numbers = []int32{
+			other_constants.Answer,
+			1,
+		}
+	}
+
+	if v.Enums == nil {
+		v.Enums = map[enums.EnumDefault]struct{}{
+			other_constants.Favorite: struct{}{},
+			enums.EnumDefaultFoo:     struct{}{},
+		}
+	}
+
+	if v.Points == nil {
+		v.Points = map[string]*structs.Point{
+			"some": &structs.Point{
+				X: 1,
+				Y: 2,
+			},
+		}
+	}
+
+	if v.Point == nil {
+		v.Point = &structs.Point{
+			X: other_constants.Origin,
+			Y: other_constants.Origin,
+		}
+	}
+
+	if v.MyEnum == nil {
+		v.MyEnum = _MyEnum_ptr(MyEnum)
+	}
+
+	if v.UUID == nil {
+		v.UUID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+
+	if v.Times == nil {
+		v.Times = []typedefs.Timestamp{
+			BeginningOfTime,
+			typedefs.Timestamp(42),
+		}
+	}
+
+	return nil
+}
+
+func _List_I32_Encode(val []int32, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []int32
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteInt32(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Set_EnumDefault_mapType_Encode(val map[enums.EnumDefault]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_String_Point_Encode(val map[string]*structs.Point, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*structs.Point', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _List_Timestamp_Encode(val []typedefs.Timestamp, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI64,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []typedefs.Timestamp
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a DefaultsFromConstants struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DefaultsFromConstants struct could not be encoded.
+func (v *DefaultsFromConstants) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	vNumbers := v.Numbers
+	if vNumbers == nil {
+		vNumbers = []int32{
+			other_constants.Answer,
+			1,
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I32_Encode(vNumbers, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vEnums := v.Enums
+	if vEnums == nil {
+		vEnums = map[enums.EnumDefault]struct{}{
+			other_constants.Favorite: struct{}{},
+			enums.EnumDefaultFoo:     struct{}{},
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_EnumDefault_mapType_Encode(vEnums, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vPoints := v.Points
+	if vPoints == nil {
+		vPoints = map[string]*structs.Point{
+			"some": &structs.Point{
+				X: 1,
+				Y: 2,
+			},
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Point_Encode(vPoints, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vPoint := v.Point
+	if vPoint == nil {
+		vPoint = &structs.Point{
+			X: other_constants.Origin,
+			Y: other_constants.Origin,
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := vPoint.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vMyEnum := v.MyEnum
+	if vMyEnum == nil {
+		vMyEnum = _MyEnum_ptr(MyEnum)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := vMyEnum.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vUUID := v.UUID
+	if vUUID == nil {
+		vUUID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := vUUID.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vTimes := v.Times
+	if vTimes == nil {
+		vTimes = []typedefs.Timestamp{
+			BeginningOfTime,
+			typedefs.Timestamp(42),
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Timestamp_Encode(vTimes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int32, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _EnumDefault_Decode(sr stream.Reader) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Set_EnumDefault_mapType_Decode(sr stream.Reader) (map[enums.EnumDefault]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[enums.EnumDefault]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _EnumDefault_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Point_Decode(sr stream.Reader) (*structs.Point, error) {
+	var v structs.Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_Point_Decode(sr stream.Reader) (map[string]*structs.Point, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]*structs.Point, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _MyEnum_Decode(sr stream.Reader) (typedefs.MyEnum, error) {
+	var x typedefs.MyEnum
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _UUID_Decode(sr stream.Reader) (*typedefs.UUID, error) {
+	var x typedefs.UUID
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _Timestamp_Decode(sr stream.Reader) (typedefs.Timestamp, error) {
+	var x typedefs.Timestamp
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_Timestamp_Decode(sr stream.Reader) ([]typedefs.Timestamp, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI64 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]typedefs.Timestamp, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Timestamp_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a DefaultsFromConstants struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DefaultsFromConstants struct could not be generated from the wire
+// representation.
+func (v *DefaultsFromConstants) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Numbers, err = _List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.Enums, err = _Set_EnumDefault_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Points, err = _Map_String_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TI32:
+			var x typedefs.MyEnum
+			x, err = _MyEnum_Decode(sr)
+			v.MyEnum = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.UUID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TList:
+			v.Times, err = _List_Timestamp_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Numbers == nil {
+		v.Numbers = []int32{
+			other_constants.Answer,
+			1,
+		}
+	}
+
+	if v.Enums == nil {
+		v.Enums = map[enums.EnumDefault]struct{}{
+			other_constants.Favorite: struct{}{},
+			enums.EnumDefaultFoo:     struct{}{},
+		}
+	}
+
+	if v.Points == nil {
+		v.Points = map[string]*structs.Point{
+			"some": &structs.Point{
+				X: 1,
+				Y: 2,
+			},
+		}
+	}
+
+	if v.Point == nil {
+		v.Point = &structs.Point{
+			X: other_constants.Origin,
+			Y: other_constants.Origin,
+		}
+	}
+
+	if v.MyEnum == nil {
+		v.MyEnum = _MyEnum_ptr(MyEnum)
+	}
+
+	if v.UUID == nil {
+		v.UUID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+
+	if v.Times == nil {
+		v.Times = []typedefs.Timestamp{
+			BeginningOfTime,
+			typedefs.Timestamp(42),
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DefaultsFromConstants
+// struct.
+func (v *DefaultsFromConstants) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Numbers != nil {
+		fields[i] = fmt.Sprintf("Numbers: %v", v.Numbers)
+		i++
+	}
+	if v.Enums != nil {
+		fields[i] = fmt.Sprintf("Enums: %v", v.Enums)
+		i++
+	}
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.MyEnum != nil {
+		fields[i] = fmt.Sprintf("MyEnum: %v", *(v.MyEnum))
+		i++
+	}
+	if v.UUID != nil {
+		fields[i] = fmt.Sprintf("UUID: %v", v.UUID)
+		i++
+	}
+	if v.Times != nil {
+		fields[i] = fmt.Sprintf("Times: %v", v.Times)
+		i++
+	}
+
+	return fmt.Sprintf("DefaultsFromConstants{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_EnumDefault_mapType_Equals(lhs, rhs map[enums.EnumDefault]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Point_Equals(lhs, rhs map[string]*structs.Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _MyEnum_EqualsPtr(lhs, rhs *typedefs.MyEnum) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Timestamp_Equals(lhs, rhs []typedefs.Timestamp) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DefaultsFromConstants match the
+// provided DefaultsFromConstants.
+//
+// This function performs a deep comparison.
+func (v *DefaultsFromConstants) Equals(rhs *DefaultsFromConstants) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Numbers == nil && rhs.Numbers == nil) || (v.Numbers != nil && rhs.Numbers != nil && _List_I32_Equals(v.Numbers, rhs.Numbers))) {
+		return false
+	}
+	if !((v.Enums == nil && rhs.Enums == nil) || (v.Enums != nil && rhs.Enums != nil && _Set_EnumDefault_mapType_Equals(v.Enums, rhs.Enums))) {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _Map_String_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !_MyEnum_EqualsPtr(v.MyEnum, rhs.MyEnum) {
+		return false
+	}
+	if !((v.UUID == nil && rhs.UUID == nil) || (v.UUID != nil && rhs.UUID != nil && v.UUID.Equals(rhs.UUID))) {
+		return false
+	}
+	if !((v.Times == nil && rhs.Times == nil) || (v.Times != nil && rhs.Times != nil && _List_Timestamp_Equals(v.Times, rhs.Times))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_EnumDefault_mapType_Copy(v map[enums.EnumDefault]struct{}) map[enums.EnumDefault]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[enums.EnumDefault]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_String_Point_Copy(v map[string]*structs.Point) map[string]*structs.Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*structs.Point, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
+
+func _MyEnum_CopyPtr(v *typedefs.MyEnum) *typedefs.MyEnum {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_Timestamp_Copy(v []typedefs.Timestamp) []typedefs.Timestamp {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]typedefs.Timestamp, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this DefaultsFromConstants.
+func (v *DefaultsFromConstants) Copy() *DefaultsFromConstants {
+	if v == nil {
+		return nil
+	}
+
+	var o DefaultsFromConstants
+	o.Numbers = _List_I32_Copy(v.Numbers)
+	o.Enums = _Set_EnumDefault_mapType_Copy(v.Enums)
+	o.Points = _Map_String_Point_Copy(v.Points)
+	o.Point = v.Point.Copy()
+	o.MyEnum = _MyEnum_CopyPtr(v.MyEnum)
+	o.UUID = v.UUID.Copy()
+	o.Times = _List_Timestamp_Copy(v.Times)
+	return &o
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+func _Set_EnumDefault_mapType_Hash(v map[enums.EnumDefault]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_Point_Hash(v map[string]*structs.Point) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_Timestamp_Hash(v []typedefs.Timestamp) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int64(int64(x))
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this DefaultsFromConstants which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DefaultsFromConstants) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_I32_Hash(v.Numbers))
+	h.Field(2)
+	h.Uint64(_Set_EnumDefault_mapType_Hash(v.Enums))
+	h.Field(3)
+	h.Uint64(_Map_String_Point_Hash(v.Points))
+	h.Field(4)
+	h.Uint64(v.Point.Hash())
+	if v.MyEnum != nil {
+		h.Field(5)
+		h.Int32(int32(*v.MyEnum))
+	}
+	h.Field(6)
+	h.Uint64(v.UUID.Hash())
+	h.Field(7)
+	h.Uint64(_List_Timestamp_Hash(v.Times))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DefaultsFromConstants so that it may be reused.
+func (v *DefaultsFromConstants) Reset() {
+	*v = DefaultsFromConstants{}
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+type _Set_EnumDefault_mapType_Zapper map[enums.EnumDefault]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_EnumDefault_mapType_Zapper.
+func (s _Set_EnumDefault_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_Point_Zapper map[string]*structs.Point
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Point_Zapper.
+func (m _Map_String_Point_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+type _List_Timestamp_Zapper []typedefs.Timestamp
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Timestamp_Zapper.
+func (l _List_Timestamp_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt64((int64)(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DefaultsFromConstants.
+func (v *DefaultsFromConstants) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Numbers != nil {
+		err = multierr.Append(err, enc.AddArray("numbers", (_List_I32_Zapper)(v.Numbers)))
+	}
+	if v.Enums != nil {
+		err = multierr.Append(err, enc.AddArray("enums", (_Set_EnumDefault_mapType_Zapper)(v.Enums)))
+	}
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddObject("points", (_Map_String_Point_Zapper)(v.Points)))
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.MyEnum != nil {
+		err = multierr.Append(err, enc.AddObject("myEnum", *v.MyEnum))
+	}
+	if v.UUID != nil {
+		err = multierr.Append(err, enc.AddObject("uuid", v.UUID))
+	}
+	if v.Times != nil {
+		err = multierr.Append(err, enc.AddArray("times", (_List_Timestamp_Zapper)(v.Times)))
+	}
+	return err
+}
+
+// GetNumbers returns the value of Numbers if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetNumbers() (o []int32) {
+	if v != nil && v.Numbers != nil {
+		return v.Numbers
+	}
+	o = []int32{
+		other_constants.Answer,
+		1,
+	}
+	return
+}
+
+// IsSetNumbers returns true if Numbers is not nil.
+func (v *DefaultsFromConstants) IsSetNumbers() bool {
+	return v != nil && v.Numbers != nil
+}
+
+// GetEnums returns the value of Enums if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetEnums() (o map[enums.EnumDefault]struct{}) {
+	if v != nil && v.Enums != nil {
+		return v.Enums
+	}
+	o = map[enums.EnumDefault]struct{}{
+		other_constants.Favorite: struct{}{},
+		enums.EnumDefaultFoo:     struct{}{},
+	}
+	return
+}
+
+// IsSetEnums returns true if Enums is not nil.
+func (v *DefaultsFromConstants) IsSetEnums() bool {
+	return v != nil && v.Enums != nil
+}
+
+// GetPoints returns the value of Points if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetPoints() (o map[string]*structs.Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+	o = map[string]*structs.Point{
+		"some": &structs.Point{
+			X: 1,
+			Y: 2,
+		},
+	}
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *DefaultsFromConstants) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetPoint returns the value of Point if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetPoint() (o *structs.Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+	o = &structs.Point{
+		X: other_constants.Origin,
+		Y: other_constants.Origin,
+	}
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *DefaultsFromConstants) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// GetMyEnum returns the value of MyEnum if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetMyEnum() (o typedefs.MyEnum) {
+	if v != nil && v.MyEnum != nil {
+		return *v.MyEnum
+	}
+	o = MyEnum
+	return
+}
+
+// IsSetMyEnum returns true if MyEnum is not nil.
+func (v *DefaultsFromConstants) IsSetMyEnum() bool {
+	return v != nil && v.MyEnum != nil
+}
+
+// GetUUID returns the value of UUID if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetUUID() (o *typedefs.UUID) {
+	if v != nil && v.UUID != nil {
+		return v.UUID
+	}
+	o = &typedefs.UUID{
+		High: 1234,
+		Low:  5678,
+	}
+	return
+}
+
+// IsSetUUID returns true if UUID is not nil.
+func (v *DefaultsFromConstants) IsSetUUID() bool {
+	return v != nil && v.UUID != nil
+}
+
+// GetTimes returns the value of Times if it is set or its
+// default value if it is unset.
+func (v *DefaultsFromConstants) GetTimes() (o []typedefs.Timestamp) {
+	if v != nil && v.Times != nil {
+		return v.Times
+	}
+	o = []typedefs.Timestamp{
+		BeginningOfTime,
+		typedefs.Timestamp(42),
+	}
+	return
+}
+
+// IsSetTimes returns true if Times is not nil.
+func (v *DefaultsFromConstants) IsSetTimes() bool {
+	return v != nil && v.Times != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "constants",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/constants",
 	FilePath: "constants.thrift",
-	SHA1:     "9dcab618ffb2d35b6baacfaa01c23461e63dbf06",
+	SHA1:     "864ef2519f28da59194e6c3b75a3563436d19416",
 	Includes: []*thriftreflect.ThriftModule{
 		containers.ThriftModule,
 		enums.ThriftModule,
@@ -358,4 +2021,4 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./other_constants.thrift\"\ninclude \"./containers.thrift\"\ninclude \"./enums.thrift\"\ninclude \"./exceptions.thrift\"\ninclude \"./structs.thrift\"\ninclude \"./unions.thrift\"\ninclude \"./typedefs.thrift\"\n\nconst i16 int16 = 12345\nconst i32 int32 = 1234567890\nconst i64 int64 = 1234567890123456789\n\nconst i16 hex16 = 0x1234\nconst i32 hex32 = 0x12345678\nconst i64 hex64 = 0x1234567890abcdef\n\nconst containers.PrimitiveContainers primitiveContainers = {\n    \"listOfInts\": other_constants.listOfInts, // imported constant\n    \"setOfStrings\": [\"foo\", \"bar\"],\n    \"setOfBytes\": other_constants.listOfInts, // imported constant with type casting\n    \"mapOfIntToString\": {\n        1: \"1\",\n        2: \"2\",\n        3: \"3\",\n    },\n    \"mapOfStringToBool\": {\n        \"1\": 0,\n        \"2\": 1,\n        \"3\": 1,\n    }\n}\n\nconst containers.EnumContainers enumContainers = {\n    \"listOfEnums\": [1, enums.EnumDefault.Foo],\n    \"setOfEnums\": [123, enums.EnumWithValues.Y],\n    \"mapOfEnums\": {\n        0: 1,\n        enums.EnumWithDuplicateValues.Q: 2,\n    },\n}\n\nconst containers.ContainersOfContainers containersOfContainers = {\n    \"listOfLists\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfSets\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfMaps\": [{1: 2, 3: 4, 5: 6}, {7: 8, 9: 10, 11: 12}],\n    \"setOfSets\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfLists\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfMaps\": [\n        {\"1\": \"2\", \"3\": \"4\", \"5\": \"6\"},\n        {\"7\": \"8\", \"9\": \"10\", \"11\": \"12\"},\n    ],\n    \"mapOfMapToInt\": {\n        {\"1\": 1, \"2\": 2, \"3\": 3}: 100,\n        {\"4\": 4, \"5\": 5, \"6\": 6}: 200,\n    },\n    \"mapOfListToSet\": {\n        // more type casting\n        other_constants.listOfInts: other_constants.listOfInts,\n        [4, 5, 6]: [4, 5, 6],\n    },\n    \"mapOfSetToListOfDouble\": {\n        [1, 2, 3]: [1.2, 3.4],\n        [4, 5, 6]: [5.6, 7.8],\n    },\n}\n\nconst enums.StructWithOptionalEnum structWithOptionalEnum = {\n    \"e\": enums.EnumDefault.Baz\n}\n\nconst exceptions.EmptyException emptyException = {}\n\nconst structs.Graph graph = {\n    \"edges\": [\n        {\"startPoint\": other_constants.some_point, \"endPoint\": {\"x\": 3, \"y\": 4}},\n        {\"startPoint\": {\"x\": 5, \"y\": 6}, \"endPoint\": {\"x\": 7, \"y\": 8}},\n    ]\n}\n\nconst structs.Node lastNode = {\"value\": 3}\nconst structs.Node node = {\n    \"value\": 1,\n    \"tail\": {\"value\": 2, \"tail\": lastNode},\n}\n\nconst unions.ArbitraryValue arbitraryValue = {\n    \"listValue\": [\n        {\"boolValue\": 1},\n        {\"int64Value\": 2},\n        {\"stringValue\": \"hello\"},\n        {\"mapValue\": {\"foo\": {\"stringValue\": \"bar\"}}},\n    ],\n}\n// TODO: union validation for constants?\n\nconst typedefs.i128 i128 = uuid\nconst typedefs.UUID uuid = {\"high\": 1234, \"low\": 5678}\n\n/** Timestamp at which time began. */\nconst typedefs.Timestamp beginningOfTime = 0\n\n/**\n * An example frame group.\n *\n * Contains two frames.\n */\nconst typedefs.FrameGroup frameGroup = [\n    {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    {\n        \"topLeft\": {\"x\": 3, \"y\": 4},\n        \"size\": {\"width\": 300, \"height\": 400},\n    },\n]\n\nconst typedefs.MyEnum myEnum = enums.EnumWithValues.Y\n\nconst enums.RecordType NAME = enums.RecordType.NAME\nconst enums.RecordType HOME = enums.RecordType.HOME_ADDRESS\nconst enums.RecordType WORK_ADDRESS = enums.RecordType.WORK_ADDRESS\n\nconst enums.lowerCaseEnum lower = enums.lowerCaseEnum.items\n"
+const rawIDL = "include \"./other_constants.thrift\"\ninclude \"./containers.thrift\"\ninclude \"./enums.thrift\"\ninclude \"./exceptions.thrift\"\ninclude \"./structs.thrift\"\ninclude \"./unions.thrift\"\ninclude \"./typedefs.thrift\"\n\nconst i16 int16 = 12345\nconst i32 int32 = 1234567890\nconst i64 int64 = 1234567890123456789\n\nconst i16 hex16 = 0x1234\nconst i32 hex32 = 0x12345678\nconst i64 hex64 = 0x1234567890abcdef\n\nconst containers.PrimitiveContainers primitiveContainers = {\n    \"listOfInts\": other_constants.listOfInts, // imported constant\n    \"setOfStrings\": [\"foo\", \"bar\"],\n    \"setOfBytes\": other_constants.listOfInts, // imported constant with type casting\n    \"mapOfIntToString\": {\n        1: \"1\",\n        2: \"2\",\n        3: \"3\",\n    },\n    \"mapOfStringToBool\": {\n        \"1\": 0,\n        \"2\": 1,\n        \"3\": 1,\n    }\n}\n\nconst containers.EnumContainers enumContainers = {\n    \"listOfEnums\": [1, enums.EnumDefault.Foo],\n    \"setOfEnums\": [123, enums.EnumWithValues.Y],\n    \"mapOfEnums\": {\n        0: 1,\n        enums.EnumWithDuplicateValues.Q: 2,\n    },\n}\n\nconst containers.ContainersOfContainers containersOfContainers = {\n    \"listOfLists\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfSets\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfMaps\": [{1: 2, 3: 4, 5: 6}, {7: 8, 9: 10, 11: 12}],\n    \"setOfSets\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfLists\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfMaps\": [\n        {\"1\": \"2\", \"3\": \"4\", \"5\": \"6\"},\n        {\"7\": \"8\", \"9\": \"10\", \"11\": \"12\"},\n    ],\n    \"mapOfMapToInt\": {\n        {\"1\": 1, \"2\": 2, \"3\": 3}: 100,\n        {\"4\": 4, \"5\": 5, \"6\": 6}: 200,\n    },\n    \"mapOfListToSet\": {\n        // more type casting\n        other_constants.listOfInts: other_constants.listOfInts,\n        [4, 5, 6]: [4, 5, 6],\n    },\n    \"mapOfSetToListOfDouble\": {\n        [1, 2, 3]: [1.2, 3.4],\n        [4, 5, 6]: [5.6, 7.8],\n    },\n}\n\nconst enums.StructWithOptionalEnum structWithOptionalEnum = {\n    \"e\": enums.EnumDefault.Baz\n}\n\nconst exceptions.EmptyException emptyException = {}\n\nconst structs.Graph graph = {\n    \"edges\": [\n        {\"startPoint\": other_constants.some_point, \"endPoint\": {\"x\": 3, \"y\": 4}},\n        {\"startPoint\": {\"x\": 5, \"y\": 6}, \"endPoint\": {\"x\": 7, \"y\": 8}},\n    ]\n}\n\nconst structs.Node lastNode = {\"value\": 3}\nconst structs.Node node = {\n    \"value\": 1,\n    \"tail\": {\"value\": 2, \"tail\": lastNode},\n}\n\nconst unions.ArbitraryValue arbitraryValue = {\n    \"listValue\": [\n        {\"boolValue\": 1},\n        {\"int64Value\": 2},\n        {\"stringValue\": \"hello\"},\n        {\"mapValue\": {\"foo\": {\"stringValue\": \"bar\"}}},\n    ],\n}\n// TODO: union validation for constants?\n\nconst typedefs.i128 i128 = uuid\nconst typedefs.UUID uuid = {\"high\": 1234, \"low\": 5678}\n\n/** Timestamp at which time began. */\nconst typedefs.Timestamp beginningOfTime = 0\n\n/**\n * An example frame group.\n *\n * Contains two frames.\n */\nconst typedefs.FrameGroup frameGroup = [\n    {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    {\n        \"topLeft\": {\"x\": 3, \"y\": 4},\n        \"size\": {\"width\": 300, \"height\": 400},\n    },\n]\n\nconst typedefs.MyEnum myEnum = enums.EnumWithValues.Y\n\nconst enums.RecordType NAME = enums.RecordType.NAME\nconst enums.RecordType HOME = enums.RecordType.HOME_ADDRESS\nconst enums.RecordType WORK_ADDRESS = enums.RecordType.WORK_ADDRESS\n\nconst enums.lowerCaseEnum lower = enums.lowerCaseEnum.items\n\n/**\n * Default values may refer to constants from included modules inside\n * containers and nested structs.\n */\nstruct DefaultsFromConstants {\n    1: optional list<i32> numbers = [other_constants.answer, 1]\n    2: optional set<enums.EnumDefault> enums = [other_constants.favorite, enums.EnumDefault.Foo]\n    3: optional map<string, structs.Point> points = {\"some\": other_constants.some_point}\n    4: optional structs.Point point = {\"x\": other_constants.origin, \"y\": other_constants.origin}\n    5: optional typedefs.MyEnum myEnum = myEnum\n    6: optional typedefs.UUID uuid = i128\n    7: optional list<typedefs.Timestamp> times = [beginningOfTime, other_constants.answer]\n}\n"
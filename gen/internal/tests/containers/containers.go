@@ -15,6 +15,7 @@ import (
 	uuid_conflict "go.uber.org/thriftrw/gen/internal/tests/uuid_conflict"
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -3214,6 +3215,512 @@ func (v *ContainersOfContainers) Equals(rhs *ContainersOfContainers) bool {
 	return true
 }
 
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_List_I32_Copy(v [][]int32) [][]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]int32, len(v))
+	for i, x := range v {
+		o[i] = _List_I32_Copy(x)
+	}
+	return o
+}
+
+func _Set_I32_mapType_Copy(v map[int32]struct{}) map[int32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _List_Set_I32_mapType_Copy(v []map[int32]struct{}) []map[int32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[int32]struct{}, len(v))
+	for i, x := range v {
+		o[i] = _Set_I32_mapType_Copy(x)
+	}
+	return o
+}
+
+func _Map_I32_I32_Copy(v map[int32]int32) map[int32]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _List_Map_I32_I32_Copy(v []map[int32]int32) []map[int32]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[int32]int32, len(v))
+	for i, x := range v {
+		o[i] = _Map_I32_I32_Copy(x)
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Set_Set_String_mapType_sliceType_Copy(v []map[string]struct{}) []map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[string]struct{}, len(v))
+	for i, x := range v {
+		o[i] = _Set_String_mapType_Copy(x)
+	}
+	return o
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_List_String_sliceType_Copy(v [][]string) [][]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]string, len(v))
+	for i, x := range v {
+		o[i] = _List_String_Copy(x)
+	}
+	return o
+}
+
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Set_Map_String_String_sliceType_Copy(v []map[string]string) []map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[string]string, len(v))
+	for i, x := range v {
+		o[i] = _Map_String_String_Copy(x)
+	}
+	return o
+}
+
+func _Map_String_I32_Copy(v map[string]int32) map[string]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_Map_String_I32_I64_Copy(v []struct {
+	Key   map[string]int32
+	Value int64
+}) []struct {
+	Key   map[string]int32
+	Value int64
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   map[string]int32
+		Value int64
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Map_String_I32_Copy(x.Key)
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Set_I64_mapType_Copy(v map[int64]struct{}) map[int64]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_List_I32_Set_I64_mapType_Copy(v []struct {
+	Key   []int32
+	Value map[int64]struct{}
+}) []struct {
+	Key   []int32
+	Value map[int64]struct{}
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []int32
+		Value map[int64]struct{}
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _List_I32_Copy(x.Key)
+		o[i].Value = _Set_I64_mapType_Copy(x.Value)
+	}
+	return o
+}
+
+func _List_Double_Copy(v []float64) []float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]float64, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_Set_I32_mapType_List_Double_Copy(v []struct {
+	Key   map[int32]struct{}
+	Value []float64
+}) []struct {
+	Key   map[int32]struct{}
+	Value []float64
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   map[int32]struct{}
+		Value []float64
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Set_I32_mapType_Copy(x.Key)
+		o[i].Value = _List_Double_Copy(x.Value)
+	}
+	return o
+}
+
+// Copy returns a deep copy of this ContainersOfContainers.
+func (v *ContainersOfContainers) Copy() *ContainersOfContainers {
+	if v == nil {
+		return nil
+	}
+
+	var o ContainersOfContainers
+	o.ListOfLists = _List_List_I32_Copy(v.ListOfLists)
+	o.ListOfSets = _List_Set_I32_mapType_Copy(v.ListOfSets)
+	o.ListOfMaps = _List_Map_I32_I32_Copy(v.ListOfMaps)
+	o.SetOfSets = _Set_Set_String_mapType_sliceType_Copy(v.SetOfSets)
+	o.SetOfLists = _Set_List_String_sliceType_Copy(v.SetOfLists)
+	o.SetOfMaps = _Set_Map_String_String_sliceType_Copy(v.SetOfMaps)
+	o.MapOfMapToInt = _Map_Map_String_I32_I64_Copy(v.MapOfMapToInt)
+	o.MapOfListToSet = _Map_List_I32_Set_I64_mapType_Copy(v.MapOfListToSet)
+	o.MapOfSetToListOfDouble = _Map_Set_I32_mapType_List_Double_Copy(v.MapOfSetToListOfDouble)
+	return &o
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+func _List_List_I32_Hash(v [][]int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(_List_I32_Hash(x))
+	}
+	return h.Sum64()
+}
+
+func _Set_I32_mapType_Hash(v map[int32]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_Set_I32_mapType_Hash(v []map[int32]struct{}) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(_Set_I32_mapType_Hash(x))
+	}
+	return h.Sum64()
+}
+
+func _Map_I32_I32_Hash(v map[int32]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(k)
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_Map_I32_I32_Hash(v []map[int32]int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(_Map_I32_I32_Hash(x))
+	}
+	return h.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Set_String_mapType_sliceType_Hash(v []map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_Set_String_mapType_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Set_List_String_sliceType_Hash(v [][]string) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_List_String_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Map_String_String_sliceType_Hash(v []map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_Map_String_String_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_I32_Hash(v map[string]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Map_String_I32_I64_Hash(v []struct {
+	Key   map[string]int32
+	Value int64
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_Map_String_I32_Hash(x.Key))
+		h.Int64(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_I64_mapType_Hash(v map[int64]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_List_I32_Set_I64_mapType_Hash(v []struct {
+	Key   []int32
+	Value map[int64]struct{}
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_List_I32_Hash(x.Key))
+		h.Uint64(_Set_I64_mapType_Hash(x.Value))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_Double_Hash(v []float64) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Double(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_Set_I32_mapType_List_Double_Hash(v []struct {
+	Key   map[int32]struct{}
+	Value []float64
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_Set_I32_mapType_Hash(x.Key))
+		h.Uint64(_List_Double_Hash(x.Value))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this ContainersOfContainers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ContainersOfContainers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_List_I32_Hash(v.ListOfLists))
+	h.Field(2)
+	h.Uint64(_List_Set_I32_mapType_Hash(v.ListOfSets))
+	h.Field(3)
+	h.Uint64(_List_Map_I32_I32_Hash(v.ListOfMaps))
+	h.Field(4)
+	h.Uint64(_Set_Set_String_mapType_sliceType_Hash(v.SetOfSets))
+	h.Field(5)
+	h.Uint64(_Set_List_String_sliceType_Hash(v.SetOfLists))
+	h.Field(6)
+	h.Uint64(_Set_Map_String_String_sliceType_Hash(v.SetOfMaps))
+	h.Field(7)
+	h.Uint64(_Map_Map_String_I32_I64_Hash(v.MapOfMapToInt))
+	h.Field(8)
+	h.Uint64(_Map_List_I32_Set_I64_mapType_Hash(v.MapOfListToSet))
+	h.Field(9)
+	h.Uint64(_Map_Set_I32_mapType_List_Double_Hash(v.MapOfSetToListOfDouble))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ContainersOfContainers so that it may be reused.
+func (v *ContainersOfContainers) Reset() {
+	*v = ContainersOfContainers{}
+}
+
 type _List_I32_Zapper []int32
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -4365,6 +4872,110 @@ func (v *EnumContainers) Equals(rhs *EnumContainers) bool {
 	return true
 }
 
+func _List_EnumDefault_Copy(v []enums.EnumDefault) []enums.EnumDefault {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]enums.EnumDefault, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_EnumWithValues_mapType_Copy(v map[enums.EnumWithValues]struct{}) map[enums.EnumWithValues]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[enums.EnumWithValues]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_EnumWithDuplicateValues_I32_Copy(v map[enums.EnumWithDuplicateValues]int32) map[enums.EnumWithDuplicateValues]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[enums.EnumWithDuplicateValues]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this EnumContainers.
+func (v *EnumContainers) Copy() *EnumContainers {
+	if v == nil {
+		return nil
+	}
+
+	var o EnumContainers
+	o.ListOfEnums = _List_EnumDefault_Copy(v.ListOfEnums)
+	o.SetOfEnums = _Set_EnumWithValues_mapType_Copy(v.SetOfEnums)
+	o.MapOfEnums = _Map_EnumWithDuplicateValues_I32_Copy(v.MapOfEnums)
+	return &o
+}
+
+func _List_EnumDefault_Hash(v []enums.EnumDefault) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(int32(x))
+	}
+	return h.Sum64()
+}
+
+func _Set_EnumWithValues_mapType_Hash(v map[enums.EnumWithValues]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_EnumWithDuplicateValues_I32_Hash(v map[enums.EnumWithDuplicateValues]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(k))
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this EnumContainers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *EnumContainers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_EnumDefault_Hash(v.ListOfEnums))
+	h.Field(2)
+	h.Uint64(_Set_EnumWithValues_mapType_Hash(v.SetOfEnums))
+	h.Field(3)
+	h.Uint64(_Map_EnumWithDuplicateValues_I32_Hash(v.MapOfEnums))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this EnumContainers so that it may be reused.
+func (v *EnumContainers) Reset() {
+	*v = EnumContainers{}
+}
+
 type _List_EnumDefault_Zapper []enums.EnumDefault
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -5000,42 +5611,124 @@ func _List_RecordType_Equals(lhs, rhs []enum_conflict.RecordType) bool {
 		}
 	}
 
-	return true
+	return true
+}
+
+func _List_RecordType_1_Equals(lhs, rhs []enums.RecordType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListOfConflictingEnums match the
+// provided ListOfConflictingEnums.
+//
+// This function performs a deep comparison.
+func (v *ListOfConflictingEnums) Equals(rhs *ListOfConflictingEnums) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_List_RecordType_Equals(v.Records, rhs.Records) {
+		return false
+	}
+	if !_List_RecordType_1_Equals(v.OtherRecords, rhs.OtherRecords) {
+		return false
+	}
+
+	return true
+}
+
+func _List_RecordType_Copy(v []enum_conflict.RecordType) []enum_conflict.RecordType {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]enum_conflict.RecordType, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_RecordType_1_Copy(v []enums.RecordType) []enums.RecordType {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]enums.RecordType, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this ListOfConflictingEnums.
+func (v *ListOfConflictingEnums) Copy() *ListOfConflictingEnums {
+	if v == nil {
+		return nil
+	}
+
+	var o ListOfConflictingEnums
+	o.Records = _List_RecordType_Copy(v.Records)
+	o.OtherRecords = _List_RecordType_1_Copy(v.OtherRecords)
+	return &o
+}
+
+func _List_RecordType_Hash(v []enum_conflict.RecordType) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(int32(x))
+	}
+	return h.Sum64()
 }
 
-func _List_RecordType_1_Equals(lhs, rhs []enums.RecordType) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_RecordType_1_Hash(v []enums.RecordType) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(int32(x))
 	}
+	return h.Sum64()
+}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+// Hash returns a hash of this ListOfConflictingEnums which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ListOfConflictingEnums) Hash() uint64 {
+	if v == nil {
+		return 0
 	}
 
-	return true
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_RecordType_Hash(v.Records))
+	h.Field(2)
+	h.Uint64(_List_RecordType_1_Hash(v.OtherRecords))
+	return h.Sum64()
 }
 
-// Equals returns true if all the fields of this ListOfConflictingEnums match the
-// provided ListOfConflictingEnums.
+// Reset zeroes all fields of this ListOfConflictingEnums so that it may be reused.
 //
-// This function performs a deep comparison.
-func (v *ListOfConflictingEnums) Equals(rhs *ListOfConflictingEnums) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_List_RecordType_Equals(v.Records, rhs.Records) {
-		return false
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *ListOfConflictingEnums) Reset() {
+	*v = ListOfConflictingEnums{
+		Records:      v.Records[:0],
+		OtherRecords: v.OtherRecords[:0],
 	}
-	if !_List_RecordType_1_Equals(v.OtherRecords, rhs.OtherRecords) {
-		return false
-	}
-
-	return true
 }
 
 type _List_RecordType_Zapper []enum_conflict.RecordType
@@ -5670,6 +6363,88 @@ func (v *ListOfConflictingUUIDs) Equals(rhs *ListOfConflictingUUIDs) bool {
 	return true
 }
 
+func _List_UUID_Copy(v []*typedefs.UUID) []*typedefs.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*typedefs.UUID, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _List_UUID_1_Copy(v []uuid_conflict.UUID) []uuid_conflict.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]uuid_conflict.UUID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this ListOfConflictingUUIDs.
+func (v *ListOfConflictingUUIDs) Copy() *ListOfConflictingUUIDs {
+	if v == nil {
+		return nil
+	}
+
+	var o ListOfConflictingUUIDs
+	o.Uuids = _List_UUID_Copy(v.Uuids)
+	o.OtherUUIDs = _List_UUID_1_Copy(v.OtherUUIDs)
+	return &o
+}
+
+func _List_UUID_Hash(v []*typedefs.UUID) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _List_UUID_1_Hash(v []uuid_conflict.UUID) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(string(x))
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this ListOfConflictingUUIDs which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ListOfConflictingUUIDs) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_UUID_Hash(v.Uuids))
+	h.Field(2)
+	h.Uint64(_List_UUID_1_Hash(v.OtherUUIDs))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ListOfConflictingUUIDs so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *ListOfConflictingUUIDs) Reset() {
+	*v = ListOfConflictingUUIDs{
+		Uuids:      v.Uuids[:0],
+		OtherUUIDs: v.OtherUUIDs[:0],
+	}
+}
+
 type _List_UUID_Zapper []*typedefs.UUID
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -5910,6 +6685,35 @@ func (v *ListOfOptionalPrimitives) Equals(rhs *ListOfOptionalPrimitives) bool {
 	return true
 }
 
+// Copy returns a deep copy of this ListOfOptionalPrimitives.
+func (v *ListOfOptionalPrimitives) Copy() *ListOfOptionalPrimitives {
+	if v == nil {
+		return nil
+	}
+
+	var o ListOfOptionalPrimitives
+	o.ListOfStrings = _List_String_Copy(v.ListOfStrings)
+	return &o
+}
+
+// Hash returns a hash of this ListOfOptionalPrimitives which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ListOfOptionalPrimitives) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_String_Hash(v.ListOfStrings))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ListOfOptionalPrimitives so that it may be reused.
+func (v *ListOfOptionalPrimitives) Reset() {
+	*v = ListOfOptionalPrimitives{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ListOfOptionalPrimitives.
 func (v *ListOfOptionalPrimitives) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6122,6 +6926,40 @@ func (v *ListOfRequiredPrimitives) Equals(rhs *ListOfRequiredPrimitives) bool {
 	return true
 }
 
+// Copy returns a deep copy of this ListOfRequiredPrimitives.
+func (v *ListOfRequiredPrimitives) Copy() *ListOfRequiredPrimitives {
+	if v == nil {
+		return nil
+	}
+
+	var o ListOfRequiredPrimitives
+	o.ListOfStrings = _List_String_Copy(v.ListOfStrings)
+	return &o
+}
+
+// Hash returns a hash of this ListOfRequiredPrimitives which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ListOfRequiredPrimitives) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_String_Hash(v.ListOfStrings))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ListOfRequiredPrimitives so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *ListOfRequiredPrimitives) Reset() {
+	*v = ListOfRequiredPrimitives{
+		ListOfStrings: v.ListOfStrings[:0],
+	}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ListOfRequiredPrimitives.
 func (v *ListOfRequiredPrimitives) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6713,6 +7551,109 @@ func (v *MapOfBinaryAndString) Equals(rhs *MapOfBinaryAndString) bool {
 	return true
 }
 
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _Map_Binary_String_Copy(v []struct {
+	Key   []byte
+	Value string
+}) []struct {
+	Key   []byte
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Binary_Copy(x.Key)
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_String_Binary_Copy(v map[string][]byte) map[string][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]byte, len(v))
+	for k, x := range v {
+		o[k] = _Binary_Copy(x)
+	}
+	return o
+}
+
+// Copy returns a deep copy of this MapOfBinaryAndString.
+func (v *MapOfBinaryAndString) Copy() *MapOfBinaryAndString {
+	if v == nil {
+		return nil
+	}
+
+	var o MapOfBinaryAndString
+	o.BinaryToString = _Map_Binary_String_Copy(v.BinaryToString)
+	o.StringToBinary = _Map_String_Binary_Copy(v.StringToBinary)
+	return &o
+}
+
+func _Map_Binary_String_Hash(v []struct {
+	Key   []byte
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Binary(x.Key)
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_Binary_Hash(v map[string][]byte) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Binary(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this MapOfBinaryAndString which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *MapOfBinaryAndString) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Map_Binary_String_Hash(v.BinaryToString))
+	h.Field(2)
+	h.Uint64(_Map_String_Binary_Hash(v.StringToBinary))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this MapOfBinaryAndString so that it may be reused.
+func (v *MapOfBinaryAndString) Reset() {
+	*v = MapOfBinaryAndString{}
+}
+
 type _Map_Binary_String_Item_Zapper struct {
 	Key   []byte
 	Value string
@@ -7901,6 +8842,165 @@ func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
 	return true
 }
 
+func _List_Binary_Copy(v [][]byte) [][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(v))
+	for i, x := range v {
+		o[i] = _Binary_Copy(x)
+	}
+	return o
+}
+
+func _List_I64_Copy(v []int64) []int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int64, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_Byte_mapType_Copy(v map[int8]struct{}) map[int8]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int8]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_I32_String_Copy(v map[int32]string) map[int32]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_Bool_Copy(v map[string]bool) map[string]bool {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]bool, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this PrimitiveContainers.
+func (v *PrimitiveContainers) Copy() *PrimitiveContainers {
+	if v == nil {
+		return nil
+	}
+
+	var o PrimitiveContainers
+	o.ListOfBinary = _List_Binary_Copy(v.ListOfBinary)
+	o.ListOfInts = _List_I64_Copy(v.ListOfInts)
+	o.SetOfStrings = _Set_String_mapType_Copy(v.SetOfStrings)
+	o.SetOfBytes = _Set_Byte_mapType_Copy(v.SetOfBytes)
+	o.MapOfIntToString = _Map_I32_String_Copy(v.MapOfIntToString)
+	o.MapOfStringToBool = _Map_String_Bool_Copy(v.MapOfStringToBool)
+	return &o
+}
+
+func _List_Binary_Hash(v [][]byte) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Binary(x)
+	}
+	return h.Sum64()
+}
+
+func _List_I64_Hash(v []int64) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int64(x)
+	}
+	return h.Sum64()
+}
+
+func _Set_Byte_mapType_Hash(v map[int8]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int8(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_I32_String_Hash(v map[int32]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_Bool_Hash(v map[string]bool) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Bool(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this PrimitiveContainers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PrimitiveContainers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Binary_Hash(v.ListOfBinary))
+	h.Field(2)
+	h.Uint64(_List_I64_Hash(v.ListOfInts))
+	h.Field(3)
+	h.Uint64(_Set_String_mapType_Hash(v.SetOfStrings))
+	h.Field(4)
+	h.Uint64(_Set_Byte_mapType_Hash(v.SetOfBytes))
+	h.Field(5)
+	h.Uint64(_Map_I32_String_Hash(v.MapOfIntToString))
+	h.Field(6)
+	h.Uint64(_Map_String_Bool_Hash(v.MapOfStringToBool))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PrimitiveContainers so that it may be reused.
+func (v *PrimitiveContainers) Reset() {
+	*v = PrimitiveContainers{}
+}
+
 type _List_Binary_Zapper [][]byte
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -8518,6 +9618,78 @@ func (v *PrimitiveContainersRequired) Equals(rhs *PrimitiveContainersRequired) b
 	return true
 }
 
+func _Map_I64_Double_Copy(v map[int64]float64) map[int64]float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]float64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this PrimitiveContainersRequired.
+func (v *PrimitiveContainersRequired) Copy() *PrimitiveContainersRequired {
+	if v == nil {
+		return nil
+	}
+
+	var o PrimitiveContainersRequired
+	o.ListOfStrings = _List_String_Copy(v.ListOfStrings)
+	o.SetOfInts = _Set_I32_mapType_Copy(v.SetOfInts)
+	o.MapOfIntsToDoubles = _Map_I64_Double_Copy(v.MapOfIntsToDoubles)
+	return &o
+}
+
+func _Map_I64_Double_Hash(v map[int64]float64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int64(k)
+		h.Double(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this PrimitiveContainersRequired which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PrimitiveContainersRequired) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_String_Hash(v.ListOfStrings))
+	h.Field(2)
+	h.Uint64(_Set_I32_mapType_Hash(v.SetOfInts))
+	h.Field(3)
+	h.Uint64(_Map_I64_Double_Hash(v.MapOfIntsToDoubles))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PrimitiveContainersRequired so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *PrimitiveContainersRequired) Reset() {
+	for k := range v.SetOfInts {
+		delete(v.SetOfInts, k)
+	}
+	for k := range v.MapOfIntsToDoubles {
+		delete(v.MapOfIntsToDoubles, k)
+	}
+	*v = PrimitiveContainersRequired{
+		ListOfStrings:      v.ListOfStrings[:0],
+		SetOfInts:          v.SetOfInts,
+		MapOfIntsToDoubles: v.MapOfIntsToDoubles,
+	}
+}
+
 type _Map_I64_Double_Item_Zapper struct {
 	Key   int64
 	Value float64
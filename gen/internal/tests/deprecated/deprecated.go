@@ -0,0 +1,3119 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package deprecated
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Color of a widget.
+//
+// Deprecated: use Paint
+type Color int32
+
+const (
+	ColorRed Color = 0
+	// Looks like red.
+	//
+	// Deprecated: use RED
+	ColorCrimson Color = 1
+	ColorBlue    Color = 2
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorCrimson,
+		ColorBlue,
+	}
+}
+
+// Color_Names returns the names of all recognized values of
+// Color, in the same order as Color_Values.
+func Color_Names() []string {
+	return []string{
+		"RED",
+		"CRIMSON",
+		"BLUE",
+	}
+}
+
+// IsValid returns true if this Color is one of its recognized
+// values.
+func (v Color) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "CRIMSON":
+		*v = ColorCrimson
+		return nil
+	case "BLUE":
+		*v = ColorBlue
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Color", err)
+		}
+		*v = Color(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Color to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Color) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("RED"), nil
+	case 1:
+		return []byte("CRIMSON"), nil
+	case 2:
+		return []byte("BLUE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "CRIMSON")
+	case 2:
+		enc.AddString("name", "BLUE")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Color) Ptr() *Color {
+	return &v
+}
+
+// Set sets Color from its name or integer value.
+//
+// This implements flag.Value, allowing Color to be used as a
+// command line flag.
+func (v *Color) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Color) Type() string {
+	return "Color"
+}
+
+// Encode encodes Color directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Color
+//   return v.Encode(sWriter)
+func (v Color) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Color directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Color
+//   if err := v.Decode(sReader); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Color)(i)
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "CRIMSON"
+	case 2:
+		return "BLUE"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"CRIMSON\""), nil
+	case 2:
+		return ([]byte)("\"BLUE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+// Deprecated: no longer thrown
+type OldError struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a OldError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *OldError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a OldError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a OldError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v OldError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *OldError) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a OldError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a OldError struct could not be encoded.
+func (v *OldError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Message)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a OldError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a OldError struct could not be generated from the wire
+// representation.
+func (v *OldError) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a OldError
+// struct.
+func (v *OldError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("OldError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*OldError) ErrorName() string {
+	return "OldError"
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this OldError match the
+// provided OldError.
+//
+// This function performs a deep comparison.
+func (v *OldError) Equals(rhs *OldError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this OldError.
+func (v *OldError) Copy() *OldError {
+	if v == nil {
+		return nil
+	}
+
+	var o OldError
+	o.Message = _String_CopyPtr(v.Message)
+	return &o
+}
+
+// Hash returns a hash of this OldError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *OldError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Message != nil {
+		h.Field(1)
+		h.String(*v.Message)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this OldError so that it may be reused.
+func (v *OldError) Reset() {
+	*v = OldError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of OldError.
+func (v *OldError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *OldError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *OldError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+func (v *OldError) Error() string {
+	return v.String()
+}
+
+type Paint struct {
+	Name string `json:"name,required"`
+}
+
+// ToWire translates a Paint struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Paint) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Paint struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Paint struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Paint
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Paint) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Paint is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Paint struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Paint struct could not be encoded.
+func (v *Paint) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Paint struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Paint struct could not be generated from the wire
+// representation.
+func (v *Paint) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Paint is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Paint
+// struct.
+func (v *Paint) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+
+	return fmt.Sprintf("Paint{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Paint match the
+// provided Paint.
+//
+// This function performs a deep comparison.
+func (v *Paint) Equals(rhs *Paint) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Paint.
+func (v *Paint) Copy() *Paint {
+	if v == nil {
+		return nil
+	}
+
+	var o Paint
+	o.Name = v.Name
+	return &o
+}
+
+// Hash returns a hash of this Paint which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Paint) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Paint so that it may be reused.
+func (v *Paint) Reset() {
+	*v = Paint{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Paint.
+func (v *Paint) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Paint) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+type Shape struct {
+	// Deprecated: use diameter
+	Radius   *float64 `json:"radius,omitempty"`
+	Diameter *float64 `json:"diameter,omitempty"`
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Radius != nil {
+		w, err = wire.NewValueDouble(*(v.Radius)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Diameter != nil {
+		w, err = wire.NewValueDouble(*(v.Diameter)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Radius = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Diameter = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Radius != nil {
+		count++
+	}
+	if v.Diameter != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Radius != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.Radius)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Diameter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.Diameter)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Radius != nil {
+		count++
+	}
+	if v.Diameter != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Radius = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Diameter = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Radius != nil {
+		count++
+	}
+	if v.Diameter != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Radius != nil {
+		fields[i] = fmt.Sprintf("Radius: %v", *(v.Radius))
+		i++
+	}
+	if v.Diameter != nil {
+		fields[i] = fmt.Sprintf("Diameter: %v", *(v.Diameter))
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Double_EqualsPtr(v.Radius, rhs.Radius) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.Diameter, rhs.Diameter) {
+		return false
+	}
+
+	return true
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Radius = _Double_CopyPtr(v.Radius)
+	o.Diameter = _Double_CopyPtr(v.Diameter)
+	return &o
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Radius != nil {
+		h.Field(1)
+		h.Double(*v.Radius)
+	}
+	if v.Diameter != nil {
+		h.Field(2)
+		h.Double(*v.Diameter)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Radius != nil {
+		enc.AddFloat64("radius", *v.Radius)
+	}
+	if v.Diameter != nil {
+		enc.AddFloat64("diameter", *v.Diameter)
+	}
+	return err
+}
+
+// GetRadius returns the value of Radius if it is set or its
+// zero value if it is unset.
+//
+// Deprecated: use diameter
+func (v *Shape) GetRadius() (o float64) {
+	if v != nil && v.Radius != nil {
+		return *v.Radius
+	}
+
+	return
+}
+
+// IsSetRadius returns true if Radius is not nil.
+func (v *Shape) IsSetRadius() bool {
+	return v != nil && v.Radius != nil
+}
+
+// LookupRadius returns the value of Radius and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupRadius() (o float64, _ bool) {
+	if v != nil && v.Radius != nil {
+		return *v.Radius, true
+	}
+	return o, false
+}
+
+// SetRadius sets the value of Radius and unsets all other
+// fields of this Shape.
+func (v *Shape) SetRadius(x float64) {
+	*v = Shape{}
+	v.Radius = &x
+}
+
+// GetDiameter returns the value of Diameter if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetDiameter() (o float64) {
+	if v != nil && v.Diameter != nil {
+		return *v.Diameter
+	}
+
+	return
+}
+
+// IsSetDiameter returns true if Diameter is not nil.
+func (v *Shape) IsSetDiameter() bool {
+	return v != nil && v.Diameter != nil
+}
+
+// LookupDiameter returns the value of Diameter and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupDiameter() (o float64, _ bool) {
+	if v != nil && v.Diameter != nil {
+		return *v.Diameter, true
+	}
+	return o, false
+}
+
+// SetDiameter sets the value of Diameter and unsets all other
+// fields of this Shape.
+func (v *Shape) SetDiameter(x float64) {
+	*v = Shape{}
+	v.Diameter = &x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Radius != nil {
+		return 1
+	}
+	if v.Diameter != nil {
+		return 2
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitRadius is called with the value of Radius if it is set.
+	VisitRadius(float64) error
+
+	// VisitDiameter is called with the value of Diameter if it is set.
+	VisitDiameter(float64) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Radius != nil {
+			return visitor.VisitRadius(*v.Radius)
+		}
+		if v.Diameter != nil {
+			return visitor.VisitDiameter(*v.Diameter)
+		}
+	}
+	return visitor.Default()
+}
+
+// Widget is a thing.
+type Widget struct {
+	ID string `json:"id,required"`
+	// Name of the widget.
+	//
+	// Deprecated: use label
+	Name  *string `json:"name,omitempty"`
+	Label *string `json:"label,omitempty"`
+	// Deprecated: Do not use.
+	Color *Color `json:"color,omitempty"`
+	Paint *Paint `json:"paint,omitempty"`
+}
+
+// ToWire translates a Widget struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Widget) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Label != nil {
+		w, err = wire.NewValueString(*(v.Label)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Color != nil {
+		w, err = v.Color.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Paint != nil {
+		w, err = v.Paint.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Color_Read(w wire.Value) (Color, error) {
+	var v Color
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Paint_Read(w wire.Value) (*Paint, error) {
+	var v Paint
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Widget struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Widget struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Widget
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Widget) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Label = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x Color
+				x, err = _Color_Read(field.Value)
+				v.Color = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.Paint, err = _Paint_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Widget is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Widget struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Widget struct could not be encoded.
+func (v *Widget) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Label != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Label)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Color != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Color.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Paint != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Paint.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Color_Decode(sr stream.Reader) (Color, error) {
+	var v Color
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Paint_Decode(sr stream.Reader) (*Paint, error) {
+	var v Paint
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Widget struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Widget struct could not be generated from the wire
+// representation.
+func (v *Widget) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Label = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x Color
+			x, err = _Color_Decode(sr)
+			v.Color = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.Paint, err = _Paint_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Widget is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Widget
+// struct.
+func (v *Widget) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Label != nil {
+		fields[i] = fmt.Sprintf("Label: %v", *(v.Label))
+		i++
+	}
+	if v.Color != nil {
+		fields[i] = fmt.Sprintf("Color: %v", *(v.Color))
+		i++
+	}
+	if v.Paint != nil {
+		fields[i] = fmt.Sprintf("Paint: %v", v.Paint)
+		i++
+	}
+
+	return fmt.Sprintf("Widget{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Color_EqualsPtr(lhs, rhs *Color) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Widget match the
+// provided Widget.
+//
+// This function performs a deep comparison.
+func (v *Widget) Equals(rhs *Widget) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Label, rhs.Label) {
+		return false
+	}
+	if !_Color_EqualsPtr(v.Color, rhs.Color) {
+		return false
+	}
+	if !((v.Paint == nil && rhs.Paint == nil) || (v.Paint != nil && rhs.Paint != nil && v.Paint.Equals(rhs.Paint))) {
+		return false
+	}
+
+	return true
+}
+
+func _Color_CopyPtr(v *Color) *Color {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Widget.
+func (v *Widget) Copy() *Widget {
+	if v == nil {
+		return nil
+	}
+
+	var o Widget
+	o.ID = v.ID
+	o.Name = _String_CopyPtr(v.Name)
+	o.Label = _String_CopyPtr(v.Label)
+	o.Color = _Color_CopyPtr(v.Color)
+	o.Paint = v.Paint.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Widget which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Widget) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	if v.Label != nil {
+		h.Field(3)
+		h.String(*v.Label)
+	}
+	if v.Color != nil {
+		h.Field(4)
+		h.Int32(int32(*v.Color))
+	}
+	h.Field(5)
+	h.Uint64(v.Paint.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Widget so that it may be reused.
+func (v *Widget) Reset() {
+	*v = Widget{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Widget.
+func (v *Widget) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Label != nil {
+		enc.AddString("label", *v.Label)
+	}
+	if v.Color != nil {
+		err = multierr.Append(err, enc.AddObject("color", *v.Color))
+	}
+	if v.Paint != nil {
+		err = multierr.Append(err, enc.AddObject("paint", v.Paint))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Widget) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// Deprecated: use label
+func (v *Widget) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Widget) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetLabel returns the value of Label if it is set or its
+// zero value if it is unset.
+func (v *Widget) GetLabel() (o string) {
+	if v != nil && v.Label != nil {
+		return *v.Label
+	}
+
+	return
+}
+
+// IsSetLabel returns true if Label is not nil.
+func (v *Widget) IsSetLabel() bool {
+	return v != nil && v.Label != nil
+}
+
+// GetColor returns the value of Color if it is set or its
+// zero value if it is unset.
+//
+// Deprecated: Do not use.
+func (v *Widget) GetColor() (o Color) {
+	if v != nil && v.Color != nil {
+		return *v.Color
+	}
+
+	return
+}
+
+// IsSetColor returns true if Color is not nil.
+func (v *Widget) IsSetColor() bool {
+	return v != nil && v.Color != nil
+}
+
+// GetPaint returns the value of Paint if it is set or its
+// zero value if it is unset.
+func (v *Widget) GetPaint() (o *Paint) {
+	if v != nil && v.Paint != nil {
+		return v.Paint
+	}
+
+	return
+}
+
+// IsSetPaint returns true if Paint is not nil.
+func (v *Widget) IsSetPaint() bool {
+	return v != nil && v.Paint != nil
+}
+
+// Deprecated: use the id field of Widget
+type WidgetID string
+
+// WidgetIDPtr returns a pointer to a WidgetID
+func (v WidgetID) Ptr() *WidgetID {
+	return &v
+}
+
+// ToWire translates WidgetID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v WidgetID) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of WidgetID.
+func (v WidgetID) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v WidgetID) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes WidgetID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *WidgetID) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (WidgetID)(x)
+	return err
+}
+
+// Decode deserializes WidgetID directly off the wire.
+func (v *WidgetID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (WidgetID)(x)
+	return err
+}
+
+// Equals returns true if this WidgetID is equal to the provided
+// WidgetID.
+func (lhs WidgetID) Equals(rhs WidgetID) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this WidgetID which is stable across
+// processes.
+func (v WidgetID) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "deprecated",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/deprecated",
+	FilePath: "deprecated.thrift",
+	SHA1:     "28f41c572570dfdb36ac67ee2d0710e08ec2c612",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "/** Color of a widget. */\nenum Color {\n    RED,\n    /** Looks like red. */\n    CRIMSON (deprecated = \"use RED\"),\n    BLUE,\n} (deprecated = \"use Paint\")\n\nstruct Paint {\n    1: required string name\n}\n\n/** Widget is a thing. */\nstruct Widget {\n    1: required string id\n    /** Name of the widget. */\n    2: optional string name (deprecated = \"use label\")\n    3: optional string label\n    4: optional Color color (deprecated)\n    5: optional Paint paint\n}\n\ntypedef string WidgetID (deprecated = \"use  the id field  of Widget \")\n\nunion Shape {\n    1: double radius (deprecated = \"use diameter\")\n    2: double diameter\n}\n\nexception OldError {\n    1: optional string message\n} (deprecated = \"no longer thrown\")\n\nservice Widgets {\n    Widget get(1: string id)\n\n    /** Returns the widget with the given name. */\n    Widget getByName(1: string name) (deprecated = \"use get\")\n} (deprecated = \"use WidgetStore\")\n"
+
+// Widgets_Get_Args represents the arguments for the Widgets.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Widgets_Get_Args struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// ToWire translates a Widgets_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Widgets_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Widgets_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Widgets_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Widgets_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Widgets_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Widgets_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Widgets_Get_Args struct could not be encoded.
+func (v *Widgets_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Widgets_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Widgets_Get_Args struct could not be generated from the wire
+// representation.
+func (v *Widgets_Get_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Widgets_Get_Args
+// struct.
+func (v *Widgets_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+
+	return fmt.Sprintf("Widgets_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Widgets_Get_Args match the
+// provided Widgets_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Widgets_Get_Args) Equals(rhs *Widgets_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Widgets_Get_Args.
+func (v *Widgets_Get_Args) Copy() *Widgets_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Widgets_Get_Args
+	o.ID = _String_CopyPtr(v.ID)
+	return &o
+}
+
+// Hash returns a hash of this Widgets_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Widgets_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.ID != nil {
+		h.Field(1)
+		h.String(*v.ID)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Widgets_Get_Args so that it may be reused.
+func (v *Widgets_Get_Args) Reset() {
+	*v = Widgets_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Widgets_Get_Args.
+func (v *Widgets_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Widgets_Get_Args) GetID() (o string) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Widgets_Get_Args) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Widgets_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Widgets_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Widgets_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Widgets.get
+// function.
+var Widgets_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id *string,
+	) *Widgets_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Widgets_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Widget, error) (*Widgets_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Widgets_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Widgets_Get_Result) (*Widget, error)
+}{}
+
+func init() {
+	Widgets_Get_Helper.Args = func(
+		id *string,
+	) *Widgets_Get_Args {
+		return &Widgets_Get_Args{
+			ID: id,
+		}
+	}
+
+	Widgets_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Widgets_Get_Helper.WrapResponse = func(success *Widget, err error) (*Widgets_Get_Result, error) {
+		if err == nil {
+			return &Widgets_Get_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Widgets_Get_Helper.UnwrapResponse = func(result *Widgets_Get_Result) (success *Widget, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Widgets_Get_Result represents the result of a Widgets.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Widgets_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success *Widget `json:"success,omitempty"`
+}
+
+// ToWire translates a Widgets_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Widgets_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Widgets_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Widget_Read(w wire.Value) (*Widget, error) {
+	var v Widget
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Widgets_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Widgets_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Widgets_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Widgets_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Widget_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Widgets_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Widgets_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Widgets_Get_Result struct could not be encoded.
+func (v *Widgets_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Widgets_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Widget_Decode(sr stream.Reader) (*Widget, error) {
+	var v Widget
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Widgets_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Widgets_Get_Result struct could not be generated from the wire
+// representation.
+func (v *Widgets_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Widget_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Widgets_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Widgets_Get_Result
+// struct.
+func (v *Widgets_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Widgets_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Widgets_Get_Result match the
+// provided Widgets_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Widgets_Get_Result) Equals(rhs *Widgets_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Widgets_Get_Result.
+func (v *Widgets_Get_Result) Copy() *Widgets_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Widgets_Get_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Widgets_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Widgets_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Widgets_Get_Result so that it may be reused.
+func (v *Widgets_Get_Result) Reset() {
+	*v = Widgets_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Widgets_Get_Result.
+func (v *Widgets_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Widgets_Get_Result) GetSuccess() (o *Widget) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Widgets_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Widgets_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Widgets_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Widgets_GetByName_Args represents the arguments for the Widgets.getByName function.
+//
+// The arguments for getByName are sent and received over the wire as this struct.
+//
+// Returns the widget with the given name.
+//
+// Deprecated: use get
+type Widgets_GetByName_Args struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ToWire translates a Widgets_GetByName_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Widgets_GetByName_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Widgets_GetByName_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Widgets_GetByName_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Widgets_GetByName_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Widgets_GetByName_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Widgets_GetByName_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Widgets_GetByName_Args struct could not be encoded.
+func (v *Widgets_GetByName_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Widgets_GetByName_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Widgets_GetByName_Args struct could not be generated from the wire
+// representation.
+func (v *Widgets_GetByName_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Widgets_GetByName_Args
+// struct.
+func (v *Widgets_GetByName_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Widgets_GetByName_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Widgets_GetByName_Args match the
+// provided Widgets_GetByName_Args.
+//
+// This function performs a deep comparison.
+func (v *Widgets_GetByName_Args) Equals(rhs *Widgets_GetByName_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Widgets_GetByName_Args.
+func (v *Widgets_GetByName_Args) Copy() *Widgets_GetByName_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Widgets_GetByName_Args
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Widgets_GetByName_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Widgets_GetByName_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Widgets_GetByName_Args so that it may be reused.
+func (v *Widgets_GetByName_Args) Reset() {
+	*v = Widgets_GetByName_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Widgets_GetByName_Args.
+func (v *Widgets_GetByName_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Widgets_GetByName_Args) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Widgets_GetByName_Args) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getByName" for this struct.
+func (v *Widgets_GetByName_Args) MethodName() string {
+	return "getByName"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Widgets_GetByName_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Widgets_GetByName_Helper provides functions that aid in handling the
+// parameters and return values of the Widgets.getByName
+// function.
+//
+// Returns the widget with the given name.
+//
+// Deprecated: use get
+var Widgets_GetByName_Helper = struct {
+	// Args accepts the parameters of getByName in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		name *string,
+	) *Widgets_GetByName_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getByName.
+	//
+	// An error can be thrown by getByName only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getByName
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getByName into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getByName
+	//
+	//   value, err := getByName(args)
+	//   result, err := Widgets_GetByName_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getByName: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Widget, error) (*Widgets_GetByName_Result, error)
+
+	// UnwrapResponse takes the result struct for getByName
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getByName threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Widgets_GetByName_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Widgets_GetByName_Result) (*Widget, error)
+}{}
+
+func init() {
+	Widgets_GetByName_Helper.Args = func(
+		name *string,
+	) *Widgets_GetByName_Args {
+		return &Widgets_GetByName_Args{
+			Name: name,
+		}
+	}
+
+	Widgets_GetByName_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Widgets_GetByName_Helper.WrapResponse = func(success *Widget, err error) (*Widgets_GetByName_Result, error) {
+		if err == nil {
+			return &Widgets_GetByName_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Widgets_GetByName_Helper.UnwrapResponse = func(result *Widgets_GetByName_Result) (success *Widget, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Widgets_GetByName_Result represents the result of a Widgets.getByName function call.
+//
+// The result of a getByName execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Widgets_GetByName_Result struct {
+	// Value returned by getByName after a successful execution.
+	Success *Widget `json:"success,omitempty"`
+}
+
+// ToWire translates a Widgets_GetByName_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Widgets_GetByName_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Widgets_GetByName_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Widgets_GetByName_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Widgets_GetByName_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Widgets_GetByName_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Widgets_GetByName_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Widget_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Widgets_GetByName_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Widgets_GetByName_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Widgets_GetByName_Result struct could not be encoded.
+func (v *Widgets_GetByName_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Widgets_GetByName_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Widgets_GetByName_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Widgets_GetByName_Result struct could not be generated from the wire
+// representation.
+func (v *Widgets_GetByName_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Widget_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Widgets_GetByName_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Widgets_GetByName_Result
+// struct.
+func (v *Widgets_GetByName_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Widgets_GetByName_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Widgets_GetByName_Result match the
+// provided Widgets_GetByName_Result.
+//
+// This function performs a deep comparison.
+func (v *Widgets_GetByName_Result) Equals(rhs *Widgets_GetByName_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Widgets_GetByName_Result.
+func (v *Widgets_GetByName_Result) Copy() *Widgets_GetByName_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Widgets_GetByName_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Widgets_GetByName_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Widgets_GetByName_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Widgets_GetByName_Result so that it may be reused.
+func (v *Widgets_GetByName_Result) Reset() {
+	*v = Widgets_GetByName_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Widgets_GetByName_Result.
+func (v *Widgets_GetByName_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Widgets_GetByName_Result) GetSuccess() (o *Widget) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Widgets_GetByName_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getByName" for this struct.
+func (v *Widgets_GetByName_Result) MethodName() string {
+	return "getByName"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Widgets_GetByName_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
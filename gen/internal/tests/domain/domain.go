@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package domain provides Go types, and functions that convert them to and
+// from Thrift types, which typedefs are bound to in tests.
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// UUID is a universally unique identifier.
+type UUID [16]byte
+
+// UUIDToBytes returns the bytes of the given UUID.
+func UUIDToBytes(u UUID) ([]byte, error) {
+	return u[:], nil
+}
+
+// BytesToUUID builds a UUID from its 16 bytes.
+func BytesToUUID(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) != len(u) {
+		return u, fmt.Errorf("invalid UUID: expected %v bytes, got %v", len(u), len(b))
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// TimeToMillis returns the number of milliseconds since the Unix epoch at
+// the given time.
+func TimeToMillis(t time.Time) (int64, error) {
+	return t.UnixNano() / int64(time.Millisecond), nil
+}
+
+// MillisToTime returns the UTC time which is the given number of
+// milliseconds after the Unix epoch.
+func MillisToTime(ms int64) (time.Time, error) {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC(), nil
+}
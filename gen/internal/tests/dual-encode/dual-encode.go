@@ -0,0 +1,2837 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package dual_encode
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	dualencode "go.uber.org/thriftrw/dualencode"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Item struct {
+	Name  string `json:"name,required"`
+	Count *int32 `json:"count,omitempty"`
+}
+
+// ToWire translates a Item struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Item) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Count != nil {
+		w, err = wire.NewValueI32(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Item struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Item struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Item
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Item) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Item is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Item struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Item struct could not be encoded.
+//
+// This also encodes the struct with ToWire and reports differences
+// between the two to the dualencode package.
+func (v *Item) Encode(sw stream.Writer) error {
+	return dualencode.Encode(sw, "Item", v.ToWire, v.encode)
+}
+
+// encode serializes a Item struct directly into bytes.
+func (v *Item) encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Count)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Item struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Item struct could not be generated from the wire
+// representation.
+func (v *Item) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Item is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Item
+// struct.
+func (v *Item) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+
+	return fmt.Sprintf("Item{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Item match the
+// provided Item.
+//
+// This function performs a deep comparison.
+func (v *Item) Equals(rhs *Item) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Item.
+func (v *Item) Copy() *Item {
+	if v == nil {
+		return nil
+	}
+
+	var o Item
+	o.Name = v.Name
+	o.Count = _I32_CopyPtr(v.Count)
+	return &o
+}
+
+// Hash returns a hash of this Item which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Item) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	if v.Count != nil {
+		h.Field(2)
+		h.Int32(*v.Count)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Item so that it may be reused.
+func (v *Item) Reset() {
+	*v = Item{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Item.
+func (v *Item) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Item) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *Item) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *Item) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+type Order struct {
+	ID      string              `json:"id,required"`
+	Items   []*Item             `json:"items,omitempty"`
+	Totals  map[string]int64    `json:"totals,omitempty"`
+	Tags    map[string]struct{} `json:"tags,omitempty"`
+	Primary *Item               `json:"primary,omitempty"`
+}
+
+type _List_Item_ValueList []*Item
+
+func (v _List_Item_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Item', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Item_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Item_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Item_ValueList) Close() {}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+// ToWire translates a Order struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Order) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Items != nil {
+		w, err = wire.NewValueList(_List_Item_ValueList(v.Items)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Totals != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.Totals)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Primary != nil {
+		w, err = v.Primary.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Item_Read(w wire.Value) (*Item, error) {
+	var v Item
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Item_Read(l wire.ValueList) ([]*Item, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Item, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Item_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Order struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Order struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Order
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Order) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Items, err = _List_Item_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Totals, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.Primary, err = _Item_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Order is required")
+	}
+
+	return nil
+}
+
+func _List_Item_Encode(val []*Item, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Item
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Item', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_I64_Encode(val map[string]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a Order struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Order struct could not be encoded.
+//
+// This also encodes the struct with ToWire and reports differences
+// between the two to the dualencode package.
+func (v *Order) Encode(sw stream.Writer) error {
+	return dualencode.Encode(sw, "Order", v.ToWire, v.encode)
+}
+
+// encode serializes a Order struct directly into bytes.
+func (v *Order) encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Items != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Item_Encode(v.Items, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Totals != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.Totals, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Primary != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Primary.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Item_Decode(sr stream.Reader) (*Item, error) {
+	var v Item
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Item_Decode(sr stream.Reader) ([]*Item, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Item, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Item_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I64_Decode(sr stream.Reader) (map[string]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Order struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Order struct could not be generated from the wire
+// representation.
+func (v *Order) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Items, err = _List_Item_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Totals, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.Primary, err = _Item_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Order is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Order
+// struct.
+func (v *Order) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Items != nil {
+		fields[i] = fmt.Sprintf("Items: %v", v.Items)
+		i++
+	}
+	if v.Totals != nil {
+		fields[i] = fmt.Sprintf("Totals: %v", v.Totals)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Primary != nil {
+		fields[i] = fmt.Sprintf("Primary: %v", v.Primary)
+		i++
+	}
+
+	return fmt.Sprintf("Order{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Item_Equals(lhs, rhs []*Item) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Order match the
+// provided Order.
+//
+// This function performs a deep comparison.
+func (v *Order) Equals(rhs *Order) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !((v.Items == nil && rhs.Items == nil) || (v.Items != nil && rhs.Items != nil && _List_Item_Equals(v.Items, rhs.Items))) {
+		return false
+	}
+	if !((v.Totals == nil && rhs.Totals == nil) || (v.Totals != nil && rhs.Totals != nil && _Map_String_I64_Equals(v.Totals, rhs.Totals))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_mapType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Primary == nil && rhs.Primary == nil) || (v.Primary != nil && rhs.Primary != nil && v.Primary.Equals(rhs.Primary))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Item_Copy(v []*Item) []*Item {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Item, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_I64_Copy(v map[string]int64) map[string]int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Order.
+func (v *Order) Copy() *Order {
+	if v == nil {
+		return nil
+	}
+
+	var o Order
+	o.ID = v.ID
+	o.Items = _List_Item_Copy(v.Items)
+	o.Totals = _Map_String_I64_Copy(v.Totals)
+	o.Tags = _Set_String_mapType_Copy(v.Tags)
+	o.Primary = v.Primary.Copy()
+	return &o
+}
+
+func _List_Item_Hash(v []*Item) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Map_String_I64_Hash(v map[string]int64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Order which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Order) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	h.Field(2)
+	h.Uint64(_List_Item_Hash(v.Items))
+	h.Field(3)
+	h.Uint64(_Map_String_I64_Hash(v.Totals))
+	h.Field(4)
+	h.Uint64(_Set_String_mapType_Hash(v.Tags))
+	h.Field(5)
+	h.Uint64(v.Primary.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Order so that it may be reused.
+func (v *Order) Reset() {
+	*v = Order{}
+}
+
+type _List_Item_Zapper []*Item
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Item_Zapper.
+func (l _List_Item_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_I64_Zapper map[string]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
+	}
+	return err
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Order.
+func (v *Order) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Items != nil {
+		err = multierr.Append(err, enc.AddArray("items", (_List_Item_Zapper)(v.Items)))
+	}
+	if v.Totals != nil {
+		err = multierr.Append(err, enc.AddObject("totals", (_Map_String_I64_Zapper)(v.Totals)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_String_mapType_Zapper)(v.Tags)))
+	}
+	if v.Primary != nil {
+		err = multierr.Append(err, enc.AddObject("primary", v.Primary))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Order) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetItems returns the value of Items if it is set or its
+// zero value if it is unset.
+func (v *Order) GetItems() (o []*Item) {
+	if v != nil && v.Items != nil {
+		return v.Items
+	}
+
+	return
+}
+
+// IsSetItems returns true if Items is not nil.
+func (v *Order) IsSetItems() bool {
+	return v != nil && v.Items != nil
+}
+
+// GetTotals returns the value of Totals if it is set or its
+// zero value if it is unset.
+func (v *Order) GetTotals() (o map[string]int64) {
+	if v != nil && v.Totals != nil {
+		return v.Totals
+	}
+
+	return
+}
+
+// IsSetTotals returns true if Totals is not nil.
+func (v *Order) IsSetTotals() bool {
+	return v != nil && v.Totals != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Order) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Order) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetPrimary returns the value of Primary if it is set or its
+// zero value if it is unset.
+func (v *Order) GetPrimary() (o *Item) {
+	if v != nil && v.Primary != nil {
+		return v.Primary
+	}
+
+	return
+}
+
+// IsSetPrimary returns true if Primary is not nil.
+func (v *Order) IsSetPrimary() bool {
+	return v != nil && v.Primary != nil
+}
+
+type OrderError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a OrderError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *OrderError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a OrderError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a OrderError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v OrderError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *OrderError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of OrderError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a OrderError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a OrderError struct could not be encoded.
+//
+// This also encodes the struct with ToWire and reports differences
+// between the two to the dualencode package.
+func (v *OrderError) Encode(sw stream.Writer) error {
+	return dualencode.Encode(sw, "OrderError", v.ToWire, v.encode)
+}
+
+// encode serializes a OrderError struct directly into bytes.
+func (v *OrderError) encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a OrderError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a OrderError struct could not be generated from the wire
+// representation.
+func (v *OrderError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of OrderError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a OrderError
+// struct.
+func (v *OrderError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("OrderError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*OrderError) ErrorName() string {
+	return "OrderError"
+}
+
+// Equals returns true if all the fields of this OrderError match the
+// provided OrderError.
+//
+// This function performs a deep comparison.
+func (v *OrderError) Equals(rhs *OrderError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this OrderError.
+func (v *OrderError) Copy() *OrderError {
+	if v == nil {
+		return nil
+	}
+
+	var o OrderError
+	o.Message = v.Message
+	return &o
+}
+
+// Hash returns a hash of this OrderError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *OrderError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this OrderError so that it may be reused.
+func (v *OrderError) Reset() {
+	*v = OrderError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of OrderError.
+func (v *OrderError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *OrderError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *OrderError) Error() string {
+	return v.String()
+}
+
+type Payment struct {
+	Card    *string `json:"card,omitempty"`
+	Voucher *string `json:"voucher,omitempty"`
+}
+
+// ToWire translates a Payment struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Payment) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Card != nil {
+		w, err = wire.NewValueString(*(v.Card)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Voucher != nil {
+		w, err = wire.NewValueString(*(v.Voucher)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Payment should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Payment struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Payment struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Payment
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Payment) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Card = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Voucher = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Card != nil {
+		count++
+	}
+	if v.Voucher != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Payment should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Payment struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Payment struct could not be encoded.
+//
+// This also encodes the struct with ToWire and reports differences
+// between the two to the dualencode package.
+func (v *Payment) Encode(sw stream.Writer) error {
+	return dualencode.Encode(sw, "Payment", v.ToWire, v.encode)
+}
+
+// encode serializes a Payment struct directly into bytes.
+func (v *Payment) encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Card != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Card)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Voucher != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Voucher)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Card != nil {
+		count++
+	}
+	if v.Voucher != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Payment should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Payment struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Payment struct could not be generated from the wire
+// representation.
+func (v *Payment) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Card = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Voucher = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Card != nil {
+		count++
+	}
+	if v.Voucher != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Payment should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Payment
+// struct.
+func (v *Payment) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Card != nil {
+		fields[i] = fmt.Sprintf("Card: %v", *(v.Card))
+		i++
+	}
+	if v.Voucher != nil {
+		fields[i] = fmt.Sprintf("Voucher: %v", *(v.Voucher))
+		i++
+	}
+
+	return fmt.Sprintf("Payment{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Payment match the
+// provided Payment.
+//
+// This function performs a deep comparison.
+func (v *Payment) Equals(rhs *Payment) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Card, rhs.Card) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Voucher, rhs.Voucher) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Payment.
+func (v *Payment) Copy() *Payment {
+	if v == nil {
+		return nil
+	}
+
+	var o Payment
+	o.Card = _String_CopyPtr(v.Card)
+	o.Voucher = _String_CopyPtr(v.Voucher)
+	return &o
+}
+
+// Hash returns a hash of this Payment which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Payment) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Card != nil {
+		h.Field(1)
+		h.String(*v.Card)
+	}
+	if v.Voucher != nil {
+		h.Field(2)
+		h.String(*v.Voucher)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Payment so that it may be reused.
+func (v *Payment) Reset() {
+	*v = Payment{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Payment.
+func (v *Payment) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Card != nil {
+		enc.AddString("card", *v.Card)
+	}
+	if v.Voucher != nil {
+		enc.AddString("voucher", *v.Voucher)
+	}
+	return err
+}
+
+// GetCard returns the value of Card if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetCard() (o string) {
+	if v != nil && v.Card != nil {
+		return *v.Card
+	}
+
+	return
+}
+
+// IsSetCard returns true if Card is not nil.
+func (v *Payment) IsSetCard() bool {
+	return v != nil && v.Card != nil
+}
+
+// LookupCard returns the value of Card and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Payment) LookupCard() (o string, _ bool) {
+	if v != nil && v.Card != nil {
+		return *v.Card, true
+	}
+	return o, false
+}
+
+// SetCard sets the value of Card and unsets all other
+// fields of this Payment.
+func (v *Payment) SetCard(x string) {
+	*v = Payment{}
+	v.Card = &x
+}
+
+// GetVoucher returns the value of Voucher if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetVoucher() (o string) {
+	if v != nil && v.Voucher != nil {
+		return *v.Voucher
+	}
+
+	return
+}
+
+// IsSetVoucher returns true if Voucher is not nil.
+func (v *Payment) IsSetVoucher() bool {
+	return v != nil && v.Voucher != nil
+}
+
+// LookupVoucher returns the value of Voucher and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Payment) LookupVoucher() (o string, _ bool) {
+	if v != nil && v.Voucher != nil {
+		return *v.Voucher, true
+	}
+	return o, false
+}
+
+// SetVoucher sets the value of Voucher and unsets all other
+// fields of this Payment.
+func (v *Payment) SetVoucher(x string) {
+	*v = Payment{}
+	v.Voucher = &x
+}
+
+// Which returns the ID of the field of this Payment which is set, or
+// 0 if no field is set.
+func (v *Payment) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Card != nil {
+		return 1
+	}
+	if v.Voucher != nil {
+		return 2
+	}
+	return 0
+}
+
+// Payment_Visitor visits the field of a Payment which is set.
+//
+// Fields added to Payment add methods to Payment_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Payment_Visitor interface {
+	// VisitCard is called with the value of Card if it is set.
+	VisitCard(string) error
+
+	// VisitVoucher is called with the value of Voucher if it is set.
+	VisitVoucher(string) error
+
+	// Default is called if no field of Payment is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Payment
+// which is set, or Default if none is, and returns its error.
+func (v *Payment) Match(visitor Payment_Visitor) error {
+	if v != nil {
+		if v.Card != nil {
+			return visitor.VisitCard(*v.Card)
+		}
+		if v.Voucher != nil {
+			return visitor.VisitVoucher(*v.Voucher)
+		}
+	}
+	return visitor.Default()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "dual-encode",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/dual-encode",
+	FilePath: "dual-encode.thrift",
+	SHA1:     "953fe04e9fa2c0d2abaeadf4585a457c9328f0a5",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Item {\n    1: required string name\n    2: optional i32 count\n}\n\nstruct Order {\n    1: required string id\n    2: optional list<Item> items\n    3: optional map<string, i64> totals\n    4: optional set<string> tags\n    5: optional Item primary\n}\n\nunion Payment {\n    1: string card\n    2: string voucher\n}\n\nexception OrderError {\n    1: required string message\n}\n\nservice Orders {\n    Order getOrder(1: string id) throws (1: OrderError notFound)\n}\n"
+
+// Orders_GetOrder_Args represents the arguments for the Orders.getOrder function.
+//
+// The arguments for getOrder are sent and received over the wire as this struct.
+type Orders_GetOrder_Args struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// ToWire translates a Orders_GetOrder_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Orders_GetOrder_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Orders_GetOrder_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Orders_GetOrder_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Orders_GetOrder_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Orders_GetOrder_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Orders_GetOrder_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Orders_GetOrder_Args struct could not be encoded.
+//
+// This also encodes the struct with ToWire and reports differences
+// between the two to the dualencode package.
+func (v *Orders_GetOrder_Args) Encode(sw stream.Writer) error {
+	return dualencode.Encode(sw, "Orders_GetOrder_Args", v.ToWire, v.encode)
+}
+
+// encode serializes a Orders_GetOrder_Args struct directly into bytes.
+func (v *Orders_GetOrder_Args) encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Orders_GetOrder_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Orders_GetOrder_Args struct could not be generated from the wire
+// representation.
+func (v *Orders_GetOrder_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Orders_GetOrder_Args
+// struct.
+func (v *Orders_GetOrder_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+
+	return fmt.Sprintf("Orders_GetOrder_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Orders_GetOrder_Args match the
+// provided Orders_GetOrder_Args.
+//
+// This function performs a deep comparison.
+func (v *Orders_GetOrder_Args) Equals(rhs *Orders_GetOrder_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Orders_GetOrder_Args.
+func (v *Orders_GetOrder_Args) Copy() *Orders_GetOrder_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Orders_GetOrder_Args
+	o.ID = _String_CopyPtr(v.ID)
+	return &o
+}
+
+// Hash returns a hash of this Orders_GetOrder_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Orders_GetOrder_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.ID != nil {
+		h.Field(1)
+		h.String(*v.ID)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Orders_GetOrder_Args so that it may be reused.
+func (v *Orders_GetOrder_Args) Reset() {
+	*v = Orders_GetOrder_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Orders_GetOrder_Args.
+func (v *Orders_GetOrder_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Orders_GetOrder_Args) GetID() (o string) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Orders_GetOrder_Args) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getOrder" for this struct.
+func (v *Orders_GetOrder_Args) MethodName() string {
+	return "getOrder"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Orders_GetOrder_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Orders_GetOrder_Helper provides functions that aid in handling the
+// parameters and return values of the Orders.getOrder
+// function.
+var Orders_GetOrder_Helper = struct {
+	// Args accepts the parameters of getOrder in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id *string,
+	) *Orders_GetOrder_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getOrder.
+	//
+	// An error can be thrown by getOrder only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getOrder
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getOrder into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getOrder
+	//
+	//   value, err := getOrder(args)
+	//   result, err := Orders_GetOrder_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getOrder: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Order, error) (*Orders_GetOrder_Result, error)
+
+	// UnwrapResponse takes the result struct for getOrder
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getOrder threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Orders_GetOrder_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Orders_GetOrder_Result) (*Order, error)
+}{}
+
+func init() {
+	Orders_GetOrder_Helper.Args = func(
+		id *string,
+	) *Orders_GetOrder_Args {
+		return &Orders_GetOrder_Args{
+			ID: id,
+		}
+	}
+
+	Orders_GetOrder_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *OrderError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Orders_GetOrder_Helper.WrapResponse = func(success *Order, err error) (*Orders_GetOrder_Result, error) {
+		if err == nil {
+			return &Orders_GetOrder_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *OrderError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Orders_GetOrder_Result.NotFound")
+			}
+			return &Orders_GetOrder_Result{NotFound: e}, nil
+		}
+
+		return nil, err
+	}
+	Orders_GetOrder_Helper.UnwrapResponse = func(result *Orders_GetOrder_Result) (success *Order, err error) {
+		if result.NotFound != nil {
+			err = result.NotFound
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Orders_GetOrder_Result represents the result of a Orders.getOrder function call.
+//
+// The result of a getOrder execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Orders_GetOrder_Result struct {
+	// Value returned by getOrder after a successful execution.
+	Success  *Order      `json:"success,omitempty"`
+	NotFound *OrderError `json:"notFound,omitempty"`
+}
+
+// ToWire translates a Orders_GetOrder_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Orders_GetOrder_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.NotFound != nil {
+		w, err = v.NotFound.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Orders_GetOrder_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Order_Read(w wire.Value) (*Order, error) {
+	var v Order
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _OrderError_Read(w wire.Value) (*OrderError, error) {
+	var v OrderError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Orders_GetOrder_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Orders_GetOrder_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Orders_GetOrder_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Orders_GetOrder_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Order_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.NotFound, err = _OrderError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Orders_GetOrder_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Orders_GetOrder_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Orders_GetOrder_Result struct could not be encoded.
+//
+// This also encodes the struct with ToWire and reports differences
+// between the two to the dualencode package.
+func (v *Orders_GetOrder_Result) Encode(sw stream.Writer) error {
+	return dualencode.Encode(sw, "Orders_GetOrder_Result", v.ToWire, v.encode)
+}
+
+// encode serializes a Orders_GetOrder_Result struct directly into bytes.
+func (v *Orders_GetOrder_Result) encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NotFound != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NotFound.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Orders_GetOrder_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Order_Decode(sr stream.Reader) (*Order, error) {
+	var v Order
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _OrderError_Decode(sr stream.Reader) (*OrderError, error) {
+	var v OrderError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Orders_GetOrder_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Orders_GetOrder_Result struct could not be generated from the wire
+// representation.
+func (v *Orders_GetOrder_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Order_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.NotFound, err = _OrderError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Orders_GetOrder_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Orders_GetOrder_Result
+// struct.
+func (v *Orders_GetOrder_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.NotFound != nil {
+		fields[i] = fmt.Sprintf("NotFound: %v", v.NotFound)
+		i++
+	}
+
+	return fmt.Sprintf("Orders_GetOrder_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Orders_GetOrder_Result match the
+// provided Orders_GetOrder_Result.
+//
+// This function performs a deep comparison.
+func (v *Orders_GetOrder_Result) Equals(rhs *Orders_GetOrder_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.NotFound == nil && rhs.NotFound == nil) || (v.NotFound != nil && rhs.NotFound != nil && v.NotFound.Equals(rhs.NotFound))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Orders_GetOrder_Result.
+func (v *Orders_GetOrder_Result) Copy() *Orders_GetOrder_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Orders_GetOrder_Result
+	o.Success = v.Success.Copy()
+	o.NotFound = v.NotFound.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Orders_GetOrder_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Orders_GetOrder_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.NotFound.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Orders_GetOrder_Result so that it may be reused.
+func (v *Orders_GetOrder_Result) Reset() {
+	*v = Orders_GetOrder_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Orders_GetOrder_Result.
+func (v *Orders_GetOrder_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.NotFound != nil {
+		err = multierr.Append(err, enc.AddObject("notFound", v.NotFound))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Orders_GetOrder_Result) GetSuccess() (o *Order) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Orders_GetOrder_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetNotFound returns the value of NotFound if it is set or its
+// zero value if it is unset.
+func (v *Orders_GetOrder_Result) GetNotFound() (o *OrderError) {
+	if v != nil && v.NotFound != nil {
+		return v.NotFound
+	}
+
+	return
+}
+
+// IsSetNotFound returns true if NotFound is not nil.
+func (v *Orders_GetOrder_Result) IsSetNotFound() bool {
+	return v != nil && v.NotFound != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getOrder" for this struct.
+func (v *Orders_GetOrder_Result) MethodName() string {
+	return "getOrder"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Orders_GetOrder_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
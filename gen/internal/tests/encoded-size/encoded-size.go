@@ -0,0 +1,10088 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package encoded_size
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	domain "go.uber.org/thriftrw/gen/internal/tests/domain"
+	ordered "go.uber.org/thriftrw/gen/internal/tests/ordered"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thriftcrypt "go.uber.org/thriftrw/thriftcrypt"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+	time "time"
+)
+
+type Color int32
+
+const (
+	ColorRed   Color = 0
+	ColorGreen Color = 1
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+	}
+}
+
+// Color_Names returns the names of all recognized values of
+// Color, in the same order as Color_Values.
+func Color_Names() []string {
+	return []string{
+		"RED",
+		"GREEN",
+	}
+}
+
+// IsValid returns true if this Color is one of its recognized
+// values.
+func (v Color) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "GREEN":
+		*v = ColorGreen
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Color", err)
+		}
+		*v = Color(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Color to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Color) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("RED"), nil
+	case 1:
+		return []byte("GREEN"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "GREEN")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Color) Ptr() *Color {
+	return &v
+}
+
+// Set sets Color from its name or integer value.
+//
+// This implements flag.Value, allowing Color to be used as a
+// command line flag.
+func (v *Color) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Color) Type() string {
+	return "Color"
+}
+
+// Encode encodes Color directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Color
+//   return v.Encode(sWriter)
+func (v Color) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Color directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Color
+//   if err := v.Decode(sReader); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Color)(i)
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "GREEN"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"GREEN\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+// EncodedSize returns the number of bytes in the encoding of a
+// Color with the Binary protocol.
+func (Color) EncodedSize() int {
+	return 4
+}
+
+type Containers struct {
+	Ints     []int32             `json:"ints,omitempty"`
+	Strings  []string            `json:"strings,omitempty"`
+	Points   []*Point            `json:"points,omitempty"`
+	Longs    map[int64]struct{}  `json:"longs,omitempty"`
+	Names    map[string]struct{} `json:"names,omitempty"`
+	PointSet []*Point            `json:"pointSet,omitempty"`
+	Fixed    map[int32]int64     `json:"fixed,omitempty"`
+	ByName   map[string]int32    `json:"byName,omitempty"`
+	ByID     map[int32]string    `json:"byID,omitempty"`
+	Nested   map[string][]string `json:"nested,omitempty"`
+	Labels   []struct {
+		Key   *Point
+		Value string
+	} `json:"labels,omitempty"`
+	Ordered      *ordered.StringInt32Map `json:"ordered,omitempty"`
+	OrderedNames *ordered.Int32StringMap `json:"orderedNames,omitempty"`
+	Pairs        []struct {
+		Key   string
+		Value int32
+	} `json:"pairs,omitempty"`
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+type _Set_I64_mapType_ValueList map[int64]struct{}
+
+func (v _Set_I64_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueI64(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_I64_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_I64_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Set_I64_mapType_ValueList) Close() {}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+type _Set_Point_sliceType_ValueList []*Point
+
+func (v _Set_Point_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set '*Point': contains nil value")
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Point_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Point_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_Point_sliceType_ValueList) Close() {}
+
+type _Map_I32_I64_MapItemList map[int32]int64
+
+func (m _Map_I32_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_I64_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_I32_I64_MapItemList) Close() {}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+type _Map_I32_String_MapItemList map[int32]string
+
+func (m _Map_I32_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_String_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_I32_String_MapItemList) Close() {}
+
+type _Map_String_List_String_MapItemList map[string][]string
+
+func (m _Map_String_List_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]string', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueList(_List_String_ValueList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_List_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_List_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_List_String_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_List_String_MapItemList) Close() {}
+
+type _Map_Point_String_MapItemList []struct {
+	Key   *Point
+	Value string
+}
+
+func (m _Map_Point_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Point_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Point_String_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Point_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Point_String_MapItemList) Close() {}
+
+type _Map_String_I32_ordered_StringInt32Map_MapItemList struct{ m *ordered.StringInt32Map }
+
+func (m _Map_String_I32_ordered_StringInt32Map_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	return m.m.ForEach(func(k string, v int32) error {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		return f(wire.MapItem{Key: kw, Value: vw})
+	})
+}
+
+func (m _Map_String_I32_ordered_StringInt32Map_MapItemList) Size() int {
+	return m.m.Len()
+}
+
+func (_Map_String_I32_ordered_StringInt32Map_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_ordered_StringInt32Map_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_ordered_StringInt32Map_MapItemList) Close() {}
+
+type _Map_I32_String_ordered_Int32StringMap_MapItemList struct{ m *ordered.Int32StringMap }
+
+func (m _Map_I32_String_ordered_Int32StringMap_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	return m.m.ForEach(func(k int32, v string) error {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		return f(wire.MapItem{Key: kw, Value: vw})
+	})
+}
+
+func (m _Map_I32_String_ordered_Int32StringMap_MapItemList) Size() int {
+	return m.m.Len()
+}
+
+func (_Map_I32_String_ordered_Int32StringMap_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_String_ordered_Int32StringMap_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_I32_String_ordered_Int32StringMap_MapItemList) Close() {}
+
+type _Map_String_I32_sliceType_MapItemList []struct {
+	Key   string
+	Value int32
+}
+
+func (m _Map_String_I32_sliceType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_sliceType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_sliceType_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_sliceType_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_sliceType_MapItemList) Close() {}
+
+// ToWire translates a Containers struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Containers) ToWire() (wire.Value, error) {
+	var (
+		fields [14]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Ints != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.Ints)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Strings != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Strings)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Longs != nil {
+		w, err = wire.NewValueSet(_Set_I64_mapType_ValueList(v.Longs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Names != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Names)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.PointSet != nil {
+		w, err = wire.NewValueSet(_Set_Point_sliceType_ValueList(v.PointSet)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Fixed != nil {
+		w, err = wire.NewValueMap(_Map_I32_I64_MapItemList(v.Fixed)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.ByName != nil {
+		w, err = wire.NewValueMap(_Map_String_I32_MapItemList(v.ByName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.ByID != nil {
+		w, err = wire.NewValueMap(_Map_I32_String_MapItemList(v.ByID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Nested != nil {
+		w, err = wire.NewValueMap(_Map_String_List_String_MapItemList(v.Nested)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_Point_String_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.Ordered != nil {
+		w, err = wire.NewValueMap(_Map_String_I32_ordered_StringInt32Map_MapItemList{v.Ordered}), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.OrderedNames != nil {
+		w, err = wire.NewValueMap(_Map_I32_String_ordered_Int32StringMap_MapItemList{v.OrderedNames}), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 13, Value: w}
+		i++
+	}
+	if v.Pairs != nil {
+		w, err = wire.NewValueMap(_Map_String_I32_sliceType_MapItemList(v.Pairs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_I64_mapType_Read(s wire.ValueList) (map[int64]struct{}, error) {
+	if s.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[int64]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_Point_sliceType_Read(s wire.ValueList) ([]*Point, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_I32_I64_Read(m wire.MapItemList) (map[int32]int64, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[int32]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_I32_String_Read(m wire.MapItemList) (map[int32]string, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[int32]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_List_String_Read(m wire.MapItemList) (map[string][]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string][]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _List_String_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_Point_String_Read(m wire.MapItemList) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Point_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Read(m wire.MapItemList) (*ordered.StringInt32Map, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := new(ordered.StringInt32Map)
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o.Put(k, v)
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Read(m wire.MapItemList) (*ordered.Int32StringMap, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := new(ordered.Int32StringMap)
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o.Put(k, v)
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I32_sliceType_Read(m wire.MapItemList) ([]struct {
+	Key   string
+	Value int32
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int32
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value int32
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Containers struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Containers struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Containers
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Containers) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Ints, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Strings, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TSet {
+				v.Longs, err = _Set_I64_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Names, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.PointSet, err = _Set_Point_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Fixed, err = _Map_I32_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.ByName, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TMap {
+				v.ByID, err = _Map_I32_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.Nested, err = _Map_String_List_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_Point_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TMap {
+				v.Ordered, err = _Map_String_I32_ordered_StringInt32Map_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 13:
+			if field.Value.Type() == wire.TMap {
+				v.OrderedNames, err = _Map_I32_String_ordered_Int32StringMap_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 14:
+			if field.Value.Type() == wire.TMap {
+				v.Pairs, err = _Map_String_I32_sliceType_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_I32_Encode(val []int32, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []int32
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteInt32(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Set_I64_mapType_Encode(val map[int64]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI64,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Set_Point_sliceType_Encode(val []*Point, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid set '*Point': contains nil value")
+		}
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_I32_I64_Encode(val map[int32]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I32_Encode(val map[string]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_I32_String_Encode(val map[int32]string, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_List_String_Encode(val map[string][]string, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TList,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]string', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_Point_String_Encode(val []struct {
+	Key   *Point
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TStruct,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		if err := key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Encode(val *ordered.StringInt32Map, sw stream.Writer) error {
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    val.Len(),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	err := val.ForEach(func(k string, v int32) error {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		return sw.WriteInt32(v)
+	})
+	if err != nil {
+		return err
+	}
+	return sw.WriteMapEnd()
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Encode(val *ordered.Int32StringMap, sw stream.Writer) error {
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TBinary,
+		Length:    val.Len(),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	err := val.ForEach(func(k int32, v string) error {
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		return sw.WriteString(v)
+	})
+	if err != nil {
+		return err
+	}
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I32_sliceType_Encode(val []struct {
+	Key   string
+	Value int32
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if err := sw.WriteString(key); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Containers struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Containers struct could not be encoded.
+func (v *Containers) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Ints != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I32_Encode(v.Ints, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Strings != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Strings, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Points, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Longs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_I64_mapType_Encode(v.Longs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Names != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Names, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PointSet != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_Point_sliceType_Encode(v.PointSet, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Fixed != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_I64_Encode(v.Fixed, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ByName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_Encode(v.ByName, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ByID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_String_Encode(v.ByID, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Nested != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_List_String_Encode(v.Nested, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Point_String_Encode(v.Labels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Ordered != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_ordered_StringInt32Map_Encode(v.Ordered, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OrderedNames != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 13, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_String_ordered_Int32StringMap_Encode(v.OrderedNames, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Pairs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 14, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_sliceType_Encode(v.Pairs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int32, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_I64_mapType_Decode(sr stream.Reader) (map[int64]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI64 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[int64]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_Point_sliceType_Decode(sr stream.Reader) ([]*Point, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TStruct {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([]*Point, 0, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I32_I64_Decode(sr stream.Reader) (map[int32]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[int32]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_Decode(sr stream.Reader) (map[string]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I32_String_Decode(sr stream.Reader) (map[int32]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[int32]string, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_List_String_Decode(sr stream.Reader) (map[string][]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TList {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string][]string, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _List_String_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Point_String_Decode(sr stream.Reader) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Decode(sr stream.Reader) (*ordered.StringInt32Map, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := new(ordered.StringInt32Map)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o.Put(k, v)
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Decode(sr stream.Reader) (*ordered.Int32StringMap, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := new(ordered.Int32StringMap)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o.Put(k, v)
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_sliceType_Decode(sr stream.Reader) ([]struct {
+	Key   string
+	Value int32
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int32
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value int32
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Containers struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Containers struct could not be generated from the wire
+// representation.
+func (v *Containers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Ints, err = _List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Strings, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TSet:
+			v.Longs, err = _Set_I64_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TSet:
+			v.Names, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.PointSet, err = _Set_Point_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Fixed, err = _Map_I32_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.ByName, err = _Map_String_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TMap:
+			v.ByID, err = _Map_I32_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TMap:
+			v.Nested, err = _Map_String_List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 11 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_Point_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 12 && fh.Type == wire.TMap:
+			v.Ordered, err = _Map_String_I32_ordered_StringInt32Map_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 13 && fh.Type == wire.TMap:
+			v.OrderedNames, err = _Map_I32_String_ordered_Int32StringMap_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 14 && fh.Type == wire.TMap:
+			v.Pairs, err = _Map_String_I32_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Containers
+// struct.
+func (v *Containers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [14]string
+	i := 0
+	if v.Ints != nil {
+		fields[i] = fmt.Sprintf("Ints: %v", v.Ints)
+		i++
+	}
+	if v.Strings != nil {
+		fields[i] = fmt.Sprintf("Strings: %v", v.Strings)
+		i++
+	}
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	if v.Longs != nil {
+		fields[i] = fmt.Sprintf("Longs: %v", v.Longs)
+		i++
+	}
+	if v.Names != nil {
+		fields[i] = fmt.Sprintf("Names: %v", v.Names)
+		i++
+	}
+	if v.PointSet != nil {
+		fields[i] = fmt.Sprintf("PointSet: %v", v.PointSet)
+		i++
+	}
+	if v.Fixed != nil {
+		fields[i] = fmt.Sprintf("Fixed: %v", v.Fixed)
+		i++
+	}
+	if v.ByName != nil {
+		fields[i] = fmt.Sprintf("ByName: %v", v.ByName)
+		i++
+	}
+	if v.ByID != nil {
+		fields[i] = fmt.Sprintf("ByID: %v", v.ByID)
+		i++
+	}
+	if v.Nested != nil {
+		fields[i] = fmt.Sprintf("Nested: %v", v.Nested)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+	if v.Ordered != nil {
+		fields[i] = fmt.Sprintf("Ordered: %v", v.Ordered)
+		i++
+	}
+	if v.OrderedNames != nil {
+		fields[i] = fmt.Sprintf("OrderedNames: %v", v.OrderedNames)
+		i++
+	}
+	if v.Pairs != nil {
+		fields[i] = fmt.Sprintf("Pairs: %v", v.Pairs)
+		i++
+	}
+
+	return fmt.Sprintf("Containers{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_I64_mapType_Equals(lhs, rhs map[int64]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Point_sliceType_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_I32_I64_Equals(lhs, rhs map[int32]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_I32_String_Equals(lhs, rhs map[int32]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_List_String_Equals(lhs, rhs map[string][]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_String_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Point_String_Equals(lhs, rhs []struct {
+	Key   *Point
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Equals(lhs, rhs *ordered.StringInt32Map) bool {
+	if lhs == nil || rhs == nil {
+		return (lhs == nil || lhs.Len() == 0) && (rhs == nil || rhs.Len() == 0)
+	}
+	if lhs.Len() != rhs.Len() {
+		return false
+	}
+
+	equal := true
+	_ = lhs.ForEach(func(k string, lv int32) error {
+		rv, ok := rhs.Get(k)
+		if !ok || !(lv == rv) {
+			equal = false
+			return errors.New("maps are not equal")
+		}
+		return nil
+	})
+	return equal
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Equals(lhs, rhs *ordered.Int32StringMap) bool {
+	if lhs == nil || rhs == nil {
+		return (lhs == nil || lhs.Len() == 0) && (rhs == nil || rhs.Len() == 0)
+	}
+	if lhs.Len() != rhs.Len() {
+		return false
+	}
+
+	equal := true
+	_ = lhs.ForEach(func(k int32, lv string) error {
+		rv, ok := rhs.Get(k)
+		if !ok || !(lv == rv) {
+			equal = false
+			return errors.New("maps are not equal")
+		}
+		return nil
+	})
+	return equal
+}
+
+func _Map_String_I32_sliceType_Equals(lhs, rhs []struct {
+	Key   string
+	Value int32
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !(lk == rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Containers match the
+// provided Containers.
+//
+// This function performs a deep comparison.
+func (v *Containers) Equals(rhs *Containers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Ints == nil && rhs.Ints == nil) || (v.Ints != nil && rhs.Ints != nil && _List_I32_Equals(v.Ints, rhs.Ints))) {
+		return false
+	}
+	if !((v.Strings == nil && rhs.Strings == nil) || (v.Strings != nil && rhs.Strings != nil && _List_String_Equals(v.Strings, rhs.Strings))) {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !((v.Longs == nil && rhs.Longs == nil) || (v.Longs != nil && rhs.Longs != nil && _Set_I64_mapType_Equals(v.Longs, rhs.Longs))) {
+		return false
+	}
+	if !((v.Names == nil && rhs.Names == nil) || (v.Names != nil && rhs.Names != nil && _Set_String_mapType_Equals(v.Names, rhs.Names))) {
+		return false
+	}
+	if !((v.PointSet == nil && rhs.PointSet == nil) || (v.PointSet != nil && rhs.PointSet != nil && _Set_Point_sliceType_Equals(v.PointSet, rhs.PointSet))) {
+		return false
+	}
+	if !((v.Fixed == nil && rhs.Fixed == nil) || (v.Fixed != nil && rhs.Fixed != nil && _Map_I32_I64_Equals(v.Fixed, rhs.Fixed))) {
+		return false
+	}
+	if !((v.ByName == nil && rhs.ByName == nil) || (v.ByName != nil && rhs.ByName != nil && _Map_String_I32_Equals(v.ByName, rhs.ByName))) {
+		return false
+	}
+	if !((v.ByID == nil && rhs.ByID == nil) || (v.ByID != nil && rhs.ByID != nil && _Map_I32_String_Equals(v.ByID, rhs.ByID))) {
+		return false
+	}
+	if !((v.Nested == nil && rhs.Nested == nil) || (v.Nested != nil && rhs.Nested != nil && _Map_String_List_String_Equals(v.Nested, rhs.Nested))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_Point_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+	if !((v.Ordered == nil && rhs.Ordered == nil) || (v.Ordered != nil && rhs.Ordered != nil && _Map_String_I32_ordered_StringInt32Map_Equals(v.Ordered, rhs.Ordered))) {
+		return false
+	}
+	if !((v.OrderedNames == nil && rhs.OrderedNames == nil) || (v.OrderedNames != nil && rhs.OrderedNames != nil && _Map_I32_String_ordered_Int32StringMap_Equals(v.OrderedNames, rhs.OrderedNames))) {
+		return false
+	}
+	if !((v.Pairs == nil && rhs.Pairs == nil) || (v.Pairs != nil && rhs.Pairs != nil && _Map_String_I32_sliceType_Equals(v.Pairs, rhs.Pairs))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Set_I64_mapType_Copy(v map[int64]struct{}) map[int64]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Set_Point_sliceType_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_I32_I64_Copy(v map[int32]int64) map[int32]int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]int64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_I32_Copy(v map[string]int32) map[string]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_I32_String_Copy(v map[int32]string) map[int32]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_List_String_Copy(v map[string][]string) map[string][]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]string, len(v))
+	for k, x := range v {
+		o[k] = _List_String_Copy(x)
+	}
+	return o
+}
+
+func _Map_Point_String_Copy(v []struct {
+	Key   *Point
+	Value string
+}) []struct {
+	Key   *Point
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Copy(v *ordered.StringInt32Map) *ordered.StringInt32Map {
+	if v == nil {
+		return nil
+	}
+
+	o := new(ordered.StringInt32Map)
+	_ = v.ForEach(func(k string, x int32) error {
+		o.Put(k, x)
+		return nil
+	})
+	return o
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Copy(v *ordered.Int32StringMap) *ordered.Int32StringMap {
+	if v == nil {
+		return nil
+	}
+
+	o := new(ordered.Int32StringMap)
+	_ = v.ForEach(func(k int32, x string) error {
+		o.Put(k, x)
+		return nil
+	})
+	return o
+}
+
+func _Map_String_I32_sliceType_Copy(v []struct {
+	Key   string
+	Value int32
+}) []struct {
+	Key   string
+	Value int32
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int32
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Containers.
+func (v *Containers) Copy() *Containers {
+	if v == nil {
+		return nil
+	}
+
+	var o Containers
+	o.Ints = _List_I32_Copy(v.Ints)
+	o.Strings = _List_String_Copy(v.Strings)
+	o.Points = _List_Point_Copy(v.Points)
+	o.Longs = _Set_I64_mapType_Copy(v.Longs)
+	o.Names = _Set_String_mapType_Copy(v.Names)
+	o.PointSet = _Set_Point_sliceType_Copy(v.PointSet)
+	o.Fixed = _Map_I32_I64_Copy(v.Fixed)
+	o.ByName = _Map_String_I32_Copy(v.ByName)
+	o.ByID = _Map_I32_String_Copy(v.ByID)
+	o.Nested = _Map_String_List_String_Copy(v.Nested)
+	o.Labels = _Map_Point_String_Copy(v.Labels)
+	o.Ordered = _Map_String_I32_ordered_StringInt32Map_Copy(v.Ordered)
+	o.OrderedNames = _Map_I32_String_ordered_Int32StringMap_Copy(v.OrderedNames)
+	o.Pairs = _Map_String_I32_sliceType_Copy(v.Pairs)
+	return &o
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Set_I64_mapType_Hash(v map[int64]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Point_sliceType_Hash(v []*Point) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_I32_I64_Hash(v map[int32]int64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(k)
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_I32_Hash(v map[string]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_I32_String_Hash(v map[int32]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_List_String_Hash(v map[string][]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(_List_String_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Point_String_Hash(v []struct {
+	Key   *Point
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Hash(v *ordered.StringInt32Map) uint64 {
+
+	var u thrifthash.Unordered
+	if v != nil {
+		_ = v.ForEach(func(k string, x int32) error {
+			h := thrifthash.New()
+			h.String(k)
+			h.Int32(x)
+			u.Add(h.Sum64())
+			return nil
+		})
+	}
+	return u.Sum64()
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Hash(v *ordered.Int32StringMap) uint64 {
+
+	var u thrifthash.Unordered
+	if v != nil {
+		_ = v.ForEach(func(k int32, x string) error {
+			h := thrifthash.New()
+			h.Int32(k)
+			h.String(x)
+			u.Add(h.Sum64())
+			return nil
+		})
+	}
+	return u.Sum64()
+}
+
+func _Map_String_I32_sliceType_Hash(v []struct {
+	Key   string
+	Value int32
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.String(x.Key)
+		h.Int32(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Containers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Containers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_I32_Hash(v.Ints))
+	h.Field(2)
+	h.Uint64(_List_String_Hash(v.Strings))
+	h.Field(3)
+	h.Uint64(_List_Point_Hash(v.Points))
+	h.Field(4)
+	h.Uint64(_Set_I64_mapType_Hash(v.Longs))
+	h.Field(5)
+	h.Uint64(_Set_String_mapType_Hash(v.Names))
+	h.Field(6)
+	h.Uint64(_Set_Point_sliceType_Hash(v.PointSet))
+	h.Field(7)
+	h.Uint64(_Map_I32_I64_Hash(v.Fixed))
+	h.Field(8)
+	h.Uint64(_Map_String_I32_Hash(v.ByName))
+	h.Field(9)
+	h.Uint64(_Map_I32_String_Hash(v.ByID))
+	h.Field(10)
+	h.Uint64(_Map_String_List_String_Hash(v.Nested))
+	h.Field(11)
+	h.Uint64(_Map_Point_String_Hash(v.Labels))
+	h.Field(12)
+	h.Uint64(_Map_String_I32_ordered_StringInt32Map_Hash(v.Ordered))
+	h.Field(13)
+	h.Uint64(_Map_I32_String_ordered_Int32StringMap_Hash(v.OrderedNames))
+	h.Field(14)
+	h.Uint64(_Map_String_I32_sliceType_Hash(v.Pairs))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Containers so that it may be reused.
+func (v *Containers) Reset() {
+	*v = Containers{}
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Set_I64_mapType_Zapper map[int64]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_I64_mapType_Zapper.
+func (s _Set_I64_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendInt64(v)
+	}
+	return err
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Set_Point_sliceType_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Point_sliceType_Zapper.
+func (s _Set_Point_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_I32_I64_Item_Zapper struct {
+	Key   int32
+	Value int64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Item_Zapper.
+func (v _Map_I32_I64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddInt64("value", v.Value)
+	return err
+}
+
+type _Map_I32_I64_Zapper map[int32]int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Zapper.
+func (m _Map_I32_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_I64_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return err
+}
+
+type _Map_I32_String_Item_Zapper struct {
+	Key   int32
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_Item_Zapper.
+func (v _Map_I32_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_I32_String_Zapper map[int32]string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_Zapper.
+func (m _Map_I32_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_String_List_String_Zapper map[string][]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_List_String_Zapper.
+func (m _Map_String_List_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddArray((string)(k), (_List_String_Zapper)(v)))
+	}
+	return err
+}
+
+type _Map_Point_String_Item_Zapper struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Item_Zapper.
+func (v _Map_Point_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Point_String_Zapper []struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Zapper.
+func (m _Map_Point_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Point_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_String_I32_ordered_StringInt32Map_Item_Zapper struct {
+	Key   string
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_ordered_StringInt32Map_Item_Zapper.
+func (v _Map_String_I32_ordered_StringInt32Map_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key)
+	enc.AddInt32("value", v.Value)
+	return err
+}
+
+type _Map_String_I32_ordered_StringInt32Map_Zapper struct{ m *ordered.StringInt32Map }
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_ordered_StringInt32Map_Zapper.
+func (m _Map_String_I32_ordered_StringInt32Map_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	if m.m == nil {
+		return nil
+	}
+	_ = m.m.ForEach(func(k string, v int32) error {
+		err = multierr.Append(err, enc.AppendObject(_Map_String_I32_ordered_StringInt32Map_Item_Zapper{Key: k, Value: v}))
+		return nil
+	})
+	return err
+}
+
+type _Map_I32_String_ordered_Int32StringMap_Item_Zapper struct {
+	Key   int32
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_ordered_Int32StringMap_Item_Zapper.
+func (v _Map_I32_String_ordered_Int32StringMap_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_I32_String_ordered_Int32StringMap_Zapper struct{ m *ordered.Int32StringMap }
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_ordered_Int32StringMap_Zapper.
+func (m _Map_I32_String_ordered_Int32StringMap_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	if m.m == nil {
+		return nil
+	}
+	_ = m.m.ForEach(func(k int32, v string) error {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_String_ordered_Int32StringMap_Item_Zapper{Key: k, Value: v}))
+		return nil
+	})
+	return err
+}
+
+type _Map_String_I32_sliceType_Item_Zapper struct {
+	Key   string
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_sliceType_Item_Zapper.
+func (v _Map_String_I32_sliceType_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key)
+	enc.AddInt32("value", v.Value)
+	return err
+}
+
+type _Map_String_I32_sliceType_Zapper []struct {
+	Key   string
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_sliceType_Zapper.
+func (m _Map_String_I32_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_String_I32_sliceType_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Containers.
+func (v *Containers) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Ints != nil {
+		err = multierr.Append(err, enc.AddArray("ints", (_List_I32_Zapper)(v.Ints)))
+	}
+	if v.Strings != nil {
+		err = multierr.Append(err, enc.AddArray("strings", (_List_String_Zapper)(v.Strings)))
+	}
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	}
+	if v.Longs != nil {
+		err = multierr.Append(err, enc.AddArray("longs", (_Set_I64_mapType_Zapper)(v.Longs)))
+	}
+	if v.Names != nil {
+		err = multierr.Append(err, enc.AddArray("names", (_Set_String_mapType_Zapper)(v.Names)))
+	}
+	if v.PointSet != nil {
+		err = multierr.Append(err, enc.AddArray("pointSet", (_Set_Point_sliceType_Zapper)(v.PointSet)))
+	}
+	if v.Fixed != nil {
+		err = multierr.Append(err, enc.AddArray("fixed", (_Map_I32_I64_Zapper)(v.Fixed)))
+	}
+	if v.ByName != nil {
+		err = multierr.Append(err, enc.AddObject("byName", (_Map_String_I32_Zapper)(v.ByName)))
+	}
+	if v.ByID != nil {
+		err = multierr.Append(err, enc.AddArray("byID", (_Map_I32_String_Zapper)(v.ByID)))
+	}
+	if v.Nested != nil {
+		err = multierr.Append(err, enc.AddObject("nested", (_Map_String_List_String_Zapper)(v.Nested)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_Point_String_Zapper)(v.Labels)))
+	}
+	if v.Ordered != nil {
+		err = multierr.Append(err, enc.AddArray("ordered", _Map_String_I32_ordered_StringInt32Map_Zapper{v.Ordered}))
+	}
+	if v.OrderedNames != nil {
+		err = multierr.Append(err, enc.AddArray("orderedNames", _Map_I32_String_ordered_Int32StringMap_Zapper{v.OrderedNames}))
+	}
+	if v.Pairs != nil {
+		err = multierr.Append(err, enc.AddArray("pairs", (_Map_String_I32_sliceType_Zapper)(v.Pairs)))
+	}
+	return err
+}
+
+// GetInts returns the value of Ints if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetInts() (o []int32) {
+	if v != nil && v.Ints != nil {
+		return v.Ints
+	}
+
+	return
+}
+
+// IsSetInts returns true if Ints is not nil.
+func (v *Containers) IsSetInts() bool {
+	return v != nil && v.Ints != nil
+}
+
+// GetStrings returns the value of Strings if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetStrings() (o []string) {
+	if v != nil && v.Strings != nil {
+		return v.Strings
+	}
+
+	return
+}
+
+// IsSetStrings returns true if Strings is not nil.
+func (v *Containers) IsSetStrings() bool {
+	return v != nil && v.Strings != nil
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Containers) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetLongs returns the value of Longs if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetLongs() (o map[int64]struct{}) {
+	if v != nil && v.Longs != nil {
+		return v.Longs
+	}
+
+	return
+}
+
+// IsSetLongs returns true if Longs is not nil.
+func (v *Containers) IsSetLongs() bool {
+	return v != nil && v.Longs != nil
+}
+
+// GetNames returns the value of Names if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetNames() (o map[string]struct{}) {
+	if v != nil && v.Names != nil {
+		return v.Names
+	}
+
+	return
+}
+
+// IsSetNames returns true if Names is not nil.
+func (v *Containers) IsSetNames() bool {
+	return v != nil && v.Names != nil
+}
+
+// GetPointSet returns the value of PointSet if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetPointSet() (o []*Point) {
+	if v != nil && v.PointSet != nil {
+		return v.PointSet
+	}
+
+	return
+}
+
+// IsSetPointSet returns true if PointSet is not nil.
+func (v *Containers) IsSetPointSet() bool {
+	return v != nil && v.PointSet != nil
+}
+
+// GetFixed returns the value of Fixed if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetFixed() (o map[int32]int64) {
+	if v != nil && v.Fixed != nil {
+		return v.Fixed
+	}
+
+	return
+}
+
+// IsSetFixed returns true if Fixed is not nil.
+func (v *Containers) IsSetFixed() bool {
+	return v != nil && v.Fixed != nil
+}
+
+// GetByName returns the value of ByName if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetByName() (o map[string]int32) {
+	if v != nil && v.ByName != nil {
+		return v.ByName
+	}
+
+	return
+}
+
+// IsSetByName returns true if ByName is not nil.
+func (v *Containers) IsSetByName() bool {
+	return v != nil && v.ByName != nil
+}
+
+// GetByID returns the value of ByID if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetByID() (o map[int32]string) {
+	if v != nil && v.ByID != nil {
+		return v.ByID
+	}
+
+	return
+}
+
+// IsSetByID returns true if ByID is not nil.
+func (v *Containers) IsSetByID() bool {
+	return v != nil && v.ByID != nil
+}
+
+// GetNested returns the value of Nested if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetNested() (o map[string][]string) {
+	if v != nil && v.Nested != nil {
+		return v.Nested
+	}
+
+	return
+}
+
+// IsSetNested returns true if Nested is not nil.
+func (v *Containers) IsSetNested() bool {
+	return v != nil && v.Nested != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetLabels() (o []struct {
+	Key   *Point
+	Value string
+}) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Containers) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetOrdered returns the value of Ordered if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetOrdered() (o *ordered.StringInt32Map) {
+	if v != nil && v.Ordered != nil {
+		return v.Ordered
+	}
+
+	return
+}
+
+// IsSetOrdered returns true if Ordered is not nil.
+func (v *Containers) IsSetOrdered() bool {
+	return v != nil && v.Ordered != nil
+}
+
+// GetOrderedNames returns the value of OrderedNames if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetOrderedNames() (o *ordered.Int32StringMap) {
+	if v != nil && v.OrderedNames != nil {
+		return v.OrderedNames
+	}
+
+	return
+}
+
+// IsSetOrderedNames returns true if OrderedNames is not nil.
+func (v *Containers) IsSetOrderedNames() bool {
+	return v != nil && v.OrderedNames != nil
+}
+
+// GetPairs returns the value of Pairs if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetPairs() (o []struct {
+	Key   string
+	Value int32
+}) {
+	if v != nil && v.Pairs != nil {
+		return v.Pairs
+	}
+
+	return
+}
+
+// IsSetPairs returns true if Pairs is not nil.
+func (v *Containers) IsSetPairs() bool {
+	return v != nil && v.Pairs != nil
+}
+
+func _List_I32_EncodedSize(v []int32) int {
+	return 5 + len(v)*4
+}
+
+func _List_String_EncodedSize(v []string) int {
+	n := 5
+	for _, x := range v {
+		n += 4 + len(x)
+	}
+	return n
+}
+
+func _List_Point_EncodedSize(v []*Point) int {
+	n := 5
+	for _, x := range v {
+		n += x.EncodedSize()
+	}
+	return n
+}
+
+func _Set_I64_mapType_EncodedSize(v map[int64]struct{}) int {
+	return 5 + len(v)*8
+}
+
+func _Set_String_mapType_EncodedSize(v map[string]struct{}) int {
+	n := 5
+	for x := range v {
+		n += 4 + len(x)
+	}
+	return n
+}
+
+func _Set_Point_sliceType_EncodedSize(v []*Point) int {
+	n := 5
+	for _, x := range v {
+		n += x.EncodedSize()
+	}
+	return n
+}
+
+func _Map_I32_I64_EncodedSize(v map[int32]int64) int {
+	return 6 + len(v)*(4+8)
+}
+
+func _Map_String_I32_EncodedSize(v map[string]int32) int {
+	n := 6 + len(v)*4
+	for k := range v {
+		n += 4 + len(k)
+	}
+	return n
+}
+
+func _Map_I32_String_EncodedSize(v map[int32]string) int {
+	n := 6 + len(v)*4
+	for _, x := range v {
+		n += 4 + len(x)
+	}
+	return n
+}
+
+func _Map_String_List_String_EncodedSize(v map[string][]string) int {
+	n := 6
+	for k, x := range v {
+		n += 4 + len(k) + _List_String_EncodedSize(x)
+	}
+	return n
+}
+
+func _Map_Point_String_EncodedSize(v []struct {
+	Key   *Point
+	Value string
+}) int {
+	n := 6
+	for _, x := range v {
+		n += x.Key.EncodedSize() + 4 + len(x.Value)
+	}
+	return n
+}
+
+func _Map_String_I32_ordered_StringInt32Map_EncodedSize(v *ordered.StringInt32Map) int {
+	n := 6
+	if v != nil {
+		_ = v.ForEach(func(k string, x int32) error {
+			n += 4 + len(k) + 4
+			return nil
+		})
+	}
+	return n
+}
+
+func _Map_I32_String_ordered_Int32StringMap_EncodedSize(v *ordered.Int32StringMap) int {
+	n := 6
+	if v != nil {
+		_ = v.ForEach(func(k int32, x string) error {
+			n += 4 + 4 + len(x)
+			return nil
+		})
+	}
+	return n
+}
+
+func _Map_String_I32_sliceType_EncodedSize(v []struct {
+	Key   string
+	Value int32
+}) int {
+	n := 6
+	for _, x := range v {
+		n += 4 + len(x.Key) + 4
+	}
+	return n
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Containers with the Binary protocol, without encoding it. The result
+// is only meaningful if the Containers can be encoded.
+func (v *Containers) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	if v.Ints != nil {
+		n += 3 + _List_I32_EncodedSize(v.Ints)
+	}
+	if v.Strings != nil {
+		n += 3 + _List_String_EncodedSize(v.Strings)
+	}
+	if v.Points != nil {
+		n += 3 + _List_Point_EncodedSize(v.Points)
+	}
+	if v.Longs != nil {
+		n += 3 + _Set_I64_mapType_EncodedSize(v.Longs)
+	}
+	if v.Names != nil {
+		n += 3 + _Set_String_mapType_EncodedSize(v.Names)
+	}
+	if v.PointSet != nil {
+		n += 3 + _Set_Point_sliceType_EncodedSize(v.PointSet)
+	}
+	if v.Fixed != nil {
+		n += 3 + _Map_I32_I64_EncodedSize(v.Fixed)
+	}
+	if v.ByName != nil {
+		n += 3 + _Map_String_I32_EncodedSize(v.ByName)
+	}
+	if v.ByID != nil {
+		n += 3 + _Map_I32_String_EncodedSize(v.ByID)
+	}
+	if v.Nested != nil {
+		n += 3 + _Map_String_List_String_EncodedSize(v.Nested)
+	}
+	if v.Labels != nil {
+		n += 3 + _Map_Point_String_EncodedSize(v.Labels)
+	}
+	if v.Ordered != nil {
+		n += 3 + _Map_String_I32_ordered_StringInt32Map_EncodedSize(v.Ordered)
+	}
+	if v.OrderedNames != nil {
+		n += 3 + _Map_I32_String_ordered_Int32StringMap_EncodedSize(v.OrderedNames)
+	}
+	if v.Pairs != nil {
+		n += 3 + _Map_String_I32_sliceType_EncodedSize(v.Pairs)
+	}
+	return n
+}
+
+type Count int64
+
+// CountPtr returns a pointer to a Count
+func (v Count) Ptr() *Count {
+	return &v
+}
+
+// ToWire translates Count into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Count) ToWire() (wire.Value, error) {
+	x := (int64)(v)
+	return wire.NewValueI64(x), error(nil)
+}
+
+// String returns a readable string representation of Count.
+func (v Count) String() string {
+	x := (int64)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Count) Encode(sw stream.Writer) error {
+	x := (int64)(v)
+	return sw.WriteInt64(x)
+}
+
+// FromWire deserializes Count from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Count) FromWire(w wire.Value) error {
+	x, err := w.GetI64(), error(nil)
+	*v = (Count)(x)
+	return err
+}
+
+// Decode deserializes Count directly off the wire.
+func (v *Count) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt64()
+	*v = (Count)(x)
+	return err
+}
+
+// Equals returns true if this Count is equal to the provided
+// Count.
+func (lhs Count) Equals(rhs Count) bool {
+	return ((int64)(lhs) == (int64)(rhs))
+}
+
+// Hash returns a hash of this Count which is stable across
+// processes.
+func (v Count) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int64((int64)(v))
+	return h.Sum64()
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Count with the Binary protocol, without encoding it.
+func (v Count) EncodedSize() int {
+	return 8
+}
+
+type Defaults struct {
+	Greeting *string  `json:"greeting,omitempty"`
+	Retries  *int32   `json:"retries,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Mode     *string  `json:"mode,omitempty"`
+}
+
+// Default_Defaults constructs a new Defaults struct,
+// pre-populating any fields with defined default values.
+func Default_Defaults() *Defaults {
+	var v Defaults
+	v.Greeting = ptr.String("hello")
+	v.Retries = ptr.Int32(3)
+	v.Tags = []string{
+		"a",
+		"b",
+	}
+	v.Mode = ptr.String("fast")
+	return &v
+}
+
+// ToWire translates a Defaults struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Defaults) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	vGreeting := v.Greeting
+	if vGreeting == nil {
+		vGreeting = ptr.String("hello")
+	}
+	{
+		w, err = wire.NewValueString(*(vGreeting)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	vRetries := v.Retries
+	if vRetries == nil {
+		vRetries = ptr.Int32(3)
+	}
+	{
+		w, err = wire.NewValueI32(*(vRetries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	vTags := v.Tags
+	if vTags == nil {
+		vTags = []string{
+			"a",
+			"b",
+		}
+	}
+	{
+		w, err = wire.NewValueList(_List_String_ValueList(vTags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Mode != nil && !((*v.Mode) == "fast") {
+		w, err = wire.NewValueString(*(v.Mode)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Defaults struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Defaults struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Defaults
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Defaults) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Greeting = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Retries = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Mode = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Greeting == nil {
+		v.Greeting = ptr.String("hello")
+	}
+
+	if v.Retries == nil {
+		v.Retries = ptr.Int32(3)
+	}
+
+	if v.Tags == nil {
+		v.Tags = []string{
+			"a",
+			"b",
+		}
+	}
+
+	if v.Mode == nil {
+		v.Mode = ptr.String("fast")
+	}
+
+	return nil
+}
+
+// Encode serializes a Defaults struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Defaults struct could not be encoded.
+func (v *Defaults) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	vGreeting := v.Greeting
+	if vGreeting == nil {
+		vGreeting = ptr.String("hello")
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(vGreeting)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vRetries := v.Retries
+	if vRetries == nil {
+		vRetries = ptr.Int32(3)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(vRetries)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vTags := v.Tags
+	if vTags == nil {
+		vTags = []string{
+			"a",
+			"b",
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(vTags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Mode != nil && !((*v.Mode) == "fast") {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Mode)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Defaults struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Defaults struct could not be generated from the wire
+// representation.
+func (v *Defaults) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Greeting = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Retries = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Mode = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Greeting == nil {
+		v.Greeting = ptr.String("hello")
+	}
+
+	if v.Retries == nil {
+		v.Retries = ptr.Int32(3)
+	}
+
+	if v.Tags == nil {
+		v.Tags = []string{
+			"a",
+			"b",
+		}
+	}
+
+	if v.Mode == nil {
+		v.Mode = ptr.String("fast")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Defaults
+// struct.
+func (v *Defaults) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Greeting != nil {
+		fields[i] = fmt.Sprintf("Greeting: %v", *(v.Greeting))
+		i++
+	}
+	if v.Retries != nil {
+		fields[i] = fmt.Sprintf("Retries: %v", *(v.Retries))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Mode != nil {
+		fields[i] = fmt.Sprintf("Mode: %v", *(v.Mode))
+		i++
+	}
+
+	return fmt.Sprintf("Defaults{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Defaults match the
+// provided Defaults.
+//
+// This function performs a deep comparison.
+func (v *Defaults) Equals(rhs *Defaults) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Greeting, rhs.Greeting) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Retries, rhs.Retries) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Mode, rhs.Mode) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Defaults.
+func (v *Defaults) Copy() *Defaults {
+	if v == nil {
+		return nil
+	}
+
+	var o Defaults
+	o.Greeting = _String_CopyPtr(v.Greeting)
+	o.Retries = _I32_CopyPtr(v.Retries)
+	o.Tags = _List_String_Copy(v.Tags)
+	o.Mode = _String_CopyPtr(v.Mode)
+	return &o
+}
+
+// Hash returns a hash of this Defaults which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Defaults) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Greeting != nil {
+		h.Field(1)
+		h.String(*v.Greeting)
+	}
+	if v.Retries != nil {
+		h.Field(2)
+		h.Int32(*v.Retries)
+	}
+	h.Field(3)
+	h.Uint64(_List_String_Hash(v.Tags))
+	if v.Mode != nil {
+		h.Field(4)
+		h.String(*v.Mode)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Defaults so that it may be reused.
+func (v *Defaults) Reset() {
+	*v = Defaults{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Defaults.
+func (v *Defaults) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Greeting != nil {
+		enc.AddString("greeting", *v.Greeting)
+	}
+	if v.Retries != nil {
+		enc.AddInt32("retries", *v.Retries)
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	if v.Mode != nil {
+		enc.AddString("mode", *v.Mode)
+	}
+	return err
+}
+
+// GetGreeting returns the value of Greeting if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetGreeting() (o string) {
+	if v != nil && v.Greeting != nil {
+		return *v.Greeting
+	}
+	o = "hello"
+	return
+}
+
+// IsSetGreeting returns true if Greeting is not nil.
+func (v *Defaults) IsSetGreeting() bool {
+	return v != nil && v.Greeting != nil
+}
+
+// GetRetries returns the value of Retries if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetRetries() (o int32) {
+	if v != nil && v.Retries != nil {
+		return *v.Retries
+	}
+	o = 3
+	return
+}
+
+// IsSetRetries returns true if Retries is not nil.
+func (v *Defaults) IsSetRetries() bool {
+	return v != nil && v.Retries != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	o = []string{
+		"a",
+		"b",
+	}
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Defaults) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetMode returns the value of Mode if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetMode() (o string) {
+	if v != nil && v.Mode != nil {
+		return *v.Mode
+	}
+	o = "fast"
+	return
+}
+
+// IsSetMode returns true if Mode is not nil.
+func (v *Defaults) IsSetMode() bool {
+	return v != nil && v.Mode != nil
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Defaults with the Binary protocol, without encoding it. The result
+// is only meaningful if the Defaults can be encoded.
+func (v *Defaults) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	vGreeting := v.Greeting
+	if vGreeting == nil {
+		vGreeting = ptr.String("hello")
+	}
+	n += 3 + 4 + len(*vGreeting)
+	n += 3 + 4
+	vTags := v.Tags
+	if vTags == nil {
+		vTags = []string{
+			"a",
+			"b",
+		}
+	}
+	n += 3 + _List_String_EncodedSize(vTags)
+	if v.Mode != nil && !((*v.Mode) == "fast") {
+		n += 3 + 4 + len(*v.Mode)
+	}
+	return n
+}
+
+type Failure struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a Failure struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Failure) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Failure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Failure struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Failure
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Failure) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of Failure is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Failure struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Failure struct could not be encoded.
+func (v *Failure) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Failure struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Failure struct could not be generated from the wire
+// representation.
+func (v *Failure) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of Failure is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Failure
+// struct.
+func (v *Failure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("Failure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*Failure) ErrorName() string {
+	return "Failure"
+}
+
+// Equals returns true if all the fields of this Failure match the
+// provided Failure.
+//
+// This function performs a deep comparison.
+func (v *Failure) Equals(rhs *Failure) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Failure.
+func (v *Failure) Copy() *Failure {
+	if v == nil {
+		return nil
+	}
+
+	var o Failure
+	o.Message = v.Message
+	return &o
+}
+
+// Hash returns a hash of this Failure which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Failure) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Failure so that it may be reused.
+func (v *Failure) Reset() {
+	*v = Failure{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Failure.
+func (v *Failure) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Failure with the Binary protocol, without encoding it. The result
+// is only meaningful if the Failure can be encoded.
+func (v *Failure) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + 4 + len(v.Message)
+	return n
+}
+
+func (v *Failure) Error() string {
+	return v.String()
+}
+
+type Name string
+
+// NamePtr returns a pointer to a Name
+func (v Name) Ptr() *Name {
+	return &v
+}
+
+// ToWire translates Name into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Name) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Name.
+func (v Name) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Name) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Name from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Name) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Name)(x)
+	return err
+}
+
+// Decode deserializes Name directly off the wire.
+func (v *Name) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Name)(x)
+	return err
+}
+
+// Equals returns true if this Name is equal to the provided
+// Name.
+func (lhs Name) Equals(rhs Name) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Name which is stable across
+// processes.
+func (v Name) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Name with the Binary protocol, without encoding it.
+func (v Name) EncodedSize() int {
+	return 4 + len((string)(v))
+}
+
+type _List_Name_ValueList []Name
+
+func (v _List_Name_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Name_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Name_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_Name_ValueList) Close() {}
+
+func _List_Name_Encode(val []Name, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []Name
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Name_Read(w wire.Value) (Name, error) {
+	var x Name
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_Name_Read(l wire.ValueList) ([]Name, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]Name, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Name_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Name_Decode(sr stream.Reader) (Name, error) {
+	var x Name
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_Name_Decode(sr stream.Reader) ([]Name, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]Name, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Name_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Name_Equals(lhs, rhs []Name) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Name_Copy(v []Name) []Name {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Name, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Name_Hash(v []Name) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(string(x))
+	}
+	return h.Sum64()
+}
+
+type _List_Name_Zapper []Name
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Name_Zapper.
+func (l _List_Name_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString((string)(v))
+	}
+	return err
+}
+
+type Names []Name
+
+// ToWire translates Names into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Names) ToWire() (wire.Value, error) {
+	x := ([]Name)(v)
+	return wire.NewValueList(_List_Name_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Names.
+func (v Names) String() string {
+	x := ([]Name)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Names) Encode(sw stream.Writer) error {
+	x := ([]Name)(v)
+	return _List_Name_Encode(x, sw)
+}
+
+// FromWire deserializes Names from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Names) FromWire(w wire.Value) error {
+	x, err := _List_Name_Read(w.GetList())
+	*v = (Names)(x)
+	return err
+}
+
+// Decode deserializes Names directly off the wire.
+func (v *Names) Decode(sr stream.Reader) error {
+	x, err := _List_Name_Decode(sr)
+	*v = (Names)(x)
+	return err
+}
+
+// Equals returns true if this Names is equal to the provided
+// Names.
+func (lhs Names) Equals(rhs Names) bool {
+	return _List_Name_Equals(([]Name)(lhs), ([]Name)(rhs))
+}
+
+// Copy returns a deep copy of this Names.
+func (v Names) Copy() Names {
+	x := ([]Name)(v)
+	return (Names)(_List_Name_Copy(x))
+}
+
+// Hash returns a hash of this Names which is stable across
+// processes.
+func (v Names) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_Name_Hash(([]Name)(v)))
+	return h.Sum64()
+}
+
+func (v Names) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Name_Zapper)(([]Name)(v))).MarshalLogArray(enc)
+}
+
+func _List_Name_EncodedSize(v []Name) int {
+	n := 5
+	for _, x := range v {
+		n += x.EncodedSize()
+	}
+	return n
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Names with the Binary protocol, without encoding it.
+func (v Names) EncodedSize() int {
+	return _List_Name_EncodedSize(([]Name)(v))
+}
+
+type Origin Point
+
+// ToWire translates Origin into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Origin) ToWire() (wire.Value, error) {
+	x := (*Point)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Origin.
+func (v *Origin) String() string {
+	x := (*Point)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *Origin) Encode(sw stream.Writer) error {
+	x := (*Point)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes Origin from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Origin) FromWire(w wire.Value) error {
+	return (*Point)(v).FromWire(w)
+}
+
+// Decode deserializes Origin directly off the wire.
+func (v *Origin) Decode(sr stream.Reader) error {
+	return (*Point)(v).Decode(sr)
+}
+
+// Equals returns true if this Origin is equal to the provided
+// Origin.
+func (lhs *Origin) Equals(rhs *Origin) bool {
+	return (*Point)(lhs).Equals((*Point)(rhs))
+}
+
+// Copy returns a deep copy of this Origin.
+func (v *Origin) Copy() *Origin {
+	x := (*Point)(v)
+	return (*Origin)(x.Copy())
+}
+
+// Hash returns a hash of this Origin which is stable across
+// processes.
+func (v *Origin) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Point)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *Origin) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Point)(v)).MarshalLogObject(enc)
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Origin with the Binary protocol, without encoding it.
+func (v *Origin) EncodedSize() int {
+	return (*Point)(v).EncodedSize()
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Point with the Binary protocol, without encoding it. The result
+// is only meaningful if the Point can be encoded.
+func (v *Point) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + 8
+	n += 3 + 8
+	return n
+}
+
+type Presence struct {
+	A int32  `json:"a,omitempty"`
+	B string `json:"b,omitempty"`
+
+	presence [1]uint64
+}
+
+// ToWire translates a Presence struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Presence) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.refA() != nil {
+		w, err = wire.NewValueI32(*(v.refA())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.refB() != nil {
+		w, err = wire.NewValueString(*(v.refB())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Presence struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Presence struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Presence
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Presence) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.A, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.B, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Presence struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Presence struct could not be encoded.
+func (v *Presence) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.refA() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refA())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refB() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.refB())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Presence struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Presence struct could not be generated from the wire
+// representation.
+func (v *Presence) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.A, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.B, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 1)
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Presence
+// struct.
+func (v *Presence) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.refA() != nil {
+		fields[i] = fmt.Sprintf("A: %v", *(v.refA()))
+		i++
+	}
+	if v.refB() != nil {
+		fields[i] = fmt.Sprintf("B: %v", *(v.refB()))
+		i++
+	}
+
+	return fmt.Sprintf("Presence{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Presence match the
+// provided Presence.
+//
+// This function performs a deep comparison.
+func (v *Presence) Equals(rhs *Presence) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refA(), rhs.refA()) {
+		return false
+	}
+	if !_String_EqualsPtr(v.refB(), rhs.refB()) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Presence.
+func (v *Presence) Copy() *Presence {
+	if v == nil {
+		return nil
+	}
+
+	var o Presence
+	o.A = v.A
+	o.B = v.B
+	o.presence = v.presence
+	return &o
+}
+
+// Hash returns a hash of this Presence which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Presence) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.refA() != nil {
+		h.Field(1)
+		h.Int32(*v.refA())
+	}
+	if v.refB() != nil {
+		h.Field(2)
+		h.String(*v.refB())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Presence so that it may be reused.
+func (v *Presence) Reset() {
+	*v = Presence{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Presence.
+func (v *Presence) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.refA() != nil {
+		enc.AddInt32("a", *v.refA())
+	}
+	if v.refB() != nil {
+		enc.AddString("b", *v.refB())
+	}
+	return err
+}
+
+// MarshalJSON serializes a Presence into JSON without its
+// unset optional fields.
+//
+// This has a value receiver so that Presence values are marshaled this way in
+// addition to pointers to them.
+func (v Presence) MarshalJSON() ([]byte, error) {
+	type plain Presence // without this method
+
+	r := struct {
+		*plain
+		A *int32  `json:"a,omitempty"`
+		B *string `json:"b,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.A = v.refA()
+	r.B = v.refB()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Presence from JSON, recording which of
+// its optional fields are set.
+func (v *Presence) UnmarshalJSON(data []byte) error {
+	type plain Presence // without this method
+
+	r := struct {
+		*plain
+		A *int32  `json:"a,omitempty"`
+		B *string `json:"b,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.A != nil {
+		v.SetA(*r.A)
+	}
+	if r.B != nil {
+		v.SetB(*r.B)
+	}
+	return nil
+}
+
+// GetA returns the value of A if it is set or its
+// zero value if it is unset.
+func (v *Presence) GetA() (o int32) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.A
+	}
+
+	return
+}
+
+// IsSetA returns true if A is set.
+func (v *Presence) IsSetA() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasA returns true if A is set.
+func (v *Presence) HasA() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearA unsets A.
+func (v *Presence) ClearA() {
+	var x int32
+	v.A = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refA returns a pointer to A if it is set, and
+// nil otherwise.
+func (v *Presence) refA() *int32 {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.A
+	}
+	return nil
+}
+
+// SetA sets the value of A.
+func (v *Presence) SetA(x int32) {
+	v.A = x
+	v.presence[0] |= (1 << 0)
+}
+
+// GetB returns the value of B if it is set or its
+// zero value if it is unset.
+func (v *Presence) GetB() (o string) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.B
+	}
+
+	return
+}
+
+// IsSetB returns true if B is set.
+func (v *Presence) IsSetB() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// HasB returns true if B is set.
+func (v *Presence) HasB() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// ClearB unsets B.
+func (v *Presence) ClearB() {
+	var x string
+	v.B = x
+	v.presence[0] &^= (1 << 1)
+}
+
+// refB returns a pointer to B if it is set, and
+// nil otherwise.
+func (v *Presence) refB() *string {
+	if v.presence[0]&(1<<1) != 0 {
+		return &v.B
+	}
+	return nil
+}
+
+// SetB sets the value of B.
+func (v *Presence) SetB(x string) {
+	v.B = x
+	v.presence[0] |= (1 << 1)
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Presence with the Binary protocol, without encoding it. The result
+// is only meaningful if the Presence can be encoded.
+func (v *Presence) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	if v.refA() != nil {
+		n += 3 + 4
+	}
+	if v.refB() != nil {
+		n += 3 + 4 + len(*v.refB())
+	}
+	return n
+}
+
+type Primitives struct {
+	B      bool             `json:"b,required"`
+	Tiny   *int8            `json:"tiny,omitempty"`
+	Small  *int16           `json:"small,omitempty"`
+	Medium uint32           `json:"medium,required"`
+	Large  *int64           `json:"large,omitempty"`
+	D      *float64         `json:"d,omitempty"`
+	S      *string          `json:"s,omitempty"`
+	Bin    []byte           `json:"bin,omitempty"`
+	U      *thriftuuid.UUID `json:"u,omitempty"`
+	Color  *Color           `json:"color,omitempty"`
+}
+
+// ToWire translates a Primitives struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Primitives) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueBool(v.B), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Tiny != nil {
+		w, err = wire.NewValueI8(*(v.Tiny)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Small != nil {
+		w, err = wire.NewValueI16(*(v.Small)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	w, err = wire.NewValueI32(int32(v.Medium)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+	if v.Large != nil {
+		w, err = wire.NewValueI64(*(v.Large)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.D != nil {
+		w, err = wire.NewValueDouble(*(v.D)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.S != nil {
+		w, err = wire.NewValueString(*(v.S)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Bin != nil {
+		w, err = wire.NewValueBinary(v.Bin), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.U != nil {
+		w, err = wire.NewValueBinary((*(v.U)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Color != nil {
+		w, err = v.Color.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UUID_Read(w wire.Value) (thriftuuid.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return thriftuuid.UUID(u), err
+}
+
+func _Color_Read(w wire.Value) (Color, error) {
+	var v Color
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a Primitives struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Primitives struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Primitives
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Primitives) FromWire(w wire.Value) error {
+	var err error
+
+	bIsSet := false
+
+	mediumIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				v.B, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				bIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				var x int8
+				x, err = field.Value.GetI8(), error(nil)
+				v.Tiny = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.Small = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.Medium, err = uint32(field.Value.GetI32()), error(nil)
+				if err != nil {
+					return err
+				}
+				mediumIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Large = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.D = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.S = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.Bin, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.U = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x Color
+				x, err = _Color_Read(field.Value)
+				v.Color = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !bIsSet {
+		return errors.New("field B of Primitives is required")
+	}
+
+	if !mediumIsSet {
+		return errors.New("field Medium of Primitives is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Primitives struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Primitives struct could not be encoded.
+func (v *Primitives) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBool}); err != nil {
+		return err
+	}
+	if err := sw.WriteBool(v.B); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Tiny != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI8}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt8(*(v.Tiny)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Small != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.Small)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(int32(v.Medium)); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Large != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Large)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.D != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.D)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.S != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.S)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Bin != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Bin); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.U != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.U)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Color != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Color.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _I32_unsigned_Decode(sr stream.Reader) (uint32, error) {
+	i, err := sr.ReadInt32()
+	return uint32(i), err
+}
+
+func _UUID_Decode(sr stream.Reader) (thriftuuid.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return thriftuuid.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return thriftuuid.UUID(u), err
+}
+
+func _Color_Decode(sr stream.Reader) (Color, error) {
+	var v Color
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a Primitives struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Primitives struct could not be generated from the wire
+// representation.
+func (v *Primitives) Decode(sr stream.Reader) error {
+
+	bIsSet := false
+
+	mediumIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.B, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			bIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			var x int8
+			x, err = sr.ReadInt8()
+			v.Tiny = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.Small = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			v.Medium, err = _I32_unsigned_Decode(sr)
+			if err != nil {
+				return err
+			}
+			mediumIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Large = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.D = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.S = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.Bin, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.U = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x Color
+			x, err = _Color_Decode(sr)
+			v.Color = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !bIsSet {
+		return errors.New("field B of Primitives is required")
+	}
+
+	if !mediumIsSet {
+		return errors.New("field Medium of Primitives is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Primitives
+// struct.
+func (v *Primitives) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [10]string
+	i := 0
+	fields[i] = fmt.Sprintf("B: %v", v.B)
+	i++
+	if v.Tiny != nil {
+		fields[i] = fmt.Sprintf("Tiny: %v", *(v.Tiny))
+		i++
+	}
+	if v.Small != nil {
+		fields[i] = fmt.Sprintf("Small: %v", *(v.Small))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Medium: %v", v.Medium)
+	i++
+	if v.Large != nil {
+		fields[i] = fmt.Sprintf("Large: %v", *(v.Large))
+		i++
+	}
+	if v.D != nil {
+		fields[i] = fmt.Sprintf("D: %v", *(v.D))
+		i++
+	}
+	if v.S != nil {
+		fields[i] = fmt.Sprintf("S: %v", *(v.S))
+		i++
+	}
+	if v.Bin != nil {
+		fields[i] = fmt.Sprintf("Bin: %v", v.Bin)
+		i++
+	}
+	if v.U != nil {
+		fields[i] = fmt.Sprintf("U: %v", *(v.U))
+		i++
+	}
+	if v.Color != nil {
+		fields[i] = fmt.Sprintf("Color: %v", *(v.Color))
+		i++
+	}
+
+	return fmt.Sprintf("Primitives{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Byte_EqualsPtr(lhs, rhs *int8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _UUID_EqualsPtr(lhs, rhs *thriftuuid.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Color_EqualsPtr(lhs, rhs *Color) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Primitives match the
+// provided Primitives.
+//
+// This function performs a deep comparison.
+func (v *Primitives) Equals(rhs *Primitives) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.B == rhs.B) {
+		return false
+	}
+	if !_Byte_EqualsPtr(v.Tiny, rhs.Tiny) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.Small, rhs.Small) {
+		return false
+	}
+	if !(v.Medium == rhs.Medium) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Large, rhs.Large) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.D, rhs.D) {
+		return false
+	}
+	if !_String_EqualsPtr(v.S, rhs.S) {
+		return false
+	}
+	if !((v.Bin == nil && rhs.Bin == nil) || (v.Bin != nil && rhs.Bin != nil && bytes.Equal(v.Bin, rhs.Bin))) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.U, rhs.U) {
+		return false
+	}
+	if !_Color_EqualsPtr(v.Color, rhs.Color) {
+		return false
+	}
+
+	return true
+}
+
+func _Byte_CopyPtr(v *int8) *int8 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I16_CopyPtr(v *int16) *int16 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _UUID_CopyPtr(v *thriftuuid.UUID) *thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Color_CopyPtr(v *Color) *Color {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Primitives.
+func (v *Primitives) Copy() *Primitives {
+	if v == nil {
+		return nil
+	}
+
+	var o Primitives
+	o.B = v.B
+	o.Tiny = _Byte_CopyPtr(v.Tiny)
+	o.Small = _I16_CopyPtr(v.Small)
+	o.Medium = v.Medium
+	o.Large = _I64_CopyPtr(v.Large)
+	o.D = _Double_CopyPtr(v.D)
+	o.S = _String_CopyPtr(v.S)
+	o.Bin = _Binary_Copy(v.Bin)
+	o.U = _UUID_CopyPtr(v.U)
+	o.Color = _Color_CopyPtr(v.Color)
+	return &o
+}
+
+// Hash returns a hash of this Primitives which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Primitives) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.B)
+	if v.Tiny != nil {
+		h.Field(2)
+		h.Int8(*v.Tiny)
+	}
+	if v.Small != nil {
+		h.Field(3)
+		h.Int16(*v.Small)
+	}
+	h.Field(4)
+	h.Int32(int32(v.Medium))
+	if v.Large != nil {
+		h.Field(5)
+		h.Int64(*v.Large)
+	}
+	if v.D != nil {
+		h.Field(6)
+		h.Double(*v.D)
+	}
+	if v.S != nil {
+		h.Field(7)
+		h.String(*v.S)
+	}
+	h.Field(8)
+	h.Binary(v.Bin)
+	if v.U != nil {
+		h.Field(9)
+		h.Binary((*v.U).Bytes())
+	}
+	if v.Color != nil {
+		h.Field(10)
+		h.Int32(int32(*v.Color))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Primitives so that it may be reused.
+func (v *Primitives) Reset() {
+	*v = Primitives{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Primitives.
+func (v *Primitives) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddBool("b", v.B)
+	if v.Tiny != nil {
+		enc.AddInt8("tiny", *v.Tiny)
+	}
+	if v.Small != nil {
+		enc.AddInt16("small", *v.Small)
+	}
+	enc.AddUint32("medium", v.Medium)
+	if v.Large != nil {
+		enc.AddInt64("large", *v.Large)
+	}
+	if v.D != nil {
+		enc.AddFloat64("d", *v.D)
+	}
+	if v.S != nil {
+		enc.AddString("s", *v.S)
+	}
+	if v.Bin != nil {
+		enc.AddString("bin", base64.StdEncoding.EncodeToString(v.Bin))
+	}
+	if v.U != nil {
+		enc.AddString("u", (*v.U).String())
+	}
+	if v.Color != nil {
+		err = multierr.Append(err, enc.AddObject("color", *v.Color))
+	}
+	return err
+}
+
+// GetB returns the value of B if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetB() (o bool) {
+	if v != nil {
+		o = v.B
+	}
+	return
+}
+
+// GetTiny returns the value of Tiny if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetTiny() (o int8) {
+	if v != nil && v.Tiny != nil {
+		return *v.Tiny
+	}
+
+	return
+}
+
+// IsSetTiny returns true if Tiny is not nil.
+func (v *Primitives) IsSetTiny() bool {
+	return v != nil && v.Tiny != nil
+}
+
+// GetSmall returns the value of Small if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetSmall() (o int16) {
+	if v != nil && v.Small != nil {
+		return *v.Small
+	}
+
+	return
+}
+
+// IsSetSmall returns true if Small is not nil.
+func (v *Primitives) IsSetSmall() bool {
+	return v != nil && v.Small != nil
+}
+
+// GetMedium returns the value of Medium if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetMedium() (o uint32) {
+	if v != nil {
+		o = v.Medium
+	}
+	return
+}
+
+// GetLarge returns the value of Large if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetLarge() (o int64) {
+	if v != nil && v.Large != nil {
+		return *v.Large
+	}
+
+	return
+}
+
+// IsSetLarge returns true if Large is not nil.
+func (v *Primitives) IsSetLarge() bool {
+	return v != nil && v.Large != nil
+}
+
+// GetD returns the value of D if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetD() (o float64) {
+	if v != nil && v.D != nil {
+		return *v.D
+	}
+
+	return
+}
+
+// IsSetD returns true if D is not nil.
+func (v *Primitives) IsSetD() bool {
+	return v != nil && v.D != nil
+}
+
+// GetS returns the value of S if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetS() (o string) {
+	if v != nil && v.S != nil {
+		return *v.S
+	}
+
+	return
+}
+
+// IsSetS returns true if S is not nil.
+func (v *Primitives) IsSetS() bool {
+	return v != nil && v.S != nil
+}
+
+// GetBin returns the value of Bin if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetBin() (o []byte) {
+	if v != nil && v.Bin != nil {
+		return v.Bin
+	}
+
+	return
+}
+
+// IsSetBin returns true if Bin is not nil.
+func (v *Primitives) IsSetBin() bool {
+	return v != nil && v.Bin != nil
+}
+
+// GetU returns the value of U if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetU() (o thriftuuid.UUID) {
+	if v != nil && v.U != nil {
+		return *v.U
+	}
+
+	return
+}
+
+// IsSetU returns true if U is not nil.
+func (v *Primitives) IsSetU() bool {
+	return v != nil && v.U != nil
+}
+
+// GetColor returns the value of Color if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetColor() (o Color) {
+	if v != nil && v.Color != nil {
+		return *v.Color
+	}
+
+	return
+}
+
+// IsSetColor returns true if Color is not nil.
+func (v *Primitives) IsSetColor() bool {
+	return v != nil && v.Color != nil
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Primitives with the Binary protocol, without encoding it. The result
+// is only meaningful if the Primitives can be encoded.
+func (v *Primitives) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + 1
+	if v.Tiny != nil {
+		n += 3 + 1
+	}
+	if v.Small != nil {
+		n += 3 + 2
+	}
+	n += 3 + 4
+	if v.Large != nil {
+		n += 3 + 8
+	}
+	if v.D != nil {
+		n += 3 + 8
+	}
+	if v.S != nil {
+		n += 3 + 4 + len(*v.S)
+	}
+	if v.Bin != nil {
+		n += 3 + 4 + len(v.Bin)
+	}
+	if v.U != nil {
+		n += 3 + 20
+	}
+	if v.Color != nil {
+		n += 3 + 4
+	}
+	return n
+}
+
+type Secret struct {
+	Token *string `json:"token,omitempty"`
+}
+
+// ToWire translates a Secret struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Secret) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Token != nil {
+		w, err = wire.NewValueString(*(v.Token)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("tokens", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Secret struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Secret struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Secret
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Secret) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("tokens", field.Value, wire.TBinary)
+				if err != nil {
+					return err
+				}
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Token = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Secret struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Secret struct could not be encoded.
+func (v *Secret) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Token != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "tokens", func(esw stream.Writer) error {
+			return esw.WriteString(*(v.Token))
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Secret struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Secret struct could not be generated from the wire
+// representation.
+func (v *Secret) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "tokens", func(dsr stream.Reader) (err error) {
+				var x string
+				x, err = dsr.ReadString()
+				v.Token = &x
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Secret
+// struct.
+func (v *Secret) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Token != nil {
+		fields[i] = fmt.Sprintf("Token: %v", *(v.Token))
+		i++
+	}
+
+	return fmt.Sprintf("Secret{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Secret match the
+// provided Secret.
+//
+// This function performs a deep comparison.
+func (v *Secret) Equals(rhs *Secret) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Token, rhs.Token) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Secret.
+func (v *Secret) Copy() *Secret {
+	if v == nil {
+		return nil
+	}
+
+	var o Secret
+	o.Token = _String_CopyPtr(v.Token)
+	return &o
+}
+
+// Hash returns a hash of this Secret which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Secret) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Token != nil {
+		h.Field(1)
+		h.String(*v.Token)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Secret so that it may be reused.
+func (v *Secret) Reset() {
+	*v = Secret{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Secret.
+func (v *Secret) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Token != nil {
+		enc.AddString("token", *v.Token)
+	}
+	return err
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *Secret) GetToken() (o string) {
+	if v != nil && v.Token != nil {
+		return *v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is not nil.
+func (v *Secret) IsSetToken() bool {
+	return v != nil && v.Token != nil
+}
+
+type SecretAlias Secret
+
+// ToWire translates SecretAlias into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *SecretAlias) ToWire() (wire.Value, error) {
+	x := (*Secret)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of SecretAlias.
+func (v *SecretAlias) String() string {
+	x := (*Secret)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *SecretAlias) Encode(sw stream.Writer) error {
+	x := (*Secret)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes SecretAlias from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *SecretAlias) FromWire(w wire.Value) error {
+	return (*Secret)(v).FromWire(w)
+}
+
+// Decode deserializes SecretAlias directly off the wire.
+func (v *SecretAlias) Decode(sr stream.Reader) error {
+	return (*Secret)(v).Decode(sr)
+}
+
+// Equals returns true if this SecretAlias is equal to the provided
+// SecretAlias.
+func (lhs *SecretAlias) Equals(rhs *SecretAlias) bool {
+	return (*Secret)(lhs).Equals((*Secret)(rhs))
+}
+
+// Copy returns a deep copy of this SecretAlias.
+func (v *SecretAlias) Copy() *SecretAlias {
+	x := (*Secret)(v)
+	return (*SecretAlias)(x.Copy())
+}
+
+// Hash returns a hash of this SecretAlias which is stable across
+// processes.
+func (v *SecretAlias) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Secret)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *SecretAlias) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Secret)(v)).MarshalLogObject(enc)
+}
+
+type Secrets struct {
+	Secrets []*Secret `json:"secrets,omitempty"`
+	Point   *Point    `json:"point,omitempty"`
+}
+
+type _List_Secret_ValueList []*Secret
+
+func (v _List_Secret_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Secret', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Secret_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Secret_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Secret_ValueList) Close() {}
+
+// ToWire translates a Secrets struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Secrets) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Secrets != nil {
+		w, err = wire.NewValueList(_List_Secret_ValueList(v.Secrets)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Secret_Read(w wire.Value) (*Secret, error) {
+	var v Secret
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Secret_Read(l wire.ValueList) ([]*Secret, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Secret, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Secret_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Secrets struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Secrets struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Secrets
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Secrets) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Secrets, err = _List_Secret_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_Secret_Encode(val []*Secret, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Secret
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Secret', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Secrets struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Secrets struct could not be encoded.
+func (v *Secrets) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Secrets != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Secret_Encode(v.Secrets, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Secret_Decode(sr stream.Reader) (*Secret, error) {
+	var v Secret
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Secret_Decode(sr stream.Reader) ([]*Secret, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Secret, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Secret_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Secrets struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Secrets struct could not be generated from the wire
+// representation.
+func (v *Secrets) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Secrets, err = _List_Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Secrets
+// struct.
+func (v *Secrets) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Secrets != nil {
+		fields[i] = fmt.Sprintf("Secrets: %v", v.Secrets)
+		i++
+	}
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+
+	return fmt.Sprintf("Secrets{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Secret_Equals(lhs, rhs []*Secret) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Secrets match the
+// provided Secrets.
+//
+// This function performs a deep comparison.
+func (v *Secrets) Equals(rhs *Secrets) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Secrets == nil && rhs.Secrets == nil) || (v.Secrets != nil && rhs.Secrets != nil && _List_Secret_Equals(v.Secrets, rhs.Secrets))) {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Secret_Copy(v []*Secret) []*Secret {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Secret, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Secrets.
+func (v *Secrets) Copy() *Secrets {
+	if v == nil {
+		return nil
+	}
+
+	var o Secrets
+	o.Secrets = _List_Secret_Copy(v.Secrets)
+	o.Point = v.Point.Copy()
+	return &o
+}
+
+func _List_Secret_Hash(v []*Secret) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Secrets which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Secrets) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Secret_Hash(v.Secrets))
+	h.Field(2)
+	h.Uint64(v.Point.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Secrets so that it may be reused.
+func (v *Secrets) Reset() {
+	*v = Secrets{}
+}
+
+type _List_Secret_Zapper []*Secret
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Secret_Zapper.
+func (l _List_Secret_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Secrets.
+func (v *Secrets) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Secrets != nil {
+		err = multierr.Append(err, enc.AddArray("secrets", (_List_Secret_Zapper)(v.Secrets)))
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	return err
+}
+
+// GetSecrets returns the value of Secrets if it is set or its
+// zero value if it is unset.
+func (v *Secrets) GetSecrets() (o []*Secret) {
+	if v != nil && v.Secrets != nil {
+		return v.Secrets
+	}
+
+	return
+}
+
+// IsSetSecrets returns true if Secrets is not nil.
+func (v *Secrets) IsSetSecrets() bool {
+	return v != nil && v.Secrets != nil
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Secrets) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Secrets) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+type Shape struct {
+	Point *Point  `json:"point,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Point = v.Point.Copy()
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Shape) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPoint(x *Point) {
+	*v = Shape{}
+	v.Point = x
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Shape) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// LookupName returns the value of Name and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupName() (o string, _ bool) {
+	if v != nil && v.Name != nil {
+		return *v.Name, true
+	}
+	return o, false
+}
+
+// SetName sets the value of Name and unsets all other
+// fields of this Shape.
+func (v *Shape) SetName(x string) {
+	*v = Shape{}
+	v.Name = &x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Name != nil {
+		return 2
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitName is called with the value of Name if it is set.
+	VisitName(string) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Name != nil {
+			return visitor.VisitName(*v.Name)
+		}
+	}
+	return visitor.Default()
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Shape with the Binary protocol, without encoding it. The result
+// is only meaningful if the Shape can be encoded.
+func (v *Shape) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	if v.Point != nil {
+		n += 3 + v.Point.EncodedSize()
+	}
+	if v.Name != nil {
+		n += 3 + 4 + len(*v.Name)
+	}
+	return n
+}
+
+type Sized struct {
+	Size *int32  `json:"size,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// ToWire translates a Sized struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Sized) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Size != nil {
+		w, err = wire.NewValueI32(*(v.Size)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Sized struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Sized struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Sized
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Sized) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Size = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Sized struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Sized struct could not be encoded.
+func (v *Sized) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Size != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Size)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Sized struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Sized struct could not be generated from the wire
+// representation.
+func (v *Sized) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Size = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Sized
+// struct.
+func (v *Sized) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Size != nil {
+		fields[i] = fmt.Sprintf("Size: %v", *(v.Size))
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Sized{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Sized match the
+// provided Sized.
+//
+// This function performs a deep comparison.
+func (v *Sized) Equals(rhs *Sized) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Size, rhs.Size) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Sized.
+func (v *Sized) Copy() *Sized {
+	if v == nil {
+		return nil
+	}
+
+	var o Sized
+	o.Size = _I32_CopyPtr(v.Size)
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Sized which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Sized) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Size != nil {
+		h.Field(1)
+		h.Int32(*v.Size)
+	}
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Sized so that it may be reused.
+func (v *Sized) Reset() {
+	*v = Sized{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Sized.
+func (v *Sized) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Size != nil {
+		enc.AddInt32("size", *v.Size)
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetSize returns the value of Size if it is set or its
+// zero value if it is unset.
+func (v *Sized) GetSize() (o int32) {
+	if v != nil && v.Size != nil {
+		return *v.Size
+	}
+
+	return
+}
+
+// IsSetSize returns true if Size is not nil.
+func (v *Sized) IsSetSize() bool {
+	return v != nil && v.Size != nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Sized) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Sized) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Sized with the Binary protocol, without encoding it. The result
+// is only meaningful if the Sized can be encoded.
+func (v *Sized) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	if v.Size != nil {
+		n += 3 + 4
+	}
+	if v.Name != nil {
+		n += 3 + 4 + len(*v.Name)
+	}
+	return n
+}
+
+type Typedefs struct {
+	Name      Name         `json:"name,required"`
+	Nickname  *Name        `json:"nickname,omitempty"`
+	Count     *Count       `json:"count,omitempty"`
+	Aliases   Names        `json:"aliases,omitempty"`
+	Origin    *Origin      `json:"origin,omitempty"`
+	ID        *domain.UUID `json:"id,omitempty"`
+	CreatedAt time.Time    `json:"createdAt,required"`
+	DeletedAt *time.Time   `json:"deletedAt,omitempty"`
+}
+
+func _ID_ToWire(v domain.UUID) (wire.Value, error) {
+	x, err := domain.UUIDToBytes(v)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueBinary(x), error(nil)
+}
+
+func _Timestamp_ToWire(v time.Time) (wire.Value, error) {
+	x, err := domain.TimeToMillis(v)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueI64(x), error(nil)
+}
+
+// ToWire translates a Typedefs struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Typedefs) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.Name.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Nickname != nil {
+		w, err = v.Nickname.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Count != nil {
+		w, err = v.Count.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Aliases != nil {
+		w, err = v.Aliases.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Origin != nil {
+		w, err = v.Origin.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.ID != nil {
+		w, err = _ID_ToWire(*(v.ID))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	w, err = _Timestamp_ToWire(v.CreatedAt)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+	if v.DeletedAt != nil {
+		w, err = _Timestamp_ToWire(*(v.DeletedAt))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Count_Read(w wire.Value) (Count, error) {
+	var x Count
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Names_Read(w wire.Value) (Names, error) {
+	var x Names
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Origin_Read(w wire.Value) (*Origin, error) {
+	var x Origin
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _ID_Read(w wire.Value) (domain.UUID, error) {
+	x, err := w.GetBinary(), error(nil)
+	if err != nil {
+		var x domain.UUID
+		return x, err
+	}
+	return domain.BytesToUUID(x)
+}
+
+func _Timestamp_Read(w wire.Value) (time.Time, error) {
+	x, err := w.GetI64(), error(nil)
+	if err != nil {
+		var x time.Time
+		return x, err
+	}
+	return domain.MillisToTime(x)
+}
+
+// FromWire deserializes a Typedefs struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Typedefs struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Typedefs
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Typedefs) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	createdAtIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _Name_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x Name
+				x, err = _Name_Read(field.Value)
+				v.Nickname = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				var x Count
+				x, err = _Count_Read(field.Value)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Aliases, err = _Names_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.Origin, err = _Origin_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TBinary {
+				var x domain.UUID
+				x, err = _ID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TI64 {
+				v.CreatedAt, err = _Timestamp_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				createdAtIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = _Timestamp_Read(field.Value)
+				v.DeletedAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Typedefs is required")
+	}
+
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of Typedefs is required")
+	}
+
+	return nil
+}
+
+func _ID_Encode(v domain.UUID, sw stream.Writer) error {
+	x, err := domain.UUIDToBytes(v)
+	if err != nil {
+		return err
+	}
+	return sw.WriteBinary(x)
+}
+
+func _Timestamp_Encode(v time.Time, sw stream.Writer) error {
+	x, err := domain.TimeToMillis(v)
+	if err != nil {
+		return err
+	}
+	return sw.WriteInt64(x)
+}
+
+// Encode serializes a Typedefs struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Typedefs struct could not be encoded.
+func (v *Typedefs) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.Name.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Nickname != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.Nickname.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := v.Count.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Aliases != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := v.Aliases.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Origin != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Origin.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := _ID_Encode(*(v.ID), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TI64}); err != nil {
+		return err
+	}
+	if err := _Timestamp_Encode(v.CreatedAt, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.DeletedAt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := _Timestamp_Encode(*(v.DeletedAt), sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Count_Decode(sr stream.Reader) (Count, error) {
+	var x Count
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Names_Decode(sr stream.Reader) (Names, error) {
+	var x Names
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Origin_Decode(sr stream.Reader) (*Origin, error) {
+	var x Origin
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _ID_Decode(sr stream.Reader) (domain.UUID, error) {
+	x, err := sr.ReadBinary()
+	if err != nil {
+		var x domain.UUID
+		return x, err
+	}
+	return domain.BytesToUUID(x)
+}
+
+func _Timestamp_Decode(sr stream.Reader) (time.Time, error) {
+	x, err := sr.ReadInt64()
+	if err != nil {
+		var x time.Time
+		return x, err
+	}
+	return domain.MillisToTime(x)
+}
+
+// Decode deserializes a Typedefs struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Typedefs struct could not be generated from the wire
+// representation.
+func (v *Typedefs) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	createdAtIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = _Name_Decode(sr)
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x Name
+			x, err = _Name_Decode(sr)
+			v.Nickname = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI64:
+			var x Count
+			x, err = _Count_Decode(sr)
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.Aliases, err = _Names_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.Origin, err = _Origin_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBinary:
+			var x domain.UUID
+			x, err = _ID_Decode(sr)
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TI64:
+			v.CreatedAt, err = _Timestamp_Decode(sr)
+			if err != nil {
+				return err
+			}
+			createdAtIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TI64:
+			var x time.Time
+			x, err = _Timestamp_Decode(sr)
+			v.DeletedAt = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Typedefs is required")
+	}
+
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of Typedefs is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Typedefs
+// struct.
+func (v *Typedefs) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Nickname != nil {
+		fields[i] = fmt.Sprintf("Nickname: %v", *(v.Nickname))
+		i++
+	}
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.Aliases != nil {
+		fields[i] = fmt.Sprintf("Aliases: %v", v.Aliases)
+		i++
+	}
+	if v.Origin != nil {
+		fields[i] = fmt.Sprintf("Origin: %v", v.Origin)
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	fields[i] = fmt.Sprintf("CreatedAt: %v", v.CreatedAt)
+	i++
+	if v.DeletedAt != nil {
+		fields[i] = fmt.Sprintf("DeletedAt: %v", *(v.DeletedAt))
+		i++
+	}
+
+	return fmt.Sprintf("Typedefs{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Name_EqualsPtr(lhs, rhs *Name) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Count_EqualsPtr(lhs, rhs *Count) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _ID_Equals(lhs, rhs domain.UUID) bool {
+	x, err := domain.UUIDToBytes(lhs)
+	if err != nil {
+		return false
+	}
+	y, err := domain.UUIDToBytes(rhs)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
+
+func _ID_EqualsPtr(lhs, rhs *domain.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return _ID_Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Timestamp_Equals(lhs, rhs time.Time) bool {
+	x, err := domain.TimeToMillis(lhs)
+	if err != nil {
+		return false
+	}
+	y, err := domain.TimeToMillis(rhs)
+	if err != nil {
+		return false
+	}
+	return (x == y)
+}
+
+func _Timestamp_EqualsPtr(lhs, rhs *time.Time) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return _Timestamp_Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Typedefs match the
+// provided Typedefs.
+//
+// This function performs a deep comparison.
+func (v *Typedefs) Equals(rhs *Typedefs) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_Name_EqualsPtr(v.Nickname, rhs.Nickname) {
+		return false
+	}
+	if !_Count_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if !((v.Aliases == nil && rhs.Aliases == nil) || (v.Aliases != nil && rhs.Aliases != nil && v.Aliases.Equals(rhs.Aliases))) {
+		return false
+	}
+	if !((v.Origin == nil && rhs.Origin == nil) || (v.Origin != nil && rhs.Origin != nil && v.Origin.Equals(rhs.Origin))) {
+		return false
+	}
+	if !_ID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !_Timestamp_Equals(v.CreatedAt, rhs.CreatedAt) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.DeletedAt, rhs.DeletedAt) {
+		return false
+	}
+
+	return true
+}
+
+func _Name_CopyPtr(v *Name) *Name {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Count_CopyPtr(v *Count) *Count {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _ID_CopyPtr(v *domain.UUID) *domain.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Timestamp_CopyPtr(v *time.Time) *time.Time {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Typedefs.
+func (v *Typedefs) Copy() *Typedefs {
+	if v == nil {
+		return nil
+	}
+
+	var o Typedefs
+	o.Name = v.Name
+	o.Nickname = _Name_CopyPtr(v.Nickname)
+	o.Count = _Count_CopyPtr(v.Count)
+	o.Aliases = v.Aliases.Copy()
+	o.Origin = v.Origin.Copy()
+	o.ID = _ID_CopyPtr(v.ID)
+	o.CreatedAt = v.CreatedAt
+	o.DeletedAt = _Timestamp_CopyPtr(v.DeletedAt)
+	return &o
+}
+
+func _ID_Hash(v domain.UUID) uint64 {
+	h := thrifthash.New()
+	if x, err := domain.UUIDToBytes(v); err == nil {
+		h.Binary(x)
+	}
+	return h.Sum64()
+}
+
+func _Timestamp_Hash(v time.Time) uint64 {
+	h := thrifthash.New()
+	if x, err := domain.TimeToMillis(v); err == nil {
+		h.Int64(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Typedefs which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Typedefs) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Name))
+	if v.Nickname != nil {
+		h.Field(2)
+		h.String(string(*v.Nickname))
+	}
+	if v.Count != nil {
+		h.Field(3)
+		h.Int64(int64(*v.Count))
+	}
+	h.Field(4)
+	h.Uint64(v.Aliases.Hash())
+	h.Field(5)
+	h.Uint64(v.Origin.Hash())
+	if v.ID != nil {
+		h.Field(6)
+		h.Uint64(_ID_Hash(*v.ID))
+	}
+	h.Field(7)
+	h.Uint64(_Timestamp_Hash(v.CreatedAt))
+	if v.DeletedAt != nil {
+		h.Field(8)
+		h.Uint64(_Timestamp_Hash(*v.DeletedAt))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Typedefs so that it may be reused.
+func (v *Typedefs) Reset() {
+	*v = Typedefs{}
+}
+
+func _ID_ZapValue(v domain.UUID) []byte {
+	x, _ := domain.UUIDToBytes(v)
+	return x
+}
+
+func _Timestamp_ZapValue(v time.Time) int64 {
+	x, _ := domain.TimeToMillis(v)
+	return x
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Typedefs.
+func (v *Typedefs) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", (string)(v.Name))
+	if v.Nickname != nil {
+		enc.AddString("nickname", (string)(*v.Nickname))
+	}
+	if v.Count != nil {
+		enc.AddInt64("count", (int64)(*v.Count))
+	}
+	if v.Aliases != nil {
+		err = multierr.Append(err, enc.AddArray("aliases", (_List_Name_Zapper)(v.Aliases)))
+	}
+	if v.Origin != nil {
+		err = multierr.Append(err, enc.AddObject("origin", v.Origin))
+	}
+	if v.ID != nil {
+		enc.AddString("id", base64.StdEncoding.EncodeToString(_ID_ZapValue(*v.ID)))
+	}
+	enc.AddInt64("createdAt", _Timestamp_ZapValue(v.CreatedAt))
+	if v.DeletedAt != nil {
+		enc.AddInt64("deletedAt", _Timestamp_ZapValue(*v.DeletedAt))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetName() (o Name) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetNickname returns the value of Nickname if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetNickname() (o Name) {
+	if v != nil && v.Nickname != nil {
+		return *v.Nickname
+	}
+
+	return
+}
+
+// IsSetNickname returns true if Nickname is not nil.
+func (v *Typedefs) IsSetNickname() bool {
+	return v != nil && v.Nickname != nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetCount() (o Count) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *Typedefs) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetAliases returns the value of Aliases if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetAliases() (o Names) {
+	if v != nil && v.Aliases != nil {
+		return v.Aliases
+	}
+
+	return
+}
+
+// IsSetAliases returns true if Aliases is not nil.
+func (v *Typedefs) IsSetAliases() bool {
+	return v != nil && v.Aliases != nil
+}
+
+// GetOrigin returns the value of Origin if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetOrigin() (o *Origin) {
+	if v != nil && v.Origin != nil {
+		return v.Origin
+	}
+
+	return
+}
+
+// IsSetOrigin returns true if Origin is not nil.
+func (v *Typedefs) IsSetOrigin() bool {
+	return v != nil && v.Origin != nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetID() (o domain.UUID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Typedefs) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// GetCreatedAt returns the value of CreatedAt if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetCreatedAt() (o time.Time) {
+	if v != nil {
+		o = v.CreatedAt
+	}
+	return
+}
+
+// GetDeletedAt returns the value of DeletedAt if it is set or its
+// zero value if it is unset.
+func (v *Typedefs) GetDeletedAt() (o time.Time) {
+	if v != nil && v.DeletedAt != nil {
+		return *v.DeletedAt
+	}
+
+	return
+}
+
+// IsSetDeletedAt returns true if DeletedAt is not nil.
+func (v *Typedefs) IsSetDeletedAt() bool {
+	return v != nil && v.DeletedAt != nil
+}
+
+func _ID_EncodedSize(v domain.UUID) int {
+	x, _ := domain.UUIDToBytes(v)
+	return 4 + len(x)
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Typedefs with the Binary protocol, without encoding it. The result
+// is only meaningful if the Typedefs can be encoded.
+func (v *Typedefs) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	n += 3 + v.Name.EncodedSize()
+	if v.Nickname != nil {
+		n += 3 + v.Nickname.EncodedSize()
+	}
+	if v.Count != nil {
+		n += 3 + 8
+	}
+	if v.Aliases != nil {
+		n += 3 + v.Aliases.EncodedSize()
+	}
+	if v.Origin != nil {
+		n += 3 + v.Origin.EncodedSize()
+	}
+	if v.ID != nil {
+		n += 3 + _ID_EncodedSize(*v.ID)
+	}
+	n += 3 + 8
+	if v.DeletedAt != nil {
+		n += 3 + 8
+	}
+	return n
+}
+
+type Unknown struct {
+	Name *string `json:"name,omitempty"`
+
+	unknownFields binary.UnknownFields
+}
+
+// ToWire translates a Unknown struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Unknown) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	all, err := v.unknownFields.ToWire(fields[:i])
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: all}), nil
+}
+
+// FromWire deserializes a Unknown struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Unknown struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Unknown
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Unknown) FromWire(w wire.Value) error {
+	var err error
+
+	v.unknownFields = nil
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		default:
+			if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Unknown struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Unknown struct could not be encoded.
+func (v *Unknown) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := v.unknownFields.Encode(sw); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Unknown struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Unknown struct could not be generated from the wire
+// representation.
+func (v *Unknown) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	v.unknownFields = nil
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := v.unknownFields.Decode(fh, sr); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Unknown
+// struct.
+func (v *Unknown) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Unknown{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Unknown match the
+// provided Unknown.
+//
+// This function performs a deep comparison.
+func (v *Unknown) Equals(rhs *Unknown) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Unknown.
+func (v *Unknown) Copy() *Unknown {
+	if v == nil {
+		return nil
+	}
+
+	var o Unknown
+	o.Name = _String_CopyPtr(v.Name)
+	o.unknownFields = v.unknownFields.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Unknown which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Unknown) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Unknown so that it may be reused.
+func (v *Unknown) Reset() {
+	*v = Unknown{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Unknown.
+func (v *Unknown) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Unknown) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Unknown) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// EncodedSize returns the number of bytes in the encoding of this
+// Unknown with the Binary protocol, without encoding it. The result
+// is only meaningful if the Unknown can be encoded.
+func (v *Unknown) EncodedSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := 1
+	if v.Name != nil {
+		n += 3 + 4 + len(*v.Name)
+	}
+	n += v.unknownFields.Size()
+	return n
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "encoded-size",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/encoded-size",
+	FilePath: "encoded-size.thrift",
+	SHA1:     "65a554ebd62aee1b1f62c8fa2429eaec40ea96ae",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Color {\n    RED\n    GREEN\n}\n\ntypedef string Name\ntypedef i64 Count\ntypedef list<Name> Names\ntypedef Point Origin\n\ntypedef binary ID (\n    go.type = \"go.uber.org/thriftrw/gen/internal/tests/domain.UUID\",\n    go.typeconv = \"go.uber.org/thriftrw/gen/internal/tests/domain.UUIDToBytes/BytesToUUID\",\n)\n\ntypedef i64 Timestamp (\n    go.type = \"time.Time\",\n    go.typeconv = \"go.uber.org/thriftrw/gen/internal/tests/domain.TimeToMillis/MillisToTime\",\n)\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Primitives {\n    1: required bool b\n    2: optional byte tiny\n    3: optional i16 small\n    4: required i32 medium (go.unsigned)\n    5: optional i64 large\n    6: optional double d\n    7: optional string s\n    8: optional binary bin\n    9: optional uuid u\n    10: optional Color color\n}\n\nstruct Containers {\n    1: optional list<i32> ints\n    2: optional list<string> strings\n    3: optional list<Point> points\n    4: optional set<i64> longs\n    5: optional set<string> names\n    6: optional set<Point> (go.type = \"slice\") pointSet\n    7: optional map<i32, i64> fixed\n    8: optional map<string, i32> byName\n    9: optional map<i32, string> byID\n    10: optional map<string, list<string>> nested\n    11: optional map<Point, string> labels\n    12: optional map<string, i32> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map\") ordered\n    13: optional map<i32, string> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.Int32StringMap\") orderedNames\n    14: optional map<string, i32> (go.type = \"keyvalue-slice\") pairs\n}\n\nstruct Typedefs {\n    1: required Name name\n    2: optional Name nickname\n    3: optional Count count\n    4: optional Names aliases\n    5: optional Origin origin\n    6: optional ID id\n    7: required Timestamp createdAt\n    8: optional Timestamp deletedAt\n}\n\nstruct Defaults {\n    1: optional string greeting = \"hello\"\n    2: optional i32 retries = 3\n    3: optional list<string> tags = [\"a\", \"b\"]\n    4: optional string mode = \"fast\" (go.omit_default = \"true\")\n}\n\nstruct Unknown {\n    1: optional string name\n} (go.preserve_unknown_fields = \"true\")\n\nstruct Presence {\n    1: optional i32 a\n    2: optional string b\n} (go.presence_bits = \"true\")\n\nunion Shape {\n    1: Point point\n    2: string name\n}\n\nexception Failure {\n    1: required string message\n}\n\nstruct Sized {\n    1: optional i32 size\n    2: optional string name\n}\n\nstruct Secret {\n    1: optional string token (encrypt = \"tokens\")\n}\n\ntypedef Secret SecretAlias\n\nstruct Secrets {\n    1: optional list<Secret> secrets\n    2: optional Point point\n}\n"
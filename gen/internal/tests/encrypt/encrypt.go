@@ -0,0 +1,2255 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package encrypt
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thriftcrypt "go.uber.org/thriftrw/thriftcrypt"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Address struct {
+	Street string  `json:"street,required"`
+	Zip    *string `json:"zip,omitempty"`
+}
+
+// ToWire translates a Address struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Address) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Street), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Zip != nil {
+		w, err = wire.NewValueString(*(v.Zip)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Address struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Address struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Address
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Address) FromWire(w wire.Value) error {
+	var err error
+
+	streetIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Street, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				streetIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Zip = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Address struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Address struct could not be encoded.
+func (v *Address) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Street); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Zip != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Zip)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Address struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Address struct could not be generated from the wire
+// representation.
+func (v *Address) Decode(sr stream.Reader) error {
+
+	streetIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Street, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			streetIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Zip = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Address
+// struct.
+func (v *Address) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Street: %v", v.Street)
+	i++
+	if v.Zip != nil {
+		fields[i] = fmt.Sprintf("Zip: %v", *(v.Zip))
+		i++
+	}
+
+	return fmt.Sprintf("Address{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Address match the
+// provided Address.
+//
+// This function performs a deep comparison.
+func (v *Address) Equals(rhs *Address) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Street == rhs.Street) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Zip, rhs.Zip) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Address.
+func (v *Address) Copy() *Address {
+	if v == nil {
+		return nil
+	}
+
+	var o Address
+	o.Street = v.Street
+	o.Zip = _String_CopyPtr(v.Zip)
+	return &o
+}
+
+// Hash returns a hash of this Address which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Address) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Street)
+	if v.Zip != nil {
+		h.Field(2)
+		h.String(*v.Zip)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Address so that it may be reused.
+func (v *Address) Reset() {
+	*v = Address{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Address.
+func (v *Address) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("street", v.Street)
+	if v.Zip != nil {
+		enc.AddString("zip", *v.Zip)
+	}
+	return err
+}
+
+// GetStreet returns the value of Street if it is set or its
+// zero value if it is unset.
+func (v *Address) GetStreet() (o string) {
+	if v != nil {
+		o = v.Street
+	}
+	return
+}
+
+// GetZip returns the value of Zip if it is set or its
+// zero value if it is unset.
+func (v *Address) GetZip() (o string) {
+	if v != nil && v.Zip != nil {
+		return *v.Zip
+	}
+
+	return
+}
+
+// IsSetZip returns true if Zip is not nil.
+func (v *Address) IsSetZip() bool {
+	return v != nil && v.Zip != nil
+}
+
+type LazyPayment struct {
+	ID         string  `json:"id,required"`
+	CardNumber *string `json:"cardNumber,omitempty"`
+}
+
+// ToWire translates a LazyPayment struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *LazyPayment) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.CardNumber != nil {
+		w, err = wire.NewValueString(*(v.CardNumber)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("payments", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a LazyPayment struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a LazyPayment struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v LazyPayment
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *LazyPayment) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("payments", field.Value, wire.TBinary)
+				if err != nil {
+					return err
+				}
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CardNumber = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of LazyPayment is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a LazyPayment struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a LazyPayment struct could not be encoded.
+func (v *LazyPayment) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.CardNumber != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "payments", func(esw stream.Writer) error {
+			return esw.WriteString(*(v.CardNumber))
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a LazyPayment struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a LazyPayment struct could not be generated from the wire
+// representation.
+func (v *LazyPayment) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "payments", func(dsr stream.Reader) (err error) {
+				var x string
+				x, err = dsr.ReadString()
+				v.CardNumber = &x
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of LazyPayment is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a LazyPayment
+// struct.
+func (v *LazyPayment) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.CardNumber != nil {
+		fields[i] = fmt.Sprintf("CardNumber: %v", *(v.CardNumber))
+		i++
+	}
+
+	return fmt.Sprintf("LazyPayment{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this LazyPayment match the
+// provided LazyPayment.
+//
+// This function performs a deep comparison.
+func (v *LazyPayment) Equals(rhs *LazyPayment) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CardNumber, rhs.CardNumber) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this LazyPayment.
+func (v *LazyPayment) Copy() *LazyPayment {
+	if v == nil {
+		return nil
+	}
+
+	var o LazyPayment
+	o.ID = v.ID
+	o.CardNumber = _String_CopyPtr(v.CardNumber)
+	return &o
+}
+
+// Hash returns a hash of this LazyPayment which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *LazyPayment) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.CardNumber != nil {
+		h.Field(2)
+		h.String(*v.CardNumber)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this LazyPayment so that it may be reused.
+func (v *LazyPayment) Reset() {
+	*v = LazyPayment{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of LazyPayment.
+func (v *LazyPayment) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.CardNumber != nil {
+		enc.AddString("cardNumber", *v.CardNumber)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *LazyPayment) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetCardNumber returns the value of CardNumber if it is set or its
+// zero value if it is unset.
+func (v *LazyPayment) GetCardNumber() (o string) {
+	if v != nil && v.CardNumber != nil {
+		return *v.CardNumber
+	}
+
+	return
+}
+
+// IsSetCardNumber returns true if CardNumber is not nil.
+func (v *LazyPayment) IsSetCardNumber() bool {
+	return v != nil && v.CardNumber != nil
+}
+
+// LazyPayment_Lazy provides access to the fields of a Thrift Binary Protocol
+// encoded LazyPayment, decoding each field only when it is first
+// accessed. Decoded fields are retained until the next call to Reset.
+//
+// LazyPayment_Lazy is not safe for concurrent use.
+type LazyPayment_Lazy struct {
+	raw     binary.LazyStruct
+	v       LazyPayment
+	decoded [2]bool
+}
+
+// Reset discards all decoded fields and starts reading from raw,
+// which holds a Thrift Binary Protocol encoded LazyPayment. raw must
+// not be modified while it is in use.
+func (v *LazyPayment_Lazy) Reset(raw []byte) {
+	v.raw.Reset(raw)
+	v.v = LazyPayment{}
+	v.decoded = [2]bool{}
+}
+
+// Bytes returns the encoded LazyPayment.
+func (v *LazyPayment_Lazy) Bytes() []byte {
+	return v.raw.Bytes()
+}
+
+// Struct decodes all fields into a new LazyPayment.
+func (v *LazyPayment_Lazy) Struct() (*LazyPayment, error) {
+	var x LazyPayment
+	sr := binary.NewStreamReader(bytes.NewReader(v.raw.Bytes()))
+	defer sr.Close()
+	err := x.Decode(sr)
+	return &x, err
+}
+
+// GetID returns the value of ID, decoding it if it
+// has not been decoded yet.
+func (v *LazyPayment_Lazy) GetID() (o string, err error) {
+	if err = v.decode(0); err == nil {
+		o = v.v.GetID()
+	}
+	return
+}
+
+// GetCardNumber returns the value of CardNumber, decoding it if it
+// has not been decoded yet.
+func (v *LazyPayment_Lazy) GetCardNumber() (o string, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.GetCardNumber()
+	}
+	return
+}
+
+// IsSetCardNumber returns true if CardNumber is set, decoding it
+// if it has not been decoded yet.
+func (v *LazyPayment_Lazy) IsSetCardNumber() (o bool, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.IsSetCardNumber()
+	}
+	return
+}
+
+// decode decodes the field at the given index if it has not been
+// decoded yet.
+func (v *LazyPayment_Lazy) decode(i int) error {
+	if v.decoded[i] {
+		return nil
+	}
+
+	var (
+		fr  stream.Reader
+		ok  bool
+		err error
+	)
+	switch i {
+	case 0:
+		fr, ok, err = v.raw.Field(1, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.ID, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.New("field ID of LazyPayment is required")
+		}
+	case 1:
+		fr, ok, err = v.raw.Field(2, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = thriftcrypt.DecodeSealed(fr, "payments", func(dsr stream.Reader) (err error) {
+				var x string
+				x, err = dsr.ReadString()
+				v.v.CardNumber = &x
+				return err
+			})
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	v.decoded[i] = true
+	return nil
+}
+
+type Payment struct {
+	ID         string   `json:"id,required"`
+	CardNumber string   `json:"cardNumber,required"`
+	Cvv        *int32   `json:"cvv,omitempty"`
+	Billing    *Address `json:"billing,omitempty"`
+	Notes      []string `json:"notes,omitempty"`
+	Token      []byte   `json:"token,omitempty"`
+	Currency   *string  `json:"currency,omitempty"`
+	Shipping   *Address `json:"shipping,required"`
+}
+
+// Default_Payment constructs a new Payment struct,
+// pre-populating any fields with defined default values.
+func Default_Payment() *Payment {
+	var v Payment
+	v.Currency = ptr.String("USD")
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Payment struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Payment) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.CardNumber), error(nil)
+	if err != nil {
+		return w, err
+	}
+	w, err = thriftcrypt.Seal("payments", w)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Cvv != nil {
+		w, err = wire.NewValueI32(*(v.Cvv)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("payments", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Billing != nil {
+		w, err = v.Billing.ToWire()
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("addresses", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Notes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Notes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("payments", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Token != nil {
+		w, err = wire.NewValueBinary(v.Token), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("tokens", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	vCurrency := v.Currency
+	if vCurrency == nil {
+		vCurrency = ptr.String("USD")
+	}
+	{
+		w, err = wire.NewValueString(*(vCurrency)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("payments", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Shipping == nil {
+		return w, errors.New("field Shipping of Payment is required")
+	}
+	w, err = v.Shipping.ToWire()
+	if err != nil {
+		return w, err
+	}
+	w, err = thriftcrypt.Seal("addresses", w)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 8, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Address_Read(w wire.Value) (*Address, error) {
+	var v Address
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Payment struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Payment struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Payment
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Payment) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+	cardNumberIsSet := false
+
+	shippingIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("payments", field.Value, wire.TBinary)
+				if err != nil {
+					return err
+				}
+				v.CardNumber, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				cardNumberIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("payments", field.Value, wire.TI32)
+				if err != nil {
+					return err
+				}
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Cvv = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("addresses", field.Value, wire.TStruct)
+				if err != nil {
+					return err
+				}
+				v.Billing, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("payments", field.Value, wire.TList)
+				if err != nil {
+					return err
+				}
+				v.Notes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("tokens", field.Value, wire.TBinary)
+				if err != nil {
+					return err
+				}
+				v.Token, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("payments", field.Value, wire.TBinary)
+				if err != nil {
+					return err
+				}
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Currency = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("addresses", field.Value, wire.TStruct)
+				if err != nil {
+					return err
+				}
+				v.Shipping, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				shippingIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Payment is required")
+	}
+
+	if !cardNumberIsSet {
+		return errors.New("field CardNumber of Payment is required")
+	}
+
+	if v.Currency == nil {
+		v.Currency = ptr.String("USD")
+	}
+
+	if !shippingIsSet {
+		return errors.New("field Shipping of Payment is required")
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Payment struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Payment struct could not be encoded.
+func (v *Payment) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := thriftcrypt.EncodeSealed(sw, "payments", func(esw stream.Writer) error {
+		return esw.WriteString(v.CardNumber)
+	}); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Cvv != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "payments", func(esw stream.Writer) error {
+			return esw.WriteInt32(*(v.Cvv))
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Billing != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "addresses", func(esw stream.Writer) error {
+			return v.Billing.Encode(esw)
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Notes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "payments", func(esw stream.Writer) error {
+			return _List_String_Encode(v.Notes, esw)
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Token != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "tokens", func(esw stream.Writer) error {
+			return esw.WriteBinary(v.Token)
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vCurrency := v.Currency
+	if vCurrency == nil {
+		vCurrency = ptr.String("USD")
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "payments", func(esw stream.Writer) error {
+			return esw.WriteString(*(vCurrency))
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Shipping == nil {
+		return errors.New("field Shipping of Payment is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := thriftcrypt.EncodeSealed(sw, "addresses", func(esw stream.Writer) error {
+		return v.Shipping.Encode(esw)
+	}); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Address_Decode(sr stream.Reader) (*Address, error) {
+	var v Address
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Payment struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Payment struct could not be generated from the wire
+// representation.
+func (v *Payment) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+	cardNumberIsSet := false
+
+	shippingIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "payments", func(dsr stream.Reader) (err error) {
+				v.CardNumber, err = dsr.ReadString()
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			cardNumberIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "payments", func(dsr stream.Reader) (err error) {
+				var x int32
+				x, err = dsr.ReadInt32()
+				v.Cvv = &x
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "addresses", func(dsr stream.Reader) (err error) {
+				v.Billing, err = _Address_Decode(dsr)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "payments", func(dsr stream.Reader) (err error) {
+				v.Notes, err = _List_String_Decode(dsr)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "tokens", func(dsr stream.Reader) (err error) {
+				v.Token, err = dsr.ReadBinary()
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "payments", func(dsr stream.Reader) (err error) {
+				var x string
+				x, err = dsr.ReadString()
+				v.Currency = &x
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "addresses", func(dsr stream.Reader) (err error) {
+				v.Shipping, err = _Address_Decode(dsr)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			shippingIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Payment is required")
+	}
+
+	if !cardNumberIsSet {
+		return errors.New("field CardNumber of Payment is required")
+	}
+
+	if v.Currency == nil {
+		v.Currency = ptr.String("USD")
+	}
+
+	if !shippingIsSet {
+		return errors.New("field Shipping of Payment is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Payment
+// struct.
+func (v *Payment) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	fields[i] = fmt.Sprintf("CardNumber: %v", v.CardNumber)
+	i++
+	if v.Cvv != nil {
+		fields[i] = fmt.Sprintf("Cvv: %v", *(v.Cvv))
+		i++
+	}
+	if v.Billing != nil {
+		fields[i] = fmt.Sprintf("Billing: %v", v.Billing)
+		i++
+	}
+	if v.Notes != nil {
+		fields[i] = fmt.Sprintf("Notes: %v", v.Notes)
+		i++
+	}
+	if v.Token != nil {
+		fields[i] = fmt.Sprintf("Token: %v", v.Token)
+		i++
+	}
+	if v.Currency != nil {
+		fields[i] = fmt.Sprintf("Currency: %v", *(v.Currency))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Shipping: %v", v.Shipping)
+	i++
+
+	return fmt.Sprintf("Payment{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Payment match the
+// provided Payment.
+//
+// This function performs a deep comparison.
+func (v *Payment) Equals(rhs *Payment) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !(v.CardNumber == rhs.CardNumber) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Cvv, rhs.Cvv) {
+		return false
+	}
+	if !((v.Billing == nil && rhs.Billing == nil) || (v.Billing != nil && rhs.Billing != nil && v.Billing.Equals(rhs.Billing))) {
+		return false
+	}
+	if !((v.Notes == nil && rhs.Notes == nil) || (v.Notes != nil && rhs.Notes != nil && _List_String_Equals(v.Notes, rhs.Notes))) {
+		return false
+	}
+	if !((v.Token == nil && rhs.Token == nil) || (v.Token != nil && rhs.Token != nil && bytes.Equal(v.Token, rhs.Token))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Currency, rhs.Currency) {
+		return false
+	}
+	if !v.Shipping.Equals(rhs.Shipping) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Payment.
+func (v *Payment) Copy() *Payment {
+	if v == nil {
+		return nil
+	}
+
+	var o Payment
+	o.ID = v.ID
+	o.CardNumber = v.CardNumber
+	o.Cvv = _I32_CopyPtr(v.Cvv)
+	o.Billing = v.Billing.Copy()
+	o.Notes = _List_String_Copy(v.Notes)
+	o.Token = _Binary_Copy(v.Token)
+	o.Currency = _String_CopyPtr(v.Currency)
+	o.Shipping = v.Shipping.Copy()
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Payment which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Payment) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	h.Field(2)
+	h.String(v.CardNumber)
+	if v.Cvv != nil {
+		h.Field(3)
+		h.Int32(*v.Cvv)
+	}
+	h.Field(4)
+	h.Uint64(v.Billing.Hash())
+	h.Field(5)
+	h.Uint64(_List_String_Hash(v.Notes))
+	h.Field(6)
+	h.Binary(v.Token)
+	if v.Currency != nil {
+		h.Field(7)
+		h.String(*v.Currency)
+	}
+	h.Field(8)
+	h.Uint64(v.Shipping.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Payment so that it may be reused.
+func (v *Payment) Reset() {
+	*v = Payment{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Payment.
+func (v *Payment) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	enc.AddString("cardNumber", v.CardNumber)
+	if v.Cvv != nil {
+		enc.AddInt32("cvv", *v.Cvv)
+	}
+	if v.Billing != nil {
+		err = multierr.Append(err, enc.AddObject("billing", v.Billing))
+	}
+	if v.Notes != nil {
+		err = multierr.Append(err, enc.AddArray("notes", (_List_String_Zapper)(v.Notes)))
+	}
+	if v.Token != nil {
+		enc.AddString("token", base64.StdEncoding.EncodeToString(v.Token))
+	}
+	if v.Currency != nil {
+		enc.AddString("currency", *v.Currency)
+	}
+	err = multierr.Append(err, enc.AddObject("shipping", v.Shipping))
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetCardNumber returns the value of CardNumber if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetCardNumber() (o string) {
+	if v != nil {
+		o = v.CardNumber
+	}
+	return
+}
+
+// GetCvv returns the value of Cvv if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetCvv() (o int32) {
+	if v != nil && v.Cvv != nil {
+		return *v.Cvv
+	}
+
+	return
+}
+
+// IsSetCvv returns true if Cvv is not nil.
+func (v *Payment) IsSetCvv() bool {
+	return v != nil && v.Cvv != nil
+}
+
+// GetBilling returns the value of Billing if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetBilling() (o *Address) {
+	if v != nil && v.Billing != nil {
+		return v.Billing
+	}
+
+	return
+}
+
+// IsSetBilling returns true if Billing is not nil.
+func (v *Payment) IsSetBilling() bool {
+	return v != nil && v.Billing != nil
+}
+
+// GetNotes returns the value of Notes if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetNotes() (o []string) {
+	if v != nil && v.Notes != nil {
+		return v.Notes
+	}
+
+	return
+}
+
+// IsSetNotes returns true if Notes is not nil.
+func (v *Payment) IsSetNotes() bool {
+	return v != nil && v.Notes != nil
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetToken() (o []byte) {
+	if v != nil && v.Token != nil {
+		return v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is not nil.
+func (v *Payment) IsSetToken() bool {
+	return v != nil && v.Token != nil
+}
+
+// GetCurrency returns the value of Currency if it is set or its
+// default value if it is unset.
+func (v *Payment) GetCurrency() (o string) {
+	if v != nil && v.Currency != nil {
+		return *v.Currency
+	}
+	o = "USD"
+	return
+}
+
+// IsSetCurrency returns true if Currency is not nil.
+func (v *Payment) IsSetCurrency() bool {
+	return v != nil && v.Currency != nil
+}
+
+// GetShipping returns the value of Shipping if it is set or its
+// zero value if it is unset.
+func (v *Payment) GetShipping() (o *Address) {
+	if v != nil {
+		o = v.Shipping
+	}
+	return
+}
+
+// IsSetShipping returns true if Shipping is not nil.
+func (v *Payment) IsSetShipping() bool {
+	return v != nil && v.Shipping != nil
+}
+
+type Secret struct {
+	Password *string `json:"password,omitempty"`
+	Hint     *string `json:"hint,omitempty"`
+}
+
+// ToWire translates a Secret struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Secret) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Password != nil {
+		w, err = wire.NewValueString(*(v.Password)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		w, err = thriftcrypt.Seal("secrets", w)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Hint != nil {
+		w, err = wire.NewValueString(*(v.Hint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Secret should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Secret struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Secret struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Secret
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Secret) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				field.Value, err = thriftcrypt.Open("secrets", field.Value, wire.TBinary)
+				if err != nil {
+					return err
+				}
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Password = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Hint = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Password != nil {
+		count++
+	}
+	if v.Hint != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Secret should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Secret struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Secret struct could not be encoded.
+func (v *Secret) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Password != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := thriftcrypt.EncodeSealed(sw, "secrets", func(esw stream.Writer) error {
+			return esw.WriteString(*(v.Password))
+		}); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Hint != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Hint)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Password != nil {
+		count++
+	}
+	if v.Hint != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Secret should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Secret struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Secret struct could not be generated from the wire
+// representation.
+func (v *Secret) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			err = thriftcrypt.DecodeSealed(sr, "secrets", func(dsr stream.Reader) (err error) {
+				var x string
+				x, err = dsr.ReadString()
+				v.Password = &x
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Hint = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Password != nil {
+		count++
+	}
+	if v.Hint != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Secret should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Secret
+// struct.
+func (v *Secret) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Password != nil {
+		fields[i] = fmt.Sprintf("Password: %v", *(v.Password))
+		i++
+	}
+	if v.Hint != nil {
+		fields[i] = fmt.Sprintf("Hint: %v", *(v.Hint))
+		i++
+	}
+
+	return fmt.Sprintf("Secret{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Secret match the
+// provided Secret.
+//
+// This function performs a deep comparison.
+func (v *Secret) Equals(rhs *Secret) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Password, rhs.Password) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Hint, rhs.Hint) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Secret.
+func (v *Secret) Copy() *Secret {
+	if v == nil {
+		return nil
+	}
+
+	var o Secret
+	o.Password = _String_CopyPtr(v.Password)
+	o.Hint = _String_CopyPtr(v.Hint)
+	return &o
+}
+
+// Hash returns a hash of this Secret which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Secret) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Password != nil {
+		h.Field(1)
+		h.String(*v.Password)
+	}
+	if v.Hint != nil {
+		h.Field(2)
+		h.String(*v.Hint)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Secret so that it may be reused.
+func (v *Secret) Reset() {
+	*v = Secret{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Secret.
+func (v *Secret) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Password != nil {
+		enc.AddString("password", *v.Password)
+	}
+	if v.Hint != nil {
+		enc.AddString("hint", *v.Hint)
+	}
+	return err
+}
+
+// GetPassword returns the value of Password if it is set or its
+// zero value if it is unset.
+func (v *Secret) GetPassword() (o string) {
+	if v != nil && v.Password != nil {
+		return *v.Password
+	}
+
+	return
+}
+
+// IsSetPassword returns true if Password is not nil.
+func (v *Secret) IsSetPassword() bool {
+	return v != nil && v.Password != nil
+}
+
+// LookupPassword returns the value of Password and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Secret) LookupPassword() (o string, _ bool) {
+	if v != nil && v.Password != nil {
+		return *v.Password, true
+	}
+	return o, false
+}
+
+// SetPassword sets the value of Password and unsets all other
+// fields of this Secret.
+func (v *Secret) SetPassword(x string) {
+	*v = Secret{}
+	v.Password = &x
+}
+
+// GetHint returns the value of Hint if it is set or its
+// zero value if it is unset.
+func (v *Secret) GetHint() (o string) {
+	if v != nil && v.Hint != nil {
+		return *v.Hint
+	}
+
+	return
+}
+
+// IsSetHint returns true if Hint is not nil.
+func (v *Secret) IsSetHint() bool {
+	return v != nil && v.Hint != nil
+}
+
+// LookupHint returns the value of Hint and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Secret) LookupHint() (o string, _ bool) {
+	if v != nil && v.Hint != nil {
+		return *v.Hint, true
+	}
+	return o, false
+}
+
+// SetHint sets the value of Hint and unsets all other
+// fields of this Secret.
+func (v *Secret) SetHint(x string) {
+	*v = Secret{}
+	v.Hint = &x
+}
+
+// Which returns the ID of the field of this Secret which is set, or
+// 0 if no field is set.
+func (v *Secret) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Password != nil {
+		return 1
+	}
+	if v.Hint != nil {
+		return 2
+	}
+	return 0
+}
+
+// Secret_Visitor visits the field of a Secret which is set.
+//
+// Fields added to Secret add methods to Secret_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Secret_Visitor interface {
+	// VisitPassword is called with the value of Password if it is set.
+	VisitPassword(string) error
+
+	// VisitHint is called with the value of Hint if it is set.
+	VisitHint(string) error
+
+	// Default is called if no field of Secret is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Secret
+// which is set, or Default if none is, and returns its error.
+func (v *Secret) Match(visitor Secret_Visitor) error {
+	if v != nil {
+		if v.Password != nil {
+			return visitor.VisitPassword(*v.Password)
+		}
+		if v.Hint != nil {
+			return visitor.VisitHint(*v.Hint)
+		}
+	}
+	return visitor.Default()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "encrypt",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/encrypt",
+	FilePath: "encrypt.thrift",
+	SHA1:     "09e856fa7fcd486a7f4cc27bdd4e2d2795e20bbf",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Address {\n    1: required string street\n    2: optional string zip\n}\n\nstruct Payment {\n    1: required string id\n    2: required string cardNumber (encrypt = \"payments\")\n    3: optional i32 cvv (encrypt = \"payments\")\n    4: optional Address billing (encrypt = \"addresses\")\n    5: optional list<string> notes (encrypt = \"payments\")\n    6: optional binary token (encrypt = \"tokens\")\n    7: optional string currency = \"USD\" (encrypt = \"payments\")\n    8: required Address shipping (encrypt = \"addresses\")\n}\n\nunion Secret {\n    1: string password (encrypt = \"secrets\")\n    2: string hint\n}\n\nstruct LazyPayment {\n    1: required string id\n    2: optional string cardNumber (encrypt = \"payments\")\n} (go.lazy = \"true\")\n"
@@ -34,6 +34,27 @@ func EnumMarshalStrict_Values() []EnumMarshalStrict {
 	}
 }
 
+// EnumMarshalStrict_Names returns the names of all recognized values of
+// EnumMarshalStrict, in the same order as EnumMarshalStrict_Values.
+func EnumMarshalStrict_Names() []string {
+	return []string{
+		"Foo",
+		"Bar",
+		"Baz",
+		"Bat",
+	}
+}
+
+// IsValid returns true if this EnumMarshalStrict is one of its recognized
+// values.
+func (v EnumMarshalStrict) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2, 3:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumMarshalStrict from a byte slice
 // containing its name.
 //
@@ -107,6 +128,22 @@ func (v EnumMarshalStrict) Ptr() *EnumMarshalStrict {
 	return &v
 }
 
+// Set sets EnumMarshalStrict from its name or integer value.
+//
+// This implements flag.Value, allowing EnumMarshalStrict to be used as a
+// command line flag.
+func (v *EnumMarshalStrict) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumMarshalStrict) Type() string {
+	return "EnumMarshalStrict"
+}
+
 // Encode encodes EnumMarshalStrict directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -10,6 +10,7 @@ import (
 	multierr "go.uber.org/multierr"
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -37,6 +38,25 @@ func RecordType_Values() []RecordType {
 	}
 }
 
+// RecordType_Names returns the names of all recognized values of
+// RecordType, in the same order as RecordType_Values.
+func RecordType_Names() []string {
+	return []string{
+		"Name",
+		"Email",
+	}
+}
+
+// IsValid returns true if this RecordType is one of its recognized
+// values.
+func (v RecordType) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode RecordType from a byte slice
 // containing its name.
 //
@@ -96,6 +116,22 @@ func (v RecordType) Ptr() *RecordType {
 	return &v
 }
 
+// Set sets RecordType from its name or integer value.
+//
+// This implements flag.Value, allowing RecordType to be used as a
+// command line flag.
+func (v *RecordType) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v RecordType) Type() string {
+	return "RecordType"
+}
+
 // Encode encodes RecordType directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -543,6 +579,60 @@ func (v *Records) Equals(rhs *Records) bool {
 	return true
 }
 
+func _RecordType_CopyPtr(v *RecordType) *RecordType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _RecordType_1_CopyPtr(v *enums.RecordType) *enums.RecordType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Records.
+func (v *Records) Copy() *Records {
+	if v == nil {
+		return nil
+	}
+
+	var o Records
+	o.RecordType = _RecordType_CopyPtr(v.RecordType)
+	o.OtherRecordType = _RecordType_1_CopyPtr(v.OtherRecordType)
+	return &o
+}
+
+// Hash returns a hash of this Records which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Records) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.RecordType != nil {
+		h.Field(1)
+		h.Int32(int32(*v.RecordType))
+	}
+	if v.OtherRecordType != nil {
+		h.Field(2)
+		h.Int32(int32(*v.OtherRecordType))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Records so that it may be reused.
+func (v *Records) Reset() {
+	*v = Records{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Records.
 func (v *Records) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
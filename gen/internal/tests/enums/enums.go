@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -24,6 +25,18 @@ func EmptyEnum_Values() []EmptyEnum {
 	return []EmptyEnum{}
 }
 
+// EmptyEnum_Names returns the names of all recognized values of
+// EmptyEnum, in the same order as EmptyEnum_Values.
+func EmptyEnum_Names() []string {
+	return []string{}
+}
+
+// IsValid returns true if this EmptyEnum is one of its recognized
+// values.
+func (v EmptyEnum) IsValid() bool {
+	return false
+}
+
 // UnmarshalText tries to decode EmptyEnum from a byte slice
 // containing its name.
 func (v *EmptyEnum) UnmarshalText(value []byte) error {
@@ -62,6 +75,22 @@ func (v EmptyEnum) Ptr() *EmptyEnum {
 	return &v
 }
 
+// Set sets EmptyEnum from its name or integer value.
+//
+// This implements flag.Value, allowing EmptyEnum to be used as a
+// command line flag.
+func (v *EmptyEnum) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EmptyEnum) Type() string {
+	return "EmptyEnum"
+}
+
 // Encode encodes EmptyEnum directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -192,6 +221,26 @@ func EnumDefault_Values() []EnumDefault {
 	}
 }
 
+// EnumDefault_Names returns the names of all recognized values of
+// EnumDefault, in the same order as EnumDefault_Values.
+func EnumDefault_Names() []string {
+	return []string{
+		"Foo",
+		"Bar",
+		"Baz",
+	}
+}
+
+// IsValid returns true if this EnumDefault is one of its recognized
+// values.
+func (v EnumDefault) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumDefault from a byte slice
 // containing its name.
 //
@@ -258,6 +307,22 @@ func (v EnumDefault) Ptr() *EnumDefault {
 	return &v
 }
 
+// Set sets EnumDefault from its name or integer value.
+//
+// This implements flag.Value, allowing EnumDefault to be used as a
+// command line flag.
+func (v *EnumDefault) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumDefault) Type() string {
+	return "EnumDefault"
+}
+
 // Encode encodes EnumDefault directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -416,6 +481,32 @@ func EnumWithDuplicateName_Values() []EnumWithDuplicateName {
 	}
 }
 
+// EnumWithDuplicateName_Names returns the names of all recognized values of
+// EnumWithDuplicateName, in the same order as EnumWithDuplicateName_Values.
+func EnumWithDuplicateName_Names() []string {
+	return []string{
+		"A",
+		"B",
+		"C",
+		"P",
+		"Q",
+		"R",
+		"X",
+		"Y",
+		"Z",
+	}
+}
+
+// IsValid returns true if this EnumWithDuplicateName is one of its recognized
+// values.
+func (v EnumWithDuplicateName) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2, 3, 4, 5, 6, 7, 8:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumWithDuplicateName from a byte slice
 // containing its name.
 //
@@ -524,6 +615,22 @@ func (v EnumWithDuplicateName) Ptr() *EnumWithDuplicateName {
 	return &v
 }
 
+// Set sets EnumWithDuplicateName from its name or integer value.
+//
+// This implements flag.Value, allowing EnumWithDuplicateName to be used as a
+// command line flag.
+func (v *EnumWithDuplicateName) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumWithDuplicateName) Type() string {
+	return "EnumWithDuplicateName"
+}
+
 // Encode encodes EnumWithDuplicateName directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -694,6 +801,26 @@ func EnumWithDuplicateValues_Values() []EnumWithDuplicateValues {
 	}
 }
 
+// EnumWithDuplicateValues_Names returns the names of all recognized values of
+// EnumWithDuplicateValues, in the same order as EnumWithDuplicateValues_Values.
+func EnumWithDuplicateValues_Names() []string {
+	return []string{
+		"P",
+		"Q",
+		"R",
+	}
+}
+
+// IsValid returns true if this EnumWithDuplicateValues is one of its recognized
+// values.
+func (v EnumWithDuplicateValues) IsValid() bool {
+	switch int32(v) {
+	case 0, -1:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumWithDuplicateValues from a byte slice
 // containing its name.
 //
@@ -756,6 +883,22 @@ func (v EnumWithDuplicateValues) Ptr() *EnumWithDuplicateValues {
 	return &v
 }
 
+// Set sets EnumWithDuplicateValues from its name or integer value.
+//
+// This implements flag.Value, allowing EnumWithDuplicateValues to be used as a
+// command line flag.
+func (v *EnumWithDuplicateValues) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumWithDuplicateValues) Type() string {
+	return "EnumWithDuplicateValues"
+}
+
 // Encode encodes EnumWithDuplicateValues directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -898,6 +1041,26 @@ func EnumWithHexValues_Values() []EnumWithHexValues {
 	}
 }
 
+// EnumWithHexValues_Names returns the names of all recognized values of
+// EnumWithHexValues, in the same order as EnumWithHexValues_Values.
+func EnumWithHexValues_Names() []string {
+	return []string{
+		"X",
+		"Y",
+		"Z",
+	}
+}
+
+// IsValid returns true if this EnumWithHexValues is one of its recognized
+// values.
+func (v EnumWithHexValues) IsValid() bool {
+	switch int32(v) {
+	case 291, 1110, 1929:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumWithHexValues from a byte slice
 // containing its name.
 //
@@ -964,6 +1127,22 @@ func (v EnumWithHexValues) Ptr() *EnumWithHexValues {
 	return &v
 }
 
+// Set sets EnumWithHexValues from its name or integer value.
+//
+// This implements flag.Value, allowing EnumWithHexValues to be used as a
+// command line flag.
+func (v *EnumWithHexValues) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumWithHexValues) Type() string {
+	return "EnumWithHexValues"
+}
+
 // Encode encodes EnumWithHexValues directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -1116,6 +1295,29 @@ func EnumWithLabel_Values() []EnumWithLabel {
 	}
 }
 
+// EnumWithLabel_Names returns the names of all recognized values of
+// EnumWithLabel, in the same order as EnumWithLabel_Values.
+func EnumWithLabel_Names() []string {
+	return []string{
+		"surname",
+		"hashed_password",
+		"SALT",
+		"SUGAR",
+		"RELAY",
+		"function",
+	}
+}
+
+// IsValid returns true if this EnumWithLabel is one of its recognized
+// values.
+func (v EnumWithLabel) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2, 3, 4, 5:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumWithLabel from a byte slice
 // containing its name.
 //
@@ -1203,6 +1405,22 @@ func (v EnumWithLabel) Ptr() *EnumWithLabel {
 	return &v
 }
 
+// Set sets EnumWithLabel from its name or integer value.
+//
+// This implements flag.Value, allowing EnumWithLabel to be used as a
+// command line flag.
+func (v *EnumWithLabel) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumWithLabel) Type() string {
+	return "EnumWithLabel"
+}
+
 // Encode encodes EnumWithLabel directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -1361,6 +1579,26 @@ func EnumWithValues_Values() []EnumWithValues {
 	}
 }
 
+// EnumWithValues_Names returns the names of all recognized values of
+// EnumWithValues, in the same order as EnumWithValues_Values.
+func EnumWithValues_Names() []string {
+	return []string{
+		"X",
+		"Y",
+		"Z",
+	}
+}
+
+// IsValid returns true if this EnumWithValues is one of its recognized
+// values.
+func (v EnumWithValues) IsValid() bool {
+	switch int32(v) {
+	case 123, 456, 789:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumWithValues from a byte slice
 // containing its name.
 //
@@ -1427,6 +1665,22 @@ func (v EnumWithValues) Ptr() *EnumWithValues {
 	return &v
 }
 
+// Set sets EnumWithValues from its name or integer value.
+//
+// This implements flag.Value, allowing EnumWithValues to be used as a
+// command line flag.
+func (v *EnumWithValues) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumWithValues) Type() string {
+	return "EnumWithValues"
+}
+
 // Encode encodes EnumWithValues directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -1581,6 +1835,26 @@ func RecordType_Values() []RecordType {
 	}
 }
 
+// RecordType_Names returns the names of all recognized values of
+// RecordType, in the same order as RecordType_Values.
+func RecordType_Names() []string {
+	return []string{
+		"NAME",
+		"HOME_ADDRESS",
+		"WORK_ADDRESS",
+	}
+}
+
+// IsValid returns true if this RecordType is one of its recognized
+// values.
+func (v RecordType) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode RecordType from a byte slice
 // containing its name.
 //
@@ -1647,6 +1921,22 @@ func (v RecordType) Ptr() *RecordType {
 	return &v
 }
 
+// Set sets RecordType from its name or integer value.
+//
+// This implements flag.Value, allowing RecordType to be used as a
+// command line flag.
+func (v *RecordType) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v RecordType) Type() string {
+	return "RecordType"
+}
+
 // Encode encodes RecordType directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -1791,6 +2081,25 @@ func RecordTypeValues_Values() []RecordTypeValues {
 	}
 }
 
+// RecordTypeValues_Names returns the names of all recognized values of
+// RecordTypeValues, in the same order as RecordTypeValues_Values.
+func RecordTypeValues_Names() []string {
+	return []string{
+		"FOO",
+		"BAR",
+	}
+}
+
+// IsValid returns true if this RecordTypeValues is one of its recognized
+// values.
+func (v RecordTypeValues) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode RecordTypeValues from a byte slice
 // containing its name.
 //
@@ -1850,6 +2159,22 @@ func (v RecordTypeValues) Ptr() *RecordTypeValues {
 	return &v
 }
 
+// Set sets RecordTypeValues from its name or integer value.
+//
+// This implements flag.Value, allowing RecordTypeValues to be used as a
+// command line flag.
+func (v *RecordTypeValues) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v RecordTypeValues) Type() string {
+	return "RecordTypeValues"
+}
+
 // Encode encodes RecordTypeValues directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -2180,6 +2505,46 @@ func (v *StructWithOptionalEnum) Equals(rhs *StructWithOptionalEnum) bool {
 	return true
 }
 
+func _EnumDefault_CopyPtr(v *EnumDefault) *EnumDefault {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this StructWithOptionalEnum.
+func (v *StructWithOptionalEnum) Copy() *StructWithOptionalEnum {
+	if v == nil {
+		return nil
+	}
+
+	var o StructWithOptionalEnum
+	o.E = _EnumDefault_CopyPtr(v.E)
+	return &o
+}
+
+// Hash returns a hash of this StructWithOptionalEnum which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *StructWithOptionalEnum) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.E != nil {
+		h.Field(1)
+		h.Int32(int32(*v.E))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this StructWithOptionalEnum so that it may be reused.
+func (v *StructWithOptionalEnum) Reset() {
+	*v = StructWithOptionalEnum{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructWithOptionalEnum.
 func (v *StructWithOptionalEnum) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2224,6 +2589,26 @@ func LowerCaseEnum_Values() []LowerCaseEnum {
 	}
 }
 
+// LowerCaseEnum_Names returns the names of all recognized values of
+// LowerCaseEnum, in the same order as LowerCaseEnum_Values.
+func LowerCaseEnum_Names() []string {
+	return []string{
+		"containing",
+		"lower_case",
+		"items",
+	}
+}
+
+// IsValid returns true if this LowerCaseEnum is one of its recognized
+// values.
+func (v LowerCaseEnum) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode LowerCaseEnum from a byte slice
 // containing its name.
 //
@@ -2290,6 +2675,22 @@ func (v LowerCaseEnum) Ptr() *LowerCaseEnum {
 	return &v
 }
 
+// Set sets LowerCaseEnum from its name or integer value.
+//
+// This implements flag.Value, allowing LowerCaseEnum to be used as a
+// command line flag.
+func (v *LowerCaseEnum) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v LowerCaseEnum) Type() string {
+	return "LowerCaseEnum"
+}
+
 // Encode encodes LowerCaseEnum directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
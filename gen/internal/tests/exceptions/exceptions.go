@@ -7,6 +7,7 @@ import (
 	errors "errors"
 	fmt "fmt"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -262,6 +263,49 @@ func (v *DoesNotExistException) Equals(rhs *DoesNotExistException) bool {
 	return true
 }
 
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this DoesNotExistException.
+func (v *DoesNotExistException) Copy() *DoesNotExistException {
+	if v == nil {
+		return nil
+	}
+
+	var o DoesNotExistException
+	o.Key = v.Key
+	o.Error2 = _String_CopyPtr(v.Error2)
+	return &o
+}
+
+// Hash returns a hash of this DoesNotExistException which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DoesNotExistException) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	if v.Error2 != nil {
+		h.Field(2)
+		h.String(*v.Error2)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DoesNotExistException so that it may be reused.
+func (v *DoesNotExistException) Reset() {
+	*v = DoesNotExistException{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DoesNotExistException.
 func (v *DoesNotExistException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -541,6 +585,40 @@ func (v *DoesNotExistException2) Equals(rhs *DoesNotExistException2) bool {
 	return true
 }
 
+// Copy returns a deep copy of this DoesNotExistException2.
+func (v *DoesNotExistException2) Copy() *DoesNotExistException2 {
+	if v == nil {
+		return nil
+	}
+
+	var o DoesNotExistException2
+	o.Key = v.Key
+	o.Error2 = _String_CopyPtr(v.Error2)
+	return &o
+}
+
+// Hash returns a hash of this DoesNotExistException2 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DoesNotExistException2) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	if v.Error2 != nil {
+		h.Field(2)
+		h.String(*v.Error2)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DoesNotExistException2 so that it may be reused.
+func (v *DoesNotExistException2) Reset() {
+	*v = DoesNotExistException2{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DoesNotExistException2.
 func (v *DoesNotExistException2) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -721,6 +799,32 @@ func (v *EmptyException) Equals(rhs *EmptyException) bool {
 	return true
 }
 
+// Copy returns a deep copy of this EmptyException.
+func (v *EmptyException) Copy() *EmptyException {
+	if v == nil {
+		return nil
+	}
+
+	var o EmptyException
+	return &o
+}
+
+// Hash returns a hash of this EmptyException which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *EmptyException) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this EmptyException so that it may be reused.
+func (v *EmptyException) Reset() {
+	*v = EmptyException{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of EmptyException.
 func (v *EmptyException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -0,0 +1,737 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package field_tags
+
+import (
+	errors "errors"
+	fmt "fmt"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type Overrides struct {
+	ID   string  `json:"id,required" db:"pk"`
+	Note *string `json:"note,omitempty" db:"-"`
+}
+
+// ToWire translates a Overrides struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Overrides) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Note != nil {
+		w, err = wire.NewValueString(*(v.Note)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Overrides struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Overrides struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Overrides
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Overrides) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Note = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Overrides is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Overrides struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Overrides struct could not be encoded.
+func (v *Overrides) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Note != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Note)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Overrides struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Overrides struct could not be generated from the wire
+// representation.
+func (v *Overrides) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Note = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Overrides is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Overrides
+// struct.
+func (v *Overrides) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Note != nil {
+		fields[i] = fmt.Sprintf("Note: %v", *(v.Note))
+		i++
+	}
+
+	return fmt.Sprintf("Overrides{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Overrides match the
+// provided Overrides.
+//
+// This function performs a deep comparison.
+func (v *Overrides) Equals(rhs *Overrides) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Note, rhs.Note) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Overrides.
+func (v *Overrides) Copy() *Overrides {
+	if v == nil {
+		return nil
+	}
+
+	var o Overrides
+	o.ID = v.ID
+	o.Note = _String_CopyPtr(v.Note)
+	return &o
+}
+
+// Hash returns a hash of this Overrides which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Overrides) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Note != nil {
+		h.Field(2)
+		h.String(*v.Note)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Overrides so that it may be reused.
+func (v *Overrides) Reset() {
+	*v = Overrides{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Overrides.
+func (v *Overrides) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Note != nil {
+		enc.AddString("note", *v.Note)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Overrides) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetNote returns the value of Note if it is set or its
+// zero value if it is unset.
+func (v *Overrides) GetNote() (o string) {
+	if v != nil && v.Note != nil {
+		return *v.Note
+	}
+
+	return
+}
+
+// IsSetNote returns true if Note is not nil.
+func (v *Overrides) IsSetNote() bool {
+	return v != nil && v.Note != nil
+}
+
+type User struct {
+	Name  string  `json:"name,required" db:"name" validate:"required,max=64" gorm:"column:user_name"`
+	Email *string `json:"mail,omitempty" db:"email" xml:"email,attr"`
+	Age   *int32  `json:"age,omitempty" db:"age"`
+}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Email != nil {
+		w, err = wire.NewValueString(*(v.Email)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Age != nil {
+		w, err = wire.NewValueI32(*(v.Age)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Email = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Age = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a User struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a User struct could not be encoded.
+func (v *User) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Email != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Email)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Age != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Age)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a User struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a User struct could not be generated from the wire
+// representation.
+func (v *User) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Email = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Age = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
+		i++
+	}
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Age, rhs.Age) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this User.
+func (v *User) Copy() *User {
+	if v == nil {
+		return nil
+	}
+
+	var o User
+	o.Name = v.Name
+	o.Email = _String_CopyPtr(v.Email)
+	o.Age = _I32_CopyPtr(v.Age)
+	return &o
+}
+
+// Hash returns a hash of this User which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *User) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	if v.Email != nil {
+		h.Field(2)
+		h.String(*v.Email)
+	}
+	if v.Age != nil {
+		h.Field(3)
+		h.Int32(*v.Age)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this User so that it may be reused.
+func (v *User) Reset() {
+	*v = User{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
+	}
+	if v.Age != nil {
+		enc.AddInt32("age", *v.Age)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *User) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+func (v *User) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
+	}
+
+	return
+}
+
+// IsSetEmail returns true if Email is not nil.
+func (v *User) IsSetEmail() bool {
+	return v != nil && v.Email != nil
+}
+
+// GetAge returns the value of Age if it is set or its
+// zero value if it is unset.
+func (v *User) GetAge() (o int32) {
+	if v != nil && v.Age != nil {
+		return *v.Age
+	}
+
+	return
+}
+
+// IsSetAge returns true if Age is not nil.
+func (v *User) IsSetAge() bool {
+	return v != nil && v.Age != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "field-tags",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/field-tags",
+	FilePath: "field-tags.thrift",
+	SHA1:     "11ea5932445a7c958b1d2d0c6b60e8c6b441291f",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct User {\n    1: required string name (go.tag.validate = \"required,max=64\", go.tag.gorm = \"column:user_name\")\n    2: optional string email (go.tag = 'json:\"mail\" xml:\"mail\"', go.tag.xml = \"email,attr\")\n    3: optional i32 age\n} (go.field_tag_template = 'db:\"{{.ThriftName}}\" validate:\"{{if .Required}}required{{end}}\"')\n\nstruct Overrides {\n    1: required string id (go.tag = 'db:\"pk\"')\n    2: optional string note (go.tag.db = \"-\")\n} (go.field_tag_template = 'db:\"{{.Label}}\"')\n"
@@ -0,0 +1,4996 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package fuzz
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Color int32
+
+const (
+	ColorRed   Color = 0
+	ColorGreen Color = 1
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+	}
+}
+
+// Color_Names returns the names of all recognized values of
+// Color, in the same order as Color_Values.
+func Color_Names() []string {
+	return []string{
+		"RED",
+		"GREEN",
+	}
+}
+
+// IsValid returns true if this Color is one of its recognized
+// values.
+func (v Color) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "GREEN":
+		*v = ColorGreen
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Color", err)
+		}
+		*v = Color(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Color to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Color) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("RED"), nil
+	case 1:
+		return []byte("GREEN"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "GREEN")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Color) Ptr() *Color {
+	return &v
+}
+
+// Set sets Color from its name or integer value.
+//
+// This implements flag.Value, allowing Color to be used as a
+// command line flag.
+func (v *Color) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Color) Type() string {
+	return "Color"
+}
+
+// Encode encodes Color directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Color
+//   return v.Encode(sWriter)
+func (v Color) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Color directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Color
+//   if err := v.Decode(sReader); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Color)(i)
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "GREEN"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"GREEN\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+type Containers struct {
+	Points []*Point            `json:"points,omitempty"`
+	Tags   map[string]struct{} `json:"tags,omitempty"`
+	Scores map[string][]int32  `json:"scores,omitempty"`
+	Labels []struct {
+		Key   *Point
+		Value string
+	} `json:"labels,omitempty"`
+	Colors  map[Color]struct{}  `json:"colors,omitempty"`
+	Weights map[float64]float64 `json:"weights,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _Map_String_List_I32_MapItemList map[string][]int32
+
+func (m _Map_String_List_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]int32', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueList(_List_I32_ValueList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_List_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_List_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_List_I32_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_List_I32_MapItemList) Close() {}
+
+type _Map_Point_String_MapItemList []struct {
+	Key   *Point
+	Value string
+}
+
+func (m _Map_Point_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Point_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Point_String_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Point_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Point_String_MapItemList) Close() {}
+
+type _Set_Color_mapType_ValueList map[Color]struct{}
+
+func (v _Set_Color_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Color_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Color_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_Color_mapType_ValueList) Close() {}
+
+type _Map_Double_Double_MapItemList map[float64]float64
+
+func (m _Map_Double_Double_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueDouble(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueDouble(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Double_Double_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Double_Double_MapItemList) KeyType() wire.Type {
+	return wire.TDouble
+}
+
+func (_Map_Double_Double_MapItemList) ValueType() wire.Type {
+	return wire.TDouble
+}
+
+func (_Map_Double_Double_MapItemList) Close() {}
+
+// ToWire translates a Containers struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Containers) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Scores != nil {
+		w, err = wire.NewValueMap(_Map_String_List_I32_MapItemList(v.Scores)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_Point_String_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Colors != nil {
+		w, err = wire.NewValueSet(_Set_Color_mapType_ValueList(v.Colors)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Weights != nil {
+		w, err = wire.NewValueMap(_Map_Double_Double_MapItemList(v.Weights)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_List_I32_Read(m wire.MapItemList) (map[string][]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string][]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _List_I32_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_Point_String_Read(m wire.MapItemList) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Point_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Color_Read(w wire.Value) (Color, error) {
+	var v Color
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Set_Color_mapType_Read(s wire.ValueList) (map[Color]struct{}, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[Color]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Color_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_Double_Double_Read(m wire.MapItemList) (map[float64]float64, error) {
+	if m.KeyType() != wire.TDouble {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TDouble {
+		return nil, nil
+	}
+
+	o := make(map[float64]float64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetDouble(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetDouble(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Containers struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Containers struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Containers
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Containers) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Scores, err = _Map_String_List_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_Point_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Colors, err = _Set_Color_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Weights, err = _Map_Double_Double_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _List_I32_Encode(val []int32, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []int32
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteInt32(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_List_I32_Encode(val map[string][]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TList,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]int32', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := _List_I32_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_Point_String_Encode(val []struct {
+	Key   *Point
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TStruct,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		if err := key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_Color_mapType_Encode(val map[Color]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_Double_Double_Encode(val map[float64]float64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TDouble,
+		ValueType: wire.TDouble,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteDouble(k); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Containers struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Containers struct could not be encoded.
+func (v *Containers) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Points, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Scores != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_List_I32_Encode(v.Scores, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Point_String_Encode(v.Labels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Colors != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_Color_mapType_Encode(v.Colors, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Weights != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Double_Double_Encode(v.Weights, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int32, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_List_I32_Decode(sr stream.Reader) (map[string][]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TList {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string][]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _List_I32_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Point_String_Decode(sr stream.Reader) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Color_Decode(sr stream.Reader) (Color, error) {
+	var v Color
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Set_Color_mapType_Decode(sr stream.Reader) (map[Color]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[Color]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Color_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Double_Double_Decode(sr stream.Reader) (map[float64]float64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TDouble || mh.ValueType != wire.TDouble {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[float64]float64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Containers struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Containers struct could not be generated from the wire
+// representation.
+func (v *Containers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Scores, err = _Map_String_List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_Point_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TSet:
+			v.Colors, err = _Set_Color_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.Weights, err = _Map_Double_Double_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Containers
+// struct.
+func (v *Containers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Scores != nil {
+		fields[i] = fmt.Sprintf("Scores: %v", v.Scores)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+	if v.Colors != nil {
+		fields[i] = fmt.Sprintf("Colors: %v", v.Colors)
+		i++
+	}
+	if v.Weights != nil {
+		fields[i] = fmt.Sprintf("Weights: %v", v.Weights)
+		i++
+	}
+
+	return fmt.Sprintf("Containers{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_List_I32_Equals(lhs, rhs map[string][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Point_String_Equals(lhs, rhs []struct {
+	Key   *Point
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Color_mapType_Equals(lhs, rhs map[Color]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_Double_Double_Equals(lhs, rhs map[float64]float64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Containers match the
+// provided Containers.
+//
+// This function performs a deep comparison.
+func (v *Containers) Equals(rhs *Containers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_mapType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Scores == nil && rhs.Scores == nil) || (v.Scores != nil && rhs.Scores != nil && _Map_String_List_I32_Equals(v.Scores, rhs.Scores))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_Point_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+	if !((v.Colors == nil && rhs.Colors == nil) || (v.Colors != nil && rhs.Colors != nil && _Set_Color_mapType_Equals(v.Colors, rhs.Colors))) {
+		return false
+	}
+	if !((v.Weights == nil && rhs.Weights == nil) || (v.Weights != nil && rhs.Weights != nil && _Map_Double_Double_Equals(v.Weights, rhs.Weights))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_List_I32_Copy(v map[string][]int32) map[string][]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]int32, len(v))
+	for k, x := range v {
+		o[k] = _List_I32_Copy(x)
+	}
+	return o
+}
+
+func _Map_Point_String_Copy(v []struct {
+	Key   *Point
+	Value string
+}) []struct {
+	Key   *Point
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Set_Color_mapType_Copy(v map[Color]struct{}) map[Color]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[Color]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_Double_Double_Copy(v map[float64]float64) map[float64]float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[float64]float64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Containers.
+func (v *Containers) Copy() *Containers {
+	if v == nil {
+		return nil
+	}
+
+	var o Containers
+	o.Points = _List_Point_Copy(v.Points)
+	o.Tags = _Set_String_mapType_Copy(v.Tags)
+	o.Scores = _Map_String_List_I32_Copy(v.Scores)
+	o.Labels = _Map_Point_String_Copy(v.Labels)
+	o.Colors = _Set_Color_mapType_Copy(v.Colors)
+	o.Weights = _Map_Double_Double_Copy(v.Weights)
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_List_I32_Hash(v map[string][]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(_List_I32_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Point_String_Hash(v []struct {
+	Key   *Point
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Color_mapType_Hash(v map[Color]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Double_Double_Hash(v map[float64]float64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Double(k)
+		h.Double(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Containers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Containers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Point_Hash(v.Points))
+	h.Field(2)
+	h.Uint64(_Set_String_mapType_Hash(v.Tags))
+	h.Field(3)
+	h.Uint64(_Map_String_List_I32_Hash(v.Scores))
+	h.Field(4)
+	h.Uint64(_Map_Point_String_Hash(v.Labels))
+	h.Field(5)
+	h.Uint64(_Set_Color_mapType_Hash(v.Colors))
+	h.Field(6)
+	h.Uint64(_Map_Double_Double_Hash(v.Weights))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Containers so that it may be reused.
+func (v *Containers) Reset() {
+	*v = Containers{}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+type _Map_String_List_I32_Zapper map[string][]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_List_I32_Zapper.
+func (m _Map_String_List_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddArray((string)(k), (_List_I32_Zapper)(v)))
+	}
+	return err
+}
+
+type _Map_Point_String_Item_Zapper struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Item_Zapper.
+func (v _Map_Point_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Point_String_Zapper []struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Zapper.
+func (m _Map_Point_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Point_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Set_Color_mapType_Zapper map[Color]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Color_mapType_Zapper.
+func (s _Set_Color_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_Double_Double_Item_Zapper struct {
+	Key   float64
+	Value float64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Double_Double_Item_Zapper.
+func (v _Map_Double_Double_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddFloat64("key", v.Key)
+	enc.AddFloat64("value", v.Value)
+	return err
+}
+
+type _Map_Double_Double_Zapper map[float64]float64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Double_Double_Zapper.
+func (m _Map_Double_Double_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_Double_Double_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Containers.
+func (v *Containers) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_String_mapType_Zapper)(v.Tags)))
+	}
+	if v.Scores != nil {
+		err = multierr.Append(err, enc.AddObject("scores", (_Map_String_List_I32_Zapper)(v.Scores)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_Point_String_Zapper)(v.Labels)))
+	}
+	if v.Colors != nil {
+		err = multierr.Append(err, enc.AddArray("colors", (_Set_Color_mapType_Zapper)(v.Colors)))
+	}
+	if v.Weights != nil {
+		err = multierr.Append(err, enc.AddArray("weights", (_Map_Double_Double_Zapper)(v.Weights)))
+	}
+	return err
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Containers) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Containers) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetScores returns the value of Scores if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetScores() (o map[string][]int32) {
+	if v != nil && v.Scores != nil {
+		return v.Scores
+	}
+
+	return
+}
+
+// IsSetScores returns true if Scores is not nil.
+func (v *Containers) IsSetScores() bool {
+	return v != nil && v.Scores != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetLabels() (o []struct {
+	Key   *Point
+	Value string
+}) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Containers) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetColors returns the value of Colors if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetColors() (o map[Color]struct{}) {
+	if v != nil && v.Colors != nil {
+		return v.Colors
+	}
+
+	return
+}
+
+// IsSetColors returns true if Colors is not nil.
+func (v *Containers) IsSetColors() bool {
+	return v != nil && v.Colors != nil
+}
+
+// GetWeights returns the value of Weights if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetWeights() (o map[float64]float64) {
+	if v != nil && v.Weights != nil {
+		return v.Weights
+	}
+
+	return
+}
+
+// IsSetWeights returns true if Weights is not nil.
+func (v *Containers) IsSetWeights() bool {
+	return v != nil && v.Weights != nil
+}
+
+type Forever struct {
+	Again *Forever `json:"again,required"`
+}
+
+// ToWire translates a Forever struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Forever) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Again == nil {
+		return w, errors.New("field Again of Forever is required")
+	}
+	w, err = v.Again.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Forever_Read(w wire.Value) (*Forever, error) {
+	var v Forever
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Forever struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Forever struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Forever
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Forever) FromWire(w wire.Value) error {
+	var err error
+
+	againIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Again, err = _Forever_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				againIsSet = true
+			}
+		}
+	}
+
+	if !againIsSet {
+		return errors.New("field Again of Forever is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Forever struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Forever struct could not be encoded.
+func (v *Forever) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Again == nil {
+		return errors.New("field Again of Forever is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Again.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Forever_Decode(sr stream.Reader) (*Forever, error) {
+	var v Forever
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Forever struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Forever struct could not be generated from the wire
+// representation.
+func (v *Forever) Decode(sr stream.Reader) error {
+
+	againIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Again, err = _Forever_Decode(sr)
+			if err != nil {
+				return err
+			}
+			againIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !againIsSet {
+		return errors.New("field Again of Forever is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Forever
+// struct.
+func (v *Forever) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Again: %v", v.Again)
+	i++
+
+	return fmt.Sprintf("Forever{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Forever match the
+// provided Forever.
+//
+// This function performs a deep comparison.
+func (v *Forever) Equals(rhs *Forever) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Again.Equals(rhs.Again) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Forever.
+func (v *Forever) Copy() *Forever {
+	if v == nil {
+		return nil
+	}
+
+	var o Forever
+	o.Again = v.Again.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Forever which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Forever) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Again.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Forever so that it may be reused.
+func (v *Forever) Reset() {
+	*v = Forever{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Forever.
+func (v *Forever) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("again", v.Again))
+	return err
+}
+
+// GetAgain returns the value of Again if it is set or its
+// zero value if it is unset.
+func (v *Forever) GetAgain() (o *Forever) {
+	if v != nil {
+		o = v.Again
+	}
+	return
+}
+
+// IsSetAgain returns true if Again is not nil.
+func (v *Forever) IsSetAgain() bool {
+	return v != nil && v.Again != nil
+}
+
+type Node struct {
+	Name     string  `json:"name,required"`
+	Sibling  *Node   `json:"sibling,omitempty"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+type _List_Node_ValueList []*Node
+
+func (v _List_Node_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Node', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Node_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Node_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Node_ValueList) Close() {}
+
+// ToWire translates a Node struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Node) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Sibling != nil {
+		w, err = v.Sibling.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Children != nil {
+		w, err = wire.NewValueList(_List_Node_ValueList(v.Children)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Node_Read(w wire.Value) (*Node, error) {
+	var v Node
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Node_Read(l wire.ValueList) ([]*Node, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Node, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Node_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Node struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Node struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Node
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Node) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Sibling, err = _Node_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Children, err = _List_Node_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Node is required")
+	}
+
+	return nil
+}
+
+func _List_Node_Encode(val []*Node, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Node
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Node', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Node struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Node struct could not be encoded.
+func (v *Node) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Sibling != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Sibling.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Children != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Node_Encode(v.Children, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Node_Decode(sr stream.Reader) (*Node, error) {
+	var v Node
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Node_Decode(sr stream.Reader) ([]*Node, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Node, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Node_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Node struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Node struct could not be generated from the wire
+// representation.
+func (v *Node) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Sibling, err = _Node_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Children, err = _List_Node_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Node is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Node
+// struct.
+func (v *Node) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Sibling != nil {
+		fields[i] = fmt.Sprintf("Sibling: %v", v.Sibling)
+		i++
+	}
+	if v.Children != nil {
+		fields[i] = fmt.Sprintf("Children: %v", v.Children)
+		i++
+	}
+
+	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Node_Equals(lhs, rhs []*Node) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Node match the
+// provided Node.
+//
+// This function performs a deep comparison.
+func (v *Node) Equals(rhs *Node) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Sibling == nil && rhs.Sibling == nil) || (v.Sibling != nil && rhs.Sibling != nil && v.Sibling.Equals(rhs.Sibling))) {
+		return false
+	}
+	if !((v.Children == nil && rhs.Children == nil) || (v.Children != nil && rhs.Children != nil && _List_Node_Equals(v.Children, rhs.Children))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Node_Copy(v []*Node) []*Node {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Node, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Node.
+func (v *Node) Copy() *Node {
+	if v == nil {
+		return nil
+	}
+
+	var o Node
+	o.Name = v.Name
+	o.Sibling = v.Sibling.Copy()
+	o.Children = _List_Node_Copy(v.Children)
+	return &o
+}
+
+func _List_Node_Hash(v []*Node) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Node which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Node) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(v.Sibling.Hash())
+	h.Field(3)
+	h.Uint64(_List_Node_Hash(v.Children))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Node so that it may be reused.
+func (v *Node) Reset() {
+	*v = Node{}
+}
+
+type _List_Node_Zapper []*Node
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Node_Zapper.
+func (l _List_Node_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Sibling != nil {
+		err = multierr.Append(err, enc.AddObject("sibling", v.Sibling))
+	}
+	if v.Children != nil {
+		err = multierr.Append(err, enc.AddArray("children", (_List_Node_Zapper)(v.Children)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Node) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetSibling returns the value of Sibling if it is set or its
+// zero value if it is unset.
+func (v *Node) GetSibling() (o *Node) {
+	if v != nil && v.Sibling != nil {
+		return v.Sibling
+	}
+
+	return
+}
+
+// IsSetSibling returns true if Sibling is not nil.
+func (v *Node) IsSetSibling() bool {
+	return v != nil && v.Sibling != nil
+}
+
+// GetChildren returns the value of Children if it is set or its
+// zero value if it is unset.
+func (v *Node) GetChildren() (o []*Node) {
+	if v != nil && v.Children != nil {
+		return v.Children
+	}
+
+	return
+}
+
+// IsSetChildren returns true if Children is not nil.
+func (v *Node) IsSetChildren() bool {
+	return v != nil && v.Children != nil
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Primitives struct {
+	BoolField   bool             `json:"boolField,required"`
+	ByteField   int8             `json:"byteField,required"`
+	Int16Field  *int16           `json:"int16Field,omitempty"`
+	Int32Field  *int32           `json:"int32Field,omitempty"`
+	Int64Field  *int64           `json:"int64Field,omitempty"`
+	DoubleField *float64         `json:"doubleField,omitempty"`
+	StringField *string          `json:"stringField,omitempty"`
+	BinaryField []byte           `json:"binaryField,omitempty"`
+	UuidField   *thriftuuid.UUID `json:"uuidField,omitempty"`
+	Color       *Color           `json:"color,omitempty"`
+}
+
+// ToWire translates a Primitives struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Primitives) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueBool(v.BoolField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI8(v.ByteField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Int16Field != nil {
+		w, err = wire.NewValueI16(*(v.Int16Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Int32Field != nil {
+		w, err = wire.NewValueI32(*(v.Int32Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Int64Field != nil {
+		w, err = wire.NewValueI64(*(v.Int64Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DoubleField != nil {
+		w, err = wire.NewValueDouble(*(v.DoubleField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.StringField != nil {
+		w, err = wire.NewValueString(*(v.StringField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.BinaryField != nil {
+		w, err = wire.NewValueBinary(v.BinaryField), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.UuidField != nil {
+		w, err = wire.NewValueBinary((*(v.UuidField)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Color != nil {
+		w, err = v.Color.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UUID_Read(w wire.Value) (thriftuuid.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return thriftuuid.UUID(u), err
+}
+
+// FromWire deserializes a Primitives struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Primitives struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Primitives
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Primitives) FromWire(w wire.Value) error {
+	var err error
+
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				v.BoolField, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				boolFieldIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				v.ByteField, err = field.Value.GetI8(), error(nil)
+				if err != nil {
+					return err
+				}
+				byteFieldIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.Int16Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Int32Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Int64Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DoubleField = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StringField = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.BinaryField, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.UuidField = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x Color
+				x, err = _Color_Read(field.Value)
+				v.Color = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !boolFieldIsSet {
+		return errors.New("field BoolField of Primitives is required")
+	}
+
+	if !byteFieldIsSet {
+		return errors.New("field ByteField of Primitives is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Primitives struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Primitives struct could not be encoded.
+func (v *Primitives) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBool}); err != nil {
+		return err
+	}
+	if err := sw.WriteBool(v.BoolField); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI8}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt8(v.ByteField); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Int16Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.Int16Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Int32Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Int32Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Int64Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Int64Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DoubleField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.DoubleField)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StringField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StringField)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BinaryField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.BinaryField); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UuidField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.UuidField)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Color != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Color.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _UUID_Decode(sr stream.Reader) (thriftuuid.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return thriftuuid.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return thriftuuid.UUID(u), err
+}
+
+// Decode deserializes a Primitives struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Primitives struct could not be generated from the wire
+// representation.
+func (v *Primitives) Decode(sr stream.Reader) error {
+
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.BoolField, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			boolFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			v.ByteField, err = sr.ReadInt8()
+			if err != nil {
+				return err
+			}
+			byteFieldIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.Int16Field = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Int32Field = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Int64Field = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.DoubleField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StringField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.BinaryField, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.UuidField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x Color
+			x, err = _Color_Decode(sr)
+			v.Color = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !boolFieldIsSet {
+		return errors.New("field BoolField of Primitives is required")
+	}
+
+	if !byteFieldIsSet {
+		return errors.New("field ByteField of Primitives is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Primitives
+// struct.
+func (v *Primitives) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [10]string
+	i := 0
+	fields[i] = fmt.Sprintf("BoolField: %v", v.BoolField)
+	i++
+	fields[i] = fmt.Sprintf("ByteField: %v", v.ByteField)
+	i++
+	if v.Int16Field != nil {
+		fields[i] = fmt.Sprintf("Int16Field: %v", *(v.Int16Field))
+		i++
+	}
+	if v.Int32Field != nil {
+		fields[i] = fmt.Sprintf("Int32Field: %v", *(v.Int32Field))
+		i++
+	}
+	if v.Int64Field != nil {
+		fields[i] = fmt.Sprintf("Int64Field: %v", *(v.Int64Field))
+		i++
+	}
+	if v.DoubleField != nil {
+		fields[i] = fmt.Sprintf("DoubleField: %v", *(v.DoubleField))
+		i++
+	}
+	if v.StringField != nil {
+		fields[i] = fmt.Sprintf("StringField: %v", *(v.StringField))
+		i++
+	}
+	if v.BinaryField != nil {
+		fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
+		i++
+	}
+	if v.UuidField != nil {
+		fields[i] = fmt.Sprintf("UuidField: %v", *(v.UuidField))
+		i++
+	}
+	if v.Color != nil {
+		fields[i] = fmt.Sprintf("Color: %v", *(v.Color))
+		i++
+	}
+
+	return fmt.Sprintf("Primitives{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _UUID_EqualsPtr(lhs, rhs *thriftuuid.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Color_EqualsPtr(lhs, rhs *Color) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Primitives match the
+// provided Primitives.
+//
+// This function performs a deep comparison.
+func (v *Primitives) Equals(rhs *Primitives) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.BoolField == rhs.BoolField) {
+		return false
+	}
+	if !(v.ByteField == rhs.ByteField) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.Int16Field, rhs.Int16Field) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Int32Field, rhs.Int32Field) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Int64Field, rhs.Int64Field) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DoubleField, rhs.DoubleField) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StringField, rhs.StringField) {
+		return false
+	}
+	if !((v.BinaryField == nil && rhs.BinaryField == nil) || (v.BinaryField != nil && rhs.BinaryField != nil && bytes.Equal(v.BinaryField, rhs.BinaryField))) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.UuidField, rhs.UuidField) {
+		return false
+	}
+	if !_Color_EqualsPtr(v.Color, rhs.Color) {
+		return false
+	}
+
+	return true
+}
+
+func _I16_CopyPtr(v *int16) *int16 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _UUID_CopyPtr(v *thriftuuid.UUID) *thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Color_CopyPtr(v *Color) *Color {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Primitives.
+func (v *Primitives) Copy() *Primitives {
+	if v == nil {
+		return nil
+	}
+
+	var o Primitives
+	o.BoolField = v.BoolField
+	o.ByteField = v.ByteField
+	o.Int16Field = _I16_CopyPtr(v.Int16Field)
+	o.Int32Field = _I32_CopyPtr(v.Int32Field)
+	o.Int64Field = _I64_CopyPtr(v.Int64Field)
+	o.DoubleField = _Double_CopyPtr(v.DoubleField)
+	o.StringField = _String_CopyPtr(v.StringField)
+	o.BinaryField = _Binary_Copy(v.BinaryField)
+	o.UuidField = _UUID_CopyPtr(v.UuidField)
+	o.Color = _Color_CopyPtr(v.Color)
+	return &o
+}
+
+// Hash returns a hash of this Primitives which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Primitives) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.BoolField)
+	h.Field(2)
+	h.Int8(v.ByteField)
+	if v.Int16Field != nil {
+		h.Field(3)
+		h.Int16(*v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		h.Field(4)
+		h.Int32(*v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		h.Field(5)
+		h.Int64(*v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		h.Field(6)
+		h.Double(*v.DoubleField)
+	}
+	if v.StringField != nil {
+		h.Field(7)
+		h.String(*v.StringField)
+	}
+	h.Field(8)
+	h.Binary(v.BinaryField)
+	if v.UuidField != nil {
+		h.Field(9)
+		h.Binary((*v.UuidField).Bytes())
+	}
+	if v.Color != nil {
+		h.Field(10)
+		h.Int32(int32(*v.Color))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Primitives so that it may be reused.
+func (v *Primitives) Reset() {
+	*v = Primitives{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Primitives.
+func (v *Primitives) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddBool("boolField", v.BoolField)
+	enc.AddInt8("byteField", v.ByteField)
+	if v.Int16Field != nil {
+		enc.AddInt16("int16Field", *v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		enc.AddInt32("int32Field", *v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		enc.AddInt64("int64Field", *v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		enc.AddFloat64("doubleField", *v.DoubleField)
+	}
+	if v.StringField != nil {
+		enc.AddString("stringField", *v.StringField)
+	}
+	if v.BinaryField != nil {
+		enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	}
+	if v.UuidField != nil {
+		enc.AddString("uuidField", (*v.UuidField).String())
+	}
+	if v.Color != nil {
+		err = multierr.Append(err, enc.AddObject("color", *v.Color))
+	}
+	return err
+}
+
+// GetBoolField returns the value of BoolField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetBoolField() (o bool) {
+	if v != nil {
+		o = v.BoolField
+	}
+	return
+}
+
+// GetByteField returns the value of ByteField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetByteField() (o int8) {
+	if v != nil {
+		o = v.ByteField
+	}
+	return
+}
+
+// GetInt16Field returns the value of Int16Field if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetInt16Field() (o int16) {
+	if v != nil && v.Int16Field != nil {
+		return *v.Int16Field
+	}
+
+	return
+}
+
+// IsSetInt16Field returns true if Int16Field is not nil.
+func (v *Primitives) IsSetInt16Field() bool {
+	return v != nil && v.Int16Field != nil
+}
+
+// GetInt32Field returns the value of Int32Field if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetInt32Field() (o int32) {
+	if v != nil && v.Int32Field != nil {
+		return *v.Int32Field
+	}
+
+	return
+}
+
+// IsSetInt32Field returns true if Int32Field is not nil.
+func (v *Primitives) IsSetInt32Field() bool {
+	return v != nil && v.Int32Field != nil
+}
+
+// GetInt64Field returns the value of Int64Field if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetInt64Field() (o int64) {
+	if v != nil && v.Int64Field != nil {
+		return *v.Int64Field
+	}
+
+	return
+}
+
+// IsSetInt64Field returns true if Int64Field is not nil.
+func (v *Primitives) IsSetInt64Field() bool {
+	return v != nil && v.Int64Field != nil
+}
+
+// GetDoubleField returns the value of DoubleField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetDoubleField() (o float64) {
+	if v != nil && v.DoubleField != nil {
+		return *v.DoubleField
+	}
+
+	return
+}
+
+// IsSetDoubleField returns true if DoubleField is not nil.
+func (v *Primitives) IsSetDoubleField() bool {
+	return v != nil && v.DoubleField != nil
+}
+
+// GetStringField returns the value of StringField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetStringField() (o string) {
+	if v != nil && v.StringField != nil {
+		return *v.StringField
+	}
+
+	return
+}
+
+// IsSetStringField returns true if StringField is not nil.
+func (v *Primitives) IsSetStringField() bool {
+	return v != nil && v.StringField != nil
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetBinaryField() (o []byte) {
+	if v != nil && v.BinaryField != nil {
+		return v.BinaryField
+	}
+
+	return
+}
+
+// IsSetBinaryField returns true if BinaryField is not nil.
+func (v *Primitives) IsSetBinaryField() bool {
+	return v != nil && v.BinaryField != nil
+}
+
+// GetUuidField returns the value of UuidField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetUuidField() (o thriftuuid.UUID) {
+	if v != nil && v.UuidField != nil {
+		return *v.UuidField
+	}
+
+	return
+}
+
+// IsSetUuidField returns true if UuidField is not nil.
+func (v *Primitives) IsSetUuidField() bool {
+	return v != nil && v.UuidField != nil
+}
+
+// GetColor returns the value of Color if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetColor() (o Color) {
+	if v != nil && v.Color != nil {
+		return *v.Color
+	}
+
+	return
+}
+
+// IsSetColor returns true if Color is not nil.
+func (v *Primitives) IsSetColor() bool {
+	return v != nil && v.Color != nil
+}
+
+type Shape struct {
+	Point   *Point   `json:"point,omitempty"`
+	Polygon []*Point `json:"polygon,omitempty"`
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Polygon != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Polygon)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Polygon, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Polygon != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Polygon, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Polygon, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Polygon != nil {
+		fields[i] = fmt.Sprintf("Polygon: %v", v.Polygon)
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !((v.Polygon == nil && rhs.Polygon == nil) || (v.Polygon != nil && rhs.Polygon != nil && _List_Point_Equals(v.Polygon, rhs.Polygon))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Point = v.Point.Copy()
+	o.Polygon = _List_Point_Copy(v.Polygon)
+	return &o
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	h.Field(2)
+	h.Uint64(_List_Point_Hash(v.Polygon))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Polygon != nil {
+		err = multierr.Append(err, enc.AddArray("polygon", (_List_Point_Zapper)(v.Polygon)))
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Shape) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPoint(x *Point) {
+	*v = Shape{}
+	v.Point = x
+}
+
+// GetPolygon returns the value of Polygon if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPolygon() (o []*Point) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon
+	}
+
+	return
+}
+
+// IsSetPolygon returns true if Polygon is not nil.
+func (v *Shape) IsSetPolygon() bool {
+	return v != nil && v.Polygon != nil
+}
+
+// LookupPolygon returns the value of Polygon and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPolygon() (o []*Point, _ bool) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon, true
+	}
+	return o, false
+}
+
+// SetPolygon sets the value of Polygon and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPolygon(x []*Point) {
+	*v = Shape{}
+	v.Polygon = x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Polygon != nil {
+		return 2
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitPolygon is called with the value of Polygon if it is set.
+	VisitPolygon([]*Point) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Polygon != nil {
+			return visitor.VisitPolygon(v.Polygon)
+		}
+	}
+	return visitor.Default()
+}
+
+type ShapeError struct {
+	Message string `json:"message,required"`
+	Shape   *Shape `json:"shape,omitempty"`
+}
+
+// ToWire translates a ShapeError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ShapeError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Shape != nil {
+		w, err = v.Shape.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Shape_Read(w wire.Value) (*Shape, error) {
+	var v Shape
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ShapeError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ShapeError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ShapeError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ShapeError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Shape, err = _Shape_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of ShapeError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a ShapeError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ShapeError struct could not be encoded.
+func (v *ShapeError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Shape != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Shape.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Shape_Decode(sr stream.Reader) (*Shape, error) {
+	var v Shape
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a ShapeError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ShapeError struct could not be generated from the wire
+// representation.
+func (v *ShapeError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Shape, err = _Shape_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of ShapeError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ShapeError
+// struct.
+func (v *ShapeError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Shape != nil {
+		fields[i] = fmt.Sprintf("Shape: %v", v.Shape)
+		i++
+	}
+
+	return fmt.Sprintf("ShapeError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*ShapeError) ErrorName() string {
+	return "ShapeError"
+}
+
+// Equals returns true if all the fields of this ShapeError match the
+// provided ShapeError.
+//
+// This function performs a deep comparison.
+func (v *ShapeError) Equals(rhs *ShapeError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !((v.Shape == nil && rhs.Shape == nil) || (v.Shape != nil && rhs.Shape != nil && v.Shape.Equals(rhs.Shape))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this ShapeError.
+func (v *ShapeError) Copy() *ShapeError {
+	if v == nil {
+		return nil
+	}
+
+	var o ShapeError
+	o.Message = v.Message
+	o.Shape = v.Shape.Copy()
+	return &o
+}
+
+// Hash returns a hash of this ShapeError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ShapeError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	h.Field(2)
+	h.Uint64(v.Shape.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ShapeError so that it may be reused.
+func (v *ShapeError) Reset() {
+	*v = ShapeError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ShapeError.
+func (v *ShapeError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	if v.Shape != nil {
+		err = multierr.Append(err, enc.AddObject("shape", v.Shape))
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *ShapeError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetShape returns the value of Shape if it is set or its
+// zero value if it is unset.
+func (v *ShapeError) GetShape() (o *Shape) {
+	if v != nil && v.Shape != nil {
+		return v.Shape
+	}
+
+	return
+}
+
+// IsSetShape returns true if Shape is not nil.
+func (v *ShapeError) IsSetShape() bool {
+	return v != nil && v.Shape != nil
+}
+
+func (v *ShapeError) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "fuzz",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/fuzz",
+	FilePath: "fuzz.thrift",
+	SHA1:     "d7ae1f0df5e0e489f5ca310c1095df4ec5c2c0ca",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Color {\n    RED,\n    GREEN,\n}\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Primitives {\n    1: required bool boolField\n    2: required byte byteField\n    3: optional i16 int16Field\n    4: optional i32 int32Field\n    5: optional i64 int64Field\n    6: optional double doubleField\n    7: optional string stringField\n    8: optional binary binaryField\n    9: optional uuid uuidField\n    10: optional Color color\n}\n\nstruct Containers {\n    1: optional list<Point> points\n    2: optional set<string> tags\n    3: optional map<string, list<i32>> scores\n    4: optional map<Point, string> labels\n    5: optional set<Color> colors\n    6: optional map<double, double> weights\n}\n\nstruct Node {\n    1: required string name\n    2: optional Node sibling\n    3: optional list<Node> children\n}\n\nunion Shape {\n    1: Point point\n    2: list<Point> polygon\n}\n\nexception ShapeError {\n    1: required string message\n    2: optional Shape shape\n}\n\nstruct Forever {\n    1: required Forever again\n}\n"
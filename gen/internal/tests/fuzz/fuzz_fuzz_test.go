@@ -0,0 +1,568 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package fuzz
+
+import (
+	bytes "bytes"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	testing "testing"
+)
+
+func _Color_ptr(v Color) *Color {
+	return &v
+}
+
+func _UUID_ptr(v thriftuuid.UUID) *thriftuuid.UUID {
+	return &v
+}
+
+type _fuzzValue interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+
+	Encode(stream.Writer) error
+	Decode(stream.Reader) error
+	String() string
+}
+
+// _fuzzSeed adds the Binary encoding of the given value to the seed
+// corpus, along with an empty struct.
+func _fuzzSeed(f *testing.F, give _fuzzValue) {
+	f.Add([]byte{0})
+	if give == nil {
+		return
+	}
+	w, err := give.ToWire()
+	if err != nil {
+		f.Fatal(err)
+	}
+	var buff bytes.Buffer
+	if err := binary.Default.Encode(w, &buff); err != nil {
+		f.Fatal(err)
+	}
+	f.Add(buff.Bytes())
+}
+
+// _fuzzRoundTrip decodes data into a value returned by newValue. If
+// that succeeds, it checks that the value encodes with each
+// serialization method, and that decoding the result produces a
+// value equal to it.
+func _fuzzRoundTrip(
+	t *testing.T,
+	data []byte,
+	newValue func() _fuzzValue,
+	equal func(_fuzzValue, _fuzzValue) bool,
+) {
+	w, err := binary.Default.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return
+	}
+	give := newValue()
+	if err := give.FromWire(w); err != nil {
+		return
+	}
+
+	check := func(method string, encoded []byte) {
+		w, err := binary.Default.Decode(bytes.NewReader(encoded), wire.TStruct)
+		if err != nil {
+			t.Fatalf("%v: cannot decode %v: %v", method, give, err)
+		}
+		got := newValue()
+		if err := got.FromWire(w); err != nil {
+			t.Fatalf("%v: cannot decode %v: %v", method, give, err)
+		}
+
+		if !equal(give, got) && give.String() != got.String() {
+			t.Fatalf("%v: %v changed to %v after a round trip", method, give, got)
+		}
+	}
+
+	w, err = give.ToWire()
+	if err != nil {
+		t.Fatalf("ToWire: cannot encode %v: %v", give, err)
+	}
+	var buff bytes.Buffer
+	if err := binary.Default.Encode(w, &buff); err != nil {
+		t.Fatalf("ToWire: cannot encode %v: %v", give, err)
+	}
+	check("ToWire", buff.Bytes())
+
+	var out bytes.Buffer
+	sw := binary.Default.Writer(&out)
+	if err := give.Encode(sw); err != nil {
+		t.Fatalf("Encode: cannot encode %v: %v", give, err)
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatalf("Encode: cannot encode %v: %v", give, err)
+	}
+	check("Encode", out.Bytes())
+}
+
+// FuzzContainersRoundTrip decodes arbitrary bytes into a Containers
+// and checks that values which decode successfully survive a round trip.
+func FuzzContainersRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &Containers{
+		Colors: map[Color]struct{}{
+			ColorRed: struct{}{},
+		},
+		Labels: []struct {
+			Key   *Point
+			Value string
+		}{
+			{
+				Key: &Point{
+					X: 3.1415,
+					Y: 3.1415,
+				},
+				Value: "representative value",
+			},
+		},
+		Points: []*Point{
+			&Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+			&Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+			&Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+		},
+		Scores: map[string][]int32{
+			"representative value": []int32{
+				4242,
+				4242,
+				4242,
+			},
+		},
+		Tags: map[string]struct{}{
+			"representative value": struct{}{},
+		},
+		Weights: map[float64]float64{
+			3.1415: 3.1415,
+		},
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Containers) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Containers).Equals(b.(*Containers))
+			},
+		)
+	})
+}
+
+// FuzzForeverRoundTrip decodes arbitrary bytes into a Forever
+// and checks that values which decode successfully survive a round trip.
+func FuzzForeverRoundTrip(f *testing.F) {
+	_fuzzSeed(f, nil)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Forever) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Forever).Equals(b.(*Forever))
+			},
+		)
+	})
+}
+
+// FuzzNodeRoundTrip decodes arbitrary bytes into a Node
+// and checks that values which decode successfully survive a round trip.
+func FuzzNodeRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &Node{
+		Children: []*Node{
+			&Node{
+				Children: []*Node{
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+				},
+				Name: "representative value",
+				Sibling: &Node{
+					Children: []*Node{
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+					},
+					Name: "representative value",
+					Sibling: &Node{
+						Name: "representative value",
+					},
+				},
+			},
+			&Node{
+				Children: []*Node{
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+				},
+				Name: "representative value",
+				Sibling: &Node{
+					Children: []*Node{
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+					},
+					Name: "representative value",
+					Sibling: &Node{
+						Name: "representative value",
+					},
+				},
+			},
+			&Node{
+				Children: []*Node{
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+					&Node{
+						Children: []*Node{
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+							&Node{
+								Name: "representative value",
+							},
+						},
+						Name: "representative value",
+						Sibling: &Node{
+							Name: "representative value",
+						},
+					},
+				},
+				Name: "representative value",
+				Sibling: &Node{
+					Children: []*Node{
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+					},
+					Name: "representative value",
+					Sibling: &Node{
+						Name: "representative value",
+					},
+				},
+			},
+		},
+		Name: "representative value",
+		Sibling: &Node{
+			Children: []*Node{
+				&Node{
+					Children: []*Node{
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+					},
+					Name: "representative value",
+					Sibling: &Node{
+						Name: "representative value",
+					},
+				},
+				&Node{
+					Children: []*Node{
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+					},
+					Name: "representative value",
+					Sibling: &Node{
+						Name: "representative value",
+					},
+				},
+				&Node{
+					Children: []*Node{
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+						&Node{
+							Name: "representative value",
+						},
+					},
+					Name: "representative value",
+					Sibling: &Node{
+						Name: "representative value",
+					},
+				},
+			},
+			Name: "representative value",
+			Sibling: &Node{
+				Children: []*Node{
+					&Node{
+						Name: "representative value",
+					},
+					&Node{
+						Name: "representative value",
+					},
+					&Node{
+						Name: "representative value",
+					},
+				},
+				Name: "representative value",
+				Sibling: &Node{
+					Name: "representative value",
+				},
+			},
+		},
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Node) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Node).Equals(b.(*Node))
+			},
+		)
+	})
+}
+
+// FuzzPointRoundTrip decodes arbitrary bytes into a Point
+// and checks that values which decode successfully survive a round trip.
+func FuzzPointRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &Point{
+		X: 3.1415,
+		Y: 3.1415,
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Point) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Point).Equals(b.(*Point))
+			},
+		)
+	})
+}
+
+// FuzzPrimitivesRoundTrip decodes arbitrary bytes into a Primitives
+// and checks that values which decode successfully survive a round trip.
+func FuzzPrimitivesRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &Primitives{
+		BinaryField: []byte("representative value"),
+		BoolField:   true,
+		ByteField:   42,
+		Color:       _Color_ptr(ColorRed),
+		DoubleField: ptr.Float64(3.1415),
+		Int16Field:  ptr.Int16(4242),
+		Int32Field:  ptr.Int32(4242),
+		Int64Field:  ptr.Int64(4242),
+		StringField: ptr.String("representative value"),
+		UuidField:   _UUID_ptr(thriftuuid.UUID{0xc3, 0xb8, 0xa5, 0xc2, 0x0f, 0x4e, 0x4b, 0x1e, 0x9e, 0x41, 0x52, 0xc3, 0xc6, 0xf2, 0xa6, 0xd1}),
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Primitives) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Primitives).Equals(b.(*Primitives))
+			},
+		)
+	})
+}
+
+// FuzzShapeRoundTrip decodes arbitrary bytes into a Shape
+// and checks that values which decode successfully survive a round trip.
+func FuzzShapeRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &Shape{
+		Point: &Point{
+			X: 3.1415,
+			Y: 3.1415,
+		},
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(Shape) },
+			func(a, b _fuzzValue) bool {
+				return a.(*Shape).Equals(b.(*Shape))
+			},
+		)
+	})
+}
+
+// FuzzShapeErrorRoundTrip decodes arbitrary bytes into a ShapeError
+// and checks that values which decode successfully survive a round trip.
+func FuzzShapeErrorRoundTrip(f *testing.F) {
+	_fuzzSeed(f, &ShapeError{
+		Message: "representative value",
+		Shape: &Shape{
+			Point: &Point{
+				X: 3.1415,
+				Y: 3.1415,
+			},
+		},
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_fuzzRoundTrip(t, data,
+			func() _fuzzValue { return new(ShapeError) },
+			func(a, b _fuzzValue) bool {
+				return a.(*ShapeError).Equals(b.(*ShapeError))
+			},
+		)
+	})
+}
@@ -0,0 +1,4342 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package golden_corpus
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	tjson "go.uber.org/thriftrw/protocol/tjson"
+	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+type Digest []byte
+
+// ToWire translates Digest into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Digest) ToWire() (wire.Value, error) {
+	x := ([]byte)(v)
+	return wire.NewValueBinary(x), error(nil)
+}
+
+// String returns a readable string representation of Digest.
+func (v Digest) String() string {
+	x := ([]byte)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Digest) Encode(sw stream.Writer) error {
+	x := ([]byte)(v)
+	return sw.WriteBinary(x)
+}
+
+// FromWire deserializes Digest from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Digest) FromWire(w wire.Value) error {
+	x, err := w.GetBinary(), error(nil)
+	*v = (Digest)(x)
+	return err
+}
+
+// Decode deserializes Digest directly off the wire.
+func (v *Digest) Decode(sr stream.Reader) error {
+	x, err := sr.ReadBinary()
+	*v = (Digest)(x)
+	return err
+}
+
+// Equals returns true if this Digest is equal to the provided
+// Digest.
+func (lhs Digest) Equals(rhs Digest) bool {
+	return bytes.Equal(([]byte)(lhs), ([]byte)(rhs))
+}
+
+// Copy returns a deep copy of this Digest.
+func (v Digest) Copy() Digest {
+	x := ([]byte)(v)
+	return (Digest)(_Binary_Copy(x))
+}
+
+// Hash returns a hash of this Digest which is stable across
+// processes.
+func (v Digest) Hash() uint64 {
+	h := thrifthash.New()
+	h.Binary(([]byte)(v))
+	return h.Sum64()
+}
+
+type Event struct {
+	Name   string                         `json:"name,required"`
+	Digest Digest                         `json:"digest,omitempty"`
+	ID     *thriftuuid.UUID               `json:"id,omitempty"`
+	Level  *Level                         `json:"level,omitempty"`
+	Points []*Point                       `json:"points,omitempty"`
+	Named  map[string]*Point              `json:"named,omitempty"`
+	Kinds  map[enums.EnumDefault]struct{} `json:"kinds,omitempty"`
+	Weight *float64                       `json:"weight,omitempty"`
+	Origin *Point                         `json:"origin,omitempty"`
+}
+
+func _Level_ptr(v Level) *Level {
+	return &v
+}
+
+// Default_Event constructs a new Event struct,
+// pre-populating any fields with defined default values.
+func Default_Event() *Event {
+	var v Event
+	v.Level = _Level_ptr(LevelHigh)
+	v.Weight = ptr.Float64(0.5)
+	v.Origin = &Point{
+		X: 0,
+		Y: 0,
+	}
+	return &v
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+type _Map_String_Point_MapItemList map[string]*Point
+
+func (m _Map_String_Point_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*Point', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Point_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Point_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Point_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Point_MapItemList) Close() {}
+
+type _Set_EnumDefault_mapType_ValueList map[enums.EnumDefault]struct{}
+
+func (v _Set_EnumDefault_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_EnumDefault_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_EnumDefault_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_EnumDefault_mapType_ValueList) Close() {}
+
+// ToWire translates a Event struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Event) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Digest != nil {
+		w, err = v.Digest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ID != nil {
+		w, err = wire.NewValueBinary((*(v.ID)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	vLevel := v.Level
+	if vLevel == nil {
+		vLevel = _Level_ptr(LevelHigh)
+	}
+	{
+		w, err = vLevel.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Named != nil {
+		w, err = wire.NewValueMap(_Map_String_Point_MapItemList(v.Named)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Kinds != nil {
+		w, err = wire.NewValueSet(_Set_EnumDefault_mapType_ValueList(v.Kinds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	vWeight := v.Weight
+	if vWeight == nil {
+		vWeight = ptr.Float64(0.5)
+	}
+	{
+		w, err = wire.NewValueDouble(*(vWeight)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	vOrigin := v.Origin
+	if vOrigin == nil {
+		vOrigin = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+	{
+		w, err = vOrigin.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Digest_Read(w wire.Value) (Digest, error) {
+	var x Digest
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _UUID_Read(w wire.Value) (thriftuuid.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return thriftuuid.UUID(u), err
+}
+
+func _Level_Read(w wire.Value) (Level, error) {
+	var v Level
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_Point_Read(m wire.MapItemList) (map[string]*Point, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Point, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Point_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _EnumDefault_Read(w wire.Value) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Set_EnumDefault_mapType_Read(s wire.ValueList) (map[enums.EnumDefault]struct{}, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[enums.EnumDefault]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _EnumDefault_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Event struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Event struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Event
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Event) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Digest, err = _Digest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x Level
+				x, err = _Level_Read(field.Value)
+				v.Level = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Named, err = _Map_String_Point_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TSet {
+				v.Kinds, err = _Set_EnumDefault_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Weight = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TStruct {
+				v.Origin, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Event is required")
+	}
+
+	if v.Level == nil {
+		v.Level = _Level_ptr(LevelHigh)
+	}
+
+	if v.Weight == nil {
+		v.Weight = ptr.Float64(0.5)
+	}
+
+	if v.Origin == nil {
+		v.Origin = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+
+	return nil
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	if _, ok := sw.(*binary.StreamWriter); !ok {
+
+		for i, v := range val {
+			if v == nil {
+				return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+			}
+			if err := v.Encode(sw); err != nil {
+				return err
+			}
+		}
+		return sw.WriteListEnd()
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_Point_Encode(val map[string]*Point, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*Point', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_EnumDefault_mapType_Encode(val map[enums.EnumDefault]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a Event struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Event struct could not be encoded.
+func (v *Event) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Digest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.Digest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.ID)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vLevel := v.Level
+	if vLevel == nil {
+		vLevel = _Level_ptr(LevelHigh)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := vLevel.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Points, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Named != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Point_Encode(v.Named, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Kinds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_EnumDefault_mapType_Encode(v.Kinds, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vWeight := v.Weight
+	if vWeight == nil {
+		vWeight = ptr.Float64(0.5)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(vWeight)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vOrigin := v.Origin
+	if vOrigin == nil {
+		vOrigin = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := vOrigin.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Digest_Decode(sr stream.Reader) (Digest, error) {
+	var x Digest
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _UUID_Decode(sr stream.Reader) (thriftuuid.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return thriftuuid.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return thriftuuid.UUID(u), err
+}
+
+func _Level_Decode(sr stream.Reader) (Level, error) {
+	var v Level
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_Point_Decode(sr stream.Reader) (map[string]*Point, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]*Point, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _EnumDefault_Decode(sr stream.Reader) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Set_EnumDefault_mapType_Decode(sr stream.Reader) (map[enums.EnumDefault]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[enums.EnumDefault]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _EnumDefault_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Event struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Event struct could not be generated from the wire
+// representation.
+func (v *Event) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Digest, err = _Digest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x Level
+			x, err = _Level_Decode(sr)
+			v.Level = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.Named, err = _Map_String_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TSet:
+			v.Kinds, err = _Set_EnumDefault_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Weight = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TStruct:
+			v.Origin, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Event is required")
+	}
+
+	if v.Level == nil {
+		v.Level = _Level_ptr(LevelHigh)
+	}
+
+	if v.Weight == nil {
+		v.Weight = ptr.Float64(0.5)
+	}
+
+	if v.Origin == nil {
+		v.Origin = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Event
+// struct.
+func (v *Event) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Digest != nil {
+		fields[i] = fmt.Sprintf("Digest: %v", v.Digest)
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.Level != nil {
+		fields[i] = fmt.Sprintf("Level: %v", *(v.Level))
+		i++
+	}
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	if v.Named != nil {
+		fields[i] = fmt.Sprintf("Named: %v", v.Named)
+		i++
+	}
+	if v.Kinds != nil {
+		fields[i] = fmt.Sprintf("Kinds: %v", v.Kinds)
+		i++
+	}
+	if v.Weight != nil {
+		fields[i] = fmt.Sprintf("Weight: %v", *(v.Weight))
+		i++
+	}
+	if v.Origin != nil {
+		fields[i] = fmt.Sprintf("Origin: %v", v.Origin)
+		i++
+	}
+
+	return fmt.Sprintf("Event{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _UUID_EqualsPtr(lhs, rhs *thriftuuid.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Level_EqualsPtr(lhs, rhs *Level) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Point_Equals(lhs, rhs map[string]*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_EnumDefault_mapType_Equals(lhs, rhs map[enums.EnumDefault]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Event match the
+// provided Event.
+//
+// This function performs a deep comparison.
+func (v *Event) Equals(rhs *Event) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Digest == nil && rhs.Digest == nil) || (v.Digest != nil && rhs.Digest != nil && v.Digest.Equals(rhs.Digest))) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !_Level_EqualsPtr(v.Level, rhs.Level) {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !((v.Named == nil && rhs.Named == nil) || (v.Named != nil && rhs.Named != nil && _Map_String_Point_Equals(v.Named, rhs.Named))) {
+		return false
+	}
+	if !((v.Kinds == nil && rhs.Kinds == nil) || (v.Kinds != nil && rhs.Kinds != nil && _Set_EnumDefault_mapType_Equals(v.Kinds, rhs.Kinds))) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.Weight, rhs.Weight) {
+		return false
+	}
+	if !((v.Origin == nil && rhs.Origin == nil) || (v.Origin != nil && rhs.Origin != nil && v.Origin.Equals(rhs.Origin))) {
+		return false
+	}
+
+	return true
+}
+
+func _UUID_CopyPtr(v *thriftuuid.UUID) *thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Level_CopyPtr(v *Level) *Level {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_Point_Copy(v map[string]*Point) map[string]*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*Point, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
+
+func _Set_EnumDefault_mapType_Copy(v map[enums.EnumDefault]struct{}) map[enums.EnumDefault]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[enums.EnumDefault]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Event.
+func (v *Event) Copy() *Event {
+	if v == nil {
+		return nil
+	}
+
+	var o Event
+	o.Name = v.Name
+	o.Digest = v.Digest.Copy()
+	o.ID = _UUID_CopyPtr(v.ID)
+	o.Level = _Level_CopyPtr(v.Level)
+	o.Points = _List_Point_Copy(v.Points)
+	o.Named = _Map_String_Point_Copy(v.Named)
+	o.Kinds = _Set_EnumDefault_mapType_Copy(v.Kinds)
+	o.Weight = _Double_CopyPtr(v.Weight)
+	o.Origin = v.Origin.Copy()
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Map_String_Point_Hash(v map[string]*Point) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_EnumDefault_mapType_Hash(v map[enums.EnumDefault]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Event which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Event) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(v.Digest.Hash())
+	if v.ID != nil {
+		h.Field(3)
+		h.Binary((*v.ID).Bytes())
+	}
+	if v.Level != nil {
+		h.Field(4)
+		h.Int32(int32(*v.Level))
+	}
+	h.Field(5)
+	h.Uint64(_List_Point_Hash(v.Points))
+	h.Field(6)
+	h.Uint64(_Map_String_Point_Hash(v.Named))
+	h.Field(7)
+	h.Uint64(_Set_EnumDefault_mapType_Hash(v.Kinds))
+	if v.Weight != nil {
+		h.Field(8)
+		h.Double(*v.Weight)
+	}
+	h.Field(9)
+	h.Uint64(v.Origin.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Event so that it may be reused.
+func (v *Event) Reset() {
+	*v = Event{}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_Point_Zapper map[string]*Point
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Point_Zapper.
+func (m _Map_String_Point_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+type _Set_EnumDefault_mapType_Zapper map[enums.EnumDefault]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_EnumDefault_mapType_Zapper.
+func (s _Set_EnumDefault_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Event.
+func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Digest != nil {
+		enc.AddString("digest", base64.StdEncoding.EncodeToString(([]byte)(v.Digest)))
+	}
+	if v.ID != nil {
+		enc.AddString("id", (*v.ID).String())
+	}
+	if v.Level != nil {
+		err = multierr.Append(err, enc.AddObject("level", *v.Level))
+	}
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	}
+	if v.Named != nil {
+		err = multierr.Append(err, enc.AddObject("named", (_Map_String_Point_Zapper)(v.Named)))
+	}
+	if v.Kinds != nil {
+		err = multierr.Append(err, enc.AddArray("kinds", (_Set_EnumDefault_mapType_Zapper)(v.Kinds)))
+	}
+	if v.Weight != nil {
+		enc.AddFloat64("weight", *v.Weight)
+	}
+	if v.Origin != nil {
+		err = multierr.Append(err, enc.AddObject("origin", v.Origin))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Event) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetDigest returns the value of Digest if it is set or its
+// zero value if it is unset.
+func (v *Event) GetDigest() (o Digest) {
+	if v != nil && v.Digest != nil {
+		return v.Digest
+	}
+
+	return
+}
+
+// IsSetDigest returns true if Digest is not nil.
+func (v *Event) IsSetDigest() bool {
+	return v != nil && v.Digest != nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Event) GetID() (o thriftuuid.UUID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Event) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// GetLevel returns the value of Level if it is set or its
+// default value if it is unset.
+func (v *Event) GetLevel() (o Level) {
+	if v != nil && v.Level != nil {
+		return *v.Level
+	}
+	o = LevelHigh
+	return
+}
+
+// IsSetLevel returns true if Level is not nil.
+func (v *Event) IsSetLevel() bool {
+	return v != nil && v.Level != nil
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Event) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Event) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetNamed returns the value of Named if it is set or its
+// zero value if it is unset.
+func (v *Event) GetNamed() (o map[string]*Point) {
+	if v != nil && v.Named != nil {
+		return v.Named
+	}
+
+	return
+}
+
+// IsSetNamed returns true if Named is not nil.
+func (v *Event) IsSetNamed() bool {
+	return v != nil && v.Named != nil
+}
+
+// GetKinds returns the value of Kinds if it is set or its
+// zero value if it is unset.
+func (v *Event) GetKinds() (o map[enums.EnumDefault]struct{}) {
+	if v != nil && v.Kinds != nil {
+		return v.Kinds
+	}
+
+	return
+}
+
+// IsSetKinds returns true if Kinds is not nil.
+func (v *Event) IsSetKinds() bool {
+	return v != nil && v.Kinds != nil
+}
+
+// GetWeight returns the value of Weight if it is set or its
+// default value if it is unset.
+func (v *Event) GetWeight() (o float64) {
+	if v != nil && v.Weight != nil {
+		return *v.Weight
+	}
+	o = 0.5
+	return
+}
+
+// IsSetWeight returns true if Weight is not nil.
+func (v *Event) IsSetWeight() bool {
+	return v != nil && v.Weight != nil
+}
+
+// GetOrigin returns the value of Origin if it is set or its
+// default value if it is unset.
+func (v *Event) GetOrigin() (o *Point) {
+	if v != nil && v.Origin != nil {
+		return v.Origin
+	}
+	o = &Point{
+		X: 0,
+		Y: 0,
+	}
+	return
+}
+
+// IsSetOrigin returns true if Origin is not nil.
+func (v *Event) IsSetOrigin() bool {
+	return v != nil && v.Origin != nil
+}
+
+// MarshalThriftJSON encodes Event in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Event) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Event from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Event) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+type Failure struct {
+	Message string `json:"message,required"`
+	Value   *Value `json:"value,omitempty"`
+}
+
+// ToWire translates a Failure struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Failure) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Value != nil {
+		w, err = v.Value.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Value_Read(w wire.Value) (*Value, error) {
+	var v Value
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Failure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Failure struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Failure
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Failure) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Value, err = _Value_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of Failure is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Failure struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Failure struct could not be encoded.
+func (v *Failure) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Value.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Value_Decode(sr stream.Reader) (*Value, error) {
+	var v Value
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Failure struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Failure struct could not be generated from the wire
+// representation.
+func (v *Failure) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Value, err = _Value_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of Failure is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Failure
+// struct.
+func (v *Failure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+		i++
+	}
+
+	return fmt.Sprintf("Failure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*Failure) ErrorName() string {
+	return "Failure"
+}
+
+// Equals returns true if all the fields of this Failure match the
+// provided Failure.
+//
+// This function performs a deep comparison.
+func (v *Failure) Equals(rhs *Failure) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && v.Value.Equals(rhs.Value))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Failure.
+func (v *Failure) Copy() *Failure {
+	if v == nil {
+		return nil
+	}
+
+	var o Failure
+	o.Message = v.Message
+	o.Value = v.Value.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Failure which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Failure) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	h.Field(2)
+	h.Uint64(v.Value.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Failure so that it may be reused.
+func (v *Failure) Reset() {
+	*v = Failure{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Failure.
+func (v *Failure) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	if v.Value != nil {
+		err = multierr.Append(err, enc.AddObject("value", v.Value))
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetValue() (o *Value) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Failure) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// MarshalThriftJSON encodes Failure in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Failure) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Failure from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Failure) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+func (v *Failure) Error() string {
+	return v.String()
+}
+
+type Keyed struct {
+	Labels []struct {
+		Key   *Point
+		Value string
+	} `json:"labels,omitempty"`
+}
+
+type _Map_Point_String_MapItemList []struct {
+	Key   *Point
+	Value string
+}
+
+func (m _Map_Point_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Point_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Point_String_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Point_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Point_String_MapItemList) Close() {}
+
+// ToWire translates a Keyed struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Keyed) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_Point_String_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_Point_String_Read(m wire.MapItemList) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Point_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Keyed struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Keyed struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Keyed
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Keyed) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_Point_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_Point_String_Encode(val []struct {
+	Key   *Point
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TStruct,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		if err := key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Keyed struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Keyed struct could not be encoded.
+func (v *Keyed) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Point_String_Encode(v.Labels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Map_Point_String_Decode(sr stream.Reader) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Keyed struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Keyed struct could not be generated from the wire
+// representation.
+func (v *Keyed) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_Point_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Keyed
+// struct.
+func (v *Keyed) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+
+	return fmt.Sprintf("Keyed{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_Point_String_Equals(lhs, rhs []struct {
+	Key   *Point
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Keyed match the
+// provided Keyed.
+//
+// This function performs a deep comparison.
+func (v *Keyed) Equals(rhs *Keyed) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_Point_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+
+	return true
+}
+
+func _Map_Point_String_Copy(v []struct {
+	Key   *Point
+	Value string
+}) []struct {
+	Key   *Point
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Keyed.
+func (v *Keyed) Copy() *Keyed {
+	if v == nil {
+		return nil
+	}
+
+	var o Keyed
+	o.Labels = _Map_Point_String_Copy(v.Labels)
+	return &o
+}
+
+func _Map_Point_String_Hash(v []struct {
+	Key   *Point
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Keyed which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Keyed) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Map_Point_String_Hash(v.Labels))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Keyed so that it may be reused.
+func (v *Keyed) Reset() {
+	*v = Keyed{}
+}
+
+type _Map_Point_String_Item_Zapper struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Item_Zapper.
+func (v _Map_Point_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Point_String_Zapper []struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Zapper.
+func (m _Map_Point_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Point_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Keyed.
+func (v *Keyed) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_Point_String_Zapper)(v.Labels)))
+	}
+	return err
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Keyed) GetLabels() (o []struct {
+	Key   *Point
+	Value string
+}) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Keyed) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// MarshalThriftJSON encodes Keyed in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Keyed) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Keyed from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Keyed) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+type Level int32
+
+const (
+	LevelLow  Level = 0
+	LevelHigh Level = 1
+)
+
+// Level_Values returns all recognized values of Level.
+func Level_Values() []Level {
+	return []Level{
+		LevelLow,
+		LevelHigh,
+	}
+}
+
+// Level_Names returns the names of all recognized values of
+// Level, in the same order as Level_Values.
+func Level_Names() []string {
+	return []string{
+		"LOW",
+		"HIGH",
+	}
+}
+
+// IsValid returns true if this Level is one of its recognized
+// values.
+func (v Level) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Level from a byte slice
+// containing its name.
+//
+//   var v Level
+//   err := v.UnmarshalText([]byte("LOW"))
+func (v *Level) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "LOW":
+		*v = LevelLow
+		return nil
+	case "HIGH":
+		*v = LevelHigh
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Level", err)
+		}
+		*v = Level(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Level to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Level) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("LOW"), nil
+	case 1:
+		return []byte("HIGH"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Level.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Level) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "LOW")
+	case 1:
+		enc.AddString("name", "HIGH")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Level) Ptr() *Level {
+	return &v
+}
+
+// Set sets Level from its name or integer value.
+//
+// This implements flag.Value, allowing Level to be used as a
+// command line flag.
+func (v *Level) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Level) Type() string {
+	return "Level"
+}
+
+// Encode encodes Level directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Level
+//   return v.Encode(sWriter)
+func (v Level) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Level into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Level) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Level from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Level(0), err
+//   }
+//
+//   var v Level
+//   if err := v.FromWire(x); err != nil {
+//     return Level(0), err
+//   }
+//   return v, nil
+func (v *Level) FromWire(w wire.Value) error {
+	*v = (Level)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Level directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Level
+//   if err := v.Decode(sReader); err != nil {
+//     return Level(0), err
+//   }
+//   return v, nil
+func (v *Level) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Level)(i)
+	return nil
+}
+
+// String returns a readable string representation of Level.
+func (v Level) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "LOW"
+	case 1:
+		return "HIGH"
+	}
+	return fmt.Sprintf("Level(%d)", w)
+}
+
+// Equals returns true if this Level value matches the provided
+// value.
+func (v Level) Equals(rhs Level) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Level into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Level) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"LOW\""), nil
+	case 1:
+		return ([]byte)("\"HIGH\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Level from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Level) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Level")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Level")
+		}
+		*v = (Level)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Level")
+	}
+}
+
+type Node struct {
+	Value *Value `json:"value,required"`
+	Child *Node  `json:"child,omitempty"`
+}
+
+// ToWire translates a Node struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Node) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Value == nil {
+		return w, errors.New("field Value of Node is required")
+	}
+	w, err = v.Value.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Child != nil {
+		w, err = v.Child.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Node_Read(w wire.Value) (*Node, error) {
+	var v Node
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Node struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Node struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Node
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Node) FromWire(w wire.Value) error {
+	var err error
+
+	valueIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Value, err = _Value_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				valueIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Child, err = _Node_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !valueIsSet {
+		return errors.New("field Value of Node is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Node struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Node struct could not be encoded.
+func (v *Node) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Value == nil {
+		return errors.New("field Value of Node is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Value.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Child != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Child.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Node_Decode(sr stream.Reader) (*Node, error) {
+	var v Node
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Node struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Node struct could not be generated from the wire
+// representation.
+func (v *Node) Decode(sr stream.Reader) error {
+
+	valueIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Value, err = _Value_Decode(sr)
+			if err != nil {
+				return err
+			}
+			valueIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Child, err = _Node_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !valueIsSet {
+		return errors.New("field Value of Node is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Node
+// struct.
+func (v *Node) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Value: %v", v.Value)
+	i++
+	if v.Child != nil {
+		fields[i] = fmt.Sprintf("Child: %v", v.Child)
+		i++
+	}
+
+	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Node match the
+// provided Node.
+//
+// This function performs a deep comparison.
+func (v *Node) Equals(rhs *Node) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Value.Equals(rhs.Value) {
+		return false
+	}
+	if !((v.Child == nil && rhs.Child == nil) || (v.Child != nil && rhs.Child != nil && v.Child.Equals(rhs.Child))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Node.
+func (v *Node) Copy() *Node {
+	if v == nil {
+		return nil
+	}
+
+	var o Node
+	o.Value = v.Value.Copy()
+	o.Child = v.Child.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Node which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Node) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Value.Hash())
+	h.Field(2)
+	h.Uint64(v.Child.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Node so that it may be reused.
+func (v *Node) Reset() {
+	*v = Node{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	if v.Child != nil {
+		err = multierr.Append(err, enc.AddObject("child", v.Child))
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Node) GetValue() (o *Value) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Node) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// GetChild returns the value of Child if it is set or its
+// zero value if it is unset.
+func (v *Node) GetChild() (o *Node) {
+	if v != nil && v.Child != nil {
+		return v.Child
+	}
+
+	return
+}
+
+// IsSetChild returns true if Child is not nil.
+func (v *Node) IsSetChild() bool {
+	return v != nil && v.Child != nil
+}
+
+// MarshalThriftJSON encodes Node in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Node) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Node from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Node) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+type Point struct {
+	X int32 `json:"x,required"`
+	Y int32 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI32(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.X, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Y, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.X, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Y, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(v.X)
+	h.Field(2)
+	h.Int32(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt32("x", v.X)
+	enc.AddInt32("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o int32) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o int32) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// MarshalThriftJSON encodes Point in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Point) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Point from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Point) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+type Settings struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Limit   *int64  `json:"limit,omitempty"`
+	Label   *string `json:"label,omitempty"`
+}
+
+// Default_Settings constructs a new Settings struct,
+// pre-populating any fields with defined default values.
+func Default_Settings() *Settings {
+	var v Settings
+	v.Enabled = ptr.Bool(true)
+	v.Limit = ptr.Int64(4242)
+	v.Label = ptr.String("none")
+	return &v
+}
+
+// ToWire translates a Settings struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Settings) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Enabled != nil && !((*v.Enabled) == true) {
+		w, err = wire.NewValueBool(*(v.Enabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Limit != nil && !((*v.Limit) == 4242) {
+		w, err = wire.NewValueI64(*(v.Limit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Label != nil && !((*v.Label) == "none") {
+		w, err = wire.NewValueString(*(v.Label)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Settings struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Settings struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Settings
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Settings) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Enabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Limit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Label = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Enabled == nil {
+		v.Enabled = ptr.Bool(true)
+	}
+
+	if v.Limit == nil {
+		v.Limit = ptr.Int64(4242)
+	}
+
+	if v.Label == nil {
+		v.Label = ptr.String("none")
+	}
+
+	return nil
+}
+
+// Encode serializes a Settings struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Settings struct could not be encoded.
+func (v *Settings) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Enabled != nil && !((*v.Enabled) == true) {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Enabled)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Limit != nil && !((*v.Limit) == 4242) {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Limit)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Label != nil && !((*v.Label) == "none") {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Label)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Settings struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Settings struct could not be generated from the wire
+// representation.
+func (v *Settings) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Enabled = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Limit = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Label = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Enabled == nil {
+		v.Enabled = ptr.Bool(true)
+	}
+
+	if v.Limit == nil {
+		v.Limit = ptr.Int64(4242)
+	}
+
+	if v.Label == nil {
+		v.Label = ptr.String("none")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Settings
+// struct.
+func (v *Settings) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Enabled != nil {
+		fields[i] = fmt.Sprintf("Enabled: %v", *(v.Enabled))
+		i++
+	}
+	if v.Limit != nil {
+		fields[i] = fmt.Sprintf("Limit: %v", *(v.Limit))
+		i++
+	}
+	if v.Label != nil {
+		fields[i] = fmt.Sprintf("Label: %v", *(v.Label))
+		i++
+	}
+
+	return fmt.Sprintf("Settings{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Settings match the
+// provided Settings.
+//
+// This function performs a deep comparison.
+func (v *Settings) Equals(rhs *Settings) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Enabled, rhs.Enabled) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Limit, rhs.Limit) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Label, rhs.Label) {
+		return false
+	}
+
+	return true
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Settings.
+func (v *Settings) Copy() *Settings {
+	if v == nil {
+		return nil
+	}
+
+	var o Settings
+	o.Enabled = _Bool_CopyPtr(v.Enabled)
+	o.Limit = _I64_CopyPtr(v.Limit)
+	o.Label = _String_CopyPtr(v.Label)
+	return &o
+}
+
+// Hash returns a hash of this Settings which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Settings) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Enabled != nil {
+		h.Field(1)
+		h.Bool(*v.Enabled)
+	}
+	if v.Limit != nil {
+		h.Field(2)
+		h.Int64(*v.Limit)
+	}
+	if v.Label != nil {
+		h.Field(3)
+		h.String(*v.Label)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Settings so that it may be reused.
+func (v *Settings) Reset() {
+	*v = Settings{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Settings.
+func (v *Settings) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		enc.AddBool("enabled", *v.Enabled)
+	}
+	if v.Limit != nil {
+		enc.AddInt64("limit", *v.Limit)
+	}
+	if v.Label != nil {
+		enc.AddString("label", *v.Label)
+	}
+	return err
+}
+
+// GetEnabled returns the value of Enabled if it is set or its
+// default value if it is unset.
+func (v *Settings) GetEnabled() (o bool) {
+	if v != nil && v.Enabled != nil {
+		return *v.Enabled
+	}
+	o = true
+	return
+}
+
+// IsSetEnabled returns true if Enabled is not nil.
+func (v *Settings) IsSetEnabled() bool {
+	return v != nil && v.Enabled != nil
+}
+
+// GetLimit returns the value of Limit if it is set or its
+// default value if it is unset.
+func (v *Settings) GetLimit() (o int64) {
+	if v != nil && v.Limit != nil {
+		return *v.Limit
+	}
+	o = 4242
+	return
+}
+
+// IsSetLimit returns true if Limit is not nil.
+func (v *Settings) IsSetLimit() bool {
+	return v != nil && v.Limit != nil
+}
+
+// GetLabel returns the value of Label if it is set or its
+// default value if it is unset.
+func (v *Settings) GetLabel() (o string) {
+	if v != nil && v.Label != nil {
+		return *v.Label
+	}
+	o = "none"
+	return
+}
+
+// IsSetLabel returns true if Label is not nil.
+func (v *Settings) IsSetLabel() bool {
+	return v != nil && v.Label != nil
+}
+
+// MarshalThriftJSON encodes Settings in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Settings) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Settings from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Settings) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+type Value struct {
+	Point *Point  `json:"point,omitempty"`
+	Text  *string `json:"text,omitempty"`
+}
+
+// ToWire translates a Value struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Value) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Text != nil {
+		w, err = wire.NewValueString(*(v.Text)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Value should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Value struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Value struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Value
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Value) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Text = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Text != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Value should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Value struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Value struct could not be encoded.
+func (v *Value) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Text != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Text)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Text != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Value should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Value struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Value struct could not be generated from the wire
+// representation.
+func (v *Value) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Text = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Text != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Value should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Value
+// struct.
+func (v *Value) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+
+	return fmt.Sprintf("Value{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Value match the
+// provided Value.
+//
+// This function performs a deep comparison.
+func (v *Value) Equals(rhs *Value) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Value.
+func (v *Value) Copy() *Value {
+	if v == nil {
+		return nil
+	}
+
+	var o Value
+	o.Point = v.Point.Copy()
+	o.Text = _String_CopyPtr(v.Text)
+	return &o
+}
+
+// Hash returns a hash of this Value which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Value) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	if v.Text != nil {
+		h.Field(2)
+		h.String(*v.Text)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Value so that it may be reused.
+func (v *Value) Reset() {
+	*v = Value{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Value.
+func (v *Value) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Value) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Value) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Value) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Value.
+func (v *Value) SetPoint(x *Point) {
+	*v = Value{}
+	v.Point = x
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+func (v *Value) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+func (v *Value) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// LookupText returns the value of Text and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Value) LookupText() (o string, _ bool) {
+	if v != nil && v.Text != nil {
+		return *v.Text, true
+	}
+	return o, false
+}
+
+// SetText sets the value of Text and unsets all other
+// fields of this Value.
+func (v *Value) SetText(x string) {
+	*v = Value{}
+	v.Text = &x
+}
+
+// Which returns the ID of the field of this Value which is set, or
+// 0 if no field is set.
+func (v *Value) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Text != nil {
+		return 2
+	}
+	return 0
+}
+
+// Value_Visitor visits the field of a Value which is set.
+//
+// Fields added to Value add methods to Value_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Value_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitText is called with the value of Text if it is set.
+	VisitText(string) error
+
+	// Default is called if no field of Value is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Value
+// which is set, or Default if none is, and returns its error.
+func (v *Value) Match(visitor Value_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Text != nil {
+			return visitor.VisitText(*v.Text)
+		}
+	}
+	return visitor.Default()
+}
+
+// MarshalThriftJSON encodes Value in the JSON protocol of Apache
+// Thrift, TJSONProtocol, which keys fields by their identifiers.
+//
+// This is not the same as MarshalJSON, which keys fields by name
+// for use by Go programs.
+func (v *Value) MarshalThriftJSON() ([]byte, error) {
+	var buff bytes.Buffer
+	sw := tjson.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// UnmarshalThriftJSON decodes Value from the JSON protocol of
+// Apache Thrift, TJSONProtocol.
+func (v *Value) UnmarshalThriftJSON(b []byte) error {
+	sr := tjson.Default.Reader(bytes.NewReader(b))
+	if err := v.Decode(sr); err != nil {
+		return err
+	}
+	return sr.Close()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "golden-corpus",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/golden-corpus",
+	FilePath: "golden-corpus.thrift",
+	SHA1:     "07c61979ccb039496bf458a104616a913005f83c",
+	Includes: []*thriftreflect.ThriftModule{
+		enums.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./enums.thrift\"\n\nenum Level {\n    LOW,\n    HIGH,\n}\n\ntypedef binary Digest\n\nstruct Point {\n    1: required i32 x\n    2: required i32 y\n}\n\nstruct Event {\n    1: required string name\n    2: optional Digest digest\n    3: optional uuid id\n    4: optional Level level = Level.HIGH\n    5: optional list<Point> points\n    6: optional map<string, Point> named\n    7: optional set<enums.EnumDefault> kinds\n    8: optional double weight = 0.5\n    9: optional Point origin = {\"x\": 0, \"y\": 0}\n}\n\nstruct Settings {\n    1: optional bool enabled = true (go.omit_default = \"true\")\n    2: optional i64 limit = 4242 (go.omit_default = \"true\")\n    3: optional string label = \"none\" (go.omit_default = \"true\")\n}\n\nstruct Keyed {\n    1: optional map<Point, string> labels\n}\n\nunion Value {\n    1: Point point\n    2: string text\n}\n\nexception Failure {\n    1: required string message\n    2: optional Value value\n}\n\nstruct Node {\n    1: required Value value\n    2: optional Node child\n}\n"
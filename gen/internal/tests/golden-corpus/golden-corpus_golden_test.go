@@ -0,0 +1,324 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package golden_corpus
+
+import (
+	bytes "bytes"
+	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	testing "testing"
+)
+
+func _UUID_ptr(v thriftuuid.UUID) *thriftuuid.UUID {
+	return &v
+}
+
+type _goldenValue interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+
+	Encode(stream.Writer) error
+	Decode(stream.Reader) error
+	MarshalThriftJSON() ([]byte, error)
+	UnmarshalThriftJSON([]byte) error
+}
+
+func _goldenToWire(v _goldenValue) ([]byte, error) {
+	w, err := v.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	err = binary.Default.Encode(w, &buff)
+	return buff.Bytes(), err
+}
+
+func _goldenEncode(v _goldenValue) ([]byte, error) {
+	var buff bytes.Buffer
+	sw := binary.Default.Writer(&buff)
+	if err := v.Encode(sw); err != nil {
+		return nil, err
+	}
+	err := sw.Close()
+	return buff.Bytes(), err
+}
+
+// _goldenCheck checks that give encodes to the golden bytes with each
+// serialization method, and that decoding the golden bytes and
+// encoding the result reproduces them. newValue returns an empty
+// value of the same type to decode into. wantThriftJSON is nil if
+// the value is not tested with TJSONProtocol.
+func _goldenCheck(t *testing.T, give _goldenValue, newValue func() _goldenValue, wantBinary, wantThriftJSON []byte) {
+	t.Helper()
+
+	check := func(t *testing.T, got []byte, err error, want []byte) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("encoding changed:\n got %q\nwant %q", got, want)
+		}
+	}
+
+	t.Run("ToWire", func(t *testing.T) {
+		got, err := _goldenToWire(give)
+		check(t, got, err, wantBinary)
+	})
+
+	t.Run("FromWire", func(t *testing.T) {
+		w, err := binary.Default.Decode(bytes.NewReader(wantBinary), wire.TStruct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := newValue()
+		if err := v.FromWire(w); err != nil {
+			t.Fatal(err)
+		}
+		got, err := _goldenToWire(v)
+		check(t, got, err, wantBinary)
+	})
+
+	t.Run("Encode", func(t *testing.T) {
+		got, err := _goldenEncode(give)
+		check(t, got, err, wantBinary)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		sr := binary.Default.Reader(bytes.NewReader(wantBinary))
+		v := newValue()
+		if err := v.Decode(sr); err != nil {
+			t.Fatal(err)
+		}
+		if err := sr.Close(); err != nil {
+			t.Fatal(err)
+		}
+		got, err := _goldenEncode(v)
+		check(t, got, err, wantBinary)
+	})
+
+	if wantThriftJSON == nil {
+		return
+	}
+
+	t.Run("MarshalThriftJSON", func(t *testing.T) {
+		got, err := give.MarshalThriftJSON()
+		check(t, got, err, wantThriftJSON)
+	})
+
+	t.Run("UnmarshalThriftJSON", func(t *testing.T) {
+		v := newValue()
+		if err := v.UnmarshalThriftJSON(wantThriftJSON); err != nil {
+			t.Fatal(err)
+		}
+		got, err := v.MarshalThriftJSON()
+		check(t, got, err, wantThriftJSON)
+	})
+}
+
+var _Event_goldenBinary = []byte("\v\x00\x01\x00\x00\x00\x14representative value\v\x00\x02\x00\x00" +
+	"\x00\x14representative value\v\x00\x03\x00\x00\x00\x10\u00f8\xa5" +
+	"\xc2\x0fNK\x1e\x9eAR\xc3\xc6\xf2\xa6\xd1\b\x00\x04\x00\x00\x00\x00\x0f\x00\x05\f\x00\x00\x00\x03\b\x00\x01\x00" +
+	"\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\b\x00\x01\x00\x00\x10" +
+	"\x92\b\x00\x02\x00\x00\x10\x92\x00\r\x00\x06\v\f\x00\x00\x00\x01\x00\x00\x00\x14representa" +
+	"tive value\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\x0e\x00\a\b\x00\x00\x00" +
+	"\x01\x00\x00\x00\x00\x04\x00\b@\t!\xca\xc0\x83\x12o\f\x00\t\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10" +
+	"\x92\x00\x00")
+
+var _Event_goldenThriftJSON = []byte(`{"1":{"str":"representative value"},"2":{"str":"cmVwcmVzZW50YXRpdmUgdmFs` +
+	`dWU="},"3":{"str":"w7ilwg9OSx6eQVLDxvKm0Q=="},"4":{"i32":0},"5":{"lst":[` +
+	`"rec",3,{"1":{"i32":4242},"2":{"i32":4242}},{"1":{"i32":4242},"2":{"i32"` +
+	`:4242}},{"1":{"i32":4242},"2":{"i32":4242}}]},"6":{"map":["str","rec",1,` +
+	`{"representative value":{"1":{"i32":4242},"2":{"i32":4242}}}]},"7":{"set` +
+	`":["i32",1,0]},"8":{"dbl":3.1415},"9":{"rec":{"1":{"i32":4242},"2":{"i32` +
+	`":4242}}}}`)
+
+// TestGoldenEvent checks that the encoding of a representative
+// Event has not changed.
+func TestGoldenEvent(t *testing.T) {
+	give := &Event{
+		Digest: Digest("representative value"),
+		ID:     _UUID_ptr(thriftuuid.UUID{0xc3, 0xb8, 0xa5, 0xc2, 0x0f, 0x4e, 0x4b, 0x1e, 0x9e, 0x41, 0x52, 0xc3, 0xc6, 0xf2, 0xa6, 0xd1}),
+		Kinds: map[enums.EnumDefault]struct{}{
+			enums.EnumDefaultFoo: struct{}{},
+		},
+		Level: _Level_ptr(LevelLow),
+		Name:  "representative value",
+		Named: map[string]*Point{
+			"representative value": &Point{
+				X: 4242,
+				Y: 4242,
+			},
+		},
+		Origin: &Point{
+			X: 4242,
+			Y: 4242,
+		},
+		Points: []*Point{
+			&Point{
+				X: 4242,
+				Y: 4242,
+			},
+			&Point{
+				X: 4242,
+				Y: 4242,
+			},
+			&Point{
+				X: 4242,
+				Y: 4242,
+			},
+		},
+		Weight: ptr.Float64(3.1415),
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Event)
+	}, _Event_goldenBinary, _Event_goldenThriftJSON)
+}
+
+var _Failure_goldenBinary = []byte("\v\x00\x01\x00\x00\x00\x14representative value\f\x00\x02\f\x00" +
+	"\x01\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\x00\x00")
+
+var _Failure_goldenThriftJSON = []byte(`{"1":{"str":"representative value"},"2":{"rec":{"1":{"rec":{"1":{"i32":4` +
+	`242},"2":{"i32":4242}}}}}}`)
+
+// TestGoldenFailure checks that the encoding of a representative
+// Failure has not changed.
+func TestGoldenFailure(t *testing.T) {
+	give := &Failure{
+		Message: "representative value",
+		Value: &Value{
+			Point: &Point{
+				X: 4242,
+				Y: 4242,
+			},
+		},
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Failure)
+	}, _Failure_goldenBinary, _Failure_goldenThriftJSON)
+}
+
+var _Keyed_goldenBinary = []byte("\r\x00\x01\f\v\x00\x00\x00\x01\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\x00\x00\x00\x14repr" +
+	"esentative value\x00")
+
+// TestGoldenKeyed checks that the encoding of a representative
+// Keyed has not changed.
+func TestGoldenKeyed(t *testing.T) {
+	give := &Keyed{
+		Labels: []struct {
+			Key   *Point
+			Value string
+		}{
+			{
+				Key: &Point{
+					X: 4242,
+					Y: 4242,
+				},
+				Value: "representative value",
+			},
+		},
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Keyed)
+	}, _Keyed_goldenBinary, nil)
+}
+
+var _Node_goldenBinary = []byte("\f\x00\x01\f\x00\x01\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\x00\f\x00\x02\f\x00\x01\f\x00\x01\b" +
+	"\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\x00\f\x00\x02\f\x00\x01\v\x00\x02\x00\x00\x00\x14repr" +
+	"esentative value\x00\f\x00\x02\f\x00\x01\v\x00\x02\x00\x00\x00\x14re" +
+	"presentative value\x00\x00\x00\x00\x00")
+
+var _Node_goldenThriftJSON = []byte(`{"1":{"rec":{"1":{"rec":{"1":{"i32":4242},"2":{"i32":4242}}}}},"2":{"rec` +
+	`":{"1":{"rec":{"1":{"rec":{"1":{"i32":4242},"2":{"i32":4242}}}}},"2":{"r` +
+	`ec":{"1":{"rec":{"2":{"str":"representative value"}}},"2":{"rec":{"1":{"` +
+	`rec":{"2":{"str":"representative value"}}}}}}}}}}`)
+
+// TestGoldenNode checks that the encoding of a representative
+// Node has not changed.
+func TestGoldenNode(t *testing.T) {
+	give := &Node{
+		Child: &Node{
+			Child: &Node{
+				Child: &Node{
+					Value: &Value{
+						Text: ptr.String("representative value"),
+					},
+				},
+				Value: &Value{
+					Text: ptr.String("representative value"),
+				},
+			},
+			Value: &Value{
+				Point: &Point{
+					X: 4242,
+					Y: 4242,
+				},
+			},
+		},
+		Value: &Value{
+			Point: &Point{
+				X: 4242,
+				Y: 4242,
+			},
+		},
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Node)
+	}, _Node_goldenBinary, _Node_goldenThriftJSON)
+}
+
+var _Point_goldenBinary = []byte("\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00")
+
+var _Point_goldenThriftJSON = []byte(`{"1":{"i32":4242},"2":{"i32":4242}}`)
+
+// TestGoldenPoint checks that the encoding of a representative
+// Point has not changed.
+func TestGoldenPoint(t *testing.T) {
+	give := &Point{
+		X: 4242,
+		Y: 4242,
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Point)
+	}, _Point_goldenBinary, _Point_goldenThriftJSON)
+}
+
+var _Settings_goldenBinary = []byte("\v\x00\x03\x00\x00\x00\x14representative value\x00")
+
+var _Settings_goldenThriftJSON = []byte(`{"3":{"str":"representative value"}}`)
+
+// TestGoldenSettings checks that the encoding of a representative
+// Settings has not changed.
+func TestGoldenSettings(t *testing.T) {
+	give := &Settings{
+		Enabled: ptr.Bool(true),
+		Label:   ptr.String("representative value"),
+		Limit:   ptr.Int64(4242),
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Settings)
+	}, _Settings_goldenBinary, _Settings_goldenThriftJSON)
+}
+
+var _Value_goldenBinary = []byte("\f\x00\x01\b\x00\x01\x00\x00\x10\x92\b\x00\x02\x00\x00\x10\x92\x00\x00")
+
+var _Value_goldenThriftJSON = []byte(`{"1":{"rec":{"1":{"i32":4242},"2":{"i32":4242}}}}`)
+
+// TestGoldenValue checks that the encoding of a representative
+// Value has not changed.
+func TestGoldenValue(t *testing.T) {
+	give := &Value{
+		Point: &Point{
+			X: 4242,
+			Y: 4242,
+		},
+	}
+	_goldenCheck(t, give, func() _goldenValue {
+		return new(Value)
+	}, _Value_goldenBinary, _Value_goldenThriftJSON)
+}
@@ -9,6 +9,7 @@ import (
 	multierr "go.uber.org/multierr"
 	non_hyphenated "go.uber.org/thriftrw/gen/internal/tests/non_hyphenated"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -218,6 +219,35 @@ func (v *DocumentStruct) Equals(rhs *DocumentStruct) bool {
 	return true
 }
 
+// Copy returns a deep copy of this DocumentStruct.
+func (v *DocumentStruct) Copy() *DocumentStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o DocumentStruct
+	o.Second = v.Second.Copy()
+	return &o
+}
+
+// Hash returns a hash of this DocumentStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DocumentStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Second.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DocumentStruct so that it may be reused.
+func (v *DocumentStruct) Reset() {
+	*v = DocumentStruct{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DocumentStruct.
 func (v *DocumentStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -9,6 +9,7 @@ import (
 	multierr "go.uber.org/multierr"
 	non_hyphenated "go.uber.org/thriftrw/gen/internal/tests/non_hyphenated"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -218,6 +219,35 @@ func (v *DocumentStructure) Equals(rhs *DocumentStructure) bool {
 	return true
 }
 
+// Copy returns a deep copy of this DocumentStructure.
+func (v *DocumentStructure) Copy() *DocumentStructure {
+	if v == nil {
+		return nil
+	}
+
+	var o DocumentStructure
+	o.R2 = v.R2.Copy()
+	return &o
+}
+
+// Hash returns a hash of this DocumentStructure which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DocumentStructure) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.R2.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DocumentStructure so that it may be reused.
+func (v *DocumentStructure) Reset() {
+	*v = DocumentStructure{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DocumentStructure.
 func (v *DocumentStructure) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
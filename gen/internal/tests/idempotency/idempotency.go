@@ -0,0 +1,2006 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package idempotency
+
+import (
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	envelope "go.uber.org/thriftrw/envelope"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type Transfer struct {
+	Source      string `json:"source,required"`
+	Destination string `json:"destination,required"`
+	Amount      int64  `json:"amount,required"`
+}
+
+// ToWire translates a Transfer struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Transfer) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Source), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.Destination), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueI64(v.Amount), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Transfer struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Transfer struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Transfer
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Transfer) FromWire(w wire.Value) error {
+	var err error
+
+	sourceIsSet := false
+	destinationIsSet := false
+	amountIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Source, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				sourceIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Destination, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				destinationIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				v.Amount, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				amountIsSet = true
+			}
+		}
+	}
+
+	if !sourceIsSet {
+		return errors.New("field Source of Transfer is required")
+	}
+
+	if !destinationIsSet {
+		return errors.New("field Destination of Transfer is required")
+	}
+
+	if !amountIsSet {
+		return errors.New("field Amount of Transfer is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Transfer struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Transfer struct could not be encoded.
+func (v *Transfer) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Source); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Destination); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI64}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt64(v.Amount); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Transfer struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Transfer struct could not be generated from the wire
+// representation.
+func (v *Transfer) Decode(sr stream.Reader) error {
+
+	sourceIsSet := false
+	destinationIsSet := false
+	amountIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Source, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			sourceIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Destination, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			destinationIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI64:
+			v.Amount, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			amountIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !sourceIsSet {
+		return errors.New("field Source of Transfer is required")
+	}
+
+	if !destinationIsSet {
+		return errors.New("field Destination of Transfer is required")
+	}
+
+	if !amountIsSet {
+		return errors.New("field Amount of Transfer is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Transfer
+// struct.
+func (v *Transfer) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Source: %v", v.Source)
+	i++
+	fields[i] = fmt.Sprintf("Destination: %v", v.Destination)
+	i++
+	fields[i] = fmt.Sprintf("Amount: %v", v.Amount)
+	i++
+
+	return fmt.Sprintf("Transfer{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Transfer match the
+// provided Transfer.
+//
+// This function performs a deep comparison.
+func (v *Transfer) Equals(rhs *Transfer) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Source == rhs.Source) {
+		return false
+	}
+	if !(v.Destination == rhs.Destination) {
+		return false
+	}
+	if !(v.Amount == rhs.Amount) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Transfer.
+func (v *Transfer) Copy() *Transfer {
+	if v == nil {
+		return nil
+	}
+
+	var o Transfer
+	o.Source = v.Source
+	o.Destination = v.Destination
+	o.Amount = v.Amount
+	return &o
+}
+
+// Hash returns a hash of this Transfer which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Transfer) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Source)
+	h.Field(2)
+	h.String(v.Destination)
+	h.Field(3)
+	h.Int64(v.Amount)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Transfer so that it may be reused.
+func (v *Transfer) Reset() {
+	*v = Transfer{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Transfer.
+func (v *Transfer) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("source", v.Source)
+	enc.AddString("destination", v.Destination)
+	enc.AddInt64("amount", v.Amount)
+	return err
+}
+
+// GetSource returns the value of Source if it is set or its
+// zero value if it is unset.
+func (v *Transfer) GetSource() (o string) {
+	if v != nil {
+		o = v.Source
+	}
+	return
+}
+
+// GetDestination returns the value of Destination if it is set or its
+// zero value if it is unset.
+func (v *Transfer) GetDestination() (o string) {
+	if v != nil {
+		o = v.Destination
+	}
+	return
+}
+
+// GetAmount returns the value of Amount if it is set or its
+// zero value if it is unset.
+func (v *Transfer) GetAmount() (o int64) {
+	if v != nil {
+		o = v.Amount
+	}
+	return
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "idempotency",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/idempotency",
+	FilePath: "idempotency.thrift",
+	SHA1:     "111cf989e221e3774d3dab9fd1711c7295b91a24",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Transfer {\n    1: required string source\n    2: required string destination\n    3: required i64 amount\n}\n\nservice Ledger {\n    i64 transfer(1: Transfer transfer) (rpc.idempotency_token)\n    oneway void record(1: string entry) (rpc.idempotency_token = \"true\")\n    i64 balance(1: string account) (rpc.idempotency_token = \"false\")\n}\n"
+
+// Ledger_Balance_Args represents the arguments for the Ledger.balance function.
+//
+// The arguments for balance are sent and received over the wire as this struct.
+type Ledger_Balance_Args struct {
+	Account *string `json:"account,omitempty"`
+}
+
+// ToWire translates a Ledger_Balance_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Ledger_Balance_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Account != nil {
+		w, err = wire.NewValueString(*(v.Account)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Ledger_Balance_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Ledger_Balance_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Ledger_Balance_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Ledger_Balance_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Account = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Ledger_Balance_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Ledger_Balance_Args struct could not be encoded.
+func (v *Ledger_Balance_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Account != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Account)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Ledger_Balance_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Ledger_Balance_Args struct could not be generated from the wire
+// representation.
+func (v *Ledger_Balance_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Account = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Ledger_Balance_Args
+// struct.
+func (v *Ledger_Balance_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Account != nil {
+		fields[i] = fmt.Sprintf("Account: %v", *(v.Account))
+		i++
+	}
+
+	return fmt.Sprintf("Ledger_Balance_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Ledger_Balance_Args match the
+// provided Ledger_Balance_Args.
+//
+// This function performs a deep comparison.
+func (v *Ledger_Balance_Args) Equals(rhs *Ledger_Balance_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Account, rhs.Account) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Ledger_Balance_Args.
+func (v *Ledger_Balance_Args) Copy() *Ledger_Balance_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Ledger_Balance_Args
+	o.Account = _String_CopyPtr(v.Account)
+	return &o
+}
+
+// Hash returns a hash of this Ledger_Balance_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Ledger_Balance_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Account != nil {
+		h.Field(1)
+		h.String(*v.Account)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Ledger_Balance_Args so that it may be reused.
+func (v *Ledger_Balance_Args) Reset() {
+	*v = Ledger_Balance_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Ledger_Balance_Args.
+func (v *Ledger_Balance_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Account != nil {
+		enc.AddString("account", *v.Account)
+	}
+	return err
+}
+
+// GetAccount returns the value of Account if it is set or its
+// zero value if it is unset.
+func (v *Ledger_Balance_Args) GetAccount() (o string) {
+	if v != nil && v.Account != nil {
+		return *v.Account
+	}
+
+	return
+}
+
+// IsSetAccount returns true if Account is not nil.
+func (v *Ledger_Balance_Args) IsSetAccount() bool {
+	return v != nil && v.Account != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "balance" for this struct.
+func (v *Ledger_Balance_Args) MethodName() string {
+	return "balance"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Ledger_Balance_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Ledger_Balance_Helper provides functions that aid in handling the
+// parameters and return values of the Ledger.balance
+// function.
+var Ledger_Balance_Helper = struct {
+	// Args accepts the parameters of balance in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		account *string,
+	) *Ledger_Balance_Args
+
+	// IsException returns true if the given error can be thrown
+	// by balance.
+	//
+	// An error can be thrown by balance only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for balance
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// balance into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by balance
+	//
+	//   value, err := balance(args)
+	//   result, err := Ledger_Balance_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from balance: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(int64, error) (*Ledger_Balance_Result, error)
+
+	// UnwrapResponse takes the result struct for balance
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if balance threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Ledger_Balance_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Ledger_Balance_Result) (int64, error)
+}{}
+
+func init() {
+	Ledger_Balance_Helper.Args = func(
+		account *string,
+	) *Ledger_Balance_Args {
+		return &Ledger_Balance_Args{
+			Account: account,
+		}
+	}
+
+	Ledger_Balance_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Ledger_Balance_Helper.WrapResponse = func(success int64, err error) (*Ledger_Balance_Result, error) {
+		if err == nil {
+			return &Ledger_Balance_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Ledger_Balance_Helper.UnwrapResponse = func(result *Ledger_Balance_Result) (success int64, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Ledger_Balance_Result represents the result of a Ledger.balance function call.
+//
+// The result of a balance execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Ledger_Balance_Result struct {
+	// Value returned by balance after a successful execution.
+	Success *int64 `json:"success,omitempty"`
+}
+
+// ToWire translates a Ledger_Balance_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Ledger_Balance_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueI64(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Ledger_Balance_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Ledger_Balance_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Ledger_Balance_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Ledger_Balance_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Ledger_Balance_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Ledger_Balance_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Ledger_Balance_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Ledger_Balance_Result struct could not be encoded.
+func (v *Ledger_Balance_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Ledger_Balance_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Ledger_Balance_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Ledger_Balance_Result struct could not be generated from the wire
+// representation.
+func (v *Ledger_Balance_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Ledger_Balance_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Ledger_Balance_Result
+// struct.
+func (v *Ledger_Balance_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Ledger_Balance_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Ledger_Balance_Result match the
+// provided Ledger_Balance_Result.
+//
+// This function performs a deep comparison.
+func (v *Ledger_Balance_Result) Equals(rhs *Ledger_Balance_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Ledger_Balance_Result.
+func (v *Ledger_Balance_Result) Copy() *Ledger_Balance_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Ledger_Balance_Result
+	o.Success = _I64_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Ledger_Balance_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Ledger_Balance_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Int64(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Ledger_Balance_Result so that it may be reused.
+func (v *Ledger_Balance_Result) Reset() {
+	*v = Ledger_Balance_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Ledger_Balance_Result.
+func (v *Ledger_Balance_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddInt64("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Ledger_Balance_Result) GetSuccess() (o int64) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Ledger_Balance_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "balance" for this struct.
+func (v *Ledger_Balance_Result) MethodName() string {
+	return "balance"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Ledger_Balance_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Ledger_Record_Args represents the arguments for the Ledger.record function.
+//
+// The arguments for record are sent and received over the wire as this struct.
+type Ledger_Record_Args struct {
+	Entry *string `json:"entry,omitempty"`
+}
+
+// ToWire translates a Ledger_Record_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Ledger_Record_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Entry != nil {
+		w, err = wire.NewValueString(*(v.Entry)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Ledger_Record_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Ledger_Record_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Ledger_Record_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Ledger_Record_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Entry = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Ledger_Record_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Ledger_Record_Args struct could not be encoded.
+func (v *Ledger_Record_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Entry != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Entry)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Ledger_Record_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Ledger_Record_Args struct could not be generated from the wire
+// representation.
+func (v *Ledger_Record_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Entry = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Ledger_Record_Args
+// struct.
+func (v *Ledger_Record_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Entry != nil {
+		fields[i] = fmt.Sprintf("Entry: %v", *(v.Entry))
+		i++
+	}
+
+	return fmt.Sprintf("Ledger_Record_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Ledger_Record_Args match the
+// provided Ledger_Record_Args.
+//
+// This function performs a deep comparison.
+func (v *Ledger_Record_Args) Equals(rhs *Ledger_Record_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Entry, rhs.Entry) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Ledger_Record_Args.
+func (v *Ledger_Record_Args) Copy() *Ledger_Record_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Ledger_Record_Args
+	o.Entry = _String_CopyPtr(v.Entry)
+	return &o
+}
+
+// Hash returns a hash of this Ledger_Record_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Ledger_Record_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Entry != nil {
+		h.Field(1)
+		h.String(*v.Entry)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Ledger_Record_Args so that it may be reused.
+func (v *Ledger_Record_Args) Reset() {
+	*v = Ledger_Record_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Ledger_Record_Args.
+func (v *Ledger_Record_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Entry != nil {
+		enc.AddString("entry", *v.Entry)
+	}
+	return err
+}
+
+// GetEntry returns the value of Entry if it is set or its
+// zero value if it is unset.
+func (v *Ledger_Record_Args) GetEntry() (o string) {
+	if v != nil && v.Entry != nil {
+		return *v.Entry
+	}
+
+	return
+}
+
+// IsSetEntry returns true if Entry is not nil.
+func (v *Ledger_Record_Args) IsSetEntry() bool {
+	return v != nil && v.Entry != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "record" for this struct.
+func (v *Ledger_Record_Args) MethodName() string {
+	return "record"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *Ledger_Record_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// Ledger_Record_Helper provides functions that aid in handling the
+// parameters and return values of the Ledger.record
+// function.
+var Ledger_Record_Helper = struct {
+	// Args accepts the parameters of record in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		entry *string,
+	) *Ledger_Record_Args
+
+	// ArgsFromWire decodes the arguments struct for record
+	// from the body of a request, and returns it along with the
+	// idempotency token attached to the request with
+	// envelope.WithIdempotencyToken. The token is empty if the
+	// request did not carry one.
+	//
+	// Servers may use the token to drop requests that were
+	// delivered more than once.
+	ArgsFromWire func(wire.Value) (*Ledger_Record_Args, string, error)
+}{}
+
+func init() {
+	Ledger_Record_Helper.Args = func(
+		entry *string,
+	) *Ledger_Record_Args {
+		return &Ledger_Record_Args{
+			Entry: entry,
+		}
+	}
+
+	Ledger_Record_Helper.ArgsFromWire = func(w wire.Value) (*Ledger_Record_Args, string, error) {
+		var args Ledger_Record_Args
+		if err := args.FromWire(w); err != nil {
+			return nil, "", err
+		}
+		token, _ := envelope.IdempotencyToken(w)
+		return &args, token, nil
+	}
+
+}
+
+// Ledger_Transfer_Args represents the arguments for the Ledger.transfer function.
+//
+// The arguments for transfer are sent and received over the wire as this struct.
+type Ledger_Transfer_Args struct {
+	Transfer *Transfer `json:"transfer,omitempty"`
+}
+
+// ToWire translates a Ledger_Transfer_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Ledger_Transfer_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Transfer != nil {
+		w, err = v.Transfer.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Transfer_Read(w wire.Value) (*Transfer, error) {
+	var v Transfer
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Ledger_Transfer_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Ledger_Transfer_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Ledger_Transfer_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Ledger_Transfer_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Transfer, err = _Transfer_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Ledger_Transfer_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Ledger_Transfer_Args struct could not be encoded.
+func (v *Ledger_Transfer_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Transfer != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Transfer.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Transfer_Decode(sr stream.Reader) (*Transfer, error) {
+	var v Transfer
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Ledger_Transfer_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Ledger_Transfer_Args struct could not be generated from the wire
+// representation.
+func (v *Ledger_Transfer_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Transfer, err = _Transfer_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Ledger_Transfer_Args
+// struct.
+func (v *Ledger_Transfer_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Transfer != nil {
+		fields[i] = fmt.Sprintf("Transfer: %v", v.Transfer)
+		i++
+	}
+
+	return fmt.Sprintf("Ledger_Transfer_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Ledger_Transfer_Args match the
+// provided Ledger_Transfer_Args.
+//
+// This function performs a deep comparison.
+func (v *Ledger_Transfer_Args) Equals(rhs *Ledger_Transfer_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Transfer == nil && rhs.Transfer == nil) || (v.Transfer != nil && rhs.Transfer != nil && v.Transfer.Equals(rhs.Transfer))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Ledger_Transfer_Args.
+func (v *Ledger_Transfer_Args) Copy() *Ledger_Transfer_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Ledger_Transfer_Args
+	o.Transfer = v.Transfer.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Ledger_Transfer_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Ledger_Transfer_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Transfer.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Ledger_Transfer_Args so that it may be reused.
+func (v *Ledger_Transfer_Args) Reset() {
+	*v = Ledger_Transfer_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Ledger_Transfer_Args.
+func (v *Ledger_Transfer_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Transfer != nil {
+		err = multierr.Append(err, enc.AddObject("transfer", v.Transfer))
+	}
+	return err
+}
+
+// GetTransfer returns the value of Transfer if it is set or its
+// zero value if it is unset.
+func (v *Ledger_Transfer_Args) GetTransfer() (o *Transfer) {
+	if v != nil && v.Transfer != nil {
+		return v.Transfer
+	}
+
+	return
+}
+
+// IsSetTransfer returns true if Transfer is not nil.
+func (v *Ledger_Transfer_Args) IsSetTransfer() bool {
+	return v != nil && v.Transfer != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "transfer" for this struct.
+func (v *Ledger_Transfer_Args) MethodName() string {
+	return "transfer"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Ledger_Transfer_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Ledger_Transfer_Helper provides functions that aid in handling the
+// parameters and return values of the Ledger.transfer
+// function.
+var Ledger_Transfer_Helper = struct {
+	// Args accepts the parameters of transfer in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		transfer *Transfer,
+	) *Ledger_Transfer_Args
+
+	// IsException returns true if the given error can be thrown
+	// by transfer.
+	//
+	// An error can be thrown by transfer only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for transfer
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// transfer into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by transfer
+	//
+	//   value, err := transfer(args)
+	//   result, err := Ledger_Transfer_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from transfer: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(int64, error) (*Ledger_Transfer_Result, error)
+
+	// UnwrapResponse takes the result struct for transfer
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if transfer threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Ledger_Transfer_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Ledger_Transfer_Result) (int64, error)
+
+	// ArgsFromWire decodes the arguments struct for transfer
+	// from the body of a request, and returns it along with the
+	// idempotency token attached to the request with
+	// envelope.WithIdempotencyToken. The token is empty if the
+	// request did not carry one.
+	//
+	// Servers may use the token to drop requests that were
+	// delivered more than once.
+	ArgsFromWire func(wire.Value) (*Ledger_Transfer_Args, string, error)
+}{}
+
+func init() {
+	Ledger_Transfer_Helper.Args = func(
+		transfer *Transfer,
+	) *Ledger_Transfer_Args {
+		return &Ledger_Transfer_Args{
+			Transfer: transfer,
+		}
+	}
+
+	Ledger_Transfer_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Ledger_Transfer_Helper.WrapResponse = func(success int64, err error) (*Ledger_Transfer_Result, error) {
+		if err == nil {
+			return &Ledger_Transfer_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Ledger_Transfer_Helper.UnwrapResponse = func(result *Ledger_Transfer_Result) (success int64, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+	Ledger_Transfer_Helper.ArgsFromWire = func(w wire.Value) (*Ledger_Transfer_Args, string, error) {
+		var args Ledger_Transfer_Args
+		if err := args.FromWire(w); err != nil {
+			return nil, "", err
+		}
+		token, _ := envelope.IdempotencyToken(w)
+		return &args, token, nil
+	}
+
+}
+
+// Ledger_Transfer_Result represents the result of a Ledger.transfer function call.
+//
+// The result of a transfer execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Ledger_Transfer_Result struct {
+	// Value returned by transfer after a successful execution.
+	Success *int64 `json:"success,omitempty"`
+}
+
+// ToWire translates a Ledger_Transfer_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Ledger_Transfer_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueI64(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Ledger_Transfer_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Ledger_Transfer_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Ledger_Transfer_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Ledger_Transfer_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Ledger_Transfer_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Ledger_Transfer_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Ledger_Transfer_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Ledger_Transfer_Result struct could not be encoded.
+func (v *Ledger_Transfer_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Ledger_Transfer_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Ledger_Transfer_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Ledger_Transfer_Result struct could not be generated from the wire
+// representation.
+func (v *Ledger_Transfer_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Ledger_Transfer_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Ledger_Transfer_Result
+// struct.
+func (v *Ledger_Transfer_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Ledger_Transfer_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Ledger_Transfer_Result match the
+// provided Ledger_Transfer_Result.
+//
+// This function performs a deep comparison.
+func (v *Ledger_Transfer_Result) Equals(rhs *Ledger_Transfer_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Ledger_Transfer_Result.
+func (v *Ledger_Transfer_Result) Copy() *Ledger_Transfer_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Ledger_Transfer_Result
+	o.Success = _I64_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Ledger_Transfer_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Ledger_Transfer_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Int64(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Ledger_Transfer_Result so that it may be reused.
+func (v *Ledger_Transfer_Result) Reset() {
+	*v = Ledger_Transfer_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Ledger_Transfer_Result.
+func (v *Ledger_Transfer_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddInt64("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Ledger_Transfer_Result) GetSuccess() (o int64) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Ledger_Transfer_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "transfer" for this struct.
+func (v *Ledger_Transfer_Result) MethodName() string {
+	return "transfer"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Ledger_Transfer_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
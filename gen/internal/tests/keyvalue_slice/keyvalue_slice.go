@@ -0,0 +1,3034 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package keyvalue_slice
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+var ConstCounts []struct {
+	Key   string
+	Value int32
+} = []struct {
+	Key   string
+	Value int32
+}{
+	{
+		Key:   "hello",
+		Value: 1,
+	},
+	{
+		Key:   "world",
+		Value: 2,
+	},
+}
+
+var ConstTypedefCounts Counts = Counts{
+	{
+		Key:   "foo",
+		Value: 3,
+	},
+}
+
+type _Map_String_I32_sliceType_MapItemList []struct {
+	Key   string
+	Value int32
+}
+
+func (m _Map_String_I32_sliceType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_sliceType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_sliceType_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_sliceType_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_sliceType_MapItemList) Close() {}
+
+func _Map_String_I32_sliceType_Encode(val []struct {
+	Key   string
+	Value int32
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if err := sw.WriteString(key); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I32_sliceType_Read(m wire.MapItemList) ([]struct {
+	Key   string
+	Value int32
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int32
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value int32
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I32_sliceType_Decode(sr stream.Reader) ([]struct {
+	Key   string
+	Value int32
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int32
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value int32
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_sliceType_Equals(lhs, rhs []struct {
+	Key   string
+	Value int32
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !(lk == rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I32_sliceType_Copy(v []struct {
+	Key   string
+	Value int32
+}) []struct {
+	Key   string
+	Value int32
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int32
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_String_I32_sliceType_Hash(v []struct {
+	Key   string
+	Value int32
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.String(x.Key)
+		h.Int32(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+type _Map_String_I32_sliceType_Item_Zapper struct {
+	Key   string
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_sliceType_Item_Zapper.
+func (v _Map_String_I32_sliceType_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key)
+	enc.AddInt32("value", v.Value)
+	return err
+}
+
+type _Map_String_I32_sliceType_Zapper []struct {
+	Key   string
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_sliceType_Zapper.
+func (m _Map_String_I32_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_String_I32_sliceType_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type Counts []struct {
+	Key   string
+	Value int32
+}
+
+// ToWire translates Counts into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Counts) ToWire() (wire.Value, error) {
+	x := ([]struct {
+		Key   string
+		Value int32
+	})(v)
+	return wire.NewValueMap(_Map_String_I32_sliceType_MapItemList(x)), error(nil)
+}
+
+// String returns a readable string representation of Counts.
+func (v Counts) String() string {
+	x := ([]struct {
+		Key   string
+		Value int32
+	})(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Counts) Encode(sw stream.Writer) error {
+	x := ([]struct {
+		Key   string
+		Value int32
+	})(v)
+	return _Map_String_I32_sliceType_Encode(x, sw)
+}
+
+// FromWire deserializes Counts from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Counts) FromWire(w wire.Value) error {
+	x, err := _Map_String_I32_sliceType_Read(w.GetMap())
+	*v = (Counts)(x)
+	return err
+}
+
+// Decode deserializes Counts directly off the wire.
+func (v *Counts) Decode(sr stream.Reader) error {
+	x, err := _Map_String_I32_sliceType_Decode(sr)
+	*v = (Counts)(x)
+	return err
+}
+
+// Equals returns true if this Counts is equal to the provided
+// Counts.
+func (lhs Counts) Equals(rhs Counts) bool {
+	return _Map_String_I32_sliceType_Equals(([]struct {
+		Key   string
+		Value int32
+	})(lhs), ([]struct {
+		Key   string
+		Value int32
+	})(rhs))
+}
+
+// Copy returns a deep copy of this Counts.
+func (v Counts) Copy() Counts {
+	x := ([]struct {
+		Key   string
+		Value int32
+	})(v)
+	return (Counts)(_Map_String_I32_sliceType_Copy(x))
+}
+
+// Get returns the value for the given key in Counts and
+// whether the key was found.
+func (v Counts) Get(key string) (int32, bool) {
+	for _, i := range v {
+		if i.Key == key {
+			return i.Value, true
+		}
+	}
+	var x int32
+	return x, false
+}
+
+// Has returns true if the given key is present in Counts.
+func (v Counts) Has(key string) bool {
+	_, ok := v.Get(key)
+	return ok
+}
+
+// Hash returns a hash of this Counts which is stable across
+// processes.
+func (v Counts) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_String_I32_sliceType_Hash(([]struct {
+		Key   string
+		Value int32
+	})(v)))
+	return h.Sum64()
+}
+
+func (v Counts) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_Map_String_I32_sliceType_Zapper)(([]struct {
+		Key   string
+		Value int32
+	})(v))).MarshalLogArray(enc)
+}
+
+type Key struct {
+	Name  string   `json:"name,required"`
+	Parts []string `json:"parts,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Key struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Key) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Parts != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Parts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Key struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Key struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Key
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Key) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Parts, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Key is required")
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Key struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Key struct could not be encoded.
+func (v *Key) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Parts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Parts, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Key struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Key struct could not be generated from the wire
+// representation.
+func (v *Key) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Parts, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Key is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Key
+// struct.
+func (v *Key) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Parts != nil {
+		fields[i] = fmt.Sprintf("Parts: %v", v.Parts)
+		i++
+	}
+
+	return fmt.Sprintf("Key{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Key match the
+// provided Key.
+//
+// This function performs a deep comparison.
+func (v *Key) Equals(rhs *Key) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Parts == nil && rhs.Parts == nil) || (v.Parts != nil && rhs.Parts != nil && _List_String_Equals(v.Parts, rhs.Parts))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Key.
+func (v *Key) Copy() *Key {
+	if v == nil {
+		return nil
+	}
+
+	var o Key
+	o.Name = v.Name
+	o.Parts = _List_String_Copy(v.Parts)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Key which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Key) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(_List_String_Hash(v.Parts))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Key so that it may be reused.
+func (v *Key) Reset() {
+	*v = Key{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Key.
+func (v *Key) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Parts != nil {
+		err = multierr.Append(err, enc.AddArray("parts", (_List_String_Zapper)(v.Parts)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Key) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetParts returns the value of Parts if it is set or its
+// zero value if it is unset.
+func (v *Key) GetParts() (o []string) {
+	if v != nil && v.Parts != nil {
+		return v.Parts
+	}
+
+	return
+}
+
+// IsSetParts returns true if Parts is not nil.
+func (v *Key) IsSetParts() bool {
+	return v != nil && v.Parts != nil
+}
+
+type _Map_Key_String_sliceType_MapItemList []struct {
+	Key   *Key
+	Value string
+}
+
+func (m _Map_Key_String_sliceType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Key; Value string}': key is nil")
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Key_String_sliceType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Key_String_sliceType_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Key_String_sliceType_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Key_String_sliceType_MapItemList) Close() {}
+
+func _Map_Key_String_sliceType_Encode(val []struct {
+	Key   *Key
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TStruct,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Key; Value string}': key is nil")
+		}
+		if err := key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Key_Read(w wire.Value) (*Key, error) {
+	var v Key
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_Key_String_sliceType_Read(m wire.MapItemList) ([]struct {
+	Key   *Key
+	Value string
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Key
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Key_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Key
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Key_Decode(sr stream.Reader) (*Key, error) {
+	var v Key
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_Key_String_sliceType_Decode(sr stream.Reader) ([]struct {
+	Key   *Key
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   *Key
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Key_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *Key
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Key_String_sliceType_Equals(lhs, rhs []struct {
+	Key   *Key
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Key_String_sliceType_Copy(v []struct {
+	Key   *Key
+	Value string
+}) []struct {
+	Key   *Key
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Key
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_Key_String_sliceType_Hash(v []struct {
+	Key   *Key
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+type _Map_Key_String_sliceType_Item_Zapper struct {
+	Key   *Key
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Key_String_sliceType_Item_Zapper.
+func (v _Map_Key_String_sliceType_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Key_String_sliceType_Zapper []struct {
+	Key   *Key
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Key_String_sliceType_Zapper.
+func (m _Map_Key_String_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Key_String_sliceType_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type Labels []struct {
+	Key   *Key
+	Value string
+}
+
+// ToWire translates Labels into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Labels) ToWire() (wire.Value, error) {
+	x := ([]struct {
+		Key   *Key
+		Value string
+	})(v)
+	return wire.NewValueMap(_Map_Key_String_sliceType_MapItemList(x)), error(nil)
+}
+
+// String returns a readable string representation of Labels.
+func (v Labels) String() string {
+	x := ([]struct {
+		Key   *Key
+		Value string
+	})(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Labels) Encode(sw stream.Writer) error {
+	x := ([]struct {
+		Key   *Key
+		Value string
+	})(v)
+	return _Map_Key_String_sliceType_Encode(x, sw)
+}
+
+// FromWire deserializes Labels from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Labels) FromWire(w wire.Value) error {
+	x, err := _Map_Key_String_sliceType_Read(w.GetMap())
+	*v = (Labels)(x)
+	return err
+}
+
+// Decode deserializes Labels directly off the wire.
+func (v *Labels) Decode(sr stream.Reader) error {
+	x, err := _Map_Key_String_sliceType_Decode(sr)
+	*v = (Labels)(x)
+	return err
+}
+
+// Equals returns true if this Labels is equal to the provided
+// Labels.
+func (lhs Labels) Equals(rhs Labels) bool {
+	return _Map_Key_String_sliceType_Equals(([]struct {
+		Key   *Key
+		Value string
+	})(lhs), ([]struct {
+		Key   *Key
+		Value string
+	})(rhs))
+}
+
+// Copy returns a deep copy of this Labels.
+func (v Labels) Copy() Labels {
+	x := ([]struct {
+		Key   *Key
+		Value string
+	})(v)
+	return (Labels)(_Map_Key_String_sliceType_Copy(x))
+}
+
+// Get returns the value for the given key in Labels and
+// whether the key was found.
+func (v Labels) Get(key *Key) (string, bool) {
+	for _, i := range v {
+		if i.Key.Equals(key) {
+			return i.Value, true
+		}
+	}
+	var x string
+	return x, false
+}
+
+// Has returns true if the given key is present in Labels.
+func (v Labels) Has(key *Key) bool {
+	_, ok := v.Get(key)
+	return ok
+}
+
+// Hash returns a hash of this Labels which is stable across
+// processes.
+func (v Labels) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_Key_String_sliceType_Hash(([]struct {
+		Key   *Key
+		Value string
+	})(v)))
+	return h.Sum64()
+}
+
+func (v Labels) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_Map_Key_String_sliceType_Zapper)(([]struct {
+		Key   *Key
+		Value string
+	})(v))).MarshalLogArray(enc)
+}
+
+func _Counts_Read(w wire.Value) (Counts, error) {
+	var x Counts
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Counts_Decode(sr stream.Reader) (Counts, error) {
+	var x Counts
+	err := x.Decode(sr)
+	return x, err
+}
+
+type MyCounts Counts
+
+// ToWire translates MyCounts into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v MyCounts) ToWire() (wire.Value, error) {
+	x := (Counts)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of MyCounts.
+func (v MyCounts) String() string {
+	x := (Counts)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v MyCounts) Encode(sw stream.Writer) error {
+	x := (Counts)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes MyCounts from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *MyCounts) FromWire(w wire.Value) error {
+	x, err := _Counts_Read(w)
+	*v = (MyCounts)(x)
+	return err
+}
+
+// Decode deserializes MyCounts directly off the wire.
+func (v *MyCounts) Decode(sr stream.Reader) error {
+	x, err := _Counts_Decode(sr)
+	*v = (MyCounts)(x)
+	return err
+}
+
+// Equals returns true if this MyCounts is equal to the provided
+// MyCounts.
+func (lhs MyCounts) Equals(rhs MyCounts) bool {
+	return (Counts)(lhs).Equals((Counts)(rhs))
+}
+
+// Copy returns a deep copy of this MyCounts.
+func (v MyCounts) Copy() MyCounts {
+	x := (Counts)(v)
+	return (MyCounts)(x.Copy())
+}
+
+// Get returns the value for the given key in MyCounts and
+// whether the key was found.
+func (v MyCounts) Get(key string) (int32, bool) {
+	for _, i := range v {
+		if i.Key == key {
+			return i.Value, true
+		}
+	}
+	var x int32
+	return x, false
+}
+
+// Has returns true if the given key is present in MyCounts.
+func (v MyCounts) Has(key string) bool {
+	_, ok := v.Get(key)
+	return ok
+}
+
+// Hash returns a hash of this MyCounts which is stable across
+// processes.
+func (v MyCounts) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((Counts)(v).Hash())
+	return h.Sum64()
+}
+
+func (v MyCounts) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_Map_String_I32_sliceType_Zapper)((Counts)(v))).MarshalLogArray(enc)
+}
+
+type Tally struct {
+	RequiredTotals []struct {
+		Key   string
+		Value int64
+	} `json:"requiredTotals,required"`
+	OptionalNames []struct {
+		Key   int32
+		Value string
+	} `json:"optionalNames,omitempty"`
+	RequiredTypedefCounts Counts           `json:"requiredTypedefCounts,required"`
+	OptionalTypedefCounts Counts           `json:"optionalTypedefCounts,omitempty"`
+	Totals                map[string]int64 `json:"totals,omitempty"`
+	Nested                []struct {
+		Key   string
+		Value []struct {
+			Key   string
+			Value int32
+		}
+	} `json:"nested,omitempty"`
+	Labels Labels `json:"labels,omitempty"`
+}
+
+type _Map_String_I64_sliceType_MapItemList []struct {
+	Key   string
+	Value int64
+}
+
+func (m _Map_String_I64_sliceType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_sliceType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_sliceType_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_sliceType_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_sliceType_MapItemList) Close() {}
+
+type _Map_I32_String_sliceType_MapItemList []struct {
+	Key   int32
+	Value string
+}
+
+func (m _Map_I32_String_sliceType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_String_sliceType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_String_sliceType_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_String_sliceType_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_I32_String_sliceType_MapItemList) Close() {}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
+type _Map_String_Map_String_I32_sliceType_sliceType_MapItemList []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}
+
+func (m _Map_String_Map_String_I32_sliceType_sliceType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if v == nil {
+			return fmt.Errorf("invalid map '[]struct{Key string; Value []struct{Key string; Value int32}}', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueMap(_Map_String_I32_sliceType_MapItemList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Map_String_I32_sliceType_sliceType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Map_String_I32_sliceType_sliceType_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Map_String_I32_sliceType_sliceType_MapItemList) ValueType() wire.Type {
+	return wire.TMap
+}
+
+func (_Map_String_Map_String_I32_sliceType_sliceType_MapItemList) Close() {}
+
+// ToWire translates a Tally struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Tally) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RequiredTotals == nil {
+		return w, errors.New("field RequiredTotals of Tally is required")
+	}
+	w, err = wire.NewValueMap(_Map_String_I64_sliceType_MapItemList(v.RequiredTotals)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.OptionalNames != nil {
+		w, err = wire.NewValueMap(_Map_I32_String_sliceType_MapItemList(v.OptionalNames)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.RequiredTypedefCounts == nil {
+		return w, errors.New("field RequiredTypedefCounts of Tally is required")
+	}
+	w, err = v.RequiredTypedefCounts.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.OptionalTypedefCounts != nil {
+		w, err = v.OptionalTypedefCounts.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Totals != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.Totals)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Nested != nil {
+		w, err = wire.NewValueMap(_Map_String_Map_String_I32_sliceType_sliceType_MapItemList(v.Nested)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = v.Labels.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_I64_sliceType_Read(m wire.MapItemList) ([]struct {
+	Key   string
+	Value int64
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int64
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value int64
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_I32_String_sliceType_Read(m wire.MapItemList) ([]struct {
+	Key   int32
+	Value string
+}, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   int32
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   int32
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_Map_String_I32_sliceType_sliceType_Read(m wire.MapItemList) ([]struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TMap {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value []struct {
+			Key   string
+			Value int32
+		}
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Map_String_I32_sliceType_Read(x.Value.GetMap())
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value []struct {
+				Key   string
+				Value int32
+			}
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Labels_Read(w wire.Value) (Labels, error) {
+	var x Labels
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Tally struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Tally struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Tally
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Tally) FromWire(w wire.Value) error {
+	var err error
+
+	requiredTotalsIsSet := false
+
+	requiredTypedefCountsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.RequiredTotals, err = _Map_String_I64_sliceType_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				requiredTotalsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.OptionalNames, err = _Map_I32_String_sliceType_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.RequiredTypedefCounts, err = _Counts_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				requiredTypedefCountsIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.OptionalTypedefCounts, err = _Counts_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Totals, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Nested, err = _Map_String_Map_String_I32_sliceType_sliceType_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Labels_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !requiredTotalsIsSet {
+		return errors.New("field RequiredTotals of Tally is required")
+	}
+
+	if !requiredTypedefCountsIsSet {
+		return errors.New("field RequiredTypedefCounts of Tally is required")
+	}
+
+	return nil
+}
+
+func _Map_String_I64_sliceType_Encode(val []struct {
+	Key   string
+	Value int64
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if err := sw.WriteString(key); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_I32_String_sliceType_Encode(val []struct {
+	Key   int32
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if err := sw.WriteInt32(key); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I64_Encode(val map[string]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_Map_String_I32_sliceType_sliceType_Encode(val []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TMap,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if value == nil {
+			return fmt.Errorf("invalid map '[]struct{Key string; Value []struct{Key string; Value int32}}', key [%v]: value is nil", key)
+		}
+		if err := sw.WriteString(key); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_sliceType_Encode(value, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Tally struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Tally struct could not be encoded.
+func (v *Tally) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.RequiredTotals == nil {
+		return errors.New("field RequiredTotals of Tally is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TMap}); err != nil {
+		return err
+	}
+	if err := _Map_String_I64_sliceType_Encode(v.RequiredTotals, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.OptionalNames != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_String_sliceType_Encode(v.OptionalNames, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RequiredTypedefCounts == nil {
+		return errors.New("field RequiredTypedefCounts of Tally is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+		return err
+	}
+	if err := v.RequiredTypedefCounts.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.OptionalTypedefCounts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := v.OptionalTypedefCounts.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Totals != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.Totals, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Nested != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Map_String_I32_sliceType_sliceType_Encode(v.Nested, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := v.Labels.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Map_String_I64_sliceType_Decode(sr stream.Reader) ([]struct {
+	Key   string
+	Value int64
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int64
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value int64
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I32_String_sliceType_Decode(sr stream.Reader) ([]struct {
+	Key   int32
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   int32
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   int32
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I64_Decode(sr stream.Reader) (map[string]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_Map_String_I32_sliceType_sliceType_Decode(sr stream.Reader) ([]struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TMap {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   string
+		Value []struct {
+			Key   string
+			Value int32
+		}
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Map_String_I32_sliceType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   string
+			Value []struct {
+				Key   string
+				Value int32
+			}
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Labels_Decode(sr stream.Reader) (Labels, error) {
+	var x Labels
+	err := x.Decode(sr)
+	return x, err
+}
+
+// Decode deserializes a Tally struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Tally struct could not be generated from the wire
+// representation.
+func (v *Tally) Decode(sr stream.Reader) error {
+
+	requiredTotalsIsSet := false
+
+	requiredTypedefCountsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.RequiredTotals, err = _Map_String_I64_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredTotalsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.OptionalNames, err = _Map_I32_String_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.RequiredTypedefCounts, err = _Counts_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredTypedefCountsIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TMap:
+			v.OptionalTypedefCounts, err = _Counts_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.Totals, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.Nested, err = _Map_String_Map_String_I32_sliceType_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Labels, err = _Labels_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !requiredTotalsIsSet {
+		return errors.New("field RequiredTotals of Tally is required")
+	}
+
+	if !requiredTypedefCountsIsSet {
+		return errors.New("field RequiredTypedefCounts of Tally is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Tally
+// struct.
+func (v *Tally) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("RequiredTotals: %v", v.RequiredTotals)
+	i++
+	if v.OptionalNames != nil {
+		fields[i] = fmt.Sprintf("OptionalNames: %v", v.OptionalNames)
+		i++
+	}
+	fields[i] = fmt.Sprintf("RequiredTypedefCounts: %v", v.RequiredTypedefCounts)
+	i++
+	if v.OptionalTypedefCounts != nil {
+		fields[i] = fmt.Sprintf("OptionalTypedefCounts: %v", v.OptionalTypedefCounts)
+		i++
+	}
+	if v.Totals != nil {
+		fields[i] = fmt.Sprintf("Totals: %v", v.Totals)
+		i++
+	}
+	if v.Nested != nil {
+		fields[i] = fmt.Sprintf("Nested: %v", v.Nested)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+
+	return fmt.Sprintf("Tally{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_I64_sliceType_Equals(lhs, rhs []struct {
+	Key   string
+	Value int64
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !(lk == rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_I32_String_sliceType_Equals(lhs, rhs []struct {
+	Key   int32
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !(lk == rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_Map_String_I32_sliceType_sliceType_Equals(lhs, rhs []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !(lk == rk) {
+				continue
+			}
+
+			if !_Map_String_I32_sliceType_Equals(lv, rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Tally match the
+// provided Tally.
+//
+// This function performs a deep comparison.
+func (v *Tally) Equals(rhs *Tally) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Map_String_I64_sliceType_Equals(v.RequiredTotals, rhs.RequiredTotals) {
+		return false
+	}
+	if !((v.OptionalNames == nil && rhs.OptionalNames == nil) || (v.OptionalNames != nil && rhs.OptionalNames != nil && _Map_I32_String_sliceType_Equals(v.OptionalNames, rhs.OptionalNames))) {
+		return false
+	}
+	if !v.RequiredTypedefCounts.Equals(rhs.RequiredTypedefCounts) {
+		return false
+	}
+	if !((v.OptionalTypedefCounts == nil && rhs.OptionalTypedefCounts == nil) || (v.OptionalTypedefCounts != nil && rhs.OptionalTypedefCounts != nil && v.OptionalTypedefCounts.Equals(rhs.OptionalTypedefCounts))) {
+		return false
+	}
+	if !((v.Totals == nil && rhs.Totals == nil) || (v.Totals != nil && rhs.Totals != nil && _Map_String_I64_Equals(v.Totals, rhs.Totals))) {
+		return false
+	}
+	if !((v.Nested == nil && rhs.Nested == nil) || (v.Nested != nil && rhs.Nested != nil && _Map_String_Map_String_I32_sliceType_sliceType_Equals(v.Nested, rhs.Nested))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && v.Labels.Equals(rhs.Labels))) {
+		return false
+	}
+
+	return true
+}
+
+func _Map_String_I64_sliceType_Copy(v []struct {
+	Key   string
+	Value int64
+}) []struct {
+	Key   string
+	Value int64
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value int64
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_I32_String_sliceType_Copy(v []struct {
+	Key   int32
+	Value string
+}) []struct {
+	Key   int32
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   int32
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_String_I64_Copy(v map[string]int64) map[string]int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_Map_String_I32_sliceType_sliceType_Copy(v []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}) []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   string
+		Value []struct {
+			Key   string
+			Value int32
+		}
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key
+		o[i].Value = _Map_String_I32_sliceType_Copy(x.Value)
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Tally.
+func (v *Tally) Copy() *Tally {
+	if v == nil {
+		return nil
+	}
+
+	var o Tally
+	o.RequiredTotals = _Map_String_I64_sliceType_Copy(v.RequiredTotals)
+	o.OptionalNames = _Map_I32_String_sliceType_Copy(v.OptionalNames)
+	o.RequiredTypedefCounts = v.RequiredTypedefCounts.Copy()
+	o.OptionalTypedefCounts = v.OptionalTypedefCounts.Copy()
+	o.Totals = _Map_String_I64_Copy(v.Totals)
+	o.Nested = _Map_String_Map_String_I32_sliceType_sliceType_Copy(v.Nested)
+	o.Labels = v.Labels.Copy()
+	return &o
+}
+
+func _Map_String_I64_sliceType_Hash(v []struct {
+	Key   string
+	Value int64
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.String(x.Key)
+		h.Int64(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_I32_String_sliceType_Hash(v []struct {
+	Key   int32
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Int32(x.Key)
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_I64_Hash(v map[string]int64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_Map_String_I32_sliceType_sliceType_Hash(v []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.String(x.Key)
+		h.Uint64(_Map_String_I32_sliceType_Hash(x.Value))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Tally which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Tally) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Map_String_I64_sliceType_Hash(v.RequiredTotals))
+	h.Field(2)
+	h.Uint64(_Map_I32_String_sliceType_Hash(v.OptionalNames))
+	h.Field(3)
+	h.Uint64(v.RequiredTypedefCounts.Hash())
+	h.Field(4)
+	h.Uint64(v.OptionalTypedefCounts.Hash())
+	h.Field(5)
+	h.Uint64(_Map_String_I64_Hash(v.Totals))
+	h.Field(6)
+	h.Uint64(_Map_String_Map_String_I32_sliceType_sliceType_Hash(v.Nested))
+	h.Field(7)
+	h.Uint64(v.Labels.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Tally so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Tally) Reset() {
+	*v = Tally{
+		RequiredTotals:        v.RequiredTotals[:0],
+		RequiredTypedefCounts: v.RequiredTypedefCounts[:0],
+	}
+}
+
+type _Map_String_I64_sliceType_Item_Zapper struct {
+	Key   string
+	Value int64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I64_sliceType_Item_Zapper.
+func (v _Map_String_I64_sliceType_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key)
+	enc.AddInt64("value", v.Value)
+	return err
+}
+
+type _Map_String_I64_sliceType_Zapper []struct {
+	Key   string
+	Value int64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I64_sliceType_Zapper.
+func (m _Map_String_I64_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_String_I64_sliceType_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_I32_String_sliceType_Item_Zapper struct {
+	Key   int32
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_sliceType_Item_Zapper.
+func (v _Map_I32_String_sliceType_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_I32_String_sliceType_Zapper []struct {
+	Key   int32
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_sliceType_Zapper.
+func (m _Map_I32_String_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_String_sliceType_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_String_I64_Zapper map[string]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
+	}
+	return err
+}
+
+type _Map_String_Map_String_I32_sliceType_sliceType_Item_Zapper struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_Map_String_I32_sliceType_sliceType_Item_Zapper.
+func (v _Map_String_Map_String_I32_sliceType_sliceType_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key)
+	err = multierr.Append(err, enc.AddArray("value", (_Map_String_I32_sliceType_Zapper)(v.Value)))
+	return err
+}
+
+type _Map_String_Map_String_I32_sliceType_sliceType_Zapper []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_Map_String_I32_sliceType_sliceType_Zapper.
+func (m _Map_String_Map_String_I32_sliceType_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_String_Map_String_I32_sliceType_sliceType_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Tally.
+func (v *Tally) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddArray("requiredTotals", (_Map_String_I64_sliceType_Zapper)(v.RequiredTotals)))
+	if v.OptionalNames != nil {
+		err = multierr.Append(err, enc.AddArray("optionalNames", (_Map_I32_String_sliceType_Zapper)(v.OptionalNames)))
+	}
+	err = multierr.Append(err, enc.AddArray("requiredTypedefCounts", (_Map_String_I32_sliceType_Zapper)(v.RequiredTypedefCounts)))
+	if v.OptionalTypedefCounts != nil {
+		err = multierr.Append(err, enc.AddArray("optionalTypedefCounts", (_Map_String_I32_sliceType_Zapper)(v.OptionalTypedefCounts)))
+	}
+	if v.Totals != nil {
+		err = multierr.Append(err, enc.AddObject("totals", (_Map_String_I64_Zapper)(v.Totals)))
+	}
+	if v.Nested != nil {
+		err = multierr.Append(err, enc.AddArray("nested", (_Map_String_Map_String_I32_sliceType_sliceType_Zapper)(v.Nested)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_Key_String_sliceType_Zapper)(v.Labels)))
+	}
+	return err
+}
+
+// GetRequiredTotals returns the value of RequiredTotals if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetRequiredTotals() (o []struct {
+	Key   string
+	Value int64
+}) {
+	if v != nil {
+		o = v.RequiredTotals
+	}
+	return
+}
+
+// IsSetRequiredTotals returns true if RequiredTotals is not nil.
+func (v *Tally) IsSetRequiredTotals() bool {
+	return v != nil && v.RequiredTotals != nil
+}
+
+// GetOptionalNames returns the value of OptionalNames if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetOptionalNames() (o []struct {
+	Key   int32
+	Value string
+}) {
+	if v != nil && v.OptionalNames != nil {
+		return v.OptionalNames
+	}
+
+	return
+}
+
+// IsSetOptionalNames returns true if OptionalNames is not nil.
+func (v *Tally) IsSetOptionalNames() bool {
+	return v != nil && v.OptionalNames != nil
+}
+
+// GetRequiredTypedefCounts returns the value of RequiredTypedefCounts if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetRequiredTypedefCounts() (o Counts) {
+	if v != nil {
+		o = v.RequiredTypedefCounts
+	}
+	return
+}
+
+// IsSetRequiredTypedefCounts returns true if RequiredTypedefCounts is not nil.
+func (v *Tally) IsSetRequiredTypedefCounts() bool {
+	return v != nil && v.RequiredTypedefCounts != nil
+}
+
+// GetOptionalTypedefCounts returns the value of OptionalTypedefCounts if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetOptionalTypedefCounts() (o Counts) {
+	if v != nil && v.OptionalTypedefCounts != nil {
+		return v.OptionalTypedefCounts
+	}
+
+	return
+}
+
+// IsSetOptionalTypedefCounts returns true if OptionalTypedefCounts is not nil.
+func (v *Tally) IsSetOptionalTypedefCounts() bool {
+	return v != nil && v.OptionalTypedefCounts != nil
+}
+
+// GetTotals returns the value of Totals if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetTotals() (o map[string]int64) {
+	if v != nil && v.Totals != nil {
+		return v.Totals
+	}
+
+	return
+}
+
+// IsSetTotals returns true if Totals is not nil.
+func (v *Tally) IsSetTotals() bool {
+	return v != nil && v.Totals != nil
+}
+
+// GetNested returns the value of Nested if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetNested() (o []struct {
+	Key   string
+	Value []struct {
+		Key   string
+		Value int32
+	}
+}) {
+	if v != nil && v.Nested != nil {
+		return v.Nested
+	}
+
+	return
+}
+
+// IsSetNested returns true if Nested is not nil.
+func (v *Tally) IsSetNested() bool {
+	return v != nil && v.Nested != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Tally) GetLabels() (o Labels) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Tally) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "keyvalue_slice",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/keyvalue_slice",
+	FilePath: "keyvalue_slice.thrift",
+	SHA1:     "46119a3e8200b7941cdccb576b624db7c927a2ae",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef map<string, i32> (go.type = \"keyvalue-slice\") Counts\ntypedef map<Key, string> (go.type = \"keyvalue-slice\") Labels\ntypedef Counts MyCounts\n\nstruct Key {\n    1: required string name\n    2: optional list<string> parts\n}\n\nstruct Tally {\n    1: required map<string, i64> (go.type = \"keyvalue-slice\") requiredTotals\n    2: optional map<i32, string> (go.type = \"keyvalue-slice\") optionalNames\n    3: required Counts requiredTypedefCounts\n    4: optional Counts optionalTypedefCounts\n    5: optional map<string, i64> totals\n    6: optional map<string, map<string, i32> (go.type = \"keyvalue-slice\")> (go.type = \"keyvalue-slice\") nested\n    7: optional Labels labels\n}\n\nconst map<string, i32> (go.type = \"keyvalue-slice\") ConstCounts = {\"hello\": 1, \"world\": 2}\nconst Counts ConstTypedefCounts = {\"foo\": 3}\n"
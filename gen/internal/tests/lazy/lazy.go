@@ -0,0 +1,3294 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package lazy
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Eager struct {
+	Value *string `json:"value,omitempty"`
+}
+
+// ToWire translates a Eager struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Eager) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Eager struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Eager struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Eager
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Eager) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Eager struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Eager struct could not be encoded.
+func (v *Eager) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Value)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Eager struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Eager struct could not be generated from the wire
+// representation.
+func (v *Eager) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Value = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Eager
+// struct.
+func (v *Eager) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
+	}
+
+	return fmt.Sprintf("Eager{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Eager match the
+// provided Eager.
+//
+// This function performs a deep comparison.
+func (v *Eager) Equals(rhs *Eager) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Eager.
+func (v *Eager) Copy() *Eager {
+	if v == nil {
+		return nil
+	}
+
+	var o Eager
+	o.Value = _String_CopyPtr(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this Eager which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Eager) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Value != nil {
+		h.Field(1)
+		h.String(*v.Value)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Eager so that it may be reused.
+func (v *Eager) Reset() {
+	*v = Eager{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Eager.
+func (v *Eager) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Eager) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Eager) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+type Empty struct {
+}
+
+// ToWire translates a Empty struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Empty) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Empty struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Empty struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Empty
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Empty) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Empty struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Empty struct could not be encoded.
+func (v *Empty) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Empty struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Empty struct could not be generated from the wire
+// representation.
+func (v *Empty) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Empty
+// struct.
+func (v *Empty) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Empty{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Empty match the
+// provided Empty.
+//
+// This function performs a deep comparison.
+func (v *Empty) Equals(rhs *Empty) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Empty.
+func (v *Empty) Copy() *Empty {
+	if v == nil {
+		return nil
+	}
+
+	var o Empty
+	return &o
+}
+
+// Hash returns a hash of this Empty which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Empty) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Empty so that it may be reused.
+func (v *Empty) Reset() {
+	*v = Empty{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Empty.
+func (v *Empty) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// Empty_Lazy provides access to the fields of a Thrift Binary Protocol
+// encoded Empty, decoding each field only when it is first
+// accessed. Decoded fields are retained until the next call to Reset.
+//
+// Empty_Lazy is not safe for concurrent use.
+type Empty_Lazy struct {
+	raw binary.LazyStruct
+	v   Empty
+}
+
+// Reset discards all decoded fields and starts reading from raw,
+// which holds a Thrift Binary Protocol encoded Empty. raw must
+// not be modified while it is in use.
+func (v *Empty_Lazy) Reset(raw []byte) {
+	v.raw.Reset(raw)
+	v.v = Empty{}
+}
+
+// Bytes returns the encoded Empty.
+func (v *Empty_Lazy) Bytes() []byte {
+	return v.raw.Bytes()
+}
+
+// Struct decodes all fields into a new Empty.
+func (v *Empty_Lazy) Struct() (*Empty, error) {
+	var x Empty
+	sr := binary.NewStreamReader(bytes.NewReader(v.raw.Bytes()))
+	defer sr.Close()
+	err := x.Decode(sr)
+	return &x, err
+}
+
+// Event is decoded lazily.
+type Event struct {
+	ID        string            `json:"id,required"`
+	Name      *string           `json:"name,omitempty"`
+	Timestamp *int64            `json:"timestamp,omitempty"`
+	Tags      []string          `json:"tags,omitempty"`
+	Points    map[string]*Point `json:"points,omitempty"`
+	Origin    *Point            `json:"origin,required"`
+	Level     *Level            `json:"level,omitempty"`
+	Payload   []byte            `json:"payload,omitempty"`
+	Active    *bool             `json:"active,omitempty"`
+}
+
+// Default_Event constructs a new Event struct,
+// pre-populating any fields with defined default values.
+func Default_Event() *Event {
+	var v Event
+	v.Timestamp = ptr.Int64(42)
+	v.Active = ptr.Bool(true)
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_Point_MapItemList map[string]*Point
+
+func (m _Map_String_Point_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*Point', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Point_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Point_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Point_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Point_MapItemList) Close() {}
+
+// ToWire translates a Event struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Event) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	vTimestamp := v.Timestamp
+	if vTimestamp == nil {
+		vTimestamp = ptr.Int64(42)
+	}
+	{
+		w, err = wire.NewValueI64(*(vTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Points != nil {
+		w, err = wire.NewValueMap(_Map_String_Point_MapItemList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Origin == nil {
+		return w, errors.New("field Origin of Event is required")
+	}
+	w, err = v.Origin.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 6, Value: w}
+	i++
+	if v.Level != nil {
+		w, err = v.Level.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Payload != nil {
+		w, err = wire.NewValueBinary(v.Payload), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	vActive := v.Active
+	if vActive == nil {
+		vActive = ptr.Bool(true)
+	}
+	{
+		w, err = wire.NewValueBool(*(vActive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_Point_Read(m wire.MapItemList) (map[string]*Point, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Point, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Point_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Level_Read(w wire.Value) (Level, error) {
+	var v Level
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a Event struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Event struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Event
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Event) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	originIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Points, err = _Map_String_Point_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.Origin, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				originIsSet = true
+			}
+		case 7:
+			if field.Value.Type() == wire.TI32 {
+				var x Level
+				x, err = _Level_Read(field.Value)
+				v.Level = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.Payload, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Active = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Event is required")
+	}
+
+	if v.Timestamp == nil {
+		v.Timestamp = ptr.Int64(42)
+	}
+
+	if !originIsSet {
+		return errors.New("field Origin of Event is required")
+	}
+
+	if v.Active == nil {
+		v.Active = ptr.Bool(true)
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_Point_Encode(val map[string]*Point, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*Point', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Event struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Event struct could not be encoded.
+func (v *Event) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vTimestamp := v.Timestamp
+	if vTimestamp == nil {
+		vTimestamp = ptr.Int64(42)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(vTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Point_Encode(v.Points, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Origin == nil {
+		return errors.New("field Origin of Event is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Origin.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Level != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Level.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Payload != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Payload); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vActive := v.Active
+	if vActive == nil {
+		vActive = ptr.Bool(true)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(vActive)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_Point_Decode(sr stream.Reader) (map[string]*Point, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]*Point, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Level_Decode(sr stream.Reader) (Level, error) {
+	var v Level
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a Event struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Event struct could not be generated from the wire
+// representation.
+func (v *Event) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	originIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Timestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.Points, err = _Map_String_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.Origin, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+			originIsSet = true
+		case fh.ID == 7 && fh.Type == wire.TI32:
+			var x Level
+			x, err = _Level_Decode(sr)
+			v.Level = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.Payload, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Active = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Event is required")
+	}
+
+	if v.Timestamp == nil {
+		v.Timestamp = ptr.Int64(42)
+	}
+
+	if !originIsSet {
+		return errors.New("field Origin of Event is required")
+	}
+
+	if v.Active == nil {
+		v.Active = ptr.Bool(true)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Event
+// struct.
+func (v *Event) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	fields[i] = fmt.Sprintf("Origin: %v", v.Origin)
+	i++
+	if v.Level != nil {
+		fields[i] = fmt.Sprintf("Level: %v", *(v.Level))
+		i++
+	}
+	if v.Payload != nil {
+		fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
+		i++
+	}
+	if v.Active != nil {
+		fields[i] = fmt.Sprintf("Active: %v", *(v.Active))
+		i++
+	}
+
+	return fmt.Sprintf("Event{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Point_Equals(lhs, rhs map[string]*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Level_EqualsPtr(lhs, rhs *Level) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Event match the
+// provided Event.
+//
+// This function performs a deep comparison.
+func (v *Event) Equals(rhs *Event) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _Map_String_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !v.Origin.Equals(rhs.Origin) {
+		return false
+	}
+	if !_Level_EqualsPtr(v.Level, rhs.Level) {
+		return false
+	}
+	if !((v.Payload == nil && rhs.Payload == nil) || (v.Payload != nil && rhs.Payload != nil && bytes.Equal(v.Payload, rhs.Payload))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Active, rhs.Active) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_Point_Copy(v map[string]*Point) map[string]*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*Point, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
+
+func _Level_CopyPtr(v *Level) *Level {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Event.
+func (v *Event) Copy() *Event {
+	if v == nil {
+		return nil
+	}
+
+	var o Event
+	o.ID = v.ID
+	o.Name = _String_CopyPtr(v.Name)
+	o.Timestamp = _I64_CopyPtr(v.Timestamp)
+	o.Tags = _List_String_Copy(v.Tags)
+	o.Points = _Map_String_Point_Copy(v.Points)
+	o.Origin = v.Origin.Copy()
+	o.Level = _Level_CopyPtr(v.Level)
+	o.Payload = _Binary_Copy(v.Payload)
+	o.Active = _Bool_CopyPtr(v.Active)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_Point_Hash(v map[string]*Point) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Event which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Event) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	if v.Timestamp != nil {
+		h.Field(3)
+		h.Int64(*v.Timestamp)
+	}
+	h.Field(4)
+	h.Uint64(_List_String_Hash(v.Tags))
+	h.Field(5)
+	h.Uint64(_Map_String_Point_Hash(v.Points))
+	h.Field(6)
+	h.Uint64(v.Origin.Hash())
+	if v.Level != nil {
+		h.Field(7)
+		h.Int32(int32(*v.Level))
+	}
+	h.Field(8)
+	h.Binary(v.Payload)
+	if v.Active != nil {
+		h.Field(9)
+		h.Bool(*v.Active)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Event so that it may be reused.
+func (v *Event) Reset() {
+	*v = Event{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Map_String_Point_Zapper map[string]*Point
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Point_Zapper.
+func (m _Map_String_Point_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Event.
+func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddObject("points", (_Map_String_Point_Zapper)(v.Points)))
+	}
+	err = multierr.Append(err, enc.AddObject("origin", v.Origin))
+	if v.Level != nil {
+		err = multierr.Append(err, enc.AddObject("level", *v.Level))
+	}
+	if v.Payload != nil {
+		enc.AddString("payload", base64.StdEncoding.EncodeToString(v.Payload))
+	}
+	if v.Active != nil {
+		enc.AddBool("active", *v.Active)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Event) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Event) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Event) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// default value if it is unset.
+func (v *Event) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+	o = 42
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *Event) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Event) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Event) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Event) GetPoints() (o map[string]*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Event) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetOrigin returns the value of Origin if it is set or its
+// zero value if it is unset.
+func (v *Event) GetOrigin() (o *Point) {
+	if v != nil {
+		o = v.Origin
+	}
+	return
+}
+
+// IsSetOrigin returns true if Origin is not nil.
+func (v *Event) IsSetOrigin() bool {
+	return v != nil && v.Origin != nil
+}
+
+// GetLevel returns the value of Level if it is set or its
+// zero value if it is unset.
+func (v *Event) GetLevel() (o Level) {
+	if v != nil && v.Level != nil {
+		return *v.Level
+	}
+
+	return
+}
+
+// IsSetLevel returns true if Level is not nil.
+func (v *Event) IsSetLevel() bool {
+	return v != nil && v.Level != nil
+}
+
+// GetPayload returns the value of Payload if it is set or its
+// zero value if it is unset.
+func (v *Event) GetPayload() (o []byte) {
+	if v != nil && v.Payload != nil {
+		return v.Payload
+	}
+
+	return
+}
+
+// IsSetPayload returns true if Payload is not nil.
+func (v *Event) IsSetPayload() bool {
+	return v != nil && v.Payload != nil
+}
+
+// GetActive returns the value of Active if it is set or its
+// default value if it is unset.
+func (v *Event) GetActive() (o bool) {
+	if v != nil && v.Active != nil {
+		return *v.Active
+	}
+	o = true
+	return
+}
+
+// IsSetActive returns true if Active is not nil.
+func (v *Event) IsSetActive() bool {
+	return v != nil && v.Active != nil
+}
+
+// Event_Lazy provides access to the fields of a Thrift Binary Protocol
+// encoded Event, decoding each field only when it is first
+// accessed. Decoded fields are retained until the next call to Reset.
+//
+// Event_Lazy is not safe for concurrent use.
+type Event_Lazy struct {
+	raw     binary.LazyStruct
+	v       Event
+	decoded [9]bool
+}
+
+// Reset discards all decoded fields and starts reading from raw,
+// which holds a Thrift Binary Protocol encoded Event. raw must
+// not be modified while it is in use.
+func (v *Event_Lazy) Reset(raw []byte) {
+	v.raw.Reset(raw)
+	v.v = Event{}
+	v.decoded = [9]bool{}
+}
+
+// Bytes returns the encoded Event.
+func (v *Event_Lazy) Bytes() []byte {
+	return v.raw.Bytes()
+}
+
+// Struct decodes all fields into a new Event.
+func (v *Event_Lazy) Struct() (*Event, error) {
+	var x Event
+	sr := binary.NewStreamReader(bytes.NewReader(v.raw.Bytes()))
+	defer sr.Close()
+	err := x.Decode(sr)
+	return &x, err
+}
+
+// GetID returns the value of ID, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetID() (o string, err error) {
+	if err = v.decode(0); err == nil {
+		o = v.v.GetID()
+	}
+	return
+}
+
+// GetName returns the value of Name, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetName() (o string, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.GetName()
+	}
+	return
+}
+
+// IsSetName returns true if Name is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetName() (o bool, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.IsSetName()
+	}
+	return
+}
+
+// GetTimestamp returns the value of Timestamp, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetTimestamp() (o int64, err error) {
+	if err = v.decode(2); err == nil {
+		o = v.v.GetTimestamp()
+	}
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetTimestamp() (o bool, err error) {
+	if err = v.decode(2); err == nil {
+		o = v.v.IsSetTimestamp()
+	}
+	return
+}
+
+// GetTags returns the value of Tags, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetTags() (o []string, err error) {
+	if err = v.decode(3); err == nil {
+		o = v.v.GetTags()
+	}
+	return
+}
+
+// IsSetTags returns true if Tags is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetTags() (o bool, err error) {
+	if err = v.decode(3); err == nil {
+		o = v.v.IsSetTags()
+	}
+	return
+}
+
+// GetPoints returns the value of Points, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetPoints() (o map[string]*Point, err error) {
+	if err = v.decode(4); err == nil {
+		o = v.v.GetPoints()
+	}
+	return
+}
+
+// IsSetPoints returns true if Points is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetPoints() (o bool, err error) {
+	if err = v.decode(4); err == nil {
+		o = v.v.IsSetPoints()
+	}
+	return
+}
+
+// GetOrigin returns the value of Origin, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetOrigin() (o *Point, err error) {
+	if err = v.decode(5); err == nil {
+		o = v.v.GetOrigin()
+	}
+	return
+}
+
+// IsSetOrigin returns true if Origin is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetOrigin() (o bool, err error) {
+	if err = v.decode(5); err == nil {
+		o = v.v.IsSetOrigin()
+	}
+	return
+}
+
+// GetLevel returns the value of Level, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetLevel() (o Level, err error) {
+	if err = v.decode(6); err == nil {
+		o = v.v.GetLevel()
+	}
+	return
+}
+
+// IsSetLevel returns true if Level is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetLevel() (o bool, err error) {
+	if err = v.decode(6); err == nil {
+		o = v.v.IsSetLevel()
+	}
+	return
+}
+
+// GetPayload returns the value of Payload, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetPayload() (o []byte, err error) {
+	if err = v.decode(7); err == nil {
+		o = v.v.GetPayload()
+	}
+	return
+}
+
+// IsSetPayload returns true if Payload is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetPayload() (o bool, err error) {
+	if err = v.decode(7); err == nil {
+		o = v.v.IsSetPayload()
+	}
+	return
+}
+
+// GetActive returns the value of Active, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetActive() (o bool, err error) {
+	if err = v.decode(8); err == nil {
+		o = v.v.GetActive()
+	}
+	return
+}
+
+// IsSetActive returns true if Active is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetActive() (o bool, err error) {
+	if err = v.decode(8); err == nil {
+		o = v.v.IsSetActive()
+	}
+	return
+}
+
+// decode decodes the field at the given index if it has not been
+// decoded yet.
+func (v *Event_Lazy) decode(i int) error {
+	if v.decoded[i] {
+		return nil
+	}
+
+	var (
+		fr  stream.Reader
+		ok  bool
+		err error
+	)
+	switch i {
+	case 0:
+		fr, ok, err = v.raw.Field(1, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.ID, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.New("field ID of Event is required")
+		}
+	case 1:
+		fr, ok, err = v.raw.Field(2, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			var x string
+			x, err = fr.ReadString()
+			v.v.Name = &x
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 2:
+		fr, ok, err = v.raw.Field(3, wire.TI64)
+		if err != nil {
+			return err
+		}
+		if ok {
+			var x int64
+			x, err = fr.ReadInt64()
+			v.v.Timestamp = &x
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			v.v.Timestamp = ptr.Int64(42)
+		}
+	case 3:
+		fr, ok, err = v.raw.Field(4, wire.TList)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Tags, err = _List_String_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 4:
+		fr, ok, err = v.raw.Field(5, wire.TMap)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Points, err = _Map_String_Point_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 5:
+		fr, ok, err = v.raw.Field(6, wire.TStruct)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Origin, err = _Point_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.New("field Origin of Event is required")
+		}
+	case 6:
+		fr, ok, err = v.raw.Field(7, wire.TI32)
+		if err != nil {
+			return err
+		}
+		if ok {
+			var x Level
+			x, err = _Level_Decode(fr)
+			v.v.Level = &x
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 7:
+		fr, ok, err = v.raw.Field(8, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Payload, err = fr.ReadBinary()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 8:
+		fr, ok, err = v.raw.Field(9, wire.TBool)
+		if err != nil {
+			return err
+		}
+		if ok {
+			var x bool
+			x, err = fr.ReadBool()
+			v.v.Active = &x
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			v.v.Active = ptr.Bool(true)
+		}
+	}
+
+	v.decoded[i] = true
+	return nil
+}
+
+type Failure struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a Failure struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Failure) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Failure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Failure struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Failure
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Failure) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Failure struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Failure struct could not be encoded.
+func (v *Failure) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Message)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Failure struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Failure struct could not be generated from the wire
+// representation.
+func (v *Failure) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Failure
+// struct.
+func (v *Failure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("Failure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*Failure) ErrorName() string {
+	return "Failure"
+}
+
+// Equals returns true if all the fields of this Failure match the
+// provided Failure.
+//
+// This function performs a deep comparison.
+func (v *Failure) Equals(rhs *Failure) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Failure.
+func (v *Failure) Copy() *Failure {
+	if v == nil {
+		return nil
+	}
+
+	var o Failure
+	o.Message = _String_CopyPtr(v.Message)
+	return &o
+}
+
+// Hash returns a hash of this Failure which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Failure) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Message != nil {
+		h.Field(1)
+		h.String(*v.Message)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Failure so that it may be reused.
+func (v *Failure) Reset() {
+	*v = Failure{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Failure.
+func (v *Failure) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *Failure) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+func (v *Failure) Error() string {
+	return v.String()
+}
+
+type Level int32
+
+const (
+	LevelLow  Level = 0
+	LevelHigh Level = 1
+)
+
+// Level_Values returns all recognized values of Level.
+func Level_Values() []Level {
+	return []Level{
+		LevelLow,
+		LevelHigh,
+	}
+}
+
+// Level_Names returns the names of all recognized values of
+// Level, in the same order as Level_Values.
+func Level_Names() []string {
+	return []string{
+		"LOW",
+		"HIGH",
+	}
+}
+
+// IsValid returns true if this Level is one of its recognized
+// values.
+func (v Level) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Level from a byte slice
+// containing its name.
+//
+//   var v Level
+//   err := v.UnmarshalText([]byte("LOW"))
+func (v *Level) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "LOW":
+		*v = LevelLow
+		return nil
+	case "HIGH":
+		*v = LevelHigh
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Level", err)
+		}
+		*v = Level(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Level to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Level) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("LOW"), nil
+	case 1:
+		return []byte("HIGH"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Level.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Level) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "LOW")
+	case 1:
+		enc.AddString("name", "HIGH")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Level) Ptr() *Level {
+	return &v
+}
+
+// Set sets Level from its name or integer value.
+//
+// This implements flag.Value, allowing Level to be used as a
+// command line flag.
+func (v *Level) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Level) Type() string {
+	return "Level"
+}
+
+// Encode encodes Level directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Level
+//   return v.Encode(sWriter)
+func (v Level) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Level into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Level) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Level from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Level(0), err
+//   }
+//
+//   var v Level
+//   if err := v.FromWire(x); err != nil {
+//     return Level(0), err
+//   }
+//   return v, nil
+func (v *Level) FromWire(w wire.Value) error {
+	*v = (Level)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Level directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Level
+//   if err := v.Decode(sReader); err != nil {
+//     return Level(0), err
+//   }
+//   return v, nil
+func (v *Level) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Level)(i)
+	return nil
+}
+
+// String returns a readable string representation of Level.
+func (v Level) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "LOW"
+	case 1:
+		return "HIGH"
+	}
+	return fmt.Sprintf("Level(%d)", w)
+}
+
+// Equals returns true if this Level value matches the provided
+// value.
+func (v Level) Equals(rhs Level) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Level into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Level) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"LOW\""), nil
+	case 1:
+		return ([]byte)("\"HIGH\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Level from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Level) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Level")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Level")
+		}
+		*v = (Level)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Level")
+	}
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// Point_Lazy provides access to the fields of a Thrift Binary Protocol
+// encoded Point, decoding each field only when it is first
+// accessed. Decoded fields are retained until the next call to Reset.
+//
+// Point_Lazy is not safe for concurrent use.
+type Point_Lazy struct {
+	raw     binary.LazyStruct
+	v       Point
+	decoded [2]bool
+}
+
+// Reset discards all decoded fields and starts reading from raw,
+// which holds a Thrift Binary Protocol encoded Point. raw must
+// not be modified while it is in use.
+func (v *Point_Lazy) Reset(raw []byte) {
+	v.raw.Reset(raw)
+	v.v = Point{}
+	v.decoded = [2]bool{}
+}
+
+// Bytes returns the encoded Point.
+func (v *Point_Lazy) Bytes() []byte {
+	return v.raw.Bytes()
+}
+
+// Struct decodes all fields into a new Point.
+func (v *Point_Lazy) Struct() (*Point, error) {
+	var x Point
+	sr := binary.NewStreamReader(bytes.NewReader(v.raw.Bytes()))
+	defer sr.Close()
+	err := x.Decode(sr)
+	return &x, err
+}
+
+// GetX returns the value of X, decoding it if it
+// has not been decoded yet.
+func (v *Point_Lazy) GetX() (o float64, err error) {
+	if err = v.decode(0); err == nil {
+		o = v.v.GetX()
+	}
+	return
+}
+
+// GetY returns the value of Y, decoding it if it
+// has not been decoded yet.
+func (v *Point_Lazy) GetY() (o float64, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.GetY()
+	}
+	return
+}
+
+// decode decodes the field at the given index if it has not been
+// decoded yet.
+func (v *Point_Lazy) decode(i int) error {
+	if v.decoded[i] {
+		return nil
+	}
+
+	var (
+		fr  stream.Reader
+		ok  bool
+		err error
+	)
+	switch i {
+	case 0:
+		fr, ok, err = v.raw.Field(1, wire.TDouble)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.X, err = fr.ReadDouble()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.New("field X of Point is required")
+		}
+	case 1:
+		fr, ok, err = v.raw.Field(2, wire.TDouble)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Y, err = fr.ReadDouble()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.New("field Y of Point is required")
+		}
+	}
+
+	v.decoded[i] = true
+	return nil
+}
+
+type Shape struct {
+	Point *Point `json:"point,omitempty"`
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Point = v.Point.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Shape) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPoint(x *Point) {
+	*v = Shape{}
+	v.Point = x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+	}
+	return visitor.Default()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "lazy",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/lazy",
+	FilePath: "lazy.thrift",
+	SHA1:     "97e0646a385cd5134523b4e25a982b424aaac465",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Point {\n    1: required double x\n    2: required double y\n}\n\nenum Level {\n    LOW,\n    HIGH,\n}\n\n/** Event is decoded lazily. */\nstruct Event {\n    1: required string id\n    2: optional string name\n    3: optional i64 timestamp = 42\n    4: optional list<string> tags\n    5: optional map<string, Point> points\n    6: required Point origin\n    7: optional Level level\n    8: optional binary payload\n    9: required bool active = true\n}\n\nstruct Empty {}\n\nstruct Eager {\n    1: optional string value\n} (go.lazy = \"false\")\n\nunion Shape {\n    1: Point point\n}\n\nexception Failure {\n    1: optional string message\n}\n"
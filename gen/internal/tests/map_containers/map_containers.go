@@ -0,0 +1,1355 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package map_containers
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	ordered "go.uber.org/thriftrw/gen/internal/tests/ordered"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+var ConstCounts *ordered.StringInt32Map = func() *ordered.StringInt32Map {
+	o := new(ordered.StringInt32Map)
+	o.Put("world", 2)
+	o.Put("hello", 1)
+	return o
+}()
+
+type Inventory struct {
+	Counts   *ordered.StringInt32Map   `json:"counts,required"`
+	Names    *ordered.Int32StringMap   `json:"names,omitempty"`
+	History  []*ordered.StringInt32Map `json:"history,omitempty"`
+	Defaults *ordered.StringInt32Map   `json:"defaults,omitempty"`
+	Plain    map[string]int32          `json:"plain,omitempty"`
+}
+
+// Default_Inventory constructs a new Inventory struct,
+// pre-populating any fields with defined default values.
+func Default_Inventory() *Inventory {
+	var v Inventory
+	v.Defaults = func() *ordered.StringInt32Map {
+		o := new(ordered.StringInt32Map)
+		o.Put("b", 2)
+		o.Put("a", 1)
+		return o
+	}()
+	return &v
+}
+
+type _Map_String_I32_ordered_StringInt32Map_MapItemList struct{ m *ordered.StringInt32Map }
+
+func (m _Map_String_I32_ordered_StringInt32Map_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	return m.m.ForEach(func(k string, v int32) error {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		return f(wire.MapItem{Key: kw, Value: vw})
+	})
+}
+
+func (m _Map_String_I32_ordered_StringInt32Map_MapItemList) Size() int {
+	return m.m.Len()
+}
+
+func (_Map_String_I32_ordered_StringInt32Map_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_ordered_StringInt32Map_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_ordered_StringInt32Map_MapItemList) Close() {}
+
+type _Map_I32_String_ordered_Int32StringMap_MapItemList struct{ m *ordered.Int32StringMap }
+
+func (m _Map_I32_String_ordered_Int32StringMap_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	return m.m.ForEach(func(k int32, v string) error {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		return f(wire.MapItem{Key: kw, Value: vw})
+	})
+}
+
+func (m _Map_I32_String_ordered_Int32StringMap_MapItemList) Size() int {
+	return m.m.Len()
+}
+
+func (_Map_I32_String_ordered_Int32StringMap_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_String_ordered_Int32StringMap_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_I32_String_ordered_Int32StringMap_MapItemList) Close() {}
+
+type _List_Map_String_I32_ordered_StringInt32Map_ValueList []*ordered.StringInt32Map
+
+func (v _List_Map_String_I32_ordered_StringInt32Map_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ordered.StringInt32Map', index [%v]: value is nil", i)
+		}
+		w, err := wire.NewValueMap(_Map_String_I32_ordered_StringInt32Map_MapItemList{x}), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Map_String_I32_ordered_StringInt32Map_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Map_String_I32_ordered_StringInt32Map_ValueList) ValueType() wire.Type {
+	return wire.TMap
+}
+
+func (_List_Map_String_I32_ordered_StringInt32Map_ValueList) Close() {}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+// ToWire translates a Inventory struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Inventory) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Counts == nil {
+		return w, errors.New("field Counts of Inventory is required")
+	}
+	w, err = wire.NewValueMap(_Map_String_I32_ordered_StringInt32Map_MapItemList{v.Counts}), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Names != nil {
+		w, err = wire.NewValueMap(_Map_I32_String_ordered_Int32StringMap_MapItemList{v.Names}), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = wire.NewValueList(_List_Map_String_I32_ordered_StringInt32Map_ValueList(v.History)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	vDefaults := v.Defaults
+	if vDefaults == nil {
+		vDefaults = func() *ordered.StringInt32Map {
+			o := new(ordered.StringInt32Map)
+			o.Put("b", 2)
+			o.Put("a", 1)
+			return o
+		}()
+	}
+	{
+		w, err = wire.NewValueMap(_Map_String_I32_ordered_StringInt32Map_MapItemList{vDefaults}), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Plain != nil {
+		w, err = wire.NewValueMap(_Map_String_I32_MapItemList(v.Plain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Read(m wire.MapItemList) (*ordered.StringInt32Map, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := new(ordered.StringInt32Map)
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o.Put(k, v)
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Read(m wire.MapItemList) (*ordered.Int32StringMap, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := new(ordered.Int32StringMap)
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o.Put(k, v)
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _List_Map_String_I32_ordered_StringInt32Map_Read(l wire.ValueList) ([]*ordered.StringInt32Map, error) {
+	if l.ValueType() != wire.TMap {
+		return nil, nil
+	}
+
+	o := make([]*ordered.StringInt32Map, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Map_String_I32_ordered_StringInt32Map_Read(x.GetMap())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Inventory struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Inventory struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Inventory
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Inventory) FromWire(w wire.Value) error {
+	var err error
+
+	countsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.Counts, err = _Map_String_I32_ordered_StringInt32Map_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				countsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.Names, err = _Map_I32_String_ordered_Int32StringMap_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.History, err = _List_Map_String_I32_ordered_StringInt32Map_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.Defaults, err = _Map_String_I32_ordered_StringInt32Map_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Plain, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !countsIsSet {
+		return errors.New("field Counts of Inventory is required")
+	}
+
+	if v.Defaults == nil {
+		v.Defaults = func() *ordered.StringInt32Map {
+			o := new(ordered.StringInt32Map)
+			o.Put("b", 2)
+			o.Put("a", 1)
+			return o
+		}()
+	}
+
+	return nil
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Encode(val *ordered.StringInt32Map, sw stream.Writer) error {
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    val.Len(),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	err := val.ForEach(func(k string, v int32) error {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		return sw.WriteInt32(v)
+	})
+	if err != nil {
+		return err
+	}
+	return sw.WriteMapEnd()
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Encode(val *ordered.Int32StringMap, sw stream.Writer) error {
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TBinary,
+		Length:    val.Len(),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	err := val.ForEach(func(k int32, v string) error {
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		return sw.WriteString(v)
+	})
+	if err != nil {
+		return err
+	}
+	return sw.WriteMapEnd()
+}
+
+func _List_Map_String_I32_ordered_StringInt32Map_Encode(val []*ordered.StringInt32Map, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TMap,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*ordered.StringInt32Map
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*ordered.StringInt32Map', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := _Map_String_I32_ordered_StringInt32Map_Encode(v, writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_I32_Encode(val map[string]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Inventory struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Inventory struct could not be encoded.
+func (v *Inventory) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Counts == nil {
+		return errors.New("field Counts of Inventory is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TMap}); err != nil {
+		return err
+	}
+	if err := _Map_String_I32_ordered_StringInt32Map_Encode(v.Counts, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Names != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_String_ordered_Int32StringMap_Encode(v.Names, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.History != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Map_String_I32_ordered_StringInt32Map_Encode(v.History, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vDefaults := v.Defaults
+	if vDefaults == nil {
+		vDefaults = func() *ordered.StringInt32Map {
+			o := new(ordered.StringInt32Map)
+			o.Put("b", 2)
+			o.Put("a", 1)
+			return o
+		}()
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_ordered_StringInt32Map_Encode(vDefaults, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Plain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I32_Encode(v.Plain, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Decode(sr stream.Reader) (*ordered.StringInt32Map, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := new(ordered.StringInt32Map)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o.Put(k, v)
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Decode(sr stream.Reader) (*ordered.Int32StringMap, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := new(ordered.Int32StringMap)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o.Put(k, v)
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Map_String_I32_ordered_StringInt32Map_Decode(sr stream.Reader) ([]*ordered.StringInt32Map, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TMap {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ordered.StringInt32Map, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Map_String_I32_ordered_StringInt32Map_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_Decode(sr stream.Reader) (map[string]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Inventory struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Inventory struct could not be generated from the wire
+// representation.
+func (v *Inventory) Decode(sr stream.Reader) error {
+
+	countsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Counts, err = _Map_String_I32_ordered_StringInt32Map_Decode(sr)
+			if err != nil {
+				return err
+			}
+			countsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.Names, err = _Map_I32_String_ordered_Int32StringMap_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.History, err = _List_Map_String_I32_ordered_StringInt32Map_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TMap:
+			v.Defaults, err = _Map_String_I32_ordered_StringInt32Map_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.Plain, err = _Map_String_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !countsIsSet {
+		return errors.New("field Counts of Inventory is required")
+	}
+
+	if v.Defaults == nil {
+		v.Defaults = func() *ordered.StringInt32Map {
+			o := new(ordered.StringInt32Map)
+			o.Put("b", 2)
+			o.Put("a", 1)
+			return o
+		}()
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Inventory
+// struct.
+func (v *Inventory) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("Counts: %v", v.Counts)
+	i++
+	if v.Names != nil {
+		fields[i] = fmt.Sprintf("Names: %v", v.Names)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.Defaults != nil {
+		fields[i] = fmt.Sprintf("Defaults: %v", v.Defaults)
+		i++
+	}
+	if v.Plain != nil {
+		fields[i] = fmt.Sprintf("Plain: %v", v.Plain)
+		i++
+	}
+
+	return fmt.Sprintf("Inventory{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Equals(lhs, rhs *ordered.StringInt32Map) bool {
+	if lhs == nil || rhs == nil {
+		return (lhs == nil || lhs.Len() == 0) && (rhs == nil || rhs.Len() == 0)
+	}
+	if lhs.Len() != rhs.Len() {
+		return false
+	}
+
+	equal := true
+	_ = lhs.ForEach(func(k string, lv int32) error {
+		rv, ok := rhs.Get(k)
+		if !ok || !(lv == rv) {
+			equal = false
+			return errors.New("maps are not equal")
+		}
+		return nil
+	})
+	return equal
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Equals(lhs, rhs *ordered.Int32StringMap) bool {
+	if lhs == nil || rhs == nil {
+		return (lhs == nil || lhs.Len() == 0) && (rhs == nil || rhs.Len() == 0)
+	}
+	if lhs.Len() != rhs.Len() {
+		return false
+	}
+
+	equal := true
+	_ = lhs.ForEach(func(k int32, lv string) error {
+		rv, ok := rhs.Get(k)
+		if !ok || !(lv == rv) {
+			equal = false
+			return errors.New("maps are not equal")
+		}
+		return nil
+	})
+	return equal
+}
+
+func _List_Map_String_I32_ordered_StringInt32Map_Equals(lhs, rhs []*ordered.StringInt32Map) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_Map_String_I32_ordered_StringInt32Map_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Inventory match the
+// provided Inventory.
+//
+// This function performs a deep comparison.
+func (v *Inventory) Equals(rhs *Inventory) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Map_String_I32_ordered_StringInt32Map_Equals(v.Counts, rhs.Counts) {
+		return false
+	}
+	if !((v.Names == nil && rhs.Names == nil) || (v.Names != nil && rhs.Names != nil && _Map_I32_String_ordered_Int32StringMap_Equals(v.Names, rhs.Names))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && _List_Map_String_I32_ordered_StringInt32Map_Equals(v.History, rhs.History))) {
+		return false
+	}
+	if !((v.Defaults == nil && rhs.Defaults == nil) || (v.Defaults != nil && rhs.Defaults != nil && _Map_String_I32_ordered_StringInt32Map_Equals(v.Defaults, rhs.Defaults))) {
+		return false
+	}
+	if !((v.Plain == nil && rhs.Plain == nil) || (v.Plain != nil && rhs.Plain != nil && _Map_String_I32_Equals(v.Plain, rhs.Plain))) {
+		return false
+	}
+
+	return true
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Copy(v *ordered.StringInt32Map) *ordered.StringInt32Map {
+	if v == nil {
+		return nil
+	}
+
+	o := new(ordered.StringInt32Map)
+	_ = v.ForEach(func(k string, x int32) error {
+		o.Put(k, x)
+		return nil
+	})
+	return o
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Copy(v *ordered.Int32StringMap) *ordered.Int32StringMap {
+	if v == nil {
+		return nil
+	}
+
+	o := new(ordered.Int32StringMap)
+	_ = v.ForEach(func(k int32, x string) error {
+		o.Put(k, x)
+		return nil
+	})
+	return o
+}
+
+func _List_Map_String_I32_ordered_StringInt32Map_Copy(v []*ordered.StringInt32Map) []*ordered.StringInt32Map {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*ordered.StringInt32Map, len(v))
+	for i, x := range v {
+		o[i] = _Map_String_I32_ordered_StringInt32Map_Copy(x)
+	}
+	return o
+}
+
+func _Map_String_I32_Copy(v map[string]int32) map[string]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Inventory.
+func (v *Inventory) Copy() *Inventory {
+	if v == nil {
+		return nil
+	}
+
+	var o Inventory
+	o.Counts = _Map_String_I32_ordered_StringInt32Map_Copy(v.Counts)
+	o.Names = _Map_I32_String_ordered_Int32StringMap_Copy(v.Names)
+	o.History = _List_Map_String_I32_ordered_StringInt32Map_Copy(v.History)
+	o.Defaults = _Map_String_I32_ordered_StringInt32Map_Copy(v.Defaults)
+	o.Plain = _Map_String_I32_Copy(v.Plain)
+	return &o
+}
+
+func _Map_String_I32_ordered_StringInt32Map_Hash(v *ordered.StringInt32Map) uint64 {
+
+	var u thrifthash.Unordered
+	if v != nil {
+		_ = v.ForEach(func(k string, x int32) error {
+			h := thrifthash.New()
+			h.String(k)
+			h.Int32(x)
+			u.Add(h.Sum64())
+			return nil
+		})
+	}
+	return u.Sum64()
+}
+
+func _Map_I32_String_ordered_Int32StringMap_Hash(v *ordered.Int32StringMap) uint64 {
+
+	var u thrifthash.Unordered
+	if v != nil {
+		_ = v.ForEach(func(k int32, x string) error {
+			h := thrifthash.New()
+			h.Int32(k)
+			h.String(x)
+			u.Add(h.Sum64())
+			return nil
+		})
+	}
+	return u.Sum64()
+}
+
+func _List_Map_String_I32_ordered_StringInt32Map_Hash(v []*ordered.StringInt32Map) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(_Map_String_I32_ordered_StringInt32Map_Hash(x))
+	}
+	return h.Sum64()
+}
+
+func _Map_String_I32_Hash(v map[string]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Inventory which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Inventory) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Map_String_I32_ordered_StringInt32Map_Hash(v.Counts))
+	h.Field(2)
+	h.Uint64(_Map_I32_String_ordered_Int32StringMap_Hash(v.Names))
+	h.Field(3)
+	h.Uint64(_List_Map_String_I32_ordered_StringInt32Map_Hash(v.History))
+	h.Field(4)
+	h.Uint64(_Map_String_I32_ordered_StringInt32Map_Hash(v.Defaults))
+	h.Field(5)
+	h.Uint64(_Map_String_I32_Hash(v.Plain))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Inventory so that it may be reused.
+func (v *Inventory) Reset() {
+	*v = Inventory{}
+}
+
+type _Map_String_I32_ordered_StringInt32Map_Item_Zapper struct {
+	Key   string
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_ordered_StringInt32Map_Item_Zapper.
+func (v _Map_String_I32_ordered_StringInt32Map_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key)
+	enc.AddInt32("value", v.Value)
+	return err
+}
+
+type _Map_String_I32_ordered_StringInt32Map_Zapper struct{ m *ordered.StringInt32Map }
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_String_I32_ordered_StringInt32Map_Zapper.
+func (m _Map_String_I32_ordered_StringInt32Map_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	if m.m == nil {
+		return nil
+	}
+	_ = m.m.ForEach(func(k string, v int32) error {
+		err = multierr.Append(err, enc.AppendObject(_Map_String_I32_ordered_StringInt32Map_Item_Zapper{Key: k, Value: v}))
+		return nil
+	})
+	return err
+}
+
+type _Map_I32_String_ordered_Int32StringMap_Item_Zapper struct {
+	Key   int32
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_ordered_Int32StringMap_Item_Zapper.
+func (v _Map_I32_String_ordered_Int32StringMap_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_I32_String_ordered_Int32StringMap_Zapper struct{ m *ordered.Int32StringMap }
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_ordered_Int32StringMap_Zapper.
+func (m _Map_I32_String_ordered_Int32StringMap_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	if m.m == nil {
+		return nil
+	}
+	_ = m.m.ForEach(func(k int32, v string) error {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_String_ordered_Int32StringMap_Item_Zapper{Key: k, Value: v}))
+		return nil
+	})
+	return err
+}
+
+type _List_Map_String_I32_ordered_StringInt32Map_Zapper []*ordered.StringInt32Map
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Map_String_I32_ordered_StringInt32Map_Zapper.
+func (l _List_Map_String_I32_ordered_StringInt32Map_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendArray(_Map_String_I32_ordered_StringInt32Map_Zapper{v}))
+	}
+	return err
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Inventory.
+func (v *Inventory) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddArray("counts", _Map_String_I32_ordered_StringInt32Map_Zapper{v.Counts}))
+	if v.Names != nil {
+		err = multierr.Append(err, enc.AddArray("names", _Map_I32_String_ordered_Int32StringMap_Zapper{v.Names}))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddArray("history", (_List_Map_String_I32_ordered_StringInt32Map_Zapper)(v.History)))
+	}
+	if v.Defaults != nil {
+		err = multierr.Append(err, enc.AddArray("defaults", _Map_String_I32_ordered_StringInt32Map_Zapper{v.Defaults}))
+	}
+	if v.Plain != nil {
+		err = multierr.Append(err, enc.AddObject("plain", (_Map_String_I32_Zapper)(v.Plain)))
+	}
+	return err
+}
+
+// GetCounts returns the value of Counts if it is set or its
+// zero value if it is unset.
+func (v *Inventory) GetCounts() (o *ordered.StringInt32Map) {
+	if v != nil {
+		o = v.Counts
+	}
+	return
+}
+
+// IsSetCounts returns true if Counts is not nil.
+func (v *Inventory) IsSetCounts() bool {
+	return v != nil && v.Counts != nil
+}
+
+// GetNames returns the value of Names if it is set or its
+// zero value if it is unset.
+func (v *Inventory) GetNames() (o *ordered.Int32StringMap) {
+	if v != nil && v.Names != nil {
+		return v.Names
+	}
+
+	return
+}
+
+// IsSetNames returns true if Names is not nil.
+func (v *Inventory) IsSetNames() bool {
+	return v != nil && v.Names != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *Inventory) GetHistory() (o []*ordered.StringInt32Map) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+func (v *Inventory) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetDefaults returns the value of Defaults if it is set or its
+// default value if it is unset.
+func (v *Inventory) GetDefaults() (o *ordered.StringInt32Map) {
+	if v != nil && v.Defaults != nil {
+		return v.Defaults
+	}
+	o = func() *ordered.StringInt32Map {
+		o := new(ordered.StringInt32Map)
+		o.Put("b", 2)
+		o.Put("a", 1)
+		return o
+	}()
+	return
+}
+
+// IsSetDefaults returns true if Defaults is not nil.
+func (v *Inventory) IsSetDefaults() bool {
+	return v != nil && v.Defaults != nil
+}
+
+// GetPlain returns the value of Plain if it is set or its
+// zero value if it is unset.
+func (v *Inventory) GetPlain() (o map[string]int32) {
+	if v != nil && v.Plain != nil {
+		return v.Plain
+	}
+
+	return
+}
+
+// IsSetPlain returns true if Plain is not nil.
+func (v *Inventory) IsSetPlain() bool {
+	return v != nil && v.Plain != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "map_containers",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/map_containers",
+	FilePath: "map_containers.thrift",
+	SHA1:     "2e053532309cca0fbc8740ccbc45b6f23129af5f",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Inventory {\n    1: required map<string, i32> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map\") counts\n    2: optional map<i32, string> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.Int32StringMap\") names\n    3: optional list<map<string, i32> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map\")> history\n    4: optional map<string, i32> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map\") defaults = {\"b\": 2, \"a\": 1}\n    5: optional map<string, i32> plain\n}\n\nconst map<string, i32> (go.type = \"go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map\") ConstCounts = {\"world\": 2, \"hello\": 1}\n"
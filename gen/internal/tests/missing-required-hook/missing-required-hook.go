@@ -0,0 +1,1909 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package missing_required_hook
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	requiredfield "go.uber.org/thriftrw/requiredfield"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type Event struct {
+	Name    string `json:"name,required"`
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// ToWire translates a Event struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Event) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Payload != nil {
+		w, err = wire.NewValueBinary(v.Payload), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Event struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Event struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Event
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Event) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Payload, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		if err := requiredfield.Missing("Event", "Name"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Event struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Event struct could not be encoded.
+func (v *Event) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Payload != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Payload); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Event struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Event struct could not be generated from the wire
+// representation.
+func (v *Event) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Payload, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		if err := requiredfield.Missing("Event", "Name"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Event
+// struct.
+func (v *Event) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Payload != nil {
+		fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
+		i++
+	}
+
+	return fmt.Sprintf("Event{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Event match the
+// provided Event.
+//
+// This function performs a deep comparison.
+func (v *Event) Equals(rhs *Event) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Payload == nil && rhs.Payload == nil) || (v.Payload != nil && rhs.Payload != nil && bytes.Equal(v.Payload, rhs.Payload))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Event.
+func (v *Event) Copy() *Event {
+	if v == nil {
+		return nil
+	}
+
+	var o Event
+	o.Name = v.Name
+	o.Payload = _Binary_Copy(v.Payload)
+	return &o
+}
+
+// Hash returns a hash of this Event which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Event) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Binary(v.Payload)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Event so that it may be reused.
+func (v *Event) Reset() {
+	*v = Event{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Event.
+func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Payload != nil {
+		enc.AddString("payload", base64.StdEncoding.EncodeToString(v.Payload))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Event) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetPayload returns the value of Payload if it is set or its
+// zero value if it is unset.
+func (v *Event) GetPayload() (o []byte) {
+	if v != nil && v.Payload != nil {
+		return v.Payload
+	}
+
+	return
+}
+
+// IsSetPayload returns true if Payload is not nil.
+func (v *Event) IsSetPayload() bool {
+	return v != nil && v.Payload != nil
+}
+
+// Event_Lazy provides access to the fields of a Thrift Binary Protocol
+// encoded Event, decoding each field only when it is first
+// accessed. Decoded fields are retained until the next call to Reset.
+//
+// Event_Lazy is not safe for concurrent use.
+type Event_Lazy struct {
+	raw     binary.LazyStruct
+	v       Event
+	decoded [2]bool
+}
+
+// Reset discards all decoded fields and starts reading from raw,
+// which holds a Thrift Binary Protocol encoded Event. raw must
+// not be modified while it is in use.
+func (v *Event_Lazy) Reset(raw []byte) {
+	v.raw.Reset(raw)
+	v.v = Event{}
+	v.decoded = [2]bool{}
+}
+
+// Bytes returns the encoded Event.
+func (v *Event_Lazy) Bytes() []byte {
+	return v.raw.Bytes()
+}
+
+// Struct decodes all fields into a new Event.
+func (v *Event_Lazy) Struct() (*Event, error) {
+	var x Event
+	sr := binary.NewStreamReader(bytes.NewReader(v.raw.Bytes()))
+	defer sr.Close()
+	err := x.Decode(sr)
+	return &x, err
+}
+
+// GetName returns the value of Name, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetName() (o string, err error) {
+	if err = v.decode(0); err == nil {
+		o = v.v.GetName()
+	}
+	return
+}
+
+// GetPayload returns the value of Payload, decoding it if it
+// has not been decoded yet.
+func (v *Event_Lazy) GetPayload() (o []byte, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.GetPayload()
+	}
+	return
+}
+
+// IsSetPayload returns true if Payload is set, decoding it
+// if it has not been decoded yet.
+func (v *Event_Lazy) IsSetPayload() (o bool, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.IsSetPayload()
+	}
+	return
+}
+
+// decode decodes the field at the given index if it has not been
+// decoded yet.
+func (v *Event_Lazy) decode(i int) error {
+	if v.decoded[i] {
+		return nil
+	}
+
+	var (
+		fr  stream.Reader
+		ok  bool
+		err error
+	)
+	switch i {
+	case 0:
+		fr, ok, err = v.raw.Field(1, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Name, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			if err := requiredfield.Missing("Event", "Name"); err != nil {
+				return err
+			}
+		}
+	case 1:
+		fr, ok, err = v.raw.Field(2, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Payload, err = fr.ReadBinary()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	v.decoded[i] = true
+	return nil
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		if err := requiredfield.Missing("Point", "X"); err != nil {
+			return err
+		}
+	}
+
+	if !yIsSet {
+		if err := requiredfield.Missing("Point", "Y"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		if err := requiredfield.Missing("Point", "X"); err != nil {
+			return err
+		}
+	}
+
+	if !yIsSet {
+		if err := requiredfield.Missing("Point", "Y"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type User struct {
+	ID   string  `json:"id,required"`
+	Name *string `json:"name,omitempty"`
+	Home *Point  `json:"home,required"`
+	Age  *int32  `json:"age,omitempty"`
+}
+
+// Default_User constructs a new User struct,
+// pre-populating any fields with defined default values.
+func Default_User() *User {
+	var v User
+	v.Age = ptr.Int32(18)
+	return &v
+}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Home == nil {
+		return w, &requiredfield.MissingError{Struct: "User", Field: "Home"}
+	}
+	w, err = v.Home.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	vAge := v.Age
+	if vAge == nil {
+		vAge = ptr.Int32(18)
+	}
+	{
+		w, err = wire.NewValueI32(*(vAge)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	homeIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Home, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				homeIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Age = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		if err := requiredfield.Missing("User", "ID"); err != nil {
+			return err
+		}
+	}
+
+	if !homeIsSet {
+		if err := requiredfield.Missing("User", "Home"); err != nil {
+			return err
+		}
+	}
+
+	if v.Age == nil {
+		v.Age = ptr.Int32(18)
+	}
+
+	return nil
+}
+
+// Encode serializes a User struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a User struct could not be encoded.
+func (v *User) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Home == nil {
+		return &requiredfield.MissingError{Struct: "User", Field: "Home"}
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Home.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	vAge := v.Age
+	if vAge == nil {
+		vAge = ptr.Int32(18)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(vAge)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a User struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a User struct could not be generated from the wire
+// representation.
+func (v *User) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	homeIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Home, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+			homeIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Age = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		if err := requiredfield.Missing("User", "ID"); err != nil {
+			return err
+		}
+	}
+
+	if !homeIsSet {
+		if err := requiredfield.Missing("User", "Home"); err != nil {
+			return err
+		}
+	}
+
+	if v.Age == nil {
+		v.Age = ptr.Int32(18)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Home: %v", v.Home)
+	i++
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !v.Home.Equals(rhs.Home) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Age, rhs.Age) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this User.
+func (v *User) Copy() *User {
+	if v == nil {
+		return nil
+	}
+
+	var o User
+	o.ID = v.ID
+	o.Name = _String_CopyPtr(v.Name)
+	o.Home = v.Home.Copy()
+	o.Age = _I32_CopyPtr(v.Age)
+	return &o
+}
+
+// Hash returns a hash of this User which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *User) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	h.Field(3)
+	h.Uint64(v.Home.Hash())
+	if v.Age != nil {
+		h.Field(4)
+		h.Int32(*v.Age)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this User so that it may be reused.
+func (v *User) Reset() {
+	*v = User{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	err = multierr.Append(err, enc.AddObject("home", v.Home))
+	if v.Age != nil {
+		enc.AddInt32("age", *v.Age)
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *User) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *User) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *User) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetHome returns the value of Home if it is set or its
+// zero value if it is unset.
+func (v *User) GetHome() (o *Point) {
+	if v != nil {
+		o = v.Home
+	}
+	return
+}
+
+// IsSetHome returns true if Home is not nil.
+func (v *User) IsSetHome() bool {
+	return v != nil && v.Home != nil
+}
+
+// GetAge returns the value of Age if it is set or its
+// default value if it is unset.
+func (v *User) GetAge() (o int32) {
+	if v != nil && v.Age != nil {
+		return *v.Age
+	}
+	o = 18
+	return
+}
+
+// IsSetAge returns true if Age is not nil.
+func (v *User) IsSetAge() bool {
+	return v != nil && v.Age != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "missing-required-hook",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/missing-required-hook",
+	FilePath: "missing-required-hook.thrift",
+	SHA1:     "6badf65441bd13467d640c60eb1f834a541d89c6",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct User {\n    1: required string id\n    2: optional string name\n    3: required Point home\n    4: required i32 age = 18\n}\n\nstruct Event {\n    1: required string name\n    2: optional binary payload\n} (go.lazy = \"true\")\n\nservice Users {\n    User get(1: required string id)\n}\n"
+
+// Users_Get_Args represents the arguments for the Users.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Users_Get_Args struct {
+	ID string `json:"id,required"`
+}
+
+// ToWire translates a Users_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Users_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		if err := requiredfield.Missing("Users_Get_Args", "ID"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Users_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Users_Get_Args struct could not be encoded.
+func (v *Users_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Users_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Users_Get_Args struct could not be generated from the wire
+// representation.
+func (v *Users_Get_Args) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		if err := requiredfield.Missing("Users_Get_Args", "ID"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_Get_Args
+// struct.
+func (v *Users_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+
+	return fmt.Sprintf("Users_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_Get_Args match the
+// provided Users_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Users_Get_Args) Equals(rhs *Users_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Users_Get_Args.
+func (v *Users_Get_Args) Copy() *Users_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Users_Get_Args
+	o.ID = v.ID
+	return &o
+}
+
+// Hash returns a hash of this Users_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Users_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Users_Get_Args so that it may be reused.
+func (v *Users_Get_Args) Reset() {
+	*v = Users_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_Get_Args.
+func (v *Users_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Users_Get_Args) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Users_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Users_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Users_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Users.get
+// function.
+var Users_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id string,
+	) *Users_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Users_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*User, error) (*Users_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Users_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Users_Get_Result) (*User, error)
+}{}
+
+func init() {
+	Users_Get_Helper.Args = func(
+		id string,
+	) *Users_Get_Args {
+		return &Users_Get_Args{
+			ID: id,
+		}
+	}
+
+	Users_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Users_Get_Helper.WrapResponse = func(success *User, err error) (*Users_Get_Result, error) {
+		if err == nil {
+			return &Users_Get_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Users_Get_Helper.UnwrapResponse = func(result *Users_Get_Result) (success *User, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Users_Get_Result represents the result of a Users.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Users_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success *User `json:"success,omitempty"`
+}
+
+// ToWire translates a Users_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _User_Read(w wire.Value) (*User, error) {
+	var v User
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Users_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _User_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Users_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Users_Get_Result struct could not be encoded.
+func (v *Users_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _User_Decode(sr stream.Reader) (*User, error) {
+	var v User
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Users_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Users_Get_Result struct could not be generated from the wire
+// representation.
+func (v *Users_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _User_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_Get_Result
+// struct.
+func (v *Users_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Users_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_Get_Result match the
+// provided Users_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Users_Get_Result) Equals(rhs *Users_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Users_Get_Result.
+func (v *Users_Get_Result) Copy() *Users_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Users_Get_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Users_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Users_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Users_Get_Result so that it may be reused.
+func (v *Users_Get_Result) Reset() {
+	*v = Users_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_Get_Result.
+func (v *Users_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Users_Get_Result) GetSuccess() (o *User) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Users_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Users_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Users_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
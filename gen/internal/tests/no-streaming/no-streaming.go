@@ -0,0 +1,2619 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package no_streaming
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+)
+
+type AliasedRecord Record
+
+// ToWire translates AliasedRecord into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *AliasedRecord) ToWire() (wire.Value, error) {
+	x := (*Record)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of AliasedRecord.
+func (v *AliasedRecord) String() string {
+	x := (*Record)(v)
+
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes AliasedRecord from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *AliasedRecord) FromWire(w wire.Value) error {
+	return (*Record)(v).FromWire(w)
+}
+
+// Equals returns true if this AliasedRecord is equal to the provided
+// AliasedRecord.
+func (lhs *AliasedRecord) Equals(rhs *AliasedRecord) bool {
+	return (*Record)(lhs).Equals((*Record)(rhs))
+}
+
+// Copy returns a deep copy of this AliasedRecord.
+func (v *AliasedRecord) Copy() *AliasedRecord {
+	x := (*Record)(v)
+	return (*AliasedRecord)(x.Copy())
+}
+
+// Hash returns a hash of this AliasedRecord which is stable across
+// processes.
+func (v *AliasedRecord) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Record)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *AliasedRecord) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Record)(v)).MarshalLogObject(enc)
+}
+
+type Name string
+
+// NamePtr returns a pointer to a Name
+func (v Name) Ptr() *Name {
+	return &v
+}
+
+// ToWire translates Name into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Name) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Name.
+func (v Name) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+// FromWire deserializes Name from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Name) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Name)(x)
+	return err
+}
+
+// Equals returns true if this Name is equal to the provided
+// Name.
+func (lhs Name) Equals(rhs Name) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Name which is stable across
+// processes.
+func (v Name) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+type _List_Name_ValueList []Name
+
+func (v _List_Name_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Name_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Name_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_Name_ValueList) Close() {}
+
+func _Name_Read(w wire.Value) (Name, error) {
+	var x Name
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_Name_Read(l wire.ValueList) ([]Name, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]Name, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Name_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_Name_Equals(lhs, rhs []Name) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Name_Copy(v []Name) []Name {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Name, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Name_Hash(v []Name) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(string(x))
+	}
+	return h.Sum64()
+}
+
+type _List_Name_Zapper []Name
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Name_Zapper.
+func (l _List_Name_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString((string)(v))
+	}
+	return err
+}
+
+type Names []Name
+
+// ToWire translates Names into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Names) ToWire() (wire.Value, error) {
+	x := ([]Name)(v)
+	return wire.NewValueList(_List_Name_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Names.
+func (v Names) String() string {
+	x := ([]Name)(v)
+
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Names from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Names) FromWire(w wire.Value) error {
+	x, err := _List_Name_Read(w.GetList())
+	*v = (Names)(x)
+	return err
+}
+
+// Equals returns true if this Names is equal to the provided
+// Names.
+func (lhs Names) Equals(rhs Names) bool {
+	return _List_Name_Equals(([]Name)(lhs), ([]Name)(rhs))
+}
+
+// Copy returns a deep copy of this Names.
+func (v Names) Copy() Names {
+	x := ([]Name)(v)
+	return (Names)(_List_Name_Copy(x))
+}
+
+// Hash returns a hash of this Names which is stable across
+// processes.
+func (v Names) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_Name_Hash(([]Name)(v)))
+	return h.Sum64()
+}
+
+func (v Names) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Name_Zapper)(([]Name)(v))).MarshalLogArray(enc)
+}
+
+type NotFound struct {
+	Key string `json:"key,required"`
+}
+
+// ToWire translates a NotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NotFound) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of NotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NotFound
+// struct.
+func (v *NotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+
+	return fmt.Sprintf("NotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*NotFound) ErrorName() string {
+	return "NotFound"
+}
+
+// Equals returns true if all the fields of this NotFound match the
+// provided NotFound.
+//
+// This function performs a deep comparison.
+func (v *NotFound) Equals(rhs *NotFound) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this NotFound.
+func (v *NotFound) Copy() *NotFound {
+	if v == nil {
+		return nil
+	}
+
+	var o NotFound
+	o.Key = v.Key
+	return &o
+}
+
+// Hash returns a hash of this NotFound which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NotFound) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NotFound so that it may be reused.
+func (v *NotFound) Reset() {
+	*v = NotFound{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NotFound.
+func (v *NotFound) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *NotFound) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+func (v *NotFound) Error() string {
+	return v.String()
+}
+
+type Record struct {
+	Name    Name                `json:"name,required"`
+	Status  *Status             `json:"status,omitempty"`
+	Scores  map[string][]int32  `json:"scores,omitempty"`
+	History map[Status]struct{} `json:"history,omitempty"`
+	Aliases Names               `json:"aliases,omitempty"`
+}
+
+func _Status_ptr(v Status) *Status {
+	return &v
+}
+
+// Default_Record constructs a new Record struct,
+// pre-populating any fields with defined default values.
+func Default_Record() *Record {
+	var v Record
+	v.Status = _Status_ptr(StatusActive)
+	return &v
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _Map_String_List_I32_MapItemList map[string][]int32
+
+func (m _Map_String_List_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]int32', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueList(_List_I32_ValueList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_List_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_List_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_List_I32_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_List_I32_MapItemList) Close() {}
+
+type _Set_Status_mapType_ValueList map[Status]struct{}
+
+func (v _Set_Status_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Status_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Status_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_Status_mapType_ValueList) Close() {}
+
+// ToWire translates a Record struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Record) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.Name.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	vStatus := v.Status
+	if vStatus == nil {
+		vStatus = _Status_ptr(StatusActive)
+	}
+	{
+		w, err = vStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Scores != nil {
+		w, err = wire.NewValueMap(_Map_String_List_I32_MapItemList(v.Scores)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = wire.NewValueSet(_Set_Status_mapType_ValueList(v.History)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Aliases != nil {
+		w, err = v.Aliases.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Status_Read(w wire.Value) (Status, error) {
+	var v Status
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_List_I32_Read(m wire.MapItemList) (map[string][]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string][]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _List_I32_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_Status_mapType_Read(s wire.ValueList) (map[Status]struct{}, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[Status]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Status_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Names_Read(w wire.Value) (Names, error) {
+	var x Names
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Record struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Record struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Record
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Record) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _Name_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x Status
+				x, err = _Status_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Scores, err = _Map_String_List_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TSet {
+				v.History, err = _Set_Status_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Aliases, err = _Names_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Record is required")
+	}
+
+	if v.Status == nil {
+		v.Status = _Status_ptr(StatusActive)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Record
+// struct.
+func (v *Record) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.Scores != nil {
+		fields[i] = fmt.Sprintf("Scores: %v", v.Scores)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.Aliases != nil {
+		fields[i] = fmt.Sprintf("Aliases: %v", v.Aliases)
+		i++
+	}
+
+	return fmt.Sprintf("Record{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Status_EqualsPtr(lhs, rhs *Status) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_List_I32_Equals(lhs, rhs map[string][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Status_mapType_Equals(lhs, rhs map[Status]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Record match the
+// provided Record.
+//
+// This function performs a deep comparison.
+func (v *Record) Equals(rhs *Record) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_Status_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !((v.Scores == nil && rhs.Scores == nil) || (v.Scores != nil && rhs.Scores != nil && _Map_String_List_I32_Equals(v.Scores, rhs.Scores))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && _Set_Status_mapType_Equals(v.History, rhs.History))) {
+		return false
+	}
+	if !((v.Aliases == nil && rhs.Aliases == nil) || (v.Aliases != nil && rhs.Aliases != nil && v.Aliases.Equals(rhs.Aliases))) {
+		return false
+	}
+
+	return true
+}
+
+func _Status_CopyPtr(v *Status) *Status {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_List_I32_Copy(v map[string][]int32) map[string][]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]int32, len(v))
+	for k, x := range v {
+		o[k] = _List_I32_Copy(x)
+	}
+	return o
+}
+
+func _Set_Status_mapType_Copy(v map[Status]struct{}) map[Status]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[Status]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Record.
+func (v *Record) Copy() *Record {
+	if v == nil {
+		return nil
+	}
+
+	var o Record
+	o.Name = v.Name
+	o.Status = _Status_CopyPtr(v.Status)
+	o.Scores = _Map_String_List_I32_Copy(v.Scores)
+	o.History = _Set_Status_mapType_Copy(v.History)
+	o.Aliases = v.Aliases.Copy()
+	return &o
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_List_I32_Hash(v map[string][]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(_List_I32_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Status_mapType_Hash(v map[Status]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Record which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Record) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Name))
+	if v.Status != nil {
+		h.Field(2)
+		h.Int32(int32(*v.Status))
+	}
+	h.Field(3)
+	h.Uint64(_Map_String_List_I32_Hash(v.Scores))
+	h.Field(4)
+	h.Uint64(_Set_Status_mapType_Hash(v.History))
+	h.Field(5)
+	h.Uint64(v.Aliases.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Record so that it may be reused.
+func (v *Record) Reset() {
+	*v = Record{}
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+type _Map_String_List_I32_Zapper map[string][]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_List_I32_Zapper.
+func (m _Map_String_List_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddArray((string)(k), (_List_I32_Zapper)(v)))
+	}
+	return err
+}
+
+type _Set_Status_mapType_Zapper map[Status]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Status_mapType_Zapper.
+func (s _Set_Status_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Record.
+func (v *Record) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", (string)(v.Name))
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	if v.Scores != nil {
+		err = multierr.Append(err, enc.AddObject("scores", (_Map_String_List_I32_Zapper)(v.Scores)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddArray("history", (_Set_Status_mapType_Zapper)(v.History)))
+	}
+	if v.Aliases != nil {
+		err = multierr.Append(err, enc.AddArray("aliases", (_List_Name_Zapper)(v.Aliases)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Record) GetName() (o Name) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetStatus returns the value of Status if it is set or its
+// default value if it is unset.
+func (v *Record) GetStatus() (o Status) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+	o = StatusActive
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *Record) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// GetScores returns the value of Scores if it is set or its
+// zero value if it is unset.
+func (v *Record) GetScores() (o map[string][]int32) {
+	if v != nil && v.Scores != nil {
+		return v.Scores
+	}
+
+	return
+}
+
+// IsSetScores returns true if Scores is not nil.
+func (v *Record) IsSetScores() bool {
+	return v != nil && v.Scores != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *Record) GetHistory() (o map[Status]struct{}) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+func (v *Record) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetAliases returns the value of Aliases if it is set or its
+// zero value if it is unset.
+func (v *Record) GetAliases() (o Names) {
+	if v != nil && v.Aliases != nil {
+		return v.Aliases
+	}
+
+	return
+}
+
+// IsSetAliases returns true if Aliases is not nil.
+func (v *Record) IsSetAliases() bool {
+	return v != nil && v.Aliases != nil
+}
+
+type Status int32
+
+const (
+	StatusActive   Status = 0
+	StatusInactive Status = 1
+)
+
+// Status_Values returns all recognized values of Status.
+func Status_Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusInactive,
+	}
+}
+
+// Status_Names returns the names of all recognized values of
+// Status, in the same order as Status_Values.
+func Status_Names() []string {
+	return []string{
+		"ACTIVE",
+		"INACTIVE",
+	}
+}
+
+// IsValid returns true if this Status is one of its recognized
+// values.
+func (v Status) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Status from a byte slice
+// containing its name.
+//
+//   var v Status
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *Status) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ACTIVE":
+		*v = StatusActive
+		return nil
+	case "INACTIVE":
+		*v = StatusInactive
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Status", err)
+		}
+		*v = Status(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Status to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Status) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("ACTIVE"), nil
+	case 1:
+		return []byte("INACTIVE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Status.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Status) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACTIVE")
+	case 1:
+		enc.AddString("name", "INACTIVE")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Status) Ptr() *Status {
+	return &v
+}
+
+// Set sets Status from its name or integer value.
+//
+// This implements flag.Value, allowing Status to be used as a
+// command line flag.
+func (v *Status) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Status) Type() string {
+	return "Status"
+}
+
+// ToWire translates Status into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Status) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Status from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Status(0), err
+//   }
+//
+//   var v Status
+//   if err := v.FromWire(x); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) FromWire(w wire.Value) error {
+	*v = (Status)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Status.
+func (v Status) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACTIVE"
+	case 1:
+		return "INACTIVE"
+	}
+	return fmt.Sprintf("Status(%d)", w)
+}
+
+// Equals returns true if this Status value matches the provided
+// value.
+func (v Status) Equals(rhs Status) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Status into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Status) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 1:
+		return ([]byte)("\"INACTIVE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Status from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Status) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Status")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Status")
+	}
+}
+
+type Value struct {
+	Text   *string `json:"text,omitempty"`
+	Record *Record `json:"record,omitempty"`
+}
+
+// ToWire translates a Value struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Value) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Text != nil {
+		w, err = wire.NewValueString(*(v.Text)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Record != nil {
+		w, err = v.Record.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Value should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Record_Read(w wire.Value) (*Record, error) {
+	var v Record
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Value struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Value struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Value
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Value) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Text = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Record, err = _Record_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Text != nil {
+		count++
+	}
+	if v.Record != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Value should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Value
+// struct.
+func (v *Value) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+	if v.Record != nil {
+		fields[i] = fmt.Sprintf("Record: %v", v.Record)
+		i++
+	}
+
+	return fmt.Sprintf("Value{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Value match the
+// provided Value.
+//
+// This function performs a deep comparison.
+func (v *Value) Equals(rhs *Value) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+	if !((v.Record == nil && rhs.Record == nil) || (v.Record != nil && rhs.Record != nil && v.Record.Equals(rhs.Record))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Value.
+func (v *Value) Copy() *Value {
+	if v == nil {
+		return nil
+	}
+
+	var o Value
+	o.Text = _String_CopyPtr(v.Text)
+	o.Record = v.Record.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Value which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Value) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Text != nil {
+		h.Field(1)
+		h.String(*v.Text)
+	}
+	h.Field(2)
+	h.Uint64(v.Record.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Value so that it may be reused.
+func (v *Value) Reset() {
+	*v = Value{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Value.
+func (v *Value) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	if v.Record != nil {
+		err = multierr.Append(err, enc.AddObject("record", v.Record))
+	}
+	return err
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+func (v *Value) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+func (v *Value) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// LookupText returns the value of Text and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Value) LookupText() (o string, _ bool) {
+	if v != nil && v.Text != nil {
+		return *v.Text, true
+	}
+	return o, false
+}
+
+// SetText sets the value of Text and unsets all other
+// fields of this Value.
+func (v *Value) SetText(x string) {
+	*v = Value{}
+	v.Text = &x
+}
+
+// GetRecord returns the value of Record if it is set or its
+// zero value if it is unset.
+func (v *Value) GetRecord() (o *Record) {
+	if v != nil && v.Record != nil {
+		return v.Record
+	}
+
+	return
+}
+
+// IsSetRecord returns true if Record is not nil.
+func (v *Value) IsSetRecord() bool {
+	return v != nil && v.Record != nil
+}
+
+// LookupRecord returns the value of Record and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Value) LookupRecord() (o *Record, _ bool) {
+	if v != nil && v.Record != nil {
+		return v.Record, true
+	}
+	return o, false
+}
+
+// SetRecord sets the value of Record and unsets all other
+// fields of this Value.
+func (v *Value) SetRecord(x *Record) {
+	*v = Value{}
+	v.Record = x
+}
+
+// Which returns the ID of the field of this Value which is set, or
+// 0 if no field is set.
+func (v *Value) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Text != nil {
+		return 1
+	}
+	if v.Record != nil {
+		return 2
+	}
+	return 0
+}
+
+// Value_Visitor visits the field of a Value which is set.
+//
+// Fields added to Value add methods to Value_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Value_Visitor interface {
+	// VisitText is called with the value of Text if it is set.
+	VisitText(string) error
+
+	// VisitRecord is called with the value of Record if it is set.
+	VisitRecord(*Record) error
+
+	// Default is called if no field of Value is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Value
+// which is set, or Default if none is, and returns its error.
+func (v *Value) Match(visitor Value_Visitor) error {
+	if v != nil {
+		if v.Text != nil {
+			return visitor.VisitText(*v.Text)
+		}
+		if v.Record != nil {
+			return visitor.VisitRecord(v.Record)
+		}
+	}
+	return visitor.Default()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "no-streaming",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/no-streaming",
+	FilePath: "no-streaming.thrift",
+	SHA1:     "54a23472fd13c45d2407906c6c602effc05012de",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Status {\n    ACTIVE,\n    INACTIVE,\n}\n\ntypedef string Name\ntypedef list<Name> Names\ntypedef Record AliasedRecord\n\nstruct Record {\n    1: required Name name\n    2: optional Status status = Status.ACTIVE\n    3: optional map<string, list<i32>> scores\n    4: optional set<Status> history\n    5: optional Names aliases\n}\n\nunion Value {\n    1: string text\n    2: Record record\n}\n\nexception NotFound {\n    1: required string key\n}\n\nservice Store {\n    Record get(1: Name name) throws (1: NotFound notFound)\n    void put(1: Record record)\n}\n"
+
+// Store_Get_Args represents the arguments for the Store.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Store_Get_Args struct {
+	Name *Name `json:"name,omitempty"`
+}
+
+// ToWire translates a Store_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = v.Name.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x Name
+				x, err = _Name_Read(field.Value)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Get_Args
+// struct.
+func (v *Store_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Store_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Name_EqualsPtr(lhs, rhs *Name) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Store_Get_Args match the
+// provided Store_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Get_Args) Equals(rhs *Store_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Name_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+func _Name_CopyPtr(v *Name) *Name {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Store_Get_Args.
+func (v *Store_Get_Args) Copy() *Store_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Get_Args
+	o.Name = _Name_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Store_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(string(*v.Name))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Get_Args so that it may be reused.
+func (v *Store_Get_Args) Reset() {
+	*v = Store_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Get_Args.
+func (v *Store_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", (string)(*v.Name))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Args) GetName() (o Name) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Store_Get_Args) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Store_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Store_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Store_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Store.get
+// function.
+var Store_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		name *Name,
+	) *Store_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Store_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Record, error) (*Store_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Store_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Store_Get_Result) (*Record, error)
+}{}
+
+func init() {
+	Store_Get_Helper.Args = func(
+		name *Name,
+	) *Store_Get_Args {
+		return &Store_Get_Args{
+			Name: name,
+		}
+	}
+
+	Store_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *NotFound:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Store_Get_Helper.WrapResponse = func(success *Record, err error) (*Store_Get_Result, error) {
+		if err == nil {
+			return &Store_Get_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *NotFound:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Store_Get_Result.NotFound")
+			}
+			return &Store_Get_Result{NotFound: e}, nil
+		}
+
+		return nil, err
+	}
+	Store_Get_Helper.UnwrapResponse = func(result *Store_Get_Result) (success *Record, err error) {
+		if result.NotFound != nil {
+			err = result.NotFound
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Store_Get_Result represents the result of a Store.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Store_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success  *Record   `json:"success,omitempty"`
+	NotFound *NotFound `json:"notFound,omitempty"`
+}
+
+// ToWire translates a Store_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.NotFound != nil {
+		w, err = v.NotFound.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _NotFound_Read(w wire.Value) (*NotFound, error) {
+	var v NotFound
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Store_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Record_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.NotFound, err = _NotFound_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Get_Result
+// struct.
+func (v *Store_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.NotFound != nil {
+		fields[i] = fmt.Sprintf("NotFound: %v", v.NotFound)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Get_Result match the
+// provided Store_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Store_Get_Result) Equals(rhs *Store_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.NotFound == nil && rhs.NotFound == nil) || (v.NotFound != nil && rhs.NotFound != nil && v.NotFound.Equals(rhs.NotFound))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Get_Result.
+func (v *Store_Get_Result) Copy() *Store_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Get_Result
+	o.Success = v.Success.Copy()
+	o.NotFound = v.NotFound.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Store_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.NotFound.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Get_Result so that it may be reused.
+func (v *Store_Get_Result) Reset() {
+	*v = Store_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Get_Result.
+func (v *Store_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.NotFound != nil {
+		err = multierr.Append(err, enc.AddObject("notFound", v.NotFound))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Result) GetSuccess() (o *Record) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Store_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetNotFound returns the value of NotFound if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Result) GetNotFound() (o *NotFound) {
+	if v != nil && v.NotFound != nil {
+		return v.NotFound
+	}
+
+	return
+}
+
+// IsSetNotFound returns true if NotFound is not nil.
+func (v *Store_Get_Result) IsSetNotFound() bool {
+	return v != nil && v.NotFound != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Store_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Store_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Store_Put_Args represents the arguments for the Store.put function.
+//
+// The arguments for put are sent and received over the wire as this struct.
+type Store_Put_Args struct {
+	Record *Record `json:"record,omitempty"`
+}
+
+// ToWire translates a Store_Put_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Put_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Record != nil {
+		w, err = v.Record.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Put_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Put_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Put_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Put_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Record, err = _Record_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Put_Args
+// struct.
+func (v *Store_Put_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Record != nil {
+		fields[i] = fmt.Sprintf("Record: %v", v.Record)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Put_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Put_Args match the
+// provided Store_Put_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Put_Args) Equals(rhs *Store_Put_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Record == nil && rhs.Record == nil) || (v.Record != nil && rhs.Record != nil && v.Record.Equals(rhs.Record))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Put_Args.
+func (v *Store_Put_Args) Copy() *Store_Put_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Put_Args
+	o.Record = v.Record.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Store_Put_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Put_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Record.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Put_Args so that it may be reused.
+func (v *Store_Put_Args) Reset() {
+	*v = Store_Put_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Put_Args.
+func (v *Store_Put_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Record != nil {
+		err = multierr.Append(err, enc.AddObject("record", v.Record))
+	}
+	return err
+}
+
+// GetRecord returns the value of Record if it is set or its
+// zero value if it is unset.
+func (v *Store_Put_Args) GetRecord() (o *Record) {
+	if v != nil && v.Record != nil {
+		return v.Record
+	}
+
+	return
+}
+
+// IsSetRecord returns true if Record is not nil.
+func (v *Store_Put_Args) IsSetRecord() bool {
+	return v != nil && v.Record != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "put" for this struct.
+func (v *Store_Put_Args) MethodName() string {
+	return "put"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Store_Put_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Store_Put_Helper provides functions that aid in handling the
+// parameters and return values of the Store.put
+// function.
+var Store_Put_Helper = struct {
+	// Args accepts the parameters of put in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		record *Record,
+	) *Store_Put_Args
+
+	// IsException returns true if the given error can be thrown
+	// by put.
+	//
+	// An error can be thrown by put only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for put
+	// given the error returned by it. The provided error may
+	// be nil if put did not fail.
+	//
+	// This allows mapping errors returned by put into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// put
+	//
+	//   err := put(args)
+	//   result, err := Store_Put_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from put: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*Store_Put_Result, error)
+
+	// UnwrapResponse takes the result struct for put
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if put threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := Store_Put_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Store_Put_Result) error
+}{}
+
+func init() {
+	Store_Put_Helper.Args = func(
+		record *Record,
+	) *Store_Put_Args {
+		return &Store_Put_Args{
+			Record: record,
+		}
+	}
+
+	Store_Put_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Store_Put_Helper.WrapResponse = func(err error) (*Store_Put_Result, error) {
+		if err == nil {
+			return &Store_Put_Result{}, nil
+		}
+
+		return nil, err
+	}
+	Store_Put_Helper.UnwrapResponse = func(result *Store_Put_Result) (err error) {
+		return
+	}
+
+}
+
+// Store_Put_Result represents the result of a Store.put function call.
+//
+// The result of a put execution is sent and received over the wire as this struct.
+type Store_Put_Result struct {
+}
+
+// ToWire translates a Store_Put_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Put_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Put_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Put_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Put_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Put_Result) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Put_Result
+// struct.
+func (v *Store_Put_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Store_Put_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Put_Result match the
+// provided Store_Put_Result.
+//
+// This function performs a deep comparison.
+func (v *Store_Put_Result) Equals(rhs *Store_Put_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Put_Result.
+func (v *Store_Put_Result) Copy() *Store_Put_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Put_Result
+	return &o
+}
+
+// Hash returns a hash of this Store_Put_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Put_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Put_Result so that it may be reused.
+func (v *Store_Put_Result) Reset() {
+	*v = Store_Put_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Put_Result.
+func (v *Store_Put_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "put" for this struct.
+func (v *Store_Put_Result) MethodName() string {
+	return "put"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Store_Put_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
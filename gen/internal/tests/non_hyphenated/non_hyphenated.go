@@ -6,6 +6,7 @@ package non_hyphenated
 import (
 	fmt "fmt"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -145,6 +146,32 @@ func (v *First) Equals(rhs *First) bool {
 	return true
 }
 
+// Copy returns a deep copy of this First.
+func (v *First) Copy() *First {
+	if v == nil {
+		return nil
+	}
+
+	var o First
+	return &o
+}
+
+// Hash returns a hash of this First which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *First) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this First so that it may be reused.
+func (v *First) Reset() {
+	*v = First{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of First.
 func (v *First) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -287,6 +314,32 @@ func (v *Second) Equals(rhs *Second) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Second.
+func (v *Second) Copy() *Second {
+	if v == nil {
+		return nil
+	}
+
+	var o Second
+	return &o
+}
+
+// Hash returns a hash of this Second which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Second) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Second so that it may be reused.
+func (v *Second) Reset() {
+	*v = Second{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Second.
 func (v *Second) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -10,6 +10,7 @@ import (
 	fmt "fmt"
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	math "math"
@@ -36,6 +37,26 @@ func EnumDefault_Values() []EnumDefault {
 	}
 }
 
+// EnumDefault_Names returns the names of all recognized values of
+// EnumDefault, in the same order as EnumDefault_Values.
+func EnumDefault_Names() []string {
+	return []string{
+		"Foo",
+		"Bar",
+		"Baz",
+	}
+}
+
+// IsValid returns true if this EnumDefault is one of its recognized
+// values.
+func (v EnumDefault) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode EnumDefault from a byte slice
 // containing its name.
 //
@@ -85,6 +106,22 @@ func (v EnumDefault) Ptr() *EnumDefault {
 	return &v
 }
 
+// Set sets EnumDefault from its name or integer value.
+//
+// This implements flag.Value, allowing EnumDefault to be used as a
+// command line flag.
+func (v *EnumDefault) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EnumDefault) Type() string {
+	return "EnumDefault"
+}
+
 // Encode encodes EnumDefault directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -1291,6 +1328,158 @@ func (v *PrimitiveRequiredStruct) Equals(rhs *PrimitiveRequiredStruct) bool {
 	return true
 }
 
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_I32_mapType_Copy(v map[int32]struct{}) map[int32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_I64_Double_Copy(v map[int64]float64) map[int64]float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]float64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) Copy() *PrimitiveRequiredStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o PrimitiveRequiredStruct
+	o.BoolField = v.BoolField
+	o.ByteField = v.ByteField
+	o.Int16Field = v.Int16Field
+	o.Int32Field = v.Int32Field
+	o.Int64Field = v.Int64Field
+	o.DoubleField = v.DoubleField
+	o.StringField = v.StringField
+	o.BinaryField = _Binary_Copy(v.BinaryField)
+	o.ListOfStrings = _List_String_Copy(v.ListOfStrings)
+	o.SetOfInts = _Set_I32_mapType_Copy(v.SetOfInts)
+	o.MapOfIntsToDoubles = _Map_I64_Double_Copy(v.MapOfIntsToDoubles)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Set_I32_mapType_Hash(v map[int32]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_I64_Double_Hash(v map[int64]float64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int64(k)
+		h.Double(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this PrimitiveRequiredStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PrimitiveRequiredStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.BoolField)
+	h.Field(2)
+	h.Int8(v.ByteField)
+	h.Field(3)
+	h.Int16(v.Int16Field)
+	h.Field(4)
+	h.Int32(v.Int32Field)
+	h.Field(5)
+	h.Int64(v.Int64Field)
+	h.Field(6)
+	h.Double(v.DoubleField)
+	h.Field(7)
+	h.String(v.StringField)
+	h.Field(8)
+	h.Binary(v.BinaryField)
+	h.Field(9)
+	h.Uint64(_List_String_Hash(v.ListOfStrings))
+	h.Field(10)
+	h.Uint64(_Set_I32_mapType_Hash(v.SetOfInts))
+	h.Field(11)
+	h.Uint64(_Map_I64_Double_Hash(v.MapOfIntsToDoubles))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PrimitiveRequiredStruct so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *PrimitiveRequiredStruct) Reset() {
+	for k := range v.SetOfInts {
+		delete(v.SetOfInts, k)
+	}
+	for k := range v.MapOfIntsToDoubles {
+		delete(v.MapOfIntsToDoubles, k)
+	}
+	*v = PrimitiveRequiredStruct{
+		BinaryField:        v.BinaryField[:0],
+		ListOfStrings:      v.ListOfStrings[:0],
+		SetOfInts:          v.SetOfInts,
+		MapOfIntsToDoubles: v.MapOfIntsToDoubles,
+	}
+}
+
 // GetBoolField returns the value of BoolField if it is set or its
 // zero value if it is unset.
 func (v *PrimitiveRequiredStruct) GetBoolField() (o bool) {
@@ -1450,6 +1639,20 @@ func (lhs *Primitives) Equals(rhs *Primitives) bool {
 	return (*PrimitiveRequiredStruct)(lhs).Equals((*PrimitiveRequiredStruct)(rhs))
 }
 
+// Copy returns a deep copy of this Primitives.
+func (v *Primitives) Copy() *Primitives {
+	x := (*PrimitiveRequiredStruct)(v)
+	return (*Primitives)(x.Copy())
+}
+
+// Hash returns a hash of this Primitives which is stable across
+// processes.
+func (v *Primitives) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*PrimitiveRequiredStruct)(v).Hash())
+	return h.Sum64()
+}
+
 type StringList []string
 
 // ToWire translates StringList into a Thrift-level intermediate
@@ -1494,6 +1697,20 @@ func (lhs StringList) Equals(rhs StringList) bool {
 	return _List_String_Equals(([]string)(lhs), ([]string)(rhs))
 }
 
+// Copy returns a deep copy of this StringList.
+func (v StringList) Copy() StringList {
+	x := ([]string)(v)
+	return (StringList)(_List_String_Copy(x))
+}
+
+// Hash returns a hash of this StringList which is stable across
+// processes.
+func (v StringList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_String_Hash(([]string)(v)))
+	return h.Sum64()
+}
+
 type _Map_String_String_MapItemList map[string]string
 
 func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
@@ -1637,6 +1854,30 @@ func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
 	return true
 }
 
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type StringMap map[string]string
 
 // ToWire translates StringMap into a Thrift-level intermediate
@@ -1681,6 +1922,20 @@ func (lhs StringMap) Equals(rhs StringMap) bool {
 	return _Map_String_String_Equals((map[string]string)(lhs), (map[string]string)(rhs))
 }
 
+// Copy returns a deep copy of this StringMap.
+func (v StringMap) Copy() StringMap {
+	x := (map[string]string)(v)
+	return (StringMap)(_Map_String_String_Copy(x))
+}
+
+// Hash returns a hash of this StringMap which is stable across
+// processes.
+func (v StringMap) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_String_String_Hash((map[string]string)(v)))
+	return h.Sum64()
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "nozap",
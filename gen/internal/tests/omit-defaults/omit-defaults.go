@@ -0,0 +1,1977 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package omit_defaults
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Color int32
+
+const (
+	ColorRed   Color = 0
+	ColorGreen Color = 1
+	ColorBlue  Color = 2
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+		ColorBlue,
+	}
+}
+
+// Color_Names returns the names of all recognized values of
+// Color, in the same order as Color_Values.
+func Color_Names() []string {
+	return []string{
+		"RED",
+		"GREEN",
+		"BLUE",
+	}
+}
+
+// IsValid returns true if this Color is one of its recognized
+// values.
+func (v Color) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "GREEN":
+		*v = ColorGreen
+		return nil
+	case "BLUE":
+		*v = ColorBlue
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Color", err)
+		}
+		*v = Color(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Color to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Color) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("RED"), nil
+	case 1:
+		return []byte("GREEN"), nil
+	case 2:
+		return []byte("BLUE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "GREEN")
+	case 2:
+		enc.AddString("name", "BLUE")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Color) Ptr() *Color {
+	return &v
+}
+
+// Set sets Color from its name or integer value.
+//
+// This implements flag.Value, allowing Color to be used as a
+// command line flag.
+func (v *Color) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Color) Type() string {
+	return "Color"
+}
+
+// Encode encodes Color directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Color
+//   return v.Encode(sWriter)
+func (v Color) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Color directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Color
+//   if err := v.Decode(sReader); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Color)(i)
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "GREEN"
+	case 2:
+		return "BLUE"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"GREEN\""), nil
+	case 2:
+		return ([]byte)("\"BLUE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+type Defaults struct {
+	Count         *int32   `json:"count,omitempty"`
+	Name          *string  `json:"name,omitempty"`
+	Color         *Color   `json:"color,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	Origin        *Point   `json:"origin,omitempty"`
+	NoDefault     *int64   `json:"noDefault,omitempty"`
+	AlwaysWritten *bool    `json:"alwaysWritten,omitempty"`
+}
+
+func _Color_ptr(v Color) *Color {
+	return &v
+}
+
+// Default_Defaults constructs a new Defaults struct,
+// pre-populating any fields with defined default values.
+func Default_Defaults() *Defaults {
+	var v Defaults
+	v.Count = ptr.Int32(10)
+	v.Name = ptr.String("foo")
+	v.Color = _Color_ptr(ColorGreen)
+	v.Tags = []string{
+		"a",
+		"b",
+	}
+	v.Origin = &Point{
+		X: 0,
+		Y: 0,
+	}
+	v.AlwaysWritten = ptr.Bool(true)
+	return &v
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Defaults struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Defaults) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Count != nil && !((*v.Count) == 10) {
+		w, err = wire.NewValueI32(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Name != nil && !((*v.Name) == "foo") {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Color != nil && !(*v.Color).Equals(ColorGreen) {
+		w, err = v.Color.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Tags != nil && !_List_String_Equals(v.Tags, []string{
+		"a",
+		"b",
+	}) {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Origin != nil && !v.Origin.Equals(&Point{
+		X: 0,
+		Y: 0,
+	}) {
+		w, err = v.Origin.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.NoDefault != nil {
+		w, err = wire.NewValueI64(*(v.NoDefault)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	vAlwaysWritten := v.AlwaysWritten
+	if vAlwaysWritten == nil {
+		vAlwaysWritten = ptr.Bool(true)
+	}
+	{
+		w, err = wire.NewValueBool(*(vAlwaysWritten)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Color_Read(w wire.Value) (Color, error) {
+	var v Color
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Defaults struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Defaults struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Defaults
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Defaults) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x Color
+				x, err = _Color_Read(field.Value)
+				v.Color = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.Origin, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NoDefault = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.AlwaysWritten = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Count == nil {
+		v.Count = ptr.Int32(10)
+	}
+
+	if v.Name == nil {
+		v.Name = ptr.String("foo")
+	}
+
+	if v.Color == nil {
+		v.Color = _Color_ptr(ColorGreen)
+	}
+
+	if v.Tags == nil {
+		v.Tags = []string{
+			"a",
+			"b",
+		}
+	}
+
+	if v.Origin == nil {
+		v.Origin = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+
+	if v.AlwaysWritten == nil {
+		v.AlwaysWritten = ptr.Bool(true)
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Defaults struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Defaults struct could not be encoded.
+func (v *Defaults) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Count != nil && !((*v.Count) == 10) {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Count)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil && !((*v.Name) == "foo") {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Color != nil && !(*v.Color).Equals(ColorGreen) {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Color.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil && !_List_String_Equals(v.Tags, []string{
+		"a",
+		"b",
+	}) {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Origin != nil && !v.Origin.Equals(&Point{
+		X: 0,
+		Y: 0,
+	}) {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Origin.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NoDefault != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.NoDefault)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vAlwaysWritten := v.AlwaysWritten
+	if vAlwaysWritten == nil {
+		vAlwaysWritten = ptr.Bool(true)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(vAlwaysWritten)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Color_Decode(sr stream.Reader) (Color, error) {
+	var v Color
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Defaults struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Defaults struct could not be generated from the wire
+// representation.
+func (v *Defaults) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x Color
+			x, err = _Color_Decode(sr)
+			v.Color = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.Origin, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.NoDefault = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.AlwaysWritten = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Count == nil {
+		v.Count = ptr.Int32(10)
+	}
+
+	if v.Name == nil {
+		v.Name = ptr.String("foo")
+	}
+
+	if v.Color == nil {
+		v.Color = _Color_ptr(ColorGreen)
+	}
+
+	if v.Tags == nil {
+		v.Tags = []string{
+			"a",
+			"b",
+		}
+	}
+
+	if v.Origin == nil {
+		v.Origin = &Point{
+			X: 0,
+			Y: 0,
+		}
+	}
+
+	if v.AlwaysWritten == nil {
+		v.AlwaysWritten = ptr.Bool(true)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Defaults
+// struct.
+func (v *Defaults) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Color != nil {
+		fields[i] = fmt.Sprintf("Color: %v", *(v.Color))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Origin != nil {
+		fields[i] = fmt.Sprintf("Origin: %v", v.Origin)
+		i++
+	}
+	if v.NoDefault != nil {
+		fields[i] = fmt.Sprintf("NoDefault: %v", *(v.NoDefault))
+		i++
+	}
+	if v.AlwaysWritten != nil {
+		fields[i] = fmt.Sprintf("AlwaysWritten: %v", *(v.AlwaysWritten))
+		i++
+	}
+
+	return fmt.Sprintf("Defaults{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Color_EqualsPtr(lhs, rhs *Color) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Defaults match the
+// provided Defaults.
+//
+// This function performs a deep comparison.
+func (v *Defaults) Equals(rhs *Defaults) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_Color_EqualsPtr(v.Color, rhs.Color) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Origin == nil && rhs.Origin == nil) || (v.Origin != nil && rhs.Origin != nil && v.Origin.Equals(rhs.Origin))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NoDefault, rhs.NoDefault) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.AlwaysWritten, rhs.AlwaysWritten) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Color_CopyPtr(v *Color) *Color {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Defaults.
+func (v *Defaults) Copy() *Defaults {
+	if v == nil {
+		return nil
+	}
+
+	var o Defaults
+	o.Count = _I32_CopyPtr(v.Count)
+	o.Name = _String_CopyPtr(v.Name)
+	o.Color = _Color_CopyPtr(v.Color)
+	o.Tags = _List_String_Copy(v.Tags)
+	o.Origin = v.Origin.Copy()
+	o.NoDefault = _I64_CopyPtr(v.NoDefault)
+	o.AlwaysWritten = _Bool_CopyPtr(v.AlwaysWritten)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Defaults which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Defaults) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Count != nil {
+		h.Field(1)
+		h.Int32(*v.Count)
+	}
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	if v.Color != nil {
+		h.Field(3)
+		h.Int32(int32(*v.Color))
+	}
+	h.Field(4)
+	h.Uint64(_List_String_Hash(v.Tags))
+	h.Field(5)
+	h.Uint64(v.Origin.Hash())
+	if v.NoDefault != nil {
+		h.Field(6)
+		h.Int64(*v.NoDefault)
+	}
+	if v.AlwaysWritten != nil {
+		h.Field(7)
+		h.Bool(*v.AlwaysWritten)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Defaults so that it may be reused.
+func (v *Defaults) Reset() {
+	*v = Defaults{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Defaults.
+func (v *Defaults) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Color != nil {
+		err = multierr.Append(err, enc.AddObject("color", *v.Color))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	if v.Origin != nil {
+		err = multierr.Append(err, enc.AddObject("origin", v.Origin))
+	}
+	if v.NoDefault != nil {
+		enc.AddInt64("noDefault", *v.NoDefault)
+	}
+	if v.AlwaysWritten != nil {
+		enc.AddBool("alwaysWritten", *v.AlwaysWritten)
+	}
+	return err
+}
+
+// GetCount returns the value of Count if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+	o = 10
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *Defaults) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetName returns the value of Name if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+	o = "foo"
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Defaults) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetColor returns the value of Color if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetColor() (o Color) {
+	if v != nil && v.Color != nil {
+		return *v.Color
+	}
+	o = ColorGreen
+	return
+}
+
+// IsSetColor returns true if Color is not nil.
+func (v *Defaults) IsSetColor() bool {
+	return v != nil && v.Color != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	o = []string{
+		"a",
+		"b",
+	}
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Defaults) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetOrigin returns the value of Origin if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetOrigin() (o *Point) {
+	if v != nil && v.Origin != nil {
+		return v.Origin
+	}
+	o = &Point{
+		X: 0,
+		Y: 0,
+	}
+	return
+}
+
+// IsSetOrigin returns true if Origin is not nil.
+func (v *Defaults) IsSetOrigin() bool {
+	return v != nil && v.Origin != nil
+}
+
+// GetNoDefault returns the value of NoDefault if it is set or its
+// zero value if it is unset.
+func (v *Defaults) GetNoDefault() (o int64) {
+	if v != nil && v.NoDefault != nil {
+		return *v.NoDefault
+	}
+
+	return
+}
+
+// IsSetNoDefault returns true if NoDefault is not nil.
+func (v *Defaults) IsSetNoDefault() bool {
+	return v != nil && v.NoDefault != nil
+}
+
+// GetAlwaysWritten returns the value of AlwaysWritten if it is set or its
+// default value if it is unset.
+func (v *Defaults) GetAlwaysWritten() (o bool) {
+	if v != nil && v.AlwaysWritten != nil {
+		return *v.AlwaysWritten
+	}
+	o = true
+	return
+}
+
+// IsSetAlwaysWritten returns true if AlwaysWritten is not nil.
+func (v *Defaults) IsSetAlwaysWritten() bool {
+	return v != nil && v.AlwaysWritten != nil
+}
+
+type NeverOmitted struct {
+	Count *int32  `json:"count,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// Default_NeverOmitted constructs a new NeverOmitted struct,
+// pre-populating any fields with defined default values.
+func Default_NeverOmitted() *NeverOmitted {
+	var v NeverOmitted
+	v.Count = ptr.Int32(10)
+	v.Name = ptr.String("foo")
+	return &v
+}
+
+// ToWire translates a NeverOmitted struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NeverOmitted) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	vCount := v.Count
+	if vCount == nil {
+		vCount = ptr.Int32(10)
+	}
+	{
+		w, err = wire.NewValueI32(*(vCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Name != nil && !((*v.Name) == "foo") {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NeverOmitted struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NeverOmitted struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NeverOmitted
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NeverOmitted) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Count == nil {
+		v.Count = ptr.Int32(10)
+	}
+
+	if v.Name == nil {
+		v.Name = ptr.String("foo")
+	}
+
+	return nil
+}
+
+// Encode serializes a NeverOmitted struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a NeverOmitted struct could not be encoded.
+func (v *NeverOmitted) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	vCount := v.Count
+	if vCount == nil {
+		vCount = ptr.Int32(10)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(vCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil && !((*v.Name) == "foo") {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a NeverOmitted struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a NeverOmitted struct could not be generated from the wire
+// representation.
+func (v *NeverOmitted) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Count == nil {
+		v.Count = ptr.Int32(10)
+	}
+
+	if v.Name == nil {
+		v.Name = ptr.String("foo")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NeverOmitted
+// struct.
+func (v *NeverOmitted) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("NeverOmitted{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this NeverOmitted match the
+// provided NeverOmitted.
+//
+// This function performs a deep comparison.
+func (v *NeverOmitted) Equals(rhs *NeverOmitted) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this NeverOmitted.
+func (v *NeverOmitted) Copy() *NeverOmitted {
+	if v == nil {
+		return nil
+	}
+
+	var o NeverOmitted
+	o.Count = _I32_CopyPtr(v.Count)
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this NeverOmitted which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NeverOmitted) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Count != nil {
+		h.Field(1)
+		h.Int32(*v.Count)
+	}
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NeverOmitted so that it may be reused.
+func (v *NeverOmitted) Reset() {
+	*v = NeverOmitted{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NeverOmitted.
+func (v *NeverOmitted) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetCount returns the value of Count if it is set or its
+// default value if it is unset.
+func (v *NeverOmitted) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+	o = 10
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *NeverOmitted) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetName returns the value of Name if it is set or its
+// default value if it is unset.
+func (v *NeverOmitted) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+	o = "foo"
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *NeverOmitted) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+type Point struct {
+	X int32 `json:"x,required"`
+	Y int32 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI32(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.X, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Y, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.X, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Y, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(v.X)
+	h.Field(2)
+	h.Int32(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt32("x", v.X)
+	enc.AddInt32("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o int32) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o int32) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "omit-defaults",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/omit-defaults",
+	FilePath: "omit-defaults.thrift",
+	SHA1:     "b3a7a8d4fb025de08797de8883910f68730bd5f1",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "// Generated with --omit-defaults.\n\nenum Color {\n    RED, GREEN, BLUE\n}\n\nstruct Point {\n    1: required i32 x\n    2: required i32 y\n}\n\nstruct Defaults {\n    1: optional i32 count = 10\n    2: optional string name = \"foo\"\n    3: optional Color color = Color.GREEN\n    4: optional list<string> tags = [\"a\", \"b\"]\n    5: optional Point origin = {\"x\": 0, \"y\": 0}\n    6: optional i64 noDefault\n    7: optional bool alwaysWritten = true (go.omit_default = \"false\")\n}\n\nstruct NeverOmitted {\n    1: optional i32 count = 10\n    2: optional string name = \"foo\" (go.omit_default = \"true\")\n} (go.omit_default = \"false\")\n"
@@ -0,0 +1,1861 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package only_servers
+
+import (
+	context "context"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftrpc "go.uber.org/thriftrw/thriftrpc"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type Item struct {
+	Key   string  `json:"key,required"`
+	Value *string `json:"value,omitempty"`
+}
+
+// ToWire translates a Item struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Item) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Item struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Item struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Item
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Item) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Item is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Item struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Item struct could not be encoded.
+func (v *Item) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Value)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Item struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Item struct could not be generated from the wire
+// representation.
+func (v *Item) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Value = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Item is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Item
+// struct.
+func (v *Item) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
+	}
+
+	return fmt.Sprintf("Item{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Item match the
+// provided Item.
+//
+// This function performs a deep comparison.
+func (v *Item) Equals(rhs *Item) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Item.
+func (v *Item) Copy() *Item {
+	if v == nil {
+		return nil
+	}
+
+	var o Item
+	o.Key = v.Key
+	o.Value = _String_CopyPtr(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this Item which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Item) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	if v.Value != nil {
+		h.Field(2)
+		h.String(*v.Value)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Item so that it may be reused.
+func (v *Item) Reset() {
+	*v = Item{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Item.
+func (v *Item) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Item) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Item) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Item) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+type ItemNotFound struct {
+	Key string `json:"key,required"`
+}
+
+// ToWire translates a ItemNotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ItemNotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ItemNotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ItemNotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ItemNotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ItemNotFound) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of ItemNotFound is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a ItemNotFound struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ItemNotFound struct could not be encoded.
+func (v *ItemNotFound) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ItemNotFound struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ItemNotFound struct could not be generated from the wire
+// representation.
+func (v *ItemNotFound) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of ItemNotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ItemNotFound
+// struct.
+func (v *ItemNotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+
+	return fmt.Sprintf("ItemNotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*ItemNotFound) ErrorName() string {
+	return "ItemNotFound"
+}
+
+// Equals returns true if all the fields of this ItemNotFound match the
+// provided ItemNotFound.
+//
+// This function performs a deep comparison.
+func (v *ItemNotFound) Equals(rhs *ItemNotFound) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this ItemNotFound.
+func (v *ItemNotFound) Copy() *ItemNotFound {
+	if v == nil {
+		return nil
+	}
+
+	var o ItemNotFound
+	o.Key = v.Key
+	return &o
+}
+
+// Hash returns a hash of this ItemNotFound which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ItemNotFound) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ItemNotFound so that it may be reused.
+func (v *ItemNotFound) Reset() {
+	*v = ItemNotFound{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ItemNotFound.
+func (v *ItemNotFound) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *ItemNotFound) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+func (v *ItemNotFound) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "only-servers",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/only-servers",
+	FilePath: "only-servers.thrift",
+	SHA1:     "084b00cb0c6de4bb82d9eed9168c0cdfe8f87c41",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Item {\n    1: required string key\n    2: optional string value\n}\n\nexception ItemNotFound {\n    1: required string key\n}\n\nservice Items {\n    Item getItem(1: required string key) throws (1: ItemNotFound notFound)\n    void putItem(1: required Item item)\n}\n"
+
+// Items_GetItem_Args represents the arguments for the Items.getItem function.
+//
+// The arguments for getItem are sent and received over the wire as this struct.
+type Items_GetItem_Args struct {
+	Key string `json:"key,required"`
+}
+
+// ToWire translates a Items_GetItem_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Items_GetItem_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Items_GetItem_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Items_GetItem_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Items_GetItem_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Items_GetItem_Args) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Items_GetItem_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Items_GetItem_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Items_GetItem_Args struct could not be encoded.
+func (v *Items_GetItem_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Items_GetItem_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Items_GetItem_Args struct could not be generated from the wire
+// representation.
+func (v *Items_GetItem_Args) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Items_GetItem_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Items_GetItem_Args
+// struct.
+func (v *Items_GetItem_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+
+	return fmt.Sprintf("Items_GetItem_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Items_GetItem_Args match the
+// provided Items_GetItem_Args.
+//
+// This function performs a deep comparison.
+func (v *Items_GetItem_Args) Equals(rhs *Items_GetItem_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Items_GetItem_Args.
+func (v *Items_GetItem_Args) Copy() *Items_GetItem_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Items_GetItem_Args
+	o.Key = v.Key
+	return &o
+}
+
+// Hash returns a hash of this Items_GetItem_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Items_GetItem_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Items_GetItem_Args so that it may be reused.
+func (v *Items_GetItem_Args) Reset() {
+	*v = Items_GetItem_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Items_GetItem_Args.
+func (v *Items_GetItem_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Items_GetItem_Args) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getItem" for this struct.
+func (v *Items_GetItem_Args) MethodName() string {
+	return "getItem"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Items_GetItem_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Items_GetItem_Helper provides functions that aid in handling the
+// parameters and return values of the Items.getItem
+// function.
+var Items_GetItem_Helper = struct {
+	// Args accepts the parameters of getItem in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key string,
+	) *Items_GetItem_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getItem.
+	//
+	// An error can be thrown by getItem only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getItem
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getItem into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getItem
+	//
+	//   value, err := getItem(args)
+	//   result, err := Items_GetItem_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getItem: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Item, error) (*Items_GetItem_Result, error)
+}{}
+
+func init() {
+	Items_GetItem_Helper.Args = func(
+		key string,
+	) *Items_GetItem_Args {
+		return &Items_GetItem_Args{
+			Key: key,
+		}
+	}
+
+	Items_GetItem_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *ItemNotFound:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Items_GetItem_Helper.WrapResponse = func(success *Item, err error) (*Items_GetItem_Result, error) {
+		if err == nil {
+			return &Items_GetItem_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *ItemNotFound:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Items_GetItem_Result.NotFound")
+			}
+			return &Items_GetItem_Result{NotFound: e}, nil
+		}
+
+		return nil, err
+	}
+
+}
+
+// Items_GetItem_Result represents the result of a Items.getItem function call.
+//
+// The result of a getItem execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Items_GetItem_Result struct {
+	// Value returned by getItem after a successful execution.
+	Success  *Item         `json:"success,omitempty"`
+	NotFound *ItemNotFound `json:"notFound,omitempty"`
+}
+
+// ToWire translates a Items_GetItem_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Items_GetItem_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.NotFound != nil {
+		w, err = v.NotFound.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Items_GetItem_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Item_Read(w wire.Value) (*Item, error) {
+	var v Item
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ItemNotFound_Read(w wire.Value) (*ItemNotFound, error) {
+	var v ItemNotFound
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Items_GetItem_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Items_GetItem_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Items_GetItem_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Items_GetItem_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Item_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.NotFound, err = _ItemNotFound_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Items_GetItem_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Items_GetItem_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Items_GetItem_Result struct could not be encoded.
+func (v *Items_GetItem_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NotFound != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NotFound.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Items_GetItem_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Item_Decode(sr stream.Reader) (*Item, error) {
+	var v Item
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _ItemNotFound_Decode(sr stream.Reader) (*ItemNotFound, error) {
+	var v ItemNotFound
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Items_GetItem_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Items_GetItem_Result struct could not be generated from the wire
+// representation.
+func (v *Items_GetItem_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Item_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.NotFound, err = _ItemNotFound_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Items_GetItem_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Items_GetItem_Result
+// struct.
+func (v *Items_GetItem_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.NotFound != nil {
+		fields[i] = fmt.Sprintf("NotFound: %v", v.NotFound)
+		i++
+	}
+
+	return fmt.Sprintf("Items_GetItem_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Items_GetItem_Result match the
+// provided Items_GetItem_Result.
+//
+// This function performs a deep comparison.
+func (v *Items_GetItem_Result) Equals(rhs *Items_GetItem_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.NotFound == nil && rhs.NotFound == nil) || (v.NotFound != nil && rhs.NotFound != nil && v.NotFound.Equals(rhs.NotFound))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Items_GetItem_Result.
+func (v *Items_GetItem_Result) Copy() *Items_GetItem_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Items_GetItem_Result
+	o.Success = v.Success.Copy()
+	o.NotFound = v.NotFound.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Items_GetItem_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Items_GetItem_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.NotFound.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Items_GetItem_Result so that it may be reused.
+func (v *Items_GetItem_Result) Reset() {
+	*v = Items_GetItem_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Items_GetItem_Result.
+func (v *Items_GetItem_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.NotFound != nil {
+		err = multierr.Append(err, enc.AddObject("notFound", v.NotFound))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Items_GetItem_Result) GetSuccess() (o *Item) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Items_GetItem_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetNotFound returns the value of NotFound if it is set or its
+// zero value if it is unset.
+func (v *Items_GetItem_Result) GetNotFound() (o *ItemNotFound) {
+	if v != nil && v.NotFound != nil {
+		return v.NotFound
+	}
+
+	return
+}
+
+// IsSetNotFound returns true if NotFound is not nil.
+func (v *Items_GetItem_Result) IsSetNotFound() bool {
+	return v != nil && v.NotFound != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getItem" for this struct.
+func (v *Items_GetItem_Result) MethodName() string {
+	return "getItem"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Items_GetItem_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Items_PutItem_Args represents the arguments for the Items.putItem function.
+//
+// The arguments for putItem are sent and received over the wire as this struct.
+type Items_PutItem_Args struct {
+	Item *Item `json:"item,required"`
+}
+
+// ToWire translates a Items_PutItem_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Items_PutItem_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Item == nil {
+		return w, errors.New("field Item of Items_PutItem_Args is required")
+	}
+	w, err = v.Item.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Items_PutItem_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Items_PutItem_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Items_PutItem_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Items_PutItem_Args) FromWire(w wire.Value) error {
+	var err error
+
+	itemIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Item, err = _Item_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				itemIsSet = true
+			}
+		}
+	}
+
+	if !itemIsSet {
+		return errors.New("field Item of Items_PutItem_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Items_PutItem_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Items_PutItem_Args struct could not be encoded.
+func (v *Items_PutItem_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Item == nil {
+		return errors.New("field Item of Items_PutItem_Args is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Item.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Items_PutItem_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Items_PutItem_Args struct could not be generated from the wire
+// representation.
+func (v *Items_PutItem_Args) Decode(sr stream.Reader) error {
+
+	itemIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Item, err = _Item_Decode(sr)
+			if err != nil {
+				return err
+			}
+			itemIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !itemIsSet {
+		return errors.New("field Item of Items_PutItem_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Items_PutItem_Args
+// struct.
+func (v *Items_PutItem_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Item: %v", v.Item)
+	i++
+
+	return fmt.Sprintf("Items_PutItem_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Items_PutItem_Args match the
+// provided Items_PutItem_Args.
+//
+// This function performs a deep comparison.
+func (v *Items_PutItem_Args) Equals(rhs *Items_PutItem_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Item.Equals(rhs.Item) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Items_PutItem_Args.
+func (v *Items_PutItem_Args) Copy() *Items_PutItem_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Items_PutItem_Args
+	o.Item = v.Item.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Items_PutItem_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Items_PutItem_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Item.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Items_PutItem_Args so that it may be reused.
+func (v *Items_PutItem_Args) Reset() {
+	*v = Items_PutItem_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Items_PutItem_Args.
+func (v *Items_PutItem_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("item", v.Item))
+	return err
+}
+
+// GetItem returns the value of Item if it is set or its
+// zero value if it is unset.
+func (v *Items_PutItem_Args) GetItem() (o *Item) {
+	if v != nil {
+		o = v.Item
+	}
+	return
+}
+
+// IsSetItem returns true if Item is not nil.
+func (v *Items_PutItem_Args) IsSetItem() bool {
+	return v != nil && v.Item != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "putItem" for this struct.
+func (v *Items_PutItem_Args) MethodName() string {
+	return "putItem"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Items_PutItem_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Items_PutItem_Helper provides functions that aid in handling the
+// parameters and return values of the Items.putItem
+// function.
+var Items_PutItem_Helper = struct {
+	// Args accepts the parameters of putItem in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		item *Item,
+	) *Items_PutItem_Args
+
+	// IsException returns true if the given error can be thrown
+	// by putItem.
+	//
+	// An error can be thrown by putItem only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for putItem
+	// given the error returned by it. The provided error may
+	// be nil if putItem did not fail.
+	//
+	// This allows mapping errors returned by putItem into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// putItem
+	//
+	//   err := putItem(args)
+	//   result, err := Items_PutItem_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from putItem: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*Items_PutItem_Result, error)
+}{}
+
+func init() {
+	Items_PutItem_Helper.Args = func(
+		item *Item,
+	) *Items_PutItem_Args {
+		return &Items_PutItem_Args{
+			Item: item,
+		}
+	}
+
+	Items_PutItem_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Items_PutItem_Helper.WrapResponse = func(err error) (*Items_PutItem_Result, error) {
+		if err == nil {
+			return &Items_PutItem_Result{}, nil
+		}
+
+		return nil, err
+	}
+
+}
+
+// Items_PutItem_Result represents the result of a Items.putItem function call.
+//
+// The result of a putItem execution is sent and received over the wire as this struct.
+type Items_PutItem_Result struct {
+}
+
+// ToWire translates a Items_PutItem_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Items_PutItem_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Items_PutItem_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Items_PutItem_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Items_PutItem_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Items_PutItem_Result) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Items_PutItem_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Items_PutItem_Result struct could not be encoded.
+func (v *Items_PutItem_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Items_PutItem_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Items_PutItem_Result struct could not be generated from the wire
+// representation.
+func (v *Items_PutItem_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Items_PutItem_Result
+// struct.
+func (v *Items_PutItem_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Items_PutItem_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Items_PutItem_Result match the
+// provided Items_PutItem_Result.
+//
+// This function performs a deep comparison.
+func (v *Items_PutItem_Result) Equals(rhs *Items_PutItem_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Items_PutItem_Result.
+func (v *Items_PutItem_Result) Copy() *Items_PutItem_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Items_PutItem_Result
+	return &o
+}
+
+// Hash returns a hash of this Items_PutItem_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Items_PutItem_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Items_PutItem_Result so that it may be reused.
+func (v *Items_PutItem_Result) Reset() {
+	*v = Items_PutItem_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Items_PutItem_Result.
+func (v *Items_PutItem_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "putItem" for this struct.
+func (v *Items_PutItem_Result) MethodName() string {
+	return "putItem"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Items_PutItem_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Items_Interface is implemented by servers of the Items
+// service.
+type Items_Interface interface {
+	GetItem(ctx context.Context, key string) (*Item, error)
+
+	PutItem(ctx context.Context, item *Item) error
+}
+
+// Items_Procedures returns a thriftrpc.Procedure for each function
+// of the Items service, including functions inherited from
+// its parent, served by the given implementation.
+func Items_Procedures(impl Items_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "Items::getItem",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Items_GetItem_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.GetItem(ctx, args.Key)
+				result, err := Items_GetItem_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body:               result,
+					IsApplicationError: result.NotFound != nil,
+				}, nil
+			},
+		},
+		{
+			Name: "Items::putItem",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Items_PutItem_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				result, err := Items_PutItem_Helper.WrapResponse(impl.PutItem(ctx, args.Item))
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	return procs
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package ordered provides maps which remember the order in which keys were
+// added to them. They are used as container types for Thrift maps in tests.
+package ordered
+
+// StringInt32Map is a map from strings to int32s which iterates over its
+// items in the order in which they were first added.
+type StringInt32Map struct {
+	keys  []string
+	items map[string]int32
+}
+
+// Len returns the number of items in the map.
+func (m *StringInt32Map) Len() int {
+	return len(m.keys)
+}
+
+// Get returns the value for the given key, and whether the key was found.
+func (m *StringInt32Map) Get(key string) (value int32, ok bool) {
+	value, ok = m.items[key]
+	return value, ok
+}
+
+// Put adds an item to the map, replacing the value of an existing key
+// without changing its position.
+func (m *StringInt32Map) Put(key string, value int32) {
+	if m.items == nil {
+		m.items = make(map[string]int32)
+	}
+	if _, ok := m.items[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.items[key] = value
+}
+
+// ForEach calls f on each item of the map in order, stopping at the first
+// error.
+func (m *StringInt32Map) ForEach(f func(key string, value int32) error) error {
+	for _, k := range m.keys {
+		if err := f(k, m.items[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Int32StringMap is a map from int32s to strings which iterates over its
+// items in the order in which they were first added.
+type Int32StringMap struct {
+	keys  []int32
+	items map[int32]string
+}
+
+// Len returns the number of items in the map.
+func (m *Int32StringMap) Len() int {
+	return len(m.keys)
+}
+
+// Get returns the value for the given key, and whether the key was found.
+func (m *Int32StringMap) Get(key int32) (value string, ok bool) {
+	value, ok = m.items[key]
+	return value, ok
+}
+
+// Put adds an item to the map, replacing the value of an existing key
+// without changing its position.
+func (m *Int32StringMap) Put(key int32, value string) {
+	if m.items == nil {
+		m.items = make(map[int32]string)
+	}
+	if _, ok := m.items[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.items[key] = value
+}
+
+// ForEach calls f on each item of the map in order, stopping at the first
+// error.
+func (m *Int32StringMap) ForEach(f func(key int32, value string) error) error {
+	for _, k := range m.keys {
+		if err := f(k, m.items[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,16 +4,23 @@
 package other_constants
 
 import (
+	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
 	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 )
 
+const Answer int32 = 42
+
+const Favorite enums.EnumDefault = enums.EnumDefaultBar
+
 var ListOfInts []int32 = []int32{
 	1,
 	2,
 	3,
 }
 
+const Origin float64 = 0
+
 var SomePoint *structs.Point = &structs.Point{
 	X: 1,
 	Y: 2,
@@ -24,11 +31,12 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "other_constants",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/other_constants",
 	FilePath: "other_constants.thrift",
-	SHA1:     "578e8e5aafda10921bb99b58be9a3714c78e31fc",
+	SHA1:     "c86ed47ce8f5d067ef4cd9546ec4479e887caf13",
 	Includes: []*thriftreflect.ThriftModule{
+		enums.ThriftModule,
 		structs.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./structs.thrift\"\n\nconst list<i32> listOfInts = [1, 2, 3]\n\nconst structs.Point some_point = {\"x\": 1, \"y\": 2.0}\n"
+const rawIDL = "include \"./enums.thrift\"\ninclude \"./structs.thrift\"\n\nconst list<i32> listOfInts = [1, 2, 3]\n\nconst structs.Point some_point = {\"x\": 1, \"y\": 2.0}\n\nconst i32 answer = 42\nconst double origin = 0.0\nconst enums.EnumDefault favorite = enums.EnumDefault.Bar\n"
@@ -0,0 +1,2630 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package pprof_labels
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	pprof "runtime/pprof"
+	strings "strings"
+	sync "sync"
+)
+
+type Geometry struct {
+	Point *Point `json:"point,omitempty"`
+	Shape *Shape `json:"shape,omitempty"`
+}
+
+// ToWire translates a Geometry struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Geometry) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Shape != nil {
+		w, err = v.Shape.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Geometry should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Shape_Read(w wire.Value) (*Shape, error) {
+	var v Shape
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// fromWire deserializes a Geometry struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Geometry struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Geometry
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Geometry) fromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Shape, err = _Shape_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Shape != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Geometry should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+var _Geometry_FromWire_pprofLabels = pprof.Labels("thrift.type", "Geometry", "thrift.method", "FromWire")
+
+// FromWire deserializes a Geometry struct from its Thrift-level
+// representation like fromWire, running under the pprof labels
+// thrift.type=Geometry and thrift.method=FromWire.
+func (v *Geometry) FromWire(w wire.Value) (err error) {
+	pprof.Do(context.Background(), _Geometry_FromWire_pprofLabels, func(context.Context) {
+		err = v.fromWire(w)
+	})
+	return err
+}
+
+// Encode serializes a Geometry struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Geometry struct could not be encoded.
+func (v *Geometry) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Shape != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Shape.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Shape != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Geometry should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Shape_Decode(sr stream.Reader) (*Shape, error) {
+	var v Shape
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// decode deserializes a Geometry struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Geometry struct could not be generated from the wire
+// representation.
+func (v *Geometry) decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Shape, err = _Shape_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Shape != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Geometry should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+var _Geometry_Decode_pprofLabels = pprof.Labels("thrift.type", "Geometry", "thrift.method", "Decode")
+
+// Decode deserializes a Geometry struct directly from its Thrift-level
+// representation like decode, running under the pprof labels
+// thrift.type=Geometry and thrift.method=Decode.
+func (v *Geometry) Decode(sr stream.Reader) (err error) {
+	pprof.Do(context.Background(), _Geometry_Decode_pprofLabels, func(context.Context) {
+		err = v.decode(sr)
+	})
+	return err
+}
+
+// String returns a readable string representation of a Geometry
+// struct.
+func (v *Geometry) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Shape != nil {
+		fields[i] = fmt.Sprintf("Shape: %v", v.Shape)
+		i++
+	}
+
+	return fmt.Sprintf("Geometry{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Geometry match the
+// provided Geometry.
+//
+// This function performs a deep comparison.
+func (v *Geometry) Equals(rhs *Geometry) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !((v.Shape == nil && rhs.Shape == nil) || (v.Shape != nil && rhs.Shape != nil && v.Shape.Equals(rhs.Shape))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Geometry.
+func (v *Geometry) Copy() *Geometry {
+	if v == nil {
+		return nil
+	}
+
+	var o Geometry
+	o.Point = v.Point.Copy()
+	o.Shape = v.Shape.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Geometry which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Geometry) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	h.Field(2)
+	h.Uint64(v.Shape.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Geometry so that it may be reused.
+func (v *Geometry) Reset() {
+	*v = Geometry{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Geometry.
+func (v *Geometry) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Shape != nil {
+		err = multierr.Append(err, enc.AddObject("shape", v.Shape))
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Geometry) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Geometry) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Geometry) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Geometry.
+func (v *Geometry) SetPoint(x *Point) {
+	*v = Geometry{}
+	v.Point = x
+}
+
+// GetShape returns the value of Shape if it is set or its
+// zero value if it is unset.
+func (v *Geometry) GetShape() (o *Shape) {
+	if v != nil && v.Shape != nil {
+		return v.Shape
+	}
+
+	return
+}
+
+// IsSetShape returns true if Shape is not nil.
+func (v *Geometry) IsSetShape() bool {
+	return v != nil && v.Shape != nil
+}
+
+// LookupShape returns the value of Shape and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Geometry) LookupShape() (o *Shape, _ bool) {
+	if v != nil && v.Shape != nil {
+		return v.Shape, true
+	}
+	return o, false
+}
+
+// SetShape sets the value of Shape and unsets all other
+// fields of this Geometry.
+func (v *Geometry) SetShape(x *Shape) {
+	*v = Geometry{}
+	v.Shape = x
+}
+
+// Which returns the ID of the field of this Geometry which is set, or
+// 0 if no field is set.
+func (v *Geometry) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Shape != nil {
+		return 2
+	}
+	return 0
+}
+
+// Geometry_Visitor visits the field of a Geometry which is set.
+//
+// Fields added to Geometry add methods to Geometry_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Geometry_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitShape is called with the value of Shape if it is set.
+	VisitShape(*Shape) error
+
+	// Default is called if no field of Geometry is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Geometry
+// which is set, or Default if none is, and returns its error.
+func (v *Geometry) Match(visitor Geometry_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Shape != nil {
+			return visitor.VisitShape(v.Shape)
+		}
+	}
+	return visitor.Default()
+}
+
+type InvalidShape struct {
+	Reason string `json:"reason,required"`
+}
+
+// ToWire translates a InvalidShape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *InvalidShape) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Reason), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// fromWire deserializes a InvalidShape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a InvalidShape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v InvalidShape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *InvalidShape) fromWire(w wire.Value) error {
+	var err error
+
+	reasonIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Reason, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				reasonIsSet = true
+			}
+		}
+	}
+
+	if !reasonIsSet {
+		return errors.New("field Reason of InvalidShape is required")
+	}
+
+	return nil
+}
+
+var _InvalidShape_FromWire_pprofLabels = pprof.Labels("thrift.type", "InvalidShape", "thrift.method", "FromWire")
+
+// FromWire deserializes a InvalidShape struct from its Thrift-level
+// representation like fromWire, running under the pprof labels
+// thrift.type=InvalidShape and thrift.method=FromWire.
+func (v *InvalidShape) FromWire(w wire.Value) (err error) {
+	pprof.Do(context.Background(), _InvalidShape_FromWire_pprofLabels, func(context.Context) {
+		err = v.fromWire(w)
+	})
+	return err
+}
+
+// Encode serializes a InvalidShape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a InvalidShape struct could not be encoded.
+func (v *InvalidShape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Reason); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// decode deserializes a InvalidShape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a InvalidShape struct could not be generated from the wire
+// representation.
+func (v *InvalidShape) decode(sr stream.Reader) error {
+
+	reasonIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Reason, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			reasonIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !reasonIsSet {
+		return errors.New("field Reason of InvalidShape is required")
+	}
+
+	return nil
+}
+
+var _InvalidShape_Decode_pprofLabels = pprof.Labels("thrift.type", "InvalidShape", "thrift.method", "Decode")
+
+// Decode deserializes a InvalidShape struct directly from its Thrift-level
+// representation like decode, running under the pprof labels
+// thrift.type=InvalidShape and thrift.method=Decode.
+func (v *InvalidShape) Decode(sr stream.Reader) (err error) {
+	pprof.Do(context.Background(), _InvalidShape_Decode_pprofLabels, func(context.Context) {
+		err = v.decode(sr)
+	})
+	return err
+}
+
+// String returns a readable string representation of a InvalidShape
+// struct.
+func (v *InvalidShape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Reason: %v", v.Reason)
+	i++
+
+	return fmt.Sprintf("InvalidShape{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InvalidShape) ErrorName() string {
+	return "InvalidShape"
+}
+
+// Equals returns true if all the fields of this InvalidShape match the
+// provided InvalidShape.
+//
+// This function performs a deep comparison.
+func (v *InvalidShape) Equals(rhs *InvalidShape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Reason == rhs.Reason) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this InvalidShape.
+func (v *InvalidShape) Copy() *InvalidShape {
+	if v == nil {
+		return nil
+	}
+
+	var o InvalidShape
+	o.Reason = v.Reason
+	return &o
+}
+
+// Hash returns a hash of this InvalidShape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *InvalidShape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Reason)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this InvalidShape so that it may be reused.
+func (v *InvalidShape) Reset() {
+	*v = InvalidShape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InvalidShape.
+func (v *InvalidShape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("reason", v.Reason)
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *InvalidShape) GetReason() (o string) {
+	if v != nil {
+		o = v.Reason
+	}
+	return
+}
+
+func (v *InvalidShape) Error() string {
+	return v.String()
+}
+
+type Point struct {
+	X int32 `json:"x,required"`
+	Y int32 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI32(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// fromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) fromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.X, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Y, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+var _Point_FromWire_pprofLabels = pprof.Labels("thrift.type", "Point", "thrift.method", "FromWire")
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation like fromWire, running under the pprof labels
+// thrift.type=Point and thrift.method=FromWire.
+func (v *Point) FromWire(w wire.Value) (err error) {
+	pprof.Do(context.Background(), _Point_FromWire_pprofLabels, func(context.Context) {
+		err = v.fromWire(w)
+	})
+	return err
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.X, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Y, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+var _Point_Decode_pprofLabels = pprof.Labels("thrift.type", "Point", "thrift.method", "Decode")
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation like decode, running under the pprof labels
+// thrift.type=Point and thrift.method=Decode.
+func (v *Point) Decode(sr stream.Reader) (err error) {
+	pprof.Do(context.Background(), _Point_Decode_pprofLabels, func(context.Context) {
+		err = v.decode(sr)
+	})
+	return err
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(v.X)
+	h.Field(2)
+	h.Int32(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt32("x", v.X)
+	enc.AddInt32("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o int32) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o int32) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Shape struct {
+	Name   string   `json:"name,required"`
+	Points []*Point `json:"points,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// fromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) fromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Shape is required")
+	}
+
+	return nil
+}
+
+var _Shape_FromWire_pprofLabels = pprof.Labels("thrift.type", "Shape", "thrift.method", "FromWire")
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation like fromWire, running under the pprof labels
+// thrift.type=Shape and thrift.method=FromWire.
+func (v *Shape) FromWire(w wire.Value) (err error) {
+	pprof.Do(context.Background(), _Shape_FromWire_pprofLabels, func(context.Context) {
+		err = v.fromWire(w)
+	})
+	return err
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Points, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Shape is required")
+	}
+
+	return nil
+}
+
+var _Shape_Decode_pprofLabels = pprof.Labels("thrift.type", "Shape", "thrift.method", "Decode")
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation like decode, running under the pprof labels
+// thrift.type=Shape and thrift.method=Decode.
+func (v *Shape) Decode(sr stream.Reader) (err error) {
+	pprof.Do(context.Background(), _Shape_Decode_pprofLabels, func(context.Context) {
+		err = v.decode(sr)
+	})
+	return err
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Name = v.Name
+	o.Points = _List_Point_Copy(v.Points)
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(_List_Point_Hash(v.Points))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Shape) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+type Unlabeled struct {
+	Value *string `json:"value,omitempty"`
+}
+
+// ToWire translates a Unlabeled struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Unlabeled) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Unlabeled struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Unlabeled struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Unlabeled
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Unlabeled) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Unlabeled struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Unlabeled struct could not be encoded.
+func (v *Unlabeled) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Value)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Unlabeled struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Unlabeled struct could not be generated from the wire
+// representation.
+func (v *Unlabeled) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Value = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Unlabeled
+// struct.
+func (v *Unlabeled) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
+	}
+
+	return fmt.Sprintf("Unlabeled{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Unlabeled match the
+// provided Unlabeled.
+//
+// This function performs a deep comparison.
+func (v *Unlabeled) Equals(rhs *Unlabeled) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Unlabeled.
+func (v *Unlabeled) Copy() *Unlabeled {
+	if v == nil {
+		return nil
+	}
+
+	var o Unlabeled
+	o.Value = _String_CopyPtr(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this Unlabeled which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Unlabeled) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Value != nil {
+		h.Field(1)
+		h.String(*v.Value)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Unlabeled so that it may be reused.
+func (v *Unlabeled) Reset() {
+	*v = Unlabeled{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Unlabeled.
+func (v *Unlabeled) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Unlabeled) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Unlabeled) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "pprof-labels",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/pprof-labels",
+	FilePath: "pprof-labels.thrift",
+	SHA1:     "28fb5acb8aaebd45af4248748a769c89d6793206",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Point {\n    1: required i32 x\n    2: required i32 y\n}\n\nstruct Shape {\n    1: required string name\n    2: optional list<Point> points\n}\n\nunion Geometry {\n    1: Point point\n    2: Shape shape\n}\n\nexception InvalidShape {\n    1: required string reason\n}\n\nstruct Unlabeled {\n    1: optional string value\n} (go.pprof_labels = \"false\")\n\nservice Canvas {\n    Shape draw(1: Geometry geometry) throws (1: InvalidShape invalid)\n}\n"
+
+// Canvas_Draw_Args represents the arguments for the Canvas.draw function.
+//
+// The arguments for draw are sent and received over the wire as this struct.
+type Canvas_Draw_Args struct {
+	Geometry *Geometry `json:"geometry,omitempty"`
+}
+
+// ToWire translates a Canvas_Draw_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Canvas_Draw_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Geometry != nil {
+		w, err = v.Geometry.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Geometry_Read(w wire.Value) (*Geometry, error) {
+	var v Geometry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// fromWire deserializes a Canvas_Draw_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Canvas_Draw_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Canvas_Draw_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Canvas_Draw_Args) fromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Geometry, err = _Geometry_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+var _Canvas_Draw_Args_FromWire_pprofLabels = pprof.Labels("thrift.type", "Canvas_Draw_Args", "thrift.method", "FromWire")
+
+// FromWire deserializes a Canvas_Draw_Args struct from its Thrift-level
+// representation like fromWire, running under the pprof labels
+// thrift.type=Canvas_Draw_Args and thrift.method=FromWire.
+func (v *Canvas_Draw_Args) FromWire(w wire.Value) (err error) {
+	pprof.Do(context.Background(), _Canvas_Draw_Args_FromWire_pprofLabels, func(context.Context) {
+		err = v.fromWire(w)
+	})
+	return err
+}
+
+// Encode serializes a Canvas_Draw_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Canvas_Draw_Args struct could not be encoded.
+func (v *Canvas_Draw_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Geometry != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Geometry.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Geometry_Decode(sr stream.Reader) (*Geometry, error) {
+	var v Geometry
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// decode deserializes a Canvas_Draw_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Canvas_Draw_Args struct could not be generated from the wire
+// representation.
+func (v *Canvas_Draw_Args) decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Geometry, err = _Geometry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _Canvas_Draw_Args_Decode_pprofLabels = pprof.Labels("thrift.type", "Canvas_Draw_Args", "thrift.method", "Decode")
+
+// Decode deserializes a Canvas_Draw_Args struct directly from its Thrift-level
+// representation like decode, running under the pprof labels
+// thrift.type=Canvas_Draw_Args and thrift.method=Decode.
+func (v *Canvas_Draw_Args) Decode(sr stream.Reader) (err error) {
+	pprof.Do(context.Background(), _Canvas_Draw_Args_Decode_pprofLabels, func(context.Context) {
+		err = v.decode(sr)
+	})
+	return err
+}
+
+// String returns a readable string representation of a Canvas_Draw_Args
+// struct.
+func (v *Canvas_Draw_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Geometry != nil {
+		fields[i] = fmt.Sprintf("Geometry: %v", v.Geometry)
+		i++
+	}
+
+	return fmt.Sprintf("Canvas_Draw_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Canvas_Draw_Args match the
+// provided Canvas_Draw_Args.
+//
+// This function performs a deep comparison.
+func (v *Canvas_Draw_Args) Equals(rhs *Canvas_Draw_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Geometry == nil && rhs.Geometry == nil) || (v.Geometry != nil && rhs.Geometry != nil && v.Geometry.Equals(rhs.Geometry))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Canvas_Draw_Args.
+func (v *Canvas_Draw_Args) Copy() *Canvas_Draw_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Canvas_Draw_Args
+	o.Geometry = v.Geometry.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Canvas_Draw_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Canvas_Draw_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Geometry.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Canvas_Draw_Args so that it may be reused.
+func (v *Canvas_Draw_Args) Reset() {
+	*v = Canvas_Draw_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Canvas_Draw_Args.
+func (v *Canvas_Draw_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Geometry != nil {
+		err = multierr.Append(err, enc.AddObject("geometry", v.Geometry))
+	}
+	return err
+}
+
+// GetGeometry returns the value of Geometry if it is set or its
+// zero value if it is unset.
+func (v *Canvas_Draw_Args) GetGeometry() (o *Geometry) {
+	if v != nil && v.Geometry != nil {
+		return v.Geometry
+	}
+
+	return
+}
+
+// IsSetGeometry returns true if Geometry is not nil.
+func (v *Canvas_Draw_Args) IsSetGeometry() bool {
+	return v != nil && v.Geometry != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "draw" for this struct.
+func (v *Canvas_Draw_Args) MethodName() string {
+	return "draw"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Canvas_Draw_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Canvas_Draw_Helper provides functions that aid in handling the
+// parameters and return values of the Canvas.draw
+// function.
+var Canvas_Draw_Helper = struct {
+	// Args accepts the parameters of draw in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		geometry *Geometry,
+	) *Canvas_Draw_Args
+
+	// IsException returns true if the given error can be thrown
+	// by draw.
+	//
+	// An error can be thrown by draw only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for draw
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// draw into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by draw
+	//
+	//   value, err := draw(args)
+	//   result, err := Canvas_Draw_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from draw: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Shape, error) (*Canvas_Draw_Result, error)
+
+	// UnwrapResponse takes the result struct for draw
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if draw threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Canvas_Draw_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Canvas_Draw_Result) (*Shape, error)
+}{}
+
+func init() {
+	Canvas_Draw_Helper.Args = func(
+		geometry *Geometry,
+	) *Canvas_Draw_Args {
+		return &Canvas_Draw_Args{
+			Geometry: geometry,
+		}
+	}
+
+	Canvas_Draw_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *InvalidShape:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Canvas_Draw_Helper.WrapResponse = func(success *Shape, err error) (*Canvas_Draw_Result, error) {
+		if err == nil {
+			return &Canvas_Draw_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *InvalidShape:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Canvas_Draw_Result.Invalid")
+			}
+			return &Canvas_Draw_Result{Invalid: e}, nil
+		}
+
+		return nil, err
+	}
+	Canvas_Draw_Helper.UnwrapResponse = func(result *Canvas_Draw_Result) (success *Shape, err error) {
+		if result.Invalid != nil {
+			err = result.Invalid
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Canvas_Draw_Result represents the result of a Canvas.draw function call.
+//
+// The result of a draw execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Canvas_Draw_Result struct {
+	// Value returned by draw after a successful execution.
+	Success *Shape        `json:"success,omitempty"`
+	Invalid *InvalidShape `json:"invalid,omitempty"`
+}
+
+// ToWire translates a Canvas_Draw_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Canvas_Draw_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.Invalid != nil {
+		w, err = v.Invalid.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Canvas_Draw_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _InvalidShape_Read(w wire.Value) (*InvalidShape, error) {
+	var v InvalidShape
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// fromWire deserializes a Canvas_Draw_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Canvas_Draw_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Canvas_Draw_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Canvas_Draw_Result) fromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Shape_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Invalid, err = _InvalidShape_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.Invalid != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Canvas_Draw_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+var _Canvas_Draw_Result_FromWire_pprofLabels = pprof.Labels("thrift.type", "Canvas_Draw_Result", "thrift.method", "FromWire")
+
+// FromWire deserializes a Canvas_Draw_Result struct from its Thrift-level
+// representation like fromWire, running under the pprof labels
+// thrift.type=Canvas_Draw_Result and thrift.method=FromWire.
+func (v *Canvas_Draw_Result) FromWire(w wire.Value) (err error) {
+	pprof.Do(context.Background(), _Canvas_Draw_Result_FromWire_pprofLabels, func(context.Context) {
+		err = v.fromWire(w)
+	})
+	return err
+}
+
+// Encode serializes a Canvas_Draw_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Canvas_Draw_Result struct could not be encoded.
+func (v *Canvas_Draw_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Invalid != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Invalid.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.Invalid != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Canvas_Draw_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _InvalidShape_Decode(sr stream.Reader) (*InvalidShape, error) {
+	var v InvalidShape
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// decode deserializes a Canvas_Draw_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Canvas_Draw_Result struct could not be generated from the wire
+// representation.
+func (v *Canvas_Draw_Result) decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Shape_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Invalid, err = _InvalidShape_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.Invalid != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Canvas_Draw_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+var _Canvas_Draw_Result_Decode_pprofLabels = pprof.Labels("thrift.type", "Canvas_Draw_Result", "thrift.method", "Decode")
+
+// Decode deserializes a Canvas_Draw_Result struct directly from its Thrift-level
+// representation like decode, running under the pprof labels
+// thrift.type=Canvas_Draw_Result and thrift.method=Decode.
+func (v *Canvas_Draw_Result) Decode(sr stream.Reader) (err error) {
+	pprof.Do(context.Background(), _Canvas_Draw_Result_Decode_pprofLabels, func(context.Context) {
+		err = v.decode(sr)
+	})
+	return err
+}
+
+// String returns a readable string representation of a Canvas_Draw_Result
+// struct.
+func (v *Canvas_Draw_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.Invalid != nil {
+		fields[i] = fmt.Sprintf("Invalid: %v", v.Invalid)
+		i++
+	}
+
+	return fmt.Sprintf("Canvas_Draw_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Canvas_Draw_Result match the
+// provided Canvas_Draw_Result.
+//
+// This function performs a deep comparison.
+func (v *Canvas_Draw_Result) Equals(rhs *Canvas_Draw_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.Invalid == nil && rhs.Invalid == nil) || (v.Invalid != nil && rhs.Invalid != nil && v.Invalid.Equals(rhs.Invalid))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Canvas_Draw_Result.
+func (v *Canvas_Draw_Result) Copy() *Canvas_Draw_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Canvas_Draw_Result
+	o.Success = v.Success.Copy()
+	o.Invalid = v.Invalid.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Canvas_Draw_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Canvas_Draw_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.Invalid.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Canvas_Draw_Result so that it may be reused.
+func (v *Canvas_Draw_Result) Reset() {
+	*v = Canvas_Draw_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Canvas_Draw_Result.
+func (v *Canvas_Draw_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.Invalid != nil {
+		err = multierr.Append(err, enc.AddObject("invalid", v.Invalid))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Canvas_Draw_Result) GetSuccess() (o *Shape) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Canvas_Draw_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetInvalid returns the value of Invalid if it is set or its
+// zero value if it is unset.
+func (v *Canvas_Draw_Result) GetInvalid() (o *InvalidShape) {
+	if v != nil && v.Invalid != nil {
+		return v.Invalid
+	}
+
+	return
+}
+
+// IsSetInvalid returns true if Invalid is not nil.
+func (v *Canvas_Draw_Result) IsSetInvalid() bool {
+	return v != nil && v.Invalid != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "draw" for this struct.
+func (v *Canvas_Draw_Result) MethodName() string {
+	return "draw"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Canvas_Draw_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
@@ -0,0 +1,11936 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package presence_bits
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	validate "go.uber.org/thriftrw/validate"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+var DefaultAliasedSample *AliasedSample = func() *AliasedSample {
+	v := &Sample{
+		ID: "aliased",
+	}
+	v.SetCount(2)
+	v.SetRetries(3)
+	return (*AliasedSample)(v)
+}()
+
+var DefaultSample *Sample = func() *Sample {
+	v := &Sample{
+		ID: "default",
+	}
+	v.SetCount(1)
+	v.SetName("sample")
+	v.SetRetries(3)
+	return v
+}()
+
+type AliasedSample Sample
+
+// ToWire translates AliasedSample into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *AliasedSample) ToWire() (wire.Value, error) {
+	x := (*Sample)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of AliasedSample.
+func (v *AliasedSample) String() string {
+	x := (*Sample)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *AliasedSample) Encode(sw stream.Writer) error {
+	x := (*Sample)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes AliasedSample from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *AliasedSample) FromWire(w wire.Value) error {
+	return (*Sample)(v).FromWire(w)
+}
+
+// Decode deserializes AliasedSample directly off the wire.
+func (v *AliasedSample) Decode(sr stream.Reader) error {
+	return (*Sample)(v).Decode(sr)
+}
+
+// Equals returns true if this AliasedSample is equal to the provided
+// AliasedSample.
+func (lhs *AliasedSample) Equals(rhs *AliasedSample) bool {
+	return (*Sample)(lhs).Equals((*Sample)(rhs))
+}
+
+// Copy returns a deep copy of this AliasedSample.
+func (v *AliasedSample) Copy() *AliasedSample {
+	x := (*Sample)(v)
+	return (*AliasedSample)(x.Copy())
+}
+
+// Hash returns a hash of this AliasedSample which is stable across
+// processes.
+func (v *AliasedSample) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Sample)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *AliasedSample) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Sample)(v)).MarshalLogObject(enc)
+}
+
+type Choice struct {
+	Text   string `json:"text,omitempty"`
+	Number int64  `json:"number,omitempty"`
+	Point  *Point `json:"point,omitempty"`
+
+	presence [1]uint64
+}
+
+// ToWire translates a Choice struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Choice) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.refText() != nil {
+		w, err = wire.NewValueString(*(v.refText())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.refNumber() != nil {
+		w, err = wire.NewValueI64(*(v.refNumber())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Choice should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Choice struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Choice struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Choice
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Choice) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Text, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				v.Number, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 1)
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.refText() != nil {
+		count++
+	}
+	if v.refNumber() != nil {
+		count++
+	}
+	if v.Point != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Choice should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Choice struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Choice struct could not be encoded.
+func (v *Choice) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.refText() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.refText())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refNumber() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.refNumber())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.refText() != nil {
+		count++
+	}
+	if v.refNumber() != nil {
+		count++
+	}
+	if v.Point != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Choice should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Choice struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Choice struct could not be generated from the wire
+// representation.
+func (v *Choice) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Text, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			v.Number, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 1)
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.refText() != nil {
+		count++
+	}
+	if v.refNumber() != nil {
+		count++
+	}
+	if v.Point != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Choice should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Choice
+// struct.
+func (v *Choice) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.refText() != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.refText()))
+		i++
+	}
+	if v.refNumber() != nil {
+		fields[i] = fmt.Sprintf("Number: %v", *(v.refNumber()))
+		i++
+	}
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+
+	return fmt.Sprintf("Choice{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Choice match the
+// provided Choice.
+//
+// This function performs a deep comparison.
+func (v *Choice) Equals(rhs *Choice) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.refText(), rhs.refText()) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.refNumber(), rhs.refNumber()) {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Choice.
+func (v *Choice) Copy() *Choice {
+	if v == nil {
+		return nil
+	}
+
+	var o Choice
+	o.Text = v.Text
+	o.Number = v.Number
+	o.Point = v.Point.Copy()
+	o.presence = v.presence
+	return &o
+}
+
+// Hash returns a hash of this Choice which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Choice) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.refText() != nil {
+		h.Field(1)
+		h.String(*v.refText())
+	}
+	if v.refNumber() != nil {
+		h.Field(2)
+		h.Int64(*v.refNumber())
+	}
+	h.Field(3)
+	h.Uint64(v.Point.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Choice so that it may be reused.
+func (v *Choice) Reset() {
+	*v = Choice{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Choice.
+func (v *Choice) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.refText() != nil {
+		enc.AddString("text", *v.refText())
+	}
+	if v.refNumber() != nil {
+		enc.AddInt64("number", *v.refNumber())
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	return err
+}
+
+// MarshalJSON serializes a Choice into JSON without its
+// unset optional fields.
+//
+// This has a value receiver so that Choice values are marshaled this way in
+// addition to pointers to them.
+func (v Choice) MarshalJSON() ([]byte, error) {
+	type plain Choice // without this method
+
+	r := struct {
+		*plain
+		Text   *string `json:"text,omitempty"`
+		Number *int64  `json:"number,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Text = v.refText()
+	r.Number = v.refNumber()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Choice from JSON, recording which of
+// its optional fields are set.
+func (v *Choice) UnmarshalJSON(data []byte) error {
+	type plain Choice // without this method
+
+	r := struct {
+		*plain
+		Text   *string `json:"text,omitempty"`
+		Number *int64  `json:"number,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.Text != nil {
+		v.SetText(*r.Text)
+	}
+	if r.Number != nil {
+		v.SetNumber(*r.Number)
+	}
+	return nil
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+func (v *Choice) GetText() (o string) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is set.
+func (v *Choice) IsSetText() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasText returns true if Text is set.
+func (v *Choice) HasText() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearText unsets Text.
+func (v *Choice) ClearText() {
+	var x string
+	v.Text = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refText returns a pointer to Text if it is set, and
+// nil otherwise.
+func (v *Choice) refText() *string {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.Text
+	}
+	return nil
+}
+
+// LookupText returns the value of Text and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Choice) LookupText() (o string, _ bool) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.Text, true
+	}
+	return o, false
+}
+
+// SetText sets the value of Text and unsets all other
+// fields of this Choice.
+func (v *Choice) SetText(x string) {
+	*v = Choice{}
+	v.Text = x
+	v.presence[0] |= (1 << 0)
+}
+
+// GetNumber returns the value of Number if it is set or its
+// zero value if it is unset.
+func (v *Choice) GetNumber() (o int64) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.Number
+	}
+
+	return
+}
+
+// IsSetNumber returns true if Number is set.
+func (v *Choice) IsSetNumber() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// HasNumber returns true if Number is set.
+func (v *Choice) HasNumber() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// ClearNumber unsets Number.
+func (v *Choice) ClearNumber() {
+	var x int64
+	v.Number = x
+	v.presence[0] &^= (1 << 1)
+}
+
+// refNumber returns a pointer to Number if it is set, and
+// nil otherwise.
+func (v *Choice) refNumber() *int64 {
+	if v.presence[0]&(1<<1) != 0 {
+		return &v.Number
+	}
+	return nil
+}
+
+// LookupNumber returns the value of Number and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Choice) LookupNumber() (o int64, _ bool) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.Number, true
+	}
+	return o, false
+}
+
+// SetNumber sets the value of Number and unsets all other
+// fields of this Choice.
+func (v *Choice) SetNumber(x int64) {
+	*v = Choice{}
+	v.Number = x
+	v.presence[0] |= (1 << 1)
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Choice) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Choice) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Choice) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Choice.
+func (v *Choice) SetPoint(x *Point) {
+	*v = Choice{}
+	v.Point = x
+}
+
+// Which returns the ID of the field of this Choice which is set, or
+// 0 if no field is set.
+func (v *Choice) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.presence[0]&(1<<0) != 0 {
+		return 1
+	}
+	if v.presence[0]&(1<<1) != 0 {
+		return 2
+	}
+	if v.Point != nil {
+		return 3
+	}
+	return 0
+}
+
+// Choice_Visitor visits the field of a Choice which is set.
+//
+// Fields added to Choice add methods to Choice_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Choice_Visitor interface {
+	// VisitText is called with the value of Text if it is set.
+	VisitText(string) error
+
+	// VisitNumber is called with the value of Number if it is set.
+	VisitNumber(int64) error
+
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// Default is called if no field of Choice is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Choice
+// which is set, or Default if none is, and returns its error.
+func (v *Choice) Match(visitor Choice_Visitor) error {
+	if v != nil {
+		if v.presence[0]&(1<<0) != 0 {
+			return visitor.VisitText(v.Text)
+		}
+		if v.presence[0]&(1<<1) != 0 {
+			return visitor.VisitNumber(v.Number)
+		}
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+	}
+	return visitor.Default()
+}
+
+// ChoiceBuilder builds Choice values with chained setters. Use
+// NewChoiceBuilder to construct one.
+type ChoiceBuilder struct {
+	v Choice
+}
+
+// NewChoiceBuilder returns a builder for Choice values.
+func NewChoiceBuilder() *ChoiceBuilder {
+	return &ChoiceBuilder{}
+}
+
+// Text sets the Text field of the Choice.
+func (b *ChoiceBuilder) Text(value string) *ChoiceBuilder {
+	b.v.SetText(value)
+	return b
+}
+
+// Number sets the Number field of the Choice.
+func (b *ChoiceBuilder) Number(value int64) *ChoiceBuilder {
+	b.v.SetNumber(value)
+	return b
+}
+
+// Point sets the Point field of the Choice.
+func (b *ChoiceBuilder) Point(value *Point) *ChoiceBuilder {
+	b.v.Point = value
+	return b
+}
+
+// Build returns the Choice built so far.
+//
+// An error is returned unless exactly one field was set.
+func (b *ChoiceBuilder) Build() (*Choice, error) {
+	count := 0
+	if b.v.refText() != nil {
+		count++
+	}
+	if b.v.refNumber() != nil {
+		count++
+	}
+	if b.v.Point != nil {
+		count++
+	}
+	if count != 1 {
+		return nil, fmt.Errorf("Choice should have exactly one field: got %v fields", count)
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type Failure struct {
+	Message string `json:"message,omitempty"`
+	Code    int32  `json:"code,omitempty"`
+
+	presence [1]uint64
+}
+
+// ToWire translates a Failure struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Failure) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.refMessage() != nil {
+		w, err = wire.NewValueString(*(v.refMessage())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.refCode() != nil {
+		w, err = wire.NewValueI32(*(v.refCode())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Failure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Failure struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Failure
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Failure) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Code, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Failure struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Failure struct could not be encoded.
+func (v *Failure) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.refMessage() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.refMessage())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refCode() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refCode())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Failure struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Failure struct could not be generated from the wire
+// representation.
+func (v *Failure) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Code, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 1)
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Failure
+// struct.
+func (v *Failure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.refMessage() != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.refMessage()))
+		i++
+	}
+	if v.refCode() != nil {
+		fields[i] = fmt.Sprintf("Code: %v", *(v.refCode()))
+		i++
+	}
+
+	return fmt.Sprintf("Failure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*Failure) ErrorName() string {
+	return "Failure"
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Failure match the
+// provided Failure.
+//
+// This function performs a deep comparison.
+func (v *Failure) Equals(rhs *Failure) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.refMessage(), rhs.refMessage()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refCode(), rhs.refCode()) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Failure.
+func (v *Failure) Copy() *Failure {
+	if v == nil {
+		return nil
+	}
+
+	var o Failure
+	o.Message = v.Message
+	o.Code = v.Code
+	o.presence = v.presence
+	return &o
+}
+
+// Hash returns a hash of this Failure which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Failure) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.refMessage() != nil {
+		h.Field(1)
+		h.String(*v.refMessage())
+	}
+	if v.refCode() != nil {
+		h.Field(2)
+		h.Int32(*v.refCode())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Failure so that it may be reused.
+func (v *Failure) Reset() {
+	*v = Failure{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Failure.
+func (v *Failure) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.refMessage() != nil {
+		enc.AddString("message", *v.refMessage())
+	}
+	if v.refCode() != nil {
+		enc.AddInt32("code", *v.refCode())
+	}
+	return err
+}
+
+// MarshalJSON serializes a Failure into JSON without its
+// unset optional fields.
+//
+// This has a value receiver so that Failure values are marshaled this way in
+// addition to pointers to them.
+func (v Failure) MarshalJSON() ([]byte, error) {
+	type plain Failure // without this method
+
+	r := struct {
+		*plain
+		Message *string `json:"message,omitempty"`
+		Code    *int32  `json:"code,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Message = v.refMessage()
+	r.Code = v.refCode()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Failure from JSON, recording which of
+// its optional fields are set.
+func (v *Failure) UnmarshalJSON(data []byte) error {
+	type plain Failure // without this method
+
+	r := struct {
+		*plain
+		Message *string `json:"message,omitempty"`
+		Code    *int32  `json:"code,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.Message != nil {
+		v.SetMessage(*r.Message)
+	}
+	if r.Code != nil {
+		v.SetCode(*r.Code)
+	}
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetMessage() (o string) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is set.
+func (v *Failure) IsSetMessage() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasMessage returns true if Message is set.
+func (v *Failure) HasMessage() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearMessage unsets Message.
+func (v *Failure) ClearMessage() {
+	var x string
+	v.Message = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refMessage returns a pointer to Message if it is set, and
+// nil otherwise.
+func (v *Failure) refMessage() *string {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.Message
+	}
+	return nil
+}
+
+// SetMessage sets the value of Message.
+func (v *Failure) SetMessage(x string) {
+	v.Message = x
+	v.presence[0] |= (1 << 0)
+}
+
+// GetCode returns the value of Code if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetCode() (o int32) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.Code
+	}
+
+	return
+}
+
+// IsSetCode returns true if Code is set.
+func (v *Failure) IsSetCode() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// HasCode returns true if Code is set.
+func (v *Failure) HasCode() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// ClearCode unsets Code.
+func (v *Failure) ClearCode() {
+	var x int32
+	v.Code = x
+	v.presence[0] &^= (1 << 1)
+}
+
+// refCode returns a pointer to Code if it is set, and
+// nil otherwise.
+func (v *Failure) refCode() *int32 {
+	if v.presence[0]&(1<<1) != 0 {
+		return &v.Code
+	}
+	return nil
+}
+
+// SetCode sets the value of Code.
+func (v *Failure) SetCode(x int32) {
+	v.Code = x
+	v.presence[0] |= (1 << 1)
+}
+
+// FailureBuilder builds Failure values with chained setters. Use
+// NewFailureBuilder to construct one.
+type FailureBuilder struct {
+	v Failure
+}
+
+// NewFailureBuilder returns a builder for Failure values.
+func NewFailureBuilder() *FailureBuilder {
+	return &FailureBuilder{}
+}
+
+// Message sets the Message field of the Failure.
+func (b *FailureBuilder) Message(value string) *FailureBuilder {
+	b.v.SetMessage(value)
+	return b
+}
+
+// Code sets the Code field of the Failure.
+func (b *FailureBuilder) Code(value int32) *FailureBuilder {
+	b.v.SetCode(value)
+	return b
+}
+
+// Build returns the Failure built so far.
+func (b *FailureBuilder) Build() (*Failure, error) {
+	v := b.v
+	return &v, nil
+}
+
+func (v *Failure) Error() string {
+	return v.String()
+}
+
+type Label string
+
+// LabelPtr returns a pointer to a Label
+func (v Label) Ptr() *Label {
+	return &v
+}
+
+// ToWire translates Label into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Label) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Label.
+func (v Label) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Label) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Label from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Label) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Label)(x)
+	return err
+}
+
+// Decode deserializes Label directly off the wire.
+func (v *Label) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Label)(x)
+	return err
+}
+
+// Equals returns true if this Label is equal to the provided
+// Label.
+func (lhs Label) Equals(rhs Label) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Label which is stable across
+// processes.
+func (v Label) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+type Level int32
+
+const (
+	LevelLow  Level = 0
+	LevelHigh Level = 1
+)
+
+// Level_Values returns all recognized values of Level.
+func Level_Values() []Level {
+	return []Level{
+		LevelLow,
+		LevelHigh,
+	}
+}
+
+// Level_Names returns the names of all recognized values of
+// Level, in the same order as Level_Values.
+func Level_Names() []string {
+	return []string{
+		"LOW",
+		"HIGH",
+	}
+}
+
+// IsValid returns true if this Level is one of its recognized
+// values.
+func (v Level) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Level from a byte slice
+// containing its name.
+//
+//   var v Level
+//   err := v.UnmarshalText([]byte("LOW"))
+func (v *Level) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "LOW":
+		*v = LevelLow
+		return nil
+	case "HIGH":
+		*v = LevelHigh
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Level", err)
+		}
+		*v = Level(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Level to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Level) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("LOW"), nil
+	case 1:
+		return []byte("HIGH"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Level.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Level) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "LOW")
+	case 1:
+		enc.AddString("name", "HIGH")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Level) Ptr() *Level {
+	return &v
+}
+
+// Set sets Level from its name or integer value.
+//
+// This implements flag.Value, allowing Level to be used as a
+// command line flag.
+func (v *Level) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Level) Type() string {
+	return "Level"
+}
+
+// Encode encodes Level directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Level
+//   return v.Encode(sWriter)
+func (v Level) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Level into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Level) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Level from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Level(0), err
+//   }
+//
+//   var v Level
+//   if err := v.FromWire(x); err != nil {
+//     return Level(0), err
+//   }
+//   return v, nil
+func (v *Level) FromWire(w wire.Value) error {
+	*v = (Level)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Level directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Level
+//   if err := v.Decode(sReader); err != nil {
+//     return Level(0), err
+//   }
+//   return v, nil
+func (v *Level) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Level)(i)
+	return nil
+}
+
+// String returns a readable string representation of Level.
+func (v Level) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "LOW"
+	case 1:
+		return "HIGH"
+	}
+	return fmt.Sprintf("Level(%d)", w)
+}
+
+// Equals returns true if this Level value matches the provided
+// value.
+func (v Level) Equals(rhs Level) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Level into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Level) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"LOW\""), nil
+	case 1:
+		return ([]byte)("\"HIGH\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Level from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Level) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Level")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Level")
+		}
+		*v = (Level)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Level")
+	}
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// PointBuilder builds Point values with chained setters. Use
+// NewPointBuilder to construct one.
+type PointBuilder struct {
+	v    Point
+	hasX bool
+	hasY bool
+}
+
+// NewPointBuilder returns a builder for Point values.
+func NewPointBuilder() *PointBuilder {
+	return &PointBuilder{}
+}
+
+// X sets the X field of the Point.
+func (b *PointBuilder) X(value float64) *PointBuilder {
+	b.v.X = value
+	b.hasX = true
+	return b
+}
+
+// Y sets the Y field of the Point.
+func (b *PointBuilder) Y(value float64) *PointBuilder {
+	b.v.Y = value
+	b.hasY = true
+	return b
+}
+
+// Build returns the Point built so far.
+//
+// An error is returned if a required field was not set.
+func (b *PointBuilder) Build() (*Point, error) {
+	if !b.hasX {
+		return nil, errors.New("field X of Point is required")
+	}
+	if !b.hasY {
+		return nil, errors.New("field Y of Point is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type Pointers struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ToWire translates a Pointers struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Pointers) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Pointers struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Pointers struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Pointers
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Pointers) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Pointers struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Pointers struct could not be encoded.
+func (v *Pointers) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Pointers struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Pointers struct could not be generated from the wire
+// representation.
+func (v *Pointers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Pointers
+// struct.
+func (v *Pointers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Pointers{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Pointers match the
+// provided Pointers.
+//
+// This function performs a deep comparison.
+func (v *Pointers) Equals(rhs *Pointers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Pointers.
+func (v *Pointers) Copy() *Pointers {
+	if v == nil {
+		return nil
+	}
+
+	var o Pointers
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Pointers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Pointers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Pointers so that it may be reused.
+func (v *Pointers) Reset() {
+	*v = Pointers{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Pointers.
+func (v *Pointers) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Pointers) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Pointers) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// PointersBuilder builds Pointers values with chained setters. Use
+// NewPointersBuilder to construct one.
+type PointersBuilder struct {
+	v Pointers
+}
+
+// NewPointersBuilder returns a builder for Pointers values.
+func NewPointersBuilder() *PointersBuilder {
+	return &PointersBuilder{}
+}
+
+// Name sets the Name field of the Pointers.
+func (b *PointersBuilder) Name(value string) *PointersBuilder {
+	b.v.Name = &value
+	return b
+}
+
+// Build returns the Pointers built so far.
+func (b *PointersBuilder) Build() (*Pointers, error) {
+	v := b.v
+	return &v, nil
+}
+
+type Sample struct {
+	ID      string   `json:"id,required"`
+	Enabled bool     `json:"enabled,omitempty"`
+	Tiny    int8     `json:"tiny,omitempty"`
+	Small   int16    `json:"small,omitempty"`
+	Count   int32    `json:"count,omitempty"`
+	Total   int64    `json:"total,omitempty"`
+	Ratio   float64  `json:"ratio,omitempty"`
+	Name    string   `json:"name,omitempty"`
+	Level   Level    `json:"level,omitempty"`
+	Label   Label    `json:"label,omitempty"`
+	Retries int32    `json:"retries,omitempty"`
+	Token   string   `json:"token,omitempty"`
+	Point   *Point   `json:"point,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	Data    []byte   `json:"data,omitempty"`
+	Secret  string   `json:"secret,omitempty"`
+
+	presence [1]uint64
+}
+
+// Default_Sample constructs a new Sample struct,
+// pre-populating any fields with defined default values.
+func Default_Sample() *Sample {
+	var v Sample
+	v.Retries = 3
+	v.presence[0] |= (1 << 9)
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Sample struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Sample) ToWire() (wire.Value, error) {
+	var (
+		fields [16]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.refEnabled() != nil {
+		w, err = wire.NewValueBool(*(v.refEnabled())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.refTiny() != nil {
+		w, err = wire.NewValueI8(*(v.refTiny())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.refSmall() != nil {
+		w, err = wire.NewValueI16(*(v.refSmall())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.refCount() != nil {
+		w, err = wire.NewValueI32(*(v.refCount())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.refTotal() != nil {
+		w, err = wire.NewValueI64(*(v.refTotal())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.refRatio() != nil {
+		w, err = wire.NewValueDouble(*(v.refRatio())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.refName() != nil {
+		w, err = wire.NewValueString(*(v.refName())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.refLevel() != nil {
+		w, err = v.refLevel().ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.refLabel() != nil {
+		w, err = v.refLabel().ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	vRetries := v.refRetries()
+	if vRetries == nil {
+		vRetries = ptr.Int32(3)
+	}
+	{
+		w, err = wire.NewValueI32(*(vRetries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.refToken() != nil {
+		w, err = wire.NewValueString(*(v.refToken())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 13, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w, err = wire.NewValueBinary(v.Data), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.refSecret() != nil {
+		w, err = wire.NewValueString(*(v.refSecret())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Level_Read(w wire.Value) (Level, error) {
+	var v Level
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Label_Read(w wire.Value) (Label, error) {
+	var x Label
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Sample struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Sample struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Sample
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Sample) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBool {
+				v.Enabled, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		case 3:
+			if field.Value.Type() == wire.TI8 {
+				v.Tiny, err = field.Value.GetI8(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 1)
+			}
+		case 4:
+			if field.Value.Type() == wire.TI16 {
+				v.Small, err = field.Value.GetI16(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 2)
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				v.Count, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 3)
+			}
+		case 6:
+			if field.Value.Type() == wire.TI64 {
+				v.Total, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 4)
+			}
+		case 7:
+			if field.Value.Type() == wire.TDouble {
+				v.Ratio, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 5)
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 6)
+			}
+		case 9:
+			if field.Value.Type() == wire.TI32 {
+				v.Level, err = _Level_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 7)
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.Label, err = _Label_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 8)
+			}
+		case 11:
+			if field.Value.Type() == wire.TI32 {
+				v.Retries, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 9)
+			}
+		case 12:
+			if field.Value.Type() == wire.TBinary {
+				v.Token, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 10)
+			}
+		case 13:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 14:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				v.Data, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 16:
+			if field.Value.Type() == wire.TBinary {
+				v.Secret, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 11)
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Sample is required")
+	}
+
+	if v.presence[0]&(1<<9) == 0 {
+		v.Retries = 3
+		v.presence[0] |= (1 << 9)
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Sample struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Sample struct could not be encoded.
+func (v *Sample) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.refEnabled() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.refEnabled())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refTiny() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI8}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt8(*(v.refTiny())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refSmall() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.refSmall())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refCount() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refCount())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refTotal() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.refTotal())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refRatio() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.refRatio())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refName() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.refName())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refLevel() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.refLevel().Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refLabel() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.refLabel().Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vRetries := v.refRetries()
+	if vRetries == nil {
+		vRetries = ptr.Int32(3)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(vRetries)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refToken() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.refToken())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 13, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 14, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Data != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Data); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refSecret() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.refSecret())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Level_Decode(sr stream.Reader) (Level, error) {
+	var v Level
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Label_Decode(sr stream.Reader) (Label, error) {
+	var x Label
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Sample struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Sample struct could not be generated from the wire
+// representation.
+func (v *Sample) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBool:
+			v.Enabled, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		case fh.ID == 3 && fh.Type == wire.TI8:
+			v.Tiny, err = sr.ReadInt8()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 1)
+		case fh.ID == 4 && fh.Type == wire.TI16:
+			v.Small, err = sr.ReadInt16()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 2)
+		case fh.ID == 5 && fh.Type == wire.TI32:
+			v.Count, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 3)
+		case fh.ID == 6 && fh.Type == wire.TI64:
+			v.Total, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 4)
+		case fh.ID == 7 && fh.Type == wire.TDouble:
+			v.Ratio, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 5)
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 6)
+		case fh.ID == 9 && fh.Type == wire.TI32:
+			v.Level, err = _Level_Decode(sr)
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 7)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			v.Label, err = _Label_Decode(sr)
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 8)
+		case fh.ID == 11 && fh.Type == wire.TI32:
+			v.Retries, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 9)
+		case fh.ID == 12 && fh.Type == wire.TBinary:
+			v.Token, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 10)
+		case fh.ID == 13 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 14 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 15 && fh.Type == wire.TBinary:
+			v.Data, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 16 && fh.Type == wire.TBinary:
+			v.Secret, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 11)
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Sample is required")
+	}
+
+	if v.presence[0]&(1<<9) == 0 {
+		v.Retries = 3
+		v.presence[0] |= (1 << 9)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Sample
+// struct.
+func (v *Sample) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [16]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.refEnabled() != nil {
+		fields[i] = fmt.Sprintf("Enabled: %v", *(v.refEnabled()))
+		i++
+	}
+	if v.refTiny() != nil {
+		fields[i] = fmt.Sprintf("Tiny: %v", *(v.refTiny()))
+		i++
+	}
+	if v.refSmall() != nil {
+		fields[i] = fmt.Sprintf("Small: %v", *(v.refSmall()))
+		i++
+	}
+	if v.refCount() != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.refCount()))
+		i++
+	}
+	if v.refTotal() != nil {
+		fields[i] = fmt.Sprintf("Total: %v", *(v.refTotal()))
+		i++
+	}
+	if v.refRatio() != nil {
+		fields[i] = fmt.Sprintf("Ratio: %v", *(v.refRatio()))
+		i++
+	}
+	if v.refName() != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.refName()))
+		i++
+	}
+	if v.refLevel() != nil {
+		fields[i] = fmt.Sprintf("Level: %v", *(v.refLevel()))
+		i++
+	}
+	if v.refLabel() != nil {
+		fields[i] = fmt.Sprintf("Label: %v", *(v.refLabel()))
+		i++
+	}
+	if v.refRetries() != nil {
+		fields[i] = fmt.Sprintf("Retries: %v", *(v.refRetries()))
+		i++
+	}
+	if v.refToken() != nil {
+		fields[i] = "Token: [redacted]"
+		i++
+	}
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+	if v.refSecret() != nil {
+		fields[i] = fmt.Sprintf("Secret: %v", *(v.refSecret()))
+		i++
+	}
+
+	return fmt.Sprintf("Sample{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Byte_EqualsPtr(lhs, rhs *int8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Level_EqualsPtr(lhs, rhs *Level) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Label_EqualsPtr(lhs, rhs *Label) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Sample match the
+// provided Sample.
+//
+// This function performs a deep comparison.
+func (v *Sample) Equals(rhs *Sample) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.refEnabled(), rhs.refEnabled()) {
+		return false
+	}
+	if !_Byte_EqualsPtr(v.refTiny(), rhs.refTiny()) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.refSmall(), rhs.refSmall()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refCount(), rhs.refCount()) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.refTotal(), rhs.refTotal()) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.refRatio(), rhs.refRatio()) {
+		return false
+	}
+	if !_String_EqualsPtr(v.refName(), rhs.refName()) {
+		return false
+	}
+	if !_Level_EqualsPtr(v.refLevel(), rhs.refLevel()) {
+		return false
+	}
+	if !_Label_EqualsPtr(v.refLabel(), rhs.refLabel()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refRetries(), rhs.refRetries()) {
+		return false
+	}
+	if !_String_EqualsPtr(v.refToken(), rhs.refToken()) {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && bytes.Equal(v.Data, rhs.Data))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.refSecret(), rhs.refSecret()) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Sample.
+func (v *Sample) Copy() *Sample {
+	if v == nil {
+		return nil
+	}
+
+	var o Sample
+	o.ID = v.ID
+	o.Enabled = v.Enabled
+	o.Tiny = v.Tiny
+	o.Small = v.Small
+	o.Count = v.Count
+	o.Total = v.Total
+	o.Ratio = v.Ratio
+	o.Name = v.Name
+	o.Level = v.Level
+	o.Label = v.Label
+	o.Retries = v.Retries
+	o.Token = v.Token
+	o.Point = v.Point.Copy()
+	o.Tags = _List_String_Copy(v.Tags)
+	o.Data = _Binary_Copy(v.Data)
+	o.Secret = v.Secret
+	o.presence = v.presence
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Sample which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Sample) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.refEnabled() != nil {
+		h.Field(2)
+		h.Bool(*v.refEnabled())
+	}
+	if v.refTiny() != nil {
+		h.Field(3)
+		h.Int8(*v.refTiny())
+	}
+	if v.refSmall() != nil {
+		h.Field(4)
+		h.Int16(*v.refSmall())
+	}
+	if v.refCount() != nil {
+		h.Field(5)
+		h.Int32(*v.refCount())
+	}
+	if v.refTotal() != nil {
+		h.Field(6)
+		h.Int64(*v.refTotal())
+	}
+	if v.refRatio() != nil {
+		h.Field(7)
+		h.Double(*v.refRatio())
+	}
+	if v.refName() != nil {
+		h.Field(8)
+		h.String(*v.refName())
+	}
+	if v.refLevel() != nil {
+		h.Field(9)
+		h.Int32(int32(*v.refLevel()))
+	}
+	if v.refLabel() != nil {
+		h.Field(10)
+		h.String(string(*v.refLabel()))
+	}
+	if v.refRetries() != nil {
+		h.Field(11)
+		h.Int32(*v.refRetries())
+	}
+	if v.refToken() != nil {
+		h.Field(12)
+		h.String(*v.refToken())
+	}
+	h.Field(13)
+	h.Uint64(v.Point.Hash())
+	h.Field(14)
+	h.Uint64(_List_String_Hash(v.Tags))
+	h.Field(15)
+	h.Binary(v.Data)
+	if v.refSecret() != nil {
+		h.Field(16)
+		h.String(*v.refSecret())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Sample so that it may be reused.
+func (v *Sample) Reset() {
+	*v = Sample{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Sample.
+func (v *Sample) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.refEnabled() != nil {
+		enc.AddBool("enabled", *v.refEnabled())
+	}
+	if v.refTiny() != nil {
+		enc.AddInt8("tiny", *v.refTiny())
+	}
+	if v.refSmall() != nil {
+		enc.AddInt16("small", *v.refSmall())
+	}
+	if v.refCount() != nil {
+		enc.AddInt32("count", *v.refCount())
+	}
+	if v.refTotal() != nil {
+		enc.AddInt64("total", *v.refTotal())
+	}
+	if v.refRatio() != nil {
+		enc.AddFloat64("ratio", *v.refRatio())
+	}
+	if v.refName() != nil {
+		enc.AddString("name", *v.refName())
+	}
+	if v.refLevel() != nil {
+		err = multierr.Append(err, enc.AddObject("level", *v.refLevel()))
+	}
+	if v.refLabel() != nil {
+		enc.AddString("label", (string)(*v.refLabel()))
+	}
+	if v.refRetries() != nil {
+		enc.AddInt32("retries", *v.refRetries())
+	}
+	if v.refToken() != nil {
+		enc.AddString("token", "[redacted]")
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	if v.Data != nil {
+		enc.AddString("data", base64.StdEncoding.EncodeToString(v.Data))
+	}
+	if v.refSecret() != nil {
+		enc.AddString("secret", *v.refSecret())
+	}
+	return err
+}
+
+// MarshalJSON serializes a Sample into JSON with the values of
+// its redacted fields replaced by "[redacted]", and
+// without its unset optional fields.
+//
+// This has a value receiver so that Sample values are redacted in
+// addition to pointers to them.
+func (v Sample) MarshalJSON() ([]byte, error) {
+	type plain Sample // without this method
+
+	redacted := "[redacted]"
+	r := struct {
+		*plain
+		Enabled *bool    `json:"enabled,omitempty"`
+		Tiny    *int8    `json:"tiny,omitempty"`
+		Small   *int16   `json:"small,omitempty"`
+		Count   *int32   `json:"count,omitempty"`
+		Total   *int64   `json:"total,omitempty"`
+		Ratio   *float64 `json:"ratio,omitempty"`
+		Name    *string  `json:"name,omitempty"`
+		Level   *Level   `json:"level,omitempty"`
+		Label   *Label   `json:"label,omitempty"`
+		Retries *int32   `json:"retries,omitempty"`
+		Token   *string  `json:"token,omitempty"`
+		Secret  *string  `json:"secret,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Enabled = v.refEnabled()
+	r.Tiny = v.refTiny()
+	r.Small = v.refSmall()
+	r.Count = v.refCount()
+	r.Total = v.refTotal()
+	r.Ratio = v.refRatio()
+	r.Name = v.refName()
+	r.Level = v.refLevel()
+	r.Label = v.refLabel()
+	r.Retries = v.refRetries()
+	if v.refToken() != nil {
+		r.Token = &redacted
+	}
+	r.Secret = v.refSecret()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Sample from JSON, recording which of
+// its optional fields are set.
+func (v *Sample) UnmarshalJSON(data []byte) error {
+	type plain Sample // without this method
+
+	r := struct {
+		*plain
+		Enabled *bool    `json:"enabled,omitempty"`
+		Tiny    *int8    `json:"tiny,omitempty"`
+		Small   *int16   `json:"small,omitempty"`
+		Count   *int32   `json:"count,omitempty"`
+		Total   *int64   `json:"total,omitempty"`
+		Ratio   *float64 `json:"ratio,omitempty"`
+		Name    *string  `json:"name,omitempty"`
+		Level   *Level   `json:"level,omitempty"`
+		Label   *Label   `json:"label,omitempty"`
+		Retries *int32   `json:"retries,omitempty"`
+		Token   *string  `json:"token,omitempty"`
+		Secret  *string  `json:"secret,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.Enabled != nil {
+		v.SetEnabled(*r.Enabled)
+	}
+	if r.Tiny != nil {
+		v.SetTiny(*r.Tiny)
+	}
+	if r.Small != nil {
+		v.SetSmall(*r.Small)
+	}
+	if r.Count != nil {
+		v.SetCount(*r.Count)
+	}
+	if r.Total != nil {
+		v.SetTotal(*r.Total)
+	}
+	if r.Ratio != nil {
+		v.SetRatio(*r.Ratio)
+	}
+	if r.Name != nil {
+		v.SetName(*r.Name)
+	}
+	if r.Level != nil {
+		v.SetLevel(*r.Level)
+	}
+	if r.Label != nil {
+		v.SetLabel(*r.Label)
+	}
+	if r.Retries != nil {
+		v.SetRetries(*r.Retries)
+	}
+	if r.Token != nil {
+		v.SetToken(*r.Token)
+	}
+	if r.Secret != nil {
+		v.SetSecret(*r.Secret)
+	}
+	return nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetEnabled returns the value of Enabled if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetEnabled() (o bool) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.Enabled
+	}
+
+	return
+}
+
+// IsSetEnabled returns true if Enabled is set.
+func (v *Sample) IsSetEnabled() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasEnabled returns true if Enabled is set.
+func (v *Sample) HasEnabled() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearEnabled unsets Enabled.
+func (v *Sample) ClearEnabled() {
+	var x bool
+	v.Enabled = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refEnabled returns a pointer to Enabled if it is set, and
+// nil otherwise.
+func (v *Sample) refEnabled() *bool {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.Enabled
+	}
+	return nil
+}
+
+// SetEnabled sets the value of Enabled.
+func (v *Sample) SetEnabled(x bool) {
+	v.Enabled = x
+	v.presence[0] |= (1 << 0)
+}
+
+// GetTiny returns the value of Tiny if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetTiny() (o int8) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.Tiny
+	}
+
+	return
+}
+
+// IsSetTiny returns true if Tiny is set.
+func (v *Sample) IsSetTiny() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// HasTiny returns true if Tiny is set.
+func (v *Sample) HasTiny() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// ClearTiny unsets Tiny.
+func (v *Sample) ClearTiny() {
+	var x int8
+	v.Tiny = x
+	v.presence[0] &^= (1 << 1)
+}
+
+// refTiny returns a pointer to Tiny if it is set, and
+// nil otherwise.
+func (v *Sample) refTiny() *int8 {
+	if v.presence[0]&(1<<1) != 0 {
+		return &v.Tiny
+	}
+	return nil
+}
+
+// SetTiny sets the value of Tiny.
+func (v *Sample) SetTiny(x int8) {
+	v.Tiny = x
+	v.presence[0] |= (1 << 1)
+}
+
+// GetSmall returns the value of Small if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetSmall() (o int16) {
+	if v != nil && v.presence[0]&(1<<2) != 0 {
+		return v.Small
+	}
+
+	return
+}
+
+// IsSetSmall returns true if Small is set.
+func (v *Sample) IsSetSmall() bool {
+	return v != nil && v.presence[0]&(1<<2) != 0
+}
+
+// HasSmall returns true if Small is set.
+func (v *Sample) HasSmall() bool {
+	return v != nil && v.presence[0]&(1<<2) != 0
+}
+
+// ClearSmall unsets Small.
+func (v *Sample) ClearSmall() {
+	var x int16
+	v.Small = x
+	v.presence[0] &^= (1 << 2)
+}
+
+// refSmall returns a pointer to Small if it is set, and
+// nil otherwise.
+func (v *Sample) refSmall() *int16 {
+	if v.presence[0]&(1<<2) != 0 {
+		return &v.Small
+	}
+	return nil
+}
+
+// SetSmall sets the value of Small.
+func (v *Sample) SetSmall(x int16) {
+	v.Small = x
+	v.presence[0] |= (1 << 2)
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetCount() (o int32) {
+	if v != nil && v.presence[0]&(1<<3) != 0 {
+		return v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is set.
+func (v *Sample) IsSetCount() bool {
+	return v != nil && v.presence[0]&(1<<3) != 0
+}
+
+// HasCount returns true if Count is set.
+func (v *Sample) HasCount() bool {
+	return v != nil && v.presence[0]&(1<<3) != 0
+}
+
+// ClearCount unsets Count.
+func (v *Sample) ClearCount() {
+	var x int32
+	v.Count = x
+	v.presence[0] &^= (1 << 3)
+}
+
+// refCount returns a pointer to Count if it is set, and
+// nil otherwise.
+func (v *Sample) refCount() *int32 {
+	if v.presence[0]&(1<<3) != 0 {
+		return &v.Count
+	}
+	return nil
+}
+
+// SetCount sets the value of Count.
+func (v *Sample) SetCount(x int32) {
+	v.Count = x
+	v.presence[0] |= (1 << 3)
+}
+
+// GetTotal returns the value of Total if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetTotal() (o int64) {
+	if v != nil && v.presence[0]&(1<<4) != 0 {
+		return v.Total
+	}
+
+	return
+}
+
+// IsSetTotal returns true if Total is set.
+func (v *Sample) IsSetTotal() bool {
+	return v != nil && v.presence[0]&(1<<4) != 0
+}
+
+// HasTotal returns true if Total is set.
+func (v *Sample) HasTotal() bool {
+	return v != nil && v.presence[0]&(1<<4) != 0
+}
+
+// ClearTotal unsets Total.
+func (v *Sample) ClearTotal() {
+	var x int64
+	v.Total = x
+	v.presence[0] &^= (1 << 4)
+}
+
+// refTotal returns a pointer to Total if it is set, and
+// nil otherwise.
+func (v *Sample) refTotal() *int64 {
+	if v.presence[0]&(1<<4) != 0 {
+		return &v.Total
+	}
+	return nil
+}
+
+// SetTotal sets the value of Total.
+func (v *Sample) SetTotal(x int64) {
+	v.Total = x
+	v.presence[0] |= (1 << 4)
+}
+
+// GetRatio returns the value of Ratio if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetRatio() (o float64) {
+	if v != nil && v.presence[0]&(1<<5) != 0 {
+		return v.Ratio
+	}
+
+	return
+}
+
+// IsSetRatio returns true if Ratio is set.
+func (v *Sample) IsSetRatio() bool {
+	return v != nil && v.presence[0]&(1<<5) != 0
+}
+
+// HasRatio returns true if Ratio is set.
+func (v *Sample) HasRatio() bool {
+	return v != nil && v.presence[0]&(1<<5) != 0
+}
+
+// ClearRatio unsets Ratio.
+func (v *Sample) ClearRatio() {
+	var x float64
+	v.Ratio = x
+	v.presence[0] &^= (1 << 5)
+}
+
+// refRatio returns a pointer to Ratio if it is set, and
+// nil otherwise.
+func (v *Sample) refRatio() *float64 {
+	if v.presence[0]&(1<<5) != 0 {
+		return &v.Ratio
+	}
+	return nil
+}
+
+// SetRatio sets the value of Ratio.
+func (v *Sample) SetRatio(x float64) {
+	v.Ratio = x
+	v.presence[0] |= (1 << 5)
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetName() (o string) {
+	if v != nil && v.presence[0]&(1<<6) != 0 {
+		return v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is set.
+func (v *Sample) IsSetName() bool {
+	return v != nil && v.presence[0]&(1<<6) != 0
+}
+
+// HasName returns true if Name is set.
+func (v *Sample) HasName() bool {
+	return v != nil && v.presence[0]&(1<<6) != 0
+}
+
+// ClearName unsets Name.
+func (v *Sample) ClearName() {
+	var x string
+	v.Name = x
+	v.presence[0] &^= (1 << 6)
+}
+
+// refName returns a pointer to Name if it is set, and
+// nil otherwise.
+func (v *Sample) refName() *string {
+	if v.presence[0]&(1<<6) != 0 {
+		return &v.Name
+	}
+	return nil
+}
+
+// SetName sets the value of Name.
+func (v *Sample) SetName(x string) {
+	v.Name = x
+	v.presence[0] |= (1 << 6)
+}
+
+// GetLevel returns the value of Level if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetLevel() (o Level) {
+	if v != nil && v.presence[0]&(1<<7) != 0 {
+		return v.Level
+	}
+
+	return
+}
+
+// IsSetLevel returns true if Level is set.
+func (v *Sample) IsSetLevel() bool {
+	return v != nil && v.presence[0]&(1<<7) != 0
+}
+
+// HasLevel returns true if Level is set.
+func (v *Sample) HasLevel() bool {
+	return v != nil && v.presence[0]&(1<<7) != 0
+}
+
+// ClearLevel unsets Level.
+func (v *Sample) ClearLevel() {
+	var x Level
+	v.Level = x
+	v.presence[0] &^= (1 << 7)
+}
+
+// refLevel returns a pointer to Level if it is set, and
+// nil otherwise.
+func (v *Sample) refLevel() *Level {
+	if v.presence[0]&(1<<7) != 0 {
+		return &v.Level
+	}
+	return nil
+}
+
+// SetLevel sets the value of Level.
+func (v *Sample) SetLevel(x Level) {
+	v.Level = x
+	v.presence[0] |= (1 << 7)
+}
+
+// GetLabel returns the value of Label if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetLabel() (o Label) {
+	if v != nil && v.presence[0]&(1<<8) != 0 {
+		return v.Label
+	}
+
+	return
+}
+
+// IsSetLabel returns true if Label is set.
+func (v *Sample) IsSetLabel() bool {
+	return v != nil && v.presence[0]&(1<<8) != 0
+}
+
+// HasLabel returns true if Label is set.
+func (v *Sample) HasLabel() bool {
+	return v != nil && v.presence[0]&(1<<8) != 0
+}
+
+// ClearLabel unsets Label.
+func (v *Sample) ClearLabel() {
+	var x Label
+	v.Label = x
+	v.presence[0] &^= (1 << 8)
+}
+
+// refLabel returns a pointer to Label if it is set, and
+// nil otherwise.
+func (v *Sample) refLabel() *Label {
+	if v.presence[0]&(1<<8) != 0 {
+		return &v.Label
+	}
+	return nil
+}
+
+// SetLabel sets the value of Label.
+func (v *Sample) SetLabel(x Label) {
+	v.Label = x
+	v.presence[0] |= (1 << 8)
+}
+
+// GetRetries returns the value of Retries if it is set or its
+// default value if it is unset.
+func (v *Sample) GetRetries() (o int32) {
+	if v != nil && v.presence[0]&(1<<9) != 0 {
+		return v.Retries
+	}
+	o = 3
+	return
+}
+
+// IsSetRetries returns true if Retries is set.
+func (v *Sample) IsSetRetries() bool {
+	return v != nil && v.presence[0]&(1<<9) != 0
+}
+
+// HasRetries returns true if Retries is set.
+func (v *Sample) HasRetries() bool {
+	return v != nil && v.presence[0]&(1<<9) != 0
+}
+
+// ClearRetries unsets Retries.
+func (v *Sample) ClearRetries() {
+	var x int32
+	v.Retries = x
+	v.presence[0] &^= (1 << 9)
+}
+
+// refRetries returns a pointer to Retries if it is set, and
+// nil otherwise.
+func (v *Sample) refRetries() *int32 {
+	if v.presence[0]&(1<<9) != 0 {
+		return &v.Retries
+	}
+	return nil
+}
+
+// SetRetries sets the value of Retries.
+func (v *Sample) SetRetries(x int32) {
+	v.Retries = x
+	v.presence[0] |= (1 << 9)
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetToken() (o string) {
+	if v != nil && v.presence[0]&(1<<10) != 0 {
+		return v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is set.
+func (v *Sample) IsSetToken() bool {
+	return v != nil && v.presence[0]&(1<<10) != 0
+}
+
+// HasToken returns true if Token is set.
+func (v *Sample) HasToken() bool {
+	return v != nil && v.presence[0]&(1<<10) != 0
+}
+
+// ClearToken unsets Token.
+func (v *Sample) ClearToken() {
+	var x string
+	v.Token = x
+	v.presence[0] &^= (1 << 10)
+}
+
+// refToken returns a pointer to Token if it is set, and
+// nil otherwise.
+func (v *Sample) refToken() *string {
+	if v.presence[0]&(1<<10) != 0 {
+		return &v.Token
+	}
+	return nil
+}
+
+// SetToken sets the value of Token.
+func (v *Sample) SetToken(x string) {
+	v.Token = x
+	v.presence[0] |= (1 << 10)
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Sample) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Sample) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetData() (o []byte) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
+}
+
+// IsSetData returns true if Data is not nil.
+func (v *Sample) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *Sample) GetSecret() (o string) {
+	if v != nil && v.presence[0]&(1<<11) != 0 {
+		return v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is set.
+func (v *Sample) IsSetSecret() bool {
+	return v != nil && v.presence[0]&(1<<11) != 0
+}
+
+// HasSecret returns true if Secret is set.
+func (v *Sample) HasSecret() bool {
+	return v != nil && v.presence[0]&(1<<11) != 0
+}
+
+// ClearSecret unsets Secret.
+func (v *Sample) ClearSecret() {
+	var x string
+	v.Secret = x
+	v.presence[0] &^= (1 << 11)
+}
+
+// refSecret returns a pointer to Secret if it is set, and
+// nil otherwise.
+func (v *Sample) refSecret() *string {
+	if v.presence[0]&(1<<11) != 0 {
+		return &v.Secret
+	}
+	return nil
+}
+
+// SetSecret sets the value of Secret.
+func (v *Sample) SetSecret(x string) {
+	v.Secret = x
+	v.presence[0] |= (1 << 11)
+}
+
+// Validate returns an error if this Sample does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *Sample) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	if v.refCount() != nil && *v.refCount() < 0 {
+		return &validate.FieldError{
+			TypeName: "Sample",
+			Field:    "count",
+			Reason:   "must be at least 0",
+		}
+	}
+	if v.refCount() != nil && *v.refCount() > 100 {
+		return &validate.FieldError{
+			TypeName: "Sample",
+			Field:    "count",
+			Reason:   "must be at most 100",
+		}
+	}
+
+	this := make(map[string]interface{}, 16)
+	this["id"] = v.ID
+	if v.refEnabled() != nil {
+		this["enabled"] = *v.refEnabled()
+	}
+	if v.refTiny() != nil {
+		this["tiny"] = *v.refTiny()
+	}
+	if v.refSmall() != nil {
+		this["small"] = *v.refSmall()
+	}
+	if v.refCount() != nil {
+		this["count"] = *v.refCount()
+	}
+	if v.refTotal() != nil {
+		this["total"] = *v.refTotal()
+	}
+	if v.refRatio() != nil {
+		this["ratio"] = *v.refRatio()
+	}
+	if v.refName() != nil {
+		this["name"] = *v.refName()
+	}
+	if v.refLevel() != nil {
+		this["level"] = *v.refLevel()
+	}
+	if v.refLabel() != nil {
+		this["label"] = *v.refLabel()
+	}
+	if v.refRetries() != nil {
+		this["retries"] = *v.refRetries()
+	}
+	if v.refToken() != nil {
+		this["token"] = *v.refToken()
+	}
+	if v.Point != nil {
+		this["point"] = v.Point
+	}
+	if v.Tags != nil {
+		this["tags"] = v.Tags
+	}
+	if v.Data != nil {
+		this["data"] = v.Data
+	}
+	if v.refSecret() != nil {
+		this["secret"] = *v.refSecret()
+	}
+
+	if err := validate.CEL("Sample", "!has(this.total) || this.total >= 0", this); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StripInternal returns a copy of this Sample with its fields
+// annotated with (visibility = "internal") cleared, including those
+// of the structs nested in it. Use it on values leaving internal
+// services.
+func (v *Sample) StripInternal() *Sample {
+	if v == nil {
+		return nil
+	}
+
+	o := v.Copy()
+	o.ClearSecret()
+	return o
+}
+
+// SampleBuilder builds Sample values with chained setters. Use
+// NewSampleBuilder to construct one.
+type SampleBuilder struct {
+	v     Sample
+	hasID bool
+}
+
+// NewSampleBuilder returns a builder for Sample values with
+// fields set to the default values defined for them in the Thrift file.
+func NewSampleBuilder() *SampleBuilder {
+	return &SampleBuilder{v: *Default_Sample()}
+}
+
+// ID sets the ID field of the Sample.
+func (b *SampleBuilder) ID(value string) *SampleBuilder {
+	b.v.ID = value
+	b.hasID = true
+	return b
+}
+
+// Enabled sets the Enabled field of the Sample.
+func (b *SampleBuilder) Enabled(value bool) *SampleBuilder {
+	b.v.SetEnabled(value)
+	return b
+}
+
+// Tiny sets the Tiny field of the Sample.
+func (b *SampleBuilder) Tiny(value int8) *SampleBuilder {
+	b.v.SetTiny(value)
+	return b
+}
+
+// Small sets the Small field of the Sample.
+func (b *SampleBuilder) Small(value int16) *SampleBuilder {
+	b.v.SetSmall(value)
+	return b
+}
+
+// Count sets the Count field of the Sample.
+func (b *SampleBuilder) Count(value int32) *SampleBuilder {
+	b.v.SetCount(value)
+	return b
+}
+
+// Total sets the Total field of the Sample.
+func (b *SampleBuilder) Total(value int64) *SampleBuilder {
+	b.v.SetTotal(value)
+	return b
+}
+
+// Ratio sets the Ratio field of the Sample.
+func (b *SampleBuilder) Ratio(value float64) *SampleBuilder {
+	b.v.SetRatio(value)
+	return b
+}
+
+// Name sets the Name field of the Sample.
+func (b *SampleBuilder) Name(value string) *SampleBuilder {
+	b.v.SetName(value)
+	return b
+}
+
+// Level sets the Level field of the Sample.
+func (b *SampleBuilder) Level(value Level) *SampleBuilder {
+	b.v.SetLevel(value)
+	return b
+}
+
+// Label sets the Label field of the Sample.
+func (b *SampleBuilder) Label(value Label) *SampleBuilder {
+	b.v.SetLabel(value)
+	return b
+}
+
+// Retries sets the Retries field of the Sample.
+func (b *SampleBuilder) Retries(value int32) *SampleBuilder {
+	b.v.SetRetries(value)
+	return b
+}
+
+// Token sets the Token field of the Sample.
+func (b *SampleBuilder) Token(value string) *SampleBuilder {
+	b.v.SetToken(value)
+	return b
+}
+
+// Point sets the Point field of the Sample.
+func (b *SampleBuilder) Point(value *Point) *SampleBuilder {
+	b.v.Point = value
+	return b
+}
+
+// Tags sets the Tags field of the Sample.
+func (b *SampleBuilder) Tags(value []string) *SampleBuilder {
+	b.v.Tags = value
+	return b
+}
+
+// Data sets the Data field of the Sample.
+func (b *SampleBuilder) Data(value []byte) *SampleBuilder {
+	b.v.Data = value
+	return b
+}
+
+// Secret sets the Secret field of the Sample.
+func (b *SampleBuilder) Secret(value string) *SampleBuilder {
+	b.v.SetSecret(value)
+	return b
+}
+
+// Build returns the Sample built so far.
+//
+// An error is returned if a required field was not set.
+func (b *SampleBuilder) Build() (*Sample, error) {
+	if !b.hasID {
+		return nil, errors.New("field ID of Sample is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+// Sample_Lazy provides access to the fields of a Thrift Binary Protocol
+// encoded Sample, decoding each field only when it is first
+// accessed. Decoded fields are retained until the next call to Reset.
+//
+// Sample_Lazy is not safe for concurrent use.
+type Sample_Lazy struct {
+	raw     binary.LazyStruct
+	v       Sample
+	decoded [16]bool
+}
+
+// Reset discards all decoded fields and starts reading from raw,
+// which holds a Thrift Binary Protocol encoded Sample. raw must
+// not be modified while it is in use.
+func (v *Sample_Lazy) Reset(raw []byte) {
+	v.raw.Reset(raw)
+	v.v = Sample{}
+	v.decoded = [16]bool{}
+}
+
+// Bytes returns the encoded Sample.
+func (v *Sample_Lazy) Bytes() []byte {
+	return v.raw.Bytes()
+}
+
+// Struct decodes all fields into a new Sample.
+func (v *Sample_Lazy) Struct() (*Sample, error) {
+	var x Sample
+	sr := binary.NewStreamReader(bytes.NewReader(v.raw.Bytes()))
+	defer sr.Close()
+	err := x.Decode(sr)
+	return &x, err
+}
+
+// GetID returns the value of ID, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetID() (o string, err error) {
+	if err = v.decode(0); err == nil {
+		o = v.v.GetID()
+	}
+	return
+}
+
+// GetEnabled returns the value of Enabled, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetEnabled() (o bool, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.GetEnabled()
+	}
+	return
+}
+
+// IsSetEnabled returns true if Enabled is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetEnabled() (o bool, err error) {
+	if err = v.decode(1); err == nil {
+		o = v.v.IsSetEnabled()
+	}
+	return
+}
+
+// GetTiny returns the value of Tiny, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetTiny() (o int8, err error) {
+	if err = v.decode(2); err == nil {
+		o = v.v.GetTiny()
+	}
+	return
+}
+
+// IsSetTiny returns true if Tiny is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetTiny() (o bool, err error) {
+	if err = v.decode(2); err == nil {
+		o = v.v.IsSetTiny()
+	}
+	return
+}
+
+// GetSmall returns the value of Small, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetSmall() (o int16, err error) {
+	if err = v.decode(3); err == nil {
+		o = v.v.GetSmall()
+	}
+	return
+}
+
+// IsSetSmall returns true if Small is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetSmall() (o bool, err error) {
+	if err = v.decode(3); err == nil {
+		o = v.v.IsSetSmall()
+	}
+	return
+}
+
+// GetCount returns the value of Count, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetCount() (o int32, err error) {
+	if err = v.decode(4); err == nil {
+		o = v.v.GetCount()
+	}
+	return
+}
+
+// IsSetCount returns true if Count is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetCount() (o bool, err error) {
+	if err = v.decode(4); err == nil {
+		o = v.v.IsSetCount()
+	}
+	return
+}
+
+// GetTotal returns the value of Total, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetTotal() (o int64, err error) {
+	if err = v.decode(5); err == nil {
+		o = v.v.GetTotal()
+	}
+	return
+}
+
+// IsSetTotal returns true if Total is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetTotal() (o bool, err error) {
+	if err = v.decode(5); err == nil {
+		o = v.v.IsSetTotal()
+	}
+	return
+}
+
+// GetRatio returns the value of Ratio, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetRatio() (o float64, err error) {
+	if err = v.decode(6); err == nil {
+		o = v.v.GetRatio()
+	}
+	return
+}
+
+// IsSetRatio returns true if Ratio is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetRatio() (o bool, err error) {
+	if err = v.decode(6); err == nil {
+		o = v.v.IsSetRatio()
+	}
+	return
+}
+
+// GetName returns the value of Name, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetName() (o string, err error) {
+	if err = v.decode(7); err == nil {
+		o = v.v.GetName()
+	}
+	return
+}
+
+// IsSetName returns true if Name is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetName() (o bool, err error) {
+	if err = v.decode(7); err == nil {
+		o = v.v.IsSetName()
+	}
+	return
+}
+
+// GetLevel returns the value of Level, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetLevel() (o Level, err error) {
+	if err = v.decode(8); err == nil {
+		o = v.v.GetLevel()
+	}
+	return
+}
+
+// IsSetLevel returns true if Level is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetLevel() (o bool, err error) {
+	if err = v.decode(8); err == nil {
+		o = v.v.IsSetLevel()
+	}
+	return
+}
+
+// GetLabel returns the value of Label, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetLabel() (o Label, err error) {
+	if err = v.decode(9); err == nil {
+		o = v.v.GetLabel()
+	}
+	return
+}
+
+// IsSetLabel returns true if Label is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetLabel() (o bool, err error) {
+	if err = v.decode(9); err == nil {
+		o = v.v.IsSetLabel()
+	}
+	return
+}
+
+// GetRetries returns the value of Retries, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetRetries() (o int32, err error) {
+	if err = v.decode(10); err == nil {
+		o = v.v.GetRetries()
+	}
+	return
+}
+
+// IsSetRetries returns true if Retries is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetRetries() (o bool, err error) {
+	if err = v.decode(10); err == nil {
+		o = v.v.IsSetRetries()
+	}
+	return
+}
+
+// GetToken returns the value of Token, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetToken() (o string, err error) {
+	if err = v.decode(11); err == nil {
+		o = v.v.GetToken()
+	}
+	return
+}
+
+// IsSetToken returns true if Token is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetToken() (o bool, err error) {
+	if err = v.decode(11); err == nil {
+		o = v.v.IsSetToken()
+	}
+	return
+}
+
+// GetPoint returns the value of Point, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetPoint() (o *Point, err error) {
+	if err = v.decode(12); err == nil {
+		o = v.v.GetPoint()
+	}
+	return
+}
+
+// IsSetPoint returns true if Point is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetPoint() (o bool, err error) {
+	if err = v.decode(12); err == nil {
+		o = v.v.IsSetPoint()
+	}
+	return
+}
+
+// GetTags returns the value of Tags, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetTags() (o []string, err error) {
+	if err = v.decode(13); err == nil {
+		o = v.v.GetTags()
+	}
+	return
+}
+
+// IsSetTags returns true if Tags is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetTags() (o bool, err error) {
+	if err = v.decode(13); err == nil {
+		o = v.v.IsSetTags()
+	}
+	return
+}
+
+// GetData returns the value of Data, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetData() (o []byte, err error) {
+	if err = v.decode(14); err == nil {
+		o = v.v.GetData()
+	}
+	return
+}
+
+// IsSetData returns true if Data is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetData() (o bool, err error) {
+	if err = v.decode(14); err == nil {
+		o = v.v.IsSetData()
+	}
+	return
+}
+
+// GetSecret returns the value of Secret, decoding it if it
+// has not been decoded yet.
+func (v *Sample_Lazy) GetSecret() (o string, err error) {
+	if err = v.decode(15); err == nil {
+		o = v.v.GetSecret()
+	}
+	return
+}
+
+// IsSetSecret returns true if Secret is set, decoding it
+// if it has not been decoded yet.
+func (v *Sample_Lazy) IsSetSecret() (o bool, err error) {
+	if err = v.decode(15); err == nil {
+		o = v.v.IsSetSecret()
+	}
+	return
+}
+
+// decode decodes the field at the given index if it has not been
+// decoded yet.
+func (v *Sample_Lazy) decode(i int) error {
+	if v.decoded[i] {
+		return nil
+	}
+
+	var (
+		fr  stream.Reader
+		ok  bool
+		err error
+	)
+	switch i {
+	case 0:
+		fr, ok, err = v.raw.Field(1, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.ID, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		} else {
+			return errors.New("field ID of Sample is required")
+		}
+	case 1:
+		fr, ok, err = v.raw.Field(2, wire.TBool)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Enabled, err = fr.ReadBool()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 0)
+		}
+	case 2:
+		fr, ok, err = v.raw.Field(3, wire.TI8)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Tiny, err = fr.ReadInt8()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 1)
+		}
+	case 3:
+		fr, ok, err = v.raw.Field(4, wire.TI16)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Small, err = fr.ReadInt16()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 2)
+		}
+	case 4:
+		fr, ok, err = v.raw.Field(5, wire.TI32)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Count, err = fr.ReadInt32()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 3)
+		}
+	case 5:
+		fr, ok, err = v.raw.Field(6, wire.TI64)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Total, err = fr.ReadInt64()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 4)
+		}
+	case 6:
+		fr, ok, err = v.raw.Field(7, wire.TDouble)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Ratio, err = fr.ReadDouble()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 5)
+		}
+	case 7:
+		fr, ok, err = v.raw.Field(8, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Name, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 6)
+		}
+	case 8:
+		fr, ok, err = v.raw.Field(9, wire.TI32)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Level, err = _Level_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 7)
+		}
+	case 9:
+		fr, ok, err = v.raw.Field(10, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Label, err = _Label_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 8)
+		}
+	case 10:
+		fr, ok, err = v.raw.Field(11, wire.TI32)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Retries, err = fr.ReadInt32()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 9)
+		} else {
+			v.v.Retries = 3
+			v.v.presence[0] |= (1 << 9)
+		}
+	case 11:
+		fr, ok, err = v.raw.Field(12, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Token, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 10)
+		}
+	case 12:
+		fr, ok, err = v.raw.Field(13, wire.TStruct)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Point, err = _Point_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 13:
+		fr, ok, err = v.raw.Field(14, wire.TList)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Tags, err = _List_String_Decode(fr)
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 14:
+		fr, ok, err = v.raw.Field(15, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Data, err = fr.ReadBinary()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+		}
+	case 15:
+		fr, ok, err = v.raw.Field(16, wire.TBinary)
+		if err != nil {
+			return err
+		}
+		if ok {
+			v.v.Secret, err = fr.ReadString()
+			fr.Close()
+			if err != nil {
+				return err
+			}
+			v.v.presence[0] |= (1 << 11)
+		}
+	}
+
+	v.decoded[i] = true
+	return nil
+}
+
+type Wide struct {
+	F1  int32 `json:"f1,omitempty"`
+	F2  int32 `json:"f2,omitempty"`
+	F3  int32 `json:"f3,omitempty"`
+	F4  int32 `json:"f4,omitempty"`
+	F5  int32 `json:"f5,omitempty"`
+	F6  int32 `json:"f6,omitempty"`
+	F7  int32 `json:"f7,omitempty"`
+	F8  int32 `json:"f8,omitempty"`
+	F9  int32 `json:"f9,omitempty"`
+	F10 int32 `json:"f10,omitempty"`
+	F11 int32 `json:"f11,omitempty"`
+	F12 int32 `json:"f12,omitempty"`
+	F13 int32 `json:"f13,omitempty"`
+	F14 int32 `json:"f14,omitempty"`
+	F15 int32 `json:"f15,omitempty"`
+	F16 int32 `json:"f16,omitempty"`
+	F17 int32 `json:"f17,omitempty"`
+	F18 int32 `json:"f18,omitempty"`
+	F19 int32 `json:"f19,omitempty"`
+	F20 int32 `json:"f20,omitempty"`
+	F21 int32 `json:"f21,omitempty"`
+	F22 int32 `json:"f22,omitempty"`
+	F23 int32 `json:"f23,omitempty"`
+	F24 int32 `json:"f24,omitempty"`
+	F25 int32 `json:"f25,omitempty"`
+	F26 int32 `json:"f26,omitempty"`
+	F27 int32 `json:"f27,omitempty"`
+	F28 int32 `json:"f28,omitempty"`
+	F29 int32 `json:"f29,omitempty"`
+	F30 int32 `json:"f30,omitempty"`
+	F31 int32 `json:"f31,omitempty"`
+	F32 int32 `json:"f32,omitempty"`
+	F33 int32 `json:"f33,omitempty"`
+	F34 int32 `json:"f34,omitempty"`
+	F35 int32 `json:"f35,omitempty"`
+	F36 int32 `json:"f36,omitempty"`
+	F37 int32 `json:"f37,omitempty"`
+	F38 int32 `json:"f38,omitempty"`
+	F39 int32 `json:"f39,omitempty"`
+	F40 int32 `json:"f40,omitempty"`
+	F41 int32 `json:"f41,omitempty"`
+	F42 int32 `json:"f42,omitempty"`
+	F43 int32 `json:"f43,omitempty"`
+	F44 int32 `json:"f44,omitempty"`
+	F45 int32 `json:"f45,omitempty"`
+	F46 int32 `json:"f46,omitempty"`
+	F47 int32 `json:"f47,omitempty"`
+	F48 int32 `json:"f48,omitempty"`
+	F49 int32 `json:"f49,omitempty"`
+	F50 int32 `json:"f50,omitempty"`
+	F51 int32 `json:"f51,omitempty"`
+	F52 int32 `json:"f52,omitempty"`
+	F53 int32 `json:"f53,omitempty"`
+	F54 int32 `json:"f54,omitempty"`
+	F55 int32 `json:"f55,omitempty"`
+	F56 int32 `json:"f56,omitempty"`
+	F57 int32 `json:"f57,omitempty"`
+	F58 int32 `json:"f58,omitempty"`
+	F59 int32 `json:"f59,omitempty"`
+	F60 int32 `json:"f60,omitempty"`
+	F61 int32 `json:"f61,omitempty"`
+	F62 int32 `json:"f62,omitempty"`
+	F63 int32 `json:"f63,omitempty"`
+	F64 int32 `json:"f64,omitempty"`
+	F65 int32 `json:"f65,omitempty"`
+	F66 int32 `json:"f66,omitempty"`
+
+	presence [2]uint64
+}
+
+// ToWire translates a Wide struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Wide) ToWire() (wire.Value, error) {
+	var (
+		fields [66]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.refF1() != nil {
+		w, err = wire.NewValueI32(*(v.refF1())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.refF2() != nil {
+		w, err = wire.NewValueI32(*(v.refF2())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.refF3() != nil {
+		w, err = wire.NewValueI32(*(v.refF3())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.refF4() != nil {
+		w, err = wire.NewValueI32(*(v.refF4())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.refF5() != nil {
+		w, err = wire.NewValueI32(*(v.refF5())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.refF6() != nil {
+		w, err = wire.NewValueI32(*(v.refF6())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.refF7() != nil {
+		w, err = wire.NewValueI32(*(v.refF7())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.refF8() != nil {
+		w, err = wire.NewValueI32(*(v.refF8())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.refF9() != nil {
+		w, err = wire.NewValueI32(*(v.refF9())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.refF10() != nil {
+		w, err = wire.NewValueI32(*(v.refF10())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.refF11() != nil {
+		w, err = wire.NewValueI32(*(v.refF11())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.refF12() != nil {
+		w, err = wire.NewValueI32(*(v.refF12())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.refF13() != nil {
+		w, err = wire.NewValueI32(*(v.refF13())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 13, Value: w}
+		i++
+	}
+	if v.refF14() != nil {
+		w, err = wire.NewValueI32(*(v.refF14())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+	if v.refF15() != nil {
+		w, err = wire.NewValueI32(*(v.refF15())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.refF16() != nil {
+		w, err = wire.NewValueI32(*(v.refF16())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+	if v.refF17() != nil {
+		w, err = wire.NewValueI32(*(v.refF17())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 17, Value: w}
+		i++
+	}
+	if v.refF18() != nil {
+		w, err = wire.NewValueI32(*(v.refF18())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.refF19() != nil {
+		w, err = wire.NewValueI32(*(v.refF19())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 19, Value: w}
+		i++
+	}
+	if v.refF20() != nil {
+		w, err = wire.NewValueI32(*(v.refF20())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.refF21() != nil {
+		w, err = wire.NewValueI32(*(v.refF21())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 21, Value: w}
+		i++
+	}
+	if v.refF22() != nil {
+		w, err = wire.NewValueI32(*(v.refF22())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
+	if v.refF23() != nil {
+		w, err = wire.NewValueI32(*(v.refF23())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 23, Value: w}
+		i++
+	}
+	if v.refF24() != nil {
+		w, err = wire.NewValueI32(*(v.refF24())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 24, Value: w}
+		i++
+	}
+	if v.refF25() != nil {
+		w, err = wire.NewValueI32(*(v.refF25())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 25, Value: w}
+		i++
+	}
+	if v.refF26() != nil {
+		w, err = wire.NewValueI32(*(v.refF26())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 26, Value: w}
+		i++
+	}
+	if v.refF27() != nil {
+		w, err = wire.NewValueI32(*(v.refF27())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 27, Value: w}
+		i++
+	}
+	if v.refF28() != nil {
+		w, err = wire.NewValueI32(*(v.refF28())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 28, Value: w}
+		i++
+	}
+	if v.refF29() != nil {
+		w, err = wire.NewValueI32(*(v.refF29())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 29, Value: w}
+		i++
+	}
+	if v.refF30() != nil {
+		w, err = wire.NewValueI32(*(v.refF30())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.refF31() != nil {
+		w, err = wire.NewValueI32(*(v.refF31())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 31, Value: w}
+		i++
+	}
+	if v.refF32() != nil {
+		w, err = wire.NewValueI32(*(v.refF32())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 32, Value: w}
+		i++
+	}
+	if v.refF33() != nil {
+		w, err = wire.NewValueI32(*(v.refF33())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 33, Value: w}
+		i++
+	}
+	if v.refF34() != nil {
+		w, err = wire.NewValueI32(*(v.refF34())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 34, Value: w}
+		i++
+	}
+	if v.refF35() != nil {
+		w, err = wire.NewValueI32(*(v.refF35())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
+		i++
+	}
+	if v.refF36() != nil {
+		w, err = wire.NewValueI32(*(v.refF36())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 36, Value: w}
+		i++
+	}
+	if v.refF37() != nil {
+		w, err = wire.NewValueI32(*(v.refF37())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 37, Value: w}
+		i++
+	}
+	if v.refF38() != nil {
+		w, err = wire.NewValueI32(*(v.refF38())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 38, Value: w}
+		i++
+	}
+	if v.refF39() != nil {
+		w, err = wire.NewValueI32(*(v.refF39())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 39, Value: w}
+		i++
+	}
+	if v.refF40() != nil {
+		w, err = wire.NewValueI32(*(v.refF40())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.refF41() != nil {
+		w, err = wire.NewValueI32(*(v.refF41())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 41, Value: w}
+		i++
+	}
+	if v.refF42() != nil {
+		w, err = wire.NewValueI32(*(v.refF42())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 42, Value: w}
+		i++
+	}
+	if v.refF43() != nil {
+		w, err = wire.NewValueI32(*(v.refF43())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 43, Value: w}
+		i++
+	}
+	if v.refF44() != nil {
+		w, err = wire.NewValueI32(*(v.refF44())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 44, Value: w}
+		i++
+	}
+	if v.refF45() != nil {
+		w, err = wire.NewValueI32(*(v.refF45())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.refF46() != nil {
+		w, err = wire.NewValueI32(*(v.refF46())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 46, Value: w}
+		i++
+	}
+	if v.refF47() != nil {
+		w, err = wire.NewValueI32(*(v.refF47())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 47, Value: w}
+		i++
+	}
+	if v.refF48() != nil {
+		w, err = wire.NewValueI32(*(v.refF48())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 48, Value: w}
+		i++
+	}
+	if v.refF49() != nil {
+		w, err = wire.NewValueI32(*(v.refF49())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 49, Value: w}
+		i++
+	}
+	if v.refF50() != nil {
+		w, err = wire.NewValueI32(*(v.refF50())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.refF51() != nil {
+		w, err = wire.NewValueI32(*(v.refF51())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 51, Value: w}
+		i++
+	}
+	if v.refF52() != nil {
+		w, err = wire.NewValueI32(*(v.refF52())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 52, Value: w}
+		i++
+	}
+	if v.refF53() != nil {
+		w, err = wire.NewValueI32(*(v.refF53())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 53, Value: w}
+		i++
+	}
+	if v.refF54() != nil {
+		w, err = wire.NewValueI32(*(v.refF54())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 54, Value: w}
+		i++
+	}
+	if v.refF55() != nil {
+		w, err = wire.NewValueI32(*(v.refF55())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 55, Value: w}
+		i++
+	}
+	if v.refF56() != nil {
+		w, err = wire.NewValueI32(*(v.refF56())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
+	if v.refF57() != nil {
+		w, err = wire.NewValueI32(*(v.refF57())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 57, Value: w}
+		i++
+	}
+	if v.refF58() != nil {
+		w, err = wire.NewValueI32(*(v.refF58())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 58, Value: w}
+		i++
+	}
+	if v.refF59() != nil {
+		w, err = wire.NewValueI32(*(v.refF59())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 59, Value: w}
+		i++
+	}
+	if v.refF60() != nil {
+		w, err = wire.NewValueI32(*(v.refF60())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.refF61() != nil {
+		w, err = wire.NewValueI32(*(v.refF61())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 61, Value: w}
+		i++
+	}
+	if v.refF62() != nil {
+		w, err = wire.NewValueI32(*(v.refF62())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 62, Value: w}
+		i++
+	}
+	if v.refF63() != nil {
+		w, err = wire.NewValueI32(*(v.refF63())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 63, Value: w}
+		i++
+	}
+	if v.refF64() != nil {
+		w, err = wire.NewValueI32(*(v.refF64())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 64, Value: w}
+		i++
+	}
+	if v.refF65() != nil {
+		w, err = wire.NewValueI32(*(v.refF65())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 65, Value: w}
+		i++
+	}
+	if v.refF66() != nil {
+		w, err = wire.NewValueI32(*(v.refF66())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 66, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Wide struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Wide struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Wide
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Wide) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.F1, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.F2, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 1)
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				v.F3, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 2)
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.F4, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 3)
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				v.F5, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 4)
+			}
+		case 6:
+			if field.Value.Type() == wire.TI32 {
+				v.F6, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 5)
+			}
+		case 7:
+			if field.Value.Type() == wire.TI32 {
+				v.F7, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 6)
+			}
+		case 8:
+			if field.Value.Type() == wire.TI32 {
+				v.F8, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 7)
+			}
+		case 9:
+			if field.Value.Type() == wire.TI32 {
+				v.F9, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 8)
+			}
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				v.F10, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 9)
+			}
+		case 11:
+			if field.Value.Type() == wire.TI32 {
+				v.F11, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 10)
+			}
+		case 12:
+			if field.Value.Type() == wire.TI32 {
+				v.F12, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 11)
+			}
+		case 13:
+			if field.Value.Type() == wire.TI32 {
+				v.F13, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 12)
+			}
+		case 14:
+			if field.Value.Type() == wire.TI32 {
+				v.F14, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 13)
+			}
+		case 15:
+			if field.Value.Type() == wire.TI32 {
+				v.F15, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 14)
+			}
+		case 16:
+			if field.Value.Type() == wire.TI32 {
+				v.F16, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 15)
+			}
+		case 17:
+			if field.Value.Type() == wire.TI32 {
+				v.F17, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 16)
+			}
+		case 18:
+			if field.Value.Type() == wire.TI32 {
+				v.F18, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 17)
+			}
+		case 19:
+			if field.Value.Type() == wire.TI32 {
+				v.F19, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 18)
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				v.F20, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 19)
+			}
+		case 21:
+			if field.Value.Type() == wire.TI32 {
+				v.F21, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 20)
+			}
+		case 22:
+			if field.Value.Type() == wire.TI32 {
+				v.F22, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 21)
+			}
+		case 23:
+			if field.Value.Type() == wire.TI32 {
+				v.F23, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 22)
+			}
+		case 24:
+			if field.Value.Type() == wire.TI32 {
+				v.F24, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 23)
+			}
+		case 25:
+			if field.Value.Type() == wire.TI32 {
+				v.F25, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 24)
+			}
+		case 26:
+			if field.Value.Type() == wire.TI32 {
+				v.F26, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 25)
+			}
+		case 27:
+			if field.Value.Type() == wire.TI32 {
+				v.F27, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 26)
+			}
+		case 28:
+			if field.Value.Type() == wire.TI32 {
+				v.F28, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 27)
+			}
+		case 29:
+			if field.Value.Type() == wire.TI32 {
+				v.F29, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 28)
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				v.F30, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 29)
+			}
+		case 31:
+			if field.Value.Type() == wire.TI32 {
+				v.F31, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 30)
+			}
+		case 32:
+			if field.Value.Type() == wire.TI32 {
+				v.F32, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 31)
+			}
+		case 33:
+			if field.Value.Type() == wire.TI32 {
+				v.F33, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 32)
+			}
+		case 34:
+			if field.Value.Type() == wire.TI32 {
+				v.F34, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 33)
+			}
+		case 35:
+			if field.Value.Type() == wire.TI32 {
+				v.F35, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 34)
+			}
+		case 36:
+			if field.Value.Type() == wire.TI32 {
+				v.F36, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 35)
+			}
+		case 37:
+			if field.Value.Type() == wire.TI32 {
+				v.F37, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 36)
+			}
+		case 38:
+			if field.Value.Type() == wire.TI32 {
+				v.F38, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 37)
+			}
+		case 39:
+			if field.Value.Type() == wire.TI32 {
+				v.F39, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 38)
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				v.F40, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 39)
+			}
+		case 41:
+			if field.Value.Type() == wire.TI32 {
+				v.F41, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 40)
+			}
+		case 42:
+			if field.Value.Type() == wire.TI32 {
+				v.F42, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 41)
+			}
+		case 43:
+			if field.Value.Type() == wire.TI32 {
+				v.F43, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 42)
+			}
+		case 44:
+			if field.Value.Type() == wire.TI32 {
+				v.F44, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 43)
+			}
+		case 45:
+			if field.Value.Type() == wire.TI32 {
+				v.F45, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 44)
+			}
+		case 46:
+			if field.Value.Type() == wire.TI32 {
+				v.F46, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 45)
+			}
+		case 47:
+			if field.Value.Type() == wire.TI32 {
+				v.F47, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 46)
+			}
+		case 48:
+			if field.Value.Type() == wire.TI32 {
+				v.F48, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 47)
+			}
+		case 49:
+			if field.Value.Type() == wire.TI32 {
+				v.F49, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 48)
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				v.F50, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 49)
+			}
+		case 51:
+			if field.Value.Type() == wire.TI32 {
+				v.F51, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 50)
+			}
+		case 52:
+			if field.Value.Type() == wire.TI32 {
+				v.F52, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 51)
+			}
+		case 53:
+			if field.Value.Type() == wire.TI32 {
+				v.F53, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 52)
+			}
+		case 54:
+			if field.Value.Type() == wire.TI32 {
+				v.F54, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 53)
+			}
+		case 55:
+			if field.Value.Type() == wire.TI32 {
+				v.F55, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 54)
+			}
+		case 56:
+			if field.Value.Type() == wire.TI32 {
+				v.F56, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 55)
+			}
+		case 57:
+			if field.Value.Type() == wire.TI32 {
+				v.F57, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 56)
+			}
+		case 58:
+			if field.Value.Type() == wire.TI32 {
+				v.F58, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 57)
+			}
+		case 59:
+			if field.Value.Type() == wire.TI32 {
+				v.F59, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 58)
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				v.F60, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 59)
+			}
+		case 61:
+			if field.Value.Type() == wire.TI32 {
+				v.F61, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 60)
+			}
+		case 62:
+			if field.Value.Type() == wire.TI32 {
+				v.F62, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 61)
+			}
+		case 63:
+			if field.Value.Type() == wire.TI32 {
+				v.F63, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 62)
+			}
+		case 64:
+			if field.Value.Type() == wire.TI32 {
+				v.F64, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 63)
+			}
+		case 65:
+			if field.Value.Type() == wire.TI32 {
+				v.F65, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[1] |= (1 << 0)
+			}
+		case 66:
+			if field.Value.Type() == wire.TI32 {
+				v.F66, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[1] |= (1 << 1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Wide struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Wide struct could not be encoded.
+func (v *Wide) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.refF1() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF1())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF2() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF2())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF3() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF3())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF4() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF4())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF5() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF5())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF6() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF6())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF7() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF7())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF8() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF8())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF9() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF9())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF10() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF10())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF11() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF11())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF12() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF12())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF13() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 13, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF13())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF14() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 14, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF14())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF15() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF15())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF16() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF16())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF17() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 17, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF17())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF18() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 18, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF18())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF19() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 19, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF19())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF20() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF20())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF21() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 21, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF21())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF22() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF22())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF23() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 23, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF23())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF24() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 24, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF24())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF25() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 25, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF25())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF26() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 26, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF26())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF27() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 27, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF27())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF28() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 28, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF28())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF29() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 29, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF29())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF30() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF30())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF31() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 31, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF31())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF32() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 32, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF32())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF33() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 33, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF33())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF34() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 34, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF34())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF35() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 35, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF35())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF36() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 36, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF36())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF37() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 37, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF37())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF38() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 38, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF38())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF39() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 39, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF39())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF40() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF40())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF41() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 41, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF41())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF42() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 42, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF42())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF43() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 43, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF43())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF44() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 44, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF44())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF45() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 45, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF45())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF46() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 46, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF46())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF47() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 47, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF47())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF48() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 48, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF48())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF49() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 49, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF49())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF50() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF50())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF51() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 51, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF51())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF52() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 52, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF52())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF53() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 53, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF53())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF54() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 54, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF54())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF55() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 55, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF55())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF56() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 56, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF56())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF57() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 57, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF57())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF58() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 58, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF58())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF59() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 59, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF59())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF60() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF60())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF61() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 61, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF61())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF62() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 62, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF62())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF63() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 63, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF63())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF64() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 64, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF64())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF65() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 65, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF65())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refF66() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 66, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refF66())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Wide struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Wide struct could not be generated from the wire
+// representation.
+func (v *Wide) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.F1, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.F2, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 1)
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			v.F3, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 2)
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			v.F4, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 3)
+		case fh.ID == 5 && fh.Type == wire.TI32:
+			v.F5, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 4)
+		case fh.ID == 6 && fh.Type == wire.TI32:
+			v.F6, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 5)
+		case fh.ID == 7 && fh.Type == wire.TI32:
+			v.F7, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 6)
+		case fh.ID == 8 && fh.Type == wire.TI32:
+			v.F8, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 7)
+		case fh.ID == 9 && fh.Type == wire.TI32:
+			v.F9, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 8)
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			v.F10, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 9)
+		case fh.ID == 11 && fh.Type == wire.TI32:
+			v.F11, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 10)
+		case fh.ID == 12 && fh.Type == wire.TI32:
+			v.F12, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 11)
+		case fh.ID == 13 && fh.Type == wire.TI32:
+			v.F13, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 12)
+		case fh.ID == 14 && fh.Type == wire.TI32:
+			v.F14, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 13)
+		case fh.ID == 15 && fh.Type == wire.TI32:
+			v.F15, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 14)
+		case fh.ID == 16 && fh.Type == wire.TI32:
+			v.F16, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 15)
+		case fh.ID == 17 && fh.Type == wire.TI32:
+			v.F17, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 16)
+		case fh.ID == 18 && fh.Type == wire.TI32:
+			v.F18, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 17)
+		case fh.ID == 19 && fh.Type == wire.TI32:
+			v.F19, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 18)
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			v.F20, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 19)
+		case fh.ID == 21 && fh.Type == wire.TI32:
+			v.F21, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 20)
+		case fh.ID == 22 && fh.Type == wire.TI32:
+			v.F22, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 21)
+		case fh.ID == 23 && fh.Type == wire.TI32:
+			v.F23, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 22)
+		case fh.ID == 24 && fh.Type == wire.TI32:
+			v.F24, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 23)
+		case fh.ID == 25 && fh.Type == wire.TI32:
+			v.F25, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 24)
+		case fh.ID == 26 && fh.Type == wire.TI32:
+			v.F26, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 25)
+		case fh.ID == 27 && fh.Type == wire.TI32:
+			v.F27, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 26)
+		case fh.ID == 28 && fh.Type == wire.TI32:
+			v.F28, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 27)
+		case fh.ID == 29 && fh.Type == wire.TI32:
+			v.F29, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 28)
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			v.F30, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 29)
+		case fh.ID == 31 && fh.Type == wire.TI32:
+			v.F31, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 30)
+		case fh.ID == 32 && fh.Type == wire.TI32:
+			v.F32, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 31)
+		case fh.ID == 33 && fh.Type == wire.TI32:
+			v.F33, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 32)
+		case fh.ID == 34 && fh.Type == wire.TI32:
+			v.F34, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 33)
+		case fh.ID == 35 && fh.Type == wire.TI32:
+			v.F35, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 34)
+		case fh.ID == 36 && fh.Type == wire.TI32:
+			v.F36, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 35)
+		case fh.ID == 37 && fh.Type == wire.TI32:
+			v.F37, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 36)
+		case fh.ID == 38 && fh.Type == wire.TI32:
+			v.F38, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 37)
+		case fh.ID == 39 && fh.Type == wire.TI32:
+			v.F39, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 38)
+		case fh.ID == 40 && fh.Type == wire.TI32:
+			v.F40, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 39)
+		case fh.ID == 41 && fh.Type == wire.TI32:
+			v.F41, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 40)
+		case fh.ID == 42 && fh.Type == wire.TI32:
+			v.F42, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 41)
+		case fh.ID == 43 && fh.Type == wire.TI32:
+			v.F43, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 42)
+		case fh.ID == 44 && fh.Type == wire.TI32:
+			v.F44, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 43)
+		case fh.ID == 45 && fh.Type == wire.TI32:
+			v.F45, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 44)
+		case fh.ID == 46 && fh.Type == wire.TI32:
+			v.F46, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 45)
+		case fh.ID == 47 && fh.Type == wire.TI32:
+			v.F47, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 46)
+		case fh.ID == 48 && fh.Type == wire.TI32:
+			v.F48, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 47)
+		case fh.ID == 49 && fh.Type == wire.TI32:
+			v.F49, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 48)
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			v.F50, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 49)
+		case fh.ID == 51 && fh.Type == wire.TI32:
+			v.F51, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 50)
+		case fh.ID == 52 && fh.Type == wire.TI32:
+			v.F52, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 51)
+		case fh.ID == 53 && fh.Type == wire.TI32:
+			v.F53, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 52)
+		case fh.ID == 54 && fh.Type == wire.TI32:
+			v.F54, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 53)
+		case fh.ID == 55 && fh.Type == wire.TI32:
+			v.F55, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 54)
+		case fh.ID == 56 && fh.Type == wire.TI32:
+			v.F56, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 55)
+		case fh.ID == 57 && fh.Type == wire.TI32:
+			v.F57, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 56)
+		case fh.ID == 58 && fh.Type == wire.TI32:
+			v.F58, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 57)
+		case fh.ID == 59 && fh.Type == wire.TI32:
+			v.F59, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 58)
+		case fh.ID == 60 && fh.Type == wire.TI32:
+			v.F60, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 59)
+		case fh.ID == 61 && fh.Type == wire.TI32:
+			v.F61, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 60)
+		case fh.ID == 62 && fh.Type == wire.TI32:
+			v.F62, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 61)
+		case fh.ID == 63 && fh.Type == wire.TI32:
+			v.F63, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 62)
+		case fh.ID == 64 && fh.Type == wire.TI32:
+			v.F64, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 63)
+		case fh.ID == 65 && fh.Type == wire.TI32:
+			v.F65, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[1] |= (1 << 0)
+		case fh.ID == 66 && fh.Type == wire.TI32:
+			v.F66, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[1] |= (1 << 1)
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Wide
+// struct.
+func (v *Wide) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [66]string
+	i := 0
+	if v.refF1() != nil {
+		fields[i] = fmt.Sprintf("F1: %v", *(v.refF1()))
+		i++
+	}
+	if v.refF2() != nil {
+		fields[i] = fmt.Sprintf("F2: %v", *(v.refF2()))
+		i++
+	}
+	if v.refF3() != nil {
+		fields[i] = fmt.Sprintf("F3: %v", *(v.refF3()))
+		i++
+	}
+	if v.refF4() != nil {
+		fields[i] = fmt.Sprintf("F4: %v", *(v.refF4()))
+		i++
+	}
+	if v.refF5() != nil {
+		fields[i] = fmt.Sprintf("F5: %v", *(v.refF5()))
+		i++
+	}
+	if v.refF6() != nil {
+		fields[i] = fmt.Sprintf("F6: %v", *(v.refF6()))
+		i++
+	}
+	if v.refF7() != nil {
+		fields[i] = fmt.Sprintf("F7: %v", *(v.refF7()))
+		i++
+	}
+	if v.refF8() != nil {
+		fields[i] = fmt.Sprintf("F8: %v", *(v.refF8()))
+		i++
+	}
+	if v.refF9() != nil {
+		fields[i] = fmt.Sprintf("F9: %v", *(v.refF9()))
+		i++
+	}
+	if v.refF10() != nil {
+		fields[i] = fmt.Sprintf("F10: %v", *(v.refF10()))
+		i++
+	}
+	if v.refF11() != nil {
+		fields[i] = fmt.Sprintf("F11: %v", *(v.refF11()))
+		i++
+	}
+	if v.refF12() != nil {
+		fields[i] = fmt.Sprintf("F12: %v", *(v.refF12()))
+		i++
+	}
+	if v.refF13() != nil {
+		fields[i] = fmt.Sprintf("F13: %v", *(v.refF13()))
+		i++
+	}
+	if v.refF14() != nil {
+		fields[i] = fmt.Sprintf("F14: %v", *(v.refF14()))
+		i++
+	}
+	if v.refF15() != nil {
+		fields[i] = fmt.Sprintf("F15: %v", *(v.refF15()))
+		i++
+	}
+	if v.refF16() != nil {
+		fields[i] = fmt.Sprintf("F16: %v", *(v.refF16()))
+		i++
+	}
+	if v.refF17() != nil {
+		fields[i] = fmt.Sprintf("F17: %v", *(v.refF17()))
+		i++
+	}
+	if v.refF18() != nil {
+		fields[i] = fmt.Sprintf("F18: %v", *(v.refF18()))
+		i++
+	}
+	if v.refF19() != nil {
+		fields[i] = fmt.Sprintf("F19: %v", *(v.refF19()))
+		i++
+	}
+	if v.refF20() != nil {
+		fields[i] = fmt.Sprintf("F20: %v", *(v.refF20()))
+		i++
+	}
+	if v.refF21() != nil {
+		fields[i] = fmt.Sprintf("F21: %v", *(v.refF21()))
+		i++
+	}
+	if v.refF22() != nil {
+		fields[i] = fmt.Sprintf("F22: %v", *(v.refF22()))
+		i++
+	}
+	if v.refF23() != nil {
+		fields[i] = fmt.Sprintf("F23: %v", *(v.refF23()))
+		i++
+	}
+	if v.refF24() != nil {
+		fields[i] = fmt.Sprintf("F24: %v", *(v.refF24()))
+		i++
+	}
+	if v.refF25() != nil {
+		fields[i] = fmt.Sprintf("F25: %v", *(v.refF25()))
+		i++
+	}
+	if v.refF26() != nil {
+		fields[i] = fmt.Sprintf("F26: %v", *(v.refF26()))
+		i++
+	}
+	if v.refF27() != nil {
+		fields[i] = fmt.Sprintf("F27: %v", *(v.refF27()))
+		i++
+	}
+	if v.refF28() != nil {
+		fields[i] = fmt.Sprintf("F28: %v", *(v.refF28()))
+		i++
+	}
+	if v.refF29() != nil {
+		fields[i] = fmt.Sprintf("F29: %v", *(v.refF29()))
+		i++
+	}
+	if v.refF30() != nil {
+		fields[i] = fmt.Sprintf("F30: %v", *(v.refF30()))
+		i++
+	}
+	if v.refF31() != nil {
+		fields[i] = fmt.Sprintf("F31: %v", *(v.refF31()))
+		i++
+	}
+	if v.refF32() != nil {
+		fields[i] = fmt.Sprintf("F32: %v", *(v.refF32()))
+		i++
+	}
+	if v.refF33() != nil {
+		fields[i] = fmt.Sprintf("F33: %v", *(v.refF33()))
+		i++
+	}
+	if v.refF34() != nil {
+		fields[i] = fmt.Sprintf("F34: %v", *(v.refF34()))
+		i++
+	}
+	if v.refF35() != nil {
+		fields[i] = fmt.Sprintf("F35: %v", *(v.refF35()))
+		i++
+	}
+	if v.refF36() != nil {
+		fields[i] = fmt.Sprintf("F36: %v", *(v.refF36()))
+		i++
+	}
+	if v.refF37() != nil {
+		fields[i] = fmt.Sprintf("F37: %v", *(v.refF37()))
+		i++
+	}
+	if v.refF38() != nil {
+		fields[i] = fmt.Sprintf("F38: %v", *(v.refF38()))
+		i++
+	}
+	if v.refF39() != nil {
+		fields[i] = fmt.Sprintf("F39: %v", *(v.refF39()))
+		i++
+	}
+	if v.refF40() != nil {
+		fields[i] = fmt.Sprintf("F40: %v", *(v.refF40()))
+		i++
+	}
+	if v.refF41() != nil {
+		fields[i] = fmt.Sprintf("F41: %v", *(v.refF41()))
+		i++
+	}
+	if v.refF42() != nil {
+		fields[i] = fmt.Sprintf("F42: %v", *(v.refF42()))
+		i++
+	}
+	if v.refF43() != nil {
+		fields[i] = fmt.Sprintf("F43: %v", *(v.refF43()))
+		i++
+	}
+	if v.refF44() != nil {
+		fields[i] = fmt.Sprintf("F44: %v", *(v.refF44()))
+		i++
+	}
+	if v.refF45() != nil {
+		fields[i] = fmt.Sprintf("F45: %v", *(v.refF45()))
+		i++
+	}
+	if v.refF46() != nil {
+		fields[i] = fmt.Sprintf("F46: %v", *(v.refF46()))
+		i++
+	}
+	if v.refF47() != nil {
+		fields[i] = fmt.Sprintf("F47: %v", *(v.refF47()))
+		i++
+	}
+	if v.refF48() != nil {
+		fields[i] = fmt.Sprintf("F48: %v", *(v.refF48()))
+		i++
+	}
+	if v.refF49() != nil {
+		fields[i] = fmt.Sprintf("F49: %v", *(v.refF49()))
+		i++
+	}
+	if v.refF50() != nil {
+		fields[i] = fmt.Sprintf("F50: %v", *(v.refF50()))
+		i++
+	}
+	if v.refF51() != nil {
+		fields[i] = fmt.Sprintf("F51: %v", *(v.refF51()))
+		i++
+	}
+	if v.refF52() != nil {
+		fields[i] = fmt.Sprintf("F52: %v", *(v.refF52()))
+		i++
+	}
+	if v.refF53() != nil {
+		fields[i] = fmt.Sprintf("F53: %v", *(v.refF53()))
+		i++
+	}
+	if v.refF54() != nil {
+		fields[i] = fmt.Sprintf("F54: %v", *(v.refF54()))
+		i++
+	}
+	if v.refF55() != nil {
+		fields[i] = fmt.Sprintf("F55: %v", *(v.refF55()))
+		i++
+	}
+	if v.refF56() != nil {
+		fields[i] = fmt.Sprintf("F56: %v", *(v.refF56()))
+		i++
+	}
+	if v.refF57() != nil {
+		fields[i] = fmt.Sprintf("F57: %v", *(v.refF57()))
+		i++
+	}
+	if v.refF58() != nil {
+		fields[i] = fmt.Sprintf("F58: %v", *(v.refF58()))
+		i++
+	}
+	if v.refF59() != nil {
+		fields[i] = fmt.Sprintf("F59: %v", *(v.refF59()))
+		i++
+	}
+	if v.refF60() != nil {
+		fields[i] = fmt.Sprintf("F60: %v", *(v.refF60()))
+		i++
+	}
+	if v.refF61() != nil {
+		fields[i] = fmt.Sprintf("F61: %v", *(v.refF61()))
+		i++
+	}
+	if v.refF62() != nil {
+		fields[i] = fmt.Sprintf("F62: %v", *(v.refF62()))
+		i++
+	}
+	if v.refF63() != nil {
+		fields[i] = fmt.Sprintf("F63: %v", *(v.refF63()))
+		i++
+	}
+	if v.refF64() != nil {
+		fields[i] = fmt.Sprintf("F64: %v", *(v.refF64()))
+		i++
+	}
+	if v.refF65() != nil {
+		fields[i] = fmt.Sprintf("F65: %v", *(v.refF65()))
+		i++
+	}
+	if v.refF66() != nil {
+		fields[i] = fmt.Sprintf("F66: %v", *(v.refF66()))
+		i++
+	}
+
+	return fmt.Sprintf("Wide{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Wide match the
+// provided Wide.
+//
+// This function performs a deep comparison.
+func (v *Wide) Equals(rhs *Wide) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF1(), rhs.refF1()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF2(), rhs.refF2()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF3(), rhs.refF3()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF4(), rhs.refF4()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF5(), rhs.refF5()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF6(), rhs.refF6()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF7(), rhs.refF7()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF8(), rhs.refF8()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF9(), rhs.refF9()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF10(), rhs.refF10()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF11(), rhs.refF11()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF12(), rhs.refF12()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF13(), rhs.refF13()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF14(), rhs.refF14()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF15(), rhs.refF15()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF16(), rhs.refF16()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF17(), rhs.refF17()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF18(), rhs.refF18()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF19(), rhs.refF19()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF20(), rhs.refF20()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF21(), rhs.refF21()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF22(), rhs.refF22()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF23(), rhs.refF23()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF24(), rhs.refF24()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF25(), rhs.refF25()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF26(), rhs.refF26()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF27(), rhs.refF27()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF28(), rhs.refF28()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF29(), rhs.refF29()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF30(), rhs.refF30()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF31(), rhs.refF31()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF32(), rhs.refF32()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF33(), rhs.refF33()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF34(), rhs.refF34()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF35(), rhs.refF35()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF36(), rhs.refF36()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF37(), rhs.refF37()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF38(), rhs.refF38()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF39(), rhs.refF39()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF40(), rhs.refF40()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF41(), rhs.refF41()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF42(), rhs.refF42()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF43(), rhs.refF43()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF44(), rhs.refF44()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF45(), rhs.refF45()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF46(), rhs.refF46()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF47(), rhs.refF47()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF48(), rhs.refF48()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF49(), rhs.refF49()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF50(), rhs.refF50()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF51(), rhs.refF51()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF52(), rhs.refF52()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF53(), rhs.refF53()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF54(), rhs.refF54()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF55(), rhs.refF55()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF56(), rhs.refF56()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF57(), rhs.refF57()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF58(), rhs.refF58()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF59(), rhs.refF59()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF60(), rhs.refF60()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF61(), rhs.refF61()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF62(), rhs.refF62()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF63(), rhs.refF63()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF64(), rhs.refF64()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF65(), rhs.refF65()) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refF66(), rhs.refF66()) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Wide.
+func (v *Wide) Copy() *Wide {
+	if v == nil {
+		return nil
+	}
+
+	var o Wide
+	o.F1 = v.F1
+	o.F2 = v.F2
+	o.F3 = v.F3
+	o.F4 = v.F4
+	o.F5 = v.F5
+	o.F6 = v.F6
+	o.F7 = v.F7
+	o.F8 = v.F8
+	o.F9 = v.F9
+	o.F10 = v.F10
+	o.F11 = v.F11
+	o.F12 = v.F12
+	o.F13 = v.F13
+	o.F14 = v.F14
+	o.F15 = v.F15
+	o.F16 = v.F16
+	o.F17 = v.F17
+	o.F18 = v.F18
+	o.F19 = v.F19
+	o.F20 = v.F20
+	o.F21 = v.F21
+	o.F22 = v.F22
+	o.F23 = v.F23
+	o.F24 = v.F24
+	o.F25 = v.F25
+	o.F26 = v.F26
+	o.F27 = v.F27
+	o.F28 = v.F28
+	o.F29 = v.F29
+	o.F30 = v.F30
+	o.F31 = v.F31
+	o.F32 = v.F32
+	o.F33 = v.F33
+	o.F34 = v.F34
+	o.F35 = v.F35
+	o.F36 = v.F36
+	o.F37 = v.F37
+	o.F38 = v.F38
+	o.F39 = v.F39
+	o.F40 = v.F40
+	o.F41 = v.F41
+	o.F42 = v.F42
+	o.F43 = v.F43
+	o.F44 = v.F44
+	o.F45 = v.F45
+	o.F46 = v.F46
+	o.F47 = v.F47
+	o.F48 = v.F48
+	o.F49 = v.F49
+	o.F50 = v.F50
+	o.F51 = v.F51
+	o.F52 = v.F52
+	o.F53 = v.F53
+	o.F54 = v.F54
+	o.F55 = v.F55
+	o.F56 = v.F56
+	o.F57 = v.F57
+	o.F58 = v.F58
+	o.F59 = v.F59
+	o.F60 = v.F60
+	o.F61 = v.F61
+	o.F62 = v.F62
+	o.F63 = v.F63
+	o.F64 = v.F64
+	o.F65 = v.F65
+	o.F66 = v.F66
+	o.presence = v.presence
+	return &o
+}
+
+// Hash returns a hash of this Wide which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Wide) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.refF1() != nil {
+		h.Field(1)
+		h.Int32(*v.refF1())
+	}
+	if v.refF2() != nil {
+		h.Field(2)
+		h.Int32(*v.refF2())
+	}
+	if v.refF3() != nil {
+		h.Field(3)
+		h.Int32(*v.refF3())
+	}
+	if v.refF4() != nil {
+		h.Field(4)
+		h.Int32(*v.refF4())
+	}
+	if v.refF5() != nil {
+		h.Field(5)
+		h.Int32(*v.refF5())
+	}
+	if v.refF6() != nil {
+		h.Field(6)
+		h.Int32(*v.refF6())
+	}
+	if v.refF7() != nil {
+		h.Field(7)
+		h.Int32(*v.refF7())
+	}
+	if v.refF8() != nil {
+		h.Field(8)
+		h.Int32(*v.refF8())
+	}
+	if v.refF9() != nil {
+		h.Field(9)
+		h.Int32(*v.refF9())
+	}
+	if v.refF10() != nil {
+		h.Field(10)
+		h.Int32(*v.refF10())
+	}
+	if v.refF11() != nil {
+		h.Field(11)
+		h.Int32(*v.refF11())
+	}
+	if v.refF12() != nil {
+		h.Field(12)
+		h.Int32(*v.refF12())
+	}
+	if v.refF13() != nil {
+		h.Field(13)
+		h.Int32(*v.refF13())
+	}
+	if v.refF14() != nil {
+		h.Field(14)
+		h.Int32(*v.refF14())
+	}
+	if v.refF15() != nil {
+		h.Field(15)
+		h.Int32(*v.refF15())
+	}
+	if v.refF16() != nil {
+		h.Field(16)
+		h.Int32(*v.refF16())
+	}
+	if v.refF17() != nil {
+		h.Field(17)
+		h.Int32(*v.refF17())
+	}
+	if v.refF18() != nil {
+		h.Field(18)
+		h.Int32(*v.refF18())
+	}
+	if v.refF19() != nil {
+		h.Field(19)
+		h.Int32(*v.refF19())
+	}
+	if v.refF20() != nil {
+		h.Field(20)
+		h.Int32(*v.refF20())
+	}
+	if v.refF21() != nil {
+		h.Field(21)
+		h.Int32(*v.refF21())
+	}
+	if v.refF22() != nil {
+		h.Field(22)
+		h.Int32(*v.refF22())
+	}
+	if v.refF23() != nil {
+		h.Field(23)
+		h.Int32(*v.refF23())
+	}
+	if v.refF24() != nil {
+		h.Field(24)
+		h.Int32(*v.refF24())
+	}
+	if v.refF25() != nil {
+		h.Field(25)
+		h.Int32(*v.refF25())
+	}
+	if v.refF26() != nil {
+		h.Field(26)
+		h.Int32(*v.refF26())
+	}
+	if v.refF27() != nil {
+		h.Field(27)
+		h.Int32(*v.refF27())
+	}
+	if v.refF28() != nil {
+		h.Field(28)
+		h.Int32(*v.refF28())
+	}
+	if v.refF29() != nil {
+		h.Field(29)
+		h.Int32(*v.refF29())
+	}
+	if v.refF30() != nil {
+		h.Field(30)
+		h.Int32(*v.refF30())
+	}
+	if v.refF31() != nil {
+		h.Field(31)
+		h.Int32(*v.refF31())
+	}
+	if v.refF32() != nil {
+		h.Field(32)
+		h.Int32(*v.refF32())
+	}
+	if v.refF33() != nil {
+		h.Field(33)
+		h.Int32(*v.refF33())
+	}
+	if v.refF34() != nil {
+		h.Field(34)
+		h.Int32(*v.refF34())
+	}
+	if v.refF35() != nil {
+		h.Field(35)
+		h.Int32(*v.refF35())
+	}
+	if v.refF36() != nil {
+		h.Field(36)
+		h.Int32(*v.refF36())
+	}
+	if v.refF37() != nil {
+		h.Field(37)
+		h.Int32(*v.refF37())
+	}
+	if v.refF38() != nil {
+		h.Field(38)
+		h.Int32(*v.refF38())
+	}
+	if v.refF39() != nil {
+		h.Field(39)
+		h.Int32(*v.refF39())
+	}
+	if v.refF40() != nil {
+		h.Field(40)
+		h.Int32(*v.refF40())
+	}
+	if v.refF41() != nil {
+		h.Field(41)
+		h.Int32(*v.refF41())
+	}
+	if v.refF42() != nil {
+		h.Field(42)
+		h.Int32(*v.refF42())
+	}
+	if v.refF43() != nil {
+		h.Field(43)
+		h.Int32(*v.refF43())
+	}
+	if v.refF44() != nil {
+		h.Field(44)
+		h.Int32(*v.refF44())
+	}
+	if v.refF45() != nil {
+		h.Field(45)
+		h.Int32(*v.refF45())
+	}
+	if v.refF46() != nil {
+		h.Field(46)
+		h.Int32(*v.refF46())
+	}
+	if v.refF47() != nil {
+		h.Field(47)
+		h.Int32(*v.refF47())
+	}
+	if v.refF48() != nil {
+		h.Field(48)
+		h.Int32(*v.refF48())
+	}
+	if v.refF49() != nil {
+		h.Field(49)
+		h.Int32(*v.refF49())
+	}
+	if v.refF50() != nil {
+		h.Field(50)
+		h.Int32(*v.refF50())
+	}
+	if v.refF51() != nil {
+		h.Field(51)
+		h.Int32(*v.refF51())
+	}
+	if v.refF52() != nil {
+		h.Field(52)
+		h.Int32(*v.refF52())
+	}
+	if v.refF53() != nil {
+		h.Field(53)
+		h.Int32(*v.refF53())
+	}
+	if v.refF54() != nil {
+		h.Field(54)
+		h.Int32(*v.refF54())
+	}
+	if v.refF55() != nil {
+		h.Field(55)
+		h.Int32(*v.refF55())
+	}
+	if v.refF56() != nil {
+		h.Field(56)
+		h.Int32(*v.refF56())
+	}
+	if v.refF57() != nil {
+		h.Field(57)
+		h.Int32(*v.refF57())
+	}
+	if v.refF58() != nil {
+		h.Field(58)
+		h.Int32(*v.refF58())
+	}
+	if v.refF59() != nil {
+		h.Field(59)
+		h.Int32(*v.refF59())
+	}
+	if v.refF60() != nil {
+		h.Field(60)
+		h.Int32(*v.refF60())
+	}
+	if v.refF61() != nil {
+		h.Field(61)
+		h.Int32(*v.refF61())
+	}
+	if v.refF62() != nil {
+		h.Field(62)
+		h.Int32(*v.refF62())
+	}
+	if v.refF63() != nil {
+		h.Field(63)
+		h.Int32(*v.refF63())
+	}
+	if v.refF64() != nil {
+		h.Field(64)
+		h.Int32(*v.refF64())
+	}
+	if v.refF65() != nil {
+		h.Field(65)
+		h.Int32(*v.refF65())
+	}
+	if v.refF66() != nil {
+		h.Field(66)
+		h.Int32(*v.refF66())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Wide so that it may be reused.
+func (v *Wide) Reset() {
+	*v = Wide{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Wide.
+func (v *Wide) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.refF1() != nil {
+		enc.AddInt32("f1", *v.refF1())
+	}
+	if v.refF2() != nil {
+		enc.AddInt32("f2", *v.refF2())
+	}
+	if v.refF3() != nil {
+		enc.AddInt32("f3", *v.refF3())
+	}
+	if v.refF4() != nil {
+		enc.AddInt32("f4", *v.refF4())
+	}
+	if v.refF5() != nil {
+		enc.AddInt32("f5", *v.refF5())
+	}
+	if v.refF6() != nil {
+		enc.AddInt32("f6", *v.refF6())
+	}
+	if v.refF7() != nil {
+		enc.AddInt32("f7", *v.refF7())
+	}
+	if v.refF8() != nil {
+		enc.AddInt32("f8", *v.refF8())
+	}
+	if v.refF9() != nil {
+		enc.AddInt32("f9", *v.refF9())
+	}
+	if v.refF10() != nil {
+		enc.AddInt32("f10", *v.refF10())
+	}
+	if v.refF11() != nil {
+		enc.AddInt32("f11", *v.refF11())
+	}
+	if v.refF12() != nil {
+		enc.AddInt32("f12", *v.refF12())
+	}
+	if v.refF13() != nil {
+		enc.AddInt32("f13", *v.refF13())
+	}
+	if v.refF14() != nil {
+		enc.AddInt32("f14", *v.refF14())
+	}
+	if v.refF15() != nil {
+		enc.AddInt32("f15", *v.refF15())
+	}
+	if v.refF16() != nil {
+		enc.AddInt32("f16", *v.refF16())
+	}
+	if v.refF17() != nil {
+		enc.AddInt32("f17", *v.refF17())
+	}
+	if v.refF18() != nil {
+		enc.AddInt32("f18", *v.refF18())
+	}
+	if v.refF19() != nil {
+		enc.AddInt32("f19", *v.refF19())
+	}
+	if v.refF20() != nil {
+		enc.AddInt32("f20", *v.refF20())
+	}
+	if v.refF21() != nil {
+		enc.AddInt32("f21", *v.refF21())
+	}
+	if v.refF22() != nil {
+		enc.AddInt32("f22", *v.refF22())
+	}
+	if v.refF23() != nil {
+		enc.AddInt32("f23", *v.refF23())
+	}
+	if v.refF24() != nil {
+		enc.AddInt32("f24", *v.refF24())
+	}
+	if v.refF25() != nil {
+		enc.AddInt32("f25", *v.refF25())
+	}
+	if v.refF26() != nil {
+		enc.AddInt32("f26", *v.refF26())
+	}
+	if v.refF27() != nil {
+		enc.AddInt32("f27", *v.refF27())
+	}
+	if v.refF28() != nil {
+		enc.AddInt32("f28", *v.refF28())
+	}
+	if v.refF29() != nil {
+		enc.AddInt32("f29", *v.refF29())
+	}
+	if v.refF30() != nil {
+		enc.AddInt32("f30", *v.refF30())
+	}
+	if v.refF31() != nil {
+		enc.AddInt32("f31", *v.refF31())
+	}
+	if v.refF32() != nil {
+		enc.AddInt32("f32", *v.refF32())
+	}
+	if v.refF33() != nil {
+		enc.AddInt32("f33", *v.refF33())
+	}
+	if v.refF34() != nil {
+		enc.AddInt32("f34", *v.refF34())
+	}
+	if v.refF35() != nil {
+		enc.AddInt32("f35", *v.refF35())
+	}
+	if v.refF36() != nil {
+		enc.AddInt32("f36", *v.refF36())
+	}
+	if v.refF37() != nil {
+		enc.AddInt32("f37", *v.refF37())
+	}
+	if v.refF38() != nil {
+		enc.AddInt32("f38", *v.refF38())
+	}
+	if v.refF39() != nil {
+		enc.AddInt32("f39", *v.refF39())
+	}
+	if v.refF40() != nil {
+		enc.AddInt32("f40", *v.refF40())
+	}
+	if v.refF41() != nil {
+		enc.AddInt32("f41", *v.refF41())
+	}
+	if v.refF42() != nil {
+		enc.AddInt32("f42", *v.refF42())
+	}
+	if v.refF43() != nil {
+		enc.AddInt32("f43", *v.refF43())
+	}
+	if v.refF44() != nil {
+		enc.AddInt32("f44", *v.refF44())
+	}
+	if v.refF45() != nil {
+		enc.AddInt32("f45", *v.refF45())
+	}
+	if v.refF46() != nil {
+		enc.AddInt32("f46", *v.refF46())
+	}
+	if v.refF47() != nil {
+		enc.AddInt32("f47", *v.refF47())
+	}
+	if v.refF48() != nil {
+		enc.AddInt32("f48", *v.refF48())
+	}
+	if v.refF49() != nil {
+		enc.AddInt32("f49", *v.refF49())
+	}
+	if v.refF50() != nil {
+		enc.AddInt32("f50", *v.refF50())
+	}
+	if v.refF51() != nil {
+		enc.AddInt32("f51", *v.refF51())
+	}
+	if v.refF52() != nil {
+		enc.AddInt32("f52", *v.refF52())
+	}
+	if v.refF53() != nil {
+		enc.AddInt32("f53", *v.refF53())
+	}
+	if v.refF54() != nil {
+		enc.AddInt32("f54", *v.refF54())
+	}
+	if v.refF55() != nil {
+		enc.AddInt32("f55", *v.refF55())
+	}
+	if v.refF56() != nil {
+		enc.AddInt32("f56", *v.refF56())
+	}
+	if v.refF57() != nil {
+		enc.AddInt32("f57", *v.refF57())
+	}
+	if v.refF58() != nil {
+		enc.AddInt32("f58", *v.refF58())
+	}
+	if v.refF59() != nil {
+		enc.AddInt32("f59", *v.refF59())
+	}
+	if v.refF60() != nil {
+		enc.AddInt32("f60", *v.refF60())
+	}
+	if v.refF61() != nil {
+		enc.AddInt32("f61", *v.refF61())
+	}
+	if v.refF62() != nil {
+		enc.AddInt32("f62", *v.refF62())
+	}
+	if v.refF63() != nil {
+		enc.AddInt32("f63", *v.refF63())
+	}
+	if v.refF64() != nil {
+		enc.AddInt32("f64", *v.refF64())
+	}
+	if v.refF65() != nil {
+		enc.AddInt32("f65", *v.refF65())
+	}
+	if v.refF66() != nil {
+		enc.AddInt32("f66", *v.refF66())
+	}
+	return err
+}
+
+// MarshalJSON serializes a Wide into JSON without its
+// unset optional fields.
+//
+// This has a value receiver so that Wide values are marshaled this way in
+// addition to pointers to them.
+func (v Wide) MarshalJSON() ([]byte, error) {
+	type plain Wide // without this method
+
+	r := struct {
+		*plain
+		F1  *int32 `json:"f1,omitempty"`
+		F2  *int32 `json:"f2,omitempty"`
+		F3  *int32 `json:"f3,omitempty"`
+		F4  *int32 `json:"f4,omitempty"`
+		F5  *int32 `json:"f5,omitempty"`
+		F6  *int32 `json:"f6,omitempty"`
+		F7  *int32 `json:"f7,omitempty"`
+		F8  *int32 `json:"f8,omitempty"`
+		F9  *int32 `json:"f9,omitempty"`
+		F10 *int32 `json:"f10,omitempty"`
+		F11 *int32 `json:"f11,omitempty"`
+		F12 *int32 `json:"f12,omitempty"`
+		F13 *int32 `json:"f13,omitempty"`
+		F14 *int32 `json:"f14,omitempty"`
+		F15 *int32 `json:"f15,omitempty"`
+		F16 *int32 `json:"f16,omitempty"`
+		F17 *int32 `json:"f17,omitempty"`
+		F18 *int32 `json:"f18,omitempty"`
+		F19 *int32 `json:"f19,omitempty"`
+		F20 *int32 `json:"f20,omitempty"`
+		F21 *int32 `json:"f21,omitempty"`
+		F22 *int32 `json:"f22,omitempty"`
+		F23 *int32 `json:"f23,omitempty"`
+		F24 *int32 `json:"f24,omitempty"`
+		F25 *int32 `json:"f25,omitempty"`
+		F26 *int32 `json:"f26,omitempty"`
+		F27 *int32 `json:"f27,omitempty"`
+		F28 *int32 `json:"f28,omitempty"`
+		F29 *int32 `json:"f29,omitempty"`
+		F30 *int32 `json:"f30,omitempty"`
+		F31 *int32 `json:"f31,omitempty"`
+		F32 *int32 `json:"f32,omitempty"`
+		F33 *int32 `json:"f33,omitempty"`
+		F34 *int32 `json:"f34,omitempty"`
+		F35 *int32 `json:"f35,omitempty"`
+		F36 *int32 `json:"f36,omitempty"`
+		F37 *int32 `json:"f37,omitempty"`
+		F38 *int32 `json:"f38,omitempty"`
+		F39 *int32 `json:"f39,omitempty"`
+		F40 *int32 `json:"f40,omitempty"`
+		F41 *int32 `json:"f41,omitempty"`
+		F42 *int32 `json:"f42,omitempty"`
+		F43 *int32 `json:"f43,omitempty"`
+		F44 *int32 `json:"f44,omitempty"`
+		F45 *int32 `json:"f45,omitempty"`
+		F46 *int32 `json:"f46,omitempty"`
+		F47 *int32 `json:"f47,omitempty"`
+		F48 *int32 `json:"f48,omitempty"`
+		F49 *int32 `json:"f49,omitempty"`
+		F50 *int32 `json:"f50,omitempty"`
+		F51 *int32 `json:"f51,omitempty"`
+		F52 *int32 `json:"f52,omitempty"`
+		F53 *int32 `json:"f53,omitempty"`
+		F54 *int32 `json:"f54,omitempty"`
+		F55 *int32 `json:"f55,omitempty"`
+		F56 *int32 `json:"f56,omitempty"`
+		F57 *int32 `json:"f57,omitempty"`
+		F58 *int32 `json:"f58,omitempty"`
+		F59 *int32 `json:"f59,omitempty"`
+		F60 *int32 `json:"f60,omitempty"`
+		F61 *int32 `json:"f61,omitempty"`
+		F62 *int32 `json:"f62,omitempty"`
+		F63 *int32 `json:"f63,omitempty"`
+		F64 *int32 `json:"f64,omitempty"`
+		F65 *int32 `json:"f65,omitempty"`
+		F66 *int32 `json:"f66,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.F1 = v.refF1()
+	r.F2 = v.refF2()
+	r.F3 = v.refF3()
+	r.F4 = v.refF4()
+	r.F5 = v.refF5()
+	r.F6 = v.refF6()
+	r.F7 = v.refF7()
+	r.F8 = v.refF8()
+	r.F9 = v.refF9()
+	r.F10 = v.refF10()
+	r.F11 = v.refF11()
+	r.F12 = v.refF12()
+	r.F13 = v.refF13()
+	r.F14 = v.refF14()
+	r.F15 = v.refF15()
+	r.F16 = v.refF16()
+	r.F17 = v.refF17()
+	r.F18 = v.refF18()
+	r.F19 = v.refF19()
+	r.F20 = v.refF20()
+	r.F21 = v.refF21()
+	r.F22 = v.refF22()
+	r.F23 = v.refF23()
+	r.F24 = v.refF24()
+	r.F25 = v.refF25()
+	r.F26 = v.refF26()
+	r.F27 = v.refF27()
+	r.F28 = v.refF28()
+	r.F29 = v.refF29()
+	r.F30 = v.refF30()
+	r.F31 = v.refF31()
+	r.F32 = v.refF32()
+	r.F33 = v.refF33()
+	r.F34 = v.refF34()
+	r.F35 = v.refF35()
+	r.F36 = v.refF36()
+	r.F37 = v.refF37()
+	r.F38 = v.refF38()
+	r.F39 = v.refF39()
+	r.F40 = v.refF40()
+	r.F41 = v.refF41()
+	r.F42 = v.refF42()
+	r.F43 = v.refF43()
+	r.F44 = v.refF44()
+	r.F45 = v.refF45()
+	r.F46 = v.refF46()
+	r.F47 = v.refF47()
+	r.F48 = v.refF48()
+	r.F49 = v.refF49()
+	r.F50 = v.refF50()
+	r.F51 = v.refF51()
+	r.F52 = v.refF52()
+	r.F53 = v.refF53()
+	r.F54 = v.refF54()
+	r.F55 = v.refF55()
+	r.F56 = v.refF56()
+	r.F57 = v.refF57()
+	r.F58 = v.refF58()
+	r.F59 = v.refF59()
+	r.F60 = v.refF60()
+	r.F61 = v.refF61()
+	r.F62 = v.refF62()
+	r.F63 = v.refF63()
+	r.F64 = v.refF64()
+	r.F65 = v.refF65()
+	r.F66 = v.refF66()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Wide from JSON, recording which of
+// its optional fields are set.
+func (v *Wide) UnmarshalJSON(data []byte) error {
+	type plain Wide // without this method
+
+	r := struct {
+		*plain
+		F1  *int32 `json:"f1,omitempty"`
+		F2  *int32 `json:"f2,omitempty"`
+		F3  *int32 `json:"f3,omitempty"`
+		F4  *int32 `json:"f4,omitempty"`
+		F5  *int32 `json:"f5,omitempty"`
+		F6  *int32 `json:"f6,omitempty"`
+		F7  *int32 `json:"f7,omitempty"`
+		F8  *int32 `json:"f8,omitempty"`
+		F9  *int32 `json:"f9,omitempty"`
+		F10 *int32 `json:"f10,omitempty"`
+		F11 *int32 `json:"f11,omitempty"`
+		F12 *int32 `json:"f12,omitempty"`
+		F13 *int32 `json:"f13,omitempty"`
+		F14 *int32 `json:"f14,omitempty"`
+		F15 *int32 `json:"f15,omitempty"`
+		F16 *int32 `json:"f16,omitempty"`
+		F17 *int32 `json:"f17,omitempty"`
+		F18 *int32 `json:"f18,omitempty"`
+		F19 *int32 `json:"f19,omitempty"`
+		F20 *int32 `json:"f20,omitempty"`
+		F21 *int32 `json:"f21,omitempty"`
+		F22 *int32 `json:"f22,omitempty"`
+		F23 *int32 `json:"f23,omitempty"`
+		F24 *int32 `json:"f24,omitempty"`
+		F25 *int32 `json:"f25,omitempty"`
+		F26 *int32 `json:"f26,omitempty"`
+		F27 *int32 `json:"f27,omitempty"`
+		F28 *int32 `json:"f28,omitempty"`
+		F29 *int32 `json:"f29,omitempty"`
+		F30 *int32 `json:"f30,omitempty"`
+		F31 *int32 `json:"f31,omitempty"`
+		F32 *int32 `json:"f32,omitempty"`
+		F33 *int32 `json:"f33,omitempty"`
+		F34 *int32 `json:"f34,omitempty"`
+		F35 *int32 `json:"f35,omitempty"`
+		F36 *int32 `json:"f36,omitempty"`
+		F37 *int32 `json:"f37,omitempty"`
+		F38 *int32 `json:"f38,omitempty"`
+		F39 *int32 `json:"f39,omitempty"`
+		F40 *int32 `json:"f40,omitempty"`
+		F41 *int32 `json:"f41,omitempty"`
+		F42 *int32 `json:"f42,omitempty"`
+		F43 *int32 `json:"f43,omitempty"`
+		F44 *int32 `json:"f44,omitempty"`
+		F45 *int32 `json:"f45,omitempty"`
+		F46 *int32 `json:"f46,omitempty"`
+		F47 *int32 `json:"f47,omitempty"`
+		F48 *int32 `json:"f48,omitempty"`
+		F49 *int32 `json:"f49,omitempty"`
+		F50 *int32 `json:"f50,omitempty"`
+		F51 *int32 `json:"f51,omitempty"`
+		F52 *int32 `json:"f52,omitempty"`
+		F53 *int32 `json:"f53,omitempty"`
+		F54 *int32 `json:"f54,omitempty"`
+		F55 *int32 `json:"f55,omitempty"`
+		F56 *int32 `json:"f56,omitempty"`
+		F57 *int32 `json:"f57,omitempty"`
+		F58 *int32 `json:"f58,omitempty"`
+		F59 *int32 `json:"f59,omitempty"`
+		F60 *int32 `json:"f60,omitempty"`
+		F61 *int32 `json:"f61,omitempty"`
+		F62 *int32 `json:"f62,omitempty"`
+		F63 *int32 `json:"f63,omitempty"`
+		F64 *int32 `json:"f64,omitempty"`
+		F65 *int32 `json:"f65,omitempty"`
+		F66 *int32 `json:"f66,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.F1 != nil {
+		v.SetF1(*r.F1)
+	}
+	if r.F2 != nil {
+		v.SetF2(*r.F2)
+	}
+	if r.F3 != nil {
+		v.SetF3(*r.F3)
+	}
+	if r.F4 != nil {
+		v.SetF4(*r.F4)
+	}
+	if r.F5 != nil {
+		v.SetF5(*r.F5)
+	}
+	if r.F6 != nil {
+		v.SetF6(*r.F6)
+	}
+	if r.F7 != nil {
+		v.SetF7(*r.F7)
+	}
+	if r.F8 != nil {
+		v.SetF8(*r.F8)
+	}
+	if r.F9 != nil {
+		v.SetF9(*r.F9)
+	}
+	if r.F10 != nil {
+		v.SetF10(*r.F10)
+	}
+	if r.F11 != nil {
+		v.SetF11(*r.F11)
+	}
+	if r.F12 != nil {
+		v.SetF12(*r.F12)
+	}
+	if r.F13 != nil {
+		v.SetF13(*r.F13)
+	}
+	if r.F14 != nil {
+		v.SetF14(*r.F14)
+	}
+	if r.F15 != nil {
+		v.SetF15(*r.F15)
+	}
+	if r.F16 != nil {
+		v.SetF16(*r.F16)
+	}
+	if r.F17 != nil {
+		v.SetF17(*r.F17)
+	}
+	if r.F18 != nil {
+		v.SetF18(*r.F18)
+	}
+	if r.F19 != nil {
+		v.SetF19(*r.F19)
+	}
+	if r.F20 != nil {
+		v.SetF20(*r.F20)
+	}
+	if r.F21 != nil {
+		v.SetF21(*r.F21)
+	}
+	if r.F22 != nil {
+		v.SetF22(*r.F22)
+	}
+	if r.F23 != nil {
+		v.SetF23(*r.F23)
+	}
+	if r.F24 != nil {
+		v.SetF24(*r.F24)
+	}
+	if r.F25 != nil {
+		v.SetF25(*r.F25)
+	}
+	if r.F26 != nil {
+		v.SetF26(*r.F26)
+	}
+	if r.F27 != nil {
+		v.SetF27(*r.F27)
+	}
+	if r.F28 != nil {
+		v.SetF28(*r.F28)
+	}
+	if r.F29 != nil {
+		v.SetF29(*r.F29)
+	}
+	if r.F30 != nil {
+		v.SetF30(*r.F30)
+	}
+	if r.F31 != nil {
+		v.SetF31(*r.F31)
+	}
+	if r.F32 != nil {
+		v.SetF32(*r.F32)
+	}
+	if r.F33 != nil {
+		v.SetF33(*r.F33)
+	}
+	if r.F34 != nil {
+		v.SetF34(*r.F34)
+	}
+	if r.F35 != nil {
+		v.SetF35(*r.F35)
+	}
+	if r.F36 != nil {
+		v.SetF36(*r.F36)
+	}
+	if r.F37 != nil {
+		v.SetF37(*r.F37)
+	}
+	if r.F38 != nil {
+		v.SetF38(*r.F38)
+	}
+	if r.F39 != nil {
+		v.SetF39(*r.F39)
+	}
+	if r.F40 != nil {
+		v.SetF40(*r.F40)
+	}
+	if r.F41 != nil {
+		v.SetF41(*r.F41)
+	}
+	if r.F42 != nil {
+		v.SetF42(*r.F42)
+	}
+	if r.F43 != nil {
+		v.SetF43(*r.F43)
+	}
+	if r.F44 != nil {
+		v.SetF44(*r.F44)
+	}
+	if r.F45 != nil {
+		v.SetF45(*r.F45)
+	}
+	if r.F46 != nil {
+		v.SetF46(*r.F46)
+	}
+	if r.F47 != nil {
+		v.SetF47(*r.F47)
+	}
+	if r.F48 != nil {
+		v.SetF48(*r.F48)
+	}
+	if r.F49 != nil {
+		v.SetF49(*r.F49)
+	}
+	if r.F50 != nil {
+		v.SetF50(*r.F50)
+	}
+	if r.F51 != nil {
+		v.SetF51(*r.F51)
+	}
+	if r.F52 != nil {
+		v.SetF52(*r.F52)
+	}
+	if r.F53 != nil {
+		v.SetF53(*r.F53)
+	}
+	if r.F54 != nil {
+		v.SetF54(*r.F54)
+	}
+	if r.F55 != nil {
+		v.SetF55(*r.F55)
+	}
+	if r.F56 != nil {
+		v.SetF56(*r.F56)
+	}
+	if r.F57 != nil {
+		v.SetF57(*r.F57)
+	}
+	if r.F58 != nil {
+		v.SetF58(*r.F58)
+	}
+	if r.F59 != nil {
+		v.SetF59(*r.F59)
+	}
+	if r.F60 != nil {
+		v.SetF60(*r.F60)
+	}
+	if r.F61 != nil {
+		v.SetF61(*r.F61)
+	}
+	if r.F62 != nil {
+		v.SetF62(*r.F62)
+	}
+	if r.F63 != nil {
+		v.SetF63(*r.F63)
+	}
+	if r.F64 != nil {
+		v.SetF64(*r.F64)
+	}
+	if r.F65 != nil {
+		v.SetF65(*r.F65)
+	}
+	if r.F66 != nil {
+		v.SetF66(*r.F66)
+	}
+	return nil
+}
+
+// GetF1 returns the value of F1 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF1() (o int32) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.F1
+	}
+
+	return
+}
+
+// IsSetF1 returns true if F1 is set.
+func (v *Wide) IsSetF1() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasF1 returns true if F1 is set.
+func (v *Wide) HasF1() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearF1 unsets F1.
+func (v *Wide) ClearF1() {
+	var x int32
+	v.F1 = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refF1 returns a pointer to F1 if it is set, and
+// nil otherwise.
+func (v *Wide) refF1() *int32 {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.F1
+	}
+	return nil
+}
+
+// SetF1 sets the value of F1.
+func (v *Wide) SetF1(x int32) {
+	v.F1 = x
+	v.presence[0] |= (1 << 0)
+}
+
+// GetF2 returns the value of F2 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF2() (o int32) {
+	if v != nil && v.presence[0]&(1<<1) != 0 {
+		return v.F2
+	}
+
+	return
+}
+
+// IsSetF2 returns true if F2 is set.
+func (v *Wide) IsSetF2() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// HasF2 returns true if F2 is set.
+func (v *Wide) HasF2() bool {
+	return v != nil && v.presence[0]&(1<<1) != 0
+}
+
+// ClearF2 unsets F2.
+func (v *Wide) ClearF2() {
+	var x int32
+	v.F2 = x
+	v.presence[0] &^= (1 << 1)
+}
+
+// refF2 returns a pointer to F2 if it is set, and
+// nil otherwise.
+func (v *Wide) refF2() *int32 {
+	if v.presence[0]&(1<<1) != 0 {
+		return &v.F2
+	}
+	return nil
+}
+
+// SetF2 sets the value of F2.
+func (v *Wide) SetF2(x int32) {
+	v.F2 = x
+	v.presence[0] |= (1 << 1)
+}
+
+// GetF3 returns the value of F3 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF3() (o int32) {
+	if v != nil && v.presence[0]&(1<<2) != 0 {
+		return v.F3
+	}
+
+	return
+}
+
+// IsSetF3 returns true if F3 is set.
+func (v *Wide) IsSetF3() bool {
+	return v != nil && v.presence[0]&(1<<2) != 0
+}
+
+// HasF3 returns true if F3 is set.
+func (v *Wide) HasF3() bool {
+	return v != nil && v.presence[0]&(1<<2) != 0
+}
+
+// ClearF3 unsets F3.
+func (v *Wide) ClearF3() {
+	var x int32
+	v.F3 = x
+	v.presence[0] &^= (1 << 2)
+}
+
+// refF3 returns a pointer to F3 if it is set, and
+// nil otherwise.
+func (v *Wide) refF3() *int32 {
+	if v.presence[0]&(1<<2) != 0 {
+		return &v.F3
+	}
+	return nil
+}
+
+// SetF3 sets the value of F3.
+func (v *Wide) SetF3(x int32) {
+	v.F3 = x
+	v.presence[0] |= (1 << 2)
+}
+
+// GetF4 returns the value of F4 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF4() (o int32) {
+	if v != nil && v.presence[0]&(1<<3) != 0 {
+		return v.F4
+	}
+
+	return
+}
+
+// IsSetF4 returns true if F4 is set.
+func (v *Wide) IsSetF4() bool {
+	return v != nil && v.presence[0]&(1<<3) != 0
+}
+
+// HasF4 returns true if F4 is set.
+func (v *Wide) HasF4() bool {
+	return v != nil && v.presence[0]&(1<<3) != 0
+}
+
+// ClearF4 unsets F4.
+func (v *Wide) ClearF4() {
+	var x int32
+	v.F4 = x
+	v.presence[0] &^= (1 << 3)
+}
+
+// refF4 returns a pointer to F4 if it is set, and
+// nil otherwise.
+func (v *Wide) refF4() *int32 {
+	if v.presence[0]&(1<<3) != 0 {
+		return &v.F4
+	}
+	return nil
+}
+
+// SetF4 sets the value of F4.
+func (v *Wide) SetF4(x int32) {
+	v.F4 = x
+	v.presence[0] |= (1 << 3)
+}
+
+// GetF5 returns the value of F5 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF5() (o int32) {
+	if v != nil && v.presence[0]&(1<<4) != 0 {
+		return v.F5
+	}
+
+	return
+}
+
+// IsSetF5 returns true if F5 is set.
+func (v *Wide) IsSetF5() bool {
+	return v != nil && v.presence[0]&(1<<4) != 0
+}
+
+// HasF5 returns true if F5 is set.
+func (v *Wide) HasF5() bool {
+	return v != nil && v.presence[0]&(1<<4) != 0
+}
+
+// ClearF5 unsets F5.
+func (v *Wide) ClearF5() {
+	var x int32
+	v.F5 = x
+	v.presence[0] &^= (1 << 4)
+}
+
+// refF5 returns a pointer to F5 if it is set, and
+// nil otherwise.
+func (v *Wide) refF5() *int32 {
+	if v.presence[0]&(1<<4) != 0 {
+		return &v.F5
+	}
+	return nil
+}
+
+// SetF5 sets the value of F5.
+func (v *Wide) SetF5(x int32) {
+	v.F5 = x
+	v.presence[0] |= (1 << 4)
+}
+
+// GetF6 returns the value of F6 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF6() (o int32) {
+	if v != nil && v.presence[0]&(1<<5) != 0 {
+		return v.F6
+	}
+
+	return
+}
+
+// IsSetF6 returns true if F6 is set.
+func (v *Wide) IsSetF6() bool {
+	return v != nil && v.presence[0]&(1<<5) != 0
+}
+
+// HasF6 returns true if F6 is set.
+func (v *Wide) HasF6() bool {
+	return v != nil && v.presence[0]&(1<<5) != 0
+}
+
+// ClearF6 unsets F6.
+func (v *Wide) ClearF6() {
+	var x int32
+	v.F6 = x
+	v.presence[0] &^= (1 << 5)
+}
+
+// refF6 returns a pointer to F6 if it is set, and
+// nil otherwise.
+func (v *Wide) refF6() *int32 {
+	if v.presence[0]&(1<<5) != 0 {
+		return &v.F6
+	}
+	return nil
+}
+
+// SetF6 sets the value of F6.
+func (v *Wide) SetF6(x int32) {
+	v.F6 = x
+	v.presence[0] |= (1 << 5)
+}
+
+// GetF7 returns the value of F7 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF7() (o int32) {
+	if v != nil && v.presence[0]&(1<<6) != 0 {
+		return v.F7
+	}
+
+	return
+}
+
+// IsSetF7 returns true if F7 is set.
+func (v *Wide) IsSetF7() bool {
+	return v != nil && v.presence[0]&(1<<6) != 0
+}
+
+// HasF7 returns true if F7 is set.
+func (v *Wide) HasF7() bool {
+	return v != nil && v.presence[0]&(1<<6) != 0
+}
+
+// ClearF7 unsets F7.
+func (v *Wide) ClearF7() {
+	var x int32
+	v.F7 = x
+	v.presence[0] &^= (1 << 6)
+}
+
+// refF7 returns a pointer to F7 if it is set, and
+// nil otherwise.
+func (v *Wide) refF7() *int32 {
+	if v.presence[0]&(1<<6) != 0 {
+		return &v.F7
+	}
+	return nil
+}
+
+// SetF7 sets the value of F7.
+func (v *Wide) SetF7(x int32) {
+	v.F7 = x
+	v.presence[0] |= (1 << 6)
+}
+
+// GetF8 returns the value of F8 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF8() (o int32) {
+	if v != nil && v.presence[0]&(1<<7) != 0 {
+		return v.F8
+	}
+
+	return
+}
+
+// IsSetF8 returns true if F8 is set.
+func (v *Wide) IsSetF8() bool {
+	return v != nil && v.presence[0]&(1<<7) != 0
+}
+
+// HasF8 returns true if F8 is set.
+func (v *Wide) HasF8() bool {
+	return v != nil && v.presence[0]&(1<<7) != 0
+}
+
+// ClearF8 unsets F8.
+func (v *Wide) ClearF8() {
+	var x int32
+	v.F8 = x
+	v.presence[0] &^= (1 << 7)
+}
+
+// refF8 returns a pointer to F8 if it is set, and
+// nil otherwise.
+func (v *Wide) refF8() *int32 {
+	if v.presence[0]&(1<<7) != 0 {
+		return &v.F8
+	}
+	return nil
+}
+
+// SetF8 sets the value of F8.
+func (v *Wide) SetF8(x int32) {
+	v.F8 = x
+	v.presence[0] |= (1 << 7)
+}
+
+// GetF9 returns the value of F9 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF9() (o int32) {
+	if v != nil && v.presence[0]&(1<<8) != 0 {
+		return v.F9
+	}
+
+	return
+}
+
+// IsSetF9 returns true if F9 is set.
+func (v *Wide) IsSetF9() bool {
+	return v != nil && v.presence[0]&(1<<8) != 0
+}
+
+// HasF9 returns true if F9 is set.
+func (v *Wide) HasF9() bool {
+	return v != nil && v.presence[0]&(1<<8) != 0
+}
+
+// ClearF9 unsets F9.
+func (v *Wide) ClearF9() {
+	var x int32
+	v.F9 = x
+	v.presence[0] &^= (1 << 8)
+}
+
+// refF9 returns a pointer to F9 if it is set, and
+// nil otherwise.
+func (v *Wide) refF9() *int32 {
+	if v.presence[0]&(1<<8) != 0 {
+		return &v.F9
+	}
+	return nil
+}
+
+// SetF9 sets the value of F9.
+func (v *Wide) SetF9(x int32) {
+	v.F9 = x
+	v.presence[0] |= (1 << 8)
+}
+
+// GetF10 returns the value of F10 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF10() (o int32) {
+	if v != nil && v.presence[0]&(1<<9) != 0 {
+		return v.F10
+	}
+
+	return
+}
+
+// IsSetF10 returns true if F10 is set.
+func (v *Wide) IsSetF10() bool {
+	return v != nil && v.presence[0]&(1<<9) != 0
+}
+
+// HasF10 returns true if F10 is set.
+func (v *Wide) HasF10() bool {
+	return v != nil && v.presence[0]&(1<<9) != 0
+}
+
+// ClearF10 unsets F10.
+func (v *Wide) ClearF10() {
+	var x int32
+	v.F10 = x
+	v.presence[0] &^= (1 << 9)
+}
+
+// refF10 returns a pointer to F10 if it is set, and
+// nil otherwise.
+func (v *Wide) refF10() *int32 {
+	if v.presence[0]&(1<<9) != 0 {
+		return &v.F10
+	}
+	return nil
+}
+
+// SetF10 sets the value of F10.
+func (v *Wide) SetF10(x int32) {
+	v.F10 = x
+	v.presence[0] |= (1 << 9)
+}
+
+// GetF11 returns the value of F11 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF11() (o int32) {
+	if v != nil && v.presence[0]&(1<<10) != 0 {
+		return v.F11
+	}
+
+	return
+}
+
+// IsSetF11 returns true if F11 is set.
+func (v *Wide) IsSetF11() bool {
+	return v != nil && v.presence[0]&(1<<10) != 0
+}
+
+// HasF11 returns true if F11 is set.
+func (v *Wide) HasF11() bool {
+	return v != nil && v.presence[0]&(1<<10) != 0
+}
+
+// ClearF11 unsets F11.
+func (v *Wide) ClearF11() {
+	var x int32
+	v.F11 = x
+	v.presence[0] &^= (1 << 10)
+}
+
+// refF11 returns a pointer to F11 if it is set, and
+// nil otherwise.
+func (v *Wide) refF11() *int32 {
+	if v.presence[0]&(1<<10) != 0 {
+		return &v.F11
+	}
+	return nil
+}
+
+// SetF11 sets the value of F11.
+func (v *Wide) SetF11(x int32) {
+	v.F11 = x
+	v.presence[0] |= (1 << 10)
+}
+
+// GetF12 returns the value of F12 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF12() (o int32) {
+	if v != nil && v.presence[0]&(1<<11) != 0 {
+		return v.F12
+	}
+
+	return
+}
+
+// IsSetF12 returns true if F12 is set.
+func (v *Wide) IsSetF12() bool {
+	return v != nil && v.presence[0]&(1<<11) != 0
+}
+
+// HasF12 returns true if F12 is set.
+func (v *Wide) HasF12() bool {
+	return v != nil && v.presence[0]&(1<<11) != 0
+}
+
+// ClearF12 unsets F12.
+func (v *Wide) ClearF12() {
+	var x int32
+	v.F12 = x
+	v.presence[0] &^= (1 << 11)
+}
+
+// refF12 returns a pointer to F12 if it is set, and
+// nil otherwise.
+func (v *Wide) refF12() *int32 {
+	if v.presence[0]&(1<<11) != 0 {
+		return &v.F12
+	}
+	return nil
+}
+
+// SetF12 sets the value of F12.
+func (v *Wide) SetF12(x int32) {
+	v.F12 = x
+	v.presence[0] |= (1 << 11)
+}
+
+// GetF13 returns the value of F13 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF13() (o int32) {
+	if v != nil && v.presence[0]&(1<<12) != 0 {
+		return v.F13
+	}
+
+	return
+}
+
+// IsSetF13 returns true if F13 is set.
+func (v *Wide) IsSetF13() bool {
+	return v != nil && v.presence[0]&(1<<12) != 0
+}
+
+// HasF13 returns true if F13 is set.
+func (v *Wide) HasF13() bool {
+	return v != nil && v.presence[0]&(1<<12) != 0
+}
+
+// ClearF13 unsets F13.
+func (v *Wide) ClearF13() {
+	var x int32
+	v.F13 = x
+	v.presence[0] &^= (1 << 12)
+}
+
+// refF13 returns a pointer to F13 if it is set, and
+// nil otherwise.
+func (v *Wide) refF13() *int32 {
+	if v.presence[0]&(1<<12) != 0 {
+		return &v.F13
+	}
+	return nil
+}
+
+// SetF13 sets the value of F13.
+func (v *Wide) SetF13(x int32) {
+	v.F13 = x
+	v.presence[0] |= (1 << 12)
+}
+
+// GetF14 returns the value of F14 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF14() (o int32) {
+	if v != nil && v.presence[0]&(1<<13) != 0 {
+		return v.F14
+	}
+
+	return
+}
+
+// IsSetF14 returns true if F14 is set.
+func (v *Wide) IsSetF14() bool {
+	return v != nil && v.presence[0]&(1<<13) != 0
+}
+
+// HasF14 returns true if F14 is set.
+func (v *Wide) HasF14() bool {
+	return v != nil && v.presence[0]&(1<<13) != 0
+}
+
+// ClearF14 unsets F14.
+func (v *Wide) ClearF14() {
+	var x int32
+	v.F14 = x
+	v.presence[0] &^= (1 << 13)
+}
+
+// refF14 returns a pointer to F14 if it is set, and
+// nil otherwise.
+func (v *Wide) refF14() *int32 {
+	if v.presence[0]&(1<<13) != 0 {
+		return &v.F14
+	}
+	return nil
+}
+
+// SetF14 sets the value of F14.
+func (v *Wide) SetF14(x int32) {
+	v.F14 = x
+	v.presence[0] |= (1 << 13)
+}
+
+// GetF15 returns the value of F15 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF15() (o int32) {
+	if v != nil && v.presence[0]&(1<<14) != 0 {
+		return v.F15
+	}
+
+	return
+}
+
+// IsSetF15 returns true if F15 is set.
+func (v *Wide) IsSetF15() bool {
+	return v != nil && v.presence[0]&(1<<14) != 0
+}
+
+// HasF15 returns true if F15 is set.
+func (v *Wide) HasF15() bool {
+	return v != nil && v.presence[0]&(1<<14) != 0
+}
+
+// ClearF15 unsets F15.
+func (v *Wide) ClearF15() {
+	var x int32
+	v.F15 = x
+	v.presence[0] &^= (1 << 14)
+}
+
+// refF15 returns a pointer to F15 if it is set, and
+// nil otherwise.
+func (v *Wide) refF15() *int32 {
+	if v.presence[0]&(1<<14) != 0 {
+		return &v.F15
+	}
+	return nil
+}
+
+// SetF15 sets the value of F15.
+func (v *Wide) SetF15(x int32) {
+	v.F15 = x
+	v.presence[0] |= (1 << 14)
+}
+
+// GetF16 returns the value of F16 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF16() (o int32) {
+	if v != nil && v.presence[0]&(1<<15) != 0 {
+		return v.F16
+	}
+
+	return
+}
+
+// IsSetF16 returns true if F16 is set.
+func (v *Wide) IsSetF16() bool {
+	return v != nil && v.presence[0]&(1<<15) != 0
+}
+
+// HasF16 returns true if F16 is set.
+func (v *Wide) HasF16() bool {
+	return v != nil && v.presence[0]&(1<<15) != 0
+}
+
+// ClearF16 unsets F16.
+func (v *Wide) ClearF16() {
+	var x int32
+	v.F16 = x
+	v.presence[0] &^= (1 << 15)
+}
+
+// refF16 returns a pointer to F16 if it is set, and
+// nil otherwise.
+func (v *Wide) refF16() *int32 {
+	if v.presence[0]&(1<<15) != 0 {
+		return &v.F16
+	}
+	return nil
+}
+
+// SetF16 sets the value of F16.
+func (v *Wide) SetF16(x int32) {
+	v.F16 = x
+	v.presence[0] |= (1 << 15)
+}
+
+// GetF17 returns the value of F17 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF17() (o int32) {
+	if v != nil && v.presence[0]&(1<<16) != 0 {
+		return v.F17
+	}
+
+	return
+}
+
+// IsSetF17 returns true if F17 is set.
+func (v *Wide) IsSetF17() bool {
+	return v != nil && v.presence[0]&(1<<16) != 0
+}
+
+// HasF17 returns true if F17 is set.
+func (v *Wide) HasF17() bool {
+	return v != nil && v.presence[0]&(1<<16) != 0
+}
+
+// ClearF17 unsets F17.
+func (v *Wide) ClearF17() {
+	var x int32
+	v.F17 = x
+	v.presence[0] &^= (1 << 16)
+}
+
+// refF17 returns a pointer to F17 if it is set, and
+// nil otherwise.
+func (v *Wide) refF17() *int32 {
+	if v.presence[0]&(1<<16) != 0 {
+		return &v.F17
+	}
+	return nil
+}
+
+// SetF17 sets the value of F17.
+func (v *Wide) SetF17(x int32) {
+	v.F17 = x
+	v.presence[0] |= (1 << 16)
+}
+
+// GetF18 returns the value of F18 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF18() (o int32) {
+	if v != nil && v.presence[0]&(1<<17) != 0 {
+		return v.F18
+	}
+
+	return
+}
+
+// IsSetF18 returns true if F18 is set.
+func (v *Wide) IsSetF18() bool {
+	return v != nil && v.presence[0]&(1<<17) != 0
+}
+
+// HasF18 returns true if F18 is set.
+func (v *Wide) HasF18() bool {
+	return v != nil && v.presence[0]&(1<<17) != 0
+}
+
+// ClearF18 unsets F18.
+func (v *Wide) ClearF18() {
+	var x int32
+	v.F18 = x
+	v.presence[0] &^= (1 << 17)
+}
+
+// refF18 returns a pointer to F18 if it is set, and
+// nil otherwise.
+func (v *Wide) refF18() *int32 {
+	if v.presence[0]&(1<<17) != 0 {
+		return &v.F18
+	}
+	return nil
+}
+
+// SetF18 sets the value of F18.
+func (v *Wide) SetF18(x int32) {
+	v.F18 = x
+	v.presence[0] |= (1 << 17)
+}
+
+// GetF19 returns the value of F19 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF19() (o int32) {
+	if v != nil && v.presence[0]&(1<<18) != 0 {
+		return v.F19
+	}
+
+	return
+}
+
+// IsSetF19 returns true if F19 is set.
+func (v *Wide) IsSetF19() bool {
+	return v != nil && v.presence[0]&(1<<18) != 0
+}
+
+// HasF19 returns true if F19 is set.
+func (v *Wide) HasF19() bool {
+	return v != nil && v.presence[0]&(1<<18) != 0
+}
+
+// ClearF19 unsets F19.
+func (v *Wide) ClearF19() {
+	var x int32
+	v.F19 = x
+	v.presence[0] &^= (1 << 18)
+}
+
+// refF19 returns a pointer to F19 if it is set, and
+// nil otherwise.
+func (v *Wide) refF19() *int32 {
+	if v.presence[0]&(1<<18) != 0 {
+		return &v.F19
+	}
+	return nil
+}
+
+// SetF19 sets the value of F19.
+func (v *Wide) SetF19(x int32) {
+	v.F19 = x
+	v.presence[0] |= (1 << 18)
+}
+
+// GetF20 returns the value of F20 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF20() (o int32) {
+	if v != nil && v.presence[0]&(1<<19) != 0 {
+		return v.F20
+	}
+
+	return
+}
+
+// IsSetF20 returns true if F20 is set.
+func (v *Wide) IsSetF20() bool {
+	return v != nil && v.presence[0]&(1<<19) != 0
+}
+
+// HasF20 returns true if F20 is set.
+func (v *Wide) HasF20() bool {
+	return v != nil && v.presence[0]&(1<<19) != 0
+}
+
+// ClearF20 unsets F20.
+func (v *Wide) ClearF20() {
+	var x int32
+	v.F20 = x
+	v.presence[0] &^= (1 << 19)
+}
+
+// refF20 returns a pointer to F20 if it is set, and
+// nil otherwise.
+func (v *Wide) refF20() *int32 {
+	if v.presence[0]&(1<<19) != 0 {
+		return &v.F20
+	}
+	return nil
+}
+
+// SetF20 sets the value of F20.
+func (v *Wide) SetF20(x int32) {
+	v.F20 = x
+	v.presence[0] |= (1 << 19)
+}
+
+// GetF21 returns the value of F21 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF21() (o int32) {
+	if v != nil && v.presence[0]&(1<<20) != 0 {
+		return v.F21
+	}
+
+	return
+}
+
+// IsSetF21 returns true if F21 is set.
+func (v *Wide) IsSetF21() bool {
+	return v != nil && v.presence[0]&(1<<20) != 0
+}
+
+// HasF21 returns true if F21 is set.
+func (v *Wide) HasF21() bool {
+	return v != nil && v.presence[0]&(1<<20) != 0
+}
+
+// ClearF21 unsets F21.
+func (v *Wide) ClearF21() {
+	var x int32
+	v.F21 = x
+	v.presence[0] &^= (1 << 20)
+}
+
+// refF21 returns a pointer to F21 if it is set, and
+// nil otherwise.
+func (v *Wide) refF21() *int32 {
+	if v.presence[0]&(1<<20) != 0 {
+		return &v.F21
+	}
+	return nil
+}
+
+// SetF21 sets the value of F21.
+func (v *Wide) SetF21(x int32) {
+	v.F21 = x
+	v.presence[0] |= (1 << 20)
+}
+
+// GetF22 returns the value of F22 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF22() (o int32) {
+	if v != nil && v.presence[0]&(1<<21) != 0 {
+		return v.F22
+	}
+
+	return
+}
+
+// IsSetF22 returns true if F22 is set.
+func (v *Wide) IsSetF22() bool {
+	return v != nil && v.presence[0]&(1<<21) != 0
+}
+
+// HasF22 returns true if F22 is set.
+func (v *Wide) HasF22() bool {
+	return v != nil && v.presence[0]&(1<<21) != 0
+}
+
+// ClearF22 unsets F22.
+func (v *Wide) ClearF22() {
+	var x int32
+	v.F22 = x
+	v.presence[0] &^= (1 << 21)
+}
+
+// refF22 returns a pointer to F22 if it is set, and
+// nil otherwise.
+func (v *Wide) refF22() *int32 {
+	if v.presence[0]&(1<<21) != 0 {
+		return &v.F22
+	}
+	return nil
+}
+
+// SetF22 sets the value of F22.
+func (v *Wide) SetF22(x int32) {
+	v.F22 = x
+	v.presence[0] |= (1 << 21)
+}
+
+// GetF23 returns the value of F23 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF23() (o int32) {
+	if v != nil && v.presence[0]&(1<<22) != 0 {
+		return v.F23
+	}
+
+	return
+}
+
+// IsSetF23 returns true if F23 is set.
+func (v *Wide) IsSetF23() bool {
+	return v != nil && v.presence[0]&(1<<22) != 0
+}
+
+// HasF23 returns true if F23 is set.
+func (v *Wide) HasF23() bool {
+	return v != nil && v.presence[0]&(1<<22) != 0
+}
+
+// ClearF23 unsets F23.
+func (v *Wide) ClearF23() {
+	var x int32
+	v.F23 = x
+	v.presence[0] &^= (1 << 22)
+}
+
+// refF23 returns a pointer to F23 if it is set, and
+// nil otherwise.
+func (v *Wide) refF23() *int32 {
+	if v.presence[0]&(1<<22) != 0 {
+		return &v.F23
+	}
+	return nil
+}
+
+// SetF23 sets the value of F23.
+func (v *Wide) SetF23(x int32) {
+	v.F23 = x
+	v.presence[0] |= (1 << 22)
+}
+
+// GetF24 returns the value of F24 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF24() (o int32) {
+	if v != nil && v.presence[0]&(1<<23) != 0 {
+		return v.F24
+	}
+
+	return
+}
+
+// IsSetF24 returns true if F24 is set.
+func (v *Wide) IsSetF24() bool {
+	return v != nil && v.presence[0]&(1<<23) != 0
+}
+
+// HasF24 returns true if F24 is set.
+func (v *Wide) HasF24() bool {
+	return v != nil && v.presence[0]&(1<<23) != 0
+}
+
+// ClearF24 unsets F24.
+func (v *Wide) ClearF24() {
+	var x int32
+	v.F24 = x
+	v.presence[0] &^= (1 << 23)
+}
+
+// refF24 returns a pointer to F24 if it is set, and
+// nil otherwise.
+func (v *Wide) refF24() *int32 {
+	if v.presence[0]&(1<<23) != 0 {
+		return &v.F24
+	}
+	return nil
+}
+
+// SetF24 sets the value of F24.
+func (v *Wide) SetF24(x int32) {
+	v.F24 = x
+	v.presence[0] |= (1 << 23)
+}
+
+// GetF25 returns the value of F25 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF25() (o int32) {
+	if v != nil && v.presence[0]&(1<<24) != 0 {
+		return v.F25
+	}
+
+	return
+}
+
+// IsSetF25 returns true if F25 is set.
+func (v *Wide) IsSetF25() bool {
+	return v != nil && v.presence[0]&(1<<24) != 0
+}
+
+// HasF25 returns true if F25 is set.
+func (v *Wide) HasF25() bool {
+	return v != nil && v.presence[0]&(1<<24) != 0
+}
+
+// ClearF25 unsets F25.
+func (v *Wide) ClearF25() {
+	var x int32
+	v.F25 = x
+	v.presence[0] &^= (1 << 24)
+}
+
+// refF25 returns a pointer to F25 if it is set, and
+// nil otherwise.
+func (v *Wide) refF25() *int32 {
+	if v.presence[0]&(1<<24) != 0 {
+		return &v.F25
+	}
+	return nil
+}
+
+// SetF25 sets the value of F25.
+func (v *Wide) SetF25(x int32) {
+	v.F25 = x
+	v.presence[0] |= (1 << 24)
+}
+
+// GetF26 returns the value of F26 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF26() (o int32) {
+	if v != nil && v.presence[0]&(1<<25) != 0 {
+		return v.F26
+	}
+
+	return
+}
+
+// IsSetF26 returns true if F26 is set.
+func (v *Wide) IsSetF26() bool {
+	return v != nil && v.presence[0]&(1<<25) != 0
+}
+
+// HasF26 returns true if F26 is set.
+func (v *Wide) HasF26() bool {
+	return v != nil && v.presence[0]&(1<<25) != 0
+}
+
+// ClearF26 unsets F26.
+func (v *Wide) ClearF26() {
+	var x int32
+	v.F26 = x
+	v.presence[0] &^= (1 << 25)
+}
+
+// refF26 returns a pointer to F26 if it is set, and
+// nil otherwise.
+func (v *Wide) refF26() *int32 {
+	if v.presence[0]&(1<<25) != 0 {
+		return &v.F26
+	}
+	return nil
+}
+
+// SetF26 sets the value of F26.
+func (v *Wide) SetF26(x int32) {
+	v.F26 = x
+	v.presence[0] |= (1 << 25)
+}
+
+// GetF27 returns the value of F27 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF27() (o int32) {
+	if v != nil && v.presence[0]&(1<<26) != 0 {
+		return v.F27
+	}
+
+	return
+}
+
+// IsSetF27 returns true if F27 is set.
+func (v *Wide) IsSetF27() bool {
+	return v != nil && v.presence[0]&(1<<26) != 0
+}
+
+// HasF27 returns true if F27 is set.
+func (v *Wide) HasF27() bool {
+	return v != nil && v.presence[0]&(1<<26) != 0
+}
+
+// ClearF27 unsets F27.
+func (v *Wide) ClearF27() {
+	var x int32
+	v.F27 = x
+	v.presence[0] &^= (1 << 26)
+}
+
+// refF27 returns a pointer to F27 if it is set, and
+// nil otherwise.
+func (v *Wide) refF27() *int32 {
+	if v.presence[0]&(1<<26) != 0 {
+		return &v.F27
+	}
+	return nil
+}
+
+// SetF27 sets the value of F27.
+func (v *Wide) SetF27(x int32) {
+	v.F27 = x
+	v.presence[0] |= (1 << 26)
+}
+
+// GetF28 returns the value of F28 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF28() (o int32) {
+	if v != nil && v.presence[0]&(1<<27) != 0 {
+		return v.F28
+	}
+
+	return
+}
+
+// IsSetF28 returns true if F28 is set.
+func (v *Wide) IsSetF28() bool {
+	return v != nil && v.presence[0]&(1<<27) != 0
+}
+
+// HasF28 returns true if F28 is set.
+func (v *Wide) HasF28() bool {
+	return v != nil && v.presence[0]&(1<<27) != 0
+}
+
+// ClearF28 unsets F28.
+func (v *Wide) ClearF28() {
+	var x int32
+	v.F28 = x
+	v.presence[0] &^= (1 << 27)
+}
+
+// refF28 returns a pointer to F28 if it is set, and
+// nil otherwise.
+func (v *Wide) refF28() *int32 {
+	if v.presence[0]&(1<<27) != 0 {
+		return &v.F28
+	}
+	return nil
+}
+
+// SetF28 sets the value of F28.
+func (v *Wide) SetF28(x int32) {
+	v.F28 = x
+	v.presence[0] |= (1 << 27)
+}
+
+// GetF29 returns the value of F29 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF29() (o int32) {
+	if v != nil && v.presence[0]&(1<<28) != 0 {
+		return v.F29
+	}
+
+	return
+}
+
+// IsSetF29 returns true if F29 is set.
+func (v *Wide) IsSetF29() bool {
+	return v != nil && v.presence[0]&(1<<28) != 0
+}
+
+// HasF29 returns true if F29 is set.
+func (v *Wide) HasF29() bool {
+	return v != nil && v.presence[0]&(1<<28) != 0
+}
+
+// ClearF29 unsets F29.
+func (v *Wide) ClearF29() {
+	var x int32
+	v.F29 = x
+	v.presence[0] &^= (1 << 28)
+}
+
+// refF29 returns a pointer to F29 if it is set, and
+// nil otherwise.
+func (v *Wide) refF29() *int32 {
+	if v.presence[0]&(1<<28) != 0 {
+		return &v.F29
+	}
+	return nil
+}
+
+// SetF29 sets the value of F29.
+func (v *Wide) SetF29(x int32) {
+	v.F29 = x
+	v.presence[0] |= (1 << 28)
+}
+
+// GetF30 returns the value of F30 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF30() (o int32) {
+	if v != nil && v.presence[0]&(1<<29) != 0 {
+		return v.F30
+	}
+
+	return
+}
+
+// IsSetF30 returns true if F30 is set.
+func (v *Wide) IsSetF30() bool {
+	return v != nil && v.presence[0]&(1<<29) != 0
+}
+
+// HasF30 returns true if F30 is set.
+func (v *Wide) HasF30() bool {
+	return v != nil && v.presence[0]&(1<<29) != 0
+}
+
+// ClearF30 unsets F30.
+func (v *Wide) ClearF30() {
+	var x int32
+	v.F30 = x
+	v.presence[0] &^= (1 << 29)
+}
+
+// refF30 returns a pointer to F30 if it is set, and
+// nil otherwise.
+func (v *Wide) refF30() *int32 {
+	if v.presence[0]&(1<<29) != 0 {
+		return &v.F30
+	}
+	return nil
+}
+
+// SetF30 sets the value of F30.
+func (v *Wide) SetF30(x int32) {
+	v.F30 = x
+	v.presence[0] |= (1 << 29)
+}
+
+// GetF31 returns the value of F31 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF31() (o int32) {
+	if v != nil && v.presence[0]&(1<<30) != 0 {
+		return v.F31
+	}
+
+	return
+}
+
+// IsSetF31 returns true if F31 is set.
+func (v *Wide) IsSetF31() bool {
+	return v != nil && v.presence[0]&(1<<30) != 0
+}
+
+// HasF31 returns true if F31 is set.
+func (v *Wide) HasF31() bool {
+	return v != nil && v.presence[0]&(1<<30) != 0
+}
+
+// ClearF31 unsets F31.
+func (v *Wide) ClearF31() {
+	var x int32
+	v.F31 = x
+	v.presence[0] &^= (1 << 30)
+}
+
+// refF31 returns a pointer to F31 if it is set, and
+// nil otherwise.
+func (v *Wide) refF31() *int32 {
+	if v.presence[0]&(1<<30) != 0 {
+		return &v.F31
+	}
+	return nil
+}
+
+// SetF31 sets the value of F31.
+func (v *Wide) SetF31(x int32) {
+	v.F31 = x
+	v.presence[0] |= (1 << 30)
+}
+
+// GetF32 returns the value of F32 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF32() (o int32) {
+	if v != nil && v.presence[0]&(1<<31) != 0 {
+		return v.F32
+	}
+
+	return
+}
+
+// IsSetF32 returns true if F32 is set.
+func (v *Wide) IsSetF32() bool {
+	return v != nil && v.presence[0]&(1<<31) != 0
+}
+
+// HasF32 returns true if F32 is set.
+func (v *Wide) HasF32() bool {
+	return v != nil && v.presence[0]&(1<<31) != 0
+}
+
+// ClearF32 unsets F32.
+func (v *Wide) ClearF32() {
+	var x int32
+	v.F32 = x
+	v.presence[0] &^= (1 << 31)
+}
+
+// refF32 returns a pointer to F32 if it is set, and
+// nil otherwise.
+func (v *Wide) refF32() *int32 {
+	if v.presence[0]&(1<<31) != 0 {
+		return &v.F32
+	}
+	return nil
+}
+
+// SetF32 sets the value of F32.
+func (v *Wide) SetF32(x int32) {
+	v.F32 = x
+	v.presence[0] |= (1 << 31)
+}
+
+// GetF33 returns the value of F33 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF33() (o int32) {
+	if v != nil && v.presence[0]&(1<<32) != 0 {
+		return v.F33
+	}
+
+	return
+}
+
+// IsSetF33 returns true if F33 is set.
+func (v *Wide) IsSetF33() bool {
+	return v != nil && v.presence[0]&(1<<32) != 0
+}
+
+// HasF33 returns true if F33 is set.
+func (v *Wide) HasF33() bool {
+	return v != nil && v.presence[0]&(1<<32) != 0
+}
+
+// ClearF33 unsets F33.
+func (v *Wide) ClearF33() {
+	var x int32
+	v.F33 = x
+	v.presence[0] &^= (1 << 32)
+}
+
+// refF33 returns a pointer to F33 if it is set, and
+// nil otherwise.
+func (v *Wide) refF33() *int32 {
+	if v.presence[0]&(1<<32) != 0 {
+		return &v.F33
+	}
+	return nil
+}
+
+// SetF33 sets the value of F33.
+func (v *Wide) SetF33(x int32) {
+	v.F33 = x
+	v.presence[0] |= (1 << 32)
+}
+
+// GetF34 returns the value of F34 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF34() (o int32) {
+	if v != nil && v.presence[0]&(1<<33) != 0 {
+		return v.F34
+	}
+
+	return
+}
+
+// IsSetF34 returns true if F34 is set.
+func (v *Wide) IsSetF34() bool {
+	return v != nil && v.presence[0]&(1<<33) != 0
+}
+
+// HasF34 returns true if F34 is set.
+func (v *Wide) HasF34() bool {
+	return v != nil && v.presence[0]&(1<<33) != 0
+}
+
+// ClearF34 unsets F34.
+func (v *Wide) ClearF34() {
+	var x int32
+	v.F34 = x
+	v.presence[0] &^= (1 << 33)
+}
+
+// refF34 returns a pointer to F34 if it is set, and
+// nil otherwise.
+func (v *Wide) refF34() *int32 {
+	if v.presence[0]&(1<<33) != 0 {
+		return &v.F34
+	}
+	return nil
+}
+
+// SetF34 sets the value of F34.
+func (v *Wide) SetF34(x int32) {
+	v.F34 = x
+	v.presence[0] |= (1 << 33)
+}
+
+// GetF35 returns the value of F35 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF35() (o int32) {
+	if v != nil && v.presence[0]&(1<<34) != 0 {
+		return v.F35
+	}
+
+	return
+}
+
+// IsSetF35 returns true if F35 is set.
+func (v *Wide) IsSetF35() bool {
+	return v != nil && v.presence[0]&(1<<34) != 0
+}
+
+// HasF35 returns true if F35 is set.
+func (v *Wide) HasF35() bool {
+	return v != nil && v.presence[0]&(1<<34) != 0
+}
+
+// ClearF35 unsets F35.
+func (v *Wide) ClearF35() {
+	var x int32
+	v.F35 = x
+	v.presence[0] &^= (1 << 34)
+}
+
+// refF35 returns a pointer to F35 if it is set, and
+// nil otherwise.
+func (v *Wide) refF35() *int32 {
+	if v.presence[0]&(1<<34) != 0 {
+		return &v.F35
+	}
+	return nil
+}
+
+// SetF35 sets the value of F35.
+func (v *Wide) SetF35(x int32) {
+	v.F35 = x
+	v.presence[0] |= (1 << 34)
+}
+
+// GetF36 returns the value of F36 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF36() (o int32) {
+	if v != nil && v.presence[0]&(1<<35) != 0 {
+		return v.F36
+	}
+
+	return
+}
+
+// IsSetF36 returns true if F36 is set.
+func (v *Wide) IsSetF36() bool {
+	return v != nil && v.presence[0]&(1<<35) != 0
+}
+
+// HasF36 returns true if F36 is set.
+func (v *Wide) HasF36() bool {
+	return v != nil && v.presence[0]&(1<<35) != 0
+}
+
+// ClearF36 unsets F36.
+func (v *Wide) ClearF36() {
+	var x int32
+	v.F36 = x
+	v.presence[0] &^= (1 << 35)
+}
+
+// refF36 returns a pointer to F36 if it is set, and
+// nil otherwise.
+func (v *Wide) refF36() *int32 {
+	if v.presence[0]&(1<<35) != 0 {
+		return &v.F36
+	}
+	return nil
+}
+
+// SetF36 sets the value of F36.
+func (v *Wide) SetF36(x int32) {
+	v.F36 = x
+	v.presence[0] |= (1 << 35)
+}
+
+// GetF37 returns the value of F37 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF37() (o int32) {
+	if v != nil && v.presence[0]&(1<<36) != 0 {
+		return v.F37
+	}
+
+	return
+}
+
+// IsSetF37 returns true if F37 is set.
+func (v *Wide) IsSetF37() bool {
+	return v != nil && v.presence[0]&(1<<36) != 0
+}
+
+// HasF37 returns true if F37 is set.
+func (v *Wide) HasF37() bool {
+	return v != nil && v.presence[0]&(1<<36) != 0
+}
+
+// ClearF37 unsets F37.
+func (v *Wide) ClearF37() {
+	var x int32
+	v.F37 = x
+	v.presence[0] &^= (1 << 36)
+}
+
+// refF37 returns a pointer to F37 if it is set, and
+// nil otherwise.
+func (v *Wide) refF37() *int32 {
+	if v.presence[0]&(1<<36) != 0 {
+		return &v.F37
+	}
+	return nil
+}
+
+// SetF37 sets the value of F37.
+func (v *Wide) SetF37(x int32) {
+	v.F37 = x
+	v.presence[0] |= (1 << 36)
+}
+
+// GetF38 returns the value of F38 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF38() (o int32) {
+	if v != nil && v.presence[0]&(1<<37) != 0 {
+		return v.F38
+	}
+
+	return
+}
+
+// IsSetF38 returns true if F38 is set.
+func (v *Wide) IsSetF38() bool {
+	return v != nil && v.presence[0]&(1<<37) != 0
+}
+
+// HasF38 returns true if F38 is set.
+func (v *Wide) HasF38() bool {
+	return v != nil && v.presence[0]&(1<<37) != 0
+}
+
+// ClearF38 unsets F38.
+func (v *Wide) ClearF38() {
+	var x int32
+	v.F38 = x
+	v.presence[0] &^= (1 << 37)
+}
+
+// refF38 returns a pointer to F38 if it is set, and
+// nil otherwise.
+func (v *Wide) refF38() *int32 {
+	if v.presence[0]&(1<<37) != 0 {
+		return &v.F38
+	}
+	return nil
+}
+
+// SetF38 sets the value of F38.
+func (v *Wide) SetF38(x int32) {
+	v.F38 = x
+	v.presence[0] |= (1 << 37)
+}
+
+// GetF39 returns the value of F39 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF39() (o int32) {
+	if v != nil && v.presence[0]&(1<<38) != 0 {
+		return v.F39
+	}
+
+	return
+}
+
+// IsSetF39 returns true if F39 is set.
+func (v *Wide) IsSetF39() bool {
+	return v != nil && v.presence[0]&(1<<38) != 0
+}
+
+// HasF39 returns true if F39 is set.
+func (v *Wide) HasF39() bool {
+	return v != nil && v.presence[0]&(1<<38) != 0
+}
+
+// ClearF39 unsets F39.
+func (v *Wide) ClearF39() {
+	var x int32
+	v.F39 = x
+	v.presence[0] &^= (1 << 38)
+}
+
+// refF39 returns a pointer to F39 if it is set, and
+// nil otherwise.
+func (v *Wide) refF39() *int32 {
+	if v.presence[0]&(1<<38) != 0 {
+		return &v.F39
+	}
+	return nil
+}
+
+// SetF39 sets the value of F39.
+func (v *Wide) SetF39(x int32) {
+	v.F39 = x
+	v.presence[0] |= (1 << 38)
+}
+
+// GetF40 returns the value of F40 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF40() (o int32) {
+	if v != nil && v.presence[0]&(1<<39) != 0 {
+		return v.F40
+	}
+
+	return
+}
+
+// IsSetF40 returns true if F40 is set.
+func (v *Wide) IsSetF40() bool {
+	return v != nil && v.presence[0]&(1<<39) != 0
+}
+
+// HasF40 returns true if F40 is set.
+func (v *Wide) HasF40() bool {
+	return v != nil && v.presence[0]&(1<<39) != 0
+}
+
+// ClearF40 unsets F40.
+func (v *Wide) ClearF40() {
+	var x int32
+	v.F40 = x
+	v.presence[0] &^= (1 << 39)
+}
+
+// refF40 returns a pointer to F40 if it is set, and
+// nil otherwise.
+func (v *Wide) refF40() *int32 {
+	if v.presence[0]&(1<<39) != 0 {
+		return &v.F40
+	}
+	return nil
+}
+
+// SetF40 sets the value of F40.
+func (v *Wide) SetF40(x int32) {
+	v.F40 = x
+	v.presence[0] |= (1 << 39)
+}
+
+// GetF41 returns the value of F41 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF41() (o int32) {
+	if v != nil && v.presence[0]&(1<<40) != 0 {
+		return v.F41
+	}
+
+	return
+}
+
+// IsSetF41 returns true if F41 is set.
+func (v *Wide) IsSetF41() bool {
+	return v != nil && v.presence[0]&(1<<40) != 0
+}
+
+// HasF41 returns true if F41 is set.
+func (v *Wide) HasF41() bool {
+	return v != nil && v.presence[0]&(1<<40) != 0
+}
+
+// ClearF41 unsets F41.
+func (v *Wide) ClearF41() {
+	var x int32
+	v.F41 = x
+	v.presence[0] &^= (1 << 40)
+}
+
+// refF41 returns a pointer to F41 if it is set, and
+// nil otherwise.
+func (v *Wide) refF41() *int32 {
+	if v.presence[0]&(1<<40) != 0 {
+		return &v.F41
+	}
+	return nil
+}
+
+// SetF41 sets the value of F41.
+func (v *Wide) SetF41(x int32) {
+	v.F41 = x
+	v.presence[0] |= (1 << 40)
+}
+
+// GetF42 returns the value of F42 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF42() (o int32) {
+	if v != nil && v.presence[0]&(1<<41) != 0 {
+		return v.F42
+	}
+
+	return
+}
+
+// IsSetF42 returns true if F42 is set.
+func (v *Wide) IsSetF42() bool {
+	return v != nil && v.presence[0]&(1<<41) != 0
+}
+
+// HasF42 returns true if F42 is set.
+func (v *Wide) HasF42() bool {
+	return v != nil && v.presence[0]&(1<<41) != 0
+}
+
+// ClearF42 unsets F42.
+func (v *Wide) ClearF42() {
+	var x int32
+	v.F42 = x
+	v.presence[0] &^= (1 << 41)
+}
+
+// refF42 returns a pointer to F42 if it is set, and
+// nil otherwise.
+func (v *Wide) refF42() *int32 {
+	if v.presence[0]&(1<<41) != 0 {
+		return &v.F42
+	}
+	return nil
+}
+
+// SetF42 sets the value of F42.
+func (v *Wide) SetF42(x int32) {
+	v.F42 = x
+	v.presence[0] |= (1 << 41)
+}
+
+// GetF43 returns the value of F43 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF43() (o int32) {
+	if v != nil && v.presence[0]&(1<<42) != 0 {
+		return v.F43
+	}
+
+	return
+}
+
+// IsSetF43 returns true if F43 is set.
+func (v *Wide) IsSetF43() bool {
+	return v != nil && v.presence[0]&(1<<42) != 0
+}
+
+// HasF43 returns true if F43 is set.
+func (v *Wide) HasF43() bool {
+	return v != nil && v.presence[0]&(1<<42) != 0
+}
+
+// ClearF43 unsets F43.
+func (v *Wide) ClearF43() {
+	var x int32
+	v.F43 = x
+	v.presence[0] &^= (1 << 42)
+}
+
+// refF43 returns a pointer to F43 if it is set, and
+// nil otherwise.
+func (v *Wide) refF43() *int32 {
+	if v.presence[0]&(1<<42) != 0 {
+		return &v.F43
+	}
+	return nil
+}
+
+// SetF43 sets the value of F43.
+func (v *Wide) SetF43(x int32) {
+	v.F43 = x
+	v.presence[0] |= (1 << 42)
+}
+
+// GetF44 returns the value of F44 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF44() (o int32) {
+	if v != nil && v.presence[0]&(1<<43) != 0 {
+		return v.F44
+	}
+
+	return
+}
+
+// IsSetF44 returns true if F44 is set.
+func (v *Wide) IsSetF44() bool {
+	return v != nil && v.presence[0]&(1<<43) != 0
+}
+
+// HasF44 returns true if F44 is set.
+func (v *Wide) HasF44() bool {
+	return v != nil && v.presence[0]&(1<<43) != 0
+}
+
+// ClearF44 unsets F44.
+func (v *Wide) ClearF44() {
+	var x int32
+	v.F44 = x
+	v.presence[0] &^= (1 << 43)
+}
+
+// refF44 returns a pointer to F44 if it is set, and
+// nil otherwise.
+func (v *Wide) refF44() *int32 {
+	if v.presence[0]&(1<<43) != 0 {
+		return &v.F44
+	}
+	return nil
+}
+
+// SetF44 sets the value of F44.
+func (v *Wide) SetF44(x int32) {
+	v.F44 = x
+	v.presence[0] |= (1 << 43)
+}
+
+// GetF45 returns the value of F45 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF45() (o int32) {
+	if v != nil && v.presence[0]&(1<<44) != 0 {
+		return v.F45
+	}
+
+	return
+}
+
+// IsSetF45 returns true if F45 is set.
+func (v *Wide) IsSetF45() bool {
+	return v != nil && v.presence[0]&(1<<44) != 0
+}
+
+// HasF45 returns true if F45 is set.
+func (v *Wide) HasF45() bool {
+	return v != nil && v.presence[0]&(1<<44) != 0
+}
+
+// ClearF45 unsets F45.
+func (v *Wide) ClearF45() {
+	var x int32
+	v.F45 = x
+	v.presence[0] &^= (1 << 44)
+}
+
+// refF45 returns a pointer to F45 if it is set, and
+// nil otherwise.
+func (v *Wide) refF45() *int32 {
+	if v.presence[0]&(1<<44) != 0 {
+		return &v.F45
+	}
+	return nil
+}
+
+// SetF45 sets the value of F45.
+func (v *Wide) SetF45(x int32) {
+	v.F45 = x
+	v.presence[0] |= (1 << 44)
+}
+
+// GetF46 returns the value of F46 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF46() (o int32) {
+	if v != nil && v.presence[0]&(1<<45) != 0 {
+		return v.F46
+	}
+
+	return
+}
+
+// IsSetF46 returns true if F46 is set.
+func (v *Wide) IsSetF46() bool {
+	return v != nil && v.presence[0]&(1<<45) != 0
+}
+
+// HasF46 returns true if F46 is set.
+func (v *Wide) HasF46() bool {
+	return v != nil && v.presence[0]&(1<<45) != 0
+}
+
+// ClearF46 unsets F46.
+func (v *Wide) ClearF46() {
+	var x int32
+	v.F46 = x
+	v.presence[0] &^= (1 << 45)
+}
+
+// refF46 returns a pointer to F46 if it is set, and
+// nil otherwise.
+func (v *Wide) refF46() *int32 {
+	if v.presence[0]&(1<<45) != 0 {
+		return &v.F46
+	}
+	return nil
+}
+
+// SetF46 sets the value of F46.
+func (v *Wide) SetF46(x int32) {
+	v.F46 = x
+	v.presence[0] |= (1 << 45)
+}
+
+// GetF47 returns the value of F47 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF47() (o int32) {
+	if v != nil && v.presence[0]&(1<<46) != 0 {
+		return v.F47
+	}
+
+	return
+}
+
+// IsSetF47 returns true if F47 is set.
+func (v *Wide) IsSetF47() bool {
+	return v != nil && v.presence[0]&(1<<46) != 0
+}
+
+// HasF47 returns true if F47 is set.
+func (v *Wide) HasF47() bool {
+	return v != nil && v.presence[0]&(1<<46) != 0
+}
+
+// ClearF47 unsets F47.
+func (v *Wide) ClearF47() {
+	var x int32
+	v.F47 = x
+	v.presence[0] &^= (1 << 46)
+}
+
+// refF47 returns a pointer to F47 if it is set, and
+// nil otherwise.
+func (v *Wide) refF47() *int32 {
+	if v.presence[0]&(1<<46) != 0 {
+		return &v.F47
+	}
+	return nil
+}
+
+// SetF47 sets the value of F47.
+func (v *Wide) SetF47(x int32) {
+	v.F47 = x
+	v.presence[0] |= (1 << 46)
+}
+
+// GetF48 returns the value of F48 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF48() (o int32) {
+	if v != nil && v.presence[0]&(1<<47) != 0 {
+		return v.F48
+	}
+
+	return
+}
+
+// IsSetF48 returns true if F48 is set.
+func (v *Wide) IsSetF48() bool {
+	return v != nil && v.presence[0]&(1<<47) != 0
+}
+
+// HasF48 returns true if F48 is set.
+func (v *Wide) HasF48() bool {
+	return v != nil && v.presence[0]&(1<<47) != 0
+}
+
+// ClearF48 unsets F48.
+func (v *Wide) ClearF48() {
+	var x int32
+	v.F48 = x
+	v.presence[0] &^= (1 << 47)
+}
+
+// refF48 returns a pointer to F48 if it is set, and
+// nil otherwise.
+func (v *Wide) refF48() *int32 {
+	if v.presence[0]&(1<<47) != 0 {
+		return &v.F48
+	}
+	return nil
+}
+
+// SetF48 sets the value of F48.
+func (v *Wide) SetF48(x int32) {
+	v.F48 = x
+	v.presence[0] |= (1 << 47)
+}
+
+// GetF49 returns the value of F49 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF49() (o int32) {
+	if v != nil && v.presence[0]&(1<<48) != 0 {
+		return v.F49
+	}
+
+	return
+}
+
+// IsSetF49 returns true if F49 is set.
+func (v *Wide) IsSetF49() bool {
+	return v != nil && v.presence[0]&(1<<48) != 0
+}
+
+// HasF49 returns true if F49 is set.
+func (v *Wide) HasF49() bool {
+	return v != nil && v.presence[0]&(1<<48) != 0
+}
+
+// ClearF49 unsets F49.
+func (v *Wide) ClearF49() {
+	var x int32
+	v.F49 = x
+	v.presence[0] &^= (1 << 48)
+}
+
+// refF49 returns a pointer to F49 if it is set, and
+// nil otherwise.
+func (v *Wide) refF49() *int32 {
+	if v.presence[0]&(1<<48) != 0 {
+		return &v.F49
+	}
+	return nil
+}
+
+// SetF49 sets the value of F49.
+func (v *Wide) SetF49(x int32) {
+	v.F49 = x
+	v.presence[0] |= (1 << 48)
+}
+
+// GetF50 returns the value of F50 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF50() (o int32) {
+	if v != nil && v.presence[0]&(1<<49) != 0 {
+		return v.F50
+	}
+
+	return
+}
+
+// IsSetF50 returns true if F50 is set.
+func (v *Wide) IsSetF50() bool {
+	return v != nil && v.presence[0]&(1<<49) != 0
+}
+
+// HasF50 returns true if F50 is set.
+func (v *Wide) HasF50() bool {
+	return v != nil && v.presence[0]&(1<<49) != 0
+}
+
+// ClearF50 unsets F50.
+func (v *Wide) ClearF50() {
+	var x int32
+	v.F50 = x
+	v.presence[0] &^= (1 << 49)
+}
+
+// refF50 returns a pointer to F50 if it is set, and
+// nil otherwise.
+func (v *Wide) refF50() *int32 {
+	if v.presence[0]&(1<<49) != 0 {
+		return &v.F50
+	}
+	return nil
+}
+
+// SetF50 sets the value of F50.
+func (v *Wide) SetF50(x int32) {
+	v.F50 = x
+	v.presence[0] |= (1 << 49)
+}
+
+// GetF51 returns the value of F51 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF51() (o int32) {
+	if v != nil && v.presence[0]&(1<<50) != 0 {
+		return v.F51
+	}
+
+	return
+}
+
+// IsSetF51 returns true if F51 is set.
+func (v *Wide) IsSetF51() bool {
+	return v != nil && v.presence[0]&(1<<50) != 0
+}
+
+// HasF51 returns true if F51 is set.
+func (v *Wide) HasF51() bool {
+	return v != nil && v.presence[0]&(1<<50) != 0
+}
+
+// ClearF51 unsets F51.
+func (v *Wide) ClearF51() {
+	var x int32
+	v.F51 = x
+	v.presence[0] &^= (1 << 50)
+}
+
+// refF51 returns a pointer to F51 if it is set, and
+// nil otherwise.
+func (v *Wide) refF51() *int32 {
+	if v.presence[0]&(1<<50) != 0 {
+		return &v.F51
+	}
+	return nil
+}
+
+// SetF51 sets the value of F51.
+func (v *Wide) SetF51(x int32) {
+	v.F51 = x
+	v.presence[0] |= (1 << 50)
+}
+
+// GetF52 returns the value of F52 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF52() (o int32) {
+	if v != nil && v.presence[0]&(1<<51) != 0 {
+		return v.F52
+	}
+
+	return
+}
+
+// IsSetF52 returns true if F52 is set.
+func (v *Wide) IsSetF52() bool {
+	return v != nil && v.presence[0]&(1<<51) != 0
+}
+
+// HasF52 returns true if F52 is set.
+func (v *Wide) HasF52() bool {
+	return v != nil && v.presence[0]&(1<<51) != 0
+}
+
+// ClearF52 unsets F52.
+func (v *Wide) ClearF52() {
+	var x int32
+	v.F52 = x
+	v.presence[0] &^= (1 << 51)
+}
+
+// refF52 returns a pointer to F52 if it is set, and
+// nil otherwise.
+func (v *Wide) refF52() *int32 {
+	if v.presence[0]&(1<<51) != 0 {
+		return &v.F52
+	}
+	return nil
+}
+
+// SetF52 sets the value of F52.
+func (v *Wide) SetF52(x int32) {
+	v.F52 = x
+	v.presence[0] |= (1 << 51)
+}
+
+// GetF53 returns the value of F53 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF53() (o int32) {
+	if v != nil && v.presence[0]&(1<<52) != 0 {
+		return v.F53
+	}
+
+	return
+}
+
+// IsSetF53 returns true if F53 is set.
+func (v *Wide) IsSetF53() bool {
+	return v != nil && v.presence[0]&(1<<52) != 0
+}
+
+// HasF53 returns true if F53 is set.
+func (v *Wide) HasF53() bool {
+	return v != nil && v.presence[0]&(1<<52) != 0
+}
+
+// ClearF53 unsets F53.
+func (v *Wide) ClearF53() {
+	var x int32
+	v.F53 = x
+	v.presence[0] &^= (1 << 52)
+}
+
+// refF53 returns a pointer to F53 if it is set, and
+// nil otherwise.
+func (v *Wide) refF53() *int32 {
+	if v.presence[0]&(1<<52) != 0 {
+		return &v.F53
+	}
+	return nil
+}
+
+// SetF53 sets the value of F53.
+func (v *Wide) SetF53(x int32) {
+	v.F53 = x
+	v.presence[0] |= (1 << 52)
+}
+
+// GetF54 returns the value of F54 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF54() (o int32) {
+	if v != nil && v.presence[0]&(1<<53) != 0 {
+		return v.F54
+	}
+
+	return
+}
+
+// IsSetF54 returns true if F54 is set.
+func (v *Wide) IsSetF54() bool {
+	return v != nil && v.presence[0]&(1<<53) != 0
+}
+
+// HasF54 returns true if F54 is set.
+func (v *Wide) HasF54() bool {
+	return v != nil && v.presence[0]&(1<<53) != 0
+}
+
+// ClearF54 unsets F54.
+func (v *Wide) ClearF54() {
+	var x int32
+	v.F54 = x
+	v.presence[0] &^= (1 << 53)
+}
+
+// refF54 returns a pointer to F54 if it is set, and
+// nil otherwise.
+func (v *Wide) refF54() *int32 {
+	if v.presence[0]&(1<<53) != 0 {
+		return &v.F54
+	}
+	return nil
+}
+
+// SetF54 sets the value of F54.
+func (v *Wide) SetF54(x int32) {
+	v.F54 = x
+	v.presence[0] |= (1 << 53)
+}
+
+// GetF55 returns the value of F55 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF55() (o int32) {
+	if v != nil && v.presence[0]&(1<<54) != 0 {
+		return v.F55
+	}
+
+	return
+}
+
+// IsSetF55 returns true if F55 is set.
+func (v *Wide) IsSetF55() bool {
+	return v != nil && v.presence[0]&(1<<54) != 0
+}
+
+// HasF55 returns true if F55 is set.
+func (v *Wide) HasF55() bool {
+	return v != nil && v.presence[0]&(1<<54) != 0
+}
+
+// ClearF55 unsets F55.
+func (v *Wide) ClearF55() {
+	var x int32
+	v.F55 = x
+	v.presence[0] &^= (1 << 54)
+}
+
+// refF55 returns a pointer to F55 if it is set, and
+// nil otherwise.
+func (v *Wide) refF55() *int32 {
+	if v.presence[0]&(1<<54) != 0 {
+		return &v.F55
+	}
+	return nil
+}
+
+// SetF55 sets the value of F55.
+func (v *Wide) SetF55(x int32) {
+	v.F55 = x
+	v.presence[0] |= (1 << 54)
+}
+
+// GetF56 returns the value of F56 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF56() (o int32) {
+	if v != nil && v.presence[0]&(1<<55) != 0 {
+		return v.F56
+	}
+
+	return
+}
+
+// IsSetF56 returns true if F56 is set.
+func (v *Wide) IsSetF56() bool {
+	return v != nil && v.presence[0]&(1<<55) != 0
+}
+
+// HasF56 returns true if F56 is set.
+func (v *Wide) HasF56() bool {
+	return v != nil && v.presence[0]&(1<<55) != 0
+}
+
+// ClearF56 unsets F56.
+func (v *Wide) ClearF56() {
+	var x int32
+	v.F56 = x
+	v.presence[0] &^= (1 << 55)
+}
+
+// refF56 returns a pointer to F56 if it is set, and
+// nil otherwise.
+func (v *Wide) refF56() *int32 {
+	if v.presence[0]&(1<<55) != 0 {
+		return &v.F56
+	}
+	return nil
+}
+
+// SetF56 sets the value of F56.
+func (v *Wide) SetF56(x int32) {
+	v.F56 = x
+	v.presence[0] |= (1 << 55)
+}
+
+// GetF57 returns the value of F57 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF57() (o int32) {
+	if v != nil && v.presence[0]&(1<<56) != 0 {
+		return v.F57
+	}
+
+	return
+}
+
+// IsSetF57 returns true if F57 is set.
+func (v *Wide) IsSetF57() bool {
+	return v != nil && v.presence[0]&(1<<56) != 0
+}
+
+// HasF57 returns true if F57 is set.
+func (v *Wide) HasF57() bool {
+	return v != nil && v.presence[0]&(1<<56) != 0
+}
+
+// ClearF57 unsets F57.
+func (v *Wide) ClearF57() {
+	var x int32
+	v.F57 = x
+	v.presence[0] &^= (1 << 56)
+}
+
+// refF57 returns a pointer to F57 if it is set, and
+// nil otherwise.
+func (v *Wide) refF57() *int32 {
+	if v.presence[0]&(1<<56) != 0 {
+		return &v.F57
+	}
+	return nil
+}
+
+// SetF57 sets the value of F57.
+func (v *Wide) SetF57(x int32) {
+	v.F57 = x
+	v.presence[0] |= (1 << 56)
+}
+
+// GetF58 returns the value of F58 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF58() (o int32) {
+	if v != nil && v.presence[0]&(1<<57) != 0 {
+		return v.F58
+	}
+
+	return
+}
+
+// IsSetF58 returns true if F58 is set.
+func (v *Wide) IsSetF58() bool {
+	return v != nil && v.presence[0]&(1<<57) != 0
+}
+
+// HasF58 returns true if F58 is set.
+func (v *Wide) HasF58() bool {
+	return v != nil && v.presence[0]&(1<<57) != 0
+}
+
+// ClearF58 unsets F58.
+func (v *Wide) ClearF58() {
+	var x int32
+	v.F58 = x
+	v.presence[0] &^= (1 << 57)
+}
+
+// refF58 returns a pointer to F58 if it is set, and
+// nil otherwise.
+func (v *Wide) refF58() *int32 {
+	if v.presence[0]&(1<<57) != 0 {
+		return &v.F58
+	}
+	return nil
+}
+
+// SetF58 sets the value of F58.
+func (v *Wide) SetF58(x int32) {
+	v.F58 = x
+	v.presence[0] |= (1 << 57)
+}
+
+// GetF59 returns the value of F59 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF59() (o int32) {
+	if v != nil && v.presence[0]&(1<<58) != 0 {
+		return v.F59
+	}
+
+	return
+}
+
+// IsSetF59 returns true if F59 is set.
+func (v *Wide) IsSetF59() bool {
+	return v != nil && v.presence[0]&(1<<58) != 0
+}
+
+// HasF59 returns true if F59 is set.
+func (v *Wide) HasF59() bool {
+	return v != nil && v.presence[0]&(1<<58) != 0
+}
+
+// ClearF59 unsets F59.
+func (v *Wide) ClearF59() {
+	var x int32
+	v.F59 = x
+	v.presence[0] &^= (1 << 58)
+}
+
+// refF59 returns a pointer to F59 if it is set, and
+// nil otherwise.
+func (v *Wide) refF59() *int32 {
+	if v.presence[0]&(1<<58) != 0 {
+		return &v.F59
+	}
+	return nil
+}
+
+// SetF59 sets the value of F59.
+func (v *Wide) SetF59(x int32) {
+	v.F59 = x
+	v.presence[0] |= (1 << 58)
+}
+
+// GetF60 returns the value of F60 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF60() (o int32) {
+	if v != nil && v.presence[0]&(1<<59) != 0 {
+		return v.F60
+	}
+
+	return
+}
+
+// IsSetF60 returns true if F60 is set.
+func (v *Wide) IsSetF60() bool {
+	return v != nil && v.presence[0]&(1<<59) != 0
+}
+
+// HasF60 returns true if F60 is set.
+func (v *Wide) HasF60() bool {
+	return v != nil && v.presence[0]&(1<<59) != 0
+}
+
+// ClearF60 unsets F60.
+func (v *Wide) ClearF60() {
+	var x int32
+	v.F60 = x
+	v.presence[0] &^= (1 << 59)
+}
+
+// refF60 returns a pointer to F60 if it is set, and
+// nil otherwise.
+func (v *Wide) refF60() *int32 {
+	if v.presence[0]&(1<<59) != 0 {
+		return &v.F60
+	}
+	return nil
+}
+
+// SetF60 sets the value of F60.
+func (v *Wide) SetF60(x int32) {
+	v.F60 = x
+	v.presence[0] |= (1 << 59)
+}
+
+// GetF61 returns the value of F61 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF61() (o int32) {
+	if v != nil && v.presence[0]&(1<<60) != 0 {
+		return v.F61
+	}
+
+	return
+}
+
+// IsSetF61 returns true if F61 is set.
+func (v *Wide) IsSetF61() bool {
+	return v != nil && v.presence[0]&(1<<60) != 0
+}
+
+// HasF61 returns true if F61 is set.
+func (v *Wide) HasF61() bool {
+	return v != nil && v.presence[0]&(1<<60) != 0
+}
+
+// ClearF61 unsets F61.
+func (v *Wide) ClearF61() {
+	var x int32
+	v.F61 = x
+	v.presence[0] &^= (1 << 60)
+}
+
+// refF61 returns a pointer to F61 if it is set, and
+// nil otherwise.
+func (v *Wide) refF61() *int32 {
+	if v.presence[0]&(1<<60) != 0 {
+		return &v.F61
+	}
+	return nil
+}
+
+// SetF61 sets the value of F61.
+func (v *Wide) SetF61(x int32) {
+	v.F61 = x
+	v.presence[0] |= (1 << 60)
+}
+
+// GetF62 returns the value of F62 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF62() (o int32) {
+	if v != nil && v.presence[0]&(1<<61) != 0 {
+		return v.F62
+	}
+
+	return
+}
+
+// IsSetF62 returns true if F62 is set.
+func (v *Wide) IsSetF62() bool {
+	return v != nil && v.presence[0]&(1<<61) != 0
+}
+
+// HasF62 returns true if F62 is set.
+func (v *Wide) HasF62() bool {
+	return v != nil && v.presence[0]&(1<<61) != 0
+}
+
+// ClearF62 unsets F62.
+func (v *Wide) ClearF62() {
+	var x int32
+	v.F62 = x
+	v.presence[0] &^= (1 << 61)
+}
+
+// refF62 returns a pointer to F62 if it is set, and
+// nil otherwise.
+func (v *Wide) refF62() *int32 {
+	if v.presence[0]&(1<<61) != 0 {
+		return &v.F62
+	}
+	return nil
+}
+
+// SetF62 sets the value of F62.
+func (v *Wide) SetF62(x int32) {
+	v.F62 = x
+	v.presence[0] |= (1 << 61)
+}
+
+// GetF63 returns the value of F63 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF63() (o int32) {
+	if v != nil && v.presence[0]&(1<<62) != 0 {
+		return v.F63
+	}
+
+	return
+}
+
+// IsSetF63 returns true if F63 is set.
+func (v *Wide) IsSetF63() bool {
+	return v != nil && v.presence[0]&(1<<62) != 0
+}
+
+// HasF63 returns true if F63 is set.
+func (v *Wide) HasF63() bool {
+	return v != nil && v.presence[0]&(1<<62) != 0
+}
+
+// ClearF63 unsets F63.
+func (v *Wide) ClearF63() {
+	var x int32
+	v.F63 = x
+	v.presence[0] &^= (1 << 62)
+}
+
+// refF63 returns a pointer to F63 if it is set, and
+// nil otherwise.
+func (v *Wide) refF63() *int32 {
+	if v.presence[0]&(1<<62) != 0 {
+		return &v.F63
+	}
+	return nil
+}
+
+// SetF63 sets the value of F63.
+func (v *Wide) SetF63(x int32) {
+	v.F63 = x
+	v.presence[0] |= (1 << 62)
+}
+
+// GetF64 returns the value of F64 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF64() (o int32) {
+	if v != nil && v.presence[0]&(1<<63) != 0 {
+		return v.F64
+	}
+
+	return
+}
+
+// IsSetF64 returns true if F64 is set.
+func (v *Wide) IsSetF64() bool {
+	return v != nil && v.presence[0]&(1<<63) != 0
+}
+
+// HasF64 returns true if F64 is set.
+func (v *Wide) HasF64() bool {
+	return v != nil && v.presence[0]&(1<<63) != 0
+}
+
+// ClearF64 unsets F64.
+func (v *Wide) ClearF64() {
+	var x int32
+	v.F64 = x
+	v.presence[0] &^= (1 << 63)
+}
+
+// refF64 returns a pointer to F64 if it is set, and
+// nil otherwise.
+func (v *Wide) refF64() *int32 {
+	if v.presence[0]&(1<<63) != 0 {
+		return &v.F64
+	}
+	return nil
+}
+
+// SetF64 sets the value of F64.
+func (v *Wide) SetF64(x int32) {
+	v.F64 = x
+	v.presence[0] |= (1 << 63)
+}
+
+// GetF65 returns the value of F65 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF65() (o int32) {
+	if v != nil && v.presence[1]&(1<<0) != 0 {
+		return v.F65
+	}
+
+	return
+}
+
+// IsSetF65 returns true if F65 is set.
+func (v *Wide) IsSetF65() bool {
+	return v != nil && v.presence[1]&(1<<0) != 0
+}
+
+// HasF65 returns true if F65 is set.
+func (v *Wide) HasF65() bool {
+	return v != nil && v.presence[1]&(1<<0) != 0
+}
+
+// ClearF65 unsets F65.
+func (v *Wide) ClearF65() {
+	var x int32
+	v.F65 = x
+	v.presence[1] &^= (1 << 0)
+}
+
+// refF65 returns a pointer to F65 if it is set, and
+// nil otherwise.
+func (v *Wide) refF65() *int32 {
+	if v.presence[1]&(1<<0) != 0 {
+		return &v.F65
+	}
+	return nil
+}
+
+// SetF65 sets the value of F65.
+func (v *Wide) SetF65(x int32) {
+	v.F65 = x
+	v.presence[1] |= (1 << 0)
+}
+
+// GetF66 returns the value of F66 if it is set or its
+// zero value if it is unset.
+func (v *Wide) GetF66() (o int32) {
+	if v != nil && v.presence[1]&(1<<1) != 0 {
+		return v.F66
+	}
+
+	return
+}
+
+// IsSetF66 returns true if F66 is set.
+func (v *Wide) IsSetF66() bool {
+	return v != nil && v.presence[1]&(1<<1) != 0
+}
+
+// HasF66 returns true if F66 is set.
+func (v *Wide) HasF66() bool {
+	return v != nil && v.presence[1]&(1<<1) != 0
+}
+
+// ClearF66 unsets F66.
+func (v *Wide) ClearF66() {
+	var x int32
+	v.F66 = x
+	v.presence[1] &^= (1 << 1)
+}
+
+// refF66 returns a pointer to F66 if it is set, and
+// nil otherwise.
+func (v *Wide) refF66() *int32 {
+	if v.presence[1]&(1<<1) != 0 {
+		return &v.F66
+	}
+	return nil
+}
+
+// SetF66 sets the value of F66.
+func (v *Wide) SetF66(x int32) {
+	v.F66 = x
+	v.presence[1] |= (1 << 1)
+}
+
+// WideBuilder builds Wide values with chained setters. Use
+// NewWideBuilder to construct one.
+type WideBuilder struct {
+	v Wide
+}
+
+// NewWideBuilder returns a builder for Wide values.
+func NewWideBuilder() *WideBuilder {
+	return &WideBuilder{}
+}
+
+// F1 sets the F1 field of the Wide.
+func (b *WideBuilder) F1(value int32) *WideBuilder {
+	b.v.SetF1(value)
+	return b
+}
+
+// F2 sets the F2 field of the Wide.
+func (b *WideBuilder) F2(value int32) *WideBuilder {
+	b.v.SetF2(value)
+	return b
+}
+
+// F3 sets the F3 field of the Wide.
+func (b *WideBuilder) F3(value int32) *WideBuilder {
+	b.v.SetF3(value)
+	return b
+}
+
+// F4 sets the F4 field of the Wide.
+func (b *WideBuilder) F4(value int32) *WideBuilder {
+	b.v.SetF4(value)
+	return b
+}
+
+// F5 sets the F5 field of the Wide.
+func (b *WideBuilder) F5(value int32) *WideBuilder {
+	b.v.SetF5(value)
+	return b
+}
+
+// F6 sets the F6 field of the Wide.
+func (b *WideBuilder) F6(value int32) *WideBuilder {
+	b.v.SetF6(value)
+	return b
+}
+
+// F7 sets the F7 field of the Wide.
+func (b *WideBuilder) F7(value int32) *WideBuilder {
+	b.v.SetF7(value)
+	return b
+}
+
+// F8 sets the F8 field of the Wide.
+func (b *WideBuilder) F8(value int32) *WideBuilder {
+	b.v.SetF8(value)
+	return b
+}
+
+// F9 sets the F9 field of the Wide.
+func (b *WideBuilder) F9(value int32) *WideBuilder {
+	b.v.SetF9(value)
+	return b
+}
+
+// F10 sets the F10 field of the Wide.
+func (b *WideBuilder) F10(value int32) *WideBuilder {
+	b.v.SetF10(value)
+	return b
+}
+
+// F11 sets the F11 field of the Wide.
+func (b *WideBuilder) F11(value int32) *WideBuilder {
+	b.v.SetF11(value)
+	return b
+}
+
+// F12 sets the F12 field of the Wide.
+func (b *WideBuilder) F12(value int32) *WideBuilder {
+	b.v.SetF12(value)
+	return b
+}
+
+// F13 sets the F13 field of the Wide.
+func (b *WideBuilder) F13(value int32) *WideBuilder {
+	b.v.SetF13(value)
+	return b
+}
+
+// F14 sets the F14 field of the Wide.
+func (b *WideBuilder) F14(value int32) *WideBuilder {
+	b.v.SetF14(value)
+	return b
+}
+
+// F15 sets the F15 field of the Wide.
+func (b *WideBuilder) F15(value int32) *WideBuilder {
+	b.v.SetF15(value)
+	return b
+}
+
+// F16 sets the F16 field of the Wide.
+func (b *WideBuilder) F16(value int32) *WideBuilder {
+	b.v.SetF16(value)
+	return b
+}
+
+// F17 sets the F17 field of the Wide.
+func (b *WideBuilder) F17(value int32) *WideBuilder {
+	b.v.SetF17(value)
+	return b
+}
+
+// F18 sets the F18 field of the Wide.
+func (b *WideBuilder) F18(value int32) *WideBuilder {
+	b.v.SetF18(value)
+	return b
+}
+
+// F19 sets the F19 field of the Wide.
+func (b *WideBuilder) F19(value int32) *WideBuilder {
+	b.v.SetF19(value)
+	return b
+}
+
+// F20 sets the F20 field of the Wide.
+func (b *WideBuilder) F20(value int32) *WideBuilder {
+	b.v.SetF20(value)
+	return b
+}
+
+// F21 sets the F21 field of the Wide.
+func (b *WideBuilder) F21(value int32) *WideBuilder {
+	b.v.SetF21(value)
+	return b
+}
+
+// F22 sets the F22 field of the Wide.
+func (b *WideBuilder) F22(value int32) *WideBuilder {
+	b.v.SetF22(value)
+	return b
+}
+
+// F23 sets the F23 field of the Wide.
+func (b *WideBuilder) F23(value int32) *WideBuilder {
+	b.v.SetF23(value)
+	return b
+}
+
+// F24 sets the F24 field of the Wide.
+func (b *WideBuilder) F24(value int32) *WideBuilder {
+	b.v.SetF24(value)
+	return b
+}
+
+// F25 sets the F25 field of the Wide.
+func (b *WideBuilder) F25(value int32) *WideBuilder {
+	b.v.SetF25(value)
+	return b
+}
+
+// F26 sets the F26 field of the Wide.
+func (b *WideBuilder) F26(value int32) *WideBuilder {
+	b.v.SetF26(value)
+	return b
+}
+
+// F27 sets the F27 field of the Wide.
+func (b *WideBuilder) F27(value int32) *WideBuilder {
+	b.v.SetF27(value)
+	return b
+}
+
+// F28 sets the F28 field of the Wide.
+func (b *WideBuilder) F28(value int32) *WideBuilder {
+	b.v.SetF28(value)
+	return b
+}
+
+// F29 sets the F29 field of the Wide.
+func (b *WideBuilder) F29(value int32) *WideBuilder {
+	b.v.SetF29(value)
+	return b
+}
+
+// F30 sets the F30 field of the Wide.
+func (b *WideBuilder) F30(value int32) *WideBuilder {
+	b.v.SetF30(value)
+	return b
+}
+
+// F31 sets the F31 field of the Wide.
+func (b *WideBuilder) F31(value int32) *WideBuilder {
+	b.v.SetF31(value)
+	return b
+}
+
+// F32 sets the F32 field of the Wide.
+func (b *WideBuilder) F32(value int32) *WideBuilder {
+	b.v.SetF32(value)
+	return b
+}
+
+// F33 sets the F33 field of the Wide.
+func (b *WideBuilder) F33(value int32) *WideBuilder {
+	b.v.SetF33(value)
+	return b
+}
+
+// F34 sets the F34 field of the Wide.
+func (b *WideBuilder) F34(value int32) *WideBuilder {
+	b.v.SetF34(value)
+	return b
+}
+
+// F35 sets the F35 field of the Wide.
+func (b *WideBuilder) F35(value int32) *WideBuilder {
+	b.v.SetF35(value)
+	return b
+}
+
+// F36 sets the F36 field of the Wide.
+func (b *WideBuilder) F36(value int32) *WideBuilder {
+	b.v.SetF36(value)
+	return b
+}
+
+// F37 sets the F37 field of the Wide.
+func (b *WideBuilder) F37(value int32) *WideBuilder {
+	b.v.SetF37(value)
+	return b
+}
+
+// F38 sets the F38 field of the Wide.
+func (b *WideBuilder) F38(value int32) *WideBuilder {
+	b.v.SetF38(value)
+	return b
+}
+
+// F39 sets the F39 field of the Wide.
+func (b *WideBuilder) F39(value int32) *WideBuilder {
+	b.v.SetF39(value)
+	return b
+}
+
+// F40 sets the F40 field of the Wide.
+func (b *WideBuilder) F40(value int32) *WideBuilder {
+	b.v.SetF40(value)
+	return b
+}
+
+// F41 sets the F41 field of the Wide.
+func (b *WideBuilder) F41(value int32) *WideBuilder {
+	b.v.SetF41(value)
+	return b
+}
+
+// F42 sets the F42 field of the Wide.
+func (b *WideBuilder) F42(value int32) *WideBuilder {
+	b.v.SetF42(value)
+	return b
+}
+
+// F43 sets the F43 field of the Wide.
+func (b *WideBuilder) F43(value int32) *WideBuilder {
+	b.v.SetF43(value)
+	return b
+}
+
+// F44 sets the F44 field of the Wide.
+func (b *WideBuilder) F44(value int32) *WideBuilder {
+	b.v.SetF44(value)
+	return b
+}
+
+// F45 sets the F45 field of the Wide.
+func (b *WideBuilder) F45(value int32) *WideBuilder {
+	b.v.SetF45(value)
+	return b
+}
+
+// F46 sets the F46 field of the Wide.
+func (b *WideBuilder) F46(value int32) *WideBuilder {
+	b.v.SetF46(value)
+	return b
+}
+
+// F47 sets the F47 field of the Wide.
+func (b *WideBuilder) F47(value int32) *WideBuilder {
+	b.v.SetF47(value)
+	return b
+}
+
+// F48 sets the F48 field of the Wide.
+func (b *WideBuilder) F48(value int32) *WideBuilder {
+	b.v.SetF48(value)
+	return b
+}
+
+// F49 sets the F49 field of the Wide.
+func (b *WideBuilder) F49(value int32) *WideBuilder {
+	b.v.SetF49(value)
+	return b
+}
+
+// F50 sets the F50 field of the Wide.
+func (b *WideBuilder) F50(value int32) *WideBuilder {
+	b.v.SetF50(value)
+	return b
+}
+
+// F51 sets the F51 field of the Wide.
+func (b *WideBuilder) F51(value int32) *WideBuilder {
+	b.v.SetF51(value)
+	return b
+}
+
+// F52 sets the F52 field of the Wide.
+func (b *WideBuilder) F52(value int32) *WideBuilder {
+	b.v.SetF52(value)
+	return b
+}
+
+// F53 sets the F53 field of the Wide.
+func (b *WideBuilder) F53(value int32) *WideBuilder {
+	b.v.SetF53(value)
+	return b
+}
+
+// F54 sets the F54 field of the Wide.
+func (b *WideBuilder) F54(value int32) *WideBuilder {
+	b.v.SetF54(value)
+	return b
+}
+
+// F55 sets the F55 field of the Wide.
+func (b *WideBuilder) F55(value int32) *WideBuilder {
+	b.v.SetF55(value)
+	return b
+}
+
+// F56 sets the F56 field of the Wide.
+func (b *WideBuilder) F56(value int32) *WideBuilder {
+	b.v.SetF56(value)
+	return b
+}
+
+// F57 sets the F57 field of the Wide.
+func (b *WideBuilder) F57(value int32) *WideBuilder {
+	b.v.SetF57(value)
+	return b
+}
+
+// F58 sets the F58 field of the Wide.
+func (b *WideBuilder) F58(value int32) *WideBuilder {
+	b.v.SetF58(value)
+	return b
+}
+
+// F59 sets the F59 field of the Wide.
+func (b *WideBuilder) F59(value int32) *WideBuilder {
+	b.v.SetF59(value)
+	return b
+}
+
+// F60 sets the F60 field of the Wide.
+func (b *WideBuilder) F60(value int32) *WideBuilder {
+	b.v.SetF60(value)
+	return b
+}
+
+// F61 sets the F61 field of the Wide.
+func (b *WideBuilder) F61(value int32) *WideBuilder {
+	b.v.SetF61(value)
+	return b
+}
+
+// F62 sets the F62 field of the Wide.
+func (b *WideBuilder) F62(value int32) *WideBuilder {
+	b.v.SetF62(value)
+	return b
+}
+
+// F63 sets the F63 field of the Wide.
+func (b *WideBuilder) F63(value int32) *WideBuilder {
+	b.v.SetF63(value)
+	return b
+}
+
+// F64 sets the F64 field of the Wide.
+func (b *WideBuilder) F64(value int32) *WideBuilder {
+	b.v.SetF64(value)
+	return b
+}
+
+// F65 sets the F65 field of the Wide.
+func (b *WideBuilder) F65(value int32) *WideBuilder {
+	b.v.SetF65(value)
+	return b
+}
+
+// F66 sets the F66 field of the Wide.
+func (b *WideBuilder) F66(value int32) *WideBuilder {
+	b.v.SetF66(value)
+	return b
+}
+
+// Build returns the Wide built so far.
+func (b *WideBuilder) Build() (*Wide, error) {
+	v := b.v
+	return &v, nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "presence-bits",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/presence-bits",
+	FilePath: "presence-bits.thrift",
+	SHA1:     "19aba419b222315ca512e6db891e625e4982fc98",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Level {\n    LOW,\n    HIGH,\n}\n\ntypedef string Label\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Sample {\n    1: required string id\n    2: optional bool enabled\n    3: optional i8 tiny\n    4: optional i16 small\n    5: optional i32 count (validate.min = \"0\", validate.max = \"100\")\n    6: optional i64 total\n    7: optional double ratio\n    8: optional string name\n    9: optional Level level\n    10: optional Label label\n    11: optional i32 retries = 3\n    12: optional string token (redact)\n    13: optional Point point\n    14: optional list<string> tags\n    15: optional binary data\n    16: optional string secret (visibility = \"internal\")\n} (go.lazy = \"true\", validate.cel = \"!has(this.total) || this.total >= 0\")\n\nunion Choice {\n    1: string text\n    2: i64 number\n    3: Point point\n}\n\nexception Failure {\n    1: optional string message\n    2: optional i32 code\n}\n\nstruct Pointers {\n    1: optional string name\n} (go.presence_bits = \"false\")\n\nstruct Wide {\n    1: optional i32 f1\n    2: optional i32 f2\n    3: optional i32 f3\n    4: optional i32 f4\n    5: optional i32 f5\n    6: optional i32 f6\n    7: optional i32 f7\n    8: optional i32 f8\n    9: optional i32 f9\n    10: optional i32 f10\n    11: optional i32 f11\n    12: optional i32 f12\n    13: optional i32 f13\n    14: optional i32 f14\n    15: optional i32 f15\n    16: optional i32 f16\n    17: optional i32 f17\n    18: optional i32 f18\n    19: optional i32 f19\n    20: optional i32 f20\n    21: optional i32 f21\n    22: optional i32 f22\n    23: optional i32 f23\n    24: optional i32 f24\n    25: optional i32 f25\n    26: optional i32 f26\n    27: optional i32 f27\n    28: optional i32 f28\n    29: optional i32 f29\n    30: optional i32 f30\n    31: optional i32 f31\n    32: optional i32 f32\n    33: optional i32 f33\n    34: optional i32 f34\n    35: optional i32 f35\n    36: optional i32 f36\n    37: optional i32 f37\n    38: optional i32 f38\n    39: optional i32 f39\n    40: optional i32 f40\n    41: optional i32 f41\n    42: optional i32 f42\n    43: optional i32 f43\n    44: optional i32 f44\n    45: optional i32 f45\n    46: optional i32 f46\n    47: optional i32 f47\n    48: optional i32 f48\n    49: optional i32 f49\n    50: optional i32 f50\n    51: optional i32 f51\n    52: optional i32 f52\n    53: optional i32 f53\n    54: optional i32 f54\n    55: optional i32 f55\n    56: optional i32 f56\n    57: optional i32 f57\n    58: optional i32 f58\n    59: optional i32 f59\n    60: optional i32 f60\n    61: optional i32 f61\n    62: optional i32 f62\n    63: optional i32 f63\n    64: optional i32 f64\n    65: optional i32 f65\n    66: optional i32 f66\n}\n\nconst Sample DefaultSample = {\n    \"id\": \"default\",\n    \"count\": 1,\n    \"name\": \"sample\",\n}\n\ntypedef Sample AliasedSample\n\nconst AliasedSample DefaultAliasedSample = {\n    \"id\": \"aliased\",\n    \"count\": 2,\n}\n"
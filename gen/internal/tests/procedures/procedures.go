@@ -0,0 +1,4798 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package procedures
+
+import (
+	bytes "bytes"
+	context "context"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftrpc "go.uber.org/thriftrw/thriftrpc"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type InternalError struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a InternalError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *InternalError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a InternalError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a InternalError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v InternalError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *InternalError) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a InternalError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a InternalError struct could not be encoded.
+func (v *InternalError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Message)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a InternalError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a InternalError struct could not be generated from the wire
+// representation.
+func (v *InternalError) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a InternalError
+// struct.
+func (v *InternalError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("InternalError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InternalError) ErrorName() string {
+	return "InternalError"
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this InternalError match the
+// provided InternalError.
+//
+// This function performs a deep comparison.
+func (v *InternalError) Equals(rhs *InternalError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this InternalError.
+func (v *InternalError) Copy() *InternalError {
+	if v == nil {
+		return nil
+	}
+
+	var o InternalError
+	o.Message = _String_CopyPtr(v.Message)
+	return &o
+}
+
+// Hash returns a hash of this InternalError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *InternalError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Message != nil {
+		h.Field(1)
+		h.String(*v.Message)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this InternalError so that it may be reused.
+func (v *InternalError) Reset() {
+	*v = InternalError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InternalError.
+func (v *InternalError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *InternalError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *InternalError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+func (v *InternalError) Error() string {
+	return v.String()
+}
+
+type KeyDoesNotExist struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyDoesNotExist struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyDoesNotExist) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyDoesNotExist struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyDoesNotExist struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyDoesNotExist
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyDoesNotExist) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyDoesNotExist struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyDoesNotExist struct could not be encoded.
+func (v *KeyDoesNotExist) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyDoesNotExist struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyDoesNotExist struct could not be generated from the wire
+// representation.
+func (v *KeyDoesNotExist) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyDoesNotExist
+// struct.
+func (v *KeyDoesNotExist) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyDoesNotExist{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*KeyDoesNotExist) ErrorName() string {
+	return "KeyDoesNotExist"
+}
+
+// Equals returns true if all the fields of this KeyDoesNotExist match the
+// provided KeyDoesNotExist.
+//
+// This function performs a deep comparison.
+func (v *KeyDoesNotExist) Equals(rhs *KeyDoesNotExist) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyDoesNotExist.
+func (v *KeyDoesNotExist) Copy() *KeyDoesNotExist {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyDoesNotExist
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyDoesNotExist which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyDoesNotExist) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyDoesNotExist so that it may be reused.
+func (v *KeyDoesNotExist) Reset() {
+	*v = KeyDoesNotExist{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyDoesNotExist.
+func (v *KeyDoesNotExist) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyDoesNotExist) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyDoesNotExist) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+func (v *KeyDoesNotExist) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "procedures",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/procedures",
+	FilePath: "procedures.thrift",
+	SHA1:     "16f497ea60c773951249ebc5d8aa413885e6876a",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "exception KeyDoesNotExist {\n    1: optional string key\n}\n\nexception InternalError {\n    1: optional string message\n}\n\nservice Health {\n    bool healthy()\n}\n\n/** KeyValue stores binary values by key. */\nservice KeyValue extends Health {\n    void setValue(1: required string key, 2: optional binary value)\n        throws (1: InternalError internalError)\n\n    binary getValue(1: optional string key)\n        throws (\n            1: KeyDoesNotExist doesNotExist,\n            2: InternalError internalError,\n        )\n\n    /**\n     * Returns the number of keys stored,\n     * optionally in the given context.\n     */\n    i64 size(1: optional string ctx)\n\n    oneway void forget(1: string key)\n\n    // Counts keys with the given prefix. The attachment is never decoded.\n    i64 countPrefix(1: optional string prefix, 2: optional binary attachment)\n        throws (1: InternalError internalError)\n        (go.lazy_args = \"true\")\n\n    oneway void forgetPrefix(1: optional string prefix) (go.lazy_args = \"true\")\n}\n"
+
+// Health_Healthy_Args represents the arguments for the Health.healthy function.
+//
+// The arguments for healthy are sent and received over the wire as this struct.
+type Health_Healthy_Args struct {
+}
+
+// ToWire translates a Health_Healthy_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Health_Healthy_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Health_Healthy_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Health_Healthy_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Health_Healthy_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Health_Healthy_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Health_Healthy_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Health_Healthy_Args struct could not be encoded.
+func (v *Health_Healthy_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Health_Healthy_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Health_Healthy_Args struct could not be generated from the wire
+// representation.
+func (v *Health_Healthy_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Health_Healthy_Args
+// struct.
+func (v *Health_Healthy_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Health_Healthy_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Health_Healthy_Args match the
+// provided Health_Healthy_Args.
+//
+// This function performs a deep comparison.
+func (v *Health_Healthy_Args) Equals(rhs *Health_Healthy_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Health_Healthy_Args.
+func (v *Health_Healthy_Args) Copy() *Health_Healthy_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Health_Healthy_Args
+	return &o
+}
+
+// Hash returns a hash of this Health_Healthy_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Health_Healthy_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Health_Healthy_Args so that it may be reused.
+func (v *Health_Healthy_Args) Reset() {
+	*v = Health_Healthy_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Health_Healthy_Args.
+func (v *Health_Healthy_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "healthy" for this struct.
+func (v *Health_Healthy_Args) MethodName() string {
+	return "healthy"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Health_Healthy_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Health_Healthy_Helper provides functions that aid in handling the
+// parameters and return values of the Health.healthy
+// function.
+var Health_Healthy_Helper = struct {
+	// Args accepts the parameters of healthy in-order and returns
+	// the arguments struct for the function.
+	Args func() *Health_Healthy_Args
+
+	// IsException returns true if the given error can be thrown
+	// by healthy.
+	//
+	// An error can be thrown by healthy only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for healthy
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// healthy into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by healthy
+	//
+	//   value, err := healthy(args)
+	//   result, err := Health_Healthy_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from healthy: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(bool, error) (*Health_Healthy_Result, error)
+
+	// UnwrapResponse takes the result struct for healthy
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if healthy threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Health_Healthy_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Health_Healthy_Result) (bool, error)
+}{}
+
+func init() {
+	Health_Healthy_Helper.Args = func() *Health_Healthy_Args {
+		return &Health_Healthy_Args{}
+	}
+
+	Health_Healthy_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Health_Healthy_Helper.WrapResponse = func(success bool, err error) (*Health_Healthy_Result, error) {
+		if err == nil {
+			return &Health_Healthy_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Health_Healthy_Helper.UnwrapResponse = func(result *Health_Healthy_Result) (success bool, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Health_Healthy_Result represents the result of a Health.healthy function call.
+//
+// The result of a healthy execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Health_Healthy_Result struct {
+	// Value returned by healthy after a successful execution.
+	Success *bool `json:"success,omitempty"`
+}
+
+// ToWire translates a Health_Healthy_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Health_Healthy_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueBool(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Health_Healthy_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Health_Healthy_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Health_Healthy_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Health_Healthy_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Health_Healthy_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Health_Healthy_Result struct could not be encoded.
+func (v *Health_Healthy_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Health_Healthy_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Health_Healthy_Result struct could not be generated from the wire
+// representation.
+func (v *Health_Healthy_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Health_Healthy_Result
+// struct.
+func (v *Health_Healthy_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Health_Healthy_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Health_Healthy_Result match the
+// provided Health_Healthy_Result.
+//
+// This function performs a deep comparison.
+func (v *Health_Healthy_Result) Equals(rhs *Health_Healthy_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Health_Healthy_Result.
+func (v *Health_Healthy_Result) Copy() *Health_Healthy_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Health_Healthy_Result
+	o.Success = _Bool_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Health_Healthy_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Health_Healthy_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Bool(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Health_Healthy_Result so that it may be reused.
+func (v *Health_Healthy_Result) Reset() {
+	*v = Health_Healthy_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Health_Healthy_Result.
+func (v *Health_Healthy_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddBool("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Health_Healthy_Result) GetSuccess() (o bool) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Health_Healthy_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "healthy" for this struct.
+func (v *Health_Healthy_Result) MethodName() string {
+	return "healthy"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Health_Healthy_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Health_Interface is implemented by servers of the Health
+// service.
+type Health_Interface interface {
+	Healthy(ctx context.Context) (bool, error)
+}
+
+// Health_Procedures returns a thriftrpc.Procedure for each function
+// of the Health service, including functions inherited from
+// its parent, served by the given implementation.
+func Health_Procedures(impl Health_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "Health::healthy",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Health_Healthy_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Healthy(ctx)
+				result, err := Health_Healthy_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	return procs
+}
+
+// KeyValue_CountPrefix_Args represents the arguments for the KeyValue.countPrefix function.
+//
+// The arguments for countPrefix are sent and received over the wire as this struct.
+type KeyValue_CountPrefix_Args struct {
+	Prefix     *string `json:"prefix,omitempty"`
+	Attachment []byte  `json:"attachment,omitempty"`
+}
+
+// ToWire translates a KeyValue_CountPrefix_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_CountPrefix_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Prefix != nil {
+		w, err = wire.NewValueString(*(v.Prefix)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Attachment != nil {
+		w, err = wire.NewValueBinary(v.Attachment), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_CountPrefix_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_CountPrefix_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_CountPrefix_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_CountPrefix_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Prefix = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Attachment, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_CountPrefix_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_CountPrefix_Args struct could not be encoded.
+func (v *KeyValue_CountPrefix_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Prefix != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Prefix)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attachment != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Attachment); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_CountPrefix_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_CountPrefix_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_CountPrefix_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Prefix = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Attachment, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_CountPrefix_Args
+// struct.
+func (v *KeyValue_CountPrefix_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Prefix != nil {
+		fields[i] = fmt.Sprintf("Prefix: %v", *(v.Prefix))
+		i++
+	}
+	if v.Attachment != nil {
+		fields[i] = fmt.Sprintf("Attachment: %v", v.Attachment)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_CountPrefix_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_CountPrefix_Args match the
+// provided KeyValue_CountPrefix_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_CountPrefix_Args) Equals(rhs *KeyValue_CountPrefix_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Prefix, rhs.Prefix) {
+		return false
+	}
+	if !((v.Attachment == nil && rhs.Attachment == nil) || (v.Attachment != nil && rhs.Attachment != nil && bytes.Equal(v.Attachment, rhs.Attachment))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this KeyValue_CountPrefix_Args.
+func (v *KeyValue_CountPrefix_Args) Copy() *KeyValue_CountPrefix_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_CountPrefix_Args
+	o.Prefix = _String_CopyPtr(v.Prefix)
+	o.Attachment = _Binary_Copy(v.Attachment)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_CountPrefix_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_CountPrefix_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Prefix != nil {
+		h.Field(1)
+		h.String(*v.Prefix)
+	}
+	h.Field(2)
+	h.Binary(v.Attachment)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_CountPrefix_Args so that it may be reused.
+func (v *KeyValue_CountPrefix_Args) Reset() {
+	*v = KeyValue_CountPrefix_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_CountPrefix_Args.
+func (v *KeyValue_CountPrefix_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Prefix != nil {
+		enc.AddString("prefix", *v.Prefix)
+	}
+	if v.Attachment != nil {
+		enc.AddString("attachment", base64.StdEncoding.EncodeToString(v.Attachment))
+	}
+	return err
+}
+
+// GetPrefix returns the value of Prefix if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_CountPrefix_Args) GetPrefix() (o string) {
+	if v != nil && v.Prefix != nil {
+		return *v.Prefix
+	}
+
+	return
+}
+
+// IsSetPrefix returns true if Prefix is not nil.
+func (v *KeyValue_CountPrefix_Args) IsSetPrefix() bool {
+	return v != nil && v.Prefix != nil
+}
+
+// GetAttachment returns the value of Attachment if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_CountPrefix_Args) GetAttachment() (o []byte) {
+	if v != nil && v.Attachment != nil {
+		return v.Attachment
+	}
+
+	return
+}
+
+// IsSetAttachment returns true if Attachment is not nil.
+func (v *KeyValue_CountPrefix_Args) IsSetAttachment() bool {
+	return v != nil && v.Attachment != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "countPrefix" for this struct.
+func (v *KeyValue_CountPrefix_Args) MethodName() string {
+	return "countPrefix"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_CountPrefix_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_CountPrefix_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.countPrefix
+// function.
+var KeyValue_CountPrefix_Helper = struct {
+	// Args accepts the parameters of countPrefix in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		prefix *string,
+		attachment []byte,
+	) *KeyValue_CountPrefix_Args
+
+	// IsException returns true if the given error can be thrown
+	// by countPrefix.
+	//
+	// An error can be thrown by countPrefix only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for countPrefix
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// countPrefix into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by countPrefix
+	//
+	//   value, err := countPrefix(args)
+	//   result, err := KeyValue_CountPrefix_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from countPrefix: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(int64, error) (*KeyValue_CountPrefix_Result, error)
+
+	// UnwrapResponse takes the result struct for countPrefix
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if countPrefix threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := KeyValue_CountPrefix_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_CountPrefix_Result) (int64, error)
+}{}
+
+func init() {
+	KeyValue_CountPrefix_Helper.Args = func(
+		prefix *string,
+		attachment []byte,
+	) *KeyValue_CountPrefix_Args {
+		return &KeyValue_CountPrefix_Args{
+			Prefix:     prefix,
+			Attachment: attachment,
+		}
+	}
+
+	KeyValue_CountPrefix_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *InternalError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	KeyValue_CountPrefix_Helper.WrapResponse = func(success int64, err error) (*KeyValue_CountPrefix_Result, error) {
+		if err == nil {
+			return &KeyValue_CountPrefix_Result{Success: &success}, nil
+		}
+
+		switch e := err.(type) {
+		case *InternalError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for KeyValue_CountPrefix_Result.InternalError")
+			}
+			return &KeyValue_CountPrefix_Result{InternalError: e}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_CountPrefix_Helper.UnwrapResponse = func(result *KeyValue_CountPrefix_Result) (success int64, err error) {
+		if result.InternalError != nil {
+			err = result.InternalError
+			return
+		}
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// KeyValue_CountPrefix_Result represents the result of a KeyValue.countPrefix function call.
+//
+// The result of a countPrefix execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type KeyValue_CountPrefix_Result struct {
+	// Value returned by countPrefix after a successful execution.
+	Success       *int64         `json:"success,omitempty"`
+	InternalError *InternalError `json:"internalError,omitempty"`
+}
+
+// ToWire translates a KeyValue_CountPrefix_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_CountPrefix_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueI64(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.InternalError != nil {
+		w, err = v.InternalError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_CountPrefix_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _InternalError_Read(w wire.Value) (*InternalError, error) {
+	var v InternalError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a KeyValue_CountPrefix_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_CountPrefix_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_CountPrefix_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_CountPrefix_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalError, err = _InternalError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_CountPrefix_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_CountPrefix_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_CountPrefix_Result struct could not be encoded.
+func (v *KeyValue_CountPrefix_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("KeyValue_CountPrefix_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _InternalError_Decode(sr stream.Reader) (*InternalError, error) {
+	var v InternalError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a KeyValue_CountPrefix_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_CountPrefix_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_CountPrefix_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.InternalError, err = _InternalError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_CountPrefix_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_CountPrefix_Result
+// struct.
+func (v *KeyValue_CountPrefix_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+	if v.InternalError != nil {
+		fields[i] = fmt.Sprintf("InternalError: %v", v.InternalError)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_CountPrefix_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this KeyValue_CountPrefix_Result match the
+// provided KeyValue_CountPrefix_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_CountPrefix_Result) Equals(rhs *KeyValue_CountPrefix_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+	if !((v.InternalError == nil && rhs.InternalError == nil) || (v.InternalError != nil && rhs.InternalError != nil && v.InternalError.Equals(rhs.InternalError))) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this KeyValue_CountPrefix_Result.
+func (v *KeyValue_CountPrefix_Result) Copy() *KeyValue_CountPrefix_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_CountPrefix_Result
+	o.Success = _I64_CopyPtr(v.Success)
+	o.InternalError = v.InternalError.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_CountPrefix_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_CountPrefix_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Int64(*v.Success)
+	}
+	h.Field(1)
+	h.Uint64(v.InternalError.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_CountPrefix_Result so that it may be reused.
+func (v *KeyValue_CountPrefix_Result) Reset() {
+	*v = KeyValue_CountPrefix_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_CountPrefix_Result.
+func (v *KeyValue_CountPrefix_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddInt64("success", *v.Success)
+	}
+	if v.InternalError != nil {
+		err = multierr.Append(err, enc.AddObject("internalError", v.InternalError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_CountPrefix_Result) GetSuccess() (o int64) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *KeyValue_CountPrefix_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetInternalError returns the value of InternalError if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_CountPrefix_Result) GetInternalError() (o *InternalError) {
+	if v != nil && v.InternalError != nil {
+		return v.InternalError
+	}
+
+	return
+}
+
+// IsSetInternalError returns true if InternalError is not nil.
+func (v *KeyValue_CountPrefix_Result) IsSetInternalError() bool {
+	return v != nil && v.InternalError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "countPrefix" for this struct.
+func (v *KeyValue_CountPrefix_Result) MethodName() string {
+	return "countPrefix"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_CountPrefix_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_Forget_Args represents the arguments for the KeyValue.forget function.
+//
+// The arguments for forget are sent and received over the wire as this struct.
+type KeyValue_Forget_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyValue_Forget_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Forget_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_Forget_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Forget_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Forget_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Forget_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Forget_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Forget_Args struct could not be encoded.
+func (v *KeyValue_Forget_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_Forget_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Forget_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Forget_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Forget_Args
+// struct.
+func (v *KeyValue_Forget_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_Forget_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_Forget_Args match the
+// provided KeyValue_Forget_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Forget_Args) Equals(rhs *KeyValue_Forget_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_Forget_Args.
+func (v *KeyValue_Forget_Args) Copy() *KeyValue_Forget_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Forget_Args
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Forget_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Forget_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Forget_Args so that it may be reused.
+func (v *KeyValue_Forget_Args) Reset() {
+	*v = KeyValue_Forget_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Forget_Args.
+func (v *KeyValue_Forget_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Forget_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyValue_Forget_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "forget" for this struct.
+func (v *KeyValue_Forget_Args) MethodName() string {
+	return "forget"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *KeyValue_Forget_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// KeyValue_Forget_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.forget
+// function.
+var KeyValue_Forget_Helper = struct {
+	// Args accepts the parameters of forget in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *KeyValue_Forget_Args
+}{}
+
+func init() {
+	KeyValue_Forget_Helper.Args = func(
+		key *string,
+	) *KeyValue_Forget_Args {
+		return &KeyValue_Forget_Args{
+			Key: key,
+		}
+	}
+
+}
+
+// KeyValue_ForgetPrefix_Args represents the arguments for the KeyValue.forgetPrefix function.
+//
+// The arguments for forgetPrefix are sent and received over the wire as this struct.
+type KeyValue_ForgetPrefix_Args struct {
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// ToWire translates a KeyValue_ForgetPrefix_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_ForgetPrefix_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Prefix != nil {
+		w, err = wire.NewValueString(*(v.Prefix)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_ForgetPrefix_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_ForgetPrefix_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_ForgetPrefix_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_ForgetPrefix_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Prefix = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_ForgetPrefix_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_ForgetPrefix_Args struct could not be encoded.
+func (v *KeyValue_ForgetPrefix_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Prefix != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Prefix)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_ForgetPrefix_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_ForgetPrefix_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_ForgetPrefix_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Prefix = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_ForgetPrefix_Args
+// struct.
+func (v *KeyValue_ForgetPrefix_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Prefix != nil {
+		fields[i] = fmt.Sprintf("Prefix: %v", *(v.Prefix))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_ForgetPrefix_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_ForgetPrefix_Args match the
+// provided KeyValue_ForgetPrefix_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_ForgetPrefix_Args) Equals(rhs *KeyValue_ForgetPrefix_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Prefix, rhs.Prefix) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_ForgetPrefix_Args.
+func (v *KeyValue_ForgetPrefix_Args) Copy() *KeyValue_ForgetPrefix_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_ForgetPrefix_Args
+	o.Prefix = _String_CopyPtr(v.Prefix)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_ForgetPrefix_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_ForgetPrefix_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Prefix != nil {
+		h.Field(1)
+		h.String(*v.Prefix)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_ForgetPrefix_Args so that it may be reused.
+func (v *KeyValue_ForgetPrefix_Args) Reset() {
+	*v = KeyValue_ForgetPrefix_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_ForgetPrefix_Args.
+func (v *KeyValue_ForgetPrefix_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Prefix != nil {
+		enc.AddString("prefix", *v.Prefix)
+	}
+	return err
+}
+
+// GetPrefix returns the value of Prefix if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_ForgetPrefix_Args) GetPrefix() (o string) {
+	if v != nil && v.Prefix != nil {
+		return *v.Prefix
+	}
+
+	return
+}
+
+// IsSetPrefix returns true if Prefix is not nil.
+func (v *KeyValue_ForgetPrefix_Args) IsSetPrefix() bool {
+	return v != nil && v.Prefix != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "forgetPrefix" for this struct.
+func (v *KeyValue_ForgetPrefix_Args) MethodName() string {
+	return "forgetPrefix"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *KeyValue_ForgetPrefix_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// KeyValue_ForgetPrefix_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.forgetPrefix
+// function.
+var KeyValue_ForgetPrefix_Helper = struct {
+	// Args accepts the parameters of forgetPrefix in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		prefix *string,
+	) *KeyValue_ForgetPrefix_Args
+}{}
+
+func init() {
+	KeyValue_ForgetPrefix_Helper.Args = func(
+		prefix *string,
+	) *KeyValue_ForgetPrefix_Args {
+		return &KeyValue_ForgetPrefix_Args{
+			Prefix: prefix,
+		}
+	}
+
+}
+
+// KeyValue_GetValue_Args represents the arguments for the KeyValue.getValue function.
+//
+// The arguments for getValue are sent and received over the wire as this struct.
+type KeyValue_GetValue_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyValue_GetValue_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_GetValue_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_GetValue_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_GetValue_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_GetValue_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_GetValue_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_GetValue_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_GetValue_Args struct could not be encoded.
+func (v *KeyValue_GetValue_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_GetValue_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_GetValue_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_GetValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_GetValue_Args
+// struct.
+func (v *KeyValue_GetValue_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_GetValue_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_GetValue_Args match the
+// provided KeyValue_GetValue_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_GetValue_Args) Equals(rhs *KeyValue_GetValue_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_GetValue_Args.
+func (v *KeyValue_GetValue_Args) Copy() *KeyValue_GetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_GetValue_Args
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_GetValue_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_GetValue_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_GetValue_Args so that it may be reused.
+func (v *KeyValue_GetValue_Args) Reset() {
+	*v = KeyValue_GetValue_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetValue_Args.
+func (v *KeyValue_GetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyValue_GetValue_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getValue" for this struct.
+func (v *KeyValue_GetValue_Args) MethodName() string {
+	return "getValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_GetValue_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_GetValue_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.getValue
+// function.
+var KeyValue_GetValue_Helper = struct {
+	// Args accepts the parameters of getValue in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *KeyValue_GetValue_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getValue.
+	//
+	// An error can be thrown by getValue only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getValue
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getValue into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getValue
+	//
+	//   value, err := getValue(args)
+	//   result, err := KeyValue_GetValue_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getValue: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func([]byte, error) (*KeyValue_GetValue_Result, error)
+
+	// UnwrapResponse takes the result struct for getValue
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getValue threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := KeyValue_GetValue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_GetValue_Result) ([]byte, error)
+}{}
+
+func init() {
+	KeyValue_GetValue_Helper.Args = func(
+		key *string,
+	) *KeyValue_GetValue_Args {
+		return &KeyValue_GetValue_Args{
+			Key: key,
+		}
+	}
+
+	KeyValue_GetValue_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *KeyDoesNotExist:
+			return true
+		case *InternalError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	KeyValue_GetValue_Helper.WrapResponse = func(success []byte, err error) (*KeyValue_GetValue_Result, error) {
+		if err == nil {
+			return &KeyValue_GetValue_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *KeyDoesNotExist:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for KeyValue_GetValue_Result.DoesNotExist")
+			}
+			return &KeyValue_GetValue_Result{DoesNotExist: e}, nil
+		case *InternalError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for KeyValue_GetValue_Result.InternalError")
+			}
+			return &KeyValue_GetValue_Result{InternalError: e}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_GetValue_Helper.UnwrapResponse = func(result *KeyValue_GetValue_Result) (success []byte, err error) {
+		if result.DoesNotExist != nil {
+			err = result.DoesNotExist
+			return
+		}
+		if result.InternalError != nil {
+			err = result.InternalError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// KeyValue_GetValue_Result represents the result of a KeyValue.getValue function call.
+//
+// The result of a getValue execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type KeyValue_GetValue_Result struct {
+	// Value returned by getValue after a successful execution.
+	Success       []byte           `json:"success,omitempty"`
+	DoesNotExist  *KeyDoesNotExist `json:"doesNotExist,omitempty"`
+	InternalError *InternalError   `json:"internalError,omitempty"`
+}
+
+// ToWire translates a KeyValue_GetValue_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_GetValue_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueBinary(v.Success), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.DoesNotExist != nil {
+		w, err = v.DoesNotExist.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalError != nil {
+		w, err = v.InternalError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _KeyDoesNotExist_Read(w wire.Value) (*KeyDoesNotExist, error) {
+	var v KeyDoesNotExist
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a KeyValue_GetValue_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_GetValue_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_GetValue_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				v.Success, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.DoesNotExist, err = _KeyDoesNotExist_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalError, err = _InternalError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_GetValue_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_GetValue_Result struct could not be encoded.
+func (v *KeyValue_GetValue_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Success); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DoesNotExist != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DoesNotExist.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _KeyDoesNotExist_Decode(sr stream.Reader) (*KeyDoesNotExist, error) {
+	var v KeyDoesNotExist
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a KeyValue_GetValue_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_GetValue_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_GetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			v.Success, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.DoesNotExist, err = _KeyDoesNotExist_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalError, err = _InternalError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_GetValue_Result
+// struct.
+func (v *KeyValue_GetValue_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.DoesNotExist != nil {
+		fields[i] = fmt.Sprintf("DoesNotExist: %v", v.DoesNotExist)
+		i++
+	}
+	if v.InternalError != nil {
+		fields[i] = fmt.Sprintf("InternalError: %v", v.InternalError)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_GetValue_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_GetValue_Result match the
+// provided KeyValue_GetValue_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_GetValue_Result) Equals(rhs *KeyValue_GetValue_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && bytes.Equal(v.Success, rhs.Success))) {
+		return false
+	}
+	if !((v.DoesNotExist == nil && rhs.DoesNotExist == nil) || (v.DoesNotExist != nil && rhs.DoesNotExist != nil && v.DoesNotExist.Equals(rhs.DoesNotExist))) {
+		return false
+	}
+	if !((v.InternalError == nil && rhs.InternalError == nil) || (v.InternalError != nil && rhs.InternalError != nil && v.InternalError.Equals(rhs.InternalError))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) Copy() *KeyValue_GetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_GetValue_Result
+	o.Success = _Binary_Copy(v.Success)
+	o.DoesNotExist = v.DoesNotExist.Copy()
+	o.InternalError = v.InternalError.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_GetValue_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_GetValue_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Binary(v.Success)
+	h.Field(1)
+	h.Uint64(v.DoesNotExist.Hash())
+	h.Field(2)
+	h.Uint64(v.InternalError.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_GetValue_Result so that it may be reused.
+func (v *KeyValue_GetValue_Result) Reset() {
+	*v = KeyValue_GetValue_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", base64.StdEncoding.EncodeToString(v.Success))
+	}
+	if v.DoesNotExist != nil {
+		err = multierr.Append(err, enc.AddObject("doesNotExist", v.DoesNotExist))
+	}
+	if v.InternalError != nil {
+		err = multierr.Append(err, enc.AddObject("internalError", v.InternalError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Result) GetSuccess() (o []byte) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *KeyValue_GetValue_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetDoesNotExist returns the value of DoesNotExist if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Result) GetDoesNotExist() (o *KeyDoesNotExist) {
+	if v != nil && v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	return
+}
+
+// IsSetDoesNotExist returns true if DoesNotExist is not nil.
+func (v *KeyValue_GetValue_Result) IsSetDoesNotExist() bool {
+	return v != nil && v.DoesNotExist != nil
+}
+
+// GetInternalError returns the value of InternalError if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_GetValue_Result) GetInternalError() (o *InternalError) {
+	if v != nil && v.InternalError != nil {
+		return v.InternalError
+	}
+
+	return
+}
+
+// IsSetInternalError returns true if InternalError is not nil.
+func (v *KeyValue_GetValue_Result) IsSetInternalError() bool {
+	return v != nil && v.InternalError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getValue" for this struct.
+func (v *KeyValue_GetValue_Result) MethodName() string {
+	return "getValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_GetValue_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_SetValue_Args represents the arguments for the KeyValue.setValue function.
+//
+// The arguments for setValue are sent and received over the wire as this struct.
+type KeyValue_SetValue_Args struct {
+	Key   string `json:"key,required"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// ToWire translates a KeyValue_SetValue_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_SetValue_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Value != nil {
+		w, err = wire.NewValueBinary(v.Value), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_SetValue_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_SetValue_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_SetValue_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_SetValue_Args) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of KeyValue_SetValue_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_SetValue_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_SetValue_Args struct could not be encoded.
+func (v *KeyValue_SetValue_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Value); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_SetValue_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_SetValue_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_SetValue_Args) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of KeyValue_SetValue_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_SetValue_Args
+// struct.
+func (v *KeyValue_SetValue_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_SetValue_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_SetValue_Args match the
+// provided KeyValue_SetValue_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_SetValue_Args) Equals(rhs *KeyValue_SetValue_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && bytes.Equal(v.Value, rhs.Value))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) Copy() *KeyValue_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_SetValue_Args
+	o.Key = v.Key
+	o.Value = _Binary_Copy(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_SetValue_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_SetValue_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	h.Field(2)
+	h.Binary(v.Value)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_SetValue_Args so that it may be reused.
+func (v *KeyValue_SetValue_Args) Reset() {
+	*v = KeyValue_SetValue_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	if v.Value != nil {
+		enc.AddString("value", base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_SetValue_Args) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_SetValue_Args) GetValue() (o []byte) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *KeyValue_SetValue_Args) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "setValue" for this struct.
+func (v *KeyValue_SetValue_Args) MethodName() string {
+	return "setValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_SetValue_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_SetValue_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.setValue
+// function.
+var KeyValue_SetValue_Helper = struct {
+	// Args accepts the parameters of setValue in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key string,
+		value []byte,
+	) *KeyValue_SetValue_Args
+
+	// IsException returns true if the given error can be thrown
+	// by setValue.
+	//
+	// An error can be thrown by setValue only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for setValue
+	// given the error returned by it. The provided error may
+	// be nil if setValue did not fail.
+	//
+	// This allows mapping errors returned by setValue into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// setValue
+	//
+	//   err := setValue(args)
+	//   result, err := KeyValue_SetValue_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from setValue: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*KeyValue_SetValue_Result, error)
+
+	// UnwrapResponse takes the result struct for setValue
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if setValue threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := KeyValue_SetValue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_SetValue_Result) error
+}{}
+
+func init() {
+	KeyValue_SetValue_Helper.Args = func(
+		key string,
+		value []byte,
+	) *KeyValue_SetValue_Args {
+		return &KeyValue_SetValue_Args{
+			Key:   key,
+			Value: value,
+		}
+	}
+
+	KeyValue_SetValue_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *InternalError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	KeyValue_SetValue_Helper.WrapResponse = func(err error) (*KeyValue_SetValue_Result, error) {
+		if err == nil {
+			return &KeyValue_SetValue_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *InternalError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for KeyValue_SetValue_Result.InternalError")
+			}
+			return &KeyValue_SetValue_Result{InternalError: e}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_SetValue_Helper.UnwrapResponse = func(result *KeyValue_SetValue_Result) (err error) {
+		if result.InternalError != nil {
+			err = result.InternalError
+			return
+		}
+		return
+	}
+
+}
+
+// KeyValue_SetValue_Result represents the result of a KeyValue.setValue function call.
+//
+// The result of a setValue execution is sent and received over the wire as this struct.
+type KeyValue_SetValue_Result struct {
+	InternalError *InternalError `json:"internalError,omitempty"`
+}
+
+// ToWire translates a KeyValue_SetValue_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_SetValue_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.InternalError != nil {
+		w, err = v.InternalError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_SetValue_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_SetValue_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_SetValue_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_SetValue_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_SetValue_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalError, err = _InternalError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.InternalError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("KeyValue_SetValue_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_SetValue_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_SetValue_Result struct could not be encoded.
+func (v *KeyValue_SetValue_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.InternalError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.InternalError != nil {
+		count++
+	}
+
+	if count > 1 {
+		return fmt.Errorf("KeyValue_SetValue_Result should have at most one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_SetValue_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_SetValue_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_SetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.InternalError, err = _InternalError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.InternalError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("KeyValue_SetValue_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_SetValue_Result
+// struct.
+func (v *KeyValue_SetValue_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.InternalError != nil {
+		fields[i] = fmt.Sprintf("InternalError: %v", v.InternalError)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_SetValue_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_SetValue_Result match the
+// provided KeyValue_SetValue_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_SetValue_Result) Equals(rhs *KeyValue_SetValue_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.InternalError == nil && rhs.InternalError == nil) || (v.InternalError != nil && rhs.InternalError != nil && v.InternalError.Equals(rhs.InternalError))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_SetValue_Result.
+func (v *KeyValue_SetValue_Result) Copy() *KeyValue_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_SetValue_Result
+	o.InternalError = v.InternalError.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_SetValue_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_SetValue_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.InternalError.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_SetValue_Result so that it may be reused.
+func (v *KeyValue_SetValue_Result) Reset() {
+	*v = KeyValue_SetValue_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValue_Result.
+func (v *KeyValue_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.InternalError != nil {
+		err = multierr.Append(err, enc.AddObject("internalError", v.InternalError))
+	}
+	return err
+}
+
+// GetInternalError returns the value of InternalError if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_SetValue_Result) GetInternalError() (o *InternalError) {
+	if v != nil && v.InternalError != nil {
+		return v.InternalError
+	}
+
+	return
+}
+
+// IsSetInternalError returns true if InternalError is not nil.
+func (v *KeyValue_SetValue_Result) IsSetInternalError() bool {
+	return v != nil && v.InternalError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "setValue" for this struct.
+func (v *KeyValue_SetValue_Result) MethodName() string {
+	return "setValue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_SetValue_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_Size_Args represents the arguments for the KeyValue.size function.
+//
+// The arguments for size are sent and received over the wire as this struct.
+//
+// Returns the number of keys stored,
+// optionally in the given context.
+type KeyValue_Size_Args struct {
+	Ctx *string `json:"ctx,omitempty"`
+}
+
+// ToWire translates a KeyValue_Size_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Size_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Ctx != nil {
+		w, err = wire.NewValueString(*(v.Ctx)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_Size_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Size_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Size_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Size_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Ctx = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Size_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Size_Args struct could not be encoded.
+func (v *KeyValue_Size_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Ctx != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Ctx)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_Size_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Size_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Size_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Ctx = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Size_Args
+// struct.
+func (v *KeyValue_Size_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Ctx != nil {
+		fields[i] = fmt.Sprintf("Ctx: %v", *(v.Ctx))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_Size_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_Size_Args match the
+// provided KeyValue_Size_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Size_Args) Equals(rhs *KeyValue_Size_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Ctx, rhs.Ctx) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_Size_Args.
+func (v *KeyValue_Size_Args) Copy() *KeyValue_Size_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Size_Args
+	o.Ctx = _String_CopyPtr(v.Ctx)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Size_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Size_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Ctx != nil {
+		h.Field(1)
+		h.String(*v.Ctx)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Size_Args so that it may be reused.
+func (v *KeyValue_Size_Args) Reset() {
+	*v = KeyValue_Size_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Size_Args.
+func (v *KeyValue_Size_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Ctx != nil {
+		enc.AddString("ctx", *v.Ctx)
+	}
+	return err
+}
+
+// GetCtx returns the value of Ctx if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Size_Args) GetCtx() (o string) {
+	if v != nil && v.Ctx != nil {
+		return *v.Ctx
+	}
+
+	return
+}
+
+// IsSetCtx returns true if Ctx is not nil.
+func (v *KeyValue_Size_Args) IsSetCtx() bool {
+	return v != nil && v.Ctx != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "size" for this struct.
+func (v *KeyValue_Size_Args) MethodName() string {
+	return "size"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_Size_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_Size_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.size
+// function.
+//
+// Returns the number of keys stored,
+// optionally in the given context.
+var KeyValue_Size_Helper = struct {
+	// Args accepts the parameters of size in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		ctx *string,
+	) *KeyValue_Size_Args
+
+	// IsException returns true if the given error can be thrown
+	// by size.
+	//
+	// An error can be thrown by size only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for size
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// size into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by size
+	//
+	//   value, err := size(args)
+	//   result, err := KeyValue_Size_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from size: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(int64, error) (*KeyValue_Size_Result, error)
+
+	// UnwrapResponse takes the result struct for size
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if size threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := KeyValue_Size_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_Size_Result) (int64, error)
+}{}
+
+func init() {
+	KeyValue_Size_Helper.Args = func(
+		ctx *string,
+	) *KeyValue_Size_Args {
+		return &KeyValue_Size_Args{
+			Ctx: ctx,
+		}
+	}
+
+	KeyValue_Size_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	KeyValue_Size_Helper.WrapResponse = func(success int64, err error) (*KeyValue_Size_Result, error) {
+		if err == nil {
+			return &KeyValue_Size_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_Size_Helper.UnwrapResponse = func(result *KeyValue_Size_Result) (success int64, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// KeyValue_Size_Result represents the result of a KeyValue.size function call.
+//
+// The result of a size execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type KeyValue_Size_Result struct {
+	// Value returned by size after a successful execution.
+	Success *int64 `json:"success,omitempty"`
+}
+
+// ToWire translates a KeyValue_Size_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Size_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueI64(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_Size_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_Size_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Size_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Size_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Size_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Size_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Size_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Size_Result struct could not be encoded.
+func (v *KeyValue_Size_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Size_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_Size_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Size_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Size_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Size_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Size_Result
+// struct.
+func (v *KeyValue_Size_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_Size_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_Size_Result match the
+// provided KeyValue_Size_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Size_Result) Equals(rhs *KeyValue_Size_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_Size_Result.
+func (v *KeyValue_Size_Result) Copy() *KeyValue_Size_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Size_Result
+	o.Success = _I64_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Size_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Size_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Int64(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Size_Result so that it may be reused.
+func (v *KeyValue_Size_Result) Reset() {
+	*v = KeyValue_Size_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Size_Result.
+func (v *KeyValue_Size_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddInt64("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Size_Result) GetSuccess() (o int64) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *KeyValue_Size_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "size" for this struct.
+func (v *KeyValue_Size_Result) MethodName() string {
+	return "size"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_Size_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_Interface is implemented by servers of the KeyValue
+// service.
+//
+// KeyValue stores binary values by key.
+type KeyValue_Interface interface {
+	Health_Interface
+
+	CountPrefix(ctx context.Context, args *thriftrpc.ArgsReader) (int64, error)
+
+	Forget(ctx context.Context, key *string) error
+
+	ForgetPrefix(ctx context.Context, args *thriftrpc.ArgsReader) error
+
+	GetValue(ctx context.Context, key *string) ([]byte, error)
+
+	SetValue(ctx context.Context, key string, value []byte) error
+
+	// Returns the number of keys stored,
+	// optionally in the given context.
+	Size(ctx context.Context, ctx2 *string) (int64, error)
+}
+
+// KeyValue_Procedures returns a thriftrpc.Procedure for each function
+// of the KeyValue service, including functions inherited from
+// its parent, served by the given implementation.
+func KeyValue_Procedures(impl KeyValue_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "KeyValue::countPrefix",
+			StreamHandler: func(ctx context.Context, args *thriftrpc.ArgsReader) (thriftrpc.Response, error) {
+				success, err := impl.CountPrefix(ctx, args)
+				result, err := KeyValue_CountPrefix_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body:               result,
+					IsApplicationError: result.InternalError != nil,
+				}, nil
+			},
+		},
+		{
+			Name:   "KeyValue::forget",
+			OneWay: true,
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args KeyValue_Forget_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				return thriftrpc.Response{}, impl.Forget(ctx, args.Key)
+			},
+		},
+		{
+			Name:   "KeyValue::forgetPrefix",
+			OneWay: true,
+			StreamHandler: func(ctx context.Context, args *thriftrpc.ArgsReader) (thriftrpc.Response, error) {
+				return thriftrpc.Response{}, impl.ForgetPrefix(ctx, args)
+			},
+		},
+		{
+			Name: "KeyValue::getValue",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args KeyValue_GetValue_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.GetValue(ctx, args.Key)
+				result, err := KeyValue_GetValue_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body:               result,
+					IsApplicationError: result.DoesNotExist != nil || result.InternalError != nil,
+				}, nil
+			},
+		},
+		{
+			Name: "KeyValue::setValue",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args KeyValue_SetValue_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				result, err := KeyValue_SetValue_Helper.WrapResponse(impl.SetValue(ctx, args.Key, args.Value))
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body:               result,
+					IsApplicationError: result.InternalError != nil,
+				}, nil
+			},
+		},
+		{
+			Name: "KeyValue::size",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args KeyValue_Size_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Size(ctx, args.Ctx)
+				result, err := KeyValue_Size_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	procs = append(procs, Health_Procedures(impl)...)
+	return procs
+}
@@ -0,0 +1,7764 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package quick_generators
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	rand "math/rand"
+	reflect "reflect"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Blobs struct {
+	Names []struct {
+		Key   []byte
+		Value string
+	} `json:"names,omitempty"`
+	Keys   [][]byte        `json:"keys,omitempty"`
+	Counts map[Color]int32 `json:"counts,omitempty"`
+}
+
+type _Map_Binary_String_MapItemList []struct {
+	Key   []byte
+	Value string
+}
+
+func (m _Map_Binary_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key []byte; Value string}': key is nil")
+		}
+		kw, err := wire.NewValueBinary(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Binary_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Binary_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Binary_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Binary_String_MapItemList) Close() {}
+
+type _Set_Binary_sliceType_ValueList [][]byte
+
+func (v _Set_Binary_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set '[]byte': contains nil value")
+		}
+		w, err := wire.NewValueBinary(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Binary_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Binary_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_Binary_sliceType_ValueList) Close() {}
+
+type _Map_Color_I32_MapItemList map[Color]int32
+
+func (m _Map_Color_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Color_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Color_I32_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_Color_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_Color_I32_MapItemList) Close() {}
+
+// ToWire translates a Blobs struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Blobs) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Names != nil {
+		w, err = wire.NewValueMap(_Map_Binary_String_MapItemList(v.Names)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Keys != nil {
+		w, err = wire.NewValueSet(_Set_Binary_sliceType_ValueList(v.Keys)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Counts != nil {
+		w, err = wire.NewValueMap(_Map_Color_I32_MapItemList(v.Counts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_Binary_String_Read(m wire.MapItemList) ([]struct {
+	Key   []byte
+	Value string
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   []byte
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_Binary_sliceType_Read(s wire.ValueList) ([][]byte, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([][]byte, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Color_Read(w wire.Value) (Color, error) {
+	var v Color
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Map_Color_I32_Read(m wire.MapItemList) (map[Color]int32, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[Color]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Color_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Blobs struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Blobs struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Blobs
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Blobs) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.Names, err = _Map_Binary_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TSet {
+				v.Keys, err = _Set_Binary_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Counts, err = _Map_Color_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_Binary_String_Encode(val []struct {
+	Key   []byte
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key []byte; Value string}': key is nil")
+		}
+		if err := sw.WriteBinary(key); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_Binary_sliceType_Encode(val [][]byte, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid set '[]byte': contains nil value")
+		}
+
+		if err := sw.WriteBinary(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_Color_I32_Encode(val map[Color]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := k.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Blobs struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Blobs struct could not be encoded.
+func (v *Blobs) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Names != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Binary_String_Encode(v.Names, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Keys != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_Binary_sliceType_Encode(v.Keys, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Counts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Color_I32_Encode(v.Counts, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Map_Binary_String_Decode(sr stream.Reader) ([]struct {
+	Key   []byte
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   []byte
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_Binary_sliceType_Decode(sr stream.Reader) ([][]byte, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([][]byte, 0, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Color_Decode(sr stream.Reader) (Color, error) {
+	var v Color
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Map_Color_I32_Decode(sr stream.Reader) (map[Color]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[Color]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Color_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Blobs struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Blobs struct could not be generated from the wire
+// representation.
+func (v *Blobs) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Names, err = _Map_Binary_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.Keys, err = _Set_Binary_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Counts, err = _Map_Color_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Blobs
+// struct.
+func (v *Blobs) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Names != nil {
+		fields[i] = fmt.Sprintf("Names: %v", v.Names)
+		i++
+	}
+	if v.Keys != nil {
+		fields[i] = fmt.Sprintf("Keys: %v", v.Keys)
+		i++
+	}
+	if v.Counts != nil {
+		fields[i] = fmt.Sprintf("Counts: %v", v.Counts)
+		i++
+	}
+
+	return fmt.Sprintf("Blobs{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_Binary_String_Equals(lhs, rhs []struct {
+	Key   []byte
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !bytes.Equal(lk, rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Binary_sliceType_Equals(lhs, rhs [][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if bytes.Equal(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_Color_I32_Equals(lhs, rhs map[Color]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Blobs match the
+// provided Blobs.
+//
+// This function performs a deep comparison.
+func (v *Blobs) Equals(rhs *Blobs) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Names == nil && rhs.Names == nil) || (v.Names != nil && rhs.Names != nil && _Map_Binary_String_Equals(v.Names, rhs.Names))) {
+		return false
+	}
+	if !((v.Keys == nil && rhs.Keys == nil) || (v.Keys != nil && rhs.Keys != nil && _Set_Binary_sliceType_Equals(v.Keys, rhs.Keys))) {
+		return false
+	}
+	if !((v.Counts == nil && rhs.Counts == nil) || (v.Counts != nil && rhs.Counts != nil && _Map_Color_I32_Equals(v.Counts, rhs.Counts))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _Map_Binary_String_Copy(v []struct {
+	Key   []byte
+	Value string
+}) []struct {
+	Key   []byte
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Binary_Copy(x.Key)
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Set_Binary_sliceType_Copy(v [][]byte) [][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(v))
+	for i, x := range v {
+		o[i] = _Binary_Copy(x)
+	}
+	return o
+}
+
+func _Map_Color_I32_Copy(v map[Color]int32) map[Color]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[Color]int32, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Blobs.
+func (v *Blobs) Copy() *Blobs {
+	if v == nil {
+		return nil
+	}
+
+	var o Blobs
+	o.Names = _Map_Binary_String_Copy(v.Names)
+	o.Keys = _Set_Binary_sliceType_Copy(v.Keys)
+	o.Counts = _Map_Color_I32_Copy(v.Counts)
+	return &o
+}
+
+func _Map_Binary_String_Hash(v []struct {
+	Key   []byte
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Binary(x.Key)
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Binary_sliceType_Hash(v [][]byte) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Binary(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Color_I32_Hash(v map[Color]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(k))
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Blobs which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Blobs) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Map_Binary_String_Hash(v.Names))
+	h.Field(2)
+	h.Uint64(_Set_Binary_sliceType_Hash(v.Keys))
+	h.Field(3)
+	h.Uint64(_Map_Color_I32_Hash(v.Counts))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Blobs so that it may be reused.
+func (v *Blobs) Reset() {
+	*v = Blobs{}
+}
+
+type _Map_Binary_String_Item_Zapper struct {
+	Key   []byte
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Binary_String_Item_Zapper.
+func (v _Map_Binary_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", base64.StdEncoding.EncodeToString(v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Binary_String_Zapper []struct {
+	Key   []byte
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Binary_String_Zapper.
+func (m _Map_Binary_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Binary_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Set_Binary_sliceType_Zapper [][]byte
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Binary_sliceType_Zapper.
+func (s _Set_Binary_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		enc.AppendString(base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
+type _Map_Color_I32_Item_Zapper struct {
+	Key   Color
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Color_I32_Item_Zapper.
+func (v _Map_Color_I32_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddInt32("value", v.Value)
+	return err
+}
+
+type _Map_Color_I32_Zapper map[Color]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Color_I32_Zapper.
+func (m _Map_Color_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_Color_I32_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Blobs.
+func (v *Blobs) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Names != nil {
+		err = multierr.Append(err, enc.AddArray("names", (_Map_Binary_String_Zapper)(v.Names)))
+	}
+	if v.Keys != nil {
+		err = multierr.Append(err, enc.AddArray("keys", (_Set_Binary_sliceType_Zapper)(v.Keys)))
+	}
+	if v.Counts != nil {
+		err = multierr.Append(err, enc.AddArray("counts", (_Map_Color_I32_Zapper)(v.Counts)))
+	}
+	return err
+}
+
+// GetNames returns the value of Names if it is set or its
+// zero value if it is unset.
+func (v *Blobs) GetNames() (o []struct {
+	Key   []byte
+	Value string
+}) {
+	if v != nil && v.Names != nil {
+		return v.Names
+	}
+
+	return
+}
+
+// IsSetNames returns true if Names is not nil.
+func (v *Blobs) IsSetNames() bool {
+	return v != nil && v.Names != nil
+}
+
+// GetKeys returns the value of Keys if it is set or its
+// zero value if it is unset.
+func (v *Blobs) GetKeys() (o [][]byte) {
+	if v != nil && v.Keys != nil {
+		return v.Keys
+	}
+
+	return
+}
+
+// IsSetKeys returns true if Keys is not nil.
+func (v *Blobs) IsSetKeys() bool {
+	return v != nil && v.Keys != nil
+}
+
+// GetCounts returns the value of Counts if it is set or its
+// zero value if it is unset.
+func (v *Blobs) GetCounts() (o map[Color]int32) {
+	if v != nil && v.Counts != nil {
+		return v.Counts
+	}
+
+	return
+}
+
+// IsSetCounts returns true if Counts is not nil.
+func (v *Blobs) IsSetCounts() bool {
+	return v != nil && v.Counts != nil
+}
+
+func _Binary_Generate(r *rand.Rand, size int) []byte {
+	o := make([]byte, r.Intn(size+1))
+	r.Read(o)
+	return o
+}
+
+func _String_Generate(r *rand.Rand, size int) string {
+	b := make([]rune, r.Intn(size+1))
+	for i := range b {
+
+		b[i] = r.Int31n(0xD800)
+		if r.Intn(4) == 0 {
+			b[i] = 0xE000 + r.Int31n(0x110000-0xE000)
+		}
+	}
+	return string(b)
+}
+
+func _Map_Binary_String_Generate(r *rand.Rand, size int) []struct {
+	Key   []byte
+	Value string
+} {
+	n := r.Intn(size + 1)
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, n)
+	m := 0
+	for i := 0; i < n; i++ {
+		x := _Binary_Generate(r, size/(n+1))
+		if !func() bool {
+			for _, y := range o[:m] {
+				if bytes.Equal(y.Key, x) {
+					return true
+				}
+			}
+			return false
+		}() {
+			o[m].Key = x
+			o[m].Value = _String_Generate(r, size/(n+1))
+			m++
+		}
+	}
+	return o[:m]
+}
+
+func _Set_Binary_sliceType_Generate(r *rand.Rand, size int) [][]byte {
+	n := r.Intn(size + 1)
+	o := make([][]byte, n)
+	m := 0
+	for i := 0; i < n; i++ {
+		x := _Binary_Generate(r, size/(n+1))
+		if !func() bool {
+			for _, y := range o[:m] {
+				if bytes.Equal(y, x) {
+					return true
+				}
+			}
+			return false
+		}() {
+			o[m] = x
+			m++
+		}
+	}
+	return o[:m]
+}
+
+func _Color_Generate(r *rand.Rand, size int) Color {
+	values := Color_Values()
+	if len(values) == 0 {
+		return Color(r.Int31())
+	}
+	return values[r.Intn(len(values))]
+}
+
+func _Map_Color_I32_Generate(r *rand.Rand, size int) map[Color]int32 {
+	n := r.Intn(size + 1)
+	o := make(map[Color]int32, n)
+	for i := 0; i < n; i++ {
+		o[_Color_Generate(r, size/(n+1))] = int32(r.Uint64())
+	}
+	return o
+}
+
+// Generate returns a random *Blobs for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*Blobs) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Blobs
+	if size > 0 && r.Intn(2) == 0 {
+		v.Names = _Map_Binary_String_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Keys = _Set_Binary_sliceType_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Counts = _Map_Color_I32_Generate(r, size/2)
+	}
+	return reflect.ValueOf(&v)
+}
+
+type Color int32
+
+const (
+	ColorRed   Color = 0
+	ColorGreen Color = 1
+	ColorBlue  Color = 2
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+		ColorBlue,
+	}
+}
+
+// Color_Names returns the names of all recognized values of
+// Color, in the same order as Color_Values.
+func Color_Names() []string {
+	return []string{
+		"RED",
+		"GREEN",
+		"BLUE",
+	}
+}
+
+// IsValid returns true if this Color is one of its recognized
+// values.
+func (v Color) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "GREEN":
+		*v = ColorGreen
+		return nil
+	case "BLUE":
+		*v = ColorBlue
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Color", err)
+		}
+		*v = Color(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Color to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Color) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("RED"), nil
+	case 1:
+		return []byte("GREEN"), nil
+	case 2:
+		return []byte("BLUE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "GREEN")
+	case 2:
+		enc.AddString("name", "BLUE")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Color) Ptr() *Color {
+	return &v
+}
+
+// Set sets Color from its name or integer value.
+//
+// This implements flag.Value, allowing Color to be used as a
+// command line flag.
+func (v *Color) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Color) Type() string {
+	return "Color"
+}
+
+// Encode encodes Color directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Color
+//   return v.Encode(sWriter)
+func (v Color) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Color directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Color
+//   if err := v.Decode(sReader); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Color)(i)
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "GREEN"
+	case 2:
+		return "BLUE"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"GREEN\""), nil
+	case 2:
+		return ([]byte)("\"BLUE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+// Generate returns a random *Color for testing/quick, chosen
+// among the known values of the enum.
+func (*Color) Generate(r *rand.Rand, _ int) reflect.Value {
+	v := _Color_Generate(r, 0)
+	return reflect.ValueOf(&v)
+}
+
+type Containers struct {
+	Points []*Point            `json:"points,omitempty"`
+	Tags   map[string]struct{} `json:"tags,omitempty"`
+	Scores map[string][]int32  `json:"scores,omitempty"`
+	Labels []struct {
+		Key   *Point
+		Value string
+	} `json:"labels,omitempty"`
+	Locations []*Location     `json:"locations,omitempty"`
+	Colors    []Color         `json:"colors,omitempty"`
+	Empties   map[int64]Empty `json:"empties,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _Map_String_List_I32_MapItemList map[string][]int32
+
+func (m _Map_String_List_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]int32', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueList(_List_I32_ValueList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_List_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_List_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_List_I32_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_List_I32_MapItemList) Close() {}
+
+type _Map_Point_String_MapItemList []struct {
+	Key   *Point
+	Value string
+}
+
+func (m _Map_Point_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Point_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Point_String_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Point_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Point_String_MapItemList) Close() {}
+
+type _Set_Location_sliceType_ValueList []*Location
+
+func (v _Set_Location_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set '*Location': contains nil value")
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Location_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Location_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_Location_sliceType_ValueList) Close() {}
+
+type _Set_Color_sliceType_ValueList []Color
+
+func (v _Set_Color_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Color_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Color_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_Color_sliceType_ValueList) Close() {}
+
+type _Map_I64_Empty_MapItemList map[int64]Empty
+
+func (m _Map_I64_Empty_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI64(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I64_Empty_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I64_Empty_MapItemList) KeyType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_I64_Empty_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I64_Empty_MapItemList) Close() {}
+
+// ToWire translates a Containers struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Containers) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Scores != nil {
+		w, err = wire.NewValueMap(_Map_String_List_I32_MapItemList(v.Scores)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_Point_String_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Locations != nil {
+		w, err = wire.NewValueSet(_Set_Location_sliceType_ValueList(v.Locations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Colors != nil {
+		w, err = wire.NewValueSet(_Set_Color_sliceType_ValueList(v.Colors)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Empties != nil {
+		w, err = wire.NewValueMap(_Map_I64_Empty_MapItemList(v.Empties)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_List_I32_Read(m wire.MapItemList) (map[string][]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string][]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _List_I32_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_Point_String_Read(m wire.MapItemList) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Point_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Location_Read(w wire.Value) (*Location, error) {
+	var x Location
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _Set_Location_sliceType_Read(s wire.ValueList) ([]*Location, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Location, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Location_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_Color_sliceType_Read(s wire.ValueList) ([]Color, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]Color, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Color_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Empty_Read(w wire.Value) (Empty, error) {
+	var v Empty
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Map_I64_Empty_Read(m wire.MapItemList) (map[int64]Empty, error) {
+	if m.KeyType() != wire.TI64 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[int64]Empty, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Empty_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Containers struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Containers struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Containers
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Containers) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Scores, err = _Map_String_List_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_Point_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Locations, err = _Set_Location_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Colors, err = _Set_Color_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Empties, err = _Map_I64_Empty_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _List_I32_Encode(val []int32, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []int32
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteInt32(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_List_I32_Encode(val map[string][]int32, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TList,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]int32', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := _List_I32_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_Point_String_Encode(val []struct {
+	Key   *Point
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TStruct,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Point; Value string}': key is nil")
+		}
+		if err := key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_Location_sliceType_Encode(val []*Location, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid set '*Location': contains nil value")
+		}
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Set_Color_sliceType_Encode(val []Color, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_I64_Empty_Encode(val map[int64]Empty, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI64,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteInt64(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Containers struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Containers struct could not be encoded.
+func (v *Containers) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Points, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Scores != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_List_I32_Encode(v.Scores, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Point_String_Encode(v.Labels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Locations != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_Location_sliceType_Encode(v.Locations, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Colors != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_Color_sliceType_Encode(v.Colors, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Empties != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I64_Empty_Encode(v.Empties, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int32, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_List_I32_Decode(sr stream.Reader) (map[string][]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TList {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string][]int32, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _List_I32_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Point_String_Decode(sr stream.Reader) ([]struct {
+	Key   *Point
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Location_Decode(sr stream.Reader) (*Location, error) {
+	var x Location
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _Set_Location_sliceType_Decode(sr stream.Reader) ([]*Location, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TStruct {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([]*Location, 0, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Location_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_Color_sliceType_Decode(sr stream.Reader) ([]Color, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([]Color, 0, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Color_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Empty_Decode(sr stream.Reader) (Empty, error) {
+	var v Empty
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Map_I64_Empty_Decode(sr stream.Reader) (map[int64]Empty, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI64 || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[int64]Empty, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Empty_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Containers struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Containers struct could not be generated from the wire
+// representation.
+func (v *Containers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Scores, err = _Map_String_List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_Point_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TSet:
+			v.Locations, err = _Set_Location_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.Colors, err = _Set_Color_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Empties, err = _Map_I64_Empty_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Containers
+// struct.
+func (v *Containers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Scores != nil {
+		fields[i] = fmt.Sprintf("Scores: %v", v.Scores)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+	if v.Locations != nil {
+		fields[i] = fmt.Sprintf("Locations: %v", v.Locations)
+		i++
+	}
+	if v.Colors != nil {
+		fields[i] = fmt.Sprintf("Colors: %v", v.Colors)
+		i++
+	}
+	if v.Empties != nil {
+		fields[i] = fmt.Sprintf("Empties: %v", v.Empties)
+		i++
+	}
+
+	return fmt.Sprintf("Containers{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_List_I32_Equals(lhs, rhs map[string][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Point_String_Equals(lhs, rhs []struct {
+	Key   *Point
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Location_sliceType_Equals(lhs, rhs []*Location) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Color_sliceType_Equals(lhs, rhs []Color) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_I64_Empty_Equals(lhs, rhs map[int64]Empty) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Containers match the
+// provided Containers.
+//
+// This function performs a deep comparison.
+func (v *Containers) Equals(rhs *Containers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_mapType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Scores == nil && rhs.Scores == nil) || (v.Scores != nil && rhs.Scores != nil && _Map_String_List_I32_Equals(v.Scores, rhs.Scores))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_Point_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+	if !((v.Locations == nil && rhs.Locations == nil) || (v.Locations != nil && rhs.Locations != nil && _Set_Location_sliceType_Equals(v.Locations, rhs.Locations))) {
+		return false
+	}
+	if !((v.Colors == nil && rhs.Colors == nil) || (v.Colors != nil && rhs.Colors != nil && _Set_Color_sliceType_Equals(v.Colors, rhs.Colors))) {
+		return false
+	}
+	if !((v.Empties == nil && rhs.Empties == nil) || (v.Empties != nil && rhs.Empties != nil && _Map_I64_Empty_Equals(v.Empties, rhs.Empties))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _List_I32_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_List_I32_Copy(v map[string][]int32) map[string][]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]int32, len(v))
+	for k, x := range v {
+		o[k] = _List_I32_Copy(x)
+	}
+	return o
+}
+
+func _Map_Point_String_Copy(v []struct {
+	Key   *Point
+	Value string
+}) []struct {
+	Key   *Point
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Set_Location_sliceType_Copy(v []*Location) []*Location {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Location, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Set_Color_sliceType_Copy(v []Color) []Color {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Color, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_I64_Empty_Copy(v map[int64]Empty) map[int64]Empty {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]Empty, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Containers.
+func (v *Containers) Copy() *Containers {
+	if v == nil {
+		return nil
+	}
+
+	var o Containers
+	o.Points = _List_Point_Copy(v.Points)
+	o.Tags = _Set_String_mapType_Copy(v.Tags)
+	o.Scores = _Map_String_List_I32_Copy(v.Scores)
+	o.Labels = _Map_Point_String_Copy(v.Labels)
+	o.Locations = _Set_Location_sliceType_Copy(v.Locations)
+	o.Colors = _Set_Color_sliceType_Copy(v.Colors)
+	o.Empties = _Map_I64_Empty_Copy(v.Empties)
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_I32_Hash(v []int32) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_List_I32_Hash(v map[string][]int32) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(_List_I32_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Point_String_Hash(v []struct {
+	Key   *Point
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Location_sliceType_Hash(v []*Location) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Color_sliceType_Hash(v []Color) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_I64_Empty_Hash(v map[int64]Empty) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int64(k)
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Containers which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Containers) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Point_Hash(v.Points))
+	h.Field(2)
+	h.Uint64(_Set_String_mapType_Hash(v.Tags))
+	h.Field(3)
+	h.Uint64(_Map_String_List_I32_Hash(v.Scores))
+	h.Field(4)
+	h.Uint64(_Map_Point_String_Hash(v.Labels))
+	h.Field(5)
+	h.Uint64(_Set_Location_sliceType_Hash(v.Locations))
+	h.Field(6)
+	h.Uint64(_Set_Color_sliceType_Hash(v.Colors))
+	h.Field(7)
+	h.Uint64(_Map_I64_Empty_Hash(v.Empties))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Containers so that it may be reused.
+func (v *Containers) Reset() {
+	*v = Containers{}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+type _Map_String_List_I32_Zapper map[string][]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_List_I32_Zapper.
+func (m _Map_String_List_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddArray((string)(k), (_List_I32_Zapper)(v)))
+	}
+	return err
+}
+
+type _Map_Point_String_Item_Zapper struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Item_Zapper.
+func (v _Map_Point_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Point_String_Zapper []struct {
+	Key   *Point
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_String_Zapper.
+func (m _Map_Point_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Point_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Set_Location_sliceType_Zapper []*Location
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Location_sliceType_Zapper.
+func (s _Set_Location_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Set_Color_sliceType_Zapper []Color
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Color_sliceType_Zapper.
+func (s _Set_Color_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_I64_Empty_Item_Zapper struct {
+	Key   int64
+	Value Empty
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I64_Empty_Item_Zapper.
+func (v _Map_I64_Empty_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt64("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
+
+type _Map_I64_Empty_Zapper map[int64]Empty
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I64_Empty_Zapper.
+func (m _Map_I64_Empty_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I64_Empty_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Containers.
+func (v *Containers) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_String_mapType_Zapper)(v.Tags)))
+	}
+	if v.Scores != nil {
+		err = multierr.Append(err, enc.AddObject("scores", (_Map_String_List_I32_Zapper)(v.Scores)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_Point_String_Zapper)(v.Labels)))
+	}
+	if v.Locations != nil {
+		err = multierr.Append(err, enc.AddArray("locations", (_Set_Location_sliceType_Zapper)(v.Locations)))
+	}
+	if v.Colors != nil {
+		err = multierr.Append(err, enc.AddArray("colors", (_Set_Color_sliceType_Zapper)(v.Colors)))
+	}
+	if v.Empties != nil {
+		err = multierr.Append(err, enc.AddArray("empties", (_Map_I64_Empty_Zapper)(v.Empties)))
+	}
+	return err
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Containers) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Containers) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetScores returns the value of Scores if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetScores() (o map[string][]int32) {
+	if v != nil && v.Scores != nil {
+		return v.Scores
+	}
+
+	return
+}
+
+// IsSetScores returns true if Scores is not nil.
+func (v *Containers) IsSetScores() bool {
+	return v != nil && v.Scores != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetLabels() (o []struct {
+	Key   *Point
+	Value string
+}) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Containers) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetLocations returns the value of Locations if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetLocations() (o []*Location) {
+	if v != nil && v.Locations != nil {
+		return v.Locations
+	}
+
+	return
+}
+
+// IsSetLocations returns true if Locations is not nil.
+func (v *Containers) IsSetLocations() bool {
+	return v != nil && v.Locations != nil
+}
+
+// GetColors returns the value of Colors if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetColors() (o []Color) {
+	if v != nil && v.Colors != nil {
+		return v.Colors
+	}
+
+	return
+}
+
+// IsSetColors returns true if Colors is not nil.
+func (v *Containers) IsSetColors() bool {
+	return v != nil && v.Colors != nil
+}
+
+// GetEmpties returns the value of Empties if it is set or its
+// zero value if it is unset.
+func (v *Containers) GetEmpties() (o map[int64]Empty) {
+	if v != nil && v.Empties != nil {
+		return v.Empties
+	}
+
+	return
+}
+
+// IsSetEmpties returns true if Empties is not nil.
+func (v *Containers) IsSetEmpties() bool {
+	return v != nil && v.Empties != nil
+}
+
+func _Point_Generate(r *rand.Rand, size int) *Point {
+	return (*Point)(nil).Generate(r, size).Interface().(*Point)
+}
+
+func _List_Point_Generate(r *rand.Rand, size int) []*Point {
+	n := r.Intn(size + 1)
+	o := make([]*Point, n)
+	for i := range o {
+		o[i] = _Point_Generate(r, size/(n+1))
+	}
+	return o
+}
+
+func _Set_String_mapType_Generate(r *rand.Rand, size int) map[string]struct{} {
+	n := r.Intn(size + 1)
+	o := make(map[string]struct{}, n)
+	for i := 0; i < n; i++ {
+		o[_String_Generate(r, size/(n+1))] = struct{}{}
+	}
+	return o
+}
+
+func _List_I32_Generate(r *rand.Rand, size int) []int32 {
+	n := r.Intn(size + 1)
+	o := make([]int32, n)
+	for i := range o {
+		o[i] = int32(r.Uint64())
+	}
+	return o
+}
+
+func _Map_String_List_I32_Generate(r *rand.Rand, size int) map[string][]int32 {
+	n := r.Intn(size + 1)
+	o := make(map[string][]int32, n)
+	for i := 0; i < n; i++ {
+		o[_String_Generate(r, size/(n+1))] = _List_I32_Generate(r, size/(n+1))
+	}
+	return o
+}
+
+func _Map_Point_String_Generate(r *rand.Rand, size int) []struct {
+	Key   *Point
+	Value string
+} {
+	n := r.Intn(size + 1)
+	o := make([]struct {
+		Key   *Point
+		Value string
+	}, n)
+	m := 0
+	for i := 0; i < n; i++ {
+		x := _Point_Generate(r, size/(n+1))
+		if !func() bool {
+			for _, y := range o[:m] {
+				if y.Key.Equals(x) {
+					return true
+				}
+			}
+			return false
+		}() {
+			o[m].Key = x
+			o[m].Value = _String_Generate(r, size/(n+1))
+			m++
+		}
+	}
+	return o[:m]
+}
+
+func _Set_Location_sliceType_Generate(r *rand.Rand, size int) []*Location {
+	n := r.Intn(size + 1)
+	o := make([]*Location, n)
+	m := 0
+	for i := 0; i < n; i++ {
+		x := (*Location)(_Point_Generate(r, size/(n+1)))
+		if !func() bool {
+			for _, y := range o[:m] {
+				if y.Equals(x) {
+					return true
+				}
+			}
+			return false
+		}() {
+			o[m] = x
+			m++
+		}
+	}
+	return o[:m]
+}
+
+func _Set_Color_sliceType_Generate(r *rand.Rand, size int) []Color {
+	n := r.Intn(size + 1)
+	o := make([]Color, n)
+	m := 0
+	for i := 0; i < n; i++ {
+		x := _Color_Generate(r, size/(n+1))
+		if !func() bool {
+			for _, y := range o[:m] {
+				if y.Equals(x) {
+					return true
+				}
+			}
+			return false
+		}() {
+			o[m] = x
+			m++
+		}
+	}
+	return o[:m]
+}
+
+func _Empty_Generate(r *rand.Rand, size int) Empty {
+	values := Empty_Values()
+	if len(values) == 0 {
+		return Empty(r.Int31())
+	}
+	return values[r.Intn(len(values))]
+}
+
+func _Map_I64_Empty_Generate(r *rand.Rand, size int) map[int64]Empty {
+	n := r.Intn(size + 1)
+	o := make(map[int64]Empty, n)
+	for i := 0; i < n; i++ {
+		o[int64(r.Uint64())] = _Empty_Generate(r, size/(n+1))
+	}
+	return o
+}
+
+// Generate returns a random *Containers for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*Containers) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Containers
+	if size > 0 && r.Intn(2) == 0 {
+		v.Points = _List_Point_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Tags = _Set_String_mapType_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Scores = _Map_String_List_I32_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Labels = _Map_Point_String_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Locations = _Set_Location_sliceType_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Colors = _Set_Color_sliceType_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Empties = _Map_I64_Empty_Generate(r, size/2)
+	}
+	return reflect.ValueOf(&v)
+}
+
+type Empty int32
+
+// Empty_Values returns all recognized values of Empty.
+func Empty_Values() []Empty {
+	return []Empty{}
+}
+
+// Empty_Names returns the names of all recognized values of
+// Empty, in the same order as Empty_Values.
+func Empty_Names() []string {
+	return []string{}
+}
+
+// IsValid returns true if this Empty is one of its recognized
+// values.
+func (v Empty) IsValid() bool {
+	return false
+}
+
+// UnmarshalText tries to decode Empty from a byte slice
+// containing its name.
+func (v *Empty) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Empty", err)
+		}
+		*v = Empty(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Empty to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Empty) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Empty.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Empty) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Empty) Ptr() *Empty {
+	return &v
+}
+
+// Set sets Empty from its name or integer value.
+//
+// This implements flag.Value, allowing Empty to be used as a
+// command line flag.
+func (v *Empty) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Empty) Type() string {
+	return "Empty"
+}
+
+// Encode encodes Empty directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Empty
+//   return v.Encode(sWriter)
+func (v Empty) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Empty into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Empty) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Empty from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Empty(0), err
+//   }
+//
+//   var v Empty
+//   if err := v.FromWire(x); err != nil {
+//     return Empty(0), err
+//   }
+//   return v, nil
+func (v *Empty) FromWire(w wire.Value) error {
+	*v = (Empty)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Empty directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Empty
+//   if err := v.Decode(sReader); err != nil {
+//     return Empty(0), err
+//   }
+//   return v, nil
+func (v *Empty) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Empty)(i)
+	return nil
+}
+
+// String returns a readable string representation of Empty.
+func (v Empty) String() string {
+	w := int32(v)
+	return fmt.Sprintf("Empty(%d)", w)
+}
+
+// Equals returns true if this Empty value matches the provided
+// value.
+func (v Empty) Equals(rhs Empty) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Empty into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Empty) MarshalJSON() ([]byte, error) {
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Empty from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Empty) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Empty")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Empty")
+		}
+		*v = (Empty)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Empty")
+	}
+}
+
+// Generate returns a random *Empty for testing/quick, chosen
+// among the known values of the enum.
+func (*Empty) Generate(r *rand.Rand, _ int) reflect.Value {
+	v := _Empty_Generate(r, 0)
+	return reflect.ValueOf(&v)
+}
+
+type Forever struct {
+	Again *Forever `json:"again,required"`
+}
+
+// ToWire translates a Forever struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Forever) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Again == nil {
+		return w, errors.New("field Again of Forever is required")
+	}
+	w, err = v.Again.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Forever_Read(w wire.Value) (*Forever, error) {
+	var v Forever
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Forever struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Forever struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Forever
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Forever) FromWire(w wire.Value) error {
+	var err error
+
+	againIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Again, err = _Forever_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				againIsSet = true
+			}
+		}
+	}
+
+	if !againIsSet {
+		return errors.New("field Again of Forever is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Forever struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Forever struct could not be encoded.
+func (v *Forever) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Again == nil {
+		return errors.New("field Again of Forever is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Again.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Forever_Decode(sr stream.Reader) (*Forever, error) {
+	var v Forever
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Forever struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Forever struct could not be generated from the wire
+// representation.
+func (v *Forever) Decode(sr stream.Reader) error {
+
+	againIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Again, err = _Forever_Decode(sr)
+			if err != nil {
+				return err
+			}
+			againIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !againIsSet {
+		return errors.New("field Again of Forever is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Forever
+// struct.
+func (v *Forever) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Again: %v", v.Again)
+	i++
+
+	return fmt.Sprintf("Forever{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Forever match the
+// provided Forever.
+//
+// This function performs a deep comparison.
+func (v *Forever) Equals(rhs *Forever) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Again.Equals(rhs.Again) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Forever.
+func (v *Forever) Copy() *Forever {
+	if v == nil {
+		return nil
+	}
+
+	var o Forever
+	o.Again = v.Again.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Forever which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Forever) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Again.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Forever so that it may be reused.
+func (v *Forever) Reset() {
+	*v = Forever{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Forever.
+func (v *Forever) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("again", v.Again))
+	return err
+}
+
+// GetAgain returns the value of Again if it is set or its
+// zero value if it is unset.
+func (v *Forever) GetAgain() (o *Forever) {
+	if v != nil {
+		o = v.Again
+	}
+	return
+}
+
+// IsSetAgain returns true if Again is not nil.
+func (v *Forever) IsSetAgain() bool {
+	return v != nil && v.Again != nil
+}
+
+type Location Point
+
+// ToWire translates Location into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Location) ToWire() (wire.Value, error) {
+	x := (*Point)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Location.
+func (v *Location) String() string {
+	x := (*Point)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *Location) Encode(sw stream.Writer) error {
+	x := (*Point)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes Location from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Location) FromWire(w wire.Value) error {
+	return (*Point)(v).FromWire(w)
+}
+
+// Decode deserializes Location directly off the wire.
+func (v *Location) Decode(sr stream.Reader) error {
+	return (*Point)(v).Decode(sr)
+}
+
+// Equals returns true if this Location is equal to the provided
+// Location.
+func (lhs *Location) Equals(rhs *Location) bool {
+	return (*Point)(lhs).Equals((*Point)(rhs))
+}
+
+// Copy returns a deep copy of this Location.
+func (v *Location) Copy() *Location {
+	x := (*Point)(v)
+	return (*Location)(x.Copy())
+}
+
+// Hash returns a hash of this Location which is stable across
+// processes.
+func (v *Location) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Point)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *Location) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Point)(v)).MarshalLogObject(enc)
+}
+
+type Name string
+
+// NamePtr returns a pointer to a Name
+func (v Name) Ptr() *Name {
+	return &v
+}
+
+// ToWire translates Name into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Name) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Name.
+func (v Name) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Name) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Name from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Name) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Name)(x)
+	return err
+}
+
+// Decode deserializes Name directly off the wire.
+func (v *Name) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Name)(x)
+	return err
+}
+
+// Equals returns true if this Name is equal to the provided
+// Name.
+func (lhs Name) Equals(rhs Name) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Name which is stable across
+// processes.
+func (v Name) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+type Node struct {
+	Name     Name    `json:"name,required"`
+	Sibling  *Node   `json:"sibling,omitempty"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+type _List_Node_ValueList []*Node
+
+func (v _List_Node_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Node', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Node_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Node_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Node_ValueList) Close() {}
+
+// ToWire translates a Node struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Node) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.Name.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Sibling != nil {
+		w, err = v.Sibling.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Children != nil {
+		w, err = wire.NewValueList(_List_Node_ValueList(v.Children)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Name_Read(w wire.Value) (Name, error) {
+	var x Name
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Node_Read(w wire.Value) (*Node, error) {
+	var v Node
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Node_Read(l wire.ValueList) ([]*Node, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Node, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Node_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Node struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Node struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Node
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Node) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _Name_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Sibling, err = _Node_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Children, err = _List_Node_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Node is required")
+	}
+
+	return nil
+}
+
+func _List_Node_Encode(val []*Node, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Node
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Node', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Node struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Node struct could not be encoded.
+func (v *Node) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.Name.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Sibling != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Sibling.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Children != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Node_Encode(v.Children, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Name_Decode(sr stream.Reader) (Name, error) {
+	var x Name
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Node_Decode(sr stream.Reader) (*Node, error) {
+	var v Node
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Node_Decode(sr stream.Reader) ([]*Node, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Node, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Node_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Node struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Node struct could not be generated from the wire
+// representation.
+func (v *Node) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = _Name_Decode(sr)
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Sibling, err = _Node_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Children, err = _List_Node_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Node is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Node
+// struct.
+func (v *Node) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Sibling != nil {
+		fields[i] = fmt.Sprintf("Sibling: %v", v.Sibling)
+		i++
+	}
+	if v.Children != nil {
+		fields[i] = fmt.Sprintf("Children: %v", v.Children)
+		i++
+	}
+
+	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Node_Equals(lhs, rhs []*Node) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Node match the
+// provided Node.
+//
+// This function performs a deep comparison.
+func (v *Node) Equals(rhs *Node) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Sibling == nil && rhs.Sibling == nil) || (v.Sibling != nil && rhs.Sibling != nil && v.Sibling.Equals(rhs.Sibling))) {
+		return false
+	}
+	if !((v.Children == nil && rhs.Children == nil) || (v.Children != nil && rhs.Children != nil && _List_Node_Equals(v.Children, rhs.Children))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Node_Copy(v []*Node) []*Node {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Node, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Node.
+func (v *Node) Copy() *Node {
+	if v == nil {
+		return nil
+	}
+
+	var o Node
+	o.Name = v.Name
+	o.Sibling = v.Sibling.Copy()
+	o.Children = _List_Node_Copy(v.Children)
+	return &o
+}
+
+func _List_Node_Hash(v []*Node) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Node which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Node) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Name))
+	h.Field(2)
+	h.Uint64(v.Sibling.Hash())
+	h.Field(3)
+	h.Uint64(_List_Node_Hash(v.Children))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Node so that it may be reused.
+func (v *Node) Reset() {
+	*v = Node{}
+}
+
+type _List_Node_Zapper []*Node
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Node_Zapper.
+func (l _List_Node_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", (string)(v.Name))
+	if v.Sibling != nil {
+		err = multierr.Append(err, enc.AddObject("sibling", v.Sibling))
+	}
+	if v.Children != nil {
+		err = multierr.Append(err, enc.AddArray("children", (_List_Node_Zapper)(v.Children)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Node) GetName() (o Name) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetSibling returns the value of Sibling if it is set or its
+// zero value if it is unset.
+func (v *Node) GetSibling() (o *Node) {
+	if v != nil && v.Sibling != nil {
+		return v.Sibling
+	}
+
+	return
+}
+
+// IsSetSibling returns true if Sibling is not nil.
+func (v *Node) IsSetSibling() bool {
+	return v != nil && v.Sibling != nil
+}
+
+// GetChildren returns the value of Children if it is set or its
+// zero value if it is unset.
+func (v *Node) GetChildren() (o []*Node) {
+	if v != nil && v.Children != nil {
+		return v.Children
+	}
+
+	return
+}
+
+// IsSetChildren returns true if Children is not nil.
+func (v *Node) IsSetChildren() bool {
+	return v != nil && v.Children != nil
+}
+
+func _Node_Generate(r *rand.Rand, size int) *Node {
+	return (*Node)(nil).Generate(r, size).Interface().(*Node)
+}
+
+func _List_Node_Generate(r *rand.Rand, size int) []*Node {
+	n := r.Intn(size + 1)
+	o := make([]*Node, n)
+	for i := range o {
+		o[i] = _Node_Generate(r, size/(n+1))
+	}
+	return o
+}
+
+// Generate returns a random *Node for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*Node) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Node
+	v.Name = (Name)(_String_Generate(r, size/2))
+	if size > 0 && r.Intn(2) == 0 {
+		v.Sibling = _Node_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Children = _List_Node_Generate(r, size/2)
+	}
+	return reflect.ValueOf(&v)
+}
+
+type Optionals struct {
+	Forever  *Forever   `json:"forever,omitempty"`
+	Forevers []*Forever `json:"forevers,omitempty"`
+	Count    int32      `json:"count,omitempty"`
+
+	presence [1]uint64
+}
+
+type _List_Forever_ValueList []*Forever
+
+func (v _List_Forever_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Forever', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Forever_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Forever_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Forever_ValueList) Close() {}
+
+// ToWire translates a Optionals struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Optionals) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Forever != nil {
+		w, err = v.Forever.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Forevers != nil {
+		w, err = wire.NewValueList(_List_Forever_ValueList(v.Forevers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.refCount() != nil {
+		w, err = wire.NewValueI32(*(v.refCount())), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Forever_Read(l wire.ValueList) ([]*Forever, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Forever, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Forever_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Optionals struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Optionals struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Optionals
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Optionals) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Forever, err = _Forever_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Forevers, err = _List_Forever_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				v.Count, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= (1 << 0)
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_Forever_Encode(val []*Forever, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Forever
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Forever', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Optionals struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Optionals struct could not be encoded.
+func (v *Optionals) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Forever != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Forever.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Forevers != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Forever_Encode(v.Forevers, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.refCount() != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.refCount())); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_Forever_Decode(sr stream.Reader) ([]*Forever, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Forever, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Forever_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Optionals struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Optionals struct could not be generated from the wire
+// representation.
+func (v *Optionals) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Forever, err = _Forever_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Forevers, err = _List_Forever_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			v.Count, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			v.presence[0] |= (1 << 0)
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Optionals
+// struct.
+func (v *Optionals) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Forever != nil {
+		fields[i] = fmt.Sprintf("Forever: %v", v.Forever)
+		i++
+	}
+	if v.Forevers != nil {
+		fields[i] = fmt.Sprintf("Forevers: %v", v.Forevers)
+		i++
+	}
+	if v.refCount() != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.refCount()))
+		i++
+	}
+
+	return fmt.Sprintf("Optionals{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Forever_Equals(lhs, rhs []*Forever) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Optionals match the
+// provided Optionals.
+//
+// This function performs a deep comparison.
+func (v *Optionals) Equals(rhs *Optionals) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Forever == nil && rhs.Forever == nil) || (v.Forever != nil && rhs.Forever != nil && v.Forever.Equals(rhs.Forever))) {
+		return false
+	}
+	if !((v.Forevers == nil && rhs.Forevers == nil) || (v.Forevers != nil && rhs.Forevers != nil && _List_Forever_Equals(v.Forevers, rhs.Forevers))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.refCount(), rhs.refCount()) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Forever_Copy(v []*Forever) []*Forever {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Forever, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Optionals.
+func (v *Optionals) Copy() *Optionals {
+	if v == nil {
+		return nil
+	}
+
+	var o Optionals
+	o.Forever = v.Forever.Copy()
+	o.Forevers = _List_Forever_Copy(v.Forevers)
+	o.Count = v.Count
+	o.presence = v.presence
+	return &o
+}
+
+func _List_Forever_Hash(v []*Forever) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Optionals which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Optionals) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Forever.Hash())
+	h.Field(2)
+	h.Uint64(_List_Forever_Hash(v.Forevers))
+	if v.refCount() != nil {
+		h.Field(3)
+		h.Int32(*v.refCount())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Optionals so that it may be reused.
+func (v *Optionals) Reset() {
+	*v = Optionals{}
+}
+
+type _List_Forever_Zapper []*Forever
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Forever_Zapper.
+func (l _List_Forever_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Optionals.
+func (v *Optionals) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Forever != nil {
+		err = multierr.Append(err, enc.AddObject("forever", v.Forever))
+	}
+	if v.Forevers != nil {
+		err = multierr.Append(err, enc.AddArray("forevers", (_List_Forever_Zapper)(v.Forevers)))
+	}
+	if v.refCount() != nil {
+		enc.AddInt32("count", *v.refCount())
+	}
+	return err
+}
+
+// MarshalJSON serializes a Optionals into JSON without its
+// unset optional fields.
+//
+// This has a value receiver so that Optionals values are marshaled this way in
+// addition to pointers to them.
+func (v Optionals) MarshalJSON() ([]byte, error) {
+	type plain Optionals // without this method
+
+	r := struct {
+		*plain
+		Count *int32 `json:"count,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Count = v.refCount()
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON deserializes a Optionals from JSON, recording which of
+// its optional fields are set.
+func (v *Optionals) UnmarshalJSON(data []byte) error {
+	type plain Optionals // without this method
+
+	r := struct {
+		*plain
+		Count *int32 `json:"count,omitempty"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	if r.Count != nil {
+		v.SetCount(*r.Count)
+	}
+	return nil
+}
+
+// GetForever returns the value of Forever if it is set or its
+// zero value if it is unset.
+func (v *Optionals) GetForever() (o *Forever) {
+	if v != nil && v.Forever != nil {
+		return v.Forever
+	}
+
+	return
+}
+
+// IsSetForever returns true if Forever is not nil.
+func (v *Optionals) IsSetForever() bool {
+	return v != nil && v.Forever != nil
+}
+
+// GetForevers returns the value of Forevers if it is set or its
+// zero value if it is unset.
+func (v *Optionals) GetForevers() (o []*Forever) {
+	if v != nil && v.Forevers != nil {
+		return v.Forevers
+	}
+
+	return
+}
+
+// IsSetForevers returns true if Forevers is not nil.
+func (v *Optionals) IsSetForevers() bool {
+	return v != nil && v.Forevers != nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *Optionals) GetCount() (o int32) {
+	if v != nil && v.presence[0]&(1<<0) != 0 {
+		return v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is set.
+func (v *Optionals) IsSetCount() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// HasCount returns true if Count is set.
+func (v *Optionals) HasCount() bool {
+	return v != nil && v.presence[0]&(1<<0) != 0
+}
+
+// ClearCount unsets Count.
+func (v *Optionals) ClearCount() {
+	var x int32
+	v.Count = x
+	v.presence[0] &^= (1 << 0)
+}
+
+// refCount returns a pointer to Count if it is set, and
+// nil otherwise.
+func (v *Optionals) refCount() *int32 {
+	if v.presence[0]&(1<<0) != 0 {
+		return &v.Count
+	}
+	return nil
+}
+
+// SetCount sets the value of Count.
+func (v *Optionals) SetCount(x int32) {
+	v.Count = x
+	v.presence[0] |= (1 << 0)
+}
+
+// Generate returns a random *Optionals for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*Optionals) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Optionals
+	if size > 0 && r.Intn(2) == 0 {
+		v.Forevers = []*Forever{}
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.Count = int32(r.Uint64())
+		v.presence[0] |= (1 << 0)
+	}
+	return reflect.ValueOf(&v)
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+// Generate returns a random *Point for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*Point) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Point
+	v.X = r.NormFloat64()
+	v.Y = r.NormFloat64()
+	return reflect.ValueOf(&v)
+}
+
+type Primitives struct {
+	BoolField   bool             `json:"boolField,required"`
+	ByteField   int8             `json:"byteField,required"`
+	Int16Field  *int16           `json:"int16Field,omitempty"`
+	Int32Field  *int32           `json:"int32Field,omitempty"`
+	Int64Field  *int64           `json:"int64Field,omitempty"`
+	DoubleField *float64         `json:"doubleField,omitempty"`
+	StringField *string          `json:"stringField,omitempty"`
+	BinaryField []byte           `json:"binaryField,omitempty"`
+	UuidField   *thriftuuid.UUID `json:"uuidField,omitempty"`
+	Color       *Color           `json:"color,omitempty"`
+	Name        *Name            `json:"name,omitempty"`
+}
+
+// ToWire translates a Primitives struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Primitives) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueBool(v.BoolField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI8(v.ByteField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Int16Field != nil {
+		w, err = wire.NewValueI16(*(v.Int16Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Int32Field != nil {
+		w, err = wire.NewValueI32(*(v.Int32Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Int64Field != nil {
+		w, err = wire.NewValueI64(*(v.Int64Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DoubleField != nil {
+		w, err = wire.NewValueDouble(*(v.DoubleField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.StringField != nil {
+		w, err = wire.NewValueString(*(v.StringField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.BinaryField != nil {
+		w, err = wire.NewValueBinary(v.BinaryField), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.UuidField != nil {
+		w, err = wire.NewValueBinary((*(v.UuidField)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Color != nil {
+		w, err = v.Color.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Name != nil {
+		w, err = v.Name.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UUID_Read(w wire.Value) (thriftuuid.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return thriftuuid.UUID(u), err
+}
+
+// FromWire deserializes a Primitives struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Primitives struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Primitives
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Primitives) FromWire(w wire.Value) error {
+	var err error
+
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				v.BoolField, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				boolFieldIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				v.ByteField, err = field.Value.GetI8(), error(nil)
+				if err != nil {
+					return err
+				}
+				byteFieldIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.Int16Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Int32Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Int64Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DoubleField = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StringField = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.BinaryField, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.UuidField = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x Color
+				x, err = _Color_Read(field.Value)
+				v.Color = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TBinary {
+				var x Name
+				x, err = _Name_Read(field.Value)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !boolFieldIsSet {
+		return errors.New("field BoolField of Primitives is required")
+	}
+
+	if !byteFieldIsSet {
+		return errors.New("field ByteField of Primitives is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Primitives struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Primitives struct could not be encoded.
+func (v *Primitives) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBool}); err != nil {
+		return err
+	}
+	if err := sw.WriteBool(v.BoolField); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI8}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt8(v.ByteField); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Int16Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.Int16Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Int32Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Int32Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Int64Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Int64Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DoubleField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.DoubleField)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StringField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StringField)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BinaryField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.BinaryField); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UuidField != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.UuidField)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Color != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Color.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.Name.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _UUID_Decode(sr stream.Reader) (thriftuuid.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return thriftuuid.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return thriftuuid.UUID(u), err
+}
+
+// Decode deserializes a Primitives struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Primitives struct could not be generated from the wire
+// representation.
+func (v *Primitives) Decode(sr stream.Reader) error {
+
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.BoolField, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			boolFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			v.ByteField, err = sr.ReadInt8()
+			if err != nil {
+				return err
+			}
+			byteFieldIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.Int16Field = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Int32Field = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Int64Field = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.DoubleField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StringField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.BinaryField, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.UuidField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x Color
+			x, err = _Color_Decode(sr)
+			v.Color = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 11 && fh.Type == wire.TBinary:
+			var x Name
+			x, err = _Name_Decode(sr)
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !boolFieldIsSet {
+		return errors.New("field BoolField of Primitives is required")
+	}
+
+	if !byteFieldIsSet {
+		return errors.New("field ByteField of Primitives is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Primitives
+// struct.
+func (v *Primitives) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	fields[i] = fmt.Sprintf("BoolField: %v", v.BoolField)
+	i++
+	fields[i] = fmt.Sprintf("ByteField: %v", v.ByteField)
+	i++
+	if v.Int16Field != nil {
+		fields[i] = fmt.Sprintf("Int16Field: %v", *(v.Int16Field))
+		i++
+	}
+	if v.Int32Field != nil {
+		fields[i] = fmt.Sprintf("Int32Field: %v", *(v.Int32Field))
+		i++
+	}
+	if v.Int64Field != nil {
+		fields[i] = fmt.Sprintf("Int64Field: %v", *(v.Int64Field))
+		i++
+	}
+	if v.DoubleField != nil {
+		fields[i] = fmt.Sprintf("DoubleField: %v", *(v.DoubleField))
+		i++
+	}
+	if v.StringField != nil {
+		fields[i] = fmt.Sprintf("StringField: %v", *(v.StringField))
+		i++
+	}
+	if v.BinaryField != nil {
+		fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
+		i++
+	}
+	if v.UuidField != nil {
+		fields[i] = fmt.Sprintf("UuidField: %v", *(v.UuidField))
+		i++
+	}
+	if v.Color != nil {
+		fields[i] = fmt.Sprintf("Color: %v", *(v.Color))
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("Primitives{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _UUID_EqualsPtr(lhs, rhs *thriftuuid.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Color_EqualsPtr(lhs, rhs *Color) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Name_EqualsPtr(lhs, rhs *Name) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Primitives match the
+// provided Primitives.
+//
+// This function performs a deep comparison.
+func (v *Primitives) Equals(rhs *Primitives) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.BoolField == rhs.BoolField) {
+		return false
+	}
+	if !(v.ByteField == rhs.ByteField) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.Int16Field, rhs.Int16Field) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Int32Field, rhs.Int32Field) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Int64Field, rhs.Int64Field) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DoubleField, rhs.DoubleField) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StringField, rhs.StringField) {
+		return false
+	}
+	if !((v.BinaryField == nil && rhs.BinaryField == nil) || (v.BinaryField != nil && rhs.BinaryField != nil && bytes.Equal(v.BinaryField, rhs.BinaryField))) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.UuidField, rhs.UuidField) {
+		return false
+	}
+	if !_Color_EqualsPtr(v.Color, rhs.Color) {
+		return false
+	}
+	if !_Name_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+func _I16_CopyPtr(v *int16) *int16 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _UUID_CopyPtr(v *thriftuuid.UUID) *thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Color_CopyPtr(v *Color) *Color {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Name_CopyPtr(v *Name) *Name {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Primitives.
+func (v *Primitives) Copy() *Primitives {
+	if v == nil {
+		return nil
+	}
+
+	var o Primitives
+	o.BoolField = v.BoolField
+	o.ByteField = v.ByteField
+	o.Int16Field = _I16_CopyPtr(v.Int16Field)
+	o.Int32Field = _I32_CopyPtr(v.Int32Field)
+	o.Int64Field = _I64_CopyPtr(v.Int64Field)
+	o.DoubleField = _Double_CopyPtr(v.DoubleField)
+	o.StringField = _String_CopyPtr(v.StringField)
+	o.BinaryField = _Binary_Copy(v.BinaryField)
+	o.UuidField = _UUID_CopyPtr(v.UuidField)
+	o.Color = _Color_CopyPtr(v.Color)
+	o.Name = _Name_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this Primitives which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Primitives) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.BoolField)
+	h.Field(2)
+	h.Int8(v.ByteField)
+	if v.Int16Field != nil {
+		h.Field(3)
+		h.Int16(*v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		h.Field(4)
+		h.Int32(*v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		h.Field(5)
+		h.Int64(*v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		h.Field(6)
+		h.Double(*v.DoubleField)
+	}
+	if v.StringField != nil {
+		h.Field(7)
+		h.String(*v.StringField)
+	}
+	h.Field(8)
+	h.Binary(v.BinaryField)
+	if v.UuidField != nil {
+		h.Field(9)
+		h.Binary((*v.UuidField).Bytes())
+	}
+	if v.Color != nil {
+		h.Field(10)
+		h.Int32(int32(*v.Color))
+	}
+	if v.Name != nil {
+		h.Field(11)
+		h.String(string(*v.Name))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Primitives so that it may be reused.
+func (v *Primitives) Reset() {
+	*v = Primitives{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Primitives.
+func (v *Primitives) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddBool("boolField", v.BoolField)
+	enc.AddInt8("byteField", v.ByteField)
+	if v.Int16Field != nil {
+		enc.AddInt16("int16Field", *v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		enc.AddInt32("int32Field", *v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		enc.AddInt64("int64Field", *v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		enc.AddFloat64("doubleField", *v.DoubleField)
+	}
+	if v.StringField != nil {
+		enc.AddString("stringField", *v.StringField)
+	}
+	if v.BinaryField != nil {
+		enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	}
+	if v.UuidField != nil {
+		enc.AddString("uuidField", (*v.UuidField).String())
+	}
+	if v.Color != nil {
+		err = multierr.Append(err, enc.AddObject("color", *v.Color))
+	}
+	if v.Name != nil {
+		enc.AddString("name", (string)(*v.Name))
+	}
+	return err
+}
+
+// GetBoolField returns the value of BoolField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetBoolField() (o bool) {
+	if v != nil {
+		o = v.BoolField
+	}
+	return
+}
+
+// GetByteField returns the value of ByteField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetByteField() (o int8) {
+	if v != nil {
+		o = v.ByteField
+	}
+	return
+}
+
+// GetInt16Field returns the value of Int16Field if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetInt16Field() (o int16) {
+	if v != nil && v.Int16Field != nil {
+		return *v.Int16Field
+	}
+
+	return
+}
+
+// IsSetInt16Field returns true if Int16Field is not nil.
+func (v *Primitives) IsSetInt16Field() bool {
+	return v != nil && v.Int16Field != nil
+}
+
+// GetInt32Field returns the value of Int32Field if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetInt32Field() (o int32) {
+	if v != nil && v.Int32Field != nil {
+		return *v.Int32Field
+	}
+
+	return
+}
+
+// IsSetInt32Field returns true if Int32Field is not nil.
+func (v *Primitives) IsSetInt32Field() bool {
+	return v != nil && v.Int32Field != nil
+}
+
+// GetInt64Field returns the value of Int64Field if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetInt64Field() (o int64) {
+	if v != nil && v.Int64Field != nil {
+		return *v.Int64Field
+	}
+
+	return
+}
+
+// IsSetInt64Field returns true if Int64Field is not nil.
+func (v *Primitives) IsSetInt64Field() bool {
+	return v != nil && v.Int64Field != nil
+}
+
+// GetDoubleField returns the value of DoubleField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetDoubleField() (o float64) {
+	if v != nil && v.DoubleField != nil {
+		return *v.DoubleField
+	}
+
+	return
+}
+
+// IsSetDoubleField returns true if DoubleField is not nil.
+func (v *Primitives) IsSetDoubleField() bool {
+	return v != nil && v.DoubleField != nil
+}
+
+// GetStringField returns the value of StringField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetStringField() (o string) {
+	if v != nil && v.StringField != nil {
+		return *v.StringField
+	}
+
+	return
+}
+
+// IsSetStringField returns true if StringField is not nil.
+func (v *Primitives) IsSetStringField() bool {
+	return v != nil && v.StringField != nil
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetBinaryField() (o []byte) {
+	if v != nil && v.BinaryField != nil {
+		return v.BinaryField
+	}
+
+	return
+}
+
+// IsSetBinaryField returns true if BinaryField is not nil.
+func (v *Primitives) IsSetBinaryField() bool {
+	return v != nil && v.BinaryField != nil
+}
+
+// GetUuidField returns the value of UuidField if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetUuidField() (o thriftuuid.UUID) {
+	if v != nil && v.UuidField != nil {
+		return *v.UuidField
+	}
+
+	return
+}
+
+// IsSetUuidField returns true if UuidField is not nil.
+func (v *Primitives) IsSetUuidField() bool {
+	return v != nil && v.UuidField != nil
+}
+
+// GetColor returns the value of Color if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetColor() (o Color) {
+	if v != nil && v.Color != nil {
+		return *v.Color
+	}
+
+	return
+}
+
+// IsSetColor returns true if Color is not nil.
+func (v *Primitives) IsSetColor() bool {
+	return v != nil && v.Color != nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Primitives) GetName() (o Name) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Primitives) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+func _UUID_Generate(r *rand.Rand, size int) thriftuuid.UUID {
+	var o thriftuuid.UUID
+	r.Read(o[:])
+	return o
+}
+
+// Generate returns a random *Primitives for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*Primitives) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Primitives
+	v.BoolField = (r.Intn(2) == 1)
+	v.ByteField = int8(r.Uint64())
+	if size > 0 && r.Intn(2) == 0 {
+		x := int16(r.Uint64())
+		v.Int16Field = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := int32(r.Uint64())
+		v.Int32Field = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := int64(r.Uint64())
+		v.Int64Field = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := r.NormFloat64()
+		v.DoubleField = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := _String_Generate(r, size/2)
+		v.StringField = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		v.BinaryField = _Binary_Generate(r, size/2)
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := _UUID_Generate(r, size/2)
+		v.UuidField = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := _Color_Generate(r, size/2)
+		v.Color = &x
+	}
+	if size > 0 && r.Intn(2) == 0 {
+		x := (Name)(_String_Generate(r, size/2))
+		v.Name = &x
+	}
+	return reflect.ValueOf(&v)
+}
+
+type Shape struct {
+	Point   *Point   `json:"point,omitempty"`
+	Polygon []*Point `json:"polygon,omitempty"`
+	Forever *Forever `json:"forever,omitempty"`
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Polygon != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Polygon)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Forever != nil {
+		w, err = v.Forever.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Polygon, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Forever, err = _Forever_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if v.Forever != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Shape struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Shape struct could not be encoded.
+func (v *Shape) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Polygon != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Point_Encode(v.Polygon, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Forever != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Forever.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if v.Forever != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Shape struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Shape struct could not be generated from the wire
+// representation.
+func (v *Shape) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Polygon, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Forever, err = _Forever_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Polygon != nil {
+		count++
+	}
+	if v.Forever != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Polygon != nil {
+		fields[i] = fmt.Sprintf("Polygon: %v", v.Polygon)
+		i++
+	}
+	if v.Forever != nil {
+		fields[i] = fmt.Sprintf("Forever: %v", v.Forever)
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !((v.Polygon == nil && rhs.Polygon == nil) || (v.Polygon != nil && rhs.Polygon != nil && _List_Point_Equals(v.Polygon, rhs.Polygon))) {
+		return false
+	}
+	if !((v.Forever == nil && rhs.Forever == nil) || (v.Forever != nil && rhs.Forever != nil && v.Forever.Equals(rhs.Forever))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Shape.
+func (v *Shape) Copy() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	var o Shape
+	o.Point = v.Point.Copy()
+	o.Polygon = _List_Point_Copy(v.Polygon)
+	o.Forever = v.Forever.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Shape which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Shape) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	h.Field(2)
+	h.Uint64(_List_Point_Hash(v.Polygon))
+	h.Field(3)
+	h.Uint64(v.Forever.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Shape so that it may be reused.
+func (v *Shape) Reset() {
+	*v = Shape{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Polygon != nil {
+		err = multierr.Append(err, enc.AddArray("polygon", (_List_Point_Zapper)(v.Polygon)))
+	}
+	if v.Forever != nil {
+		err = multierr.Append(err, enc.AddObject("forever", v.Forever))
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Shape) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPoint(x *Point) {
+	*v = Shape{}
+	v.Point = x
+}
+
+// GetPolygon returns the value of Polygon if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetPolygon() (o []*Point) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon
+	}
+
+	return
+}
+
+// IsSetPolygon returns true if Polygon is not nil.
+func (v *Shape) IsSetPolygon() bool {
+	return v != nil && v.Polygon != nil
+}
+
+// LookupPolygon returns the value of Polygon and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupPolygon() (o []*Point, _ bool) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon, true
+	}
+	return o, false
+}
+
+// SetPolygon sets the value of Polygon and unsets all other
+// fields of this Shape.
+func (v *Shape) SetPolygon(x []*Point) {
+	*v = Shape{}
+	v.Polygon = x
+}
+
+// GetForever returns the value of Forever if it is set or its
+// zero value if it is unset.
+func (v *Shape) GetForever() (o *Forever) {
+	if v != nil && v.Forever != nil {
+		return v.Forever
+	}
+
+	return
+}
+
+// IsSetForever returns true if Forever is not nil.
+func (v *Shape) IsSetForever() bool {
+	return v != nil && v.Forever != nil
+}
+
+// LookupForever returns the value of Forever and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Shape) LookupForever() (o *Forever, _ bool) {
+	if v != nil && v.Forever != nil {
+		return v.Forever, true
+	}
+	return o, false
+}
+
+// SetForever sets the value of Forever and unsets all other
+// fields of this Shape.
+func (v *Shape) SetForever(x *Forever) {
+	*v = Shape{}
+	v.Forever = x
+}
+
+// Which returns the ID of the field of this Shape which is set, or
+// 0 if no field is set.
+func (v *Shape) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Polygon != nil {
+		return 2
+	}
+	if v.Forever != nil {
+		return 3
+	}
+	return 0
+}
+
+// Shape_Visitor visits the field of a Shape which is set.
+//
+// Fields added to Shape add methods to Shape_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Shape_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitPolygon is called with the value of Polygon if it is set.
+	VisitPolygon([]*Point) error
+
+	// VisitForever is called with the value of Forever if it is set.
+	VisitForever(*Forever) error
+
+	// Default is called if no field of Shape is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Shape
+// which is set, or Default if none is, and returns its error.
+func (v *Shape) Match(visitor Shape_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Polygon != nil {
+			return visitor.VisitPolygon(v.Polygon)
+		}
+		if v.Forever != nil {
+			return visitor.VisitForever(v.Forever)
+		}
+	}
+	return visitor.Default()
+}
+
+// Generate returns a random *Shape for testing/quick.
+// Exactly one field is set,
+// with containers and nested values bounded by size.
+func (*Shape) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Shape
+	switch r.Intn(2) {
+	case 0:
+		v.Point = _Point_Generate(r, size/2)
+	case 1:
+		v.Polygon = _List_Point_Generate(r, size/2)
+	}
+	return reflect.ValueOf(&v)
+}
+
+type ShapeError struct {
+	Message string `json:"message,required"`
+	Shape   *Shape `json:"shape,omitempty"`
+}
+
+// ToWire translates a ShapeError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ShapeError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Shape != nil {
+		w, err = v.Shape.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Shape_Read(w wire.Value) (*Shape, error) {
+	var v Shape
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ShapeError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ShapeError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ShapeError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ShapeError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Shape, err = _Shape_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of ShapeError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a ShapeError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ShapeError struct could not be encoded.
+func (v *ShapeError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Shape != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Shape.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Shape_Decode(sr stream.Reader) (*Shape, error) {
+	var v Shape
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a ShapeError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ShapeError struct could not be generated from the wire
+// representation.
+func (v *ShapeError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Shape, err = _Shape_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of ShapeError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ShapeError
+// struct.
+func (v *ShapeError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Shape != nil {
+		fields[i] = fmt.Sprintf("Shape: %v", v.Shape)
+		i++
+	}
+
+	return fmt.Sprintf("ShapeError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*ShapeError) ErrorName() string {
+	return "ShapeError"
+}
+
+// Equals returns true if all the fields of this ShapeError match the
+// provided ShapeError.
+//
+// This function performs a deep comparison.
+func (v *ShapeError) Equals(rhs *ShapeError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !((v.Shape == nil && rhs.Shape == nil) || (v.Shape != nil && rhs.Shape != nil && v.Shape.Equals(rhs.Shape))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this ShapeError.
+func (v *ShapeError) Copy() *ShapeError {
+	if v == nil {
+		return nil
+	}
+
+	var o ShapeError
+	o.Message = v.Message
+	o.Shape = v.Shape.Copy()
+	return &o
+}
+
+// Hash returns a hash of this ShapeError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ShapeError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	h.Field(2)
+	h.Uint64(v.Shape.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ShapeError so that it may be reused.
+func (v *ShapeError) Reset() {
+	*v = ShapeError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ShapeError.
+func (v *ShapeError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	if v.Shape != nil {
+		err = multierr.Append(err, enc.AddObject("shape", v.Shape))
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *ShapeError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetShape returns the value of Shape if it is set or its
+// zero value if it is unset.
+func (v *ShapeError) GetShape() (o *Shape) {
+	if v != nil && v.Shape != nil {
+		return v.Shape
+	}
+
+	return
+}
+
+// IsSetShape returns true if Shape is not nil.
+func (v *ShapeError) IsSetShape() bool {
+	return v != nil && v.Shape != nil
+}
+
+func _Shape_Generate(r *rand.Rand, size int) *Shape {
+	return (*Shape)(nil).Generate(r, size).Interface().(*Shape)
+}
+
+// Generate returns a random *ShapeError for testing/quick.
+// Required fields are always set and optional fields are set at random,
+// with containers and nested values bounded by size.
+func (*ShapeError) Generate(r *rand.Rand, size int) reflect.Value {
+	var v ShapeError
+	v.Message = _String_Generate(r, size/2)
+	if size > 0 && r.Intn(2) == 0 {
+		v.Shape = _Shape_Generate(r, size/2)
+	}
+	return reflect.ValueOf(&v)
+}
+
+func (v *ShapeError) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "quick-generators",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/quick-generators",
+	FilePath: "quick-generators.thrift",
+	SHA1:     "2b3973e0298536d02317d80334401d355ea77565",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Color {\n    RED,\n    GREEN,\n    BLUE,\n}\n\nenum Empty {}\n\ntypedef string Name\ntypedef Point Location\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Primitives {\n    1: required bool boolField\n    2: required byte byteField\n    3: optional i16 int16Field\n    4: optional i32 int32Field\n    5: optional i64 int64Field\n    6: optional double doubleField\n    7: optional string stringField\n    8: optional binary binaryField\n    9: optional uuid uuidField\n    10: optional Color color\n    11: optional Name name\n}\n\nstruct Containers {\n    1: optional list<Point> points\n    2: optional set<string> tags\n    3: optional map<string, list<i32>> scores\n    4: optional map<Point, string> labels\n    5: optional set<Location> locations\n    6: optional set<Color> (go.type = \"slice\") colors\n    7: optional map<i64, Empty> empties\n}\n\nstruct Blobs {\n    1: optional map<binary, string> names\n    2: optional set<binary> keys\n    3: optional map<Color, i32> (go.type = \"slice\") counts\n}\n\nstruct Node {\n    1: required Name name\n    2: optional Node sibling\n    3: optional list<Node> children\n}\n\nunion Shape {\n    1: Point point\n    2: list<Point> polygon\n    3: Forever forever\n}\n\nexception ShapeError {\n    1: required string message\n    2: optional Shape shape\n}\n\nstruct Forever {\n    1: required Forever again\n}\n\nstruct Optionals {\n    1: optional Forever forever\n    2: optional list<Forever> forevers\n    3: optional i32 count\n} (go.presence_bits = \"true\")\n"
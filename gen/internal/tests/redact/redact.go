@@ -0,0 +1,1417 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package redact
+
+import (
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type AuthError struct {
+	Message  string  `json:"message,required"`
+	Password *string `json:"password,omitempty"`
+}
+
+// ToWire translates a AuthError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AuthError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Password != nil {
+		w, err = wire.NewValueString(*(v.Password)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AuthError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AuthError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AuthError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AuthError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Password = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of AuthError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a AuthError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AuthError struct could not be encoded.
+func (v *AuthError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Password != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Password)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a AuthError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AuthError struct could not be generated from the wire
+// representation.
+func (v *AuthError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Password = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of AuthError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AuthError
+// struct.
+func (v *AuthError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Password != nil {
+		fields[i] = "Password: [redacted]"
+		i++
+	}
+
+	return fmt.Sprintf("AuthError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*AuthError) ErrorName() string {
+	return "AuthError"
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this AuthError match the
+// provided AuthError.
+//
+// This function performs a deep comparison.
+func (v *AuthError) Equals(rhs *AuthError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Password, rhs.Password) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this AuthError.
+func (v *AuthError) Copy() *AuthError {
+	if v == nil {
+		return nil
+	}
+
+	var o AuthError
+	o.Message = v.Message
+	o.Password = _String_CopyPtr(v.Password)
+	return &o
+}
+
+// Hash returns a hash of this AuthError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *AuthError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	if v.Password != nil {
+		h.Field(2)
+		h.String(*v.Password)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this AuthError so that it may be reused.
+func (v *AuthError) Reset() {
+	*v = AuthError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AuthError.
+func (v *AuthError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	if v.Password != nil {
+		enc.AddString("password", "[redacted]")
+	}
+	return err
+}
+
+// MarshalJSON serializes a AuthError into JSON with the values of
+// its redacted fields replaced by "[redacted]".
+//
+// This has a value receiver so that AuthError values are redacted in
+// addition to pointers to them.
+func (v AuthError) MarshalJSON() ([]byte, error) {
+	type plain AuthError // without this method
+
+	redacted := "[redacted]"
+	r := struct {
+		*plain
+		Password *string `json:"password,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	if v.Password != nil {
+		r.Password = &redacted
+	}
+
+	return json.Marshal(r)
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *AuthError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetPassword returns the value of Password if it is set or its
+// zero value if it is unset.
+func (v *AuthError) GetPassword() (o string) {
+	if v != nil && v.Password != nil {
+		return *v.Password
+	}
+
+	return
+}
+
+// IsSetPassword returns true if Password is not nil.
+func (v *AuthError) IsSetPassword() bool {
+	return v != nil && v.Password != nil
+}
+
+func (v *AuthError) Error() string {
+	return v.String()
+}
+
+type Card struct {
+	Number string `json:"number,required"`
+	Cvv    *int32 `json:"cvv,omitempty"`
+}
+
+// ToWire translates a Card struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Card) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Number), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Cvv != nil {
+		w, err = wire.NewValueI32(*(v.Cvv)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Card struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Card struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Card
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Card) FromWire(w wire.Value) error {
+	var err error
+
+	numberIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Number, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				numberIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Cvv = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !numberIsSet {
+		return errors.New("field Number of Card is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Card struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Card struct could not be encoded.
+func (v *Card) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Number); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Cvv != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Cvv)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Card struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Card struct could not be generated from the wire
+// representation.
+func (v *Card) Decode(sr stream.Reader) error {
+
+	numberIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Number, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			numberIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Cvv = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !numberIsSet {
+		return errors.New("field Number of Card is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Card
+// struct.
+func (v *Card) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = "Number: [redacted]"
+	i++
+	if v.Cvv != nil {
+		fields[i] = "Cvv: [redacted]"
+		i++
+	}
+
+	return fmt.Sprintf("Card{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Card match the
+// provided Card.
+//
+// This function performs a deep comparison.
+func (v *Card) Equals(rhs *Card) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Number == rhs.Number) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Cvv, rhs.Cvv) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Card.
+func (v *Card) Copy() *Card {
+	if v == nil {
+		return nil
+	}
+
+	var o Card
+	o.Number = v.Number
+	o.Cvv = _I32_CopyPtr(v.Cvv)
+	return &o
+}
+
+// Hash returns a hash of this Card which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Card) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Number)
+	if v.Cvv != nil {
+		h.Field(2)
+		h.Int32(*v.Cvv)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Card so that it may be reused.
+func (v *Card) Reset() {
+	*v = Card{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Card.
+func (v *Card) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("number", "[redacted]")
+	if v.Cvv != nil {
+		enc.AddString("cvv", "[redacted]")
+	}
+	return err
+}
+
+// MarshalJSON serializes a Card into JSON with the values of
+// its redacted fields replaced by "[redacted]".
+//
+// This has a value receiver so that Card values are redacted in
+// addition to pointers to them.
+func (v Card) MarshalJSON() ([]byte, error) {
+	type plain Card // without this method
+
+	redacted := "[redacted]"
+	r := struct {
+		*plain
+		Number *string `json:"number,omitempty"`
+		Cvv    *string `json:"cvv,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Number = &redacted
+	if v.Cvv != nil {
+		r.Cvv = &redacted
+	}
+
+	return json.Marshal(r)
+}
+
+// GetNumber returns the value of Number if it is set or its
+// zero value if it is unset.
+func (v *Card) GetNumber() (o string) {
+	if v != nil {
+		o = v.Number
+	}
+	return
+}
+
+// GetCvv returns the value of Cvv if it is set or its
+// zero value if it is unset.
+func (v *Card) GetCvv() (o int32) {
+	if v != nil && v.Cvv != nil {
+		return *v.Cvv
+	}
+
+	return
+}
+
+// IsSetCvv returns true if Cvv is not nil.
+func (v *Card) IsSetCvv() bool {
+	return v != nil && v.Cvv != nil
+}
+
+type Credentials struct {
+	User     string  `json:"user,required"`
+	Password string  `json:"password,required"`
+	Token    *string `json:"accessToken,omitempty"`
+	Pin      *int64  `json:"pin,omitempty"`
+	Card     *Card   `json:"card,omitempty"`
+	Note     *string `json:"-"`
+	Comment  *string `json:"comment,omitempty"`
+}
+
+// ToWire translates a Credentials struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Credentials) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.User), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.Password), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Token != nil {
+		w, err = wire.NewValueString(*(v.Token)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Pin != nil {
+		w, err = wire.NewValueI64(*(v.Pin)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Card != nil {
+		w, err = v.Card.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Note != nil {
+		w, err = wire.NewValueString(*(v.Note)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Comment != nil {
+		w, err = wire.NewValueString(*(v.Comment)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Card_Read(w wire.Value) (*Card, error) {
+	var v Card
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Credentials struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Credentials struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Credentials
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Credentials) FromWire(w wire.Value) error {
+	var err error
+
+	userIsSet := false
+	passwordIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.User, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				userIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Password, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				passwordIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Token = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Pin = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.Card, err = _Card_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Note = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Comment = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !userIsSet {
+		return errors.New("field User of Credentials is required")
+	}
+
+	if !passwordIsSet {
+		return errors.New("field Password of Credentials is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Credentials struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Credentials struct could not be encoded.
+func (v *Credentials) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.User); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Password); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Token != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Token)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Pin != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Pin)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Card != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Card.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Note != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Note)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Comment != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Comment)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Card_Decode(sr stream.Reader) (*Card, error) {
+	var v Card
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Credentials struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Credentials struct could not be generated from the wire
+// representation.
+func (v *Credentials) Decode(sr stream.Reader) error {
+
+	userIsSet := false
+	passwordIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.User, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			userIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Password, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			passwordIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Token = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Pin = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.Card, err = _Card_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Note = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Comment = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !userIsSet {
+		return errors.New("field User of Credentials is required")
+	}
+
+	if !passwordIsSet {
+		return errors.New("field Password of Credentials is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Credentials
+// struct.
+func (v *Credentials) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("User: %v", v.User)
+	i++
+	fields[i] = "Password: [redacted]"
+	i++
+	if v.Token != nil {
+		fields[i] = "Token: [redacted]"
+		i++
+	}
+	if v.Pin != nil {
+		fields[i] = "Pin: [redacted]"
+		i++
+	}
+	if v.Card != nil {
+		fields[i] = "Card: [redacted]"
+		i++
+	}
+	if v.Note != nil {
+		fields[i] = "Note: [redacted]"
+		i++
+	}
+	if v.Comment != nil {
+		fields[i] = fmt.Sprintf("Comment: %v", *(v.Comment))
+		i++
+	}
+
+	return fmt.Sprintf("Credentials{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Credentials match the
+// provided Credentials.
+//
+// This function performs a deep comparison.
+func (v *Credentials) Equals(rhs *Credentials) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.User == rhs.User) {
+		return false
+	}
+	if !(v.Password == rhs.Password) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Token, rhs.Token) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Pin, rhs.Pin) {
+		return false
+	}
+	if !((v.Card == nil && rhs.Card == nil) || (v.Card != nil && rhs.Card != nil && v.Card.Equals(rhs.Card))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Note, rhs.Note) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Comment, rhs.Comment) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Credentials.
+func (v *Credentials) Copy() *Credentials {
+	if v == nil {
+		return nil
+	}
+
+	var o Credentials
+	o.User = v.User
+	o.Password = v.Password
+	o.Token = _String_CopyPtr(v.Token)
+	o.Pin = _I64_CopyPtr(v.Pin)
+	o.Card = v.Card.Copy()
+	o.Note = _String_CopyPtr(v.Note)
+	o.Comment = _String_CopyPtr(v.Comment)
+	return &o
+}
+
+// Hash returns a hash of this Credentials which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Credentials) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.User)
+	h.Field(2)
+	h.String(v.Password)
+	if v.Token != nil {
+		h.Field(3)
+		h.String(*v.Token)
+	}
+	if v.Pin != nil {
+		h.Field(4)
+		h.Int64(*v.Pin)
+	}
+	h.Field(5)
+	h.Uint64(v.Card.Hash())
+	if v.Note != nil {
+		h.Field(6)
+		h.String(*v.Note)
+	}
+	if v.Comment != nil {
+		h.Field(7)
+		h.String(*v.Comment)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Credentials so that it may be reused.
+func (v *Credentials) Reset() {
+	*v = Credentials{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Credentials.
+func (v *Credentials) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("user", v.User)
+	enc.AddString("password", "[redacted]")
+	if v.Token != nil {
+		enc.AddString("token", "[redacted]")
+	}
+	if v.Pin != nil {
+		enc.AddString("pin", "[redacted]")
+	}
+	if v.Card != nil {
+		enc.AddString("card", "[redacted]")
+	}
+	if v.Note != nil {
+		enc.AddString("note", "[redacted]")
+	}
+	if v.Comment != nil {
+		enc.AddString("comment", *v.Comment)
+	}
+	return err
+}
+
+// MarshalJSON serializes a Credentials into JSON with the values of
+// its redacted fields replaced by "[redacted]".
+//
+// This has a value receiver so that Credentials values are redacted in
+// addition to pointers to them.
+func (v Credentials) MarshalJSON() ([]byte, error) {
+	type plain Credentials // without this method
+
+	redacted := "[redacted]"
+	r := struct {
+		*plain
+		Password *string `json:"password,omitempty"`
+		Token    *string `json:"accessToken,omitempty"`
+		Pin      *string `json:"pin,omitempty"`
+		Card     *string `json:"card,omitempty"`
+	}{plain: (*plain)(&v)}
+
+	r.Password = &redacted
+	if v.Token != nil {
+		r.Token = &redacted
+	}
+	if v.Pin != nil {
+		r.Pin = &redacted
+	}
+	if v.Card != nil {
+		r.Card = &redacted
+	}
+
+	return json.Marshal(r)
+}
+
+// GetUser returns the value of User if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetUser() (o string) {
+	if v != nil {
+		o = v.User
+	}
+	return
+}
+
+// GetPassword returns the value of Password if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetPassword() (o string) {
+	if v != nil {
+		o = v.Password
+	}
+	return
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetToken() (o string) {
+	if v != nil && v.Token != nil {
+		return *v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is not nil.
+func (v *Credentials) IsSetToken() bool {
+	return v != nil && v.Token != nil
+}
+
+// GetPin returns the value of Pin if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetPin() (o int64) {
+	if v != nil && v.Pin != nil {
+		return *v.Pin
+	}
+
+	return
+}
+
+// IsSetPin returns true if Pin is not nil.
+func (v *Credentials) IsSetPin() bool {
+	return v != nil && v.Pin != nil
+}
+
+// GetCard returns the value of Card if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetCard() (o *Card) {
+	if v != nil && v.Card != nil {
+		return v.Card
+	}
+
+	return
+}
+
+// IsSetCard returns true if Card is not nil.
+func (v *Credentials) IsSetCard() bool {
+	return v != nil && v.Card != nil
+}
+
+// GetNote returns the value of Note if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetNote() (o string) {
+	if v != nil && v.Note != nil {
+		return *v.Note
+	}
+
+	return
+}
+
+// IsSetNote returns true if Note is not nil.
+func (v *Credentials) IsSetNote() bool {
+	return v != nil && v.Note != nil
+}
+
+// GetComment returns the value of Comment if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetComment() (o string) {
+	if v != nil && v.Comment != nil {
+		return *v.Comment
+	}
+
+	return
+}
+
+// IsSetComment returns true if Comment is not nil.
+func (v *Credentials) IsSetComment() bool {
+	return v != nil && v.Comment != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "redact",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/redact",
+	FilePath: "redact.thrift",
+	SHA1:     "cd13561f3bbfcfb377efcd1504d5aee9eff1ebc4",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Card {\n    1: required string number (redact)\n    2: optional i32 cvv (redact)\n}\n\nstruct Credentials {\n    1: required string user\n    2: required string password (redact)\n    3: optional string token (redact, go.tag = 'json:\"accessToken\"')\n    4: optional i64 pin (redact)\n    5: optional Card card (redact)\n    6: optional string note (redact, go.tag = 'json:\"-\"')\n    7: optional string comment\n}\n\nexception AuthError {\n    1: required string message\n    2: optional string password (redact)\n}\n"
@@ -0,0 +1,2012 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package router_prefix
+
+import (
+	context "context"
+	errors "errors"
+	fmt "fmt"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftrpc "go.uber.org/thriftrw/thriftrpc"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "router-prefix",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/router-prefix",
+	FilePath: "router-prefix.thrift",
+	SHA1:     "b36d1f6ad75bba07b3135c93fc2c3117b944c387",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "service Health {\n    bool healthy()\n}\n\nservice Users extends Health {\n    string get(1: required i64 id)\n}\n\nservice Groups extends Health {\n    string get(1: required i64 id) (thrift.alias = \"Teams::get\")\n}\n"
+
+// Groups_Get_Args represents the arguments for the Groups.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Groups_Get_Args struct {
+	ID int64 `json:"id,required"`
+}
+
+// ToWire translates a Groups_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Groups_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI64(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Groups_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Groups_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Groups_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Groups_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.ID, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Groups_Get_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Groups_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Groups_Get_Args struct could not be encoded.
+func (v *Groups_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI64}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt64(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Groups_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Groups_Get_Args struct could not be generated from the wire
+// representation.
+func (v *Groups_Get_Args) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			v.ID, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Groups_Get_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Groups_Get_Args
+// struct.
+func (v *Groups_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+
+	return fmt.Sprintf("Groups_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Groups_Get_Args match the
+// provided Groups_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Groups_Get_Args) Equals(rhs *Groups_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Groups_Get_Args.
+func (v *Groups_Get_Args) Copy() *Groups_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Groups_Get_Args
+	o.ID = v.ID
+	return &o
+}
+
+// Hash returns a hash of this Groups_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Groups_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int64(v.ID)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Groups_Get_Args so that it may be reused.
+func (v *Groups_Get_Args) Reset() {
+	*v = Groups_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Groups_Get_Args.
+func (v *Groups_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt64("id", v.ID)
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Groups_Get_Args) GetID() (o int64) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Groups_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Groups_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Groups_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Groups.get
+// function.
+var Groups_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id int64,
+	) *Groups_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Groups_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(string, error) (*Groups_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Groups_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Groups_Get_Result) (string, error)
+}{}
+
+func init() {
+	Groups_Get_Helper.Args = func(
+		id int64,
+	) *Groups_Get_Args {
+		return &Groups_Get_Args{
+			ID: id,
+		}
+	}
+
+	Groups_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Groups_Get_Helper.WrapResponse = func(success string, err error) (*Groups_Get_Result, error) {
+		if err == nil {
+			return &Groups_Get_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Groups_Get_Helper.UnwrapResponse = func(result *Groups_Get_Result) (success string, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Groups_Get_Result represents the result of a Groups.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Groups_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success *string `json:"success,omitempty"`
+}
+
+// ToWire translates a Groups_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Groups_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueString(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Groups_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Groups_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Groups_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Groups_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Groups_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Groups_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Groups_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Groups_Get_Result struct could not be encoded.
+func (v *Groups_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Groups_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Groups_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Groups_Get_Result struct could not be generated from the wire
+// representation.
+func (v *Groups_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Groups_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Groups_Get_Result
+// struct.
+func (v *Groups_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Groups_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Groups_Get_Result match the
+// provided Groups_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Groups_Get_Result) Equals(rhs *Groups_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Groups_Get_Result.
+func (v *Groups_Get_Result) Copy() *Groups_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Groups_Get_Result
+	o.Success = _String_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Groups_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Groups_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.String(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Groups_Get_Result so that it may be reused.
+func (v *Groups_Get_Result) Reset() {
+	*v = Groups_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Groups_Get_Result.
+func (v *Groups_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Groups_Get_Result) GetSuccess() (o string) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Groups_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Groups_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Groups_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Groups_Interface is implemented by servers of the Groups
+// service.
+type Groups_Interface interface {
+	Health_Interface
+
+	Get(ctx context.Context, id int64) (string, error)
+}
+
+// Groups_Procedures returns a thriftrpc.Procedure for each function
+// of the Groups service, including functions inherited from
+// its parent, served by the given implementation.
+func Groups_Procedures(impl Groups_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name:    "Groups::get",
+			Aliases: []string{"Teams::get"},
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Groups_Get_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Get(ctx, args.ID)
+				result, err := Groups_Get_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	procs = append(procs, Health_Procedures(impl)...)
+	return procs
+}
+
+// Health_Healthy_Args represents the arguments for the Health.healthy function.
+//
+// The arguments for healthy are sent and received over the wire as this struct.
+type Health_Healthy_Args struct {
+}
+
+// ToWire translates a Health_Healthy_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Health_Healthy_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Health_Healthy_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Health_Healthy_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Health_Healthy_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Health_Healthy_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Health_Healthy_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Health_Healthy_Args struct could not be encoded.
+func (v *Health_Healthy_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Health_Healthy_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Health_Healthy_Args struct could not be generated from the wire
+// representation.
+func (v *Health_Healthy_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Health_Healthy_Args
+// struct.
+func (v *Health_Healthy_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Health_Healthy_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Health_Healthy_Args match the
+// provided Health_Healthy_Args.
+//
+// This function performs a deep comparison.
+func (v *Health_Healthy_Args) Equals(rhs *Health_Healthy_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Health_Healthy_Args.
+func (v *Health_Healthy_Args) Copy() *Health_Healthy_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Health_Healthy_Args
+	return &o
+}
+
+// Hash returns a hash of this Health_Healthy_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Health_Healthy_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Health_Healthy_Args so that it may be reused.
+func (v *Health_Healthy_Args) Reset() {
+	*v = Health_Healthy_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Health_Healthy_Args.
+func (v *Health_Healthy_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "healthy" for this struct.
+func (v *Health_Healthy_Args) MethodName() string {
+	return "healthy"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Health_Healthy_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Health_Healthy_Helper provides functions that aid in handling the
+// parameters and return values of the Health.healthy
+// function.
+var Health_Healthy_Helper = struct {
+	// Args accepts the parameters of healthy in-order and returns
+	// the arguments struct for the function.
+	Args func() *Health_Healthy_Args
+
+	// IsException returns true if the given error can be thrown
+	// by healthy.
+	//
+	// An error can be thrown by healthy only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for healthy
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// healthy into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by healthy
+	//
+	//   value, err := healthy(args)
+	//   result, err := Health_Healthy_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from healthy: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(bool, error) (*Health_Healthy_Result, error)
+
+	// UnwrapResponse takes the result struct for healthy
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if healthy threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Health_Healthy_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Health_Healthy_Result) (bool, error)
+}{}
+
+func init() {
+	Health_Healthy_Helper.Args = func() *Health_Healthy_Args {
+		return &Health_Healthy_Args{}
+	}
+
+	Health_Healthy_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Health_Healthy_Helper.WrapResponse = func(success bool, err error) (*Health_Healthy_Result, error) {
+		if err == nil {
+			return &Health_Healthy_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Health_Healthy_Helper.UnwrapResponse = func(result *Health_Healthy_Result) (success bool, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Health_Healthy_Result represents the result of a Health.healthy function call.
+//
+// The result of a healthy execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Health_Healthy_Result struct {
+	// Value returned by healthy after a successful execution.
+	Success *bool `json:"success,omitempty"`
+}
+
+// ToWire translates a Health_Healthy_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Health_Healthy_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueBool(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Health_Healthy_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Health_Healthy_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Health_Healthy_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Health_Healthy_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Health_Healthy_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Health_Healthy_Result struct could not be encoded.
+func (v *Health_Healthy_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Health_Healthy_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Health_Healthy_Result struct could not be generated from the wire
+// representation.
+func (v *Health_Healthy_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Health_Healthy_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Health_Healthy_Result
+// struct.
+func (v *Health_Healthy_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Health_Healthy_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Health_Healthy_Result match the
+// provided Health_Healthy_Result.
+//
+// This function performs a deep comparison.
+func (v *Health_Healthy_Result) Equals(rhs *Health_Healthy_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Health_Healthy_Result.
+func (v *Health_Healthy_Result) Copy() *Health_Healthy_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Health_Healthy_Result
+	o.Success = _Bool_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Health_Healthy_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Health_Healthy_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Bool(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Health_Healthy_Result so that it may be reused.
+func (v *Health_Healthy_Result) Reset() {
+	*v = Health_Healthy_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Health_Healthy_Result.
+func (v *Health_Healthy_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddBool("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Health_Healthy_Result) GetSuccess() (o bool) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Health_Healthy_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "healthy" for this struct.
+func (v *Health_Healthy_Result) MethodName() string {
+	return "healthy"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Health_Healthy_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Health_Interface is implemented by servers of the Health
+// service.
+type Health_Interface interface {
+	Healthy(ctx context.Context) (bool, error)
+}
+
+// Health_Procedures returns a thriftrpc.Procedure for each function
+// of the Health service, including functions inherited from
+// its parent, served by the given implementation.
+func Health_Procedures(impl Health_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "Health::healthy",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Health_Healthy_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Healthy(ctx)
+				result, err := Health_Healthy_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	return procs
+}
+
+// Users_Get_Args represents the arguments for the Users.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Users_Get_Args struct {
+	ID int64 `json:"id,required"`
+}
+
+// ToWire translates a Users_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI64(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Users_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.ID, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Users_Get_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Users_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Users_Get_Args struct could not be encoded.
+func (v *Users_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI64}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt64(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Users_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Users_Get_Args struct could not be generated from the wire
+// representation.
+func (v *Users_Get_Args) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			v.ID, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Users_Get_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_Get_Args
+// struct.
+func (v *Users_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+
+	return fmt.Sprintf("Users_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_Get_Args match the
+// provided Users_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Users_Get_Args) Equals(rhs *Users_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Users_Get_Args.
+func (v *Users_Get_Args) Copy() *Users_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Users_Get_Args
+	o.ID = v.ID
+	return &o
+}
+
+// Hash returns a hash of this Users_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Users_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int64(v.ID)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Users_Get_Args so that it may be reused.
+func (v *Users_Get_Args) Reset() {
+	*v = Users_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_Get_Args.
+func (v *Users_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt64("id", v.ID)
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Users_Get_Args) GetID() (o int64) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Users_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Users_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Users_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Users.get
+// function.
+var Users_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id int64,
+	) *Users_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Users_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(string, error) (*Users_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Users_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Users_Get_Result) (string, error)
+}{}
+
+func init() {
+	Users_Get_Helper.Args = func(
+		id int64,
+	) *Users_Get_Args {
+		return &Users_Get_Args{
+			ID: id,
+		}
+	}
+
+	Users_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Users_Get_Helper.WrapResponse = func(success string, err error) (*Users_Get_Result, error) {
+		if err == nil {
+			return &Users_Get_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Users_Get_Helper.UnwrapResponse = func(result *Users_Get_Result) (success string, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Users_Get_Result represents the result of a Users.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Users_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success *string `json:"success,omitempty"`
+}
+
+// ToWire translates a Users_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueString(*(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Users_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Users_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Users_Get_Result struct could not be encoded.
+func (v *Users_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Success)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Users_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Users_Get_Result struct could not be generated from the wire
+// representation.
+func (v *Users_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Users_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_Get_Result
+// struct.
+func (v *Users_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Users_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_Get_Result match the
+// provided Users_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Users_Get_Result) Equals(rhs *Users_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Users_Get_Result.
+func (v *Users_Get_Result) Copy() *Users_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Users_Get_Result
+	o.Success = _String_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Users_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Users_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.String(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Users_Get_Result so that it may be reused.
+func (v *Users_Get_Result) Reset() {
+	*v = Users_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_Get_Result.
+func (v *Users_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", *v.Success)
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Users_Get_Result) GetSuccess() (o string) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Users_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Users_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Users_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Users_Interface is implemented by servers of the Users
+// service.
+type Users_Interface interface {
+	Health_Interface
+
+	Get(ctx context.Context, id int64) (string, error)
+}
+
+// Users_Procedures returns a thriftrpc.Procedure for each function
+// of the Users service, including functions inherited from
+// its parent, served by the given implementation.
+func Users_Procedures(impl Users_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "Users::get",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Users_Get_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Get(ctx, args.ID)
+				result, err := Users_Get_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	procs = append(procs, Health_Procedures(impl)...)
+	return procs
+}
+
+// RouterServices holds implementations of the services served by
+// NewRouter. Services left nil are not served.
+type RouterServices struct {
+	Groups Groups_Interface
+	Health Health_Interface
+	Users  Users_Interface
+}
+
+// NewRouter returns a thriftrpc.Router which serves the given
+// services from one listener, routing requests by the method names
+// in their envelopes prefixed with the service name, as in
+// "Service:method".
+func NewRouter(s RouterServices) *thriftrpc.Router {
+	routes := make(map[string]thriftrpc.Procedure)
+	if s.Groups != nil {
+		for _, p := range Groups_Procedures(s.Groups) {
+			for _, name := range p.RouteNames("Groups") {
+				routes[name] = p
+			}
+		}
+	}
+	if s.Health != nil {
+		for _, p := range Health_Procedures(s.Health) {
+			for _, name := range p.RouteNames("Health") {
+				routes[name] = p
+			}
+		}
+	}
+	if s.Users != nil {
+		for _, p := range Users_Procedures(s.Users) {
+			for _, name := range p.RouteNames("Users") {
+				routes[name] = p
+			}
+		}
+	}
+
+	return thriftrpc.NewRouter(routes)
+}
@@ -0,0 +1,1876 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package router
+
+import (
+	bytes "bytes"
+	context "context"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	procedures "go.uber.org/thriftrw/gen/internal/tests/procedures"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftrpc "go.uber.org/thriftrw/thriftrpc"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+type NotFound struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a NotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NotFound) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a NotFound struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a NotFound struct could not be encoded.
+func (v *NotFound) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a NotFound struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a NotFound struct could not be generated from the wire
+// representation.
+func (v *NotFound) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NotFound
+// struct.
+func (v *NotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("NotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*NotFound) ErrorName() string {
+	return "NotFound"
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this NotFound match the
+// provided NotFound.
+//
+// This function performs a deep comparison.
+func (v *NotFound) Equals(rhs *NotFound) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this NotFound.
+func (v *NotFound) Copy() *NotFound {
+	if v == nil {
+		return nil
+	}
+
+	var o NotFound
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this NotFound which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NotFound) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NotFound so that it may be reused.
+func (v *NotFound) Reset() {
+	*v = NotFound{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NotFound.
+func (v *NotFound) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *NotFound) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *NotFound) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+func (v *NotFound) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "router",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/router",
+	FilePath: "router.thrift",
+	SHA1:     "ac8e37d99a257564e56324c140338a76a745613b",
+	Includes: []*thriftreflect.ThriftModule{
+		procedures.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./procedures.thrift\"\n\nexception NotFound {\n    1: optional string key\n}\n\nservice Store extends procedures.Health {\n    binary get(1: optional string key)\n        throws (1: NotFound notFound)\n        (thrift.alias = \"KeyValue::getValue, KeyValue::fetch\")\n\n    oneway void evict(1: string key)\n}\n\nservice Admin {\n    void drain()\n}\n"
+
+// Admin_Drain_Args represents the arguments for the Admin.drain function.
+//
+// The arguments for drain are sent and received over the wire as this struct.
+type Admin_Drain_Args struct {
+}
+
+// ToWire translates a Admin_Drain_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Admin_Drain_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Admin_Drain_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Admin_Drain_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Admin_Drain_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Admin_Drain_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Admin_Drain_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Admin_Drain_Args struct could not be encoded.
+func (v *Admin_Drain_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Admin_Drain_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Admin_Drain_Args struct could not be generated from the wire
+// representation.
+func (v *Admin_Drain_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Admin_Drain_Args
+// struct.
+func (v *Admin_Drain_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Admin_Drain_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Admin_Drain_Args match the
+// provided Admin_Drain_Args.
+//
+// This function performs a deep comparison.
+func (v *Admin_Drain_Args) Equals(rhs *Admin_Drain_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Admin_Drain_Args.
+func (v *Admin_Drain_Args) Copy() *Admin_Drain_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Admin_Drain_Args
+	return &o
+}
+
+// Hash returns a hash of this Admin_Drain_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Admin_Drain_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Admin_Drain_Args so that it may be reused.
+func (v *Admin_Drain_Args) Reset() {
+	*v = Admin_Drain_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Admin_Drain_Args.
+func (v *Admin_Drain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "drain" for this struct.
+func (v *Admin_Drain_Args) MethodName() string {
+	return "drain"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Admin_Drain_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Admin_Drain_Helper provides functions that aid in handling the
+// parameters and return values of the Admin.drain
+// function.
+var Admin_Drain_Helper = struct {
+	// Args accepts the parameters of drain in-order and returns
+	// the arguments struct for the function.
+	Args func() *Admin_Drain_Args
+
+	// IsException returns true if the given error can be thrown
+	// by drain.
+	//
+	// An error can be thrown by drain only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for drain
+	// given the error returned by it. The provided error may
+	// be nil if drain did not fail.
+	//
+	// This allows mapping errors returned by drain into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// drain
+	//
+	//   err := drain(args)
+	//   result, err := Admin_Drain_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from drain: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*Admin_Drain_Result, error)
+
+	// UnwrapResponse takes the result struct for drain
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if drain threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := Admin_Drain_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Admin_Drain_Result) error
+}{}
+
+func init() {
+	Admin_Drain_Helper.Args = func() *Admin_Drain_Args {
+		return &Admin_Drain_Args{}
+	}
+
+	Admin_Drain_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Admin_Drain_Helper.WrapResponse = func(err error) (*Admin_Drain_Result, error) {
+		if err == nil {
+			return &Admin_Drain_Result{}, nil
+		}
+
+		return nil, err
+	}
+	Admin_Drain_Helper.UnwrapResponse = func(result *Admin_Drain_Result) (err error) {
+		return
+	}
+
+}
+
+// Admin_Drain_Result represents the result of a Admin.drain function call.
+//
+// The result of a drain execution is sent and received over the wire as this struct.
+type Admin_Drain_Result struct {
+}
+
+// ToWire translates a Admin_Drain_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Admin_Drain_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Admin_Drain_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Admin_Drain_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Admin_Drain_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Admin_Drain_Result) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Admin_Drain_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Admin_Drain_Result struct could not be encoded.
+func (v *Admin_Drain_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Admin_Drain_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Admin_Drain_Result struct could not be generated from the wire
+// representation.
+func (v *Admin_Drain_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Admin_Drain_Result
+// struct.
+func (v *Admin_Drain_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Admin_Drain_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Admin_Drain_Result match the
+// provided Admin_Drain_Result.
+//
+// This function performs a deep comparison.
+func (v *Admin_Drain_Result) Equals(rhs *Admin_Drain_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Admin_Drain_Result.
+func (v *Admin_Drain_Result) Copy() *Admin_Drain_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Admin_Drain_Result
+	return &o
+}
+
+// Hash returns a hash of this Admin_Drain_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Admin_Drain_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Admin_Drain_Result so that it may be reused.
+func (v *Admin_Drain_Result) Reset() {
+	*v = Admin_Drain_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Admin_Drain_Result.
+func (v *Admin_Drain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "drain" for this struct.
+func (v *Admin_Drain_Result) MethodName() string {
+	return "drain"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Admin_Drain_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Admin_Interface is implemented by servers of the Admin
+// service.
+type Admin_Interface interface {
+	Drain(ctx context.Context) error
+}
+
+// Admin_Procedures returns a thriftrpc.Procedure for each function
+// of the Admin service, including functions inherited from
+// its parent, served by the given implementation.
+func Admin_Procedures(impl Admin_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "Admin::drain",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Admin_Drain_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				result, err := Admin_Drain_Helper.WrapResponse(impl.Drain(ctx))
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body: result,
+				}, nil
+			},
+		},
+	}
+	return procs
+}
+
+// Store_Evict_Args represents the arguments for the Store.evict function.
+//
+// The arguments for evict are sent and received over the wire as this struct.
+type Store_Evict_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a Store_Evict_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Evict_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Evict_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Evict_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Evict_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Evict_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Evict_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Evict_Args struct could not be encoded.
+func (v *Store_Evict_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Store_Evict_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Evict_Args struct could not be generated from the wire
+// representation.
+func (v *Store_Evict_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Evict_Args
+// struct.
+func (v *Store_Evict_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("Store_Evict_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Evict_Args match the
+// provided Store_Evict_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Evict_Args) Equals(rhs *Store_Evict_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Evict_Args.
+func (v *Store_Evict_Args) Copy() *Store_Evict_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Evict_Args
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this Store_Evict_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Evict_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Evict_Args so that it may be reused.
+func (v *Store_Evict_Args) Reset() {
+	*v = Store_Evict_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Evict_Args.
+func (v *Store_Evict_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Store_Evict_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *Store_Evict_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "evict" for this struct.
+func (v *Store_Evict_Args) MethodName() string {
+	return "evict"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *Store_Evict_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// Store_Evict_Helper provides functions that aid in handling the
+// parameters and return values of the Store.evict
+// function.
+var Store_Evict_Helper = struct {
+	// Args accepts the parameters of evict in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *Store_Evict_Args
+}{}
+
+func init() {
+	Store_Evict_Helper.Args = func(
+		key *string,
+	) *Store_Evict_Args {
+		return &Store_Evict_Args{
+			Key: key,
+		}
+	}
+
+}
+
+// Store_Get_Args represents the arguments for the Store.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Store_Get_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a Store_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Get_Args struct could not be encoded.
+func (v *Store_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Store_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Get_Args struct could not be generated from the wire
+// representation.
+func (v *Store_Get_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Get_Args
+// struct.
+func (v *Store_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("Store_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Get_Args match the
+// provided Store_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Get_Args) Equals(rhs *Store_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Get_Args.
+func (v *Store_Get_Args) Copy() *Store_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Get_Args
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this Store_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Get_Args so that it may be reused.
+func (v *Store_Get_Args) Reset() {
+	*v = Store_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Get_Args.
+func (v *Store_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *Store_Get_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Store_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Store_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Store_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Store.get
+// function.
+var Store_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *Store_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Store_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func([]byte, error) (*Store_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Store_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Store_Get_Result) ([]byte, error)
+}{}
+
+func init() {
+	Store_Get_Helper.Args = func(
+		key *string,
+	) *Store_Get_Args {
+		return &Store_Get_Args{
+			Key: key,
+		}
+	}
+
+	Store_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *NotFound:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Store_Get_Helper.WrapResponse = func(success []byte, err error) (*Store_Get_Result, error) {
+		if err == nil {
+			return &Store_Get_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *NotFound:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Store_Get_Result.NotFound")
+			}
+			return &Store_Get_Result{NotFound: e}, nil
+		}
+
+		return nil, err
+	}
+	Store_Get_Helper.UnwrapResponse = func(result *Store_Get_Result) (success []byte, err error) {
+		if result.NotFound != nil {
+			err = result.NotFound
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Store_Get_Result represents the result of a Store.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Store_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success  []byte    `json:"success,omitempty"`
+	NotFound *NotFound `json:"notFound,omitempty"`
+}
+
+// ToWire translates a Store_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueBinary(v.Success), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.NotFound != nil {
+		w, err = v.NotFound.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _NotFound_Read(w wire.Value) (*NotFound, error) {
+	var v NotFound
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Store_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				v.Success, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.NotFound, err = _NotFound_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Get_Result struct could not be encoded.
+func (v *Store_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Success); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NotFound != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NotFound.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _NotFound_Decode(sr stream.Reader) (*NotFound, error) {
+	var v NotFound
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Store_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Get_Result struct could not be generated from the wire
+// representation.
+func (v *Store_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			v.Success, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.NotFound, err = _NotFound_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Get_Result
+// struct.
+func (v *Store_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.NotFound != nil {
+		fields[i] = fmt.Sprintf("NotFound: %v", v.NotFound)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Get_Result match the
+// provided Store_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Store_Get_Result) Equals(rhs *Store_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && bytes.Equal(v.Success, rhs.Success))) {
+		return false
+	}
+	if !((v.NotFound == nil && rhs.NotFound == nil) || (v.NotFound != nil && rhs.NotFound != nil && v.NotFound.Equals(rhs.NotFound))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Store_Get_Result.
+func (v *Store_Get_Result) Copy() *Store_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Get_Result
+	o.Success = _Binary_Copy(v.Success)
+	o.NotFound = v.NotFound.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Store_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Binary(v.Success)
+	h.Field(1)
+	h.Uint64(v.NotFound.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Get_Result so that it may be reused.
+func (v *Store_Get_Result) Reset() {
+	*v = Store_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Get_Result.
+func (v *Store_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", base64.StdEncoding.EncodeToString(v.Success))
+	}
+	if v.NotFound != nil {
+		err = multierr.Append(err, enc.AddObject("notFound", v.NotFound))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Result) GetSuccess() (o []byte) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Store_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetNotFound returns the value of NotFound if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Result) GetNotFound() (o *NotFound) {
+	if v != nil && v.NotFound != nil {
+		return v.NotFound
+	}
+
+	return
+}
+
+// IsSetNotFound returns true if NotFound is not nil.
+func (v *Store_Get_Result) IsSetNotFound() bool {
+	return v != nil && v.NotFound != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Store_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Store_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Store_Interface is implemented by servers of the Store
+// service.
+type Store_Interface interface {
+	procedures.Health_Interface
+
+	Evict(ctx context.Context, key *string) error
+
+	Get(ctx context.Context, key *string) ([]byte, error)
+}
+
+// Store_Procedures returns a thriftrpc.Procedure for each function
+// of the Store service, including functions inherited from
+// its parent, served by the given implementation.
+func Store_Procedures(impl Store_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name:   "Store::evict",
+			OneWay: true,
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Store_Evict_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				return thriftrpc.Response{}, impl.Evict(ctx, args.Key)
+			},
+		},
+		{
+			Name:    "Store::get",
+			Aliases: []string{"KeyValue::getValue", "KeyValue::fetch"},
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args Store_Get_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Get(ctx, args.Key)
+				result, err := Store_Get_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body:               result,
+					IsApplicationError: result.NotFound != nil,
+				}, nil
+			},
+		},
+	}
+	procs = append(procs, procedures.Health_Procedures(impl)...)
+	return procs
+}
+
+// RouterServices holds implementations of the services served by
+// NewRouter. Services left nil are not served.
+type RouterServices struct {
+	Admin Admin_Interface
+	Store Store_Interface
+}
+
+// NewRouter returns a thriftrpc.Router which serves the given
+// services from one listener, routing requests by the method names
+// in their envelopes.
+func NewRouter(s RouterServices) *thriftrpc.Router {
+	routes := make(map[string]thriftrpc.Procedure)
+	if s.Admin != nil {
+		for _, p := range Admin_Procedures(s.Admin) {
+			for _, name := range p.RouteNames("") {
+				routes[name] = p
+			}
+		}
+	}
+	if s.Store != nil {
+		for _, p := range Store_Procedures(s.Store) {
+			for _, name := range p.RouteNames("") {
+				routes[name] = p
+			}
+		}
+	}
+
+	return thriftrpc.NewRouter(routes)
+}
@@ -0,0 +1,4118 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package service_specs
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	exceptions "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Entry struct {
+	Key   string `json:"key,required"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// ToWire translates a Entry struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Entry) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Value != nil {
+		w, err = wire.NewValueBinary(v.Value), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Entry struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Entry struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Entry
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Entry) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Entry is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Entry struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Entry struct could not be encoded.
+func (v *Entry) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Value); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Entry struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Entry struct could not be generated from the wire
+// representation.
+func (v *Entry) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Entry is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Entry
+// struct.
+func (v *Entry) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+		i++
+	}
+
+	return fmt.Sprintf("Entry{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Entry match the
+// provided Entry.
+//
+// This function performs a deep comparison.
+func (v *Entry) Equals(rhs *Entry) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && bytes.Equal(v.Value, rhs.Value))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Entry.
+func (v *Entry) Copy() *Entry {
+	if v == nil {
+		return nil
+	}
+
+	var o Entry
+	o.Key = v.Key
+	o.Value = _Binary_Copy(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this Entry which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Entry) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	h.Field(2)
+	h.Binary(v.Value)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Entry so that it may be reused.
+func (v *Entry) Reset() {
+	*v = Entry{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Entry.
+func (v *Entry) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	if v.Value != nil {
+		enc.AddString("value", base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Entry) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Entry) GetValue() (o []byte) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Entry) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+type Unavailable struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a Unavailable struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Unavailable) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Unavailable struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Unavailable struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Unavailable
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Unavailable) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Unavailable struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Unavailable struct could not be encoded.
+func (v *Unavailable) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Message)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Unavailable struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Unavailable struct could not be generated from the wire
+// representation.
+func (v *Unavailable) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Unavailable
+// struct.
+func (v *Unavailable) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("Unavailable{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*Unavailable) ErrorName() string {
+	return "Unavailable"
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Unavailable match the
+// provided Unavailable.
+//
+// This function performs a deep comparison.
+func (v *Unavailable) Equals(rhs *Unavailable) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Unavailable.
+func (v *Unavailable) Copy() *Unavailable {
+	if v == nil {
+		return nil
+	}
+
+	var o Unavailable
+	o.Message = _String_CopyPtr(v.Message)
+	return &o
+}
+
+// Hash returns a hash of this Unavailable which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Unavailable) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Message != nil {
+		h.Field(1)
+		h.String(*v.Message)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Unavailable so that it may be reused.
+func (v *Unavailable) Reset() {
+	*v = Unavailable{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Unavailable.
+func (v *Unavailable) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *Unavailable) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *Unavailable) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+func (v *Unavailable) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "service-specs",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/service-specs",
+	FilePath: "service-specs.thrift",
+	SHA1:     "05b04af6a88c0259edcbf05aad861ed2136007bc",
+	Includes: []*thriftreflect.ThriftModule{
+		exceptions.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./exceptions.thrift\"\n\nexception Unavailable {\n    1: optional string message\n}\n\nstruct Entry {\n    1: required string key\n    2: optional binary value\n}\n\nservice Store {\n    Entry get(1: required string key (pii = \"false\"))\n        throws (1: exceptions.DoesNotExistException notFound)\n        (slo.latency_ms = \"50\")\n\n    void put(1: Entry entry, 2: optional i64 ttl)\n        throws (1: Unavailable unavailable)\n\n    map<string, list<Entry>> scan(1: set<string> prefixes)\n\n    oneway void touch(1: string key)\n} (\n    owner = \"storage-team\"\n    team = \"storage\"\n)\n\nservice CachingStore extends Store {\n    void invalidate(1: string key) (idempotent = \"true\")\n}\n"
+
+// CachingStore_Invalidate_Args represents the arguments for the CachingStore.invalidate function.
+//
+// The arguments for invalidate are sent and received over the wire as this struct.
+type CachingStore_Invalidate_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a CachingStore_Invalidate_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CachingStore_Invalidate_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CachingStore_Invalidate_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CachingStore_Invalidate_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CachingStore_Invalidate_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CachingStore_Invalidate_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a CachingStore_Invalidate_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a CachingStore_Invalidate_Args struct could not be encoded.
+func (v *CachingStore_Invalidate_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a CachingStore_Invalidate_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a CachingStore_Invalidate_Args struct could not be generated from the wire
+// representation.
+func (v *CachingStore_Invalidate_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CachingStore_Invalidate_Args
+// struct.
+func (v *CachingStore_Invalidate_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("CachingStore_Invalidate_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CachingStore_Invalidate_Args match the
+// provided CachingStore_Invalidate_Args.
+//
+// This function performs a deep comparison.
+func (v *CachingStore_Invalidate_Args) Equals(rhs *CachingStore_Invalidate_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this CachingStore_Invalidate_Args.
+func (v *CachingStore_Invalidate_Args) Copy() *CachingStore_Invalidate_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o CachingStore_Invalidate_Args
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this CachingStore_Invalidate_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *CachingStore_Invalidate_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this CachingStore_Invalidate_Args so that it may be reused.
+func (v *CachingStore_Invalidate_Args) Reset() {
+	*v = CachingStore_Invalidate_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CachingStore_Invalidate_Args.
+func (v *CachingStore_Invalidate_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *CachingStore_Invalidate_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *CachingStore_Invalidate_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "invalidate" for this struct.
+func (v *CachingStore_Invalidate_Args) MethodName() string {
+	return "invalidate"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *CachingStore_Invalidate_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// CachingStore_Invalidate_Helper provides functions that aid in handling the
+// parameters and return values of the CachingStore.invalidate
+// function.
+var CachingStore_Invalidate_Helper = struct {
+	// Args accepts the parameters of invalidate in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *CachingStore_Invalidate_Args
+
+	// IsException returns true if the given error can be thrown
+	// by invalidate.
+	//
+	// An error can be thrown by invalidate only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for invalidate
+	// given the error returned by it. The provided error may
+	// be nil if invalidate did not fail.
+	//
+	// This allows mapping errors returned by invalidate into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// invalidate
+	//
+	//   err := invalidate(args)
+	//   result, err := CachingStore_Invalidate_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from invalidate: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*CachingStore_Invalidate_Result, error)
+
+	// UnwrapResponse takes the result struct for invalidate
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if invalidate threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := CachingStore_Invalidate_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*CachingStore_Invalidate_Result) error
+}{}
+
+func init() {
+	CachingStore_Invalidate_Helper.Args = func(
+		key *string,
+	) *CachingStore_Invalidate_Args {
+		return &CachingStore_Invalidate_Args{
+			Key: key,
+		}
+	}
+
+	CachingStore_Invalidate_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	CachingStore_Invalidate_Helper.WrapResponse = func(err error) (*CachingStore_Invalidate_Result, error) {
+		if err == nil {
+			return &CachingStore_Invalidate_Result{}, nil
+		}
+
+		return nil, err
+	}
+	CachingStore_Invalidate_Helper.UnwrapResponse = func(result *CachingStore_Invalidate_Result) (err error) {
+		return
+	}
+
+}
+
+// CachingStore_Invalidate_Result represents the result of a CachingStore.invalidate function call.
+//
+// The result of a invalidate execution is sent and received over the wire as this struct.
+type CachingStore_Invalidate_Result struct {
+}
+
+// ToWire translates a CachingStore_Invalidate_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CachingStore_Invalidate_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CachingStore_Invalidate_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CachingStore_Invalidate_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CachingStore_Invalidate_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CachingStore_Invalidate_Result) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a CachingStore_Invalidate_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a CachingStore_Invalidate_Result struct could not be encoded.
+func (v *CachingStore_Invalidate_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a CachingStore_Invalidate_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a CachingStore_Invalidate_Result struct could not be generated from the wire
+// representation.
+func (v *CachingStore_Invalidate_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CachingStore_Invalidate_Result
+// struct.
+func (v *CachingStore_Invalidate_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("CachingStore_Invalidate_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CachingStore_Invalidate_Result match the
+// provided CachingStore_Invalidate_Result.
+//
+// This function performs a deep comparison.
+func (v *CachingStore_Invalidate_Result) Equals(rhs *CachingStore_Invalidate_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this CachingStore_Invalidate_Result.
+func (v *CachingStore_Invalidate_Result) Copy() *CachingStore_Invalidate_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o CachingStore_Invalidate_Result
+	return &o
+}
+
+// Hash returns a hash of this CachingStore_Invalidate_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *CachingStore_Invalidate_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this CachingStore_Invalidate_Result so that it may be reused.
+func (v *CachingStore_Invalidate_Result) Reset() {
+	*v = CachingStore_Invalidate_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CachingStore_Invalidate_Result.
+func (v *CachingStore_Invalidate_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "invalidate" for this struct.
+func (v *CachingStore_Invalidate_Result) MethodName() string {
+	return "invalidate"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *CachingStore_Invalidate_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Store_Get_Args represents the arguments for the Store.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+type Store_Get_Args struct {
+	Key string `json:"key,required"`
+}
+
+// ToWire translates a Store_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Key), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Store_Get_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Get_Args struct could not be encoded.
+func (v *Store_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Key); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Store_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Get_Args struct could not be generated from the wire
+// representation.
+func (v *Store_Get_Args) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Store_Get_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Get_Args
+// struct.
+func (v *Store_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+
+	return fmt.Sprintf("Store_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Get_Args match the
+// provided Store_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Get_Args) Equals(rhs *Store_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Get_Args.
+func (v *Store_Get_Args) Copy() *Store_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Get_Args
+	o.Key = v.Key
+	return &o
+}
+
+// Hash returns a hash of this Store_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Get_Args so that it may be reused.
+func (v *Store_Get_Args) Reset() {
+	*v = Store_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Get_Args.
+func (v *Store_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", v.Key)
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Args) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *Store_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Store_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Store_Get_Helper provides functions that aid in handling the
+// parameters and return values of the Store.get
+// function.
+var Store_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key string,
+	) *Store_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := Store_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Entry, error) (*Store_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Store_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Store_Get_Result) (*Entry, error)
+}{}
+
+func init() {
+	Store_Get_Helper.Args = func(
+		key string,
+	) *Store_Get_Args {
+		return &Store_Get_Args{
+			Key: key,
+		}
+	}
+
+	Store_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *exceptions.DoesNotExistException:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Store_Get_Helper.WrapResponse = func(success *Entry, err error) (*Store_Get_Result, error) {
+		if err == nil {
+			return &Store_Get_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *exceptions.DoesNotExistException:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Store_Get_Result.NotFound")
+			}
+			return &Store_Get_Result{NotFound: e}, nil
+		}
+
+		return nil, err
+	}
+	Store_Get_Helper.UnwrapResponse = func(result *Store_Get_Result) (success *Entry, err error) {
+		if result.NotFound != nil {
+			err = result.NotFound
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Store_Get_Result represents the result of a Store.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Store_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success  *Entry                            `json:"success,omitempty"`
+	NotFound *exceptions.DoesNotExistException `json:"notFound,omitempty"`
+}
+
+// ToWire translates a Store_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.NotFound != nil {
+		w, err = v.NotFound.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Entry_Read(w wire.Value) (*Entry, error) {
+	var v Entry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DoesNotExistException_Read(w wire.Value) (*exceptions.DoesNotExistException, error) {
+	var v exceptions.DoesNotExistException
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Store_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Entry_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.NotFound, err = _DoesNotExistException_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Get_Result struct could not be encoded.
+func (v *Store_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NotFound != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NotFound.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Entry_Decode(sr stream.Reader) (*Entry, error) {
+	var v Entry
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _DoesNotExistException_Decode(sr stream.Reader) (*exceptions.DoesNotExistException, error) {
+	var v exceptions.DoesNotExistException
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Store_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Get_Result struct could not be generated from the wire
+// representation.
+func (v *Store_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Entry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.NotFound, err = _DoesNotExistException_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Get_Result
+// struct.
+func (v *Store_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.NotFound != nil {
+		fields[i] = fmt.Sprintf("NotFound: %v", v.NotFound)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Get_Result match the
+// provided Store_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *Store_Get_Result) Equals(rhs *Store_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.NotFound == nil && rhs.NotFound == nil) || (v.NotFound != nil && rhs.NotFound != nil && v.NotFound.Equals(rhs.NotFound))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Get_Result.
+func (v *Store_Get_Result) Copy() *Store_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Get_Result
+	o.Success = v.Success.Copy()
+	o.NotFound = v.NotFound.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Store_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.NotFound.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Get_Result so that it may be reused.
+func (v *Store_Get_Result) Reset() {
+	*v = Store_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Get_Result.
+func (v *Store_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.NotFound != nil {
+		err = multierr.Append(err, enc.AddObject("notFound", v.NotFound))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Result) GetSuccess() (o *Entry) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Store_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetNotFound returns the value of NotFound if it is set or its
+// zero value if it is unset.
+func (v *Store_Get_Result) GetNotFound() (o *exceptions.DoesNotExistException) {
+	if v != nil && v.NotFound != nil {
+		return v.NotFound
+	}
+
+	return
+}
+
+// IsSetNotFound returns true if NotFound is not nil.
+func (v *Store_Get_Result) IsSetNotFound() bool {
+	return v != nil && v.NotFound != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *Store_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Store_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Store_Put_Args represents the arguments for the Store.put function.
+//
+// The arguments for put are sent and received over the wire as this struct.
+type Store_Put_Args struct {
+	Entry *Entry `json:"entry,omitempty"`
+	TTL   *int64 `json:"ttl,omitempty"`
+}
+
+// ToWire translates a Store_Put_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Put_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Entry != nil {
+		w, err = v.Entry.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.TTL != nil {
+		w, err = wire.NewValueI64(*(v.TTL)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Put_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Put_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Put_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Put_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Entry, err = _Entry_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TTL = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Put_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Put_Args struct could not be encoded.
+func (v *Store_Put_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Entry != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Entry.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TTL != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TTL)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Store_Put_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Put_Args struct could not be generated from the wire
+// representation.
+func (v *Store_Put_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Entry, err = _Entry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TTL = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Put_Args
+// struct.
+func (v *Store_Put_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Entry != nil {
+		fields[i] = fmt.Sprintf("Entry: %v", v.Entry)
+		i++
+	}
+	if v.TTL != nil {
+		fields[i] = fmt.Sprintf("TTL: %v", *(v.TTL))
+		i++
+	}
+
+	return fmt.Sprintf("Store_Put_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Store_Put_Args match the
+// provided Store_Put_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Put_Args) Equals(rhs *Store_Put_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Entry == nil && rhs.Entry == nil) || (v.Entry != nil && rhs.Entry != nil && v.Entry.Equals(rhs.Entry))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TTL, rhs.TTL) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Store_Put_Args.
+func (v *Store_Put_Args) Copy() *Store_Put_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Put_Args
+	o.Entry = v.Entry.Copy()
+	o.TTL = _I64_CopyPtr(v.TTL)
+	return &o
+}
+
+// Hash returns a hash of this Store_Put_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Put_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Entry.Hash())
+	if v.TTL != nil {
+		h.Field(2)
+		h.Int64(*v.TTL)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Put_Args so that it may be reused.
+func (v *Store_Put_Args) Reset() {
+	*v = Store_Put_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Put_Args.
+func (v *Store_Put_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Entry != nil {
+		err = multierr.Append(err, enc.AddObject("entry", v.Entry))
+	}
+	if v.TTL != nil {
+		enc.AddInt64("ttl", *v.TTL)
+	}
+	return err
+}
+
+// GetEntry returns the value of Entry if it is set or its
+// zero value if it is unset.
+func (v *Store_Put_Args) GetEntry() (o *Entry) {
+	if v != nil && v.Entry != nil {
+		return v.Entry
+	}
+
+	return
+}
+
+// IsSetEntry returns true if Entry is not nil.
+func (v *Store_Put_Args) IsSetEntry() bool {
+	return v != nil && v.Entry != nil
+}
+
+// GetTTL returns the value of TTL if it is set or its
+// zero value if it is unset.
+func (v *Store_Put_Args) GetTTL() (o int64) {
+	if v != nil && v.TTL != nil {
+		return *v.TTL
+	}
+
+	return
+}
+
+// IsSetTTL returns true if TTL is not nil.
+func (v *Store_Put_Args) IsSetTTL() bool {
+	return v != nil && v.TTL != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "put" for this struct.
+func (v *Store_Put_Args) MethodName() string {
+	return "put"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Store_Put_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Store_Put_Helper provides functions that aid in handling the
+// parameters and return values of the Store.put
+// function.
+var Store_Put_Helper = struct {
+	// Args accepts the parameters of put in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		entry *Entry,
+		ttl *int64,
+	) *Store_Put_Args
+
+	// IsException returns true if the given error can be thrown
+	// by put.
+	//
+	// An error can be thrown by put only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for put
+	// given the error returned by it. The provided error may
+	// be nil if put did not fail.
+	//
+	// This allows mapping errors returned by put into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// put
+	//
+	//   err := put(args)
+	//   result, err := Store_Put_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from put: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*Store_Put_Result, error)
+
+	// UnwrapResponse takes the result struct for put
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if put threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := Store_Put_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Store_Put_Result) error
+}{}
+
+func init() {
+	Store_Put_Helper.Args = func(
+		entry *Entry,
+		ttl *int64,
+	) *Store_Put_Args {
+		return &Store_Put_Args{
+			Entry: entry,
+			TTL:   ttl,
+		}
+	}
+
+	Store_Put_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *Unavailable:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Store_Put_Helper.WrapResponse = func(err error) (*Store_Put_Result, error) {
+		if err == nil {
+			return &Store_Put_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *Unavailable:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Store_Put_Result.Unavailable")
+			}
+			return &Store_Put_Result{Unavailable: e}, nil
+		}
+
+		return nil, err
+	}
+	Store_Put_Helper.UnwrapResponse = func(result *Store_Put_Result) (err error) {
+		if result.Unavailable != nil {
+			err = result.Unavailable
+			return
+		}
+		return
+	}
+
+}
+
+// Store_Put_Result represents the result of a Store.put function call.
+//
+// The result of a put execution is sent and received over the wire as this struct.
+type Store_Put_Result struct {
+	Unavailable *Unavailable `json:"unavailable,omitempty"`
+}
+
+// ToWire translates a Store_Put_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Put_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Unavailable != nil {
+		w, err = v.Unavailable.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("Store_Put_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Unavailable_Read(w wire.Value) (*Unavailable, error) {
+	var v Unavailable
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Store_Put_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Put_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Put_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Put_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Unavailable, err = _Unavailable_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Unavailable != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("Store_Put_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Put_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Put_Result struct could not be encoded.
+func (v *Store_Put_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Unavailable != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Unavailable.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Unavailable != nil {
+		count++
+	}
+
+	if count > 1 {
+		return fmt.Errorf("Store_Put_Result should have at most one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Unavailable_Decode(sr stream.Reader) (*Unavailable, error) {
+	var v Unavailable
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Store_Put_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Put_Result struct could not be generated from the wire
+// representation.
+func (v *Store_Put_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Unavailable, err = _Unavailable_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Unavailable != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("Store_Put_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Put_Result
+// struct.
+func (v *Store_Put_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Unavailable != nil {
+		fields[i] = fmt.Sprintf("Unavailable: %v", v.Unavailable)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Put_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Put_Result match the
+// provided Store_Put_Result.
+//
+// This function performs a deep comparison.
+func (v *Store_Put_Result) Equals(rhs *Store_Put_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Unavailable == nil && rhs.Unavailable == nil) || (v.Unavailable != nil && rhs.Unavailable != nil && v.Unavailable.Equals(rhs.Unavailable))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Put_Result.
+func (v *Store_Put_Result) Copy() *Store_Put_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Put_Result
+	o.Unavailable = v.Unavailable.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Store_Put_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Put_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Unavailable.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Put_Result so that it may be reused.
+func (v *Store_Put_Result) Reset() {
+	*v = Store_Put_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Put_Result.
+func (v *Store_Put_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Unavailable != nil {
+		err = multierr.Append(err, enc.AddObject("unavailable", v.Unavailable))
+	}
+	return err
+}
+
+// GetUnavailable returns the value of Unavailable if it is set or its
+// zero value if it is unset.
+func (v *Store_Put_Result) GetUnavailable() (o *Unavailable) {
+	if v != nil && v.Unavailable != nil {
+		return v.Unavailable
+	}
+
+	return
+}
+
+// IsSetUnavailable returns true if Unavailable is not nil.
+func (v *Store_Put_Result) IsSetUnavailable() bool {
+	return v != nil && v.Unavailable != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "put" for this struct.
+func (v *Store_Put_Result) MethodName() string {
+	return "put"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Store_Put_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Store_Scan_Args represents the arguments for the Store.scan function.
+//
+// The arguments for scan are sent and received over the wire as this struct.
+type Store_Scan_Args struct {
+	Prefixes map[string]struct{} `json:"prefixes,omitempty"`
+}
+
+type _Set_String_mapType_ValueList map[string]struct{}
+
+func (v _Set_String_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_mapType_ValueList) Close() {}
+
+// ToWire translates a Store_Scan_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Scan_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Prefixes != nil {
+		w, err = wire.NewValueSet(_Set_String_mapType_ValueList(v.Prefixes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Store_Scan_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Scan_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Scan_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Scan_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TSet {
+				v.Prefixes, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Set_String_mapType_Encode(val map[string]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a Store_Scan_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Scan_Args struct could not be encoded.
+func (v *Store_Scan_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Prefixes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_String_mapType_Encode(v.Prefixes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[string]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Store_Scan_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Scan_Args struct could not be generated from the wire
+// representation.
+func (v *Store_Scan_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TSet:
+			v.Prefixes, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Scan_Args
+// struct.
+func (v *Store_Scan_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Prefixes != nil {
+		fields[i] = fmt.Sprintf("Prefixes: %v", v.Prefixes)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Scan_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Store_Scan_Args match the
+// provided Store_Scan_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Scan_Args) Equals(rhs *Store_Scan_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Prefixes == nil && rhs.Prefixes == nil) || (v.Prefixes != nil && rhs.Prefixes != nil && _Set_String_mapType_Equals(v.Prefixes, rhs.Prefixes))) {
+		return false
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Store_Scan_Args.
+func (v *Store_Scan_Args) Copy() *Store_Scan_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Scan_Args
+	o.Prefixes = _Set_String_mapType_Copy(v.Prefixes)
+	return &o
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Store_Scan_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Scan_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Set_String_mapType_Hash(v.Prefixes))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Scan_Args so that it may be reused.
+func (v *Store_Scan_Args) Reset() {
+	*v = Store_Scan_Args{}
+}
+
+type _Set_String_mapType_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_mapType_Zapper.
+func (s _Set_String_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Scan_Args.
+func (v *Store_Scan_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Prefixes != nil {
+		err = multierr.Append(err, enc.AddArray("prefixes", (_Set_String_mapType_Zapper)(v.Prefixes)))
+	}
+	return err
+}
+
+// GetPrefixes returns the value of Prefixes if it is set or its
+// zero value if it is unset.
+func (v *Store_Scan_Args) GetPrefixes() (o map[string]struct{}) {
+	if v != nil && v.Prefixes != nil {
+		return v.Prefixes
+	}
+
+	return
+}
+
+// IsSetPrefixes returns true if Prefixes is not nil.
+func (v *Store_Scan_Args) IsSetPrefixes() bool {
+	return v != nil && v.Prefixes != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "scan" for this struct.
+func (v *Store_Scan_Args) MethodName() string {
+	return "scan"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Store_Scan_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Store_Scan_Helper provides functions that aid in handling the
+// parameters and return values of the Store.scan
+// function.
+var Store_Scan_Helper = struct {
+	// Args accepts the parameters of scan in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		prefixes map[string]struct{},
+	) *Store_Scan_Args
+
+	// IsException returns true if the given error can be thrown
+	// by scan.
+	//
+	// An error can be thrown by scan only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for scan
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// scan into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by scan
+	//
+	//   value, err := scan(args)
+	//   result, err := Store_Scan_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from scan: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(map[string][]*Entry, error) (*Store_Scan_Result, error)
+
+	// UnwrapResponse takes the result struct for scan
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if scan threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Store_Scan_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Store_Scan_Result) (map[string][]*Entry, error)
+}{}
+
+func init() {
+	Store_Scan_Helper.Args = func(
+		prefixes map[string]struct{},
+	) *Store_Scan_Args {
+		return &Store_Scan_Args{
+			Prefixes: prefixes,
+		}
+	}
+
+	Store_Scan_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Store_Scan_Helper.WrapResponse = func(success map[string][]*Entry, err error) (*Store_Scan_Result, error) {
+		if err == nil {
+			return &Store_Scan_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Store_Scan_Helper.UnwrapResponse = func(result *Store_Scan_Result) (success map[string][]*Entry, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Store_Scan_Result represents the result of a Store.scan function call.
+//
+// The result of a scan execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Store_Scan_Result struct {
+	// Value returned by scan after a successful execution.
+	Success map[string][]*Entry `json:"success,omitempty"`
+}
+
+type _List_Entry_ValueList []*Entry
+
+func (v _List_Entry_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Entry', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Entry_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Entry_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Entry_ValueList) Close() {}
+
+type _Map_String_List_Entry_MapItemList map[string][]*Entry
+
+func (m _Map_String_List_Entry_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]*Entry', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueList(_List_Entry_ValueList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_List_Entry_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_List_Entry_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_List_Entry_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_List_Entry_MapItemList) Close() {}
+
+// ToWire translates a Store_Scan_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Scan_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueMap(_Map_String_List_Entry_MapItemList(v.Success)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Store_Scan_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Entry_Read(l wire.ValueList) ([]*Entry, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Entry, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Entry_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_List_Entry_Read(m wire.MapItemList) (map[string][]*Entry, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string][]*Entry, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _List_Entry_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Store_Scan_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Scan_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Scan_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Scan_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TMap {
+				v.Success, err = _Map_String_List_Entry_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Scan_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+func _List_Entry_Encode(val []*Entry, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Entry
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Entry', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_List_Entry_Encode(val map[string][]*Entry, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TList,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]*Entry', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := _List_Entry_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Store_Scan_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Scan_Result struct could not be encoded.
+func (v *Store_Scan_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_List_Entry_Encode(v.Success, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Store_Scan_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_Entry_Decode(sr stream.Reader) ([]*Entry, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Entry, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Entry_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_List_Entry_Decode(sr stream.Reader) (map[string][]*Entry, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TList {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string][]*Entry, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _List_Entry_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Store_Scan_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Scan_Result struct could not be generated from the wire
+// representation.
+func (v *Store_Scan_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TMap:
+			v.Success, err = _Map_String_List_Entry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Store_Scan_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Scan_Result
+// struct.
+func (v *Store_Scan_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Store_Scan_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Entry_Equals(lhs, rhs []*Entry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_List_Entry_Equals(lhs, rhs map[string][]*Entry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_Entry_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Store_Scan_Result match the
+// provided Store_Scan_Result.
+//
+// This function performs a deep comparison.
+func (v *Store_Scan_Result) Equals(rhs *Store_Scan_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && _Map_String_List_Entry_Equals(v.Success, rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Entry_Copy(v []*Entry) []*Entry {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Entry, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_List_Entry_Copy(v map[string][]*Entry) map[string][]*Entry {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]*Entry, len(v))
+	for k, x := range v {
+		o[k] = _List_Entry_Copy(x)
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Store_Scan_Result.
+func (v *Store_Scan_Result) Copy() *Store_Scan_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Scan_Result
+	o.Success = _Map_String_List_Entry_Copy(v.Success)
+	return &o
+}
+
+func _List_Entry_Hash(v []*Entry) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Map_String_List_Entry_Hash(v map[string][]*Entry) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(_List_Entry_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Store_Scan_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Scan_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(_Map_String_List_Entry_Hash(v.Success))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Scan_Result so that it may be reused.
+func (v *Store_Scan_Result) Reset() {
+	*v = Store_Scan_Result{}
+}
+
+type _List_Entry_Zapper []*Entry
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Entry_Zapper.
+func (l _List_Entry_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_List_Entry_Zapper map[string][]*Entry
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_List_Entry_Zapper.
+func (m _Map_String_List_Entry_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddArray((string)(k), (_List_Entry_Zapper)(v)))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Scan_Result.
+func (v *Store_Scan_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", (_Map_String_List_Entry_Zapper)(v.Success)))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Store_Scan_Result) GetSuccess() (o map[string][]*Entry) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Store_Scan_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "scan" for this struct.
+func (v *Store_Scan_Result) MethodName() string {
+	return "scan"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Store_Scan_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Store_Touch_Args represents the arguments for the Store.touch function.
+//
+// The arguments for touch are sent and received over the wire as this struct.
+type Store_Touch_Args struct {
+	Key *string `json:"key,omitempty"`
+}
+
+// ToWire translates a Store_Touch_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Store_Touch_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Store_Touch_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Store_Touch_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Store_Touch_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Store_Touch_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Store_Touch_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Store_Touch_Args struct could not be encoded.
+func (v *Store_Touch_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Store_Touch_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Store_Touch_Args struct could not be generated from the wire
+// representation.
+func (v *Store_Touch_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Store_Touch_Args
+// struct.
+func (v *Store_Touch_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("Store_Touch_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Store_Touch_Args match the
+// provided Store_Touch_Args.
+//
+// This function performs a deep comparison.
+func (v *Store_Touch_Args) Equals(rhs *Store_Touch_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Store_Touch_Args.
+func (v *Store_Touch_Args) Copy() *Store_Touch_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Store_Touch_Args
+	o.Key = _String_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this Store_Touch_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Store_Touch_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(*v.Key)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Store_Touch_Args so that it may be reused.
+func (v *Store_Touch_Args) Reset() {
+	*v = Store_Touch_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Store_Touch_Args.
+func (v *Store_Touch_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Store_Touch_Args) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *Store_Touch_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "touch" for this struct.
+func (v *Store_Touch_Args) MethodName() string {
+	return "touch"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *Store_Touch_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// Store_Touch_Helper provides functions that aid in handling the
+// parameters and return values of the Store.touch
+// function.
+var Store_Touch_Helper = struct {
+	// Args accepts the parameters of touch in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *string,
+	) *Store_Touch_Args
+}{}
+
+func init() {
+	Store_Touch_Helper.Args = func(
+		key *string,
+	) *Store_Touch_Args {
+		return &Store_Touch_Args{
+			Key: key,
+		}
+	}
+
+}
+
+// CachingStoreServiceSpec describes the CachingStore service.
+var CachingStoreServiceSpec = &thriftreflect.ServiceSpec{
+	Name:    "CachingStore",
+	File:    "service-specs.thrift",
+	Extends: "Store",
+	Functions: []thriftreflect.FunctionSpec{
+		{
+			Name: "invalidate",
+			Args: []thriftreflect.ParamSpec{
+				{
+					ID:   1,
+					Name: "key",
+					Type: "string",
+				},
+			},
+			Annotations: map[string]string{
+				"idempotent": "true",
+			},
+		},
+	},
+}
+
+// StoreServiceSpec describes the Store service.
+var StoreServiceSpec = &thriftreflect.ServiceSpec{
+	Name: "Store",
+	File: "service-specs.thrift",
+	Functions: []thriftreflect.FunctionSpec{
+		{
+			Name: "get",
+			Args: []thriftreflect.ParamSpec{
+				{
+					ID:       1,
+					Name:     "key",
+					Type:     "string",
+					Required: true,
+					Annotations: map[string]string{
+						"pii": "false",
+					},
+				},
+			},
+			Result: "Entry",
+			Exceptions: []thriftreflect.ParamSpec{
+				{
+					ID:   1,
+					Name: "notFound",
+					Type: "exceptions.DoesNotExistException",
+				},
+			},
+			Annotations: map[string]string{
+				"slo.latency_ms": "50",
+			},
+		},
+		{
+			Name: "put",
+			Args: []thriftreflect.ParamSpec{
+				{
+					ID:   1,
+					Name: "entry",
+					Type: "Entry",
+				},
+				{
+					ID:   2,
+					Name: "ttl",
+					Type: "i64",
+				},
+			},
+			Exceptions: []thriftreflect.ParamSpec{
+				{
+					ID:   1,
+					Name: "unavailable",
+					Type: "Unavailable",
+				},
+			},
+		},
+		{
+			Name: "scan",
+			Args: []thriftreflect.ParamSpec{
+				{
+					ID:   1,
+					Name: "prefixes",
+					Type: "set<string>",
+				},
+			},
+			Result: "map<string, list<Entry>>",
+		},
+		{
+			Name:   "touch",
+			OneWay: true,
+			Args: []thriftreflect.ParamSpec{
+				{
+					ID:   1,
+					Name: "key",
+					Type: "string",
+				},
+			},
+		},
+	},
+	Annotations: map[string]string{
+		"owner": "storage-team",
+		"team":  "storage",
+	},
+}
+
+// ServiceSpecs describes the services defined in this package, keyed
+// by their names in the Thrift file.
+var ServiceSpecs = map[string]*thriftreflect.ServiceSpec{
+	"CachingStore": CachingStoreServiceSpec,
+	"Store":        StoreServiceSpec,
+}
@@ -13,6 +13,7 @@ import (
 	unions "go.uber.org/thriftrw/gen/internal/tests/unions"
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -258,6 +259,53 @@ func (v *ConflictingNamesSetValueArgs) Equals(rhs *ConflictingNamesSetValueArgs)
 	return true
 }
 
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this ConflictingNamesSetValueArgs.
+func (v *ConflictingNamesSetValueArgs) Copy() *ConflictingNamesSetValueArgs {
+	if v == nil {
+		return nil
+	}
+
+	var o ConflictingNamesSetValueArgs
+	o.Key = v.Key
+	o.Value = _Binary_Copy(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this ConflictingNamesSetValueArgs which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ConflictingNamesSetValueArgs) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Key)
+	h.Field(2)
+	h.Binary(v.Value)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ConflictingNamesSetValueArgs so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *ConflictingNamesSetValueArgs) Reset() {
+	*v = ConflictingNamesSetValueArgs{
+		Value: v.Value[:0],
+	}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ConflictingNamesSetValueArgs.
 func (v *ConflictingNamesSetValueArgs) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -491,6 +539,46 @@ func (v *InternalError) Equals(rhs *InternalError) bool {
 	return true
 }
 
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this InternalError.
+func (v *InternalError) Copy() *InternalError {
+	if v == nil {
+		return nil
+	}
+
+	var o InternalError
+	o.Message = _String_CopyPtr(v.Message)
+	return &o
+}
+
+// Hash returns a hash of this InternalError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *InternalError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Message != nil {
+		h.Field(1)
+		h.String(*v.Message)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this InternalError so that it may be reused.
+func (v *InternalError) Reset() {
+	*v = InternalError{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of InternalError.
 func (v *InternalError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -570,12 +658,20 @@ func (lhs Key) Equals(rhs Key) bool {
 	return ((string)(lhs) == (string)(rhs))
 }
 
+// Hash returns a hash of this Key which is stable across
+// processes.
+func (v Key) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "services",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/services",
 	FilePath: "services.thrift",
-	SHA1:     "22d2183c133fb327925d7288a6191b17e8f28175",
+	SHA1:     "c326f3ea1993d9e0a907167f9e5e20c8a1479d1b",
 	Includes: []*thriftreflect.ThriftModule{
 		exceptions.ThriftModule,
 		unions.ThriftModule,
@@ -583,7 +679,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./unions.thrift\"\ninclude \"./exceptions.thrift\"\n\ntypedef string Key\n\nexception InternalError {\n    1: optional string message\n}\n\nservice KeyValue {\n    // void and no exceptions\n    void setValue(1: Key key, 2: unions.ArbitraryValue value)\n\n    void setValueV2(\n        /** Key to change. */\n        1: required Key key,\n        /**\n         * New value for the key.\n         *\n         * If the key already has an existing value, it will be overwritten.\n         */\n        2: required unions.ArbitraryValue value,\n    )\n\n    // Return with exceptions\n    unions.ArbitraryValue getValue(1: Key key)\n        throws (1: exceptions.DoesNotExistException doesNotExist)\n\n    // void with exceptions\n    void deleteValue(1: Key key)\n        throws (\n            /**\n             * Raised if a value with the given key doesn't exist.\n             */\n            1: exceptions.DoesNotExistException doesNotExist,\n            2: InternalError internalError\n        )\n\n    list<unions.ArbitraryValue> getManyValues(\n        1: list<Key> range  // < reserved keyword as an argument\n    ) throws (\n        1: exceptions.DoesNotExistException doesNotExist,\n    )\n\n    i64 size()  // < primitve return value\n}\n\nservice Cache {\n    oneway void clear()\n    oneway void clearAfter(1: i64 durationMS)\n}\n\nstruct ConflictingNames_SetValue_Args {\n    1: required string key\n    2: required binary value\n}\n\nservice ConflictingNames {\n    void setValue(1: ConflictingNames_SetValue_Args request)\n}\n\nservice non_standard_service_name {\n    void non_standard_function_name()\n}\n"
+const rawIDL = "include \"./unions.thrift\"\ninclude \"./exceptions.thrift\"\n\ntypedef string Key\n\nexception InternalError {\n    1: optional string message\n}\n\n/**\n * KeyValue stores arbitrary values by key.\n */\nservice KeyValue {\n    // void and no exceptions\n    void setValue(1: Key key, 2: unions.ArbitraryValue value)\n\n    void setValueV2(\n        /** Key to change. */\n        1: required Key key,\n        /**\n         * New value for the key.\n         *\n         * If the key already has an existing value, it will be overwritten.\n         */\n        2: required unions.ArbitraryValue value,\n    )\n\n    // Return with exceptions\n    unions.ArbitraryValue getValue(1: Key key)\n        throws (1: exceptions.DoesNotExistException doesNotExist)\n\n    // void with exceptions\n    void deleteValue(1: Key key)\n        throws (\n            /**\n             * Raised if a value with the given key doesn't exist.\n             */\n            1: exceptions.DoesNotExistException doesNotExist,\n            2: InternalError internalError\n        )\n\n    list<unions.ArbitraryValue> getManyValues(\n        1: list<Key> range  // < reserved keyword as an argument\n    ) throws (\n        1: exceptions.DoesNotExistException doesNotExist,\n    )\n\n    /** Returns the number of keys stored. */\n    i64 size()  // < primitve return value\n}\n\nservice Cache {\n    oneway void clear()\n    oneway void clearAfter(1: i64 durationMS)\n}\n\nstruct ConflictingNames_SetValue_Args {\n    1: required string key\n    2: required binary value\n}\n\nservice ConflictingNames {\n    void setValue(1: ConflictingNames_SetValue_Args request)\n}\n\nservice non_standard_service_name {\n    void non_standard_function_name()\n}\n"
 
 // Cache_Clear_Args represents the arguments for the Cache.clear function.
 //
@@ -721,6 +817,32 @@ func (v *Cache_Clear_Args) Equals(rhs *Cache_Clear_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Cache_Clear_Args.
+func (v *Cache_Clear_Args) Copy() *Cache_Clear_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Cache_Clear_Args
+	return &o
+}
+
+// Hash returns a hash of this Cache_Clear_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Cache_Clear_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Cache_Clear_Args so that it may be reused.
+func (v *Cache_Clear_Args) Reset() {
+	*v = Cache_Clear_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Cache_Clear_Args.
 func (v *Cache_Clear_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -957,6 +1079,46 @@ func (v *Cache_ClearAfter_Args) Equals(rhs *Cache_ClearAfter_Args) bool {
 	return true
 }
 
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Cache_ClearAfter_Args.
+func (v *Cache_ClearAfter_Args) Copy() *Cache_ClearAfter_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Cache_ClearAfter_Args
+	o.DurationMS = _I64_CopyPtr(v.DurationMS)
+	return &o
+}
+
+// Hash returns a hash of this Cache_ClearAfter_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Cache_ClearAfter_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.DurationMS != nil {
+		h.Field(1)
+		h.Int64(*v.DurationMS)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Cache_ClearAfter_Args so that it may be reused.
+func (v *Cache_ClearAfter_Args) Reset() {
+	*v = Cache_ClearAfter_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Cache_ClearAfter_Args.
 func (v *Cache_ClearAfter_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1215,6 +1377,35 @@ func (v *ConflictingNames_SetValue_Args) Equals(rhs *ConflictingNames_SetValue_A
 	return true
 }
 
+// Copy returns a deep copy of this ConflictingNames_SetValue_Args.
+func (v *ConflictingNames_SetValue_Args) Copy() *ConflictingNames_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o ConflictingNames_SetValue_Args
+	o.Request = v.Request.Copy()
+	return &o
+}
+
+// Hash returns a hash of this ConflictingNames_SetValue_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ConflictingNames_SetValue_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Request.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ConflictingNames_SetValue_Args so that it may be reused.
+func (v *ConflictingNames_SetValue_Args) Reset() {
+	*v = ConflictingNames_SetValue_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ConflictingNames_SetValue_Args.
 func (v *ConflictingNames_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1468,6 +1659,32 @@ func (v *ConflictingNames_SetValue_Result) Equals(rhs *ConflictingNames_SetValue
 	return true
 }
 
+// Copy returns a deep copy of this ConflictingNames_SetValue_Result.
+func (v *ConflictingNames_SetValue_Result) Copy() *ConflictingNames_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o ConflictingNames_SetValue_Result
+	return &o
+}
+
+// Hash returns a hash of this ConflictingNames_SetValue_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ConflictingNames_SetValue_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ConflictingNames_SetValue_Result so that it may be reused.
+func (v *ConflictingNames_SetValue_Result) Reset() {
+	*v = ConflictingNames_SetValue_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ConflictingNames_SetValue_Result.
 func (v *ConflictingNames_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1700,6 +1917,46 @@ func (v *KeyValue_DeleteValue_Args) Equals(rhs *KeyValue_DeleteValue_Args) bool
 	return true
 }
 
+func _Key_CopyPtr(v *Key) *Key {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this KeyValue_DeleteValue_Args.
+func (v *KeyValue_DeleteValue_Args) Copy() *KeyValue_DeleteValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_DeleteValue_Args
+	o.Key = _Key_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_DeleteValue_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_DeleteValue_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(string(*v.Key))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_DeleteValue_Args so that it may be reused.
+func (v *KeyValue_DeleteValue_Args) Reset() {
+	*v = KeyValue_DeleteValue_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_DeleteValue_Args.
 func (v *KeyValue_DeleteValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2129,6 +2386,38 @@ func (v *KeyValue_DeleteValue_Result) Equals(rhs *KeyValue_DeleteValue_Result) b
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_DeleteValue_Result.
+func (v *KeyValue_DeleteValue_Result) Copy() *KeyValue_DeleteValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_DeleteValue_Result
+	o.DoesNotExist = v.DoesNotExist.Copy()
+	o.InternalError = v.InternalError.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_DeleteValue_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_DeleteValue_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.DoesNotExist.Hash())
+	h.Field(2)
+	h.Uint64(v.InternalError.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_DeleteValue_Result so that it may be reused.
+func (v *KeyValue_DeleteValue_Result) Reset() {
+	*v = KeyValue_DeleteValue_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_DeleteValue_Result.
 func (v *KeyValue_DeleteValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2525,6 +2814,57 @@ func (v *KeyValue_GetManyValues_Args) Equals(rhs *KeyValue_GetManyValues_Args) b
 	return true
 }
 
+func _List_Key_Copy(v []Key) []Key {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Key, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this KeyValue_GetManyValues_Args.
+func (v *KeyValue_GetManyValues_Args) Copy() *KeyValue_GetManyValues_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_GetManyValues_Args
+	o.Range = _List_Key_Copy(v.Range)
+	return &o
+}
+
+func _List_Key_Hash(v []Key) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(string(x))
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this KeyValue_GetManyValues_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_GetManyValues_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Key_Hash(v.Range))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_GetManyValues_Args so that it may be reused.
+func (v *KeyValue_GetManyValues_Args) Reset() {
+	*v = KeyValue_GetManyValues_Args{}
+}
+
 type _List_Key_Zapper []Key
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -3111,6 +3451,60 @@ func (v *KeyValue_GetManyValues_Result) Equals(rhs *KeyValue_GetManyValues_Resul
 	return true
 }
 
+func _List_ArbitraryValue_Copy(v []*unions.ArbitraryValue) []*unions.ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*unions.ArbitraryValue, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this KeyValue_GetManyValues_Result.
+func (v *KeyValue_GetManyValues_Result) Copy() *KeyValue_GetManyValues_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_GetManyValues_Result
+	o.Success = _List_ArbitraryValue_Copy(v.Success)
+	o.DoesNotExist = v.DoesNotExist.Copy()
+	return &o
+}
+
+func _List_ArbitraryValue_Hash(v []*unions.ArbitraryValue) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this KeyValue_GetManyValues_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_GetManyValues_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(_List_ArbitraryValue_Hash(v.Success))
+	h.Field(1)
+	h.Uint64(v.DoesNotExist.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_GetManyValues_Result so that it may be reused.
+func (v *KeyValue_GetManyValues_Result) Reset() {
+	*v = KeyValue_GetManyValues_Result{}
+}
+
 type _List_ArbitraryValue_Zapper []*unions.ArbitraryValue
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -3368,6 +3762,37 @@ func (v *KeyValue_GetValue_Args) Equals(rhs *KeyValue_GetValue_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_GetValue_Args.
+func (v *KeyValue_GetValue_Args) Copy() *KeyValue_GetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_GetValue_Args
+	o.Key = _Key_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_GetValue_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_GetValue_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(string(*v.Key))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_GetValue_Args so that it may be reused.
+func (v *KeyValue_GetValue_Args) Reset() {
+	*v = KeyValue_GetValue_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_GetValue_Args.
 func (v *KeyValue_GetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3770,6 +4195,38 @@ func (v *KeyValue_GetValue_Result) Equals(rhs *KeyValue_GetValue_Result) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) Copy() *KeyValue_GetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_GetValue_Result
+	o.Success = v.Success.Copy()
+	o.DoesNotExist = v.DoesNotExist.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_GetValue_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_GetValue_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.DoesNotExist.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_GetValue_Result so that it may be reused.
+func (v *KeyValue_GetValue_Result) Reset() {
+	*v = KeyValue_GetValue_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_GetValue_Result.
 func (v *KeyValue_GetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4058,6 +4515,40 @@ func (v *KeyValue_SetValue_Args) Equals(rhs *KeyValue_SetValue_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) Copy() *KeyValue_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_SetValue_Args
+	o.Key = _Key_CopyPtr(v.Key)
+	o.Value = v.Value.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_SetValue_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_SetValue_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(string(*v.Key))
+	}
+	h.Field(2)
+	h.Uint64(v.Value.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_SetValue_Args so that it may be reused.
+func (v *KeyValue_SetValue_Args) Reset() {
+	*v = KeyValue_SetValue_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValue_Args.
 func (v *KeyValue_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4332,6 +4823,32 @@ func (v *KeyValue_SetValue_Result) Equals(rhs *KeyValue_SetValue_Result) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_SetValue_Result.
+func (v *KeyValue_SetValue_Result) Copy() *KeyValue_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_SetValue_Result
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_SetValue_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_SetValue_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_SetValue_Result so that it may be reused.
+func (v *KeyValue_SetValue_Result) Reset() {
+	*v = KeyValue_SetValue_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValue_Result.
 func (v *KeyValue_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4600,6 +5117,38 @@ func (v *KeyValue_SetValueV2_Args) Equals(rhs *KeyValue_SetValueV2_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_SetValueV2_Args.
+func (v *KeyValue_SetValueV2_Args) Copy() *KeyValue_SetValueV2_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_SetValueV2_Args
+	o.Key = v.Key
+	o.Value = v.Value.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_SetValueV2_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_SetValueV2_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Key))
+	h.Field(2)
+	h.Uint64(v.Value.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_SetValueV2_Args so that it may be reused.
+func (v *KeyValue_SetValueV2_Args) Reset() {
+	*v = KeyValue_SetValueV2_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValueV2_Args.
 func (v *KeyValue_SetValueV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4863,6 +5412,32 @@ func (v *KeyValue_SetValueV2_Result) Equals(rhs *KeyValue_SetValueV2_Result) boo
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_SetValueV2_Result.
+func (v *KeyValue_SetValueV2_Result) Copy() *KeyValue_SetValueV2_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_SetValueV2_Result
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_SetValueV2_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_SetValueV2_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_SetValueV2_Result so that it may be reused.
+func (v *KeyValue_SetValueV2_Result) Reset() {
+	*v = KeyValue_SetValueV2_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValueV2_Result.
 func (v *KeyValue_SetValueV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4890,6 +5465,8 @@ func (v *KeyValue_SetValueV2_Result) EnvelopeType() wire.EnvelopeType {
 // KeyValue_Size_Args represents the arguments for the KeyValue.size function.
 //
 // The arguments for size are sent and received over the wire as this struct.
+//
+// Returns the number of keys stored.
 type KeyValue_Size_Args struct {
 }
 
@@ -5023,6 +5600,32 @@ func (v *KeyValue_Size_Args) Equals(rhs *KeyValue_Size_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_Size_Args.
+func (v *KeyValue_Size_Args) Copy() *KeyValue_Size_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Size_Args
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Size_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Size_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Size_Args so that it may be reused.
+func (v *KeyValue_Size_Args) Reset() {
+	*v = KeyValue_Size_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_Size_Args.
 func (v *KeyValue_Size_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -5050,6 +5653,8 @@ func (v *KeyValue_Size_Args) EnvelopeType() wire.EnvelopeType {
 // KeyValue_Size_Helper provides functions that aid in handling the
 // parameters and return values of the KeyValue.size
 // function.
+//
+// Returns the number of keys stored.
 var KeyValue_Size_Helper = struct {
 	// Args accepts the parameters of size in-order and returns
 	// the arguments struct for the function.
@@ -5340,6 +5945,37 @@ func (v *KeyValue_Size_Result) Equals(rhs *KeyValue_Size_Result) bool {
 	return true
 }
 
+// Copy returns a deep copy of this KeyValue_Size_Result.
+func (v *KeyValue_Size_Result) Copy() *KeyValue_Size_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Size_Result
+	o.Success = _I64_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Size_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Size_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Int64(*v.Success)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Size_Result so that it may be reused.
+func (v *KeyValue_Size_Result) Reset() {
+	*v = KeyValue_Size_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_Size_Result.
 func (v *KeyValue_Size_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -5518,6 +6154,32 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Args) Equals(rhs *NonSta
 	return true
 }
 
+// Copy returns a deep copy of this NonStandardServiceName_NonStandardFunctionName_Args.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) Copy() *NonStandardServiceName_NonStandardFunctionName_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o NonStandardServiceName_NonStandardFunctionName_Args
+	return &o
+}
+
+// Hash returns a hash of this NonStandardServiceName_NonStandardFunctionName_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NonStandardServiceName_NonStandardFunctionName_Args so that it may be reused.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) Reset() {
+	*v = NonStandardServiceName_NonStandardFunctionName_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of NonStandardServiceName_NonStandardFunctionName_Args.
 func (v *NonStandardServiceName_NonStandardFunctionName_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -5747,6 +6409,32 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) Equals(rhs *NonS
 	return true
 }
 
+// Copy returns a deep copy of this NonStandardServiceName_NonStandardFunctionName_Result.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) Copy() *NonStandardServiceName_NonStandardFunctionName_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o NonStandardServiceName_NonStandardFunctionName_Result
+	return &o
+}
+
+// Hash returns a hash of this NonStandardServiceName_NonStandardFunctionName_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NonStandardServiceName_NonStandardFunctionName_Result so that it may be reused.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) Reset() {
+	*v = NonStandardServiceName_NonStandardFunctionName_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of NonStandardServiceName_NonStandardFunctionName_Result.
 func (v *NonStandardServiceName_NonStandardFunctionName_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
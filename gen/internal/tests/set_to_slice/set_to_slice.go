@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -94,6 +95,20 @@ func (lhs AnotherStringList) Equals(rhs AnotherStringList) bool {
 	return (MyStringList)(lhs).Equals((MyStringList)(rhs))
 }
 
+// Copy returns a deep copy of this AnotherStringList.
+func (v AnotherStringList) Copy() AnotherStringList {
+	x := (MyStringList)(v)
+	return (AnotherStringList)(x.Copy())
+}
+
+// Hash returns a hash of this AnotherStringList which is stable across
+// processes.
+func (v AnotherStringList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((MyStringList)(v).Hash())
+	return h.Sum64()
+}
+
 func (v AnotherStringList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_sliceType_Zapper)((MyStringList)(v))).MarshalLogArray(enc)
 }
@@ -1253,6 +1268,164 @@ func (v *Bar) Equals(rhs *Bar) bool {
 	return true
 }
 
+func _Set_I32_sliceType_Copy(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_String_sliceType_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_Foo_sliceType_Copy(v []*Foo) []*Foo {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Foo, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Set_Set_String_sliceType_sliceType_Copy(v [][]string) [][]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]string, len(v))
+	for i, x := range v {
+		o[i] = _Set_String_sliceType_Copy(x)
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Bar.
+func (v *Bar) Copy() *Bar {
+	if v == nil {
+		return nil
+	}
+
+	var o Bar
+	o.RequiredInt32ListField = _Set_I32_sliceType_Copy(v.RequiredInt32ListField)
+	o.OptionalStringListField = _Set_String_sliceType_Copy(v.OptionalStringListField)
+	o.RequiredTypedefStringListField = v.RequiredTypedefStringListField.Copy()
+	o.OptionalTypedefStringListField = v.OptionalTypedefStringListField.Copy()
+	o.RequiredFooListField = _Set_Foo_sliceType_Copy(v.RequiredFooListField)
+	o.OptionalFooListField = _Set_Foo_sliceType_Copy(v.OptionalFooListField)
+	o.RequiredTypedefFooListField = v.RequiredTypedefFooListField.Copy()
+	o.OptionalTypedefFooListField = v.OptionalTypedefFooListField.Copy()
+	o.RequiredStringListListField = _Set_Set_String_sliceType_sliceType_Copy(v.RequiredStringListListField)
+	o.RequiredTypedefStringListListField = v.RequiredTypedefStringListListField.Copy()
+	return &o
+}
+
+func _Set_I32_sliceType_Hash(v []int32) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Int32(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_String_sliceType_Hash(v []string) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Foo_sliceType_Hash(v []*Foo) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_Set_String_sliceType_sliceType_Hash(v [][]string) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(_Set_String_sliceType_Hash(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Bar which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Bar) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Set_I32_sliceType_Hash(v.RequiredInt32ListField))
+	h.Field(2)
+	h.Uint64(_Set_String_sliceType_Hash(v.OptionalStringListField))
+	h.Field(3)
+	h.Uint64(v.RequiredTypedefStringListField.Hash())
+	h.Field(4)
+	h.Uint64(v.OptionalTypedefStringListField.Hash())
+	h.Field(5)
+	h.Uint64(_Set_Foo_sliceType_Hash(v.RequiredFooListField))
+	h.Field(6)
+	h.Uint64(_Set_Foo_sliceType_Hash(v.OptionalFooListField))
+	h.Field(7)
+	h.Uint64(v.RequiredTypedefFooListField.Hash())
+	h.Field(8)
+	h.Uint64(v.OptionalTypedefFooListField.Hash())
+	h.Field(9)
+	h.Uint64(_Set_Set_String_sliceType_sliceType_Hash(v.RequiredStringListListField))
+	h.Field(10)
+	h.Uint64(v.RequiredTypedefStringListListField.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Bar so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Bar) Reset() {
+	*v = Bar{
+		RequiredInt32ListField:             v.RequiredInt32ListField[:0],
+		RequiredTypedefStringListField:     v.RequiredTypedefStringListField[:0],
+		RequiredFooListField:               v.RequiredFooListField[:0],
+		RequiredTypedefFooListField:        v.RequiredTypedefFooListField[:0],
+		RequiredStringListListField:        v.RequiredStringListListField[:0],
+		RequiredTypedefStringListListField: v.RequiredTypedefStringListListField[:0],
+	}
+}
+
 type _Set_I32_sliceType_Zapper []int32
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -1642,6 +1815,35 @@ func (v *Foo) Equals(rhs *Foo) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Foo.
+func (v *Foo) Copy() *Foo {
+	if v == nil {
+		return nil
+	}
+
+	var o Foo
+	o.StringField = v.StringField
+	return &o
+}
+
+// Hash returns a hash of this Foo which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Foo) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.StringField)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Foo so that it may be reused.
+func (v *Foo) Reset() {
+	*v = Foo{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Foo.
 func (v *Foo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1705,6 +1907,20 @@ func (lhs FooList) Equals(rhs FooList) bool {
 	return _Set_Foo_sliceType_Equals(([]*Foo)(lhs), ([]*Foo)(rhs))
 }
 
+// Copy returns a deep copy of this FooList.
+func (v FooList) Copy() FooList {
+	x := ([]*Foo)(v)
+	return (FooList)(_Set_Foo_sliceType_Copy(x))
+}
+
+// Hash returns a hash of this FooList which is stable across
+// processes.
+func (v FooList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Set_Foo_sliceType_Hash(([]*Foo)(v)))
+	return h.Sum64()
+}
+
 func (v FooList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Foo_sliceType_Zapper)(([]*Foo)(v))).MarshalLogArray(enc)
 }
@@ -1753,6 +1969,20 @@ func (lhs MyStringList) Equals(rhs MyStringList) bool {
 	return (StringList)(lhs).Equals((StringList)(rhs))
 }
 
+// Copy returns a deep copy of this MyStringList.
+func (v MyStringList) Copy() MyStringList {
+	x := (StringList)(v)
+	return (MyStringList)(x.Copy())
+}
+
+// Hash returns a hash of this MyStringList which is stable across
+// processes.
+func (v MyStringList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((StringList)(v).Hash())
+	return h.Sum64()
+}
+
 func (v MyStringList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_sliceType_Zapper)((StringList)(v))).MarshalLogArray(enc)
 }
@@ -1801,6 +2031,20 @@ func (lhs StringList) Equals(rhs StringList) bool {
 	return _Set_String_sliceType_Equals(([]string)(lhs), ([]string)(rhs))
 }
 
+// Copy returns a deep copy of this StringList.
+func (v StringList) Copy() StringList {
+	x := ([]string)(v)
+	return (StringList)(_Set_String_sliceType_Copy(x))
+}
+
+// Hash returns a hash of this StringList which is stable across
+// processes.
+func (v StringList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Set_String_sliceType_Hash(([]string)(v)))
+	return h.Sum64()
+}
+
 func (v StringList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_sliceType_Zapper)(([]string)(v))).MarshalLogArray(enc)
 }
@@ -1849,6 +2093,20 @@ func (lhs StringListList) Equals(rhs StringListList) bool {
 	return _Set_Set_String_sliceType_sliceType_Equals(([][]string)(lhs), ([][]string)(rhs))
 }
 
+// Copy returns a deep copy of this StringListList.
+func (v StringListList) Copy() StringListList {
+	x := ([][]string)(v)
+	return (StringListList)(_Set_Set_String_sliceType_sliceType_Copy(x))
+}
+
+// Hash returns a hash of this StringListList which is stable across
+// processes.
+func (v StringListList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Set_Set_String_sliceType_sliceType_Hash(([][]string)(v)))
+	return h.Sum64()
+}
+
 func (v StringListList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Set_String_sliceType_sliceType_Zapper)(([][]string)(v))).MarshalLogArray(enc)
 }
@@ -1963,6 +2221,29 @@ func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
 	return true
 }
 
+func _Set_String_mapType_Copy(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Set_String_mapType_Hash(v map[string]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Set_String_mapType_Zapper map[string]struct{}
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -2018,6 +2299,20 @@ func (lhs StringSet) Equals(rhs StringSet) bool {
 	return _Set_String_mapType_Equals((map[string]struct{})(lhs), (map[string]struct{})(rhs))
 }
 
+// Copy returns a deep copy of this StringSet.
+func (v StringSet) Copy() StringSet {
+	x := (map[string]struct{})(v)
+	return (StringSet)(_Set_String_mapType_Copy(x))
+}
+
+// Hash returns a hash of this StringSet which is stable across
+// processes.
+func (v StringSet) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Set_String_mapType_Hash((map[string]struct{})(v)))
+	return h.Sum64()
+}
+
 func (v StringSet) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_mapType_Zapper)((map[string]struct{})(v))).MarshalLogArray(enc)
 }
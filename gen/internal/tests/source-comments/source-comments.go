@@ -0,0 +1,2007 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package source_comments
+
+import (
+	bytes "bytes"
+	context "context"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftrpc "go.uber.org/thriftrw/thriftrpc"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Default port of the key-value service.
+//
+// Source: source-comments.thrift:2
+const DefaultPort int32 = 8080
+
+// Source: source-comments.thrift:4
+type Consistency int32
+
+const (
+	// Reads may return stale values.
+	ConsistencyEventual Consistency = 0
+	ConsistencyStrong   Consistency = 1
+)
+
+// Consistency_Values returns all recognized values of Consistency.
+func Consistency_Values() []Consistency {
+	return []Consistency{
+		ConsistencyEventual,
+		ConsistencyStrong,
+	}
+}
+
+// Consistency_Names returns the names of all recognized values of
+// Consistency, in the same order as Consistency_Values.
+func Consistency_Names() []string {
+	return []string{
+		"EVENTUAL",
+		"STRONG",
+	}
+}
+
+// IsValid returns true if this Consistency is one of its recognized
+// values.
+func (v Consistency) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Consistency from a byte slice
+// containing its name.
+//
+//   var v Consistency
+//   err := v.UnmarshalText([]byte("EVENTUAL"))
+func (v *Consistency) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "EVENTUAL":
+		*v = ConsistencyEventual
+		return nil
+	case "STRONG":
+		*v = ConsistencyStrong
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Consistency", err)
+		}
+		*v = Consistency(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Consistency to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Consistency) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("EVENTUAL"), nil
+	case 1:
+		return []byte("STRONG"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Consistency.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Consistency) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "EVENTUAL")
+	case 1:
+		enc.AddString("name", "STRONG")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Consistency) Ptr() *Consistency {
+	return &v
+}
+
+// Set sets Consistency from its name or integer value.
+//
+// This implements flag.Value, allowing Consistency to be used as a
+// command line flag.
+func (v *Consistency) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Consistency) Type() string {
+	return "Consistency"
+}
+
+// Encode encodes Consistency directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Consistency
+//   return v.Encode(sWriter)
+func (v Consistency) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Consistency into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Consistency) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Consistency from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Consistency(0), err
+//   }
+//
+//   var v Consistency
+//   if err := v.FromWire(x); err != nil {
+//     return Consistency(0), err
+//   }
+//   return v, nil
+func (v *Consistency) FromWire(w wire.Value) error {
+	*v = (Consistency)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Consistency directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Consistency
+//   if err := v.Decode(sReader); err != nil {
+//     return Consistency(0), err
+//   }
+//   return v, nil
+func (v *Consistency) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Consistency)(i)
+	return nil
+}
+
+// String returns a readable string representation of Consistency.
+func (v Consistency) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "EVENTUAL"
+	case 1:
+		return "STRONG"
+	}
+	return fmt.Sprintf("Consistency(%d)", w)
+}
+
+// Equals returns true if this Consistency value matches the provided
+// value.
+func (v Consistency) Equals(rhs Consistency) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Consistency into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Consistency) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"EVENTUAL\""), nil
+	case 1:
+		return ([]byte)("\"STRONG\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Consistency from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Consistency) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Consistency")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Consistency")
+		}
+		*v = (Consistency)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Consistency")
+	}
+}
+
+// Entry is a single key-value pair.
+//
+// Source: source-comments.thrift:16
+type Entry struct {
+	// Key of the entry.
+	Key   Key    `json:"key,required"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// ToWire translates a Entry struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Entry) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.Key.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Value != nil {
+		w, err = wire.NewValueBinary(v.Value), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Key_Read(w wire.Value) (Key, error) {
+	var x Key
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Entry struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Entry struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Entry
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Entry) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = _Key_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Entry is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Entry struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Entry struct could not be encoded.
+func (v *Entry) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.Key.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Value); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Key_Decode(sr stream.Reader) (Key, error) {
+	var x Key
+	err := x.Decode(sr)
+	return x, err
+}
+
+// Decode deserializes a Entry struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Entry struct could not be generated from the wire
+// representation.
+func (v *Entry) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = _Key_Decode(sr)
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of Entry is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Entry
+// struct.
+func (v *Entry) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+		i++
+	}
+
+	return fmt.Sprintf("Entry{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Entry match the
+// provided Entry.
+//
+// This function performs a deep comparison.
+func (v *Entry) Equals(rhs *Entry) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && bytes.Equal(v.Value, rhs.Value))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Entry.
+func (v *Entry) Copy() *Entry {
+	if v == nil {
+		return nil
+	}
+
+	var o Entry
+	o.Key = v.Key
+	o.Value = _Binary_Copy(v.Value)
+	return &o
+}
+
+// Hash returns a hash of this Entry which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Entry) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Key))
+	h.Field(2)
+	h.Binary(v.Value)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Entry so that it may be reused.
+func (v *Entry) Reset() {
+	*v = Entry{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Entry.
+func (v *Entry) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", (string)(v.Key))
+	if v.Value != nil {
+		enc.AddString("value", base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *Entry) GetKey() (o Key) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Entry) GetValue() (o []byte) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *Entry) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// Key identifies an entry.
+//
+// Source: source-comments.thrift:11
+type Key string
+
+// KeyPtr returns a pointer to a Key
+func (v Key) Ptr() *Key {
+	return &v
+}
+
+// ToWire translates Key into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Key) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Key.
+func (v Key) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Key) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Key from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Key) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Key)(x)
+	return err
+}
+
+// Decode deserializes Key directly off the wire.
+func (v *Key) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Key)(x)
+	return err
+}
+
+// Equals returns true if this Key is equal to the provided
+// Key.
+func (lhs Key) Equals(rhs Key) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Key which is stable across
+// processes.
+func (v Key) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+// Source: source-comments.thrift:22
+type KeyNotFound struct {
+	Key Key `json:"key,required"`
+}
+
+// ToWire translates a KeyNotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyNotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.Key.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyNotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyNotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyNotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyNotFound) FromWire(w wire.Value) error {
+	var err error
+
+	keyIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Key, err = _Key_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				keyIsSet = true
+			}
+		}
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of KeyNotFound is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyNotFound struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyNotFound struct could not be encoded.
+func (v *KeyNotFound) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.Key.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyNotFound struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyNotFound struct could not be generated from the wire
+// representation.
+func (v *KeyNotFound) Decode(sr stream.Reader) error {
+
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = _Key_Decode(sr)
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of KeyNotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyNotFound
+// struct.
+func (v *KeyNotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Key: %v", v.Key)
+	i++
+
+	return fmt.Sprintf("KeyNotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*KeyNotFound) ErrorName() string {
+	return "KeyNotFound"
+}
+
+// Equals returns true if all the fields of this KeyNotFound match the
+// provided KeyNotFound.
+//
+// This function performs a deep comparison.
+func (v *KeyNotFound) Equals(rhs *KeyNotFound) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Key == rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyNotFound.
+func (v *KeyNotFound) Copy() *KeyNotFound {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyNotFound
+	o.Key = v.Key
+	return &o
+}
+
+// Hash returns a hash of this KeyNotFound which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyNotFound) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.Key))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyNotFound so that it may be reused.
+func (v *KeyNotFound) Reset() {
+	*v = KeyNotFound{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyNotFound.
+func (v *KeyNotFound) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("key", (string)(v.Key))
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyNotFound) GetKey() (o Key) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+func (v *KeyNotFound) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "source-comments",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/source-comments",
+	FilePath: "source-comments.thrift",
+	SHA1:     "515060aec8ee3ee02ee30ca68ebd5189985a82eb",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "/** Default port of the key-value service. */\nconst i32 defaultPort = 8080\n\nenum Consistency {\n    /** Reads may return stale values. */\n    EVENTUAL,\n    STRONG,\n}\n\n/** Key identifies an entry. */\ntypedef string Key\n\n/**\n * Entry is a single key-value pair.\n */\nstruct Entry {\n    /** Key of the entry. */\n    1: required Key key\n    2: optional binary value\n}\n\nexception KeyNotFound {\n    1: required Key key\n}\n\n/** KeyValue stores entries by key. */\nservice KeyValue {\n    /** Returns the entry for the given key. */\n    Entry get(1: Key key) throws (1: KeyNotFound notFound)\n    oneway void touch(1: Key key)\n}\n"
+
+// KeyValue_Get_Args represents the arguments for the KeyValue.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+//
+// Returns the entry for the given key.
+//
+// Source: source-comments.thrift:29
+type KeyValue_Get_Args struct {
+	Key *Key `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyValue_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = v.Key.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x Key
+				x, err = _Key_Read(field.Value)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Get_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Get_Args struct could not be encoded.
+func (v *KeyValue_Get_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.Key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_Get_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Get_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Get_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x Key
+			x, err = _Key_Decode(sr)
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Get_Args
+// struct.
+func (v *KeyValue_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Key_EqualsPtr(lhs, rhs *Key) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this KeyValue_Get_Args match the
+// provided KeyValue_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Get_Args) Equals(rhs *KeyValue_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Key_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+func _Key_CopyPtr(v *Key) *Key {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this KeyValue_Get_Args.
+func (v *KeyValue_Get_Args) Copy() *KeyValue_Get_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Get_Args
+	o.Key = _Key_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Get_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Get_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(string(*v.Key))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Get_Args so that it may be reused.
+func (v *KeyValue_Get_Args) Reset() {
+	*v = KeyValue_Get_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Get_Args.
+func (v *KeyValue_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", (string)(*v.Key))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Get_Args) GetKey() (o Key) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyValue_Get_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *KeyValue_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *KeyValue_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// KeyValue_Get_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.get
+// function.
+//
+// Returns the entry for the given key.
+//
+// Source: source-comments.thrift:29
+var KeyValue_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *Key,
+	) *KeyValue_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := KeyValue_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Entry, error) (*KeyValue_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := KeyValue_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*KeyValue_Get_Result) (*Entry, error)
+}{}
+
+func init() {
+	KeyValue_Get_Helper.Args = func(
+		key *Key,
+	) *KeyValue_Get_Args {
+		return &KeyValue_Get_Args{
+			Key: key,
+		}
+	}
+
+	KeyValue_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *KeyNotFound:
+			return true
+		default:
+			return false
+		}
+	}
+
+	KeyValue_Get_Helper.WrapResponse = func(success *Entry, err error) (*KeyValue_Get_Result, error) {
+		if err == nil {
+			return &KeyValue_Get_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *KeyNotFound:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for KeyValue_Get_Result.NotFound")
+			}
+			return &KeyValue_Get_Result{NotFound: e}, nil
+		}
+
+		return nil, err
+	}
+	KeyValue_Get_Helper.UnwrapResponse = func(result *KeyValue_Get_Result) (success *Entry, err error) {
+		if result.NotFound != nil {
+			err = result.NotFound
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// KeyValue_Get_Result represents the result of a KeyValue.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+//
+// Source: source-comments.thrift:29
+type KeyValue_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success  *Entry       `json:"success,omitempty"`
+	NotFound *KeyNotFound `json:"notFound,omitempty"`
+}
+
+// ToWire translates a KeyValue_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.NotFound != nil {
+		w, err = v.NotFound.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("KeyValue_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Entry_Read(w wire.Value) (*Entry, error) {
+	var v Entry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _KeyNotFound_Read(w wire.Value) (*KeyNotFound, error) {
+	var v KeyNotFound
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a KeyValue_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Entry_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.NotFound, err = _KeyNotFound_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Get_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Get_Result struct could not be encoded.
+func (v *KeyValue_Get_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NotFound != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NotFound.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Entry_Decode(sr stream.Reader) (*Entry, error) {
+	var v Entry
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _KeyNotFound_Decode(sr stream.Reader) (*KeyNotFound, error) {
+	var v KeyNotFound
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a KeyValue_Get_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Get_Result struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Get_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Entry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.NotFound, err = _KeyNotFound_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.NotFound != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Get_Result
+// struct.
+func (v *KeyValue_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.NotFound != nil {
+		fields[i] = fmt.Sprintf("NotFound: %v", v.NotFound)
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_Get_Result match the
+// provided KeyValue_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Get_Result) Equals(rhs *KeyValue_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.NotFound == nil && rhs.NotFound == nil) || (v.NotFound != nil && rhs.NotFound != nil && v.NotFound.Equals(rhs.NotFound))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_Get_Result.
+func (v *KeyValue_Get_Result) Copy() *KeyValue_Get_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Get_Result
+	o.Success = v.Success.Copy()
+	o.NotFound = v.NotFound.Copy()
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Get_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Get_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.NotFound.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Get_Result so that it may be reused.
+func (v *KeyValue_Get_Result) Reset() {
+	*v = KeyValue_Get_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Get_Result.
+func (v *KeyValue_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.NotFound != nil {
+		err = multierr.Append(err, enc.AddObject("notFound", v.NotFound))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Get_Result) GetSuccess() (o *Entry) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *KeyValue_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetNotFound returns the value of NotFound if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Get_Result) GetNotFound() (o *KeyNotFound) {
+	if v != nil && v.NotFound != nil {
+		return v.NotFound
+	}
+
+	return
+}
+
+// IsSetNotFound returns true if NotFound is not nil.
+func (v *KeyValue_Get_Result) IsSetNotFound() bool {
+	return v != nil && v.NotFound != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *KeyValue_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *KeyValue_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// KeyValue_Touch_Args represents the arguments for the KeyValue.touch function.
+//
+// The arguments for touch are sent and received over the wire as this struct.
+//
+// Source: source-comments.thrift:30
+type KeyValue_Touch_Args struct {
+	Key *Key `json:"key,omitempty"`
+}
+
+// ToWire translates a KeyValue_Touch_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *KeyValue_Touch_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = v.Key.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a KeyValue_Touch_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a KeyValue_Touch_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v KeyValue_Touch_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *KeyValue_Touch_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x Key
+				x, err = _Key_Read(field.Value)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a KeyValue_Touch_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a KeyValue_Touch_Args struct could not be encoded.
+func (v *KeyValue_Touch_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.Key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a KeyValue_Touch_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a KeyValue_Touch_Args struct could not be generated from the wire
+// representation.
+func (v *KeyValue_Touch_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x Key
+			x, err = _Key_Decode(sr)
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a KeyValue_Touch_Args
+// struct.
+func (v *KeyValue_Touch_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("KeyValue_Touch_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this KeyValue_Touch_Args match the
+// provided KeyValue_Touch_Args.
+//
+// This function performs a deep comparison.
+func (v *KeyValue_Touch_Args) Equals(rhs *KeyValue_Touch_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Key_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this KeyValue_Touch_Args.
+func (v *KeyValue_Touch_Args) Copy() *KeyValue_Touch_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o KeyValue_Touch_Args
+	o.Key = _Key_CopyPtr(v.Key)
+	return &o
+}
+
+// Hash returns a hash of this KeyValue_Touch_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *KeyValue_Touch_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Key != nil {
+		h.Field(1)
+		h.String(string(*v.Key))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this KeyValue_Touch_Args so that it may be reused.
+func (v *KeyValue_Touch_Args) Reset() {
+	*v = KeyValue_Touch_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Touch_Args.
+func (v *KeyValue_Touch_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", (string)(*v.Key))
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *KeyValue_Touch_Args) GetKey() (o Key) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *KeyValue_Touch_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "touch" for this struct.
+func (v *KeyValue_Touch_Args) MethodName() string {
+	return "touch"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be OneWay for this struct.
+func (v *KeyValue_Touch_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.OneWay
+}
+
+// KeyValue_Touch_Helper provides functions that aid in handling the
+// parameters and return values of the KeyValue.touch
+// function.
+//
+// Source: source-comments.thrift:30
+var KeyValue_Touch_Helper = struct {
+	// Args accepts the parameters of touch in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		key *Key,
+	) *KeyValue_Touch_Args
+}{}
+
+func init() {
+	KeyValue_Touch_Helper.Args = func(
+		key *Key,
+	) *KeyValue_Touch_Args {
+		return &KeyValue_Touch_Args{
+			Key: key,
+		}
+	}
+
+}
+
+// KeyValue_Interface is implemented by servers of the KeyValue
+// service.
+//
+// KeyValue stores entries by key.
+//
+// Source: source-comments.thrift:27
+type KeyValue_Interface interface {
+
+	// Returns the entry for the given key.
+	//
+	// Source: source-comments.thrift:29
+	Get(ctx context.Context, key *Key) (*Entry, error)
+
+	// Source: source-comments.thrift:30
+	Touch(ctx context.Context, key *Key) error
+}
+
+// KeyValue_Procedures returns a thriftrpc.Procedure for each function
+// of the KeyValue service, including functions inherited from
+// its parent, served by the given implementation.
+func KeyValue_Procedures(impl KeyValue_Interface) []thriftrpc.Procedure {
+	procs := []thriftrpc.Procedure{
+		{
+			Name: "KeyValue::get",
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args KeyValue_Get_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				success, err := impl.Get(ctx, args.Key)
+				result, err := KeyValue_Get_Helper.WrapResponse(success, err)
+				if err != nil {
+					return thriftrpc.Response{}, err
+				}
+
+				return thriftrpc.Response{
+					Body:               result,
+					IsApplicationError: result.NotFound != nil,
+				}, nil
+			},
+		},
+		{
+			Name:   "KeyValue::touch",
+			OneWay: true,
+			Handler: func(ctx context.Context, body wire.Value) (thriftrpc.Response, error) {
+				var args KeyValue_Touch_Args
+				if err := args.FromWire(body); err != nil {
+					return thriftrpc.Response{}, &thriftrpc.ArgumentsError{Err: err}
+				}
+
+				return thriftrpc.Response{}, impl.Touch(ctx, args.Key)
+			},
+		},
+	}
+	return procs
+}
@@ -0,0 +1,1544 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package sql
+
+import (
+	bytes "bytes"
+	driver "database/sql/driver"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+)
+
+type Account struct {
+	ID        UserID     `json:"id,required"`
+	Status    *Status    `json:"status,omitempty"`
+	CreatedAt *Timestamp `json:"createdAt,omitempty"`
+}
+
+// ToWire translates a Account struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Account) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.ID.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.CreatedAt != nil {
+		w, err = v.CreatedAt.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UserID_Read(w wire.Value) (UserID, error) {
+	var x UserID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Status_Read(w wire.Value) (Status, error) {
+	var v Status
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Timestamp_Read(w wire.Value) (Timestamp, error) {
+	var x Timestamp
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Account struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Account struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Account
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Account) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = _UserID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x Status
+				x, err = _Status_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				var x Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.CreatedAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Account is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Account struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Account struct could not be encoded.
+func (v *Account) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.ID.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Status != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Status.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CreatedAt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := v.CreatedAt.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _UserID_Decode(sr stream.Reader) (UserID, error) {
+	var x UserID
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Status_Decode(sr stream.Reader) (Status, error) {
+	var v Status
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Timestamp_Decode(sr stream.Reader) (Timestamp, error) {
+	var x Timestamp
+	err := x.Decode(sr)
+	return x, err
+}
+
+// Decode deserializes a Account struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Account struct could not be generated from the wire
+// representation.
+func (v *Account) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = _UserID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x Status
+			x, err = _Status_Decode(sr)
+			v.Status = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI64:
+			var x Timestamp
+			x, err = _Timestamp_Decode(sr)
+			v.CreatedAt = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Account is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Account
+// struct.
+func (v *Account) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.CreatedAt != nil {
+		fields[i] = fmt.Sprintf("CreatedAt: %v", *(v.CreatedAt))
+		i++
+	}
+
+	return fmt.Sprintf("Account{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Status_EqualsPtr(lhs, rhs *Status) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Timestamp_EqualsPtr(lhs, rhs *Timestamp) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Account match the
+// provided Account.
+//
+// This function performs a deep comparison.
+func (v *Account) Equals(rhs *Account) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_Status_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.CreatedAt, rhs.CreatedAt) {
+		return false
+	}
+
+	return true
+}
+
+func _Status_CopyPtr(v *Status) *Status {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Timestamp_CopyPtr(v *Timestamp) *Timestamp {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Account.
+func (v *Account) Copy() *Account {
+	if v == nil {
+		return nil
+	}
+
+	var o Account
+	o.ID = v.ID
+	o.Status = _Status_CopyPtr(v.Status)
+	o.CreatedAt = _Timestamp_CopyPtr(v.CreatedAt)
+	return &o
+}
+
+// Hash returns a hash of this Account which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Account) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.ID))
+	if v.Status != nil {
+		h.Field(2)
+		h.Int32(int32(*v.Status))
+	}
+	if v.CreatedAt != nil {
+		h.Field(3)
+		h.Int64(int64(*v.CreatedAt))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Account so that it may be reused.
+func (v *Account) Reset() {
+	*v = Account{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Account.
+func (v *Account) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", (string)(v.ID))
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	if v.CreatedAt != nil {
+		enc.AddInt64("createdAt", (int64)(*v.CreatedAt))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Account) GetID() (o UserID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+func (v *Account) GetStatus() (o Status) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *Account) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// GetCreatedAt returns the value of CreatedAt if it is set or its
+// zero value if it is unset.
+func (v *Account) GetCreatedAt() (o Timestamp) {
+	if v != nil && v.CreatedAt != nil {
+		return *v.CreatedAt
+	}
+
+	return
+}
+
+// IsSetCreatedAt returns true if CreatedAt is not nil.
+func (v *Account) IsSetCreatedAt() bool {
+	return v != nil && v.CreatedAt != nil
+}
+
+type Color int32
+
+const (
+	ColorRed   Color = 0
+	ColorGreen Color = 1
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+	}
+}
+
+// Color_Names returns the names of all recognized values of
+// Color, in the same order as Color_Values.
+func Color_Names() []string {
+	return []string{
+		"RED",
+		"GREEN",
+	}
+}
+
+// IsValid returns true if this Color is one of its recognized
+// values.
+func (v Color) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "GREEN":
+		*v = ColorGreen
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Color", err)
+		}
+		*v = Color(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Color to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Color) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("RED"), nil
+	case 1:
+		return []byte("GREEN"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "GREEN")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Color) Ptr() *Color {
+	return &v
+}
+
+// Set sets Color from its name or integer value.
+//
+// This implements flag.Value, allowing Color to be used as a
+// command line flag.
+func (v *Color) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Color) Type() string {
+	return "Color"
+}
+
+// Encode encodes Color directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Color
+//   return v.Encode(sWriter)
+func (v Color) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Color directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Color
+//   if err := v.Decode(sReader); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Color)(i)
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "GREEN"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"GREEN\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+type Note string
+
+// NotePtr returns a pointer to a Note
+func (v Note) Ptr() *Note {
+	return &v
+}
+
+// ToWire translates Note into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Note) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Note.
+func (v Note) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Note) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Note from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Note) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Note)(x)
+	return err
+}
+
+// Decode deserializes Note directly off the wire.
+func (v *Note) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Note)(x)
+	return err
+}
+
+// Equals returns true if this Note is equal to the provided
+// Note.
+func (lhs Note) Equals(rhs Note) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Note which is stable across
+// processes.
+func (v Note) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+type OwnerID UserID
+
+// OwnerIDPtr returns a pointer to a OwnerID
+func (v OwnerID) Ptr() *OwnerID {
+	return &v
+}
+
+// ToWire translates OwnerID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v OwnerID) ToWire() (wire.Value, error) {
+	x := (UserID)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of OwnerID.
+func (v OwnerID) String() string {
+	x := (UserID)(v)
+	return (string)(x)
+}
+
+func (v OwnerID) Encode(sw stream.Writer) error {
+	x := (UserID)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes OwnerID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *OwnerID) FromWire(w wire.Value) error {
+	x, err := _UserID_Read(w)
+	*v = (OwnerID)(x)
+	return err
+}
+
+// Decode deserializes OwnerID directly off the wire.
+func (v *OwnerID) Decode(sr stream.Reader) error {
+	x, err := _UserID_Decode(sr)
+	*v = (OwnerID)(x)
+	return err
+}
+
+// Equals returns true if this OwnerID is equal to the provided
+// OwnerID.
+func (lhs OwnerID) Equals(rhs OwnerID) bool {
+	return ((UserID)(lhs) == (UserID)(rhs))
+}
+
+// Hash returns a hash of this OwnerID which is stable across
+// processes.
+func (v OwnerID) Hash() uint64 {
+	h := thrifthash.New()
+	h.String(string((UserID)(v)))
+	return h.Sum64()
+}
+
+// Scan reads OwnerID from a database column.
+//
+// This implements sql.Scanner.
+func (v *OwnerID) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case string:
+		*v = (OwnerID)(x)
+		return nil
+	case []byte:
+		*v = (OwnerID)(string(x))
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into %q", src, "OwnerID")
+	}
+}
+
+// Value stores OwnerID in a database column.
+//
+// This implements driver.Valuer.
+func (v OwnerID) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+type Priority int32
+
+const (
+	PriorityLow  Priority = 0
+	PriorityHigh Priority = 1
+)
+
+// Priority_Values returns all recognized values of Priority.
+func Priority_Values() []Priority {
+	return []Priority{
+		PriorityLow,
+		PriorityHigh,
+	}
+}
+
+// Priority_Names returns the names of all recognized values of
+// Priority, in the same order as Priority_Values.
+func Priority_Names() []string {
+	return []string{
+		"LOW",
+		"HIGH",
+	}
+}
+
+// IsValid returns true if this Priority is one of its recognized
+// values.
+func (v Priority) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Priority from a byte slice
+// containing its name.
+//
+//   var v Priority
+//   err := v.UnmarshalText([]byte("LOW"))
+func (v *Priority) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "LOW":
+		*v = PriorityLow
+		return nil
+	case "HIGH":
+		*v = PriorityHigh
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Priority", err)
+		}
+		*v = Priority(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Priority to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Priority) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("LOW"), nil
+	case 1:
+		return []byte("HIGH"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Priority.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Priority) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "LOW")
+	case 1:
+		enc.AddString("name", "HIGH")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Priority) Ptr() *Priority {
+	return &v
+}
+
+// Set sets Priority from its name or integer value.
+//
+// This implements flag.Value, allowing Priority to be used as a
+// command line flag.
+func (v *Priority) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Priority) Type() string {
+	return "Priority"
+}
+
+// Encode encodes Priority directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Priority
+//   return v.Encode(sWriter)
+func (v Priority) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Priority into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Priority) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Priority from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Priority(0), err
+//   }
+//
+//   var v Priority
+//   if err := v.FromWire(x); err != nil {
+//     return Priority(0), err
+//   }
+//   return v, nil
+func (v *Priority) FromWire(w wire.Value) error {
+	*v = (Priority)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Priority directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Priority
+//   if err := v.Decode(sReader); err != nil {
+//     return Priority(0), err
+//   }
+//   return v, nil
+func (v *Priority) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Priority)(i)
+	return nil
+}
+
+// String returns a readable string representation of Priority.
+func (v Priority) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "LOW"
+	case 1:
+		return "HIGH"
+	}
+	return fmt.Sprintf("Priority(%d)", w)
+}
+
+// Equals returns true if this Priority value matches the provided
+// value.
+func (v Priority) Equals(rhs Priority) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Priority into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Priority) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"LOW\""), nil
+	case 1:
+		return ([]byte)("\"HIGH\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Priority from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Priority) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Priority")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Priority")
+		}
+		*v = (Priority)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Priority")
+	}
+}
+
+// Scan reads Priority from a database column holding either its
+// integer value or its name.
+//
+// This implements sql.Scanner.
+func (v *Priority) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case int64:
+		if x > math.MaxInt32 || x < math.MinInt32 {
+			return fmt.Errorf("enum overflow from %v for %q", x, "Priority")
+		}
+		*v = (Priority)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(x))
+	case []byte:
+		return v.UnmarshalText(x)
+	default:
+		return fmt.Errorf("cannot scan %T into %q", src, "Priority")
+	}
+}
+
+// Value stores Priority in a database column as its integer value.
+//
+// This implements driver.Valuer.
+func (v Priority) Value() (driver.Value, error) {
+	return int64(v), nil
+}
+
+type Status int32
+
+const (
+	StatusActive    Status = 1
+	StatusSuspended Status = 2
+)
+
+// Status_Values returns all recognized values of Status.
+func Status_Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusSuspended,
+	}
+}
+
+// Status_Names returns the names of all recognized values of
+// Status, in the same order as Status_Values.
+func Status_Names() []string {
+	return []string{
+		"ACTIVE",
+		"SUSPENDED",
+	}
+}
+
+// IsValid returns true if this Status is one of its recognized
+// values.
+func (v Status) IsValid() bool {
+	switch int32(v) {
+	case 1, 2:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Status from a byte slice
+// containing its name.
+//
+//   var v Status
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *Status) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ACTIVE":
+		*v = StatusActive
+		return nil
+	case "SUSPENDED":
+		*v = StatusSuspended
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Status", err)
+		}
+		*v = Status(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Status to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Status) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return []byte("ACTIVE"), nil
+	case 2:
+		return []byte("SUSPENDED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Status.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Status) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "ACTIVE")
+	case 2:
+		enc.AddString("name", "SUSPENDED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Status) Ptr() *Status {
+	return &v
+}
+
+// Set sets Status from its name or integer value.
+//
+// This implements flag.Value, allowing Status to be used as a
+// command line flag.
+func (v *Status) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Status) Type() string {
+	return "Status"
+}
+
+// Encode encodes Status directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Status
+//   return v.Encode(sWriter)
+func (v Status) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Status into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Status) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Status from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Status(0), err
+//   }
+//
+//   var v Status
+//   if err := v.FromWire(x); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) FromWire(w wire.Value) error {
+	*v = (Status)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Status directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Status
+//   if err := v.Decode(sReader); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Status)(i)
+	return nil
+}
+
+// String returns a readable string representation of Status.
+func (v Status) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "ACTIVE"
+	case 2:
+		return "SUSPENDED"
+	}
+	return fmt.Sprintf("Status(%d)", w)
+}
+
+// Equals returns true if this Status value matches the provided
+// value.
+func (v Status) Equals(rhs Status) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Status into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Status) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 2:
+		return ([]byte)("\"SUSPENDED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Status from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Status) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Status")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Status")
+	}
+}
+
+// Scan reads Status from a database column holding either its
+// integer value or its name.
+//
+// This implements sql.Scanner.
+func (v *Status) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case int64:
+		if x > math.MaxInt32 || x < math.MinInt32 {
+			return fmt.Errorf("enum overflow from %v for %q", x, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(x))
+	case []byte:
+		return v.UnmarshalText(x)
+	default:
+		return fmt.Errorf("cannot scan %T into %q", src, "Status")
+	}
+}
+
+// Value stores Status in a database column as its name.
+//
+// This implements driver.Valuer.
+func (v Status) Value() (driver.Value, error) {
+	x, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(x), nil
+}
+
+type Timestamp int64
+
+// TimestampPtr returns a pointer to a Timestamp
+func (v Timestamp) Ptr() *Timestamp {
+	return &v
+}
+
+// ToWire translates Timestamp into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Timestamp) ToWire() (wire.Value, error) {
+	x := (int64)(v)
+	return wire.NewValueI64(x), error(nil)
+}
+
+// String returns a readable string representation of Timestamp.
+func (v Timestamp) String() string {
+	x := (int64)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Timestamp) Encode(sw stream.Writer) error {
+	x := (int64)(v)
+	return sw.WriteInt64(x)
+}
+
+// FromWire deserializes Timestamp from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Timestamp) FromWire(w wire.Value) error {
+	x, err := w.GetI64(), error(nil)
+	*v = (Timestamp)(x)
+	return err
+}
+
+// Decode deserializes Timestamp directly off the wire.
+func (v *Timestamp) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt64()
+	*v = (Timestamp)(x)
+	return err
+}
+
+// Equals returns true if this Timestamp is equal to the provided
+// Timestamp.
+func (lhs Timestamp) Equals(rhs Timestamp) bool {
+	return ((int64)(lhs) == (int64)(rhs))
+}
+
+// Hash returns a hash of this Timestamp which is stable across
+// processes.
+func (v Timestamp) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int64((int64)(v))
+	return h.Sum64()
+}
+
+// Scan reads Timestamp from a database column.
+//
+// This implements sql.Scanner.
+func (v *Timestamp) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case int64:
+		*v = (Timestamp)(x)
+		return nil
+	case string:
+		i, err := strconv.ParseInt(x, 10, 64)
+		if err != nil {
+			return err
+		}
+		*v = (Timestamp)(i)
+		return nil
+	case []byte:
+		i, err := strconv.ParseInt(string(x), 10, 64)
+		if err != nil {
+			return err
+		}
+		*v = (Timestamp)(i)
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into %q", src, "Timestamp")
+	}
+}
+
+// Value stores Timestamp in a database column.
+//
+// This implements driver.Valuer.
+func (v Timestamp) Value() (driver.Value, error) {
+	return int64(v), nil
+}
+
+type UserID string
+
+// UserIDPtr returns a pointer to a UserID
+func (v UserID) Ptr() *UserID {
+	return &v
+}
+
+// ToWire translates UserID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v UserID) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of UserID.
+func (v UserID) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v UserID) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes UserID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *UserID) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (UserID)(x)
+	return err
+}
+
+// Decode deserializes UserID directly off the wire.
+func (v *UserID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (UserID)(x)
+	return err
+}
+
+// Equals returns true if this UserID is equal to the provided
+// UserID.
+func (lhs UserID) Equals(rhs UserID) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this UserID which is stable across
+// processes.
+func (v UserID) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+// Scan reads UserID from a database column.
+//
+// This implements sql.Scanner.
+func (v *UserID) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case string:
+		*v = (UserID)(x)
+		return nil
+	case []byte:
+		*v = (UserID)(string(x))
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into %q", src, "UserID")
+	}
+}
+
+// Value stores UserID in a database column.
+//
+// This implements driver.Valuer.
+func (v UserID) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "sql",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/sql",
+	FilePath: "sql.thrift",
+	SHA1:     "707ea00364e1d5e37cc765f918150054af94f19e",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Status {\n    ACTIVE = 1,\n    SUSPENDED = 2,\n} (go.sql = \"string\")\n\nenum Priority {\n    LOW,\n    HIGH,\n} (go.sql = \"int\")\n\nenum Color {\n    RED,\n    GREEN,\n}\n\ntypedef string UserID (go.sql = \"string\")\n\ntypedef i64 Timestamp (go.sql = \"int\")\n\ntypedef UserID OwnerID (go.sql = \"string\")\n\ntypedef string Note\n\nstruct Account {\n    1: required UserID id\n    2: optional Status status\n    3: optional Timestamp createdAt\n}\n"
@@ -5,6 +5,7 @@ package stringdef
 
 import (
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 )
@@ -57,6 +58,14 @@ func (lhs StringDef) Equals(rhs StringDef) bool {
 	return ((string)(lhs) == (string)(rhs))
 }
 
+// Hash returns a hash of this StringDef which is stable across
+// processes.
+func (v StringDef) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "stringdef",
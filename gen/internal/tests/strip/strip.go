@@ -0,0 +1,3820 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package strip
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type AliasedSecret Secret
+
+// ToWire translates AliasedSecret into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *AliasedSecret) ToWire() (wire.Value, error) {
+	x := (*Secret)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of AliasedSecret.
+func (v *AliasedSecret) String() string {
+	x := (*Secret)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v *AliasedSecret) Encode(sw stream.Writer) error {
+	x := (*Secret)(v)
+	return x.Encode(sw)
+}
+
+// FromWire deserializes AliasedSecret from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *AliasedSecret) FromWire(w wire.Value) error {
+	return (*Secret)(v).FromWire(w)
+}
+
+// Decode deserializes AliasedSecret directly off the wire.
+func (v *AliasedSecret) Decode(sr stream.Reader) error {
+	return (*Secret)(v).Decode(sr)
+}
+
+// Equals returns true if this AliasedSecret is equal to the provided
+// AliasedSecret.
+func (lhs *AliasedSecret) Equals(rhs *AliasedSecret) bool {
+	return (*Secret)(lhs).Equals((*Secret)(rhs))
+}
+
+// Copy returns a deep copy of this AliasedSecret.
+func (v *AliasedSecret) Copy() *AliasedSecret {
+	x := (*Secret)(v)
+	return (*AliasedSecret)(x.Copy())
+}
+
+// Hash returns a hash of this AliasedSecret which is stable across
+// processes.
+func (v *AliasedSecret) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Secret)(v).Hash())
+	return h.Sum64()
+}
+
+func (v *AliasedSecret) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Secret)(v)).MarshalLogObject(enc)
+}
+
+type Node struct {
+	Value string  `json:"value,required"`
+	Tail  *Node   `json:"tail,omitempty"`
+	Debug *string `json:"debug,omitempty"`
+}
+
+// ToWire translates a Node struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Node) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Tail != nil {
+		w, err = v.Tail.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Debug != nil {
+		w, err = wire.NewValueString(*(v.Debug)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Node_Read(w wire.Value) (*Node, error) {
+	var v Node
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Node struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Node struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Node
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Node) FromWire(w wire.Value) error {
+	var err error
+
+	valueIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				valueIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Tail, err = _Node_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Debug = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !valueIsSet {
+		return errors.New("field Value of Node is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Node struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Node struct could not be encoded.
+func (v *Node) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Value); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Tail != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Tail.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Debug != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Debug)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Node_Decode(sr stream.Reader) (*Node, error) {
+	var v Node
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Node struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Node struct could not be generated from the wire
+// representation.
+func (v *Node) Decode(sr stream.Reader) error {
+
+	valueIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			valueIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Tail, err = _Node_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Debug = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !valueIsSet {
+		return errors.New("field Value of Node is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Node
+// struct.
+func (v *Node) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Value: %v", v.Value)
+	i++
+	if v.Tail != nil {
+		fields[i] = fmt.Sprintf("Tail: %v", v.Tail)
+		i++
+	}
+	if v.Debug != nil {
+		fields[i] = fmt.Sprintf("Debug: %v", *(v.Debug))
+		i++
+	}
+
+	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Node match the
+// provided Node.
+//
+// This function performs a deep comparison.
+func (v *Node) Equals(rhs *Node) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Value == rhs.Value) {
+		return false
+	}
+	if !((v.Tail == nil && rhs.Tail == nil) || (v.Tail != nil && rhs.Tail != nil && v.Tail.Equals(rhs.Tail))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Debug, rhs.Debug) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Node.
+func (v *Node) Copy() *Node {
+	if v == nil {
+		return nil
+	}
+
+	var o Node
+	o.Value = v.Value
+	o.Tail = v.Tail.Copy()
+	o.Debug = _String_CopyPtr(v.Debug)
+	return &o
+}
+
+// Hash returns a hash of this Node which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Node) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Value)
+	h.Field(2)
+	h.Uint64(v.Tail.Hash())
+	if v.Debug != nil {
+		h.Field(3)
+		h.String(*v.Debug)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Node so that it may be reused.
+func (v *Node) Reset() {
+	*v = Node{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("value", v.Value)
+	if v.Tail != nil {
+		err = multierr.Append(err, enc.AddObject("tail", v.Tail))
+	}
+	if v.Debug != nil {
+		enc.AddString("debug", *v.Debug)
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *Node) GetValue() (o string) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
+// GetTail returns the value of Tail if it is set or its
+// zero value if it is unset.
+func (v *Node) GetTail() (o *Node) {
+	if v != nil && v.Tail != nil {
+		return v.Tail
+	}
+
+	return
+}
+
+// IsSetTail returns true if Tail is not nil.
+func (v *Node) IsSetTail() bool {
+	return v != nil && v.Tail != nil
+}
+
+// GetDebug returns the value of Debug if it is set or its
+// zero value if it is unset.
+func (v *Node) GetDebug() (o string) {
+	if v != nil && v.Debug != nil {
+		return *v.Debug
+	}
+
+	return
+}
+
+// IsSetDebug returns true if Debug is not nil.
+func (v *Node) IsSetDebug() bool {
+	return v != nil && v.Debug != nil
+}
+
+// StripInternal returns a copy of this Node with its fields
+// annotated with (visibility = "internal") cleared, including those
+// of the structs nested in it. Use it on values leaving internal
+// services.
+func (v *Node) StripInternal() *Node {
+	if v == nil {
+		return nil
+	}
+
+	o := v.Copy()
+	o.Tail = o.Tail.StripInternal()
+	o.Debug = nil
+	return o
+}
+
+type Payload struct {
+	Secret *Secret `json:"secret,omitempty"`
+	Text   *string `json:"text,omitempty"`
+}
+
+// ToWire translates a Payload struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Payload) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Secret != nil {
+		w, err = v.Secret.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Text != nil {
+		w, err = wire.NewValueString(*(v.Text)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Payload should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Secret_Read(w wire.Value) (*Secret, error) {
+	var v Secret
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Payload struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Payload struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Payload
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Payload) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Secret, err = _Secret_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Text = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Secret != nil {
+		count++
+	}
+	if v.Text != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Payload should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Payload struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Payload struct could not be encoded.
+func (v *Payload) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Secret != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Secret.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Text != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Text)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Secret != nil {
+		count++
+	}
+	if v.Text != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Payload should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Secret_Decode(sr stream.Reader) (*Secret, error) {
+	var v Secret
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Payload struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Payload struct could not be generated from the wire
+// representation.
+func (v *Payload) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Secret, err = _Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Text = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Secret != nil {
+		count++
+	}
+	if v.Text != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Payload should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Payload
+// struct.
+func (v *Payload) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Secret != nil {
+		fields[i] = fmt.Sprintf("Secret: %v", v.Secret)
+		i++
+	}
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+
+	return fmt.Sprintf("Payload{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Payload match the
+// provided Payload.
+//
+// This function performs a deep comparison.
+func (v *Payload) Equals(rhs *Payload) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Secret == nil && rhs.Secret == nil) || (v.Secret != nil && rhs.Secret != nil && v.Secret.Equals(rhs.Secret))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Payload.
+func (v *Payload) Copy() *Payload {
+	if v == nil {
+		return nil
+	}
+
+	var o Payload
+	o.Secret = v.Secret.Copy()
+	o.Text = _String_CopyPtr(v.Text)
+	return &o
+}
+
+// Hash returns a hash of this Payload which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Payload) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Secret.Hash())
+	if v.Text != nil {
+		h.Field(2)
+		h.String(*v.Text)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Payload so that it may be reused.
+func (v *Payload) Reset() {
+	*v = Payload{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Payload.
+func (v *Payload) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Secret != nil {
+		err = multierr.Append(err, enc.AddObject("secret", v.Secret))
+	}
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	return err
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *Payload) GetSecret() (o *Secret) {
+	if v != nil && v.Secret != nil {
+		return v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is not nil.
+func (v *Payload) IsSetSecret() bool {
+	return v != nil && v.Secret != nil
+}
+
+// LookupSecret returns the value of Secret and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Payload) LookupSecret() (o *Secret, _ bool) {
+	if v != nil && v.Secret != nil {
+		return v.Secret, true
+	}
+	return o, false
+}
+
+// SetSecret sets the value of Secret and unsets all other
+// fields of this Payload.
+func (v *Payload) SetSecret(x *Secret) {
+	*v = Payload{}
+	v.Secret = x
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+func (v *Payload) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+func (v *Payload) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// LookupText returns the value of Text and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Payload) LookupText() (o string, _ bool) {
+	if v != nil && v.Text != nil {
+		return *v.Text, true
+	}
+	return o, false
+}
+
+// SetText sets the value of Text and unsets all other
+// fields of this Payload.
+func (v *Payload) SetText(x string) {
+	*v = Payload{}
+	v.Text = &x
+}
+
+// Which returns the ID of the field of this Payload which is set, or
+// 0 if no field is set.
+func (v *Payload) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Secret != nil {
+		return 1
+	}
+	if v.Text != nil {
+		return 2
+	}
+	return 0
+}
+
+// Payload_Visitor visits the field of a Payload which is set.
+//
+// Fields added to Payload add methods to Payload_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Payload_Visitor interface {
+	// VisitSecret is called with the value of Secret if it is set.
+	VisitSecret(*Secret) error
+
+	// VisitText is called with the value of Text if it is set.
+	VisitText(string) error
+
+	// Default is called if no field of Payload is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Payload
+// which is set, or Default if none is, and returns its error.
+func (v *Payload) Match(visitor Payload_Visitor) error {
+	if v != nil {
+		if v.Secret != nil {
+			return visitor.VisitSecret(v.Secret)
+		}
+		if v.Text != nil {
+			return visitor.VisitText(*v.Text)
+		}
+	}
+	return visitor.Default()
+}
+
+// StripInternal returns a copy of this Payload with its fields
+// annotated with (visibility = "internal") cleared, including those
+// of the structs nested in it. Use it on values leaving internal
+// services.
+func (v *Payload) StripInternal() *Payload {
+	if v == nil {
+		return nil
+	}
+
+	o := v.Copy()
+	o.Secret = o.Secret.StripInternal()
+	return o
+}
+
+type Plain struct {
+	Name *string  `json:"name,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Plain struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Plain) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Plain struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Plain struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Plain
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Plain) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Plain struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Plain struct could not be encoded.
+func (v *Plain) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Plain struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Plain struct could not be generated from the wire
+// representation.
+func (v *Plain) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Plain
+// struct.
+func (v *Plain) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+
+	return fmt.Sprintf("Plain{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Plain match the
+// provided Plain.
+//
+// This function performs a deep comparison.
+func (v *Plain) Equals(rhs *Plain) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Plain.
+func (v *Plain) Copy() *Plain {
+	if v == nil {
+		return nil
+	}
+
+	var o Plain
+	o.Name = _String_CopyPtr(v.Name)
+	o.Tags = _List_String_Copy(v.Tags)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Plain which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Plain) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	h.Field(2)
+	h.Uint64(_List_String_Hash(v.Tags))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Plain so that it may be reused.
+func (v *Plain) Reset() {
+	*v = Plain{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Plain.
+func (v *Plain) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Plain) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Plain) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Plain) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Plain) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+type Secret struct {
+	Name  *string `json:"name,omitempty"`
+	Token *string `json:"token,omitempty"`
+}
+
+// ToWire translates a Secret struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Secret) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Token != nil {
+		w, err = wire.NewValueString(*(v.Token)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Secret struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Secret struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Secret
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Secret) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Token = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Secret struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Secret struct could not be encoded.
+func (v *Secret) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Token != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Token)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Secret struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Secret struct could not be generated from the wire
+// representation.
+func (v *Secret) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Token = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Secret
+// struct.
+func (v *Secret) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Token != nil {
+		fields[i] = fmt.Sprintf("Token: %v", *(v.Token))
+		i++
+	}
+
+	return fmt.Sprintf("Secret{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Secret match the
+// provided Secret.
+//
+// This function performs a deep comparison.
+func (v *Secret) Equals(rhs *Secret) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Token, rhs.Token) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Secret.
+func (v *Secret) Copy() *Secret {
+	if v == nil {
+		return nil
+	}
+
+	var o Secret
+	o.Name = _String_CopyPtr(v.Name)
+	o.Token = _String_CopyPtr(v.Token)
+	return &o
+}
+
+// Hash returns a hash of this Secret which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Secret) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Name != nil {
+		h.Field(1)
+		h.String(*v.Name)
+	}
+	if v.Token != nil {
+		h.Field(2)
+		h.String(*v.Token)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Secret so that it may be reused.
+func (v *Secret) Reset() {
+	*v = Secret{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Secret.
+func (v *Secret) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Token != nil {
+		enc.AddString("token", *v.Token)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Secret) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Secret) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *Secret) GetToken() (o string) {
+	if v != nil && v.Token != nil {
+		return *v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is not nil.
+func (v *Secret) IsSetToken() bool {
+	return v != nil && v.Token != nil
+}
+
+// StripInternal returns a copy of this Secret with its fields
+// annotated with (visibility = "internal") cleared, including those
+// of the structs nested in it. Use it on values leaving internal
+// services.
+func (v *Secret) StripInternal() *Secret {
+	if v == nil {
+		return nil
+	}
+
+	o := v.Copy()
+	o.Token = nil
+	return o
+}
+
+type _List_Secret_ValueList []*Secret
+
+func (v _List_Secret_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Secret', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Secret_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Secret_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Secret_ValueList) Close() {}
+
+func _List_Secret_Encode(val []*Secret, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Secret
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Secret', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_Secret_Read(l wire.ValueList) ([]*Secret, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Secret, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Secret_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_Secret_Decode(sr stream.Reader) ([]*Secret, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Secret, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Secret_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Secret_Equals(lhs, rhs []*Secret) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Secret_Copy(v []*Secret) []*Secret {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Secret, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _List_Secret_Hash(v []*Secret) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+type _List_Secret_Zapper []*Secret
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Secret_Zapper.
+func (l _List_Secret_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type SecretList []*Secret
+
+// ToWire translates SecretList into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v SecretList) ToWire() (wire.Value, error) {
+	x := ([]*Secret)(v)
+	return wire.NewValueList(_List_Secret_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of SecretList.
+func (v SecretList) String() string {
+	x := ([]*Secret)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v SecretList) Encode(sw stream.Writer) error {
+	x := ([]*Secret)(v)
+	return _List_Secret_Encode(x, sw)
+}
+
+// FromWire deserializes SecretList from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *SecretList) FromWire(w wire.Value) error {
+	x, err := _List_Secret_Read(w.GetList())
+	*v = (SecretList)(x)
+	return err
+}
+
+// Decode deserializes SecretList directly off the wire.
+func (v *SecretList) Decode(sr stream.Reader) error {
+	x, err := _List_Secret_Decode(sr)
+	*v = (SecretList)(x)
+	return err
+}
+
+// Equals returns true if this SecretList is equal to the provided
+// SecretList.
+func (lhs SecretList) Equals(rhs SecretList) bool {
+	return _List_Secret_Equals(([]*Secret)(lhs), ([]*Secret)(rhs))
+}
+
+// Copy returns a deep copy of this SecretList.
+func (v SecretList) Copy() SecretList {
+	x := ([]*Secret)(v)
+	return (SecretList)(_List_Secret_Copy(x))
+}
+
+// Hash returns a hash of this SecretList which is stable across
+// processes.
+func (v SecretList) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_Secret_Hash(([]*Secret)(v)))
+	return h.Sum64()
+}
+
+func (v SecretList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Secret_Zapper)(([]*Secret)(v))).MarshalLogArray(enc)
+}
+
+type User struct {
+	ID            string             `json:"id,required"`
+	Ssn           *string            `json:"ssn,omitempty"`
+	Primary       *Secret            `json:"primary,required"`
+	Secret        *Secret            `json:"secret,omitempty"`
+	Secrets       []*Secret          `json:"secrets,omitempty"`
+	SecretSet     []*Secret          `json:"secretSet,omitempty"`
+	SecretsByName map[string]*Secret `json:"secretsByName,omitempty"`
+	NamesBySecret []struct {
+		Key   *Secret
+		Value string
+	} `json:"namesBySecret,omitempty"`
+	Aliased    *AliasedSecret `json:"aliased,omitempty"`
+	SecretList SecretList     `json:"secretList,omitempty"`
+	Nested     [][]*Secret    `json:"nested,omitempty"`
+	Tags       []string       `json:"tags,omitempty"`
+}
+
+type _Set_Secret_sliceType_ValueList []*Secret
+
+func (v _Set_Secret_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set '*Secret': contains nil value")
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Secret_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Secret_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_Secret_sliceType_ValueList) Close() {}
+
+type _Map_String_Secret_MapItemList map[string]*Secret
+
+func (m _Map_String_Secret_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*Secret', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Secret_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Secret_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Secret_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Secret_MapItemList) Close() {}
+
+type _Map_Secret_String_MapItemList []struct {
+	Key   *Secret
+	Value string
+}
+
+func (m _Map_Secret_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Secret; Value string}': key is nil")
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Secret_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Secret_String_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Secret_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Secret_String_MapItemList) Close() {}
+
+type _List_List_Secret_ValueList [][]*Secret
+
+func (v _List_List_Secret_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[][]*Secret', index [%v]: value is nil", i)
+		}
+		w, err := wire.NewValueList(_List_Secret_ValueList(x)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_List_Secret_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_List_Secret_ValueList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_List_List_Secret_ValueList) Close() {}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [12]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Ssn != nil {
+		w, err = wire.NewValueString(*(v.Ssn)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Primary == nil {
+		return w, errors.New("field Primary of User is required")
+	}
+	w, err = v.Primary.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.Secret != nil {
+		w, err = v.Secret.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Secrets != nil {
+		w, err = wire.NewValueList(_List_Secret_ValueList(v.Secrets)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.SecretSet != nil {
+		w, err = wire.NewValueSet(_Set_Secret_sliceType_ValueList(v.SecretSet)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.SecretsByName != nil {
+		w, err = wire.NewValueMap(_Map_String_Secret_MapItemList(v.SecretsByName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.NamesBySecret != nil {
+		w, err = wire.NewValueMap(_Map_Secret_String_MapItemList(v.NamesBySecret)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Aliased != nil {
+		w, err = v.Aliased.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.SecretList != nil {
+		w, err = v.SecretList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Nested != nil {
+		w, err = wire.NewValueList(_List_List_Secret_ValueList(v.Nested)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Set_Secret_sliceType_Read(s wire.ValueList) ([]*Secret, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Secret, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Secret_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_String_Secret_Read(m wire.MapItemList) (map[string]*Secret, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Secret, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Secret_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_Secret_String_Read(m wire.MapItemList) ([]struct {
+	Key   *Secret
+	Value string
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Secret
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Secret_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Secret
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _AliasedSecret_Read(w wire.Value) (*AliasedSecret, error) {
+	var x AliasedSecret
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _SecretList_Read(w wire.Value) (SecretList, error) {
+	var x SecretList
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_List_Secret_Read(l wire.ValueList) ([][]*Secret, error) {
+	if l.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make([][]*Secret, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _List_Secret_Read(x.GetList())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	primaryIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Ssn = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Primary, err = _Secret_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				primaryIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Secret, err = _Secret_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Secrets, err = _List_Secret_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.SecretSet, err = _Set_Secret_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.SecretsByName, err = _Map_String_Secret_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.NamesBySecret, err = _Map_Secret_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TStruct {
+				v.Aliased, err = _AliasedSecret_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.SecretList, err = _SecretList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TList {
+				v.Nested, err = _List_List_Secret_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of User is required")
+	}
+
+	if !primaryIsSet {
+		return errors.New("field Primary of User is required")
+	}
+
+	return nil
+}
+
+func _Set_Secret_sliceType_Encode(val []*Secret, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid set '*Secret': contains nil value")
+		}
+
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_String_Secret_Encode(val map[string]*Secret, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*Secret', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_Secret_String_Encode(val []struct {
+	Key   *Secret
+	Value string
+}, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TStruct,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		key := v.Key
+		value := v.Value
+
+		if key == nil {
+			return fmt.Errorf("invalid map '[]struct{Key *Secret; Value string}': key is nil")
+		}
+		if err := key.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteString(value); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _List_List_Secret_Encode(val [][]*Secret, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TList,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    [][]*Secret
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[][]*Secret', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := _List_Secret_Encode(v, writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a User struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a User struct could not be encoded.
+func (v *User) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Ssn != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Ssn)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Primary == nil {
+		return errors.New("field Primary of User is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Primary.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Secret != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Secret.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Secrets != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Secret_Encode(v.Secrets, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SecretSet != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_Secret_sliceType_Encode(v.SecretSet, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SecretsByName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Secret_Encode(v.SecretsByName, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NamesBySecret != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Secret_String_Encode(v.NamesBySecret, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Aliased != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Aliased.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SecretList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := v.SecretList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Nested != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_List_Secret_Encode(v.Nested, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Set_Secret_sliceType_Decode(sr stream.Reader) ([]*Secret, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TStruct {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([]*Secret, 0, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Secret_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_Secret_Decode(sr stream.Reader) (map[string]*Secret, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]*Secret, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Secret_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Secret_String_Decode(sr stream.Reader) ([]struct {
+	Key   *Secret
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make([]struct {
+		Key   *Secret
+		Value string
+	}, 0, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Secret_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *Secret
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _AliasedSecret_Decode(sr stream.Reader) (*AliasedSecret, error) {
+	var x AliasedSecret
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _SecretList_Decode(sr stream.Reader) (SecretList, error) {
+	var x SecretList
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_List_Secret_Decode(sr stream.Reader) ([][]*Secret, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TList {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([][]*Secret, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _List_Secret_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a User struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a User struct could not be generated from the wire
+// representation.
+func (v *User) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	primaryIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Ssn = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Primary, err = _Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+			primaryIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.Secret, err = _Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Secrets, err = _List_Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.SecretSet, err = _Set_Secret_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.SecretsByName, err = _Map_String_Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.NamesBySecret, err = _Map_Secret_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TStruct:
+			v.Aliased, err = _AliasedSecret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.SecretList, err = _SecretList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 11 && fh.Type == wire.TList:
+			v.Nested, err = _List_List_Secret_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 12 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of User is required")
+	}
+
+	if !primaryIsSet {
+		return errors.New("field Primary of User is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [12]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Ssn != nil {
+		fields[i] = fmt.Sprintf("Ssn: %v", *(v.Ssn))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Primary: %v", v.Primary)
+	i++
+	if v.Secret != nil {
+		fields[i] = fmt.Sprintf("Secret: %v", v.Secret)
+		i++
+	}
+	if v.Secrets != nil {
+		fields[i] = fmt.Sprintf("Secrets: %v", v.Secrets)
+		i++
+	}
+	if v.SecretSet != nil {
+		fields[i] = fmt.Sprintf("SecretSet: %v", v.SecretSet)
+		i++
+	}
+	if v.SecretsByName != nil {
+		fields[i] = fmt.Sprintf("SecretsByName: %v", v.SecretsByName)
+		i++
+	}
+	if v.NamesBySecret != nil {
+		fields[i] = fmt.Sprintf("NamesBySecret: %v", v.NamesBySecret)
+		i++
+	}
+	if v.Aliased != nil {
+		fields[i] = fmt.Sprintf("Aliased: %v", v.Aliased)
+		i++
+	}
+	if v.SecretList != nil {
+		fields[i] = fmt.Sprintf("SecretList: %v", v.SecretList)
+		i++
+	}
+	if v.Nested != nil {
+		fields[i] = fmt.Sprintf("Nested: %v", v.Nested)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Set_Secret_sliceType_Equals(lhs, rhs []*Secret) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Secret_Equals(lhs, rhs map[string]*Secret) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Secret_String_Equals(lhs, rhs []struct {
+	Key   *Secret
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _List_List_Secret_Equals(lhs, rhs [][]*Secret) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_List_Secret_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Ssn, rhs.Ssn) {
+		return false
+	}
+	if !v.Primary.Equals(rhs.Primary) {
+		return false
+	}
+	if !((v.Secret == nil && rhs.Secret == nil) || (v.Secret != nil && rhs.Secret != nil && v.Secret.Equals(rhs.Secret))) {
+		return false
+	}
+	if !((v.Secrets == nil && rhs.Secrets == nil) || (v.Secrets != nil && rhs.Secrets != nil && _List_Secret_Equals(v.Secrets, rhs.Secrets))) {
+		return false
+	}
+	if !((v.SecretSet == nil && rhs.SecretSet == nil) || (v.SecretSet != nil && rhs.SecretSet != nil && _Set_Secret_sliceType_Equals(v.SecretSet, rhs.SecretSet))) {
+		return false
+	}
+	if !((v.SecretsByName == nil && rhs.SecretsByName == nil) || (v.SecretsByName != nil && rhs.SecretsByName != nil && _Map_String_Secret_Equals(v.SecretsByName, rhs.SecretsByName))) {
+		return false
+	}
+	if !((v.NamesBySecret == nil && rhs.NamesBySecret == nil) || (v.NamesBySecret != nil && rhs.NamesBySecret != nil && _Map_Secret_String_Equals(v.NamesBySecret, rhs.NamesBySecret))) {
+		return false
+	}
+	if !((v.Aliased == nil && rhs.Aliased == nil) || (v.Aliased != nil && rhs.Aliased != nil && v.Aliased.Equals(rhs.Aliased))) {
+		return false
+	}
+	if !((v.SecretList == nil && rhs.SecretList == nil) || (v.SecretList != nil && rhs.SecretList != nil && v.SecretList.Equals(rhs.SecretList))) {
+		return false
+	}
+	if !((v.Nested == nil && rhs.Nested == nil) || (v.Nested != nil && rhs.Nested != nil && _List_List_Secret_Equals(v.Nested, rhs.Nested))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+
+	return true
+}
+
+func _Set_Secret_sliceType_Copy(v []*Secret) []*Secret {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Secret, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_Secret_Copy(v map[string]*Secret) map[string]*Secret {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*Secret, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
+
+func _Map_Secret_String_Copy(v []struct {
+	Key   *Secret
+	Value string
+}) []struct {
+	Key   *Secret
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Secret
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _List_List_Secret_Copy(v [][]*Secret) [][]*Secret {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]*Secret, len(v))
+	for i, x := range v {
+		o[i] = _List_Secret_Copy(x)
+	}
+	return o
+}
+
+// Copy returns a deep copy of this User.
+func (v *User) Copy() *User {
+	if v == nil {
+		return nil
+	}
+
+	var o User
+	o.ID = v.ID
+	o.Ssn = _String_CopyPtr(v.Ssn)
+	o.Primary = v.Primary.Copy()
+	o.Secret = v.Secret.Copy()
+	o.Secrets = _List_Secret_Copy(v.Secrets)
+	o.SecretSet = _Set_Secret_sliceType_Copy(v.SecretSet)
+	o.SecretsByName = _Map_String_Secret_Copy(v.SecretsByName)
+	o.NamesBySecret = _Map_Secret_String_Copy(v.NamesBySecret)
+	o.Aliased = v.Aliased.Copy()
+	o.SecretList = v.SecretList.Copy()
+	o.Nested = _List_List_Secret_Copy(v.Nested)
+	o.Tags = _List_String_Copy(v.Tags)
+	return &o
+}
+
+func _Set_Secret_sliceType_Hash(v []*Secret) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_String_Secret_Hash(v map[string]*Secret) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_Secret_String_Hash(v []struct {
+	Key   *Secret
+	Value string
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.String(x.Value)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _List_List_Secret_Hash(v [][]*Secret) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(_List_Secret_Hash(x))
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this User which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *User) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Ssn != nil {
+		h.Field(2)
+		h.String(*v.Ssn)
+	}
+	h.Field(3)
+	h.Uint64(v.Primary.Hash())
+	h.Field(4)
+	h.Uint64(v.Secret.Hash())
+	h.Field(5)
+	h.Uint64(_List_Secret_Hash(v.Secrets))
+	h.Field(6)
+	h.Uint64(_Set_Secret_sliceType_Hash(v.SecretSet))
+	h.Field(7)
+	h.Uint64(_Map_String_Secret_Hash(v.SecretsByName))
+	h.Field(8)
+	h.Uint64(_Map_Secret_String_Hash(v.NamesBySecret))
+	h.Field(9)
+	h.Uint64(v.Aliased.Hash())
+	h.Field(10)
+	h.Uint64(v.SecretList.Hash())
+	h.Field(11)
+	h.Uint64(_List_List_Secret_Hash(v.Nested))
+	h.Field(12)
+	h.Uint64(_List_String_Hash(v.Tags))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this User so that it may be reused.
+func (v *User) Reset() {
+	*v = User{}
+}
+
+type _Set_Secret_sliceType_Zapper []*Secret
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Secret_sliceType_Zapper.
+func (s _Set_Secret_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_Secret_Zapper map[string]*Secret
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Secret_Zapper.
+func (m _Map_String_Secret_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+type _Map_Secret_String_Item_Zapper struct {
+	Key   *Secret
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Secret_String_Item_Zapper.
+func (v _Map_Secret_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_Secret_String_Zapper []struct {
+	Key   *Secret
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Secret_String_Zapper.
+func (m _Map_Secret_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Secret_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _List_List_Secret_Zapper [][]*Secret
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_List_Secret_Zapper.
+func (l _List_List_Secret_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendArray((_List_Secret_Zapper)(v)))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Ssn != nil {
+		enc.AddString("ssn", *v.Ssn)
+	}
+	err = multierr.Append(err, enc.AddObject("primary", v.Primary))
+	if v.Secret != nil {
+		err = multierr.Append(err, enc.AddObject("secret", v.Secret))
+	}
+	if v.Secrets != nil {
+		err = multierr.Append(err, enc.AddArray("secrets", (_List_Secret_Zapper)(v.Secrets)))
+	}
+	if v.SecretSet != nil {
+		err = multierr.Append(err, enc.AddArray("secretSet", (_Set_Secret_sliceType_Zapper)(v.SecretSet)))
+	}
+	if v.SecretsByName != nil {
+		err = multierr.Append(err, enc.AddObject("secretsByName", (_Map_String_Secret_Zapper)(v.SecretsByName)))
+	}
+	if v.NamesBySecret != nil {
+		err = multierr.Append(err, enc.AddArray("namesBySecret", (_Map_Secret_String_Zapper)(v.NamesBySecret)))
+	}
+	if v.Aliased != nil {
+		err = multierr.Append(err, enc.AddObject("aliased", v.Aliased))
+	}
+	if v.SecretList != nil {
+		err = multierr.Append(err, enc.AddArray("secretList", (_List_Secret_Zapper)(v.SecretList)))
+	}
+	if v.Nested != nil {
+		err = multierr.Append(err, enc.AddArray("nested", (_List_List_Secret_Zapper)(v.Nested)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *User) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetSsn returns the value of Ssn if it is set or its
+// zero value if it is unset.
+func (v *User) GetSsn() (o string) {
+	if v != nil && v.Ssn != nil {
+		return *v.Ssn
+	}
+
+	return
+}
+
+// IsSetSsn returns true if Ssn is not nil.
+func (v *User) IsSetSsn() bool {
+	return v != nil && v.Ssn != nil
+}
+
+// GetPrimary returns the value of Primary if it is set or its
+// zero value if it is unset.
+func (v *User) GetPrimary() (o *Secret) {
+	if v != nil {
+		o = v.Primary
+	}
+	return
+}
+
+// IsSetPrimary returns true if Primary is not nil.
+func (v *User) IsSetPrimary() bool {
+	return v != nil && v.Primary != nil
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *User) GetSecret() (o *Secret) {
+	if v != nil && v.Secret != nil {
+		return v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is not nil.
+func (v *User) IsSetSecret() bool {
+	return v != nil && v.Secret != nil
+}
+
+// GetSecrets returns the value of Secrets if it is set or its
+// zero value if it is unset.
+func (v *User) GetSecrets() (o []*Secret) {
+	if v != nil && v.Secrets != nil {
+		return v.Secrets
+	}
+
+	return
+}
+
+// IsSetSecrets returns true if Secrets is not nil.
+func (v *User) IsSetSecrets() bool {
+	return v != nil && v.Secrets != nil
+}
+
+// GetSecretSet returns the value of SecretSet if it is set or its
+// zero value if it is unset.
+func (v *User) GetSecretSet() (o []*Secret) {
+	if v != nil && v.SecretSet != nil {
+		return v.SecretSet
+	}
+
+	return
+}
+
+// IsSetSecretSet returns true if SecretSet is not nil.
+func (v *User) IsSetSecretSet() bool {
+	return v != nil && v.SecretSet != nil
+}
+
+// GetSecretsByName returns the value of SecretsByName if it is set or its
+// zero value if it is unset.
+func (v *User) GetSecretsByName() (o map[string]*Secret) {
+	if v != nil && v.SecretsByName != nil {
+		return v.SecretsByName
+	}
+
+	return
+}
+
+// IsSetSecretsByName returns true if SecretsByName is not nil.
+func (v *User) IsSetSecretsByName() bool {
+	return v != nil && v.SecretsByName != nil
+}
+
+// GetNamesBySecret returns the value of NamesBySecret if it is set or its
+// zero value if it is unset.
+func (v *User) GetNamesBySecret() (o []struct {
+	Key   *Secret
+	Value string
+}) {
+	if v != nil && v.NamesBySecret != nil {
+		return v.NamesBySecret
+	}
+
+	return
+}
+
+// IsSetNamesBySecret returns true if NamesBySecret is not nil.
+func (v *User) IsSetNamesBySecret() bool {
+	return v != nil && v.NamesBySecret != nil
+}
+
+// GetAliased returns the value of Aliased if it is set or its
+// zero value if it is unset.
+func (v *User) GetAliased() (o *AliasedSecret) {
+	if v != nil && v.Aliased != nil {
+		return v.Aliased
+	}
+
+	return
+}
+
+// IsSetAliased returns true if Aliased is not nil.
+func (v *User) IsSetAliased() bool {
+	return v != nil && v.Aliased != nil
+}
+
+// GetSecretList returns the value of SecretList if it is set or its
+// zero value if it is unset.
+func (v *User) GetSecretList() (o SecretList) {
+	if v != nil && v.SecretList != nil {
+		return v.SecretList
+	}
+
+	return
+}
+
+// IsSetSecretList returns true if SecretList is not nil.
+func (v *User) IsSetSecretList() bool {
+	return v != nil && v.SecretList != nil
+}
+
+// GetNested returns the value of Nested if it is set or its
+// zero value if it is unset.
+func (v *User) GetNested() (o [][]*Secret) {
+	if v != nil && v.Nested != nil {
+		return v.Nested
+	}
+
+	return
+}
+
+// IsSetNested returns true if Nested is not nil.
+func (v *User) IsSetNested() bool {
+	return v != nil && v.Nested != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *User) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *User) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// StripInternal returns a copy of this User with its fields
+// annotated with (visibility = "internal") cleared, including those
+// of the structs nested in it. Use it on values leaving internal
+// services.
+func (v *User) StripInternal() *User {
+	if v == nil {
+		return nil
+	}
+
+	o := v.Copy()
+	o.Ssn = nil
+	o.Primary = o.Primary.StripInternal()
+	o.Secret = o.Secret.StripInternal()
+	for i0 := range o.Secrets {
+		o.Secrets[i0] = o.Secrets[i0].StripInternal()
+	}
+	for i0 := range o.SecretSet {
+		o.SecretSet[i0] = o.SecretSet[i0].StripInternal()
+	}
+	for i0 := range o.SecretsByName {
+		o.SecretsByName[i0] = o.SecretsByName[i0].StripInternal()
+	}
+	for i0 := range o.NamesBySecret {
+		o.NamesBySecret[i0].Key = o.NamesBySecret[i0].Key.StripInternal()
+	}
+	o.Aliased = (*AliasedSecret)((*Secret)(o.Aliased).StripInternal())
+	for i0 := range o.SecretList {
+		o.SecretList[i0] = o.SecretList[i0].StripInternal()
+	}
+	for i0 := range o.Nested {
+		for i1 := range o.Nested[i0] {
+			o.Nested[i0][i1] = o.Nested[i0][i1].StripInternal()
+		}
+	}
+	return o
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "strip",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/strip",
+	FilePath: "strip.thrift",
+	SHA1:     "2cc4b00815d0d6682c1844e4c7199b7cb4934317",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Secret {\n    1: optional string name\n    2: optional string token (visibility = \"internal\")\n}\n\ntypedef Secret AliasedSecret\ntypedef list<Secret> SecretList\n\nstruct User {\n    1: required string id\n    2: optional string ssn (visibility = \"internal\")\n    3: required Secret primary\n    4: optional Secret secret\n    5: optional list<Secret> secrets\n    6: optional set<Secret> secretSet\n    7: optional map<string, Secret> secretsByName\n    8: optional map<Secret, string> namesBySecret\n    9: optional AliasedSecret aliased\n    10: optional SecretList secretList\n    11: optional list<list<Secret>> nested\n    12: optional list<string> tags\n}\n\nunion Payload {\n    1: Secret secret\n    2: string text\n}\n\nstruct Node {\n    1: required string value\n    2: optional Node tail\n    3: optional string debug (visibility = \"internal\")\n}\n\nstruct Plain {\n    1: optional string name\n    2: optional list<string> tags\n}\n"
@@ -13,6 +13,7 @@ import (
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
 	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -206,6 +207,35 @@ func (v *ContactInfo) Equals(rhs *ContactInfo) bool {
 	return true
 }
 
+// Copy returns a deep copy of this ContactInfo.
+func (v *ContactInfo) Copy() *ContactInfo {
+	if v == nil {
+		return nil
+	}
+
+	var o ContactInfo
+	o.EmailAddress = v.EmailAddress
+	return &o
+}
+
+// Hash returns a hash of this ContactInfo which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ContactInfo) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.EmailAddress)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ContactInfo so that it may be reused.
+func (v *ContactInfo) Reset() {
+	*v = ContactInfo{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ContactInfo.
 func (v *ContactInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1612,6 +1642,155 @@ func (v *DefaultsStruct) Equals(rhs *DefaultsStruct) bool {
 	return true
 }
 
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _EnumDefault_CopyPtr(v *enums.EnumDefault) *enums.EnumDefault {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Double_Copy(v []float64) []float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]float64, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this DefaultsStruct.
+func (v *DefaultsStruct) Copy() *DefaultsStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o DefaultsStruct
+	o.RequiredPrimitive = _I32_CopyPtr(v.RequiredPrimitive)
+	o.OptionalPrimitive = _I32_CopyPtr(v.OptionalPrimitive)
+	o.RequiredEnum = _EnumDefault_CopyPtr(v.RequiredEnum)
+	o.OptionalEnum = _EnumDefault_CopyPtr(v.OptionalEnum)
+	o.RequiredList = _List_String_Copy(v.RequiredList)
+	o.OptionalList = _List_Double_Copy(v.OptionalList)
+	o.RequiredStruct = v.RequiredStruct.Copy()
+	o.OptionalStruct = v.OptionalStruct.Copy()
+	o.RequiredBoolDefaultTrue = _Bool_CopyPtr(v.RequiredBoolDefaultTrue)
+	o.OptionalBoolDefaultTrue = _Bool_CopyPtr(v.OptionalBoolDefaultTrue)
+	o.RequiredBoolDefaultFalse = _Bool_CopyPtr(v.RequiredBoolDefaultFalse)
+	o.OptionalBoolDefaultFalse = _Bool_CopyPtr(v.OptionalBoolDefaultFalse)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _List_Double_Hash(v []float64) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Double(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this DefaultsStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DefaultsStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.RequiredPrimitive != nil {
+		h.Field(1)
+		h.Int32(*v.RequiredPrimitive)
+	}
+	if v.OptionalPrimitive != nil {
+		h.Field(2)
+		h.Int32(*v.OptionalPrimitive)
+	}
+	if v.RequiredEnum != nil {
+		h.Field(3)
+		h.Int32(int32(*v.RequiredEnum))
+	}
+	if v.OptionalEnum != nil {
+		h.Field(4)
+		h.Int32(int32(*v.OptionalEnum))
+	}
+	h.Field(5)
+	h.Uint64(_List_String_Hash(v.RequiredList))
+	h.Field(6)
+	h.Uint64(_List_Double_Hash(v.OptionalList))
+	h.Field(7)
+	h.Uint64(v.RequiredStruct.Hash())
+	h.Field(8)
+	h.Uint64(v.OptionalStruct.Hash())
+	if v.RequiredBoolDefaultTrue != nil {
+		h.Field(9)
+		h.Bool(*v.RequiredBoolDefaultTrue)
+	}
+	if v.OptionalBoolDefaultTrue != nil {
+		h.Field(10)
+		h.Bool(*v.OptionalBoolDefaultTrue)
+	}
+	if v.RequiredBoolDefaultFalse != nil {
+		h.Field(11)
+		h.Bool(*v.RequiredBoolDefaultFalse)
+	}
+	if v.OptionalBoolDefaultFalse != nil {
+		h.Field(12)
+		h.Bool(*v.OptionalBoolDefaultFalse)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DefaultsStruct so that it may be reused.
+func (v *DefaultsStruct) Reset() {
+	*v = DefaultsStruct{}
+}
+
 type _List_String_Zapper []string
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -2139,6 +2318,38 @@ func (v *Edge) Equals(rhs *Edge) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Edge.
+func (v *Edge) Copy() *Edge {
+	if v == nil {
+		return nil
+	}
+
+	var o Edge
+	o.StartPoint = v.StartPoint.Copy()
+	o.EndPoint = v.EndPoint.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Edge which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Edge) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.StartPoint.Hash())
+	h.Field(2)
+	h.Uint64(v.EndPoint.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Edge so that it may be reused.
+func (v *Edge) Reset() {
+	*v = Edge{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Edge.
 func (v *Edge) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2311,6 +2522,32 @@ func (v *EmptyStruct) Equals(rhs *EmptyStruct) bool {
 	return true
 }
 
+// Copy returns a deep copy of this EmptyStruct.
+func (v *EmptyStruct) Copy() *EmptyStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o EmptyStruct
+	return &o
+}
+
+// Hash returns a hash of this EmptyStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *EmptyStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this EmptyStruct so that it may be reused.
+func (v *EmptyStruct) Reset() {
+	*v = EmptyStruct{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of EmptyStruct.
 func (v *EmptyStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2575,6 +2812,38 @@ func (v *Frame) Equals(rhs *Frame) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Frame.
+func (v *Frame) Copy() *Frame {
+	if v == nil {
+		return nil
+	}
+
+	var o Frame
+	o.TopLeft = v.TopLeft.Copy()
+	o.Size = v.Size.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Frame which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Frame) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.TopLeft.Hash())
+	h.Field(2)
+	h.Uint64(v.Size.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Frame so that it may be reused.
+func (v *Frame) Reset() {
+	*v = Frame{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Frame.
 func (v *Frame) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3046,6 +3315,63 @@ func (v *GoTags) Equals(rhs *GoTags) bool {
 	return true
 }
 
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this GoTags.
+func (v *GoTags) Copy() *GoTags {
+	if v == nil {
+		return nil
+	}
+
+	var o GoTags
+	o.Foo = v.Foo
+	o.Bar = _String_CopyPtr(v.Bar)
+	o.FooBar = v.FooBar
+	o.FooBarWithSpace = v.FooBarWithSpace
+	o.FooBarWithOmitEmpty = _String_CopyPtr(v.FooBarWithOmitEmpty)
+	o.FooBarWithRequired = v.FooBarWithRequired
+	return &o
+}
+
+// Hash returns a hash of this GoTags which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *GoTags) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Foo)
+	if v.Bar != nil {
+		h.Field(2)
+		h.String(*v.Bar)
+	}
+	h.Field(3)
+	h.String(v.FooBar)
+	h.Field(4)
+	h.String(v.FooBarWithSpace)
+	if v.FooBarWithOmitEmpty != nil {
+		h.Field(5)
+		h.String(*v.FooBarWithOmitEmpty)
+	}
+	h.Field(6)
+	h.String(v.FooBarWithRequired)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this GoTags so that it may be reused.
+func (v *GoTags) Reset() {
+	*v = GoTags{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of GoTags.
 func (v *GoTags) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3481,6 +3807,62 @@ func (v *Graph) Equals(rhs *Graph) bool {
 	return true
 }
 
+func _List_Edge_Copy(v []*Edge) []*Edge {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Edge, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Graph.
+func (v *Graph) Copy() *Graph {
+	if v == nil {
+		return nil
+	}
+
+	var o Graph
+	o.Edges = _List_Edge_Copy(v.Edges)
+	return &o
+}
+
+func _List_Edge_Hash(v []*Edge) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Graph which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Graph) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Edge_Hash(v.Edges))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Graph so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Graph) Reset() {
+	*v = Graph{
+		Edges: v.Edges[:0],
+	}
+}
+
 type _List_Edge_Zapper []*Edge
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -3556,6 +3938,20 @@ func (lhs *List) Equals(rhs *List) bool {
 	return (*Node)(lhs).Equals((*Node)(rhs))
 }
 
+// Copy returns a deep copy of this List.
+func (v *List) Copy() *List {
+	x := (*Node)(v)
+	return (*List)(x.Copy())
+}
+
+// Hash returns a hash of this List which is stable across
+// processes.
+func (v *List) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*Node)(v).Hash())
+	return h.Sum64()
+}
+
 func (v *List) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((*Node)(v)).MarshalLogObject(enc)
 }
@@ -3801,6 +4197,38 @@ func (v *Node) Equals(rhs *Node) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Node.
+func (v *Node) Copy() *Node {
+	if v == nil {
+		return nil
+	}
+
+	var o Node
+	o.Value = v.Value
+	o.Tail = v.Tail.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Node which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Node) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(v.Value)
+	h.Field(2)
+	h.Uint64(v.Tail.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Node so that it may be reused.
+func (v *Node) Reset() {
+	*v = Node{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Node.
 func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4470,6 +4898,88 @@ func (v *NotOmitEmpty) Equals(rhs *NotOmitEmpty) bool {
 	return true
 }
 
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this NotOmitEmpty.
+func (v *NotOmitEmpty) Copy() *NotOmitEmpty {
+	if v == nil {
+		return nil
+	}
+
+	var o NotOmitEmpty
+	o.NotOmitEmptyString = _String_CopyPtr(v.NotOmitEmptyString)
+	o.NotOmitEmptyInt = _String_CopyPtr(v.NotOmitEmptyInt)
+	o.NotOmitEmptyBool = _String_CopyPtr(v.NotOmitEmptyBool)
+	o.NotOmitEmptyList = _List_String_Copy(v.NotOmitEmptyList)
+	o.NotOmitEmptyMap = _Map_String_String_Copy(v.NotOmitEmptyMap)
+	o.NotOmitEmptyListMixedWithOmitEmpty = _List_String_Copy(v.NotOmitEmptyListMixedWithOmitEmpty)
+	o.NotOmitEmptyListMixedWithOmitEmptyV2 = _List_String_Copy(v.NotOmitEmptyListMixedWithOmitEmptyV2)
+	o.OmitEmptyString = _String_CopyPtr(v.OmitEmptyString)
+	return &o
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this NotOmitEmpty which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NotOmitEmpty) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.NotOmitEmptyString != nil {
+		h.Field(1)
+		h.String(*v.NotOmitEmptyString)
+	}
+	if v.NotOmitEmptyInt != nil {
+		h.Field(2)
+		h.String(*v.NotOmitEmptyInt)
+	}
+	if v.NotOmitEmptyBool != nil {
+		h.Field(3)
+		h.String(*v.NotOmitEmptyBool)
+	}
+	h.Field(4)
+	h.Uint64(_List_String_Hash(v.NotOmitEmptyList))
+	h.Field(5)
+	h.Uint64(_Map_String_String_Hash(v.NotOmitEmptyMap))
+	h.Field(6)
+	h.Uint64(_List_String_Hash(v.NotOmitEmptyListMixedWithOmitEmpty))
+	h.Field(7)
+	h.Uint64(_List_String_Hash(v.NotOmitEmptyListMixedWithOmitEmptyV2))
+	if v.OmitEmptyString != nil {
+		h.Field(8)
+		h.String(*v.OmitEmptyString)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NotOmitEmpty so that it may be reused.
+func (v *NotOmitEmpty) Reset() {
+	*v = NotOmitEmpty{}
+}
+
 type _Map_String_String_Zapper map[string]string
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
@@ -4866,6 +5376,38 @@ func (v *Omit) Equals(rhs *Omit) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Omit.
+func (v *Omit) Copy() *Omit {
+	if v == nil {
+		return nil
+	}
+
+	var o Omit
+	o.Serialized = v.Serialized
+	o.Hidden = v.Hidden
+	return &o
+}
+
+// Hash returns a hash of this Omit which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Omit) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Serialized)
+	h.Field(2)
+	h.String(v.Hidden)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Omit so that it may be reused.
+func (v *Omit) Reset() {
+	*v = Omit{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Omit.
 func (v *Omit) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -5078,6 +5620,37 @@ func (v *PersonalInfo) Equals(rhs *PersonalInfo) bool {
 	return true
 }
 
+// Copy returns a deep copy of this PersonalInfo.
+func (v *PersonalInfo) Copy() *PersonalInfo {
+	if v == nil {
+		return nil
+	}
+
+	var o PersonalInfo
+	o.Age = _I32_CopyPtr(v.Age)
+	return &o
+}
+
+// Hash returns a hash of this PersonalInfo which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PersonalInfo) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Age != nil {
+		h.Field(1)
+		h.Int32(*v.Age)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PersonalInfo so that it may be reused.
+func (v *PersonalInfo) Reset() {
+	*v = PersonalInfo{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of PersonalInfo.
 func (v *PersonalInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -5338,6 +5911,38 @@ func (v *Point) Equals(rhs *Point) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Point.
 func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -5911,6 +6516,116 @@ func (v *PrimitiveOptionalStruct) Equals(rhs *PrimitiveOptionalStruct) bool {
 	return true
 }
 
+func _Byte_CopyPtr(v *int8) *int8 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I16_CopyPtr(v *int16) *int16 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) Copy() *PrimitiveOptionalStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o PrimitiveOptionalStruct
+	o.BoolField = _Bool_CopyPtr(v.BoolField)
+	o.ByteField = _Byte_CopyPtr(v.ByteField)
+	o.Int16Field = _I16_CopyPtr(v.Int16Field)
+	o.Int32Field = _I32_CopyPtr(v.Int32Field)
+	o.Int64Field = _I64_CopyPtr(v.Int64Field)
+	o.DoubleField = _Double_CopyPtr(v.DoubleField)
+	o.StringField = _String_CopyPtr(v.StringField)
+	o.BinaryField = _Binary_Copy(v.BinaryField)
+	return &o
+}
+
+// Hash returns a hash of this PrimitiveOptionalStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PrimitiveOptionalStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.BoolField != nil {
+		h.Field(1)
+		h.Bool(*v.BoolField)
+	}
+	if v.ByteField != nil {
+		h.Field(2)
+		h.Int8(*v.ByteField)
+	}
+	if v.Int16Field != nil {
+		h.Field(3)
+		h.Int16(*v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		h.Field(4)
+		h.Int32(*v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		h.Field(5)
+		h.Int64(*v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		h.Field(6)
+		h.Double(*v.DoubleField)
+	}
+	if v.StringField != nil {
+		h.Field(7)
+		h.String(*v.StringField)
+	}
+	h.Field(8)
+	h.Binary(v.BinaryField)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PrimitiveOptionalStruct so that it may be reused.
+func (v *PrimitiveOptionalStruct) Reset() {
+	*v = PrimitiveOptionalStruct{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6586,6 +7301,61 @@ func (v *PrimitiveRequiredStruct) Equals(rhs *PrimitiveRequiredStruct) bool {
 	return true
 }
 
+// Copy returns a deep copy of this PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) Copy() *PrimitiveRequiredStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o PrimitiveRequiredStruct
+	o.BoolField = v.BoolField
+	o.ByteField = v.ByteField
+	o.Int16Field = v.Int16Field
+	o.Int32Field = v.Int32Field
+	o.Int64Field = v.Int64Field
+	o.DoubleField = v.DoubleField
+	o.StringField = v.StringField
+	o.BinaryField = _Binary_Copy(v.BinaryField)
+	return &o
+}
+
+// Hash returns a hash of this PrimitiveRequiredStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *PrimitiveRequiredStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Bool(v.BoolField)
+	h.Field(2)
+	h.Int8(v.ByteField)
+	h.Field(3)
+	h.Int16(v.Int16Field)
+	h.Field(4)
+	h.Int32(v.Int32Field)
+	h.Field(5)
+	h.Int64(v.Int64Field)
+	h.Field(6)
+	h.Double(v.DoubleField)
+	h.Field(7)
+	h.String(v.StringField)
+	h.Field(8)
+	h.Binary(v.BinaryField)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this PrimitiveRequiredStruct so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *PrimitiveRequiredStruct) Reset() {
+	*v = PrimitiveRequiredStruct{
+		BinaryField: v.BinaryField[:0],
+	}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of PrimitiveRequiredStruct.
 func (v *PrimitiveRequiredStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6912,6 +7682,38 @@ func (v *Rename) Equals(rhs *Rename) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Rename.
+func (v *Rename) Copy() *Rename {
+	if v == nil {
+		return nil
+	}
+
+	var o Rename
+	o.Default = v.Default
+	o.CamelCase = v.CamelCase
+	return &o
+}
+
+// Hash returns a hash of this Rename which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Rename) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Default)
+	h.Field(2)
+	h.String(v.CamelCase)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Rename so that it may be reused.
+func (v *Rename) Reset() {
+	*v = Rename{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Rename.
 func (v *Rename) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -7176,6 +7978,38 @@ func (v *Size) Equals(rhs *Size) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Size.
+func (v *Size) Copy() *Size {
+	if v == nil {
+		return nil
+	}
+
+	var o Size
+	o.Width = v.Width
+	o.Height = v.Height
+	return &o
+}
+
+// Hash returns a hash of this Size which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Size) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.Width)
+	h.Field(2)
+	h.Double(v.Height)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Size so that it may be reused.
+func (v *Size) Reset() {
+	*v = Size{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Size.
 func (v *Size) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -7526,6 +8360,52 @@ func (v *StructLabels) Equals(rhs *StructLabels) bool {
 	return true
 }
 
+// Copy returns a deep copy of this StructLabels.
+func (v *StructLabels) Copy() *StructLabels {
+	if v == nil {
+		return nil
+	}
+
+	var o StructLabels
+	o.IsRequired = _Bool_CopyPtr(v.IsRequired)
+	o.Foo = _String_CopyPtr(v.Foo)
+	o.Qux = _String_CopyPtr(v.Qux)
+	o.Quux = _String_CopyPtr(v.Quux)
+	return &o
+}
+
+// Hash returns a hash of this StructLabels which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *StructLabels) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.IsRequired != nil {
+		h.Field(1)
+		h.Bool(*v.IsRequired)
+	}
+	if v.Foo != nil {
+		h.Field(2)
+		h.String(*v.Foo)
+	}
+	if v.Qux != nil {
+		h.Field(3)
+		h.String(*v.Qux)
+	}
+	if v.Quux != nil {
+		h.Field(4)
+		h.String(*v.Quux)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this StructLabels so that it may be reused.
+func (v *StructLabels) Reset() {
+	*v = StructLabels{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructLabels.
 func (v *StructLabels) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -7900,6 +8780,41 @@ func (v *User) Equals(rhs *User) bool {
 	return true
 }
 
+// Copy returns a deep copy of this User.
+func (v *User) Copy() *User {
+	if v == nil {
+		return nil
+	}
+
+	var o User
+	o.Name = v.Name
+	o.Contact = v.Contact.Copy()
+	o.Personal = v.Personal.Copy()
+	return &o
+}
+
+// Hash returns a hash of this User which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *User) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(v.Contact.Hash())
+	h.Field(3)
+	h.Uint64(v.Personal.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this User so that it may be reused.
+func (v *User) Reset() {
+	*v = User{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of User.
 func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -8116,6 +9031,30 @@ func _Map_String_User_Equals(lhs, rhs map[string]*User) bool {
 	return true
 }
 
+func _Map_String_User_Copy(v map[string]*User) map[string]*User {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*User, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_User_Hash(v map[string]*User) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Map_String_User_Zapper map[string]*User
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
@@ -8171,6 +9110,20 @@ func (lhs UserMap) Equals(rhs UserMap) bool {
 	return _Map_String_User_Equals((map[string]*User)(lhs), (map[string]*User)(rhs))
 }
 
+// Copy returns a deep copy of this UserMap.
+func (v UserMap) Copy() UserMap {
+	x := (map[string]*User)(v)
+	return (UserMap)(_Map_String_User_Copy(x))
+}
+
+// Hash returns a hash of this UserMap which is stable across
+// processes.
+func (v UserMap) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_String_User_Hash((map[string]*User)(v)))
+	return h.Sum64()
+}
+
 func (v UserMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((_Map_String_User_Zapper)((map[string]*User)(v))).MarshalLogObject(enc)
 }
@@ -8407,6 +9360,38 @@ func (v *ZapOptOutStruct) Equals(rhs *ZapOptOutStruct) bool {
 	return true
 }
 
+// Copy returns a deep copy of this ZapOptOutStruct.
+func (v *ZapOptOutStruct) Copy() *ZapOptOutStruct {
+	if v == nil {
+		return nil
+	}
+
+	var o ZapOptOutStruct
+	o.Name = v.Name
+	o.Optout = v.Optout
+	return &o
+}
+
+// Hash returns a hash of this ZapOptOutStruct which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ZapOptOutStruct) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.String(v.Optout)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ZapOptOutStruct so that it may be reused.
+func (v *ZapOptOutStruct) Reset() {
+	*v = ZapOptOutStruct{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ZapOptOutStruct.
 func (v *ZapOptOutStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -0,0 +1,2113 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package test_helpers
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	ptr "go.uber.org/thriftrw/ptr"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Account struct {
+	ID     string   `json:"id,required"`
+	Name   *string  `json:"name,omitempty"`
+	Status *Status  `json:"status,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+func _Status_ptr(v Status) *Status {
+	return &v
+}
+
+// Default_Account constructs a new Account struct,
+// pre-populating any fields with defined default values.
+func Default_Account() *Account {
+	var v Account
+	v.Name = ptr.String("anonymous")
+	v.Status = _Status_ptr(StatusActive)
+	v.Tags = []string{
+		"new",
+	}
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Account struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Account) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	vName := v.Name
+	if vName == nil {
+		vName = ptr.String("anonymous")
+	}
+	{
+		w, err = wire.NewValueString(*(vName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	vStatus := v.Status
+	if vStatus == nil {
+		vStatus = _Status_ptr(StatusActive)
+	}
+	{
+		w, err = vStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	vTags := v.Tags
+	if vTags == nil {
+		vTags = []string{
+			"new",
+		}
+	}
+	{
+		w, err = wire.NewValueList(_List_String_ValueList(vTags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Status_Read(w wire.Value) (Status, error) {
+	var v Status
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Account struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Account struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Account
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Account) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x Status
+				x, err = _Status_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Account is required")
+	}
+
+	if v.Name == nil {
+		v.Name = ptr.String("anonymous")
+	}
+
+	if v.Status == nil {
+		v.Status = _Status_ptr(StatusActive)
+	}
+
+	if v.Tags == nil {
+		v.Tags = []string{
+			"new",
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Account struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Account struct could not be encoded.
+func (v *Account) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	vName := v.Name
+	if vName == nil {
+		vName = ptr.String("anonymous")
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(vName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vStatus := v.Status
+	if vStatus == nil {
+		vStatus = _Status_ptr(StatusActive)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := vStatus.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vTags := v.Tags
+	if vTags == nil {
+		vTags = []string{
+			"new",
+		}
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(vTags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Status_Decode(sr stream.Reader) (Status, error) {
+	var v Status
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Account struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Account struct could not be generated from the wire
+// representation.
+func (v *Account) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x Status
+			x, err = _Status_Decode(sr)
+			v.Status = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Account is required")
+	}
+
+	if v.Name == nil {
+		v.Name = ptr.String("anonymous")
+	}
+
+	if v.Status == nil {
+		v.Status = _Status_ptr(StatusActive)
+	}
+
+	if v.Tags == nil {
+		v.Tags = []string{
+			"new",
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Account
+// struct.
+func (v *Account) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+
+	return fmt.Sprintf("Account{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Status_EqualsPtr(lhs, rhs *Status) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Account match the
+// provided Account.
+//
+// This function performs a deep comparison.
+func (v *Account) Equals(rhs *Account) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_Status_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Status_CopyPtr(v *Status) *Status {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Account.
+func (v *Account) Copy() *Account {
+	if v == nil {
+		return nil
+	}
+
+	var o Account
+	o.ID = v.ID
+	o.Name = _String_CopyPtr(v.Name)
+	o.Status = _Status_CopyPtr(v.Status)
+	o.Tags = _List_String_Copy(v.Tags)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Account which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Account) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	if v.Status != nil {
+		h.Field(3)
+		h.Int32(int32(*v.Status))
+	}
+	h.Field(4)
+	h.Uint64(_List_String_Hash(v.Tags))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Account so that it may be reused.
+func (v *Account) Reset() {
+	*v = Account{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Account.
+func (v *Account) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Account) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetName returns the value of Name if it is set or its
+// default value if it is unset.
+func (v *Account) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+	o = "anonymous"
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *Account) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetStatus returns the value of Status if it is set or its
+// default value if it is unset.
+func (v *Account) GetStatus() (o Status) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+	o = StatusActive
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *Account) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// default value if it is unset.
+func (v *Account) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	o = []string{
+		"new",
+	}
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Account) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// NewAccountWithDefaults constructs a new Account with its fields
+// set to the default values defined for them in the Thrift file.
+func NewAccountWithDefaults() *Account {
+	return Default_Account()
+}
+
+// MustAccountFromWire decodes a Account from its Thrift-level
+// representation, panicking if it fails. This is intended for tests.
+func MustAccountFromWire(w wire.Value) *Account {
+	var v Account
+	if err := v.FromWire(w); err != nil {
+		panic(fmt.Sprintf("could not decode Account from wire: %v", err))
+	}
+	return &v
+}
+
+// MustAccountFromJSON decodes a Account from JSON, panicking if it
+// fails. This is intended for tests.
+func MustAccountFromJSON(data []byte) *Account {
+	var v Account
+	if err := json.Unmarshal(data, &v); err != nil {
+		panic(fmt.Sprintf("could not decode Account from JSON: %v", err))
+	}
+	return &v
+}
+
+type AccountNotFound struct {
+	ID string `json:"id,required"`
+}
+
+// ToWire translates a AccountNotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AccountNotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ID), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AccountNotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AccountNotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AccountNotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AccountNotFound) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of AccountNotFound is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a AccountNotFound struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AccountNotFound struct could not be encoded.
+func (v *AccountNotFound) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a AccountNotFound struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AccountNotFound struct could not be generated from the wire
+// representation.
+func (v *AccountNotFound) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of AccountNotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AccountNotFound
+// struct.
+func (v *AccountNotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+
+	return fmt.Sprintf("AccountNotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*AccountNotFound) ErrorName() string {
+	return "AccountNotFound"
+}
+
+// Equals returns true if all the fields of this AccountNotFound match the
+// provided AccountNotFound.
+//
+// This function performs a deep comparison.
+func (v *AccountNotFound) Equals(rhs *AccountNotFound) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this AccountNotFound.
+func (v *AccountNotFound) Copy() *AccountNotFound {
+	if v == nil {
+		return nil
+	}
+
+	var o AccountNotFound
+	o.ID = v.ID
+	return &o
+}
+
+// Hash returns a hash of this AccountNotFound which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *AccountNotFound) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ID)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this AccountNotFound so that it may be reused.
+func (v *AccountNotFound) Reset() {
+	*v = AccountNotFound{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AccountNotFound.
+func (v *AccountNotFound) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID)
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *AccountNotFound) GetID() (o string) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// NewAccountNotFoundWithDefaults constructs a new AccountNotFound. None of its
+// fields have default values defined in the Thrift file.
+func NewAccountNotFoundWithDefaults() *AccountNotFound {
+	return &AccountNotFound{}
+}
+
+// MustAccountNotFoundFromWire decodes a AccountNotFound from its Thrift-level
+// representation, panicking if it fails. This is intended for tests.
+func MustAccountNotFoundFromWire(w wire.Value) *AccountNotFound {
+	var v AccountNotFound
+	if err := v.FromWire(w); err != nil {
+		panic(fmt.Sprintf("could not decode AccountNotFound from wire: %v", err))
+	}
+	return &v
+}
+
+// MustAccountNotFoundFromJSON decodes a AccountNotFound from JSON, panicking if it
+// fails. This is intended for tests.
+func MustAccountNotFoundFromJSON(data []byte) *AccountNotFound {
+	var v AccountNotFound
+	if err := json.Unmarshal(data, &v); err != nil {
+		panic(fmt.Sprintf("could not decode AccountNotFound from JSON: %v", err))
+	}
+	return &v
+}
+
+func (v *AccountNotFound) Error() string {
+	return v.String()
+}
+
+type Credentials struct {
+	User   string `json:"user,required"`
+	Secret []byte `json:"secret,omitempty"`
+}
+
+// ToWire translates a Credentials struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Credentials) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.User), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Secret != nil {
+		w, err = wire.NewValueBinary(v.Secret), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Credentials struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Credentials struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Credentials
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Credentials) FromWire(w wire.Value) error {
+	var err error
+
+	userIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.User, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				userIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Secret, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !userIsSet {
+		return errors.New("field User of Credentials is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Credentials struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Credentials struct could not be encoded.
+func (v *Credentials) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.User); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Secret != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Secret); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Credentials struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Credentials struct could not be generated from the wire
+// representation.
+func (v *Credentials) Decode(sr stream.Reader) error {
+
+	userIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.User, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			userIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Secret, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !userIsSet {
+		return errors.New("field User of Credentials is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Credentials
+// struct.
+func (v *Credentials) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("User: %v", v.User)
+	i++
+	if v.Secret != nil {
+		fields[i] = fmt.Sprintf("Secret: %v", v.Secret)
+		i++
+	}
+
+	return fmt.Sprintf("Credentials{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Credentials match the
+// provided Credentials.
+//
+// This function performs a deep comparison.
+func (v *Credentials) Equals(rhs *Credentials) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.User == rhs.User) {
+		return false
+	}
+	if !((v.Secret == nil && rhs.Secret == nil) || (v.Secret != nil && rhs.Secret != nil && bytes.Equal(v.Secret, rhs.Secret))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Credentials.
+func (v *Credentials) Copy() *Credentials {
+	if v == nil {
+		return nil
+	}
+
+	var o Credentials
+	o.User = v.User
+	o.Secret = _Binary_Copy(v.Secret)
+	return &o
+}
+
+// Hash returns a hash of this Credentials which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Credentials) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.User)
+	h.Field(2)
+	h.Binary(v.Secret)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Credentials so that it may be reused.
+func (v *Credentials) Reset() {
+	*v = Credentials{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Credentials.
+func (v *Credentials) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("user", v.User)
+	if v.Secret != nil {
+		enc.AddString("secret", base64.StdEncoding.EncodeToString(v.Secret))
+	}
+	return err
+}
+
+// GetUser returns the value of User if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetUser() (o string) {
+	if v != nil {
+		o = v.User
+	}
+	return
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *Credentials) GetSecret() (o []byte) {
+	if v != nil && v.Secret != nil {
+		return v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is not nil.
+func (v *Credentials) IsSetSecret() bool {
+	return v != nil && v.Secret != nil
+}
+
+// NewCredentialsWithDefaults constructs a new Credentials. None of its
+// fields have default values defined in the Thrift file.
+func NewCredentialsWithDefaults() *Credentials {
+	return &Credentials{}
+}
+
+// MustCredentialsFromWire decodes a Credentials from its Thrift-level
+// representation, panicking if it fails. This is intended for tests.
+func MustCredentialsFromWire(w wire.Value) *Credentials {
+	var v Credentials
+	if err := v.FromWire(w); err != nil {
+		panic(fmt.Sprintf("could not decode Credentials from wire: %v", err))
+	}
+	return &v
+}
+
+// MustCredentialsFromJSON decodes a Credentials from JSON, panicking if it
+// fails. This is intended for tests.
+func MustCredentialsFromJSON(data []byte) *Credentials {
+	var v Credentials
+	if err := json.Unmarshal(data, &v); err != nil {
+		panic(fmt.Sprintf("could not decode Credentials from JSON: %v", err))
+	}
+	return &v
+}
+
+type Identifier struct {
+	Email  *string `json:"email,omitempty"`
+	Number *int64  `json:"number,omitempty"`
+}
+
+// ToWire translates a Identifier struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Identifier) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Email != nil {
+		w, err = wire.NewValueString(*(v.Email)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Number != nil {
+		w, err = wire.NewValueI64(*(v.Number)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Identifier should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Identifier struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Identifier struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Identifier
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Identifier) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Email = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Number = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Number != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Identifier should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Identifier struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Identifier struct could not be encoded.
+func (v *Identifier) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Email != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Email)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Number != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Number)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Number != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Identifier should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Identifier struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Identifier struct could not be generated from the wire
+// representation.
+func (v *Identifier) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Email = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Number = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.Number != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Identifier should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Identifier
+// struct.
+func (v *Identifier) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
+		i++
+	}
+	if v.Number != nil {
+		fields[i] = fmt.Sprintf("Number: %v", *(v.Number))
+		i++
+	}
+
+	return fmt.Sprintf("Identifier{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Identifier match the
+// provided Identifier.
+//
+// This function performs a deep comparison.
+func (v *Identifier) Equals(rhs *Identifier) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Number, rhs.Number) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Identifier.
+func (v *Identifier) Copy() *Identifier {
+	if v == nil {
+		return nil
+	}
+
+	var o Identifier
+	o.Email = _String_CopyPtr(v.Email)
+	o.Number = _I64_CopyPtr(v.Number)
+	return &o
+}
+
+// Hash returns a hash of this Identifier which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Identifier) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Email != nil {
+		h.Field(1)
+		h.String(*v.Email)
+	}
+	if v.Number != nil {
+		h.Field(2)
+		h.Int64(*v.Number)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Identifier so that it may be reused.
+func (v *Identifier) Reset() {
+	*v = Identifier{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Identifier.
+func (v *Identifier) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
+	}
+	if v.Number != nil {
+		enc.AddInt64("number", *v.Number)
+	}
+	return err
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+func (v *Identifier) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
+	}
+
+	return
+}
+
+// IsSetEmail returns true if Email is not nil.
+func (v *Identifier) IsSetEmail() bool {
+	return v != nil && v.Email != nil
+}
+
+// LookupEmail returns the value of Email and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Identifier) LookupEmail() (o string, _ bool) {
+	if v != nil && v.Email != nil {
+		return *v.Email, true
+	}
+	return o, false
+}
+
+// SetEmail sets the value of Email and unsets all other
+// fields of this Identifier.
+func (v *Identifier) SetEmail(x string) {
+	*v = Identifier{}
+	v.Email = &x
+}
+
+// GetNumber returns the value of Number if it is set or its
+// zero value if it is unset.
+func (v *Identifier) GetNumber() (o int64) {
+	if v != nil && v.Number != nil {
+		return *v.Number
+	}
+
+	return
+}
+
+// IsSetNumber returns true if Number is not nil.
+func (v *Identifier) IsSetNumber() bool {
+	return v != nil && v.Number != nil
+}
+
+// LookupNumber returns the value of Number and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Identifier) LookupNumber() (o int64, _ bool) {
+	if v != nil && v.Number != nil {
+		return *v.Number, true
+	}
+	return o, false
+}
+
+// SetNumber sets the value of Number and unsets all other
+// fields of this Identifier.
+func (v *Identifier) SetNumber(x int64) {
+	*v = Identifier{}
+	v.Number = &x
+}
+
+// Which returns the ID of the field of this Identifier which is set, or
+// 0 if no field is set.
+func (v *Identifier) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Email != nil {
+		return 1
+	}
+	if v.Number != nil {
+		return 2
+	}
+	return 0
+}
+
+// Identifier_Visitor visits the field of a Identifier which is set.
+//
+// Fields added to Identifier add methods to Identifier_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Identifier_Visitor interface {
+	// VisitEmail is called with the value of Email if it is set.
+	VisitEmail(string) error
+
+	// VisitNumber is called with the value of Number if it is set.
+	VisitNumber(int64) error
+
+	// Default is called if no field of Identifier is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Identifier
+// which is set, or Default if none is, and returns its error.
+func (v *Identifier) Match(visitor Identifier_Visitor) error {
+	if v != nil {
+		if v.Email != nil {
+			return visitor.VisitEmail(*v.Email)
+		}
+		if v.Number != nil {
+			return visitor.VisitNumber(*v.Number)
+		}
+	}
+	return visitor.Default()
+}
+
+// NewIdentifierWithDefaults constructs a new Identifier. None of its
+// fields have default values defined in the Thrift file.
+func NewIdentifierWithDefaults() *Identifier {
+	return &Identifier{}
+}
+
+// MustIdentifierFromWire decodes a Identifier from its Thrift-level
+// representation, panicking if it fails. This is intended for tests.
+func MustIdentifierFromWire(w wire.Value) *Identifier {
+	var v Identifier
+	if err := v.FromWire(w); err != nil {
+		panic(fmt.Sprintf("could not decode Identifier from wire: %v", err))
+	}
+	return &v
+}
+
+// MustIdentifierFromJSON decodes a Identifier from JSON, panicking if it
+// fails. This is intended for tests.
+func MustIdentifierFromJSON(data []byte) *Identifier {
+	var v Identifier
+	if err := json.Unmarshal(data, &v); err != nil {
+		panic(fmt.Sprintf("could not decode Identifier from JSON: %v", err))
+	}
+	return &v
+}
+
+type Status int32
+
+const (
+	StatusActive   Status = 0
+	StatusInactive Status = 1
+)
+
+// Status_Values returns all recognized values of Status.
+func Status_Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusInactive,
+	}
+}
+
+// Status_Names returns the names of all recognized values of
+// Status, in the same order as Status_Values.
+func Status_Names() []string {
+	return []string{
+		"ACTIVE",
+		"INACTIVE",
+	}
+}
+
+// IsValid returns true if this Status is one of its recognized
+// values.
+func (v Status) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Status from a byte slice
+// containing its name.
+//
+//   var v Status
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *Status) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ACTIVE":
+		*v = StatusActive
+		return nil
+	case "INACTIVE":
+		*v = StatusInactive
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Status", err)
+		}
+		*v = Status(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Status to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Status) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("ACTIVE"), nil
+	case 1:
+		return []byte("INACTIVE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Status.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Status) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACTIVE")
+	case 1:
+		enc.AddString("name", "INACTIVE")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Status) Ptr() *Status {
+	return &v
+}
+
+// Set sets Status from its name or integer value.
+//
+// This implements flag.Value, allowing Status to be used as a
+// command line flag.
+func (v *Status) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Status) Type() string {
+	return "Status"
+}
+
+// Encode encodes Status directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Status
+//   return v.Encode(sWriter)
+func (v Status) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Status into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Status) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Status from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Status(0), err
+//   }
+//
+//   var v Status
+//   if err := v.FromWire(x); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) FromWire(w wire.Value) error {
+	*v = (Status)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Status directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Status
+//   if err := v.Decode(sReader); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Status)(i)
+	return nil
+}
+
+// String returns a readable string representation of Status.
+func (v Status) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACTIVE"
+	case 1:
+		return "INACTIVE"
+	}
+	return fmt.Sprintf("Status(%d)", w)
+}
+
+// Equals returns true if this Status value matches the provided
+// value.
+func (v Status) Equals(rhs Status) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Status into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Status) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 1:
+		return ([]byte)("\"INACTIVE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Status from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Status) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Status")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Status")
+	}
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "test-helpers",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/test-helpers",
+	FilePath: "test-helpers.thrift",
+	SHA1:     "ec92b7a433d455b731555400d24f62b663f5ef37",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Status {\n    ACTIVE,\n    INACTIVE,\n}\n\nstruct Account {\n    1: required string id\n    2: optional string name = \"anonymous\"\n    3: optional Status status = Status.ACTIVE\n    4: optional list<string> tags = [\"new\"]\n}\n\nstruct Credentials {\n    1: required string user\n    2: optional binary secret\n}\n\nunion Identifier {\n    1: string email\n    2: i64 number\n}\n\nexception AccountNotFound {\n    1: required string id\n}\n"
@@ -0,0 +1,30 @@
+struct Country {
+    1: required string code
+}
+
+struct Address {
+    1: required string street
+    2: optional string city
+    3: required Country country
+}
+
+typedef Address Location
+
+union Contact {
+    1: string email
+    2: string phone
+}
+
+struct User {
+    1: required string name
+    2: required Address home
+    3: optional Address work
+    4: optional Contact contact
+    5: required list<string> tags
+    6: optional Location location
+    7: optional list<Address> previous
+}
+
+exception UserError {
+    1: required User user
+}
@@ -0,0 +1,20 @@
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Trace {
+    1: required string id
+    2: optional list<Point> path
+    3: optional map<string, string> tags
+    4: optional binary payload
+}
+
+union Shape {
+    1: Point point
+    2: string name
+}
+
+exception TraceFailed {
+    1: required string message
+}
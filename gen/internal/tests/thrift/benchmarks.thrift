@@ -0,0 +1,56 @@
+include "./enums.thrift"
+
+enum Color {
+    RED,
+    GREEN,
+}
+
+typedef string Name
+typedef i64 Timestamp
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Primitives {
+    1: required bool boolField
+    2: required byte byteField
+    3: optional i16 int16Field
+    4: optional i32 int32Field
+    5: optional i64 int64Field
+    6: optional double doubleField
+    7: optional string stringField
+    8: optional binary binaryField
+    9: optional uuid uuidField
+}
+
+struct Containers {
+    1: optional list<Point> points
+    2: optional set<string> tags
+    3: optional map<string, list<i32>> scores
+    4: optional map<Point, string> labels
+    5: optional set<Color> colors
+    6: optional list<enums.EnumDefault> others
+}
+
+struct Node {
+    1: required Name name
+    2: optional Timestamp createdAt
+    3: optional Node sibling
+    4: optional list<Node> children
+}
+
+union Shape {
+    1: Point point
+    2: list<Point> polygon
+}
+
+exception ShapeError {
+    1: required string message
+    2: optional Shape shape
+}
+
+struct Forever {
+    1: required Forever again
+}
@@ -0,0 +1,33 @@
+typedef binary UUID (
+    go.type = "go.uber.org/thriftrw/gen/internal/tests/domain.UUID",
+    go.typeconv = "go.uber.org/thriftrw/gen/internal/tests/domain.UUIDToBytes/BytesToUUID",
+)
+
+typedef i64 Timestamp (
+    go.type = "time.Time",
+    go.typeconv = "go.uber.org/thriftrw/gen/internal/tests/domain.TimeToMillis/MillisToTime",
+)
+
+struct Event {
+    1: required UUID id
+    2: optional UUID parentID
+    3: required Timestamp createdAt
+    4: optional Timestamp deletedAt
+    5: optional list<UUID> related
+    6: optional set<UUID> tags
+    7: optional map<UUID, Timestamp> seen
+    8: optional map<string, UUID> byName
+    9: optional Timestamp expiresAt = 86400000
+}
+
+union EventRef {
+    1: UUID id
+    2: Timestamp at
+}
+
+const Timestamp Epoch = 0
+
+service Events {
+    Event getEvent(1: UUID id)
+    list<UUID> listEvents(1: Timestamp since, 2: optional Timestamp before)
+}
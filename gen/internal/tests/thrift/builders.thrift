@@ -0,0 +1,36 @@
+enum Role {
+    USER,
+    ADMIN,
+}
+
+struct Address {
+    1: required string street
+    2: optional string city
+}
+
+struct Profile {
+    1: required string id
+    2: required Address address
+    3: optional string name
+    4: optional i32 age
+    5: optional Role role = Role.USER
+    6: optional list<string> tags
+    7: optional map<string, string> labels
+    8: optional binary avatar
+    9: optional Address previousAddress
+    10: required bool active = true
+    11: required list<string> emails
+}
+
+union Contact {
+    1: string email
+    2: i64 phone
+    3: Address address
+}
+
+exception ProfileNotFound {
+    1: required string id
+    2: optional string message
+}
+
+struct Empty {}
@@ -0,0 +1,21 @@
+typedef list<string (go.bytes)> Chunks
+
+const string defaultBody = "hello"
+
+struct Message {
+    1: required string body (go.bytes)
+    2: optional string subject (go.bytes)
+    3: required binary checksum (go.string)
+    4: optional binary signature (go.string)
+    5: optional list<string (go.bytes)> chunks
+    6: optional map<binary (go.string), i32> counts
+    7: optional Chunks moreChunks
+    8: optional set<binary (go.string)> tags
+    9: optional string footer = defaultBody (go.bytes)
+    10: optional string title
+    11: optional binary data
+}
+
+service Messages {
+    binary (go.string) send(1: required string body (go.bytes))
+}
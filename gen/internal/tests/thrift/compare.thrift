@@ -0,0 +1,52 @@
+enum Priority {
+    LOW,
+    MEDIUM,
+    HIGH,
+}
+
+typedef string Name
+typedef list<i32> Path
+typedef Version Release
+
+struct Version {
+    1: required i32 major
+    2: required i32 minor
+    3: optional i32 patch
+    4: optional string label
+}
+
+struct Task {
+    1: required Name name
+    2: required Priority priority
+    3: optional bool done
+    4: optional double weight
+    5: optional binary payload
+    6: optional uuid id
+    7: optional Version since
+    8: optional Path path
+    9: optional list<Release> releases
+    10: optional Task parent
+}
+
+union Key {
+    1: string name
+    2: i64 id
+    3: Version version
+}
+
+exception TaskError {
+    1: required string message
+    2: optional Key key
+}
+
+struct Counts {
+    1: optional i32 count
+    2: optional Name name
+} (go.presence_bits = "true")
+
+struct Tagged {
+    1: required string name
+    2: optional set<string> tags
+}
+
+typedef map<string, i32> Scores
@@ -0,0 +1,41 @@
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+union Shape {
+    1: Point point
+    2: list<Point> polygon
+}
+
+typedef list<string> Names
+
+/** Names of the primary colors. */
+const list<string> primaryColors = ["red", "green", "blue"]
+
+const set<i32> primes = [2, 3, 5, 7]
+
+const map<string, i32> ports = {"http": 80, "https": 443}
+
+const Point origin = {"x": 0.0, "y": 0.0}
+
+const Shape unitSquare = {
+    "polygon": [
+        {"x": 0.0, "y": 0.0},
+        {"x": 1.0, "y": 0.0},
+        {"x": 1.0, "y": 1.0},
+        {"x": 0.0, "y": 1.0},
+    ]
+}
+
+const Names users = ["alice", "bob"]
+
+/** Constants of immutable types are still generated as constants. */
+const i32 maxPoints = 100
+
+const string greeting = "hello"
+
+struct Canvas {
+    1: optional list<string> colors = primaryColors
+    2: optional Point center = origin
+}
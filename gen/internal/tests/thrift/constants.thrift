@@ -122,3 +122,17 @@ const enums.RecordType HOME = enums.RecordType.HOME_ADDRESS
 const enums.RecordType WORK_ADDRESS = enums.RecordType.WORK_ADDRESS
 
 const enums.lowerCaseEnum lower = enums.lowerCaseEnum.items
+
+/**
+ * Default values may refer to constants from included modules inside
+ * containers and nested structs.
+ */
+struct DefaultsFromConstants {
+    1: optional list<i32> numbers = [other_constants.answer, 1]
+    2: optional set<enums.EnumDefault> enums = [other_constants.favorite, enums.EnumDefault.Foo]
+    3: optional map<string, structs.Point> points = {"some": other_constants.some_point}
+    4: optional structs.Point point = {"x": other_constants.origin, "y": other_constants.origin}
+    5: optional typedefs.MyEnum myEnum = myEnum
+    6: optional typedefs.UUID uuid = i128
+    7: optional list<typedefs.Timestamp> times = [beginningOfTime, other_constants.answer]
+}
@@ -0,0 +1,39 @@
+/** Color of a widget. */
+enum Color {
+    RED,
+    /** Looks like red. */
+    CRIMSON (deprecated = "use RED"),
+    BLUE,
+} (deprecated = "use Paint")
+
+struct Paint {
+    1: required string name
+}
+
+/** Widget is a thing. */
+struct Widget {
+    1: required string id
+    /** Name of the widget. */
+    2: optional string name (deprecated = "use label")
+    3: optional string label
+    4: optional Color color (deprecated)
+    5: optional Paint paint
+}
+
+typedef string WidgetID (deprecated = "use  the id field  of Widget ")
+
+union Shape {
+    1: double radius (deprecated = "use diameter")
+    2: double diameter
+}
+
+exception OldError {
+    1: optional string message
+} (deprecated = "no longer thrown")
+
+service Widgets {
+    Widget get(1: string id)
+
+    /** Returns the widget with the given name. */
+    Widget getByName(1: string name) (deprecated = "use get")
+} (deprecated = "use WidgetStore")
@@ -0,0 +1,25 @@
+struct Item {
+    1: required string name
+    2: optional i32 count
+}
+
+struct Order {
+    1: required string id
+    2: optional list<Item> items
+    3: optional map<string, i64> totals
+    4: optional set<string> tags
+    5: optional Item primary
+}
+
+union Payment {
+    1: string card
+    2: string voucher
+}
+
+exception OrderError {
+    1: required string message
+}
+
+service Orders {
+    Order getOrder(1: string id) throws (1: OrderError notFound)
+}
@@ -0,0 +1,106 @@
+enum Color {
+    RED
+    GREEN
+}
+
+typedef string Name
+typedef i64 Count
+typedef list<Name> Names
+typedef Point Origin
+
+typedef binary ID (
+    go.type = "go.uber.org/thriftrw/gen/internal/tests/domain.UUID",
+    go.typeconv = "go.uber.org/thriftrw/gen/internal/tests/domain.UUIDToBytes/BytesToUUID",
+)
+
+typedef i64 Timestamp (
+    go.type = "time.Time",
+    go.typeconv = "go.uber.org/thriftrw/gen/internal/tests/domain.TimeToMillis/MillisToTime",
+)
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Primitives {
+    1: required bool b
+    2: optional byte tiny
+    3: optional i16 small
+    4: required i32 medium (go.unsigned)
+    5: optional i64 large
+    6: optional double d
+    7: optional string s
+    8: optional binary bin
+    9: optional uuid u
+    10: optional Color color
+}
+
+struct Containers {
+    1: optional list<i32> ints
+    2: optional list<string> strings
+    3: optional list<Point> points
+    4: optional set<i64> longs
+    5: optional set<string> names
+    6: optional set<Point> (go.type = "slice") pointSet
+    7: optional map<i32, i64> fixed
+    8: optional map<string, i32> byName
+    9: optional map<i32, string> byID
+    10: optional map<string, list<string>> nested
+    11: optional map<Point, string> labels
+    12: optional map<string, i32> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map") ordered
+    13: optional map<i32, string> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.Int32StringMap") orderedNames
+    14: optional map<string, i32> (go.type = "keyvalue-slice") pairs
+}
+
+struct Typedefs {
+    1: required Name name
+    2: optional Name nickname
+    3: optional Count count
+    4: optional Names aliases
+    5: optional Origin origin
+    6: optional ID id
+    7: required Timestamp createdAt
+    8: optional Timestamp deletedAt
+}
+
+struct Defaults {
+    1: optional string greeting = "hello"
+    2: optional i32 retries = 3
+    3: optional list<string> tags = ["a", "b"]
+    4: optional string mode = "fast" (go.omit_default = "true")
+}
+
+struct Unknown {
+    1: optional string name
+} (go.preserve_unknown_fields = "true")
+
+struct Presence {
+    1: optional i32 a
+    2: optional string b
+} (go.presence_bits = "true")
+
+union Shape {
+    1: Point point
+    2: string name
+}
+
+exception Failure {
+    1: required string message
+}
+
+struct Sized {
+    1: optional i32 size
+    2: optional string name
+}
+
+struct Secret {
+    1: optional string token (encrypt = "tokens")
+}
+
+typedef Secret SecretAlias
+
+struct Secrets {
+    1: optional list<Secret> secrets
+    2: optional Point point
+}
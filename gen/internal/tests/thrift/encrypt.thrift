@@ -0,0 +1,25 @@
+struct Address {
+    1: required string street
+    2: optional string zip
+}
+
+struct Payment {
+    1: required string id
+    2: required string cardNumber (encrypt = "payments")
+    3: optional i32 cvv (encrypt = "payments")
+    4: optional Address billing (encrypt = "addresses")
+    5: optional list<string> notes (encrypt = "payments")
+    6: optional binary token (encrypt = "tokens")
+    7: optional string currency = "USD" (encrypt = "payments")
+    8: required Address shipping (encrypt = "addresses")
+}
+
+union Secret {
+    1: string password (encrypt = "secrets")
+    2: string hint
+}
+
+struct LazyPayment {
+    1: required string id
+    2: optional string cardNumber (encrypt = "payments")
+} (go.lazy = "true")
@@ -0,0 +1,10 @@
+struct User {
+    1: required string name (go.tag.validate = "required,max=64", go.tag.gorm = "column:user_name")
+    2: optional string email (go.tag = 'json:"mail" xml:"mail"', go.tag.xml = "email,attr")
+    3: optional i32 age
+} (go.field_tag_template = 'db:"{{.ThriftName}}" validate:"{{if .Required}}required{{end}}"')
+
+struct Overrides {
+    1: required string id (go.tag = 'db:"pk"')
+    2: optional string note (go.tag.db = "-")
+} (go.field_tag_template = 'db:"{{.Label}}"')
@@ -0,0 +1,51 @@
+enum Color {
+    RED,
+    GREEN,
+}
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Primitives {
+    1: required bool boolField
+    2: required byte byteField
+    3: optional i16 int16Field
+    4: optional i32 int32Field
+    5: optional i64 int64Field
+    6: optional double doubleField
+    7: optional string stringField
+    8: optional binary binaryField
+    9: optional uuid uuidField
+    10: optional Color color
+}
+
+struct Containers {
+    1: optional list<Point> points
+    2: optional set<string> tags
+    3: optional map<string, list<i32>> scores
+    4: optional map<Point, string> labels
+    5: optional set<Color> colors
+    6: optional map<double, double> weights
+}
+
+struct Node {
+    1: required string name
+    2: optional Node sibling
+    3: optional list<Node> children
+}
+
+union Shape {
+    1: Point point
+    2: list<Point> polygon
+}
+
+exception ShapeError {
+    1: required string message
+    2: optional Shape shape
+}
+
+struct Forever {
+    1: required Forever again
+}
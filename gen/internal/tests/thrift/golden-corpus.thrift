@@ -0,0 +1,50 @@
+include "./enums.thrift"
+
+enum Level {
+    LOW,
+    HIGH,
+}
+
+typedef binary Digest
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+struct Event {
+    1: required string name
+    2: optional Digest digest
+    3: optional uuid id
+    4: optional Level level = Level.HIGH
+    5: optional list<Point> points
+    6: optional map<string, Point> named
+    7: optional set<enums.EnumDefault> kinds
+    8: optional double weight = 0.5
+    9: optional Point origin = {"x": 0, "y": 0}
+}
+
+struct Settings {
+    1: optional bool enabled = true (go.omit_default = "true")
+    2: optional i64 limit = 4242 (go.omit_default = "true")
+    3: optional string label = "none" (go.omit_default = "true")
+}
+
+struct Keyed {
+    1: optional map<Point, string> labels
+}
+
+union Value {
+    1: Point point
+    2: string text
+}
+
+exception Failure {
+    1: required string message
+    2: optional Value value
+}
+
+struct Node {
+    1: required Value value
+    2: optional Node child
+}
@@ -0,0 +1,26 @@
+exception KeyDoesNotExist {
+    1: optional string key
+} (http.status = "404")
+
+exception InternalError {
+    1: optional string message
+}
+
+service Health {
+    bool healthy()
+}
+
+service KeyValue extends Health {
+    void setValue(1: required string key, 2: optional binary value)
+        throws (1: InternalError internalError)
+
+    binary getValue(1: optional string key)
+        throws (
+            1: KeyDoesNotExist doesNotExist,
+            2: InternalError internalError,
+        )
+
+    i64 size()
+
+    oneway void forget(1: string key)
+}
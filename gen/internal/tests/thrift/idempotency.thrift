@@ -0,0 +1,11 @@
+struct Transfer {
+    1: required string source
+    2: required string destination
+    3: required i64 amount
+}
+
+service Ledger {
+    i64 transfer(1: Transfer transfer) (rpc.idempotency_token)
+    oneway void record(1: string entry) (rpc.idempotency_token = "true")
+    i64 balance(1: string account) (rpc.idempotency_token = "false")
+}
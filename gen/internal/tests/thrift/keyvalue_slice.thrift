@@ -0,0 +1,21 @@
+typedef map<string, i32> (go.type = "keyvalue-slice") Counts
+typedef map<Key, string> (go.type = "keyvalue-slice") Labels
+typedef Counts MyCounts
+
+struct Key {
+    1: required string name
+    2: optional list<string> parts
+}
+
+struct Tally {
+    1: required map<string, i64> (go.type = "keyvalue-slice") requiredTotals
+    2: optional map<i32, string> (go.type = "keyvalue-slice") optionalNames
+    3: required Counts requiredTypedefCounts
+    4: optional Counts optionalTypedefCounts
+    5: optional map<string, i64> totals
+    6: optional map<string, map<string, i32> (go.type = "keyvalue-slice")> (go.type = "keyvalue-slice") nested
+    7: optional Labels labels
+}
+
+const map<string, i32> (go.type = "keyvalue-slice") ConstCounts = {"hello": 1, "world": 2}
+const Counts ConstTypedefCounts = {"foo": 3}
@@ -0,0 +1,36 @@
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+enum Level {
+    LOW,
+    HIGH,
+}
+
+/** Event is decoded lazily. */
+struct Event {
+    1: required string id
+    2: optional string name
+    3: optional i64 timestamp = 42
+    4: optional list<string> tags
+    5: optional map<string, Point> points
+    6: required Point origin
+    7: optional Level level
+    8: optional binary payload
+    9: required bool active = true
+}
+
+struct Empty {}
+
+struct Eager {
+    1: optional string value
+} (go.lazy = "false")
+
+union Shape {
+    1: Point point
+}
+
+exception Failure {
+    1: optional string message
+}
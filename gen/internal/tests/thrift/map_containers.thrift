@@ -0,0 +1,9 @@
+struct Inventory {
+    1: required map<string, i32> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map") counts
+    2: optional map<i32, string> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.Int32StringMap") names
+    3: optional list<map<string, i32> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map")> history
+    4: optional map<string, i32> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map") defaults = {"b": 2, "a": 1}
+    5: optional map<string, i32> plain
+}
+
+const map<string, i32> (go.type = "go.uber.org/thriftrw/gen/internal/tests/ordered.StringInt32Map") ConstCounts = {"world": 2, "hello": 1}
@@ -0,0 +1,20 @@
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct User {
+    1: required string id
+    2: optional string name
+    3: required Point home
+    4: required i32 age = 18
+}
+
+struct Event {
+    1: required string name
+    2: optional binary payload
+} (go.lazy = "true")
+
+service Users {
+    User get(1: required string id)
+}
@@ -0,0 +1,30 @@
+enum Status {
+    ACTIVE,
+    INACTIVE,
+}
+
+typedef string Name
+typedef list<Name> Names
+typedef Record AliasedRecord
+
+struct Record {
+    1: required Name name
+    2: optional Status status = Status.ACTIVE
+    3: optional map<string, list<i32>> scores
+    4: optional set<Status> history
+    5: optional Names aliases
+}
+
+union Value {
+    1: string text
+    2: Record record
+}
+
+exception NotFound {
+    1: required string key
+}
+
+service Store {
+    Record get(1: Name name) throws (1: NotFound notFound)
+    void put(1: Record record)
+}
@@ -0,0 +1,25 @@
+// Generated with --omit-defaults.
+
+enum Color {
+    RED, GREEN, BLUE
+}
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+struct Defaults {
+    1: optional i32 count = 10
+    2: optional string name = "foo"
+    3: optional Color color = Color.GREEN
+    4: optional list<string> tags = ["a", "b"]
+    5: optional Point origin = {"x": 0, "y": 0}
+    6: optional i64 noDefault
+    7: optional bool alwaysWritten = true (go.omit_default = "false")
+}
+
+struct NeverOmitted {
+    1: optional i32 count = 10
+    2: optional string name = "foo" (go.omit_default = "true")
+} (go.omit_default = "false")
@@ -0,0 +1,13 @@
+struct Item {
+    1: required string key
+    2: optional string value
+}
+
+exception ItemNotFound {
+    1: required string key
+}
+
+service Items {
+    Item getItem(1: required string key) throws (1: ItemNotFound notFound)
+    void putItem(1: required Item item)
+}
@@ -1,5 +1,10 @@
+include "./enums.thrift"
 include "./structs.thrift"
 
 const list<i32> listOfInts = [1, 2, 3]
 
 const structs.Point some_point = {"x": 1, "y": 2.0}
+
+const i32 answer = 42
+const double origin = 0.0
+const enums.EnumDefault favorite = enums.EnumDefault.Bar
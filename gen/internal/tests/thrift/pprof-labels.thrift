@@ -0,0 +1,26 @@
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+struct Shape {
+    1: required string name
+    2: optional list<Point> points
+}
+
+union Geometry {
+    1: Point point
+    2: Shape shape
+}
+
+exception InvalidShape {
+    1: required string reason
+}
+
+struct Unlabeled {
+    1: optional string value
+} (go.pprof_labels = "false")
+
+service Canvas {
+    Shape draw(1: Geometry geometry) throws (1: InvalidShape invalid)
+}
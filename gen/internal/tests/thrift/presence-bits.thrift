@@ -0,0 +1,127 @@
+enum Level {
+    LOW,
+    HIGH,
+}
+
+typedef string Label
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Sample {
+    1: required string id
+    2: optional bool enabled
+    3: optional i8 tiny
+    4: optional i16 small
+    5: optional i32 count (validate.min = "0", validate.max = "100")
+    6: optional i64 total
+    7: optional double ratio
+    8: optional string name
+    9: optional Level level
+    10: optional Label label
+    11: optional i32 retries = 3
+    12: optional string token (redact)
+    13: optional Point point
+    14: optional list<string> tags
+    15: optional binary data
+    16: optional string secret (visibility = "internal")
+} (go.lazy = "true", validate.cel = "!has(this.total) || this.total >= 0")
+
+union Choice {
+    1: string text
+    2: i64 number
+    3: Point point
+}
+
+exception Failure {
+    1: optional string message
+    2: optional i32 code
+}
+
+struct Pointers {
+    1: optional string name
+} (go.presence_bits = "false")
+
+struct Wide {
+    1: optional i32 f1
+    2: optional i32 f2
+    3: optional i32 f3
+    4: optional i32 f4
+    5: optional i32 f5
+    6: optional i32 f6
+    7: optional i32 f7
+    8: optional i32 f8
+    9: optional i32 f9
+    10: optional i32 f10
+    11: optional i32 f11
+    12: optional i32 f12
+    13: optional i32 f13
+    14: optional i32 f14
+    15: optional i32 f15
+    16: optional i32 f16
+    17: optional i32 f17
+    18: optional i32 f18
+    19: optional i32 f19
+    20: optional i32 f20
+    21: optional i32 f21
+    22: optional i32 f22
+    23: optional i32 f23
+    24: optional i32 f24
+    25: optional i32 f25
+    26: optional i32 f26
+    27: optional i32 f27
+    28: optional i32 f28
+    29: optional i32 f29
+    30: optional i32 f30
+    31: optional i32 f31
+    32: optional i32 f32
+    33: optional i32 f33
+    34: optional i32 f34
+    35: optional i32 f35
+    36: optional i32 f36
+    37: optional i32 f37
+    38: optional i32 f38
+    39: optional i32 f39
+    40: optional i32 f40
+    41: optional i32 f41
+    42: optional i32 f42
+    43: optional i32 f43
+    44: optional i32 f44
+    45: optional i32 f45
+    46: optional i32 f46
+    47: optional i32 f47
+    48: optional i32 f48
+    49: optional i32 f49
+    50: optional i32 f50
+    51: optional i32 f51
+    52: optional i32 f52
+    53: optional i32 f53
+    54: optional i32 f54
+    55: optional i32 f55
+    56: optional i32 f56
+    57: optional i32 f57
+    58: optional i32 f58
+    59: optional i32 f59
+    60: optional i32 f60
+    61: optional i32 f61
+    62: optional i32 f62
+    63: optional i32 f63
+    64: optional i32 f64
+    65: optional i32 f65
+    66: optional i32 f66
+}
+
+const Sample DefaultSample = {
+    "id": "default",
+    "count": 1,
+    "name": "sample",
+}
+
+typedef Sample AliasedSample
+
+const AliasedSample DefaultAliasedSample = {
+    "id": "aliased",
+    "count": 2,
+}
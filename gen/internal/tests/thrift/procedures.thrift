@@ -0,0 +1,38 @@
+exception KeyDoesNotExist {
+    1: optional string key
+}
+
+exception InternalError {
+    1: optional string message
+}
+
+service Health {
+    bool healthy()
+}
+
+/** KeyValue stores binary values by key. */
+service KeyValue extends Health {
+    void setValue(1: required string key, 2: optional binary value)
+        throws (1: InternalError internalError)
+
+    binary getValue(1: optional string key)
+        throws (
+            1: KeyDoesNotExist doesNotExist,
+            2: InternalError internalError,
+        )
+
+    /**
+     * Returns the number of keys stored,
+     * optionally in the given context.
+     */
+    i64 size(1: optional string ctx)
+
+    oneway void forget(1: string key)
+
+    // Counts keys with the given prefix. The attachment is never decoded.
+    i64 countPrefix(1: optional string prefix, 2: optional binary attachment)
+        throws (1: InternalError internalError)
+        (go.lazy_args = "true")
+
+    oneway void forgetPrefix(1: optional string prefix) (go.lazy_args = "true")
+}
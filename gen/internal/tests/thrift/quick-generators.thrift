@@ -0,0 +1,72 @@
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+enum Empty {}
+
+typedef string Name
+typedef Point Location
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Primitives {
+    1: required bool boolField
+    2: required byte byteField
+    3: optional i16 int16Field
+    4: optional i32 int32Field
+    5: optional i64 int64Field
+    6: optional double doubleField
+    7: optional string stringField
+    8: optional binary binaryField
+    9: optional uuid uuidField
+    10: optional Color color
+    11: optional Name name
+}
+
+struct Containers {
+    1: optional list<Point> points
+    2: optional set<string> tags
+    3: optional map<string, list<i32>> scores
+    4: optional map<Point, string> labels
+    5: optional set<Location> locations
+    6: optional set<Color> (go.type = "slice") colors
+    7: optional map<i64, Empty> empties
+}
+
+struct Blobs {
+    1: optional map<binary, string> names
+    2: optional set<binary> keys
+    3: optional map<Color, i32> (go.type = "slice") counts
+}
+
+struct Node {
+    1: required Name name
+    2: optional Node sibling
+    3: optional list<Node> children
+}
+
+union Shape {
+    1: Point point
+    2: list<Point> polygon
+    3: Forever forever
+}
+
+exception ShapeError {
+    1: required string message
+    2: optional Shape shape
+}
+
+struct Forever {
+    1: required Forever again
+}
+
+struct Optionals {
+    1: optional Forever forever
+    2: optional list<Forever> forevers
+    3: optional i32 count
+} (go.presence_bits = "true")
@@ -0,0 +1,19 @@
+struct Card {
+    1: required string number (redact)
+    2: optional i32 cvv (redact)
+}
+
+struct Credentials {
+    1: required string user
+    2: required string password (redact)
+    3: optional string token (redact, go.tag = 'json:"accessToken"')
+    4: optional i64 pin (redact)
+    5: optional Card card (redact)
+    6: optional string note (redact, go.tag = 'json:"-"')
+    7: optional string comment
+}
+
+exception AuthError {
+    1: required string message
+    2: optional string password (redact)
+}
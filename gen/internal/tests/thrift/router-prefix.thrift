@@ -0,0 +1,11 @@
+service Health {
+    bool healthy()
+}
+
+service Users extends Health {
+    string get(1: required i64 id)
+}
+
+service Groups extends Health {
+    string get(1: required i64 id) (thrift.alias = "Teams::get")
+}
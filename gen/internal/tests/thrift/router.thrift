@@ -0,0 +1,17 @@
+include "./procedures.thrift"
+
+exception NotFound {
+    1: optional string key
+}
+
+service Store extends procedures.Health {
+    binary get(1: optional string key)
+        throws (1: NotFound notFound)
+        (thrift.alias = "KeyValue::getValue, KeyValue::fetch")
+
+    oneway void evict(1: string key)
+}
+
+service Admin {
+    void drain()
+}
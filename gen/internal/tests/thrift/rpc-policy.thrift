@@ -0,0 +1,12 @@
+exception KeyNotFound {
+    1: required string key
+}
+
+service KeyValue {
+    binary getValue(1: string key)
+        throws (1: KeyNotFound notFound)
+        (rpc.timeout = "500ms", rpc.retries = "3")
+    void setValue(1: string key, 2: binary value) (rpc.timeout = "1m30s")
+    oneway void flush() (rpc.retries = "2")
+    i64 size()
+}
@@ -0,0 +1,30 @@
+include "./exceptions.thrift"
+
+exception Unavailable {
+    1: optional string message
+}
+
+struct Entry {
+    1: required string key
+    2: optional binary value
+}
+
+service Store {
+    Entry get(1: required string key (pii = "false"))
+        throws (1: exceptions.DoesNotExistException notFound)
+        (slo.latency_ms = "50")
+
+    void put(1: Entry entry, 2: optional i64 ttl)
+        throws (1: Unavailable unavailable)
+
+    map<string, list<Entry>> scan(1: set<string> prefixes)
+
+    oneway void touch(1: string key)
+} (
+    owner = "storage-team"
+    team = "storage"
+)
+
+service CachingStore extends Store {
+    void invalidate(1: string key) (idempotent = "true")
+}
@@ -7,6 +7,9 @@ exception InternalError {
     1: optional string message
 }
 
+/**
+ * KeyValue stores arbitrary values by key.
+ */
 service KeyValue {
     // void and no exceptions
     void setValue(1: Key key, 2: unions.ArbitraryValue value)
@@ -42,6 +45,7 @@ service KeyValue {
         1: exceptions.DoesNotExistException doesNotExist,
     )
 
+    /** Returns the number of keys stored. */
     i64 size()  // < primitve return value
 }
 
@@ -0,0 +1,26 @@
+enum Color {
+    RED,
+    GREEN,
+}
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+struct Shape {
+    1: required string name
+    2: optional string label
+    3: optional i64 area
+    4: optional Color color = Color.RED
+    5: required Point origin
+    6: optional Point center
+    7: optional list<Point> points
+    8: optional map<string, string> attributes
+    9: optional binary data
+}
+
+union Value {
+    1: string text
+    2: Point point
+}
@@ -0,0 +1,37 @@
+struct Point {
+    1: required double x
+    2: required double y
+    3: optional string label (go.skip)
+}
+
+struct User {
+    1: required string id
+    2: optional string name
+    /** Only available to internal services. */
+    3: optional string ssn (go.skip)
+    4: optional Point home
+    5: optional list<Point> visited (go.skip = "true")
+    6: optional string email (go.skip = "false")
+}
+
+union Contact {
+    1: string phone
+    2: string email
+    3: Point address (go.skip)
+}
+
+exception NotFound {
+    1: optional string message
+    2: optional string trace (go.skip)
+}
+
+const User someUser = {
+    "id": "1",
+    "ssn": "123-45-6789",
+    "home": {"x": 1.0, "y": 2.0, "label": "home"},
+}
+
+struct Profile {
+    1: optional User user = someUser
+    2: optional list<Point> pins = [{"x": 0.0, "y": 0.0, "label": "origin"}]
+}
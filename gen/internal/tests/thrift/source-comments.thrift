@@ -0,0 +1,31 @@
+/** Default port of the key-value service. */
+const i32 defaultPort = 8080
+
+enum Consistency {
+    /** Reads may return stale values. */
+    EVENTUAL,
+    STRONG,
+}
+
+/** Key identifies an entry. */
+typedef string Key
+
+/**
+ * Entry is a single key-value pair.
+ */
+struct Entry {
+    /** Key of the entry. */
+    1: required Key key
+    2: optional binary value
+}
+
+exception KeyNotFound {
+    1: required Key key
+}
+
+/** KeyValue stores entries by key. */
+service KeyValue {
+    /** Returns the entry for the given key. */
+    Entry get(1: Key key) throws (1: KeyNotFound notFound)
+    oneway void touch(1: Key key)
+}
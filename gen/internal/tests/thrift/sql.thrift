@@ -0,0 +1,28 @@
+enum Status {
+    ACTIVE = 1,
+    SUSPENDED = 2,
+} (go.sql = "string")
+
+enum Priority {
+    LOW,
+    HIGH,
+} (go.sql = "int")
+
+enum Color {
+    RED,
+    GREEN,
+}
+
+typedef string UserID (go.sql = "string")
+
+typedef i64 Timestamp (go.sql = "int")
+
+typedef UserID OwnerID (go.sql = "string")
+
+typedef string Note
+
+struct Account {
+    1: required UserID id
+    2: optional Status status
+    3: optional Timestamp createdAt
+}
@@ -0,0 +1,38 @@
+struct Secret {
+    1: optional string name
+    2: optional string token (visibility = "internal")
+}
+
+typedef Secret AliasedSecret
+typedef list<Secret> SecretList
+
+struct User {
+    1: required string id
+    2: optional string ssn (visibility = "internal")
+    3: required Secret primary
+    4: optional Secret secret
+    5: optional list<Secret> secrets
+    6: optional set<Secret> secretSet
+    7: optional map<string, Secret> secretsByName
+    8: optional map<Secret, string> namesBySecret
+    9: optional AliasedSecret aliased
+    10: optional SecretList secretList
+    11: optional list<list<Secret>> nested
+    12: optional list<string> tags
+}
+
+union Payload {
+    1: Secret secret
+    2: string text
+}
+
+struct Node {
+    1: required string value
+    2: optional Node tail
+    3: optional string debug (visibility = "internal")
+}
+
+struct Plain {
+    1: optional string name
+    2: optional list<string> tags
+}
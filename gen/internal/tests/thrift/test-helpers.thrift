@@ -0,0 +1,25 @@
+enum Status {
+    ACTIVE,
+    INACTIVE,
+}
+
+struct Account {
+    1: required string id
+    2: optional string name = "anonymous"
+    3: optional Status status = Status.ACTIVE
+    4: optional list<string> tags = ["new"]
+}
+
+struct Credentials {
+    1: required string user
+    2: optional binary secret
+}
+
+union Identifier {
+    1: string email
+    2: i64 number
+}
+
+exception AccountNotFound {
+    1: required string id
+}
@@ -0,0 +1,38 @@
+enum Status {
+    ACTIVE = 1,
+    RETIRED = 2,
+}
+
+typedef binary Checksum
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Record {
+    1: required string name
+    2: optional binary payload
+    3: optional Checksum checksum
+    4: optional bool active
+    5: optional byte flags
+    6: optional i16 shard
+    7: optional i32 version
+    8: optional i64 createdAt
+    9: optional Status status
+    10: optional uuid id
+    11: optional list<Point> path
+    12: optional set<string> tags
+    13: optional map<i32, string> labels
+    14: optional map<string, list<binary>> blobs
+}
+
+union Shape {
+    1: Point point
+    2: list<Point> polygon
+}
+
+exception RecordError {
+    1: required string message
+    2: optional Record record
+}
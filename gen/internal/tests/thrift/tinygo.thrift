@@ -0,0 +1,17 @@
+enum Color {
+    RED = 1
+    GREEN = 2
+    BLUE = 3
+}
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+struct Shape {
+    1: required string name
+    2: required list<Point> points
+    3: optional list<Color> colors
+    4: optional list<string> labels
+}
@@ -0,0 +1,28 @@
+enum Shape {
+    CIRCLE,
+    SQUARE,
+} (docs.category = "geometry")
+
+typedef list<Point> Path
+
+typedef string Label (docs.example = "origin")
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Graph {
+    1: required list<Point> points
+    2: optional map<Label, Shape> shapes (docs.deprecated = "use points")
+    3: optional string secret (go.encrypt = "default")
+} (owner = "graphs")
+
+union Selection {
+    1: Point point
+    2: Path path
+}
+
+exception GraphError {
+    1: required string message
+}
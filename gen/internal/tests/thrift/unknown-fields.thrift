@@ -0,0 +1,21 @@
+struct Address {
+    1: optional string city
+}
+
+/** UserV1 is an older version of UserV2. */
+struct UserV1 {
+    1: required string name
+}
+
+struct UserV2 {
+    1: required string name
+    2: optional i32 age
+    3: optional list<string> emails
+    4: optional Address address
+    5: optional map<string, i64> scores
+    6: optional set<i16> flags
+}
+
+struct DroppingUserV1 {
+    1: required string name
+} (go.preserve_unknown_fields = "false")
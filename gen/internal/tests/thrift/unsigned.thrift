@@ -0,0 +1,29 @@
+typedef i32 Port (go.unsigned)
+typedef i64 Hash (go.unsigned)
+typedef i8 (go.unsigned) Octet
+
+const Port DefaultPort = 8080
+const i16 (go.unsigned) MaxUint16 = -1
+const Hash MaxHash = -1
+
+struct Endpoint {
+    1: required string host
+    2: required i32 port (go.unsigned)
+    3: optional Port backupPort = DefaultPort
+    4: optional i64 checksum (go.unsigned)
+    5: optional i16 weight (go.unsigned, validate.max = "65535")
+    6: optional Hash digest
+    7: optional list<Octet> address
+    8: optional map<i8 (go.unsigned), i64 (go.unsigned)> counters
+    9: optional set<i32 (go.unsigned)> ids
+   10: optional i32 signed
+}
+
+exception Overflow {
+    1: optional i64 limit (go.unsigned)
+}
+
+service Endpoints {
+    i32 (go.unsigned) lookup(1: string host, 2: i16 weight (go.unsigned))
+        throws (1: Overflow overflow)
+}
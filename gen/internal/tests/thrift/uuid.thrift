@@ -0,0 +1,19 @@
+typedef uuid RequestID
+
+const uuid NilUUID = "00000000-0000-0000-0000-000000000000"
+const RequestID DefaultRequestID = "123e4567-e89b-12d3-a456-426614174000"
+
+struct Request {
+    1: required uuid id
+    2: optional uuid parentID
+    3: optional RequestID requestID = DefaultRequestID
+    4: optional uuid (go.type = "go.uber.org/thriftrw/gen/internal/tests/domain.UUID") domainID
+    5: optional list<uuid> related
+    6: optional set<uuid> tags
+    7: optional map<uuid, string> labels
+}
+
+service Requests {
+    uuid create(1: Request request)
+    Request lookup(1: required uuid id, 2: RequestID requestID)
+}
@@ -0,0 +1,39 @@
+//////////////////////////////////////////////////////////////////////////////
+// CEL expressions
+
+struct Range {
+    1: required i32 min
+    2: required i32 max
+} (validate.cel = "this.min <= this.max")
+
+struct OptionalRange {
+    1: optional i64 low
+    2: optional i64 high
+    3: optional list<string> tags
+} (validate.cel = "!has(this.low) || !has(this.high) || this.low <= this.high")
+
+union RangeOrName {
+    1: Range range
+    2: string name
+} (validate.cel = 'has(this.range) || this.name != ""')
+
+//////////////////////////////////////////////////////////////////////////////
+// Field rules
+
+typedef string Slug
+
+struct Account {
+    1: required string name (validate.required_non_empty)
+    2: required Slug slug (validate.pattern = "^[a-z0-9-]+$", validate.max = "16")
+    3: optional i32 age (validate.min = "0", validate.max = "150")
+    4: optional double score (validate.min = "-1.5", validate.max = "1.5")
+    5: optional string nickname (validate.min = "2", validate.pattern = "^[A-Za-z]+$")
+    6: optional list<string> emails (validate.required_non_empty, validate.max = "3")
+    7: required map<string, string> labels (validate.max = "2")
+    8: optional binary avatar (validate.max = "4")
+}
+
+struct Invite {
+    1: required string email (validate.pattern = "@")
+    2: optional i8 uses (validate.min = "1")
+} (validate.cel = "this.email != \"\"")
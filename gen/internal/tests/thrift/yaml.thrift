@@ -0,0 +1,30 @@
+enum Color {
+    RED = 1,
+    GREEN = 2,
+    BLUE = 3 (go.label = "blue"),
+}
+
+struct Point {
+    1: required i32 row
+    2: required i32 col
+}
+
+struct Config {
+    1: required string name
+    2: optional i32 replicas
+    3: optional Color color
+    4: optional list<Point> points
+    5: optional map<string, string> labels (go.tag = 'json:"meta"')
+    6: optional string secret (go.tag = 'json:"-"')
+    7: optional string owner (go.tag.yaml = "owned_by")
+    8: optional bool enabled (go.tag = 'json:"enabled,!omitempty"')
+    9: optional Target target
+}
+
+union Target {
+    1: Point point
+    2: string host
+    3: Color color
+}
+
+union Empty {}
@@ -0,0 +1,2293 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package type_specs
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	runtime "runtime"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Graph struct {
+	Points []*Point        `json:"points,required"`
+	Shapes map[Label]Shape `json:"shapes,omitempty"`
+	Secret *string         `json:"secret,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+type _Map_Label_Shape_MapItemList map[Label]Shape
+
+func (m _Map_Label_Shape_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Label_Shape_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Label_Shape_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_Label_Shape_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_Label_Shape_MapItemList) Close() {}
+
+// ToWire translates a Graph struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Graph) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Shapes != nil {
+		w, err = wire.NewValueMap(_Map_Label_Shape_MapItemList(v.Shapes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Secret != nil {
+		w, err = wire.NewValueString(*(v.Secret)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Label_Read(w wire.Value) (Label, error) {
+	var x Label
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Shape_Read(w wire.Value) (Shape, error) {
+	var v Shape
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Map_Label_Shape_Read(m wire.MapItemList) (map[Label]Shape, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[Label]Shape, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Label_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Shape_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Graph struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Graph struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Graph
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Graph) FromWire(w wire.Value) error {
+	var err error
+
+	pointsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				pointsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.Shapes, err = _Map_Label_Shape_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Secret = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !pointsIsSet {
+		return errors.New("field Points of Graph is required")
+	}
+
+	return nil
+}
+
+func _List_Point_Encode(val []*Point, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Point
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Point', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_Label_Shape_Encode(val map[Label]Shape, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := k.Encode(sw); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Graph struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Graph struct could not be encoded.
+func (v *Graph) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_Point_Encode(v.Points, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Shapes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Label_Shape_Encode(v.Shapes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Secret != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Secret)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Point, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Label_Decode(sr stream.Reader) (Label, error) {
+	var x Label
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Shape_Decode(sr stream.Reader) (Shape, error) {
+	var v Shape
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Map_Label_Shape_Decode(sr stream.Reader) (map[Label]Shape, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[Label]Shape, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Label_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Shape_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Graph struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Graph struct could not be generated from the wire
+// representation.
+func (v *Graph) Decode(sr stream.Reader) error {
+
+	pointsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+			pointsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.Shapes, err = _Map_Label_Shape_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Secret = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !pointsIsSet {
+		return errors.New("field Points of Graph is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Graph
+// struct.
+func (v *Graph) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Points: %v", v.Points)
+	i++
+	if v.Shapes != nil {
+		fields[i] = fmt.Sprintf("Shapes: %v", v.Shapes)
+		i++
+	}
+	if v.Secret != nil {
+		fields[i] = fmt.Sprintf("Secret: %v", *(v.Secret))
+		i++
+	}
+
+	return fmt.Sprintf("Graph{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_Label_Shape_Equals(lhs, rhs map[Label]Shape) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Graph match the
+// provided Graph.
+//
+// This function performs a deep comparison.
+func (v *Graph) Equals(rhs *Graph) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_List_Point_Equals(v.Points, rhs.Points) {
+		return false
+	}
+	if !((v.Shapes == nil && rhs.Shapes == nil) || (v.Shapes != nil && rhs.Shapes != nil && _Map_Label_Shape_Equals(v.Shapes, rhs.Shapes))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Secret, rhs.Secret) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Point_Copy(v []*Point) []*Point {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_Label_Shape_Copy(v map[Label]Shape) map[Label]Shape {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[Label]Shape, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Graph.
+func (v *Graph) Copy() *Graph {
+	if v == nil {
+		return nil
+	}
+
+	var o Graph
+	o.Points = _List_Point_Copy(v.Points)
+	o.Shapes = _Map_Label_Shape_Copy(v.Shapes)
+	o.Secret = _String_CopyPtr(v.Secret)
+	return &o
+}
+
+func _List_Point_Hash(v []*Point) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Map_Label_Shape_Hash(v map[Label]Shape) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(string(k))
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Graph which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Graph) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Point_Hash(v.Points))
+	h.Field(2)
+	h.Uint64(_Map_Label_Shape_Hash(v.Shapes))
+	if v.Secret != nil {
+		h.Field(3)
+		h.String(*v.Secret)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Graph so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Graph) Reset() {
+	*v = Graph{
+		Points: v.Points[:0],
+	}
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_Label_Shape_Zapper map[Label]Shape
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Label_Shape_Zapper.
+func (m _Map_Label_Shape_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Graph.
+func (v *Graph) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	if v.Shapes != nil {
+		err = multierr.Append(err, enc.AddObject("shapes", (_Map_Label_Shape_Zapper)(v.Shapes)))
+	}
+	if v.Secret != nil {
+		enc.AddString("secret", *v.Secret)
+	}
+	return err
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *Graph) GetPoints() (o []*Point) {
+	if v != nil {
+		o = v.Points
+	}
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *Graph) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetShapes returns the value of Shapes if it is set or its
+// zero value if it is unset.
+func (v *Graph) GetShapes() (o map[Label]Shape) {
+	if v != nil && v.Shapes != nil {
+		return v.Shapes
+	}
+
+	return
+}
+
+// IsSetShapes returns true if Shapes is not nil.
+func (v *Graph) IsSetShapes() bool {
+	return v != nil && v.Shapes != nil
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *Graph) GetSecret() (o string) {
+	if v != nil && v.Secret != nil {
+		return *v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is not nil.
+func (v *Graph) IsSetSecret() bool {
+	return v != nil && v.Secret != nil
+}
+
+type GraphError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a GraphError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GraphError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GraphError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GraphError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GraphError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GraphError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of GraphError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a GraphError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GraphError struct could not be encoded.
+func (v *GraphError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a GraphError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GraphError struct could not be generated from the wire
+// representation.
+func (v *GraphError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of GraphError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GraphError
+// struct.
+func (v *GraphError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("GraphError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*GraphError) ErrorName() string {
+	return "GraphError"
+}
+
+// Equals returns true if all the fields of this GraphError match the
+// provided GraphError.
+//
+// This function performs a deep comparison.
+func (v *GraphError) Equals(rhs *GraphError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this GraphError.
+func (v *GraphError) Copy() *GraphError {
+	if v == nil {
+		return nil
+	}
+
+	var o GraphError
+	o.Message = v.Message
+	return &o
+}
+
+// Hash returns a hash of this GraphError which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *GraphError) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this GraphError so that it may be reused.
+func (v *GraphError) Reset() {
+	*v = GraphError{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GraphError.
+func (v *GraphError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *GraphError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *GraphError) Error() string {
+	return v.String()
+}
+
+type Label string
+
+// LabelPtr returns a pointer to a Label
+func (v Label) Ptr() *Label {
+	return &v
+}
+
+// ToWire translates Label into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Label) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Label.
+func (v Label) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Label) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Label from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Label) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Label)(x)
+	return err
+}
+
+// Decode deserializes Label directly off the wire.
+func (v *Label) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Label)(x)
+	return err
+}
+
+// Equals returns true if this Label is equal to the provided
+// Label.
+func (lhs Label) Equals(rhs Label) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Label which is stable across
+// processes.
+func (v Label) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+type Path []*Point
+
+// ToWire translates Path into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Path) ToWire() (wire.Value, error) {
+	x := ([]*Point)(v)
+	return wire.NewValueList(_List_Point_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Path.
+func (v Path) String() string {
+	x := ([]*Point)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Path) Encode(sw stream.Writer) error {
+	x := ([]*Point)(v)
+	return _List_Point_Encode(x, sw)
+}
+
+// FromWire deserializes Path from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Path) FromWire(w wire.Value) error {
+	x, err := _List_Point_Read(w.GetList())
+	*v = (Path)(x)
+	return err
+}
+
+// Decode deserializes Path directly off the wire.
+func (v *Path) Decode(sr stream.Reader) error {
+	x, err := _List_Point_Decode(sr)
+	*v = (Path)(x)
+	return err
+}
+
+// Equals returns true if this Path is equal to the provided
+// Path.
+func (lhs Path) Equals(rhs Path) bool {
+	return _List_Point_Equals(([]*Point)(lhs), ([]*Point)(rhs))
+}
+
+// Copy returns a deep copy of this Path.
+func (v Path) Copy() Path {
+	x := ([]*Point)(v)
+	return (Path)(_List_Point_Copy(x))
+}
+
+// Hash returns a hash of this Path which is stable across
+// processes.
+func (v Path) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_Point_Hash(([]*Point)(v)))
+	return h.Sum64()
+}
+
+func (v Path) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Point_Zapper)(([]*Point)(v))).MarshalLogArray(enc)
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Point struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Point struct could not be encoded.
+func (v *Point) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.X); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TDouble}); err != nil {
+		return err
+	}
+	if err := sw.WriteDouble(v.Y); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Point struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Point struct could not be generated from the wire
+// representation.
+func (v *Point) Decode(sr stream.Reader) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Point.
+func (v *Point) Copy() *Point {
+	if v == nil {
+		return nil
+	}
+
+	var o Point
+	o.X = v.X
+	o.Y = v.Y
+	return &o
+}
+
+// Hash returns a hash of this Point which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Point) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Double(v.X)
+	h.Field(2)
+	h.Double(v.Y)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Point so that it may be reused.
+func (v *Point) Reset() {
+	*v = Point{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Selection struct {
+	Point *Point `json:"point,omitempty"`
+	Path  Path   `json:"path,omitempty"`
+}
+
+// ToWire translates a Selection struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Selection) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Point != nil {
+		w, err = v.Point.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Path != nil {
+		w, err = v.Path.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Selection should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Path_Read(w wire.Value) (Path, error) {
+	var x Path
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Selection struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Selection struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Selection
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Selection) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Path, err = _Path_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Path != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Selection should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Selection struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Selection struct could not be encoded.
+func (v *Selection) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Point != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Point.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Path != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := v.Path.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Path != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Selection should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Path_Decode(sr stream.Reader) (Path, error) {
+	var x Path
+	err := x.Decode(sr)
+	return x, err
+}
+
+// Decode deserializes a Selection struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Selection struct could not be generated from the wire
+// representation.
+func (v *Selection) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Point, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Path, err = _Path_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Point != nil {
+		count++
+	}
+	if v.Path != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Selection should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Selection
+// struct.
+func (v *Selection) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+	if v.Path != nil {
+		fields[i] = fmt.Sprintf("Path: %v", v.Path)
+		i++
+	}
+
+	return fmt.Sprintf("Selection{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Selection match the
+// provided Selection.
+//
+// This function performs a deep comparison.
+func (v *Selection) Equals(rhs *Selection) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && v.Point.Equals(rhs.Point))) {
+		return false
+	}
+	if !((v.Path == nil && rhs.Path == nil) || (v.Path != nil && rhs.Path != nil && v.Path.Equals(rhs.Path))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Selection.
+func (v *Selection) Copy() *Selection {
+	if v == nil {
+		return nil
+	}
+
+	var o Selection
+	o.Point = v.Point.Copy()
+	o.Path = v.Path.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Selection which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Selection) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Point.Hash())
+	h.Field(2)
+	h.Uint64(v.Path.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Selection so that it may be reused.
+func (v *Selection) Reset() {
+	*v = Selection{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Selection.
+func (v *Selection) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Point != nil {
+		err = multierr.Append(err, enc.AddObject("point", v.Point))
+	}
+	if v.Path != nil {
+		err = multierr.Append(err, enc.AddArray("path", (_List_Point_Zapper)(v.Path)))
+	}
+	return err
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+func (v *Selection) GetPoint() (o *Point) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+func (v *Selection) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
+
+// LookupPoint returns the value of Point and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Selection) LookupPoint() (o *Point, _ bool) {
+	if v != nil && v.Point != nil {
+		return v.Point, true
+	}
+	return o, false
+}
+
+// SetPoint sets the value of Point and unsets all other
+// fields of this Selection.
+func (v *Selection) SetPoint(x *Point) {
+	*v = Selection{}
+	v.Point = x
+}
+
+// GetPath returns the value of Path if it is set or its
+// zero value if it is unset.
+func (v *Selection) GetPath() (o Path) {
+	if v != nil && v.Path != nil {
+		return v.Path
+	}
+
+	return
+}
+
+// IsSetPath returns true if Path is not nil.
+func (v *Selection) IsSetPath() bool {
+	return v != nil && v.Path != nil
+}
+
+// LookupPath returns the value of Path and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Selection) LookupPath() (o Path, _ bool) {
+	if v != nil && v.Path != nil {
+		return v.Path, true
+	}
+	return o, false
+}
+
+// SetPath sets the value of Path and unsets all other
+// fields of this Selection.
+func (v *Selection) SetPath(x Path) {
+	*v = Selection{}
+	v.Path = x
+}
+
+// Which returns the ID of the field of this Selection which is set, or
+// 0 if no field is set.
+func (v *Selection) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Point != nil {
+		return 1
+	}
+	if v.Path != nil {
+		return 2
+	}
+	return 0
+}
+
+// Selection_Visitor visits the field of a Selection which is set.
+//
+// Fields added to Selection add methods to Selection_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Selection_Visitor interface {
+	// VisitPoint is called with the value of Point if it is set.
+	VisitPoint(*Point) error
+
+	// VisitPath is called with the value of Path if it is set.
+	VisitPath(Path) error
+
+	// Default is called if no field of Selection is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Selection
+// which is set, or Default if none is, and returns its error.
+func (v *Selection) Match(visitor Selection_Visitor) error {
+	if v != nil {
+		if v.Point != nil {
+			return visitor.VisitPoint(v.Point)
+		}
+		if v.Path != nil {
+			return visitor.VisitPath(v.Path)
+		}
+	}
+	return visitor.Default()
+}
+
+type Shape int32
+
+const (
+	ShapeCircle Shape = 0
+	ShapeSquare Shape = 1
+)
+
+// Shape_Values returns all recognized values of Shape.
+func Shape_Values() []Shape {
+	return []Shape{
+		ShapeCircle,
+		ShapeSquare,
+	}
+}
+
+// Shape_Names returns the names of all recognized values of
+// Shape, in the same order as Shape_Values.
+func Shape_Names() []string {
+	return []string{
+		"CIRCLE",
+		"SQUARE",
+	}
+}
+
+// IsValid returns true if this Shape is one of its recognized
+// values.
+func (v Shape) IsValid() bool {
+	switch int32(v) {
+	case 0, 1:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode Shape from a byte slice
+// containing its name.
+//
+//   var v Shape
+//   err := v.UnmarshalText([]byte("CIRCLE"))
+func (v *Shape) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "CIRCLE":
+		*v = ShapeCircle
+		return nil
+	case "SQUARE":
+		*v = ShapeSquare
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Shape", err)
+		}
+		*v = Shape(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Shape to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Shape) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("CIRCLE"), nil
+	case 1:
+		return []byte("SQUARE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Shape) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "CIRCLE")
+	case 1:
+		enc.AddString("name", "SQUARE")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Shape) Ptr() *Shape {
+	return &v
+}
+
+// Set sets Shape from its name or integer value.
+//
+// This implements flag.Value, allowing Shape to be used as a
+// command line flag.
+func (v *Shape) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Shape) Type() string {
+	return "Shape"
+}
+
+// Encode encodes Shape directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Shape
+//   return v.Encode(sWriter)
+func (v Shape) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates Shape into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Shape) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Shape from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Shape(0), err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return Shape(0), err
+//   }
+//   return v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	*v = (Shape)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded Shape directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Shape
+//   if err := v.Decode(sReader); err != nil {
+//     return Shape(0), err
+//   }
+//   return v, nil
+func (v *Shape) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (Shape)(i)
+	return nil
+}
+
+// String returns a readable string representation of Shape.
+func (v Shape) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "CIRCLE"
+	case 1:
+		return "SQUARE"
+	}
+	return fmt.Sprintf("Shape(%d)", w)
+}
+
+// Equals returns true if this Shape value matches the provided
+// value.
+func (v Shape) Equals(rhs Shape) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Shape into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Shape) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"CIRCLE\""), nil
+	case 1:
+		return ([]byte)("\"SQUARE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Shape from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Shape) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Shape")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Shape")
+		}
+		*v = (Shape)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Shape")
+	}
+}
+
+// GraphTypeSpec describes the Graph struct.
+var GraphTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "Graph",
+	Kind:     thriftreflect.KindStruct,
+	WireType: wire.TStruct,
+	Fields: []thriftreflect.FieldSpec{
+		{
+			ID:       1,
+			Name:     "points",
+			Type:     "list<Point>",
+			WireType: wire.TList,
+			Required: true,
+		},
+		{
+			ID:       2,
+			Name:     "shapes",
+			Type:     "map<Label, Shape>",
+			WireType: wire.TMap,
+			Annotations: map[string]string{
+				"docs.deprecated": "use points",
+			},
+		},
+		{
+			ID:       3,
+			Name:     "secret",
+			Type:     "string",
+			WireType: wire.TBinary,
+			Annotations: map[string]string{
+				"go.encrypt": "default",
+			},
+		},
+	},
+	New: func() thriftreflect.Value { return new(Graph) },
+	Annotations: map[string]string{
+		"owner": "graphs",
+	},
+}
+
+// GraphErrorTypeSpec describes the GraphError exception.
+var GraphErrorTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "GraphError",
+	Kind:     thriftreflect.KindException,
+	WireType: wire.TStruct,
+	Fields: []thriftreflect.FieldSpec{
+		{
+			ID:       1,
+			Name:     "message",
+			Type:     "string",
+			WireType: wire.TBinary,
+			Required: true,
+		},
+	},
+	New: func() thriftreflect.Value { return new(GraphError) },
+}
+
+// LabelTypeSpec describes the Label typedef.
+var LabelTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "Label",
+	Kind:     thriftreflect.KindTypedef,
+	WireType: wire.TBinary,
+	New:      func() thriftreflect.Value { return new(Label) },
+	Annotations: map[string]string{
+		"docs.example": "origin",
+	},
+}
+
+// PathTypeSpec describes the Path typedef.
+var PathTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "Path",
+	Kind:     thriftreflect.KindTypedef,
+	WireType: wire.TList,
+	New:      func() thriftreflect.Value { return new(Path) },
+}
+
+// PointTypeSpec describes the Point struct.
+var PointTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "Point",
+	Kind:     thriftreflect.KindStruct,
+	WireType: wire.TStruct,
+	Fields: []thriftreflect.FieldSpec{
+		{
+			ID:       1,
+			Name:     "x",
+			Type:     "double",
+			WireType: wire.TDouble,
+			Required: true,
+		},
+		{
+			ID:       2,
+			Name:     "y",
+			Type:     "double",
+			WireType: wire.TDouble,
+			Required: true,
+		},
+	},
+	New: func() thriftreflect.Value { return new(Point) },
+}
+
+// SelectionTypeSpec describes the Selection union.
+var SelectionTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "Selection",
+	Kind:     thriftreflect.KindUnion,
+	WireType: wire.TStruct,
+	Fields: []thriftreflect.FieldSpec{
+		{
+			ID:       1,
+			Name:     "point",
+			Type:     "Point",
+			WireType: wire.TStruct,
+		},
+		{
+			ID:       2,
+			Name:     "path",
+			Type:     "Path",
+			WireType: wire.TList,
+		},
+	},
+	New: func() thriftreflect.Value { return new(Selection) },
+}
+
+// ShapeTypeSpec describes the Shape enum.
+var ShapeTypeSpec = &thriftreflect.TypeSpec{
+	Name:     "Shape",
+	Kind:     thriftreflect.KindEnum,
+	WireType: wire.TI32,
+	New:      func() thriftreflect.Value { return new(Shape) },
+	Annotations: map[string]string{
+		"docs.category": "geometry",
+	},
+}
+
+// TypeSpecs describes the types defined in this package, keyed by
+// their names in the Thrift file.
+var TypeSpecs = map[string]*thriftreflect.TypeSpec{
+	"Graph":      GraphTypeSpec,
+	"GraphError": GraphErrorTypeSpec,
+	"Label":      LabelTypeSpec,
+	"Path":       PathTypeSpec,
+	"Point":      PointTypeSpec,
+	"Selection":  SelectionTypeSpec,
+	"Shape":      ShapeTypeSpec,
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "type-specs",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/type-specs",
+	FilePath: "type-specs.thrift",
+	SHA1:     "6ed87d70f4899cf9c1194a34147f560380435e9e",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "enum Shape {\n    CIRCLE,\n    SQUARE,\n} (docs.category = \"geometry\")\n\ntypedef list<Point> Path\n\ntypedef string Label (docs.example = \"origin\")\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Graph {\n    1: required list<Point> points\n    2: optional map<Label, Shape> shapes (docs.deprecated = \"use points\")\n    3: optional string secret (go.encrypt = \"default\")\n} (owner = \"graphs\")\n\nunion Selection {\n    1: Point point\n    2: Path path\n}\n\nexception GraphError {\n    1: required string message\n}\n"
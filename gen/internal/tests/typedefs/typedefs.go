@@ -14,6 +14,7 @@ import (
 	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -145,6 +146,39 @@ func _Set_Binary_sliceType_Equals(lhs, rhs [][]byte) bool {
 	return true
 }
 
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+func _Set_Binary_sliceType_Copy(v [][]byte) [][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(v))
+	for i, x := range v {
+		o[i] = _Binary_Copy(x)
+	}
+	return o
+}
+
+func _Set_Binary_sliceType_Hash(v [][]byte) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Binary(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Set_Binary_sliceType_Zapper [][]byte
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -200,6 +234,20 @@ func (lhs BinarySet) Equals(rhs BinarySet) bool {
 	return _Set_Binary_sliceType_Equals(([][]byte)(lhs), ([][]byte)(rhs))
 }
 
+// Copy returns a deep copy of this BinarySet.
+func (v BinarySet) Copy() BinarySet {
+	x := ([][]byte)(v)
+	return (BinarySet)(_Set_Binary_sliceType_Copy(x))
+}
+
+// Hash returns a hash of this BinarySet which is stable across
+// processes.
+func (v BinarySet) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Set_Binary_sliceType_Hash(([][]byte)(v)))
+	return h.Sum64()
+}
+
 func (v BinarySet) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Binary_sliceType_Zapper)(([][]byte)(v))).MarshalLogArray(enc)
 }
@@ -437,6 +485,46 @@ func (v *DefaultPrimitiveTypedef) Equals(rhs *DefaultPrimitiveTypedef) bool {
 	return true
 }
 
+func _State_CopyPtr(v *State) *State {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this DefaultPrimitiveTypedef.
+func (v *DefaultPrimitiveTypedef) Copy() *DefaultPrimitiveTypedef {
+	if v == nil {
+		return nil
+	}
+
+	var o DefaultPrimitiveTypedef
+	o.State = _State_CopyPtr(v.State)
+	return &o
+}
+
+// Hash returns a hash of this DefaultPrimitiveTypedef which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DefaultPrimitiveTypedef) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.State != nil {
+		h.Field(1)
+		h.String(string(*v.State))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DefaultPrimitiveTypedef so that it may be reused.
+func (v *DefaultPrimitiveTypedef) Reset() {
+	*v = DefaultPrimitiveTypedef{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DefaultPrimitiveTypedef.
 func (v *DefaultPrimitiveTypedef) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -676,6 +764,43 @@ func _Map_Edge_Edge_Equals(lhs, rhs []struct {
 	return true
 }
 
+func _Map_Edge_Edge_Copy(v []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}) []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value.Copy()
+	}
+	return o
+}
+
+func _Map_Edge_Edge_Hash(v []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.Uint64(x.Value.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Map_Edge_Edge_Item_Zapper struct {
 	Key   *structs.Edge
 	Value *structs.Edge
@@ -767,6 +892,26 @@ func (lhs EdgeMap) Equals(rhs EdgeMap) bool {
 	})(rhs))
 }
 
+// Copy returns a deep copy of this EdgeMap.
+func (v EdgeMap) Copy() EdgeMap {
+	x := ([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	})(v)
+	return (EdgeMap)(_Map_Edge_Edge_Copy(x))
+}
+
+// Hash returns a hash of this EdgeMap which is stable across
+// processes.
+func (v EdgeMap) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_Edge_Edge_Hash(([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	})(v)))
+	return h.Sum64()
+}
+
 func (v EdgeMap) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Map_Edge_Edge_Zapper)(([]struct {
 		Key   *structs.Edge
@@ -1045,6 +1190,49 @@ func (v *Event) Equals(rhs *Event) bool {
 	return true
 }
 
+func _Timestamp_CopyPtr(v *Timestamp) *Timestamp {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Event.
+func (v *Event) Copy() *Event {
+	if v == nil {
+		return nil
+	}
+
+	var o Event
+	o.UUID = v.UUID.Copy()
+	o.Time = _Timestamp_CopyPtr(v.Time)
+	return &o
+}
+
+// Hash returns a hash of this Event which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Event) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.UUID.Hash())
+	if v.Time != nil {
+		h.Field(2)
+		h.Int64(int64(*v.Time))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Event so that it may be reused.
+func (v *Event) Reset() {
+	*v = Event{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Event.
 func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1260,6 +1448,28 @@ func _List_Event_Equals(lhs, rhs []*Event) bool {
 	return true
 }
 
+func _List_Event_Copy(v []*Event) []*Event {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Event, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _List_Event_Hash(v []*Event) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
 type _List_Event_Zapper []*Event
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -1315,6 +1525,20 @@ func (lhs EventGroup) Equals(rhs EventGroup) bool {
 	return _List_Event_Equals(([]*Event)(lhs), ([]*Event)(rhs))
 }
 
+// Copy returns a deep copy of this EventGroup.
+func (v EventGroup) Copy() EventGroup {
+	x := ([]*Event)(v)
+	return (EventGroup)(_List_Event_Copy(x))
+}
+
+// Hash returns a hash of this EventGroup which is stable across
+// processes.
+func (v EventGroup) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_List_Event_Hash(([]*Event)(v)))
+	return h.Sum64()
+}
+
 func (v EventGroup) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_List_Event_Zapper)(([]*Event)(v))).MarshalLogArray(enc)
 }
@@ -1454,6 +1678,29 @@ func _Set_Frame_sliceType_Equals(lhs, rhs []*structs.Frame) bool {
 	return true
 }
 
+func _Set_Frame_sliceType_Copy(v []*structs.Frame) []*structs.Frame {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*structs.Frame, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Set_Frame_sliceType_Hash(v []*structs.Frame) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Set_Frame_sliceType_Zapper []*structs.Frame
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -1509,6 +1756,20 @@ func (lhs FrameGroup) Equals(rhs FrameGroup) bool {
 	return _Set_Frame_sliceType_Equals(([]*structs.Frame)(lhs), ([]*structs.Frame)(rhs))
 }
 
+// Copy returns a deep copy of this FrameGroup.
+func (v FrameGroup) Copy() FrameGroup {
+	x := ([]*structs.Frame)(v)
+	return (FrameGroup)(_Set_Frame_sliceType_Copy(x))
+}
+
+// Hash returns a hash of this FrameGroup which is stable across
+// processes.
+func (v FrameGroup) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Set_Frame_sliceType_Hash(([]*structs.Frame)(v)))
+	return h.Sum64()
+}
+
 func (v FrameGroup) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Frame_sliceType_Zapper)(([]*structs.Frame)(v))).MarshalLogArray(enc)
 }
@@ -1574,6 +1835,14 @@ func (lhs MyEnum) Equals(rhs MyEnum) bool {
 	return (enums.EnumWithValues)(lhs).Equals((enums.EnumWithValues)(rhs))
 }
 
+// Hash returns a hash of this MyEnum which is stable across
+// processes.
+func (v MyEnum) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int32(int32((enums.EnumWithValues)(v)))
+	return h.Sum64()
+}
+
 func (v MyEnum) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((enums.EnumWithValues)(v)).MarshalLogObject(enc)
 }
@@ -1618,6 +1887,20 @@ func (lhs *MyUUID) Equals(rhs *MyUUID) bool {
 	return (*UUID)(lhs).Equals((*UUID)(rhs))
 }
 
+// Copy returns a deep copy of this MyUUID.
+func (v *MyUUID) Copy() *MyUUID {
+	x := (*UUID)(v)
+	return (*MyUUID)(x.Copy())
+}
+
+// Hash returns a hash of this MyUUID which is stable across
+// processes.
+func (v *MyUUID) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*UUID)(v).Hash())
+	return h.Sum64()
+}
+
 func (v *MyUUID) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((*UUID)(v)).MarshalLogObject(enc)
 }
@@ -1666,6 +1949,20 @@ func (lhs PDF) Equals(rhs PDF) bool {
 	return bytes.Equal(([]byte)(lhs), ([]byte)(rhs))
 }
 
+// Copy returns a deep copy of this PDF.
+func (v PDF) Copy() PDF {
+	x := ([]byte)(v)
+	return (PDF)(_Binary_Copy(x))
+}
+
+// Hash returns a hash of this PDF which is stable across
+// processes.
+func (v PDF) Hash() uint64 {
+	h := thrifthash.New()
+	h.Binary(([]byte)(v))
+	return h.Sum64()
+}
+
 type _Map_Point_Point_MapItemList []struct {
 	Key   *structs.Point
 	Value *structs.Point
@@ -1878,6 +2175,43 @@ func _Map_Point_Point_Equals(lhs, rhs []struct {
 	return true
 }
 
+func _Map_Point_Point_Copy(v []struct {
+	Key   *structs.Point
+	Value *structs.Point
+}) []struct {
+	Key   *structs.Point
+	Value *structs.Point
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Copy()
+		o[i].Value = x.Value.Copy()
+	}
+	return o
+}
+
+func _Map_Point_Point_Hash(v []struct {
+	Key   *structs.Point
+	Value *structs.Point
+}) uint64 {
+
+	var u thrifthash.Unordered
+	for _, x := range v {
+		h := thrifthash.New()
+		h.Uint64(x.Key.Hash())
+		h.Uint64(x.Value.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Map_Point_Point_Item_Zapper struct {
 	Key   *structs.Point
 	Value *structs.Point
@@ -1969,6 +2303,26 @@ func (lhs PointMap) Equals(rhs PointMap) bool {
 	})(rhs))
 }
 
+// Copy returns a deep copy of this PointMap.
+func (v PointMap) Copy() PointMap {
+	x := ([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	})(v)
+	return (PointMap)(_Map_Point_Point_Copy(x))
+}
+
+// Hash returns a hash of this PointMap which is stable across
+// processes.
+func (v PointMap) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_Point_Point_Hash(([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	})(v)))
+	return h.Sum64()
+}
+
 func (v PointMap) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Map_Point_Point_Zapper)(([]struct {
 		Key   *structs.Point
@@ -2024,6 +2378,14 @@ func (lhs State) Equals(rhs State) bool {
 	return ((string)(lhs) == (string)(rhs))
 }
 
+// Hash returns a hash of this State which is stable across
+// processes.
+func (v State) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
 type _Map_State_I64_MapItemList map[State]int64
 
 func (m _Map_State_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
@@ -2167,6 +2529,30 @@ func _Map_State_I64_Equals(lhs, rhs map[State]int64) bool {
 	return true
 }
 
+func _Map_State_I64_Copy(v map[State]int64) map[State]int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[State]int64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_State_I64_Hash(v map[State]int64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(string(k))
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
 type _Map_State_I64_Zapper map[State]int64
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
@@ -2222,6 +2608,20 @@ func (lhs StateMap) Equals(rhs StateMap) bool {
 	return _Map_State_I64_Equals((map[State]int64)(lhs), (map[State]int64)(rhs))
 }
 
+// Copy returns a deep copy of this StateMap.
+func (v StateMap) Copy() StateMap {
+	x := (map[State]int64)(v)
+	return (StateMap)(_Map_State_I64_Copy(x))
+}
+
+// Hash returns a hash of this StateMap which is stable across
+// processes.
+func (v StateMap) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64(_Map_State_I64_Hash((map[State]int64)(v)))
+	return h.Sum64()
+}
+
 func (v StateMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((_Map_State_I64_Zapper)((map[State]int64)(v))).MarshalLogObject(enc)
 }
@@ -2286,6 +2686,14 @@ func (lhs StringReDef) Equals(rhs StringReDef) bool {
 	return ((stringdef.StringDef)(lhs) == (stringdef.StringDef)(rhs))
 }
 
+// Hash returns a hash of this StringReDef which is stable across
+// processes.
+func (v StringReDef) Hash() uint64 {
+	h := thrifthash.New()
+	h.String(string((stringdef.StringDef)(v)))
+	return h.Sum64()
+}
+
 // Number of seconds since epoch.
 //
 // Deprecated: Use ISOTime instead.
@@ -2338,6 +2746,14 @@ func (lhs Timestamp) Equals(rhs Timestamp) bool {
 	return ((int64)(lhs) == (int64)(rhs))
 }
 
+// Hash returns a hash of this Timestamp which is stable across
+// processes.
+func (v Timestamp) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int64((int64)(v))
+	return h.Sum64()
+}
+
 type Transition struct {
 	FromState State      `json:"fromState,required"`
 	ToState   State      `json:"toState,required"`
@@ -2624,6 +3040,41 @@ func (v *Transition) Equals(rhs *Transition) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Transition.
+func (v *Transition) Copy() *Transition {
+	if v == nil {
+		return nil
+	}
+
+	var o Transition
+	o.FromState = v.FromState
+	o.ToState = v.ToState
+	o.Events = v.Events.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Transition which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Transition) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.FromState))
+	h.Field(2)
+	h.String(string(v.ToState))
+	h.Field(3)
+	h.Uint64(v.Events.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Transition so that it may be reused.
+func (v *Transition) Reset() {
+	*v = Transition{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Transition.
 func (v *Transition) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2874,6 +3325,35 @@ func (v *TransitiveTypedefField) Equals(rhs *TransitiveTypedefField) bool {
 	return true
 }
 
+// Copy returns a deep copy of this TransitiveTypedefField.
+func (v *TransitiveTypedefField) Copy() *TransitiveTypedefField {
+	if v == nil {
+		return nil
+	}
+
+	var o TransitiveTypedefField
+	o.DefUUID = v.DefUUID.Copy()
+	return &o
+}
+
+// Hash returns a hash of this TransitiveTypedefField which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *TransitiveTypedefField) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.DefUUID.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this TransitiveTypedefField so that it may be reused.
+func (v *TransitiveTypedefField) Reset() {
+	*v = TransitiveTypedefField{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of TransitiveTypedefField.
 func (v *TransitiveTypedefField) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2938,6 +3418,20 @@ func (lhs *UUID) Equals(rhs *UUID) bool {
 	return (*I128)(lhs).Equals((*I128)(rhs))
 }
 
+// Copy returns a deep copy of this UUID.
+func (v *UUID) Copy() *UUID {
+	x := (*I128)(v)
+	return (*UUID)(x.Copy())
+}
+
+// Hash returns a hash of this UUID which is stable across
+// processes.
+func (v *UUID) Hash() uint64 {
+	h := thrifthash.New()
+	h.Uint64((*I128)(v).Hash())
+	return h.Sum64()
+}
+
 func (v *UUID) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((*I128)(v)).MarshalLogObject(enc)
 }
@@ -3174,6 +3668,38 @@ func (v *I128) Equals(rhs *I128) bool {
 	return true
 }
 
+// Copy returns a deep copy of this I128.
+func (v *I128) Copy() *I128 {
+	if v == nil {
+		return nil
+	}
+
+	var o I128
+	o.High = v.High
+	o.Low = v.Low
+	return &o
+}
+
+// Hash returns a hash of this I128 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *I128) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int64(v.High)
+	h.Field(2)
+	h.Int64(v.Low)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this I128 so that it may be reused.
+func (v *I128) Reset() {
+	*v = I128{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of I128.
 func (v *I128) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
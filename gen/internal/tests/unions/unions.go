@@ -11,6 +11,7 @@ import (
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -804,6 +805,126 @@ func (v *ArbitraryValue) Equals(rhs *ArbitraryValue) bool {
 	return true
 }
 
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_ArbitraryValue_Copy(v []*ArbitraryValue) []*ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*ArbitraryValue, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+func _Map_String_ArbitraryValue_Copy(v map[string]*ArbitraryValue) map[string]*ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*ArbitraryValue, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this ArbitraryValue.
+func (v *ArbitraryValue) Copy() *ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	var o ArbitraryValue
+	o.BoolValue = _Bool_CopyPtr(v.BoolValue)
+	o.Int64Value = _I64_CopyPtr(v.Int64Value)
+	o.StringValue = _String_CopyPtr(v.StringValue)
+	o.ListValue = _List_ArbitraryValue_Copy(v.ListValue)
+	o.MapValue = _Map_String_ArbitraryValue_Copy(v.MapValue)
+	return &o
+}
+
+func _List_ArbitraryValue_Hash(v []*ArbitraryValue) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+func _Map_String_ArbitraryValue_Hash(v map[string]*ArbitraryValue) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this ArbitraryValue which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ArbitraryValue) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.BoolValue != nil {
+		h.Field(1)
+		h.Bool(*v.BoolValue)
+	}
+	if v.Int64Value != nil {
+		h.Field(2)
+		h.Int64(*v.Int64Value)
+	}
+	if v.StringValue != nil {
+		h.Field(3)
+		h.String(*v.StringValue)
+	}
+	h.Field(4)
+	h.Uint64(_List_ArbitraryValue_Hash(v.ListValue))
+	h.Field(5)
+	h.Uint64(_Map_String_ArbitraryValue_Hash(v.MapValue))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ArbitraryValue so that it may be reused.
+func (v *ArbitraryValue) Reset() {
+	*v = ArbitraryValue{}
+}
+
 type _List_ArbitraryValue_Zapper []*ArbitraryValue
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -865,6 +986,22 @@ func (v *ArbitraryValue) IsSetBoolValue() bool {
 	return v != nil && v.BoolValue != nil
 }
 
+// LookupBoolValue returns the value of BoolValue and true if it is
+// set, or its zero value and false if another field is set.
+func (v *ArbitraryValue) LookupBoolValue() (o bool, _ bool) {
+	if v != nil && v.BoolValue != nil {
+		return *v.BoolValue, true
+	}
+	return o, false
+}
+
+// SetBoolValue sets the value of BoolValue and unsets all other
+// fields of this ArbitraryValue.
+func (v *ArbitraryValue) SetBoolValue(x bool) {
+	*v = ArbitraryValue{}
+	v.BoolValue = &x
+}
+
 // GetInt64Value returns the value of Int64Value if it is set or its
 // zero value if it is unset.
 func (v *ArbitraryValue) GetInt64Value() (o int64) {
@@ -880,6 +1017,22 @@ func (v *ArbitraryValue) IsSetInt64Value() bool {
 	return v != nil && v.Int64Value != nil
 }
 
+// LookupInt64Value returns the value of Int64Value and true if it is
+// set, or its zero value and false if another field is set.
+func (v *ArbitraryValue) LookupInt64Value() (o int64, _ bool) {
+	if v != nil && v.Int64Value != nil {
+		return *v.Int64Value, true
+	}
+	return o, false
+}
+
+// SetInt64Value sets the value of Int64Value and unsets all other
+// fields of this ArbitraryValue.
+func (v *ArbitraryValue) SetInt64Value(x int64) {
+	*v = ArbitraryValue{}
+	v.Int64Value = &x
+}
+
 // GetStringValue returns the value of StringValue if it is set or its
 // zero value if it is unset.
 func (v *ArbitraryValue) GetStringValue() (o string) {
@@ -895,6 +1048,22 @@ func (v *ArbitraryValue) IsSetStringValue() bool {
 	return v != nil && v.StringValue != nil
 }
 
+// LookupStringValue returns the value of StringValue and true if it is
+// set, or its zero value and false if another field is set.
+func (v *ArbitraryValue) LookupStringValue() (o string, _ bool) {
+	if v != nil && v.StringValue != nil {
+		return *v.StringValue, true
+	}
+	return o, false
+}
+
+// SetStringValue sets the value of StringValue and unsets all other
+// fields of this ArbitraryValue.
+func (v *ArbitraryValue) SetStringValue(x string) {
+	*v = ArbitraryValue{}
+	v.StringValue = &x
+}
+
 // GetListValue returns the value of ListValue if it is set or its
 // zero value if it is unset.
 func (v *ArbitraryValue) GetListValue() (o []*ArbitraryValue) {
@@ -910,6 +1079,22 @@ func (v *ArbitraryValue) IsSetListValue() bool {
 	return v != nil && v.ListValue != nil
 }
 
+// LookupListValue returns the value of ListValue and true if it is
+// set, or its zero value and false if another field is set.
+func (v *ArbitraryValue) LookupListValue() (o []*ArbitraryValue, _ bool) {
+	if v != nil && v.ListValue != nil {
+		return v.ListValue, true
+	}
+	return o, false
+}
+
+// SetListValue sets the value of ListValue and unsets all other
+// fields of this ArbitraryValue.
+func (v *ArbitraryValue) SetListValue(x []*ArbitraryValue) {
+	*v = ArbitraryValue{}
+	v.ListValue = x
+}
+
 // GetMapValue returns the value of MapValue if it is set or its
 // zero value if it is unset.
 func (v *ArbitraryValue) GetMapValue() (o map[string]*ArbitraryValue) {
@@ -925,6 +1110,95 @@ func (v *ArbitraryValue) IsSetMapValue() bool {
 	return v != nil && v.MapValue != nil
 }
 
+// LookupMapValue returns the value of MapValue and true if it is
+// set, or its zero value and false if another field is set.
+func (v *ArbitraryValue) LookupMapValue() (o map[string]*ArbitraryValue, _ bool) {
+	if v != nil && v.MapValue != nil {
+		return v.MapValue, true
+	}
+	return o, false
+}
+
+// SetMapValue sets the value of MapValue and unsets all other
+// fields of this ArbitraryValue.
+func (v *ArbitraryValue) SetMapValue(x map[string]*ArbitraryValue) {
+	*v = ArbitraryValue{}
+	v.MapValue = x
+}
+
+// Which returns the ID of the field of this ArbitraryValue which is set, or
+// 0 if no field is set.
+func (v *ArbitraryValue) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.BoolValue != nil {
+		return 1
+	}
+	if v.Int64Value != nil {
+		return 2
+	}
+	if v.StringValue != nil {
+		return 3
+	}
+	if v.ListValue != nil {
+		return 4
+	}
+	if v.MapValue != nil {
+		return 5
+	}
+	return 0
+}
+
+// ArbitraryValue_Visitor visits the field of a ArbitraryValue which is set.
+//
+// Fields added to ArbitraryValue add methods to ArbitraryValue_Visitor, so that
+// implementations which do not handle them fail to compile.
+type ArbitraryValue_Visitor interface {
+	// VisitBoolValue is called with the value of BoolValue if it is set.
+	VisitBoolValue(bool) error
+
+	// VisitInt64Value is called with the value of Int64Value if it is set.
+	VisitInt64Value(int64) error
+
+	// VisitStringValue is called with the value of StringValue if it is set.
+	VisitStringValue(string) error
+
+	// VisitListValue is called with the value of ListValue if it is set.
+	VisitListValue([]*ArbitraryValue) error
+
+	// VisitMapValue is called with the value of MapValue if it is set.
+	VisitMapValue(map[string]*ArbitraryValue) error
+
+	// Default is called if no field of ArbitraryValue is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this ArbitraryValue
+// which is set, or Default if none is, and returns its error.
+func (v *ArbitraryValue) Match(visitor ArbitraryValue_Visitor) error {
+	if v != nil {
+		if v.BoolValue != nil {
+			return visitor.VisitBoolValue(*v.BoolValue)
+		}
+		if v.Int64Value != nil {
+			return visitor.VisitInt64Value(*v.Int64Value)
+		}
+		if v.StringValue != nil {
+			return visitor.VisitStringValue(*v.StringValue)
+		}
+		if v.ListValue != nil {
+			return visitor.VisitListValue(v.ListValue)
+		}
+		if v.MapValue != nil {
+			return visitor.VisitMapValue(v.MapValue)
+		}
+	}
+	return visitor.Default()
+}
+
 type Document struct {
 	Pdf       typedefs.PDF `json:"pdf,omitempty"`
 	PlainText *string      `json:"plainText,omitempty"`
@@ -1200,6 +1474,40 @@ func (v *Document) Equals(rhs *Document) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Document.
+func (v *Document) Copy() *Document {
+	if v == nil {
+		return nil
+	}
+
+	var o Document
+	o.Pdf = v.Pdf.Copy()
+	o.PlainText = _String_CopyPtr(v.PlainText)
+	return &o
+}
+
+// Hash returns a hash of this Document which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Document) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Pdf.Hash())
+	if v.PlainText != nil {
+		h.Field(2)
+		h.String(*v.PlainText)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Document so that it may be reused.
+func (v *Document) Reset() {
+	*v = Document{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Document.
 func (v *Document) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1230,6 +1538,22 @@ func (v *Document) IsSetPdf() bool {
 	return v != nil && v.Pdf != nil
 }
 
+// LookupPdf returns the value of Pdf and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Document) LookupPdf() (o typedefs.PDF, _ bool) {
+	if v != nil && v.Pdf != nil {
+		return v.Pdf, true
+	}
+	return o, false
+}
+
+// SetPdf sets the value of Pdf and unsets all other
+// fields of this Document.
+func (v *Document) SetPdf(x typedefs.PDF) {
+	*v = Document{}
+	v.Pdf = x
+}
+
 // GetPlainText returns the value of PlainText if it is set or its
 // zero value if it is unset.
 func (v *Document) GetPlainText() (o string) {
@@ -1245,6 +1569,68 @@ func (v *Document) IsSetPlainText() bool {
 	return v != nil && v.PlainText != nil
 }
 
+// LookupPlainText returns the value of PlainText and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Document) LookupPlainText() (o string, _ bool) {
+	if v != nil && v.PlainText != nil {
+		return *v.PlainText, true
+	}
+	return o, false
+}
+
+// SetPlainText sets the value of PlainText and unsets all other
+// fields of this Document.
+func (v *Document) SetPlainText(x string) {
+	*v = Document{}
+	v.PlainText = &x
+}
+
+// Which returns the ID of the field of this Document which is set, or
+// 0 if no field is set.
+func (v *Document) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Pdf != nil {
+		return 1
+	}
+	if v.PlainText != nil {
+		return 2
+	}
+	return 0
+}
+
+// Document_Visitor visits the field of a Document which is set.
+//
+// Fields added to Document add methods to Document_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Document_Visitor interface {
+	// VisitPdf is called with the value of Pdf if it is set.
+	VisitPdf(typedefs.PDF) error
+
+	// VisitPlainText is called with the value of PlainText if it is set.
+	VisitPlainText(string) error
+
+	// Default is called if no field of Document is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Document
+// which is set, or Default if none is, and returns its error.
+func (v *Document) Match(visitor Document_Visitor) error {
+	if v != nil {
+		if v.Pdf != nil {
+			return visitor.VisitPdf(v.Pdf)
+		}
+		if v.PlainText != nil {
+			return visitor.VisitPlainText(*v.PlainText)
+		}
+	}
+	return visitor.Default()
+}
+
 type EmptyUnion struct {
 }
 
@@ -1378,6 +1764,32 @@ func (v *EmptyUnion) Equals(rhs *EmptyUnion) bool {
 	return true
 }
 
+// Copy returns a deep copy of this EmptyUnion.
+func (v *EmptyUnion) Copy() *EmptyUnion {
+	if v == nil {
+		return nil
+	}
+
+	var o EmptyUnion
+	return &o
+}
+
+// Hash returns a hash of this EmptyUnion which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *EmptyUnion) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this EmptyUnion so that it may be reused.
+func (v *EmptyUnion) Reset() {
+	*v = EmptyUnion{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of EmptyUnion.
 func (v *EmptyUnion) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1387,6 +1799,32 @@ func (v *EmptyUnion) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	return err
 }
 
+// Which returns the ID of the field of this EmptyUnion which is set, or
+// 0 if no field is set.
+func (v *EmptyUnion) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	return 0
+}
+
+// EmptyUnion_Visitor visits the field of a EmptyUnion which is set.
+//
+// Fields added to EmptyUnion add methods to EmptyUnion_Visitor, so that
+// implementations which do not handle them fail to compile.
+type EmptyUnion_Visitor interface {
+	// Default is called if no field of EmptyUnion is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this EmptyUnion
+// which is set, or Default if none is, and returns its error.
+func (v *EmptyUnion) Match(visitor EmptyUnion_Visitor) error {
+	return visitor.Default()
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "unions",
@@ -0,0 +1,1922 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package unknown_fields
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Address struct {
+	City *string `json:"city,omitempty"`
+
+	unknownFields binary.UnknownFields
+}
+
+// ToWire translates a Address struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Address) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.City != nil {
+		w, err = wire.NewValueString(*(v.City)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	all, err := v.unknownFields.ToWire(fields[:i])
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: all}), nil
+}
+
+// FromWire deserializes a Address struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Address struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Address
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Address) FromWire(w wire.Value) error {
+	var err error
+
+	v.unknownFields = nil
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.City = &x
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		default:
+			if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Address struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Address struct could not be encoded.
+func (v *Address) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.City != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.City)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := v.unknownFields.Encode(sw); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Address struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Address struct could not be generated from the wire
+// representation.
+func (v *Address) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	v.unknownFields = nil
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.City = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := v.unknownFields.Decode(fh, sr); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Address
+// struct.
+func (v *Address) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.City != nil {
+		fields[i] = fmt.Sprintf("City: %v", *(v.City))
+		i++
+	}
+
+	return fmt.Sprintf("Address{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Address match the
+// provided Address.
+//
+// This function performs a deep comparison.
+func (v *Address) Equals(rhs *Address) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.City, rhs.City) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Address.
+func (v *Address) Copy() *Address {
+	if v == nil {
+		return nil
+	}
+
+	var o Address
+	o.City = _String_CopyPtr(v.City)
+	o.unknownFields = v.unknownFields.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Address which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Address) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.City != nil {
+		h.Field(1)
+		h.String(*v.City)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Address so that it may be reused.
+func (v *Address) Reset() {
+	*v = Address{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Address.
+func (v *Address) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.City != nil {
+		enc.AddString("city", *v.City)
+	}
+	return err
+}
+
+// GetCity returns the value of City if it is set or its
+// zero value if it is unset.
+func (v *Address) GetCity() (o string) {
+	if v != nil && v.City != nil {
+		return *v.City
+	}
+
+	return
+}
+
+// IsSetCity returns true if City is not nil.
+func (v *Address) IsSetCity() bool {
+	return v != nil && v.City != nil
+}
+
+type DroppingUserV1 struct {
+	Name string `json:"name,required"`
+}
+
+// ToWire translates a DroppingUserV1 struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DroppingUserV1) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DroppingUserV1 struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DroppingUserV1 struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DroppingUserV1
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DroppingUserV1) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of DroppingUserV1 is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a DroppingUserV1 struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DroppingUserV1 struct could not be encoded.
+func (v *DroppingUserV1) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DroppingUserV1 struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DroppingUserV1 struct could not be generated from the wire
+// representation.
+func (v *DroppingUserV1) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of DroppingUserV1 is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DroppingUserV1
+// struct.
+func (v *DroppingUserV1) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+
+	return fmt.Sprintf("DroppingUserV1{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DroppingUserV1 match the
+// provided DroppingUserV1.
+//
+// This function performs a deep comparison.
+func (v *DroppingUserV1) Equals(rhs *DroppingUserV1) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this DroppingUserV1.
+func (v *DroppingUserV1) Copy() *DroppingUserV1 {
+	if v == nil {
+		return nil
+	}
+
+	var o DroppingUserV1
+	o.Name = v.Name
+	return &o
+}
+
+// Hash returns a hash of this DroppingUserV1 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *DroppingUserV1) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this DroppingUserV1 so that it may be reused.
+func (v *DroppingUserV1) Reset() {
+	*v = DroppingUserV1{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DroppingUserV1.
+func (v *DroppingUserV1) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DroppingUserV1) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// UserV1 is an older version of UserV2.
+type UserV1 struct {
+	Name string `json:"name,required"`
+
+	unknownFields binary.UnknownFields
+}
+
+// ToWire translates a UserV1 struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UserV1) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	all, err := v.unknownFields.ToWire(fields[:i])
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: all}), nil
+}
+
+// FromWire deserializes a UserV1 struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UserV1 struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UserV1
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UserV1) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	v.unknownFields = nil
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		default:
+			if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of UserV1 is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a UserV1 struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a UserV1 struct could not be encoded.
+func (v *UserV1) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := v.unknownFields.Encode(sw); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a UserV1 struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a UserV1 struct could not be generated from the wire
+// representation.
+func (v *UserV1) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	v.unknownFields = nil
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		default:
+			if err := v.unknownFields.Decode(fh, sr); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of UserV1 is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UserV1
+// struct.
+func (v *UserV1) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+
+	return fmt.Sprintf("UserV1{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UserV1 match the
+// provided UserV1.
+//
+// This function performs a deep comparison.
+func (v *UserV1) Equals(rhs *UserV1) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this UserV1.
+func (v *UserV1) Copy() *UserV1 {
+	if v == nil {
+		return nil
+	}
+
+	var o UserV1
+	o.Name = v.Name
+	o.unknownFields = v.unknownFields.Copy()
+	return &o
+}
+
+// Hash returns a hash of this UserV1 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *UserV1) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this UserV1 so that it may be reused.
+func (v *UserV1) Reset() {
+	*v = UserV1{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UserV1.
+func (v *UserV1) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *UserV1) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+type UserV2 struct {
+	Name    string             `json:"name,required"`
+	Age     *int32             `json:"age,omitempty"`
+	Emails  []string           `json:"emails,omitempty"`
+	Address *Address           `json:"address,omitempty"`
+	Scores  map[string]int64   `json:"scores,omitempty"`
+	Flags   map[int16]struct{} `json:"flags,omitempty"`
+
+	unknownFields binary.UnknownFields
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
+type _Set_I16_mapType_ValueList map[int16]struct{}
+
+func (v _Set_I16_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueI16(x), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_I16_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_I16_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI16
+}
+
+func (_Set_I16_mapType_ValueList) Close() {}
+
+// ToWire translates a UserV2 struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UserV2) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Age != nil {
+		w, err = wire.NewValueI32(*(v.Age)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Emails != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Emails)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Address != nil {
+		w, err = v.Address.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Scores != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.Scores)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Flags != nil {
+		w, err = wire.NewValueSet(_Set_I16_mapType_ValueList(v.Flags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	all, err := v.unknownFields.ToWire(fields[:i])
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: all}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Address_Read(w wire.Value) (*Address, error) {
+	var v Address
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_I16_mapType_Read(s wire.ValueList) (map[int16]struct{}, error) {
+	if s.ValueType() != wire.TI16 {
+		return nil, nil
+	}
+
+	o := make(map[int16]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := x.GetI16(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a UserV2 struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UserV2 struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UserV2
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UserV2) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	v.unknownFields = nil
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Age = &x
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Emails, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Address, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Scores, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Flags, err = _Set_I16_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			} else if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		default:
+			if err := v.unknownFields.FromWire(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of UserV2 is required")
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_I64_Encode(val map[string]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_I16_mapType_Encode(val map[int16]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI16,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteInt16(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a UserV2 struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a UserV2 struct could not be encoded.
+func (v *UserV2) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Age != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Age)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Emails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Emails, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Address != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Address.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Scores != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.Scores, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Flags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_I16_mapType_Encode(v.Flags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := v.unknownFields.Encode(sw); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Address_Decode(sr stream.Reader) (*Address, error) {
+	var v Address
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_I64_Decode(sr stream.Reader) (map[string]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_I16_mapType_Decode(sr stream.Reader) (map[int16]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI16 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[int16]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt16()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a UserV2 struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a UserV2 struct could not be generated from the wire
+// representation.
+func (v *UserV2) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	v.unknownFields = nil
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Age = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Emails, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.Address, err = _Address_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.Scores, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.Flags, err = _Set_I16_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := v.unknownFields.Decode(fh, sr); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of UserV2 is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UserV2
+// struct.
+func (v *UserV2) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+		i++
+	}
+	if v.Emails != nil {
+		fields[i] = fmt.Sprintf("Emails: %v", v.Emails)
+		i++
+	}
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", v.Address)
+		i++
+	}
+	if v.Scores != nil {
+		fields[i] = fmt.Sprintf("Scores: %v", v.Scores)
+		i++
+	}
+	if v.Flags != nil {
+		fields[i] = fmt.Sprintf("Flags: %v", v.Flags)
+		i++
+	}
+
+	return fmt.Sprintf("UserV2{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_I16_mapType_Equals(lhs, rhs map[int16]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this UserV2 match the
+// provided UserV2.
+//
+// This function performs a deep comparison.
+func (v *UserV2) Equals(rhs *UserV2) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Age, rhs.Age) {
+		return false
+	}
+	if !((v.Emails == nil && rhs.Emails == nil) || (v.Emails != nil && rhs.Emails != nil && _List_String_Equals(v.Emails, rhs.Emails))) {
+		return false
+	}
+	if !((v.Address == nil && rhs.Address == nil) || (v.Address != nil && rhs.Address != nil && v.Address.Equals(rhs.Address))) {
+		return false
+	}
+	if !((v.Scores == nil && rhs.Scores == nil) || (v.Scores != nil && rhs.Scores != nil && _Map_String_I64_Equals(v.Scores, rhs.Scores))) {
+		return false
+	}
+	if !((v.Flags == nil && rhs.Flags == nil) || (v.Flags != nil && rhs.Flags != nil && _Set_I16_mapType_Equals(v.Flags, rhs.Flags))) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_I64_Copy(v map[string]int64) map[string]int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Set_I16_mapType_Copy(v map[int16]struct{}) map[int16]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int16]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+// Copy returns a deep copy of this UserV2.
+func (v *UserV2) Copy() *UserV2 {
+	if v == nil {
+		return nil
+	}
+
+	var o UserV2
+	o.Name = v.Name
+	o.Age = _I32_CopyPtr(v.Age)
+	o.Emails = _List_String_Copy(v.Emails)
+	o.Address = v.Address.Copy()
+	o.Scores = _Map_String_I64_Copy(v.Scores)
+	o.Flags = _Set_I16_mapType_Copy(v.Flags)
+	o.unknownFields = v.unknownFields.Copy()
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_I64_Hash(v map[string]int64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Int64(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_I16_mapType_Hash(v map[int16]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int16(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this UserV2 which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *UserV2) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	if v.Age != nil {
+		h.Field(2)
+		h.Int32(*v.Age)
+	}
+	h.Field(3)
+	h.Uint64(_List_String_Hash(v.Emails))
+	h.Field(4)
+	h.Uint64(v.Address.Hash())
+	h.Field(5)
+	h.Uint64(_Map_String_I64_Hash(v.Scores))
+	h.Field(6)
+	h.Uint64(_Set_I16_mapType_Hash(v.Flags))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this UserV2 so that it may be reused.
+func (v *UserV2) Reset() {
+	*v = UserV2{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Map_String_I64_Zapper map[string]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
+	}
+	return err
+}
+
+type _Set_I16_mapType_Zapper map[int16]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_I16_mapType_Zapper.
+func (s _Set_I16_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendInt16(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UserV2.
+func (v *UserV2) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Age != nil {
+		enc.AddInt32("age", *v.Age)
+	}
+	if v.Emails != nil {
+		err = multierr.Append(err, enc.AddArray("emails", (_List_String_Zapper)(v.Emails)))
+	}
+	if v.Address != nil {
+		err = multierr.Append(err, enc.AddObject("address", v.Address))
+	}
+	if v.Scores != nil {
+		err = multierr.Append(err, enc.AddObject("scores", (_Map_String_I64_Zapper)(v.Scores)))
+	}
+	if v.Flags != nil {
+		err = multierr.Append(err, enc.AddArray("flags", (_Set_I16_mapType_Zapper)(v.Flags)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *UserV2) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetAge returns the value of Age if it is set or its
+// zero value if it is unset.
+func (v *UserV2) GetAge() (o int32) {
+	if v != nil && v.Age != nil {
+		return *v.Age
+	}
+
+	return
+}
+
+// IsSetAge returns true if Age is not nil.
+func (v *UserV2) IsSetAge() bool {
+	return v != nil && v.Age != nil
+}
+
+// GetEmails returns the value of Emails if it is set or its
+// zero value if it is unset.
+func (v *UserV2) GetEmails() (o []string) {
+	if v != nil && v.Emails != nil {
+		return v.Emails
+	}
+
+	return
+}
+
+// IsSetEmails returns true if Emails is not nil.
+func (v *UserV2) IsSetEmails() bool {
+	return v != nil && v.Emails != nil
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *UserV2) GetAddress() (o *Address) {
+	if v != nil && v.Address != nil {
+		return v.Address
+	}
+
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+func (v *UserV2) IsSetAddress() bool {
+	return v != nil && v.Address != nil
+}
+
+// GetScores returns the value of Scores if it is set or its
+// zero value if it is unset.
+func (v *UserV2) GetScores() (o map[string]int64) {
+	if v != nil && v.Scores != nil {
+		return v.Scores
+	}
+
+	return
+}
+
+// IsSetScores returns true if Scores is not nil.
+func (v *UserV2) IsSetScores() bool {
+	return v != nil && v.Scores != nil
+}
+
+// GetFlags returns the value of Flags if it is set or its
+// zero value if it is unset.
+func (v *UserV2) GetFlags() (o map[int16]struct{}) {
+	if v != nil && v.Flags != nil {
+		return v.Flags
+	}
+
+	return
+}
+
+// IsSetFlags returns true if Flags is not nil.
+func (v *UserV2) IsSetFlags() bool {
+	return v != nil && v.Flags != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "unknown-fields",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/unknown-fields",
+	FilePath: "unknown-fields.thrift",
+	SHA1:     "30b3d17ee7eab3ed448fbec45985d7b62a11ac85",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "struct Address {\n    1: optional string city\n}\n\n/** UserV1 is an older version of UserV2. */\nstruct UserV1 {\n    1: required string name\n}\n\nstruct UserV2 {\n    1: required string name\n    2: optional i32 age\n    3: optional list<string> emails\n    4: optional Address address\n    5: optional map<string, i64> scores\n    6: optional set<i16> flags\n}\n\nstruct DroppingUserV1 {\n    1: required string name\n} (go.preserve_unknown_fields = \"false\")\n"
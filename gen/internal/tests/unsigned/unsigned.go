@@ -0,0 +1,2845 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package unsigned
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	validate "go.uber.org/thriftrw/validate"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+const DefaultPort Port = Port(8080)
+
+const MaxHash Hash = Hash(18446744073709551615)
+
+const MaxUint16 uint16 = 65535
+
+type Endpoint struct {
+	Host       string              `json:"host,required"`
+	Port       uint32              `json:"port,required"`
+	BackupPort *Port               `json:"backupPort,omitempty"`
+	Checksum   *uint64             `json:"checksum,omitempty"`
+	Weight     *uint16             `json:"weight,omitempty"`
+	Digest     *Hash               `json:"digest,omitempty"`
+	Address    []Octet             `json:"address,omitempty"`
+	Counters   map[uint8]uint64    `json:"counters,omitempty"`
+	Ids        map[uint32]struct{} `json:"ids,omitempty"`
+	Signed     *int32              `json:"signed,omitempty"`
+}
+
+func _Port_ptr(v Port) *Port {
+	return &v
+}
+
+// Default_Endpoint constructs a new Endpoint struct,
+// pre-populating any fields with defined default values.
+func Default_Endpoint() *Endpoint {
+	var v Endpoint
+	v.BackupPort = _Port_ptr(DefaultPort)
+	return &v
+}
+
+type _List_Octet_ValueList []Octet
+
+func (v _List_Octet_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Octet_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Octet_ValueList) ValueType() wire.Type {
+	return wire.TI8
+}
+
+func (_List_Octet_ValueList) Close() {}
+
+type _Map_Byte_unsigned_I64_unsigned_MapItemList map[uint8]uint64
+
+func (m _Map_Byte_unsigned_I64_unsigned_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI8(int8(k)), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(int64(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Byte_unsigned_I64_unsigned_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Byte_unsigned_I64_unsigned_MapItemList) KeyType() wire.Type {
+	return wire.TI8
+}
+
+func (_Map_Byte_unsigned_I64_unsigned_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_Byte_unsigned_I64_unsigned_MapItemList) Close() {}
+
+type _Set_I32_unsigned_mapType_ValueList map[uint32]struct{}
+
+func (v _Set_I32_unsigned_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueI32(int32(x)), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_I32_unsigned_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_I32_unsigned_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_I32_unsigned_mapType_ValueList) Close() {}
+
+// ToWire translates a Endpoint struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Endpoint) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Host), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(int32(v.Port)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	vBackupPort := v.BackupPort
+	if vBackupPort == nil {
+		vBackupPort = _Port_ptr(DefaultPort)
+	}
+	{
+		w, err = vBackupPort.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Checksum != nil {
+		w, err = wire.NewValueI64(int64(*(v.Checksum))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Weight != nil {
+		w, err = wire.NewValueI16(int16(*(v.Weight))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Digest != nil {
+		w, err = v.Digest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Address != nil {
+		w, err = wire.NewValueList(_List_Octet_ValueList(v.Address)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Counters != nil {
+		w, err = wire.NewValueMap(_Map_Byte_unsigned_I64_unsigned_MapItemList(v.Counters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Ids != nil {
+		w, err = wire.NewValueSet(_Set_I32_unsigned_mapType_ValueList(v.Ids)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Signed != nil {
+		w, err = wire.NewValueI32(*(v.Signed)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Port_Read(w wire.Value) (Port, error) {
+	var x Port
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Hash_Read(w wire.Value) (Hash, error) {
+	var x Hash
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Octet_Read(w wire.Value) (Octet, error) {
+	var x Octet
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_Octet_Read(l wire.ValueList) ([]Octet, error) {
+	if l.ValueType() != wire.TI8 {
+		return nil, nil
+	}
+
+	o := make([]Octet, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Octet_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_Byte_unsigned_I64_unsigned_Read(m wire.MapItemList) (map[uint8]uint64, error) {
+	if m.KeyType() != wire.TI8 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[uint8]uint64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := uint8(x.Key.GetI8()), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := uint64(x.Value.GetI64()), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_I32_unsigned_mapType_Read(s wire.ValueList) (map[uint32]struct{}, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[uint32]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := uint32(x.GetI32()), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Endpoint struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Endpoint struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Endpoint
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Endpoint) FromWire(w wire.Value) error {
+	var err error
+
+	hostIsSet := false
+	portIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Host, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				hostIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Port, err = uint32(field.Value.GetI32()), error(nil)
+				if err != nil {
+					return err
+				}
+				portIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x Port
+				x, err = _Port_Read(field.Value)
+				v.BackupPort = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				var x uint64
+				x, err = uint64(field.Value.GetI64()), error(nil)
+				v.Checksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI16 {
+				var x uint16
+				x, err = uint16(field.Value.GetI16()), error(nil)
+				v.Weight = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TI64 {
+				var x Hash
+				x, err = _Hash_Read(field.Value)
+				v.Digest = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TList {
+				v.Address, err = _List_Octet_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.Counters, err = _Map_Byte_unsigned_I64_unsigned_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TSet {
+				v.Ids, err = _Set_I32_unsigned_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Signed = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !hostIsSet {
+		return errors.New("field Host of Endpoint is required")
+	}
+
+	if !portIsSet {
+		return errors.New("field Port of Endpoint is required")
+	}
+
+	if v.BackupPort == nil {
+		v.BackupPort = _Port_ptr(DefaultPort)
+	}
+
+	return nil
+}
+
+func _List_Octet_Encode(val []Octet, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI8,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []Octet
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_Byte_unsigned_I64_unsigned_Encode(val map[uint8]uint64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI8,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteInt8(int8(k)); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(int64(v)); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Set_I32_unsigned_mapType_Encode(val map[uint32]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteInt32(int32(v)); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+// Encode serializes a Endpoint struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Endpoint struct could not be encoded.
+func (v *Endpoint) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Host); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(int32(v.Port)); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	vBackupPort := v.BackupPort
+	if vBackupPort == nil {
+		vBackupPort = _Port_ptr(DefaultPort)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := vBackupPort.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Checksum != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(int64(*(v.Checksum))); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Weight != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(int16(*(v.Weight))); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Digest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := v.Digest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Address != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Octet_Encode(v.Address, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Counters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_Byte_unsigned_I64_unsigned_Encode(v.Counters, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Ids != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 9, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_I32_unsigned_mapType_Encode(v.Ids, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Signed != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Signed)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _I32_unsigned_Decode(sr stream.Reader) (uint32, error) {
+	i, err := sr.ReadInt32()
+	return uint32(i), err
+}
+
+func _Port_Decode(sr stream.Reader) (Port, error) {
+	var x Port
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _I64_unsigned_Decode(sr stream.Reader) (uint64, error) {
+	i, err := sr.ReadInt64()
+	return uint64(i), err
+}
+
+func _I16_unsigned_Decode(sr stream.Reader) (uint16, error) {
+	i, err := sr.ReadInt16()
+	return uint16(i), err
+}
+
+func _Hash_Decode(sr stream.Reader) (Hash, error) {
+	var x Hash
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Octet_Decode(sr stream.Reader) (Octet, error) {
+	var x Octet
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_Octet_Decode(sr stream.Reader) ([]Octet, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI8 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]Octet, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Octet_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Byte_unsigned_Decode(sr stream.Reader) (uint8, error) {
+	i, err := sr.ReadInt8()
+	return uint8(i), err
+}
+
+func _Map_Byte_unsigned_I64_unsigned_Decode(sr stream.Reader) (map[uint8]uint64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI8 || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[uint8]uint64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Byte_unsigned_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _I64_unsigned_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_I32_unsigned_mapType_Decode(sr stream.Reader) (map[uint32]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[uint32]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _I32_unsigned_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Endpoint struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Endpoint struct could not be generated from the wire
+// representation.
+func (v *Endpoint) Decode(sr stream.Reader) error {
+
+	hostIsSet := false
+	portIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Host, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			hostIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Port, err = _I32_unsigned_Decode(sr)
+			if err != nil {
+				return err
+			}
+			portIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x Port
+			x, err = _Port_Decode(sr)
+			v.BackupPort = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI64:
+			var x uint64
+			x, err = _I64_unsigned_Decode(sr)
+			v.Checksum = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TI16:
+			var x uint16
+			x, err = _I16_unsigned_Decode(sr)
+			v.Weight = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TI64:
+			var x Hash
+			x, err = _Hash_Decode(sr)
+			v.Digest = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TList:
+			v.Address, err = _List_Octet_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.Counters, err = _Map_Byte_unsigned_I64_unsigned_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TSet:
+			v.Ids, err = _Set_I32_unsigned_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Signed = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !hostIsSet {
+		return errors.New("field Host of Endpoint is required")
+	}
+
+	if !portIsSet {
+		return errors.New("field Port of Endpoint is required")
+	}
+
+	if v.BackupPort == nil {
+		v.BackupPort = _Port_ptr(DefaultPort)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Endpoint
+// struct.
+func (v *Endpoint) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [10]string
+	i := 0
+	fields[i] = fmt.Sprintf("Host: %v", v.Host)
+	i++
+	fields[i] = fmt.Sprintf("Port: %v", v.Port)
+	i++
+	if v.BackupPort != nil {
+		fields[i] = fmt.Sprintf("BackupPort: %v", *(v.BackupPort))
+		i++
+	}
+	if v.Checksum != nil {
+		fields[i] = fmt.Sprintf("Checksum: %v", *(v.Checksum))
+		i++
+	}
+	if v.Weight != nil {
+		fields[i] = fmt.Sprintf("Weight: %v", *(v.Weight))
+		i++
+	}
+	if v.Digest != nil {
+		fields[i] = fmt.Sprintf("Digest: %v", *(v.Digest))
+		i++
+	}
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", v.Address)
+		i++
+	}
+	if v.Counters != nil {
+		fields[i] = fmt.Sprintf("Counters: %v", v.Counters)
+		i++
+	}
+	if v.Ids != nil {
+		fields[i] = fmt.Sprintf("Ids: %v", v.Ids)
+		i++
+	}
+	if v.Signed != nil {
+		fields[i] = fmt.Sprintf("Signed: %v", *(v.Signed))
+		i++
+	}
+
+	return fmt.Sprintf("Endpoint{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Port_EqualsPtr(lhs, rhs *Port) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_unsigned_EqualsPtr(lhs, rhs *uint64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I16_unsigned_EqualsPtr(lhs, rhs *uint16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Hash_EqualsPtr(lhs, rhs *Hash) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Octet_Equals(lhs, rhs []Octet) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_Byte_unsigned_I64_unsigned_Equals(lhs, rhs map[uint8]uint64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_I32_unsigned_mapType_Equals(lhs, rhs map[uint32]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Endpoint match the
+// provided Endpoint.
+//
+// This function performs a deep comparison.
+func (v *Endpoint) Equals(rhs *Endpoint) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Host == rhs.Host) {
+		return false
+	}
+	if !(v.Port == rhs.Port) {
+		return false
+	}
+	if !_Port_EqualsPtr(v.BackupPort, rhs.BackupPort) {
+		return false
+	}
+	if !_I64_unsigned_EqualsPtr(v.Checksum, rhs.Checksum) {
+		return false
+	}
+	if !_I16_unsigned_EqualsPtr(v.Weight, rhs.Weight) {
+		return false
+	}
+	if !_Hash_EqualsPtr(v.Digest, rhs.Digest) {
+		return false
+	}
+	if !((v.Address == nil && rhs.Address == nil) || (v.Address != nil && rhs.Address != nil && _List_Octet_Equals(v.Address, rhs.Address))) {
+		return false
+	}
+	if !((v.Counters == nil && rhs.Counters == nil) || (v.Counters != nil && rhs.Counters != nil && _Map_Byte_unsigned_I64_unsigned_Equals(v.Counters, rhs.Counters))) {
+		return false
+	}
+	if !((v.Ids == nil && rhs.Ids == nil) || (v.Ids != nil && rhs.Ids != nil && _Set_I32_unsigned_mapType_Equals(v.Ids, rhs.Ids))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Signed, rhs.Signed) {
+		return false
+	}
+
+	return true
+}
+
+func _Port_CopyPtr(v *Port) *Port {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_unsigned_CopyPtr(v *uint64) *uint64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I16_unsigned_CopyPtr(v *uint16) *uint16 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Hash_CopyPtr(v *Hash) *Hash {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_Octet_Copy(v []Octet) []Octet {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Octet, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_Byte_unsigned_I64_unsigned_Copy(v map[uint8]uint64) map[uint8]uint64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[uint8]uint64, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Set_I32_unsigned_mapType_Copy(v map[uint32]struct{}) map[uint32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[uint32]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Endpoint.
+func (v *Endpoint) Copy() *Endpoint {
+	if v == nil {
+		return nil
+	}
+
+	var o Endpoint
+	o.Host = v.Host
+	o.Port = v.Port
+	o.BackupPort = _Port_CopyPtr(v.BackupPort)
+	o.Checksum = _I64_unsigned_CopyPtr(v.Checksum)
+	o.Weight = _I16_unsigned_CopyPtr(v.Weight)
+	o.Digest = _Hash_CopyPtr(v.Digest)
+	o.Address = _List_Octet_Copy(v.Address)
+	o.Counters = _Map_Byte_unsigned_I64_unsigned_Copy(v.Counters)
+	o.Ids = _Set_I32_unsigned_mapType_Copy(v.Ids)
+	o.Signed = _I32_CopyPtr(v.Signed)
+	return &o
+}
+
+func _List_Octet_Hash(v []Octet) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int8(int8(x))
+	}
+	return h.Sum64()
+}
+
+func _Map_Byte_unsigned_I64_unsigned_Hash(v map[uint8]uint64) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int8(int8(k))
+		h.Int64(int64(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Set_I32_unsigned_mapType_Hash(v map[uint32]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(x))
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Endpoint which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Endpoint) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Host)
+	h.Field(2)
+	h.Int32(int32(v.Port))
+	if v.BackupPort != nil {
+		h.Field(3)
+		h.Int32(int32(*v.BackupPort))
+	}
+	if v.Checksum != nil {
+		h.Field(4)
+		h.Int64(int64(*v.Checksum))
+	}
+	if v.Weight != nil {
+		h.Field(5)
+		h.Int16(int16(*v.Weight))
+	}
+	if v.Digest != nil {
+		h.Field(6)
+		h.Int64(int64(*v.Digest))
+	}
+	h.Field(7)
+	h.Uint64(_List_Octet_Hash(v.Address))
+	h.Field(8)
+	h.Uint64(_Map_Byte_unsigned_I64_unsigned_Hash(v.Counters))
+	h.Field(9)
+	h.Uint64(_Set_I32_unsigned_mapType_Hash(v.Ids))
+	if v.Signed != nil {
+		h.Field(10)
+		h.Int32(*v.Signed)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Endpoint so that it may be reused.
+func (v *Endpoint) Reset() {
+	*v = Endpoint{}
+}
+
+type _List_Octet_Zapper []Octet
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Octet_Zapper.
+func (l _List_Octet_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendUint8((uint8)(v))
+	}
+	return err
+}
+
+type _Map_Byte_unsigned_I64_unsigned_Item_Zapper struct {
+	Key   uint8
+	Value uint64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Byte_unsigned_I64_unsigned_Item_Zapper.
+func (v _Map_Byte_unsigned_I64_unsigned_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddUint8("key", v.Key)
+	enc.AddUint64("value", v.Value)
+	return err
+}
+
+type _Map_Byte_unsigned_I64_unsigned_Zapper map[uint8]uint64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Byte_unsigned_I64_unsigned_Zapper.
+func (m _Map_Byte_unsigned_I64_unsigned_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_Byte_unsigned_I64_unsigned_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Set_I32_unsigned_mapType_Zapper map[uint32]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_I32_unsigned_mapType_Zapper.
+func (s _Set_I32_unsigned_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendUint32(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Endpoint.
+func (v *Endpoint) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("host", v.Host)
+	enc.AddUint32("port", v.Port)
+	if v.BackupPort != nil {
+		enc.AddUint32("backupPort", (uint32)(*v.BackupPort))
+	}
+	if v.Checksum != nil {
+		enc.AddUint64("checksum", *v.Checksum)
+	}
+	if v.Weight != nil {
+		enc.AddUint16("weight", *v.Weight)
+	}
+	if v.Digest != nil {
+		enc.AddUint64("digest", (uint64)(*v.Digest))
+	}
+	if v.Address != nil {
+		err = multierr.Append(err, enc.AddArray("address", (_List_Octet_Zapper)(v.Address)))
+	}
+	if v.Counters != nil {
+		err = multierr.Append(err, enc.AddArray("counters", (_Map_Byte_unsigned_I64_unsigned_Zapper)(v.Counters)))
+	}
+	if v.Ids != nil {
+		err = multierr.Append(err, enc.AddArray("ids", (_Set_I32_unsigned_mapType_Zapper)(v.Ids)))
+	}
+	if v.Signed != nil {
+		enc.AddInt32("signed", *v.Signed)
+	}
+	return err
+}
+
+// GetHost returns the value of Host if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetHost() (o string) {
+	if v != nil {
+		o = v.Host
+	}
+	return
+}
+
+// GetPort returns the value of Port if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetPort() (o uint32) {
+	if v != nil {
+		o = v.Port
+	}
+	return
+}
+
+// GetBackupPort returns the value of BackupPort if it is set or its
+// default value if it is unset.
+func (v *Endpoint) GetBackupPort() (o Port) {
+	if v != nil && v.BackupPort != nil {
+		return *v.BackupPort
+	}
+	o = DefaultPort
+	return
+}
+
+// IsSetBackupPort returns true if BackupPort is not nil.
+func (v *Endpoint) IsSetBackupPort() bool {
+	return v != nil && v.BackupPort != nil
+}
+
+// GetChecksum returns the value of Checksum if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetChecksum() (o uint64) {
+	if v != nil && v.Checksum != nil {
+		return *v.Checksum
+	}
+
+	return
+}
+
+// IsSetChecksum returns true if Checksum is not nil.
+func (v *Endpoint) IsSetChecksum() bool {
+	return v != nil && v.Checksum != nil
+}
+
+// GetWeight returns the value of Weight if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetWeight() (o uint16) {
+	if v != nil && v.Weight != nil {
+		return *v.Weight
+	}
+
+	return
+}
+
+// IsSetWeight returns true if Weight is not nil.
+func (v *Endpoint) IsSetWeight() bool {
+	return v != nil && v.Weight != nil
+}
+
+// GetDigest returns the value of Digest if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetDigest() (o Hash) {
+	if v != nil && v.Digest != nil {
+		return *v.Digest
+	}
+
+	return
+}
+
+// IsSetDigest returns true if Digest is not nil.
+func (v *Endpoint) IsSetDigest() bool {
+	return v != nil && v.Digest != nil
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetAddress() (o []Octet) {
+	if v != nil && v.Address != nil {
+		return v.Address
+	}
+
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+func (v *Endpoint) IsSetAddress() bool {
+	return v != nil && v.Address != nil
+}
+
+// GetCounters returns the value of Counters if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetCounters() (o map[uint8]uint64) {
+	if v != nil && v.Counters != nil {
+		return v.Counters
+	}
+
+	return
+}
+
+// IsSetCounters returns true if Counters is not nil.
+func (v *Endpoint) IsSetCounters() bool {
+	return v != nil && v.Counters != nil
+}
+
+// GetIds returns the value of Ids if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetIds() (o map[uint32]struct{}) {
+	if v != nil && v.Ids != nil {
+		return v.Ids
+	}
+
+	return
+}
+
+// IsSetIds returns true if Ids is not nil.
+func (v *Endpoint) IsSetIds() bool {
+	return v != nil && v.Ids != nil
+}
+
+// GetSigned returns the value of Signed if it is set or its
+// zero value if it is unset.
+func (v *Endpoint) GetSigned() (o int32) {
+	if v != nil && v.Signed != nil {
+		return *v.Signed
+	}
+
+	return
+}
+
+// IsSetSigned returns true if Signed is not nil.
+func (v *Endpoint) IsSetSigned() bool {
+	return v != nil && v.Signed != nil
+}
+
+// Validate returns an error if this Endpoint does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *Endpoint) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Weight != nil && *v.Weight > 65535 {
+		return &validate.FieldError{
+			TypeName: "Endpoint",
+			Field:    "weight",
+			Reason:   "must be at most 65535",
+		}
+	}
+
+	return nil
+}
+
+type Hash uint64
+
+// HashPtr returns a pointer to a Hash
+func (v Hash) Ptr() *Hash {
+	return &v
+}
+
+// ToWire translates Hash into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Hash) ToWire() (wire.Value, error) {
+	x := (uint64)(v)
+	return wire.NewValueI64(int64(x)), error(nil)
+}
+
+// String returns a readable string representation of Hash.
+func (v Hash) String() string {
+	x := (uint64)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Hash) Encode(sw stream.Writer) error {
+	x := (uint64)(v)
+	return sw.WriteInt64(int64(x))
+}
+
+// FromWire deserializes Hash from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Hash) FromWire(w wire.Value) error {
+	x, err := uint64(w.GetI64()), error(nil)
+	*v = (Hash)(x)
+	return err
+}
+
+// Decode deserializes Hash directly off the wire.
+func (v *Hash) Decode(sr stream.Reader) error {
+	x, err := _I64_unsigned_Decode(sr)
+	*v = (Hash)(x)
+	return err
+}
+
+// Equals returns true if this Hash is equal to the provided
+// Hash.
+func (lhs Hash) Equals(rhs Hash) bool {
+	return ((uint64)(lhs) == (uint64)(rhs))
+}
+
+// Hash returns a hash of this Hash which is stable across
+// processes.
+func (v Hash) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int64(int64((uint64)(v)))
+	return h.Sum64()
+}
+
+type Octet uint8
+
+// OctetPtr returns a pointer to a Octet
+func (v Octet) Ptr() *Octet {
+	return &v
+}
+
+// ToWire translates Octet into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Octet) ToWire() (wire.Value, error) {
+	x := (uint8)(v)
+	return wire.NewValueI8(int8(x)), error(nil)
+}
+
+// String returns a readable string representation of Octet.
+func (v Octet) String() string {
+	x := (uint8)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Octet) Encode(sw stream.Writer) error {
+	x := (uint8)(v)
+	return sw.WriteInt8(int8(x))
+}
+
+// FromWire deserializes Octet from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Octet) FromWire(w wire.Value) error {
+	x, err := uint8(w.GetI8()), error(nil)
+	*v = (Octet)(x)
+	return err
+}
+
+// Decode deserializes Octet directly off the wire.
+func (v *Octet) Decode(sr stream.Reader) error {
+	x, err := _Byte_unsigned_Decode(sr)
+	*v = (Octet)(x)
+	return err
+}
+
+// Equals returns true if this Octet is equal to the provided
+// Octet.
+func (lhs Octet) Equals(rhs Octet) bool {
+	return ((uint8)(lhs) == (uint8)(rhs))
+}
+
+// Hash returns a hash of this Octet which is stable across
+// processes.
+func (v Octet) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int8(int8((uint8)(v)))
+	return h.Sum64()
+}
+
+type Overflow struct {
+	Limit *uint64 `json:"limit,omitempty"`
+}
+
+// ToWire translates a Overflow struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Overflow) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Limit != nil {
+		w, err = wire.NewValueI64(int64(*(v.Limit))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Overflow struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Overflow struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Overflow
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Overflow) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				var x uint64
+				x, err = uint64(field.Value.GetI64()), error(nil)
+				v.Limit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Overflow struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Overflow struct could not be encoded.
+func (v *Overflow) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Limit != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(int64(*(v.Limit))); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Overflow struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Overflow struct could not be generated from the wire
+// representation.
+func (v *Overflow) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			var x uint64
+			x, err = _I64_unsigned_Decode(sr)
+			v.Limit = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Overflow
+// struct.
+func (v *Overflow) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Limit != nil {
+		fields[i] = fmt.Sprintf("Limit: %v", *(v.Limit))
+		i++
+	}
+
+	return fmt.Sprintf("Overflow{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*Overflow) ErrorName() string {
+	return "Overflow"
+}
+
+// Equals returns true if all the fields of this Overflow match the
+// provided Overflow.
+//
+// This function performs a deep comparison.
+func (v *Overflow) Equals(rhs *Overflow) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_unsigned_EqualsPtr(v.Limit, rhs.Limit) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Overflow.
+func (v *Overflow) Copy() *Overflow {
+	if v == nil {
+		return nil
+	}
+
+	var o Overflow
+	o.Limit = _I64_unsigned_CopyPtr(v.Limit)
+	return &o
+}
+
+// Hash returns a hash of this Overflow which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Overflow) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Limit != nil {
+		h.Field(1)
+		h.Int64(int64(*v.Limit))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Overflow so that it may be reused.
+func (v *Overflow) Reset() {
+	*v = Overflow{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Overflow.
+func (v *Overflow) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Limit != nil {
+		enc.AddUint64("limit", *v.Limit)
+	}
+	return err
+}
+
+// GetLimit returns the value of Limit if it is set or its
+// zero value if it is unset.
+func (v *Overflow) GetLimit() (o uint64) {
+	if v != nil && v.Limit != nil {
+		return *v.Limit
+	}
+
+	return
+}
+
+// IsSetLimit returns true if Limit is not nil.
+func (v *Overflow) IsSetLimit() bool {
+	return v != nil && v.Limit != nil
+}
+
+func (v *Overflow) Error() string {
+	return v.String()
+}
+
+type Port uint32
+
+// PortPtr returns a pointer to a Port
+func (v Port) Ptr() *Port {
+	return &v
+}
+
+// ToWire translates Port into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Port) ToWire() (wire.Value, error) {
+	x := (uint32)(v)
+	return wire.NewValueI32(int32(x)), error(nil)
+}
+
+// String returns a readable string representation of Port.
+func (v Port) String() string {
+	x := (uint32)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v Port) Encode(sw stream.Writer) error {
+	x := (uint32)(v)
+	return sw.WriteInt32(int32(x))
+}
+
+// FromWire deserializes Port from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Port) FromWire(w wire.Value) error {
+	x, err := uint32(w.GetI32()), error(nil)
+	*v = (Port)(x)
+	return err
+}
+
+// Decode deserializes Port directly off the wire.
+func (v *Port) Decode(sr stream.Reader) error {
+	x, err := _I32_unsigned_Decode(sr)
+	*v = (Port)(x)
+	return err
+}
+
+// Equals returns true if this Port is equal to the provided
+// Port.
+func (lhs Port) Equals(rhs Port) bool {
+	return ((uint32)(lhs) == (uint32)(rhs))
+}
+
+// Hash returns a hash of this Port which is stable across
+// processes.
+func (v Port) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int32(int32((uint32)(v)))
+	return h.Sum64()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "unsigned",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/unsigned",
+	FilePath: "unsigned.thrift",
+	SHA1:     "16c0aaea026d98800d0e237d35cc38be2005319e",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef i32 Port (go.unsigned)\ntypedef i64 Hash (go.unsigned)\ntypedef i8 (go.unsigned) Octet\n\nconst Port DefaultPort = 8080\nconst i16 (go.unsigned) MaxUint16 = -1\nconst Hash MaxHash = -1\n\nstruct Endpoint {\n    1: required string host\n    2: required i32 port (go.unsigned)\n    3: optional Port backupPort = DefaultPort\n    4: optional i64 checksum (go.unsigned)\n    5: optional i16 weight (go.unsigned, validate.max = \"65535\")\n    6: optional Hash digest\n    7: optional list<Octet> address\n    8: optional map<i8 (go.unsigned), i64 (go.unsigned)> counters\n    9: optional set<i32 (go.unsigned)> ids\n   10: optional i32 signed\n}\n\nexception Overflow {\n    1: optional i64 limit (go.unsigned)\n}\n\nservice Endpoints {\n    i32 (go.unsigned) lookup(1: string host, 2: i16 weight (go.unsigned))\n        throws (1: Overflow overflow)\n}\n"
+
+// Endpoints_Lookup_Args represents the arguments for the Endpoints.lookup function.
+//
+// The arguments for lookup are sent and received over the wire as this struct.
+type Endpoints_Lookup_Args struct {
+	Host   *string `json:"host,omitempty"`
+	Weight *uint16 `json:"weight,omitempty"`
+}
+
+// ToWire translates a Endpoints_Lookup_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Endpoints_Lookup_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Host != nil {
+		w, err = wire.NewValueString(*(v.Host)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Weight != nil {
+		w, err = wire.NewValueI16(int16(*(v.Weight))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Endpoints_Lookup_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Endpoints_Lookup_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Endpoints_Lookup_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Endpoints_Lookup_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Host = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI16 {
+				var x uint16
+				x, err = uint16(field.Value.GetI16()), error(nil)
+				v.Weight = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Endpoints_Lookup_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Endpoints_Lookup_Args struct could not be encoded.
+func (v *Endpoints_Lookup_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Host != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Host)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Weight != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(int16(*(v.Weight))); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Endpoints_Lookup_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Endpoints_Lookup_Args struct could not be generated from the wire
+// representation.
+func (v *Endpoints_Lookup_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Host = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI16:
+			var x uint16
+			x, err = _I16_unsigned_Decode(sr)
+			v.Weight = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Endpoints_Lookup_Args
+// struct.
+func (v *Endpoints_Lookup_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Host != nil {
+		fields[i] = fmt.Sprintf("Host: %v", *(v.Host))
+		i++
+	}
+	if v.Weight != nil {
+		fields[i] = fmt.Sprintf("Weight: %v", *(v.Weight))
+		i++
+	}
+
+	return fmt.Sprintf("Endpoints_Lookup_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Endpoints_Lookup_Args match the
+// provided Endpoints_Lookup_Args.
+//
+// This function performs a deep comparison.
+func (v *Endpoints_Lookup_Args) Equals(rhs *Endpoints_Lookup_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Host, rhs.Host) {
+		return false
+	}
+	if !_I16_unsigned_EqualsPtr(v.Weight, rhs.Weight) {
+		return false
+	}
+
+	return true
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Endpoints_Lookup_Args.
+func (v *Endpoints_Lookup_Args) Copy() *Endpoints_Lookup_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Endpoints_Lookup_Args
+	o.Host = _String_CopyPtr(v.Host)
+	o.Weight = _I16_unsigned_CopyPtr(v.Weight)
+	return &o
+}
+
+// Hash returns a hash of this Endpoints_Lookup_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Endpoints_Lookup_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Host != nil {
+		h.Field(1)
+		h.String(*v.Host)
+	}
+	if v.Weight != nil {
+		h.Field(2)
+		h.Int16(int16(*v.Weight))
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Endpoints_Lookup_Args so that it may be reused.
+func (v *Endpoints_Lookup_Args) Reset() {
+	*v = Endpoints_Lookup_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Endpoints_Lookup_Args.
+func (v *Endpoints_Lookup_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Host != nil {
+		enc.AddString("host", *v.Host)
+	}
+	if v.Weight != nil {
+		enc.AddUint16("weight", *v.Weight)
+	}
+	return err
+}
+
+// GetHost returns the value of Host if it is set or its
+// zero value if it is unset.
+func (v *Endpoints_Lookup_Args) GetHost() (o string) {
+	if v != nil && v.Host != nil {
+		return *v.Host
+	}
+
+	return
+}
+
+// IsSetHost returns true if Host is not nil.
+func (v *Endpoints_Lookup_Args) IsSetHost() bool {
+	return v != nil && v.Host != nil
+}
+
+// GetWeight returns the value of Weight if it is set or its
+// zero value if it is unset.
+func (v *Endpoints_Lookup_Args) GetWeight() (o uint16) {
+	if v != nil && v.Weight != nil {
+		return *v.Weight
+	}
+
+	return
+}
+
+// IsSetWeight returns true if Weight is not nil.
+func (v *Endpoints_Lookup_Args) IsSetWeight() bool {
+	return v != nil && v.Weight != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "lookup" for this struct.
+func (v *Endpoints_Lookup_Args) MethodName() string {
+	return "lookup"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Endpoints_Lookup_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Endpoints_Lookup_Helper provides functions that aid in handling the
+// parameters and return values of the Endpoints.lookup
+// function.
+var Endpoints_Lookup_Helper = struct {
+	// Args accepts the parameters of lookup in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		host *string,
+		weight *uint16,
+	) *Endpoints_Lookup_Args
+
+	// IsException returns true if the given error can be thrown
+	// by lookup.
+	//
+	// An error can be thrown by lookup only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for lookup
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// lookup into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by lookup
+	//
+	//   value, err := lookup(args)
+	//   result, err := Endpoints_Lookup_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from lookup: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(uint32, error) (*Endpoints_Lookup_Result, error)
+
+	// UnwrapResponse takes the result struct for lookup
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if lookup threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Endpoints_Lookup_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Endpoints_Lookup_Result) (uint32, error)
+}{}
+
+func init() {
+	Endpoints_Lookup_Helper.Args = func(
+		host *string,
+		weight *uint16,
+	) *Endpoints_Lookup_Args {
+		return &Endpoints_Lookup_Args{
+			Host:   host,
+			Weight: weight,
+		}
+	}
+
+	Endpoints_Lookup_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *Overflow:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Endpoints_Lookup_Helper.WrapResponse = func(success uint32, err error) (*Endpoints_Lookup_Result, error) {
+		if err == nil {
+			return &Endpoints_Lookup_Result{Success: &success}, nil
+		}
+
+		switch e := err.(type) {
+		case *Overflow:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Endpoints_Lookup_Result.Overflow")
+			}
+			return &Endpoints_Lookup_Result{Overflow: e}, nil
+		}
+
+		return nil, err
+	}
+	Endpoints_Lookup_Helper.UnwrapResponse = func(result *Endpoints_Lookup_Result) (success uint32, err error) {
+		if result.Overflow != nil {
+			err = result.Overflow
+			return
+		}
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Endpoints_Lookup_Result represents the result of a Endpoints.lookup function call.
+//
+// The result of a lookup execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Endpoints_Lookup_Result struct {
+	// Value returned by lookup after a successful execution.
+	Success  *uint32   `json:"success,omitempty"`
+	Overflow *Overflow `json:"overflow,omitempty"`
+}
+
+// ToWire translates a Endpoints_Lookup_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Endpoints_Lookup_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueI32(int32(*(v.Success))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.Overflow != nil {
+		w, err = v.Overflow.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Endpoints_Lookup_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Overflow_Read(w wire.Value) (*Overflow, error) {
+	var v Overflow
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Endpoints_Lookup_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Endpoints_Lookup_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Endpoints_Lookup_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Endpoints_Lookup_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI32 {
+				var x uint32
+				x, err = uint32(field.Value.GetI32()), error(nil)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Overflow, err = _Overflow_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.Overflow != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Endpoints_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Endpoints_Lookup_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Endpoints_Lookup_Result struct could not be encoded.
+func (v *Endpoints_Lookup_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(int32(*(v.Success))); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Overflow != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Overflow.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.Overflow != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Endpoints_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Overflow_Decode(sr stream.Reader) (*Overflow, error) {
+	var v Overflow
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Endpoints_Lookup_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Endpoints_Lookup_Result struct could not be generated from the wire
+// representation.
+func (v *Endpoints_Lookup_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TI32:
+			var x uint32
+			x, err = _I32_unsigned_Decode(sr)
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Overflow, err = _Overflow_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.Overflow != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Endpoints_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Endpoints_Lookup_Result
+// struct.
+func (v *Endpoints_Lookup_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+	if v.Overflow != nil {
+		fields[i] = fmt.Sprintf("Overflow: %v", v.Overflow)
+		i++
+	}
+
+	return fmt.Sprintf("Endpoints_Lookup_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_unsigned_EqualsPtr(lhs, rhs *uint32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Endpoints_Lookup_Result match the
+// provided Endpoints_Lookup_Result.
+//
+// This function performs a deep comparison.
+func (v *Endpoints_Lookup_Result) Equals(rhs *Endpoints_Lookup_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_unsigned_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+	if !((v.Overflow == nil && rhs.Overflow == nil) || (v.Overflow != nil && rhs.Overflow != nil && v.Overflow.Equals(rhs.Overflow))) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_unsigned_CopyPtr(v *uint32) *uint32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Endpoints_Lookup_Result.
+func (v *Endpoints_Lookup_Result) Copy() *Endpoints_Lookup_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Endpoints_Lookup_Result
+	o.Success = _I32_unsigned_CopyPtr(v.Success)
+	o.Overflow = v.Overflow.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Endpoints_Lookup_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Endpoints_Lookup_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Int32(int32(*v.Success))
+	}
+	h.Field(1)
+	h.Uint64(v.Overflow.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Endpoints_Lookup_Result so that it may be reused.
+func (v *Endpoints_Lookup_Result) Reset() {
+	*v = Endpoints_Lookup_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Endpoints_Lookup_Result.
+func (v *Endpoints_Lookup_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddUint32("success", *v.Success)
+	}
+	if v.Overflow != nil {
+		err = multierr.Append(err, enc.AddObject("overflow", v.Overflow))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Endpoints_Lookup_Result) GetSuccess() (o uint32) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Endpoints_Lookup_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetOverflow returns the value of Overflow if it is set or its
+// zero value if it is unset.
+func (v *Endpoints_Lookup_Result) GetOverflow() (o *Overflow) {
+	if v != nil && v.Overflow != nil {
+		return v.Overflow
+	}
+
+	return
+}
+
+// IsSetOverflow returns true if Overflow is not nil.
+func (v *Endpoints_Lookup_Result) IsSetOverflow() bool {
+	return v != nil && v.Overflow != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "lookup" for this struct.
+func (v *Endpoints_Lookup_Result) MethodName() string {
+	return "lookup"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Endpoints_Lookup_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
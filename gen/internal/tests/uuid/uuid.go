@@ -0,0 +1,2706 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package uuid
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	domain "go.uber.org/thriftrw/gen/internal/tests/domain"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thriftuuid "go.uber.org/thriftrw/thriftuuid"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+var DefaultRequestID RequestID = RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+
+var NilUUID thriftuuid.UUID = thriftuuid.UUID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+type Request struct {
+	ID        thriftuuid.UUID              `json:"id,required"`
+	ParentID  *thriftuuid.UUID             `json:"parentID,omitempty"`
+	RequestID *RequestID                   `json:"requestID,omitempty"`
+	DomainID  *domain.UUID                 `json:"domainID,omitempty"`
+	Related   []thriftuuid.UUID            `json:"related,omitempty"`
+	Tags      map[thriftuuid.UUID]struct{} `json:"tags,omitempty"`
+	Labels    map[thriftuuid.UUID]string   `json:"labels,omitempty"`
+}
+
+func _RequestID_ptr(v RequestID) *RequestID {
+	return &v
+}
+
+// Default_Request constructs a new Request struct,
+// pre-populating any fields with defined default values.
+func Default_Request() *Request {
+	var v Request
+	v.RequestID = _RequestID_ptr(RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00})
+	return &v
+}
+
+type _List_UUID_ValueList []thriftuuid.UUID
+
+func (v _List_UUID_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueBinary(x.Bytes()), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_UUID_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_UUID_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_UUID_ValueList) Close() {}
+
+type _Set_UUID_mapType_ValueList map[thriftuuid.UUID]struct{}
+
+func (v _Set_UUID_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := wire.NewValueBinary(x.Bytes()), error(nil)
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_UUID_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_UUID_mapType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_UUID_mapType_ValueList) Close() {}
+
+type _Map_UUID_String_MapItemList map[thriftuuid.UUID]string
+
+func (m _Map_UUID_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueBinary(k.Bytes()), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_UUID_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_UUID_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_UUID_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_UUID_String_MapItemList) Close() {}
+
+// ToWire translates a Request struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Request) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueBinary(v.ID.Bytes()), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.ParentID != nil {
+		w, err = wire.NewValueBinary((*(v.ParentID)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	vRequestID := v.RequestID
+	if vRequestID == nil {
+		vRequestID = _RequestID_ptr(RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00})
+	}
+	{
+		w, err = vRequestID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.DomainID != nil {
+		w, err = wire.NewValueBinary(thriftuuid.UUID(*(v.DomainID)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Related != nil {
+		w, err = wire.NewValueList(_List_UUID_ValueList(v.Related)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_UUID_mapType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_UUID_String_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UUID_Read(w wire.Value) (thriftuuid.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return thriftuuid.UUID(u), err
+}
+
+func _RequestID_Read(w wire.Value) (RequestID, error) {
+	var x RequestID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _UUID_domain_UUID_Read(w wire.Value) (domain.UUID, error) {
+	u, err := thriftuuid.FromBytes(w.GetBinary())
+	return domain.UUID(u), err
+}
+
+func _List_UUID_Read(l wire.ValueList) ([]thriftuuid.UUID, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]thriftuuid.UUID, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _UUID_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_UUID_mapType_Read(s wire.ValueList) (map[thriftuuid.UUID]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[thriftuuid.UUID]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _UUID_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_UUID_String_Read(m wire.MapItemList) (map[thriftuuid.UUID]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[thriftuuid.UUID]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _UUID_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Request struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Request struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Request
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Request) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = _UUID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.ParentID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x RequestID
+				x, err = _RequestID_Read(field.Value)
+				v.RequestID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x domain.UUID
+				x, err = _UUID_domain_UUID_Read(field.Value)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Related, err = _List_UUID_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_UUID_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_UUID_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Request is required")
+	}
+
+	if v.RequestID == nil {
+		v.RequestID = _RequestID_ptr(RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00})
+	}
+
+	return nil
+}
+
+func _List_UUID_Encode(val []thriftuuid.UUID, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []thriftuuid.UUID
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteBinary(v.Bytes()); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Set_UUID_mapType_Encode(val map[thriftuuid.UUID]struct{}, sw stream.Writer) error {
+
+	sh := stream.SetHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+
+	if err := sw.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for v, _ := range val {
+
+		if err := sw.WriteBinary(v.Bytes()); err != nil {
+			return err
+		}
+	}
+	return sw.WriteSetEnd()
+}
+
+func _Map_UUID_String_Encode(val map[thriftuuid.UUID]string, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteBinary(k.Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Request struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Request struct could not be encoded.
+func (v *Request) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteBinary(v.ID.Bytes()); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.ParentID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.ParentID)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vRequestID := v.RequestID
+	if vRequestID == nil {
+		vRequestID = _RequestID_ptr(RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00})
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := vRequestID.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(thriftuuid.UUID(*(v.DomainID)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Related != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_UUID_Encode(v.Related, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TSet}); err != nil {
+			return err
+		}
+		if err := _Set_UUID_mapType_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_UUID_String_Encode(v.Labels, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _UUID_Decode(sr stream.Reader) (thriftuuid.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return thriftuuid.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return thriftuuid.UUID(u), err
+}
+
+func _RequestID_Decode(sr stream.Reader) (RequestID, error) {
+	var x RequestID
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _UUID_domain_UUID_Decode(sr stream.Reader) (domain.UUID, error) {
+	b, err := sr.ReadBinary()
+	if err != nil {
+		return domain.UUID{}, err
+	}
+	u, err := thriftuuid.FromBytes(b)
+	return domain.UUID(u), err
+}
+
+func _List_UUID_Decode(sr stream.Reader) ([]thriftuuid.UUID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]thriftuuid.UUID, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_UUID_mapType_Decode(sr stream.Reader) (map[thriftuuid.UUID]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[thriftuuid.UUID]struct{}, sh.Length)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_UUID_String_Decode(sr stream.Reader) (map[thriftuuid.UUID]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[thriftuuid.UUID]string, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Request struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Request struct could not be generated from the wire
+// representation.
+func (v *Request) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.ParentID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x RequestID
+			x, err = _RequestID_Decode(sr)
+			v.RequestID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x domain.UUID
+			x, err = _UUID_domain_UUID_Decode(sr)
+			v.DomainID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Related, err = _List_UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.Tags, err = _Set_UUID_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_UUID_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Request is required")
+	}
+
+	if v.RequestID == nil {
+		v.RequestID = _RequestID_ptr(RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00})
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Request
+// struct.
+func (v *Request) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.ParentID != nil {
+		fields[i] = fmt.Sprintf("ParentID: %v", *(v.ParentID))
+		i++
+	}
+	if v.RequestID != nil {
+		fields[i] = fmt.Sprintf("RequestID: %v", *(v.RequestID))
+		i++
+	}
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.Related != nil {
+		fields[i] = fmt.Sprintf("Related: %v", v.Related)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+
+	return fmt.Sprintf("Request{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _UUID_EqualsPtr(lhs, rhs *thriftuuid.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _RequestID_EqualsPtr(lhs, rhs *RequestID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _UUID_domain_UUID_EqualsPtr(lhs, rhs *domain.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_UUID_Equals(lhs, rhs []thriftuuid.UUID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_UUID_mapType_Equals(lhs, rhs map[thriftuuid.UUID]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_UUID_String_Equals(lhs, rhs map[thriftuuid.UUID]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Request match the
+// provided Request.
+//
+// This function performs a deep comparison.
+func (v *Request) Equals(rhs *Request) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.ParentID, rhs.ParentID) {
+		return false
+	}
+	if !_RequestID_EqualsPtr(v.RequestID, rhs.RequestID) {
+		return false
+	}
+	if !_UUID_domain_UUID_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !((v.Related == nil && rhs.Related == nil) || (v.Related != nil && rhs.Related != nil && _List_UUID_Equals(v.Related, rhs.Related))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_UUID_mapType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_UUID_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+
+	return true
+}
+
+func _UUID_CopyPtr(v *thriftuuid.UUID) *thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _RequestID_CopyPtr(v *RequestID) *RequestID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _UUID_domain_UUID_CopyPtr(v *domain.UUID) *domain.UUID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_UUID_Copy(v []thriftuuid.UUID) []thriftuuid.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]thriftuuid.UUID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_UUID_mapType_Copy(v map[thriftuuid.UUID]struct{}) map[thriftuuid.UUID]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[thriftuuid.UUID]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+	return o
+}
+
+func _Map_UUID_String_Copy(v map[thriftuuid.UUID]string) map[thriftuuid.UUID]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[thriftuuid.UUID]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Request.
+func (v *Request) Copy() *Request {
+	if v == nil {
+		return nil
+	}
+
+	var o Request
+	o.ID = v.ID
+	o.ParentID = _UUID_CopyPtr(v.ParentID)
+	o.RequestID = _RequestID_CopyPtr(v.RequestID)
+	o.DomainID = _UUID_domain_UUID_CopyPtr(v.DomainID)
+	o.Related = _List_UUID_Copy(v.Related)
+	o.Tags = _Set_UUID_mapType_Copy(v.Tags)
+	o.Labels = _Map_UUID_String_Copy(v.Labels)
+	return &o
+}
+
+func _List_UUID_Hash(v []thriftuuid.UUID) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Binary(x.Bytes())
+	}
+	return h.Sum64()
+}
+
+func _Set_UUID_mapType_Hash(v map[thriftuuid.UUID]struct{}) uint64 {
+
+	var u thrifthash.Unordered
+	for x := range v {
+		h := thrifthash.New()
+		h.Binary(x.Bytes())
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+func _Map_UUID_String_Hash(v map[thriftuuid.UUID]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Binary(k.Bytes())
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Request which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Request) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Binary(v.ID.Bytes())
+	if v.ParentID != nil {
+		h.Field(2)
+		h.Binary((*v.ParentID).Bytes())
+	}
+	if v.RequestID != nil {
+		h.Field(3)
+		h.Binary(thriftuuid.UUID(*v.RequestID).Bytes())
+	}
+	if v.DomainID != nil {
+		h.Field(4)
+		h.Binary(thriftuuid.UUID(*v.DomainID).Bytes())
+	}
+	h.Field(5)
+	h.Uint64(_List_UUID_Hash(v.Related))
+	h.Field(6)
+	h.Uint64(_Set_UUID_mapType_Hash(v.Tags))
+	h.Field(7)
+	h.Uint64(_Map_UUID_String_Hash(v.Labels))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Request so that it may be reused.
+func (v *Request) Reset() {
+	*v = Request{}
+}
+
+type _List_UUID_Zapper []thriftuuid.UUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_UUID_Zapper.
+func (l _List_UUID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v.String())
+	}
+	return err
+}
+
+type _Set_UUID_mapType_Zapper map[thriftuuid.UUID]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_UUID_mapType_Zapper.
+func (s _Set_UUID_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendString(v.String())
+	}
+	return err
+}
+
+type _Map_UUID_String_Item_Zapper struct {
+	Key   thriftuuid.UUID
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UUID_String_Item_Zapper.
+func (v _Map_UUID_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("key", v.Key.String())
+	enc.AddString("value", v.Value)
+	return err
+}
+
+type _Map_UUID_String_Zapper map[thriftuuid.UUID]string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UUID_String_Zapper.
+func (m _Map_UUID_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_UUID_String_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Request.
+func (v *Request) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID.String())
+	if v.ParentID != nil {
+		enc.AddString("parentID", (*v.ParentID).String())
+	}
+	if v.RequestID != nil {
+		enc.AddString("requestID", (thriftuuid.UUID)(*v.RequestID).String())
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", thriftuuid.UUID(*v.DomainID).String())
+	}
+	if v.Related != nil {
+		err = multierr.Append(err, enc.AddArray("related", (_List_UUID_Zapper)(v.Related)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_UUID_mapType_Zapper)(v.Tags)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_UUID_String_Zapper)(v.Labels)))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Request) GetID() (o thriftuuid.UUID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetParentID returns the value of ParentID if it is set or its
+// zero value if it is unset.
+func (v *Request) GetParentID() (o thriftuuid.UUID) {
+	if v != nil && v.ParentID != nil {
+		return *v.ParentID
+	}
+
+	return
+}
+
+// IsSetParentID returns true if ParentID is not nil.
+func (v *Request) IsSetParentID() bool {
+	return v != nil && v.ParentID != nil
+}
+
+// GetRequestID returns the value of RequestID if it is set or its
+// default value if it is unset.
+func (v *Request) GetRequestID() (o RequestID) {
+	if v != nil && v.RequestID != nil {
+		return *v.RequestID
+	}
+	o = RequestID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+	return
+}
+
+// IsSetRequestID returns true if RequestID is not nil.
+func (v *Request) IsSetRequestID() bool {
+	return v != nil && v.RequestID != nil
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *Request) GetDomainID() (o domain.UUID) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *Request) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetRelated returns the value of Related if it is set or its
+// zero value if it is unset.
+func (v *Request) GetRelated() (o []thriftuuid.UUID) {
+	if v != nil && v.Related != nil {
+		return v.Related
+	}
+
+	return
+}
+
+// IsSetRelated returns true if Related is not nil.
+func (v *Request) IsSetRelated() bool {
+	return v != nil && v.Related != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Request) GetTags() (o map[thriftuuid.UUID]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Request) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Request) GetLabels() (o map[thriftuuid.UUID]string) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Request) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+type RequestID thriftuuid.UUID
+
+// RequestIDPtr returns a pointer to a RequestID
+func (v RequestID) Ptr() *RequestID {
+	return &v
+}
+
+// ToWire translates RequestID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v RequestID) ToWire() (wire.Value, error) {
+	x := (thriftuuid.UUID)(v)
+	return wire.NewValueBinary(x.Bytes()), error(nil)
+}
+
+// String returns a readable string representation of RequestID.
+func (v RequestID) String() string {
+	x := (thriftuuid.UUID)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v RequestID) Encode(sw stream.Writer) error {
+	x := (thriftuuid.UUID)(v)
+	return sw.WriteBinary(x.Bytes())
+}
+
+// FromWire deserializes RequestID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *RequestID) FromWire(w wire.Value) error {
+	x, err := _UUID_Read(w)
+	*v = (RequestID)(x)
+	return err
+}
+
+// Decode deserializes RequestID directly off the wire.
+func (v *RequestID) Decode(sr stream.Reader) error {
+	x, err := _UUID_Decode(sr)
+	*v = (RequestID)(x)
+	return err
+}
+
+// Equals returns true if this RequestID is equal to the provided
+// RequestID.
+func (lhs RequestID) Equals(rhs RequestID) bool {
+	return ((thriftuuid.UUID)(lhs) == (thriftuuid.UUID)(rhs))
+}
+
+// Hash returns a hash of this RequestID which is stable across
+// processes.
+func (v RequestID) Hash() uint64 {
+	h := thrifthash.New()
+	h.Binary((thriftuuid.UUID)(v).Bytes())
+	return h.Sum64()
+}
+
+// MarshalText encodes RequestID in the canonical UUID format.
+func (v RequestID) MarshalText() ([]byte, error) {
+	return thriftuuid.UUID(v).MarshalText()
+}
+
+// UnmarshalText decodes RequestID from the canonical UUID format.
+func (v *RequestID) UnmarshalText(text []byte) error {
+	return (*thriftuuid.UUID)(v).UnmarshalText(text)
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "uuid",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/uuid",
+	FilePath: "uuid.thrift",
+	SHA1:     "20cdbc4da5be21cd2a6ee40bd06af942dda95ec9",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef uuid RequestID\n\nconst uuid NilUUID = \"00000000-0000-0000-0000-000000000000\"\nconst RequestID DefaultRequestID = \"123e4567-e89b-12d3-a456-426614174000\"\n\nstruct Request {\n    1: required uuid id\n    2: optional uuid parentID\n    3: optional RequestID requestID = DefaultRequestID\n    4: optional uuid (go.type = \"go.uber.org/thriftrw/gen/internal/tests/domain.UUID\") domainID\n    5: optional list<uuid> related\n    6: optional set<uuid> tags\n    7: optional map<uuid, string> labels\n}\n\nservice Requests {\n    uuid create(1: Request request)\n    Request lookup(1: required uuid id, 2: RequestID requestID)\n}\n"
+
+// Requests_Create_Args represents the arguments for the Requests.create function.
+//
+// The arguments for create are sent and received over the wire as this struct.
+type Requests_Create_Args struct {
+	Request *Request `json:"request,omitempty"`
+}
+
+// ToWire translates a Requests_Create_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Requests_Create_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Request_Read(w wire.Value) (*Request, error) {
+	var v Request
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Requests_Create_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Requests_Create_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Requests_Create_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Requests_Create_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _Request_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Requests_Create_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Requests_Create_Args struct could not be encoded.
+func (v *Requests_Create_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Request_Decode(sr stream.Reader) (*Request, error) {
+	var v Request
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Requests_Create_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Requests_Create_Args struct could not be generated from the wire
+// representation.
+func (v *Requests_Create_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _Request_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Requests_Create_Args
+// struct.
+func (v *Requests_Create_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("Requests_Create_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Requests_Create_Args match the
+// provided Requests_Create_Args.
+//
+// This function performs a deep comparison.
+func (v *Requests_Create_Args) Equals(rhs *Requests_Create_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Requests_Create_Args.
+func (v *Requests_Create_Args) Copy() *Requests_Create_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Requests_Create_Args
+	o.Request = v.Request.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Requests_Create_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Requests_Create_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Request.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Requests_Create_Args so that it may be reused.
+func (v *Requests_Create_Args) Reset() {
+	*v = Requests_Create_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Requests_Create_Args.
+func (v *Requests_Create_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *Requests_Create_Args) GetRequest() (o *Request) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *Requests_Create_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "create" for this struct.
+func (v *Requests_Create_Args) MethodName() string {
+	return "create"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Requests_Create_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Requests_Create_Helper provides functions that aid in handling the
+// parameters and return values of the Requests.create
+// function.
+var Requests_Create_Helper = struct {
+	// Args accepts the parameters of create in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *Request,
+	) *Requests_Create_Args
+
+	// IsException returns true if the given error can be thrown
+	// by create.
+	//
+	// An error can be thrown by create only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for create
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// create into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by create
+	//
+	//   value, err := create(args)
+	//   result, err := Requests_Create_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from create: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(thriftuuid.UUID, error) (*Requests_Create_Result, error)
+
+	// UnwrapResponse takes the result struct for create
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if create threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Requests_Create_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Requests_Create_Result) (thriftuuid.UUID, error)
+}{}
+
+func init() {
+	Requests_Create_Helper.Args = func(
+		request *Request,
+	) *Requests_Create_Args {
+		return &Requests_Create_Args{
+			Request: request,
+		}
+	}
+
+	Requests_Create_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Requests_Create_Helper.WrapResponse = func(success thriftuuid.UUID, err error) (*Requests_Create_Result, error) {
+		if err == nil {
+			return &Requests_Create_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Requests_Create_Helper.UnwrapResponse = func(result *Requests_Create_Result) (success thriftuuid.UUID, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Requests_Create_Result represents the result of a Requests.create function call.
+//
+// The result of a create execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Requests_Create_Result struct {
+	// Value returned by create after a successful execution.
+	Success *thriftuuid.UUID `json:"success,omitempty"`
+}
+
+// ToWire translates a Requests_Create_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Requests_Create_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = wire.NewValueBinary((*(v.Success)).Bytes()), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Requests_Create_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Requests_Create_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Requests_Create_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Requests_Create_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Requests_Create_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TBinary {
+				var x thriftuuid.UUID
+				x, err = _UUID_Read(field.Value)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Requests_Create_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Requests_Create_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Requests_Create_Result struct could not be encoded.
+func (v *Requests_Create_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary((*(v.Success)).Bytes()); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Requests_Create_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Requests_Create_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Requests_Create_Result struct could not be generated from the wire
+// representation.
+func (v *Requests_Create_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TBinary:
+			var x thriftuuid.UUID
+			x, err = _UUID_Decode(sr)
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Requests_Create_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Requests_Create_Result
+// struct.
+func (v *Requests_Create_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Requests_Create_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Requests_Create_Result match the
+// provided Requests_Create_Result.
+//
+// This function performs a deep comparison.
+func (v *Requests_Create_Result) Equals(rhs *Requests_Create_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Requests_Create_Result.
+func (v *Requests_Create_Result) Copy() *Requests_Create_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Requests_Create_Result
+	o.Success = _UUID_CopyPtr(v.Success)
+	return &o
+}
+
+// Hash returns a hash of this Requests_Create_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Requests_Create_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Success != nil {
+		h.Field(0)
+		h.Binary((*v.Success).Bytes())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Requests_Create_Result so that it may be reused.
+func (v *Requests_Create_Result) Reset() {
+	*v = Requests_Create_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Requests_Create_Result.
+func (v *Requests_Create_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddString("success", (*v.Success).String())
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Requests_Create_Result) GetSuccess() (o thriftuuid.UUID) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Requests_Create_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "create" for this struct.
+func (v *Requests_Create_Result) MethodName() string {
+	return "create"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Requests_Create_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Requests_Lookup_Args represents the arguments for the Requests.lookup function.
+//
+// The arguments for lookup are sent and received over the wire as this struct.
+type Requests_Lookup_Args struct {
+	ID        thriftuuid.UUID `json:"id,required"`
+	RequestID *RequestID      `json:"requestID,omitempty"`
+}
+
+// ToWire translates a Requests_Lookup_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Requests_Lookup_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueBinary(v.ID.Bytes()), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.RequestID != nil {
+		w, err = v.RequestID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Requests_Lookup_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Requests_Lookup_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Requests_Lookup_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Requests_Lookup_Args) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = _UUID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x RequestID
+				x, err = _RequestID_Read(field.Value)
+				v.RequestID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Requests_Lookup_Args is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Requests_Lookup_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Requests_Lookup_Args struct could not be encoded.
+func (v *Requests_Lookup_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteBinary(v.ID.Bytes()); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.RequestID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := v.RequestID.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Requests_Lookup_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Requests_Lookup_Args struct could not be generated from the wire
+// representation.
+func (v *Requests_Lookup_Args) Decode(sr stream.Reader) error {
+
+	idIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			idIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x RequestID
+			x, err = _RequestID_Decode(sr)
+			v.RequestID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Requests_Lookup_Args is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Requests_Lookup_Args
+// struct.
+func (v *Requests_Lookup_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.RequestID != nil {
+		fields[i] = fmt.Sprintf("RequestID: %v", *(v.RequestID))
+		i++
+	}
+
+	return fmt.Sprintf("Requests_Lookup_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Requests_Lookup_Args match the
+// provided Requests_Lookup_Args.
+//
+// This function performs a deep comparison.
+func (v *Requests_Lookup_Args) Equals(rhs *Requests_Lookup_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_RequestID_EqualsPtr(v.RequestID, rhs.RequestID) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Requests_Lookup_Args.
+func (v *Requests_Lookup_Args) Copy() *Requests_Lookup_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Requests_Lookup_Args
+	o.ID = v.ID
+	o.RequestID = _RequestID_CopyPtr(v.RequestID)
+	return &o
+}
+
+// Hash returns a hash of this Requests_Lookup_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Requests_Lookup_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Binary(v.ID.Bytes())
+	if v.RequestID != nil {
+		h.Field(2)
+		h.Binary(thriftuuid.UUID(*v.RequestID).Bytes())
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Requests_Lookup_Args so that it may be reused.
+func (v *Requests_Lookup_Args) Reset() {
+	*v = Requests_Lookup_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Requests_Lookup_Args.
+func (v *Requests_Lookup_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", v.ID.String())
+	if v.RequestID != nil {
+		enc.AddString("requestID", (thriftuuid.UUID)(*v.RequestID).String())
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Requests_Lookup_Args) GetID() (o thriftuuid.UUID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetRequestID returns the value of RequestID if it is set or its
+// zero value if it is unset.
+func (v *Requests_Lookup_Args) GetRequestID() (o RequestID) {
+	if v != nil && v.RequestID != nil {
+		return *v.RequestID
+	}
+
+	return
+}
+
+// IsSetRequestID returns true if RequestID is not nil.
+func (v *Requests_Lookup_Args) IsSetRequestID() bool {
+	return v != nil && v.RequestID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "lookup" for this struct.
+func (v *Requests_Lookup_Args) MethodName() string {
+	return "lookup"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Requests_Lookup_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Requests_Lookup_Helper provides functions that aid in handling the
+// parameters and return values of the Requests.lookup
+// function.
+var Requests_Lookup_Helper = struct {
+	// Args accepts the parameters of lookup in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id thriftuuid.UUID,
+		requestID *RequestID,
+	) *Requests_Lookup_Args
+
+	// IsException returns true if the given error can be thrown
+	// by lookup.
+	//
+	// An error can be thrown by lookup only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for lookup
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// lookup into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by lookup
+	//
+	//   value, err := lookup(args)
+	//   result, err := Requests_Lookup_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from lookup: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*Request, error) (*Requests_Lookup_Result, error)
+
+	// UnwrapResponse takes the result struct for lookup
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if lookup threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Requests_Lookup_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Requests_Lookup_Result) (*Request, error)
+}{}
+
+func init() {
+	Requests_Lookup_Helper.Args = func(
+		id thriftuuid.UUID,
+		requestID *RequestID,
+	) *Requests_Lookup_Args {
+		return &Requests_Lookup_Args{
+			ID:        id,
+			RequestID: requestID,
+		}
+	}
+
+	Requests_Lookup_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Requests_Lookup_Helper.WrapResponse = func(success *Request, err error) (*Requests_Lookup_Result, error) {
+		if err == nil {
+			return &Requests_Lookup_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Requests_Lookup_Helper.UnwrapResponse = func(result *Requests_Lookup_Result) (success *Request, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Requests_Lookup_Result represents the result of a Requests.lookup function call.
+//
+// The result of a lookup execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Requests_Lookup_Result struct {
+	// Value returned by lookup after a successful execution.
+	Success *Request `json:"success,omitempty"`
+}
+
+// ToWire translates a Requests_Lookup_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Requests_Lookup_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Requests_Lookup_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Requests_Lookup_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Requests_Lookup_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Requests_Lookup_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Requests_Lookup_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _Request_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Requests_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Requests_Lookup_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Requests_Lookup_Result struct could not be encoded.
+func (v *Requests_Lookup_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Requests_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Requests_Lookup_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Requests_Lookup_Result struct could not be generated from the wire
+// representation.
+func (v *Requests_Lookup_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _Request_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Requests_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Requests_Lookup_Result
+// struct.
+func (v *Requests_Lookup_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Requests_Lookup_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Requests_Lookup_Result match the
+// provided Requests_Lookup_Result.
+//
+// This function performs a deep comparison.
+func (v *Requests_Lookup_Result) Equals(rhs *Requests_Lookup_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Requests_Lookup_Result.
+func (v *Requests_Lookup_Result) Copy() *Requests_Lookup_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Requests_Lookup_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Requests_Lookup_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Requests_Lookup_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Requests_Lookup_Result so that it may be reused.
+func (v *Requests_Lookup_Result) Reset() {
+	*v = Requests_Lookup_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Requests_Lookup_Result.
+func (v *Requests_Lookup_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Requests_Lookup_Result) GetSuccess() (o *Request) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Requests_Lookup_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "lookup" for this struct.
+func (v *Requests_Lookup_Result) MethodName() string {
+	return "lookup"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Requests_Lookup_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
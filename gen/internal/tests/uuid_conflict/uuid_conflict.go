@@ -9,6 +9,7 @@ import (
 	multierr "go.uber.org/multierr"
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -63,6 +64,14 @@ func (lhs UUID) Equals(rhs UUID) bool {
 	return ((string)(lhs) == (string)(rhs))
 }
 
+// Hash returns a hash of this UUID which is stable across
+// processes.
+func (v UUID) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
 type UUIDConflict struct {
 	LocalUUID    UUID           `json:"localUUID,required"`
 	ImportedUUID *typedefs.UUID `json:"importedUUID,required"`
@@ -324,6 +333,38 @@ func (v *UUIDConflict) Equals(rhs *UUIDConflict) bool {
 	return true
 }
 
+// Copy returns a deep copy of this UUIDConflict.
+func (v *UUIDConflict) Copy() *UUIDConflict {
+	if v == nil {
+		return nil
+	}
+
+	var o UUIDConflict
+	o.LocalUUID = v.LocalUUID
+	o.ImportedUUID = v.ImportedUUID.Copy()
+	return &o
+}
+
+// Hash returns a hash of this UUIDConflict which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *UUIDConflict) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(string(v.LocalUUID))
+	h.Field(2)
+	h.Uint64(v.ImportedUUID.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this UUIDConflict so that it may be reused.
+func (v *UUIDConflict) Reset() {
+	*v = UUIDConflict{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of UUIDConflict.
 func (v *UUIDConflict) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -0,0 +1,2886 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package validate
+
+import (
+	bytes "bytes"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	validate "go.uber.org/thriftrw/validate"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	regexp "regexp"
+	runtime "runtime"
+	strings "strings"
+	sync "sync"
+)
+
+type Account struct {
+	Name     string            `json:"name,required"`
+	Slug     Slug              `json:"slug,required"`
+	Age      *int32            `json:"age,omitempty"`
+	Score    *float64          `json:"score,omitempty"`
+	Nickname *string           `json:"nickname,omitempty"`
+	Emails   []string          `json:"emails,omitempty"`
+	Labels   map[string]string `json:"labels,required"`
+	Avatar   []byte            `json:"avatar,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_String_MapItemList map[string]string
+
+func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) Close() {}
+
+// ToWire translates a Account struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Account) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = v.Slug.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Age != nil {
+		w, err = wire.NewValueI32(*(v.Age)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Score != nil {
+		w, err = wire.NewValueDouble(*(v.Score)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Nickname != nil {
+		w, err = wire.NewValueString(*(v.Nickname)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Emails != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Emails)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Labels == nil {
+		return w, errors.New("field Labels of Account is required")
+	}
+	w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Labels)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+	if v.Avatar != nil {
+		w, err = wire.NewValueBinary(v.Avatar), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Slug_Read(w wire.Value) (Slug, error) {
+	var x Slug
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Account struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Account struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Account
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Account) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	slugIsSet := false
+
+	labelsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Slug, err = _Slug_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				slugIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Age = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Score = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Nickname = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TList {
+				v.Emails, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				labelsIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.Avatar, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Account is required")
+	}
+
+	if !slugIsSet {
+		return errors.New("field Slug of Account is required")
+	}
+
+	if !labelsIsSet {
+		return errors.New("field Labels of Account is required")
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_String_String_Encode(val map[string]string, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a Account struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Account struct could not be encoded.
+func (v *Account) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := v.Slug.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Age != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Age)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Score != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.Score)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Nickname != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Nickname)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Emails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Emails, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Labels == nil {
+		return errors.New("field Labels of Account is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
+		return err
+	}
+	if err := _Map_String_String_Encode(v.Labels, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Avatar != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Avatar); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Slug_Decode(sr stream.Reader) (Slug, error) {
+	var x Slug
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]string, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Account struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Account struct could not be generated from the wire
+// representation.
+func (v *Account) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+	slugIsSet := false
+
+	labelsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Slug, err = _Slug_Decode(sr)
+			if err != nil {
+				return err
+			}
+			slugIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Age = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Score = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Nickname = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TList:
+			v.Emails, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Labels, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			labelsIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.Avatar, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Account is required")
+	}
+
+	if !slugIsSet {
+		return errors.New("field Slug of Account is required")
+	}
+
+	if !labelsIsSet {
+		return errors.New("field Labels of Account is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Account
+// struct.
+func (v *Account) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Slug: %v", v.Slug)
+	i++
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+		i++
+	}
+	if v.Score != nil {
+		fields[i] = fmt.Sprintf("Score: %v", *(v.Score))
+		i++
+	}
+	if v.Nickname != nil {
+		fields[i] = fmt.Sprintf("Nickname: %v", *(v.Nickname))
+		i++
+	}
+	if v.Emails != nil {
+		fields[i] = fmt.Sprintf("Emails: %v", v.Emails)
+		i++
+	}
+	fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+	i++
+	if v.Avatar != nil {
+		fields[i] = fmt.Sprintf("Avatar: %v", v.Avatar)
+		i++
+	}
+
+	return fmt.Sprintf("Account{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Account match the
+// provided Account.
+//
+// This function performs a deep comparison.
+func (v *Account) Equals(rhs *Account) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.Slug == rhs.Slug) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Age, rhs.Age) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.Score, rhs.Score) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Nickname, rhs.Nickname) {
+		return false
+	}
+	if !((v.Emails == nil && rhs.Emails == nil) || (v.Emails != nil && rhs.Emails != nil && _List_String_Equals(v.Emails, rhs.Emails))) {
+		return false
+	}
+	if !_Map_String_String_Equals(v.Labels, rhs.Labels) {
+		return false
+	}
+	if !((v.Avatar == nil && rhs.Avatar == nil) || (v.Avatar != nil && rhs.Avatar != nil && bytes.Equal(v.Avatar, rhs.Avatar))) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_CopyPtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_CopyPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Binary_Copy(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
+}
+
+// Copy returns a deep copy of this Account.
+func (v *Account) Copy() *Account {
+	if v == nil {
+		return nil
+	}
+
+	var o Account
+	o.Name = v.Name
+	o.Slug = v.Slug
+	o.Age = _I32_CopyPtr(v.Age)
+	o.Score = _Double_CopyPtr(v.Score)
+	o.Nickname = _String_CopyPtr(v.Nickname)
+	o.Emails = _List_String_Copy(v.Emails)
+	o.Labels = _Map_String_String_Copy(v.Labels)
+	o.Avatar = _Binary_Copy(v.Avatar)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Account which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Account) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.String(string(v.Slug))
+	if v.Age != nil {
+		h.Field(3)
+		h.Int32(*v.Age)
+	}
+	if v.Score != nil {
+		h.Field(4)
+		h.Double(*v.Score)
+	}
+	if v.Nickname != nil {
+		h.Field(5)
+		h.String(*v.Nickname)
+	}
+	h.Field(6)
+	h.Uint64(_List_String_Hash(v.Emails))
+	h.Field(7)
+	h.Uint64(_Map_String_String_Hash(v.Labels))
+	h.Field(8)
+	h.Binary(v.Avatar)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Account so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Account) Reset() {
+	for k := range v.Labels {
+		delete(v.Labels, k)
+	}
+	*v = Account{
+		Labels: v.Labels,
+	}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Account.
+func (v *Account) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddString("slug", (string)(v.Slug))
+	if v.Age != nil {
+		enc.AddInt32("age", *v.Age)
+	}
+	if v.Score != nil {
+		enc.AddFloat64("score", *v.Score)
+	}
+	if v.Nickname != nil {
+		enc.AddString("nickname", *v.Nickname)
+	}
+	if v.Emails != nil {
+		err = multierr.Append(err, enc.AddArray("emails", (_List_String_Zapper)(v.Emails)))
+	}
+	err = multierr.Append(err, enc.AddObject("labels", (_Map_String_String_Zapper)(v.Labels)))
+	if v.Avatar != nil {
+		enc.AddString("avatar", base64.StdEncoding.EncodeToString(v.Avatar))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Account) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetSlug returns the value of Slug if it is set or its
+// zero value if it is unset.
+func (v *Account) GetSlug() (o Slug) {
+	if v != nil {
+		o = v.Slug
+	}
+	return
+}
+
+// GetAge returns the value of Age if it is set or its
+// zero value if it is unset.
+func (v *Account) GetAge() (o int32) {
+	if v != nil && v.Age != nil {
+		return *v.Age
+	}
+
+	return
+}
+
+// IsSetAge returns true if Age is not nil.
+func (v *Account) IsSetAge() bool {
+	return v != nil && v.Age != nil
+}
+
+// GetScore returns the value of Score if it is set or its
+// zero value if it is unset.
+func (v *Account) GetScore() (o float64) {
+	if v != nil && v.Score != nil {
+		return *v.Score
+	}
+
+	return
+}
+
+// IsSetScore returns true if Score is not nil.
+func (v *Account) IsSetScore() bool {
+	return v != nil && v.Score != nil
+}
+
+// GetNickname returns the value of Nickname if it is set or its
+// zero value if it is unset.
+func (v *Account) GetNickname() (o string) {
+	if v != nil && v.Nickname != nil {
+		return *v.Nickname
+	}
+
+	return
+}
+
+// IsSetNickname returns true if Nickname is not nil.
+func (v *Account) IsSetNickname() bool {
+	return v != nil && v.Nickname != nil
+}
+
+// GetEmails returns the value of Emails if it is set or its
+// zero value if it is unset.
+func (v *Account) GetEmails() (o []string) {
+	if v != nil && v.Emails != nil {
+		return v.Emails
+	}
+
+	return
+}
+
+// IsSetEmails returns true if Emails is not nil.
+func (v *Account) IsSetEmails() bool {
+	return v != nil && v.Emails != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Account) GetLabels() (o map[string]string) {
+	if v != nil {
+		o = v.Labels
+	}
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Account) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetAvatar returns the value of Avatar if it is set or its
+// zero value if it is unset.
+func (v *Account) GetAvatar() (o []byte) {
+	if v != nil && v.Avatar != nil {
+		return v.Avatar
+	}
+
+	return
+}
+
+// IsSetAvatar returns true if Avatar is not nil.
+func (v *Account) IsSetAvatar() bool {
+	return v != nil && v.Avatar != nil
+}
+
+var _Account_Slug_Pattern = regexp.MustCompile("^[a-z0-9-]+$")
+
+var _Account_Nickname_Pattern = regexp.MustCompile("^[A-Za-z]+$")
+
+// Validate returns an error if this Account does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *Account) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	if len(v.Name) == 0 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "name",
+			Reason:   "must not be empty",
+		}
+	}
+	if len(v.Slug) > 16 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "slug",
+			Reason:   "must have a length of at most 16",
+		}
+	}
+	if !_Account_Slug_Pattern.MatchString(string(v.Slug)) {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "slug",
+			Reason:   "must match \"^[a-z0-9-]+$\"",
+		}
+	}
+	if v.Age != nil && *v.Age < 0 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "age",
+			Reason:   "must be at least 0",
+		}
+	}
+	if v.Age != nil && *v.Age > 150 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "age",
+			Reason:   "must be at most 150",
+		}
+	}
+	if v.Score != nil && *v.Score < -1.5 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "score",
+			Reason:   "must be at least -1.5",
+		}
+	}
+	if v.Score != nil && *v.Score > 1.5 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "score",
+			Reason:   "must be at most 1.5",
+		}
+	}
+	if v.Nickname != nil && len(*v.Nickname) < 2 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "nickname",
+			Reason:   "must have a length of at least 2",
+		}
+	}
+	if v.Nickname != nil && !_Account_Nickname_Pattern.MatchString(*v.Nickname) {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "nickname",
+			Reason:   "must match \"^[A-Za-z]+$\"",
+		}
+	}
+	if len(v.Emails) == 0 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "emails",
+			Reason:   "must be set and non-empty",
+		}
+	}
+	if v.Emails != nil && len(v.Emails) > 3 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "emails",
+			Reason:   "must have a length of at most 3",
+		}
+	}
+	if len(v.Labels) > 2 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "labels",
+			Reason:   "must have a length of at most 2",
+		}
+	}
+	if v.Avatar != nil && len(v.Avatar) > 4 {
+		return &validate.FieldError{
+			TypeName: "Account",
+			Field:    "avatar",
+			Reason:   "must have a length of at most 4",
+		}
+	}
+
+	return nil
+}
+
+type Invite struct {
+	Email string `json:"email,required"`
+	Uses  *int8  `json:"uses,omitempty"`
+}
+
+// ToWire translates a Invite struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Invite) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Email), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Uses != nil {
+		w, err = wire.NewValueI8(*(v.Uses)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Invite struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Invite struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Invite
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Invite) FromWire(w wire.Value) error {
+	var err error
+
+	emailIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Email, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				emailIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				var x int8
+				x, err = field.Value.GetI8(), error(nil)
+				v.Uses = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !emailIsSet {
+		return errors.New("field Email of Invite is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Invite struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Invite struct could not be encoded.
+func (v *Invite) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Email); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Uses != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI8}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt8(*(v.Uses)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Invite struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Invite struct could not be generated from the wire
+// representation.
+func (v *Invite) Decode(sr stream.Reader) error {
+
+	emailIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Email, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			emailIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			var x int8
+			x, err = sr.ReadInt8()
+			v.Uses = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !emailIsSet {
+		return errors.New("field Email of Invite is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Invite
+// struct.
+func (v *Invite) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Email: %v", v.Email)
+	i++
+	if v.Uses != nil {
+		fields[i] = fmt.Sprintf("Uses: %v", *(v.Uses))
+		i++
+	}
+
+	return fmt.Sprintf("Invite{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Byte_EqualsPtr(lhs, rhs *int8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Invite match the
+// provided Invite.
+//
+// This function performs a deep comparison.
+func (v *Invite) Equals(rhs *Invite) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Email == rhs.Email) {
+		return false
+	}
+	if !_Byte_EqualsPtr(v.Uses, rhs.Uses) {
+		return false
+	}
+
+	return true
+}
+
+func _Byte_CopyPtr(v *int8) *int8 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Invite.
+func (v *Invite) Copy() *Invite {
+	if v == nil {
+		return nil
+	}
+
+	var o Invite
+	o.Email = v.Email
+	o.Uses = _Byte_CopyPtr(v.Uses)
+	return &o
+}
+
+// Hash returns a hash of this Invite which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Invite) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Email)
+	if v.Uses != nil {
+		h.Field(2)
+		h.Int8(*v.Uses)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Invite so that it may be reused.
+func (v *Invite) Reset() {
+	*v = Invite{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Invite.
+func (v *Invite) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("email", v.Email)
+	if v.Uses != nil {
+		enc.AddInt8("uses", *v.Uses)
+	}
+	return err
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+func (v *Invite) GetEmail() (o string) {
+	if v != nil {
+		o = v.Email
+	}
+	return
+}
+
+// GetUses returns the value of Uses if it is set or its
+// zero value if it is unset.
+func (v *Invite) GetUses() (o int8) {
+	if v != nil && v.Uses != nil {
+		return *v.Uses
+	}
+
+	return
+}
+
+// IsSetUses returns true if Uses is not nil.
+func (v *Invite) IsSetUses() bool {
+	return v != nil && v.Uses != nil
+}
+
+var _Invite_Email_Pattern = regexp.MustCompile("@")
+
+// Validate returns an error if this Invite does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *Invite) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	if !_Invite_Email_Pattern.MatchString(v.Email) {
+		return &validate.FieldError{
+			TypeName: "Invite",
+			Field:    "email",
+			Reason:   "must match \"@\"",
+		}
+	}
+	if v.Uses != nil && *v.Uses < 1 {
+		return &validate.FieldError{
+			TypeName: "Invite",
+			Field:    "uses",
+			Reason:   "must be at least 1",
+		}
+	}
+
+	this := make(map[string]interface{}, 2)
+	this["email"] = v.Email
+	if v.Uses != nil {
+		this["uses"] = *v.Uses
+	}
+
+	if err := validate.CEL("Invite", "this.email != \"\"", this); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type OptionalRange struct {
+	Low  *int64   `json:"low,omitempty"`
+	High *int64   `json:"high,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ToWire translates a OptionalRange struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *OptionalRange) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Low != nil {
+		w, err = wire.NewValueI64(*(v.Low)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.High != nil {
+		w, err = wire.NewValueI64(*(v.High)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a OptionalRange struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a OptionalRange struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v OptionalRange
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *OptionalRange) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Low = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.High = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a OptionalRange struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a OptionalRange struct could not be encoded.
+func (v *OptionalRange) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Low != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Low)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.High != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.High)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a OptionalRange struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a OptionalRange struct could not be generated from the wire
+// representation.
+func (v *OptionalRange) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Low = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.High = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a OptionalRange
+// struct.
+func (v *OptionalRange) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Low != nil {
+		fields[i] = fmt.Sprintf("Low: %v", *(v.Low))
+		i++
+	}
+	if v.High != nil {
+		fields[i] = fmt.Sprintf("High: %v", *(v.High))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+
+	return fmt.Sprintf("OptionalRange{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this OptionalRange match the
+// provided OptionalRange.
+//
+// This function performs a deep comparison.
+func (v *OptionalRange) Equals(rhs *OptionalRange) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Low, rhs.Low) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.High, rhs.High) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_CopyPtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this OptionalRange.
+func (v *OptionalRange) Copy() *OptionalRange {
+	if v == nil {
+		return nil
+	}
+
+	var o OptionalRange
+	o.Low = _I64_CopyPtr(v.Low)
+	o.High = _I64_CopyPtr(v.High)
+	o.Tags = _List_String_Copy(v.Tags)
+	return &o
+}
+
+// Hash returns a hash of this OptionalRange which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *OptionalRange) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.Low != nil {
+		h.Field(1)
+		h.Int64(*v.Low)
+	}
+	if v.High != nil {
+		h.Field(2)
+		h.Int64(*v.High)
+	}
+	h.Field(3)
+	h.Uint64(_List_String_Hash(v.Tags))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this OptionalRange so that it may be reused.
+func (v *OptionalRange) Reset() {
+	*v = OptionalRange{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of OptionalRange.
+func (v *OptionalRange) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Low != nil {
+		enc.AddInt64("low", *v.Low)
+	}
+	if v.High != nil {
+		enc.AddInt64("high", *v.High)
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	return err
+}
+
+// GetLow returns the value of Low if it is set or its
+// zero value if it is unset.
+func (v *OptionalRange) GetLow() (o int64) {
+	if v != nil && v.Low != nil {
+		return *v.Low
+	}
+
+	return
+}
+
+// IsSetLow returns true if Low is not nil.
+func (v *OptionalRange) IsSetLow() bool {
+	return v != nil && v.Low != nil
+}
+
+// GetHigh returns the value of High if it is set or its
+// zero value if it is unset.
+func (v *OptionalRange) GetHigh() (o int64) {
+	if v != nil && v.High != nil {
+		return *v.High
+	}
+
+	return
+}
+
+// IsSetHigh returns true if High is not nil.
+func (v *OptionalRange) IsSetHigh() bool {
+	return v != nil && v.High != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *OptionalRange) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *OptionalRange) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// Validate returns an error if this OptionalRange does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *OptionalRange) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	this := make(map[string]interface{}, 3)
+	if v.Low != nil {
+		this["low"] = *v.Low
+	}
+	if v.High != nil {
+		this["high"] = *v.High
+	}
+	if v.Tags != nil {
+		this["tags"] = v.Tags
+	}
+
+	if err := validate.CEL("OptionalRange", "!has(this.low) || !has(this.high) || this.low <= this.high", this); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type Range struct {
+	Min int32 `json:"min,required"`
+	Max int32 `json:"max,required"`
+}
+
+// ToWire translates a Range struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Range) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI32(v.Min), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Max), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Range struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Range struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Range
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Range) FromWire(w wire.Value) error {
+	var err error
+
+	minIsSet := false
+	maxIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Min, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				minIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Max, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				maxIsSet = true
+			}
+		}
+	}
+
+	if !minIsSet {
+		return errors.New("field Min of Range is required")
+	}
+
+	if !maxIsSet {
+		return errors.New("field Max of Range is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Range struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Range struct could not be encoded.
+func (v *Range) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Min); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt32(v.Max); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Range struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Range struct could not be generated from the wire
+// representation.
+func (v *Range) Decode(sr stream.Reader) error {
+
+	minIsSet := false
+	maxIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.Min, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			minIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Max, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			maxIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !minIsSet {
+		return errors.New("field Min of Range is required")
+	}
+
+	if !maxIsSet {
+		return errors.New("field Max of Range is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Range
+// struct.
+func (v *Range) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Min: %v", v.Min)
+	i++
+	fields[i] = fmt.Sprintf("Max: %v", v.Max)
+	i++
+
+	return fmt.Sprintf("Range{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Range match the
+// provided Range.
+//
+// This function performs a deep comparison.
+func (v *Range) Equals(rhs *Range) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Min == rhs.Min) {
+		return false
+	}
+	if !(v.Max == rhs.Max) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Range.
+func (v *Range) Copy() *Range {
+	if v == nil {
+		return nil
+	}
+
+	var o Range
+	o.Min = v.Min
+	o.Max = v.Max
+	return &o
+}
+
+// Hash returns a hash of this Range which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Range) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(v.Min)
+	h.Field(2)
+	h.Int32(v.Max)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Range so that it may be reused.
+func (v *Range) Reset() {
+	*v = Range{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Range.
+func (v *Range) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt32("min", v.Min)
+	enc.AddInt32("max", v.Max)
+	return err
+}
+
+// GetMin returns the value of Min if it is set or its
+// zero value if it is unset.
+func (v *Range) GetMin() (o int32) {
+	if v != nil {
+		o = v.Min
+	}
+	return
+}
+
+// GetMax returns the value of Max if it is set or its
+// zero value if it is unset.
+func (v *Range) GetMax() (o int32) {
+	if v != nil {
+		o = v.Max
+	}
+	return
+}
+
+// Validate returns an error if this Range does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *Range) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	this := make(map[string]interface{}, 2)
+	this["min"] = v.Min
+	this["max"] = v.Max
+
+	if err := validate.CEL("Range", "this.min <= this.max", this); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type RangeOrName struct {
+	Range *Range  `json:"range,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// ToWire translates a RangeOrName struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RangeOrName) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Range != nil {
+		w, err = v.Range.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("RangeOrName should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Range_Read(w wire.Value) (*Range, error) {
+	var v Range
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RangeOrName struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RangeOrName struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RangeOrName
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RangeOrName) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Range, err = _Range_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Range != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("RangeOrName should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a RangeOrName struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a RangeOrName struct could not be encoded.
+func (v *RangeOrName) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Range != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Range.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Range != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("RangeOrName should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Range_Decode(sr stream.Reader) (*Range, error) {
+	var v Range
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a RangeOrName struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a RangeOrName struct could not be generated from the wire
+// representation.
+func (v *RangeOrName) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Range, err = _Range_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Range != nil {
+		count++
+	}
+	if v.Name != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("RangeOrName should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RangeOrName
+// struct.
+func (v *RangeOrName) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Range != nil {
+		fields[i] = fmt.Sprintf("Range: %v", v.Range)
+		i++
+	}
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("RangeOrName{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RangeOrName match the
+// provided RangeOrName.
+//
+// This function performs a deep comparison.
+func (v *RangeOrName) Equals(rhs *RangeOrName) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Range == nil && rhs.Range == nil) || (v.Range != nil && rhs.Range != nil && v.Range.Equals(rhs.Range))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this RangeOrName.
+func (v *RangeOrName) Copy() *RangeOrName {
+	if v == nil {
+		return nil
+	}
+
+	var o RangeOrName
+	o.Range = v.Range.Copy()
+	o.Name = _String_CopyPtr(v.Name)
+	return &o
+}
+
+// Hash returns a hash of this RangeOrName which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *RangeOrName) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Range.Hash())
+	if v.Name != nil {
+		h.Field(2)
+		h.String(*v.Name)
+	}
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this RangeOrName so that it may be reused.
+func (v *RangeOrName) Reset() {
+	*v = RangeOrName{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RangeOrName.
+func (v *RangeOrName) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Range != nil {
+		err = multierr.Append(err, enc.AddObject("range", v.Range))
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetRange returns the value of Range if it is set or its
+// zero value if it is unset.
+func (v *RangeOrName) GetRange() (o *Range) {
+	if v != nil && v.Range != nil {
+		return v.Range
+	}
+
+	return
+}
+
+// IsSetRange returns true if Range is not nil.
+func (v *RangeOrName) IsSetRange() bool {
+	return v != nil && v.Range != nil
+}
+
+// LookupRange returns the value of Range and true if it is
+// set, or its zero value and false if another field is set.
+func (v *RangeOrName) LookupRange() (o *Range, _ bool) {
+	if v != nil && v.Range != nil {
+		return v.Range, true
+	}
+	return o, false
+}
+
+// SetRange sets the value of Range and unsets all other
+// fields of this RangeOrName.
+func (v *RangeOrName) SetRange(x *Range) {
+	*v = RangeOrName{}
+	v.Range = x
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *RangeOrName) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *RangeOrName) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// LookupName returns the value of Name and true if it is
+// set, or its zero value and false if another field is set.
+func (v *RangeOrName) LookupName() (o string, _ bool) {
+	if v != nil && v.Name != nil {
+		return *v.Name, true
+	}
+	return o, false
+}
+
+// SetName sets the value of Name and unsets all other
+// fields of this RangeOrName.
+func (v *RangeOrName) SetName(x string) {
+	*v = RangeOrName{}
+	v.Name = &x
+}
+
+// Which returns the ID of the field of this RangeOrName which is set, or
+// 0 if no field is set.
+func (v *RangeOrName) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.Range != nil {
+		return 1
+	}
+	if v.Name != nil {
+		return 2
+	}
+	return 0
+}
+
+// RangeOrName_Visitor visits the field of a RangeOrName which is set.
+//
+// Fields added to RangeOrName add methods to RangeOrName_Visitor, so that
+// implementations which do not handle them fail to compile.
+type RangeOrName_Visitor interface {
+	// VisitRange is called with the value of Range if it is set.
+	VisitRange(*Range) error
+
+	// VisitName is called with the value of Name if it is set.
+	VisitName(string) error
+
+	// Default is called if no field of RangeOrName is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this RangeOrName
+// which is set, or Default if none is, and returns its error.
+func (v *RangeOrName) Match(visitor RangeOrName_Visitor) error {
+	if v != nil {
+		if v.Range != nil {
+			return visitor.VisitRange(v.Range)
+		}
+		if v.Name != nil {
+			return visitor.VisitName(*v.Name)
+		}
+	}
+	return visitor.Default()
+}
+
+// Validate returns an error if this RangeOrName does not satisfy the
+// validation rules declared on it in the Thrift file.
+func (v *RangeOrName) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	this := make(map[string]interface{}, 2)
+	if v.Range != nil {
+		this["range"] = v.Range
+	}
+	if v.Name != nil {
+		this["name"] = *v.Name
+	}
+
+	if err := validate.CEL("RangeOrName", "has(this.range) || this.name != \"\"", this); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type Slug string
+
+// SlugPtr returns a pointer to a Slug
+func (v Slug) Ptr() *Slug {
+	return &v
+}
+
+// ToWire translates Slug into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Slug) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Slug.
+func (v Slug) String() string {
+	x := (string)(v)
+	return (string)(x)
+}
+
+func (v Slug) Encode(sw stream.Writer) error {
+	x := (string)(v)
+	return sw.WriteString(x)
+}
+
+// FromWire deserializes Slug from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Slug) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Slug)(x)
+	return err
+}
+
+// Decode deserializes Slug directly off the wire.
+func (v *Slug) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Slug)(x)
+	return err
+}
+
+// Equals returns true if this Slug is equal to the provided
+// Slug.
+func (lhs Slug) Equals(rhs Slug) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Hash returns a hash of this Slug which is stable across
+// processes.
+func (v Slug) Hash() uint64 {
+	h := thrifthash.New()
+	h.String((string)(v))
+	return h.Sum64()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "validate",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/validate",
+	FilePath: "validate.thrift",
+	SHA1:     "2069f84e66c92cde8d80f8d85434cd26c8959097",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "//////////////////////////////////////////////////////////////////////////////\n// CEL expressions\n\nstruct Range {\n    1: required i32 min\n    2: required i32 max\n} (validate.cel = \"this.min <= this.max\")\n\nstruct OptionalRange {\n    1: optional i64 low\n    2: optional i64 high\n    3: optional list<string> tags\n} (validate.cel = \"!has(this.low) || !has(this.high) || this.low <= this.high\")\n\nunion RangeOrName {\n    1: Range range\n    2: string name\n} (validate.cel = 'has(this.range) || this.name != \"\"')\n\n//////////////////////////////////////////////////////////////////////////////\n// Field rules\n\ntypedef string Slug\n\nstruct Account {\n    1: required string name (validate.required_non_empty)\n    2: required Slug slug (validate.pattern = \"^[a-z0-9-]+$\", validate.max = \"16\")\n    3: optional i32 age (validate.min = \"0\", validate.max = \"150\")\n    4: optional double score (validate.min = \"-1.5\", validate.max = \"1.5\")\n    5: optional string nickname (validate.min = \"2\", validate.pattern = \"^[A-Za-z]+$\")\n    6: optional list<string> emails (validate.required_non_empty, validate.max = \"3\")\n    7: required map<string, string> labels (validate.max = \"2\")\n    8: optional binary avatar (validate.max = \"4\")\n}\n\nstruct Invite {\n    1: required string email (validate.pattern = \"@\")\n    2: optional i8 uses (validate.min = \"1\")\n} (validate.cel = \"this.email != \\\"\\\"\")\n"
@@ -0,0 +1,218 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// mergeSingleFile adds the files generated by plugins in src to dest,
+// merging Go files which belong to the package of a Thrift file into the
+// file generated for it.
+//
+// moduleFiles maps the directories of the packages generated for Thrift
+// files to the paths of their files, relative to the output directory.
+func mergeSingleFile(dest map[string][]byte, moduleFiles map[string]string, src map[string][]byte) error {
+	for _, relPath := range sortStringKeys(src) {
+		contents := src[relPath]
+		modulePath, ok := moduleFiles[filepath.Dir(relPath)]
+		if !ok || filepath.Ext(relPath) != ".go" || relPath == modulePath {
+			// Files in other packages, and conflicts with the module file,
+			// are left to addFile.
+			if err := addFile(dest, relPath, contents); err != nil {
+				return err
+			}
+			continue
+		}
+
+		merged, err := mergeGoFiles(modulePath, dest[modulePath], relPath, contents)
+		if err != nil {
+			return err
+		}
+		dest[modulePath] = merged
+	}
+	return nil
+}
+
+// goImport is an import of a Go file.
+type goImport struct {
+	Name string // explicit name, if any
+	Path string
+}
+
+// PackageName is the name under which the import is referenced in the
+// file. This is a guess based on the import path if the import does not
+// have an explicit name.
+func (i goImport) PackageName() string {
+	if i.Name != "" {
+		return i.Name
+	}
+	return path.Base(i.Path)
+}
+
+func (i goImport) String() string {
+	if i.Name != "" {
+		return i.Name + " " + strconv.Quote(i.Path)
+	}
+	return strconv.Quote(i.Path)
+}
+
+// parsedGoFile is a Go file split into its header, imports, and the rest of
+// its declarations.
+type parsedGoFile struct {
+	Package string
+	Header  []byte // everything up to and including the package clause
+	Imports []goImport
+	Body    []byte // everything after the package clause except imports
+}
+
+func parseGoFile(filename string, contents []byte) (*parsedGoFile, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, contents, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q: %v", filename, err)
+	}
+
+	offset := func(pos token.Pos) int { return fset.Position(pos).Offset }
+	pkgEnd := offset(f.Name.End())
+
+	var imports []goImport
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %q: invalid import %v", filename, spec.Path.Value)
+		}
+		imp := goImport{Path: importPath}
+		if spec.Name != nil {
+			imp.Name = spec.Name.Name
+		}
+		imports = append(imports, imp)
+	}
+
+	// Cut the import declarations out of the rest of the file.
+	var body bytes.Buffer
+	last := pkgEnd
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		body.Write(contents[last:offset(gd.Pos())])
+		last = offset(gd.End())
+	}
+	body.Write(contents[last:])
+
+	return &parsedGoFile{
+		Package: f.Name.Name,
+		Header:  contents[:pkgEnd],
+		Imports: imports,
+		Body:    body.Bytes(),
+	}, nil
+}
+
+// hasBuildConstraints reports whether the header of a Go file specifies
+// build constraints.
+func (f *parsedGoFile) hasBuildConstraints() bool {
+	for _, line := range strings.Split(string(f.Header), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "//go:build") || strings.HasPrefix(line, "// +build") {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeGoFiles appends the declarations of the Go file src to those of dst,
+// combining their imports. The header of dst is retained.
+func mergeGoFiles(dstPath string, dst []byte, srcPath string, src []byte) ([]byte, error) {
+	into, err := parseGoFile(dstPath, dst)
+	if err != nil {
+		return nil, err
+	}
+	from, err := parseGoFile(srcPath, src)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.Package != into.Package {
+		return nil, fmt.Errorf("cannot merge %q into %q: package %v does not match package %v",
+			srcPath, dstPath, from.Package, into.Package)
+	}
+	if from.hasBuildConstraints() {
+		return nil, fmt.Errorf("cannot merge %q into %q: it has build constraints", srcPath, dstPath)
+	}
+
+	var imports []goImport
+	seen := make(map[goImport]struct{})
+	names := make(map[string]string) // package name -> import path
+	for _, imp := range append(into.Imports, from.Imports...) {
+		if _, ok := seen[imp]; ok {
+			continue
+		}
+		seen[imp] = struct{}{}
+		imports = append(imports, imp)
+
+		name := imp.PackageName()
+		if name == "_" || name == "." {
+			continue
+		}
+		if other, ok := names[name]; ok && other != imp.Path {
+			return nil, fmt.Errorf("cannot merge %q into %q: %q and %q are both imported as %v",
+				srcPath, dstPath, other, imp.Path, name)
+		}
+		names[name] = imp.Path
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		if imports[i].Path != imports[j].Path {
+			return imports[i].Path < imports[j].Path
+		}
+		return imports[i].Name < imports[j].Name
+	})
+
+	var buff bytes.Buffer
+	buff.Write(into.Header)
+	if len(imports) > 0 {
+		buff.WriteString("\n\nimport (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&buff, "\t%v\n", imp)
+		}
+		buff.WriteString(")\n")
+	}
+	buff.Write(into.Body)
+	buff.WriteString("\n")
+	buff.Write(from.Body)
+
+	merged, err := format.Source(buff.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("cannot merge %q into %q: %v", srcPath, dstPath, err)
+	}
+	return merged, nil
+}
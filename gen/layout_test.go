@@ -0,0 +1,262 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+func TestMergeGoFiles(t *testing.T) {
+	dst := `// Code generated by thriftrw. DO NOT EDIT.
+
+package foo
+
+import (
+	"fmt"
+	wire "go.uber.org/thriftrw/wire"
+)
+
+// Foo is a type.
+type Foo struct{}
+
+func (Foo) String() string { return fmt.Sprint(wire.Value{}) }
+`
+
+	tests := []struct {
+		desc    string
+		src     string
+		want    string
+		wantErr string
+	}{
+		{
+			desc: "shared and new imports",
+			src: `// Code generated by a plugin.
+
+package foo
+
+import (
+	"context"
+	"fmt"
+)
+
+// Bar is another type.
+type Bar struct{}
+
+func (Bar) Do(context.Context) string { return fmt.Sprint("bar") }
+`,
+			want: `// Code generated by thriftrw. DO NOT EDIT.
+
+package foo
+
+import (
+	"context"
+	"fmt"
+	wire "go.uber.org/thriftrw/wire"
+)
+
+// Foo is a type.
+type Foo struct{}
+
+func (Foo) String() string { return fmt.Sprint(wire.Value{}) }
+
+// Bar is another type.
+type Bar struct{}
+
+func (Bar) Do(context.Context) string { return fmt.Sprint("bar") }
+`,
+		},
+		{
+			desc: "no imports",
+			src: `package foo
+
+const Baz = 42
+`,
+			want: `// Code generated by thriftrw. DO NOT EDIT.
+
+package foo
+
+import (
+	"fmt"
+	wire "go.uber.org/thriftrw/wire"
+)
+
+// Foo is a type.
+type Foo struct{}
+
+func (Foo) String() string { return fmt.Sprint(wire.Value{}) }
+
+const Baz = 42
+`,
+		},
+		{
+			desc:    "different package",
+			src:     "package bar\n",
+			wantErr: `cannot merge "foo/bar.go" into "foo/foo.go": package bar does not match package foo`,
+		},
+		{
+			desc: "build constraints",
+			src: `//go:build linux
+// +build linux
+
+package foo
+`,
+			wantErr: `cannot merge "foo/bar.go" into "foo/foo.go": it has build constraints`,
+		},
+		{
+			desc: "import conflict",
+			src: `package foo
+
+import wire "example.com/wire"
+
+var _ wire.Foo
+`,
+			wantErr: `cannot merge "foo/bar.go" into "foo/foo.go": "go.uber.org/thriftrw/wire" and "example.com/wire" are both imported as wire`,
+		},
+		{
+			desc:    "invalid",
+			src:     "package foo\n\nfunc {",
+			wantErr: `could not parse "foo/bar.go"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := mergeGoFiles("foo/foo.go", []byte(dst), "foo/bar.go", []byte(tt.src))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestOutputLayout(t *testing.T) {
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+	module, err := compile.Compile("internal/tests/thrift/only-types.thrift")
+	require.NoError(t, err)
+
+	pluginFiles := map[string][]byte{
+		"only-types/only-types_plugin.go": []byte("package only_types\n\nimport \"fmt\"\n\nvar Plugin = fmt.Sprint(1)\n"),
+		"only-types/footest/footest.go":   []byte("package footest\n"),
+		"only-types/README.md":            []byte("# only-types\n"),
+	}
+
+	tests := []struct {
+		desc      string
+		layout    string
+		wantFiles []string
+		wantErr   string
+	}{
+		{
+			desc:   "default",
+			layout: "",
+			wantFiles: []string{
+				"only-types/README.md",
+				"only-types/footest/footest.go",
+				"only-types/only-types.go",
+				"only-types/only-types_plugin.go",
+			},
+		},
+		{
+			desc:   "multi-file",
+			layout: OutputLayoutMultiFile,
+			wantFiles: []string{
+				"only-types/README.md",
+				"only-types/footest/footest.go",
+				"only-types/only-types.go",
+				"only-types/only-types_plugin.go",
+			},
+		},
+		{
+			desc:   "single-file",
+			layout: OutputLayoutSingleFile,
+			wantFiles: []string{
+				"only-types/README.md",
+				"only-types/footest/footest.go",
+				"only-types/only-types.go",
+			},
+		},
+		{
+			desc:    "unknown",
+			layout:  "one-file",
+			wantErr: `unknown output layout "one-file": must be "multi-file" or "single-file"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			sgen := handletest.NewMockServiceGenerator(mockCtrl)
+			if tt.wantErr == "" {
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{Files: pluginFiles}, nil)
+			}
+
+			outputDir := t.TempDir()
+			err := Generate(module, &Options{
+				OutputDir:    outputDir,
+				ThriftRoot:   thriftRoot,
+				NoRecurse:    true,
+				Plugin:       CodeGenerator{ServiceGenerator: sgen},
+				OutputLayout: tt.layout,
+			})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []string
+			err = filepath.Walk(outputDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(outputDir, path)
+				got = append(got, filepath.ToSlash(rel))
+				return err
+			})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantFiles, got)
+
+			if tt.layout == OutputLayoutSingleFile {
+				bs, err := ioutil.ReadFile(filepath.Join(outputDir, "only-types", "only-types.go"))
+				require.NoError(t, err)
+				assert.Contains(t, string(bs), "var Plugin = fmt.Sprint(1)")
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// value of this annotation on a struct overrides whether a lazy variant
+// of it is generated
+const lazyKey = "go.lazy"
+
+// lazyGenerator generates <Name>_Lazy types which decode the fields of a
+// struct from its encoding only when they are first accessed.
+type lazyGenerator struct {
+	Name     string
+	Spec     *compile.StructSpec
+	Presence presenceLayout
+}
+
+// newLazyGenerator returns a lazyGenerator for the given struct, or false
+// if a lazy variant should not be generated for it.
+func newLazyGenerator(g Generator, name string, spec *compile.StructSpec) (_ lazyGenerator, ok bool, err error) {
+	enabled := checkLazyStructs(g) && spec.Type == ast.StructType
+	if v, ok := spec.Annotations[lazyKey]; ok {
+		enabled, err = strconv.ParseBool(v)
+		if err != nil {
+			return lazyGenerator{}, false, fmt.Errorf(
+				"invalid %v annotation: %q is not a boolean", lazyKey, v)
+		}
+		if enabled && spec.Type != ast.StructType {
+			return lazyGenerator{}, false, fmt.Errorf(
+				"%v annotation is only supported on structs", lazyKey)
+		}
+	}
+	if !enabled {
+		return lazyGenerator{}, false, nil
+	}
+	if checkNoStreaming(g) {
+		return lazyGenerator{}, false, fmt.Errorf(
+			"lazy structs cannot be generated with --no-streaming: they require Decode methods")
+	}
+
+	presence, err := newPresenceLayout(g, spec)
+	if err != nil {
+		return lazyGenerator{}, false, err
+	}
+	return lazyGenerator{Name: name, Spec: spec, Presence: presence}, true, nil
+}
+
+func (l lazyGenerator) Generate(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+
+		<$name := .Name>
+		<$lazy := printf "%s_Lazy" .Name>
+		<$v := newVar "v">
+		<$raw := newVar "raw">
+		// <$lazy> provides access to the fields of a Thrift Binary Protocol
+		// encoded <$name>, decoding each field only when it is first
+		// accessed. Decoded fields are retained until the next call to Reset.
+		//
+		// <$lazy> is not safe for concurrent use.
+		type <$lazy> struct {
+			raw <$binary>.LazyStruct
+			v <$name>
+			<- if .Spec.Fields>
+			decoded [<len .Spec.Fields>]bool
+			<- end>
+		}
+
+		// Reset discards all decoded fields and starts reading from <$raw>,
+		// which holds a Thrift Binary Protocol encoded <$name>. <$raw> must
+		// not be modified while it is in use.
+		func (<$v> *<$lazy>) Reset(<$raw> []byte) {
+			<$v>.raw.Reset(<$raw>)
+			<$v>.v = <$name>{}
+			<- if .Spec.Fields>
+			<$v>.decoded = [<len .Spec.Fields>]bool{}
+			<- end>
+		}
+
+		// Bytes returns the encoded <$name>.
+		func (<$v> *<$lazy>) Bytes() []byte {
+			return <$v>.raw.Bytes()
+		}
+
+		// Struct decodes all fields into a new <$name>.
+		func (<$v> *<$lazy>) Struct() (*<$name>, error) {
+			<- $x := newVar "x" ->
+			<- $sr := newVar "sr">
+			var <$x> <$name>
+			<$sr> := <$binary>.NewStreamReader(<import "bytes">.NewReader(<$v>.raw.Bytes()))
+			defer <$sr>.Close()
+			err := <$x>.Decode(<$sr>)
+			return &<$x>, err
+		}
+
+		<$o := newVar "o">
+		<range $i, $f := .Spec.Fields>
+			<$fname := goName $f>
+			// Get<$fname> returns the value of <$fname>, decoding it if it
+			// has not been decoded yet.
+			func (<$v> *<$lazy>) Get<$fname>() (<$o> <typeReference .Type>, err error) {
+				if err = <$v>.decode(<$i>); err == nil {
+					<$o> = <$v>.v.Get<$fname>()
+				}
+				return
+			}
+
+			<if hasIsSet $f>
+			// IsSet<$fname> returns true if <$fname> is set, decoding it
+			// if it has not been decoded yet.
+			func (<$v> *<$lazy>) IsSet<$fname>() (<$o> bool, err error) {
+				if err = <$v>.decode(<$i>); err == nil {
+					<$o> = <$v>.v.IsSet<$fname>()
+				}
+				return
+			}
+			<end>
+		<end>
+
+		<if .Spec.Fields>
+		<$i := newVar "i">
+		<$fr := newVar "fr">
+		<$ok := newVar "ok">
+		// decode decodes the field at the given index if it has not been
+		// decoded yet.
+		func (<$v> *<$lazy>) decode(<$i> int) error {
+			if <$v>.decoded[<$i>] {
+				return nil
+			}
+
+			var (
+				<$fr> <import "go.uber.org/thriftrw/protocol/stream">.Reader
+				<$ok> bool
+				err error
+			)
+			switch <$i> {
+			<range $idx, $f := .Spec.Fields ->
+			case <$idx>:
+				<- $sv := printf "%s.v" $v ->
+				<- $lhs := printf "%s.%s" $sv (goName $f) >
+				<$fr>, <$ok>, err = <$v>.raw.Field(<.ID>, <fieldTypeCode .>)
+				if err != nil {
+					return err
+				}
+				if <$ok> {
+					<decodeField . $lhs $fr (not (or .Required ($.Presence.Has .)))>
+					<$fr>.Close()
+					if err != nil {
+						return err
+					}
+					<- if $.Presence.Has .>
+					<$.Presence.Set $sv .>
+					<- end>
+				}
+				<- if and (isNotNil .Default) ($.Presence.Has .)> else {
+					<$lhs> = <constantValue .Default .Type>
+					<$.Presence.Set $sv .>
+				}
+				<- else if isNotNil .Default> else {
+					<$lhs> = <constantValuePtr .Default .Type>
+				}
+				<- else if checkRequired .> else {
+					<missingRequired $name (goName $f)>
+				}
+				<- end>
+			<end ->
+			}
+
+			<$v>.decoded[<$i>] = true
+			return nil
+		}
+		<end>
+		`, l,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("hasIsSet", hasIsSet),
+		TemplateFunc("fieldTypeCode", curryGenerator(fieldTypeCode, g)),
+		TemplateFunc("decodeField", curryGenerator(decodeField, g)),
+		TemplateFunc("checkRequired", curryGenerator(checkRequired, g)),
+		TemplateFunc("missingRequired", curryGenerator(missingRequired, g)),
+	)
+}
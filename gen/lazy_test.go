@@ -0,0 +1,239 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	tl "go.uber.org/thriftrw/gen/internal/tests/lazy"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeWire encodes the given Value with the Thrift Binary Protocol.
+func encodeWire(t *testing.T, v wire.Value) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(v, &buf), "binary.Encode")
+	return buf.Bytes()
+}
+
+func TestLazyStruct(t *testing.T) {
+	give := &tl.Event{
+		ID:      "1",
+		Name:    ptr.String("deploy"),
+		Tags:    []string{"a", "b"},
+		Points:  map[string]*tl.Point{"p": {X: 1, Y: 2}},
+		Origin:  &tl.Point{X: 3, Y: 4},
+		Level:   tl.LevelHigh.Ptr(),
+		Payload: []byte("hello"),
+		Active:  ptr.Bool(false),
+	}
+	bs := encodeThrift(t, give, true)
+
+	var lazy tl.Event_Lazy
+	lazy.Reset(bs)
+	assert.Equal(t, bs, lazy.Bytes())
+
+	id, err := lazy.GetID()
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	name, err := lazy.GetName()
+	require.NoError(t, err)
+	assert.Equal(t, "deploy", name)
+
+	ts, err := lazy.GetTimestamp()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), ts, "default must be used for missing fields")
+
+	ok, err := lazy.IsSetTimestamp()
+	require.NoError(t, err)
+	assert.True(t, ok, "default must be set for missing fields")
+
+	tags, err := lazy.GetTags()
+	require.NoError(t, err)
+	assert.Equal(t, give.Tags, tags)
+
+	points, err := lazy.GetPoints()
+	require.NoError(t, err)
+	assert.Equal(t, give.Points, points)
+
+	origin, err := lazy.GetOrigin()
+	require.NoError(t, err)
+	assert.Equal(t, give.Origin, origin)
+
+	level, err := lazy.GetLevel()
+	require.NoError(t, err)
+	assert.Equal(t, tl.LevelHigh, level)
+
+	payload, err := lazy.GetPayload()
+	require.NoError(t, err)
+	assert.Equal(t, give.Payload, payload)
+
+	active, err := lazy.GetActive()
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	got, err := lazy.Struct()
+	require.NoError(t, err)
+	want := *give
+	want.Timestamp = ptr.Int64(42)
+	assert.Equal(t, &want, got)
+}
+
+func TestLazyStructCachesFields(t *testing.T) {
+	bs := encodeThrift(t, &tl.Event{ID: "1", Origin: &tl.Point{}}, true)
+
+	var lazy tl.Event_Lazy
+	lazy.Reset(bs)
+	origin, err := lazy.GetOrigin()
+	require.NoError(t, err)
+	origin.X = 42
+
+	origin, err = lazy.GetOrigin()
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, origin.X, "decoded fields must be retained")
+
+	lazy.Reset(bs)
+	origin, err = lazy.GetOrigin()
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, origin.X, "Reset must discard decoded fields")
+}
+
+func TestLazyStructDecodesOnlyAccessedFields(t *testing.T) {
+	// The Point in field 5 is missing its required fields so it cannot be
+	// decoded. Accessing other fields must still work.
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("1")},
+		{ID: 5, Value: wire.NewValueMap(wire.MapItemListFromSlice(
+			wire.TBinary, wire.TStruct,
+			[]wire.MapItem{{
+				Key:   wire.NewValueString("p"),
+				Value: wire.NewValueStruct(wire.Struct{}),
+			}},
+		))},
+		{ID: 6, Value: wire.NewValueStruct(wire.Struct{})},
+	}})
+	var lazy tl.Event_Lazy
+	lazy.Reset(encodeWire(t, v))
+
+	id, err := lazy.GetID()
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	_, err = lazy.GetPoints()
+	assert.EqualError(t, err, "field X of Point is required")
+}
+
+func TestLazyStructRequiredField(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: wire.NewValueString("deploy")},
+	}})
+	var lazy tl.Event_Lazy
+	lazy.Reset(encodeWire(t, v))
+
+	name, err := lazy.GetName()
+	require.NoError(t, err)
+	assert.Equal(t, "deploy", name)
+
+	_, err = lazy.GetID()
+	assert.EqualError(t, err, "field ID of Event is required")
+
+	_, err = lazy.Struct()
+	assert.Error(t, err)
+}
+
+func TestLazyStructTypeMismatch(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("1")},
+		{ID: 2, Value: wire.NewValueI32(42)},
+		{ID: 6, Value: wire.NewValueStruct(wire.Struct{})},
+	}})
+	var lazy tl.Event_Lazy
+	lazy.Reset(encodeWire(t, v))
+
+	ok, err := lazy.IsSetName()
+	require.NoError(t, err)
+	assert.False(t, ok, "fields with a mismatched type must be ignored")
+}
+
+func TestLazyStructInvalidPayload(t *testing.T) {
+	var lazy tl.Event_Lazy
+	lazy.Reset([]byte{0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x10})
+
+	_, err := lazy.GetName()
+	assert.Error(t, err)
+}
+
+func TestLazyStructAnnotation(t *testing.T) {
+	tests := []struct {
+		desc    string
+		flag    bool
+		typ     ast.StructureType
+		value   string
+		want    bool
+		wantErr string
+	}{
+		{desc: "flag", flag: true, typ: ast.StructType, want: true},
+		{desc: "no flag", typ: ast.StructType},
+		{desc: "union", flag: true, typ: ast.UnionType},
+		{desc: "exception", flag: true, typ: ast.ExceptionType},
+		{desc: "enable", typ: ast.StructType, value: "true", want: true},
+		{desc: "disable", flag: true, typ: ast.StructType, value: "false"},
+		{
+			desc:    "invalid",
+			typ:     ast.StructType,
+			value:   "yes",
+			wantErr: `invalid go.lazy annotation: "yes" is not a boolean`,
+		},
+		{
+			desc:    "enable union",
+			typ:     ast.UnionType,
+			value:   "true",
+			wantErr: "go.lazy annotation is only supported on structs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			spec := &compile.StructSpec{Name: "Foo", Type: tt.typ}
+			if tt.value != "" {
+				spec.Annotations = compile.Annotations{"go.lazy": tt.value}
+			}
+
+			g := NewGenerator(&GeneratorOptions{LazyStructs: tt.flag})
+			_, ok, err := newLazyGenerator(g, "Foo", spec)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
@@ -152,7 +152,6 @@ func (l *listGenerator) Encoder(g Generator, spec *compile.ListSpec) (string, er
 	err := g.EnsureDeclared(
 		`
 		<$stream := import "go.uber.org/thriftrw/protocol/stream">
-		<$binary := import "go.uber.org/thriftrw/protocol/binary">
 
 		<$listType := typeReference .Spec>
 		<$sw := newVar "sw">
@@ -169,6 +168,40 @@ func (l *listGenerator) Encoder(g Generator, spec *compile.ListSpec) (string, er
 			if err := <$sw>.WriteListBegin(<$lh>); err != nil {
 				return err
 			}
+			<if checkTinyGo ->
+			<if isPrimitiveType .Spec.ValueSpec ->
+			for _, <$v> := range <$val> {
+			<- else ->
+			for i, <$v> := range <$val> {
+				if <$v> == nil {
+					return <import "fmt">.Errorf("invalid list '<typeReference .Spec>', index [%v]: value is nil", i)
+				}
+			<- end>
+				if err := <encode .Spec.ValueSpec $v $sw>; err != nil {
+					return err
+				}
+			}
+			<- else ->
+			<- $binary := import "go.uber.org/thriftrw/protocol/binary" ->
+			<if checkThriftJSON ->
+			if _, ok := <$sw>.(*<$binary>.StreamWriter); !ok {
+				// Chunks are encoded in parallel with the Binary protocol,
+				// so other protocols encode items one at a time.
+				<if isPrimitiveType .Spec.ValueSpec ->
+				for _, <$v> := range <$val> {
+				<- else ->
+				for i, <$v> := range <$val> {
+					if <$v> == nil {
+						return <import "fmt">.Errorf("invalid list '<typeReference .Spec>', index [%v]: value is nil", i)
+					}
+				<- end>
+					if err := <encode .Spec.ValueSpec $v $sw>; err != nil {
+						return err
+					}
+				}
+				return <$sw>.WriteListEnd()
+			}
+			<end ->
 			type chunk struct {
 				idx int
 				<$val> <$listType>
@@ -229,6 +262,7 @@ func (l *listGenerator) Encoder(g Generator, spec *compile.ListSpec) (string, er
 				c.buffer.Reset()
 				binary.BufferPool.Put(c.buffer)
 			}
+			<- end>
 			return <$sw>.WriteListEnd()
 		}
 		`,
@@ -236,6 +270,8 @@ func (l *listGenerator) Encoder(g Generator, spec *compile.ListSpec) (string, er
 			Name string
 			Spec *compile.ListSpec
 		}{Name: name, Spec: spec},
+		TemplateFunc("checkTinyGo", checkTinyGo),
+		TemplateFunc("checkThriftJSON", checkThriftJSON),
 	)
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
@@ -341,6 +377,64 @@ func (l *listGenerator) Equals(g Generator, spec *compile.ListSpec) (string, err
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+func (l *listGenerator) Copy(g Generator, spec *compile.ListSpec) (string, error) {
+	name := copyFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$listType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$listType>) <$listType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$i := newVar "i">
+				<$x := newVar "x">
+				<$o> := make(<$listType>, len(<$v>))
+				for <$i>, <$x> := range <$v> {
+					<$o>[<$i>] = <copy .Spec.ValueSpec $x>
+				}
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (l *listGenerator) Hash(g Generator, spec *compile.ListSpec) (string, error) {
+	name := hashFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <typeReference .Spec>) uint64 {
+				<$h := newVar "h">
+				<$x := newVar "x">
+				<$h> := <$thrifthash>.New()
+				<$h>.Len(len(<$v>))
+				for _, <$x> := range <$v> {
+					<hash .Spec.ValueSpec $h $x>
+				}
+				return <$h>.Sum64()
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 // Slices are logged as JSON arrays.
 func (l *listGenerator) zapMarshaler(
 	g Generator,
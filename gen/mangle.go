@@ -44,9 +44,16 @@ func newMangler() *mangler {
 func (m *mangler) MangleType(spec compile.TypeSpec) string {
 	switch s := spec.(type) {
 	case *compile.MapSpec:
-		return fmt.Sprintf(
+		name := fmt.Sprintf(
 			"Map_%s_%s", m.MangleType(s.KeySpec), m.MangleType(s.ValueSpec),
 		)
+		if s.Annotations[goTypeKey] == keyValueSliceType {
+			name += "_sliceType"
+		}
+		if c, _ := mapContainerType(s); c != nil {
+			name += mangleContainer(c)
+		}
+		return name
 	case *compile.ListSpec:
 		return fmt.Sprintf("List_%s", m.MangleType(s.ValueSpec))
 	case *compile.SetSpec:
@@ -56,11 +63,19 @@ func (m *mangler) MangleType(spec compile.TypeSpec) string {
 		}
 
 		return fmt.Sprintf("Set_%s_%vType", m.MangleType(s.ValueSpec), setType)
+	case *compile.UUIDSpec:
+		if importPath, name, _ := uuidGoType(s); importPath != "" {
+			return "UUID" + mangleGoName(importPath, name)
+		}
+		return "UUID"
 	}
 
 	// Native primitive types have unique names
 	thriftFile := spec.ThriftFile()
 	if thriftFile == "" {
+		if isUnsigned(spec) {
+			return goCase(spec.ThriftName()) + "_unsigned"
+		}
 		return goCase(spec.ThriftName())
 	}
 
@@ -47,6 +47,10 @@ type mapGenerator struct{}
 // And $mapItemListName is returned. This may be used where a MapItemList of the
 // given type is expected.
 func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, error) {
+	if isMapContainer(spec) {
+		return m.containerItemList(g, spec)
+	}
+
 	name := mapItemListName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -61,7 +65,7 @@ func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, err
 			<$kw := newVar "kw">
 			<$vw := newVar "vw">
 			func (<$m> <.Name>) ForEach(<$f> func(<$wire>.MapItem) error) error {
-				<- if isHashable .Spec.KeySpec ->
+				<- if mapUsesMap .Spec ->
 					for <$k>, <$v> := range <$m> {
 				<else ->
 					for _, <$i> := range <$m> {
@@ -120,6 +124,10 @@ func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, err
 }
 
 func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error) {
+	if isMapContainer(spec) {
+		return m.containerReader(g, spec)
+	}
+
 	name := readerFuncName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -140,7 +148,7 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 					return nil, nil
 				}
 
-				<if isHashable .Spec.KeySpec>
+				<if mapUsesMap .Spec>
 					<$o> := make(<$mapType>, <$m>.Size())
 				<else>
 					<$o> := make(<$mapType>, 0, <$m>.Size())
@@ -156,7 +164,7 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 						return err
 					}
 
-					<if isHashable .Spec.KeySpec>
+					<if mapUsesMap .Spec>
 						<$o>[<$k>] = <$v>
 					<else>
 						<$o> = append(<$o>, struct {
@@ -187,6 +195,10 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 //
 // And returns its name.
 func (m *mapGenerator) Encoder(g Generator, spec *compile.MapSpec) (string, error) {
+	if isMapContainer(spec) {
+		return m.containerEncoder(g, spec)
+	}
+
 	name := encoderFuncName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -213,7 +225,7 @@ func (m *mapGenerator) Encoder(g Generator, spec *compile.MapSpec) (string, erro
 				return err
 			}
 
-			<if isHashable .Spec.KeySpec>
+			<if mapUsesMap .Spec>
 				for <$k>, <$v> := range <$val> {
 					<- if not (isPrimitiveType .Spec.KeySpec) ->
 					if <$k> == nil {
@@ -278,6 +290,10 @@ func (m *mapGenerator) Encoder(g Generator, spec *compile.MapSpec) (string, erro
 //
 // And returns its name.
 func (m *mapGenerator) Decoder(g Generator, spec *compile.MapSpec) (string, error) {
+	if isMapContainer(spec) {
+		return m.containerDecoder(g, spec)
+	}
+
 	name := decoderFuncName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -308,7 +324,7 @@ func (m *mapGenerator) Decoder(g Generator, spec *compile.MapSpec) (string, erro
 				return nil, <$sr>.ReadMapEnd()
 			}
 
-			<if isHashable .Spec.KeySpec>
+			<if mapUsesMap .Spec>
 				<$o> := make(<$mapType>, <$mh>.Length)
 			<else>
 				<$o> := make(<$mapType>, 0, <$mh>.Length)
@@ -324,7 +340,7 @@ func (m *mapGenerator) Decoder(g Generator, spec *compile.MapSpec) (string, erro
 					return nil, err
 				}
 
-				<if isHashable .Spec.KeySpec>
+				<if mapUsesMap .Spec>
 					<$o>[<$k>] = <$v>
 				<else>
 					<$o> = append(<$o>, struct {
@@ -357,7 +373,11 @@ func (m *mapGenerator) Decoder(g Generator, spec *compile.MapSpec) (string, erro
 //
 // And returns its name.
 func (m *mapGenerator) Equals(g Generator, spec *compile.MapSpec) (string, error) {
-	if !isHashable(spec.KeySpec) {
+	if isMapContainer(spec) {
+		return m.containerEquals(g, spec)
+	}
+
+	if !mapUsesMap(spec) {
 		return m.equalsUnhashable(g, spec)
 	}
 
@@ -452,6 +472,93 @@ func (m *mapGenerator) equalsUnhashable(g Generator, spec *compile.MapSpec) (str
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+func (m *mapGenerator) Copy(g Generator, spec *compile.MapSpec) (string, error) {
+	if isMapContainer(spec) {
+		return m.containerCopy(g, spec)
+	}
+
+	name := copyFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$mapType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$mapType>) <$mapType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$i := newVar "i">
+				<$k := newVar "k">
+				<$x := newVar "x">
+				<$o> := make(<$mapType>, len(<$v>))
+				<if mapUsesMap .Spec ->
+					for <$k>, <$x> := range <$v> {
+						<$o>[<$k>] = <copy .Spec.ValueSpec $x>
+					}
+				<- else ->
+					for <$i>, <$x> := range <$v> {
+						<$o>[<$i>].Key = <copy .Spec.KeySpec (printf "%s.Key" $x)>
+						<$o>[<$i>].Value = <copy .Spec.ValueSpec (printf "%s.Value" $x)>
+					}
+				<- end>
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) Hash(g Generator, spec *compile.MapSpec) (string, error) {
+	if isMapContainer(spec) {
+		return m.containerHash(g, spec)
+	}
+
+	name := hashFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <typeReference .Spec>) uint64 {
+				<$u := newVar "u">
+				<$h := newVar "h">
+				<$k := newVar "k">
+				<$x := newVar "x">
+				var <$u> <$thrifthash>.Unordered
+				<if mapUsesMap .Spec ->
+					for <$k>, <$x> := range <$v> {
+						<$h> := <$thrifthash>.New()
+						<hash .Spec.KeySpec $h $k>
+						<hash .Spec.ValueSpec $h $x>
+						<$u>.Add(<$h>.Sum64())
+					}
+				<- else ->
+					for _, <$x> := range <$v> {
+						<$h> := <$thrifthash>.New()
+						<hash .Spec.KeySpec $h (printf "%s.Key" $x)>
+						<hash .Spec.ValueSpec $h (printf "%s.Value" $x)>
+						<$u>.Add(<$h>.Sum64())
+					}
+				<- end>
+				return <$u>.Sum64()
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 // Maps are logged as objects if the key is a string or a typedef of a
 // string. If the key is not a string, maps are logged as arrays of
 // objects with a key and value.
@@ -467,13 +574,15 @@ func (m *mapGenerator) zapMarshaler(
 	root *compile.MapSpec,
 	fieldValue string,
 ) (string, error) {
+	if isMapContainer(root) {
+		return m.containerZapMarshaler(g, root, fieldValue)
+	}
+
 	name := zapperName(g, root)
-	switch compile.RootTypeSpec(root.KeySpec).(type) {
-	case *compile.StringSpec:
+	if _, ok := compile.RootTypeSpec(root.KeySpec).(*compile.StringSpec); ok && mapUsesMap(root) {
 		return m.zapStringKeyMarshaler(g, name, root, fieldValue)
-	default:
-		return m.zapNonstringKeyMarshaler(g, name, root, fieldValue)
 	}
+	return m.zapNonstringKeyMarshaler(g, name, root, fieldValue)
 }
 
 func (m *mapGenerator) zapStringKeyMarshaler(
@@ -532,7 +641,7 @@ func (m *mapGenerator) zapNonstringKeyMarshaler(
 			// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
 			// fast logging of <.Name>.
 			func (<$m> <.Name>) MarshalLogArray(<$enc> <$zapcore>.ArrayEncoder) (err error) {
-				<- if isHashable .Type.KeySpec ->
+				<- if mapUsesMap .Type ->
 					for <$k>, <$v> := range <$m> {
 				<else ->
 					for _, <$i> := range <$m> {
@@ -0,0 +1,482 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// containerName returns the name of the container type of the given map,
+// importing its package if needed.
+func containerName(g Generator, spec *compile.MapSpec) (string, error) {
+	c, err := mapContainerType(spec)
+	if err != nil {
+		return "", err
+	}
+	return g.Import(c.ImportPath) + "." + c.Name, nil
+}
+
+// mangleContainer returns a suffix for mangled names of maps stored in the
+// given container so that they do not conflict with other maps of the same
+// types.
+func mangleContainer(c *mapContainer) string {
+	return mangleGoName(c.ImportPath, c.Name)
+}
+
+// mangleGoName returns a suffix for mangled names of types which use the
+// given Go type.
+func mangleGoName(importPath, name string) string {
+	pkg := strings.Map(func(r rune) rune {
+		if r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, path.Base(importPath))
+	return fmt.Sprintf("_%v_%v", pkg, name)
+}
+
+// The functions below are the counterparts of those of mapGenerator for
+// maps stored in container types. They only use the methods documented on
+// mapContainer.
+
+func (m *mapGenerator) containerItemList(g Generator, spec *compile.MapSpec) (string, error) {
+	name := mapItemListName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$wire := import "go.uber.org/thriftrw/wire">
+			type <.Name> struct{ m <typeReference .Spec> }
+
+			<$m := newVar "m">
+			<$f := newVar "f">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$kw := newVar "kw">
+			<$vw := newVar "vw">
+			func (<$m> <.Name>) ForEach(<$f> func(<$wire>.MapItem) error) error {
+				return <$m>.m.ForEach(func(<$k> <typeReference .Spec.KeySpec>, <$v> <typeReference .Spec.ValueSpec>) error {
+					<- if not (isPrimitiveType .Spec.KeySpec) ->
+						if <$k> == nil {
+							return <import "fmt">.Errorf("invalid map '<typeReference .Spec>': key is nil")
+						}
+					<end ->
+					<- if not (isPrimitiveType .Spec.ValueSpec) ->
+						if <$v> == nil {
+							return <import "fmt">.Errorf("invalid map '<typeReference .Spec>', key [%v]: value is nil", <$k>)
+						}
+					<end ->
+
+					<$kw>, err := <toWire .Spec.KeySpec $k>
+					if err != nil {
+						return err
+					}
+
+					<$vw>, err := <toWire .Spec.ValueSpec $v>
+					if err != nil {
+						return err
+					}
+					return <$f>(<$wire>.MapItem{Key: <$kw>, Value: <$vw>})
+				})
+			}
+
+			func (<$m> <.Name>) Size() int {
+				return <$m>.m.Len()
+			}
+
+			func (<.Name>) KeyType() <$wire>.Type {
+				return <typeCode .Spec.KeySpec>
+			}
+
+			func (<.Name>) ValueType() <$wire>.Type {
+				return <typeCode .Spec.ValueSpec>
+			}
+
+			func (<.Name>) Close() {}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) containerReader(g Generator, spec *compile.MapSpec) (string, error) {
+	container, err := containerName(g, spec)
+	if err != nil {
+		return "", wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	name := readerFuncName(g, spec)
+	err = g.EnsureDeclared(
+		`
+			<$wire := import "go.uber.org/thriftrw/wire">
+
+			<$m := newVar "m">
+			<$o := newVar "o">
+			<$x := newVar "x">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			func <.Name>(<$m> <$wire>.MapItemList) (<typeReference .Spec>, error) {
+				if <$m>.KeyType() != <typeCode .Spec.KeySpec> {
+					return nil, nil
+				}
+
+				if <$m>.ValueType() != <typeCode .Spec.ValueSpec> {
+					return nil, nil
+				}
+
+				<$o> := new(<.Container>)
+				err := <$m>.ForEach(func(<$x> <$wire>.MapItem) error {
+					<$k>, err := <fromWire .Spec.KeySpec (printf "%s.Key" $x)>
+					if err != nil {
+						return err
+					}
+
+					<$v>, err := <fromWire .Spec.ValueSpec (printf "%s.Value" $x)>
+					if err != nil {
+						return err
+					}
+
+					<$o>.Put(<$k>, <$v>)
+					return nil
+				})
+				<$m>.Close()
+				return <$o>, err
+			}
+		`,
+		struct {
+			Name      string
+			Spec      *compile.MapSpec
+			Container string
+		}{Name: name, Spec: spec, Container: container},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) containerEncoder(g Generator, spec *compile.MapSpec) (string, error) {
+	name := encoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$sw := newVar "sw">
+		<$mh := newVar "mh">
+		<$k := newVar "k">
+		<$v := newVar "v">
+		<$val := newVar "val">
+		func <.Name>(<$val> <typeReference .Spec>, <$sw> <$stream>.Writer) error {
+			<$mh> := <$stream>.MapHeader{
+				KeyType: <typeCode .Spec.KeySpec>,
+				ValueType: <typeCode .Spec.ValueSpec>,
+				Length: <$val>.Len(),
+			}
+			if err := <$sw>.WriteMapBegin(<$mh>); err != nil {
+				return err
+			}
+
+			err := <$val>.ForEach(func(<$k> <typeReference .Spec.KeySpec>, <$v> <typeReference .Spec.ValueSpec>) error {
+				<- if not (isPrimitiveType .Spec.KeySpec) ->
+				if <$k> == nil {
+					return <import "fmt">.Errorf("invalid map '<typeReference .Spec>': key is nil")
+				}
+				<end ->
+				<- if not (isPrimitiveType .Spec.ValueSpec) ->
+				if <$v> == nil {
+					return <import "fmt">.Errorf("invalid map '<typeReference .Spec>', key [%v]: value is nil", <$k>)
+				}
+				<end ->
+
+				if err := <encode .Spec.KeySpec $k $sw>; err != nil {
+					return err
+				}
+				return <encode .Spec.ValueSpec $v $sw>
+			})
+			if err != nil {
+				return err
+			}
+			return <$sw>.WriteMapEnd()
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) containerDecoder(g Generator, spec *compile.MapSpec) (string, error) {
+	container, err := containerName(g, spec)
+	if err != nil {
+		return "", wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	name := decoderFuncName(g, spec)
+	err = g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$sr := newVar "sr">
+		<$mh := newVar "mh">
+		<$o := newVar "o">
+		<$k := newVar "k">
+		<$v := newVar "v">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeReference .Spec>, error) {
+			<$mh>, err := <$sr>.ReadMapBegin()
+			if err != nil {
+				return nil, err
+			}
+
+			if <$mh>.KeyType != <typeCode .Spec.KeySpec> || <$mh>.ValueType != <typeCode .Spec.ValueSpec> {
+				for i := 0; i <lessthan> <$mh>.Length; i++ {
+					if err := <$sr>.Skip(<$mh>.KeyType); err != nil {
+						return nil, err
+					}
+
+					if err := <$sr>.Skip(<$mh>.ValueType); err != nil {
+						return nil, err
+					}
+				}
+				return nil, <$sr>.ReadMapEnd()
+			}
+
+			<$o> := new(<.Container>)
+			for i := 0; i <lessthan> <$mh>.Length; i++ {
+				<$k>, err := <decode .Spec.KeySpec $sr>
+				if err != nil {
+					return nil, err
+				}
+
+				<$v>, err := <decode .Spec.ValueSpec $sr>
+				if err != nil {
+					return nil, err
+				}
+
+				<$o>.Put(<$k>, <$v>)
+			}
+
+			if err = <$sr>.ReadMapEnd(); err != nil {
+				return nil, err
+			}
+			return <$o>, err
+		}
+		`,
+		struct {
+			Name      string
+			Spec      *compile.MapSpec
+			Container string
+		}{Name: name, Spec: spec, Container: container},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) containerEquals(g Generator, spec *compile.MapSpec) (string, error) {
+	name := equalsFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$lhs := newVar "lhs">
+			<$rhs := newVar "rhs">
+			func <.Name>(<$lhs>, <$rhs> <typeReference .Spec>) bool {
+				if <$lhs> == nil || <$rhs> == nil {
+					return (<$lhs> == nil || <$lhs>.Len() == 0) && (<$rhs> == nil || <$rhs>.Len() == 0)
+				}
+				if <$lhs>.Len() != <$rhs>.Len() {
+					return false
+				}
+
+				<$k := newVar "k">
+				<$lv := newVar "lv">
+				<$rv := newVar "rv">
+				<$ok := newVar "ok">
+				<$equal := newVar "equal">
+				<$equal> := true
+				_ = <$lhs>.ForEach(func(<$k> <typeReference .Spec.KeySpec>, <$lv> <typeReference .Spec.ValueSpec>) error {
+					<$rv>, <$ok> := <$rhs>.Get(<$k>)
+					if !<$ok> || !<equals .Spec.ValueSpec $lv $rv> {
+						<$equal> = false
+						return <import "errors">.New("maps are not equal")
+					}
+					return nil
+				})
+				return <$equal>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) containerCopy(g Generator, spec *compile.MapSpec) (string, error) {
+	container, err := containerName(g, spec)
+	if err != nil {
+		return "", wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	name := copyFuncName(g, spec)
+	err = g.EnsureDeclared(
+		`
+			<$mapType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$mapType>) <$mapType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$k := newVar "k">
+				<$x := newVar "x">
+				<$o> := new(<.Container>)
+				_ = <$v>.ForEach(func(<$k> <typeReference .Spec.KeySpec>, <$x> <typeReference .Spec.ValueSpec>) error {
+					<$o>.Put(<copy .Spec.KeySpec $k>, <copy .Spec.ValueSpec $x>)
+					return nil
+				})
+				return <$o>
+			}
+		`,
+		struct {
+			Name      string
+			Spec      *compile.MapSpec
+			Container string
+		}{Name: name, Spec: spec, Container: container},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (m *mapGenerator) containerHash(g Generator, spec *compile.MapSpec) (string, error) {
+	name := hashFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <typeReference .Spec>) uint64 {
+				<$u := newVar "u">
+				<$h := newVar "h">
+				<$k := newVar "k">
+				<$x := newVar "x">
+				var <$u> <$thrifthash>.Unordered
+				if <$v> != nil {
+					_ = <$v>.ForEach(func(<$k> <typeReference .Spec.KeySpec>, <$x> <typeReference .Spec.ValueSpec>) error {
+						<$h> := <$thrifthash>.New()
+						<hash .Spec.KeySpec $h $k>
+						<hash .Spec.ValueSpec $h $x>
+						<$u>.Add(<$h>.Sum64())
+						return nil
+					})
+				}
+				return <$u>.Sum64()
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// containerZapMarshaler logs maps stored in containers as arrays of objects
+// with a key and value, in the order of the container.
+func (m *mapGenerator) containerZapMarshaler(
+	g Generator,
+	root *compile.MapSpec,
+	fieldValue string,
+) (string, error) {
+	name := zapperName(g, root)
+	if err := g.EnsureDeclared(
+		`
+			<$zapcore := import "go.uber.org/zap/zapcore">
+			<$multierr := import "go.uber.org/multierr">
+
+			type <.Name> struct{ m <typeReference .Type> }
+			<$m := newVar "m">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$enc := newVar "enc">
+			// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+			// fast logging of <.Name>.
+			func (<$m> <.Name>) MarshalLogArray(<$enc> <$zapcore>.ArrayEncoder) (err error) {
+				if <$m>.m == nil {
+					return nil
+				}
+				_ = <$m>.m.ForEach(func(<$k> <typeReference .Type.KeySpec>, <$v> <typeReference .Type.ValueSpec>) error {
+					err = <$multierr>.Append(err, <$enc>.AppendObject(<zapMapItemMarshaler .Type $k $v>))
+					return nil
+				})
+				return err
+			}
+			`, struct {
+			Name string
+			Type *compile.MapSpec
+		}{
+			Name: name,
+			Type: root,
+		},
+		TemplateFunc("zapMapItemMarshaler", m.zapMapItemMarshaler),
+	); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v{%v}", name, fieldValue), nil
+}
+
+// containerConstant generates an expression which builds a container
+// holding the items of the given constant map.
+func containerConstant(g Generator, v compile.ConstantMap, t compile.TypeSpec) (string, error) {
+	spec := compile.RootTypeSpec(t).(*compile.MapSpec)
+	container, err := containerName(g, spec)
+	if err != nil {
+		return "", err
+	}
+
+	return g.TextTemplate(
+		`
+		<- $keyType := .Spec.KeySpec ->
+		<- $valueType := .Spec.ValueSpec ->
+		<- $o := newVar "o" ->
+		func() <typeReference .Type> {
+			<$o> := new(<.Container>)
+			<range .Value ->
+				<$o>.Put(<constantValue .Key $keyType>, <constantValue .Value $valueType>)
+			<end ->
+			return <$o>
+		}()`, struct {
+			Type      compile.TypeSpec
+			Spec      *compile.MapSpec
+			Container string
+			Value     compile.ConstantMap
+		}{Type: t, Spec: spec, Container: container, Value: v},
+		TemplateFunc("constantValue", ConstantValue))
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tmc "go.uber.org/thriftrw/gen/internal/tests/map_containers"
+	"go.uber.org/thriftrw/gen/internal/tests/ordered"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func newStringInt32Map(items ...interface{}) *ordered.StringInt32Map {
+	var m ordered.StringInt32Map
+	for i := 0; i < len(items); i += 2 {
+		m.Put(items[i].(string), int32(items[i+1].(int)))
+	}
+	return &m
+}
+
+func TestMapContainerRoundTrip(t *testing.T) {
+	var names ordered.Int32StringMap
+	names.Put(2, "two")
+	names.Put(1, "one")
+
+	x := tmc.Inventory{
+		Counts:   newStringInt32Map("b", 2, "a", 1),
+		Names:    &names,
+		History:  []*ordered.StringInt32Map{newStringInt32Map("c", 3), newStringInt32Map()},
+		Defaults: newStringInt32Map(),
+	}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{
+				{Key: wire.NewValueString("b"), Value: wire.NewValueI32(2)},
+				{Key: wire.NewValueString("a"), Value: wire.NewValueI32(1)},
+			}),
+		)},
+		{ID: 2, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TI32, wire.TBinary, []wire.MapItem{
+				{Key: wire.NewValueI32(2), Value: wire.NewValueString("two")},
+				{Key: wire.NewValueI32(1), Value: wire.NewValueString("one")},
+			}),
+		)},
+		{ID: 3, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TMap, []wire.Value{
+				wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{
+					{Key: wire.NewValueString("c"), Value: wire.NewValueI32(3)},
+				})),
+				wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{})),
+			}),
+		)},
+		{ID: 4, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{}),
+		)},
+	}})
+
+	assertRoundTrip(t, &x, v, "Inventory")
+	testRoundTripCombos(t, &x, v, "Inventory")
+}
+
+func TestMapContainerEncodeOrder(t *testing.T) {
+	x := tmc.Inventory{
+		Counts:   newStringInt32Map("b", 2, "a", 1),
+		Defaults: newStringInt32Map(),
+	}
+	want := []byte{
+		0x0d,       // type:1 = map
+		0x00, 0x01, // id:2 = 1
+		0x0b, 0x08, // key:1 = string, value:1 = i32
+		0x00, 0x00, 0x00, 0x02, // length:4 = 2
+		0x00, 0x00, 0x00, 0x01, 'b', // "b"
+		0x00, 0x00, 0x00, 0x02, // 2
+		0x00, 0x00, 0x00, 0x01, 'a', // "a"
+		0x00, 0x00, 0x00, 0x01, // 1
+
+		0x0d,       // type:1 = map
+		0x00, 0x04, // id:2 = 4
+		0x0b, 0x08, // key:1 = string, value:1 = i32
+		0x00, 0x00, 0x00, 0x00, // length:4 = 0
+		0x00, // stop
+	}
+
+	for i := 0; i < 10; i++ {
+		w, err := x.ToWire()
+		require.NoError(t, err)
+		var buff bytes.Buffer
+		require.NoError(t, binary.Default.Encode(w, &buff))
+		assert.Equal(t, want, buff.Bytes(), "ToWire")
+
+		buff.Reset()
+		sw := binary.NewStreamWriter(&buff)
+		require.NoError(t, x.Encode(sw))
+		require.NoError(t, sw.Close())
+		assert.Equal(t, want, buff.Bytes(), "Encode")
+	}
+
+	var got tmc.Inventory
+	require.NoError(t, got.Decode(binary.Default.Reader(bytes.NewReader(want))))
+	var keys []string
+	require.NoError(t, got.Counts.ForEach(func(k string, _ int32) error {
+		keys = append(keys, k)
+		return nil
+	}))
+	assert.Equal(t, []string{"b", "a"}, keys, "decoding must keep the order of the items")
+}
+
+func TestMapContainerEqualsCopyHash(t *testing.T) {
+	x := &tmc.Inventory{Counts: newStringInt32Map("a", 1, "b", 2)}
+	y := &tmc.Inventory{Counts: newStringInt32Map("b", 2, "a", 1)}
+	assert.True(t, x.Equals(y), "order of items must not matter for Equals")
+	assert.Equal(t, x.Hash(), y.Hash(), "order of items must not matter for Hash")
+
+	assert.False(t, x.Equals(&tmc.Inventory{Counts: newStringInt32Map("a", 1, "b", 3)}))
+	assert.False(t, x.Equals(&tmc.Inventory{Counts: newStringInt32Map("a", 1, "c", 2)}))
+	assert.False(t, x.Equals(&tmc.Inventory{Counts: newStringInt32Map("a", 1)}))
+	assert.True(t,
+		(&tmc.Inventory{Counts: newStringInt32Map()}).Equals(&tmc.Inventory{}),
+		"empty and nil containers must be equal")
+
+	c := x.Copy()
+	assert.True(t, x.Equals(c))
+	c.Counts.Put("c", 3)
+	assert.Equal(t, 2, x.Counts.Len(), "copies must not share containers")
+	assert.Nil(t, (&tmc.Inventory{}).Copy().Counts)
+}
+
+func TestMapContainerConstant(t *testing.T) {
+	var keys []string
+	require.NoError(t, tmc.ConstCounts.ForEach(func(k string, _ int32) error {
+		keys = append(keys, k)
+		return nil
+	}))
+	assert.Equal(t, []string{"world", "hello"}, keys)
+
+	v, ok := tmc.Default_Inventory().Defaults.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), v)
+}
+
+func TestMapContainerZap(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	x := &tmc.Inventory{Counts: newStringInt32Map("b", 2, "a", 1)}
+	require.NoError(t, x.MarshalLogObject(enc))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "b", "value": int32(2)},
+		map[string]interface{}{"key": "a", "value": int32(1)},
+	}, enc.Fields["counts"])
+}
+
+func TestMapContainerErrors(t *testing.T) {
+	container := func(annotation string) *compile.MapSpec {
+		return &compile.MapSpec{
+			KeySpec:     &compile.StringSpec{},
+			ValueSpec:   &compile.I32Spec{},
+			Annotations: compile.Annotations{"go.type": annotation},
+		}
+	}
+
+	t.Run("invalid annotation", func(t *testing.T) {
+		for _, give := range []string{".StringMap", "example.com/ordered.", "example.com/ordered.stringMap"} {
+			spec := &compile.StructSpec{Name: "Foo", Fields: compile.FieldGroup{
+				{ID: 1, Name: "counts", Type: container(give)},
+			}}
+			err := structure(NewGenerator(&GeneratorOptions{}), spec)
+			require.Error(t, err, give)
+			assert.Contains(t, err.Error(), "expected a Go type", give)
+		}
+	})
+
+	t.Run("typedef", func(t *testing.T) {
+		spec := &compile.TypedefSpec{Name: "Counts", Target: container("example.com/ordered.StringMap")}
+		err := typedef(NewGenerator(&GeneratorOptions{}), spec)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "typedefs of maps annotated with a container type are not supported")
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// unionMatch generates a <Name>_Visitor interface with a method for each
+// field of the given union, and a Match method which calls the method for
+// the field that is set.
+//
+// Adding a field to the union adds a method to the interface, so visitors
+// which do not handle it stop compiling.
+func unionMatch(g Generator, name string, spec *compile.StructSpec, presence presenceLayout) error {
+	for _, f := range spec.Fields {
+		fname, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if fname == "Match" {
+			return fmt.Errorf("field %q conflicts with the generated Match method", f.Name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		// <.Name>_Visitor visits the field of a <.Name> which is set.
+		//
+		// Fields added to <.Name> add methods to <.Name>_Visitor, so that
+		// implementations which do not handle them fail to compile.
+		type <.Name>_Visitor interface {
+			<range .Fields ->
+				// Visit<goName .> is called with the value of <goName .> if it is set.
+				Visit<goName .>(<typeReference .Type>) error
+
+			<end ->
+			// Default is called if no field of <.Name> is set, for example
+			// because it was decoded from a peer which knows about fields
+			// that this code does not.
+			Default() error
+		}
+
+		<$v := newVar "v">
+		<$visitor := newVar "visitor">
+		// Match calls the method of visitor for the field of this <.Name>
+		// which is set, or Default if none is, and returns its error.
+		func (<$v> *<.Name>) Match(<$visitor> <.Name>_Visitor) error {
+			<- if .Fields>
+			if <$v> != nil {
+				<- range .Fields>
+				<- $f := printf "%v.%v" $v (goName .)>
+				<- if $.Presence.Has .>
+				if <$.Presence.IsSet $v .> {
+					return <$visitor>.Visit<goName .>(<$f>)
+				}
+				<- else if isPrimitiveType .Type>
+				if <$f> != nil {
+					return <$visitor>.Visit<goName .>(*<$f>)
+				}
+				<- else>
+				if <$f> != nil {
+					return <$visitor>.Visit<goName .>(<$f>)
+				}
+				<- end>
+				<- end>
+			}
+			<- end>
+			return <$visitor>.Default()
+		}
+		`,
+		struct {
+			Name     string
+			Fields   compile.FieldGroup
+			Presence presenceLayout
+		}{Name: name, Fields: spec.Fields, Presence: presence},
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tpb "go.uber.org/thriftrw/gen/internal/tests/presence-bits"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
+	"go.uber.org/thriftrw/ptr"
+)
+
+// arbitraryValueVisitor describes the field of an ArbitraryValue that it
+// visits.
+type arbitraryValueVisitor struct{ got string }
+
+var _ tu.ArbitraryValue_Visitor = (*arbitraryValueVisitor)(nil)
+
+func (v *arbitraryValueVisitor) VisitBoolValue(x bool) error {
+	v.got = fmt.Sprintf("bool %v", x)
+	return nil
+}
+
+func (v *arbitraryValueVisitor) VisitInt64Value(x int64) error {
+	v.got = fmt.Sprintf("int64 %v", x)
+	return nil
+}
+
+func (v *arbitraryValueVisitor) VisitStringValue(x string) error {
+	v.got = fmt.Sprintf("string %v", x)
+	return nil
+}
+
+func (v *arbitraryValueVisitor) VisitListValue(x []*tu.ArbitraryValue) error {
+	v.got = fmt.Sprintf("list of %v", len(x))
+	return nil
+}
+
+func (v *arbitraryValueVisitor) VisitMapValue(x map[string]*tu.ArbitraryValue) error {
+	v.got = fmt.Sprintf("map of %v", len(x))
+	return nil
+}
+
+func (v *arbitraryValueVisitor) Default() error {
+	return errors.New("no field is set")
+}
+
+func TestUnionMatch(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    *tu.ArbitraryValue
+		want    string
+		wantErr string
+	}{
+		{
+			desc: "primitive",
+			give: &tu.ArbitraryValue{Int64Value: ptr.Int64(42)},
+			want: "int64 42",
+		},
+		{
+			desc: "zero value",
+			give: &tu.ArbitraryValue{BoolValue: ptr.Bool(false)},
+			want: "bool false",
+		},
+		{
+			desc: "container",
+			give: &tu.ArbitraryValue{ListValue: []*tu.ArbitraryValue{{}, {}}},
+			want: "list of 2",
+		},
+		{
+			desc:    "empty",
+			give:    &tu.ArbitraryValue{},
+			wantErr: "no field is set",
+		},
+		{
+			desc:    "nil",
+			wantErr: "no field is set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var v arbitraryValueVisitor
+			err := tt.give.Match(&v)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.got)
+		})
+	}
+}
+
+// choiceVisitor records the field of a Choice that it visits.
+type choiceVisitor struct{ got interface{} }
+
+func (v *choiceVisitor) VisitText(x string) error      { v.got = x; return nil }
+func (v *choiceVisitor) VisitNumber(x int64) error     { v.got = x; return nil }
+func (v *choiceVisitor) VisitPoint(x *tpb.Point) error { v.got = x; return nil }
+func (v *choiceVisitor) Default() error                { v.got = nil; return nil }
+
+func TestUnionMatchPresenceBits(t *testing.T) {
+	var c tpb.Choice
+	c.SetNumber(0)
+
+	var v choiceVisitor
+	require.NoError(t, c.Match(&v))
+	assert.Equal(t, int64(0), v.got, "zero values which are set must be visited")
+}
+
+func TestUnionMatchConflict(t *testing.T) {
+	thriftRoot := t.TempDir()
+	path := filepath.Join(thriftRoot, "u.thrift")
+	require.NoError(t, os.WriteFile(path, []byte("union U {\n1: string match\n}\n"), 0o644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     t.TempDir(),
+		PackagePrefix: "example.com/gen",
+		ThriftRoot:    thriftRoot,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `field "match" conflicts with the generated Match method`)
+}
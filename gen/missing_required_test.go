@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmh "go.uber.org/thriftrw/gen/internal/tests/missing-required-hook"
+	tmz "go.uber.org/thriftrw/gen/internal/tests/missing-required-zero"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/requiredfield"
+	"go.uber.org/thriftrw/wire"
+)
+
+// userWithoutRequired is a User with only its optional name field.
+var userWithoutRequired = singleFieldStruct(2, wire.NewValueString("alice"))
+
+func TestMissingRequiredHookRejectsByDefault(t *testing.T) {
+	var u tmh.User
+	err := u.FromWire(userWithoutRequired)
+	var missing *requiredfield.MissingError
+	require.True(t, errors.As(err, &missing), "unexpected error %v", err)
+	assert.Equal(t, &requiredfield.MissingError{Struct: "User", Field: "ID"}, missing)
+	assert.EqualError(t, err, "field ID of User is required")
+
+	err = streamDecodeWireType(t, userWithoutRequired, &tmh.User{})
+	assert.EqualError(t, err, "field ID of User is required")
+}
+
+func TestMissingRequiredHook(t *testing.T) {
+	var got []string
+	requiredfield.SetHandler(func(err *requiredfield.MissingError) error {
+		got = append(got, err.Struct+"."+err.Field)
+		return nil
+	})
+	defer requiredfield.SetHandler(nil)
+
+	want := tmh.User{Name: ptr.String("alice"), Age: ptr.Int32(18)}
+
+	var u tmh.User
+	require.NoError(t, u.FromWire(userWithoutRequired))
+	assert.Equal(t, want, u)
+	assert.Equal(t, []string{"User.ID", "User.Home"}, got,
+		"fields with defaults must not be reported")
+
+	got = nil
+	u = tmh.User{}
+	require.NoError(t, streamDecodeWireType(t, userWithoutRequired, &u))
+	assert.Equal(t, want, u)
+	assert.Equal(t, []string{"User.ID", "User.Home"}, got)
+
+	got = nil
+	var args tmh.Users_Get_Args
+	require.NoError(t, args.FromWire(wire.NewValueStruct(wire.Struct{})))
+	assert.Equal(t, []string{"Users_Get_Args.ID"}, got)
+}
+
+func TestMissingRequiredHookLazy(t *testing.T) {
+	var buf bytes.Buffer
+	payload := singleFieldStruct(2, wire.NewValueBinary([]byte("x")))
+	require.NoError(t, binary.Default.Encode(payload, &buf))
+
+	var e tmh.Event_Lazy
+	e.Reset(buf.Bytes())
+	_, err := e.GetName()
+	assert.EqualError(t, err, "field Name of Event is required")
+
+	requiredfield.SetHandler(requiredfield.Ignore)
+	defer requiredfield.SetHandler(nil)
+
+	e.Reset(buf.Bytes())
+	name, err := e.GetName()
+	require.NoError(t, err)
+	assert.Empty(t, name)
+}
+
+func TestMissingRequiredZero(t *testing.T) {
+	want := tmz.User{Name: ptr.String("alice"), Age: ptr.Int32(18)}
+
+	var u tmz.User
+	require.NoError(t, u.FromWire(userWithoutRequired))
+	assert.Equal(t, want, u)
+
+	u = tmz.User{}
+	require.NoError(t, streamDecodeWireType(t, userWithoutRequired, &u))
+	assert.Equal(t, want, u)
+
+	_, err := u.ToWire()
+	assert.EqualError(t, err, "field Home of User is required",
+		"unset required fields must still fail encoding")
+}
+
+func TestMissingRequiredInvalid(t *testing.T) {
+	err := Generate(nil, &Options{
+		OutputDir:       "/out",
+		ThriftRoot:      "/thrift",
+		MissingRequired: "warn",
+	})
+	assert.EqualError(t, err, `unknown value "warn" for MissingRequired: must be "error", "hook", or "zero"`)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
+)
+
+// namedEntity is an entity whose Go name may be chosen by a NameResolver.
+type namedEntity struct {
+	api.Entity
+
+	// Annotations of the entity, to which the chosen name is written as a
+	// go.name annotation.
+	annotations *compile.Annotations
+}
+
+func (e *namedEntity) String() string {
+	kind := strings.ToLower(e.Kind.String())
+	if e.Parent != nil {
+		return fmt.Sprintf("%v %q of %q", kind, e.ThriftName, *e.Parent)
+	}
+	return fmt.Sprintf("%v %q", kind, e.ThriftName)
+}
+
+// resolveNames asks the given NameResolvers, in order, to choose Go names for
+// the types, struct fields, and service functions of the given module, and
+// records the names they choose as go.name annotations so that the rest of
+// code generation picks them up.
+//
+// Names are checked to be exported Go identifiers which do not conflict with
+// the names of other entities of the same kind in the same scope.
+func resolveNames(m *compile.Module, resolvers ...api.NameResolver) error {
+	entities, err := collectNamedEntities(m)
+	if err != nil || len(entities) == 0 {
+		return err
+	}
+
+	req := &api.ResolveNamesRequest{Entities: make([]*api.Entity, len(entities))}
+	var changed bool
+	for _, nr := range resolvers {
+		for i, e := range entities {
+			entity := e.Entity
+			req.Entities[i] = &entity
+		}
+
+		res, err := nr.ResolveNames(req)
+		if err != nil {
+			return err
+		}
+		if len(res.Names) == 0 {
+			continue
+		}
+		if len(res.Names) != len(entities) {
+			return fmt.Errorf("got %d names for %d entities", len(res.Names), len(entities))
+		}
+
+		for i, name := range res.Names {
+			e := entities[i]
+			if name == "" || name == e.GoName {
+				continue
+			}
+			if !token.IsIdentifier(name) || !token.IsExported(name) {
+				return fmt.Errorf("cannot name %v %q: not an exported Go identifier", e, name)
+			}
+			e.GoName = name
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	// Entities of the same kind must have unique names within their type,
+	// service, or module.
+	type scope struct {
+		Kind   api.EntityKind
+		Parent string
+		Name   string
+	}
+	seen := make(map[scope]*namedEntity, len(entities))
+	for _, e := range entities {
+		s := scope{Kind: e.Kind, Name: e.GoName}
+		if e.Parent != nil {
+			s.Parent = *e.Parent
+		}
+		if other, ok := seen[s]; ok {
+			return fmt.Errorf("cannot name both %v and %v %q", other, e, e.GoName)
+		}
+		seen[s] = e
+	}
+
+	for _, e := range entities {
+		if *e.annotations == nil {
+			*e.annotations = make(compile.Annotations)
+		}
+		(*e.annotations)[goNameKey] = e.GoName
+	}
+	return nil
+}
+
+// collectNamedEntities returns the entities of the given module which may be
+// renamed, along with the names they would get otherwise.
+func collectNamedEntities(m *compile.Module) ([]*namedEntity, error) {
+	var entities []*namedEntity
+	add := func(kind api.EntityKind, parent string, e compile.NamedEntity, annotations *compile.Annotations) error {
+		name, err := goName(e)
+		if err != nil {
+			return err
+		}
+
+		entity := &namedEntity{
+			Entity: api.Entity{
+				Kind:           kind,
+				ThriftName:     e.ThriftName(),
+				ThriftFilePath: m.ThriftPath,
+				GoName:         name,
+				Annotations:    *annotations,
+			},
+			annotations: annotations,
+		}
+		if parent != "" {
+			entity.Parent = ptr.String(parent)
+		}
+		entities = append(entities, entity)
+		return nil
+	}
+
+	for _, name := range sortStringKeys(m.Types) {
+		var err error
+		switch t := m.Types[name].(type) {
+		case *compile.StructSpec:
+			if err = add(api.EntityKindType, "", t, &t.Annotations); err != nil {
+				break
+			}
+			for _, f := range t.Fields {
+				if err = add(api.EntityKindField, name, f, &f.Annotations); err != nil {
+					break
+				}
+			}
+		case *compile.EnumSpec:
+			err = add(api.EntityKindType, "", t, &t.Annotations)
+		case *compile.TypedefSpec:
+			err = add(api.EntityKindType, "", t, &t.Annotations)
+		}
+		if err != nil {
+			return nil, wrapGenerateError(name, err)
+		}
+	}
+
+	for _, serviceName := range sortStringKeys(m.Services) {
+		s := m.Services[serviceName]
+		for _, name := range sortStringKeys(s.Functions) {
+			f := s.Functions[name]
+			if err := add(api.EntityKindFunction, serviceName, f, &f.Annotations); err != nil {
+				return nil, wrapGenerateError(serviceName+"."+name, err)
+			}
+		}
+	}
+
+	return entities, nil
+}
+
+// goNameMap is a NameResolver which looks up Go names by the Thrift names of
+// entities. Fields and functions are looked up as "Parent.name".
+type goNameMap struct {
+	names map[string]string
+	used  map[string]struct{}
+}
+
+func newGoNameMap(names map[string]string) *goNameMap {
+	return &goNameMap{names: names, used: make(map[string]struct{})}
+}
+
+func (nm *goNameMap) ResolveNames(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	var res api.ResolveNamesResponse
+	for i, e := range req.Entities {
+		key := e.ThriftName
+		if e.Parent != nil {
+			key = *e.Parent + "." + key
+		}
+
+		name, ok := nm.names[key]
+		if !ok {
+			continue
+		}
+		if res.Names == nil {
+			res.Names = make([]string, len(req.Entities))
+		}
+		res.Names[i] = name
+		nm.used[key] = struct{}{}
+	}
+	return &res, nil
+}
+
+// checkUsed returns an error if any of the names in the map did not match an
+// entity.
+func (nm *goNameMap) checkUsed() error {
+	var unused []string
+	for _, key := range sortStringKeys(nm.names) {
+		if _, ok := nm.used[key]; !ok {
+			unused = append(unused, fmt.Sprintf("%q", key))
+		}
+	}
+	if len(unused) > 0 {
+		return fmt.Errorf("Go names specified for unknown entities: %v", strings.Join(unused, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+const _namesThrift = `
+struct User {
+	1: required string user_id
+	2: optional string name (go.name = "FullName")
+}
+
+enum Role { ADMIN, MEMBER }
+
+typedef string Email
+
+service Users {
+	User getUser(1: string userID)
+}
+`
+
+func compileNamesThrift(t *testing.T) (dir string, m *compile.Module) {
+	dir = t.TempDir()
+	path := filepath.Join(dir, "users.thrift")
+	require.NoError(t, os.WriteFile(path, []byte(_namesThrift), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return dir, m
+}
+
+type fakeNameResolver func(*api.ResolveNamesRequest) (*api.ResolveNamesResponse, error)
+
+func (f fakeNameResolver) ResolveNames(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	return f(req)
+}
+
+// renameTo returns a NameResolver which renames entities by their Thrift
+// names.
+func renameTo(names map[string]string) api.NameResolver {
+	return fakeNameResolver(func(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+		res := &api.ResolveNamesResponse{Names: make([]string, len(req.Entities))}
+		for i, e := range req.Entities {
+			res.Names[i] = names[e.ThriftName]
+		}
+		return res, nil
+	})
+}
+
+func TestResolveNamesRequest(t *testing.T) {
+	_, m := compileNamesThrift(t)
+
+	var got []*api.Entity
+	err := resolveNames(m, fakeNameResolver(func(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+		got = req.Entities
+		return &api.ResolveNamesResponse{}, nil
+	}))
+	require.NoError(t, err)
+
+	type entity struct {
+		Kind   api.EntityKind
+		Name   string
+		Parent string
+		GoName string
+	}
+	var entities []entity
+	for _, e := range got {
+		assert.Equal(t, m.ThriftPath, e.ThriftFilePath)
+		var parent string
+		if e.Parent != nil {
+			parent = *e.Parent
+		}
+		entities = append(entities, entity{e.Kind, e.ThriftName, parent, e.GoName})
+	}
+	assert.Equal(t, []entity{
+		{api.EntityKindType, "Email", "", "Email"},
+		{api.EntityKindType, "Role", "", "Role"},
+		{api.EntityKindType, "User", "", "User"},
+		{api.EntityKindField, "user_id", "User", "UserID"},
+		{api.EntityKindField, "name", "User", "FullName"},
+		{api.EntityKindFunction, "getUser", "Users", "GetUser"},
+	}, entities)
+
+	assert.Equal(t, map[string]string{"go.name": "FullName"}, got[4].Annotations)
+
+	user := m.Types["User"].(*compile.StructSpec)
+	_, ok := user.Annotations[goNameKey]
+	assert.False(t, ok, "annotations must not change if no names were changed")
+}
+
+func TestResolveNames(t *testing.T) {
+	tests := []struct {
+		desc      string
+		resolvers []api.NameResolver
+		wantNames map[string]string // Type, Type.field, or Service.function
+		wantErr   string
+	}{
+		{
+			desc: "rename",
+			resolvers: []api.NameResolver{renameTo(map[string]string{
+				"User":    "Account",
+				"user_id": "AccountID",
+				"getUser": "FetchUser",
+			})},
+			wantNames: map[string]string{
+				"User":          "Account",
+				"User.user_id":  "AccountID",
+				"User.name":     "FullName",
+				"Users.getUser": "FetchUser",
+				"Role":          "Role",
+			},
+		},
+		{
+			desc: "later resolvers win",
+			resolvers: []api.NameResolver{
+				renameTo(map[string]string{"User": "Account", "Role": "Permission"}),
+				renameTo(map[string]string{"User": "Member"}),
+			},
+			wantNames: map[string]string{
+				"User": "Member",
+				"Role": "Permission",
+			},
+		},
+		{
+			desc:      "not exported",
+			resolvers: []api.NameResolver{renameTo(map[string]string{"User": "user"})},
+			wantErr:   `cannot name type "User" "user": not an exported Go identifier`,
+		},
+		{
+			desc:      "not an identifier",
+			resolvers: []api.NameResolver{renameTo(map[string]string{"getUser": "Get-User"})},
+			wantErr:   `cannot name function "getUser" of "Users" "Get-User": not an exported Go identifier`,
+		},
+		{
+			desc:      "type conflict",
+			resolvers: []api.NameResolver{renameTo(map[string]string{"Email": "Role"})},
+			wantErr:   `cannot name both type "Email" and type "Role" "Role"`,
+		},
+		{
+			desc:      "field conflict",
+			resolvers: []api.NameResolver{renameTo(map[string]string{"user_id": "FullName"})},
+			wantErr:   `cannot name both field "user_id" of "User" and field "name" of "User" "FullName"`,
+		},
+		{
+			desc:      "fields of different types may share names",
+			resolvers: []api.NameResolver{renameTo(map[string]string{"user_id": "User"})},
+			wantNames: map[string]string{
+				"User":         "User",
+				"User.user_id": "User",
+			},
+		},
+		{
+			desc: "wrong number of names",
+			resolvers: []api.NameResolver{fakeNameResolver(func(*api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+				return &api.ResolveNamesResponse{Names: []string{"Foo"}}, nil
+			})},
+			wantErr: "got 1 names for 6 entities",
+		},
+		{
+			desc: "resolver error",
+			resolvers: []api.NameResolver{fakeNameResolver(func(*api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+				return nil, errors.New("great sadness")
+			})},
+			wantErr: "great sadness",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, m := compileNamesThrift(t)
+
+			err := resolveNames(m, tt.resolvers...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			entities, err := collectNamedEntities(m)
+			require.NoError(t, err)
+
+			got := make(map[string]string)
+			for _, e := range entities {
+				key := e.ThriftName
+				if e.Parent != nil {
+					key = *e.Parent + "." + key
+				}
+				got[key] = e.GoName
+			}
+			for key, want := range tt.wantNames {
+				assert.Equal(t, want, got[key], "name of %q", key)
+			}
+		})
+	}
+}
+
+func TestGenerateGoNames(t *testing.T) {
+	dir, m := compileNamesThrift(t)
+
+	outputDir := t.TempDir()
+	err := Generate(m, &Options{
+		OutputDir:      outputDir,
+		ThriftRoot:     dir,
+		PackagePrefix:  "example.com/gen",
+		NoVersionCheck: true,
+		NoEmbedIDL:     true,
+		Procedures:     true,
+		Plugin: CodeGenerator{
+			NameResolver: renameTo(map[string]string{"getUser": "LookupUser"}),
+		},
+		GoNames: map[string]string{
+			"User":          "Account",
+			"User.user_id":  "AccountID",
+			"Users.getUser": "FetchUser",
+		},
+	})
+	require.NoError(t, err)
+
+	body, err := os.ReadFile(filepath.Join(outputDir, "users", "users.go"))
+	require.NoError(t, err)
+	code := string(body)
+
+	assert.Contains(t, code, "type Account struct {")
+	assert.Contains(t, code, "\tAccountID string ")
+	assert.Contains(t, code, "\tFullName  *string ")
+	assert.Contains(t, code, "type Users_FetchUser_Args struct {")
+	assert.Contains(t, code, "\tFetchUser(ctx context.Context")
+	assert.NotContains(t, code, "LookupUser", "--go-name must take precedence over plugins")
+
+	t.Run("unknown entity", func(t *testing.T) {
+		_, m := compileNamesThrift(t)
+		err := Generate(m, &Options{
+			OutputDir:  t.TempDir(),
+			ThriftRoot: dir,
+			GoNames:    map[string]string{"User.email": "Email", "User": "Account"},
+		})
+		assert.EqualError(t, err, `Go names specified for unknown entities: "User.email"`)
+	})
+}
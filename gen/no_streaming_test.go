@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tns "go.uber.org/thriftrw/gen/internal/tests/no-streaming"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestNoStreamingRoundTrip(t *testing.T) {
+	status := tns.StatusInactive
+	give := &tns.Record{
+		Name:    "foo",
+		Status:  &status,
+		Scores:  map[string][]int32{"bar": {1, 2, 3}},
+		History: map[tns.Status]struct{}{tns.StatusActive: {}},
+		Aliases: tns.Names{"baz"},
+	}
+
+	v, err := give.ToWire()
+	require.NoError(t, err)
+
+	var got tns.Record
+	require.NoError(t, got.FromWire(v))
+	assert.Equal(t, give, &got)
+}
+
+func TestNoStreamingOmitsMethods(t *testing.T) {
+	type encoder interface {
+		Encode(stream.Writer) error
+	}
+	type decoder interface {
+		Decode(stream.Reader) error
+	}
+
+	types := []interface{}{
+		new(tns.Record),
+		new(tns.Value),
+		new(tns.NotFound),
+		new(tns.Status),
+		new(tns.Name),
+		new(tns.Names),
+		new(tns.AliasedRecord),
+		new(tns.Store_Get_Args),
+		new(tns.Store_Get_Result),
+	}
+	for _, v := range types {
+		_, isEncoder := v.(encoder)
+		assert.False(t, isEncoder, "%T must not have an Encode method", v)
+
+		_, isDecoder := v.(decoder)
+		assert.False(t, isDecoder, "%T must not have a Decode method", v)
+
+		_, isValue := v.(interface{ FromWire(wire.Value) error })
+		assert.True(t, isValue, "%T must have a FromWire method", v)
+	}
+}
+
+func TestNoStreamingOptions(t *testing.T) {
+	tests := []struct {
+		desc    string
+		file    string
+		give    Options
+		wantErr string
+	}{
+		{
+			desc:    "dual encode",
+			file:    "internal/tests/thrift/no-streaming.thrift",
+			give:    Options{NoStreaming: true, DualEncode: true},
+			wantErr: "DualEncode cannot be combined with NoStreaming: it requires Encode methods",
+		},
+		{
+			desc:    "lazy structs",
+			file:    "internal/tests/thrift/no-streaming.thrift",
+			give:    Options{NoStreaming: true, LazyStructs: true},
+			wantErr: "LazyStructs cannot be combined with NoStreaming: they require Decode methods",
+		},
+		{
+			desc:    "thrift json",
+			file:    "internal/tests/thrift/no-streaming.thrift",
+			give:    Options{NoStreaming: true, ThriftJSON: true},
+			wantErr: "ThriftJSON cannot be combined with NoStreaming: it requires Encode and Decode methods",
+		},
+		{
+			desc:    "lazy annotation",
+			file:    "internal/tests/thrift/presence-bits.thrift",
+			give:    Options{NoStreaming: true},
+			wantErr: "lazy structs cannot be generated with --no-streaming: they require Decode methods",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			module, err := compile.Compile(tt.file)
+			require.NoError(t, err)
+
+			opts := tt.give
+			opts.OutputDir = t.TempDir()
+			opts.ThriftRoot = testdata(t, "thrift")
+			opts.NoRecurse = true
+			err = Generate(module, &opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		module, err := compile.Compile("internal/tests/thrift/no-streaming.thrift")
+		require.NoError(t, err)
+		assert.NoError(t, Generate(module, &Options{
+			OutputDir:   t.TempDir(),
+			ThriftRoot:  testdata(t, "thrift"),
+			NoRecurse:   true,
+			NoStreaming: true,
+		}))
+	})
+}
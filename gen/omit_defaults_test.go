@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tod "go.uber.org/thriftrw/gen/internal/tests/omit-defaults"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestOmitDefaults(t *testing.T) {
+	green := tod.ColorGreen
+	blue := tod.ColorBlue
+
+	tests := []struct {
+		desc string
+		x    thriftType
+		v    wire.Value
+	}{
+		{
+			desc: "values matching defaults are omitted",
+			x: &tod.Defaults{
+				Count:         ptr.Int32(10),
+				Name:          ptr.String("foo"),
+				Color:         &green,
+				Tags:          []string{"a", "b"},
+				Origin:        &tod.Point{X: 0, Y: 0},
+				AlwaysWritten: ptr.Bool(true),
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 7, Value: wire.NewValueBool(true)},
+			}}),
+		},
+		{
+			desc: "values differing from defaults are written",
+			x: &tod.Defaults{
+				Count:         ptr.Int32(11),
+				Name:          ptr.String("foo"),
+				Color:         &blue,
+				Tags:          []string{"a", "b"},
+				Origin:        &tod.Point{X: 1, Y: 0},
+				NoDefault:     ptr.Int64(0),
+				AlwaysWritten: ptr.Bool(false),
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueI32(11)},
+				{ID: 3, Value: wire.NewValueI32(2)},
+				{ID: 5, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueI32(1)},
+					{ID: 2, Value: wire.NewValueI32(0)},
+				}})},
+				{ID: 6, Value: wire.NewValueI64(0)},
+				{ID: 7, Value: wire.NewValueBool(false)},
+			}}),
+		},
+		{
+			desc: "struct annotation disables omission",
+			x: &tod.NeverOmitted{
+				Count: ptr.Int32(10),
+				Name:  ptr.String("foo"),
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueI32(10)},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			testRoundTripCombos(t, tt.x, tt.v, tt.desc)
+		})
+	}
+}
+
+func TestOmitDefaultInvalidAnnotation(t *testing.T) {
+	fg := fieldGroupGenerator{
+		Namespace: NewNamespace(),
+		Fields: compile.FieldGroup{
+			{
+				Name:        "foo",
+				Type:        &compile.I32Spec{},
+				Default:     compile.ConstantInt(1),
+				Annotations: compile.Annotations{"go.omit_default": "yes"},
+			},
+		},
+	}
+	err := fg.Generate(nil /* generator */)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`invalid go.omit_default annotation on field "foo": "yes" is not a boolean`)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	onlyclients "go.uber.org/thriftrw/gen/internal/tests/only-clients"
+	onlyservers "go.uber.org/thriftrw/gen/internal/tests/only-servers"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
+)
+
+func TestOnlyHelpers(t *testing.T) {
+	hasField := func(v interface{}, name string) bool {
+		_, ok := reflect.TypeOf(v).FieldByName(name)
+		return ok
+	}
+
+	assert.True(t, hasField(onlyclients.Items_GetItem_Helper, "UnwrapResponse"))
+	assert.False(t, hasField(onlyclients.Items_GetItem_Helper, "WrapResponse"))
+	assert.False(t, hasField(onlyclients.Items_PutItem_Helper, "WrapResponse"))
+
+	assert.True(t, hasField(onlyservers.Items_GetItem_Helper, "WrapResponse"))
+	assert.False(t, hasField(onlyservers.Items_GetItem_Helper, "UnwrapResponse"))
+	assert.False(t, hasField(onlyservers.Items_PutItem_Helper, "UnwrapResponse"))
+
+	res, err := onlyservers.Items_GetItem_Helper.WrapResponse(
+		nil, &onlyservers.ItemNotFound{Key: "foo"})
+	require.NoError(t, err)
+	assert.Equal(t, "foo", res.NotFound.Key)
+}
+
+func TestOnlyOptions(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    Options
+		wantErr string
+	}{
+		{
+			desc:    "unknown",
+			give:    Options{Only: "everything"},
+			wantErr: `unknown value "everything" for Only: must be "types", "clients", or "servers"`,
+		},
+		{
+			desc:    "clients with procedures",
+			give:    Options{Only: OnlyClients, Procedures: true},
+			wantErr: `Procedures cannot be generated with Only "clients": they are used by servers`,
+		},
+		{
+			desc:    "types with HTTP handlers",
+			give:    Options{Only: OnlyTypes, HTTPHandlers: true},
+			wantErr: `HTTPHandlers cannot be generated with Only "types": they are used by servers`,
+		},
+		{
+			desc:    "types with service specs",
+			give:    Options{Only: OnlyTypes, ServiceSpecs: true},
+			wantErr: `ServiceSpecs cannot be generated with Only "types"`,
+		},
+	}
+
+	module, err := compile.Compile("internal/tests/thrift/only-types.thrift")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			opts := tt.give
+			opts.OutputDir = os.TempDir()
+			opts.ThriftRoot = testdata(t, "thrift")
+			assert.EqualError(t, Generate(module, &opts), tt.wantErr)
+		})
+	}
+}
+
+func TestOnlyPluginRequest(t *testing.T) {
+	tests := []struct {
+		only          string
+		wantCalled    bool
+		wantNoClients *bool
+		wantNoServers *bool
+	}{
+		{only: "", wantCalled: true},
+		{only: OnlyTypes},
+		{only: OnlyClients, wantCalled: true, wantNoServers: ptr.Bool(true)},
+		{only: OnlyServers, wantCalled: true, wantNoClients: ptr.Bool(true)},
+	}
+
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+	module, err := compile.Compile("internal/tests/thrift/only-types.thrift")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.only, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			outputDir, err := ioutil.TempDir("", "thriftrw-only-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			var got *api.GenerateServiceRequest
+			sgen := handletest.NewMockServiceGenerator(mockCtrl)
+			if tt.wantCalled {
+				sgen.EXPECT().Generate(gomock.Any()).DoAndReturn(
+					func(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+						got = req
+						return &api.GenerateServiceResponse{}, nil
+					})
+			}
+
+			err = Generate(module, &Options{
+				OutputDir:  outputDir,
+				ThriftRoot: thriftRoot,
+				NoRecurse:  true,
+				Plugin:     CodeGenerator{ServiceGenerator: sgen},
+				Only:       tt.only,
+			})
+			require.NoError(t, err)
+			if !tt.wantCalled {
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantNoClients, got.NoClients)
+			assert.Equal(t, tt.wantNoServers, got.NoServers)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// PackageMapping overrides the directory, package name, and file name of
+// the code generated for Thrift files, which are otherwise derived from
+// their paths relative to ThriftRoot.
+type PackageMapping struct {
+	// Namespace matches Thrift files with a "namespace go" directive with
+	// this value.
+	Namespace string
+
+	// ThriftPath matches the Thrift file at this path relative to
+	// ThriftRoot or, if it names a directory, all Thrift files under it.
+	//
+	// Exactly one of Namespace and ThriftPath must be set.
+	ThriftPath string
+
+	// Dir is the directory of the generated package relative to
+	// OutputDir, which is also its import path relative to PackagePrefix.
+	// For directories matched by ThriftPath, Thrift files are placed at
+	// their relative paths inside Dir.
+	//
+	// This defaults to the namespace with "." replaced by "/" for
+	// Namespace, and is required for ThriftPath.
+	Dir string
+
+	// Package is the name of the generated package. This defaults to the
+	// last element of its directory with hyphens replaced by underscores.
+	Package string
+
+	// File is the name of the generated file. This defaults to the last
+	// element of the package's directory with a ".go" extension, and
+	// takes precedence over Options.OutputFile.
+	File string
+}
+
+func (pm *PackageMapping) String() string {
+	if pm.Namespace != "" {
+		return fmt.Sprintf("namespace %q", pm.Namespace)
+	}
+	return fmt.Sprintf("Thrift path %q", pm.ThriftPath)
+}
+
+func (pm *PackageMapping) validate() error {
+	if (pm.Namespace == "") == (pm.ThriftPath == "") {
+		return fmt.Errorf("package mapping must specify exactly one of a namespace and a Thrift path")
+	}
+	if pm.ThriftPath != "" {
+		if pm.Dir == "" {
+			return fmt.Errorf("package mapping for %v must specify a directory", pm)
+		}
+		if (pm.Package != "" || pm.File != "") && filepath.Ext(pm.ThriftPath) != ".thrift" {
+			return fmt.Errorf("package mapping for %v cannot specify a package or file name: "+
+				"it matches a directory of Thrift files", pm)
+		}
+	}
+	if dir := pm.dir(); filepath.IsAbs(dir) || dir == "." || dir == ".." || strings.HasPrefix(dir, "../") {
+		return fmt.Errorf("package mapping for %v has invalid directory %q: "+
+			"it must be inside the output directory", pm, pm.Dir)
+	}
+	if pm.Package != "" && !token.IsIdentifier(pm.Package) {
+		return fmt.Errorf("package mapping for %v has invalid package name %q", pm, pm.Package)
+	}
+	if pm.File != "" && (filepath.Ext(pm.File) != ".go" || filepath.Base(pm.File) != pm.File) {
+		return fmt.Errorf("package mapping for %v has invalid file name %q: "+
+			"it must be a {FILENAME}.go name", pm, pm.File)
+	}
+	return nil
+}
+
+// dir returns the directory of the package for this mapping.
+func (pm *PackageMapping) dir() string {
+	if pm.Dir == "" {
+		return strings.Replace(pm.Namespace, ".", "/", -1)
+	}
+	return filepath.ToSlash(filepath.Clean(pm.Dir))
+}
+
+// packageLayout is where and how the code for a Thrift file is generated.
+type packageLayout struct {
+	// Directory relative to the output directory.
+	Dir string
+
+	// Name of the package, and of the generated file. File is empty if the
+	// default applies.
+	Package string
+	File    string
+}
+
+// resolvePackageLayouts determines the layout of the packages generated for
+// the given module and the modules it includes, keyed by Thrift path.
+// Thrift files matched by a mapping for their path take precedence over
+// those for their namespace, which take precedence over mappings for the
+// directories they are in. Of the latter, the one for the innermost
+// directory applies.
+func resolvePackageLayouts(m *compile.Module, thriftRoot string, mappings []PackageMapping) (map[string]packageLayout, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]struct{})
+	for i := range mappings {
+		pm := &mappings[i]
+		if err := pm.validate(); err != nil {
+			return nil, err
+		}
+		key := pm.String()
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%v is mapped more than once", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	layouts := make(map[string]packageLayout)
+	owners := make(map[string]string) // package directory -> Thrift path
+	err := m.Walk(func(m *compile.Module) error {
+		if _, ok := layouts[m.ThriftPath]; ok {
+			return nil
+		}
+
+		rel, err := filepath.Rel(thriftRoot, m.ThriftPath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		layout := matchPackageMapping(rel, m.Namespaces["go"], mappings)
+		if layout.Package == "" {
+			layout.Package = normalizePackageName(layout.Dir)
+		}
+
+		if other, ok := owners[layout.Dir]; ok {
+			return fmt.Errorf("Thrift files %q and %q cannot both be generated into %q",
+				other, m.ThriftPath, layout.Dir)
+		}
+		owners[layout.Dir] = m.ThriftPath
+		layouts[m.ThriftPath] = layout
+		return nil
+	})
+	return layouts, err
+}
+
+// matchPackageMapping returns the layout of the package for the Thrift file
+// at the given path relative to ThriftRoot, with the given Go namespace.
+func matchPackageMapping(rel, namespace string, mappings []PackageMapping) packageLayout {
+	var byNamespace, byDir *PackageMapping
+	var byDirPath string
+	for i := range mappings {
+		pm := &mappings[i]
+		thriftPath := filepath.ToSlash(filepath.Clean(pm.ThriftPath))
+		switch {
+		case pm.ThriftPath != "" && thriftPath == rel:
+			return packageLayout{Dir: pm.dir(), Package: pm.Package, File: pm.File}
+		case pm.Namespace != "" && pm.Namespace == namespace:
+			byNamespace = pm
+		case pm.ThriftPath != "" && strings.HasPrefix(rel, thriftPath+"/"):
+			if byDir == nil || len(thriftPath) > len(byDirPath) {
+				byDir, byDirPath = pm, thriftPath
+			}
+		}
+	}
+
+	noExt := strings.TrimSuffix(rel, ".thrift")
+	switch {
+	case byNamespace != nil:
+		return packageLayout{Dir: byNamespace.dir(), Package: byNamespace.Package, File: byNamespace.File}
+	case byDir != nil:
+		return packageLayout{Dir: path.Join(byDir.dir(), strings.TrimPrefix(noExt, byDirPath+"/"))}
+	default:
+		return packageLayout{Dir: noExt}
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestMatchPackageMapping(t *testing.T) {
+	mappings := []PackageMapping{
+		{Namespace: "payments.ledger"},
+		{Namespace: "payments.audit", Dir: "audit/v1", Package: "auditv1", File: "audit.gen.go"},
+		{ThriftPath: "idl", Dir: "gen"},
+		{ThriftPath: "idl/common", Dir: "shared"},
+		{ThriftPath: "idl/common/money.thrift", Dir: "money", Package: "cash"},
+	}
+
+	tests := []struct {
+		rel       string
+		namespace string
+		want      packageLayout
+	}{
+		{rel: "other/foo.thrift", want: packageLayout{Dir: "other/foo"}},
+		{rel: "other/foo.thrift", namespace: "payments.ledger", want: packageLayout{Dir: "payments/ledger"}},
+		{
+			rel:       "idl/audit.thrift",
+			namespace: "payments.audit",
+			want:      packageLayout{Dir: "audit/v1", Package: "auditv1", File: "audit.gen.go"},
+		},
+		{rel: "idl/users/users.thrift", want: packageLayout{Dir: "gen/users/users"}},
+		{rel: "idl/common/time.thrift", want: packageLayout{Dir: "shared/time"}},
+		{
+			rel:       "idl/common/money.thrift",
+			namespace: "payments.ledger",
+			want:      packageLayout{Dir: "money", Package: "cash"},
+		},
+		{rel: "idlx/foo.thrift", want: packageLayout{Dir: "idlx/foo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rel+" "+tt.namespace, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchPackageMapping(tt.rel, tt.namespace, mappings))
+		})
+	}
+}
+
+func TestPackageMappingValidate(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    PackageMapping
+		wantErr string
+	}{
+		{desc: "namespace", give: PackageMapping{Namespace: "foo.bar"}},
+		{desc: "file", give: PackageMapping{ThriftPath: "foo.thrift", Dir: "foo", Package: "foo", File: "foo.go"}},
+		{
+			desc:    "neither",
+			give:    PackageMapping{Dir: "foo"},
+			wantErr: "package mapping must specify exactly one of a namespace and a Thrift path",
+		},
+		{
+			desc:    "both",
+			give:    PackageMapping{Namespace: "foo", ThriftPath: "foo.thrift", Dir: "foo"},
+			wantErr: "package mapping must specify exactly one of a namespace and a Thrift path",
+		},
+		{
+			desc:    "no dir",
+			give:    PackageMapping{ThriftPath: "foo.thrift"},
+			wantErr: `package mapping for Thrift path "foo.thrift" must specify a directory`,
+		},
+		{
+			desc: "package for directory",
+			give: PackageMapping{ThriftPath: "idl", Dir: "gen", Package: "gen"},
+			wantErr: `package mapping for Thrift path "idl" cannot specify a package or file name: ` +
+				"it matches a directory of Thrift files",
+		},
+		{
+			desc: "outside",
+			give: PackageMapping{Namespace: "foo", Dir: "../foo"},
+			wantErr: `package mapping for namespace "foo" has invalid directory "../foo": ` +
+				"it must be inside the output directory",
+		},
+		{
+			desc: "output directory",
+			give: PackageMapping{Namespace: "foo", Dir: "."},
+			wantErr: `package mapping for namespace "foo" has invalid directory ".": ` +
+				"it must be inside the output directory",
+		},
+		{
+			desc:    "invalid package",
+			give:    PackageMapping{Namespace: "foo", Package: "foo-bar"},
+			wantErr: `package mapping for namespace "foo" has invalid package name "foo-bar"`,
+		},
+		{
+			desc:    "invalid file",
+			give:    PackageMapping{Namespace: "foo", File: "foo/bar.go"},
+			wantErr: `package mapping for namespace "foo" has invalid file name "foo/bar.go": it must be a {FILENAME}.go name`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.give.validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeneratePackageMappings(t *testing.T) {
+	thriftRoot := t.TempDir()
+	writeThrift := func(rel, contents string) {
+		path := filepath.Join(thriftRoot, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+	writeThrift("idl/common/money.thrift", `
+		struct Money {
+			1: required i64 cents
+		}
+	`)
+	writeThrift("idl/payments/ledger.thrift", `
+		namespace go payments.ledger
+		include "../common/money.thrift"
+
+		struct Entry {
+			1: required money.Money amount
+		}
+	`)
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "idl/payments/ledger.thrift"))
+	require.NoError(t, err)
+
+	t.Run("mapped", func(t *testing.T) {
+		outputDir := t.TempDir()
+		err := Generate(module, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "example.com/gen",
+			ThriftRoot:    thriftRoot,
+			PackageMappings: []PackageMapping{
+				{Namespace: "payments.ledger", Dir: "ledger/v1", Package: "ledgerv1", File: "ledger.gen.go"},
+				{ThriftPath: "idl/common", Dir: "shared"},
+			},
+		})
+		require.NoError(t, err)
+
+		bs, err := ioutil.ReadFile(filepath.Join(outputDir, "ledger", "v1", "ledger.gen.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(bs), "package ledgerv1")
+		assert.Contains(t, string(bs), `"example.com/gen/shared/money"`)
+
+		bs, err = ioutil.ReadFile(filepath.Join(outputDir, "shared", "money", "money.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(bs), "package money")
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		err := Generate(module, &Options{
+			OutputDir:     t.TempDir(),
+			PackagePrefix: "example.com/gen",
+			ThriftRoot:    thriftRoot,
+			PackageMappings: []PackageMapping{
+				{Namespace: "payments.ledger", Dir: "shared"},
+				{ThriftPath: "idl/common/money.thrift", Dir: "shared"},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot both be generated into "shared"`)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		err := Generate(module, &Options{
+			OutputDir:     t.TempDir(),
+			PackagePrefix: "example.com/gen",
+			ThriftRoot:    thriftRoot,
+			PackageMappings: []PackageMapping{
+				{Namespace: "payments.ledger", Dir: "a"},
+				{Namespace: "payments.ledger", Dir: "b"},
+			},
+		})
+		assert.EqualError(t, err, `namespace "payments.ledger" is mapped more than once`)
+	})
+}
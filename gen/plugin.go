@@ -188,7 +188,7 @@ func (g *generateServiceBuilder) buildFunction(spec *compile.FunctionSpec) (*api
 	}
 
 	function := &api.Function{
-		Name:        goCase(spec.Name),
+		Name:        functionName(spec),
 		ThriftName:  spec.Name,
 		Arguments:   args,
 		Annotations: spec.Annotations,
@@ -249,16 +249,49 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeBool)}
 	case *compile.I8Spec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeInt8)}
+		if isUnsigned(s) {
+			t.SimpleType = simpleType(api.SimpleTypeUint8)
+		}
 	case *compile.I16Spec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeInt16)}
+		if isUnsigned(s) {
+			t.SimpleType = simpleType(api.SimpleTypeUint16)
+		}
 	case *compile.I32Spec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeInt32)}
+		if isUnsigned(s) {
+			t.SimpleType = simpleType(api.SimpleTypeUint32)
+		}
 	case *compile.I64Spec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeInt64)}
+		if isUnsigned(s) {
+			t.SimpleType = simpleType(api.SimpleTypeUint64)
+		}
 	case *compile.DoubleSpec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeFloat64)}
 	case *compile.StringSpec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeString)}
+	case *compile.UUIDSpec:
+		importPath, name, err := uuidGoType(s)
+		if err != nil {
+			return nil, err
+		}
+		if importPath == "" {
+			importPath, name = thriftuuidPackage, "UUID"
+		}
+		t = &api.Type{
+			ReferenceType: &api.TypeReference{Name: name, ImportPath: importPath},
+		}
+	case *compile.TypedefSpec:
+		b, err := typedefBinding(s)
+		if err != nil {
+			return nil, err
+		}
+		if b != nil {
+			t = &api.Type{
+				ReferenceType: &api.TypeReference{Name: b.Name, ImportPath: b.ImportPath},
+			}
+		}
 	case *compile.EnumSpec:
 		importPath, err := g.importer.Package(s.ThriftFile())
 		if err != nil {
@@ -289,6 +322,14 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		return &api.Type{SliceType: &api.Type{SimpleType: simpleType(api.SimpleTypeByte)}}, nil
 
 	case *compile.MapSpec:
+		if c, err := mapContainerType(s); err != nil {
+			return nil, err
+		} else if c != nil {
+			return &api.Type{PointerType: &api.Type{
+				ReferenceType: &api.TypeReference{Name: c.Name, ImportPath: c.ImportPath},
+			}}, nil
+		}
+
 		k, err := g.buildType(s.KeySpec, true)
 		if err != nil {
 			return nil, err
@@ -299,7 +340,7 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 			return nil, err
 		}
 
-		if !isHashable(s.KeySpec) {
+		if !mapUsesMap(s) {
 			return &api.Type{KeyValueSliceType: &api.TypePair{Left: k, Right: v}}, nil
 		}
 
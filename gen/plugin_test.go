@@ -430,6 +430,12 @@ func TestBuildType(t *testing.T) {
 			required: true,
 			want:     &api.Type{SimpleType: simpleType(api.SimpleTypeInt64)},
 		},
+		{
+			desc:     "uint32",
+			spec:     &compile.I32Spec{Annotations: compile.Annotations{"go.unsigned": ""}},
+			required: true,
+			want:     &api.Type{SimpleType: simpleType(api.SimpleTypeUint32)},
+		},
 		{
 			desc:     "float64",
 			spec:     &compile.DoubleSpec{},
@@ -475,6 +481,11 @@ func TestBuildType(t *testing.T) {
 			spec: &compile.I64Spec{},
 			want: &api.Type{PointerType: &api.Type{SimpleType: simpleType(api.SimpleTypeInt64)}},
 		},
+		{
+			desc: "*uint64",
+			spec: &compile.I64Spec{Annotations: compile.Annotations{"go.unsigned": "true"}},
+			want: &api.Type{PointerType: &api.Type{SimpleType: simpleType(api.SimpleTypeUint64)}},
+		},
 		{
 			desc: "*float64",
 			spec: &compile.DoubleSpec{},
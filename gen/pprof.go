@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// pprofLabelsKey is a Thrift annotation on structs which overrides whether
+// their FromWire and Decode methods run under pprof labels.
+const pprofLabelsKey = "go.pprof_labels"
+
+// pprofLabels reports whether the FromWire and Decode methods of the given
+// struct should run under pprof labels.
+func pprofLabels(g Generator, spec *compile.StructSpec) (bool, error) {
+	enabled := checkPprofLabels(g)
+	if v, ok := spec.Annotations[pprofLabelsKey]; ok {
+		var err error
+		enabled, err = strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf(
+				"invalid %v annotation: %q is not a boolean", pprofLabelsKey, v)
+		}
+		if enabled && checkTinyGo(g) {
+			return false, fmt.Errorf(
+				"%v annotation is not supported with TinyGo: runtime/pprof is unavailable", pprofLabelsKey)
+		}
+	}
+	return enabled, nil
+}
+
+// pprofFromWire generates a FromWire method which calls fromWire under
+// pprof labels identifying the type.
+func (f fieldGroupGenerator) pprofFromWire(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$context := import "context">
+		<$pprof := import "runtime/pprof">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$labels := printf "_%v_FromWire_pprofLabels" .Name>
+		var <$labels> = <$pprof>.Labels("thrift.type", "<pprofTypeName .>", "thrift.method", "FromWire")
+
+		<$v := newVar "v">
+		<$w := newVar "w">
+		// FromWire deserializes a <.Name> struct from its Thrift-level
+		// representation like fromWire, running under the pprof labels
+		// thrift.type=<pprofTypeName .> and thrift.method=FromWire.
+		func (<$v> *<.Name>) FromWire(<$w> <$wire>.Value) (err error) {
+			<$pprof>.Do(<$context>.Background(), <$labels>, func(<$context>.Context) {
+				err = <$v>.fromWire(<$w>)
+			})
+			return err
+		}
+		`, f,
+		TemplateFunc("pprofTypeName", pprofTypeName),
+	)
+}
+
+// pprofDecode generates a Decode method which calls decode under pprof
+// labels identifying the type.
+func (f fieldGroupGenerator) pprofDecode(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$context := import "context">
+		<$pprof := import "runtime/pprof">
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$labels := printf "_%v_Decode_pprofLabels" .Name>
+		var <$labels> = <$pprof>.Labels("thrift.type", "<pprofTypeName .>", "thrift.method", "Decode")
+
+		<$v := newVar "v">
+		<$sr := newVar "sr">
+		// Decode deserializes a <.Name> struct directly from its Thrift-level
+		// representation like decode, running under the pprof labels
+		// thrift.type=<pprofTypeName .> and thrift.method=Decode.
+		func (<$v> *<.Name>) Decode(<$sr> <$stream>.Reader) (err error) {
+			<$pprof>.Do(<$context>.Background(), <$labels>, func(<$context>.Context) {
+				err = <$v>.decode(<$sr>)
+			})
+			return err
+		}
+		`, f,
+		TemplateFunc("pprofTypeName", pprofTypeName),
+	)
+}
+
+// pprofTypeName returns the value of the thrift.type label for the given
+// field group: its Thrift name, or its Go name for the arguments and
+// results of service functions, which have none.
+func pprofTypeName(f fieldGroupGenerator) string {
+	if f.ThriftName != "" {
+		return f.ThriftName
+	}
+	return f.Name
+}
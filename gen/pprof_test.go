@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"runtime/pprof"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tp "go.uber.org/thriftrw/gen/internal/tests/pprof-labels"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestPprofLabelsRoundTrip(t *testing.T) {
+	give := &tp.Geometry{Shape: &tp.Shape{
+		Name:   "line",
+		Points: []*tp.Point{{X: 1, Y: 2}, {X: 3, Y: 4}},
+	}}
+
+	v, err := give.ToWire()
+	require.NoError(t, err)
+
+	var got tp.Geometry
+	require.NoError(t, got.FromWire(v))
+	assert.Equal(t, give, &got)
+
+	var buff bytes.Buffer
+	require.NoError(t, give.Encode(binary.Default.Writer(&buff)))
+
+	var decoded tp.Geometry
+	require.NoError(t, decoded.Decode(binary.Default.Reader(bytes.NewReader(buff.Bytes()))))
+	assert.Equal(t, give, &decoded)
+
+	var shape tp.Shape
+	assert.EqualError(t, shape.FromWire(wire.NewValueStruct(wire.Struct{})),
+		"field Name of Shape is required")
+}
+
+func TestPprofLabelsApplied(t *testing.T) {
+	v, err := (&tp.Point{X: 1, Y: 2}).ToWire()
+	require.NoError(t, err)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer func() {
+		close(stop)
+		<-done
+	}()
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				var p tp.Point
+				_ = p.FromWire(v)
+			}
+		}
+	}()
+
+	// The labels of a goroutine show up in the goroutine profile while it
+	// is inside FromWire, so look for them until the goroutine is caught
+	// there.
+	want := `"thrift.method":"FromWire", "thrift.type":"Point"`
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		var buff bytes.Buffer
+		require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buff, 1))
+		if strings.Contains(buff.String(), want) {
+			return
+		}
+	}
+	t.Errorf("did not find labels %v in the goroutine profile", want)
+}
+
+func TestPprofLabelsAnnotation(t *testing.T) {
+	tests := []struct {
+		desc    string
+		flag    bool
+		tinyGo  bool
+		value   string
+		want    bool
+		wantErr string
+	}{
+		{desc: "flag", flag: true, want: true},
+		{desc: "no flag"},
+		{desc: "enable", value: "true", want: true},
+		{desc: "disable", flag: true, value: "false"},
+		{
+			desc:    "invalid",
+			value:   "yes",
+			wantErr: `invalid go.pprof_labels annotation: "yes" is not a boolean`,
+		},
+		{
+			desc:    "tinygo",
+			tinyGo:  true,
+			value:   "true",
+			wantErr: "go.pprof_labels annotation is not supported with TinyGo: runtime/pprof is unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			spec := &compile.StructSpec{Name: "Foo"}
+			if tt.value != "" {
+				spec.Annotations = compile.Annotations{pprofLabelsKey: tt.value}
+			}
+
+			g := NewGenerator(&GeneratorOptions{PprofLabels: tt.flag, TinyGo: tt.tinyGo})
+			got, err := pprofLabels(g, spec)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
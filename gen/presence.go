@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// value of this annotation on a struct overrides whether its optional
+	// primitive fields are stored by value with a presence bitset rather
+	// than as pointers
+	presenceBitsKey = "go.presence_bits"
+
+	// name of the hidden member of generated structs which records which
+	// optional primitive fields are set; this must match presenceLayout
+	presenceName = "presence"
+)
+
+// presenceLayout assigns a bit of the hidden presence member to each
+// optional primitive field of a struct whose fields are stored by value.
+//
+// A nil presenceLayout stores all optional fields as pointers.
+type presenceLayout map[*compile.FieldSpec]presenceField
+
+type presenceField struct {
+	Bit    int
+	GoName string
+}
+
+// newPresenceLayout returns the presence layout of the given struct, or nil
+// if its optional fields are stored as pointers.
+func newPresenceLayout(g Generator, spec *compile.StructSpec) (presenceLayout, error) {
+	enabled := checkPresenceBits(g)
+	if v, ok := spec.Annotations[presenceBitsKey]; ok {
+		var err error
+		enabled, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v annotation: %q is not a boolean", presenceBitsKey, v)
+		}
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	layout := make(presenceLayout)
+	for _, f := range spec.Fields {
+		if f.Required || !isPrimitiveType(f.Type) {
+			continue
+		}
+		name, err := goName(f)
+		if err != nil {
+			return nil, err
+		}
+		layout[f] = presenceField{Bit: len(layout), GoName: name}
+	}
+	if len(layout) == 0 {
+		return nil, nil
+	}
+	return layout, nil
+}
+
+// Has returns true if the given field is stored by value and tracked by
+// the presence bitset.
+func (p presenceLayout) Has(f *compile.FieldSpec) bool {
+	_, ok := p[f]
+	return ok
+}
+
+// Words returns the number of uint64s in the presence bitset.
+func (p presenceLayout) Words() int {
+	return (len(p) + 63) / 64
+}
+
+// Ref returns an expression which evaluates to a pointer to the value of
+// the given field of v, or nil if it is unset, as if the field were stored
+// as a pointer. The expression may not be assigned to.
+func (p presenceLayout) Ref(v string, f *compile.FieldSpec) (string, error) {
+	if pf, ok := p[f]; ok {
+		return fmt.Sprintf("%s.ref%s()", v, pf.GoName), nil
+	}
+	name, err := goName(f)
+	return v + "." + name, err
+}
+
+// IsSet returns an expression which reports whether the given field of v is
+// set.
+func (p presenceLayout) IsSet(v string, f *compile.FieldSpec) string {
+	word, mask := p.bit(f)
+	return fmt.Sprintf("%s.%s[%d]&%s != 0", v, presenceName, word, mask)
+}
+
+// IsUnset returns an expression which reports whether the given field of v
+// is unset.
+func (p presenceLayout) IsUnset(v string, f *compile.FieldSpec) string {
+	word, mask := p.bit(f)
+	return fmt.Sprintf("%s.%s[%d]&%s == 0", v, presenceName, word, mask)
+}
+
+// Set returns a statement which marks the given field of v as set.
+func (p presenceLayout) Set(v string, f *compile.FieldSpec) string {
+	word, mask := p.bit(f)
+	return fmt.Sprintf("%s.%s[%d] |= %s", v, presenceName, word, mask)
+}
+
+// Clear returns a statement which marks the given field of v as unset.
+func (p presenceLayout) Clear(v string, f *compile.FieldSpec) string {
+	word, mask := p.bit(f)
+	return fmt.Sprintf("%s.%s[%d] &^= %s", v, presenceName, word, mask)
+}
+
+func (p presenceLayout) bit(f *compile.FieldSpec) (word int, mask string) {
+	bit := p[f].Bit
+	return bit / 64, fmt.Sprintf("(1 << %d)", bit%64)
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tp "go.uber.org/thriftrw/gen/internal/tests/presence-bits"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/validate"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresenceBitsAccessors(t *testing.T) {
+	var s tp.Sample
+	assert.False(t, s.HasCount())
+	assert.Equal(t, int32(0), s.GetCount())
+
+	s.SetCount(0)
+	assert.True(t, s.HasCount(), "zero values must be recorded as set")
+	assert.True(t, s.IsSetCount())
+	assert.Equal(t, int32(0), s.GetCount())
+
+	s.SetCount(42)
+	assert.Equal(t, int32(42), s.GetCount())
+	assert.Equal(t, int32(42), s.Count, "fields must be stored by value")
+
+	s.ClearCount()
+	assert.False(t, s.HasCount())
+	assert.Equal(t, int32(0), s.Count)
+
+	// Fields which are not primitives are still pointers.
+	s.Point = &tp.Point{X: 1, Y: 2}
+	assert.True(t, s.IsSetPoint())
+
+	var p tp.Pointers
+	p.Name = new(string)
+	assert.True(t, p.IsSetName(), "go.presence_bits = false must keep pointers")
+}
+
+func TestPresenceBitsRoundTrip(t *testing.T) {
+	var s tp.Sample
+	s.ID = "a"
+	s.SetEnabled(false)
+	s.SetTiny(1)
+	s.SetSmall(2)
+	s.SetTotal(0)
+	s.SetRatio(0.5)
+	s.SetName("")
+	s.SetLevel(tp.LevelHigh)
+	s.SetLabel("label")
+	s.SetToken("hunter2")
+	s.Tags = []string{"x"}
+
+	w, err := s.ToWire()
+	require.NoError(t, err)
+
+	var fromWire tp.Sample
+	require.NoError(t, fromWire.FromWire(w))
+	assert.False(t, fromWire.HasCount(), "unset fields must stay unset")
+	assert.True(t, fromWire.HasTotal(), "set zero values must stay set")
+	assert.True(t, fromWire.HasRetries(), "fields with defaults must be set")
+	assert.Equal(t, int32(3), fromWire.GetRetries())
+
+	want := s
+	want.SetRetries(3)
+	assert.True(t, want.Equals(&fromWire))
+
+	var buf bytes.Buffer
+	sw := binary.Default.Writer(&buf)
+	require.NoError(t, s.Encode(sw))
+	require.NoError(t, sw.Close())
+
+	var decoded tp.Sample
+	sr := binary.Default.Reader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, decoded.Decode(sr))
+	require.NoError(t, sr.Close())
+	assert.True(t, fromWire.Equals(&decoded))
+
+	var lazy tp.Sample_Lazy
+	lazy.Reset(buf.Bytes())
+	total, err := lazy.IsSetTotal()
+	require.NoError(t, err)
+	assert.True(t, total)
+	count, err := lazy.IsSetCount()
+	require.NoError(t, err)
+	assert.False(t, count)
+	retries, err := lazy.GetRetries()
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), retries)
+}
+
+func TestPresenceBitsWireMatchesPointers(t *testing.T) {
+	var s tp.Sample
+	s.ID = "a"
+	s.SetCount(0)
+	w, err := s.ToWire()
+	require.NoError(t, err)
+
+	// Only set fields and those with defaults are encoded.
+	var ids []int16
+	for _, f := range w.GetStruct().Fields {
+		ids = append(ids, f.ID)
+	}
+	assert.Equal(t, []int16{1, 5, 11}, ids)
+	assert.Equal(t, wire.NewValueI32(0), w.GetStruct().Fields[1].Value)
+}
+
+func TestPresenceBitsDefaults(t *testing.T) {
+	s := tp.Default_Sample()
+	assert.True(t, s.HasRetries())
+	assert.Equal(t, int32(3), s.GetRetries())
+	assert.False(t, s.HasCount())
+
+	assert.True(t, tp.DefaultSample.HasCount())
+	assert.Equal(t, int32(1), tp.DefaultSample.GetCount())
+	assert.Equal(t, "sample", tp.DefaultSample.GetName())
+	assert.False(t, tp.DefaultSample.HasTotal())
+}
+
+func TestPresenceBitsTypedefConstant(t *testing.T) {
+	s := (*tp.Sample)(tp.DefaultAliasedSample)
+	assert.Equal(t, "aliased", s.GetID())
+	assert.True(t, s.HasCount())
+	assert.Equal(t, int32(2), s.GetCount())
+	assert.False(t, s.HasName())
+}
+
+func TestPresenceBitsWide(t *testing.T) {
+	var w tp.Wide
+	w.SetF1(1)
+	w.SetF64(64)
+	w.SetF65(65)
+	assert.True(t, w.HasF65())
+	assert.False(t, w.HasF66())
+
+	v, err := w.ToWire()
+	require.NoError(t, err)
+	var got tp.Wide
+	require.NoError(t, got.FromWire(v))
+	assert.True(t, w.Equals(&got))
+	assert.Equal(t, int32(65), got.GetF65())
+	assert.False(t, got.HasF2())
+
+	got.ClearF65()
+	assert.False(t, w.Equals(&got))
+}
+
+func TestPresenceBitsEqualsCopyHash(t *testing.T) {
+	var a, b tp.Sample
+	a.SetTotal(0)
+	assert.False(t, a.Equals(&b), "set zero values must differ from unset fields")
+	assert.NotEqual(t, a.Hash(), b.Hash())
+
+	b.SetTotal(0)
+	assert.True(t, a.Equals(&b))
+	assert.Equal(t, a.Hash(), b.Hash())
+
+	c := a.Copy()
+	assert.True(t, c.HasTotal())
+	c.ClearTotal()
+	assert.True(t, a.HasTotal(), "copies must not share presence bits")
+}
+
+func TestPresenceBitsString(t *testing.T) {
+	var s tp.Sample
+	s.ID = "a"
+	s.SetCount(0)
+	assert.Equal(t, "Sample{ID: a, Count: 0}", s.String())
+}
+
+func TestPresenceBitsJSON(t *testing.T) {
+	var s tp.Sample
+	s.ID = "a"
+	s.SetCount(0)
+	s.SetToken("hunter2")
+
+	b, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "a", "count": 0, "token": "[redacted]"}`, string(b))
+
+	var got tp.Sample
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "a", "total": 0, "level": "HIGH"}`), &got))
+	assert.True(t, got.HasTotal())
+	assert.True(t, got.HasLevel())
+	assert.Equal(t, tp.LevelHigh, got.GetLevel())
+	assert.False(t, got.HasCount())
+}
+
+func TestPresenceBitsUnion(t *testing.T) {
+	c, err := tp.NewChoiceBuilder().Number(0).Build()
+	require.NoError(t, err)
+	assert.True(t, c.HasNumber())
+
+	_, err = c.ToWire()
+	require.NoError(t, err)
+
+	var empty tp.Choice
+	_, err = empty.ToWire()
+	assert.Error(t, err, "unions must have exactly one field set")
+}
+
+func TestPresenceBitsGenerated(t *testing.T) {
+	defer validate.RegisterCELEvaluator(nil)
+
+	var gotThis map[string]interface{}
+	validate.RegisterCELEvaluator(celEvaluatorFunc(
+		func(_ string, this map[string]interface{}) (bool, error) {
+			gotThis = this
+			return true, nil
+		}))
+
+	s := tp.Sample{ID: "a"}
+	s.SetSecret("")
+	assert.False(t, s.StripInternal().HasSecret())
+	assert.True(t, s.HasSecret())
+
+	s.SetCount(101)
+	var ferr *validate.FieldError
+	require.ErrorAs(t, s.Validate(), &ferr)
+	assert.Equal(t, "count", ferr.Field)
+
+	s.SetCount(0)
+	require.NoError(t, s.Validate())
+	assert.Equal(t, map[string]interface{}{"id": "a", "count": int32(0), "secret": ""}, gotThis)
+}
+
+func TestPresenceBitsInvalidAnnotation(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Foo",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "bar", Type: &compile.StringSpec{}},
+		},
+		Annotations: map[string]string{presenceBitsKey: "maybe"},
+	}
+	err := structure(NewGenerator(&GeneratorOptions{}), spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid go.presence_bits annotation: "maybe" is not a boolean`)
+}
+
+func TestPresenceBitsUnionAccessors(t *testing.T) {
+	var c tp.Choice
+	c.SetText("foo")
+	c.SetNumber(0)
+	assert.Equal(t, int16(2), c.Which())
+	assert.False(t, c.HasText(), "setting a field must unset the others")
+	assert.Empty(t, c.Text)
+
+	n, ok := c.LookupNumber()
+	assert.True(t, ok, "zero values must be recorded as set")
+	assert.Equal(t, int64(0), n)
+
+	_, ok = c.LookupText()
+	assert.False(t, ok)
+}
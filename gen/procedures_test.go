@@ -0,0 +1,323 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tp "go.uber.org/thriftrw/gen/internal/tests/procedures"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/thriftrpc"
+	"go.uber.org/thriftrw/wire"
+)
+
+type procKeyValue struct {
+	items  map[string][]byte
+	forgot chan string
+}
+
+var _ tp.KeyValue_Interface = (*procKeyValue)(nil)
+
+func (kv *procKeyValue) Healthy(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (kv *procKeyValue) GetValue(ctx context.Context, key *string) ([]byte, error) {
+	v, ok := kv.items[*key]
+	if !ok {
+		return nil, &tp.KeyDoesNotExist{Key: key}
+	}
+	return v, nil
+}
+
+func (kv *procKeyValue) SetValue(ctx context.Context, key string, value []byte) error {
+	if key == "" {
+		return errors.New("empty key")
+	}
+	kv.items[key] = value
+	return nil
+}
+
+func (kv *procKeyValue) Size(ctx context.Context, _ *string) (int64, error) {
+	return int64(len(kv.items)), nil
+}
+
+func (kv *procKeyValue) Forget(ctx context.Context, key *string) error {
+	kv.forgot <- *key
+	return nil
+}
+
+func (kv *procKeyValue) CountPrefix(ctx context.Context, args *thriftrpc.ArgsReader) (int64, error) {
+	var prefix string
+	for {
+		fh, ok, err := args.Next()
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+		// The attachment is skipped by Next.
+		if fh.ID == 1 {
+			if prefix, err = args.Reader().ReadString(); err != nil {
+				return 0, &thriftrpc.ArgumentsError{Err: err}
+			}
+		}
+	}
+
+	if prefix == "" {
+		return 0, &tp.InternalError{Message: ptr.String("empty prefix")}
+	}
+
+	var n int64
+	for k := range kv.items {
+		if strings.HasPrefix(k, prefix) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (kv *procKeyValue) ForgetPrefix(ctx context.Context, args *thriftrpc.ArgsReader) error {
+	// Only the first field is read. The rest of the request is skipped.
+	if _, ok, err := args.Next(); err != nil || !ok {
+		return err
+	}
+	prefix, err := args.Reader().ReadString()
+	if err != nil {
+		return err
+	}
+	kv.forgot <- prefix + "*"
+	return nil
+}
+
+func TestProcedures(t *testing.T) {
+	kv := &procKeyValue{items: map[string][]byte{"foo": []byte("bar")}, forgot: make(chan string, 1)}
+	ctx := context.Background()
+
+	procs := make(map[string]thriftrpc.Procedure)
+	var names []string
+	for _, p := range tp.KeyValue_Procedures(kv) {
+		procs[p.Name] = p
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{
+		"KeyValue::countPrefix",
+		"KeyValue::forget",
+		"KeyValue::forgetPrefix",
+		"KeyValue::getValue",
+		"KeyValue::setValue",
+		"KeyValue::size",
+		"Health::healthy",
+	}, names)
+
+	call := func(t *testing.T, name string, args wire.Value) (thriftrpc.Response, error) {
+		return procs[name].Handler(ctx, args)
+	}
+	toWire := func(t *testing.T, v interface{ ToWire() (wire.Value, error) }) wire.Value {
+		w, err := v.ToWire()
+		require.NoError(t, err)
+		return w
+	}
+
+	t.Run("success", func(t *testing.T) {
+		res, err := call(t, "KeyValue::getValue", toWire(t, tp.KeyValue_GetValue_Helper.Args(ptr.String("foo"))))
+		require.NoError(t, err)
+		assert.False(t, res.IsApplicationError)
+		assert.Equal(t, &tp.KeyValue_GetValue_Result{Success: []byte("bar")}, res.Body)
+	})
+
+	t.Run("inherited", func(t *testing.T) {
+		res, err := call(t, "Health::healthy", toWire(t, tp.Health_Healthy_Helper.Args()))
+		require.NoError(t, err)
+		assert.Equal(t, &tp.Health_Healthy_Result{Success: ptr.Bool(true)}, res.Body)
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		res, err := call(t, "KeyValue::getValue", toWire(t, tp.KeyValue_GetValue_Helper.Args(ptr.String("baz"))))
+		require.NoError(t, err)
+		assert.True(t, res.IsApplicationError)
+		assert.Equal(t, &tp.KeyValue_GetValue_Result{
+			DoesNotExist: &tp.KeyDoesNotExist{Key: ptr.String("baz")},
+		}, res.Body)
+	})
+
+	t.Run("undeclared error", func(t *testing.T) {
+		_, err := call(t, "KeyValue::setValue", toWire(t, tp.KeyValue_SetValue_Helper.Args("", nil)))
+		assert.EqualError(t, err, "empty key")
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := call(t, "KeyValue::setValue", wire.NewValueStruct(wire.Struct{}))
+		var argsErr *thriftrpc.ArgumentsError
+		assert.True(t, errors.As(err, &argsErr), "expected ArgumentsError, got %v", err)
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		p := procs["KeyValue::forget"]
+		assert.True(t, p.OneWay)
+
+		res, err := p.Handler(ctx, toWire(t, tp.KeyValue_Forget_Helper.Args(ptr.String("foo"))))
+		require.NoError(t, err)
+		assert.Nil(t, res.Body)
+		assert.Equal(t, "foo", <-kv.forgot)
+	})
+
+	t.Run("lazy arguments", func(t *testing.T) {
+		p := procs["KeyValue::countPrefix"]
+		assert.Nil(t, p.Handler)
+
+		args := tp.KeyValue_CountPrefix_Helper.Args(ptr.String("fo"), []byte("large attachment"))
+		res, err := p.Handle(ctx, toWire(t, args))
+		require.NoError(t, err)
+		assert.Equal(t, &tp.KeyValue_CountPrefix_Result{Success: ptr.Int64(1)}, res.Body)
+
+		for _, enveloped := range []bool{true, false} {
+			req, err := thriftrpc.EncodeRequest(args, enveloped)
+			require.NoError(t, err)
+
+			res, isAppErr, err := p.Serve(ctx, req)
+			require.NoError(t, err)
+			assert.False(t, isAppErr)
+
+			var result tp.KeyValue_CountPrefix_Result
+			require.NoError(t, thriftrpc.DecodeResponse(res, enveloped, &result))
+			assert.Equal(t, ptr.Int64(1), result.Success)
+		}
+	})
+
+	t.Run("lazy arguments exception", func(t *testing.T) {
+		req, err := thriftrpc.EncodeRequest(tp.KeyValue_CountPrefix_Helper.Args(nil, nil), true)
+		require.NoError(t, err)
+
+		res, isAppErr, err := procs["KeyValue::countPrefix"].Serve(ctx, req)
+		require.NoError(t, err)
+		assert.True(t, isAppErr)
+
+		var result tp.KeyValue_CountPrefix_Result
+		require.NoError(t, thriftrpc.DecodeResponse(res, true, &result))
+		assert.Equal(t, ptr.String("empty prefix"), result.InternalError.Message)
+	})
+
+	t.Run("lazy arguments invalid", func(t *testing.T) {
+		tests := []struct {
+			desc string
+			give []byte
+		}{
+			{
+				desc: "invalid value",
+				give: []byte{0x0b, 0x00, 0x01, 0xff},
+			},
+			{
+				desc: "invalid skipped value",
+				give: []byte{
+					0x0b, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 'x',
+					0x0b, 0x00, 0x03, 0xff,
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.desc, func(t *testing.T) {
+				_, _, err := procs["KeyValue::countPrefix"].Serve(ctx, tt.give)
+				var argsErr *thriftrpc.ArgumentsError
+				assert.True(t, errors.As(err, &argsErr), "expected ArgumentsError, got %v", err)
+			})
+		}
+	})
+
+	t.Run("lazy arguments oneway", func(t *testing.T) {
+		req, err := thriftrpc.EncodeRequest(tp.KeyValue_ForgetPrefix_Helper.Args(ptr.String("fo")), false)
+		require.NoError(t, err)
+
+		res, _, err := procs["KeyValue::forgetPrefix"].Serve(ctx, req)
+		require.NoError(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, "fo*", <-kv.forgot)
+	})
+
+	t.Run("serve", func(t *testing.T) {
+		for _, enveloped := range []bool{true, false} {
+			req, err := thriftrpc.EncodeRequest(tp.KeyValue_GetValue_Helper.Args(ptr.String("baz")), enveloped)
+			require.NoError(t, err)
+
+			res, isAppErr, err := procs["KeyValue::getValue"].Serve(ctx, req)
+			require.NoError(t, err)
+			assert.True(t, isAppErr)
+
+			var result tp.KeyValue_GetValue_Result
+			require.NoError(t, thriftrpc.DecodeResponse(res, enveloped, &result))
+			assert.Equal(t, ptr.String("baz"), result.DoesNotExist.Key)
+		}
+	})
+}
+
+func TestProceduresInheritFromIncludedService(t *testing.T) {
+	thriftRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(thriftRoot, "base.thrift"),
+		[]byte("service Base { bool healthy() }\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(thriftRoot, "kv.thrift"),
+		[]byte("include \"./base.thrift\"\nservice KeyValue extends base.Base { i64 size() }\n"), 0o644))
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "kv.thrift"))
+	require.NoError(t, err)
+
+	outputDir := t.TempDir()
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "example.com/gen",
+		ThriftRoot:    thriftRoot,
+		Procedures:    true,
+	}))
+
+	got, err := os.ReadFile(filepath.Join(outputDir, "kv/kv.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(got), "\tbase.Base_Interface\n")
+	assert.Contains(t, string(got), "procs = append(procs, base.Base_Procedures(impl)...)")
+	assert.Contains(t, string(got), `"example.com/gen/base"`)
+}
+
+func TestProceduresInvalidLazyArgs(t *testing.T) {
+	thriftRoot := t.TempDir()
+	path := filepath.Join(thriftRoot, "kv.thrift")
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`service KeyValue { i64 size() (go.lazy_args = "sometimes") }`), 0o644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     t.TempDir(),
+		PackagePrefix: "example.com/gen",
+		ThriftRoot:    thriftRoot,
+		Procedures:    true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid go.lazy_args annotation on size: "sometimes" is not a boolean`)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// EventType is the kind of progress Event reported during code generation.
+type EventType int
+
+const (
+	// ModuleStarted is reported before generating code for a Thrift file.
+	ModuleStarted EventType = iota + 1
+
+	// ModuleFinished is reported after code for a Thrift file has been
+	// generated, before any files are written.
+	ModuleFinished
+
+	// PluginStarted is reported before the plugin is asked to generate
+	// code.
+	PluginStarted
+
+	// PluginFinished is reported after the plugin has generated code.
+	PluginFinished
+
+	// FileWritten is reported after each file is written to OutputDir.
+	FileWritten
+
+	// Warning reports a condition which did not stop code generation but
+	// may be surprising to the user. This includes the warnings found while
+	// compiling each module that code is generated for.
+	Warning
+)
+
+// SkippedIncludes is the kind of warning reported when code is not generated
+// for included Thrift files because Options.OutputFile is set.
+const SkippedIncludes compile.WarningKind = "skipped_includes"
+
+func (t EventType) String() string {
+	switch t {
+	case ModuleStarted:
+		return "ModuleStarted"
+	case ModuleFinished:
+		return "ModuleFinished"
+	case PluginStarted:
+		return "PluginStarted"
+	case PluginFinished:
+		return "PluginFinished"
+	case FileWritten:
+		return "FileWritten"
+	case Warning:
+		return "Warning"
+	default:
+		return fmt.Sprintf("EventType(%d)", int(t))
+	}
+}
+
+// Event reports the progress of code generation.
+type Event struct {
+	Type EventType
+
+	// Module is the path to the Thrift file the event is about, if any.
+	Module string
+
+	// Path is the absolute path of the file written for FileWritten
+	// events.
+	Path string
+
+	// Message describes the problem for Warning events.
+	Message string
+
+	// Warning holds details about the problem for Warning events.
+	Warning *compile.Warning
+}
+
+func (e Event) String() string {
+	switch {
+	case e.Path != "":
+		return fmt.Sprintf("%v %v", e.Type, e.Path)
+	case e.Message != "" && e.Module != "":
+		return fmt.Sprintf("%v %v: %v", e.Type, e.Module, e.Message)
+	case e.Message != "":
+		return fmt.Sprintf("%v %v", e.Type, e.Message)
+	case e.Module != "":
+		return fmt.Sprintf("%v %v", e.Type, e.Module)
+	default:
+		return e.Type.String()
+	}
+}
+
+// GenerateAsync generates code in a new goroutine and reports its progress
+// on the returned channel of events. Events are also passed to
+// Options.Progress if set.
+//
+// The events channel is closed once code generation is over, after which
+// its outcome is sent on the error channel. Callers must drain the events
+// channel for code generation to proceed.
+//
+//	events, done := gen.GenerateAsync(module, &opts)
+//	for e := range events {
+//		log.Print(e)
+//	}
+//	if err := <-done; err != nil {
+//		return err
+//	}
+func GenerateAsync(m *compile.Module, o *Options) (<-chan Event, <-chan error) {
+	events := make(chan Event, 16)
+	done := make(chan error, 1)
+
+	opts := *o
+	opts.Progress = func(e Event) {
+		if o.Progress != nil {
+			o.Progress(e)
+		}
+		events <- e
+	}
+
+	go func() {
+		err := Generate(m, &opts)
+		close(events)
+		done <- err
+		close(done)
+	}()
+
+	return events, done
+}
+
+// progress reports events to the Progress callback of the given Options.
+type progress struct{ o *Options }
+
+func (p progress) warn(w *compile.Warning) {
+	p.report(Event{
+		Type:    Warning,
+		Module:  w.Path,
+		Message: w.Message,
+		Warning: w,
+	})
+}
+
+func (p progress) report(e Event) {
+	if p.o.Progress != nil {
+		p.o.Progress(e)
+	}
+}
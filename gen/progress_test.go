@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeServiceGenerator map[string][]byte
+
+func (g fakeServiceGenerator) Generate(*api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	return &api.GenerateServiceResponse{Files: g}, nil
+}
+
+func TestGenerateProgress(t *testing.T) {
+	module, err := compile.Compile(testdata(t, "thrift/typedefs.thrift"))
+	require.NoError(t, err)
+
+	t.Run("recurse", func(t *testing.T) {
+		outputDir := t.TempDir()
+
+		var events []Event
+		require.NoError(t, Generate(module, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:    testdata(t, "thrift"),
+			Plugin:        CodeGenerator{ServiceGenerator: fakeServiceGenerator{"plugin/foo.go": []byte("package plugin\n")}},
+			Progress:      func(e Event) { events = append(events, e) },
+		}))
+
+		var modules, written []string
+		for _, e := range events {
+			switch e.Type {
+			case ModuleStarted:
+				modules = append(modules, e.Module)
+			case FileWritten:
+				written = append(written, e.Path)
+			}
+		}
+
+		assert.Contains(t, modules, testdata(t, "thrift/typedefs.thrift"))
+		assert.Contains(t, modules, testdata(t, "thrift/structs.thrift"), "must report included modules")
+		assert.Equal(t, Event{Type: ModuleStarted, Module: modules[0]}, events[0])
+		assert.Equal(t, Event{Type: ModuleFinished, Module: modules[0]}, events[1])
+
+		assert.Len(t, written, len(modules)+1)
+		assert.IsIncreasing(t, written, "files must be written in order")
+		assert.Contains(t, written, filepath.Join(outputDir, "plugin/foo.go"))
+		assert.Contains(t, written, filepath.Join(outputDir, "typedefs/typedefs.go"))
+
+		pluginIdx := len(modules) * 2
+		assert.Equal(t, PluginStarted, events[pluginIdx].Type)
+		assert.Equal(t, PluginFinished, events[pluginIdx+1].Type)
+		assert.Equal(t, FileWritten, events[len(events)-1].Type)
+	})
+
+	t.Run("output file", func(t *testing.T) {
+		var events []Event
+		require.NoError(t, Generate(module, &Options{
+			OutputDir:     t.TempDir(),
+			PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:    testdata(t, "thrift"),
+			OutputFile:    "types.go",
+			Progress:      func(e Event) { events = append(events, e) },
+		}))
+
+		require.NotEmpty(t, events)
+		msg := "code is not generated for included Thrift files when an output file is specified"
+		assert.Equal(t, Event{
+			Type:    Warning,
+			Module:  testdata(t, "thrift/typedefs.thrift"),
+			Message: msg,
+			Warning: &compile.Warning{
+				Kind:     SkippedIncludes,
+				Severity: compile.SeverityWarning,
+				Path:     testdata(t, "thrift/typedefs.thrift"),
+				Message:  msg,
+			},
+		}, events[0])
+		assert.Equal(t, ModuleStarted, events[1].Type)
+	})
+}
+
+func TestGenerateProgressCompileWarnings(t *testing.T) {
+	thriftRoot := t.TempDir()
+	path := filepath.Join(thriftRoot, "main.thrift")
+	require.NoError(t, os.WriteFile(filepath.Join(thriftRoot, "shared.thrift"),
+		[]byte("typedef string UUID\n"), 0o644))
+	require.NoError(t, os.WriteFile(path,
+		[]byte("include \"./shared.thrift\"\nstruct S { 1: optional byte b = 300 }\n"), 0o644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	var warnings []*compile.Warning
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:     t.TempDir(),
+		PackagePrefix: "example.com/gen",
+		ThriftRoot:    thriftRoot,
+		Progress: func(e Event) {
+			if e.Type == Warning {
+				warnings = append(warnings, e.Warning)
+			}
+		},
+	}))
+
+	assert.Equal(t, module.Warnings, warnings)
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, compile.UnusedInclude, warnings[0].Kind)
+		assert.Equal(t, compile.LossyDefault, warnings[1].Kind)
+	}
+}
+
+func TestGenerateAsync(t *testing.T) {
+	module, err := compile.Compile(testdata(t, "thrift/structs.thrift"))
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		var fromCallback []Event
+		events, done := GenerateAsync(module, &Options{
+			OutputDir:     t.TempDir(),
+			PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:    testdata(t, "thrift"),
+			NoRecurse:     true,
+			Progress:      func(e Event) { fromCallback = append(fromCallback, e) },
+		})
+
+		var got []Event
+		for e := range events {
+			got = append(got, e)
+		}
+		require.NoError(t, <-done)
+
+		assert.Equal(t, fromCallback, got)
+		require.Len(t, got, 3)
+		assert.Equal(t, ModuleStarted, got[0].Type)
+		assert.Equal(t, ModuleFinished, got[1].Type)
+		assert.Equal(t, FileWritten, got[2].Type)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		events, done := GenerateAsync(module, &Options{
+			OutputDir:  "relative",
+			ThriftRoot: testdata(t, "thrift"),
+		})
+
+		for e := range events {
+			t.Errorf("unexpected event %v", e)
+		}
+		err := <-done
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an absolute path")
+	})
+}
+
+func TestEventString(t *testing.T) {
+	tests := []struct {
+		give Event
+		want string
+	}{
+		{Event{Type: ModuleStarted, Module: "foo.thrift"}, "ModuleStarted foo.thrift"},
+		{Event{Type: PluginFinished}, "PluginFinished"},
+		{Event{Type: FileWritten, Path: "/out/foo/foo.go"}, "FileWritten /out/foo/foo.go"},
+		{Event{Type: Warning, Module: "foo.thrift", Message: "careful"}, "Warning foo.thrift: careful"},
+		{Event{Type: Warning, Message: "careful"}, "Warning careful"},
+		{Event{Type: EventType(42)}, "EventType(42)"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.give.String())
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// quickStruct generates a Generate method for the given struct, union, or
+// exception which implements testing/quick.Generator for pointers to it.
+// Nothing is generated for types which have no finite values that can be
+// generated, such as structs whose required fields recurse forever.
+func quickStruct(g Generator, name string, spec *compile.StructSpec, presence presenceLayout) error {
+	if !quickGeneratable(spec) {
+		return nil
+	}
+
+	var fields compile.FieldGroup
+	for _, f := range spec.Fields {
+		fname, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if fname == "Generate" {
+			return fmt.Errorf("field %q conflicts with the generated Generate method", f.Name)
+		}
+		if quickGeneratable(f.Type) {
+			fields = append(fields, f)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$rand := import "math/rand">
+		<$reflect := import "reflect">
+		<$r := newVar "r">
+		<$size := newVar "size">
+		<$v := newVar "v">
+
+		// Generate returns a random *<.Name> for testing/quick.
+		<if .IsUnion ->
+		// Exactly one field is set,
+		<- else ->
+		// Required fields are always set and optional fields are set at random,
+		<- end>
+		// with containers and nested values bounded by size.
+		func (*<.Name>) Generate(<$r> *<$rand>.Rand, <$size> int) <$reflect>.Value {
+			var <$v> <.Name>
+			<- if .IsUnion>
+			switch <$r>.Intn(<len .Fields>) {
+			<- range $i, $f := .Fields>
+			case <$i>:
+				<quickSetField $v $f $r (printf "%s/2" $size)>
+			<- end>
+			}
+			<- else>
+			<- range .Fields>
+			<- if .Required>
+			<quickSetField $v . $r (printf "%s/2" $size)>
+			<- else>
+			if <$size> > 0 && <$r>.Intn(2) == 0 {
+				<quickSetField $v . $r (printf "%s/2" $size)>
+			}
+			<- end>
+			<- end>
+			<- end>
+			return <$reflect>.ValueOf(&<$v>)
+		}
+		`,
+		struct {
+			Name    string
+			IsUnion bool
+			Fields  compile.FieldGroup
+		}{Name: name, IsUnion: spec.Type == ast.UnionType, Fields: fields},
+		TemplateFunc("quickSetField", func(v string, f *compile.FieldSpec, r, size string) (string, error) {
+			return quickSetField(g, presence, v, f, r, size)
+		}),
+	)
+}
+
+// quickSetField returns statements which set the given field of v to a
+// random value.
+func quickSetField(g Generator, presence presenceLayout, v string, f *compile.FieldSpec, r, size string) (string, error) {
+	s, err := g.TextTemplate(
+		`
+		<- $fname := goName .Field ->
+		<- if .Presence.Has .Field ->
+			<.V>.<$fname> = <quickValue .Field.Type .R .Size>
+			<.Presence.Set .V .Field>
+		<- else if and (not .Field.Required) (isPrimitiveType .Field.Type) ->
+			<- $x := newVar "x" ->
+			<$x> := <quickValue .Field.Type .R .Size>
+			<.V>.<$fname> = &<$x>
+		<- else ->
+			<.V>.<$fname> = <quickValue .Field.Type .R .Size>
+		<- end>
+		`,
+		struct {
+			V        string
+			Field    *compile.FieldSpec
+			R        string
+			Size     string
+			Presence presenceLayout
+		}{V: v, Field: f, R: r, Size: size, Presence: presence},
+		TemplateFunc("quickValue", curryGenerator(quickValue, g)),
+	)
+	return strings.TrimSpace(s), err
+}
+
+// quickEnum generates a Generate method for the given enum which implements
+// testing/quick.Generator for pointers to it.
+func quickEnum(g Generator, spec *compile.EnumSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$rand := import "math/rand">
+		<$reflect := import "reflect">
+		<$r := newVar "r">
+		<$v := newVar "v">
+
+		// Generate returns a random *<typeName .> for testing/quick, chosen
+		// among the known values of the enum.
+		func (*<typeName .>) Generate(<$r> *<$rand>.Rand, _ int) <$reflect>.Value {
+			<$v> := <quickValue . $r "0">
+			return <$reflect>.ValueOf(&<$v>)
+		}
+		`,
+		spec,
+		TemplateFunc("quickValue", curryGenerator(quickValue, g)),
+	)
+}
+
+// quickValue returns an expression which evaluates to a random value of
+// the given type, given the names of a *rand.Rand and of the maximum size
+// of the value. The type must be generatable.
+func quickValue(g Generator, spec compile.TypeSpec, r, size string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return fmt.Sprintf("(%s.Intn(2) == 1)", r), nil
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
+		// Conversions truncate, covering the range of each type, signed
+		// or not.
+		t, err := typeReference(g, spec)
+		return fmt.Sprintf("%s(%s.Uint64())", t, r), err
+	case *compile.DoubleSpec:
+		return fmt.Sprintf("%s.NormFloat64()", r), nil
+	case *compile.TypedefSpec:
+		if isBoundTypedef(s) {
+			return "", fmt.Errorf("cannot generate values of %v: it is bound to a Go type", s.Name)
+		}
+		t, err := typeReference(g, s)
+		if err != nil {
+			return "", err
+		}
+		v, err := quickValue(g, s.Target, r, size)
+		return fmt.Sprintf("(%s)(%s)", t, v), err
+	}
+
+	name := fmt.Sprintf("_%s_Generate", g.MangleType(spec))
+	var body string
+	switch s := spec.(type) {
+	case *compile.StringSpec:
+		body = `
+			<$b := newVar "b" ->
+			<$b> := make([]rune, <$r>.Intn(<$size> + 1))
+			for <$i := newVar "i"><$i> := range <$b> {
+				// Any code point but surrogates, which are invalid in UTF-8.
+				<$b>[<$i>] = <$r>.Int31n(0xD800)
+				if <$r>.Intn(4) == 0 {
+					<$b>[<$i>] = 0xE000 + <$r>.Int31n(0x110000-0xE000)
+				}
+			}
+			return string(<$b>)
+		`
+	case *compile.BinarySpec:
+		body = `
+			<$o> := make([]byte, <$r>.Intn(<$size> + 1))
+			<$r>.Read(<$o>)
+			return <$o>
+		`
+	case *compile.UUIDSpec:
+		body = `
+			var <$o> <$t>
+			<$r>.Read(<$o>[:])
+			return <$o>
+		`
+	case *compile.EnumSpec:
+		body = `
+			<$values := newVar "values" ->
+			<$values> := <$t>_Values()
+			if len(<$values>) == 0 {
+				return <$t>(<$r>.Int31())
+			}
+			return <$values>[<$r>.Intn(len(<$values>))]
+		`
+	case *compile.StructSpec:
+		body = `return (<$t>)(nil).Generate(<$r>, <$size>).Interface().(<$t>)`
+	case *compile.ListSpec:
+		if !quickGeneratable(s.ValueSpec) {
+			return quickEmpty(g, spec)
+		}
+		body = `
+			` + quickItems + `
+			<$o> := make(<$t>, <$n>)
+			for <$i := newVar "i"><$i> := range <$o> {
+				<$o>[<$i>] = <quickValue .Spec.ValueSpec $r $s>
+			}
+			return <$o>
+		`
+	case *compile.SetSpec:
+		if !quickGeneratable(s.ValueSpec) {
+			return quickEmpty(g, spec)
+		}
+		if setUsesMap(s) {
+			body = `
+				` + quickItems + `
+				<$o> := make(<$t>, <$n>)
+				for <$i := newVar "i"><$i> := 0; <$i> <lessthan> <$n>; <$i>++ {
+					<$o>[<quickValue .Spec.ValueSpec $r $s>] = struct{}{}
+				}
+				return <$o>
+			`
+		} else {
+			// Items equal to earlier ones are dropped so that the result
+			// is a valid set.
+			body = `
+				` + quickItems + `
+				<$o> := make(<$t>, <$n>)
+				<$m := newVar "m"><$m> := 0
+				for <$i := newVar "i"><$i> := 0; <$i> <lessthan> <$n>; <$i>++ {
+					<$x := newVar "x"><$x> := <quickValue .Spec.ValueSpec $r $s>
+					if !` + quickContains + ` {
+						<$o>[<$m>] = <$x>
+						<$m>++
+					}
+				}
+				return <$o>[:<$m>]
+			`
+		}
+	case *compile.MapSpec:
+		if !quickGeneratable(s.KeySpec) || !quickGeneratable(s.ValueSpec) {
+			return quickEmpty(g, spec)
+		}
+		if mapUsesMap(s) {
+			body = `
+				` + quickItems + `
+				<$o> := make(<$t>, <$n>)
+				for <$i := newVar "i"><$i> := 0; <$i> <lessthan> <$n>; <$i>++ {
+					<$o>[<quickValue .Spec.KeySpec $r $s>] = <quickValue .Spec.ValueSpec $r $s>
+				}
+				return <$o>
+			`
+		} else {
+			// Items with keys equal to earlier ones are dropped so that
+			// the result is a valid map.
+			body = `
+				` + quickItems + `
+				<$o> := make(<$t>, <$n>)
+				<$m := newVar "m"><$m> := 0
+				for <$i := newVar "i"><$i> := 0; <$i> <lessthan> <$n>; <$i>++ {
+					<$x := newVar "x"><$x> := <quickValue .Spec.KeySpec $r $s>
+					if !` + quickContainsKey + ` {
+						<$o>[<$m>].Key = <$x>
+						<$o>[<$m>].Value = <quickValue .Spec.ValueSpec $r $s>
+						<$m>++
+					}
+				}
+				return <$o>[:<$m>]
+			`
+		}
+	default:
+		return "", fmt.Errorf("cannot generate values of %v", spec.ThriftName())
+	}
+
+	err := g.EnsureDeclared(
+		`
+		<$rand := import "math/rand">
+		<$r := newVar "r">
+		<$size := newVar "size">
+		<$n := newVar "n">
+		<$o := newVar "o">
+		<$t := typeReference .Spec>
+
+		func <.Name>(<$r> *<$rand>.Rand, <$size> int) <$t> {
+			`+strings.TrimSpace(body)+`
+		}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("quickValue", curryGenerator(quickValue, g)),
+	)
+	return fmt.Sprintf("%s(%s, %s)", name, r, size), wrapGenerateError(spec.ThriftName(), err)
+}
+
+// quickItems declares, inside generated functions, the number of items n of
+// a container, and the expression s for the size of each item so that they
+// share the size of the container.
+const quickItems = `
+	<$n> := <$r>.Intn(<$size> + 1)
+	<- $s := printf "%s/(%s+1)" $size $n>`
+
+// quickContains is an expression which is true if the first $m items of
+// the slice-backed set $o include one equal to $x.
+const quickContains = `func() bool {
+	for _, <$y := newVar "y"><$y> := range <$o>[:<$m>] {
+		if <equals .Spec.ValueSpec $y $x> {
+			return true
+		}
+	}
+	return false
+}()`
+
+// quickContainsKey is an expression which is true if the first $m items of
+// the slice-backed map $o include one with a key equal to $x.
+const quickContainsKey = `func() bool {
+	for _, <$y := newVar "y"><$y> := range <$o>[:<$m>] {
+		if <equals .Spec.KeySpec (printf "%s.Key" $y) $x> {
+			return true
+		}
+	}
+	return false
+}()`
+
+// quickEmpty returns an expression which evaluates to an empty, non-nil
+// container of the given type, for containers of items that cannot be
+// generated.
+func quickEmpty(g Generator, spec compile.TypeSpec) (string, error) {
+	t, err := typeReference(g, spec)
+	return t + "{}", err
+}
+
+// quickGeneratable returns true if finite values of the given type can be
+// generated.
+//
+// Structs whose required fields recurse forever and unions without any
+// field that can be generated have no finite values. Neither do typedefs
+// bound to Go types or map containers, which quick generators know nothing
+// about.
+func quickGeneratable(spec compile.TypeSpec) bool {
+	var structs []*compile.StructSpec
+	seen := make(map[*compile.StructSpec]struct{})
+	var visit func(compile.TypeSpec)
+	visit = func(spec compile.TypeSpec) {
+		switch s := spec.(type) {
+		case *compile.TypedefSpec:
+			visit(s.Target)
+		case *compile.ListSpec:
+			visit(s.ValueSpec)
+		case *compile.SetSpec:
+			visit(s.ValueSpec)
+		case *compile.MapSpec:
+			visit(s.KeySpec)
+			visit(s.ValueSpec)
+		case *compile.StructSpec:
+			if _, ok := seen[s]; ok {
+				return
+			}
+			seen[s] = struct{}{}
+			structs = append(structs, s)
+			for _, f := range s.Fields {
+				visit(f.Type)
+			}
+		}
+	}
+	visit(spec)
+
+	// Starting from no finite structs, add structs whose values can be
+	// built from the finite ones until there are no more.
+	finite := make(map[*compile.StructSpec]bool, len(structs))
+	for changed := true; changed; {
+		changed = false
+		for _, s := range structs {
+			if !finite[s] && quickFiniteStruct(s, finite) {
+				finite[s] = true
+				changed = true
+			}
+		}
+	}
+	return quickFinite(spec, finite)
+}
+
+func quickFiniteStruct(spec *compile.StructSpec, finite map[*compile.StructSpec]bool) bool {
+	if spec.Type == ast.UnionType {
+		for _, f := range spec.Fields {
+			if quickFinite(f.Type, finite) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, f := range spec.Fields {
+		if f.Required && !quickFinite(f.Type, finite) {
+			return false
+		}
+	}
+	return true
+}
+
+func quickFinite(spec compile.TypeSpec, finite map[*compile.StructSpec]bool) bool {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return !isBoundTypedef(s) && quickFinite(s.Target, finite)
+	case *compile.MapSpec:
+		// Containers may always be left empty.
+		return !isMapContainer(s)
+	case *compile.StructSpec:
+		return finite[s]
+	default:
+		return true
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tq "go.uber.org/thriftrw/gen/internal/tests/quick-generators"
+)
+
+func TestQuickGeneratorsRoundTrip(t *testing.T) {
+	tests := []thriftType{
+		&tq.Point{},
+		&tq.Primitives{},
+		&tq.Containers{},
+		&tq.Blobs{},
+		&tq.Node{},
+		&tq.Shape{},
+		&tq.ShapeError{},
+		&tq.Optionals{},
+	}
+
+	for _, tt := range tests {
+		typ := reflect.TypeOf(tt)
+		t.Run(typ.Elem().Name(), func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			for i := 0; i < 100; i++ {
+				v, ok := quick.Value(typ, r)
+				require.True(t, ok, "must be generatable")
+
+				give := v.Interface().(thriftType)
+				w, err := give.ToWire()
+				require.NoError(t, err, "generated values must be valid: %v", give)
+
+				got := reflect.New(typ.Elem()).Interface().(thriftType)
+				require.NoError(t, got.FromWire(w))
+				assert.Equal(t, give, got)
+			}
+		})
+	}
+}
+
+func TestQuickGeneratorsCheck(t *testing.T) {
+	// Points generated for the arguments of a function checked by
+	// testing/quick are never nil.
+	assert.NoError(t, quick.Check(func(p *tq.Point, c *tq.Color) bool {
+		return p != nil && c != nil
+	}, nil))
+}
+
+func TestQuickGeneratorsDistinctKeys(t *testing.T) {
+	// Sets and maps backed by slices must not hold duplicate items or keys,
+	// or they would not survive a round trip.
+	assert.NoError(t, quick.Check(func(b *tq.Blobs) bool {
+		for i, x := range b.Names {
+			for _, y := range b.Names[:i] {
+				if bytes.Equal(x.Key, y.Key) {
+					return false
+				}
+			}
+		}
+		for i, x := range b.Keys {
+			for _, y := range b.Keys[:i] {
+				if bytes.Equal(x, y) {
+					return false
+				}
+			}
+		}
+
+		w, err := b.ToWire()
+		if err != nil {
+			return false
+		}
+		var got tq.Blobs
+		if err := got.FromWire(w); err != nil {
+			return false
+		}
+		return b.Copy().Equals(b) && got.Equals(b)
+	}, &quick.Config{MaxCount: 500}))
+}
+
+func TestQuickGeneratorsEnum(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		v, ok := quick.Value(reflect.TypeOf((*tq.Color)(nil)), r)
+		require.True(t, ok)
+		assert.Contains(t, tq.Color_Values(), *v.Interface().(*tq.Color))
+	}
+}
+
+func TestQuickGeneratorsInfinite(t *testing.T) {
+	// Forever has no finite values, so it has no Generate method.
+	_, ok := reflect.TypeOf((*tq.Forever)(nil)).MethodByName("Generate")
+	assert.False(t, ok, "Forever must not have a Generate method")
+}
+
+func TestQuickGeneratorsConflict(t *testing.T) {
+	thriftRoot := t.TempDir()
+	path := filepath.Join(thriftRoot, "q.thrift")
+	require.NoError(t, os.WriteFile(path, []byte("struct S {\n1: optional string generate\n}\n"), 0o644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:       t.TempDir(),
+		PackagePrefix:   "example.com/gen",
+		ThriftRoot:      thriftRoot,
+		QuickGenerators: true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `field "generate" conflicts with the generated Generate method`)
+}
@@ -331,7 +331,7 @@ func TestQuickSuite(t *testing.T) {
 
 	tests := []testCase{
 		// structs, unions, and exceptions
-		{Sample: envex.TApplicationException{}, Kind: thriftStruct},
+		{Sample: envex.TApplicationException{}, NoLog: true, Kind: thriftStruct},
 		{Sample: tc.ContainersOfContainers{}, NoEquals: true, Kind: thriftStruct},
 		{Sample: tc.EnumContainers{}, Kind: thriftStruct},
 		{Sample: tc.ListOfConflictingEnums{}, Kind: thriftStruct},
@@ -503,6 +503,7 @@ func TestQuickSuite(t *testing.T) {
 		{
 			Sample:    envex.ExceptionType(0),
 			Generator: enumValueGenerator(envex.ExceptionType_Values),
+			NoLog:     true,
 			Kind:      thriftEnum,
 		},
 		{
@@ -709,6 +710,26 @@ func TestQuickSuite(t *testing.T) {
 				if typ.Kind() == reflect.Struct {
 					t.Run("EqualsNil", suite.testEqualsNil)
 				}
+
+				t.Run("Copy", func(t *testing.T) {
+					for _, give := range values {
+						suite.testCopy(t, give)
+					}
+				})
+
+				t.Run("Hash", func(t *testing.T) {
+					for _, give := range values {
+						suite.testHash(t, give)
+					}
+				})
+
+				if tt.Kind == thriftStruct {
+					t.Run("Reset", func(t *testing.T) {
+						for _, give := range values {
+							suite.testReset(t, give)
+						}
+					})
+				}
 			}
 		})
 	}
@@ -917,6 +938,58 @@ func (q *quickSuite) testEquals(t *testing.T, giveVal thriftType) {
 		"%v should be equal to itself", giveVal)
 }
 
+// Tests that v.Copy() is equal to v for types that have a Copy method.
+func (q *quickSuite) testCopy(t *testing.T, giveVal thriftType) {
+	give := reflect.ValueOf(giveVal)
+	cp := give.MethodByName("Copy")
+	if !cp.IsValid() {
+		// Primitive typedefs and enums are copied by value.
+		return
+	}
+
+	got := cp.Call(nil)[0]
+	rhs := got
+	equals := give.MethodByName("Equals")
+	if equals.Type().In(0) != rhs.Type() {
+		rhs = rhs.Elem()
+	}
+
+	assert.True(t,
+		equals.Call([]reflect.Value{rhs})[0].Bool(),
+		"copy of %v should be equal to it", giveVal)
+}
+
+// Tests that copies of v, which are equal to it, have the same hash for
+// types that have a Hash method.
+func (q *quickSuite) testHash(t *testing.T, giveVal thriftType) {
+	give := reflect.ValueOf(giveVal)
+	hash := give.MethodByName("Hash")
+	if !hash.IsValid() {
+		// Enums do not have a Hash method.
+		return
+	}
+
+	cp := give.MethodByName("Copy")
+	if !cp.IsValid() {
+		return
+	}
+
+	want := hash.Call(nil)[0].Uint()
+	got := cp.Call(nil)[0].MethodByName("Hash").Call(nil)[0].Uint()
+	assert.Equal(t, want, got, "copy of %v should have the same hash", giveVal)
+}
+
+// Tests that v.Reset() leaves v equal to an empty value.
+func (q *quickSuite) testReset(t *testing.T, giveVal thriftType) {
+	give := reflect.ValueOf(giveVal)
+	give.MethodByName("Reset").Call(nil)
+
+	empty := q.newEmpty()
+	assert.True(t,
+		give.MethodByName("Equals").Call([]reflect.Value{empty})[0].Bool(),
+		"%v should be empty after Reset", giveVal)
+}
+
 // Tests that Equals methods work with nil values and receivers.
 func (q *quickSuite) testEqualsNil(t *testing.T) {
 	t.Run("both nil", func(t *testing.T) {
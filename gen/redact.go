@@ -0,0 +1,252 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/fatih/structtag"
+)
+
+// RedactLabel marks struct fields which hold sensitive values. The values of
+// such fields are replaced with "[redacted]" in the output of the String
+// method, Zap logging, and JSON serialization of the struct. i.e.
+//
+//	struct Credentials {
+//		1: required string user
+//		2: required string password (redact)
+//	}
+//
+// The above struct will be printed as,
+//
+//	Credentials{User: alice, Password: [redacted]}
+const RedactLabel = "redact"
+
+// redactedValue replaces the values of redacted fields.
+const redactedValue = "[redacted]"
+
+func isRedacted(spec *compile.FieldSpec) bool {
+	_, ok := spec.Annotations[RedactLabel]
+	return ok
+}
+
+// jsonField is a field whose JSON representation is changed by the
+// MarshalJSON and UnmarshalJSON methods generated by JSON.
+type jsonField struct {
+	GoName   string
+	JSONName string
+	Required bool
+
+	// Redacted fields are marshaled as "[redacted]".
+	Redacted bool
+
+	// Fields stored with presence bits are marshaled only if they are set,
+	// through Ref, a pointer to their value of type *Type, and unmarshaled
+	// with their setters.
+	Presence bool
+	Ref      string
+	Type     string
+}
+
+// JSON generates a MarshalJSON method which replaces the values of redacted
+// fields of the struct with "[redacted]" and omits unset fields stored with
+// presence bits, and an UnmarshalJSON method which records the latter as
+// set. Nothing is generated if the struct has neither.
+func (f fieldGroupGenerator) JSON(g Generator) error {
+	var fields []jsonField
+	var hasRedacted, hasPresence bool
+	for _, field := range f.Fields {
+		name, err := goName(field)
+		if err != nil {
+			return err
+		}
+		if name == "MarshalJSON" && (hasRedactedFields(f.Fields) || f.Presence != nil) {
+			note := "redacted fields"
+			if !hasRedactedFields(f.Fields) {
+				note = "fields with presence bits"
+			}
+			return fmt.Errorf("field %q conflicts with the MarshalJSON method generated for %v", field.Name, note)
+		}
+		if name == "UnmarshalJSON" && f.Presence != nil {
+			return fmt.Errorf(
+				"field %q conflicts with the UnmarshalJSON method generated for fields with presence bits", field.Name)
+		}
+
+		redacted, presence := isRedacted(field), f.Presence.Has(field)
+		if !redacted && !presence {
+			continue
+		}
+
+		jsonName, err := f.jsonName(field)
+		if err != nil {
+			return err
+		}
+		if jsonName == "-" {
+			continue
+		}
+
+		jf := jsonField{
+			GoName:   name,
+			JSONName: jsonName,
+			Required: field.Required,
+			Redacted: redacted,
+			Presence: presence,
+		}
+		if jf.Ref, err = f.Presence.Ref("v", field); err != nil {
+			return err
+		}
+		if presence {
+			if jf.Type, err = typeReference(g, field.Type); err != nil {
+				return err
+			}
+		}
+		hasRedacted = hasRedacted || redacted
+		hasPresence = hasPresence || presence
+		fields = append(fields, jf)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$json := import "encoding/json">
+
+		<$v := "v">
+		<$plain := newVar "plain">
+		<$redacted := newVar "redacted">
+		<$r := newVar "r">
+		// MarshalJSON serializes a <.Name> into JSON
+		<- if .HasRedacted> with the values of
+		// its redacted fields replaced by "<.Redacted>"
+		<- end>
+		<- if and .HasRedacted .HasPresence>, and
+		// without its unset optional fields
+		<- else if .HasPresence> without its
+		// unset optional fields
+		<- end>.
+		//
+		// This has a value receiver so that <.Name> values are
+		<- if .HasRedacted> redacted<else> marshaled this way<end> in
+		// addition to pointers to them.
+		func (<$v> <.Name>) MarshalJSON() ([]byte, error) {
+			type <$plain> <.Name> // without this method
+
+			<if .HasRedacted ->
+			<$redacted> := "<.Redacted>"
+			<end ->
+			<$r> := struct {
+				*<$plain>
+				<range .Fields>
+					<- .GoName> *<if .Redacted>string<else><.Type><end> `+"`"+`json:"<.JSONName>,omitempty"`+"`"+`
+				<end>
+			}{<$plain>: (*<$plain>)(&<$v>)}
+			<range .Fields>
+				<- if and .Redacted .Required>
+					<$r>.<.GoName> = &<$redacted>
+				<- else if .Redacted>
+					if <.Ref> != nil {
+						<$r>.<.GoName> = &<$redacted>
+					}
+				<- else>
+					<$r>.<.GoName> = <.Ref>
+				<- end>
+			<- end>
+
+			return <$json>.Marshal(<$r>)
+		}
+
+		<if .HasPresence>
+		<$data := newVar "data">
+		// UnmarshalJSON deserializes a <.Name> from JSON, recording which of
+		// its optional fields are set.
+		func (<$v> *<.Name>) UnmarshalJSON(<$data> []byte) error {
+			type <$plain> <.Name> // without this method
+
+			<$r> := struct {
+				*<$plain>
+				<range .Fields>
+					<- if .Presence>
+					<- .GoName> *<.Type> `+"`"+`json:"<.JSONName>,omitempty"`+"`"+`
+					<end>
+				<- end>
+			}{<$plain>: (*<$plain>)(<$v>)}
+			if err := <$json>.Unmarshal(<$data>, &<$r>); err != nil {
+				return err
+			}
+			<range .Fields>
+				<- if .Presence>
+					if <$r>.<.GoName> != nil {
+						<$v>.Set<.GoName>(*<$r>.<.GoName>)
+					}
+				<- end>
+			<- end>
+			return nil
+		}
+		<end>
+		`,
+		struct {
+			Name        string
+			Fields      []jsonField
+			Redacted    string
+			HasRedacted bool
+			HasPresence bool
+		}{
+			Name:        f.Name,
+			Fields:      fields,
+			Redacted:    redactedValue,
+			HasRedacted: hasRedacted,
+			HasPresence: hasPresence,
+		},
+	)
+}
+
+func hasRedactedFields(fields compile.FieldGroup) bool {
+	for _, f := range fields {
+		if isRedacted(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonName returns the name of the given field in the JSON representation of
+// its struct, or "-" if it is omitted from it.
+func (f fieldGroupGenerator) jsonName(field *compile.FieldSpec) (string, error) {
+	tag, err := f.generateTags(field)
+	if err != nil {
+		return "", err
+	}
+
+	tags, err := structtag.Parse(strings.Trim(tag, "`"))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse tag: %v", err)
+	}
+
+	t, err := tags.Get(jsonTagKey)
+	if err != nil || t.Name == "" {
+		return entityLabel(field), nil
+	}
+	return t.Name, nil
+}
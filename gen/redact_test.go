@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tr "go.uber.org/thriftrw/gen/internal/tests/redact"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestRedactString(t *testing.T) {
+	tests := []struct {
+		desc string
+		give *tr.Credentials
+		want string
+	}{
+		{
+			desc: "required only",
+			give: &tr.Credentials{User: "alice", Password: "hunter2"},
+			want: "Credentials{User: alice, Password: [redacted]}",
+		},
+		{
+			desc: "all fields",
+			give: &tr.Credentials{
+				User:     "alice",
+				Password: "hunter2",
+				Token:    ptr.String("abc"),
+				Pin:      ptr.Int64(1234),
+				Card:     &tr.Card{Number: "4111111111111111"},
+				Note:     ptr.String("secret"),
+				Comment:  ptr.String("hello"),
+			},
+			want: "Credentials{User: alice, Password: [redacted], Token: [redacted], " +
+				"Pin: [redacted], Card: [redacted], Note: [redacted], Comment: hello}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.String())
+		})
+	}
+
+	t.Run("exception", func(t *testing.T) {
+		var err error = &tr.AuthError{Message: "denied", Password: ptr.String("hunter2")}
+		assert.EqualError(t, err, "AuthError{Message: denied, Password: [redacted]}")
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		card := &tr.Card{Number: "4111111111111111", Cvv: ptr.Int32(123)}
+		assert.Equal(t, "Card{Number: [redacted], Cvv: [redacted]}", card.String())
+	})
+}
+
+func TestRedactZap(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	require.NoError(t, (&tr.Credentials{
+		User:     "alice",
+		Password: "hunter2",
+		Pin:      ptr.Int64(1234),
+		Comment:  ptr.String("hello"),
+	}).MarshalLogObject(enc))
+
+	assert.Equal(t, map[string]interface{}{
+		"user":     "alice",
+		"password": "[redacted]",
+		"pin":      "[redacted]",
+		"comment":  "hello",
+	}, enc.Fields)
+}
+
+func TestRedactJSON(t *testing.T) {
+	give := tr.Credentials{
+		User:     "alice",
+		Password: "hunter2",
+		Token:    ptr.String("abc"),
+		Card:     &tr.Card{Number: "4111111111111111"},
+		Note:     ptr.String("secret"),
+		Comment:  ptr.String("hello"),
+	}
+	want := `{"user":"alice","password":"[redacted]","accessToken":"[redacted]",` +
+		`"card":"[redacted]","comment":"hello"}`
+
+	t.Run("pointer", func(t *testing.T) {
+		bs, err := json.Marshal(&give)
+		require.NoError(t, err)
+		assert.JSONEq(t, want, string(bs))
+	})
+
+	t.Run("value", func(t *testing.T) {
+		bs, err := json.Marshal(give)
+		require.NoError(t, err)
+		assert.JSONEq(t, want, string(bs))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		bs, err := json.Marshal(struct{ Card *tr.Card }{&tr.Card{Number: "4111111111111111"}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Card":{"number":"[redacted]"}}`, string(bs))
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		bs, err := json.Marshal(&tr.AuthError{Message: "denied"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"message":"denied"}`, string(bs))
+	})
+}
+
+func TestRedactJSONFieldConflict(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Foo",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "marshalJSON", Type: &compile.StringSpec{}},
+			{
+				ID:          2,
+				Name:        "password",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{RedactLabel: ""},
+			},
+		},
+	}
+	err := structure(NewGenerator(&GeneratorOptions{}), spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`field "marshalJSON" conflicts with the MarshalJSON method generated for redacted fields`)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// checkRequired returns true if decoding must check that the given field
+// was present: it is required and missing required fields are not left
+// zero-valued.
+func checkRequired(g Generator, f *compile.FieldSpec) bool {
+	return f.Required && checkMissingRequired(g) != MissingRequiredZero
+}
+
+// missingRequired generates statements which handle the absence of the
+// required field with the given Go name from the struct with the given Go
+// name when decoding it.
+func missingRequired(g Generator, structName, fieldName string) string {
+	if checkMissingRequired(g) == MissingRequiredHook {
+		return fmt.Sprintf(
+			"if err := %v.Missing(%q, %q); err != nil {\nreturn err\n}",
+			g.Import("go.uber.org/thriftrw/requiredfield"), structName, fieldName)
+	}
+	return fmt.Sprintf("return %v.New(%q)",
+		g.Import("errors"), fmt.Sprintf("field %v of %v is required", fieldName, structName))
+}
+
+// requiredFieldError generates an expression for the error returned when
+// encoding a struct with the given Go name whose required field with the
+// given Go name is unset.
+//
+// Decoding may leave required fields unset if they are not checked, so
+// such code reports a *requiredfield.MissingError that callers can tell
+// apart from other failures.
+func requiredFieldError(g Generator, structName, fieldName string) string {
+	if checkMissingRequired(g) == MissingRequiredError {
+		return fmt.Sprintf("%v.New(%q)",
+			g.Import("errors"), fmt.Sprintf("field %v of %v is required", fieldName, structName))
+	}
+	return fmt.Sprintf("&%v.MissingError{Struct: %q, Field: %q}",
+		g.Import("go.uber.org/thriftrw/requiredfield"), structName, fieldName)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"sync"
+	"testing"
+
+	tz "go.uber.org/thriftrw/gen/internal/tests/nozap"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	tuf "go.uber.org/thriftrw/gen/internal/tests/unknown-fields"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetRetainsCapacity(t *testing.T) {
+	give := &tz.PrimitiveRequiredStruct{
+		BoolField:          true,
+		StringField:        "foo",
+		BinaryField:        []byte("bar"),
+		ListOfStrings:      []string{"a", "b"},
+		SetOfInts:          map[int32]struct{}{1: {}},
+		MapOfIntsToDoubles: map[int64]float64{1: 2},
+	}
+	set, m := give.SetOfInts, give.MapOfIntsToDoubles
+
+	give.Reset()
+	assert.True(t, give.Equals(&tz.PrimitiveRequiredStruct{}))
+	assert.Equal(t, 3, cap(give.BinaryField))
+	assert.Equal(t, 2, cap(give.ListOfStrings))
+	assert.Empty(t, give.SetOfInts)
+	assert.Empty(t, give.MapOfIntsToDoubles)
+
+	give.SetOfInts[2] = struct{}{}
+	give.MapOfIntsToDoubles[3] = 4
+	assert.Contains(t, set, int32(2), "sets must be reused")
+	assert.Contains(t, m, int64(3), "maps must be reused")
+}
+
+func TestResetOptional(t *testing.T) {
+	give := &ts.PrimitiveOptionalStruct{
+		BoolField:   ptr.Bool(true),
+		StringField: ptr.String("foo"),
+		BinaryField: []byte("bar"),
+	}
+
+	give.Reset()
+	assert.Equal(t, &ts.PrimitiveOptionalStruct{}, give,
+		"optional fields must be unset, not empty")
+}
+
+func TestResetUnknownFields(t *testing.T) {
+	var give tuf.UserV1
+	decodeThrift(t, encodeThrift(t, &tuf.UserV2{Name: "alice", Age: ptr.Int32(42)}, false), &give, true)
+
+	give.Reset()
+	give.Name = "bob"
+
+	var got tuf.UserV2
+	decodeThrift(t, encodeThrift(t, &give, true), &got, true)
+	assert.Equal(t, &tuf.UserV2{Name: "bob"}, &got)
+}
+
+func TestResetPool(t *testing.T) {
+	pool := sync.Pool{New: func() interface{} { return new(ts.Frame) }}
+	bs := encodeThrift(t, &ts.Frame{
+		TopLeft: &ts.Point{X: 1, Y: 2},
+		Size:    &ts.Size{Width: 3, Height: 4},
+	}, false)
+
+	for i := 0; i < 3; i++ {
+		f := pool.Get().(*ts.Frame)
+		decodeThrift(t, bs, f, false)
+		require.Equal(t, 3.0, f.Size.Width)
+
+		f.Reset()
+		pool.Put(f)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// router generates a NewRouter function which serves the procedures of all
+// the given services from one thriftrpc.Router.
+//
+// Without service prefixes, requests are routed by method name alone, so
+// methods with the same name in different services, including inherited
+// methods, are reported as conflicts. Functions are also routed by the
+// names listed in their thrift.alias annotations.
+func router(g Generator, services map[string]*compile.ServiceSpec, prefix bool) error {
+	names := sortStringKeys(services)
+	if err := checkRouterConflicts(services, names, prefix); err != nil {
+		return err
+	}
+
+	specs := make([]*compile.ServiceSpec, len(names))
+	for i, name := range names {
+		specs[i] = services[name]
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$thriftrpc := import "go.uber.org/thriftrw/thriftrpc">
+
+		// RouterServices holds implementations of the services served by
+		// NewRouter. Services left nil are not served.
+		type RouterServices struct {
+			<- range .Services>
+				<goCase .Name> <goCase .Name>_Interface
+			<- end>
+		}
+
+		<$s := newVar "s">
+		<$routes := newVar "routes">
+		<$p := newVar "p">
+		<$name := newVar "name">
+		// NewRouter returns a thriftrpc.Router which serves the given
+		// services from one listener, routing requests by the method names
+		<- if .Prefix>
+		// in their envelopes prefixed with the service name, as in
+		// "Service:method".
+		<- else>
+		// in their envelopes.
+		<- end>
+		func NewRouter(<$s> RouterServices) *<$thriftrpc>.Router {
+			<$routes> := make(map[string]<$thriftrpc>.Procedure)
+			<- range .Services>
+				<- $svc := goCase .Name>
+				if <$s>.<$svc> != nil {
+					for _, <$p> := range <$svc>_Procedures(<$s>.<$svc>) {
+						for _, <$name> := range <$p>.RouteNames("<if $.Prefix><.Name><end>") {
+							<$routes>[<$name>] = <$p>
+						}
+					}
+				}
+			<- end>
+
+			return <$thriftrpc>.NewRouter(<$routes>)
+		}
+		`,
+		struct {
+			Services []*compile.ServiceSpec
+			Prefix   bool
+		}{Services: specs, Prefix: prefix},
+	)
+}
+
+// checkRouterConflicts returns an error if functions of different services,
+// including inherited functions and aliases, are routed by the same name.
+// This matches the names returned by thriftrpc.Procedure.RouteNames.
+func checkRouterConflicts(services map[string]*compile.ServiceSpec, names []string, prefix bool) error {
+	owners := make(map[string]string) // route name -> "Service.function"
+	for _, name := range names {
+		for s := services[name]; s != nil; s = s.Parent {
+			for _, fname := range sortStringKeys(s.Functions) {
+				f := s.Functions[fname]
+				aliases, err := functionAliases(f)
+				if err != nil {
+					return err
+				}
+
+				route := f.MethodName()
+				if prefix {
+					route = name + ":" + route
+				}
+				routes := []string{route}
+				for _, alias := range aliases {
+					parts := strings.SplitN(alias, "::", 2)
+					if prefix {
+						routes = append(routes, parts[0]+":"+parts[1])
+					} else {
+						routes = append(routes, parts[1])
+					}
+				}
+
+				owner := name + "." + f.Name
+				for _, route := range routes {
+					other, ok := owners[route]
+					if !ok {
+						owners[route] = owner
+						continue
+					}
+					if other == owner {
+						// Aliases of other services may route to the same
+						// method name as the function itself.
+						continue
+					}
+
+					err := fmt.Errorf("cannot route %q to %v: it is also routed to %v", route, owner, other)
+					if !prefix {
+						err = fmt.Errorf("%v: use router service prefixes to serve both", err)
+					}
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
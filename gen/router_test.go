@@ -0,0 +1,316 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	tr "go.uber.org/thriftrw/gen/internal/tests/router"
+	trp "go.uber.org/thriftrw/gen/internal/tests/router-prefix"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/thriftrpc"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type routerStore struct{ evicted chan string }
+
+func (s *routerStore) Healthy(ctx context.Context) (bool, error) { return true, nil }
+
+func (s *routerStore) Get(ctx context.Context, key *string) ([]byte, error) {
+	if *key != "foo" {
+		return nil, &tr.NotFound{Key: key}
+	}
+	return []byte("bar"), nil
+}
+
+func (s *routerStore) Evict(ctx context.Context, key *string) error {
+	s.evicted <- *key
+	return nil
+}
+
+type routerAdmin struct{ drained bool }
+
+func (a *routerAdmin) Drain(ctx context.Context) error {
+	a.drained = true
+	return nil
+}
+
+type routerUsers struct{ kind string }
+
+func (u routerUsers) Healthy(ctx context.Context) (bool, error) { return true, nil }
+
+func (u routerUsers) Get(ctx context.Context, id int64) (string, error) {
+	return u.kind, nil
+}
+
+// encodeRouterRequest encodes an enveloped request for the given method.
+func encodeRouterRequest(t *testing.T, name string, args envelope.Enveloper) []byte {
+	v, err := args.ToWire()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  args.EnvelopeType(),
+		SeqID: 1,
+		Value: v,
+	}, &buf))
+	return buf.Bytes()
+}
+
+func TestRouter(t *testing.T) {
+	ctx := context.Background()
+	store := &routerStore{evicted: make(chan string, 1)}
+	admin := &routerAdmin{}
+	router := tr.NewRouter(tr.RouterServices{Store: store, Admin: admin})
+
+	t.Run("method", func(t *testing.T) {
+		req := encodeRouterRequest(t, "get", tr.Store_Get_Helper.Args(ptr.String("foo")))
+		res, isAppErr, err := router.Serve(ctx, req)
+		require.NoError(t, err)
+		assert.False(t, isAppErr)
+
+		var result tr.Store_Get_Result
+		require.NoError(t, thriftrpc.DecodeResponse(res, true, &result))
+		assert.Equal(t, []byte("bar"), result.Success)
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		req := encodeRouterRequest(t, "get", tr.Store_Get_Helper.Args(ptr.String("baz")))
+		_, isAppErr, err := router.Serve(ctx, req)
+		require.NoError(t, err)
+		assert.True(t, isAppErr)
+	})
+
+	t.Run("inherited method", func(t *testing.T) {
+		_, ok := router.Procedure("healthy")
+		assert.True(t, ok)
+	})
+
+	t.Run("other service", func(t *testing.T) {
+		req := encodeRouterRequest(t, "drain", tr.Admin_Drain_Helper.Args())
+		_, _, err := router.Serve(ctx, req)
+		require.NoError(t, err)
+		assert.True(t, admin.drained)
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		req := encodeRouterRequest(t, "evict", tr.Store_Evict_Helper.Args(ptr.String("foo")))
+		res, _, err := router.Serve(ctx, req)
+		require.NoError(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, "foo", <-store.evicted)
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		req := encodeRouterRequest(t, "Store:get", tr.Store_Get_Helper.Args(ptr.String("foo")))
+		res, _, err := router.Serve(ctx, req)
+		require.NoError(t, err)
+
+		var result tr.Store_Get_Result
+		err = thriftrpc.DecodeResponse(res, true, &result)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown method "Store:get"`)
+		assert.Contains(t, err.Error(), "UNKNOWN_METHOD")
+	})
+
+	t.Run("unknown oneway method", func(t *testing.T) {
+		req := encodeRouterRequest(t, "forget", tr.Store_Evict_Helper.Args(ptr.String("foo")))
+		_, _, err := router.Serve(ctx, req)
+		assert.Equal(t, &thriftrpc.UnknownMethodError{Method: "forget"}, err)
+	})
+
+	t.Run("without envelope", func(t *testing.T) {
+		req, err := thriftrpc.EncodeRequest(tr.Store_Get_Helper.Args(ptr.String("foo")), false)
+		require.NoError(t, err)
+
+		_, _, err = router.Serve(ctx, req)
+		var argsErr *thriftrpc.ArgumentsError
+		assert.ErrorAs(t, err, &argsErr)
+	})
+
+	t.Run("alias", func(t *testing.T) {
+		for _, name := range []string{"getValue", "fetch"} {
+			args := thriftrpc.Aliased(tr.Store_Get_Helper.Args(ptr.String("foo")), "KeyValue::"+name)
+			assert.Equal(t, name, args.MethodName())
+
+			req, err := thriftrpc.EncodeRequest(args, true)
+			require.NoError(t, err)
+
+			res, _, err := router.Serve(ctx, req)
+			require.NoError(t, err, name)
+
+			var result tr.Store_Get_Result
+			require.NoError(t, thriftrpc.DecodeResponse(res, true, &result))
+			assert.Equal(t, []byte("bar"), result.Success)
+		}
+	})
+
+	t.Run("nil services are not served", func(t *testing.T) {
+		router := tr.NewRouter(tr.RouterServices{Admin: admin})
+		_, ok := router.Procedure("get")
+		assert.False(t, ok)
+	})
+}
+
+func TestRouterPrefix(t *testing.T) {
+	ctx := context.Background()
+	router := trp.NewRouter(trp.RouterServices{
+		Users:  routerUsers{kind: "user"},
+		Groups: routerUsers{kind: "group"},
+	})
+
+	for _, svc := range []string{"Users", "Groups"} {
+		t.Run(svc, func(t *testing.T) {
+			req := encodeRouterRequest(t, svc+":get", trp.Users_Get_Helper.Args(1))
+			res, _, err := router.Serve(ctx, req)
+			require.NoError(t, err)
+
+			var result trp.Users_Get_Result
+			require.NoError(t, thriftrpc.DecodeResponse(res, true, &result))
+			want := map[string]string{"Users": "user", "Groups": "group"}[svc]
+			assert.Equal(t, want, *result.Success)
+
+			_, ok := router.Procedure(svc + ":healthy")
+			assert.True(t, ok, "inherited methods must be prefixed with the child service")
+		})
+	}
+
+	_, ok := router.Procedure("get")
+	assert.False(t, ok)
+
+	p, ok := router.Procedure("Teams:get")
+	if assert.True(t, ok, "aliases must be prefixed with their own service") {
+		assert.Equal(t, "Groups::get", p.Name)
+	}
+}
+
+func TestRouterConflicts(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    string
+		opts    Options
+		wantErr string
+	}{
+		{
+			desc: "same method",
+			give: "service A { void ping() }\nservice B { void ping() }\n",
+			opts: Options{Procedures: true, Router: true},
+			wantErr: `cannot route "ping" to B.ping: it is also routed to A.ping: ` +
+				"use router service prefixes to serve both",
+		},
+		{
+			desc: "inherited method",
+			give: "service A { void ping() }\nservice B extends A { void pong() }\n",
+			opts: Options{Procedures: true, Router: true},
+			wantErr: `cannot route "ping" to B.ping: it is also routed to A.ping: ` +
+				"use router service prefixes to serve both",
+		},
+		{
+			desc: "alias",
+			give: "service A { void ping() }\n" +
+				`service B { void pong() (thrift.alias = "Old::ping") }` + "\n",
+			opts: Options{Procedures: true, Router: true},
+			wantErr: `cannot route "ping" to B.pong: it is also routed to A.ping: ` +
+				"use router service prefixes to serve both",
+		},
+		{
+			desc: "prefixed alias",
+			give: "service A { void ping() }\n" +
+				`service B { void pong() (thrift.alias = "A::ping") }` + "\n",
+			opts:    Options{Procedures: true, Router: true, RouterPrefix: true},
+			wantErr: `cannot route "A:ping" to B.pong: it is also routed to A.ping`,
+		},
+		{
+			desc: "alias with the same method name",
+			give: `service Groups { void get() (thrift.alias = "Teams::get") }` + "\n",
+			opts: Options{Procedures: true, Router: true},
+		},
+		{
+			desc: "prefixed alias of another service",
+			give: "service A { void ping() }\n" +
+				`service B { void pong() (thrift.alias = "Old::ping") }` + "\n",
+			opts: Options{Procedures: true, Router: true, RouterPrefix: true},
+		},
+		{
+			desc:    "invalid alias",
+			give:    `service A { void ping() (thrift.alias = "ping") }` + "\n",
+			opts:    Options{Procedures: true},
+			wantErr: `invalid thrift.alias annotation on ping: "ping" is not of the form "Service::method"`,
+		},
+		{
+			desc:    "duplicate alias",
+			give:    `service A { void ping() (thrift.alias = "Old::ping, Old::ping") }` + "\n",
+			opts:    Options{Procedures: true},
+			wantErr: `invalid thrift.alias annotation on ping: "Old::ping" is listed more than once`,
+		},
+		{
+			desc: "prefixed",
+			give: "service A { void ping() }\nservice B { void ping() }\n",
+			opts: Options{Procedures: true, Router: true, RouterPrefix: true},
+		},
+		{
+			desc:    "without procedures",
+			give:    "service A { void ping() }\n",
+			opts:    Options{Router: true},
+			wantErr: "Router requires Procedures",
+		},
+		{
+			desc:    "prefix without router",
+			give:    "service A { void ping() }\n",
+			opts:    Options{Procedures: true, RouterPrefix: true},
+			wantErr: "RouterPrefix requires Router",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot := t.TempDir()
+			path := filepath.Join(thriftRoot, "svc.thrift")
+			require.NoError(t, os.WriteFile(path, []byte(tt.give), 0o644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			opts := tt.opts
+			opts.OutputDir = t.TempDir()
+			opts.PackagePrefix = "example.com/gen"
+			opts.ThriftRoot = thriftRoot
+			err = Generate(module, &opts)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	trp "go.uber.org/thriftrw/gen/internal/tests/rpc-policy"
+	"go.uber.org/thriftrw/rpcpolicy"
+)
+
+func TestRPCPolicy(t *testing.T) {
+	tests := []struct {
+		desc   string
+		give   rpcpolicy.Policy
+		want   rpcpolicy.Policy
+		oneway bool
+	}{
+		{
+			desc: "timeout and retries",
+			give: trp.KeyValue_GetValue_Helper.Policy,
+			want: rpcpolicy.Policy{Timeout: 500 * time.Millisecond, Retries: 3},
+		},
+		{
+			desc: "timeout only",
+			give: trp.KeyValue_SetValue_Helper.Policy,
+			want: rpcpolicy.Policy{Timeout: 90 * time.Second},
+		},
+		{
+			desc:   "oneway",
+			give:   trp.KeyValue_Flush_Helper.Policy,
+			want:   rpcpolicy.Policy{Retries: 2},
+			oneway: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want.Timeout, tt.give.Timeout)
+			assert.Equal(t, tt.want.Retries, tt.give.Retries)
+			assert.Equal(t, tt.oneway, tt.give.IsException == nil,
+				"only oneway functions must not recognize exceptions")
+		})
+	}
+}
+
+func TestRPCPolicyDeclaredException(t *testing.T) {
+	p := trp.KeyValue_GetValue_Helper.Policy
+
+	var attempts int
+	err := p.Call(context.Background(), func(context.Context) error {
+		attempts++
+		return &trp.KeyNotFound{Key: "foo"}
+	})
+	assert.Equal(t, &trp.KeyNotFound{Key: "foo"}, err)
+	assert.Equal(t, 1, attempts, "declared exceptions must not be retried")
+
+	attempts = 0
+	err = p.Call(context.Background(), func(context.Context) error {
+		attempts++
+		return errors.New("great sadness")
+	})
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, 4, attempts, "other errors must be retried")
+}
+
+func TestRPCPolicyInvalidAnnotation(t *testing.T) {
+	s := &compile.ServiceSpec{Name: "KeyValue"}
+	f := &compile.FunctionSpec{
+		Name:        "getValue",
+		Annotations: compile.Annotations{rpcpolicy.TimeoutAnnotation: "soon"},
+	}
+
+	err := functionHelper(nil /* generator */, s, f)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid rpc.timeout annotation "soon"`)
+}
@@ -24,9 +24,13 @@ import (
 	"bytes"
 	"fmt"
 	"go/token"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/rpcpolicy"
 )
 
 // Service generates code for the given service.
@@ -55,6 +59,18 @@ func Service(g Generator, s *compile.ServiceSpec) (map[string]*bytes.Buffer, err
 		files[fileName] = buff
 	}
 
+	if checkProcedures(g) {
+		if err := serviceProcedures(g, s); err != nil {
+			return nil, fmt.Errorf("could not generate procedures for %s: %v", s.Name, err)
+		}
+
+		buff := new(bytes.Buffer)
+		if err := g.Write(buff, token.NewFileSet()); err != nil {
+			return nil, fmt.Errorf("could not write procedures for %s: %v", s.Name, err)
+		}
+		files[fmt.Sprintf("%s_procedures.go", strings.ToLower(s.Name))] = buff
+	}
+
 	return files, nil
 }
 
@@ -71,6 +87,18 @@ func Services(g Generator, services map[string]*compile.ServiceSpec) error {
 					s.Name, functionName, err)
 			}
 		}
+
+		if checkProcedures(g) {
+			if err := serviceProcedures(g, s); err != nil {
+				return fmt.Errorf("could not generate procedures for %s: %v", s.Name, err)
+			}
+		}
+	}
+
+	if ok, prefix := checkRouter(g); ok {
+		if err := router(g, services, prefix); err != nil {
+			return fmt.Errorf("could not generate router: %v", err)
+		}
 	}
 
 	return nil
@@ -80,14 +108,17 @@ func Services(g Generator, services map[string]*compile.ServiceSpec) error {
 func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
 	argsName := functionNamePrefix(s, f) + "Args"
 	argsGen := fieldGroupGenerator{
-		Namespace: NewNamespace(),
-		Name:      argsName,
-		Fields:    compile.FieldGroup(f.ArgsSpec),
-		Doc: fmt.Sprintf(
+		Namespace:    NewNamespace(),
+		Name:         argsName,
+		Fields:       compile.FieldGroup(f.ArgsSpec),
+		OmitDefaults: checkOmitDefaults(g),
+		DualEncode:   checkDualEncode(g),
+		PprofLabels:  checkPprofLabels(g),
+		Doc: deprecatedDoc(sourceDoc(g, appendDoc(fmt.Sprintf(
 			"%v represents the arguments for the %v.%v function.\n\n"+
 				"The arguments for %v are sent and received over the wire as this struct.",
 			argsName, s.Name, f.Name, f.Name,
-		),
+		), f.Doc), s.File, f.Line), f.Annotations),
 	}
 	if err := argsGen.Generate(g); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
@@ -101,6 +132,9 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	}
 
 	if f.ResultSpec == nil {
+		if err := functionHTTPHandler(g, s, f); err != nil {
+			return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+		}
 		return nil
 	}
 
@@ -131,7 +165,9 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 		Fields:          resultFields,
 		IsUnion:         true,
 		AllowEmptyUnion: f.ResultSpec.ReturnType == nil,
-		Doc:             resultDoc,
+		DualEncode:      checkDualEncode(g),
+		PprofLabels:     checkPprofLabels(g),
+		Doc:             sourceDoc(g, resultDoc, s.File, f.Line),
 	}
 	if err := resultGen.Generate(g); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
@@ -142,9 +178,24 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 
 	// TODO(abg): If we receive unknown exceptions over the wire, we need to
 	// throw a generic error.
+	if err := functionHTTPHandler(g, s, f); err != nil {
+		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+	}
 	return nil
 }
 
+// appendDoc appends the documentation for a Thrift definition to the given
+// generated doc comment as a new paragraph.
+func appendDoc(doc, thriftDoc string) string {
+	if thriftDoc == "" {
+		return doc
+	}
+	if doc == "" {
+		return thriftDoc
+	}
+	return doc + "\n\n" + thriftDoc
+}
+
 // functionParams returns a named parameter list for the given function.
 func functionParams(g Generator, f *compile.FunctionSpec) (string, error) {
 	return g.TextTemplate(
@@ -161,6 +212,24 @@ func functionParams(g Generator, f *compile.FunctionSpec) (string, error) {
 }
 
 func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
+	policy, err := rpcpolicy.FromAnnotations(f.Annotations)
+	if err != nil {
+		return err
+	}
+
+	var policyExpr string
+	if !policy.IsZero() {
+		policyExpr, err = functionPolicy(g, policy)
+		if err != nil {
+			return err
+		}
+	}
+
+	idempotencyToken, err := hasIdempotencyToken(f)
+	if err != nil {
+		return err
+	}
+
 	return g.DeclareFromTemplate(
 		`
 		<$f := .Function>
@@ -169,6 +238,10 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 		// <$prefix>Helper provides functions that aid in handling the
 		// parameters and return values of the <.Service.Name>.<$f.Name>
 		// function.
+		<with deprecatedDoc (sourceDoc $f.Doc .Service.File $f.Line) $f.Annotations ->
+		//
+		<formatDoc .>
+		<- end ->
 		var <$prefix>Helper = struct{
 			// Args accepts the parameters of <$f.Name> in-order and returns
 			// the arguments struct for the function.
@@ -182,6 +255,7 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 				// section for it in the Thrift file.
 				IsException func(error) bool
 				<if $f.ResultSpec.ReturnType>
+					<if .Server ->
 					// WrapResponse returns the result struct for <$f.Name>
 					// given its return value and error.
 					//
@@ -197,7 +271,8 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 					//   }
 					//   serialize(result)
 					WrapResponse func(<typeReference $f.ResultSpec.ReturnType>, error) (*<$prefix>Result, error)
-
+					<end>
+					<if .Client ->
 					// UnwrapResponse takes the result struct for <$f.Name>
 					// and returns the value or error returned by it.
 					//
@@ -207,7 +282,9 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 					//   result := deserialize(bytes)
 					//   value, err := <$prefix>Helper.UnwrapResponse(result)
 					UnwrapResponse func(*<$prefix>Result) (<typeReference $f.ResultSpec.ReturnType>, error)
+					<- end>
 				<else>
+					<if .Server ->
 					// WrapResponse returns the result struct for <$f.Name>
 					// given the error returned by it. The provided error may
 					// be nil if <$f.Name> did not fail.
@@ -224,7 +301,8 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 					//   }
 					//   serialize(result)
 					WrapResponse func(error) (*<$prefix>Result, error)
-
+					<end>
+					<if .Client ->
 					// UnwrapResponse takes the result struct for <$f.Name>
 					// and returns the erorr returned by it (if any).
 					//
@@ -234,8 +312,25 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 					//   result := deserialize(bytes)
 					//   err := <$prefix>Helper.UnwrapResponse(result)
 					UnwrapResponse func(*<$prefix>Result) error
+					<- end>
 				<end>
 			<end>
+			<if .Policy>
+				// Policy is the retry and timeout policy declared for
+				// <$f.Name> in the Thrift file.
+				Policy <import "go.uber.org/thriftrw/rpcpolicy">.Policy
+			<end>
+			<if and .IdempotencyToken .Server>
+				// ArgsFromWire decodes the arguments struct for <$f.Name>
+				// from the body of a request, and returns it along with the
+				// idempotency token attached to the request with
+				// envelope.WithIdempotencyToken. The token is empty if the
+				// request did not carry one.
+				//
+				// Servers may use the token to drop requests that were
+				// delivered more than once.
+				ArgsFromWire func(<import "go.uber.org/thriftrw/wire">.Value) (*<$prefix>Args, string, error)
+			<end>
 		}{}
 
 		func init() {
@@ -243,17 +338,48 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 			<if not $f.OneWay>
 				<$prefix>Helper.IsException = <isException $f>
 
+				<if .Server ->
 				<$prefix>Helper.WrapResponse = <wrapResponse .Service $f>
+				<- end>
+				<if .Client ->
 				<$prefix>Helper.UnwrapResponse = <unwrapResponse .Service $f>
+				<- end>
+			<end>
+			<if .Policy>
+				<$prefix>Helper.Policy = <.Policy>
+				<- if not $f.OneWay>
+				<$prefix>Helper.Policy.IsException = <$prefix>Helper.IsException
+				<- end>
+			<end>
+			<if and .IdempotencyToken .Server>
+				<$w := newVar "w">
+				<$args := newVar "args">
+				<$token := newVar "token">
+				<$prefix>Helper.ArgsFromWire = func(<$w> <import "go.uber.org/thriftrw/wire">.Value) (*<$prefix>Args, string, error) {
+					var <$args> <$prefix>Args
+					if err := <$args>.FromWire(<$w>); err != nil {
+						return nil, "", err
+					}
+					<$token>, _ := <import "go.uber.org/thriftrw/envelope">.IdempotencyToken(<$w>)
+					return &<$args>, <$token>, nil
+				}
 			<end>
 		}
 		`,
 		struct {
-			Service  *compile.ServiceSpec
-			Function *compile.FunctionSpec
+			Service          *compile.ServiceSpec
+			Function         *compile.FunctionSpec
+			Policy           string
+			IdempotencyToken bool
+			Client           bool
+			Server           bool
 		}{
-			Service:  s,
-			Function: f,
+			Service:          s,
+			Function:         f,
+			Policy:           policyExpr,
+			IdempotencyToken: idempotencyToken,
+			Client:           checkOnly(g) != OnlyServers,
+			Server:           checkOnly(g) != OnlyClients,
 		},
 		TemplateFunc("params", functionParams),
 		TemplateFunc("isException", functionIsException),
@@ -264,6 +390,46 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 	)
 }
 
+// functionPolicy generates an expression that provides the retry and timeout
+// policy declared for a Thrift function.
+func functionPolicy(g Generator, p rpcpolicy.Policy) (string, error) {
+	return g.TextTemplate(
+		`
+		<- $rpcpolicy := import "go.uber.org/thriftrw/rpcpolicy" ->
+		<$rpcpolicy>.Policy{
+			<- if .Timeout>
+				Timeout: <duration .Timeout>,
+			<- end>
+			<- if .Retries>
+				Retries: <.Retries>,
+			<- end>
+		}`, p,
+		TemplateFunc("duration", durationExpr))
+}
+
+// durationExpr generates an expression for the given duration in the
+// largest unit that represents it exactly.
+func durationExpr(g Generator, d time.Duration) string {
+	units := []struct {
+		Name string
+		Unit time.Duration
+	}{
+		{"Hour", time.Hour},
+		{"Minute", time.Minute},
+		{"Second", time.Second},
+		{"Millisecond", time.Millisecond},
+		{"Microsecond", time.Microsecond},
+	}
+
+	timePkg := g.Import("time")
+	for _, u := range units {
+		if d%u.Unit == 0 {
+			return fmt.Sprintf("%d * %s.%s", d/u.Unit, timePkg, u.Name)
+		}
+	}
+	return fmt.Sprintf("%d * %s.Nanosecond", int64(d), timePkg)
+}
+
 // functionIsException generates an expression that provides the IsException
 // function for the given Thrift function.
 func functionIsException(g Generator, f *compile.FunctionSpec) (string, error) {
@@ -477,6 +643,394 @@ func functionResponseEnveloper(g Generator, s *compile.ServiceSpec, f *compile.F
 
 }
 
+// idempotencyTokenKey is the annotation on service functions whose requests
+// may carry idempotency tokens. Helpers for such functions provide an
+// ArgsFromWire function which returns the token along with the arguments.
+const idempotencyTokenKey = "rpc.idempotency_token"
+
+// hasIdempotencyToken returns true if the given function has the
+// rpc.idempotency_token annotation. The annotation may be specified without a
+// value.
+func hasIdempotencyToken(f *compile.FunctionSpec) (bool, error) {
+	v, ok := f.Annotations[idempotencyTokenKey]
+	if !ok {
+		return false, nil
+	}
+	if v == "" {
+		return true, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %v annotation: %q is not a boolean", idempotencyTokenKey, v)
+	}
+	return b, nil
+}
+
+// httpStatusKey is the annotation on exceptions that specifies the HTTP
+// status code with which they are reported by generated HTTP handlers.
+const httpStatusKey = "http.status"
+
+// lazyArgsKey is the annotation on functions which receive their arguments
+// as a thriftrpc.ArgsReader in the code generated by --procedures.
+const lazyArgsKey = "go.lazy_args"
+
+// aliasKey is the annotation on functions which lists their former names,
+// as comma-separated "Service::method" pairs, under which the code
+// generated by --procedures and --router also serves them.
+const aliasKey = "thrift.alias"
+
+// functionHTTPHandler generates a function that builds a thrifthttp.Function
+// for the given Thrift function if the HTTPHandlers option was passed.
+func functionHTTPHandler(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
+	if !checkHTTPHandlers(g) {
+		return nil
+	}
+
+	type exception struct {
+		Name   string
+		Status int
+	}
+
+	var exceptions []exception
+	if f.ResultSpec != nil {
+		for _, e := range f.ResultSpec.Exceptions {
+			status, err := exceptionHTTPStatus(e.Type)
+			if err != nil {
+				return err
+			}
+			exceptions = append(exceptions, exception{Name: e.Name, Status: status})
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$f := .Function>
+		<$prefix := namePrefix .Service $f>
+
+		<$context := import "context">
+		<$thrifthttp := import "go.uber.org/thriftrw/thrifthttp">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$impl := newVar "impl">
+		<$ctx := newVar "ctx">
+		<$body := newVar "body">
+		<$args := newVar "args">
+		<$success := newVar "success">
+		<$result := newVar "result">
+		<$status := newVar "status">
+
+		// <$prefix>HTTPHandler returns a thrifthttp.Function that serves the
+		// <.Service.Name>.<$f.Name> function over HTTP at
+		// "/<.Service.Name>/<$f.MethodName>" with the given implementation.
+		func <$prefix>HTTPHandler(<$impl> func(<$context>.Context, <range $f.ArgsSpec>
+			<- if .Required><typeReference .Type><else><typeReferencePtr .Type><end>, <end>)
+			<- if $f.OneWay> error
+			<- else if $f.ResultSpec.ReturnType> (<typeReference $f.ResultSpec.ReturnType>, error)
+			<- else> error
+			<- end>) <$thrifthttp>.Function {
+			return <$thrifthttp>.Function{
+				Path: "/<.Service.Name>/<$f.MethodName>",
+				<if $f.OneWay ->
+					OneWay: true,
+				<end ->
+				Call: func(<$ctx> <$context>.Context, <$body> <$wire>.Value) (<$thrifthttp>.Response, error) {
+					var <$args> <$prefix>Args
+					if err := <$args>.FromWire(<$body>); err != nil {
+						return <$thrifthttp>.Response{}, &<$thrifthttp>.ArgumentsError{Err: err}
+					}
+
+					<$call := printf "%v(%v, " $impl $ctx>
+					<- if $f.OneWay ->
+						return <$thrifthttp>.Response{}, <$call>
+							<- range $f.ArgsSpec><$args>.<goCase .Name>, <end>)
+					<- else ->
+						<if $f.ResultSpec.ReturnType ->
+							<$success>, err := <$call>
+								<- range $f.ArgsSpec><$args>.<goCase .Name>, <end>)
+							<$result>, err := <$prefix>Helper.WrapResponse(<$success>, err)
+						<- else ->
+							<$result>, err := <$prefix>Helper.WrapResponse(<$call>
+								<- range $f.ArgsSpec><$args>.<goCase .Name>, <end>))
+						<- end>
+						if err != nil {
+							return <$thrifthttp>.Response{}, err
+						}
+
+						<if .Exceptions ->
+							var <$status> int
+							switch {
+							<range .Exceptions ->
+								case <$result>.<goCase .Name> != nil:
+									<$status> = <.Status>
+							<end ->
+							}
+							return <$thrifthttp>.Response{Result: <$result>, Status: <$status>}, nil
+						<- else ->
+							return <$thrifthttp>.Response{Result: <$result>}, nil
+						<- end>
+					<- end>
+				},
+			}
+		}
+		`,
+		struct {
+			Service    *compile.ServiceSpec
+			Function   *compile.FunctionSpec
+			Exceptions []exception
+		}{
+			Service:    s,
+			Function:   f,
+			Exceptions: exceptions,
+		},
+		TemplateFunc("namePrefix", functionNamePrefix))
+}
+
+// exceptionHTTPStatus returns the HTTP status code for the given exception
+// type as specified by its http.status annotation. Exceptions without the
+// annotation are reported with status 500.
+func exceptionHTTPStatus(t compile.TypeSpec) (int, error) {
+	v, ok := t.ThriftAnnotations()[httpStatusKey]
+	if !ok {
+		return http.StatusInternalServerError, nil
+	}
+
+	status, err := strconv.Atoi(v)
+	if err != nil || status < 100 || status > 599 {
+		return 0, fmt.Errorf(
+			"invalid %v annotation on %v: %q is not an HTTP status code",
+			httpStatusKey, t.ThriftName(), v)
+	}
+	return status, nil
+}
+
+// serviceProcedures generates an interface for implementations of the given
+// service, and a function that builds thriftrpc.Procedures backed by it, if
+// the Procedures option was passed.
+func serviceProcedures(g Generator, s *compile.ServiceSpec) error {
+	var parent string
+	if s.Parent != nil {
+		var err error
+		parent, err = lookupServiceName(g, s.Parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	type procedureFunction struct {
+		*compile.FunctionSpec
+
+		// LazyArgs is true if the function receives a
+		// thriftrpc.ArgsReader instead of its decoded arguments.
+		LazyArgs bool
+
+		// Aliases are the former names of the function.
+		Aliases []string
+	}
+
+	functions := make([]procedureFunction, 0, len(s.Functions))
+	for _, name := range sortStringKeys(s.Functions) {
+		f := s.Functions[name]
+		lazy, err := lazyArgs(f)
+		if err != nil {
+			return err
+		}
+		aliases, err := functionAliases(f)
+		if err != nil {
+			return err
+		}
+		functions = append(functions, procedureFunction{
+			FunctionSpec: f,
+			LazyArgs:     lazy,
+			Aliases:      aliases,
+		})
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$svc := goCase .Service.Name>
+
+		<$context := import "context">
+		<$thriftrpc := import "go.uber.org/thriftrw/thriftrpc">
+
+		<$impl := newVar "impl">
+		<$ctx := newVar "ctx">
+		<$body := newVar "body">
+		<$args := newVar "args">
+		<$success := newVar "success">
+		<$result := newVar "result">
+		<$procs := newVar "procs">
+
+		// <$svc>_Interface is implemented by servers of the <.Service.Name>
+		// service.
+		<with deprecatedDoc (sourceDoc .Service.Doc .Service.File .Service.Line) .Service.Annotations ->
+		//
+		<formatDoc .>
+		<- end ->
+		type <$svc>_Interface interface {
+			<- if .Parent>
+				<.Parent>_Interface
+			<end>
+			<range .Functions>
+				<- $params := newNamespace>
+				<formatDoc (deprecatedDoc (sourceDoc .Doc $.Service.File .Line) .Annotations)><functionName .FunctionSpec>(<$params.NewName "ctx"> <$context>.Context, <if .LazyArgs>
+					<- $params.NewName "args"> *<$thriftrpc>.ArgsReader<else><range .ArgsSpec>
+					<- $params.NewName .Name> <if .Required><typeReference .Type><else><typeReferencePtr .Type><end>, <end><end>)
+					<- if .OneWay> error
+					<- else if .ResultSpec.ReturnType> (<typeReference .ResultSpec.ReturnType>, error)
+					<- else> error
+					<- end>
+			<end>
+		}
+
+		// <$svc>_Procedures returns a thriftrpc.Procedure for each function
+		// of the <.Service.Name> service, including functions inherited from
+		// its parent, served by the given implementation.
+		func <$svc>_Procedures(<$impl> <$svc>_Interface) []<$thriftrpc>.Procedure {
+			<$procs> := []<$thriftrpc>.Procedure{
+				<range $f := .Functions ->
+				<- $prefix := namePrefix $.Service $f.FunctionSpec ->
+				<- $call := printf "%v.%v(%v, " $impl (functionName $f.FunctionSpec) $ctx ->
+				{
+					Name: "<$.Service.Name>::<$f.MethodName>",
+					<if $f.Aliases ->
+						Aliases: []string{<range $i, $a := $f.Aliases><if $i>, <end><printf "%q" $a><end>},
+					<end ->
+					<if $f.OneWay ->
+						OneWay: true,
+					<end ->
+					<if $f.LazyArgs ->
+						<- $call = printf "%v%v" $call $args ->
+					StreamHandler: func(<$ctx> <$context>.Context, <$args> *<$thriftrpc>.ArgsReader) (<$thriftrpc>.Response, error) {
+					<- else ->
+						<- $wire := import "go.uber.org/thriftrw/wire" ->
+					Handler: func(<$ctx> <$context>.Context, <$body> <$wire>.Value) (<$thriftrpc>.Response, error) {
+						var <$args> <$prefix>Args
+						if err := <$args>.FromWire(<$body>); err != nil {
+							return <$thriftrpc>.Response{}, &<$thriftrpc>.ArgumentsError{Err: err}
+						}
+						<range $f.ArgsSpec>
+							<- $call = printf "%v%v.%v, " $call $args (goCase .Name) ->
+						<end>
+					<end ->
+						<if $f.OneWay ->
+							return <$thriftrpc>.Response{}, <$call>)
+						<- else ->
+							<if $f.ResultSpec.ReturnType ->
+								<$success>, err := <$call>)
+								<$result>, err := <$prefix>Helper.WrapResponse(<$success>, err)
+							<- else ->
+								<$result>, err := <$prefix>Helper.WrapResponse(<$call>))
+							<- end>
+							if err != nil {
+								return <$thriftrpc>.Response{}, err
+							}
+
+							return <$thriftrpc>.Response{
+								Body: <$result>,
+								<- if $f.ResultSpec.Exceptions>
+									IsApplicationError: <range $i, $e := $f.ResultSpec.Exceptions>
+										<- if $i> || <end><$result>.<goCase $e.Name> != nil<end>,
+								<- end>
+							}, nil
+						<- end>
+					},
+				},
+				<end>
+			}
+			<- if .Parent>
+				<$procs> = append(<$procs>, <.Parent>_Procedures(<$impl>)...)
+			<- end>
+			return <$procs>
+		}
+		`,
+		struct {
+			Service   *compile.ServiceSpec
+			Parent    string
+			Functions []procedureFunction
+		}{
+			Service:   s,
+			Parent:    parent,
+			Functions: functions,
+		},
+		TemplateFunc("namePrefix", functionNamePrefix))
+}
+
+// lazyArgs reports whether the given function has the go.lazy_args
+// annotation.
+func lazyArgs(f *compile.FunctionSpec) (bool, error) {
+	v, ok := f.Annotations[lazyArgsKey]
+	if !ok {
+		return false, nil
+	}
+
+	lazy, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf(
+			"invalid %v annotation on %v: %q is not a boolean", lazyArgsKey, f.Name, v)
+	}
+	return lazy, nil
+}
+
+// functionAliases returns the former names of the given function from its
+// thrift.alias annotation.
+func functionAliases(f *compile.FunctionSpec) ([]string, error) {
+	v, ok := f.Annotations[aliasKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var aliases []string
+	seen := make(map[string]struct{})
+	for _, alias := range strings.Split(v, ",") {
+		alias = strings.TrimSpace(alias)
+		parts := strings.Split(alias, "::")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" ||
+			strings.Count(alias, ":") != 2 || strings.ContainsAny(alias, " \t") {
+			return nil, fmt.Errorf(
+				`invalid %v annotation on %v: %q is not of the form "Service::method"`,
+				aliasKey, f.Name, alias)
+		}
+		if _, ok := seen[alias]; ok {
+			return nil, fmt.Errorf(
+				"invalid %v annotation on %v: %q is listed more than once",
+				aliasKey, f.Name, alias)
+		}
+		seen[alias] = struct{}{}
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+// lookupServiceName returns the qualified Go name of the given service,
+// importing the package which declares it if necessary.
+func lookupServiceName(g Generator, s *compile.ServiceSpec) (string, error) {
+	gen, ok := g.(*generator)
+	if !ok {
+		return goCase(s.Name), nil
+	}
+
+	importPath, err := gen.thriftImporter.Package(s.File)
+	if err != nil {
+		return "", err
+	}
+
+	name := goCase(s.Name)
+	if importPath != gen.ImportPath {
+		name = gen.Import(importPath) + "." + name
+	}
+	return name, nil
+}
+
 func functionNamePrefix(s *compile.ServiceSpec, f *compile.FunctionSpec) string {
-	return fmt.Sprintf("%s_%s_", goCase(s.Name), goCase(f.Name))
+	return fmt.Sprintf("%s_%s_", goCase(s.Name), functionName(f))
+}
+
+// functionName returns the name of the Go method generated for the given
+// function. go.name annotations on functions are validated by resolveNames
+// before any code is generated.
+func functionName(f *compile.FunctionSpec) string {
+	if name, err := goName(f); err == nil {
+		return name
+	}
+	return goCase(f.Name)
 }
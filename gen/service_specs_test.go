@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	ss "go.uber.org/thriftrw/gen/internal/tests/service-specs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceSpecs(t *testing.T) {
+	assert.Len(t, ss.ServiceSpecs, 2)
+	for name, spec := range ss.ServiceSpecs {
+		assert.Equal(t, name, spec.Name)
+	}
+
+	get, ok := ss.StoreServiceSpec.FunctionByName("get")
+	require.True(t, ok, "get must be described")
+	assert.Equal(t, "Entry", get.Result)
+	assert.Equal(t, "exceptions.DoesNotExistException", get.Exceptions[0].Type)
+
+	_, ok = ss.CachingStoreServiceSpec.FunctionByName("get")
+	assert.False(t, ok, "inherited functions must not be described")
+}
+
+func TestServiceSpecsJSON(t *testing.T) {
+	got, err := json.Marshal(ss.CachingStoreServiceSpec)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "CachingStore",
+		"file": "service-specs.thrift",
+		"extends": "Store",
+		"functions": [
+			{
+				"name": "invalidate",
+				"args": [{"id": 1, "name": "key", "type": "string"}],
+				"annotations": {"idempotent": "true"}
+			}
+		]
+	}`, string(got))
+
+	got, err = json.Marshal(ss.StoreServiceSpec)
+	require.NoError(t, err)
+
+	var desc struct {
+		Annotations map[string]string
+		Functions   []struct {
+			Name   string
+			OneWay bool
+			Result string
+		}
+	}
+	require.NoError(t, json.Unmarshal(got, &desc))
+	assert.Equal(t, map[string]string{"owner": "storage-team", "team": "storage"}, desc.Annotations)
+	require.Len(t, desc.Functions, 4)
+	assert.Equal(t, "map<string, list<Entry>>", desc.Functions[2].Result)
+	assert.True(t, desc.Functions[3].OneWay, "touch must be oneway")
+	assert.Empty(t, desc.Functions[1].Result, "put returns void")
+}
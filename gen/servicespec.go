@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// serviceSpecs generates a <Name>ServiceSpec variable describing each of the
+// given services, and a ServiceSpecs map holding all of them keyed by their
+// Thrift names.
+func serviceSpecs(g Generator, i thriftPackageImporter, services map[string]*compile.ServiceSpec) error {
+	var names []string
+	for _, name := range sortStringKeys(services) {
+		s := services[name]
+		if err := serviceSpec(g, i, s); err != nil {
+			return wrapGenerateError(s.Name, err)
+		}
+		names = append(names, s.Name)
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$reflect := import "go.uber.org/thriftrw/thriftreflect">
+
+		// ServiceSpecs describes the services defined in this package, keyed
+		// by their names in the Thrift file.
+		var ServiceSpecs = map[string]*<$reflect>.ServiceSpec{
+			<range .>"<.>": <.>ServiceSpec,
+			<end>
+		}
+		`, names)
+	return wrapGenerateError("service specs", err)
+}
+
+func serviceSpec(g Generator, i thriftPackageImporter, s *compile.ServiceSpec) error {
+	file, err := i.RelativeThriftFilePath(s.File)
+	if err != nil {
+		return err
+	}
+
+	var extends string
+	if s.Parent != nil {
+		extends = qualifiedThriftName(s.Parent.Name, s.Parent.File, s.File)
+	}
+
+	functions := make([]*compile.FunctionSpec, 0, len(s.Functions))
+	for _, name := range sortStringKeys(s.Functions) {
+		functions = append(functions, s.Functions[name])
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$reflect := import "go.uber.org/thriftrw/thriftreflect">
+
+		<define "annotations">
+			<- if . ->
+			Annotations: map[string]string{
+				<range $k, $v := .>
+					<- printf "%q" $k>: <printf "%q" $v>,
+				<end>
+			},
+			<- end>
+		<- end>
+
+		<define "params">
+			<- range . ->
+			{
+				ID: <.ID>,
+				Name: "<.Name>",
+				Type: <printf "%q" (thriftType .Type)>,
+				<- if .Required>
+				Required: true,
+				<- end>
+				<template "annotations" .Annotations>
+			},
+			<end>
+		<- end>
+
+		// <.Spec.Name>ServiceSpec describes the <.Spec.Name> service.
+		var <.Spec.Name>ServiceSpec = &<$reflect>.ServiceSpec{
+			Name: "<.Spec.Name>",
+			File: <printf "%q" .File>,
+			<- if .Extends>
+			Extends: "<.Extends>",
+			<- end>
+			Functions: []<$reflect>.FunctionSpec{
+				<range .Functions ->
+				{
+					Name: "<.Name>",
+					<- if .OneWay>
+					OneWay: true,
+					<- end>
+					Args: []<$reflect>.ParamSpec{
+						<template "params" .ArgsSpec>
+					},
+					<- with .ResultSpec>
+						<- if .ReturnType>
+						Result: <printf "%q" (thriftType .ReturnType)>,
+						<- end>
+						<- if .Exceptions>
+						Exceptions: []<$reflect>.ParamSpec{
+							<template "params" .Exceptions>
+						},
+						<- end>
+					<- end>
+					<template "annotations" .Annotations>
+				},
+				<end>
+			},
+			<template "annotations" .Spec.Annotations>
+		}
+		`,
+		struct {
+			Spec      *compile.ServiceSpec
+			File      string
+			Extends   string
+			Functions []*compile.FunctionSpec
+		}{Spec: s, File: file, Extends: extends, Functions: functions},
+		TemplateFunc("thriftType", func(t compile.TypeSpec) string {
+			return thriftTypeName(t, s.File)
+		}),
+	)
+}
+
+// thriftTypeName returns the name by which the given type is referred to in
+// the given Thrift file.
+func thriftTypeName(t compile.TypeSpec, file string) string {
+	switch spec := t.(type) {
+	case *compile.MapSpec:
+		return "map<" + thriftTypeName(spec.KeySpec, file) + ", " + thriftTypeName(spec.ValueSpec, file) + ">"
+	case *compile.ListSpec:
+		return "list<" + thriftTypeName(spec.ValueSpec, file) + ">"
+	case *compile.SetSpec:
+		return "set<" + thriftTypeName(spec.ValueSpec, file) + ">"
+	default:
+		return qualifiedThriftName(t.ThriftName(), t.ThriftFile(), file)
+	}
+}
+
+// qualifiedThriftName qualifies the name of a type or service defined in
+// the Thrift file def with the name of that file if it was included by the
+// Thrift file from.
+func qualifiedThriftName(name, def, from string) string {
+	if def == "" || def == from {
+		return name
+	}
+	return strings.TrimSuffix(filepath.Base(def), ".thrift") + "." + name
+}
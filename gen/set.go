@@ -319,6 +319,76 @@ func (s *setGenerator) Equals(g Generator, spec *compile.SetSpec) (string, error
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+func (s *setGenerator) Copy(g Generator, spec *compile.SetSpec) (string, error) {
+	name := copyFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$setType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$setType>) <$setType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$i := newVar "i">
+				<$x := newVar "x">
+				<$o> := make(<$setType>, len(<$v>))
+				<if setUsesMap .Spec ->
+					for <$x> := range <$v> {
+						<$o>[<$x>] = struct{}{}
+					}
+				<- else ->
+					for <$i>, <$x> := range <$v> {
+						<$o>[<$i>] = <copy .Spec.ValueSpec $x>
+					}
+				<- end>
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (s *setGenerator) Hash(g Generator, spec *compile.SetSpec) (string, error) {
+	name := hashFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <typeReference .Spec>) uint64 {
+				<$u := newVar "u">
+				<$h := newVar "h">
+				<$x := newVar "x">
+				var <$u> <$thrifthash>.Unordered
+				<if setUsesMap .Spec ->
+					for <$x> := range <$v> {
+				<- else ->
+					for _, <$x> := range <$v> {
+				<- end>
+					<$h> := <$thrifthash>.New()
+					<hash .Spec.ValueSpec $h $x>
+					<$u>.Add(<$h>.Sum64())
+				}
+				return <$u>.Sum64()
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 func (s *setGenerator) zapMarshaler(
 	g Generator,
 	root *compile.SetSpec,
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/setters"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetters(t *testing.T) {
+	var s ts.Shape
+	s.SetName("square")
+	s.SetLabel("a square")
+	s.SetArea(16)
+	s.SetColor(ts.ColorGreen)
+	s.SetOrigin(&ts.Point{X: 1, Y: 2})
+	s.SetCenter(&ts.Point{X: 3, Y: 4})
+	s.SetPoints([]*ts.Point{{X: 1, Y: 2}})
+	s.SetAttributes(map[string]string{"sides": "4"})
+	s.SetData([]byte("hello"))
+
+	assert.Equal(t, ts.Shape{
+		Name:       "square",
+		Label:      ptr.String("a square"),
+		Area:       ptr.Int64(16),
+		Color:      ts.ColorGreen.Ptr(),
+		Origin:     &ts.Point{X: 1, Y: 2},
+		Center:     &ts.Point{X: 3, Y: 4},
+		Points:     []*ts.Point{{X: 1, Y: 2}},
+		Attributes: map[string]string{"sides": "4"},
+		Data:       []byte("hello"),
+	}, s)
+
+	// Setters mirror getters.
+	assert.Equal(t, "a square", s.GetLabel())
+	assert.Equal(t, int64(16), s.GetArea())
+	assert.Equal(t, ts.ColorGreen, s.GetColor())
+
+	var v ts.Value
+	v.SetText("hello")
+	assert.True(t, v.IsSetText())
+	assert.Equal(t, "hello", v.GetText())
+}
+
+func TestSettersCopyValues(t *testing.T) {
+	label := "before"
+	var s ts.Shape
+	s.SetLabel(label)
+	label = "after"
+	assert.Equal(t, "before", s.GetLabel(), "optional values must be copied")
+}
+
+func TestSettersConflict(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Foo",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "bar", Type: &compile.StringSpec{}},
+			{ID: 2, Name: "setBar", Type: &compile.StringSpec{}},
+		},
+	}
+	err := structure(NewGenerator(&GeneratorOptions{Setters: true}), spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"SetBar"`)
+}
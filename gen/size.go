@@ -0,0 +1,363 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Sizes of the parts of the Binary protocol encoding which precede values.
+const (
+	sizeFieldHeader = 3 // type:1 id:2
+	sizeListHeader  = 5 // vtype:1 length:4
+	sizeMapHeader   = 6 // ktype:1 vtype:1 length:4
+	sizeStructEnd   = 1 // stop:1
+)
+
+// hasEncodedSize returns true if an EncodedSize method is generated for
+// the given type. It is not for structs with a field named EncodedSize or
+// with encrypted fields, whose length depends on the thriftcrypt.Provider,
+// nor for types which hold values of these structs.
+func hasEncodedSize(spec compile.TypeSpec) bool {
+	return canSize(spec, make(map[*compile.StructSpec]struct{}))
+}
+
+func canSize(spec compile.TypeSpec, seen map[*compile.StructSpec]struct{}) bool {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return canSize(s.Target, seen)
+	case *compile.ListSpec:
+		return canSize(s.ValueSpec, seen)
+	case *compile.SetSpec:
+		return canSize(s.ValueSpec, seen)
+	case *compile.MapSpec:
+		return canSize(s.KeySpec, seen) && canSize(s.ValueSpec, seen)
+	case *compile.StructSpec:
+		if _, ok := seen[s]; ok {
+			// Recursive references are sized if the rest of the struct is.
+			return true
+		}
+		seen[s] = struct{}{}
+		for _, f := range s.Fields {
+			if encryptKey(f) != "" {
+				return false
+			}
+			if name, err := goName(f); err != nil || name == "EncodedSize" {
+				return false
+			}
+			if !canSize(f.Type, seen) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EncodedSize generates an EncodedSize method for the struct, union, or
+// exception represented by this field group which returns the length of
+// its encoding with the Binary protocol.
+func (f fieldGroupGenerator) EncodedSize(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$n := newVar "n">
+		// EncodedSize returns the number of bytes in the encoding of this
+		// <.Name> with the Binary protocol, without encoding it. The result
+		// is only meaningful if the <.Name> can be encoded.
+		func (<$v> *<.Name>) EncodedSize() int {
+			if <$v> == nil {
+				return 0
+			}
+
+			<$n> := <sizeStructEnd>
+			<range .Fields>
+				<- $f := $.Presence.Ref $v . ->
+				<- if .Required ->
+					<$n> += <sizeFieldHeader> + <size .Type $f>
+				<- else if omitDefault . ->
+					if <$f> != nil && !<isDefault . $f> {
+						<$n> += <sizeFieldHeader> + <sizePtr .Type $f>
+					}
+				<- else if and (isNotNil .Default) (not (isFixedSize .Type)) ->
+					<- $fval := printf "%s%s" $v (goName .) ->
+					<$fval> := <$f>
+					if <$fval> == nil {
+						<$fval> = <constantValuePtr .Default .Type>
+					}
+					<$n> += <sizeFieldHeader> + <sizePtr .Type $fval>
+				<- else if isNotNil .Default ->
+					<$n> += <sizeFieldHeader> + <sizePtr .Type $f>
+				<- else ->
+					if <$f> != nil {
+						<$n> += <sizeFieldHeader> + <sizePtr .Type $f>
+					}
+				<- end>
+			<end ->
+			<if .PreserveUnknownFields ->
+				<$n> += <$v>.unknownFields.Size()
+			<end ->
+			return <$n>
+		}
+		`, f,
+		TemplateFunc("sizeStructEnd", func() int { return sizeStructEnd }),
+		TemplateFunc("sizeFieldHeader", func() int { return sizeFieldHeader }),
+		TemplateFunc("size", curryGenerator(sizeValue, g)),
+		TemplateFunc("sizePtr", curryGenerator(sizeValuePtr, g)),
+		TemplateFunc("isFixedSize", isFixedSize),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("omitDefault", f.omitDefault),
+		TemplateFunc("isDefault", isDefault),
+	)
+}
+
+// sizeEnum generates an EncodedSize method for the given enum.
+func sizeEnum(g Generator, spec *compile.EnumSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		// EncodedSize returns the number of bytes in the encoding of a
+		// <typeName .> with the Binary protocol.
+		func (<typeName .>) EncodedSize() int {
+			return <size .>
+		}
+		`,
+		spec,
+		TemplateFunc("size", func(spec compile.TypeSpec) (string, error) {
+			return sizeValue(g, spec, "")
+		}),
+	)
+}
+
+// sizeTypedef generates an EncodedSize method for the given typedef.
+func sizeTypedef(g Generator, spec *compile.TypedefSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		// EncodedSize returns the number of bytes in the encoding of this
+		// <typeName .> with the Binary protocol, without encoding it.
+		func (<$v> <typeReference .>) EncodedSize() int {
+			return <size .Target (printf "(%v)(%v)" (typeReference .Target) $v)>
+		}
+		`,
+		spec,
+		TemplateFunc("size", curryGenerator(sizeValue, g)),
+	)
+}
+
+// fixedSize returns the length of the encoding of all values of the given
+// type, if it is the same for all of them.
+func fixedSize(spec compile.TypeSpec) (int, bool) {
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec, *compile.I8Spec:
+		return 1, true
+	case *compile.I16Spec:
+		return 2, true
+	case *compile.I32Spec, *compile.EnumSpec:
+		return 4, true
+	case *compile.I64Spec, *compile.DoubleSpec:
+		return 8, true
+	case *compile.UUIDSpec:
+		// length:4 bytes:16
+		return 20, true
+	default:
+		return 0, false
+	}
+}
+
+func isFixedSize(spec compile.TypeSpec) bool {
+	_, ok := fixedSize(spec)
+	return ok
+}
+
+// sizeValue returns an expression which evaluates to the length of the
+// encoding of v, a value of the given type, with the Binary protocol.
+func sizeValue(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if n, ok := fixedSize(spec); ok {
+		return strconv.Itoa(n), nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.StringSpec, *compile.BinarySpec:
+		// length:4 bytes
+		return fmt.Sprintf("4 + len(%s)", v), nil
+	case *compile.TypedefSpec:
+		if !isBoundTypedef(s) {
+			return v + ".EncodedSize()", nil
+		}
+		var t typedefGenerator
+		name, err := t.boundHelper(g, fmt.Sprintf("_%s_EncodedSize", g.MangleType(s)), s,
+			`
+			<$v := newVar "v">
+			<$x := newVar "x">
+			func <.Name>(<$v> <typeReference .Spec>) int {
+				<$x>, _ := <.To>(<$v>)
+				return <size .Spec.Target $x>
+			}
+			`,
+			TemplateFunc("size", curryGenerator(sizeValue, g)))
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.ListSpec, *compile.SetSpec, *compile.MapSpec:
+		name, err := sizeContainer(g, spec)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	default:
+		// Structs, unions, and exceptions
+		return v + ".EncodedSize()", nil
+	}
+}
+
+// sizeValuePtr is the same as sizeValue except v is expected to be a
+// reference to a value of the given type.
+func sizeValuePtr(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.StringSpec:
+		return sizeValue(g, spec, "*"+v)
+	case *compile.TypedefSpec:
+		if isBoundTypedef(s) {
+			return sizeValue(g, spec, "*"+v)
+		}
+	}
+	// Everything else is of a fixed size, a reference type, or has an
+	// EncodedSize method which dereferences it.
+	return sizeValue(g, spec, v)
+}
+
+// Bodies of the functions generated by sizeContainer for lists and sets
+// whose items are of a fixed size, and for those stored in slices.
+const (
+	sizeFixedItems = `return <sizeListHeader> + len(<$v>)*<size .Spec.ValueSpec "">`
+	sizeSliceItems = `
+		<$n> := <sizeListHeader>
+		for _, <$x> := range <$v> {
+			<$n> += <size .Spec.ValueSpec $x>
+		}
+		return <$n>
+	`
+)
+
+// sizeContainer generates a function which returns the length of the
+// encoding of lists, sets, or maps of the given type, and returns its name.
+// Items of a fixed size are not visited.
+func sizeContainer(g Generator, spec compile.TypeSpec) (string, error) {
+	var body string
+	switch s := spec.(type) {
+	case *compile.ListSpec:
+		if isFixedSize(s.ValueSpec) {
+			body = sizeFixedItems
+		} else {
+			body = sizeSliceItems
+		}
+	case *compile.SetSpec:
+		switch {
+		case isFixedSize(s.ValueSpec):
+			body = sizeFixedItems
+		case setUsesMap(s):
+			body = `
+				<$n> := <sizeListHeader>
+				for <$x> := range <$v> {
+					<$n> += <size .Spec.ValueSpec $x>
+				}
+				return <$n>
+			`
+		default:
+			body = sizeSliceItems
+		}
+	case *compile.MapSpec:
+		kfixed, vfixed := isFixedSize(s.KeySpec), isFixedSize(s.ValueSpec)
+		switch {
+		case isMapContainer(s) && kfixed && vfixed:
+			body = `
+				if <$v> == nil {
+					return <sizeMapHeader>
+				}
+				return <sizeMapHeader> + <$v>.Len()*(<size .Spec.KeySpec ""> + <size .Spec.ValueSpec "">)
+			`
+		case isMapContainer(s):
+			body = `
+				<$n> := <sizeMapHeader>
+				if <$v> != nil {
+					_ = <$v>.ForEach(func(<$k> <typeReference .Spec.KeySpec>, <$x> <typeReference .Spec.ValueSpec>) error {
+						<$n> += <size .Spec.KeySpec $k> + <size .Spec.ValueSpec $x>
+						return nil
+					})
+				}
+				return <$n>
+			`
+		case kfixed && vfixed:
+			body = `return <sizeMapHeader> + len(<$v>)*(<size .Spec.KeySpec ""> + <size .Spec.ValueSpec "">)`
+		case !mapUsesMap(s):
+			body = `
+				<$n> := <sizeMapHeader>
+				for _, <$x> := range <$v> {
+					<$n> += <size .Spec.KeySpec (printf "%s.Key" $x)> + <size .Spec.ValueSpec (printf "%s.Value" $x)>
+				}
+				return <$n>
+			`
+		case kfixed:
+			body = `
+				<$n> := <sizeMapHeader> + len(<$v>)*<size .Spec.KeySpec "">
+				for _, <$x> := range <$v> {
+					<$n> += <size .Spec.ValueSpec $x>
+				}
+				return <$n>
+			`
+		case vfixed:
+			body = `
+				<$n> := <sizeMapHeader> + len(<$v>)*<size .Spec.ValueSpec "">
+				for <$k> := range <$v> {
+					<$n> += <size .Spec.KeySpec $k>
+				}
+				return <$n>
+			`
+		default:
+			body = `
+				<$n> := <sizeMapHeader>
+				for <$k>, <$x> := range <$v> {
+					<$n> += <size .Spec.KeySpec $k> + <size .Spec.ValueSpec $x>
+				}
+				return <$n>
+			`
+		}
+	}
+
+	name := fmt.Sprintf("_%s_EncodedSize", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+		<$v := newVar "v">
+		<$n := newVar "n">
+		<$k := newVar "k">
+		<$x := newVar "x">
+		func <.Name>(<$v> <typeReference .Spec>) int {
+			`+strings.TrimSpace(body)+`
+		}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("sizeListHeader", func() int { return sizeListHeader }),
+		TemplateFunc("sizeMapHeader", func() int { return sizeMapHeader }),
+		TemplateFunc("size", curryGenerator(sizeValue, g)),
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
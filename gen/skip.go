@@ -0,0 +1,206 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// goSkipKey is a Thrift annotation which leaves a field out of the generated
+// struct entirely. The field is ignored when decoding, like fields unknown to
+// the struct, and is never encoded.
+//
+//	struct User {
+//	    1: required string id
+//	    2: optional string ssn (go.skip)
+//	}
+const goSkipKey = "go.skip"
+
+// isSkipped returns true if the given field has the go.skip annotation. The
+// annotation may be specified without a value.
+func isSkipped(f *compile.FieldSpec) (bool, error) {
+	v, ok := f.Annotations[goSkipKey]
+	if !ok {
+		return false, nil
+	}
+	if v == "" {
+		return true, nil
+	}
+	skip, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %v annotation: %q is not a boolean", goSkipKey, v)
+	}
+	return skip, nil
+}
+
+// resolveSkipped removes the fields annotated with go.skip from the structs
+// of the given module and the modules it includes, so that code generation
+// never sees them. Values for these fields are dropped from constants and
+// default values.
+func resolveSkipped(m *compile.Module) error {
+	skipped := make(map[*compile.StructSpec]map[string]struct{})
+	err := m.Walk(func(m *compile.Module) error {
+		for _, name := range sortStringKeys(m.Types) {
+			spec, ok := m.Types[name].(*compile.StructSpec)
+			if !ok {
+				continue
+			}
+			names, err := removeSkippedFields(spec)
+			if err != nil {
+				return generateError{Name: m.ThriftPath, Reason: wrapGenerateError(name, err)}
+			}
+			if len(names) > 0 {
+				skipped[spec] = names
+			}
+		}
+
+		for _, serviceName := range sortStringKeys(m.Services) {
+			s := m.Services[serviceName]
+			for _, name := range sortStringKeys(s.Functions) {
+				if err := checkNotSkippedFunction(s.Functions[name]); err != nil {
+					return generateError{
+						Name:   m.ThriftPath,
+						Reason: wrapGenerateError(serviceName+"."+name, err),
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil || len(skipped) == 0 {
+		return err
+	}
+
+	return m.Walk(func(m *compile.Module) error {
+		for _, c := range m.Constants {
+			dropSkippedValues(skipped, c.Value, c.Type)
+		}
+		for _, t := range m.Types {
+			if spec, ok := t.(*compile.StructSpec); ok {
+				dropSkippedDefaults(skipped, spec.Fields)
+			}
+		}
+		for _, s := range m.Services {
+			for _, f := range s.Functions {
+				dropSkippedDefaults(skipped, compile.FieldGroup(f.ArgsSpec))
+			}
+		}
+		return nil
+	})
+}
+
+// removeSkippedFields removes the fields annotated with go.skip from the
+// given struct and returns their names.
+func removeSkippedFields(spec *compile.StructSpec) (map[string]struct{}, error) {
+	var names map[string]struct{}
+	fields := make(compile.FieldGroup, 0, len(spec.Fields))
+	for _, f := range spec.Fields {
+		skip, err := isSkipped(f)
+		if err != nil {
+			return nil, wrapGenerateError(f.ThriftName(), err)
+		}
+		if !skip {
+			fields = append(fields, f)
+			continue
+		}
+		if f.Required {
+			return nil, fmt.Errorf("field %q cannot be both required and skipped with %v", f.Name, goSkipKey)
+		}
+		if names == nil {
+			names = make(map[string]struct{})
+		}
+		names[f.Name] = struct{}{}
+	}
+	spec.Fields = fields
+	return names, nil
+}
+
+// checkNotSkippedFunction returns an error if any of the arguments or
+// exceptions of the given function are annotated with go.skip: they are part
+// of the signature of the function and cannot be left out.
+func checkNotSkippedFunction(f *compile.FunctionSpec) error {
+	fields := compile.FieldGroup(f.ArgsSpec)
+	if f.ResultSpec != nil {
+		fields = append(fields[:len(fields):len(fields)], f.ResultSpec.Exceptions...)
+	}
+	for _, field := range fields {
+		if _, ok := field.Annotations[goSkipKey]; ok {
+			return fmt.Errorf(
+				"%v annotation on %q is only supported on the fields of structs, unions, and exceptions",
+				goSkipKey, field.Name)
+		}
+	}
+	return nil
+}
+
+func dropSkippedDefaults(skipped map[*compile.StructSpec]map[string]struct{}, fields compile.FieldGroup) {
+	for _, f := range fields {
+		if f.Default != nil {
+			dropSkippedValues(skipped, f.Default, f.Type)
+		}
+	}
+}
+
+// dropSkippedValues removes values for skipped fields from the structs in
+// the given constant value of the given type.
+func dropSkippedValues(skipped map[*compile.StructSpec]map[string]struct{}, v compile.ConstantValue, t compile.TypeSpec) {
+	switch v := v.(type) {
+	case *compile.ConstantStruct:
+		spec, ok := compile.RootTypeSpec(t).(*compile.StructSpec)
+		if !ok {
+			return
+		}
+		for name, fv := range v.Fields {
+			if _, ok := skipped[spec][name]; ok {
+				delete(v.Fields, name)
+				continue
+			}
+			if f, err := spec.Fields.FindByName(name); err == nil {
+				dropSkippedValues(skipped, fv, f.Type)
+			}
+		}
+
+	case compile.ConstantList:
+		if spec, ok := compile.RootTypeSpec(t).(*compile.ListSpec); ok {
+			for _, item := range v {
+				dropSkippedValues(skipped, item, spec.ValueSpec)
+			}
+		}
+
+	case compile.ConstantSet:
+		if spec, ok := compile.RootTypeSpec(t).(*compile.SetSpec); ok {
+			for _, item := range v {
+				dropSkippedValues(skipped, item, spec.ValueSpec)
+			}
+		}
+
+	case compile.ConstantMap:
+		if spec, ok := compile.RootTypeSpec(t).(*compile.MapSpec); ok {
+			for _, pair := range v {
+				dropSkippedValues(skipped, pair.Key, spec.KeySpec)
+				dropSkippedValues(skipped, pair.Value, spec.ValueSpec)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tsk "go.uber.org/thriftrw/gen/internal/tests/skip"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestSkippedFieldsAreNotGenerated(t *testing.T) {
+	tests := []struct {
+		typ     reflect.Type
+		skipped []string
+		kept    []string
+	}{
+		{reflect.TypeOf(tsk.Point{}), []string{"Label"}, []string{"X", "Y"}},
+		{reflect.TypeOf(tsk.User{}), []string{"Ssn", "Visited"}, []string{"ID", "Name", "Home", "Email"}},
+		{reflect.TypeOf(tsk.Contact{}), []string{"Address"}, []string{"Phone", "Email"}},
+		{reflect.TypeOf(tsk.NotFound{}), []string{"Trace"}, []string{"Message"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.Name(), func(t *testing.T) {
+			for _, name := range tt.skipped {
+				_, ok := tt.typ.FieldByName(name)
+				assert.False(t, ok, "%v must not be generated", name)
+			}
+			for _, name := range tt.kept {
+				_, ok := tt.typ.FieldByName(name)
+				assert.True(t, ok, "%v must be generated", name)
+			}
+		})
+	}
+}
+
+func TestSkippedFieldsAreIgnoredOnDecode(t *testing.T) {
+	w := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("1")},
+		{ID: 3, Value: wire.NewValueString("123-45-6789")},
+		{ID: 4, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueDouble(1)},
+			{ID: 2, Value: wire.NewValueDouble(2)},
+			{ID: 3, Value: wire.NewValueString("home")},
+		}})},
+	}})
+
+	var u tsk.User
+	require.NoError(t, u.FromWire(w))
+	assert.Equal(t, tsk.User{ID: "1", Home: &tsk.Point{X: 1, Y: 2}}, u)
+
+	got, err := u.ToWire()
+	require.NoError(t, err)
+	for _, f := range got.GetStruct().Fields {
+		assert.NotEqual(t, int16(3), f.ID, "skipped fields must not be encoded")
+	}
+}
+
+func TestSkippedFieldsInConstants(t *testing.T) {
+	assert.Equal(t, &tsk.User{ID: "1", Home: &tsk.Point{X: 1, Y: 2}}, tsk.SomeUser)
+	assert.Equal(t, &tsk.Profile{
+		User: &tsk.User{ID: "1", Home: &tsk.Point{X: 1, Y: 2}},
+		Pins: []*tsk.Point{{X: 0, Y: 0}},
+	}, tsk.Default_Profile())
+
+	u := tsk.User{ID: "1", Email: ptr.String("a@example.com")}
+	assert.Equal(t, "a@example.com", u.GetEmail(), `go.skip = "false" must keep the field`)
+}
+
+func TestSkippedFieldErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc:    "required field",
+			src:     "struct S {\n1: required string a (go.skip)\n}\n",
+			wantErr: `field "a" cannot be both required and skipped with go.skip`,
+		},
+		{
+			desc:    "invalid value",
+			src:     "struct S {\n1: optional string a (go.skip = \"sometimes\")\n}\n",
+			wantErr: `invalid go.skip annotation: "sometimes" is not a boolean`,
+		},
+		{
+			desc:    "function argument",
+			src:     "service S {\nvoid f(1: string a (go.skip))\n}\n",
+			wantErr: `go.skip annotation on "a" is only supported on the fields of structs, unions, and exceptions`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot := t.TempDir()
+			path := filepath.Join(thriftRoot, "s.thrift")
+			require.NoError(t, os.WriteFile(path, []byte(tt.src), 0o644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     t.TempDir(),
+				PackagePrefix: "example.com/gen",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// sourceDoc returns doc with a line stating where in the given Thrift file
+// the documented definition was found, if --source-comments was passed.
+//
+// The file is reported relative to the Thrift root so that the output does
+// not depend on where it was generated.
+//
+//	// Foo is a thing.
+//	//
+//	// Source: services/foo.thrift:12
+func sourceDoc(g Generator, doc, file string, line int) string {
+	if !checkSourceComments(g) || file == "" {
+		return doc
+	}
+
+	if gen, ok := g.(*generator); ok && gen.thriftImporter != nil {
+		if rel, err := gen.thriftImporter.RelativeThriftFilePath(file); err == nil {
+			file = rel
+		}
+	}
+
+	source := fmt.Sprintf("Source: %v:%d", filepath.ToSlash(file), line)
+	if doc == "" {
+		return source
+	}
+	return doc + "\n\n" + source
+}
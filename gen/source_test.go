@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceDoc(t *testing.T) {
+	importer := thriftPackageImporter{ImportPrefix: "example.com/idl", ThriftRoot: "/idl"}
+
+	g := NewGenerator(&GeneratorOptions{Importer: importer, SourceComments: true})
+	assert.Equal(t, "Source: foo/bar.thrift:12", sourceDoc(g, "", "/idl/foo/bar.thrift", 12))
+	assert.Equal(t,
+		"Bar is a thing.\n\nSource: foo/bar.thrift:3",
+		sourceDoc(g, "Bar is a thing.", "/idl/foo/bar.thrift", 3))
+	assert.Equal(t, "Bar is a thing.", sourceDoc(g, "Bar is a thing.", "", 0),
+		"definitions without files must be left alone")
+
+	g = NewGenerator(&GeneratorOptions{Importer: importer})
+	assert.Equal(t, "Bar is a thing.", sourceDoc(g, "Bar is a thing.", "/idl/foo/bar.thrift", 3))
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// sqlKey is the annotation used on enums and typedefs to generate
+// implementations of sql.Scanner and driver.Valuer for them. Its value
+// selects how values are stored in the database.
+const sqlKey = "go.sql"
+
+const (
+	// sqlInt stores values as integers.
+	sqlInt = "int"
+
+	// sqlString stores values as strings.
+	sqlString = "string"
+)
+
+// sqlGenerator generates Scan and Value methods for enums and typedefs.
+type sqlGenerator struct {
+	Spec compile.TypeSpec
+
+	// How values are stored in the database: sqlInt or sqlString.
+	Repr string
+}
+
+// newSQLGenerator builds an sqlGenerator for the given enum or typedef. ok
+// is false if the type did not ask for Scan and Value methods.
+func newSQLGenerator(spec compile.TypeSpec) (_ sqlGenerator, ok bool, err error) {
+	repr, ok := spec.ThriftAnnotations()[sqlKey]
+	if !ok {
+		return sqlGenerator{}, false, nil
+	}
+
+	var allowed []string
+	switch t := spec.(type) {
+	case *compile.EnumSpec:
+		allowed = []string{sqlInt, sqlString}
+	case *compile.TypedefSpec:
+		switch compile.RootTypeSpec(t.Target).(type) {
+		case *compile.I64Spec:
+			allowed = []string{sqlInt}
+		case *compile.StringSpec:
+			allowed = []string{sqlString}
+		}
+	}
+
+	if len(allowed) == 0 {
+		return sqlGenerator{}, false, fmt.Errorf(
+			"%v annotation is only supported on enums and typedefs of i64 or string", sqlKey)
+	}
+
+	for _, a := range allowed {
+		if repr == a {
+			return sqlGenerator{Spec: spec, Repr: repr}, true, nil
+		}
+	}
+	return sqlGenerator{}, false, fmt.Errorf(
+		"invalid %v annotation: %q is not one of %q", sqlKey, repr, allowed)
+}
+
+func (s sqlGenerator) Generate(g Generator) error {
+	switch spec := s.Spec.(type) {
+	case *compile.EnumSpec:
+		return s.enum(g, spec)
+	case *compile.TypedefSpec:
+		return s.typedef(g, spec)
+	default:
+		panic(fmt.Sprintf("unexpected type %T", spec))
+	}
+}
+
+func (s sqlGenerator) enum(g Generator, spec *compile.EnumSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$driver := import "database/sql/driver">
+		<$fmt := import "fmt">
+		<$math := import "math">
+
+		<$name := goName .Spec>
+		<$v := newVar "v">
+		<$src := newVar "src">
+		<$x := newVar "x">
+		// Scan reads <$name> from a database column holding either its
+		// integer value or its name.
+		//
+		// This implements sql.Scanner.
+		func (<$v> *<$name>) Scan(<$src> interface{}) error {
+			switch <$x> := <$src>.(type) {
+			case int64:
+				if <$x> <">"> <$math>.MaxInt32 || <$x> <"<"> <$math>.MinInt32 {
+					return <$fmt>.Errorf("enum overflow from %v for %q", <$x>, "<$name>")
+				}
+				*<$v> = (<$name>)(<$x>)
+				return nil
+			case string:
+				return <$v>.UnmarshalText([]byte(<$x>))
+			case []byte:
+				return <$v>.UnmarshalText(<$x>)
+			default:
+				return <$fmt>.Errorf("cannot scan %T into %q", <$src>, "<$name>")
+			}
+		}
+
+		<if eq .Repr "int" ->
+		// Value stores <$name> in a database column as its integer value.
+		//
+		// This implements driver.Valuer.
+		func (<$v> <$name>) Value() (<$driver>.Value, error) {
+			return int64(<$v>), nil
+		}
+		<- else ->
+		// Value stores <$name> in a database column as its name.
+		//
+		// This implements driver.Valuer.
+		func (<$v> <$name>) Value() (<$driver>.Value, error) {
+			<$x>, err := <$v>.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			return string(<$x>), nil
+		}
+		<- end>
+		`, s)
+}
+
+func (s sqlGenerator) typedef(g Generator, spec *compile.TypedefSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$driver := import "database/sql/driver">
+		<$fmt := import "fmt">
+
+		<$name := typeName .Spec>
+		<$v := newVar "v">
+		<$src := newVar "src">
+		<$x := newVar "x">
+		// Scan reads <$name> from a database column.
+		//
+		// This implements sql.Scanner.
+		func (<$v> *<$name>) Scan(<$src> interface{}) error {
+			switch <$x> := <$src>.(type) {
+			<if eq .Repr "int" ->
+			<- $strconv := import "strconv" ->
+			<- $i := newVar "i" ->
+			case int64:
+				*<$v> = (<$name>)(<$x>)
+				return nil
+			case string:
+				<$i>, err := <$strconv>.ParseInt(<$x>, 10, 64)
+				if err != nil {
+					return err
+				}
+				*<$v> = (<$name>)(<$i>)
+				return nil
+			case []byte:
+				<$i>, err := <$strconv>.ParseInt(string(<$x>), 10, 64)
+				if err != nil {
+					return err
+				}
+				*<$v> = (<$name>)(<$i>)
+				return nil
+			<- else ->
+			case string:
+				*<$v> = (<$name>)(<$x>)
+				return nil
+			case []byte:
+				*<$v> = (<$name>)(string(<$x>))
+				return nil
+			<- end>
+			default:
+				return <$fmt>.Errorf("cannot scan %T into %q", <$src>, "<$name>")
+			}
+		}
+
+		// Value stores <$name> in a database column.
+		//
+		// This implements driver.Valuer.
+		func (<$v> <$name>) Value() (<$driver>.Value, error) {
+			<if eq .Repr "int" ->
+				return int64(<$v>), nil
+			<- else ->
+				return string(<$v>), nil
+			<- end>
+		}
+		`, s)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/sql"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ sql.Scanner   = (*ts.Status)(nil)
+	_ driver.Valuer = ts.Status(0)
+	_ sql.Scanner   = (*ts.UserID)(nil)
+	_ driver.Valuer = ts.UserID("")
+	_ sql.Scanner   = (*ts.Timestamp)(nil)
+	_ driver.Valuer = ts.Timestamp(0)
+)
+
+func TestSQLNotGenerated(t *testing.T) {
+	var (
+		color interface{} = ts.ColorRed
+		note  interface{} = ts.Note("")
+	)
+
+	_, ok := color.(driver.Valuer)
+	assert.False(t, ok, "Color must not implement driver.Valuer")
+
+	_, ok = note.(driver.Valuer)
+	assert.False(t, ok, "Note must not implement driver.Valuer")
+}
+
+func TestSQLValue(t *testing.T) {
+	tests := []struct {
+		desc string
+		give driver.Valuer
+		want driver.Value
+	}{
+		{desc: "string enum", give: ts.StatusSuspended, want: "SUSPENDED"},
+		{desc: "unknown string enum", give: ts.Status(42), want: "42"},
+		{desc: "int enum", give: ts.PriorityHigh, want: int64(1)},
+		{desc: "string typedef", give: ts.UserID("alice"), want: "alice"},
+		{desc: "nested typedef", give: ts.OwnerID("bob"), want: "bob"},
+		{desc: "i64 typedef", give: ts.Timestamp(1234), want: int64(1234)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := tt.give.Value()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, driver.IsValue(got), "must be a valid driver.Value")
+		})
+	}
+}
+
+func TestSQLScan(t *testing.T) {
+	t.Run("enum", func(t *testing.T) {
+		tests := []struct {
+			give    interface{}
+			want    ts.Status
+			wantErr string
+		}{
+			{give: int64(2), want: ts.StatusSuspended},
+			{give: "ACTIVE", want: ts.StatusActive},
+			{give: []byte("SUSPENDED"), want: ts.StatusSuspended},
+			{give: "42", want: ts.Status(42)},
+			{give: "UNKNOWN", wantErr: `unknown enum value "UNKNOWN" for "Status"`},
+			{give: int64(math.MaxInt32 + 1), wantErr: `enum overflow from 2147483648 for "Status"`},
+			{give: 1.5, wantErr: `cannot scan float64 into "Status"`},
+			{give: nil, wantErr: `cannot scan <nil> into "Status"`},
+		}
+
+		for _, tt := range tests {
+			var got ts.Status
+			err := got.Scan(tt.give)
+			if tt.wantErr != "" {
+				require.Error(t, err, "Scan(%#v)", tt.give)
+				assert.Contains(t, err.Error(), tt.wantErr, "Scan(%#v)", tt.give)
+				continue
+			}
+			require.NoError(t, err, "Scan(%#v)", tt.give)
+			assert.Equal(t, tt.want, got, "Scan(%#v)", tt.give)
+		}
+	})
+
+	t.Run("string typedef", func(t *testing.T) {
+		var got ts.UserID
+		require.NoError(t, got.Scan("alice"))
+		assert.Equal(t, ts.UserID("alice"), got)
+
+		bs := []byte("bob")
+		require.NoError(t, got.Scan(bs))
+		bs[0] = 'r'
+		assert.Equal(t, ts.UserID("bob"), got, "must not retain the scanned bytes")
+
+		assert.EqualError(t, got.Scan(int64(1)), `cannot scan int64 into "UserID"`)
+	})
+
+	t.Run("i64 typedef", func(t *testing.T) {
+		var got ts.Timestamp
+		require.NoError(t, got.Scan(int64(1234)))
+		assert.Equal(t, ts.Timestamp(1234), got)
+
+		require.NoError(t, got.Scan("5678"))
+		assert.Equal(t, ts.Timestamp(5678), got)
+
+		require.NoError(t, got.Scan([]byte("90")))
+		assert.Equal(t, ts.Timestamp(90), got)
+
+		assert.Error(t, got.Scan("soon"))
+		assert.EqualError(t, got.Scan(true), `cannot scan bool into "Timestamp"`)
+	})
+}
+
+func TestSQLAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    compile.TypeSpec
+		wantErr string
+	}{
+		{
+			desc: "unknown enum representation",
+			give: &compile.EnumSpec{
+				Name:        "Status",
+				Annotations: compile.Annotations{"go.sql": "json"},
+			},
+			wantErr: `invalid go.sql annotation: "json" is not one of ["int" "string"]`,
+		},
+		{
+			desc: "string typedef stored as int",
+			give: &compile.TypedefSpec{
+				Name:        "UserID",
+				Target:      &compile.StringSpec{},
+				Annotations: compile.Annotations{"go.sql": "int"},
+			},
+			wantErr: `invalid go.sql annotation: "int" is not one of ["string"]`,
+		},
+		{
+			desc: "unsupported typedef",
+			give: &compile.TypedefSpec{
+				Name:        "Ratio",
+				Target:      &compile.DoubleSpec{},
+				Annotations: compile.Annotations{"go.sql": "int"},
+			},
+			wantErr: "go.sql annotation is only supported on enums and typedefs of i64 or string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, _, err := newSQLGenerator(tt.give)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
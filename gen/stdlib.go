@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// stdlibRuntimePackages are the ThriftRW packages that generated code may
+// import when it is restricted to the standard library. These packages
+// depend only on the standard library.
+var stdlibRuntimePackages = map[string]struct{}{
+	"go.uber.org/thriftrw/dualencode":        {},
+	"go.uber.org/thriftrw/envelope":          {},
+	"go.uber.org/thriftrw/protocol":          {},
+	"go.uber.org/thriftrw/protocol/binary":   {},
+	"go.uber.org/thriftrw/protocol/envelope": {},
+	"go.uber.org/thriftrw/protocol/stream":   {},
+	"go.uber.org/thriftrw/protocol/tjson":    {},
+	"go.uber.org/thriftrw/ptr":               {},
+	"go.uber.org/thriftrw/requiredfield":     {},
+	"go.uber.org/thriftrw/rpcpolicy":         {},
+	"go.uber.org/thriftrw/thrifthash":        {},
+	"go.uber.org/thriftrw/thriftcrypt":       {},
+	"go.uber.org/thriftrw/thrifthttp":        {},
+	"go.uber.org/thriftrw/thriftreflect":     {},
+	"go.uber.org/thriftrw/thriftrpc":         {},
+	"go.uber.org/thriftrw/thriftuuid":        {},
+	"go.uber.org/thriftrw/validate":          {},
+	"go.uber.org/thriftrw/version":           {},
+	"go.uber.org/thriftrw/wire":              {},
+}
+
+// stdlibChecker verifies that generated files depend only on the standard
+// library, the ThriftRW packages in stdlibRuntimePackages, and other
+// generated packages.
+type stdlibChecker struct {
+	// Import paths of the packages generated for Thrift files. Plugins
+	// may generate code in their subpackages.
+	generated []string
+}
+
+func newStdlibChecker(m *compile.Module, i ThriftPackageImporter) (*stdlibChecker, error) {
+	var c stdlibChecker
+	err := m.Walk(func(m *compile.Module) error {
+		pkg, err := i.Package(m.ThriftPath)
+		if err != nil {
+			return err
+		}
+		c.generated = append(c.generated, pkg)
+		return nil
+	})
+	return &c, err
+}
+
+func (c *stdlibChecker) allowed(importPath string) bool {
+	if _, ok := stdlibRuntimePackages[importPath]; ok {
+		return true
+	}
+	for _, pkg := range c.generated {
+		if importPath == pkg || strings.HasPrefix(importPath, pkg+"/") {
+			return true
+		}
+	}
+
+	// Packages in the standard library do not have a domain name in the
+	// first element of their import paths.
+	first := strings.SplitN(importPath, "/", 2)[0]
+	return !strings.Contains(first, ".")
+}
+
+// Check verifies the imports of the given Go file.
+func (c *stdlibChecker) Check(path string, contents []byte) error {
+	if filepath.Ext(path) != ".go" {
+		return nil
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), path, contents, parser.ImportsOnly)
+	if err != nil {
+		return fmt.Errorf("could not parse %q: %v", path, err)
+	}
+
+	for _, imp := range f.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return fmt.Errorf("could not parse %q: invalid import %v", path, imp.Path.Value)
+		}
+		if !c.allowed(importPath) {
+			return fmt.Errorf(
+				"%q imports %q, which is not part of the standard library or ThriftRW's standard library runtime",
+				path, importPath)
+		}
+	}
+	return nil
+}
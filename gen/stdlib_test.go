@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdlibRuntimePackages(t *testing.T) {
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	seen := make(map[string]struct{})
+	var check func(importPath, importedBy string)
+	check = func(importPath, importedBy string) {
+		if _, ok := seen[importPath]; ok {
+			return
+		}
+		seen[importPath] = struct{}{}
+
+		pkg, err := build.Import(importPath, cwd, 0)
+		require.NoError(t, err, "could not import %q", importPath)
+		if pkg.Goroot || importPath == "C" {
+			return
+		}
+
+		if !assert.True(t, strings.HasPrefix(importPath, "go.uber.org/thriftrw/"),
+			"%q imported by %q is not part of the standard library", importPath, importedBy) {
+			return
+		}
+		for _, imp := range pkg.Imports {
+			check(imp, importPath)
+		}
+	}
+
+	for _, importPath := range sortStringKeys(stdlibRuntimePackages) {
+		check(importPath, "")
+	}
+}
+
+func TestStdlibCheckerAllowed(t *testing.T) {
+	c := stdlibChecker{generated: []string{"example.com/gen/foo"}}
+
+	tests := []struct {
+		give string
+		want bool
+	}{
+		{give: "fmt", want: true},
+		{give: "encoding/json", want: true},
+		{give: "go.uber.org/thriftrw/wire", want: true},
+		{give: "example.com/gen/foo", want: true},
+		{give: "example.com/gen/foo/fooserver", want: true},
+		{give: "example.com/gen/foobar", want: false},
+		{give: "go.uber.org/thriftrw/compile", want: false},
+		{give: "go.uber.org/zap/zapcore", want: false},
+		{give: "go.uber.org/multierr", want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, c.allowed(tt.give), tt.give)
+	}
+}
+
+func TestGenerateStdlibOnly(t *testing.T) {
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc      string
+		file      string
+		opts      Options
+		plugin    map[string][]byte
+		wantError string
+	}{
+		{desc: "structs", file: "structs.thrift"},
+		{desc: "containers", file: "containers.thrift"},
+		{desc: "uuids", file: "fuzz.thrift"},
+		{
+			desc: "missing required hook",
+			file: "structs.thrift",
+			opts: Options{MissingRequired: MissingRequiredHook},
+		},
+		{desc: "services", file: "services.thrift"},
+		{
+			desc: "procedures",
+			file: "procedures.thrift",
+			opts: Options{Procedures: true},
+		},
+		{
+			desc: "http handlers",
+			file: "http-handlers.thrift",
+			opts: Options{HTTPHandlers: true},
+		},
+		{
+			desc: "plugin",
+			file: "services.thrift",
+			plugin: map[string][]byte{
+				"services/servicesserver/server.go": []byte(`package servicesserver
+
+import (
+	"context"
+
+	"go.uber.org/thriftrw/gen/internal/tests/services"
+)
+`),
+			},
+		},
+		{
+			desc: "plugin with dependencies",
+			file: "services.thrift",
+			plugin: map[string][]byte{
+				"services/servicesserver/server.go": []byte(`package servicesserver
+
+import "go.uber.org/zap"
+`),
+			},
+			wantError: `"services/servicesserver/server.go" imports "go.uber.org/zap", ` +
+				`which is not part of the standard library or ThriftRW's standard library runtime`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			outputDir, err := ioutil.TempDir("", "thriftrw-stdlib-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			module, err := compile.Compile(filepath.Join(thriftRoot, tt.file))
+			require.NoError(t, err)
+
+			opts := tt.opts
+			opts.OutputDir = outputDir
+			opts.PackagePrefix = "go.uber.org/thriftrw/gen/internal/tests"
+			opts.ThriftRoot = thriftRoot
+			opts.NoRecurse = true
+			opts.StdlibOnly = true
+			if tt.plugin != nil {
+				sgen := handletest.NewMockServiceGenerator(mockCtrl)
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{Files: tt.plugin}, nil)
+				opts.Plugin = CodeGenerator{ServiceGenerator: sgen}
+			}
+
+			err = Generate(module, &opts)
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+
+			err = filepath.Walk(outputDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				bs, err := ioutil.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				assert.NotContains(t, string(bs), "go.uber.org/zap", "%v must not import Zap", path)
+				assert.NotContains(t, string(bs), "go.uber.org/multierr", "%v must not import multierr", path)
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
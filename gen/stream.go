@@ -36,10 +36,18 @@ type StreamGenerator struct {
 	enumG    enumGenerator
 	structG  structGenerator
 	typedefG typedefGenerator
+	uuidG    uuidGenerator
+
+	unsignedG unsignedGenerator
 }
 
 // Encode generates code that knows how to serialize Thrift types into bytes.
 func (sg *StreamGenerator) Encode(g Generator, spec compile.TypeSpec, varName string, sw string) (string, error) {
+	if isUnsigned(spec) {
+		bits := intBits(spec)
+		return fmt.Sprintf("%s.WriteInt%d(int%d(%s))", sw, bits, bits, varName), nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return fmt.Sprintf("%s.WriteBool(%s)", sw, varName), nil
@@ -57,6 +65,8 @@ func (sg *StreamGenerator) Encode(g Generator, spec compile.TypeSpec, varName st
 		return fmt.Sprintf("%s.WriteString(%s)", sw, varName), nil
 	case *compile.BinarySpec:
 		return fmt.Sprintf("%s.WriteBinary(%s)", sw, varName), nil
+	case *compile.UUIDSpec:
+		return fmt.Sprintf("%s.WriteBinary(%s.Bytes())", sw, uuidValue(g, s, varName)), nil
 	case *compile.MapSpec:
 		encoder, err := sg.mapG.Encoder(g, s)
 		return fmt.Sprintf("%s(%s, %s)", encoder, varName, sw), err
@@ -66,6 +76,12 @@ func (sg *StreamGenerator) Encode(g Generator, spec compile.TypeSpec, varName st
 	case *compile.SetSpec:
 		encoder, err := sg.setG.Encoder(g, s)
 		return fmt.Sprintf("%s(%s, %s)", encoder, varName, sw), err
+	case *compile.TypedefSpec:
+		if isBoundTypedef(s) {
+			encoder, err := sg.typedefG.boundEncoder(g, s)
+			return fmt.Sprintf("%s(%s, %s)", encoder, varName, sw), err
+		}
+		return fmt.Sprintf("%s.Encode(%s)", varName, sw), nil
 	default:
 		return fmt.Sprintf("%s.Encode(%s)", varName, sw), nil
 	}
@@ -76,8 +92,13 @@ func (sg *StreamGenerator) Encode(g Generator, spec compile.TypeSpec, varName st
 func (sg *StreamGenerator) EncodePtr(g Generator, spec compile.TypeSpec, varName string, sw string) (string, error) {
 	switch spec.(type) {
 	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
-		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.UUIDSpec:
 		return sg.Encode(g, spec, fmt.Sprintf("*(%s)", varName), sw)
+	case *compile.TypedefSpec:
+		if isBoundTypedef(spec) {
+			return sg.Encode(g, spec, fmt.Sprintf("*(%s)", varName), sw)
+		}
+		return sg.Encode(g, spec, varName, sw)
 	default:
 		// Everything else is either a reference type or has an Encode method
 		// on it that does automatic dereferencing.
@@ -88,6 +109,14 @@ func (sg *StreamGenerator) EncodePtr(g Generator, spec compile.TypeSpec, varName
 // Decode generates an expression that can deserialize Thrift data into their
 // raw types.
 func (sg *StreamGenerator) Decode(g Generator, spec compile.TypeSpec, reader string) (string, error) {
+	if isUnsigned(spec) {
+		decoder, err := sg.unsignedG.Decoder(g, spec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return fmt.Sprintf("%s.ReadBool()", reader), nil
@@ -105,6 +134,12 @@ func (sg *StreamGenerator) Decode(g Generator, spec compile.TypeSpec, reader str
 		return fmt.Sprintf("%s.ReadString()", reader), nil
 	case *compile.BinarySpec:
 		return fmt.Sprintf("%s.ReadBinary()", reader), nil
+	case *compile.UUIDSpec:
+		decoder, err := sg.uuidG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
 	case *compile.MapSpec:
 		decoder, err := sg.mapG.Decoder(g, s)
 		if err != nil {
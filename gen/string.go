@@ -92,9 +92,13 @@ func goCase(s string) string {
 	// That is, "FOO" is allowed but "FOO_BAR" is changed to "FooBar".
 }
 
+// goNameKey is a Thrift annotation which overrides the Go name of a type,
+// field, or service function.
+const goNameKey = "go.name"
+
 // goNameAnnotation returns ("", nil) if there is no "go.name" annotation.
 func goNameAnnotation(e compile.NamedEntity) (string, error) {
-	name, ok := e.ThriftAnnotations()["go.name"]
+	name, ok := e.ThriftAnnotations()[goNameKey]
 	if !ok {
 		return "", nil
 	}
@@ -133,6 +137,12 @@ func goName(e compile.NamedEntity) (string, error) {
 	return name, err
 }
 
+// GoName returns the name of the Go identifier generated for the given
+// Thrift entity, such as a type or a field.
+func GoName(e compile.NamedEntity) (string, error) {
+	return goName(e)
+}
+
 // This set is taken from https://github.com/golang/lint/blob/master/lint.go#L692
 var commonInitialisms = map[string]bool{
 	"API":   true,
@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// visibilityKey is the annotation which marks fields as internal.
+	visibilityKey = "visibility"
+
+	// internalVisibility marks fields which must not leave internal
+	// services. StripInternal clears them.
+	internalVisibility = "internal"
+)
+
+// stripGenerator generates StripInternal methods for structs which have
+// internal fields, directly or through the structs nested in them.
+type stripGenerator struct {
+	Name     string
+	Spec     *compile.StructSpec
+	Presence presenceLayout
+}
+
+func newStripGenerator(name string, spec *compile.StructSpec, presence presenceLayout) (stripGenerator, error) {
+	for _, f := range spec.Fields {
+		v, ok := f.Annotations[visibilityKey]
+		if !ok {
+			continue
+		}
+		if v != internalVisibility {
+			return stripGenerator{}, fmt.Errorf(
+				"invalid %v annotation on field %q: %q is not %q", visibilityKey, f.Name, v, internalVisibility)
+		}
+		if f.Required {
+			return stripGenerator{}, fmt.Errorf(
+				"field %q cannot be both required and %v", f.Name, internalVisibility)
+		}
+	}
+	return stripGenerator{Name: name, Spec: spec, Presence: presence}, nil
+}
+
+// Enabled returns true if a StripInternal method should be generated.
+func (s stripGenerator) Enabled() bool {
+	return hasInternalFields(s.Spec, make(map[*compile.StructSpec]struct{}))
+}
+
+func (s stripGenerator) Generate(g Generator) error {
+	for _, f := range s.Spec.Fields {
+		name, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if name == "StripInternal" {
+			return fmt.Errorf("field %q conflicts with the generated StripInternal method", f.Name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$o := newVar "o">
+		// StripInternal returns a copy of this <.Name> with its fields
+		// annotated with (visibility = "internal") cleared, including those
+		// of the structs nested in it. Use it on values leaving internal
+		// services.
+		func (<$v> *<.Name>) StripInternal() *<.Name> {
+			if <$v> == nil {
+				return nil
+			}
+
+			<$o> := <$v>.Copy()
+			<- range .Spec.Fields>
+				<- $f := printf "%s.%s" $o (goName .) ->
+				<- if $.Presence.Has .>
+					<- if isInternal .>
+					<$o>.Clear<goName .>()
+					<- end>
+				<- else if isInternal .>
+					<$f> = nil
+				<- else if hasInternal .Type>
+					<stripInternal .Type $f>
+				<- end>
+			<- end>
+			return <$o>
+		}
+		`, s,
+		TemplateFunc("isInternal", isInternalField),
+		TemplateFunc("hasInternal", func(spec compile.TypeSpec) bool {
+			return typeHasInternalFields(spec, make(map[*compile.StructSpec]struct{}))
+		}),
+		TemplateFunc("stripInternal", func(spec compile.TypeSpec, v string) (string, error) {
+			return stripInternal(g, spec, v, 0)
+		}),
+	)
+}
+
+func isInternalField(f *compile.FieldSpec) bool {
+	return f.Annotations[visibilityKey] == internalVisibility
+}
+
+// hasInternalFields returns true if the given struct or the structs nested
+// in it have internal fields. Structs in seen are skipped.
+func hasInternalFields(spec *compile.StructSpec, seen map[*compile.StructSpec]struct{}) bool {
+	if _, ok := seen[spec]; ok {
+		return false
+	}
+	seen[spec] = struct{}{}
+	defer delete(seen, spec)
+
+	for _, f := range spec.Fields {
+		if isInternalField(f) || typeHasInternalFields(f.Type, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func typeHasInternalFields(spec compile.TypeSpec, seen map[*compile.StructSpec]struct{}) bool {
+	switch s := spec.(type) {
+	case *compile.StructSpec:
+		return hasInternalFields(s, seen)
+	case *compile.TypedefSpec:
+		// Values of typedefs bound to Go types are opaque to us.
+		return !isBoundTypedef(s) && typeHasInternalFields(s.Target, seen)
+	case *compile.ListSpec:
+		return typeHasInternalFields(s.ValueSpec, seen)
+	case *compile.SetSpec:
+		// Sets which use maps hold only primitives.
+		return !setUsesMap(s) && typeHasInternalFields(s.ValueSpec, seen)
+	case *compile.MapSpec:
+		return typeHasInternalFields(s.KeySpec, seen) || typeHasInternalFields(s.ValueSpec, seen)
+	default:
+		return false
+	}
+}
+
+// stripInternal generates statements which clear the internal fields of the
+// structs in v, a deep copy of a value of the given type.
+func stripInternal(g Generator, spec compile.TypeSpec, v string, depth int) (string, error) {
+	if !typeHasInternalFields(spec, make(map[*compile.StructSpec]struct{})) {
+		return "", nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.StructSpec:
+		return fmt.Sprintf("%s = %s.StripInternal()", v, v), nil
+
+	case *compile.TypedefSpec:
+		root, ok := compile.RootTypeSpec(s).(*compile.StructSpec)
+		if !ok {
+			// Typedefs of containers may be indexed like them.
+			return stripInternal(g, s.Target, v, depth)
+		}
+
+		ref, err := typeReference(g, s)
+		if err != nil {
+			return "", err
+		}
+		rootRef, err := typeReference(g, root)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s = (%s)((%s)(%s).StripInternal())", v, ref, rootRef, v), nil
+
+	case *compile.ListSpec:
+		return stripElements(g, s.ValueSpec, v, depth)
+
+	case *compile.SetSpec:
+		return stripElements(g, s.ValueSpec, v, depth)
+
+	case *compile.MapSpec:
+		if isMapContainer(s) {
+			return "", fmt.Errorf(
+				"cannot strip internal fields of %v: it is stored in a container type", spec.ThriftName())
+		}
+
+		i := fmt.Sprintf("i%d", depth)
+		if mapUsesMap(s) {
+			body, err := stripInternal(g, s.ValueSpec, fmt.Sprintf("%s[%s]", v, i), depth+1)
+			return fmt.Sprintf("for %s := range %s {\n%s\n}", i, v, body), err
+		}
+
+		// Other maps are slices of key-value pairs.
+		key, err := stripInternal(g, s.KeySpec, fmt.Sprintf("%s[%s].Key", v, i), depth+1)
+		if err != nil {
+			return "", err
+		}
+		value, err := stripInternal(g, s.ValueSpec, fmt.Sprintf("%s[%s].Value", v, i), depth+1)
+		body := strings.TrimSpace(key + "\n" + value)
+		return fmt.Sprintf("for %s := range %s {\n%s\n}", i, v, body), err
+
+	default:
+		return "", fmt.Errorf("cannot strip internal fields of %v", spec.ThriftName())
+	}
+}
+
+// stripElements generates a loop which clears the internal fields of the
+// elements of the list or slice-backed set v.
+func stripElements(g Generator, spec compile.TypeSpec, v string, depth int) (string, error) {
+	i := fmt.Sprintf("i%d", depth)
+	body, err := stripInternal(g, spec, fmt.Sprintf("%s[%s]", v, i), depth+1)
+	return fmt.Sprintf("for %s := range %s {\n%s\n}", i, v, body), err
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/strip"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripInternal(t *testing.T) {
+	secret := func(name string) *ts.Secret {
+		return &ts.Secret{Name: ptr.String(name), Token: ptr.String(name + "-token")}
+	}
+	stripped := func(name string) *ts.Secret {
+		return &ts.Secret{Name: ptr.String(name)}
+	}
+
+	give := &ts.User{
+		ID:            "1",
+		Ssn:           ptr.String("123-45-6789"),
+		Primary:       secret("primary"),
+		Secret:        secret("secret"),
+		Secrets:       []*ts.Secret{secret("a"), nil},
+		SecretSet:     []*ts.Secret{secret("b")},
+		SecretsByName: map[string]*ts.Secret{"c": secret("c")},
+		NamesBySecret: []struct {
+			Key   *ts.Secret
+			Value string
+		}{{Key: secret("d"), Value: "d"}},
+		Aliased:    (*ts.AliasedSecret)(secret("e")),
+		SecretList: ts.SecretList{secret("f")},
+		Nested:     [][]*ts.Secret{{secret("g")}},
+		Tags:       []string{"x"},
+	}
+	want := &ts.User{
+		ID:            "1",
+		Primary:       stripped("primary"),
+		Secret:        stripped("secret"),
+		Secrets:       []*ts.Secret{stripped("a"), nil},
+		SecretSet:     []*ts.Secret{stripped("b")},
+		SecretsByName: map[string]*ts.Secret{"c": stripped("c")},
+		NamesBySecret: []struct {
+			Key   *ts.Secret
+			Value string
+		}{{Key: stripped("d"), Value: "d"}},
+		Aliased:    (*ts.AliasedSecret)(stripped("e")),
+		SecretList: ts.SecretList{stripped("f")},
+		Nested:     [][]*ts.Secret{{stripped("g")}},
+		Tags:       []string{"x"},
+	}
+
+	before := give.Copy()
+	assert.Equal(t, want, give.StripInternal())
+	assert.Equal(t, before, give, "original must not be modified")
+}
+
+func TestStripInternalRecursive(t *testing.T) {
+	give := &ts.Node{
+		Value: "a",
+		Debug: ptr.String("a"),
+		Tail:  &ts.Node{Value: "b", Debug: ptr.String("b")},
+	}
+	assert.Equal(t, &ts.Node{Value: "a", Tail: &ts.Node{Value: "b"}}, give.StripInternal())
+}
+
+func TestStripInternalNil(t *testing.T) {
+	var u *ts.User
+	assert.Nil(t, u.StripInternal())
+	assert.Equal(t, &ts.Payload{Text: ptr.String("x")}, (&ts.Payload{Text: ptr.String("x")}).StripInternal())
+}
+
+func TestStripInternalErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    *compile.FieldSpec
+		wantErr string
+	}{
+		{
+			desc: "unknown visibility",
+			give: &compile.FieldSpec{
+				ID:          1,
+				Name:        "token",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"visibility": "private"},
+			},
+			wantErr: `invalid visibility annotation on field "token": "private" is not "internal"`,
+		},
+		{
+			desc: "required",
+			give: &compile.FieldSpec{
+				ID:          1,
+				Name:        "token",
+				Type:        &compile.StringSpec{},
+				Required:    true,
+				Annotations: compile.Annotations{"visibility": "internal"},
+			},
+			wantErr: `field "token" cannot be both required and internal`,
+		},
+		{
+			desc: "conflict",
+			give: &compile.FieldSpec{
+				ID:          1,
+				Name:        "stripInternal",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"visibility": "internal"},
+			},
+			wantErr: `field "stripInternal" conflicts with the generated StripInternal method`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			spec := &compile.StructSpec{Name: "Foo", Fields: compile.FieldGroup{tt.give}}
+			err := structure(NewGenerator(&GeneratorOptions{}), spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -21,6 +21,9 @@
 package gen
 
 import (
+	"fmt"
+	"strconv"
+
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/compile"
 )
@@ -80,20 +83,162 @@ func structure(g Generator, spec *compile.StructSpec) error {
 		return err
 	}
 
+	omitDefaults := checkOmitDefaults(g)
+	if v, ok := spec.Annotations[omitDefaultKey]; ok {
+		omitDefaults, err = strconv.ParseBool(v)
+		if err != nil {
+			return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+				"invalid %v annotation: %q is not a boolean", omitDefaultKey, v))
+		}
+	}
+
+	preserveUnknownFields := checkPreserveUnknownFields(g)
+	if v, ok := spec.Annotations[preserveUnknownFieldsKey]; ok {
+		preserveUnknownFields, err = strconv.ParseBool(v)
+		if err != nil {
+			return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+				"invalid %v annotation: %q is not a boolean", preserveUnknownFieldsKey, v))
+		}
+	}
+
+	tagTemplates, err := parseTagTemplates("--field-tag-template", checkFieldTagTemplates(g)...)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if text, ok := spec.Annotations[fieldTagTemplateKey]; ok {
+		tmpls, err := parseTagTemplates(fieldTagTemplateKey+" annotation", text)
+		if err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+		tagTemplates = append(tagTemplates, tmpls...)
+	}
+
+	presence, err := newPresenceLayout(g, spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	labels, err := pprofLabels(g, spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	fg := fieldGroupGenerator{
-		Namespace:   NewNamespace(),
-		Name:        name,
-		ThriftName:  spec.ThriftName(),
-		Doc:         spec.Doc,
-		Fields:      spec.Fields,
-		IsUnion:     spec.Type == ast.UnionType,
-		IsException: spec.Type == ast.ExceptionType,
+		Namespace:    NewNamespace(),
+		Name:         name,
+		ThriftName:   spec.ThriftName(),
+		Doc:          deprecatedDoc(sourceDoc(g, spec.Doc, spec.File, spec.Line), spec.Annotations),
+		Fields:       spec.Fields,
+		IsUnion:      spec.Type == ast.UnionType,
+		IsException:  spec.Type == ast.ExceptionType,
+		OmitDefaults: omitDefaults,
+		TagTemplates: tagTemplates,
+
+		PreserveUnknownFields: preserveUnknownFields,
+		AggregateErrors:       checkAggregateErrors(g),
+		DualEncode:            checkDualEncode(g),
+		Setters:               checkSetters(g),
+		UnionAccessors:        spec.Type == ast.UnionType,
+		PprofLabels:           labels,
+		Presence:              presence,
+		YAMLTags:              checkYAML(g),
 	}
 
 	if err := fg.Generate(g); err != nil {
 		return wrapGenerateError(spec.ThriftName(), err)
 	}
 
+	if spec.Type == ast.UnionType {
+		if err := unionMatch(g, name, spec, presence); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if vg := newValidateGenerator(name, spec, presence); vg.Enabled() {
+		if err := vg.Generate(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	sg, err := newStripGenerator(name, spec, presence)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if sg.Enabled() {
+		if err := sg.Generate(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkTestHelpers(g) {
+		if err := newTestHelpersGenerator(g, name, spec).Generate(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkBuilders(g) {
+		bg, err := newBuilderGenerator(name, spec, spec.Type == ast.UnionType, presence)
+		if err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+		if err := bg.Generate(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkAggregateErrors(g) {
+		vg := violationsGenerator{Name: name, Spec: spec, Presence: presence}
+		if err := vg.Generate(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkThriftJSON(g) {
+		if err := thriftJSON(g, name, spec); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkYAML(g) && spec.Type == ast.UnionType && presence == nil {
+		if err := unionYAML(g, name, spec); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkQuickGenerators(g) {
+		if err := quickStruct(g, name, spec, presence); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkCompare(g) {
+		if err := compareStruct(g, name, spec, presence); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkEncodedSize(g) && hasEncodedSize(spec) {
+		if err := fg.EncodedSize(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	if checkAppendTo(g) {
+		if err := appendTo(g, name, spec); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
+	lg, ok, err := newLazyGenerator(g, name, spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if ok {
+		if err := lg.Generate(g); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
 	if spec.Type == ast.ExceptionType {
 		err := g.DeclareFromTemplate(
 			`
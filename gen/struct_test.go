@@ -2061,6 +2061,61 @@ func TestStructAccessors(t *testing.T) {
 	})
 }
 
+func TestUnionAccessors(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		var u *tu.ArbitraryValue
+		_, ok := u.LookupStringValue()
+		assert.False(t, ok)
+		assert.Equal(t, int16(0), u.Which())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var u tu.ArbitraryValue
+		v, ok := u.LookupBoolValue()
+		assert.False(t, ok)
+		assert.False(t, v)
+		assert.Equal(t, int16(0), u.Which())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		var u tu.ArbitraryValue
+		u.SetStringValue("foo")
+		assert.Equal(t, int16(3), u.Which())
+
+		v, ok := u.LookupStringValue()
+		assert.True(t, ok)
+		assert.Equal(t, "foo", v)
+
+		_, ok = u.LookupInt64Value()
+		assert.False(t, ok)
+	})
+
+	t.Run("set replaces other fields", func(t *testing.T) {
+		var u tu.ArbitraryValue
+		u.SetStringValue("foo")
+		u.SetBoolValue(false)
+		assert.Equal(t, &tu.ArbitraryValue{BoolValue: ptr.Bool(false)}, &u)
+		assert.Equal(t, int16(1), u.Which())
+
+		v, ok := u.LookupBoolValue()
+		assert.True(t, ok, "zero values which are set must be found")
+		assert.False(t, v)
+
+		_, ok = u.LookupStringValue()
+		assert.False(t, ok, "other fields must be unset")
+	})
+
+	t.Run("reference types", func(t *testing.T) {
+		var u tu.ArbitraryValue
+		u.SetListValue([]*tu.ArbitraryValue{})
+		assert.Equal(t, int16(4), u.Which())
+
+		v, ok := u.LookupListValue()
+		assert.True(t, ok)
+		assert.Empty(t, v)
+	})
+}
+
 func TestEmptyPrimitivesRoundTrip(t *testing.T) {
 	t.Run("required", func(t *testing.T) {
 		give := ts.PrimitiveRequiredStruct{
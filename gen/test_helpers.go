@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// testHelpersGenerator generates constructors for structs which trim
+// boilerplate from tests: New<Name>WithDefaults, and Must<Name>FromWire and
+// Must<Name>FromJSON which panic if decoding fails.
+type testHelpersGenerator struct {
+	Name string
+	Spec *compile.StructSpec
+
+	// HasDefaults is true if a Default_<Name> constructor was generated.
+	HasDefaults bool
+
+	// TinyGo omits Must<Name>FromJSON since it depends on encoding/json.
+	TinyGo bool
+}
+
+func newTestHelpersGenerator(g Generator, name string, spec *compile.StructSpec) testHelpersGenerator {
+	var hasDefaults bool
+	for _, f := range spec.Fields {
+		if f.Default != nil {
+			hasDefaults = true
+			break
+		}
+	}
+
+	return testHelpersGenerator{
+		Name:        name,
+		Spec:        spec,
+		HasDefaults: hasDefaults,
+		TinyGo:      checkTinyGo(g),
+	}
+}
+
+func (t testHelpersGenerator) Generate(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$fmt := import "fmt">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		// New<.Name>WithDefaults constructs a new <.Name>
+		<- if .HasDefaults> with its fields
+		// set to the default values defined for them in the Thrift file.
+		<- else>. None of its
+		// fields have default values defined in the Thrift file.
+		<- end>
+		func New<.Name>WithDefaults() *<.Name> {
+			<- if .HasDefaults>
+			return Default_<.Name>()
+			<- else>
+			return &<.Name>{}
+			<- end>
+		}
+
+		<$w := newVar "w">
+		<$v := newVar "v">
+		// Must<.Name>FromWire decodes a <.Name> from its Thrift-level
+		// representation, panicking if it fails. This is intended for tests.
+		func Must<.Name>FromWire(<$w> <$wire>.Value) *<.Name> {
+			var <$v> <.Name>
+			if err := <$v>.FromWire(<$w>); err != nil {
+				panic(<$fmt>.Sprintf("could not decode <.Name> from wire: %v", err))
+			}
+			return &<$v>
+		}
+
+		<if not .TinyGo>
+		<$data := newVar "data">
+		// Must<.Name>FromJSON decodes a <.Name> from JSON, panicking if it
+		// fails. This is intended for tests.
+		func Must<.Name>FromJSON(<$data> []byte) *<.Name> {
+			var <$v> <.Name>
+			if err := <import "encoding/json">.Unmarshal(<$data>, &<$v>); err != nil {
+				panic(<$fmt>.Sprintf("could not decode <.Name> from JSON: %v", err))
+			}
+			return &<$v>
+		}
+		<end>
+		`, t)
+}
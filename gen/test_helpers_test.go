@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	th "go.uber.org/thriftrw/gen/internal/tests/test-helpers"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithDefaults(t *testing.T) {
+	assert.Equal(t, th.Default_Account(), th.NewAccountWithDefaults())
+	assert.Equal(t, &th.Account{
+		Name:   ptr.String("anonymous"),
+		Status: th.StatusActive.Ptr(),
+		Tags:   []string{"new"},
+	}, th.NewAccountWithDefaults())
+
+	assert.Equal(t, &th.Credentials{}, th.NewCredentialsWithDefaults())
+	assert.Equal(t, &th.Identifier{}, th.NewIdentifierWithDefaults())
+	assert.Equal(t, &th.AccountNotFound{}, th.NewAccountNotFoundWithDefaults())
+}
+
+func TestMustFromWire(t *testing.T) {
+	give := &th.Credentials{User: "alice", Secret: []byte("hunter2")}
+	w, err := give.ToWire()
+	require.NoError(t, err)
+	assert.Equal(t, give, th.MustCredentialsFromWire(w))
+
+	assert.PanicsWithValue(t,
+		"could not decode Credentials from wire: field User of Credentials is required",
+		func() { th.MustCredentialsFromWire(wire.NewValueStruct(wire.Struct{})) })
+}
+
+func TestMustFromJSON(t *testing.T) {
+	assert.Equal(t,
+		&th.Identifier{Email: ptr.String("alice@example.com")},
+		th.MustIdentifierFromJSON([]byte(`{"email": "alice@example.com"}`)))
+
+	assert.Panics(t, func() { th.MustAccountFromJSON([]byte(`{`)) })
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// thriftJSON generates MarshalThriftJSON and UnmarshalThriftJSON methods for
+// the given struct, which encode it in the JSON protocol of Apache Thrift
+// with its Encode and Decode methods.
+func thriftJSON(g Generator, name string, spec *compile.StructSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$tjson := import "go.uber.org/thriftrw/protocol/tjson">
+
+		<$v := newVar "v">
+		<$buff := newVar "buff">
+		<$sw := newVar "sw">
+		// MarshalThriftJSON encodes <.Name> in the JSON protocol of Apache
+		// Thrift, TJSONProtocol, which keys fields by their identifiers.
+		//
+		// This is not the same as MarshalJSON, which keys fields by name
+		// for use by Go programs.
+		func (<$v> *<.Name>) MarshalThriftJSON() ([]byte, error) {
+			var <$buff> <$bytes>.Buffer
+			<$sw> := <$tjson>.Default.Writer(&<$buff>)
+			if err := <$v>.Encode(<$sw>); err != nil {
+				return nil, err
+			}
+			if err := <$sw>.Close(); err != nil {
+				return nil, err
+			}
+			return <$buff>.Bytes(), nil
+		}
+
+		<$b := newVar "b">
+		<$sr := newVar "sr">
+		// UnmarshalThriftJSON decodes <.Name> from the JSON protocol of
+		// Apache Thrift, TJSONProtocol.
+		func (<$v> *<.Name>) UnmarshalThriftJSON(<$b> []byte) error {
+			<$sr> := <$tjson>.Default.Reader(<$bytes>.NewReader(<$b>))
+			if err := <$v>.Decode(<$sr>); err != nil {
+				return err
+			}
+			return <$sr>.Close()
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.StructSpec
+		}{Name: name, Spec: spec},
+	)
+}
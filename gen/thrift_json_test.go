@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tj "go.uber.org/thriftrw/gen/internal/tests/thrift-json"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/thriftuuid"
+)
+
+func TestThriftJSONFormat(t *testing.T) {
+	give := &tj.Record{
+		Name:     "foo",
+		Payload:  []byte("foo"),
+		Checksum: tj.Checksum{0xff},
+		Active:   ptr.Bool(true),
+		Status:   tj.StatusRetired.Ptr(),
+		Path:     []*tj.Point{{X: 1, Y: -2.5}},
+		Labels:   map[int32]string{42: "answer"},
+	}
+
+	// Strings are written as they are while binary data is written in
+	// base64, as Apache Thrift does.
+	want := `{"1":{"str":"foo"},"2":{"str":"Zm9v"},"3":{"str":"/w=="},"4":{"tf":1},` +
+		`"9":{"i32":2},"11":{"lst":["rec",1,{"1":{"dbl":1},"2":{"dbl":-2.5}}]},` +
+		`"13":{"map":["i32","str",1,{"42":"answer"}]}}`
+
+	got, err := give.MarshalThriftJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, want, string(got))
+
+	var decoded tj.Record
+	require.NoError(t, decoded.UnmarshalThriftJSON([]byte(want)))
+	assert.Equal(t, give, &decoded)
+}
+
+func TestThriftJSONRoundTrip(t *testing.T) {
+	id := thriftuuid.UUID{0xc3, 0xb8, 0xa5, 0xc2, 0x0f, 0x4e, 0x4b, 0x1e, 0x9e, 0x41, 0x52, 0xc3, 0xc6, 0xf2, 0xa6, 0xd1}
+	record := &tj.Record{
+		Name:      "bar \"baz\"\n",
+		Payload:   []byte{0, 1, 2},
+		Checksum:  tj.Checksum{},
+		Active:    ptr.Bool(false),
+		Flags:     ptr.Int8(-1),
+		Shard:     ptr.Int16(1234),
+		Version:   ptr.Int32(-5),
+		CreatedAt: ptr.Int64(1 << 62),
+		Status:    tj.StatusActive.Ptr(),
+		ID:        &id,
+		Path:      []*tj.Point{{X: 1.5, Y: 2}, {X: 0, Y: 0}},
+		Tags:      map[string]struct{}{"a": {}, "b": {}},
+		Labels:    map[int32]string{-1: "minus one", 1: "one"},
+		Blobs:     map[string][][]byte{"x": {{1}, {}}},
+	}
+
+	t.Run("struct", func(t *testing.T) {
+		b, err := record.MarshalThriftJSON()
+		require.NoError(t, err)
+
+		var got tj.Record
+		require.NoError(t, got.UnmarshalThriftJSON(b))
+		assert.Equal(t, record, &got)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		// Lists are still encoded in parallel chunks for the Binary
+		// protocol.
+		var buff bytes.Buffer
+		require.NoError(t, record.Encode(binary.Default.Writer(&buff)))
+
+		var got tj.Record
+		require.NoError(t, got.Decode(binary.Default.Reader(bytes.NewReader(buff.Bytes()))))
+		assert.Equal(t, record, &got)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		give := &tj.Shape{Polygon: []*tj.Point{{X: 1, Y: 2}}}
+		b, err := give.MarshalThriftJSON()
+		require.NoError(t, err)
+		assert.Equal(t, `{"2":{"lst":["rec",1,{"1":{"dbl":1},"2":{"dbl":2}}]}}`, string(b))
+
+		var got tj.Shape
+		require.NoError(t, got.UnmarshalThriftJSON(b))
+		assert.Equal(t, give, &got)
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		give := &tj.RecordError{Message: "great sadness", Record: record}
+		b, err := give.MarshalThriftJSON()
+		require.NoError(t, err)
+
+		var got tj.RecordError
+		require.NoError(t, got.UnmarshalThriftJSON(b))
+		assert.Equal(t, give, &got)
+	})
+}
+
+func TestThriftJSONErrors(t *testing.T) {
+	t.Run("missing required field", func(t *testing.T) {
+		_, err := (&tj.Record{}).MarshalThriftJSON()
+		require.NoError(t, err, "strings are not checked when encoding")
+
+		var r tj.Record
+		assert.EqualError(t, r.UnmarshalThriftJSON([]byte(`{}`)),
+			"field Name of Record is required")
+	})
+
+	t.Run("unknown fields are skipped", func(t *testing.T) {
+		var r tj.Record
+		require.NoError(t, r.UnmarshalThriftJSON([]byte(
+			`{"1":{"str":"foo"},"99":{"map":["str","lst",1,{"a":["i32",1,1]}]}}`)))
+		assert.Equal(t, tj.Record{Name: "foo"}, r)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		var p tj.Point
+		assert.Error(t, p.UnmarshalThriftJSON([]byte(`{"1":{"dbl":`)))
+		assert.Error(t, p.UnmarshalThriftJSON([]byte(`[]`)))
+	})
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tt "go.uber.org/thriftrw/gen/internal/tests/tinygo"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTinyGoRoundTrip(t *testing.T) {
+	shape := &tt.Shape{
+		Name:   "triangle",
+		Points: []*tt.Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}},
+		Colors: []tt.Color{tt.ColorRed, tt.ColorBlue},
+	}
+	point := func(x, y int32) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueI32(x)},
+			{ID: 2, Value: wire.NewValueI32(y)},
+		}})
+	}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("triangle")},
+		{ID: 2, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+				point(0, 0), point(1, 0), point(0, 1),
+			}),
+		)},
+		{ID: 3, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TI32, []wire.Value{
+				wire.NewValueI32(1), wire.NewValueI32(3),
+			}),
+		)},
+	}})
+
+	testRoundTripCombos(t, shape, v, "Shape")
+}
+
+func TestTinyGoEncodeNilListItem(t *testing.T) {
+	shape := &tt.Shape{Name: "broken", Points: []*tt.Point{{}, nil}}
+
+	var buf bytes.Buffer
+	sw := binary.Default.Writer(&buf)
+	defer sw.Close()
+
+	err := shape.Encode(sw)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid list '[]*Point', index [1]: value is nil")
+}
+
+func TestTinyGoEnumUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		give    string
+		want    tt.Color
+		wantErr string
+	}{
+		{give: `"RED"`, want: tt.ColorRed},
+		{give: ` "GREEN" `, want: tt.ColorGreen},
+		{give: `3`, want: tt.ColorBlue},
+		{give: `42`, want: tt.Color(42)},
+		{give: `"PURPLE"`, wantErr: `unknown enum value "PURPLE" for "Color"`},
+		{give: `2147483648`, wantErr: `enum overflow from JSON "2147483648" for "Color"`},
+		{give: `-2147483649`, wantErr: `enum underflow from JSON "-2147483649" for "Color"`},
+		{give: `1.5`, wantErr: `invalid JSON value "1.5" to unmarshal into "Color"`},
+		{give: `"RED`, wantErr: "invalid syntax"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.give, func(t *testing.T) {
+			var c tt.Color
+			err := c.UnmarshalJSON([]byte(tc.give))
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, c)
+		})
+	}
+
+	t.Run("json.Unmarshal", func(t *testing.T) {
+		var s struct{ Colors []tt.Color }
+		require.NoError(t, json.Unmarshal([]byte(`{"Colors": ["BLUE", 1]}`), &s))
+		assert.Equal(t, []tt.Color{tt.ColorBlue, tt.ColorRed}, s.Colors)
+	})
+}
+
+func TestGenerateTarget(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "tinygo.thrift"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		opts    Options
+		wantErr string
+	}{
+		{desc: "default"},
+		{desc: "go", opts: Options{Target: TargetGo}},
+		{desc: "tinygo", opts: Options{Target: TargetTinyGo}},
+		{desc: "tinygo with test helpers", opts: Options{Target: TargetTinyGo, TestHelpers: true}},
+		{
+			desc:    "unknown",
+			opts:    Options{Target: "wasm"},
+			wantErr: `unknown target "wasm": must be "go" or "tinygo"`,
+		},
+		{
+			desc:    "tinygo with http handlers",
+			opts:    Options{Target: TargetTinyGo, HTTPHandlers: true},
+			wantErr: `HTTPHandlers are not supported for target "tinygo"`,
+		},
+		{
+			desc:    "tinygo with pprof labels",
+			opts:    Options{Target: TargetTinyGo, PprofLabels: true},
+			wantErr: `PprofLabels are not supported for target "tinygo"`,
+		},
+		{
+			desc:    "tinygo with thrift json",
+			opts:    Options{Target: TargetTinyGo, ThriftJSON: true},
+			wantErr: `ThriftJSON is not supported for target "tinygo"`,
+		},
+		{
+			desc:    "tinygo with quick generators",
+			opts:    Options{Target: TargetTinyGo, QuickGenerators: true},
+			wantErr: `QuickGenerators are not supported for target "tinygo"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			opts := tc.opts
+			opts.OutputDir = outputDir
+			opts.PackagePrefix = "go.uber.org/thriftrw/gen/internal/tests"
+			opts.ThriftRoot = thriftRoot
+			opts.NoRecurse = true
+
+			err := Generate(module, &opts)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			bs, err := ioutil.ReadFile(filepath.Join(outputDir, "tinygo", "tinygo.go"))
+			require.NoError(t, err)
+			if opts.Target == TargetTinyGo {
+				assert.NotContains(t, string(bs), `"encoding/json"`)
+				assert.NotContains(t, string(bs), `"go.uber.org/zap/zapcore"`)
+				assert.NotContains(t, string(bs), "GOMAXPROCS")
+				assert.NotContains(t, string(bs), `"reflect"`)
+			} else {
+				assert.Contains(t, string(bs), `"encoding/json"`)
+				assert.Contains(t, string(bs), `"go.uber.org/zap/zapcore"`)
+			}
+		})
+	}
+}
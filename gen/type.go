@@ -44,9 +44,9 @@ func TypeDefinition(g Generator, spec compile.TypeSpec) error {
 // thriftrw.
 //
 // Only primitive types, enums, and typedefs of other hashable types are
-// considered hashable.
+// considered hashable. Typedefs bound to Go types are not.
 func isHashable(t compile.TypeSpec) bool {
-	return isPrimitiveType(t)
+	return isPrimitiveType(t) && !isBoundTypedef(t)
 }
 
 // setUsesMap returns true if the given set type is not annotated with
@@ -56,6 +56,14 @@ func setUsesMap(spec *compile.SetSpec) bool {
 	return (spec.Annotations[goTypeKey] != sliceType) && isHashable(spec.ValueSpec)
 }
 
+// mapUsesMap returns true if the given map type is not annotated with
+// (go.type = "keyvalue-slice") or a container type, and the key of the map
+// is considered hashable by thriftrw.
+func mapUsesMap(spec *compile.MapSpec) bool {
+	return (spec.Annotations[goTypeKey] != keyValueSliceType) &&
+		!isMapContainer(spec) && isHashable(spec.KeySpec)
+}
+
 // isPrimitiveType returns true if the given type is a primitive type.
 // Primitive types, enums, and typedefs of primitive types are considered
 // primitive.
@@ -63,10 +71,15 @@ func setUsesMap(spec *compile.SetSpec) bool {
 // Note that binary is not considered a primitive type because it is
 // represented as []byte in Go.
 func isPrimitiveType(spec compile.TypeSpec) bool {
+	if isBoundTypedef(spec) {
+		// Typedefs bound to Go types are stored by value like primitives.
+		return true
+	}
+
 	spec = compile.RootTypeSpec(spec)
 	switch spec.(type) {
 	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
-		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.UUIDSpec:
 		return true
 	}
 
@@ -85,6 +98,10 @@ func isStringType(spec compile.TypeSpec) bool {
 //
 // Sets, maps, lists, and slices are reference types.
 func isReferenceType(spec compile.TypeSpec) bool {
+	if isBoundTypedef(spec) {
+		return false
+	}
+
 	spec = compile.RootTypeSpec(spec)
 	if _, ok := spec.(*compile.BinarySpec); ok {
 		return true
@@ -100,6 +117,10 @@ func isReferenceType(spec compile.TypeSpec) bool {
 
 // Used to allow special treatment of slices in ToWire and treat nil as a valid slice.
 func isListType(spec compile.TypeSpec) bool {
+	if isBoundTypedef(spec) {
+		return false
+	}
+
 	// Lookup aliases for our type.
 	spec = compile.RootTypeSpec(spec)
 	_, isList := spec.(*compile.ListSpec)
@@ -107,6 +128,10 @@ func isListType(spec compile.TypeSpec) bool {
 }
 
 func isStructType(spec compile.TypeSpec) bool {
+	if isBoundTypedef(spec) {
+		return false
+	}
+
 	spec = compile.RootTypeSpec(spec)
 	_, isStruct := spec.(*compile.StructSpec)
 	return isStruct
@@ -145,6 +170,14 @@ func typeReferencePtr(g Generator, spec compile.TypeSpec) (string, error) {
 // typeName returns the name of the given type, whether it's a custom type or
 // native.
 func typeName(g Generator, spec compile.TypeSpec) (string, error) {
+	if t, ok := spec.(*compile.TypedefSpec); ok && isBoundTypedef(t) {
+		return boundTypeName(g, t)
+	}
+
+	if isUnsigned(spec) {
+		return unsignedName(spec), nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return "bool", nil
@@ -162,7 +195,16 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 		return "string", nil
 	case *compile.BinarySpec:
 		return "[]byte", nil
+	case *compile.UUIDSpec:
+		return uuidTypeName(g, s)
 	case *compile.MapSpec:
+		if isMapContainer(s) {
+			c, err := containerName(g, s)
+			if err != nil {
+				return "", err
+			}
+			return "*" + c, nil
+		}
 		k, err := typeReference(g, s.KeySpec)
 		if err != nil {
 			return "", err
@@ -171,8 +213,8 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 		if err != nil {
 			return "", err
 		}
-		if !isHashable(s.KeySpec) {
-			// unhashable type
+		if !mapUsesMap(s) {
+			// unhashable type or annotated to be a slice
 			return fmt.Sprintf("[]struct{Key %s; Value %s}", k, v), nil
 		}
 		return fmt.Sprintf("map[%s]%s", k, v), nil
@@ -207,6 +249,18 @@ func equalsPtrFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_EqualsPtr", g.MangleType(spec))
 }
 
+func copyFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Copy", g.MangleType(spec))
+}
+
+func copyPtrFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_CopyPtr", g.MangleType(spec))
+}
+
+func hashFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Hash", g.MangleType(spec))
+}
+
 func readerFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_Read", g.MangleType(spec))
 }
@@ -233,6 +287,6 @@ func zapperName(g Generator, spec compile.TypeSpec) string {
 // canBeConstant returns true if the given type can be a constant.
 func canBeConstant(t compile.TypeSpec) bool {
 	// Only primitives can use const declarations. Everything else has to be a
-	// `var` declaration.
-	return isPrimitiveType(t)
+	// `var` declaration. UUIDs are arrays, which cannot be constants.
+	return isPrimitiveType(t) && !isBoundTypedef(t) && !isUUIDType(t)
 }
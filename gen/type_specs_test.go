@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	ts "go.uber.org/thriftrw/gen/internal/tests/type-specs"
+	"go.uber.org/thriftrw/thriftreflect"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeSpecs(t *testing.T) {
+	assert.Len(t, ts.TypeSpecs, 7)
+	for name, spec := range ts.TypeSpecs {
+		assert.Equal(t, name, spec.Name)
+	}
+
+	tests := []struct {
+		spec     *thriftreflect.TypeSpec
+		kind     thriftreflect.Kind
+		wireType wire.Type
+		want     thriftreflect.Value
+	}{
+		{ts.PointTypeSpec, thriftreflect.KindStruct, wire.TStruct, &ts.Point{}},
+		{ts.SelectionTypeSpec, thriftreflect.KindUnion, wire.TStruct, &ts.Selection{}},
+		{ts.GraphErrorTypeSpec, thriftreflect.KindException, wire.TStruct, &ts.GraphError{}},
+		{ts.ShapeTypeSpec, thriftreflect.KindEnum, wire.TI32, new(ts.Shape)},
+		{ts.PathTypeSpec, thriftreflect.KindTypedef, wire.TList, new(ts.Path)},
+		{ts.LabelTypeSpec, thriftreflect.KindTypedef, wire.TBinary, new(ts.Label)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec.Name, func(t *testing.T) {
+			assert.Equal(t, tt.kind, tt.spec.Kind)
+			assert.Equal(t, tt.wireType, tt.spec.WireType)
+			assert.Equal(t, tt.want, tt.spec.New())
+		})
+	}
+}
+
+func TestTypeSpecFields(t *testing.T) {
+	assert.Equal(t, []thriftreflect.FieldSpec{
+		{ID: 1, Name: "points", Type: "list<Point>", WireType: wire.TList, Required: true},
+		{
+			ID:          2,
+			Name:        "shapes",
+			Type:        "map<Label, Shape>",
+			WireType:    wire.TMap,
+			Annotations: map[string]string{"docs.deprecated": "use points"},
+		},
+		{
+			ID:          3,
+			Name:        "secret",
+			Type:        "string",
+			WireType:    wire.TBinary,
+			Annotations: map[string]string{"go.encrypt": "default"},
+		},
+	}, ts.GraphTypeSpec.Fields)
+
+	assert.Nil(t, ts.ShapeTypeSpec.Fields)
+}
+
+func TestTypeSpecAnnotations(t *testing.T) {
+	tests := []struct {
+		spec *thriftreflect.TypeSpec
+		want map[string]string
+	}{
+		{ts.GraphTypeSpec, map[string]string{"owner": "graphs"}},
+		{ts.ShapeTypeSpec, map[string]string{"docs.category": "geometry"}},
+		{ts.LabelTypeSpec, map[string]string{"docs.example": "origin"}},
+		{ts.PointTypeSpec, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec.Name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.spec.Annotations)
+		})
+	}
+
+	f, ok := ts.SelectionTypeSpec.FieldByName("path")
+	require.True(t, ok)
+	assert.Equal(t, "Path", f.Type)
+	assert.Nil(t, f.Annotations)
+}
+
+func TestTypeSpecFromWire(t *testing.T) {
+	give := &ts.Point{X: 1, Y: 2}
+	w, err := give.ToWire()
+	require.NoError(t, err)
+
+	got, err := ts.TypeSpecs["Point"].FromWire(w)
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+
+	_, err = ts.TypeSpecs["Shape"].FromWire(w)
+	assert.EqualError(t, err, "cannot decode Shape from TStruct: expected TI32")
+}
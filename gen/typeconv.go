@@ -0,0 +1,316 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// goTypeConvKey is a Thrift annotation which, along with go.type, binds a
+// typedef to an existing Go type. go.typeconv names the functions which
+// convert values of that type to and from the Go type of the typedef's
+// target.
+//
+//	typedef binary UUID (
+//	    go.type = "github.com/google/uuid.UUID",
+//	    go.typeconv = "example.com/uuidconv.UUIDToBytes/BytesToUUID",
+//	)
+//
+// The functions must have the following signatures, where T is the bound
+// type and R is the Go type of the target.
+//
+//	func UUIDToBytes(T) (R, error)
+//	func BytesToUUID(R) (T, error)
+//
+// No Go type is generated for such typedefs; code which uses them uses T
+// directly, and optional values of it are pointers.
+const goTypeConvKey = "go.typeconv"
+
+// typeBinding is a Go type that a typedef is bound to, and the functions
+// that convert between it and the Go type of the typedef's target.
+type typeBinding struct {
+	ImportPath string
+	Name       string
+
+	ConvImportPath string
+	ToFunc         string
+	FromFunc       string
+}
+
+// isBoundTypedef returns true if the given type is a typedef bound to a Go
+// type with go.typeconv.
+func isBoundTypedef(spec compile.TypeSpec) bool {
+	t, ok := spec.(*compile.TypedefSpec)
+	if !ok {
+		return false
+	}
+	_, ok = t.Annotations[goTypeConvKey]
+	return ok
+}
+
+// typedefBinding returns the Go type that the given typedef is bound to, or
+// nil if it is not bound to one.
+func typedefBinding(spec *compile.TypedefSpec) (*typeBinding, error) {
+	conv, ok := spec.Annotations[goTypeConvKey]
+	if !ok {
+		if _, ok := spec.Annotations[goTypeKey]; ok {
+			return nil, fmt.Errorf("%v annotation on typedef requires a %v annotation", goTypeKey, goTypeConvKey)
+		}
+		return nil, nil
+	}
+
+	var b typeBinding
+	b.ImportPath, b.Name, ok = splitGoName(spec.Annotations[goTypeKey])
+	if !ok {
+		return nil, fmt.Errorf(
+			"invalid %v annotation %q: expected a Go type as in %q",
+			goTypeKey, spec.Annotations[goTypeKey], "github.com/google/uuid.UUID")
+	}
+
+	i := strings.LastIndexByte(conv, '/')
+	if i >= 0 {
+		b.FromFunc = conv[i+1:]
+		b.ConvImportPath, b.ToFunc, ok = splitGoName(conv[:i])
+	}
+	if i < 0 || !ok || !token.IsIdentifier(b.FromFunc) || !token.IsExported(b.FromFunc) {
+		return nil, fmt.Errorf(
+			"invalid %v annotation %q: expected conversion functions as in %q",
+			goTypeConvKey, conv, "example.com/uuidconv.UUIDToBytes/BytesToUUID")
+	}
+	return &b, nil
+}
+
+// boundTypeName returns the name of the Go type that the given typedef is
+// bound to, importing its package if needed.
+func boundTypeName(g Generator, spec *compile.TypedefSpec) (string, error) {
+	b, err := typedefBinding(spec)
+	if err != nil {
+		return "", err
+	}
+	return g.Import(b.ImportPath) + "." + b.Name, nil
+}
+
+// boundFuncs returns references to the functions which convert values of
+// the given bound typedef to and from its target.
+func boundFuncs(g Generator, spec *compile.TypedefSpec) (to, from string, err error) {
+	b, err := typedefBinding(spec)
+	if err != nil {
+		return "", "", err
+	}
+	pkg := g.Import(b.ConvImportPath)
+	return pkg + "." + b.ToFunc, pkg + "." + b.FromFunc, nil
+}
+
+// boundTypedef verifies that the given typedef is bound correctly. No code
+// is generated for it until it is used.
+func boundTypedef(spec *compile.TypedefSpec) error {
+	if _, err := typedefBinding(spec); err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if _, ok := spec.Annotations[sqlKey]; ok {
+		return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+			"%v annotation is not supported on typedefs bound to Go types", sqlKey))
+	}
+	if isBoundTypedef(spec.Target) {
+		return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+			"cannot bind %v to a Go type: %v is already bound to one",
+			spec.ThriftName(), spec.Target.ThriftName()))
+	}
+	return nil
+}
+
+// The functions below generate helpers which convert values of bound
+// typedefs to their targets and use the code generated for the targets.
+
+// boundData is the data passed to templates of bound typedefs.
+type boundData struct {
+	Name string
+	Spec *compile.TypedefSpec
+	To   string
+	From string
+}
+
+func newBoundData(g Generator, name string, spec *compile.TypedefSpec) (boundData, error) {
+	to, from, err := boundFuncs(g, spec)
+	return boundData{Name: name, Spec: spec, To: to, From: from}, err
+}
+
+func (t *typedefGenerator) boundHelper(g Generator, name string, spec *compile.TypedefSpec, tmpl string, opts ...TemplateOption) (string, error) {
+	data, err := newBoundData(g, name, spec)
+	if err == nil {
+		err = g.EnsureDeclared(tmpl, data, opts...)
+	}
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+func (t *typedefGenerator) boundReader(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, readerFuncName(g, spec), spec,
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$w := newVar "w">
+		<$x := newVar "x">
+		func <.Name>(<$w> <$wire>.Value) (<typeReference .Spec>, error) {
+			<$x>, err := <fromWire .Spec.Target $w>
+			if err != nil {
+				var <$x> <typeReference .Spec>
+				return <$x>, err
+			}
+			return <.From>(<$x>)
+		}
+		`)
+}
+
+func (t *typedefGenerator) boundDecoder(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, decoderFuncName(g, spec), spec,
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$sr := newVar "sr">
+		<$x := newVar "x">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeReference .Spec>, error) {
+			<$x>, err := <decode .Spec.Target $sr>
+			if err != nil {
+				var <$x> <typeReference .Spec>
+				return <$x>, err
+			}
+			return <.From>(<$x>)
+		}
+		`)
+}
+
+// boundToWire generates a function which returns the Value of a bound
+// typedef, and an error if it could not be converted.
+func (t *typedefGenerator) boundToWire(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, fmt.Sprintf("_%s_ToWire", g.MangleType(spec)), spec,
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$v := newVar "v">
+		<$x := newVar "x">
+		func <.Name>(<$v> <typeReference .Spec>) (<$wire>.Value, error) {
+			<$x>, err := <.To>(<$v>)
+			if err != nil {
+				return <$wire>.Value{}, err
+			}
+			return <toWire .Spec.Target $x>
+		}
+		`)
+}
+
+func (t *typedefGenerator) boundEncoder(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, encoderFuncName(g, spec), spec,
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$v := newVar "v">
+		<$sw := newVar "sw">
+		<$x := newVar "x">
+		func <.Name>(<$v> <typeReference .Spec>, <$sw> <$stream>.Writer) error {
+			<$x>, err := <.To>(<$v>)
+			if err != nil {
+				return err
+			}
+			return <encode .Spec.Target $x $sw>
+		}
+		`)
+}
+
+// boundEquals generates a function which compares values of a bound typedef
+// by comparing what they convert to. Values which cannot be converted are
+// not equal to anything.
+func (t *typedefGenerator) boundEquals(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, equalsFuncName(g, spec), spec,
+		`
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+		<$x := newVar "x">
+		<$y := newVar "y">
+		func <.Name>(<$lhs>, <$rhs> <typeReference .Spec>) bool {
+			<$x>, err := <.To>(<$lhs>)
+			if err != nil {
+				return false
+			}
+			<$y>, err := <.To>(<$rhs>)
+			if err != nil {
+				return false
+			}
+			return <equals .Spec.Target $x $y>
+		}
+		`)
+}
+
+func (t *typedefGenerator) boundHash(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, hashFuncName(g, spec), spec,
+		`
+		<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+
+		<$v := newVar "v">
+		<$h := newVar "h">
+		<$x := newVar "x">
+		func <.Name>(<$v> <typeReference .Spec>) uint64 {
+			<$h> := <$thrifthash>.New()
+			if <$x>, err := <.To>(<$v>); err == nil {
+				<hash .Spec.Target $h $x>
+			}
+			return <$h>.Sum64()
+		}
+		`)
+}
+
+// boundZapValue generates a function which converts values of a bound
+// typedef to their target for logging. Values which cannot be converted are
+// logged as the zero value of the target.
+func (t *typedefGenerator) boundZapValue(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, fmt.Sprintf("_%s_ZapValue", g.MangleType(spec)), spec,
+		`
+		<$v := newVar "v">
+		<$x := newVar "x">
+		func <.Name>(<$v> <typeReference .Spec>) <typeReference .Spec.Target> {
+			<$x>, _ := <.To>(<$v>)
+			return <$x>
+		}
+		`)
+}
+
+// boundConstant generates a function which converts constant values of the
+// target of a bound typedef. It panics if the conversion fails because
+// constants are initialized when the package is.
+func (t *typedefGenerator) boundConstant(g Generator, spec *compile.TypedefSpec) (string, error) {
+	return t.boundHelper(g, fmt.Sprintf("_%s_FromConstant", g.MangleType(spec)), spec,
+		`
+		<$fmt := import "fmt">
+
+		<$x := newVar "x">
+		<$v := newVar "v">
+		func <.Name>(<$x> <typeReference .Spec.Target>) <typeReference .Spec> {
+			<$v>, err := <.From>(<$x>)
+			if err != nil {
+				panic(<$fmt>.Sprintf("invalid <.Spec.ThriftName> constant %v: %v", <$x>, err))
+			}
+			return <$v>
+		}
+		`)
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tbt "go.uber.org/thriftrw/gen/internal/tests/bound_types"
+	"go.uber.org/thriftrw/gen/internal/tests/domain"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestBoundTypedefRoundTrip(t *testing.T) {
+	id := domain.UUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	parent := domain.UUID{42}
+	createdAt := time.Unix(1600000000, 123000000).UTC()
+	expiresAt := time.Unix(86400, 0).UTC()
+
+	x := tbt.Event{
+		ID:        id,
+		ParentID:  &parent,
+		CreatedAt: createdAt,
+		Related:   []domain.UUID{parent},
+		ByName:    map[string]domain.UUID{"self": id},
+		ExpiresAt: &expiresAt,
+	}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary(id[:])},
+		{ID: 2, Value: wire.NewValueBinary(parent[:])},
+		{ID: 3, Value: wire.NewValueI64(1600000000123)},
+		{ID: 5, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TBinary, []wire.Value{wire.NewValueBinary(parent[:])}),
+		)},
+		{ID: 8, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TBinary, []wire.MapItem{
+				{Key: wire.NewValueString("self"), Value: wire.NewValueBinary(id[:])},
+			}),
+		)},
+		{ID: 9, Value: wire.NewValueI64(86400000)},
+	}})
+
+	assertRoundTrip(t, &x, v, "Event")
+	testRoundTripCombos(t, &x, v, "Event")
+}
+
+func TestBoundTypedefConversionError(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary([]byte{1, 2, 3})},
+		{ID: 3, Value: wire.NewValueI64(0)},
+	}})
+
+	var x tbt.Event
+	err := x.FromWire(v)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid UUID: expected 16 bytes, got 3")
+}
+
+func TestBoundTypedefEqualsCopyHash(t *testing.T) {
+	at := time.Unix(1600000000, 0)
+	x := &tbt.Event{ID: domain.UUID{1}, CreatedAt: at, Tags: []domain.UUID{{2}, {3}}}
+
+	// Times in different locations are equal if they are the same instant
+	// because they are compared as milliseconds.
+	y := &tbt.Event{ID: domain.UUID{1}, CreatedAt: at.UTC(), Tags: []domain.UUID{{3}, {2}}}
+	assert.True(t, x.Equals(y))
+	assert.Equal(t, x.Hash(), y.Hash())
+
+	assert.False(t, x.Equals(&tbt.Event{ID: domain.UUID{2}, CreatedAt: at}))
+	assert.False(t, x.Equals(&tbt.Event{ID: domain.UUID{1}, CreatedAt: at.Add(time.Second)}))
+
+	c := x.Copy()
+	assert.True(t, x.Equals(c))
+	c.Tags[0] = domain.UUID{4}
+	assert.Equal(t, domain.UUID{2}, x.Tags[0], "copies must not share slices")
+}
+
+func TestBoundTypedefConstants(t *testing.T) {
+	assert.True(t, tbt.Epoch.Equal(time.Unix(0, 0)))
+	assert.Equal(t, time.Unix(86400, 0).UTC(), *tbt.Default_Event().ExpiresAt)
+}
+
+func TestBoundTypedefZap(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	x := &tbt.Event{ID: domain.UUID{1}, CreatedAt: time.Unix(1, 0)}
+	require.NoError(t, x.MarshalLogObject(enc))
+	assert.Equal(t, "AQAAAAAAAAAAAAAAAAAAAA==", enc.Fields["id"])
+	assert.Equal(t, int64(1000), enc.Fields["createdAt"])
+}
+
+func TestTypedefBinding(t *testing.T) {
+	tests := []struct {
+		desc        string
+		annotations compile.Annotations
+		want        *typeBinding
+		wantErr     string
+	}{
+		{desc: "not bound"},
+		{
+			desc: "bound",
+			annotations: compile.Annotations{
+				"go.type":     "github.com/google/uuid.UUID",
+				"go.typeconv": "example.com/uuidconv.UUIDToBytes/BytesToUUID",
+			},
+			want: &typeBinding{
+				ImportPath:     "github.com/google/uuid",
+				Name:           "UUID",
+				ConvImportPath: "example.com/uuidconv",
+				ToFunc:         "UUIDToBytes",
+				FromFunc:       "BytesToUUID",
+			},
+		},
+		{
+			desc:        "no go.typeconv",
+			annotations: compile.Annotations{"go.type": "time.Time"},
+			wantErr:     "go.type annotation on typedef requires a go.typeconv annotation",
+		},
+		{
+			desc:        "no go.type",
+			annotations: compile.Annotations{"go.typeconv": "example.com/conv.To/From"},
+			wantErr:     `invalid go.type annotation ""`,
+		},
+		{
+			desc: "no from function",
+			annotations: compile.Annotations{
+				"go.type":     "time.Time",
+				"go.typeconv": "example.com/conv.To",
+			},
+			wantErr: `invalid go.typeconv annotation "example.com/conv.To"`,
+		},
+		{
+			desc: "unexported function",
+			annotations: compile.Annotations{
+				"go.type":     "time.Time",
+				"go.typeconv": "example.com/conv.To/from",
+			},
+			wantErr: `invalid go.typeconv annotation "example.com/conv.To/from"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			spec := &compile.TypedefSpec{Name: "Foo", Target: &compile.BinarySpec{}, Annotations: tt.annotations}
+			got, err := typedefBinding(spec)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTypedefOfBoundTypedef(t *testing.T) {
+	bound := &compile.TypedefSpec{
+		Name:   "Timestamp",
+		Target: &compile.I64Spec{},
+		Annotations: compile.Annotations{
+			"go.type":     "time.Time",
+			"go.typeconv": "example.com/conv.TimeToMillis/MillisToTime",
+		},
+	}
+	err := typedef(NewGenerator(&GeneratorOptions{}), &compile.TypedefSpec{Name: "CreatedAt", Target: bound})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "typedefs of Timestamp are not supported: it is bound to a Go type")
+}
@@ -20,12 +20,20 @@
 
 package gen
 
-import "go.uber.org/thriftrw/compile"
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
 
 // typedefGenerator generates code to serialize and deserialize typedefs.
 type typedefGenerator struct{}
 
 func (t *typedefGenerator) Reader(g Generator, spec *compile.TypedefSpec) (string, error) {
+	if isBoundTypedef(spec) {
+		return t.boundReader(g, spec)
+	}
+
 	name := readerFuncName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -53,6 +61,10 @@ func (t *typedefGenerator) Reader(g Generator, spec *compile.TypedefSpec) (strin
 }
 
 func (t *typedefGenerator) Decoder(g Generator, spec *compile.TypedefSpec) (string, error) {
+	if isBoundTypedef(spec) {
+		return t.boundDecoder(g, spec)
+	}
+
 	name := decoderFuncName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -79,15 +91,42 @@ func (t *typedefGenerator) Decoder(g Generator, spec *compile.TypedefSpec) (stri
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+// keyValueSlice returns the map that the given typedef refers to if it is
+// annotated with (go.type = "keyvalue-slice"), and nil otherwise.
+func keyValueSlice(spec *compile.TypedefSpec) *compile.MapSpec {
+	m, ok := compile.RootTypeSpec(spec).(*compile.MapSpec)
+	if !ok || m.Annotations[goTypeKey] != keyValueSliceType {
+		return nil
+	}
+	return m
+}
+
 // typedef generates code for the given typedef.
 func typedef(g Generator, spec *compile.TypedefSpec) error {
+	if isBoundTypedef(spec) {
+		return boundTypedef(spec)
+	}
+	if _, err := typedefBinding(spec); err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if isBoundTypedef(spec.Target) {
+		// The bound type is not ours to declare methods on.
+		return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+			"typedefs of %v are not supported: it is bound to a Go type", spec.Target.ThriftName()))
+	}
+	if m, ok := compile.RootTypeSpec(spec.Target).(*compile.MapSpec); ok && isMapContainer(m) {
+		// Go does not allow methods on named pointer types.
+		return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+			"typedefs of maps annotated with a container type are not supported: "+
+				"annotate the fields that use %v instead", spec.ThriftName()))
+	}
+
 	err := g.DeclareFromTemplate(
 		`
-		<$stream := import "go.uber.org/thriftrw/protocol/stream">
 		<$wire := import "go.uber.org/thriftrw/wire">
 		<$typedefType := typeReference .>
 
-		<formatDoc .Doc>type <typeName .> <typeName .Target>
+		<formatDoc (deprecatedDoc (sourceDoc .Doc .File .Line) .Annotations)>type <typeName .> <typeName .Target>
 
 		<$v := newVar "v">
 		<$x := newVar "x">
@@ -119,10 +158,13 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			<- end>
 		}
 
+		<if not (checkNoStreaming) ->
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
 		func (<$v> <$typedefType>) Encode(<$sw> <$stream>.Writer) error {
 			<$x> := (<typeReference .Target>)(<$v>)
 			return <encode .Target $x $sw>
 		}
+		<- end>
 
 		<$w := newVar "w">
 		// FromWire deserializes <typeName .> from its Thrift-level
@@ -138,6 +180,8 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			<- end>
 		}
 
+		<if not (checkNoStreaming) ->
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
 		<$sr := newVar "sr">
 		// Decode deserializes <typeName .> directly off the wire.
 		func (<$v> *<typeName .>) Decode(<$sr> <$stream>.Reader) error {
@@ -149,6 +193,7 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 				return err
 			<- end>
 		}
+		<- end>
 
 		<$lhs := newVar "lhs">
 		<$rhs := newVar "rhs">
@@ -160,6 +205,60 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			return <equals .Target $lhsCast $rhsCast>
 		}
 
+		<if not (isPrimitiveType .) ->
+		// Copy returns a deep copy of this <typeName .>.
+		func (<$v> <$typedefType>) Copy() <$typedefType> {
+			<$x> := (<typeReference .Target>)(<$v>)
+			return (<$typedefType>)(<copy .Target $x>)
+		}
+		<- end>
+
+		<with keyValueSlice . ->
+		<$key := newVar "key">
+		<$i := newVar "i">
+		// Get returns the value for the given key in <typeName $> and
+		// whether the key was found.
+		func (<$v> <$typedefType>) Get(<$key> <typeReference .KeySpec>) (<typeReference .ValueSpec>, bool) {
+			for _, <$i> := range <$v> {
+				if <equals .KeySpec (printf "%v.Key" $i) $key> {
+					return <$i>.Value, true
+				}
+			}
+			var <$x> <typeReference .ValueSpec>
+			return <$x>, false
+		}
+
+		// Has returns true if the given key is present in <typeName $>.
+		func (<$v> <$typedefType>) Has(<$key> <typeReference .KeySpec>) bool {
+			_, ok := <$v>.Get(<$key>)
+			return ok
+		}
+		<- end>
+
+		<$thrifthash := import "go.uber.org/thriftrw/thrifthash">
+		<$h := newVar "h">
+		// Hash returns a hash of this <typeName .> which is stable across
+		// processes.
+		func (<$v> <$typedefType>) Hash() uint64 {
+			<$h> := <$thrifthash>.New()
+			<hash .Target $h (printf "(%v)(%v)" (typeReference .Target) $v)>
+			return <$h>.Sum64()
+		}
+
+		<if isUUIDType . ->
+		<$thriftuuid := import "go.uber.org/thriftrw/thriftuuid">
+		<$text := newVar "text">
+		// MarshalText encodes <typeName .> in the canonical UUID format.
+		func (<$v> <$typedefType>) MarshalText() ([]byte, error) {
+			return <$thriftuuid>.UUID(<$v>).MarshalText()
+		}
+
+		// UnmarshalText decodes <typeName .> from the canonical UUID format.
+		func (<$v> *<$typedefType>) UnmarshalText(<$text> []byte) error {
+			return (*<$thriftuuid>.UUID)(<$v>).UnmarshalText(<$text>)
+		}
+		<- end>
+
 		<if not (checkNoZap) ->
 		</* We want the behavior of the underlying type for typedefs: in the case that
 				they are objects or arrays, we need to cast to the underlying object or array;
@@ -181,6 +280,28 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 		`,
 		spec,
 		TemplateFunc("checkNoZap", checkNoZap),
+		TemplateFunc("checkNoStreaming", checkNoStreaming),
+		TemplateFunc("keyValueSlice", keyValueSlice),
 	)
+	if err != nil {
+		return wrapGenerateError(spec.Name, err)
+	}
+
+	if checkCompare(g) {
+		if err := compareTypedef(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if checkEncodedSize(g) && hasEncodedSize(spec) {
+		if err := sizeTypedef(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	sg, ok, err := newSQLGenerator(spec)
+	if err == nil && ok {
+		err = sg.Generate(g)
+	}
 	return wrapGenerateError(spec.Name, err)
 }
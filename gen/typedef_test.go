@@ -23,6 +23,7 @@ package gen
 import (
 	"testing"
 
+	tkv "go.uber.org/thriftrw/gen/internal/tests/keyvalue_slice"
 	tss "go.uber.org/thriftrw/gen/internal/tests/set_to_slice"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
 	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
@@ -620,3 +621,77 @@ func TestTypedefAnnotatedSetToSlice(t *testing.T) {
 	testRoundTripCombos(t, &g, ll, "StringListList")
 	assert.Equal(t, "[[foo]]", g.String())
 }
+
+func TestTypedefAnnotatedMapToKeyValueSlice(t *testing.T) {
+	counts := tkv.Counts{
+		{Key: "foo", Value: 1},
+		{Key: "bar", Value: 2},
+	}
+	v := wire.NewValueMap(
+		wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{
+			{Key: wire.NewValueString("foo"), Value: wire.NewValueI32(1)},
+			{Key: wire.NewValueString("bar"), Value: wire.NewValueI32(2)},
+		}),
+	)
+
+	assertRoundTrip(t, &counts, v, "Counts")
+	testRoundTripCombos(t, &counts, v, "Counts")
+	assert.True(t, counts.Equals(counts.Copy()))
+
+	got, ok := counts.Get("bar")
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), got)
+	assert.True(t, counts.Has("foo"))
+
+	got, ok = counts.Get("baz")
+	assert.False(t, ok)
+	assert.Zero(t, got)
+	assert.False(t, counts.Has("baz"))
+	assert.False(t, tkv.Counts(nil).Has("foo"))
+
+	my := tkv.MyCounts(counts)
+	assert.True(t, my.Has("foo"))
+
+	labels := tkv.Labels{
+		{Key: &tkv.Key{Name: "a", Parts: []string{"x"}}, Value: "first"},
+		{Key: &tkv.Key{Name: "a", Parts: []string{"y"}}, Value: "second"},
+	}
+	label, ok := labels.Get(&tkv.Key{Name: "a", Parts: []string{"y"}})
+	assert.True(t, ok)
+	assert.Equal(t, "second", label)
+	assert.False(t, labels.Has(&tkv.Key{Name: "a"}))
+}
+
+func TestStructAnnotatedMapToKeyValueSlice(t *testing.T) {
+	x := tkv.Tally{
+		RequiredTotals: []struct {
+			Key   string
+			Value int64
+		}{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		RequiredTypedefCounts: tkv.Counts{},
+		Totals:                map[string]int64{"b": 3},
+	}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TI64, []wire.MapItem{
+				{Key: wire.NewValueString("a"), Value: wire.NewValueI64(1)},
+				{Key: wire.NewValueString("b"), Value: wire.NewValueI64(2)},
+			}),
+		)},
+		{ID: 3, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{}),
+		)},
+		{ID: 5, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TI64, []wire.MapItem{
+				{Key: wire.NewValueString("b"), Value: wire.NewValueI64(3)},
+			}),
+		)},
+	}})
+
+	assertRoundTrip(t, &x, v, "Tally")
+	testRoundTripCombos(t, &x, v, "Tally")
+	assert.True(t, x.Equals(x.Copy()))
+
+	assert.Len(t, tkv.ConstCounts, 2)
+	assert.True(t, tkv.ConstTypedefCounts.Has("foo"))
+}
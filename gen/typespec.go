@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// typeSpecs generates a <Name>TypeSpec variable describing each of the
+// given types, and a TypeSpecs map holding all of them keyed by their Thrift
+// names.
+func typeSpecs(g Generator, types map[string]compile.TypeSpec) error {
+	var specs []compile.TypeSpec
+	for _, name := range sortStringKeys(types) {
+		spec := types[name]
+		if isBoundTypedef(spec) {
+			// No Go type is generated for typedefs bound to Go types.
+			continue
+		}
+		if err := typeSpec(g, spec); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+		specs = append(specs, spec)
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$reflect := import "go.uber.org/thriftrw/thriftreflect">
+
+		// TypeSpecs describes the types defined in this package, keyed by
+		// their names in the Thrift file.
+		var TypeSpecs = map[string]*<$reflect>.TypeSpec{
+			<range .>"<.ThriftName>": <typeName .>TypeSpec,
+			<end>
+		}
+		`, specs)
+	return wrapGenerateError("type specs", err)
+}
+
+func typeSpec(g Generator, spec compile.TypeSpec) error {
+	// kind is the Thrift keyword for the type, and the thriftreflect.Kind
+	// constant is named after it.
+	var (
+		kind        string
+		fields      compile.FieldGroup
+		annotations compile.Annotations
+	)
+	switch s := spec.(type) {
+	case *compile.StructSpec:
+		switch s.Type {
+		case ast.UnionType:
+			kind = "union"
+		case ast.ExceptionType:
+			kind = "exception"
+		default:
+			kind = "struct"
+		}
+		fields = s.Fields
+		annotations = s.Annotations
+	case *compile.EnumSpec:
+		kind = "enum"
+		annotations = s.Annotations
+	case *compile.TypedefSpec:
+		kind = "typedef"
+		annotations = s.Annotations
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$reflect := import "go.uber.org/thriftrw/thriftreflect">
+
+		<define "annotations">
+			<- if . ->
+			Annotations: map[string]string{
+				<range $k, $v := .>
+					<- printf "%q" $k>: <printf "%q" $v>,
+				<end>
+			},
+			<- end>
+		<- end>
+
+		<$name := typeName .Spec>
+		// <$name>TypeSpec describes the <.Spec.ThriftName> <.Kind>.
+		var <$name>TypeSpec = &<$reflect>.TypeSpec{
+			Name: "<.Spec.ThriftName>",
+			Kind: <$reflect>.Kind<title .Kind>,
+			WireType: <typeCode .Spec>,
+			<- if .Fields>
+			Fields: []<$reflect>.FieldSpec{
+				<range .Fields ->
+				{
+					ID: <.ID>,
+					Name: "<.Name>",
+					Type: <printf "%q" (thriftType .Type)>,
+					WireType: <fieldTypeCode .>,
+					<- if .Required>
+					Required: true,
+					<- end>
+					<template "annotations" .Annotations>
+				},
+				<end>
+			},
+			<- end>
+			New: func() <$reflect>.Value { return new(<$name>) },
+			<template "annotations" .Annotations>
+		}
+		`,
+		struct {
+			Spec        compile.TypeSpec
+			Kind        string
+			Fields      compile.FieldGroup
+			Annotations compile.Annotations
+		}{Spec: spec, Kind: kind, Fields: fields, Annotations: annotations},
+		TemplateFunc("fieldTypeCode", curryGenerator(fieldTypeCode, g)),
+		TemplateFunc("thriftType", func(t compile.TypeSpec) string {
+			return thriftTypeName(t, spec.ThriftFile())
+		}),
+		TemplateFunc("title", func(s string) string {
+			return strings.ToUpper(s[:1]) + s[1:]
+		}),
+	)
+}
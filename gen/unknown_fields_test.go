@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unknown-fields"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeThrift encodes x with ToWire if streaming is false, and Encode
+// otherwise.
+func encodeThrift(t *testing.T, x thriftType, streaming bool) []byte {
+	var buf bytes.Buffer
+	if streaming {
+		sw := binary.NewStreamWriter(&buf)
+		require.NoError(t, x.Encode(sw), "Encode")
+		require.NoError(t, sw.Close())
+	} else {
+		v, err := x.ToWire()
+		require.NoError(t, err, "ToWire")
+		require.NoError(t, binary.Default.Encode(v, &buf), "binary.Encode")
+	}
+	return buf.Bytes()
+}
+
+// decodeThrift decodes bs into x with FromWire if streaming is false, and
+// Decode otherwise.
+func decodeThrift(t *testing.T, bs []byte, x thriftType, streaming bool) {
+	if streaming {
+		sr := binary.NewStreamReader(bytes.NewReader(bs))
+		defer sr.Close()
+		require.NoError(t, x.Decode(sr), "Decode")
+	} else {
+		v, err := binary.Default.Decode(bytes.NewReader(bs), wire.TStruct)
+		require.NoError(t, err, "binary.Decode")
+		require.NoError(t, x.FromWire(v), "FromWire")
+	}
+}
+
+func TestPreserveUnknownFields(t *testing.T) {
+	give := &tu.UserV2{
+		Name:    "alice",
+		Age:     ptr.Int32(42),
+		Emails:  []string{"alice@example.com", "a@example.com"},
+		Address: &tu.Address{City: ptr.String("Paris")},
+		Scores:  map[string]int64{"chess": 1800},
+		Flags:   map[int16]struct{}{1: {}, 7: {}},
+	}
+
+	for _, decodeStreaming := range []bool{false, true} {
+		for _, encodeStreaming := range []bool{false, true} {
+			name := fmt.Sprintf("stream-decode: %v, stream-encode: %v", decodeStreaming, encodeStreaming)
+			t.Run(name, func(t *testing.T) {
+				var old tu.UserV1
+				decodeThrift(t, encodeThrift(t, give, false), &old, decodeStreaming)
+				assert.Equal(t, "alice", old.Name)
+
+				old.Name = "bob"
+				var got tu.UserV2
+				decodeThrift(t, encodeThrift(t, &old, encodeStreaming), &got, false)
+
+				want := *give
+				want.Name = "bob"
+				assert.Equal(t, &want, &got)
+			})
+		}
+	}
+}
+
+func TestPreserveUnknownFieldsDecodeResets(t *testing.T) {
+	bs := encodeThrift(t, &tu.UserV2{Name: "alice", Age: ptr.Int32(42)}, false)
+
+	for _, streaming := range []bool{false, true} {
+		t.Run(fmt.Sprint("streaming: ", streaming), func(t *testing.T) {
+			var old tu.UserV1
+			decodeThrift(t, bs, &old, streaming)
+			decodeThrift(t, bs, &old, streaming)
+
+			v, err := old.ToWire()
+			require.NoError(t, err)
+			assert.Len(t, v.GetStruct().Fields, 2, "unknown fields must not accumulate")
+		})
+	}
+}
+
+func TestPreserveUnknownFieldsTypeMismatch(t *testing.T) {
+	// Field 1 of UserV1 is a string, but an i32 is provided.
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("alice")},
+		{ID: 1, Value: wire.NewValueI32(42)},
+	}})
+
+	var old tu.UserV1
+	require.NoError(t, old.FromWire(v))
+
+	got, err := old.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(v, got), "expected %v, got %v", v, got)
+}
+
+func TestPreserveUnknownFieldsAnnotation(t *testing.T) {
+	bs := encodeThrift(t, &tu.UserV2{Name: "alice", Age: ptr.Int32(42)}, false)
+
+	for _, streaming := range []bool{false, true} {
+		t.Run(fmt.Sprint("streaming: ", streaming), func(t *testing.T) {
+			var old tu.DroppingUserV1
+			decodeThrift(t, bs, &old, streaming)
+
+			var got tu.UserV2
+			decodeThrift(t, encodeThrift(t, &old, streaming), &got, false)
+			assert.Equal(t, &tu.UserV2{Name: "alice"}, &got)
+		})
+	}
+}
+
+func TestPreserveUnknownFieldsInvalidAnnotation(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name:        "Foo",
+		Annotations: compile.Annotations{"go.preserve_unknown_fields": "yes"},
+	}
+	err := structure(NewGenerator(&GeneratorOptions{}), spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`invalid go.preserve_unknown_fields annotation: "yes" is not a boolean`)
+}
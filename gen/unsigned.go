@@ -0,0 +1,265 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// goUnsignedKey is a Thrift annotation which maps an integer type to the
+// unsigned Go integer type of the same size. Values are reinterpreted as
+// two's complement on the wire, so the uint32 4294967295 is sent as the i32
+// -1.
+//
+//	struct Endpoint {
+//	    1: required i32 port (go.unsigned)
+//	}
+//
+//	typedef i64 Hash (go.unsigned)
+//
+// The annotation may also be placed on the type itself, as in
+// list<i16 (go.unsigned)>.
+const goUnsignedKey = "go.unsigned"
+
+// isUnsigned returns true if the given type is an integer type that maps to
+// an unsigned Go type.
+func isUnsigned(spec compile.TypeSpec) bool {
+	switch spec.(type) {
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
+	default:
+		return false
+	}
+
+	v, ok := spec.ThriftAnnotations()[goUnsignedKey]
+	if !ok {
+		return false
+	}
+	unsigned, err := parseUnsigned(v)
+	return err == nil && unsigned
+}
+
+// parseUnsigned parses the value of a go.unsigned annotation. The annotation
+// may be specified without a value.
+func parseUnsigned(v string) (bool, error) {
+	if v == "" {
+		return true, nil
+	}
+	unsigned, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %v annotation: %q is not a boolean", goUnsignedKey, v)
+	}
+	return unsigned, nil
+}
+
+// resolveUnsigned copies go.unsigned annotations on the fields and typedefs
+// of the given module onto the integer types they refer to, so that code
+// generation only has to look at types. Integer types are compiled
+// separately for each reference so this does not affect other fields.
+func resolveUnsigned(m *compile.Module) error {
+	for _, name := range sortStringKeys(m.Types) {
+		var err error
+		switch t := m.Types[name].(type) {
+		case *compile.TypedefSpec:
+			err = markUnsigned(t.Annotations, t.Target)
+		case *compile.StructSpec:
+			err = resolveUnsignedFields(t.Fields)
+		}
+		if err != nil {
+			return wrapGenerateError(name, err)
+		}
+	}
+
+	for _, name := range sortStringKeys(m.Constants) {
+		if err := checkUnsignedType(m.Constants[name].Type); err != nil {
+			return wrapGenerateError(name, err)
+		}
+	}
+
+	for _, serviceName := range sortStringKeys(m.Services) {
+		s := m.Services[serviceName]
+		for _, name := range sortStringKeys(s.Functions) {
+			if err := resolveUnsignedFunction(s.Functions[name]); err != nil {
+				return wrapGenerateError(serviceName+"."+name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resolveUnsignedFunction(f *compile.FunctionSpec) error {
+	if err := resolveUnsignedFields(compile.FieldGroup(f.ArgsSpec)); err != nil {
+		return err
+	}
+	if f.ResultSpec == nil {
+		return nil
+	}
+	if f.ResultSpec.ReturnType != nil {
+		if err := checkUnsignedType(f.ResultSpec.ReturnType); err != nil {
+			return err
+		}
+	}
+	return resolveUnsignedFields(f.ResultSpec.Exceptions)
+}
+
+func resolveUnsignedFields(fields compile.FieldGroup) error {
+	for _, f := range fields {
+		if err := markUnsigned(f.Annotations, f.Type); err != nil {
+			return wrapGenerateError(f.ThriftName(), err)
+		}
+	}
+	return nil
+}
+
+// markUnsigned copies the go.unsigned annotation, if any, from the given
+// annotations onto the given type.
+func markUnsigned(annotations compile.Annotations, spec compile.TypeSpec) error {
+	if err := checkUnsignedType(spec); err != nil {
+		return err
+	}
+
+	v, ok := annotations[goUnsignedKey]
+	if !ok {
+		return nil
+	}
+	if _, err := parseUnsigned(v); err != nil {
+		return err
+	}
+
+	var target *compile.Annotations
+	switch t := spec.(type) {
+	case *compile.I8Spec:
+		target = &t.Annotations
+	case *compile.I16Spec:
+		target = &t.Annotations
+	case *compile.I32Spec:
+		target = &t.Annotations
+	case *compile.I64Spec:
+		target = &t.Annotations
+	default:
+		return unsignedTypeError(spec)
+	}
+
+	// An annotation on the type itself takes precedence.
+	if _, ok := (*target)[goUnsignedKey]; !ok {
+		if *target == nil {
+			*target = make(compile.Annotations)
+		}
+		(*target)[goUnsignedKey] = v
+	}
+	return nil
+}
+
+// checkUnsignedType verifies go.unsigned annotations placed directly on the
+// given type and the types it contains. Named types are checked where they
+// are declared.
+func checkUnsignedType(spec compile.TypeSpec) error {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec, *compile.EnumSpec, *compile.StructSpec:
+		return nil
+	case *compile.ListSpec:
+		if err := checkUnsignedType(s.ValueSpec); err != nil {
+			return err
+		}
+	case *compile.SetSpec:
+		if err := checkUnsignedType(s.ValueSpec); err != nil {
+			return err
+		}
+	case *compile.MapSpec:
+		if err := checkUnsignedType(s.KeySpec); err != nil {
+			return err
+		}
+		if err := checkUnsignedType(s.ValueSpec); err != nil {
+			return err
+		}
+	}
+
+	v, ok := spec.ThriftAnnotations()[goUnsignedKey]
+	if !ok {
+		return nil
+	}
+	if _, err := parseUnsigned(v); err != nil {
+		return err
+	}
+	switch spec.(type) {
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
+		return nil
+	default:
+		return unsignedTypeError(spec)
+	}
+}
+
+func unsignedTypeError(spec compile.TypeSpec) error {
+	return fmt.Errorf("%v annotation is only supported on i8, i16, i32, and i64: found %v",
+		goUnsignedKey, spec.ThriftName())
+}
+
+// intBits returns the size in bits of the given integer type.
+func intBits(spec compile.TypeSpec) int {
+	switch spec.(type) {
+	case *compile.I8Spec:
+		return 8
+	case *compile.I16Spec:
+		return 16
+	case *compile.I32Spec:
+		return 32
+	case *compile.I64Spec:
+		return 64
+	default:
+		panic(fmt.Sprintf("intBits: %v is not an integer type", spec.ThriftName()))
+	}
+}
+
+// unsignedName returns the name of the unsigned Go type for the given
+// integer type.
+func unsignedName(spec compile.TypeSpec) string {
+	return fmt.Sprintf("uint%d", intBits(spec))
+}
+
+// unsignedGenerator generates functions to decode unsigned integers.
+type unsignedGenerator struct{}
+
+// Decoder returns the name of a function which reads a value of the given
+// unsigned integer type from a stream.Reader.
+func (unsignedGenerator) Decoder(g Generator, spec compile.TypeSpec) (string, error) {
+	name := fmt.Sprintf("_%s_Decode", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$sr := newVar "sr">
+		<$i := newVar "i">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeName .Spec>, error) {
+			<$i>, err := <$sr>.Read<.Method>()
+			return <typeName .Spec>(<$i>), err
+		}
+		`,
+		struct {
+			Name   string
+			Method string
+			Spec   compile.TypeSpec
+		}{Name: name, Method: fmt.Sprintf("Int%d", intBits(spec)), Spec: spec},
+	)
+	return name, err
+}
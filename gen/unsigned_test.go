@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unsigned"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUnsignedRoundTrip(t *testing.T) {
+	checksum := uint64(math.MaxUint64)
+	weight := uint16(math.MaxUint16)
+	digest := tu.Hash(1 << 63)
+
+	x := tu.Endpoint{
+		Host:     "localhost",
+		Port:     math.MaxUint32,
+		Checksum: &checksum,
+		Weight:   &weight,
+		Digest:   &digest,
+		Address:  []tu.Octet{192, 168, 0, 255},
+		Counters: map[uint8]uint64{200: 1 << 40},
+	}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("localhost")},
+		{ID: 2, Value: wire.NewValueI32(-1)},
+		{ID: 3, Value: wire.NewValueI32(8080)},
+		{ID: 4, Value: wire.NewValueI64(-1)},
+		{ID: 5, Value: wire.NewValueI16(-1)},
+		{ID: 6, Value: wire.NewValueI64(math.MinInt64)},
+		{ID: 7, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TI8, []wire.Value{
+				wire.NewValueI8(-64),
+				wire.NewValueI8(-88),
+				wire.NewValueI8(0),
+				wire.NewValueI8(-1),
+			}),
+		)},
+		{ID: 8, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TI8, wire.TI64, []wire.MapItem{
+				{Key: wire.NewValueI8(-56), Value: wire.NewValueI64(1 << 40)},
+			}),
+		)},
+	}})
+
+	port := tu.DefaultPort
+	x.BackupPort = &port
+	assertRoundTrip(t, &x, v, "Endpoint")
+	testRoundTripCombos(t, &x, v, "Endpoint")
+}
+
+func TestUnsignedConstants(t *testing.T) {
+	assert.Equal(t, tu.Port(8080), tu.DefaultPort)
+	assert.Equal(t, uint16(math.MaxUint16), tu.MaxUint16)
+	assert.Equal(t, tu.Hash(math.MaxUint64), tu.MaxHash)
+	assert.Equal(t, tu.DefaultPort, tu.Default_Endpoint().GetBackupPort())
+}
+
+func TestUnsignedValidate(t *testing.T) {
+	weight := uint16(math.MaxUint16)
+	x := tu.Endpoint{Host: "localhost", Weight: &weight}
+	assert.NoError(t, x.Validate())
+}
+
+func TestUnsignedZap(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	checksum := uint64(math.MaxUint64)
+	x := &tu.Endpoint{Host: "localhost", Port: math.MaxUint32, Checksum: &checksum}
+	require.NoError(t, x.MarshalLogObject(enc))
+	assert.Equal(t, uint32(math.MaxUint32), enc.Fields["port"])
+	assert.Equal(t, uint64(math.MaxUint64), enc.Fields["checksum"])
+}
+
+func TestResolveUnsigned(t *testing.T) {
+	tests := []struct {
+		desc    string
+		types   map[string]compile.TypeSpec
+		want    compile.TypeSpec
+		wantErr string
+	}{
+		{
+			desc: "field",
+			types: map[string]compile.TypeSpec{
+				"Foo": &compile.StructSpec{Name: "Foo", Fields: compile.FieldGroup{
+					{
+						Name:        "bar",
+						Type:        &compile.I32Spec{},
+						Annotations: compile.Annotations{"go.unsigned": ""},
+					},
+				}},
+			},
+			want: &compile.I32Spec{Annotations: compile.Annotations{"go.unsigned": ""}},
+		},
+		{
+			desc: "type annotation wins",
+			types: map[string]compile.TypeSpec{
+				"Foo": &compile.StructSpec{Name: "Foo", Fields: compile.FieldGroup{
+					{
+						Name:        "bar",
+						Type:        &compile.I32Spec{Annotations: compile.Annotations{"go.unsigned": "false"}},
+						Annotations: compile.Annotations{"go.unsigned": "true"},
+					},
+				}},
+			},
+			want: &compile.I32Spec{Annotations: compile.Annotations{"go.unsigned": "false"}},
+		},
+		{
+			desc: "not a boolean",
+			types: map[string]compile.TypeSpec{
+				"Foo": &compile.StructSpec{Name: "Foo", Fields: compile.FieldGroup{
+					{
+						Name:        "bar",
+						Type:        &compile.I32Spec{},
+						Annotations: compile.Annotations{"go.unsigned": "yes"},
+					},
+				}},
+			},
+			wantErr: `invalid go.unsigned annotation: "yes" is not a boolean`,
+		},
+		{
+			desc: "not an integer",
+			types: map[string]compile.TypeSpec{
+				"Foo": &compile.TypedefSpec{
+					Name:        "Foo",
+					Target:      &compile.StringSpec{},
+					Annotations: compile.Annotations{"go.unsigned": ""},
+				},
+			},
+			wantErr: "go.unsigned annotation is only supported on i8, i16, i32, and i64: found string",
+		},
+		{
+			desc: "typedef field",
+			types: map[string]compile.TypeSpec{
+				"Foo": &compile.StructSpec{Name: "Foo", Fields: compile.FieldGroup{
+					{
+						Name:        "bar",
+						Type:        &compile.TypedefSpec{Name: "Bar", Target: &compile.I64Spec{}},
+						Annotations: compile.Annotations{"go.unsigned": ""},
+					},
+				}},
+			},
+			wantErr: "go.unsigned annotation is only supported on i8, i16, i32, and i64: found Bar",
+		},
+		{
+			desc: "nested in container",
+			types: map[string]compile.TypeSpec{
+				"Foo": &compile.TypedefSpec{
+					Name: "Foo",
+					Target: &compile.ListSpec{ValueSpec: &compile.DoubleSpec{
+						Annotations: compile.Annotations{"go.unsigned": ""},
+					}},
+				},
+			},
+			wantErr: "go.unsigned annotation is only supported on i8, i16, i32, and i64: found double",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := resolveUnsigned(&compile.Module{Types: tt.types})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tt.types["Foo"].(*compile.StructSpec).Fields[0].Type)
+		})
+	}
+}
+
+func TestUnsignedName(t *testing.T) {
+	assert.Equal(t, "uint8", unsignedName(&compile.I8Spec{}))
+	assert.Equal(t, "uint64", unsignedName(&compile.I64Spec{}))
+	assert.Panics(t, func() { unsignedName(&compile.StringSpec{}) })
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/thriftuuid"
+)
+
+const thriftuuidPackage = "go.uber.org/thriftrw/thriftuuid"
+
+// uuidGoType returns the import path and name of the Go type that the given
+// uuid is annotated to use with go.type, or empty strings if it uses
+// thriftuuid.UUID. The type must have an underlying type of [16]byte.
+//
+//	uuid (go.type = "github.com/google/uuid.UUID")
+func uuidGoType(spec *compile.UUIDSpec) (importPath, name string, err error) {
+	v, ok := spec.Annotations[goTypeKey]
+	if !ok {
+		return "", "", nil
+	}
+
+	importPath, name, ok = splitGoName(v)
+	if !ok {
+		return "", "", fmt.Errorf(
+			"invalid %v annotation %q on uuid: expected a Go type as in %q",
+			goTypeKey, v, "github.com/google/uuid.UUID")
+	}
+	return importPath, name, nil
+}
+
+// uuidTypeName returns the name of the Go type used for the given uuid.
+func uuidTypeName(g Generator, spec *compile.UUIDSpec) (string, error) {
+	importPath, name, err := uuidGoType(spec)
+	if err != nil {
+		return "", err
+	}
+	if importPath == "" {
+		return g.Import(thriftuuidPackage) + ".UUID", nil
+	}
+	return g.Import(importPath) + "." + name, nil
+}
+
+// isUUIDType returns true if the given type is a uuid or a typedef of one.
+func isUUIDType(spec compile.TypeSpec) bool {
+	if isBoundTypedef(spec) {
+		return false
+	}
+	_, ok := compile.RootTypeSpec(spec).(*compile.UUIDSpec)
+	return ok
+}
+
+// uuidValue generates an expression of type thriftuuid.UUID from v, a value
+// of the given type, which is a uuid or a typedef of one. The expression
+// may be used as the operand of a method call.
+func uuidValue(g Generator, spec compile.TypeSpec, v string) string {
+	if u, ok := spec.(*compile.UUIDSpec); ok {
+		if _, hasGoType := u.Annotations[goTypeKey]; !hasGoType {
+			if strings.HasPrefix(v, "*") {
+				return "(" + v + ")"
+			}
+			return v
+		}
+	}
+	return fmt.Sprintf("%s.UUID(%s)", g.Import(thriftuuidPackage), v)
+}
+
+// uuidGenerator generates code to deserialize uuids, which must be exactly
+// 16 bytes long.
+type uuidGenerator struct{}
+
+func (uuidGenerator) Reader(g Generator, spec *compile.UUIDSpec) (string, error) {
+	name := readerFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$thriftuuid := import "go.uber.org/thriftrw/thriftuuid">
+
+		<$w := newVar "w">
+		<$u := newVar "u">
+		func <.Name>(<$w> <$wire>.Value) (<typeName .Spec>, error) {
+			<$u>, err := <$thriftuuid>.FromBytes(<$w>.GetBinary())
+			return <typeName .Spec>(<$u>), err
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.UUIDSpec
+		}{Name: name, Spec: spec},
+	)
+	return name, wrapGenerateError("uuid", err)
+}
+
+func (uuidGenerator) Decoder(g Generator, spec *compile.UUIDSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+		<$thriftuuid := import "go.uber.org/thriftrw/thriftuuid">
+
+		<$sr := newVar "sr">
+		<$b := newVar "b">
+		<$u := newVar "u">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeName .Spec>, error) {
+			<$b>, err := <$sr>.ReadBinary()
+			if err != nil {
+				return <typeName .Spec>{}, err
+			}
+			<$u>, err := <$thriftuuid>.FromBytes(<$b>)
+			return <typeName .Spec>(<$u>), err
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.UUIDSpec
+		}{Name: name, Spec: spec},
+	)
+	return name, wrapGenerateError("uuid", err)
+}
+
+// uuidConstant generates a composite literal of the given type, which is a
+// uuid or a typedef of one, holding the UUID written in the given string.
+// The string was validated when the constant was linked.
+func uuidConstant(g Generator, v compile.ConstantString, t compile.TypeSpec) (string, error) {
+	u, err := thriftuuid.Parse(string(v))
+	if err != nil {
+		return "", err
+	}
+	name, err := typeName(g, t)
+	if err != nil {
+		return "", err
+	}
+	return g.TextTemplate(
+		`<.Name>{<range $i, $b := .Bytes><if $i>, <end><printf "0x%02x" $b><end>}`,
+		struct {
+			Name  string
+			Bytes []byte
+		}{Name: name, Bytes: u.Bytes()},
+	)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen/internal/tests/domain"
+	tu "go.uber.org/thriftrw/gen/internal/tests/uuid"
+	"go.uber.org/thriftrw/thriftuuid"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUUIDRoundTrip(t *testing.T) {
+	id := thriftuuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	parent := thriftuuid.UUID{1, 2, 3}
+	domainID := domain.UUID{4, 5, 6}
+	requestID := tu.RequestID{7}
+
+	x := tu.Request{
+		ID:        id,
+		ParentID:  &parent,
+		RequestID: &requestID,
+		DomainID:  &domainID,
+		Related:   []thriftuuid.UUID{parent},
+		Labels:    map[thriftuuid.UUID]string{id: "self"},
+	}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary(id[:])},
+		{ID: 2, Value: wire.NewValueBinary(parent[:])},
+		{ID: 3, Value: wire.NewValueBinary(requestID[:])},
+		{ID: 4, Value: wire.NewValueBinary(domainID[:])},
+		{ID: 5, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TBinary, []wire.Value{wire.NewValueBinary(parent[:])}),
+		)},
+		{ID: 7, Value: wire.NewValueMap(
+			wire.MapItemListFromSlice(wire.TBinary, wire.TBinary, []wire.MapItem{
+				{Key: wire.NewValueBinary(id[:]), Value: wire.NewValueString("self")},
+			}),
+		)},
+	}})
+
+	assertRoundTrip(t, &x, v, "Request")
+	testRoundTripCombos(t, &x, v, "Request")
+}
+
+func TestUUIDWrongLength(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary([]byte{1, 2, 3})},
+	}})
+
+	var x tu.Request
+	err := x.FromWire(v)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid UUID: expected 16 bytes, got 3")
+}
+
+func TestUUIDConstants(t *testing.T) {
+	assert.Equal(t, thriftuuid.UUID{}, tu.NilUUID)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", tu.DefaultRequestID.String())
+	assert.Equal(t, tu.DefaultRequestID, tu.Default_Request().GetRequestID())
+}
+
+func TestUUIDJSON(t *testing.T) {
+	requestID := tu.RequestID(thriftuuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+	x := tu.Request{
+		ID:        thriftuuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		RequestID: &requestID,
+	}
+
+	b, err := json.Marshal(&x)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"requestID": "123e4567-e89b-12d3-a456-426614174000"
+	}`, string(b))
+
+	var y tu.Request
+	require.NoError(t, json.Unmarshal(b, &y))
+	assert.True(t, x.Equals(&y))
+}
+
+func TestUUIDEqualsCopyHash(t *testing.T) {
+	x := &tu.Request{ID: thriftuuid.UUID{1}, Tags: map[thriftuuid.UUID]struct{}{{2}: {}}}
+	y := &tu.Request{ID: thriftuuid.UUID{1}, Tags: map[thriftuuid.UUID]struct{}{{2}: {}}}
+	assert.True(t, x.Equals(y))
+	assert.Equal(t, x.Hash(), y.Hash())
+	assert.False(t, x.Equals(&tu.Request{ID: thriftuuid.UUID{2}}))
+
+	c := x.Copy()
+	assert.True(t, x.Equals(c))
+}
+
+func TestUUIDZap(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	requestID := tu.RequestID{2}
+	x := &tu.Request{ID: thriftuuid.UUID{1}, RequestID: &requestID}
+	require.NoError(t, x.MarshalLogObject(enc))
+	assert.Equal(t, "01000000-0000-0000-0000-000000000000", enc.Fields["id"])
+	assert.Equal(t, "02000000-0000-0000-0000-000000000000", enc.Fields["requestID"])
+}
+
+func TestUUIDGoType(t *testing.T) {
+	tests := []struct {
+		desc           string
+		annotations    compile.Annotations
+		wantImportPath string
+		wantName       string
+		wantErr        string
+	}{
+		{desc: "default"},
+		{
+			desc:           "go.type",
+			annotations:    compile.Annotations{"go.type": "github.com/google/uuid.UUID"},
+			wantImportPath: "github.com/google/uuid",
+			wantName:       "UUID",
+		},
+		{
+			desc:        "invalid",
+			annotations: compile.Annotations{"go.type": "UUID"},
+			wantErr:     `invalid go.type annotation "UUID" on uuid`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			importPath, name, err := uuidGoType(&compile.UUIDSpec{Annotations: tt.annotations})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantImportPath, importPath)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/validate"
+)
+
+// validateGenerator generates Validate methods for structs that declare
+// validation rules through annotations.
+type validateGenerator struct {
+	Name     string
+	Spec     *compile.StructSpec
+	Presence presenceLayout
+
+	// CEL expression declared on the struct, if any.
+	CEL string
+}
+
+func newValidateGenerator(name string, spec *compile.StructSpec, presence presenceLayout) validateGenerator {
+	return validateGenerator{
+		Name:     name,
+		Spec:     spec,
+		Presence: presence,
+		CEL:      spec.Annotations[validate.CELAnnotation],
+	}
+}
+
+// fieldRuleAnnotations are the annotations on fields which declare
+// validation rules.
+var fieldRuleAnnotations = []string{
+	validate.MinAnnotation,
+	validate.MaxAnnotation,
+	validate.PatternAnnotation,
+	validate.NonEmptyAnnotation,
+}
+
+// Enabled returns true if a Validate method should be generated.
+func (v validateGenerator) Enabled() bool {
+	if v.CEL != "" {
+		return true
+	}
+	for _, f := range v.Spec.Fields {
+		for _, key := range fieldRuleAnnotations {
+			if _, ok := f.Annotations[key]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldCheck is a check performed by a generated Validate method on one
+// field.
+type fieldCheck struct {
+	// Field is the Thrift name of the field.
+	Field string
+
+	// Cond is a Go expression which is true if the field violates the
+	// rule.
+	Cond string
+
+	// Reason is reported in the validate.FieldError if Cond is true.
+	Reason string
+}
+
+// pattern is a regular expression declared with validate.pattern, compiled
+// once into a package-level variable.
+type pattern struct {
+	Name string
+	Expr string
+}
+
+func (v validateGenerator) Generate(g Generator) error {
+	for _, f := range v.Spec.Fields {
+		name, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if name == "Validate" {
+			return fmt.Errorf(
+				"field %q conflicts with the generated Validate method", f.Name)
+		}
+	}
+
+	rules, err := v.fieldRules()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range rules.Patterns {
+		err := g.DeclareFromTemplate(
+			`
+			<$regexp := import "regexp">
+			var <.Name> = <$regexp>.MustCompile(<printf "%q" .Expr>)
+			`, p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$validate := import "go.uber.org/thriftrw/validate">
+
+		<$v := newVar "v">
+		// Validate returns an error if this <.Name> does not satisfy the
+		// validation rules declared on it in the Thrift file.
+		func (<$v> *<.Name>) Validate() error {
+			if <$v> == nil {
+				return nil
+			}
+			<range fieldChecks $v>
+				if <.Cond> {
+					return &<$validate>.FieldError{
+						TypeName: "<$.Spec.Name>",
+						Field:    "<.Field>",
+						Reason:   <printf "%q" .Reason>,
+					}
+				}
+			<- end>
+
+			<if .CEL ->
+			<- $this := newVar "this" ->
+			<$this> := make(map[string]interface{}, <len .Spec.Fields>)
+			<range .Spec.Fields>
+				<- $f := $.Presence.Ref $v . ->
+				<- if .Required ->
+					<$this>["<.Name>"] = <$f>
+				<- else ->
+					if <$f> != nil {
+						<$this>["<.Name>"] = <if isPrimitiveType .Type>*<end><$f>
+					}
+				<- end>
+			<end>
+			if err := <$validate>.CEL("<.Spec.Name>", <printf "%q" .CEL>, <$this>); err != nil {
+				return err
+			}
+			<- end>
+
+			return nil
+		}
+		`, v, TemplateFunc("fieldChecks", rules.Checks))
+}
+
+// fieldRules holds the validation rules declared on the fields of a struct.
+type fieldRules struct {
+	Patterns []pattern
+
+	// Checks returns the checks of the Validate method with the given
+	// receiver.
+	Checks func(v string) []fieldCheck
+}
+
+// fieldRule is a rule declared on a single field, with checks built from
+// the name of the Validate receiver.
+type fieldRule func(v string) []fieldCheck
+
+func (v validateGenerator) fieldRules() (fieldRules, error) {
+	var (
+		patterns []pattern
+		rules    []fieldRule
+	)
+	for _, f := range v.Spec.Fields {
+		fieldName, err := goName(f)
+		if err != nil {
+			return fieldRules{}, err
+		}
+
+		r, p, err := newFieldRule(v.Name, fieldName, f, v.Presence.Has(f))
+		if err != nil {
+			return fieldRules{}, fmt.Errorf("invalid validation rules on field %q: %v", f.Name, err)
+		}
+		if r != nil {
+			rules = append(rules, r)
+		}
+		if p != nil {
+			patterns = append(patterns, *p)
+		}
+	}
+
+	return fieldRules{
+		Patterns: patterns,
+		Checks: func(v string) []fieldCheck {
+			var checks []fieldCheck
+			for _, r := range rules {
+				checks = append(checks, r(v)...)
+			}
+			return checks
+		},
+	}, nil
+}
+
+// newFieldRule parses the validation annotations on the given field of the
+// struct with the given Go name. It returns a nil rule if the field declares
+// none, and the pattern the field must match, if any. Fields with presence
+// bits are read through their ref methods.
+func newFieldRule(structName, fieldName string, f *compile.FieldSpec, presence bool) (fieldRule, *pattern, error) {
+	root := compile.RootTypeSpec(f.Type)
+	if isBoundTypedef(f.Type) {
+		// Values of typedefs bound to Go types are opaque to us.
+		root = f.Type
+	}
+
+	// Bounds are values of numeric fields and lengths of the others.
+	const (
+		intBound = iota + 1
+		floatBound
+		lengthBound
+	)
+	var bound, bits int
+	switch r := root.(type) {
+	case *compile.I8Spec:
+		bound, bits = intBound, 8
+	case *compile.I16Spec:
+		bound, bits = intBound, 16
+	case *compile.I32Spec:
+		bound, bits = intBound, 32
+	case *compile.I64Spec:
+		bound, bits = intBound, 64
+	case *compile.DoubleSpec:
+		bound = floatBound
+	case *compile.StringSpec, *compile.BinarySpec,
+		*compile.ListSpec, *compile.SetSpec:
+		bound = lengthBound
+	case *compile.MapSpec:
+		// Maps stored in containers have no length that we can check
+		// without calling into them.
+		if !isMapContainer(r) {
+			bound = lengthBound
+		}
+	}
+	isLength := bound == lengthBound
+
+	parseBound := func(key string) (string, error) {
+		s, ok := f.Annotations[key]
+		if !ok {
+			return "", nil
+		}
+		switch bound {
+		case intBound:
+			if isUnsigned(root) {
+				n, err := strconv.ParseUint(s, 10, bits)
+				if err != nil {
+					return "", fmt.Errorf("%v: %q is not a valid unsigned %v", key, s, root.ThriftName())
+				}
+				return strconv.FormatUint(n, 10), nil
+			}
+			n, err := strconv.ParseInt(s, 10, bits)
+			if err != nil {
+				return "", fmt.Errorf("%v: %q is not a valid %v", key, s, root.ThriftName())
+			}
+			return strconv.FormatInt(n, 10), nil
+		case floatBound:
+			x, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return "", fmt.Errorf("%v: %q is not a number", key, s)
+			}
+			return strconv.FormatFloat(x, 'g', -1, 64), nil
+		case lengthBound:
+			n, err := strconv.ParseUint(s, 10, 31)
+			if err != nil {
+				return "", fmt.Errorf("%v: %q is not a valid length", key, s)
+			}
+			return strconv.FormatUint(n, 10), nil
+		default:
+			return "", fmt.Errorf("%v is not supported on fields of type %v", key, f.Type.ThriftName())
+		}
+	}
+
+	min, err := parseBound(validate.MinAnnotation)
+	if err != nil {
+		return nil, nil, err
+	}
+	max, err := parseBound(validate.MaxAnnotation)
+	if err != nil {
+		return nil, nil, err
+	}
+	if min != "" && max != "" {
+		lo, _ := strconv.ParseFloat(min, 64)
+		hi, _ := strconv.ParseFloat(max, 64)
+		if lo > hi {
+			return nil, nil, fmt.Errorf("%v %v is greater than %v %v",
+				validate.MinAnnotation, min, validate.MaxAnnotation, max)
+		}
+	}
+
+	var pat *pattern
+	if expr, ok := f.Annotations[validate.PatternAnnotation]; ok {
+		if _, isString := root.(*compile.StringSpec); !isString {
+			return nil, nil, fmt.Errorf("%v is not supported on fields of type %v",
+				validate.PatternAnnotation, f.Type.ThriftName())
+		}
+		if _, err := regexp.Compile(expr); err != nil {
+			return nil, nil, fmt.Errorf("%v: %v", validate.PatternAnnotation, err)
+		}
+		pat = &pattern{Name: fmt.Sprintf("_%v_%v_Pattern", structName, fieldName), Expr: expr}
+	}
+
+	var nonEmpty bool
+	if s, ok := f.Annotations[validate.NonEmptyAnnotation]; ok {
+		if !isLength {
+			return nil, nil, fmt.Errorf("%v is not supported on fields of type %v",
+				validate.NonEmptyAnnotation, f.Type.ThriftName())
+		}
+		nonEmpty = s == ""
+		if !nonEmpty {
+			if nonEmpty, err = strconv.ParseBool(s); err != nil {
+				return nil, nil, fmt.Errorf("%v: %q is not a boolean", validate.NonEmptyAnnotation, s)
+			}
+		}
+	}
+
+	if min == "" && max == "" && pat == nil && !nonEmpty {
+		return nil, nil, nil
+	}
+
+	// Optional primitives, including strings, are pointers. Other optional
+	// fields are nil when they are unset.
+	isPtr := !f.Required && isPrimitiveType(f.Type)
+	rule := func(v string) []fieldCheck {
+		field := fmt.Sprintf("%s.%s", v, fieldName)
+		if presence {
+			field = fmt.Sprintf("%s.ref%s()", v, fieldName)
+		}
+		value := field
+		if isPtr {
+			value = "*" + field
+		}
+		if isLength {
+			value = fmt.Sprintf("len(%s)", value)
+		}
+
+		// Rules other than validate.required_non_empty do not apply to
+		// unset optional fields.
+		guard := ""
+		if !f.Required {
+			guard = field + " != nil && "
+		}
+
+		var checks []fieldCheck
+		if nonEmpty {
+			cond := value + " == 0"
+			if isPtr {
+				cond = field + " == nil || " + cond
+			}
+			reason := "must not be empty"
+			if !f.Required {
+				reason = "must be set and non-empty"
+			}
+			checks = append(checks, fieldCheck{Field: f.Name, Cond: cond, Reason: reason})
+		}
+
+		reason := "must be at %v %v"
+		if isLength {
+			reason = "must have a length of at %v %v"
+		}
+		if min != "" {
+			checks = append(checks, fieldCheck{
+				Field:  f.Name,
+				Cond:   fmt.Sprintf("%s%s < %s", guard, value, min),
+				Reason: fmt.Sprintf(reason, "least", min),
+			})
+		}
+		if max != "" {
+			checks = append(checks, fieldCheck{
+				Field:  f.Name,
+				Cond:   fmt.Sprintf("%s%s > %s", guard, value, max),
+				Reason: fmt.Sprintf(reason, "most", max),
+			})
+		}
+
+		if pat != nil {
+			s := field
+			if isPtr {
+				s = "*" + field
+			}
+			if f.Type != root {
+				s = fmt.Sprintf("string(%s)", s)
+			}
+			checks = append(checks, fieldCheck{
+				Field:  f.Name,
+				Cond:   fmt.Sprintf("%s!%s.MatchString(%s)", guard, pat.Name, s),
+				Reason: fmt.Sprintf("must match %q", pat.Expr),
+			})
+		}
+		return checks
+	}
+	return rule, pat, nil
+}
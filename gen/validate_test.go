@@ -0,0 +1,307 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tv "go.uber.org/thriftrw/gen/internal/tests/validate"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/validate"
+)
+
+// celEvaluatorFunc is a fake CEL evaluator that records its inputs.
+type celEvaluatorFunc func(expr string, this map[string]interface{}) (bool, error)
+
+func (f celEvaluatorFunc) EvalCEL(expr string, this map[string]interface{}) (bool, error) {
+	return f(expr, this)
+}
+
+func TestValidateCEL(t *testing.T) {
+	defer validate.RegisterCELEvaluator(nil)
+
+	tests := []struct {
+		desc     string
+		give     interface{ Validate() error }
+		wantExpr string
+		wantThis map[string]interface{}
+	}{
+		{
+			desc:     "required fields",
+			give:     &tv.Range{Min: 1, Max: 2},
+			wantExpr: "this.min <= this.max",
+			wantThis: map[string]interface{}{"min": int32(1), "max": int32(2)},
+		},
+		{
+			desc:     "unset optional fields",
+			give:     &tv.OptionalRange{High: ptr.Int64(3)},
+			wantExpr: "!has(this.low) || !has(this.high) || this.low <= this.high",
+			wantThis: map[string]interface{}{"high": int64(3)},
+		},
+		{
+			desc:     "optional reference fields",
+			give:     &tv.OptionalRange{Tags: []string{"a"}},
+			wantExpr: "!has(this.low) || !has(this.high) || this.low <= this.high",
+			wantThis: map[string]interface{}{"tags": []string{"a"}},
+		},
+		{
+			desc:     "union",
+			give:     &tv.RangeOrName{Name: ptr.String("foo")},
+			wantExpr: `has(this.range) || this.name != ""`,
+			wantThis: map[string]interface{}{"name": "foo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var (
+				gotExpr string
+				gotThis map[string]interface{}
+			)
+			validate.RegisterCELEvaluator(celEvaluatorFunc(
+				func(expr string, this map[string]interface{}) (bool, error) {
+					gotExpr, gotThis = expr, this
+					return true, nil
+				}))
+
+			require.NoError(t, tt.give.Validate())
+			assert.Equal(t, tt.wantExpr, gotExpr)
+			assert.Equal(t, tt.wantThis, gotThis)
+		})
+	}
+}
+
+func TestValidateCELFailures(t *testing.T) {
+	defer validate.RegisterCELEvaluator(nil)
+
+	t.Run("no evaluator", func(t *testing.T) {
+		validate.RegisterCELEvaluator(nil)
+		err := (&tv.Range{}).Validate()
+		assert.Equal(t, validate.ErrNoCELEvaluator, err)
+	})
+
+	t.Run("expression does not hold", func(t *testing.T) {
+		validate.RegisterCELEvaluator(celEvaluatorFunc(
+			func(string, map[string]interface{}) (bool, error) {
+				return false, nil
+			}))
+		err := (&tv.Range{Min: 2, Max: 1}).Validate()
+		var celErr *validate.CELError
+		require.True(t, errors.As(err, &celErr), "expected a CELError, got %v", err)
+		assert.Equal(t, "Range", celErr.TypeName)
+	})
+
+	t.Run("nil struct", func(t *testing.T) {
+		var r *tv.Range
+		assert.NoError(t, r.Validate())
+	})
+}
+
+func TestValidateFieldRules(t *testing.T) {
+	valid := func() *tv.Account {
+		return &tv.Account{
+			Name:   "alice",
+			Slug:   "alice-1",
+			Emails: []string{"alice@example.com"},
+		}
+	}
+
+	tests := []struct {
+		desc  string
+		give  func(*tv.Account)
+		field string
+		want  string
+	}{
+		{desc: "valid", give: func(*tv.Account) {}},
+		{
+			desc: "optional fields in range",
+			give: func(a *tv.Account) {
+				a.Age = ptr.Int32(150)
+				a.Score = ptr.Float64(-1.5)
+				a.Nickname = ptr.String("Al")
+				a.Avatar = []byte{1, 2, 3, 4}
+			},
+		},
+		{
+			desc:  "empty required string",
+			give:  func(a *tv.Account) { a.Name = "" },
+			field: "name",
+			want:  "must not be empty",
+		},
+		{
+			desc:  "pattern on typedef",
+			give:  func(a *tv.Account) { a.Slug = "Alice" },
+			field: "slug",
+			want:  `must match "^[a-z0-9-]+$"`,
+		},
+		{
+			desc:  "max length",
+			give:  func(a *tv.Account) { a.Slug = "abcdefghijklmnopq" },
+			field: "slug",
+			want:  "must have a length of at most 16",
+		},
+		{
+			desc:  "min value",
+			give:  func(a *tv.Account) { a.Age = ptr.Int32(-1) },
+			field: "age",
+			want:  "must be at least 0",
+		},
+		{
+			desc:  "max double",
+			give:  func(a *tv.Account) { a.Score = ptr.Float64(1.6) },
+			field: "score",
+			want:  "must be at most 1.5",
+		},
+		{
+			desc:  "min length of optional string",
+			give:  func(a *tv.Account) { a.Nickname = ptr.String("A") },
+			field: "nickname",
+			want:  "must have a length of at least 2",
+		},
+		{
+			desc:  "unset non-empty list",
+			give:  func(a *tv.Account) { a.Emails = nil },
+			field: "emails",
+			want:  "must be set and non-empty",
+		},
+		{
+			desc:  "max map length",
+			give:  func(a *tv.Account) { a.Labels = map[string]string{"a": "", "b": "", "c": ""} },
+			field: "labels",
+			want:  "must have a length of at most 2",
+		},
+		{
+			desc:  "max binary length",
+			give:  func(a *tv.Account) { a.Avatar = []byte{1, 2, 3, 4, 5} },
+			field: "avatar",
+			want:  "must have a length of at most 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			a := valid()
+			tt.give(a)
+
+			err := a.Validate()
+			if tt.want == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Equal(t, &validate.FieldError{
+				TypeName: "Account",
+				Field:    tt.field,
+				Reason:   tt.want,
+			}, err)
+		})
+	}
+}
+
+func TestValidateFieldRulesBeforeCEL(t *testing.T) {
+	defer validate.RegisterCELEvaluator(nil)
+	validate.RegisterCELEvaluator(nil)
+
+	err := (&tv.Invite{Email: "alice"}).Validate()
+	assert.EqualError(t, err, `validate: Invite.email must match "@"`)
+
+	err = (&tv.Invite{Email: "alice@example.com"}).Validate()
+	assert.Equal(t, validate.ErrNoCELEvaluator, err)
+}
+
+func TestValidateFieldRuleErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    string
+		wantErr string
+	}{
+		{
+			desc:    "bound on bool",
+			give:    `1: required bool b (validate.min = "1")`,
+			wantErr: `invalid validation rules on field "b": validate.min is not supported on fields of type bool`,
+		},
+		{
+			desc:    "out of range",
+			give:    `1: required i8 b (validate.max = "300")`,
+			wantErr: `validate.max: "300" is not a valid byte`,
+		},
+		{
+			desc:    "negative length",
+			give:    `1: required string s (validate.min = "-1")`,
+			wantErr: `validate.min: "-1" is not a valid length`,
+		},
+		{
+			desc:    "min above max",
+			give:    `1: required i32 i (validate.min = "2", validate.max = "1")`,
+			wantErr: "validate.min 2 is greater than validate.max 1",
+		},
+		{
+			desc:    "pattern on binary",
+			give:    `1: required binary b (validate.pattern = "a")`,
+			wantErr: "validate.pattern is not supported on fields of type binary",
+		},
+		{
+			desc:    "invalid pattern",
+			give:    `1: required string s (validate.pattern = "(")`,
+			wantErr: "validate.pattern: error parsing regexp",
+		},
+		{
+			desc:    "non-empty number",
+			give:    `1: required i32 i (validate.required_non_empty)`,
+			wantErr: "validate.required_non_empty is not supported on fields of type i32",
+		},
+		{
+			desc:    "non-empty not a boolean",
+			give:    `1: required string s (validate.required_non_empty = "yes")`,
+			wantErr: `validate.required_non_empty: "yes" is not a boolean`,
+		},
+		{
+			desc:    "conflict",
+			give:    `1: required string validate (validate.min = "1")`,
+			wantErr: `field "validate" conflicts with the generated Validate method`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot := t.TempDir()
+			path := filepath.Join(thriftRoot, "v.thrift")
+			require.NoError(t, os.WriteFile(path, []byte("struct S {\n"+tt.give+"\n}\n"), 0o644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     t.TempDir(),
+				PackagePrefix: "example.com/gen",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// violationsGenerator generates CollectViolations methods which report
+// every missing required field and invalid union in a struct and its
+// nested structs.
+type violationsGenerator struct {
+	Name     string
+	Spec     *compile.StructSpec
+	Presence presenceLayout
+}
+
+func (v violationsGenerator) Generate(g Generator) error {
+	for _, f := range v.Spec.Fields {
+		name, err := goName(f)
+		if err != nil {
+			return err
+		}
+		if name == "CollectViolations" {
+			return fmt.Errorf(
+				"field %q conflicts with the CollectViolations method generated for --aggregate-errors",
+				f.Name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$validate := import "go.uber.org/thriftrw/validate">
+
+		<$v := newVar "v">
+		<$c := newVar "c">
+		// CollectViolations records every missing required field and invalid
+		// union in this <.Name> and the structs nested in it.
+		func (<$v> *<.Name>) CollectViolations(<$c> *<$validate>.Collector) {
+			if <$v> == nil {
+				return
+			}
+
+			<- $structName := .Name>
+			<- range .Spec.Fields>
+				<- $fname := goName . ->
+				<- $f := printf "%s.%s" $v $fname ->
+				<- if and .Required (not (isPrimitiveType .Type)) (not (isListType .Type))>
+
+			if <$f> == nil {
+				<$c>.Add(<import "errors">.New("field <$fname> of <$structName> is required"))
+			}
+					<- if isStructType .Type> else {
+				<$c>.Field("<.Name>", <$f>)
+			}
+					<- end>
+				<- else if isStructType .Type>
+
+			<$c>.Field("<.Name>", <$f>)
+				<- end>
+			<- end>
+
+			<- if and .IsUnion (len .Spec.Fields)>
+				<- $count := newVar "count">
+
+			<$count> := 0
+				<- range .Spec.Fields>
+			if <$.Presence.Ref $v .> != nil {
+				<$count>++
+			}
+				<- end>
+			if <$count> != 1 {
+				<$c>.Add(<import "fmt">.Errorf("<.Name> should have exactly one field: got %v fields", <$count>))
+			}
+			<- end>
+		}
+		`, struct {
+			Name     string
+			Spec     *compile.StructSpec
+			Presence presenceLayout
+			IsUnion  bool
+		}{Name: v.Name, Spec: v.Spec, Presence: v.Presence, IsUnion: v.Spec.Type == ast.UnionType},
+	)
+}
@@ -36,12 +36,19 @@ type WireGenerator struct {
 	enumG    enumGenerator
 	structG  structGenerator
 	typedefG typedefGenerator
+	uuidG    uuidGenerator
 }
 
 // ToWire generates an expression of type (Value, error) object containing the
 // wire representation of the variable $varName of type $spec or an error.
 func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName string) (string, error) {
 	wire := g.Import("go.uber.org/thriftrw/wire")
+	if isUnsigned(spec) {
+		// Unsigned integers are sent as their two's complement.
+		bits := intBits(spec)
+		return fmt.Sprintf("%s.NewValueI%d(int%d(%s)), error(nil)", wire, bits, bits, varName), nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return fmt.Sprintf("%s.NewValueBool(%s), error(nil)", wire, varName), nil
@@ -59,6 +66,8 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 		return fmt.Sprintf("%s.NewValueString(%s), error(nil)", wire, varName), nil
 	case *compile.BinarySpec:
 		return fmt.Sprintf("%s.NewValueBinary(%s), error(nil)", wire, varName), nil
+	case *compile.UUIDSpec:
+		return fmt.Sprintf("%s.NewValueBinary(%s.Bytes()), error(nil)", wire, uuidValue(g, s, varName)), nil
 	case *compile.MapSpec:
 		mapItemList, err := w.mapG.ItemList(g, s)
 		if err != nil {
@@ -66,13 +75,15 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 		}
 
 		return g.TextTemplate(
-			`<.Wire>.NewValueMap(<.MapItemList>(<.Name>)), error(nil)`,
+			`<.Wire>.NewValueMap(<.MapItemList>
+				<- if .Container>{<.Name>}<else>(<.Name>)<end>), error(nil)`,
 			struct {
 				Wire        string
 				Name        string
 				Spec        *compile.MapSpec
 				MapItemList string
-			}{Wire: wire, Name: varName, Spec: s, MapItemList: mapItemList},
+				Container   bool
+			}{Wire: wire, Name: varName, Spec: s, MapItemList: mapItemList, Container: isMapContainer(s)},
 		)
 	case *compile.ListSpec:
 		valueList, err := w.listG.ValueList(g, s)
@@ -104,6 +115,12 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 				ValueList string
 			}{Wire: wire, Name: varName, Spec: s, ValueList: valueList},
 		)
+	case *compile.TypedefSpec:
+		if isBoundTypedef(s) {
+			toWire, err := w.typedefG.boundToWire(g, s)
+			return fmt.Sprintf("%s(%s)", toWire, varName), err
+		}
+		return fmt.Sprintf("%s.ToWire()", varName), nil
 	default:
 		// Custom defined type
 		return fmt.Sprintf("%s.ToWire()", varName), nil
@@ -115,8 +132,13 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 func (w *WireGenerator) ToWirePtr(g Generator, spec compile.TypeSpec, varName string) (string, error) {
 	switch spec.(type) {
 	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
-		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.UUIDSpec:
 		return w.ToWire(g, spec, fmt.Sprintf("*(%s)", varName))
+	case *compile.TypedefSpec:
+		if isBoundTypedef(spec) {
+			return w.ToWire(g, spec, fmt.Sprintf("*(%s)", varName))
+		}
+		return w.ToWire(g, spec, varName)
 	default:
 		// Everything else is either a reference type or has a ToWire method
 		// on it that does automatic dereferencing.
@@ -127,6 +149,10 @@ func (w *WireGenerator) ToWirePtr(g Generator, spec compile.TypeSpec, varName st
 // FromWire generates an expression of type ($spec, error) which reads the Value
 // at $value into a $spec.
 func (w *WireGenerator) FromWire(g Generator, spec compile.TypeSpec, value string) (string, error) {
+	if isUnsigned(spec) {
+		return fmt.Sprintf("%s(%s.GetI%d()), error(nil)", unsignedName(spec), value, intBits(spec)), nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return fmt.Sprintf("%s.GetBool(), error(nil)", value), nil
@@ -144,6 +170,12 @@ func (w *WireGenerator) FromWire(g Generator, spec compile.TypeSpec, value strin
 		return fmt.Sprintf("%s.GetString(), error(nil)", value), nil
 	case *compile.BinarySpec:
 		return fmt.Sprintf("%s.GetBinary(), error(nil)", value), nil
+	case *compile.UUIDSpec:
+		reader, err := w.uuidG.Reader(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", reader, value), nil
 	case *compile.MapSpec:
 		reader, err := w.mapG.Reader(g, s)
 		if err != nil {
@@ -233,7 +265,7 @@ func TypeCode(g Generator, spec compile.TypeSpec) string {
 		return fmt.Sprintf("%s.TI64", wire)
 	case *compile.DoubleSpec:
 		return fmt.Sprintf("%s.TDouble", wire)
-	case *compile.StringSpec, *compile.BinarySpec:
+	case *compile.StringSpec, *compile.BinarySpec, *compile.UUIDSpec:
 		return fmt.Sprintf("%s.TBinary", wire)
 	case *compile.MapSpec:
 		return fmt.Sprintf("%s.TMap", wire)
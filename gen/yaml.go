@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// enumYAML generates MarshalYAML and UnmarshalYAML methods for the given
+// enum, which represent it by its name as MarshalJSON and UnmarshalJSON do.
+//
+// UnmarshalYAML uses the func(interface{}) error form of the method, which
+// both gopkg.in/yaml.v2 and gopkg.in/yaml.v3 accept, so that generated code
+// does not depend on either.
+func enumYAML(g Generator, spec *compile.EnumSpec, items []compile.EnumItem) error {
+	return g.DeclareFromTemplate(
+		`
+		<$enumName := goName .Spec>
+		<$v := newVar "v">
+		// MarshalYAML returns the value used to represent <$enumName> in YAML.
+		//
+		// If the enum value is recognized, its name is returned.
+		<if checkEnumTextMarshalStrict ->
+		// Otherwise, an error is returned.
+		<else ->
+		// Otherwise, its integer value is returned.
+		<end ->
+		//
+		// This implements yaml.Marshaler.
+		func (<$v> <$enumName>) MarshalYAML() (interface{}, error) {
+			<if len .Spec.Items ->
+				switch int32(<$v>) {
+				<range .UniqueItems ->
+					case <.Value>:
+						return "<enumItemLabelName .>", nil
+				<end ->
+				}
+			<end ->
+			<if checkEnumTextMarshalStrict ->
+				return nil, <import "fmt">.Errorf("unknown enum value %q for %q", <$v>, "<$enumName>")
+			<else ->
+				return int32(<$v>), nil
+			<end ->
+		}
+
+		<$unmarshal := newVar "unmarshal">
+		<$s := newVar "s">
+		// UnmarshalYAML attempts to decode <$enumName> from its YAML
+		// representation, which is either a known enum name or an integer.
+		//
+		// This implements yaml.Unmarshaler.
+		func (<$v> *<$enumName>) UnmarshalYAML(<$unmarshal> func(interface{}) error) error {
+			var <$s> string
+			if err := <$unmarshal>(&<$s>); err != nil {
+				return err
+			}
+			return <$v>.UnmarshalText([]byte(<$s>))
+		}
+		`,
+		struct {
+			Spec        *compile.EnumSpec
+			UniqueItems []compile.EnumItem
+		}{Spec: spec, UniqueItems: items},
+		TemplateFunc("enumItemLabelName", entityLabel),
+		TemplateFunc("checkEnumTextMarshalStrict", checkEnumTextMarshalStrict),
+	)
+}
+
+// unionYAML generates MarshalYAML and UnmarshalYAML methods for the given
+// union which verify that exactly one of its fields is set.
+func unionYAML(g Generator, name string, spec *compile.StructSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$alias := newVar "alias">
+		<$count := newVar "count">
+		// MarshalYAML returns the value used to represent <.Name> in YAML,
+		// failing if it does not have exactly one field set.
+		//
+		// This implements yaml.Marshaler.
+		func (<$v> <.Name>) MarshalYAML() (interface{}, error) {
+			<if .Spec.Fields ->
+			<$count> := 0
+			<- range .Spec.Fields>
+			if <$v>.<goName .> != nil {
+				<$count>++
+			}
+			<- end>
+			if <$count> != 1 {
+				return nil, <import "fmt">.Errorf("<.Name> should have exactly one field: got %v fields", <$count>)
+			}
+
+			<end ->
+			// The alias drops this method so that the encoder falls back
+			// to the fields of the union.
+			type <$alias> <.Name>
+			return <$alias>(<$v>), nil
+		}
+
+		<$unmarshal := newVar "unmarshal">
+		<$a := newVar "a">
+		// UnmarshalYAML decodes <.Name> from its YAML representation,
+		// failing if it does not have exactly one field set.
+		//
+		// This implements yaml.Unmarshaler.
+		func (<$v> *<.Name>) UnmarshalYAML(<$unmarshal> func(interface{}) error) error {
+			type <$alias> <.Name>
+			var <$a> <$alias>
+			if err := <$unmarshal>(&<$a>); err != nil {
+				return err
+			}
+
+			<if .Spec.Fields ->
+			<$count> := 0
+			<- range .Spec.Fields>
+			if <$a>.<goName .> != nil {
+				<$count>++
+			}
+			<- end>
+			if <$count> != 1 {
+				return <import "fmt">.Errorf("<.Name> should have exactly one field: got %v fields", <$count>)
+			}
+
+			<end ->
+			*<$v> = <.Name>(<$a>)
+			return nil
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.StructSpec
+		}{Name: name, Spec: spec},
+	)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ty "go.uber.org/thriftrw/gen/internal/tests/yaml"
+	"go.uber.org/thriftrw/ptr"
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	give := &ty.Config{
+		Name:     "web",
+		Replicas: ptr.Int32(3),
+		Color:    ty.ColorBlue.Ptr(),
+		Points:   []*ty.Point{{Row: 1, Col: 2}},
+		Labels:   map[string]string{"team": "infra"},
+		Secret:   ptr.String("hunter2"),
+		Owner:    ptr.String("alice"),
+		Enabled:  ptr.Bool(false),
+		Target:   &ty.Target{Host: ptr.String("example.com")},
+	}
+
+	b, err := yaml.Marshal(give)
+	require.NoError(t, err)
+	assert.Equal(t, `name: web
+replicas: 3
+color: blue
+points:
+    - row: 1
+      col: 2
+meta:
+    team: infra
+owned_by: alice
+enabled: false
+target:
+    host: example.com
+`, string(b))
+
+	var got ty.Config
+	require.NoError(t, yaml.Unmarshal(b, &got))
+
+	want := *give
+	want.Secret = nil // skipped like the json tag
+	assert.Equal(t, want, got)
+}
+
+func TestYAMLEnum(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want ty.Color
+	}{
+		{desc: "name", give: "RED", want: ty.ColorRed},
+		{desc: "label", give: "blue", want: ty.ColorBlue},
+		{desc: "integer", give: "2", want: ty.ColorGreen},
+		{desc: "unknown integer", give: "42", want: ty.Color(42)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got ty.Color
+			require.NoError(t, yaml.Unmarshal([]byte(tt.give), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unknown value is written as integer", func(t *testing.T) {
+		b, err := yaml.Marshal(ty.Color(42))
+		require.NoError(t, err)
+		assert.Equal(t, "42\n", string(b))
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		var got ty.Color
+		err := yaml.Unmarshal([]byte("PURPLE"), &got)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown enum value "PURPLE" for "Color"`)
+	})
+}
+
+func TestYAMLUnion(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		give := ty.Target{Point: &ty.Point{Row: 3, Col: 4}}
+		b, err := yaml.Marshal(give)
+		require.NoError(t, err)
+		assert.Equal(t, "point:\n    row: 3\n    col: 4\n", string(b))
+
+		var got ty.Target
+		require.NoError(t, yaml.Unmarshal(b, &got))
+		assert.Equal(t, give, got)
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		_, err := yaml.Marshal(ty.Target{})
+		assert.EqualError(t, err, "Target should have exactly one field: got 0 fields")
+
+		var got ty.Target
+		err = yaml.Unmarshal([]byte("{}"), &got)
+		assert.EqualError(t, err, "Target should have exactly one field: got 0 fields")
+	})
+
+	t.Run("too many fields", func(t *testing.T) {
+		var got ty.Target
+		err := yaml.Unmarshal([]byte("host: foo\ncolor: RED\n"), &got)
+		assert.EqualError(t, err, "Target should have exactly one field: got 2 fields")
+	})
+}
@@ -39,15 +39,19 @@ import (
 const NoZapLabel = "go.nolog"
 
 type zapGenerator struct {
-	mapG  mapGenerator
-	setG  setGenerator
-	listG listGenerator
+	mapG     mapGenerator
+	setG     setGenerator
+	listG    listGenerator
+	typedefG typedefGenerator
 }
 
 // zapEncoder returns the Zap type name of the root spec, determining what type
 // the Zap marshaler needs to log it as (i.e. AddString, AppendObject, etc.)
 func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 	root := compile.RootTypeSpec(spec)
+	if isUnsigned(root) {
+		return fmt.Sprintf("Uint%d", intBits(root))
+	}
 
 	switch t := root.(type) {
 	// Primitives
@@ -67,15 +71,15 @@ func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 		return "String"
 	case *compile.BinarySpec:
 		return "String" // encode binary as a string and log as string
+	case *compile.UUIDSpec:
+		return "String"
 
 	// Containers
 	case *compile.MapSpec:
-		switch compile.RootTypeSpec(t.KeySpec).(type) {
-		case *compile.StringSpec:
+		if _, ok := compile.RootTypeSpec(t.KeySpec).(*compile.StringSpec); ok && mapUsesMap(t) {
 			return "Object"
-		default:
-			return "Array"
 		}
+		return "Array"
 	case *compile.SetSpec, *compile.ListSpec:
 		return "Array"
 
@@ -118,6 +122,15 @@ func (z *zapGenerator) zapTypedefGenerateMarshaler(g Generator, spec *compile.Ty
 //   enc.Add<zapEncoder .Type>("foo", <zapMarshaler .Type "v">)
 //
 func (z *zapGenerator) zapMarshaler(g Generator, spec compile.TypeSpec, fieldValue string) (string, error) {
+	// Typedefs bound to Go types are logged as their targets.
+	if t, ok := spec.(*compile.TypedefSpec); ok && isBoundTypedef(t) {
+		value, err := z.typedefG.boundZapValue(g, t)
+		if err != nil {
+			return "", err
+		}
+		return z.zapMarshaler(g, t.Target, fmt.Sprintf("%v(%v)", value, fieldValue))
+	}
+
 	// For typedefs, cast to the root type and rely on that functionality if the
 	// typedef doesn't have generated Zap marshal methods.
 	if _, ok := spec.(*compile.TypedefSpec); ok && !z.zapTypedefHasGeneratedMarshaler(g, spec) {
@@ -132,6 +145,10 @@ func (z *zapGenerator) zapMarshaler(g Generator, spec compile.TypeSpec, fieldVal
 }
 
 func (z *zapGenerator) zapMarshalerGenerator(g Generator, spec compile.TypeSpec, fieldValue string) (string, error) {
+	if isUUIDType(spec) {
+		return fmt.Sprintf("%v.String()", uuidValue(g, compile.RootTypeSpec(spec), fieldValue)), nil
+	}
+
 	if isPrimitiveType(spec) {
 		return fieldValue, nil
 	}
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
+	tkv "go.uber.org/thriftrw/gen/internal/tests/keyvalue_slice"
 	tz "go.uber.org/thriftrw/gen/internal/tests/nozap"
 	tss "go.uber.org/thriftrw/gen/internal/tests/set_to_slice"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
@@ -619,6 +620,14 @@ func TestTypedefsZapLogging(t *testing.T) {
 	err = mapEncoder.AddArray("addTypedefSetToSliceTest", test8)
 	require.NoError(t, err)
 	assert.Equal(t, expected8, mapEncoder.Fields)
+
+	// test map annotated with (go.type = "keyvalue-slice")
+	mapEncoder = zapcore.NewMapObjectEncoder()
+	test9 := tkv.Counts{{Key: "foo", Value: 1}}
+	expected9 := o{"addTypedefKeyValueSliceTest": a{o{"key": "foo", "value": int32(1)}}}
+	err = mapEncoder.AddArray("addTypedefKeyValueSliceTest", test9)
+	require.NoError(t, err)
+	assert.Equal(t, expected9, mapEncoder.Fields)
 }
 
 func TestEnumWithLabelZapLogging(t *testing.T) {
@@ -386,8 +386,14 @@ type
         { $$ = ast.SetType{ValueType: $4, Annotations: $6, Line: $1.Line, Column: $1.Column} }
 
     /* type references ('pos' is last to avoid selection preference over 'base_type_name') */
-    | IDENTIFIER pos
-        { $$ = ast.TypeReference{Name: $1, Line: $2.Line, Column: $2.Column} }
+    | IDENTIFIER pos type_annotations
+        {
+            t, err := typeReference($1, $3, $2)
+            if err != nil {
+                yylex.(*lexer).AppendError(err)
+            }
+            $$ = t
+        }
     ;
 
 base_type_name
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// uuidTypeName is the name of the uuid base type. It is not a keyword so
+// that fields and other declarations named uuid in existing Thrift files
+// remain valid: it refers to the base type only where a type is expected,
+// and the compiler resolves it to a type named uuid if the file defines one.
+const uuidTypeName = "uuid"
+
+// typeReference returns the type referred to by the given name where a type
+// is expected. Only references to base types may have annotations.
+func typeReference(name string, annotations []*ast.Annotation, pos ast.Position) (ast.Type, error) {
+	if name == uuidTypeName {
+		return ast.BaseType{
+			ID:          ast.UUIDTypeID,
+			Annotations: annotations,
+			Line:        pos.Line,
+			Column:      pos.Column,
+		}, nil
+	}
+
+	ref := ast.TypeReference{Name: name, Line: pos.Line, Column: pos.Column}
+	if len(annotations) > 0 {
+		return ref, fmt.Errorf("annotations are not allowed on references to %q: "+
+			"annotate its definition instead", name)
+	}
+	return ref, nil
+}
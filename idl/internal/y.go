@@ -183,53 +183,53 @@ var yyExca = [...]int8{
 
 const yyPrivate = 57344
 
-const yyLast = 177
+const yyLast = 178
 
 var yyAct = [...]uint8{
-	32, 59, 66, 5, 7, 69, 120, 31, 11, 67,
-	124, 83, 90, 89, 85, 86, 12, 12, 95, 14,
-	13, 13, 126, 97, 96, 63, 62, 61, 163, 34,
-	156, 152, 128, 93, 52, 60, 60, 161, 60, 149,
-	145, 132, 136, 122, 87, 88, 81, 78, 92, 57,
-	75, 107, 55, 54, 58, 64, 91, 141, 68, 70,
-	56, 130, 131, 159, 118, 116, 77, 80, 33, 72,
-	73, 74, 94, 139, 19, 134, 28, 98, 16, 15,
-	101, 17, 148, 104, 106, 99, 143, 33, 102, 100,
-	114, 105, 103, 110, 21, 25, 26, 27, 112, 113,
-	24, 22, 20, 111, 10, 8, 9, 84, 18, 70,
-	123, 53, 38, 37, 121, 36, 127, 119, 35, 125,
-	30, 29, 158, 133, 70, 135, 117, 71, 140, 138,
-	137, 109, 108, 3, 6, 65, 76, 142, 144, 82,
-	2, 4, 79, 147, 23, 129, 70, 115, 146, 39,
-	151, 150, 153, 70, 80, 1, 0, 157, 155, 154,
-	160, 0, 0, 80, 162, 43, 44, 45, 46, 47,
-	48, 49, 50, 51, 40, 41, 42,
+	32, 59, 67, 5, 7, 70, 121, 31, 11, 68,
+	125, 84, 91, 90, 86, 87, 12, 12, 96, 14,
+	13, 13, 127, 98, 97, 63, 62, 61, 164, 34,
+	157, 153, 129, 94, 52, 60, 60, 162, 60, 150,
+	146, 133, 137, 123, 88, 89, 82, 79, 93, 57,
+	76, 108, 55, 54, 64, 65, 92, 58, 69, 71,
+	56, 131, 132, 160, 119, 142, 117, 78, 81, 73,
+	74, 75, 19, 95, 140, 33, 16, 15, 99, 28,
+	17, 102, 135, 149, 105, 107, 100, 144, 33, 103,
+	101, 115, 106, 104, 111, 21, 25, 26, 27, 113,
+	114, 24, 22, 20, 112, 85, 18, 10, 8, 9,
+	71, 124, 53, 38, 37, 122, 36, 128, 120, 35,
+	126, 30, 29, 159, 134, 71, 136, 118, 72, 141,
+	139, 138, 110, 109, 3, 6, 66, 77, 143, 145,
+	83, 2, 4, 80, 148, 23, 130, 71, 116, 147,
+	39, 152, 151, 154, 71, 81, 1, 0, 158, 156,
+	155, 161, 0, 0, 81, 163, 43, 44, 45, 46,
+	47, 48, 49, 50, 51, 40, 41, 42,
 }
 
 var yyPact = [...]int16{
-	-1000, -1000, -1000, -1000, -1000, 96, -30, -1000, 74, 76,
-	70, -1000, -1000, -1000, 69, -1000, 71, -1000, 117, 116,
-	83, 83, 114, 111, 109, -1000, -1000, -1000, -1000, -1000,
-	-1000, 108, 153, -1000, 107, 13, 12, 20, 15, -5,
+	-1000, -1000, -1000, -1000, -1000, 99, -30, -1000, 72, 75,
+	68, -1000, -1000, -1000, 70, -1000, 74, -1000, 118, 117,
+	84, 84, 115, 112, 110, -1000, -1000, -1000, -1000, -1000,
+	-1000, 109, 154, -1000, 108, 13, 12, 20, 18, -5,
 	-18, -19, -20, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -5, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 83, 83, 83, -1000, 9, 6, 5, 103, -1000,
-	8, -11, -28, -23, -24, -5, -30, -1000, -5, -30,
-	-1000, -5, -30, -1000, 11, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 89, 83, -5, -5, -1000, -1000,
-	86, -1000, -1000, 59, -1000, -1000, 40, -1000, -43, 2,
-	-29, -25, -1000, -1000, -7, 27, -1, 64, -1000, 1,
-	-1000, -30, -1000, -1000, 68, -1000, -5, -1000, 51, 83,
-	-1000, -1000, -1000, 82, -1000, -1000, -5, -1000, -2, -30,
-	-1000, -5, 78, -4, -1000, -1000, -1000, -1000, -8, -1000,
-	-30, -1000, -1000, -14, -1000, -5, 33, -1000, -5, -6,
-	-1000, -1000, -16, -1000,
+	-1000, -1000, -5, -5, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 84, 84, 84, -1000, -1000, 9, 6, 5, 101,
+	-1000, 8, -11, -28, -23, -24, -5, -30, -1000, -5,
+	-30, -1000, -5, -30, -1000, 11, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 90, 84, -5, -5, -1000,
+	-1000, 87, -1000, -1000, 60, -1000, -1000, 40, -1000, -43,
+	2, -29, -25, -1000, -1000, -7, 27, -1, 71, -1000,
+	1, -1000, -30, -1000, -1000, 69, -1000, -5, -1000, 59,
+	84, -1000, -1000, -1000, 83, -1000, -1000, -5, -1000, -2,
+	-30, -1000, -5, 79, -4, -1000, -1000, -1000, -1000, -8,
+	-1000, -30, -1000, -1000, -14, -1000, -5, 33, -1000, -5,
+	-6, -1000, -1000, -16, -1000,
 }
 
 var yyPgo = [...]uint8{
-	0, 0, 11, 155, 7, 149, 147, 145, 144, 142,
-	2, 141, 140, 139, 9, 136, 135, 134, 133, 5,
-	132, 131, 127, 1, 8, 126, 123, 122,
+	0, 0, 11, 156, 7, 150, 148, 146, 145, 143,
+	2, 142, 141, 140, 9, 137, 136, 135, 134, 5,
+	133, 132, 128, 1, 8, 127, 124, 123,
 }
 
 var yyR1 = [...]int8{
@@ -248,7 +248,7 @@ var yyR2 = [...]int8{
 	3, 7, 6, 8, 8, 8, 11, 1, 1, 1,
 	0, 3, 4, 6, 0, 3, 7, 9, 2, 0,
 	1, 1, 0, 0, 3, 10, 1, 0, 1, 1,
-	0, 4, 3, 8, 6, 6, 2, 1, 1, 1,
+	0, 4, 3, 8, 6, 6, 3, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
 	2, 2, 4, 4, 0, 3, 0, 6, 0, 3,
 	0, 6, 4, 0, 0, 1, 1, 0,
@@ -261,17 +261,17 @@ var yyChk = [...]int16{
 	4, -4, -1, 4, -4, 4, 4, 4, 4, -5,
 	21, 22, 23, 12, 13, 14, 15, 16, 17, 18,
 	19, 20, -1, 4, 40, 40, 40, 29, 39, -23,
-	43, 45, 45, 45, -23, -16, -10, -14, -1, -19,
-	-1, -22, -4, -4, -4, 41, -15, -1, 41, -9,
-	-1, 41, -13, -2, 4, 6, 7, 36, 37, 5,
-	4, 48, 40, 44, -1, 46, 47, 47, -23, -24,
-	-2, -23, -24, -2, -23, -24, -1, 40, -20, -21,
-	4, -4, -23, -23, 4, -6, 6, -25, 24, -14,
-	49, -19, 41, -1, 39, -24, 47, -23, 39, -7,
-	34, 35, 42, -26, 11, -4, 41, -24, -19, 5,
-	-23, 6, -4, 4, -23, 42, -24, -23, 4, 43,
-	-19, -23, 39, -10, -24, -19, 44, -23, -27, 30,
-	-23, 43, -10, 44,
+	43, 45, 45, 45, -23, -23, -16, -10, -14, -1,
+	-19, -1, -22, -4, -4, -4, 41, -15, -1, 41,
+	-9, -1, 41, -13, -2, 4, 6, 7, 36, 37,
+	5, 4, 48, 40, 44, -1, 46, 47, 47, -23,
+	-24, -2, -23, -24, -2, -23, -24, -1, 40, -20,
+	-21, 4, -4, -23, -23, 4, -6, 6, -25, 24,
+	-14, 49, -19, 41, -1, 39, -24, 47, -23, 39,
+	-7, 34, 35, 42, -26, 11, -4, 41, -24, -19,
+	5, -23, 6, -4, 4, -23, 42, -24, -23, 4,
+	43, -19, -23, 39, -10, -24, -19, 44, -23, -27,
+	30, -23, 43, -10, 44,
 }
 
 var yyDef = [...]int8{
@@ -280,18 +280,18 @@ var yyDef = [...]int8{
 	73, 73, 0, 0, 0, 17, 18, 19, 5, 7,
 	8, 0, 0, 73, 0, 0, 0, 0, 0, 68,
 	0, 0, 0, 47, 48, 49, 50, 51, 52, 53,
-	54, 55, 46, 68, 20, 24, 33, 73, 73, 42,
-	70, 73, 73, 73, 12, 73, 73, 74, 0, 11,
-	0, 73, 0, 0, 0, 68, 77, 74, 68, 77,
-	74, 68, 77, 73, 0, 56, 57, 58, 59, 60,
-	61, 64, 66, 69, 0, 73, 68, 68, 13, 21,
-	0, 14, 25, 29, 15, 34, 37, 33, 73, 73,
-	77, 0, 44, 45, 68, 32, 0, 73, 36, 74,
-	62, 77, 63, 73, 0, 72, 68, 22, 0, 73,
-	30, 31, 28, 0, 38, 39, 68, 65, 0, 77,
-	43, 68, 0, 0, 16, 73, 71, 23, 68, 24,
-	77, 26, 73, 73, 67, 68, 40, 27, 68, 0,
-	35, 24, 73, 41,
+	54, 55, 68, 68, 20, 24, 33, 73, 73, 42,
+	70, 73, 73, 73, 46, 12, 73, 73, 74, 0,
+	11, 0, 73, 0, 0, 0, 68, 77, 74, 68,
+	77, 74, 68, 77, 73, 0, 56, 57, 58, 59,
+	60, 61, 64, 66, 69, 0, 73, 68, 68, 13,
+	21, 0, 14, 25, 29, 15, 34, 37, 33, 73,
+	73, 77, 0, 44, 45, 68, 32, 0, 73, 36,
+	74, 62, 77, 63, 73, 0, 72, 68, 22, 0,
+	73, 30, 31, 28, 0, 38, 39, 68, 65, 0,
+	77, 43, 68, 0, 0, 16, 73, 71, 23, 68,
+	24, 77, 26, 73, 73, 67, 68, 40, 27, 68,
+	0, 35, 24, 73, 41,
 }
 
 var yyTok1 = [...]int8{
@@ -1002,9 +1002,13 @@ yydefault:
 			yyVAL.fieldType = ast.SetType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
 	case 46:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.fieldType = ast.TypeReference{Name: yyDollar[1].str, Line: yyDollar[2].pos.Line, Column: yyDollar[2].pos.Column}
+			t, err := typeReference(yyDollar[1].str, yyDollar[3].typeAnnotations, yyDollar[2].pos)
+			if err != nil {
+				yylex.(*lexer).AppendError(err)
+			}
+			yyVAL.fieldType = t
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
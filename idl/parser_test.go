@@ -150,6 +150,10 @@ func TestParseErrors(t *testing.T) {
 			give:       `typedef string (foo =) UUID`,
 			wantErrors: []string{"line 1:22", "unexpected ')'"},
 		},
+		{
+			give:       `struct Foo { 1: Bar (foo = "bar") bar }`,
+			wantErrors: []string{"line 1:33", `annotations are not allowed on references to "Bar"`},
+		},
 		{
 			give:       `union Operation { 1: Insert insert; 2: Delete delete }`,
 			wantErrors: []string{"line 1:47", `"delete" is a reserved keyword`},
@@ -597,6 +601,65 @@ func TestParseTypedef(t *testing.T) {
 	assertParseCases(t, tests)
 }
 
+func TestParseUUID(t *testing.T) {
+	tests := []parseCase{
+		{
+			`
+				typedef uuid RequestID
+				typedef uuid (go.type = "example.com/uuid.UUID") TraceID
+
+				struct Event {
+					1: required uuid uuid
+				}
+			`,
+			&Program{Definitions: []Definition{
+				&Typedef{
+					Name:   "RequestID",
+					Type:   BaseType{ID: UUIDTypeID, Line: 2, Column: 13},
+					Line:   2,
+					Column: 5,
+				},
+				&Typedef{
+					Name: "TraceID",
+					Type: BaseType{
+						ID:     UUIDTypeID,
+						Line:   3,
+						Column: 13,
+						Annotations: []*Annotation{
+							{
+								Name:   "go.type",
+								Value:  "example.com/uuid.UUID",
+								Line:   3,
+								Column: 19,
+							},
+						},
+					},
+					Line:   3,
+					Column: 5,
+				},
+				&Struct{
+					Name: "Event",
+					Type: StructType,
+					Fields: []*Field{
+						{
+							ID:           1,
+							Name:         "uuid",
+							Type:         BaseType{ID: UUIDTypeID, Line: 6, Column: 18},
+							Requiredness: Required,
+							Line:         6,
+							Column:       6,
+						},
+					},
+					Line:   5,
+					Column: 5,
+				},
+			}},
+		},
+	}
+
+	assertParseCases(t, tests)
+}
+
 func TestParseEnum(t *testing.T) {
 	aValue := 42
 
@@ -0,0 +1,558 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+)
+
+// initData is the data available to the templates used by "thriftrw init".
+type initData struct {
+	// Name of the project. This is also the name of the Go package and of
+	// the Thrift file.
+	Name string
+
+	// ImportPath of the project's root package.
+	ImportPath string
+
+	// Version of ThriftRW required by the project's go.mod.
+	Version string
+}
+
+// _latestRelease is the latest released version of ThriftRW. Update it with
+// each release.
+const _latestRelease = "1.30.0"
+
+var _readBuildInfo = debug.ReadBuildInfo
+
+// initModuleVersion returns the version of ThriftRW required by go.mod files
+// written by "thriftrw init".
+//
+// This is the version of the running binary if it was installed from the
+// module proxy, as with "go install go.uber.org/thriftrw@v1.30.0". Binaries
+// built from a local checkout report version.Version, which may not have
+// been released and could not be fetched, so the latest release is used
+// for them instead.
+func initModuleVersion() string {
+	info, ok := _readBuildInfo()
+	if !ok || info.Main.Path != "go.uber.org/thriftrw" {
+		return _latestRelease
+	}
+
+	v := info.Main.Version
+	if !strings.HasPrefix(v, "v") || strings.Contains(v, "+") {
+		// "(devel)", or a build with local changes.
+		return _latestRelease
+	}
+	return strings.TrimPrefix(v, "v")
+}
+
+// initFile is a file written by "thriftrw init".
+type initFile struct {
+	Path     string // relative to the project directory
+	Template string
+}
+
+var initFiles = []initFile{
+	{Path: "idl/<.Name>.thrift", Template: initThriftTemplate},
+	{Path: "generate.go", Template: initGenerateTemplate},
+	{Path: "server.go", Template: initServerTemplate},
+	{Path: "client.go", Template: initClientTemplate},
+	{Path: "greeter.go", Template: initGreeterTemplate},
+	{Path: "<.Name>_test.go", Template: initTestTemplate},
+}
+
+// initProject implements "thriftrw init NAME".
+//
+// It creates a directory named after the project inside the output directory
+// with a sample Thrift file, the code generated for it, a small HTTP server
+// and client for the service, and tests exercising them. A go.mod is added
+// if the directory is not already part of a Go module.
+func initProject(name string, gopts genOptions) error {
+	if !isValidProjectName(name) {
+		return fmt.Errorf(
+			"invalid project name %q: the name must be a valid Go package name "+
+				"made up of lowercase letters and digits", name)
+	}
+
+	parent := gopts.OutputDirectory
+	if parent == "" {
+		parent = "."
+	}
+	parent, err := filepath.Abs(parent)
+	if err != nil {
+		return fmt.Errorf("Unable to resolve absolute path for %q: %v", parent, err)
+	}
+
+	dir := filepath.Join(parent, name)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%q already exists", dir)
+	}
+
+	data := initData{Name: name, Version: initModuleVersion()}
+	writeGoMod := false
+	switch {
+	case gopts.PackagePrefix != "":
+		data.ImportPath = path.Join(gopts.PackagePrefix, name)
+	default:
+		data.ImportPath, err = findImportPath(dir)
+		if err != nil {
+			return err
+		}
+		if data.ImportPath == "" {
+			// Not inside a module: make the project its own module.
+			data.ImportPath = name
+			writeGoMod = true
+		}
+	}
+
+	files := initFiles
+	if writeGoMod {
+		files = append(files, initFile{Path: "go.mod", Template: initGoModTemplate})
+	}
+
+	for _, f := range files {
+		if err := writeInitFile(dir, f, &data); err != nil {
+			return err
+		}
+	}
+
+	thriftRoot := filepath.Join(dir, "idl")
+	thriftFile := filepath.Join(thriftRoot, name+".thrift")
+	module, err := compile.Compile(thriftFile)
+	if err != nil {
+		return fmt.Errorf("Failed to compile %q: %+v", thriftFile, err)
+	}
+
+	// Keep this in sync with the go:generate directive in generate.go.
+	err = gen.Generate(module, &gen.Options{
+		OutputDir:     filepath.Join(dir, "gen"),
+		PackagePrefix: data.ImportPath + "/gen",
+		ThriftRoot:    thriftRoot,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to generate code: %+v", err)
+	}
+
+	fmt.Printf("Created %v in %v.\n", data.ImportPath, dir)
+	if writeGoMod {
+		fmt.Printf("Run 'go mod tidy' inside it to fetch its dependencies.\n")
+	}
+	return nil
+}
+
+func writeInitFile(dir string, f initFile, data *initData) error {
+	name, err := executeInitTemplate(f.Path, f.Path, data)
+	if err != nil {
+		return err
+	}
+	filePath := string(name)
+
+	contents, err := executeInitTemplate(filePath, f.Template, data)
+	if err != nil {
+		return err
+	}
+
+	if filepath.Ext(filePath) == ".go" {
+		contents, err = format.Source(contents)
+		if err != nil {
+			return fmt.Errorf("failed to format %q: %v", filePath, err)
+		}
+	}
+
+	filePath = filepath.Join(dir, filePath)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, contents, 0644)
+}
+
+func executeInitTemplate(name, text string, data *initData) ([]byte, error) {
+	tmpl, err := template.New(name).Delims("<", ">").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %q: %v", name, err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template %q: %v", name, err)
+	}
+	return bytes.TrimLeft(buff.Bytes(), "\n"), nil
+}
+
+// isValidProjectName reports whether name may be used as the name of a
+// project created by "thriftrw init".
+func isValidProjectName(name string) bool {
+	if name == "" || token.Lookup(name).IsKeyword() {
+		return false
+	}
+
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// findImportPath returns the import path of the given directory based on the
+// go.mod of the module containing it. An empty string is returned if the
+// directory is not inside a module.
+func findImportPath(dir string) (string, error) {
+	for root := filepath.Dir(dir); ; root = filepath.Dir(root) {
+		f, err := os.Open(filepath.Join(root, "go.mod"))
+		if err == nil {
+			modPath, err := readModulePath(f)
+			f.Close()
+			if err != nil {
+				return "", fmt.Errorf("could not read %q: %v", f.Name(), err)
+			}
+
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modPath, filepath.ToSlash(rel)), nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if filepath.Dir(root) == root {
+			return "", nil
+		}
+	}
+}
+
+// readModulePath reads the module path from the "module" directive of a
+// go.mod file.
+func readModulePath(f *os.File) (string, error) {
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "module") {
+			continue
+		}
+
+		modPath := strings.TrimSpace(strings.TrimPrefix(line, "module"))
+		if unquoted, err := strconv.Unquote(modPath); err == nil {
+			modPath = unquoted
+		}
+		if modPath != "" {
+			return modPath, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no module directive found")
+}
+
+const initThriftTemplate = `
+/**
+ * GreetRequest is the request for Greeter.greet.
+ */
+struct GreetRequest {
+    1: required string name
+}
+
+/**
+ * GreetResponse is the response of Greeter.greet.
+ */
+struct GreetResponse {
+    1: required string message
+}
+
+/**
+ * InvalidName is raised when a greeting was requested for an invalid name.
+ */
+exception InvalidName {
+    1: required string message
+}
+
+/**
+ * Greeter greets people.
+ */
+service Greeter {
+    GreetResponse greet(1: GreetRequest request) throws (1: InvalidName invalidName)
+}
+`
+
+const initGenerateTemplate = `
+// Package <.Name> implements a sample Thrift service created by
+// "thriftrw init".
+//
+// The Thrift definition of the service is in idl/<.Name>.thrift. After
+// changing it, regenerate the code in gen/ by running "go generate" in this
+// directory.
+package <.Name>
+
+//go:generate thriftrw --out=gen --thrift-root=idl --pkg-prefix=<.ImportPath>/gen idl/<.Name>.thrift
+`
+
+const initServerTemplate = `
+package <.Name>
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"<.ImportPath>/gen/<.Name>"
+)
+
+// Service is implemented by servers of the Greeter service.
+type Service interface {
+	Greet(ctx context.Context, req *<.Name>.GreetRequest) (*<.Name>.GreetResponse, error)
+}
+
+// NewHandler returns an http.Handler that serves the Greeter service with
+// svc.
+//
+// Each request body holds a single enveloped Thrift call encoded with the
+// binary protocol, and each response body holds the reply.
+func NewHandler(svc Service) http.Handler {
+	return &handler{svc: svc}
+}
+
+type handler struct {
+	svc Service
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req, err := binary.Default.DecodeEnveloped(bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.handle(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var buff bytes.Buffer
+	if err := envelope.Write(binary.Default, &buff, req.SeqID, res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(buff.Bytes())
+}
+
+// handle calls the method of the service requested by the given envelope.
+// Exceptions declared in the Thrift file are part of the returned result.
+func (h *handler) handle(ctx context.Context, req wire.Envelope) (envelope.Enveloper, error) {
+	switch req.Name {
+	case "greet":
+		var args <.Name>.Greeter_Greet_Args
+		if err := args.FromWire(req.Value); err != nil {
+			return nil, err
+		}
+		return <.Name>.Greeter_Greet_Helper.WrapResponse(h.svc.Greet(ctx, args.Request))
+	default:
+		return nil, fmt.Errorf("unknown method %q", req.Name)
+	}
+}
+`
+
+const initClientTemplate = `
+package <.Name>
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"<.ImportPath>/gen/<.Name>"
+)
+
+const contentType = "application/vnd.apache.thrift.binary"
+
+// Client calls the Greeter service over HTTP.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+var _ Service = (*Client)(nil)
+
+// NewClient builds a new Client for the Greeter service served at the given
+// URL by a handler returned by NewHandler.
+func NewClient(url string) *Client {
+	return &Client{url: url, httpClient: http.DefaultClient}
+}
+
+// Greet calls Greeter.greet.
+func (c *Client) Greet(ctx context.Context, req *<.Name>.GreetRequest) (*<.Name>.GreetResponse, error) {
+	body, err := c.call(ctx, <.Name>.Greeter_Greet_Helper.Args(req))
+	if err != nil {
+		return nil, err
+	}
+
+	var result <.Name>.Greeter_Greet_Result
+	if err := result.FromWire(body); err != nil {
+		return nil, err
+	}
+	return <.Name>.Greeter_Greet_Helper.UnwrapResponse(&result)
+}
+
+// call sends the given request and returns the body of the reply.
+func (c *Client) call(ctx context.Context, req envelope.Enveloper) (wire.Value, error) {
+	var buff bytes.Buffer
+	if err := envelope.Write(binary.Default, &buff, 1, req); err != nil {
+		return wire.Value{}, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, &buff)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+
+	res, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return wire.Value{}, fmt.Errorf("%v: %s", res.Status, bytes.TrimSpace(body))
+	}
+
+	value, _, err := envelope.ReadReply(binary.Default, bytes.NewReader(body))
+	return value, err
+}
+`
+
+const initGreeterTemplate = `
+package <.Name>
+
+import (
+	"context"
+
+	"<.ImportPath>/gen/<.Name>"
+)
+
+// NewService returns a sample implementation of the Greeter service.
+//
+// Replace it with your own implementation.
+func NewService() Service {
+	return greeter{}
+}
+
+type greeter struct{}
+
+func (greeter) Greet(ctx context.Context, req *<.Name>.GreetRequest) (*<.Name>.GreetResponse, error) {
+	if req.GetName() == "" {
+		return nil, &<.Name>.InvalidName{Message: "name must not be empty"}
+	}
+	return &<.Name>.GreetResponse{Message: "Hello, " + req.GetName() + "!"}, nil
+}
+`
+
+const initTestTemplate = `
+package <.Name>
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"<.ImportPath>/gen/<.Name>"
+)
+
+func TestGreet(t *testing.T) {
+	server := httptest.NewServer(NewHandler(NewService()))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		res, err := client.Greet(ctx, &<.Name>.GreetRequest{Name: "world"})
+		if err != nil {
+			t.Fatalf("Greet failed: %v", err)
+		}
+		if want := "Hello, world!"; res.Message != want {
+			t.Errorf("Message = %q, want %q", res.Message, want)
+		}
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		_, err := client.Greet(ctx, &<.Name>.GreetRequest{})
+
+		var invalidName *<.Name>.InvalidName
+		if !errors.As(err, &invalidName) {
+			t.Fatalf("expected InvalidName, got %v", err)
+		}
+	})
+}
+`
+
+const initGoModTemplate = `
+module <.Name>
+
+go 1.17
+
+require go.uber.org/thriftrw v<.Version>
+`
@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitIsUpToDate(t *testing.T) {
+	// This test verifies that the sample project in internal/examples/hello
+	// matches the output of "thriftrw init". If this test fails, delete
+	// that directory, run "thriftrw init --out internal/examples hello",
+	// and commit the changes.
+	const exampleDir = "internal/examples/hello"
+
+	outputDir := t.TempDir()
+	require.NoError(t, initProject("hello", genOptions{
+		OutputDirectory: outputDir,
+		PackagePrefix:   "go.uber.org/thriftrw/internal/examples",
+	}))
+
+	var want []string
+	err := filepath.Walk(exampleDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(exampleDir, path)
+		if err != nil {
+			return err
+		}
+		want = append(want, rel)
+
+		wantContents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		gotContents, err := os.ReadFile(filepath.Join(outputDir, "hello", rel))
+		if assert.NoError(t, err, "%v was not generated", rel) {
+			assert.Equal(t, string(wantContents), string(gotContents), "%v is out of date", rel)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, want, 7, "unexpected number of files in %v", exampleDir)
+}
+
+func TestInitGoMod(t *testing.T) {
+	t.Run("outside a module", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, initProject("demo", genOptions{OutputDirectory: dir}))
+
+		gomod, err := os.ReadFile(filepath.Join(dir, "demo", "go.mod"))
+		require.NoError(t, err)
+		assert.Contains(t, string(gomod), "module demo\n")
+		assert.Contains(t, string(gomod), "require go.uber.org/thriftrw v"+_latestRelease+"\n",
+			"tests are not built from a released module")
+
+		generated, err := os.ReadFile(filepath.Join(dir, "demo/gen/demo/demo.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(generated), "package demo")
+	})
+
+	t.Run("inside a module", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"),
+			[]byte("module \"example.com/foo\"\n\ngo 1.17\n"), 0644))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "services"), 0755))
+
+		require.NoError(t, initProject("demo", genOptions{
+			OutputDirectory: filepath.Join(dir, "services"),
+		}))
+
+		_, err := os.Stat(filepath.Join(dir, "services/demo/go.mod"))
+		assert.True(t, os.IsNotExist(err), "go.mod must not be written inside a module")
+
+		server, err := os.ReadFile(filepath.Join(dir, "services/demo/server.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(server), `"example.com/foo/services/demo/gen/demo"`)
+	})
+}
+
+func TestInitErrors(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "taken"), 0755))
+
+	tests := []struct {
+		desc    string
+		name    string
+		wantErr string
+	}{
+		{desc: "uppercase", name: "Hello", wantErr: `invalid project name "Hello"`},
+		{desc: "leading digit", name: "1hello", wantErr: `invalid project name "1hello"`},
+		{desc: "keyword", name: "func", wantErr: `invalid project name "func"`},
+		{desc: "empty", name: "", wantErr: `invalid project name ""`},
+		{desc: "exists", name: "taken", wantErr: "already exists"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := initProject(tt.name, genOptions{OutputDirectory: dir})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestInitModuleVersion(t *testing.T) {
+	defer func(f func() (*debug.BuildInfo, bool)) { _readBuildInfo = f }(_readBuildInfo)
+
+	tests := []struct {
+		desc string
+		info *debug.BuildInfo
+		want string
+	}{
+		{desc: "no build info", want: _latestRelease},
+		{
+			desc: "released",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "go.uber.org/thriftrw", Version: "v1.29.2"}},
+			want: "1.29.2",
+		},
+		{
+			desc: "pseudo-version",
+			info: &debug.BuildInfo{Main: debug.Module{
+				Path:    "go.uber.org/thriftrw",
+				Version: "v1.30.1-0.20230501000000-0123456789ab",
+			}},
+			want: "1.30.1-0.20230501000000-0123456789ab",
+		},
+		{
+			desc: "local checkout",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "go.uber.org/thriftrw", Version: "(devel)"}},
+			want: _latestRelease,
+		},
+		{
+			desc: "local changes",
+			info: &debug.BuildInfo{Main: debug.Module{
+				Path:    "go.uber.org/thriftrw",
+				Version: "v1.30.1-0.20230501000000-0123456789ab+dirty",
+			}},
+			want: _latestRelease,
+		},
+		{
+			desc: "other module",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "example.com/tools", Version: "v0.1.0"}},
+			want: _latestRelease,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_readBuildInfo = func() (*debug.BuildInfo, bool) {
+				return tt.info, tt.info != nil
+			}
+			assert.Equal(t, tt.want, initModuleVersion())
+		})
+	}
+}
+
+func TestLatestReleaseInChangelog(t *testing.T) {
+	changelog, err := os.ReadFile("CHANGELOG.md")
+	require.NoError(t, err)
+	assert.Contains(t, string(changelog), "## ["+_latestRelease+"]",
+		"_latestRelease must be a released version")
+}
@@ -40,6 +40,10 @@ type Transport interface {
 type Client interface {
 	// Send sends a request to the method with the given name and body.
 	Send(name string, body wire.Value) (wire.Value, error)
+
+	// SendOneWay sends a request to the oneway method with the given name
+	// and body. No reply is expected for oneway requests.
+	SendOneWay(name string, body wire.Value) error
 }
 
 // NewClient builds a new client which sends requests over the given
@@ -97,6 +101,26 @@ func (c client) Send(name string, reqValue wire.Value) (wire.Value, error) {
 	}
 }
 
+// SendOneWay sends the given oneway request envelope over this transport.
+//
+// Anything the transport returns in response to the request is discarded.
+func (c client) SendOneWay(name string, reqValue wire.Value) error {
+	reqEnvelope := wire.Envelope{
+		Name:  name,
+		Type:  wire.OneWay,
+		SeqID: 1, // don't care
+		Value: reqValue,
+	}
+
+	var buff bytes.Buffer
+	if err := c.p.EncodeEnveloped(reqEnvelope, &buff); err != nil {
+		return err
+	}
+
+	_, err := c.t.Send(buff.Bytes())
+	return err
+}
+
 type errUnknownEnvelopeType wire.EnvelopeType
 
 func (e errUnknownEnvelopeType) Error() string {
@@ -127,3 +127,46 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, tt.wantError, err)
 	}
 }
+
+func TestClientOneWay(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		desc           string
+		transportError error
+		wantError      error
+	}{
+		{desc: "nothing went wrong"},
+		{
+			desc:           "transport error",
+			transportError: errors.New("great sadness"),
+			wantError:      errors.New("great sadness"),
+		},
+	}
+
+	for _, tt := range tests {
+		proto := NewMockProtocol(mockCtrl)
+		proto.EXPECT().EncodeEnveloped(
+			wire.Envelope{
+				Name:  "hello",
+				Type:  wire.OneWay,
+				SeqID: 1,
+				Value: wire.NewValueStruct(wire.Struct{}),
+			},
+			gomock.Any(),
+		).Do(func(_ wire.Envelope, w io.Writer) {
+			_, err := w.Write([]byte{1, 2, 3})
+			assert.NoError(t, err, tt.desc)
+		}).Return(nil)
+
+		// The response, if any, must be ignored; DecodeEnveloped is never
+		// called.
+		transport := envelopetest.NewMockTransport(mockCtrl)
+		transport.EXPECT().Send([]byte{1, 2, 3}).Return(nil, tt.transportError)
+
+		client := NewClient(proto, transport)
+		err := client.SendOneWay("hello", wire.NewValueStruct(wire.Struct{}))
+		assert.Equal(t, tt.wantError, err, tt.desc)
+	}
+}
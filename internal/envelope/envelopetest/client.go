@@ -106,3 +106,17 @@ func (mr *MockClientMockRecorder) Send(name, body interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockClient)(nil).Send), name, body)
 }
+
+// SendOneWay mocks base method.
+func (m *MockClient) SendOneWay(name string, body wire.Value) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOneWay", name, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOneWay indicates an expected call of SendOneWay.
+func (mr *MockClientMockRecorder) SendOneWay(name, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOneWay", reflect.TypeOf((*MockClient)(nil).SendOneWay), name, body)
+}
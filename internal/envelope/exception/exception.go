@@ -1,37 +1,16 @@
 // Code generated by thriftrw v1.31.0. DO NOT EDIT.
 // @generated
 
-// Copyright (c) 2023 Uber Technologies, Inc.
-//
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-//
-// The above copyright notice and this permission notice shall be included in
-// all copies or substantial portions of the Software.
-//
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
-// THE SOFTWARE.
-
 package exception
 
 import (
 	bytes "bytes"
 	json "encoding/json"
 	fmt "fmt"
-	multierr "go.uber.org/multierr"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
-	zapcore "go.uber.org/zap/zapcore"
 	math "math"
 	strconv "strconv"
 	strings "strings"
@@ -70,6 +49,34 @@ func ExceptionType_Values() []ExceptionType {
 	}
 }
 
+// ExceptionType_Names returns the names of all recognized values of
+// ExceptionType, in the same order as ExceptionType_Values.
+func ExceptionType_Names() []string {
+	return []string{
+		"UNKNOWN",
+		"UNKNOWN_METHOD",
+		"INVALID_MESSAGE_TYPE",
+		"WRONG_METHOD_NAME",
+		"BAD_SEQUENCE_ID",
+		"MISSING_RESULT",
+		"INTERNAL_ERROR",
+		"PROTOCOL_ERROR",
+		"INVALID_TRANSFORM",
+		"INVALID_PROTOCOL",
+		"UNSUPPORTED_CLIENT_TYPE",
+	}
+}
+
+// IsValid returns true if this ExceptionType is one of its recognized
+// values.
+func (v ExceptionType) IsValid() bool {
+	switch int32(v) {
+	case 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10:
+		return true
+	}
+	return false
+}
+
 // UnmarshalText tries to decode ExceptionType from a byte slice
 // containing its name.
 //
@@ -154,44 +161,27 @@ func (v ExceptionType) MarshalText() ([]byte, error) {
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ExceptionType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v ExceptionType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "UNKNOWN")
-	case 1:
-		enc.AddString("name", "UNKNOWN_METHOD")
-	case 2:
-		enc.AddString("name", "INVALID_MESSAGE_TYPE")
-	case 3:
-		enc.AddString("name", "WRONG_METHOD_NAME")
-	case 4:
-		enc.AddString("name", "BAD_SEQUENCE_ID")
-	case 5:
-		enc.AddString("name", "MISSING_RESULT")
-	case 6:
-		enc.AddString("name", "INTERNAL_ERROR")
-	case 7:
-		enc.AddString("name", "PROTOCOL_ERROR")
-	case 8:
-		enc.AddString("name", "INVALID_TRANSFORM")
-	case 9:
-		enc.AddString("name", "INVALID_PROTOCOL")
-	case 10:
-		enc.AddString("name", "UNSUPPORTED_CLIENT_TYPE")
-	}
-	return nil
-}
-
 // Ptr returns a pointer to this enum value.
 func (v ExceptionType) Ptr() *ExceptionType {
 	return &v
 }
 
+// Set sets ExceptionType from its name or integer value.
+//
+// This implements flag.Value, allowing ExceptionType to be used as a
+// command line flag.
+func (v *ExceptionType) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v ExceptionType) Type() string {
+	return "ExceptionType"
+}
+
 // Encode encodes ExceptionType directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
@@ -620,19 +610,58 @@ func (v *TApplicationException) Equals(rhs *TApplicationException) bool {
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TApplicationException.
-func (v *TApplicationException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _ExceptionType_CopyPtr(v *ExceptionType) *ExceptionType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this TApplicationException.
+func (v *TApplicationException) Copy() *TApplicationException {
 	if v == nil {
 		return nil
 	}
+
+	var o TApplicationException
+	o.Message = _String_CopyPtr(v.Message)
+	o.Type = _ExceptionType_CopyPtr(v.Type)
+	return &o
+}
+
+// Hash returns a hash of this TApplicationException which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *TApplicationException) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
 	if v.Message != nil {
-		enc.AddString("message", *v.Message)
+		h.Field(1)
+		h.String(*v.Message)
 	}
 	if v.Type != nil {
-		err = multierr.Append(err, enc.AddObject("type", *v.Type))
+		h.Field(2)
+		h.Int32(int32(*v.Type))
 	}
-	return err
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this TApplicationException so that it may be reused.
+func (v *TApplicationException) Reset() {
+	*v = TApplicationException{}
 }
 
 // GetMessage returns the value of Message if it is set or its
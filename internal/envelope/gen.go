@@ -20,4 +20,4 @@
 
 package envelope
 
-//go:generate thriftrw --pkg-prefix=go.uber.org/thriftrw/internal/envelope exception.thrift
+//go:generate thriftrw --no-zap --pkg-prefix=go.uber.org/thriftrw/internal/envelope exception.thrift
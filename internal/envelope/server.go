@@ -59,12 +59,20 @@ func NewServer(p protocol.Protocol, h Handler) Server {
 }
 
 // Handle handles the given binary payload.
+//
+// No response is produced for oneway requests.
 func (s Server) Handle(data []byte) ([]byte, error) {
 	request, err := s.p.DecodeEnveloped(bytes.NewReader(data))
 	if err != nil {
 		return nil, err
 	}
 
+	// Oneway requests never receive a response, not even when they fail.
+	if request.Type == wire.OneWay {
+		_, _ = s.h.Handle(request.Name, request.Value)
+		return nil, nil
+	}
+
 	response := wire.Envelope{
 		Name:  request.Name,
 		SeqID: request.SeqID,
@@ -99,6 +99,31 @@ func TestServer(t *testing.T) {
 				}}),
 			},
 		},
+		{
+			desc: "oneway",
+			giveEnvelope: wire.Envelope{
+				Name:  "hello",
+				Type:  wire.OneWay,
+				SeqID: 1,
+				Value: wire.NewValueStruct(wire.Struct{}),
+			},
+			handler: func(name string, body wire.Value) (wire.Value, error) {
+				assert.Equal(t, "hello", name)
+				return wire.Value{}, nil
+			},
+		},
+		{
+			desc: "oneway failure",
+			giveEnvelope: wire.Envelope{
+				Name:  "hello",
+				Type:  wire.OneWay,
+				SeqID: 1,
+				Value: wire.NewValueStruct(wire.Struct{}),
+			},
+			handler: func(string, wire.Value) (wire.Value, error) {
+				return wire.Value{}, errors.New("great sadness")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -120,9 +145,14 @@ func TestServer(t *testing.T) {
 		}
 
 		server := NewServer(proto, tt.handler)
-		_, err := server.Handle([]byte{1, 2, 3})
+		res, err := server.Handle([]byte{1, 2, 3})
 		if tt.wantError != nil {
 			assert.Equal(t, tt.wantError, err, tt.desc)
+		} else {
+			assert.NoError(t, err, tt.desc)
+		}
+		if tt.wantEnvelope == nil {
+			assert.Empty(t, res, "%v: unexpected response", tt.desc)
 		}
 	}
 }
@@ -0,0 +1,76 @@
+package hello
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"go.uber.org/thriftrw/internal/examples/hello/gen/hello"
+)
+
+const contentType = "application/vnd.apache.thrift.binary"
+
+// Client calls the Greeter service over HTTP.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+var _ Service = (*Client)(nil)
+
+// NewClient builds a new Client for the Greeter service served at the given
+// URL by a handler returned by NewHandler.
+func NewClient(url string) *Client {
+	return &Client{url: url, httpClient: http.DefaultClient}
+}
+
+// Greet calls Greeter.greet.
+func (c *Client) Greet(ctx context.Context, req *hello.GreetRequest) (*hello.GreetResponse, error) {
+	body, err := c.call(ctx, hello.Greeter_Greet_Helper.Args(req))
+	if err != nil {
+		return nil, err
+	}
+
+	var result hello.Greeter_Greet_Result
+	if err := result.FromWire(body); err != nil {
+		return nil, err
+	}
+	return hello.Greeter_Greet_Helper.UnwrapResponse(&result)
+}
+
+// call sends the given request and returns the body of the reply.
+func (c *Client) call(ctx context.Context, req envelope.Enveloper) (wire.Value, error) {
+	var buff bytes.Buffer
+	if err := envelope.Write(binary.Default, &buff, 1, req); err != nil {
+		return wire.Value{}, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, &buff)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+
+	res, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return wire.Value{}, fmt.Errorf("%v: %s", res.Status, bytes.TrimSpace(body))
+	}
+
+	value, _, err := envelope.ReadReply(binary.Default, bytes.NewReader(body))
+	return value, err
+}
@@ -0,0 +1,1480 @@
+// Code generated by thriftrw v1.31.0. DO NOT EDIT.
+// @generated
+
+package hello
+
+import (
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+// GreetRequest is the request for Greeter.greet.
+type GreetRequest struct {
+	Name string `json:"name,required"`
+}
+
+// ToWire translates a GreetRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GreetRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GreetRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GreetRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GreetRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GreetRequest) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of GreetRequest is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a GreetRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GreetRequest struct could not be encoded.
+func (v *GreetRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a GreetRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GreetRequest struct could not be generated from the wire
+// representation.
+func (v *GreetRequest) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of GreetRequest is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GreetRequest
+// struct.
+func (v *GreetRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+
+	return fmt.Sprintf("GreetRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GreetRequest match the
+// provided GreetRequest.
+//
+// This function performs a deep comparison.
+func (v *GreetRequest) Equals(rhs *GreetRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this GreetRequest.
+func (v *GreetRequest) Copy() *GreetRequest {
+	if v == nil {
+		return nil
+	}
+
+	var o GreetRequest
+	o.Name = v.Name
+	return &o
+}
+
+// Hash returns a hash of this GreetRequest which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *GreetRequest) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this GreetRequest so that it may be reused.
+func (v *GreetRequest) Reset() {
+	*v = GreetRequest{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GreetRequest.
+func (v *GreetRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *GreetRequest) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GreetResponse is the response of Greeter.greet.
+type GreetResponse struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a GreetResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GreetResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GreetResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GreetResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GreetResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GreetResponse) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of GreetResponse is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a GreetResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GreetResponse struct could not be encoded.
+func (v *GreetResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a GreetResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GreetResponse struct could not be generated from the wire
+// representation.
+func (v *GreetResponse) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of GreetResponse is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GreetResponse
+// struct.
+func (v *GreetResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("GreetResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GreetResponse match the
+// provided GreetResponse.
+//
+// This function performs a deep comparison.
+func (v *GreetResponse) Equals(rhs *GreetResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this GreetResponse.
+func (v *GreetResponse) Copy() *GreetResponse {
+	if v == nil {
+		return nil
+	}
+
+	var o GreetResponse
+	o.Message = v.Message
+	return &o
+}
+
+// Hash returns a hash of this GreetResponse which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *GreetResponse) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this GreetResponse so that it may be reused.
+func (v *GreetResponse) Reset() {
+	*v = GreetResponse{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GreetResponse.
+func (v *GreetResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *GreetResponse) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// InvalidName is raised when a greeting was requested for an invalid name.
+type InvalidName struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a InvalidName struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *InvalidName) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a InvalidName struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a InvalidName struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v InvalidName
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *InvalidName) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of InvalidName is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a InvalidName struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a InvalidName struct could not be encoded.
+func (v *InvalidName) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a InvalidName struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a InvalidName struct could not be generated from the wire
+// representation.
+func (v *InvalidName) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of InvalidName is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a InvalidName
+// struct.
+func (v *InvalidName) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("InvalidName{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InvalidName) ErrorName() string {
+	return "InvalidName"
+}
+
+// Equals returns true if all the fields of this InvalidName match the
+// provided InvalidName.
+//
+// This function performs a deep comparison.
+func (v *InvalidName) Equals(rhs *InvalidName) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this InvalidName.
+func (v *InvalidName) Copy() *InvalidName {
+	if v == nil {
+		return nil
+	}
+
+	var o InvalidName
+	o.Message = v.Message
+	return &o
+}
+
+// Hash returns a hash of this InvalidName which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *InvalidName) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Message)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this InvalidName so that it may be reused.
+func (v *InvalidName) Reset() {
+	*v = InvalidName{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InvalidName.
+func (v *InvalidName) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *InvalidName) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *InvalidName) Error() string {
+	return v.String()
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "hello",
+	Package:  "go.uber.org/thriftrw/internal/examples/hello/gen/hello",
+	FilePath: "hello.thrift",
+	SHA1:     "d5136a5f06899d1774baec67b126cf561732ba26",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "/**\n * GreetRequest is the request for Greeter.greet.\n */\nstruct GreetRequest {\n    1: required string name\n}\n\n/**\n * GreetResponse is the response of Greeter.greet.\n */\nstruct GreetResponse {\n    1: required string message\n}\n\n/**\n * InvalidName is raised when a greeting was requested for an invalid name.\n */\nexception InvalidName {\n    1: required string message\n}\n\n/**\n * Greeter greets people.\n */\nservice Greeter {\n    GreetResponse greet(1: GreetRequest request) throws (1: InvalidName invalidName)\n}\n"
+
+// Greeter_Greet_Args represents the arguments for the Greeter.greet function.
+//
+// The arguments for greet are sent and received over the wire as this struct.
+type Greeter_Greet_Args struct {
+	Request *GreetRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a Greeter_Greet_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Greeter_Greet_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GreetRequest_Read(w wire.Value) (*GreetRequest, error) {
+	var v GreetRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Greeter_Greet_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Greeter_Greet_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Greeter_Greet_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Greeter_Greet_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _GreetRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Greeter_Greet_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Greeter_Greet_Args struct could not be encoded.
+func (v *Greeter_Greet_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GreetRequest_Decode(sr stream.Reader) (*GreetRequest, error) {
+	var v GreetRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Greeter_Greet_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Greeter_Greet_Args struct could not be generated from the wire
+// representation.
+func (v *Greeter_Greet_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _GreetRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Greeter_Greet_Args
+// struct.
+func (v *Greeter_Greet_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("Greeter_Greet_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Greeter_Greet_Args match the
+// provided Greeter_Greet_Args.
+//
+// This function performs a deep comparison.
+func (v *Greeter_Greet_Args) Equals(rhs *Greeter_Greet_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Greeter_Greet_Args.
+func (v *Greeter_Greet_Args) Copy() *Greeter_Greet_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Greeter_Greet_Args
+	o.Request = v.Request.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Greeter_Greet_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Greeter_Greet_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Request.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Greeter_Greet_Args so that it may be reused.
+func (v *Greeter_Greet_Args) Reset() {
+	*v = Greeter_Greet_Args{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Greeter_Greet_Args.
+func (v *Greeter_Greet_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *Greeter_Greet_Args) GetRequest() (o *GreetRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *Greeter_Greet_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "greet" for this struct.
+func (v *Greeter_Greet_Args) MethodName() string {
+	return "greet"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Greeter_Greet_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Greeter_Greet_Helper provides functions that aid in handling the
+// parameters and return values of the Greeter.greet
+// function.
+var Greeter_Greet_Helper = struct {
+	// Args accepts the parameters of greet in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *GreetRequest,
+	) *Greeter_Greet_Args
+
+	// IsException returns true if the given error can be thrown
+	// by greet.
+	//
+	// An error can be thrown by greet only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for greet
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// greet into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by greet
+	//
+	//   value, err := greet(args)
+	//   result, err := Greeter_Greet_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from greet: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*GreetResponse, error) (*Greeter_Greet_Result, error)
+
+	// UnwrapResponse takes the result struct for greet
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if greet threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Greeter_Greet_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Greeter_Greet_Result) (*GreetResponse, error)
+}{}
+
+func init() {
+	Greeter_Greet_Helper.Args = func(
+		request *GreetRequest,
+	) *Greeter_Greet_Args {
+		return &Greeter_Greet_Args{
+			Request: request,
+		}
+	}
+
+	Greeter_Greet_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *InvalidName:
+			return true
+		default:
+			return false
+		}
+	}
+
+	Greeter_Greet_Helper.WrapResponse = func(success *GreetResponse, err error) (*Greeter_Greet_Result, error) {
+		if err == nil {
+			return &Greeter_Greet_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *InvalidName:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for Greeter_Greet_Result.InvalidName")
+			}
+			return &Greeter_Greet_Result{InvalidName: e}, nil
+		}
+
+		return nil, err
+	}
+	Greeter_Greet_Helper.UnwrapResponse = func(result *Greeter_Greet_Result) (success *GreetResponse, err error) {
+		if result.InvalidName != nil {
+			err = result.InvalidName
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Greeter_Greet_Result represents the result of a Greeter.greet function call.
+//
+// The result of a greet execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Greeter_Greet_Result struct {
+	// Value returned by greet after a successful execution.
+	Success     *GreetResponse `json:"success,omitempty"`
+	InvalidName *InvalidName   `json:"invalidName,omitempty"`
+}
+
+// ToWire translates a Greeter_Greet_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Greeter_Greet_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.InvalidName != nil {
+		w, err = v.InvalidName.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Greeter_Greet_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GreetResponse_Read(w wire.Value) (*GreetResponse, error) {
+	var v GreetResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InvalidName_Read(w wire.Value) (*InvalidName, error) {
+	var v InvalidName
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Greeter_Greet_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Greeter_Greet_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Greeter_Greet_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Greeter_Greet_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GreetResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.InvalidName, err = _InvalidName_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InvalidName != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Greeter_Greet_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Greeter_Greet_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Greeter_Greet_Result struct could not be encoded.
+func (v *Greeter_Greet_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InvalidName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InvalidName.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InvalidName != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Greeter_Greet_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GreetResponse_Decode(sr stream.Reader) (*GreetResponse, error) {
+	var v GreetResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _InvalidName_Decode(sr stream.Reader) (*InvalidName, error) {
+	var v InvalidName
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Greeter_Greet_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Greeter_Greet_Result struct could not be generated from the wire
+// representation.
+func (v *Greeter_Greet_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _GreetResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.InvalidName, err = _InvalidName_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InvalidName != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Greeter_Greet_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Greeter_Greet_Result
+// struct.
+func (v *Greeter_Greet_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.InvalidName != nil {
+		fields[i] = fmt.Sprintf("InvalidName: %v", v.InvalidName)
+		i++
+	}
+
+	return fmt.Sprintf("Greeter_Greet_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Greeter_Greet_Result match the
+// provided Greeter_Greet_Result.
+//
+// This function performs a deep comparison.
+func (v *Greeter_Greet_Result) Equals(rhs *Greeter_Greet_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.InvalidName == nil && rhs.InvalidName == nil) || (v.InvalidName != nil && rhs.InvalidName != nil && v.InvalidName.Equals(rhs.InvalidName))) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Greeter_Greet_Result.
+func (v *Greeter_Greet_Result) Copy() *Greeter_Greet_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Greeter_Greet_Result
+	o.Success = v.Success.Copy()
+	o.InvalidName = v.InvalidName.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Greeter_Greet_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Greeter_Greet_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	h.Field(1)
+	h.Uint64(v.InvalidName.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Greeter_Greet_Result so that it may be reused.
+func (v *Greeter_Greet_Result) Reset() {
+	*v = Greeter_Greet_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Greeter_Greet_Result.
+func (v *Greeter_Greet_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.InvalidName != nil {
+		err = multierr.Append(err, enc.AddObject("invalidName", v.InvalidName))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Greeter_Greet_Result) GetSuccess() (o *GreetResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Greeter_Greet_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetInvalidName returns the value of InvalidName if it is set or its
+// zero value if it is unset.
+func (v *Greeter_Greet_Result) GetInvalidName() (o *InvalidName) {
+	if v != nil && v.InvalidName != nil {
+		return v.InvalidName
+	}
+
+	return
+}
+
+// IsSetInvalidName returns true if InvalidName is not nil.
+func (v *Greeter_Greet_Result) IsSetInvalidName() bool {
+	return v != nil && v.InvalidName != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "greet" for this struct.
+func (v *Greeter_Greet_Result) MethodName() string {
+	return "greet"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Greeter_Greet_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
@@ -0,0 +1,9 @@
+// Package hello implements a sample Thrift service created by
+// "thriftrw init".
+//
+// The Thrift definition of the service is in idl/hello.thrift. After
+// changing it, regenerate the code in gen/ by running "go generate" in this
+// directory.
+package hello
+
+//go:generate thriftrw --out=gen --thrift-root=idl --pkg-prefix=go.uber.org/thriftrw/internal/examples/hello/gen idl/hello.thrift
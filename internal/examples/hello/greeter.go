@@ -0,0 +1,23 @@
+package hello
+
+import (
+	"context"
+
+	"go.uber.org/thriftrw/internal/examples/hello/gen/hello"
+)
+
+// NewService returns a sample implementation of the Greeter service.
+//
+// Replace it with your own implementation.
+func NewService() Service {
+	return greeter{}
+}
+
+type greeter struct{}
+
+func (greeter) Greet(ctx context.Context, req *hello.GreetRequest) (*hello.GreetResponse, error) {
+	if req.GetName() == "" {
+		return nil, &hello.InvalidName{Message: "name must not be empty"}
+	}
+	return &hello.GreetResponse{Message: "Hello, " + req.GetName() + "!"}, nil
+}
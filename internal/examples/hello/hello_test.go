@@ -0,0 +1,37 @@
+package hello
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/examples/hello/gen/hello"
+)
+
+func TestGreet(t *testing.T) {
+	server := httptest.NewServer(NewHandler(NewService()))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		res, err := client.Greet(ctx, &hello.GreetRequest{Name: "world"})
+		if err != nil {
+			t.Fatalf("Greet failed: %v", err)
+		}
+		if want := "Hello, world!"; res.Message != want {
+			t.Errorf("Message = %q, want %q", res.Message, want)
+		}
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		_, err := client.Greet(ctx, &hello.GreetRequest{})
+
+		var invalidName *hello.InvalidName
+		if !errors.As(err, &invalidName) {
+			t.Fatalf("expected InvalidName, got %v", err)
+		}
+	})
+}
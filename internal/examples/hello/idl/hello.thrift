@@ -0,0 +1,27 @@
+/**
+ * GreetRequest is the request for Greeter.greet.
+ */
+struct GreetRequest {
+    1: required string name
+}
+
+/**
+ * GreetResponse is the response of Greeter.greet.
+ */
+struct GreetResponse {
+    1: required string message
+}
+
+/**
+ * InvalidName is raised when a greeting was requested for an invalid name.
+ */
+exception InvalidName {
+    1: required string message
+}
+
+/**
+ * Greeter greets people.
+ */
+service Greeter {
+    GreetResponse greet(1: GreetRequest request) throws (1: InvalidName invalidName)
+}
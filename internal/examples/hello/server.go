@@ -0,0 +1,77 @@
+package hello
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"go.uber.org/thriftrw/internal/examples/hello/gen/hello"
+)
+
+// Service is implemented by servers of the Greeter service.
+type Service interface {
+	Greet(ctx context.Context, req *hello.GreetRequest) (*hello.GreetResponse, error)
+}
+
+// NewHandler returns an http.Handler that serves the Greeter service with
+// svc.
+//
+// Each request body holds a single enveloped Thrift call encoded with the
+// binary protocol, and each response body holds the reply.
+func NewHandler(svc Service) http.Handler {
+	return &handler{svc: svc}
+}
+
+type handler struct {
+	svc Service
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req, err := binary.Default.DecodeEnveloped(bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.handle(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var buff bytes.Buffer
+	if err := envelope.Write(binary.Default, &buff, req.SeqID, res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(buff.Bytes())
+}
+
+// handle calls the method of the service requested by the given envelope.
+// Exceptions declared in the Thrift file are part of the returned result.
+func (h *handler) handle(ctx context.Context, req wire.Envelope) (envelope.Enveloper, error) {
+	switch req.Name {
+	case "greet":
+		var args hello.Greeter_Greet_Args
+		if err := args.FromWire(req.Value); err != nil {
+			return nil, err
+		}
+		return hello.Greeter_Greet_Helper.WrapResponse(h.svc.Greet(ctx, args.Request))
+	default:
+		return nil, fmt.Errorf("unknown method %q", req.Name)
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// avroNamespace is the namespace scope which names the Avro namespace of the
+// types of a Thrift file. By default, the name of the Thrift file is used.
+const avroNamespace = "avro"
+
+// Avro translates the structs, unions, exceptions, and enums of the given
+// module, and unless NoRecurse is set the modules it includes, into Avro
+// schemas. Each type is written to a file named after its full Avro name
+// with the .avsc extension, as in "shared.Point.avsc".
+//
+// Schemas are self-contained: named types they reference are defined
+// inline where they are first used. Optional fields become unions with
+// "null", typedefs are replaced by their targets, and Thrift unions become
+// records whose fields are all optional. Map keys which are not strings are
+// reported as warnings since Avro represents them as strings.
+func Avro(m *compile.Module, opts *Options) (*Result, error) {
+	// Namespaces are needed for every module that may be referenced, even
+	// with NoRecurse.
+	all := modules(m, &Options{})
+	namespaces := make(map[string]string, len(all))
+	for _, mod := range all {
+		namespaces[mod.ThriftPath] = avroNamespaceOf(mod)
+	}
+
+	var res Result
+	warned := make(map[string]struct{})
+	for _, mod := range modules(m, opts) {
+		for _, name := range sortStringKeys(mod.Types) {
+			switch mod.Types[name].(type) {
+			case *compile.EnumSpec, *compile.StructSpec:
+			default:
+				continue
+			}
+
+			w := avroWriter{
+				res:        &res,
+				namespaces: namespaces,
+				warned:     warned,
+				defined:    make(map[string]struct{}),
+			}
+			spec := mod.Types[name]
+			contents, err := marshalJSON(w.schemaOf(spec))
+			if err != nil {
+				return nil, fmt.Errorf("cannot translate %v: %v", name, err)
+			}
+			res.Files = append(res.Files, &File{
+				Path:     w.fullName(spec) + ".avsc",
+				Contents: contents,
+			})
+		}
+	}
+	sort.Slice(res.Files, func(i, j int) bool {
+		return res.Files[i].Path < res.Files[j].Path
+	})
+	return &res, nil
+}
+
+func avroNamespaceOf(m *compile.Module) string {
+	if ns := m.Namespaces[avroNamespace]; ns != "" {
+		return ns
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '.' || r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, baseName(m.ThriftPath))
+}
+
+type avroRecord struct {
+	Type      string       `json:"type"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace"`
+	Doc       string       `json:"doc,omitempty"`
+	Fields    []*avroField `json:"fields"`
+}
+
+type avroField struct {
+	Name    string      `json:"name"`
+	Doc     string      `json:"doc,omitempty"`
+	Type    interface{} `json:"type"`
+	Default interface{} `json:"default,omitempty"`
+}
+
+type avroEnum struct {
+	Type      string   `json:"type"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Doc       string   `json:"doc,omitempty"`
+	Symbols   []string `json:"symbols"`
+}
+
+type avroArray struct {
+	Type  string      `json:"type"`
+	Items interface{} `json:"items"`
+}
+
+type avroMap struct {
+	Type   string      `json:"type"`
+	Values interface{} `json:"values"`
+}
+
+type avroLogical struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+}
+
+// avroNull is the null default value of optional fields. It is distinct
+// from a nil interface so that it is not omitted.
+type avroNull struct{}
+
+func (avroNull) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// avroWriter builds a single self-contained Avro schema.
+type avroWriter struct {
+	res        *Result
+	namespaces map[string]string
+
+	// Warnings reported so far by any schema. Types are defined in every
+	// schema which references them, but warned about only once.
+	warned map[string]struct{}
+
+	// Struct and field whose type is being translated.
+	parent *compile.StructSpec
+	field  *compile.FieldSpec
+
+	// Full names of the named types defined so far in this schema.
+	defined map[string]struct{}
+}
+
+func (w *avroWriter) warnf(format string, args ...interface{}) {
+	msg := fmt.Sprintf("field %v.%v: ", w.parent.Name, w.field.Name) + fmt.Sprintf(format, args...)
+	key := w.parent.File + ":" + msg
+	if _, ok := w.warned[key]; ok {
+		return
+	}
+	w.warned[key] = struct{}{}
+	w.res.warnf(w.parent.File, w.parent.Line, "%v", msg)
+}
+
+func (w *avroWriter) fullName(spec compile.TypeSpec) string {
+	return w.namespaces[spec.ThriftFile()] + "." + spec.ThriftName()
+}
+
+// schemaOf returns the Avro schema of values of the given type. Enums,
+// structs, and unions are defined the first time they are referenced, and
+// referred to by their full names afterwards.
+func (w *avroWriter) schemaOf(spec compile.TypeSpec) interface{} {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return "boolean"
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec:
+		return "int"
+	case *compile.I64Spec:
+		return "long"
+	case *compile.DoubleSpec:
+		return "double"
+	case *compile.StringSpec:
+		return "string"
+	case *compile.BinarySpec:
+		return "bytes"
+	case *compile.UUIDSpec:
+		return &avroLogical{Type: "string", LogicalType: "uuid"}
+	case *compile.TypedefSpec:
+		return w.schemaOf(s.Target)
+	case *compile.EnumSpec:
+		name := w.fullName(s)
+		if _, ok := w.defined[name]; ok {
+			return name
+		}
+		w.defined[name] = struct{}{}
+		return w.enum(s)
+	case *compile.StructSpec:
+		name := w.fullName(s)
+		if _, ok := w.defined[name]; ok {
+			return name
+		}
+		// Define the name before the fields so that recursive types refer
+		// to it.
+		w.defined[name] = struct{}{}
+		return w.record(s)
+	case *compile.ListSpec:
+		return &avroArray{Type: "array", Items: w.schemaOf(s.ValueSpec)}
+	case *compile.SetSpec:
+		return &avroArray{Type: "array", Items: w.schemaOf(s.ValueSpec)}
+	case *compile.MapSpec:
+		if _, ok := compile.RootTypeSpec(s.KeySpec).(*compile.StringSpec); !ok {
+			w.warnf("keys of %v are represented as strings: Avro map keys must be strings",
+				s.ThriftName())
+		}
+		return &avroMap{Type: "map", Values: w.schemaOf(s.ValueSpec)}
+	default:
+		panic(fmt.Sprintf("unknown type %v", spec.ThriftName()))
+	}
+}
+
+func (w *avroWriter) enum(spec *compile.EnumSpec) *avroEnum {
+	e := &avroEnum{
+		Type:      "enum",
+		Name:      spec.Name,
+		Namespace: w.namespaces[spec.File],
+		Doc:       doc(spec.Doc),
+		Symbols:   make([]string, len(spec.Items)),
+	}
+	for i, item := range spec.Items {
+		e.Symbols[i] = item.Name
+	}
+	return e
+}
+
+// record returns the record for a struct, union, or exception.
+func (w *avroWriter) record(spec *compile.StructSpec) *avroRecord {
+	r := &avroRecord{
+		Type:      "record",
+		Name:      spec.Name,
+		Namespace: w.namespaces[spec.File],
+		Doc:       doc(spec.Doc),
+		Fields:    make([]*avroField, 0, len(spec.Fields)),
+	}
+	if spec.Type == ast.UnionType {
+		if r.Doc != "" {
+			r.Doc += "\n\n"
+		}
+		r.Doc += "Exactly one field is set."
+	}
+
+	parent, field := w.parent, w.field
+	defer func() { w.parent, w.field = parent, field }()
+
+	for _, f := range spec.Fields {
+		w.parent, w.field = spec, f
+		af := &avroField{
+			Name: f.Name,
+			Doc:  doc(f.Doc),
+			Type: w.schemaOf(f.Type),
+		}
+		if f.Default != nil {
+			v, err := avroValue(f.Default, f.Type)
+			if err != nil {
+				w.warnf("default value cannot be translated: %v", err)
+			} else {
+				af.Default = v
+			}
+		}
+
+		// Union fields are always optional.
+		if !f.Required || spec.Type == ast.UnionType {
+			// The default value of a union must match its first branch.
+			if af.Default != nil {
+				af.Type = []interface{}{af.Type, "null"}
+			} else {
+				af.Type = []interface{}{"null", af.Type}
+				af.Default = avroNull{}
+			}
+		}
+		r.Fields = append(r.Fields, af)
+	}
+	return r
+}
+
+// avroValue returns the JSON representation of the given constant value of
+// the given type as an Avro default value.
+func avroValue(v compile.ConstantValue, t compile.TypeSpec) (interface{}, error) {
+	t = compile.RootTypeSpec(t)
+	switch v := v.(type) {
+	case compile.ConstantBool:
+		return bool(v), nil
+	case compile.ConstantInt:
+		switch t := t.(type) {
+		case *compile.BoolSpec:
+			return v != 0, nil
+		case *compile.DoubleSpec:
+			return float64(v), nil
+		case *compile.EnumSpec:
+			for _, item := range t.Items {
+				if int64(item.Value) == int64(v) {
+					return item.Name, nil
+				}
+			}
+			return nil, fmt.Errorf("%v is not a value of %v", int64(v), t.Name)
+		}
+		return int64(v), nil
+	case compile.ConstantDouble:
+		return float64(v), nil
+	case compile.ConstantString:
+		if _, ok := t.(*compile.BinarySpec); ok {
+			// Avro represents bytes in JSON as strings whose code points
+			// are the values of the bytes.
+			runes := make([]rune, len(v))
+			for i := 0; i < len(v); i++ {
+				runes[i] = rune(v[i])
+			}
+			return string(runes), nil
+		}
+		return string(v), nil
+	case compile.EnumItemReference:
+		return v.Item.Name, nil
+	case compile.ConstReference:
+		return avroValue(v.Target.Value, t)
+	case compile.ConstantList:
+		return avroItems(v, t)
+	case compile.ConstantSet:
+		return avroItems(v, t)
+	case compile.ConstantMap:
+		m, ok := t.(*compile.MapSpec)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a map", t.ThriftName())
+		}
+		obj := make(map[string]interface{}, len(v))
+		for _, pair := range v {
+			k, err := avroValue(pair.Key, m.KeySpec)
+			if err != nil {
+				return nil, err
+			}
+			val, err := avroValue(pair.Value, m.ValueSpec)
+			if err != nil {
+				return nil, err
+			}
+			switch k.(type) {
+			case string, int64:
+				obj[fmt.Sprint(k)] = val
+			default:
+				return nil, fmt.Errorf("map keys of type %v are not supported", m.KeySpec.ThriftName())
+			}
+		}
+		return obj, nil
+	case *compile.ConstantStruct:
+		s, ok := t.(*compile.StructSpec)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a struct", t.ThriftName())
+		}
+		obj := make(map[string]interface{}, len(v.Fields))
+		for _, f := range s.Fields {
+			fv, ok := v.Fields[f.Name]
+			if !ok {
+				continue
+			}
+			val, err := avroValue(fv, f.Type)
+			if err != nil {
+				return nil, err
+			}
+			obj[f.Name] = val
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unknown constant value %v", v)
+	}
+}
+
+func avroItems(items []compile.ConstantValue, t compile.TypeSpec) (interface{}, error) {
+	var itemType compile.TypeSpec
+	switch t := t.(type) {
+	case *compile.ListSpec:
+		itemType = t.ValueSpec
+	case *compile.SetSpec:
+		itemType = t.ValueSpec
+	default:
+		return nil, fmt.Errorf("%v is not a list or set", t.ThriftName())
+	}
+
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		v, err := avroValue(item, itemType)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
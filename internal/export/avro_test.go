@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestAvro(t *testing.T) {
+	m, err := compile.Compile("testdata/catalog.thrift")
+	require.NoError(t, err)
+
+	res, err := Avro(m, &Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"catalog.Item.avsc",
+		"catalog.ItemNotFound.avsc",
+		"catalog.ItemRetired.avsc",
+		"catalog.ItemState.avsc",
+		"catalog.Priority.avsc",
+		"catalog.Selector.avsc",
+		"catalog.Unavailable.avsc",
+		"com.example.shared.Point.avsc",
+		"com.example.shared.Unit.avsc",
+	}, filePaths(res))
+
+	files := make(map[string]string, len(res.Files))
+	for _, f := range res.Files {
+		files[f.Path] = string(f.Contents)
+	}
+
+	assert.Equal(t, `{
+  "type": "enum",
+  "name": "ItemState",
+  "namespace": "catalog",
+  "doc": "State of an item in the catalog.",
+  "symbols": [
+    "UNKNOWN",
+    "AVAILABLE",
+    "DISCONTINUED"
+  ]
+}
+`, files["catalog.ItemState.avsc"])
+
+	assert.JSONEq(t, `{
+		"type": "record",
+		"name": "Selector",
+		"namespace": "catalog",
+		"doc": "Exactly one field is set.",
+		"fields": [
+			{"name": "id", "type": ["null", "string"], "default": null},
+			{"name": "query", "type": ["null", "string"], "default": null},
+			{"name": "ids", "type": ["null", {"type": "array", "items": "string"}], "default": null}
+		]
+	}`, files["catalog.Selector.avsc"])
+
+	var item struct {
+		Fields []struct {
+			Name    string
+			Type    json.RawMessage
+			Default json.RawMessage
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(files["catalog.Item.avsc"]), &item))
+	fields := make(map[string]string)
+	defaults := make(map[string]string)
+	for _, f := range item.Fields {
+		fields[f.Name] = compactJSON(t, f.Type)
+		if f.Default != nil {
+			defaults[f.Name] = compactJSON(t, f.Default)
+		}
+	}
+
+	assert.Equal(t, `"string"`, fields["itemID"], "typedefs are replaced by their targets")
+	assert.NotContains(t, defaults, "itemID", "required fields have no default")
+	assert.Equal(t, `["null","string"]`, fields["displayName"])
+	assert.Equal(t, `null`, defaults["displayName"])
+	assert.Equal(t, `["int","null"]`, fields["quantity"], "defaults must match the first branch")
+	assert.Equal(t, `1`, defaults["quantity"])
+	assert.Equal(t, `[{"type":"array","items":"long"},"null"]`, fields["relatedIDs"])
+	assert.Equal(t, `[1,2]`, defaults["relatedIDs"])
+	assert.Equal(t, `["null",{"type":"string","logicalType":"uuid"}]`, fields["externalID"])
+	assert.Contains(t, fields["locations"],
+		`{"type":"record","name":"Point","namespace":"com.example.shared"`,
+		"referenced types are defined inline")
+
+	require.Len(t, res.Warnings, 1)
+	assert.Contains(t, res.Warnings[0].String(),
+		"field Item.weights: keys of map<double, string> are represented as strings")
+}
+
+func TestAvroSelfContained(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tree.thrift")
+	require.NoError(t, os.WriteFile(path, []byte(`
+		enum Color { RED, BLACK }
+		struct Node {
+			1: required Color color
+			2: optional Color previous
+			3: optional list<Node> children
+			4: optional map<i32, Color> labels
+			5: optional map<i32, Color> otherLabels
+		}
+	`), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	res, err := Avro(m, &Options{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tree.Color.avsc", "tree.Node.avsc"}, filePaths(res))
+
+	assert.JSONEq(t, `{
+		"type": "record",
+		"name": "Node",
+		"namespace": "tree",
+		"fields": [
+			{"name": "color", "type": {"type": "enum", "name": "Color", "namespace": "tree", "symbols": ["RED", "BLACK"]}},
+			{"name": "previous", "type": ["null", "tree.Color"], "default": null},
+			{"name": "children", "type": ["null", {"type": "array", "items": "tree.Node"}], "default": null},
+			{"name": "labels", "type": ["null", {"type": "map", "values": "tree.Color"}], "default": null},
+			{"name": "otherLabels", "type": ["null", {"type": "map", "values": "tree.Color"}], "default": null}
+		]
+	}`, string(res.Files[1].Contents))
+
+	var msgs []string
+	for _, w := range res.Warnings {
+		msgs = append(msgs, w.Message)
+	}
+	assert.Equal(t, []string{
+		"field Node.labels: keys of map<i32, Color> are represented as strings: Avro map keys must be strings",
+		"field Node.otherLabels: keys of map<i32, Color> are represented as strings: Avro map keys must be strings",
+	}, msgs, "warnings must be reported once")
+}
+
+func TestAvroBinaryDefault(t *testing.T) {
+	v, err := avroValue(compile.ConstantString("\x00\xff"), &compile.BinarySpec{})
+	require.NoError(t, err)
+	assert.Equal(t, "\u0000\u00ff", v, "bytes are represented by code points")
+}
+
+func compactJSON(t *testing.T, raw json.RawMessage) string {
+	var buf bytes.Buffer
+	require.NoError(t, json.Compact(&buf, raw))
+	return buf.String()
+}
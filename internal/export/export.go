@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package export translates compiled Thrift modules into the schema
+// languages of other systems. It backs "thriftrw export".
+package export
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Options configures an exporter.
+type Options struct {
+	// Translate only the given module and not the modules it includes.
+	// References to types of included modules are still translated.
+	NoRecurse bool
+}
+
+// File is a file produced by an exporter.
+type File struct {
+	// Path of the file relative to the output directory.
+	Path     string
+	Contents []byte
+}
+
+// Warning reports a construct which could not be translated faithfully.
+// Exporters leave such constructs out or approximate them, and report a
+// Warning for each.
+type Warning struct {
+	// Path is the absolute path to the Thrift file and Line is the line in
+	// it that the warning is about, or 0 if it is not known.
+	Path string
+	Line int
+
+	Message string
+}
+
+func (w *Warning) String() string {
+	pos := w.Path
+	if w.Line > 0 {
+		pos += ":" + strconv.Itoa(w.Line)
+	}
+	return fmt.Sprintf("%v: %v", pos, w.Message)
+}
+
+// Result is the output of an exporter.
+type Result struct {
+	Files    []*File
+	Warnings []*Warning
+}
+
+func (r *Result) warnf(path string, line int, format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, &Warning{
+		Path:    path,
+		Line:    line,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// modules returns the given module followed by the modules it includes,
+// directly or indirectly, ordered by path. Only the given module is
+// returned with NoRecurse.
+func modules(m *compile.Module, opts *Options) []*compile.Module {
+	if opts.NoRecurse {
+		return []*compile.Module{m}
+	}
+
+	seen := map[string]*compile.Module{m.ThriftPath: m}
+	var visit func(*compile.Module)
+	visit = func(m *compile.Module) {
+		for _, inc := range m.Includes {
+			if _, ok := seen[inc.Module.ThriftPath]; ok {
+				continue
+			}
+			seen[inc.Module.ThriftPath] = inc.Module
+			visit(inc.Module)
+		}
+	}
+	visit(m)
+
+	mods := make([]*compile.Module, 0, len(seen))
+	for path, mod := range seen {
+		if path != m.ThriftPath {
+			mods = append(mods, mod)
+		}
+	}
+	sort.Slice(mods, func(i, j int) bool {
+		return mods[i].ThriftPath < mods[j].ThriftPath
+	})
+	return append([]*compile.Module{m}, mods...)
+}
+
+// baseName returns the name of the Thrift file at the given path without
+// its directory or extension.
+func baseName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// sortStringKeys returns a sorted list of strings given a map[string]*.
+func sortStringKeys(m interface{}) []string {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		panic("sortStringKeys may be called with a map[string]* only")
+	}
+
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// snakeCase converts the given name to lower_snake_case, splitting words at
+// case changes and keeping acronyms together: "userID" becomes "user_id" and
+// "HTTPHeaders" becomes "http_headers".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && runes[i-1] != '_' {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !pluralAcronym(runes, i+1)
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// pluralAcronym returns whether the rune at index i is the "s" ending a
+// plural acronym, as in "IDs".
+func pluralAcronym(runes []rune, i int) bool {
+	if runes[i] != 's' {
+		return false
+	}
+	return i+1 == len(runes) || !unicode.IsLower(runes[i+1])
+}
+
+// camelCase converts the given name to UpperCamelCase, dropping
+// underscores: "get_value" and "getValue" both become "GetValue".
+func camelCase(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
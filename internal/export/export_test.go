@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{"name", "name"},
+		{"displayName", "display_name"},
+		{"userID", "user_id"},
+		{"relatedIDs", "related_ids"},
+		{"HTTPHeaders", "http_headers"},
+		{"already_snake", "already_snake"},
+		{"ItemState", "item_state"},
+		{"v2Config", "v2_config"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, snakeCase(tt.give), "snakeCase(%q)", tt.give)
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{"getValue", "GetValue"},
+		{"get_value", "GetValue"},
+		{"Ping", "Ping"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, camelCase(tt.give), "camelCase(%q)", tt.give)
+	}
+}
@@ -0,0 +1,561 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// graphQLOperationKey is the annotation on service functions which chooses
+// whether they become queries or mutations. Without it, functions are
+// queries if their names start with one of graphQLQueryPrefixes.
+const graphQLOperationKey = "graphql.operation"
+
+// graphQLQueryPrefixes are the prefixes of names of functions which are
+// queries by default.
+var graphQLQueryPrefixes = []string{
+	"count", "fetch", "find", "get", "has", "is", "list", "lookup", "query", "read", "search",
+}
+
+// Custom scalars used to represent Thrift types which GraphQL has no
+// built-in scalar for, and their descriptions.
+var graphQLScalars = map[string]string{
+	"Binary": "Base64-encoded binary data.",
+	"Int64":  "A 64-bit signed integer.",
+	"JSON":   "A map, represented as a JSON object.",
+	"UUID":   "A UUID in its canonical string form.",
+}
+
+// GraphQL translates the given module into a GraphQL schema named after the
+// Thrift file with the .graphql extension.
+//
+// Structs, unions, and exceptions become object types, and those used as
+// function arguments also become input types with the Input suffix. Enums
+// become enums and each service function, including those inherited from
+// other services, becomes a field of Query or Mutation named after the
+// service and the function, as in "catalogGetItem". Functions are queries if
+// their names start with a verb such as get, list, or find, and mutations
+// otherwise; the graphql.operation annotation overrides this with "query"
+// or "mutation".
+//
+// Types of included modules are prefixed with the names of their Thrift
+// files, as in "SharedPoint". Unless NoRecurse is set, all types and
+// services of included modules are translated, and not only those which
+// are referenced.
+func GraphQL(m *compile.Module, opts *Options) (*Result, error) {
+	var res Result
+	w := graphQLWriter{
+		res:     &res,
+		root:    m,
+		warned:  make(map[string]struct{}),
+		names:   make(map[string]string),
+		defs:    make(map[string]string),
+		scalars: make(map[string]struct{}),
+	}
+
+	for _, mod := range modules(m, opts) {
+		for _, name := range sortStringKeys(mod.Constants) {
+			c := mod.Constants[name]
+			res.warnf(c.File, c.Line, "constant %v cannot be translated: GraphQL has no constants", name)
+		}
+		for _, name := range sortStringKeys(mod.Types) {
+			switch spec := mod.Types[name].(type) {
+			case *compile.EnumSpec:
+				w.enum(spec)
+			case *compile.StructSpec:
+				w.object(spec)
+			}
+			// Typedefs have no equivalent. References to them are
+			// replaced with their targets.
+		}
+		for _, name := range sortStringKeys(mod.Services) {
+			w.service(mod.Services[name])
+		}
+	}
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Translated from %v by thriftrw export graphql.\n", baseName(m.ThriftPath)+".thrift")
+	for _, op := range []struct {
+		name   string
+		fields []string
+	}{
+		{"Query", w.queries},
+		{"Mutation", w.mutations},
+	} {
+		if len(op.fields) == 0 {
+			continue
+		}
+		sort.Strings(op.fields)
+		fmt.Fprintf(&buf, "\ntype %v {\n", op.name)
+		for _, f := range op.fields {
+			buf.WriteString(f)
+		}
+		buf.WriteString("}\n")
+	}
+	for _, name := range sortStringKeys(w.defs) {
+		buf.WriteString("\n")
+		buf.WriteString(w.defs[name])
+	}
+	for _, name := range sortStringKeys(w.scalars) {
+		fmt.Fprintf(&buf, "\n%vscalar %v\n", graphQLDescription("", graphQLScalars[name]), name)
+	}
+
+	res.Files = append(res.Files, &File{
+		Path:     baseName(m.ThriftPath) + ".graphql",
+		Contents: buf.Bytes(),
+	})
+	return &res, nil
+}
+
+// graphQLWriter builds a GraphQL schema.
+type graphQLWriter struct {
+	res  *Result
+	root *compile.Module
+
+	// Warnings reported so far. Fields of types which are both object and
+	// input types are warned about only once.
+	warned map[string]struct{}
+
+	// Types by GraphQL name, identified by their file and Thrift name.
+	names map[string]string
+
+	// Definitions of types by GraphQL name, and custom scalars used by
+	// them.
+	defs    map[string]string
+	scalars map[string]struct{}
+
+	// Fields of the Query and Mutation types.
+	queries, mutations []string
+
+	// First error encountered.
+	err error
+}
+
+func (w *graphQLWriter) warnf(path string, line int, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	key := path + ":" + strconv.Itoa(line) + ":" + msg
+	if _, ok := w.warned[key]; ok {
+		return
+	}
+	w.warned[key] = struct{}{}
+	w.res.warnf(path, line, "%v", msg)
+}
+
+// typeName returns the GraphQL name of the given enum or struct. Types
+// from modules other than the root module are prefixed with the name of
+// their Thrift file.
+func (w *graphQLWriter) typeName(spec compile.TypeSpec) string {
+	name := spec.ThriftName()
+	if spec.ThriftFile() != w.root.ThriftPath {
+		name = camelCase(baseName(spec.ThriftFile())) + name
+	}
+
+	id := spec.ThriftFile() + ":" + spec.ThriftName()
+	if other, ok := w.names[name]; ok && other != id && w.err == nil {
+		w.err = fmt.Errorf("cannot translate %v: type %v is already in use by %v", id, name, other)
+	}
+	w.names[name] = id
+	return name
+}
+
+// reserve reserves the definition with the given name, returning false if
+// it was already reserved.
+func (w *graphQLWriter) reserve(name string) bool {
+	if _, ok := w.defs[name]; ok {
+		return false
+	}
+	w.defs[name] = ""
+	return true
+}
+
+func (w *graphQLWriter) enum(spec *compile.EnumSpec) string {
+	name := w.typeName(spec)
+	if !w.reserve(name) {
+		return name
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(graphQLDescription("", spec.Doc))
+	fmt.Fprintf(&buf, "enum %v {\n", name)
+	for _, item := range spec.Items {
+		switch item.Name {
+		case "true", "false", "null":
+			w.warnf(spec.File, spec.Line,
+				"enum item %v.%v cannot be translated: %v is not a valid GraphQL enum value",
+				spec.Name, item.Name, item.Name)
+			continue
+		}
+		buf.WriteString(graphQLDescription("  ", item.Doc))
+		fmt.Fprintf(&buf, "  %v\n", item.Name)
+	}
+	buf.WriteString("}\n")
+	w.defs[name] = buf.String()
+	return name
+}
+
+// object defines the object type for a struct, union, or exception.
+func (w *graphQLWriter) object(spec *compile.StructSpec) string {
+	name := w.typeName(spec)
+	if w.reserve(name) {
+		w.defs[name] = w.fields("type", name, spec, false)
+	}
+	return name
+}
+
+// input defines the input type for a struct, union, or exception.
+func (w *graphQLWriter) input(spec *compile.StructSpec) string {
+	name := w.typeName(spec) + "Input"
+	if w.reserve(name) {
+		w.defs[name] = w.fields("input", name, spec, true)
+	}
+	return name
+}
+
+func (w *graphQLWriter) fields(kind, name string, spec *compile.StructSpec, input bool) string {
+	desc := doc(spec.Doc)
+	if spec.Type == ast.UnionType {
+		if desc != "" {
+			desc += "\n\n"
+		}
+		if input {
+			desc += "Exactly one field must be set."
+		} else {
+			desc += "Exactly one field is set."
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(graphQLDescription("", desc))
+	fmt.Fprintf(&buf, "%v %v {\n", kind, name)
+	if len(spec.Fields) == 0 {
+		w.warnf(spec.File, spec.Line,
+			"%v has no fields: a placeholder field _ is added since GraphQL types must have fields",
+			spec.Name)
+		buf.WriteString("  _: Boolean\n")
+	}
+	for _, f := range spec.Fields {
+		buf.WriteString(graphQLDescription("  ", f.Doc))
+		fmt.Fprintf(&buf, "  %v\n", w.field(spec, f, input))
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// field returns the declaration of the given field of an object or input
+// type, or argument of a function, without a description.
+func (w *graphQLWriter) field(parent *compile.StructSpec, f *compile.FieldSpec, input bool) string {
+	warnf := func(format string, args ...interface{}) {
+		w.warnf(parent.File, parent.Line, "field %v.%v: %v",
+			parent.Name, f.Name, fmt.Sprintf(format, args...))
+	}
+
+	t := w.typeRef(f.Type, input, warnf)
+	// Fields of unions are never all set.
+	if f.Required && parent.Type != ast.UnionType {
+		t += "!"
+	}
+	decl := f.Name + ": " + t
+	if input && f.Default != nil {
+		v, err := graphQLValue(f.Default, f.Type)
+		if err != nil {
+			warnf("default value cannot be translated: %v", err)
+		} else {
+			decl += " = " + v
+		}
+	}
+	return decl
+}
+
+// typeRef returns a reference to the GraphQL type of values of the given
+// type, defining it if needed.
+func (w *graphQLWriter) typeRef(spec compile.TypeSpec, input bool, warnf func(string, ...interface{})) string {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return "Boolean"
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec:
+		return "Int"
+	case *compile.I64Spec:
+		return w.scalar("Int64")
+	case *compile.DoubleSpec:
+		return "Float"
+	case *compile.StringSpec:
+		return "String"
+	case *compile.BinarySpec:
+		return w.scalar("Binary")
+	case *compile.UUIDSpec:
+		return w.scalar("UUID")
+	case *compile.TypedefSpec:
+		return w.typeRef(s.Target, input, warnf)
+	case *compile.EnumSpec:
+		return w.enum(s)
+	case *compile.StructSpec:
+		if input {
+			return w.input(s)
+		}
+		return w.object(s)
+	case *compile.ListSpec:
+		return "[" + w.typeRef(s.ValueSpec, input, warnf) + "!]"
+	case *compile.SetSpec:
+		return "[" + w.typeRef(s.ValueSpec, input, warnf) + "!]"
+	case *compile.MapSpec:
+		warnf("%v is represented by the JSON scalar: GraphQL has no maps", s.ThriftName())
+		return w.scalar("JSON")
+	default:
+		panic(fmt.Sprintf("unknown type %v", spec.ThriftName()))
+	}
+}
+
+func (w *graphQLWriter) scalar(name string) string {
+	w.scalars[name] = struct{}{}
+	return name
+}
+
+// service adds fields for the functions of the given service and the
+// services it inherits from to Query or Mutation.
+func (w *graphQLWriter) service(spec *compile.ServiceSpec) {
+	seen := make(map[string]struct{})
+	for s := spec; s != nil; s = s.Parent {
+		for _, name := range sortStringKeys(s.Functions) {
+			if _, ok := seen[name]; ok {
+				continue // overridden by the inheriting service
+			}
+			seen[name] = struct{}{}
+			w.function(spec, s.Functions[name])
+		}
+	}
+}
+
+func (w *graphQLWriter) function(service *compile.ServiceSpec, f *compile.FunctionSpec) {
+	mutation := !graphQLQueryName(f.Name) || f.OneWay
+	switch op := f.Annotations[graphQLOperationKey]; op {
+	case "":
+	case "query":
+		mutation = false
+	case "mutation":
+		mutation = true
+	default:
+		if w.err == nil {
+			w.err = fmt.Errorf("cannot translate %v.%v: %v must be %q or %q, not %q",
+				service.Name, f.Name, graphQLOperationKey, "query", "mutation", op)
+		}
+		return
+	}
+
+	// Arguments are declared as fields of a struct to share their
+	// translation with input types.
+	args := &compile.StructSpec{
+		Name:   f.Name,
+		File:   service.File,
+		Line:   f.Line,
+		Type:   ast.StructType,
+		Fields: compile.FieldGroup(f.ArgsSpec),
+	}
+	decls := make([]string, len(args.Fields))
+	for i, arg := range args.Fields {
+		decls[i] = w.field(args, arg, true)
+	}
+
+	result := "Boolean"
+	if f.ResultSpec != nil && f.ResultSpec.ReturnType != nil {
+		result = w.typeRef(f.ResultSpec.ReturnType, false, func(format string, args ...interface{}) {
+			w.warnf(service.File, f.Line, "result of %v.%v: %v",
+				service.Name, f.Name, fmt.Sprintf(format, args...))
+		})
+	}
+
+	var decl strings.Builder
+	decl.WriteString("  ")
+	decl.WriteString(lowerFirst(service.Name) + camelCase(f.Name))
+	if len(decls) > 0 {
+		decl.WriteString("(" + strings.Join(decls, ", ") + ")")
+	}
+	decl.WriteString(": " + result + "\n")
+
+	if mutation {
+		w.mutations = append(w.mutations, decl.String())
+	} else {
+		w.queries = append(w.queries, decl.String())
+	}
+}
+
+// graphQLQueryName returns whether a function with the given name is a
+// query by default: its name starts with one of graphQLQueryPrefixes
+// followed by the end of the name or a new word.
+func graphQLQueryName(name string) bool {
+	for _, prefix := range graphQLQueryPrefixes {
+		if len(name) < len(prefix) || !strings.EqualFold(name[:len(prefix)], prefix) {
+			continue
+		}
+		rest := name[len(prefix):]
+		if rest == "" || rest[0] == '_' || unicode.IsUpper(rune(rest[0])) {
+			return true
+		}
+	}
+	return false
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// graphQLDescription returns the given documentation as a GraphQL
+// description preceding a definition indented by indent, or an empty
+// string if there is no documentation.
+func graphQLDescription(indent, d string) string {
+	d = doc(d)
+	if d == "" {
+		return ""
+	}
+	d = strings.ReplaceAll(d, `"""`, `\"""`)
+	if !strings.Contains(d, "\n") {
+		return fmt.Sprintf("%v\"\"\"%v\"\"\"\n", indent, d)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(indent + "\"\"\"\n")
+	for _, line := range strings.Split(d, "\n") {
+		sb.WriteString(strings.TrimRight(indent+line, " ") + "\n")
+	}
+	sb.WriteString(indent + "\"\"\"\n")
+	return sb.String()
+}
+
+// graphQLValue returns the given constant value of the given type as a
+// GraphQL input value literal.
+func graphQLValue(v compile.ConstantValue, t compile.TypeSpec) (string, error) {
+	t = compile.RootTypeSpec(t)
+	switch v := v.(type) {
+	case compile.ConstantBool:
+		return strconv.FormatBool(bool(v)), nil
+	case compile.ConstantInt:
+		switch t := t.(type) {
+		case *compile.BoolSpec:
+			return strconv.FormatBool(v != 0), nil
+		case *compile.EnumSpec:
+			for _, item := range t.Items {
+				if int64(item.Value) == int64(v) {
+					return item.Name, nil
+				}
+			}
+			return "", fmt.Errorf("%v is not a value of %v", int64(v), t.Name)
+		}
+		return strconv.FormatInt(int64(v), 10), nil
+	case compile.ConstantDouble:
+		return strconv.FormatFloat(float64(v), 'g', -1, 64), nil
+	case compile.ConstantString:
+		if _, ok := t.(*compile.BinarySpec); ok {
+			return graphQLString(base64.StdEncoding.EncodeToString([]byte(v))), nil
+		}
+		return graphQLString(string(v)), nil
+	case compile.EnumItemReference:
+		return v.Item.Name, nil
+	case compile.ConstReference:
+		return graphQLValue(v.Target.Value, t)
+	case compile.ConstantList:
+		return graphQLItems(v, t)
+	case compile.ConstantSet:
+		return graphQLItems(v, t)
+	case *compile.ConstantStruct:
+		s, ok := t.(*compile.StructSpec)
+		if !ok {
+			return "", fmt.Errorf("%v is not a struct", t.ThriftName())
+		}
+		var fields []string
+		for _, f := range s.Fields {
+			fv, ok := v.Fields[f.Name]
+			if !ok {
+				continue
+			}
+			val, err := graphQLValue(fv, f.Type)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, f.Name+": "+val)
+		}
+		return "{" + strings.Join(fields, ", ") + "}", nil
+	case compile.ConstantMap:
+		return "", fmt.Errorf("maps are represented by the JSON scalar")
+	default:
+		return "", fmt.Errorf("unknown constant value %v", v)
+	}
+}
+
+func graphQLItems(items []compile.ConstantValue, t compile.TypeSpec) (string, error) {
+	var itemType compile.TypeSpec
+	switch t := t.(type) {
+	case *compile.ListSpec:
+		itemType = t.ValueSpec
+	case *compile.SetSpec:
+		itemType = t.ValueSpec
+	default:
+		return "", fmt.Errorf("%v is not a list or set", t.ThriftName())
+	}
+
+	values := make([]string, len(items))
+	for i, item := range items {
+		v, err := graphQLValue(item, itemType)
+		if err != nil {
+			return "", err
+		}
+		values[i] = v
+	}
+	return "[" + strings.Join(values, ", ") + "]", nil
+}
+
+// graphQLString returns the given string as a GraphQL string literal.
+func graphQLString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r < 0x20:
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
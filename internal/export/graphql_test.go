@@ -0,0 +1,221 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestGraphQL(t *testing.T) {
+	m, err := compile.Compile("testdata/catalog.thrift")
+	require.NoError(t, err)
+
+	res, err := GraphQL(m, &Options{})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 1)
+	assert.Equal(t, "catalog.graphql", res.Files[0].Path)
+
+	got := string(res.Files[0].Contents)
+	assert.Contains(t, got, `# Translated from catalog.thrift by thriftrw export graphql.
+
+type Query {
+  adminCatalogFindItems(selector: SelectorInput, limit: Int): [Item!]
+  adminCatalogGetItem(id: String): Item
+  catalogFindItems(selector: SelectorInput, limit: Int): [Item!]
+  catalogGetItem(id: String): Item
+}
+
+type Mutation {
+  adminCatalogPing: Boolean
+  adminCatalogPutItem(item: ItemInput): Boolean
+  adminCatalogRemoveItem(id: String): Boolean
+  catalogPing: Boolean
+  catalogPutItem(item: ItemInput): Boolean
+}
+`)
+	assert.Contains(t, got, `
+type Item {
+  """Unique identifier of the item."""
+  itemID: String!
+  displayName: String
+  quantity: Int
+  state: ItemState
+  tags: [String!]
+  relatedIDs: [Int64!]
+  locations: JSON
+  unit: SharedUnit
+  matrix: [[Int!]!]
+  weights: JSON
+  thumbnail: Binary
+  externalID: UUID
+}
+`)
+	assert.Contains(t, got, `
+  quantity: Int = 1
+`, "inputs carry default values")
+	assert.Contains(t, got, `
+"""State of an item in the catalog."""
+enum ItemState {
+  UNKNOWN
+  """The item may be ordered."""
+  AVAILABLE
+  DISCONTINUED
+}
+`)
+	assert.Contains(t, got, `
+"""Exactly one field must be set."""
+input SelectorInput {
+  id: String
+  query: String
+  ids: [String!]
+}
+`)
+	assert.Contains(t, got, `
+"""A point in two dimensions."""
+type SharedPoint {
+  x: Float!
+  y: Float!
+}
+`)
+	assert.NotContains(t, got, "input SharedPointInput", "input types are only defined for arguments")
+	assert.Contains(t, got, `
+"""A 64-bit signed integer."""
+scalar Int64
+`)
+
+	var msgs []string
+	for _, w := range res.Warnings {
+		msgs = append(msgs, w.Message)
+	}
+	assert.Equal(t, []string{
+		"constant MaxItems cannot be translated: GraphQL has no constants",
+		"field Item.locations: map<string, Point> is represented by the JSON scalar: GraphQL has no maps",
+		"field Item.weights: map<double, string> is represented by the JSON scalar: GraphQL has no maps",
+	}, msgs)
+}
+
+func TestGraphQLOperation(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		want    string
+		wantErr string
+	}{
+		{
+			desc: "naming convention",
+			src: `service Users {
+				string getName()
+				bool isActive()
+				list<string> listUsers()
+				void get_ready()
+				void setName(1: required string name, 2: string title = "none")
+				void getaway()
+				oneway void getLost()
+			}`,
+			want: `
+type Query {
+  usersGetName: String
+  usersGetReady: Boolean
+  usersIsActive: Boolean
+  usersListUsers: [String!]
+}
+
+type Mutation {
+  usersGetLost: Boolean
+  usersGetaway: Boolean
+  usersSetName(name: String!, title: String = "none"): Boolean
+}
+`,
+		},
+		{
+			desc: "annotation",
+			src: `service Users {
+				string getName() (graphql.operation = "mutation")
+				string currentUser() (graphql.operation = "query")
+			}`,
+			want: `
+type Query {
+  usersCurrentUser: String
+}
+
+type Mutation {
+  usersGetName: String
+}
+`,
+		},
+		{
+			desc:    "invalid annotation",
+			src:     `service Users { string getName() (graphql.operation = "subscription") }`,
+			wantErr: `cannot translate Users.getName: graphql.operation must be "query" or "mutation", not "subscription"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "users.thrift")
+			require.NoError(t, os.WriteFile(path, []byte(tt.src), 0644))
+			m, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			res, err := GraphQL(m, &Options{})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "# Translated from users.thrift by thriftrw export graphql.\n"+tt.want,
+				string(res.Files[0].Contents))
+		})
+	}
+}
+
+func TestGraphQLNameConflict(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "shared.thrift"),
+		[]byte("struct Point { 1: required double x }"), 0644))
+	path := filepath.Join(dir, "main.thrift")
+	require.NoError(t, os.WriteFile(path,
+		[]byte("include \"./shared.thrift\"\nstruct SharedPoint { 1: optional shared.Point point }"), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	_, err = GraphQL(m, &Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "type SharedPoint is already in use")
+}
+
+func TestGraphQLDescription(t *testing.T) {
+	assert.Equal(t, "", graphQLDescription("  ", ""))
+	assert.Equal(t, "  \"\"\"One line.\"\"\"\n", graphQLDescription("  ", "One line.\n"))
+	assert.Equal(t, "  \"\"\"\n  First.\n\n  Second \\\"\"\" quoted.\n  \"\"\"\n",
+		graphQLDescription("  ", "First.\n\nSecond \"\"\" quoted."))
+}
+
+func TestGraphQLString(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c\n\u0001é"`, graphQLString("a\"b\\c\n\x01é"))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import "go.uber.org/thriftrw/compile"
+
+// jsonSchemaDialect is the JSON Schema dialect of exported schemas.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema translates the types of the given module, and unless NoRecurse
+// is set the modules it includes, into JSON Schema documents describing
+// their JSON representation in generated code. Each struct, union,
+// exception, enum, and typedef is written to a file named after it with the
+// .schema.json extension. Types of included modules are prefixed with the
+// names of their Thrift files, as in "shared.Point.schema.json".
+//
+// Schemas refer to each other by file name, so types referenced from other
+// modules are written even with NoRecurse.
+func JSONSchema(m *compile.Module, opts *Options) (*Result, error) {
+	var res Result
+	b := newSchemaBuilder(&res, m, jsonSchemaFileName)
+	for _, mod := range modules(m, opts) {
+		for _, name := range sortStringKeys(mod.Types) {
+			b.define(mod.Types[name])
+		}
+	}
+
+	for _, def := range b.definitions() {
+		s := *def.Schema
+		s.Schema = jsonSchemaDialect
+		s.ID = jsonSchemaFileName(def.Name)
+		s.Title = def.Name
+
+		contents, err := marshalJSON(&s)
+		if err != nil {
+			return nil, err
+		}
+		res.Files = append(res.Files, &File{
+			Path:     jsonSchemaFileName(def.Name),
+			Contents: contents,
+		})
+	}
+	return &res, nil
+}
+
+func jsonSchemaFileName(name string) string {
+	return name + ".schema.json"
+}
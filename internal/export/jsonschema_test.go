@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestJSONSchema(t *testing.T) {
+	m, err := compile.Compile("testdata/catalog.thrift")
+	require.NoError(t, err)
+
+	res, err := JSONSchema(m, &Options{})
+	require.NoError(t, err)
+
+	files := make(map[string]string, len(res.Files))
+	var paths []string
+	for _, f := range res.Files {
+		files[f.Path] = string(f.Contents)
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{
+		"Item.schema.json",
+		"ItemID.schema.json",
+		"ItemNotFound.schema.json",
+		"ItemRetired.schema.json",
+		"ItemState.schema.json",
+		"Priority.schema.json",
+		"Selector.schema.json",
+		"Unavailable.schema.json",
+		"shared.Point.schema.json",
+		"shared.Unit.schema.json",
+	}, paths)
+
+	assert.Equal(t, `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "shared.Point.schema.json",
+  "title": "shared.Point",
+  "description": "A point in two dimensions.",
+  "type": "object",
+  "properties": {
+    "x": {
+      "type": "number",
+      "format": "double"
+    },
+    "y": {
+      "type": "number",
+      "format": "double"
+    }
+  },
+  "required": [
+    "x",
+    "y"
+  ]
+}
+`, files["shared.Point.schema.json"])
+
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id": "ItemState.schema.json",
+		"title": "ItemState",
+		"description": "State of an item in the catalog.",
+		"type": "string",
+		"enum": ["UNKNOWN", "AVAILABLE", "DISCONTINUED"]
+	}`, files["ItemState.schema.json"])
+
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id": "ItemID.schema.json",
+		"title": "ItemID",
+		"type": "string"
+	}`, files["ItemID.schema.json"])
+
+	assert.Contains(t, files["Item.schema.json"], `"$ref": "ItemID.schema.json"`)
+	assert.Contains(t, files["Item.schema.json"], `"$ref": "shared.Point.schema.json"`)
+}
+
+func TestJSONSchemaNoRecurse(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "shared.thrift"),
+		[]byte("struct Point { 1: required double x }\nstruct Unused {}"), 0644))
+	path := filepath.Join(dir, "main.thrift")
+	require.NoError(t, os.WriteFile(path,
+		[]byte("include \"./shared.thrift\"\nstruct Shape { 1: optional list<shared.Point> points }"), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	t.Run("recurse", func(t *testing.T) {
+		res, err := JSONSchema(m, &Options{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Shape.schema.json",
+			"shared.Point.schema.json",
+			"shared.Unused.schema.json",
+		}, filePaths(res))
+	})
+
+	t.Run("no recurse", func(t *testing.T) {
+		res, err := JSONSchema(m, &Options{NoRecurse: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Shape.schema.json",
+			"shared.Point.schema.json",
+		}, filePaths(res), "referenced types must be written")
+	})
+}
+
+func filePaths(res *Result) []string {
+	paths := make([]string, len(res.Files))
+	for i, f := range res.Files {
+		paths[i] = f.Path
+	}
+	return paths
+}
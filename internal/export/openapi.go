@@ -0,0 +1,210 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// httpStatusKey is the annotation on exceptions which specifies the HTTP
+// status code with which they are reported, as with --http-handlers.
+const httpStatusKey = "http.status"
+
+const _jsonContentType = "application/json"
+
+type openAPIDocument struct {
+	OpenAPI    string                  `json:"openapi"`
+	Info       openAPIInfo             `json:"info"`
+	Paths      map[string]*openAPIPath `json:"paths"`
+	Components openAPIComponents       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIPath struct {
+	Post *openAPIOperation `json:"post"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Tags        []string                    `json:"tags"`
+	RequestBody *openAPIRequestBody         `json:"requestBody"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas properties `json:"schemas"`
+}
+
+// OpenAPI translates the given module into an OpenAPI 3.1 document named
+// after the Thrift file with the .openapi.json extension.
+//
+// Structs, unions, exceptions, enums, and typedefs become schemas under
+// components, describing the JSON representation of the generated types.
+// Each service function, including those inherited from other services,
+// becomes a POST operation at /Service/function whose request body holds
+// its arguments. Exceptions are responses with the status code given by
+// their http.status annotation, or 500.
+//
+// Types of included modules are named after their Thrift files, as in
+// "shared.Point". Unless NoRecurse is set, all types of included modules
+// are translated, and not only those which are referenced.
+func OpenAPI(m *compile.Module, opts *Options) (*Result, error) {
+	var res Result
+	b := newSchemaBuilder(&res, m, func(name string) string {
+		return "#/components/schemas/" + name
+	})
+
+	doc := openAPIDocument{
+		OpenAPI: "3.1.0",
+		Info:    openAPIInfo{Title: baseName(m.ThriftPath), Version: "1.0.0"},
+		Paths:   make(map[string]*openAPIPath),
+	}
+
+	for _, mod := range modules(m, opts) {
+		for _, name := range sortStringKeys(mod.Types) {
+			b.define(mod.Types[name])
+		}
+		for _, name := range sortStringKeys(mod.Services) {
+			if err := openAPIService(b, doc.Paths, mod.Services[name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	doc.Components.Schemas = b.definitions()
+
+	contents, err := marshalJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	res.Files = append(res.Files, &File{
+		Path:     baseName(m.ThriftPath) + ".openapi.json",
+		Contents: contents,
+	})
+	return &res, nil
+}
+
+// openAPIService adds operations for the functions of the given service and
+// the services it inherits from to paths.
+func openAPIService(b *schemaBuilder, paths map[string]*openAPIPath, spec *compile.ServiceSpec) error {
+	for s := spec; s != nil; s = s.Parent {
+		for _, name := range sortStringKeys(s.Functions) {
+			path := fmt.Sprintf("/%v/%v", spec.Name, name)
+			if _, ok := paths[path]; ok {
+				if s != spec {
+					continue // overridden by the inheriting service
+				}
+				return fmt.Errorf("cannot translate %v.%v: path %v is already in use", spec.Name, name, path)
+			}
+			paths[path] = &openAPIPath{Post: openAPIOperationFor(b, spec, s.Functions[name])}
+		}
+	}
+	return nil
+}
+
+func openAPIOperationFor(b *schemaBuilder, service *compile.ServiceSpec, f *compile.FunctionSpec) *openAPIOperation {
+	args := b.object(&compile.StructSpec{
+		Name:   service.Name + "." + f.Name,
+		File:   service.File,
+		Line:   f.Line,
+		Fields: compile.FieldGroup(f.ArgsSpec),
+	})
+
+	op := &openAPIOperation{
+		OperationID: service.Name + "_" + f.Name,
+		Tags:        []string{service.Name},
+		RequestBody: &openAPIRequestBody{
+			Required: true,
+			Content:  map[string]openAPIMediaType{_jsonContentType: {Schema: args}},
+		},
+		Responses: make(map[string]*openAPIResponse),
+	}
+
+	switch {
+	case f.OneWay:
+		op.Responses["202"] = &openAPIResponse{Description: "Accepted"}
+	case f.ResultSpec.ReturnType == nil:
+		op.Responses["200"] = &openAPIResponse{Description: "Success"}
+	default:
+		op.Responses["200"] = &openAPIResponse{
+			Description: "Success",
+			Content: map[string]openAPIMediaType{
+				_jsonContentType: {Schema: b.schemaOf(f.ResultSpec.ReturnType)},
+			},
+		}
+	}
+
+	if f.ResultSpec == nil {
+		return op
+	}
+	for _, exc := range f.ResultSpec.Exceptions {
+		status := "500"
+		if v, ok := exc.Type.ThriftAnnotations()[httpStatusKey]; ok {
+			if code, err := strconv.Atoi(v); err == nil && code >= 100 && code <= 599 {
+				status = v
+			} else {
+				b.res.warnf(service.File, f.Line,
+					"invalid %v annotation on %v: %q is not an HTTP status code, using 500",
+					httpStatusKey, exc.Type.ThriftName(), v)
+			}
+		}
+
+		s := b.schemaOf(exc.Type)
+		resp, ok := op.Responses[status]
+		if !ok {
+			op.Responses[status] = &openAPIResponse{
+				Description: exc.Type.ThriftName(),
+				Content:     map[string]openAPIMediaType{_jsonContentType: {Schema: s}},
+			}
+			continue
+		}
+
+		// Exceptions with the same status share a response.
+		media := resp.Content[_jsonContentType]
+		if media.Schema.OneOf == nil {
+			media.Schema = &schema{OneOf: []*schema{media.Schema}}
+		}
+		media.Schema.OneOf = append(media.Schema.OneOf, s)
+		resp.Content[_jsonContentType] = media
+		resp.Description += ", " + exc.Type.ThriftName()
+	}
+	return op
+}
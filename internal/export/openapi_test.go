@@ -0,0 +1,162 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestOpenAPI(t *testing.T) {
+	m, err := compile.Compile("testdata/catalog.thrift")
+	require.NoError(t, err)
+
+	res, err := OpenAPI(m, &Options{})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 1)
+	assert.Equal(t, "catalog.openapi.json", res.Files[0].Path)
+
+	var doc struct {
+		OpenAPI    string                                `json:"openapi"`
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(res.Files[0].Contents, &doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+
+	var paths []string
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	assert.Equal(t, []string{
+		"/AdminCatalog/find_items",
+		"/AdminCatalog/getItem",
+		"/AdminCatalog/ping",
+		"/AdminCatalog/putItem",
+		"/AdminCatalog/removeItem",
+		"/Catalog/find_items",
+		"/Catalog/getItem",
+		"/Catalog/ping",
+		"/Catalog/putItem",
+	}, paths, "inherited functions must be included")
+
+	var schemas []string
+	for name := range doc.Components.Schemas {
+		schemas = append(schemas, name)
+	}
+	sort.Strings(schemas)
+	assert.Equal(t, []string{
+		"Item", "ItemID", "ItemNotFound", "ItemRetired", "ItemState",
+		"Priority", "Selector", "Unavailable", "shared.Point", "shared.Unit",
+	}, schemas)
+
+	t.Run("operation", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"operationId": "AdminCatalog_removeItem",
+			"tags": ["AdminCatalog"],
+			"requestBody": {
+				"required": true,
+				"content": {"application/json": {"schema": {
+					"type": "object",
+					"properties": {"id": {"$ref": "#/components/schemas/ItemID"}}
+				}}}
+			},
+			"responses": {
+				"200": {"description": "Success"},
+				"404": {
+					"description": "ItemNotFound, ItemRetired",
+					"content": {"application/json": {"schema": {"oneOf": [
+						{"$ref": "#/components/schemas/ItemNotFound"},
+						{"$ref": "#/components/schemas/ItemRetired"}
+					]}}}
+				},
+				"500": {
+					"description": "Unavailable",
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Unavailable"}}}
+				}
+			}
+		}`, string(doc.Paths["/AdminCatalog/removeItem"]["post"]))
+
+		assert.JSONEq(t, `{
+			"operationId": "Catalog_ping",
+			"tags": ["Catalog"],
+			"requestBody": {
+				"required": true,
+				"content": {"application/json": {"schema": {"type": "object"}}}
+			},
+			"responses": {"202": {"description": "Accepted"}}
+		}`, string(doc.Paths["/Catalog/ping"]["post"]))
+	})
+
+	t.Run("schemas", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"description": "State of an item in the catalog.",
+			"type": "string",
+			"enum": ["UNKNOWN", "AVAILABLE", "DISCONTINUED"]
+		}`, string(doc.Components.Schemas["ItemState"]))
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"properties": {
+				"id": {"$ref": "#/components/schemas/ItemID"},
+				"query": {"type": "string"},
+				"ids": {"type": "array", "items": {"type": "string"}}
+			},
+			"minProperties": 1,
+			"maxProperties": 1
+		}`, string(doc.Components.Schemas["Selector"]))
+
+		var item struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+			Required   []string                   `json:"required"`
+		}
+		require.NoError(t, json.Unmarshal(doc.Components.Schemas["Item"], &item))
+		assert.Equal(t, []string{"itemID"}, item.Required)
+		assert.JSONEq(t, `{
+			"$ref": "#/components/schemas/ItemID",
+			"description": "Unique identifier of the item."
+		}`, string(item.Properties["itemID"]))
+		assert.JSONEq(t, `{"type": "integer", "format": "int32", "default": 1}`,
+			string(item.Properties["quantity"]))
+		assert.JSONEq(t, `{
+			"type": "object",
+			"additionalProperties": {"type": "object", "maxProperties": 0},
+			"default": {"1": {}, "2": {}}
+		}`, string(item.Properties["relatedIDs"]))
+		assert.JSONEq(t, `{
+			"type": "object",
+			"additionalProperties": {"$ref": "#/components/schemas/shared.Point"}
+		}`, string(item.Properties["locations"]))
+		assert.JSONEq(t, `{"type": "string", "format": "byte"}`, string(item.Properties["thumbnail"]))
+	})
+
+	require.Len(t, res.Warnings, 1)
+	assert.Contains(t, res.Warnings[0].Message,
+		"map<double, string> cannot be represented in JSON")
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// protoNamespace is the namespace scope which names the proto package of a
+// Thrift file. By default, the name of the Thrift file is used.
+const protoNamespace = "proto"
+
+// Field numbers reserved by Protocol Buffers for its own use.
+const (
+	protoFirstReserved = 19000
+	protoLastReserved  = 19999
+)
+
+// Proto translates the given module, and unless NoRecurse is set the
+// modules it includes, into proto3 files. Each Thrift file is translated
+// into a file with the same name and the .proto extension.
+//
+// Structs and exceptions become messages, unions become messages with a
+// oneof, and services become gRPC services with request and response
+// messages for each function. Constants, default values, exceptions thrown
+// by functions, and types which Protocol Buffers cannot express, such as
+// nested containers, are reported as warnings.
+func Proto(m *compile.Module, opts *Options) (*Result, error) {
+	// Packages are needed for every module that may be referenced, even
+	// with NoRecurse.
+	all := modules(m, &Options{})
+	pkgs := make(map[string]string, len(all))
+	paths := make(map[string]string, len(all))
+	for _, mod := range all {
+		name := protoFileName(mod)
+		if other, ok := paths[name]; ok {
+			return nil, fmt.Errorf(
+				"cannot translate both %q and %q: they would both be written to %q",
+				other, mod.ThriftPath, name)
+		}
+		paths[name] = mod.ThriftPath
+		pkgs[mod.ThriftPath] = protoPackage(mod)
+	}
+
+	var res Result
+	for _, mod := range modules(m, opts) {
+		w := protoWriter{
+			res:     &res,
+			mod:     mod,
+			pkgs:    pkgs,
+			imports: make(map[string]struct{}),
+		}
+		contents, err := w.file()
+		if err != nil {
+			return nil, fmt.Errorf("cannot translate %q: %v", mod.ThriftPath, err)
+		}
+		res.Files = append(res.Files, &File{
+			Path:     protoFileName(mod),
+			Contents: contents,
+		})
+	}
+	return &res, nil
+}
+
+func protoFileName(m *compile.Module) string {
+	return baseName(m.ThriftPath) + ".proto"
+}
+
+func protoPackage(m *compile.Module) string {
+	if ns := m.Namespaces[protoNamespace]; ns != "" {
+		return ns
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '.' || r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, baseName(m.ThriftPath))
+}
+
+// protoWriter writes the proto file for a single module.
+type protoWriter struct {
+	res  *Result
+	mod  *compile.Module
+	pkgs map[string]string
+
+	// Proto files imported by this file, by name.
+	imports map[string]struct{}
+
+	body bytes.Buffer
+}
+
+func (w *protoWriter) file() ([]byte, error) {
+	for _, name := range sortStringKeys(w.mod.Constants) {
+		c := w.mod.Constants[name]
+		w.res.warnf(c.File, c.Line, "constant %v cannot be translated: Protocol Buffers has no constants", name)
+	}
+
+	for _, name := range sortStringKeys(w.mod.Types) {
+		switch spec := w.mod.Types[name].(type) {
+		case *compile.EnumSpec:
+			w.enum(spec)
+		case *compile.StructSpec:
+			if err := w.message(spec); err != nil {
+				return nil, err
+			}
+		}
+		// Typedefs have no equivalent. References to them are replaced
+		// with their targets.
+	}
+
+	for _, name := range sortStringKeys(w.mod.Services) {
+		if err := w.service(w.mod.Services[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Translated from %v by thriftrw export proto.\n\n", baseName(w.mod.ThriftPath)+".thrift")
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&buf, "package %v;\n", w.pkgs[w.mod.ThriftPath])
+	if len(w.imports) > 0 {
+		buf.WriteString("\n")
+		for _, name := range sortStringKeys(w.imports) {
+			fmt.Fprintf(&buf, "import %q;\n", name)
+		}
+	}
+	buf.Write(w.body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func (w *protoWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&w.body, format, args...)
+}
+
+func (w *protoWriter) doc(indent, doc string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimRight(doc, "\n"), "\n") {
+		w.printf("%v//%v\n", indent, strings.TrimRight(" "+line, " "))
+	}
+}
+
+func (w *protoWriter) enum(spec *compile.EnumSpec) {
+	prefix := strings.ToUpper(snakeCase(spec.Name)) + "_"
+	itemName := func(name string) string {
+		name = strings.ToUpper(snakeCase(name))
+		if strings.HasPrefix(name, prefix) {
+			return name
+		}
+		return prefix + name
+	}
+
+	// The first value of a proto3 enum must be zero.
+	items := make([]compile.EnumItem, 0, len(spec.Items))
+	var zero []compile.EnumItem
+	values := make(map[int32]struct{}, len(spec.Items))
+	aliased := false
+	for _, item := range spec.Items {
+		if _, ok := values[item.Value]; ok {
+			aliased = true
+		}
+		values[item.Value] = struct{}{}
+		if item.Value == 0 {
+			zero = append(zero, item)
+		} else {
+			items = append(items, item)
+		}
+	}
+
+	w.printf("\n")
+	w.doc("", spec.Doc)
+	w.printf("enum %v {\n", spec.Name)
+	if aliased {
+		w.printf("  option allow_alias = true;\n")
+	}
+	if len(zero) == 0 {
+		w.printf("  %vUNSPECIFIED = 0;\n", prefix)
+	}
+	for _, item := range append(zero, items...) {
+		w.doc("  ", item.Doc)
+		w.printf("  %v = %v;\n", itemName(item.Name), item.Value)
+	}
+	w.printf("}\n")
+}
+
+func (w *protoWriter) message(spec *compile.StructSpec) error {
+	w.printf("\n")
+	w.doc("", spec.Doc)
+	w.printf("message %v {\n", spec.Name)
+
+	indent := "  "
+	if spec.Type == ast.UnionType && len(spec.Fields) > 0 {
+		w.printf("  oneof value {\n")
+		indent = "    "
+	}
+	for _, f := range spec.Fields {
+		if f.Default != nil {
+			w.res.warnf(spec.File, spec.Line,
+				"default value of %v.%v cannot be translated: proto3 fields default to zero values",
+				spec.Name, f.Name)
+		}
+		if err := w.field(spec, indent, f); err != nil {
+			return err
+		}
+	}
+	if indent != "  " {
+		w.printf("  }\n")
+	}
+	w.printf("}\n")
+	return nil
+}
+
+// field writes a field of the given struct, or reports a warning if it
+// cannot be translated.
+func (w *protoWriter) field(parent *compile.StructSpec, indent string, f *compile.FieldSpec) error {
+	warnf := func(format string, args ...interface{}) {
+		w.res.warnf(parent.File, parent.Line, "field %v.%v cannot be translated: %v",
+			parent.Name, f.Name, fmt.Sprintf(format, args...))
+	}
+
+	if f.ID < 1 {
+		warnf("field number %v is not positive", f.ID)
+		return nil
+	}
+	if f.ID >= protoFirstReserved && f.ID <= protoLastReserved {
+		warnf("field numbers %v through %v are reserved", protoFirstReserved, protoLastReserved)
+		return nil
+	}
+
+	t, err := w.typeOf(f.Type)
+	if err != nil {
+		warnf("%v", err)
+		return nil
+	}
+
+	label := ""
+	switch {
+	case parent.Type == ast.UnionType:
+		if t.repeated || t.isMap {
+			warnf("oneof fields cannot be repeated or maps")
+			return nil
+		}
+	case t.repeated:
+		label = "repeated "
+	case !f.Required && t.scalar:
+		label = "optional "
+	}
+
+	w.doc(indent, f.Doc)
+	w.printf("%v%v%v %v = %v;\n", indent, label, t.name, snakeCase(f.Name), f.ID)
+	return nil
+}
+
+// protoType is the translation of a Thrift type.
+type protoType struct {
+	name string
+
+	// Whether this type is a scalar, without presence unless it is marked
+	// optional.
+	scalar bool
+
+	repeated bool
+	isMap    bool
+}
+
+func (w *protoWriter) typeOf(spec compile.TypeSpec) (protoType, error) {
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return protoType{name: "bool", scalar: true}, nil
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec:
+		return protoType{name: "int32", scalar: true}, nil
+	case *compile.I64Spec:
+		return protoType{name: "int64", scalar: true}, nil
+	case *compile.DoubleSpec:
+		return protoType{name: "double", scalar: true}, nil
+	case *compile.StringSpec, *compile.UUIDSpec:
+		return protoType{name: "string", scalar: true}, nil
+	case *compile.BinarySpec:
+		return protoType{name: "bytes", scalar: true}, nil
+	case *compile.EnumSpec:
+		return protoType{name: w.reference(s), scalar: true}, nil
+	case *compile.StructSpec:
+		return protoType{name: w.reference(s)}, nil
+	case *compile.ListSpec:
+		return w.repeated(s.ValueSpec)
+	case *compile.SetSpec:
+		return w.repeated(s.ValueSpec)
+	case *compile.MapSpec:
+		k, err := w.typeOf(s.KeySpec)
+		if err != nil {
+			return protoType{}, err
+		}
+		switch k.name {
+		case "bool", "int32", "int64", "string":
+		default:
+			if _, isEnum := compile.RootTypeSpec(s.KeySpec).(*compile.EnumSpec); isEnum {
+				return protoType{}, fmt.Errorf("map keys cannot be enums")
+			}
+			return protoType{}, fmt.Errorf("map keys cannot be %v", s.KeySpec.ThriftName())
+		}
+		v, err := w.typeOf(s.ValueSpec)
+		if err != nil {
+			return protoType{}, err
+		}
+		if v.repeated || v.isMap {
+			return protoType{}, fmt.Errorf("map values cannot be containers")
+		}
+		return protoType{name: fmt.Sprintf("map<%v, %v>", k.name, v.name), isMap: true}, nil
+	default:
+		return protoType{}, fmt.Errorf("unknown type %v", spec.ThriftName())
+	}
+}
+
+func (w *protoWriter) repeated(spec compile.TypeSpec) (protoType, error) {
+	t, err := w.typeOf(spec)
+	if err != nil {
+		return protoType{}, err
+	}
+	if t.repeated || t.isMap {
+		return protoType{}, fmt.Errorf("list and set items cannot be containers")
+	}
+	return protoType{name: t.name, repeated: true}, nil
+}
+
+// reference returns the name by which the given enum or struct is referred
+// to from this file, importing the file which defines it if needed.
+func (w *protoWriter) reference(spec compile.TypeSpec) string {
+	path := spec.ThriftFile()
+	if path == w.mod.ThriftPath {
+		return spec.ThriftName()
+	}
+	w.imports[baseName(path)+".proto"] = struct{}{}
+	return w.pkgs[path] + "." + spec.ThriftName()
+}
+
+func (w *protoWriter) service(spec *compile.ServiceSpec) error {
+	if spec.Parent != nil {
+		w.res.warnf(spec.File, spec.Line,
+			"service %v extends %v: inherited functions are not translated",
+			spec.Name, spec.Parent.Name)
+	}
+
+	type rpc struct {
+		name, request, response string
+	}
+	var rpcs []rpc
+	for _, name := range sortStringKeys(spec.Functions) {
+		f := spec.Functions[name]
+		r := rpc{
+			name:     camelCase(f.Name),
+			request:  spec.Name + camelCase(f.Name) + "Request",
+			response: spec.Name + camelCase(f.Name) + "Response",
+		}
+		for _, msg := range []string{r.request, r.response} {
+			if _, ok := w.mod.Types[msg]; ok {
+				return fmt.Errorf(
+					"cannot translate %v.%v: message %v conflicts with a type of the same name",
+					spec.Name, f.Name, msg)
+			}
+		}
+
+		if f.OneWay {
+			w.res.warnf(spec.File, f.Line,
+				"oneway function %v.%v is translated to a call with an empty response",
+				spec.Name, f.Name)
+		}
+		if f.ResultSpec != nil && len(f.ResultSpec.Exceptions) > 0 {
+			w.res.warnf(spec.File, f.Line,
+				"exceptions thrown by %v.%v cannot be translated: report them with gRPC status details",
+				spec.Name, f.Name)
+		}
+
+		args := &compile.StructSpec{
+			Name:   r.request,
+			File:   spec.File,
+			Line:   f.Line,
+			Type:   ast.StructType,
+			Fields: compile.FieldGroup(f.ArgsSpec),
+		}
+		if err := w.message(args); err != nil {
+			return err
+		}
+
+		result := &compile.StructSpec{
+			Name: r.response,
+			File: spec.File,
+			Line: f.Line,
+			Type: ast.StructType,
+		}
+		if f.ResultSpec != nil && f.ResultSpec.ReturnType != nil {
+			result.Fields = compile.FieldGroup{{
+				ID:       1,
+				Name:     "result",
+				Type:     f.ResultSpec.ReturnType,
+				Required: true,
+			}}
+		}
+		if err := w.message(result); err != nil {
+			return err
+		}
+
+		rpcs = append(rpcs, r)
+	}
+
+	w.printf("\nservice %v {\n", spec.Name)
+	for _, r := range rpcs {
+		w.printf("  rpc %v(%v) returns (%v);\n", r.name, r.request, r.response)
+	}
+	w.printf("}\n")
+	return nil
+}
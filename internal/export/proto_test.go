@@ -0,0 +1,243 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestProto(t *testing.T) {
+	m, err := compile.Compile("testdata/catalog.thrift")
+	require.NoError(t, err)
+
+	res, err := Proto(m, &Options{})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 2)
+
+	assert.Equal(t, "catalog.proto", res.Files[0].Path)
+	assert.Equal(t, `// Translated from catalog.thrift by thriftrw export proto.
+
+syntax = "proto3";
+
+package catalog;
+
+import "shared.proto";
+
+message Item {
+  // Unique identifier of the item.
+  string item_id = 1;
+  optional string display_name = 2;
+  optional int32 quantity = 3;
+  optional ItemState state = 4;
+  repeated string tags = 5;
+  repeated int64 related_ids = 6;
+  map<string, example.shared.Point> locations = 7;
+  optional example.shared.Unit unit = 8;
+  optional bytes thumbnail = 11;
+  optional string external_id = 12;
+}
+
+message ItemNotFound {
+  string message = 1;
+}
+
+message ItemRetired {
+  string item_id = 1;
+}
+
+// State of an item in the catalog.
+enum ItemState {
+  ITEM_STATE_UNKNOWN = 0;
+  // The item may be ordered.
+  ITEM_STATE_AVAILABLE = 1;
+  ITEM_STATE_DISCONTINUED = 2;
+}
+
+enum Priority {
+  option allow_alias = true;
+  PRIORITY_UNSPECIFIED = 0;
+  PRIORITY_LOW = 1;
+  PRIORITY_HIGH = 2;
+  PRIORITY_URGENT = 2;
+}
+
+message Selector {
+  oneof value {
+    string id = 1;
+    string query = 2;
+  }
+}
+
+message Unavailable {
+  optional string reason = 1;
+}
+
+message AdminCatalogRemoveItemRequest {
+  optional string id = 1;
+}
+
+message AdminCatalogRemoveItemResponse {
+}
+
+service AdminCatalog {
+  rpc RemoveItem(AdminCatalogRemoveItemRequest) returns (AdminCatalogRemoveItemResponse);
+}
+
+message CatalogFindItemsRequest {
+  Selector selector = 1;
+  optional int32 limit = 2;
+}
+
+message CatalogFindItemsResponse {
+  repeated Item result = 1;
+}
+
+message CatalogGetItemRequest {
+  optional string id = 1;
+}
+
+message CatalogGetItemResponse {
+  Item result = 1;
+}
+
+message CatalogPingRequest {
+}
+
+message CatalogPingResponse {
+}
+
+message CatalogPutItemRequest {
+  Item item = 1;
+}
+
+message CatalogPutItemResponse {
+}
+
+service Catalog {
+  rpc FindItems(CatalogFindItemsRequest) returns (CatalogFindItemsResponse);
+  rpc GetItem(CatalogGetItemRequest) returns (CatalogGetItemResponse);
+  rpc Ping(CatalogPingRequest) returns (CatalogPingResponse);
+  rpc PutItem(CatalogPutItemRequest) returns (CatalogPutItemResponse);
+}
+`, string(res.Files[0].Contents))
+
+	assert.Equal(t, "shared.proto", res.Files[1].Path)
+	assert.Equal(t, `// Translated from shared.thrift by thriftrw export proto.
+
+syntax = "proto3";
+
+package example.shared;
+
+// A point in two dimensions.
+message Point {
+  double x = 1;
+  double y = 2;
+}
+
+enum Unit {
+  UNIT_UNSPECIFIED = 0;
+  UNIT_METERS = 1;
+  UNIT_FEET = 2;
+}
+`, string(res.Files[1].Contents))
+
+	var warnings []string
+	for _, w := range res.Warnings {
+		rel, err := filepath.Rel(filepath.Dir(m.ThriftPath), w.Path)
+		require.NoError(t, err)
+		w.Path = rel
+		warnings = append(warnings, w.String())
+	}
+	assert.Equal(t, []string{
+		"catalog.thrift:5: constant MaxItems cannot be translated: Protocol Buffers has no constants",
+		"catalog.thrift:21: default value of Item.quantity cannot be translated: proto3 fields default to zero values",
+		"catalog.thrift:21: default value of Item.relatedIDs cannot be translated: proto3 fields default to zero values",
+		"catalog.thrift:21: field Item.matrix cannot be translated: list and set items cannot be containers",
+		"catalog.thrift:21: field Item.weights cannot be translated: map keys cannot be double",
+		"catalog.thrift:37: field Selector.ids cannot be translated: oneof fields cannot be repeated or maps",
+		"catalog.thrift:62: service AdminCatalog extends Catalog: inherited functions are not translated",
+		"catalog.thrift:63: exceptions thrown by AdminCatalog.removeItem cannot be translated: report them with gRPC status details",
+		"catalog.thrift:48: exceptions thrown by Catalog.getItem cannot be translated: report them with gRPC status details",
+		"catalog.thrift:51: oneway function Catalog.ping is translated to a call with an empty response",
+	}, warnings)
+}
+
+func TestProtoNoRecurse(t *testing.T) {
+	m, err := compile.Compile("testdata/catalog.thrift")
+	require.NoError(t, err)
+
+	res, err := Proto(m, &Options{NoRecurse: true})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 1)
+	assert.Equal(t, "catalog.proto", res.Files[0].Path)
+	assert.Contains(t, string(res.Files[0].Contents), `import "shared.proto";`)
+}
+
+func TestProtoErrors(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+		return path
+	}
+
+	tests := []struct {
+		desc    string
+		file    string
+		wantErr string
+	}{
+		{
+			desc: "conflicting files",
+			file: write("a/shared.thrift", `
+				include "../b/shared.thrift"
+				struct Foo { 1: optional shared.Bar bar }
+			`),
+			wantErr: `they would both be written to "shared.proto"`,
+		},
+		{
+			desc: "conflicting messages",
+			file: write("conflict.thrift", `
+				struct KeyValueGetRequest {}
+				service KeyValue { string get(1: string key) }
+			`),
+			wantErr: "message KeyValueGetRequest conflicts with a type of the same name",
+		},
+	}
+	write("b/shared.thrift", "struct Bar {}")
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m, err := compile.Compile(tt.file)
+			require.NoError(t, err)
+
+			_, err = Proto(m, &Options{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,476 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// labelKey is the annotation which overrides the name of a field or enum
+// item in the JSON representation of generated types.
+const labelKey = "go.label"
+
+// label returns the name of the given field or enum item in the JSON
+// representation of generated types.
+func label(e compile.NamedEntity) string {
+	if l := e.ThriftAnnotations()[labelKey]; l != "" {
+		return l
+	}
+	return e.ThriftName()
+}
+
+// schema is a JSON Schema, as used by both JSON Schema documents and
+// OpenAPI 3.1. Only the keywords needed to describe the JSON representation
+// of generated types are supported.
+type schema struct {
+	Schema string `json:"$schema,omitempty"`
+	ID     string `json:"$id,omitempty"`
+	Title  string `json:"title,omitempty"`
+
+	Ref         string      `json:"$ref,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Type        string      `json:"type,omitempty"`
+	Format      string      `json:"format,omitempty"`
+	Enum        []string    `json:"enum,omitempty"`
+	Minimum     *int64      `json:"minimum,omitempty"`
+	Maximum     *int64      `json:"maximum,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+
+	Items       *schema `json:"items,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	Properties           properties `json:"properties,omitempty"`
+	Required             []string   `json:"required,omitempty"`
+	AdditionalProperties *schema    `json:"additionalProperties,omitempty"`
+	MinProperties        *int       `json:"minProperties,omitempty"`
+	MaxProperties        *int       `json:"maxProperties,omitempty"`
+
+	OneOf []*schema `json:"oneOf,omitempty"`
+}
+
+// property is a property of an object schema.
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// properties are the properties of an object schema, which are marshaled
+// in the order in which they were defined rather than sorted by name.
+type properties []property
+
+func (ps properties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, p := range ps {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(p.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(p.Schema)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// definitions returns the definitions made by the builder, sorted by name.
+func (b *schemaBuilder) definitions() properties {
+	names := append([]string(nil), b.names...)
+	sort.Strings(names)
+
+	defs := make(properties, len(names))
+	for i, name := range names {
+		defs[i] = property{Name: name, Schema: b.defs[name]}
+	}
+	return defs
+}
+
+// marshalJSON marshals the given value as indented JSON, without escaping
+// HTML characters in documentation.
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func intPtr(i int) *int { return &i }
+
+func int64Ptr(i int64) *int64 { return &i }
+
+// schemaBuilder builds schemas for Thrift types. Enums, structs, and
+// typedefs are defined once under their names and referred to by $ref.
+type schemaBuilder struct {
+	res  *Result
+	root *compile.Module
+
+	// ref returns the $ref referring to the definition with the given
+	// name.
+	ref func(name string) string
+
+	// Definitions by name, and their names in the order in which they
+	// were defined.
+	defs  map[string]*schema
+	names []string
+}
+
+func newSchemaBuilder(res *Result, root *compile.Module, ref func(string) string) *schemaBuilder {
+	return &schemaBuilder{
+		res:  res,
+		root: root,
+		ref:  ref,
+		defs: make(map[string]*schema),
+	}
+}
+
+// defName returns the name under which the given type is defined. Types
+// from modules other than the root module are prefixed with the name of
+// their Thrift file.
+func (b *schemaBuilder) defName(spec compile.TypeSpec) string {
+	if spec.ThriftFile() == b.root.ThriftPath {
+		return spec.ThriftName()
+	}
+	return baseName(spec.ThriftFile()) + "." + spec.ThriftName()
+}
+
+// define defines the given enum, struct, or typedef if it has not been
+// defined already, and returns its name.
+func (b *schemaBuilder) define(spec compile.TypeSpec) string {
+	name := b.defName(spec)
+	if _, ok := b.defs[name]; ok {
+		return name
+	}
+
+	// Reserve the name first so that recursive types terminate.
+	s := &schema{}
+	b.defs[name] = s
+	b.names = append(b.names, name)
+
+	switch spec := spec.(type) {
+	case *compile.EnumSpec:
+		*s = *b.enum(spec)
+	case *compile.StructSpec:
+		*s = *b.object(spec)
+	case *compile.TypedefSpec:
+		*s = *b.schemaOf(spec.Target)
+		s.Description = doc(spec.Doc)
+	}
+	return name
+}
+
+// schemaOf returns the schema of values of the given type.
+func (b *schemaBuilder) schemaOf(spec compile.TypeSpec) *schema {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return &schema{Type: "boolean"}
+	case *compile.I8Spec:
+		return &schema{Type: "integer", Format: "int32", Minimum: int64Ptr(math.MinInt8), Maximum: int64Ptr(math.MaxInt8)}
+	case *compile.I16Spec:
+		return &schema{Type: "integer", Format: "int32", Minimum: int64Ptr(math.MinInt16), Maximum: int64Ptr(math.MaxInt16)}
+	case *compile.I32Spec:
+		return &schema{Type: "integer", Format: "int32"}
+	case *compile.I64Spec:
+		return &schema{Type: "integer", Format: "int64"}
+	case *compile.DoubleSpec:
+		return &schema{Type: "number", Format: "double"}
+	case *compile.StringSpec:
+		return &schema{Type: "string"}
+	case *compile.BinarySpec:
+		// Binary data is base64-encoded, as encoding/json does for []byte.
+		return &schema{Type: "string", Format: "byte"}
+	case *compile.UUIDSpec:
+		return &schema{Type: "string", Format: "uuid"}
+	case *compile.EnumSpec, *compile.StructSpec, *compile.TypedefSpec:
+		return &schema{Ref: b.ref(b.define(s))}
+	case *compile.ListSpec:
+		return &schema{Type: "array", Items: b.schemaOf(s.ValueSpec)}
+	case *compile.SetSpec:
+		return b.setSchema(s)
+	case *compile.MapSpec:
+		return b.mapSchema(s)
+	default:
+		panic(fmt.Sprintf("unknown type %v", spec.ThriftName()))
+	}
+}
+
+// goTypeKey is the annotation which changes the Go representation of sets
+// and maps to slices.
+const goTypeKey = "go.type"
+
+// keyKind describes how generated code represents sets and maps with keys
+// of a given type in JSON.
+type keyKind int
+
+const (
+	// Keys are the names of the properties of an object.
+	objectKeys keyKind = iota
+
+	// Keys are the items of a list, or Key properties of items of a list.
+	listKeys
+
+	// Keys cannot be represented in JSON.
+	unsupportedKeys
+)
+
+func keyKindOf(spec compile.TypeSpec, sliceAnnotation string, annotations compile.Annotations) keyKind {
+	if annotations[goTypeKey] == sliceAnnotation {
+		return listKeys
+	}
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.StringSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.EnumSpec, *compile.UUIDSpec:
+		return objectKeys
+	case *compile.BoolSpec, *compile.DoubleSpec:
+		return unsupportedKeys
+	default:
+		return listKeys
+	}
+}
+
+// setSchema returns the schema of a set. Sets of strings, integers, enums,
+// and UUIDs are objects whose properties hold empty objects, while other
+// sets are lists, as they are represented by generated code.
+func (b *schemaBuilder) setSchema(spec *compile.SetSpec) *schema {
+	switch keyKindOf(spec.ValueSpec, "slice", spec.Annotations) {
+	case objectKeys:
+		return &schema{
+			Type:                 "object",
+			AdditionalProperties: &schema{Type: "object", MaxProperties: intPtr(0)},
+		}
+	case unsupportedKeys:
+		b.res.warnf(b.root.ThriftPath, 0,
+			"%v cannot be represented in JSON: items of sets must be strings, integers, enums, or structs",
+			spec.ThriftName())
+		return &schema{Type: "object"}
+	default:
+		return &schema{Type: "array", Items: b.schemaOf(spec.ValueSpec), UniqueItems: true}
+	}
+}
+
+// mapSchema returns the schema of a map. Maps keyed by strings, integers,
+// enums, and UUIDs are objects, while other maps are lists of key-value
+// pairs, as they are represented by generated code.
+func (b *schemaBuilder) mapSchema(spec *compile.MapSpec) *schema {
+	switch keyKindOf(spec.KeySpec, "keyvalue-slice", spec.Annotations) {
+	case objectKeys:
+		return &schema{Type: "object", AdditionalProperties: b.schemaOf(spec.ValueSpec)}
+	case unsupportedKeys:
+		b.res.warnf(b.root.ThriftPath, 0,
+			"%v cannot be represented in JSON: map keys must be strings, integers, enums, or structs",
+			spec.ThriftName())
+		return &schema{Type: "object"}
+	default:
+		return &schema{
+			Type: "array",
+			Items: &schema{
+				Type: "object",
+				Properties: properties{
+					{Name: "Key", Schema: b.schemaOf(spec.KeySpec)},
+					{Name: "Value", Schema: b.schemaOf(spec.ValueSpec)},
+				},
+				Required: []string{"Key", "Value"},
+			},
+		}
+	}
+}
+
+func (b *schemaBuilder) enum(spec *compile.EnumSpec) *schema {
+	s := &schema{Type: "string", Description: doc(spec.Doc)}
+	for _, item := range spec.Items {
+		s.Enum = append(s.Enum, label(&item))
+	}
+	return s
+}
+
+// object returns the schema of a struct, union, or exception.
+func (b *schemaBuilder) object(spec *compile.StructSpec) *schema {
+	s := &schema{Type: "object", Description: doc(spec.Doc)}
+	for _, f := range spec.Fields {
+		// Keywords next to $ref are allowed by JSON Schema 2020-12 and
+		// OpenAPI 3.1.
+		fs := b.schemaOf(f.Type)
+		fs.Description = doc(f.Doc)
+		if f.Default != nil {
+			v, err := jsonValue(f.Default, f.Type)
+			if err != nil {
+				b.res.warnf(spec.File, spec.Line,
+					"default value of %v.%v cannot be translated: %v", spec.Name, f.Name, err)
+			} else {
+				fs.Default = v
+			}
+		}
+
+		s.Properties = append(s.Properties, property{Name: label(f), Schema: fs})
+		if f.Required {
+			s.Required = append(s.Required, label(f))
+		}
+	}
+	if spec.Type == ast.UnionType && len(spec.Fields) > 0 {
+		s.MinProperties = intPtr(1)
+		s.MaxProperties = intPtr(1)
+	}
+	return s
+}
+
+// doc returns the given documentation as a description, without trailing
+// newlines.
+func doc(d string) string {
+	for len(d) > 0 && d[len(d)-1] == '\n' {
+		d = d[:len(d)-1]
+	}
+	return d
+}
+
+// jsonValue returns the JSON representation of the given constant value of
+// the given type, as produced by generated code.
+func jsonValue(v compile.ConstantValue, t compile.TypeSpec) (interface{}, error) {
+	t = compile.RootTypeSpec(t)
+	switch v := v.(type) {
+	case compile.ConstantBool:
+		return bool(v), nil
+	case compile.ConstantInt:
+		switch t := t.(type) {
+		case *compile.BoolSpec:
+			return v != 0, nil
+		case *compile.DoubleSpec:
+			return float64(v), nil
+		case *compile.EnumSpec:
+			for _, item := range t.Items {
+				if int64(item.Value) == int64(v) {
+					return label(&item), nil
+				}
+			}
+		}
+		return int64(v), nil
+	case compile.ConstantDouble:
+		return float64(v), nil
+	case compile.ConstantString:
+		if _, ok := t.(*compile.BinarySpec); ok {
+			return base64.StdEncoding.EncodeToString([]byte(v)), nil
+		}
+		return string(v), nil
+	case compile.EnumItemReference:
+		return label(v.Item), nil
+	case compile.ConstReference:
+		return jsonValue(v.Target.Value, t)
+	case compile.ConstantList:
+		return jsonItems(v, t)
+	case compile.ConstantSet:
+		set, ok := t.(*compile.SetSpec)
+		if !ok || keyKindOf(set.ValueSpec, "slice", set.Annotations) != objectKeys {
+			return jsonItems(v, t)
+		}
+		obj := make(map[string]interface{}, len(v))
+		for _, item := range v {
+			k, err := jsonValue(item, set.ValueSpec)
+			if err != nil {
+				return nil, err
+			}
+			obj[fmt.Sprint(k)] = struct{}{}
+		}
+		return obj, nil
+	case compile.ConstantMap:
+		m, ok := t.(*compile.MapSpec)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a map", t.ThriftName())
+		}
+		obj := make(map[string]interface{}, len(v))
+		for _, pair := range v {
+			k, err := jsonValue(pair.Key, m.KeySpec)
+			if err != nil {
+				return nil, err
+			}
+			val, err := jsonValue(pair.Value, m.ValueSpec)
+			if err != nil {
+				return nil, err
+			}
+			switch k.(type) {
+			case string, int64:
+				obj[fmt.Sprint(k)] = val
+			default:
+				return nil, fmt.Errorf("map keys of type %v are not supported", m.KeySpec.ThriftName())
+			}
+		}
+		return obj, nil
+	case *compile.ConstantStruct:
+		s, ok := t.(*compile.StructSpec)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a struct", t.ThriftName())
+		}
+		obj := make(map[string]interface{}, len(v.Fields))
+		for _, f := range s.Fields {
+			fv, ok := v.Fields[f.Name]
+			if !ok {
+				continue
+			}
+			val, err := jsonValue(fv, f.Type)
+			if err != nil {
+				return nil, err
+			}
+			obj[label(f)] = val
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unknown constant value %v", v)
+	}
+}
+
+func jsonItems(items []compile.ConstantValue, t compile.TypeSpec) (interface{}, error) {
+	var itemType compile.TypeSpec
+	switch t := t.(type) {
+	case *compile.ListSpec:
+		itemType = t.ValueSpec
+	case *compile.SetSpec:
+		itemType = t.ValueSpec
+	default:
+		return nil, fmt.Errorf("%v is not a list or set", t.ThriftName())
+	}
+
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		v, err := jsonValue(item, itemType)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
@@ -0,0 +1,64 @@
+include "./shared.thrift"
+
+typedef string ItemID
+
+const i32 MaxItems = 100
+
+/** State of an item in the catalog. */
+enum ItemState {
+    UNKNOWN = 0,
+    /** The item may be ordered. */
+    AVAILABLE = 1,
+    DISCONTINUED = 2,
+}
+
+enum Priority {
+    Low = 1,
+    High = 2,
+    Urgent = 2,
+}
+
+struct Item {
+    /** Unique identifier of the item. */
+    1: required ItemID itemID
+    2: optional string displayName
+    3: optional i32 quantity = 1
+    4: optional ItemState state
+    5: optional list<string> tags
+    6: optional set<i64> relatedIDs = [1, 2]
+    7: optional map<string, shared.Point> locations
+    8: optional shared.Unit unit
+    9: optional list<list<i32>> matrix
+    10: optional map<double, string> weights
+    11: optional binary thumbnail
+    12: optional uuid externalID
+}
+
+union Selector {
+    1: ItemID id
+    2: string query
+    3: list<string> ids
+}
+
+exception ItemNotFound {
+    1: required string message
+} (http.status = "404")
+
+service Catalog {
+    Item getItem(1: ItemID id) throws (1: ItemNotFound notFound, 2: Unavailable unavailable)
+    list<Item> find_items(1: Selector selector, 2: i32 limit)
+    void putItem(1: Item item)
+    oneway void ping()
+}
+
+exception ItemRetired {
+    1: required string itemID
+} (http.status = "404")
+
+exception Unavailable {
+    1: optional string reason
+}
+
+service AdminCatalog extends Catalog {
+    void removeItem(1: ItemID id) throws (1: ItemNotFound notFound, 2: ItemRetired retired, 3: Unavailable unavailable)
+}
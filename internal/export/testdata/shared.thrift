@@ -0,0 +1,13 @@
+namespace proto example.shared
+namespace avro com.example.shared
+
+/** A point in two dimensions. */
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+enum Unit {
+    METERS = 1,
+    FEET = 2,
+}
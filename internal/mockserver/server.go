@@ -0,0 +1,215 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package mockserver implements a Thrift server which replies to calls with
+// canned responses. It backs "thriftrw serve-mock".
+package mockserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/proxy"
+	"go.uber.org/thriftrw/wire"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Server replies to calls for a Thrift service with canned responses.
+//
+// Calls are checked against the IDL of the service. Calls to methods without
+// a canned response receive a TApplicationException.
+type Server struct {
+	proxy     *proxy.Proxy
+	responses map[string]wire.Value
+}
+
+// New builds a Server for the service with the given name in the given
+// module.
+//
+// The canned responses are read from YAML or JSON in the following format,
+// with one entry for every method that should succeed. The response for a
+// method is its result: either "success" with the return value, or the name
+// of one of the exceptions it throws. The response for a void method may be
+// empty.
+//
+//	getValue:
+//	  success: "hello"
+//	deleteValue:
+//	  doesNotExist:
+//	    key: foo
+//	setValue: {}
+//
+// Oneway methods need no response.
+func New(m *compile.Module, service string, responses []byte, opts ...proxy.Option) (*Server, error) {
+	spec, err := m.LookupService(service)
+	if err != nil {
+		return nil, fmt.Errorf("unknown service %q: %v", service, err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(responses, &raw); err != nil {
+		return nil, fmt.Errorf("could not parse responses: %v", err)
+	}
+
+	s := &Server{responses: make(map[string]wire.Value, len(raw))}
+	for method, v := range raw {
+		f, err := lookupFunction(spec, method)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := resultValue(f, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response for %q: %v", method, err)
+		}
+		s.responses[method] = result
+	}
+
+	s.proxy, err = proxy.New(m, service, proxy.HandlerFunc(s.handle), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// lookupFunction finds the function with the given name in the given
+// service or its parents.
+func lookupFunction(spec *compile.ServiceSpec, method string) (*compile.FunctionSpec, error) {
+	for s := spec; s != nil; s = s.Parent {
+		if f, ok := s.Functions[method]; ok {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("service %q does not have a method %q", spec.Name, method)
+}
+
+// resultValue builds the result struct for the given function from its
+// canned response.
+func resultValue(f *compile.FunctionSpec, v interface{}) (wire.Value, error) {
+	if f.OneWay {
+		return wire.Value{}, fmt.Errorf("oneway methods cannot have a response")
+	}
+
+	var fields compile.FieldGroup
+	if f.ResultSpec.ReturnType != nil {
+		fields = append(fields, &compile.FieldSpec{
+			ID:   0,
+			Name: "success",
+			Type: f.ResultSpec.ReturnType,
+		})
+	}
+	fields = append(fields, f.ResultSpec.Exceptions...)
+
+	result, err := structValue(fields, v)
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	switch n := len(result.GetStruct().Fields); {
+	case n > 1:
+		return wire.Value{}, fmt.Errorf("expected a single result, got %d", n)
+	case n == 0 && f.ResultSpec.ReturnType != nil:
+		return wire.Value{}, fmt.Errorf("expected a success value or an exception")
+	}
+	return result, nil
+}
+
+func (s *Server) handle(ctx context.Context, call *proxy.Call) (wire.Value, error) {
+	if call.Function.OneWay {
+		return wire.Value{}, nil
+	}
+
+	result, ok := s.responses[call.Method]
+	if !ok {
+		return wire.Value{}, fmt.Errorf("no canned response for %q", call.Method)
+	}
+	return result, nil
+}
+
+// Handle handles an enveloped request and returns the enveloped response.
+// No response is produced for oneway requests.
+func (s *Server) Handle(ctx context.Context, request []byte) ([]byte, error) {
+	return s.proxy.Handle(ctx, request)
+}
+
+// ServeHTTP serves a request whose body holds an enveloped request. The
+// response body holds the enveloped response.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := s.Handle(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.apache.thrift.binary")
+	w.Write(res)
+}
+
+// ServeFramed accepts connections from the given listener and serves
+// requests over them with the framed transport: each request and response
+// is prefixed with its 4-byte big-endian length.
+//
+// This blocks until the listener is closed.
+func (s *Server) ServeFramed(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	r := frame.NewReader(conn)
+	w := frame.NewWriter(conn)
+	for {
+		req, err := r.Read()
+		if err != nil {
+			return
+		}
+
+		res, err := s.Handle(context.Background(), req)
+		if err != nil {
+			return
+		}
+
+		if res == nil {
+			continue // oneway
+		}
+		if err := w.Write(res); err != nil {
+			return
+		}
+	}
+}
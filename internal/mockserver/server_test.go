@@ -0,0 +1,287 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mockserver
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResponses = `
+healthy:
+  success: true
+getValue:
+  doesNotExist:
+    key: foo
+setValue: {}
+names:
+  success: {1: one}
+`
+
+func newTestServer(t *testing.T) *Server {
+	m, err := compile.Compile("testdata/kv.thrift")
+	require.NoError(t, err)
+
+	s, err := New(m, "KeyValue", []byte(testResponses))
+	require.NoError(t, err)
+	return s
+}
+
+func encodeRequest(t *testing.T, name string, typ wire.EnvelopeType, fields ...wire.Field) []byte {
+	var buff bytes.Buffer
+	require.NoError(t, binary.Default.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  typ,
+		SeqID: 42,
+		Value: wire.NewValueStruct(wire.Struct{Fields: fields}),
+	}, &buff))
+	return buff.Bytes()
+}
+
+func decodeResponse(t *testing.T, res []byte) wire.Envelope {
+	e, err := binary.Default.DecodeEnveloped(bytes.NewReader(res))
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), e.SeqID)
+	return e
+}
+
+func TestServerHandle(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	key := wire.Field{ID: 1, Value: wire.NewValueString("foo")}
+
+	tests := []struct {
+		desc     string
+		method   string
+		typ      wire.EnvelopeType
+		args     []wire.Field
+		wantType wire.EnvelopeType
+		want     wire.Value
+
+		// Type of the TApplicationException expected in the response, if
+		// any.
+		wantException exception.ExceptionType
+	}{
+		{
+			desc:     "inherited method",
+			method:   "healthy",
+			typ:      wire.Call,
+			wantType: wire.Reply,
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 0, Value: wire.NewValueBool(true)},
+			}}),
+		},
+		{
+			desc:     "exception",
+			method:   "getValue",
+			typ:      wire.Call,
+			args:     []wire.Field{key},
+			wantType: wire.Reply,
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{key}})},
+			}}),
+		},
+		{
+			desc:     "void",
+			method:   "setValue",
+			typ:      wire.Call,
+			wantType: wire.Reply,
+			want:     wire.NewValueStruct(wire.Struct{}),
+		},
+		{
+			desc:     "map",
+			method:   "names",
+			typ:      wire.Call,
+			wantType: wire.Reply,
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 0, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI32, wire.TBinary, []wire.MapItem{
+					{Key: wire.NewValueI32(1), Value: wire.NewValueString("one")},
+				}))},
+			}}),
+		},
+		{
+			desc:          "no canned response",
+			method:        "listItems",
+			typ:           wire.Call,
+			wantType:      wire.Exception,
+			wantException: exception.ExceptionTypeInternalError,
+		},
+		{
+			desc:          "unknown method",
+			method:        "deleteValue",
+			typ:           wire.Call,
+			wantType:      wire.Exception,
+			wantException: exception.ExceptionTypeUnknownMethod,
+		},
+		{
+			desc:          "invalid arguments",
+			method:        "getValue",
+			typ:           wire.Call,
+			args:          []wire.Field{{ID: 1, Value: wire.NewValueI32(1)}},
+			wantType:      wire.Exception,
+			wantException: exception.ExceptionTypeProtocolError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			res, err := s.Handle(ctx, encodeRequest(t, tt.method, tt.typ, tt.args...))
+			require.NoError(t, err)
+
+			e := decodeResponse(t, res)
+			assert.Equal(t, tt.method, e.Name)
+			require.Equal(t, tt.wantType, e.Type)
+
+			if tt.wantType == wire.Exception {
+				var exc exception.TApplicationException
+				require.NoError(t, exc.FromWire(e.Value))
+				assert.Equal(t, tt.wantException, exc.GetType())
+				return
+			}
+			assert.True(t, wire.ValuesAreEqual(tt.want, e.Value), "expected %v, got %v", tt.want, e.Value)
+		})
+	}
+
+	t.Run("oneway", func(t *testing.T) {
+		res, err := s.Handle(ctx, encodeRequest(t, "flush", wire.OneWay))
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestNewErrors(t *testing.T) {
+	m, err := compile.Compile("testdata/kv.thrift")
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc      string
+		service   string
+		responses string
+		wantErr   string
+	}{
+		{
+			desc:    "unknown service",
+			service: "Cache",
+			wantErr: `unknown service "Cache"`,
+		},
+		{
+			desc:      "not YAML",
+			service:   "KeyValue",
+			responses: "getValue: [",
+			wantErr:   "could not parse responses",
+		},
+		{
+			desc:      "unknown method",
+			service:   "KeyValue",
+			responses: "deleteValue: {}",
+			wantErr:   `service "KeyValue" does not have a method "deleteValue"`,
+		},
+		{
+			desc:      "missing result",
+			service:   "KeyValue",
+			responses: "getValue: {}",
+			wantErr:   `invalid response for "getValue": expected a success value or an exception`,
+		},
+		{
+			desc:      "multiple results",
+			service:   "KeyValue",
+			responses: "getValue: {success: foo, doesNotExist: {}}",
+			wantErr:   `invalid response for "getValue": expected a single result, got 2`,
+		},
+		{
+			desc:      "wrong type",
+			service:   "KeyValue",
+			responses: "getValue: {success: [foo]}",
+			wantErr:   `invalid response for "getValue": field "success": expected string`,
+		},
+		{
+			desc:      "oneway",
+			service:   "KeyValue",
+			responses: "flush: {}",
+			wantErr:   `invalid response for "flush": oneway methods cannot have a response`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := New(m, tt.service, []byte(tt.responses))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	server := httptest.NewServer(newTestServer(t))
+	defer server.Close()
+
+	res, err := http.Post(server.URL, "application/vnd.apache.thrift.binary",
+		bytes.NewReader(encodeRequest(t, "healthy", wire.Call)))
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var body bytes.Buffer
+	_, err = body.ReadFrom(res.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, wire.Reply, decodeResponse(t, body.Bytes()).Type)
+}
+
+func TestServeFramed(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	go newTestServer(t).ServeFramed(ln)
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	w := frame.NewWriter(conn)
+	r := frame.NewReader(conn)
+
+	// Oneway requests must not produce a response, so the next response
+	// read must be for the request that follows.
+	require.NoError(t, w.Write(encodeRequest(t, "flush", wire.OneWay)))
+	require.NoError(t, w.Write(encodeRequest(t, "healthy", wire.Call)))
+
+	res, err := r.Read()
+	require.NoError(t, err)
+
+	e := decodeResponse(t, res)
+	assert.Equal(t, "healthy", e.Name)
+	assert.Equal(t, wire.Reply, e.Type)
+}
@@ -0,0 +1,33 @@
+enum Color {
+    RED = 1
+    GREEN = 2
+}
+
+struct Item {
+    1: required string key
+    2: optional binary value
+    3: optional Color color
+}
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+exception KeyDoesNotExist {
+    1: optional string key
+}
+
+service Base {
+    bool healthy()
+}
+
+service KeyValue extends Base {
+    string getValue(1: string key) throws (1: KeyDoesNotExist doesNotExist)
+    void setValue(1: string key, 2: string value)
+    list<Item> listItems()
+    map<i32, string> names()
+    map<Point, string> labels()
+    set<double> weights()
+    oneway void flush()
+}
@@ -0,0 +1,336 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mockserver
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/thriftuuid"
+	"go.uber.org/thriftrw/wire"
+)
+
+// toWire converts a value decoded from YAML or JSON into a wire.Value of the
+// given type.
+//
+// Structs are objects keyed by the Thrift names of their fields, enums are
+// item names or numbers, and binary fields are strings. Maps are objects if
+// their keys can be written as strings, and lists of {key, value} objects
+// otherwise.
+func toWire(spec compile.TypeSpec, v interface{}) (wire.Value, error) {
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		b, ok := v.(bool)
+		if !ok {
+			return wire.Value{}, typeError("bool", v)
+		}
+		return wire.NewValueBool(b), nil
+
+	case *compile.I8Spec:
+		i, err := toInt(v, 8)
+		return wire.NewValueI8(int8(i)), err
+
+	case *compile.I16Spec:
+		i, err := toInt(v, 16)
+		return wire.NewValueI16(int16(i)), err
+
+	case *compile.I32Spec:
+		i, err := toInt(v, 32)
+		return wire.NewValueI32(int32(i)), err
+
+	case *compile.I64Spec:
+		i, err := toInt(v, 64)
+		return wire.NewValueI64(i), err
+
+	case *compile.DoubleSpec:
+		switch f := v.(type) {
+		case float64:
+			return wire.NewValueDouble(f), nil
+		case int:
+			return wire.NewValueDouble(float64(f)), nil
+		}
+		return wire.Value{}, typeError("double", v)
+
+	case *compile.StringSpec:
+		str, ok := v.(string)
+		if !ok {
+			return wire.Value{}, typeError("string", v)
+		}
+		return wire.NewValueString(str), nil
+
+	case *compile.BinarySpec:
+		str, ok := v.(string)
+		if !ok {
+			return wire.Value{}, typeError("binary", v)
+		}
+		return wire.NewValueBinary([]byte(str)), nil
+
+	case *compile.UUIDSpec:
+		str, ok := v.(string)
+		if !ok {
+			return wire.Value{}, typeError("uuid", v)
+		}
+		u, err := thriftuuid.Parse(str)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueBinary(u.Bytes()), nil
+
+	case *compile.EnumSpec:
+		return enumValue(s, v)
+
+	case *compile.StructSpec:
+		return structValue(s.Fields, v)
+
+	case *compile.ListSpec:
+		items, err := listItems(s.ValueSpec, v)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), nil
+
+	case *compile.SetSpec:
+		items, err := listItems(s.ValueSpec, v)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), nil
+
+	case *compile.MapSpec:
+		items, err := mapItems(s, v)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueMap(wire.MapItemListFromSlice(
+			s.KeySpec.TypeCode(), s.ValueSpec.TypeCode(), items)), nil
+
+	default:
+		return wire.Value{}, fmt.Errorf("unsupported type %v", spec.ThriftName())
+	}
+}
+
+// structValue converts an object into a struct with the given fields.
+func structValue(fields compile.FieldGroup, v interface{}) (wire.Value, error) {
+	obj, err := toObject(v)
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out []wire.Field
+	for _, name := range names {
+		spec, err := fields.FindByName(name)
+		if err != nil {
+			return wire.Value{}, fmt.Errorf("unknown field %q", name)
+		}
+
+		value, err := toWire(spec.Type, obj[name])
+		if err != nil {
+			return wire.Value{}, fmt.Errorf("field %q: %v", name, err)
+		}
+		out = append(out, wire.Field{ID: spec.ID, Value: value})
+	}
+
+	for _, f := range fields {
+		if _, ok := obj[f.Name]; f.Required && !ok {
+			return wire.Value{}, fmt.Errorf("required field %q is missing", f.Name)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return wire.NewValueStruct(wire.Struct{Fields: out}), nil
+}
+
+func enumValue(spec *compile.EnumSpec, v interface{}) (wire.Value, error) {
+	if name, ok := v.(string); ok {
+		item, ok := spec.LookupItem(name)
+		if !ok {
+			return wire.Value{}, fmt.Errorf("unknown item %q for enum %v", name, spec.Name)
+		}
+		return wire.NewValueI32(item.Value), nil
+	}
+
+	i, err := toInt(v, 32)
+	if err != nil {
+		return wire.Value{}, typeError("enum "+spec.Name, v)
+	}
+	return wire.NewValueI32(int32(i)), nil
+}
+
+func listItems(spec compile.TypeSpec, v interface{}) ([]wire.Value, error) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, typeError("list", v)
+	}
+
+	items := make([]wire.Value, len(list))
+	for i, x := range list {
+		item, err := toWire(spec, x)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %v", i, err)
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func mapItems(spec *compile.MapSpec, v interface{}) ([]wire.MapItem, error) {
+	// Maps with keys that cannot be written as strings are given as lists
+	// of {key, value} objects.
+	if list, ok := v.([]interface{}); ok {
+		items := make([]wire.MapItem, len(list))
+		for i, x := range list {
+			obj, err := toObject(x)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %v", i, err)
+			}
+
+			k, hasKey := obj["key"]
+			v, hasValue := obj["value"]
+			if !hasKey || !hasValue || len(obj) != 2 {
+				return nil, fmt.Errorf("item %d: expected an object with only a key and a value", i)
+			}
+
+			items[i], err = mapItem(spec, k, v)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %v", i, err)
+			}
+		}
+		return items, nil
+	}
+
+	obj, err := toObject(v)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	items := make([]wire.MapItem, 0, len(obj))
+	for _, k := range keys {
+		item, err := mapItem(spec, stringKey(spec.KeySpec, k), obj[k])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func mapItem(spec *compile.MapSpec, k, v interface{}) (wire.MapItem, error) {
+	key, err := toWire(spec.KeySpec, k)
+	if err != nil {
+		return wire.MapItem{}, fmt.Errorf("key %v: %v", k, err)
+	}
+
+	value, err := toWire(spec.ValueSpec, v)
+	if err != nil {
+		return wire.MapItem{}, fmt.Errorf("value for key %v: %v", k, err)
+	}
+	return wire.MapItem{Key: key, Value: value}, nil
+}
+
+// stringKey converts the key of an object into a value of the given type, so
+// that objects may be used for maps with numeric keys.
+func stringKey(spec compile.TypeSpec, k string) interface{} {
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
+		if i, err := strconv.ParseInt(k, 10, 64); err == nil {
+			return i
+		}
+	case *compile.DoubleSpec:
+		if f, err := strconv.ParseFloat(k, 64); err == nil {
+			return f
+		}
+	case *compile.BoolSpec:
+		if b, err := strconv.ParseBool(k); err == nil {
+			return b
+		}
+	}
+	return k
+}
+
+// toObject converts a decoded YAML or JSON mapping into a map keyed by
+// strings.
+func toObject(v interface{}) (map[string]interface{}, error) {
+	switch obj := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return obj, nil
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			out[fmt.Sprint(k)] = v
+		}
+		return out, nil
+	default:
+		return nil, typeError("object", v)
+	}
+}
+
+// toInt converts a decoded number into an integer that fits into the given
+// number of bits.
+func toInt(v interface{}, bits int) (int64, error) {
+	var i int64
+	switch n := v.(type) {
+	case int:
+		i = int64(n)
+	case int64:
+		i = n
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, fmt.Errorf("%v overflows i%d", n, bits)
+		}
+		i = int64(n)
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("%v is not an integer", n)
+		}
+		i = int64(n)
+	default:
+		return 0, typeError(fmt.Sprintf("i%d", bits), v)
+	}
+
+	if bits < 64 {
+		limit := int64(1) << (bits - 1)
+		if i < -limit || i >= limit {
+			return 0, fmt.Errorf("%v overflows i%d", i, bits)
+		}
+	}
+	return i, nil
+}
+
+func typeError(want string, got interface{}) error {
+	return fmt.Errorf("expected %v, got %T", want, got)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mockserver
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestToWire(t *testing.T) {
+	m, err := compile.Compile("testdata/kv.thrift")
+	require.NoError(t, err)
+
+	item := m.Types["Item"]
+	color := m.Types["Color"]
+	point := m.Types["Point"]
+
+	tests := []struct {
+		desc    string
+		spec    compile.TypeSpec
+		give    string // YAML
+		want    wire.Value
+		wantErr string
+	}{
+		{
+			desc: "bool",
+			spec: &compile.BoolSpec{},
+			give: "true",
+			want: wire.NewValueBool(true),
+		},
+		{
+			desc: "i8",
+			spec: &compile.I8Spec{},
+			give: "-128",
+			want: wire.NewValueI8(-128),
+		},
+		{
+			desc:    "i8 overflow",
+			spec:    &compile.I8Spec{},
+			give:    "128",
+			wantErr: "128 overflows i8",
+		},
+		{
+			desc: "i64",
+			spec: &compile.I64Spec{},
+			give: "9223372036854775807",
+			want: wire.NewValueI64(9223372036854775807),
+		},
+		{
+			desc: "double from integer",
+			spec: &compile.DoubleSpec{},
+			give: "2",
+			want: wire.NewValueDouble(2),
+		},
+		{
+			desc:    "string mismatch",
+			spec:    &compile.StringSpec{},
+			give:    "[1]",
+			wantErr: "expected string, got []interface {}",
+		},
+		{
+			desc: "binary",
+			spec: &compile.BinarySpec{},
+			give: "!!binary aGVsbG8=",
+			want: wire.NewValueBinary([]byte("hello")),
+		},
+		{
+			desc: "enum name",
+			spec: color,
+			give: "GREEN",
+			want: wire.NewValueI32(2),
+		},
+		{
+			desc: "enum number",
+			spec: color,
+			give: "1",
+			want: wire.NewValueI32(1),
+		},
+		{
+			desc:    "unknown enum item",
+			spec:    color,
+			give:    "BLUE",
+			wantErr: `unknown item "BLUE" for enum Color`,
+		},
+		{
+			desc: "struct",
+			spec: item,
+			give: `{color: RED, key: foo}`,
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+				{ID: 3, Value: wire.NewValueI32(1)},
+			}}),
+		},
+		{
+			desc:    "missing required field",
+			spec:    item,
+			give:    `{value: bar}`,
+			wantErr: `required field "key" is missing`,
+		},
+		{
+			desc:    "unknown field",
+			spec:    item,
+			give:    `{key: foo, size: 1}`,
+			wantErr: `unknown field "size"`,
+		},
+		{
+			desc: "list",
+			spec: &compile.ListSpec{ValueSpec: &compile.StringSpec{}},
+			give: `[a, b]`,
+			want: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+				wire.NewValueString("a"),
+				wire.NewValueString("b"),
+			})),
+		},
+		{
+			desc: "set",
+			spec: &compile.SetSpec{ValueSpec: &compile.I32Spec{}},
+			give: `[1]`,
+			want: wire.NewValueSet(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+				wire.NewValueI32(1),
+			})),
+		},
+		{
+			desc: "map with integer keys",
+			spec: &compile.MapSpec{KeySpec: &compile.I32Spec{}, ValueSpec: &compile.StringSpec{}},
+			give: `{"1": a, 2: b}`,
+			want: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI32, wire.TBinary, []wire.MapItem{
+				{Key: wire.NewValueI32(1), Value: wire.NewValueString("a")},
+				{Key: wire.NewValueI32(2), Value: wire.NewValueString("b")},
+			})),
+		},
+		{
+			desc: "map with struct keys",
+			spec: &compile.MapSpec{KeySpec: point, ValueSpec: &compile.StringSpec{}},
+			give: `[{key: {x: 1, y: 2}, value: a}]`,
+			want: wire.NewValueMap(wire.MapItemListFromSlice(wire.TStruct, wire.TBinary, []wire.MapItem{
+				{
+					Key: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueI32(1)},
+						{ID: 2, Value: wire.NewValueI32(2)},
+					}}),
+					Value: wire.NewValueString("a"),
+				},
+			})),
+		},
+		{
+			desc:    "map item without value",
+			spec:    &compile.MapSpec{KeySpec: point, ValueSpec: &compile.StringSpec{}},
+			give:    `[{key: {x: 1, y: 2}}]`,
+			wantErr: "item 0: expected an object with only a key and a value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var give interface{}
+			require.NoError(t, yaml.Unmarshal([]byte(tt.give), &give))
+
+			got, err := toWire(tt.spec, give)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(tt.want, got), "expected %v, got %v", tt.want, got)
+		})
+	}
+}
@@ -42,3 +42,7 @@ type client struct {
 func (c client) Send(name string, reqValue wire.Value) (wire.Value, error) {
 	return c.c.Send(c.name+":"+name, reqValue)
 }
+
+func (c client) SendOneWay(name string, reqValue wire.Value) error {
+	return c.c.SendOneWay(c.name+":"+name, reqValue)
+}
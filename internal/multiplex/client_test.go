@@ -22,3 +22,15 @@ func TestClient(t *testing.T) {
 	_, err := client.Send("hello", wire.NewValueStruct(wire.Struct{}))
 	assert.NoError(t, err)
 }
+
+func TestClientOneWay(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockClient := envelopetest.NewMockClient(mockCtrl)
+	mockClient.EXPECT().SendOneWay("Foo:hello", wire.NewValueStruct(wire.Struct{})).
+		Return(nil)
+
+	client := NewClient("Foo", mockClient)
+	assert.NoError(t, client.SendOneWay("hello", wire.NewValueStruct(wire.Struct{})))
+}
@@ -25,6 +25,7 @@
 package pluginapigen
 
 import (
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -50,6 +51,10 @@ func (handle) ServiceGenerator() intplugin.ServiceGenerator {
 	return sgen{}
 }
 
+func (handle) NameResolver() intplugin.NameResolver {
+	return nil
+}
+
 type sgen struct{}
 
 func (sgen) Handle() intplugin.Handle {
@@ -95,6 +100,20 @@ func (sgen) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceRespo
 		if err != nil {
 			return nil, err
 		}
+
+		// Mocks are placed in a separate package so that the generated
+		// package does not depend on gomock.
+		mockPkg := path.Base(module.ImportPath) + "test"
+		mockOpts := append([]plugin.TemplateOption{
+			plugin.GoFileImportPath(path.Join(module.ImportPath, mockPkg)),
+		}, templateOptions...)
+
+		mockPath := filepath.Join(module.Directory, mockPkg, strings.ToLower(service.Name)+".go")
+		files[mockPath], err = plugin.GoFileFromTemplate(
+			mockPath, mockTemplate, templateData, mockOpts...)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &api.GenerateServiceResponse{Files: files}, nil
 }
@@ -105,9 +124,22 @@ func getService(req *api.GenerateServiceRequest, id api.ServiceID) *api.Service
 	return req.Services[id]
 }
 
+// allFunctions returns the functions of the given service, followed by those
+// inherited from its parents.
+func allFunctions(req *api.GenerateServiceRequest, service *api.Service) []*api.Function {
+	var funcs []*api.Function
+	for s := service; ; s = req.Services[*s.ParentID] {
+		funcs = append(funcs, s.Functions...)
+		if s.ParentID == nil {
+			return funcs
+		}
+	}
+}
+
 var templateOptions = []plugin.TemplateOption{
 	plugin.TemplateFunc("basename", filepath.Base),
 	plugin.TemplateFunc("getService", getService),
+	plugin.TemplateFunc("allFunctions", allFunctions),
 }
 
 const interfaceTemplate = `
@@ -169,10 +201,10 @@ func New<.Service.Name>Client(c <$envelope>.Client) <.Service.Name> {
 		<if .Service.ParentID>
 			<$parent := getService .Request .Service.ParentID>
 			<if eq $parent.ModuleID .Service.ModuleID>
-				<$parent.Name>: New<$parent.Name>Client(t),
+				<$parent.Name>: New<$parent.Name>Client(c),
 			<else>
 				<$parentModule := index .Request.Modules $parent.ModuleID>
-				<$parent.Name>: <import $parentModule>.New<$parent.Name>Client(t),
+				<$parent.Name>: <import $parentModule.ImportPath>.New<$parent.Name>Client(c),
 			<end>
 		<end>
 	}
@@ -194,6 +226,10 @@ func (c *<$client>) <.Name>(<range .Arguments>
 		return
 	}
 
+	<if .OneWay>
+		err = c.client.SendOneWay("<.ThriftName>", body)
+		return
+	<else>
 	body, err = c.client.Send("<.ThriftName>", body)
 	if err != nil {
 		return
@@ -206,6 +242,7 @@ func (c *<$client>) <.Name>(<range .Arguments>
 
 	<if .ReturnType>success, <end>err = <$prefix>Helper.UnwrapResponse(&result)
 	return
+	<- end>
 }
 <end>
 `
@@ -265,6 +302,11 @@ func (h <$Handler>) Handle(name string, reqValue <$wire>.Value) (<$wire>.Value,
 					return <$wire>.Value{}, err
 				}
 
+				<if .OneWay>
+					// Oneway requests never receive a response.
+					err := h.impl.<.Name>(<range .Arguments>args.<.Name>, <end>)
+					return <$wire>.Value{}, err
+				<else>
 				result, err := <$prefix>Helper.WrapResponse(
 					h.impl.<.Name>(<range .Arguments>args.<.Name>, <end>),
 				)
@@ -273,6 +315,7 @@ func (h <$Handler>) Handle(name string, reqValue <$wire>.Value) (<$wire>.Value,
 				}
 
 				return result.ToWire()
+				<- end>
 		<end>
 		default:
 			<if .Service.ParentID>
@@ -283,3 +326,63 @@ func (h <$Handler>) Handle(name string, reqValue <$wire>.Value) (<$wire>.Value,
 	}
 }
 `
+
+const mockTemplate = `
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+<$module := index .Request.Modules .Service.ModuleID>
+// Package <basename $module.ImportPath>test is a generated GoMock package.
+package <basename $module.ImportPath>test
+
+<$gomock := import "github.com/golang/mock/gomock">
+<$reflect := import "reflect">
+
+<$mock := printf "Mock%s" .Service.Name>
+<$recorder := printf "Mock%sMockRecorder" .Service.Name>
+
+// <$mock> is a mock of <.Service.Name> interface.
+type <$mock> struct {
+	ctrl     *<$gomock>.Controller
+	recorder *<$recorder>
+}
+
+// <$recorder> is the mock recorder for <$mock>.
+type <$recorder> struct {
+	mock *<$mock>
+}
+
+// New<$mock> creates a new mock instance.
+func New<$mock>(ctrl *<$gomock>.Controller) *<$mock> {
+	mock := &<$mock>{ctrl: ctrl}
+	mock.recorder = &<$recorder>{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *<$mock>) EXPECT() *<$recorder> {
+	return m.recorder
+}
+
+<range allFunctions .Request .Service>
+// <.Name> mocks base method.
+func (m *<$mock>) <.Name>(<range $i, $a := .Arguments>arg<$i> <formatType $a.Type>, <end>) <if .ReturnType>(<formatType .ReturnType>, error)<else>error<end> {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "<.Name>", <range $i, $a := .Arguments>arg<$i>, <end>)
+	<if .ReturnType ->
+	ret0, _ := ret[0].(<formatType .ReturnType>)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+	<- else ->
+	ret0, _ := ret[0].(error)
+	return ret0
+	<- end>
+}
+
+// <.Name> indicates an expected call of <.Name>.
+func (mr *<$recorder>) <.Name>(<range $i, $a := .Arguments>arg<$i> interface{}, <end>) *<$gomock>.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "<.Name>", <$reflect>.TypeOf((*<$mock>)(nil).<.Name>), <range $i, $a := .Arguments>arg<$i>, <end>)
+}
+<end>
+`
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pluginapigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
+)
+
+func TestGenerateOneWay(t *testing.T) {
+	req := &api.GenerateServiceRequest{
+		RootServices: []api.ServiceID{1},
+		Services: map[api.ServiceID]*api.Service{
+			1: {
+				Name:       "Cache",
+				ThriftName: "Cache",
+				ModuleID:   1,
+				Functions: []*api.Function{
+					{
+						Name:       "Clear",
+						ThriftName: "clear",
+						OneWay:     ptr.Bool(true),
+						Arguments:  []*api.Argument{},
+					},
+					{
+						Name:       "Size",
+						ThriftName: "size",
+						ReturnType: &api.Type{SimpleType: api.SimpleTypeInt32.Ptr()},
+						Arguments:  []*api.Argument{},
+					},
+				},
+			},
+		},
+		Modules: map[api.ModuleID]*api.Module{
+			1: {
+				ImportPath: "go.uber.org/thriftrw/cache",
+				Directory:  "cache",
+			},
+		},
+	}
+
+	res, err := sgen{}.Generate(req)
+	require.NoError(t, err)
+
+	client := string(res.Files["cache/cache_client.go"])
+	assert.Contains(t, client, `err = c.client.SendOneWay("clear", body)`)
+	assert.NotContains(t, client, "Cache_Clear_Result",
+		"oneway functions must not expect a result")
+	assert.Contains(t, client, `body, err = c.client.Send("size", body)`)
+
+	handler := string(res.Files["cache/cache_handler.go"])
+	assert.Contains(t, handler, "err := h.impl.Clear()")
+	assert.NotContains(t, handler, "Cache_Clear_Helper.WrapResponse",
+		"oneway functions must not produce a response")
+	assert.Contains(t, handler, "Cache_Size_Helper.WrapResponse")
+}
+
+func TestGenerateMocks(t *testing.T) {
+	req := &api.GenerateServiceRequest{
+		RootServices: []api.ServiceID{1},
+		Services: map[api.ServiceID]*api.Service{
+			1: {
+				Name:       "Cache",
+				ThriftName: "Cache",
+				ModuleID:   1,
+				ParentID:   (*api.ServiceID)(ptr.Int32(2)),
+				Functions: []*api.Function{
+					{
+						Name:       "Clear",
+						ThriftName: "clear",
+						OneWay:     ptr.Bool(true),
+						Arguments:  []*api.Argument{},
+					},
+					{
+						Name:       "Get",
+						ThriftName: "get",
+						Arguments: []*api.Argument{
+							{
+								Name: "Key",
+								Type: &api.Type{PointerType: &api.Type{SimpleType: api.SimpleTypeString.Ptr()}},
+							},
+						},
+						ReturnType: &api.Type{SliceType: &api.Type{SimpleType: api.SimpleTypeByte.Ptr()}},
+					},
+				},
+			},
+			2: {
+				Name:       "Base",
+				ThriftName: "Base",
+				ModuleID:   2,
+				Functions: []*api.Function{
+					{
+						Name:       "Healthy",
+						ThriftName: "healthy",
+						ReturnType: &api.Type{SimpleType: api.SimpleTypeBool.Ptr()},
+						Arguments:  []*api.Argument{},
+					},
+				},
+			},
+		},
+		Modules: map[api.ModuleID]*api.Module{
+			1: {
+				ImportPath: "go.uber.org/thriftrw/cache",
+				Directory:  "cache",
+			},
+			2: {
+				ImportPath: "go.uber.org/thriftrw/base",
+				Directory:  "base",
+			},
+		},
+	}
+
+	res, err := sgen{}.Generate(req)
+	require.NoError(t, err)
+
+	mock := string(res.Files["cache/cachetest/cache.go"])
+	assert.Contains(t, mock, "package cachetest")
+	assert.Contains(t, mock, "func NewMockCache(ctrl *gomock.Controller) *MockCache {")
+
+	assert.Contains(t, mock, "func (m *MockCache) Get(arg0 *string) ([]byte, error) {")
+	assert.Contains(t, mock, `ret := m.ctrl.Call(m, "Get", arg0)`)
+	assert.Contains(t, mock, "func (mr *MockCacheMockRecorder) Get(arg0 interface{}) *gomock.Call {")
+
+	assert.Contains(t, mock, "func (m *MockCache) Clear() error {")
+	assert.Contains(t, mock, "func (m *MockCache) Healthy() (bool, error) {",
+		"inherited functions must be mocked")
+
+	client := string(res.Files["cache/cache_client.go"])
+	assert.Contains(t, client, "Base: base.NewBaseClient(c),")
+}
@@ -39,6 +39,10 @@ func (emptyHandle) ServiceGenerator() ServiceGenerator {
 	return EmptyServiceGenerator
 }
 
+func (emptyHandle) NameResolver() NameResolver {
+	return EmptyNameResolver
+}
+
 // EmptyServiceGenerator is a no-op service generator that does not generate
 // any new files.
 var EmptyServiceGenerator ServiceGenerator = emptyServiceGenerator{}
@@ -52,3 +56,17 @@ func (emptyServiceGenerator) Handle() Handle {
 func (emptyServiceGenerator) Generate(Request *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
 	return &api.GenerateServiceResponse{Files: make(map[string][]byte)}, nil
 }
+
+// EmptyNameResolver is a no-op name resolver that does not change any
+// names.
+var EmptyNameResolver NameResolver = emptyNameResolver{}
+
+type emptyNameResolver struct{}
+
+func (emptyNameResolver) Handle() Handle {
+	return EmptyHandle
+}
+
+func (emptyNameResolver) ResolveNames(*api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	return &api.ResolveNamesResponse{}, nil
+}
@@ -22,6 +22,7 @@ package plugin
 
 import (
 	"fmt"
+	"time"
 )
 
 type errHandshakeFailed struct {
@@ -48,3 +49,13 @@ type errAPIVersionMismatch struct {
 func (e errAPIVersionMismatch) Error() string {
 	return fmt.Sprintf("plugin API version mismatch: expected %v but got %v", e.Want, e.Got)
 }
+
+type errTimedOut struct {
+	Name    string
+	Timeout time.Duration
+	Reason  error
+}
+
+func (e errTimedOut) Error() string {
+	return fmt.Sprintf("plugin %q was killed after running for longer than %v: %v", e.Name, e.Timeout, e.Reason)
+}
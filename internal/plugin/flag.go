@@ -25,6 +25,7 @@ import (
 	"os"
 	"os/exec"
 	"sync"
+	"time"
 
 	"go.uber.org/thriftrw/internal/concurrent"
 	"go.uber.org/thriftrw/internal/process"
@@ -47,8 +48,9 @@ const _pluginExecPrefix = "thriftrw-plugin-"
 //
 // Will pass the arguments "-a --bc" to the executable "thriftrw-plugin-foo".
 type Flag struct {
-	Name    string    // Name of the plugin
-	Command *exec.Cmd // Command specification
+	Name    string        // Name of the plugin
+	Command *exec.Cmd     // Command specification
+	Timeout time.Duration // Time after which the plugin is killed, if any
 }
 
 // Handle gets a Handle to this plugin specification.
@@ -60,14 +62,22 @@ func (f *Flag) Handle() (Handle, error) {
 		return nil, fmt.Errorf("failed to open plugin %q: %v", f.Name, err)
 	}
 
+	var d *deadline
+	if f.Timeout > 0 {
+		d = startDeadline(f.Command.Process, f.Timeout)
+	}
+
 	handle, err := NewTransportHandle(f.Name, transport)
 	if err != nil {
 		return nil, multierr.Combine(
-			fmt.Errorf("failed to open plugin %q: %v", f.Name, err),
+			fmt.Errorf("failed to open plugin %q: %v", f.Name, d.Wrap(f.Name, err)),
 			transport.Close(),
 		)
 	}
 
+	if d != nil {
+		handle = &deadlineHandle{Handle: handle, name: f.Name, deadline: d}
+	}
 	return handle, nil
 }
 
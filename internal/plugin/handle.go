@@ -39,6 +39,13 @@ type Handle interface {
 	// Note that the ServiceGenerator is valid only as long as Close is not
 	// called on the Handle.
 	ServiceGenerator() ServiceGenerator
+
+	// NameResolver returns a NameResolver for this plugin or nil if this
+	// plugin does not implement that feature.
+	//
+	// Note that the NameResolver is valid only as long as Close is not
+	// called on the Handle.
+	NameResolver() NameResolver
 }
 
 // ServiceGenerator generates files for Thrift services.
@@ -48,3 +55,11 @@ type ServiceGenerator interface {
 	// Handle returns the Handle that owns this ServiceGenerator.
 	Handle() Handle
 }
+
+// NameResolver overrides the Go names chosen for Thrift entities.
+type NameResolver interface {
+	api.NameResolver
+
+	// Handle returns the Handle that owns this NameResolver.
+	Handle() Handle
+}
@@ -19,7 +19,7 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
-// Source: go.uber.org/thriftrw/internal/plugin (interfaces: Handle,ServiceGenerator)
+// Source: go.uber.org/thriftrw/internal/plugin (interfaces: Handle,ServiceGenerator,NameResolver)
 
 // Package handletest is a generated GoMock package.
 package handletest
@@ -83,6 +83,20 @@ func (mr *MockHandleMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockHandle)(nil).Name))
 }
 
+// NameResolver mocks base method.
+func (m *MockHandle) NameResolver() plugin.NameResolver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NameResolver")
+	ret0, _ := ret[0].(plugin.NameResolver)
+	return ret0
+}
+
+// NameResolver indicates an expected call of NameResolver.
+func (mr *MockHandleMockRecorder) NameResolver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameResolver", reflect.TypeOf((*MockHandle)(nil).NameResolver))
+}
+
 // ServiceGenerator mocks base method.
 func (m *MockHandle) ServiceGenerator() plugin.ServiceGenerator {
 	m.ctrl.T.Helper()
@@ -148,3 +162,55 @@ func (mr *MockServiceGeneratorMockRecorder) Handle() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockServiceGenerator)(nil).Handle))
 }
+
+// MockNameResolver is a mock of NameResolver interface.
+type MockNameResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockNameResolverMockRecorder
+}
+
+// MockNameResolverMockRecorder is the mock recorder for MockNameResolver.
+type MockNameResolverMockRecorder struct {
+	mock *MockNameResolver
+}
+
+// NewMockNameResolver creates a new mock instance.
+func NewMockNameResolver(ctrl *gomock.Controller) *MockNameResolver {
+	mock := &MockNameResolver{ctrl: ctrl}
+	mock.recorder = &MockNameResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNameResolver) EXPECT() *MockNameResolverMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockNameResolver) Handle() plugin.Handle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(plugin.Handle)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockNameResolverMockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockNameResolver)(nil).Handle))
+}
+
+// ResolveNames mocks base method.
+func (m *MockNameResolver) ResolveNames(arg0 *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveNames", arg0)
+	ret0, _ := ret[0].(*api.ResolveNamesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveNames indicates an expected call of ResolveNames.
+func (mr *MockNameResolverMockRecorder) ResolveNames(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveNames", reflect.TypeOf((*MockNameResolver)(nil).ResolveNames), arg0)
+}
@@ -61,6 +61,18 @@ func (mh MultiHandle) ServiceGenerator() ServiceGenerator {
 	return msg
 }
 
+// NameResolver returns a NameResolver which passes names through the
+// NameResolvers of all plugins associated with this MultiHandle in order.
+func (mh MultiHandle) NameResolver() NameResolver {
+	mnr := make(MultiNameResolver, 0, len(mh))
+	for _, h := range mh {
+		if nr := h.NameResolver(); nr != nil {
+			mnr = append(mnr, nr)
+		}
+	}
+	return mnr
+}
+
 // MultiServiceGenerator wraps a collection of ServiceGenerators into a single
 // ServiceGenerator.
 type MultiServiceGenerator []ServiceGenerator
@@ -110,3 +122,53 @@ func (msg MultiServiceGenerator) Generate(req *api.GenerateServiceRequest) (*api
 
 	return &api.GenerateServiceResponse{Files: files}, err
 }
+
+// MultiNameResolver wraps a collection of NameResolvers into a single
+// NameResolver.
+type MultiNameResolver []NameResolver
+
+// Handle returns a reference to the Handle that owns this NameResolver.
+func (mnr MultiNameResolver) Handle() Handle {
+	mh := make(MultiHandle, len(mnr))
+	for i, nr := range mnr {
+		mh[i] = nr.Handle()
+	}
+	return mh
+}
+
+// ResolveNames calls the name resolvers associated with this plugin in
+// order. Each one sees the names chosen by those before it, and the names
+// chosen by the last one win.
+func (mnr MultiNameResolver) ResolveNames(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	entities := make([]*api.Entity, len(req.Entities))
+	for i, e := range req.Entities {
+		copied := *e
+		entities[i] = &copied
+	}
+
+	var changed bool
+	for _, nr := range mnr {
+		res, err := nr.ResolveNames(&api.ResolveNamesRequest{Entities: entities})
+		if err != nil {
+			return nil, err
+		}
+		for i, name := range res.Names {
+			if name != "" && name != entities[i].GoName {
+				entities[i].GoName = name
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return &api.ResolveNamesResponse{}, nil
+	}
+
+	names := make([]string, len(entities))
+	for i, e := range entities {
+		if e.GoName != req.Entities[i].GoName {
+			names[i] = e.GoName
+		}
+	}
+	return &api.ResolveNamesResponse{Names: names}, nil
+}
@@ -263,3 +263,66 @@ func TestMultiServiceGeneratorGenerateNil(t *testing.T) {
 	_, err := msg.Generate(&api.GenerateServiceRequest{})
 	assert.NoError(t, err)
 }
+
+func TestMultiHandleNameResolver(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	withResolver := handletest.NewMockHandle(mockCtrl)
+	withResolver.EXPECT().NameResolver().Return(handletest.NewMockNameResolver(mockCtrl))
+
+	withoutResolver := handletest.NewMockHandle(mockCtrl)
+	withoutResolver.EXPECT().NameResolver().Return(nil)
+
+	mnr := MultiHandle{withResolver, withoutResolver}.NameResolver()
+	assert.Len(t, mnr, 1)
+}
+
+func TestMultiNameResolverResolveNames(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	req := &api.ResolveNamesRequest{
+		Entities: []*api.Entity{
+			{Kind: api.EntityKindType, ThriftName: "User", GoName: "User"},
+			{Kind: api.EntityKindType, ThriftName: "Role", GoName: "Role"},
+			{Kind: api.EntityKindType, ThriftName: "Email", GoName: "Email"},
+		},
+	}
+
+	first := handletest.NewMockNameResolver(mockCtrl)
+	first.EXPECT().ResolveNames(gomock.Any()).
+		DoAndReturn(func(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+			return &api.ResolveNamesResponse{Names: []string{"Account", "Permission", ""}}, nil
+		})
+
+	second := handletest.NewMockNameResolver(mockCtrl)
+	second.EXPECT().ResolveNames(gomock.Any()).
+		DoAndReturn(func(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+			// Names chosen by earlier resolvers are visible.
+			assert.Equal(t, "Account", req.Entities[0].GoName)
+			return &api.ResolveNamesResponse{Names: []string{"Member", "Role", ""}}, nil
+		})
+
+	empty := handletest.NewMockNameResolver(mockCtrl)
+	empty.EXPECT().ResolveNames(gomock.Any()).Return(&api.ResolveNamesResponse{}, nil)
+
+	res, err := MultiNameResolver{first, second, empty}.ResolveNames(req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Member", "", ""}, res.Names)
+	assert.Equal(t, "User", req.Entities[0].GoName, "request must not be modified")
+
+	t.Run("error", func(t *testing.T) {
+		failing := handletest.NewMockNameResolver(mockCtrl)
+		failing.EXPECT().ResolveNames(gomock.Any()).Return(nil, errors.New("great sadness"))
+
+		_, err := MultiNameResolver{failing}.ResolveNames(req)
+		assert.EqualError(t, err, "great sadness")
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		res, err := MultiNameResolver(nil).ResolveNames(req)
+		require.NoError(t, err)
+		assert.Empty(t, res.Names)
+	})
+}
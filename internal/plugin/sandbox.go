@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+// SandboxCommand is the hidden thriftrw subcommand through which plugins
+// with resource limits are started. It applies the limits to itself and
+// then replaces itself with the plugin. See ExecSandboxed.
+const SandboxCommand = "plugin-sandbox"
+
+// Sandbox restricts the resources available to plugins and the environment
+// in which they run, for when plugins or the Thrift files given to them are
+// not trusted.
+type Sandbox struct {
+	// Timeout is the wall-clock time after which plugins are killed.
+	Timeout time.Duration
+
+	// CPUTime is the CPU time after which plugins are killed, and Memory
+	// is the number of bytes of address space that they may use. Only
+	// supported on Linux.
+	CPUTime time.Duration
+	Memory  uint64
+
+	// Isolate runs plugins with only the PATH environment variable and
+	// those named in Env, and without network access unless AllowNetwork
+	// is set. Network isolation is only supported on Linux, where it uses
+	// user and network namespaces.
+	Isolate      bool
+	Env          []string
+	AllowNetwork bool
+}
+
+func (s *Sandbox) hasLimits() bool {
+	return s.CPUTime > 0 || s.Memory > 0
+}
+
+// Sandbox runs all plugins in this list in the given sandbox.
+func (fs Flags) Sandbox(s Sandbox) error {
+	for i := range fs {
+		if err := fs[i].sandbox(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Flag) sandbox(s Sandbox) error {
+	f.Timeout = s.Timeout
+
+	cmd := f.Command
+	if s.hasLimits() {
+		exe, err := os.Executable()
+		if err != nil {
+			return fmt.Errorf("cannot limit resources of plugin %q: %v", f.Name, err)
+		}
+
+		args := []string{
+			SandboxCommand,
+			"-cpu-time=" + s.CPUTime.String(),
+			"-memory=" + strconv.FormatUint(s.Memory, 10),
+			"--",
+			f.Command.Path,
+		}
+		cmd = exec.Command(exe, append(args, f.Command.Args[1:]...)...)
+		cmd.Env = f.Command.Env
+		cmd.Stderr = f.Command.Stderr
+	}
+
+	if s.Isolate {
+		cmd.Env = isolatedEnv(s.Env)
+		if !s.AllowNetwork {
+			if err := isolateNetwork(cmd); err != nil {
+				return fmt.Errorf("cannot isolate plugin %q: %v", f.Name, err)
+			}
+		}
+	}
+
+	f.Command = cmd
+	return nil
+}
+
+// isolatedEnv returns the environment of isolated plugins: PATH and the
+// given variables, if they are set.
+func isolatedEnv(names []string) []string {
+	env := make([]string, 0, len(names)+1)
+	for _, name := range append([]string{"PATH"}, names...) {
+		if v, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+v)
+		}
+	}
+	return env
+}
+
+// ExecSandboxed implements SandboxCommand. Given the resource limits and the
+// command line of a plugin, it applies the limits to the current process
+// and replaces it with the plugin. It returns only if that failed.
+func ExecSandboxed(args []string) error {
+	flags := flag.NewFlagSet(SandboxCommand, flag.ContinueOnError)
+	cpuTime := flags.Duration("cpu-time", 0, "CPU time after which the plugin is killed")
+	memory := flags.Uint64("memory", 0, "bytes of address space available to the plugin")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	args = flags.Args()
+	if len(args) == 0 {
+		return errors.New("please provide the plugin to run")
+	}
+	return execWithLimits(args[0], args, *cpuTime, *memory)
+}
+
+// deadline kills a plugin if it runs for longer than its timeout.
+type deadline struct {
+	timeout time.Duration
+	timer   *time.Timer
+	expired *atomic.Bool
+}
+
+func startDeadline(p *os.Process, timeout time.Duration) *deadline {
+	d := &deadline{timeout: timeout, expired: atomic.NewBool(false)}
+	d.timer = time.AfterFunc(timeout, func() {
+		d.expired.Store(true)
+		_ = p.Kill()
+	})
+	return d
+}
+
+// Wrap annotates the given error, if any, with the timeout if the plugin
+// was killed because it expired.
+func (d *deadline) Wrap(name string, err error) error {
+	if d == nil || err == nil || !d.expired.Load() {
+		return err
+	}
+	return errTimedOut{Name: name, Timeout: d.timeout, Reason: err}
+}
+
+// deadlineHandle is a Handle to a plugin which is killed if it runs for
+// longer than its timeout.
+type deadlineHandle struct {
+	Handle
+
+	name     string
+	deadline *deadline
+}
+
+func (h *deadlineHandle) Close() error {
+	h.deadline.timer.Stop()
+	return h.deadline.Wrap(h.name, h.Handle.Close())
+}
+
+func (h *deadlineHandle) ServiceGenerator() ServiceGenerator {
+	sg := h.Handle.ServiceGenerator()
+	if sg == nil {
+		return nil
+	}
+	return &deadlineServiceGenerator{ServiceGenerator: sg, handle: h}
+}
+
+// deadlineServiceGenerator reports requests to a ServiceGenerator that
+// failed because its plugin was killed.
+type deadlineServiceGenerator struct {
+	ServiceGenerator
+
+	handle *deadlineHandle
+}
+
+func (sg *deadlineServiceGenerator) Handle() Handle {
+	return sg.handle
+}
+
+func (sg *deadlineServiceGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	res, err := sg.ServiceGenerator.Generate(req)
+	return res, sg.handle.deadline.Wrap(sg.handle.name, err)
+}
+
+func (h *deadlineHandle) NameResolver() NameResolver {
+	nr := h.Handle.NameResolver()
+	if nr == nil {
+		return nil
+	}
+	return &deadlineNameResolver{NameResolver: nr, handle: h}
+}
+
+// deadlineNameResolver reports requests to a NameResolver that failed
+// because its plugin was killed.
+type deadlineNameResolver struct {
+	NameResolver
+
+	handle *deadlineHandle
+}
+
+func (nr *deadlineNameResolver) Handle() Handle {
+	return nr.handle
+}
+
+func (nr *deadlineNameResolver) ResolveNames(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	res, err := nr.NameResolver.ResolveNames(req)
+	return res, nr.handle.deadline.Wrap(nr.handle.name, err)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	// Sandboxed plugins with resource limits are started through the
+	// current executable, which is the test binary here.
+	if len(os.Args) > 1 && os.Args[1] == SandboxCommand {
+		err := ExecSandboxed(os.Args[2:])
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
+func sandboxed(t *testing.T, s Sandbox, name string, args ...string) *exec.Cmd {
+	path, err := exec.LookPath(name)
+	require.NoError(t, err)
+
+	fs := Flags{{Name: name, Command: exec.Command(path, args...)}}
+	require.NoError(t, fs.Sandbox(s))
+	return fs[0].Command
+}
+
+func TestSandboxTimeout(t *testing.T) {
+	fs := Flags{{Name: "sleep", Command: exec.Command("sleep", "10")}}
+	require.NoError(t, fs.Sandbox(Sandbox{Timeout: 100 * time.Millisecond}))
+
+	start := time.Now()
+	_, err := fs.Handle()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`plugin "sleep" was killed after running for longer than 100ms`)
+	assert.True(t, time.Since(start) < 5*time.Second, "plugin must be killed")
+}
+
+func TestSandboxEnv(t *testing.T) {
+	t.Setenv("THRIFTRW_SANDBOX_PASS", "foo")
+	t.Setenv("THRIFTRW_SANDBOX_DROP", "bar")
+
+	t.Run("not isolated", func(t *testing.T) {
+		out, err := sandboxed(t, Sandbox{}, "env").Output()
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "THRIFTRW_SANDBOX_PASS=foo")
+		assert.Contains(t, string(out), "THRIFTRW_SANDBOX_DROP=bar")
+	})
+
+	t.Run("isolated", func(t *testing.T) {
+		s := Sandbox{
+			Isolate:      true,
+			Env:          []string{"THRIFTRW_SANDBOX_PASS", "THRIFTRW_SANDBOX_UNSET"},
+			AllowNetwork: true,
+		}
+		out, err := sandboxed(t, s, "env").Output()
+		require.NoError(t, err)
+
+		env := strings.Fields(string(out))
+		assert.ElementsMatch(t, []string{
+			"PATH=" + os.Getenv("PATH"),
+			"THRIFTRW_SANDBOX_PASS=foo",
+		}, env)
+	})
+}
+
+func TestSandboxLinux(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("resource limits and network isolation require Linux")
+	}
+
+	t.Run("network", func(t *testing.T) {
+		cmd := sandboxed(t, Sandbox{Isolate: true}, "cat", "/proc/net/dev")
+		out, err := cmd.Output()
+		if err != nil {
+			// User namespaces may be disabled on this system.
+			t.Skipf("cannot create network namespace: %v", err)
+		}
+
+		var ifaces []string
+		for _, line := range strings.Split(string(out), "\n")[2:] {
+			if name := strings.TrimSpace(strings.SplitN(line, ":", 2)[0]); name != "" {
+				ifaces = append(ifaces, name)
+			}
+		}
+		assert.Equal(t, []string{"lo"}, ifaces)
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		s := Sandbox{CPUTime: 1500 * time.Millisecond, Memory: 512 << 20}
+		out, err := sandboxed(t, s, "sh", "-c", "ulimit -t; ulimit -v").Output()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2", "524288"}, strings.Fields(string(out)))
+	})
+}
+
+func TestExecSandboxedErrors(t *testing.T) {
+	assert.EqualError(t, ExecSandboxed(nil), "please provide the plugin to run")
+	assert.Error(t, ExecSandboxed([]string{"-memory=lots", "--", "true"}))
+}
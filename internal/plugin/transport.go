@@ -157,3 +157,56 @@ func (sg *serviceGenerator) Generate(req *api.GenerateServiceRequest) (*api.Gene
 
 	return res, nil
 }
+
+func (h *transportHandle) NameResolver() NameResolver {
+	if !h.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", h.name))
+	}
+
+	if _, hasFeature := h.Features[api.FeatureNameResolver]; !hasFeature {
+		return nil
+	}
+
+	return &nameResolver{
+		handle:  h,
+		Running: h.Running,
+		NameResolver: api.NewNameResolverClient(multiplex.NewClient(
+			"NameResolver",
+			envelope.NewClient(_proto, h.Transport),
+		)),
+	}
+}
+
+// nameResolver is a NameResolver that validates the output of a
+// NameResolver.
+//
+// It also panics if a request is made to it after it has been closed.
+type nameResolver struct {
+	handle *transportHandle
+
+	NameResolver api.NameResolver
+	Running      *atomic.Bool
+}
+
+func (nr *nameResolver) Handle() Handle {
+	return nr.handle
+}
+
+func (nr *nameResolver) ResolveNames(req *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	name := nr.handle.name
+	if !nr.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", name))
+	}
+
+	res, err := nr.NameResolver.ResolveNames(req)
+	if err != nil {
+		return res, fmt.Errorf("plugin %q failed to resolve names: %v", name, err)
+	}
+
+	if len(res.Names) != 0 && len(res.Names) != len(req.Entities) {
+		return res, fmt.Errorf(
+			"plugin %q returned %d names for %d entities", name, len(res.Names), len(req.Entities))
+	}
+
+	return res, nil
+}
@@ -28,6 +28,7 @@ type fakePluginServer struct {
 	ClientTransport  envelope.Transport
 	Plugin           *plugintest.MockPlugin
 	ServiceGenerator *plugintest.MockServiceGenerator
+	NameResolver     *plugintest.MockNameResolver
 }
 
 func newFakePluginServer(mockCtrl *gomock.Controller) *fakePluginServer {
@@ -39,10 +40,12 @@ func newFakePluginServer(mockCtrl *gomock.Controller) *fakePluginServer {
 
 	mockPlugin := plugintest.NewMockPlugin(mockCtrl)
 	mockServiceGenerator := plugintest.NewMockServiceGenerator(mockCtrl)
+	mockNameResolver := plugintest.NewMockNameResolver(mockCtrl)
 
 	handler := multiplex.NewHandler()
 	handler.Put("Plugin", api.NewPluginHandler(mockPlugin))
 	handler.Put("ServiceGenerator", api.NewServiceGeneratorHandler(mockServiceGenerator))
+	handler.Put("NameResolver", api.NewNameResolverHandler(mockNameResolver))
 
 	done := make(chan error)
 	go func() {
@@ -59,6 +62,7 @@ func newFakePluginServer(mockCtrl *gomock.Controller) *fakePluginServer {
 		ClientTransport:  client,
 		Plugin:           mockPlugin,
 		ServiceGenerator: mockServiceGenerator,
+		NameResolver:     mockNameResolver,
 	}
 }
 
@@ -384,3 +388,114 @@ func TestServiceGeneratorGenerate(t *testing.T) {
 		}()
 	}
 }
+
+func TestTransportHandleNameResolver(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureServiceGenerator})
+	assert.Nil(t, handle.NameResolver(), "plugin without the feature must not have a NameResolver")
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+}
+
+func TestNameResolverResolveNames(t *testing.T) {
+	req := &api.ResolveNamesRequest{
+		Entities: []*api.Entity{
+			{
+				Kind:           api.EntityKindType,
+				ThriftName:     "User",
+				ThriftFilePath: "idl/users.thrift",
+				GoName:         "User",
+			},
+			{
+				Kind:           api.EntityKindField,
+				ThriftName:     "user_id",
+				Parent:         ptr.String("User"),
+				ThriftFilePath: "idl/users.thrift",
+				GoName:         "UserID",
+			},
+		},
+	}
+
+	tests := []struct {
+		desc         string
+		resolveRes   *api.ResolveNamesResponse
+		resolveError error
+
+		wantError string
+	}{
+		{
+			desc:       "success",
+			resolveRes: &api.ResolveNamesResponse{Names: []string{"Account", ""}},
+		},
+		{
+			desc:       "no names",
+			resolveRes: &api.ResolveNamesResponse{},
+		},
+		{
+			desc:       "wrong number of names",
+			resolveRes: &api.ResolveNamesResponse{Names: []string{"Account"}},
+			wantError:  `plugin "foo" returned 1 names for 2 entities`,
+		},
+		{
+			desc:         "call error",
+			resolveError: errors.New("great sadness"),
+			wantError: `plugin "foo" failed to resolve names: ` +
+				"TApplicationException{Message: great sadness, Type: INTERNAL_ERROR}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			server := newFakePluginServer(mockCtrl)
+			defer server.Close()
+
+			handle := server.Handshake(t, "foo", []api.Feature{api.FeatureNameResolver})
+			defer func() {
+				server.ExpectGoodbye()
+				require.NoError(t, handle.Close())
+			}()
+
+			server.NameResolver.EXPECT().ResolveNames(req).
+				Return(tt.resolveRes, tt.resolveError)
+
+			nr := handle.NameResolver()
+			require.NotNil(t, nr)
+			assert.Equal(t, handle, nr.Handle())
+
+			res, err := nr.ResolveNames(req)
+			if tt.wantError != "" {
+				assert.EqualError(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.resolveRes, res)
+		})
+	}
+}
+
+func TestNameResolverClosed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureNameResolver})
+	nr := handle.NameResolver()
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		nr.ResolveNames(&api.ResolveNamesRequest{})
+	})
+}
@@ -0,0 +1,445 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package preprocess expands templates and macros in Thrift files before
+// they are parsed, so that families of nearly identical definitions need not
+// be copied by hand.
+//
+// Directives are lines starting with "#@", which are comments to Thrift.
+//
+//	#@define NAME value
+//
+// Defines the macro NAME for the rest of the file. ${NAME} is replaced by
+// value outside directives. Macros provided by Config.Defines take
+// precedence over those defined in files.
+//
+//	#@template Name(Param, ...)
+//	...
+//	#@end
+//
+// Declares a template with the given parameters. The lines between the
+// directives are its body and are not part of the file.
+//
+//	#@expand Name(arg, ...)
+//
+// Expands the template with the given arguments. Copies of the body, with
+// ${Param} replaced by the arguments and other macros replaced by their
+// values, are added to the end of the file so that the lines of the file
+// keep their line numbers. Bodies may expand other templates.
+//
+//	#@include "./templates.thrift"
+//
+// Makes the templates and macros defined in another file, relative to this
+// one, available. Other contents of the included file are ignored.
+//
+// "$${" is replaced by a literal "${".
+package preprocess
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// directivePrefix starts lines which hold directives.
+const directivePrefix = "#@"
+
+var (
+	_identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	_call       = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*\((.*)\)$`)
+)
+
+// Config configures the preprocessor.
+type Config struct {
+	// Defines holds macros available to all files.
+	Defines map[string]string
+
+	// Read reads files included with the #@include directive. Files may
+	// not be included if this is nil.
+	Read func(path string) ([]byte, error)
+}
+
+// Expand expands the directives in src, the contents of the Thrift file at
+// the given path.
+func (c *Config) Expand(path string, src []byte) ([]byte, error) {
+	for name, value := range c.Defines {
+		if !_identifier.MatchString(name) {
+			return nil, fmt.Errorf("invalid macro name %q", name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("value of macro %q must not contain newlines", name)
+		}
+	}
+
+	f := c.newFile(path, map[string]struct{}{path: {}})
+	return f.process(src)
+}
+
+// file holds the state of a file being preprocessed.
+type file struct {
+	*Config
+
+	path      string
+	defines   map[string]string
+	templates map[string]*template
+
+	// including holds the files being included, to detect cycles.
+	including map[string]struct{}
+}
+
+// template is a template declared with #@template.
+type template struct {
+	Name   string
+	Params []string
+	Body   []string
+
+	// Line is the line on which the template was declared.
+	Line int
+}
+
+func (c *Config) newFile(path string, including map[string]struct{}) *file {
+	defines := make(map[string]string, len(c.Defines))
+	for name, value := range c.Defines {
+		defines[name] = value
+	}
+	return &file{
+		Config:    c,
+		path:      path,
+		defines:   defines,
+		templates: make(map[string]*template),
+		including: including,
+	}
+}
+
+// lineError is an error on a line of a file.
+type lineError struct {
+	Line   int
+	Reason error
+}
+
+func (e lineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Reason)
+}
+
+func (e lineError) Unwrap() error { return e.Reason }
+
+// process preprocesses the contents of the file. Lines holding directives
+// or template bodies are blanked out and expanded templates are added to
+// the end.
+func (f *file) process(src []byte) ([]byte, error) {
+	lines := strings.Split(string(src), "\n")
+
+	var (
+		out      bytes.Buffer
+		expanded bytes.Buffer
+		current  *template // template whose body is being read
+	)
+	for i, line := range lines {
+		lineno := i + 1
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+
+		text := strings.TrimSpace(line)
+		if !strings.HasPrefix(text, directivePrefix) {
+			if current != nil {
+				current.Body = append(current.Body, line)
+				continue
+			}
+
+			s, err := f.substitute(line, nil)
+			if err != nil {
+				return nil, lineError{Line: lineno, Reason: err}
+			}
+			out.WriteString(s)
+			continue
+		}
+
+		name, args := splitDirective(text)
+		if current != nil {
+			switch name {
+			case "end":
+				if args != "" {
+					return nil, lineError{Line: lineno, Reason: errors.New("#@end does not accept arguments")}
+				}
+				f.templates[current.Name] = current
+				current = nil
+			case "expand":
+				// Expanded along with the template.
+				current.Body = append(current.Body, line)
+			default:
+				return nil, lineError{
+					Line:   lineno,
+					Reason: fmt.Errorf("#@%v is not allowed inside template %q", name, current.Name),
+				}
+			}
+			continue
+		}
+
+		var err error
+		switch name {
+		case "define":
+			err = f.define(args)
+		case "include":
+			err = f.include(args)
+		case "template":
+			current, err = f.declare(args, lineno)
+		case "expand":
+			err = f.expand(&expanded, args, lineno, nil)
+		case "end":
+			err = errors.New("#@end without #@template")
+		default:
+			err = fmt.Errorf("unknown directive %q", directivePrefix+name)
+		}
+		if err != nil {
+			return nil, lineError{Line: lineno, Reason: err}
+		}
+	}
+
+	if current != nil {
+		return nil, lineError{
+			Line:   current.Line,
+			Reason: fmt.Errorf("template %q is missing #@end", current.Name),
+		}
+	}
+
+	out.Write(expanded.Bytes())
+	return out.Bytes(), nil
+}
+
+// splitDirective splits a line holding a directive into the name of the
+// directive and its arguments.
+func splitDirective(text string) (name, args string) {
+	text = strings.TrimPrefix(text, directivePrefix)
+	if i := strings.IndexAny(text, " \t"); i >= 0 {
+		return text[:i], strings.TrimSpace(text[i:])
+	}
+	return text, ""
+}
+
+func (f *file) define(args string) error {
+	name, value := args, ""
+	if i := strings.IndexAny(args, " \t"); i >= 0 {
+		name, value = args[:i], strings.TrimSpace(args[i:])
+	}
+	if !_identifier.MatchString(name) {
+		return fmt.Errorf("invalid macro name %q", name)
+	}
+
+	if _, ok := f.Defines[name]; ok {
+		// Provided macros take precedence.
+		return nil
+	}
+	if _, ok := f.defines[name]; ok {
+		return fmt.Errorf("macro %q is already defined", name)
+	}
+
+	value, err := f.substitute(value, nil)
+	if err != nil {
+		return err
+	}
+	f.defines[name] = value
+	return nil
+}
+
+func (f *file) include(args string) error {
+	rel, err := strconv.Unquote(args)
+	if err != nil {
+		return fmt.Errorf("#@include expects a quoted path: got %v", args)
+	}
+	if f.Read == nil {
+		return errors.New("#@include is not supported")
+	}
+
+	path := filepath.Join(filepath.Dir(f.path), rel)
+	if _, ok := f.including[path]; ok {
+		return fmt.Errorf("%q includes itself", rel)
+	}
+
+	src, err := f.Read(path)
+	if err != nil {
+		return err
+	}
+
+	including := make(map[string]struct{}, len(f.including)+1)
+	for p := range f.including {
+		including[p] = struct{}{}
+	}
+	including[path] = struct{}{}
+
+	inc := f.newFile(path, including)
+	if _, err := inc.process(src); err != nil {
+		return fmt.Errorf("could not preprocess %q: %v", rel, err)
+	}
+
+	for name, value := range inc.defines {
+		if _, ok := f.Defines[name]; ok {
+			continue
+		}
+		if _, ok := f.defines[name]; ok {
+			return fmt.Errorf("macro %q from %q is already defined", name, rel)
+		}
+		f.defines[name] = value
+	}
+	for name, t := range inc.templates {
+		if _, ok := f.templates[name]; ok {
+			return fmt.Errorf("template %q from %q is already defined", name, rel)
+		}
+		f.templates[name] = t
+	}
+	return nil
+}
+
+func (f *file) declare(args string, lineno int) (*template, error) {
+	name, params, err := parseCall(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid #@template: %v", err)
+	}
+	if _, ok := f.templates[name]; ok {
+		return nil, fmt.Errorf("template %q is already defined", name)
+	}
+
+	seen := make(map[string]struct{}, len(params))
+	for _, p := range params {
+		if !_identifier.MatchString(p) {
+			return nil, fmt.Errorf("invalid parameter name %q", p)
+		}
+		if _, ok := seen[p]; ok {
+			return nil, fmt.Errorf("parameter %q of template %q is repeated", p, name)
+		}
+		seen[p] = struct{}{}
+	}
+
+	return &template{Name: name, Params: params, Line: lineno}, nil
+}
+
+// expand writes the body of the template called by args to w. stack holds
+// the templates being expanded, to detect recursion.
+func (f *file) expand(w *bytes.Buffer, args string, lineno int, stack []string) error {
+	name, values, err := parseCall(args)
+	if err != nil {
+		return fmt.Errorf("invalid #@expand: %v", err)
+	}
+
+	t, ok := f.templates[name]
+	if !ok {
+		return fmt.Errorf("unknown template %q", name)
+	}
+	for _, s := range stack {
+		if s == name {
+			return fmt.Errorf("template %q expands itself: %v", name, strings.Join(append(stack, name), " -> "))
+		}
+	}
+	if len(values) != len(t.Params) {
+		return fmt.Errorf("template %q expects %d arguments: got %d", name, len(t.Params), len(values))
+	}
+
+	params := make(map[string]string, len(t.Params))
+	for i, p := range t.Params {
+		params[p] = values[i]
+	}
+
+	fmt.Fprintf(w, "\n# expanded from %v(%v) on line %d\n", name, strings.Join(values, ", "), lineno)
+	for i, line := range t.Body {
+		text := strings.TrimSpace(line)
+		if strings.HasPrefix(text, directivePrefix) {
+			// Only #@expand is allowed in bodies.
+			_, inner := splitDirective(text)
+			inner, err := f.substitute(inner, params)
+			if err != nil {
+				return fmt.Errorf("in template %q on line %d: %v", name, t.Line+i+1, err)
+			}
+			if err := f.expand(w, inner, lineno, append(stack, name)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		s, err := f.substitute(line, params)
+		if err != nil {
+			return fmt.Errorf("in template %q on line %d: %v", name, t.Line+i+1, err)
+		}
+		w.WriteString(s)
+		w.WriteByte('\n')
+	}
+	return nil
+}
+
+// parseCall parses "Name(a, b, ...)".
+func parseCall(s string) (name string, args []string, err error) {
+	m := _call.FindStringSubmatch(s)
+	if m == nil {
+		return "", nil, fmt.Errorf("expected Name(...): got %q", s)
+	}
+
+	name = m[1]
+	if strings.TrimSpace(m[2]) == "" {
+		return name, nil, nil
+	}
+	for _, a := range strings.Split(m[2], ",") {
+		args = append(args, strings.TrimSpace(a))
+	}
+	return name, args, nil
+}
+
+// substitute replaces ${NAME} in s with the value of the parameter or macro
+// NAME, preferring parameters.
+func (f *file) substitute(s string, params map[string]string) (string, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		if i > 0 && s[i-1] == '$' {
+			// "$${" is a literal "${".
+			b.WriteString(s[:i-1])
+			b.WriteString("${")
+			s = s[i+2:]
+			continue
+		}
+
+		b.WriteString(s[:i])
+		end := strings.IndexByte(s[i:], '}')
+		if end < 0 {
+			return "", errors.New(`unterminated "${"`)
+		}
+
+		name := s[i+2 : i+end]
+		value, ok := params[name]
+		if !ok {
+			value, ok = f.defines[name]
+		}
+		if !ok {
+			return "", fmt.Errorf("undefined macro %q", name)
+		}
+		b.WriteString(value)
+		s = s[i+end+1:]
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package preprocess
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lines(ls ...string) string {
+	return strings.Join(ls, "\n")
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		desc    string
+		defines map[string]string
+		files   map[string]string
+		src     string
+		want    string
+	}{
+		{
+			desc: "no directives",
+			src:  lines("struct Foo {", "  1: required string bar", "}"),
+			want: lines("struct Foo {", "  1: required string bar", "}"),
+		},
+		{
+			desc: "define",
+			src: lines(
+				"#@define prefix us_east",
+				"const string region = \"${prefix}\"",
+			),
+			want: lines(
+				"",
+				"const string region = \"us_east\"",
+			),
+		},
+		{
+			desc:    "provided defines take precedence",
+			defines: map[string]string{"prefix": "eu_west"},
+			src: lines(
+				"#@define prefix us_east",
+				"const string region = \"${prefix}\"",
+			),
+			want: lines(
+				"",
+				"const string region = \"eu_west\"",
+			),
+		},
+		{
+			desc: "escaped substitution",
+			src:  `const string tmpl = "$${name}"`,
+			want: `const string tmpl = "${name}"`,
+		},
+		{
+			desc: "template",
+			src: lines(
+				"#@template Endpoint(Region, Port)",
+				"struct ${Region}Endpoint {",
+				"  1: required i32 port = ${Port}",
+				"}",
+				"#@end",
+				"#@expand Endpoint(USEast, 8080)",
+				"#@expand Endpoint(EUWest, 9090)",
+				"struct Other {}",
+			),
+			want: lines(
+				"",
+				"",
+				"",
+				"",
+				"",
+				"",
+				"",
+				"struct Other {}",
+				"# expanded from Endpoint(USEast, 8080) on line 6",
+				"struct USEastEndpoint {",
+				"  1: required i32 port = 8080",
+				"}",
+				"",
+				"# expanded from Endpoint(EUWest, 9090) on line 7",
+				"struct EUWestEndpoint {",
+				"  1: required i32 port = 9090",
+				"}",
+				"",
+			),
+		},
+		{
+			desc: "nested expansion",
+			src: lines(
+				"#@define ns Geo",
+				"#@template Point(Name)",
+				"struct ${ns}${Name} {}",
+				"#@end",
+				"#@template Pair(A, B)",
+				"  #@expand Point(${A})",
+				"  #@expand Point(${B})",
+				"#@end",
+				"#@expand Pair(Start, End)",
+			),
+			want: lines(
+				"", "", "", "", "", "", "", "", "",
+				"# expanded from Pair(Start, End) on line 9",
+				"",
+				"# expanded from Point(Start) on line 9",
+				"struct GeoStart {}",
+				"",
+				"# expanded from Point(End) on line 9",
+				"struct GeoEnd {}",
+				"",
+			),
+		},
+		{
+			desc: "include",
+			files: map[string]string{
+				"idl/common/templates.thrift": lines(
+					"#@define version 2",
+					"#@template Named(Name)",
+					"struct ${Name}V${version} {}",
+					"#@end",
+					"struct Ignored {}",
+				),
+			},
+			src: lines(
+				`#@include "./common/templates.thrift"`,
+				"#@expand Named(Foo)",
+			),
+			want: lines(
+				"",
+				"",
+				"# expanded from Named(Foo) on line 2",
+				"struct FooV2 {}",
+				"",
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := Config{
+				Defines: tt.defines,
+				Read: func(path string) ([]byte, error) {
+					if s, ok := tt.files[path]; ok {
+						return []byte(s), nil
+					}
+					return nil, os.ErrNotExist
+				},
+			}
+			got, err := cfg.Expand("idl/service.thrift", []byte(tt.src))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		defines map[string]string
+		files   map[string]string
+		src     string
+		wantErr string
+	}{
+		{
+			desc:    "invalid provided define",
+			defines: map[string]string{"not-valid": "foo"},
+			wantErr: `invalid macro name "not-valid"`,
+		},
+		{
+			desc:    "provided define with newline",
+			defines: map[string]string{"foo": "bar\nbaz"},
+			wantErr: `value of macro "foo" must not contain newlines`,
+		},
+		{
+			desc:    "unknown directive",
+			src:     lines("struct Foo {}", "#@frobnicate"),
+			wantErr: `line 2: unknown directive "#@frobnicate"`,
+		},
+		{
+			desc:    "undefined macro",
+			src:     `const string foo = "${bar}"`,
+			wantErr: `line 1: undefined macro "bar"`,
+		},
+		{
+			desc:    "unterminated substitution",
+			src:     `const string foo = "${bar"`,
+			wantErr: `line 1: unterminated "${"`,
+		},
+		{
+			desc:    "redefined macro",
+			src:     lines("#@define foo 1", "#@define foo 2"),
+			wantErr: `line 2: macro "foo" is already defined`,
+		},
+		{
+			desc:    "end without template",
+			src:     "#@end",
+			wantErr: "line 1: #@end without #@template",
+		},
+		{
+			desc:    "missing end",
+			src:     lines("", "#@template Foo()", "struct Foo {}"),
+			wantErr: `line 2: template "Foo" is missing #@end`,
+		},
+		{
+			desc:    "directive inside template",
+			src:     lines("#@template Foo()", "#@define bar baz", "#@end"),
+			wantErr: `line 2: #@define is not allowed inside template "Foo"`,
+		},
+		{
+			desc:    "repeated parameter",
+			src:     lines("#@template Foo(A, A)", "#@end"),
+			wantErr: `line 1: parameter "A" of template "Foo" is repeated`,
+		},
+		{
+			desc:    "unknown template",
+			src:     "#@expand Foo()",
+			wantErr: `line 1: unknown template "Foo"`,
+		},
+		{
+			desc:    "argument count",
+			src:     lines("#@template Foo(A, B)", "#@end", "#@expand Foo(x)"),
+			wantErr: `line 3: template "Foo" expects 2 arguments: got 1`,
+		},
+		{
+			desc: "recursive template",
+			src: lines(
+				"#@template Foo()",
+				"#@expand Bar()",
+				"#@end",
+				"#@template Bar()",
+				"#@expand Foo()",
+				"#@end",
+				"#@expand Foo()",
+			),
+			wantErr: `line 7: template "Foo" expands itself: Foo -> Bar -> Foo`,
+		},
+		{
+			desc:    "undefined macro in template",
+			src:     lines("#@template Foo()", "struct ${Name} {}", "#@end", "#@expand Foo()"),
+			wantErr: `line 4: in template "Foo" on line 2: undefined macro "Name"`,
+		},
+		{
+			desc:    "unquoted include",
+			src:     "#@include foo.thrift",
+			wantErr: "line 1: #@include expects a quoted path: got foo.thrift",
+		},
+		{
+			desc:    "missing include",
+			src:     `#@include "foo.thrift"`,
+			wantErr: "line 1: file does not exist",
+		},
+		{
+			desc: "include cycle",
+			files: map[string]string{
+				"idl/a.thrift": `#@include "b.thrift"`,
+				"idl/b.thrift": `#@include "a.thrift"`,
+			},
+			src:     `#@include "a.thrift"`,
+			wantErr: `line 1: could not preprocess "a.thrift": line 1: could not preprocess "b.thrift": line 1: "a.thrift" includes itself`,
+		},
+		{
+			desc: "included template conflicts",
+			files: map[string]string{
+				"idl/a.thrift": lines("#@template Foo()", "#@end"),
+			},
+			src:     lines("#@template Foo()", "#@end", `#@include "a.thrift"`),
+			wantErr: `line 3: template "Foo" from "a.thrift" is already defined`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cfg := Config{
+				Defines: tt.defines,
+				Read: func(path string) ([]byte, error) {
+					if s, ok := tt.files[path]; ok {
+						return []byte(s), nil
+					}
+					return nil, fmt.Errorf("file does not exist")
+				},
+			}
+			_, err := cfg.Expand("idl/service.thrift", []byte(tt.src))
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestExpandWithoutRead(t *testing.T) {
+	var cfg Config
+	_, err := cfg.Expand("foo.thrift", []byte(`#@include "bar.thrift"`))
+	assert.EqualError(t, err, "line 1: #@include is not supported")
+}
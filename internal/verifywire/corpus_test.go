@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifywire
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const _testThrift = `
+enum Color { RED, GREEN, BLUE }
+
+typedef list<string> Names
+
+struct Item {
+    1: required string name
+    2: optional i32 count
+    3: optional Color color
+    4: optional Names aliases
+    5: optional map<string, double> prices
+    6: optional set<binary> tags
+    7: optional Item child
+    8: optional Choice choice
+    9: required bool active
+    10: optional i8 small
+    11: optional i16 medium
+    12: optional i64 large
+}
+
+union Choice {
+    1: string text
+    2: Item item
+}
+`
+
+func compileTestThrift(t *testing.T) *compile.Module {
+	path := filepath.Join(t.TempDir(), "test.thrift")
+	require.NoError(t, os.WriteFile(path, []byte(_testThrift), 0o644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return m
+}
+
+func TestValue(t *testing.T) {
+	m := compileTestThrift(t)
+	item := m.Types["Item"].(*compile.StructSpec)
+	choice := m.Types["Choice"].(*compile.StructSpec)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		v := Value(r, item)
+		assertMatchesSpec(t, item, v)
+
+		u := Value(r, choice)
+		assertMatchesSpec(t, choice, u)
+		assert.Len(t, u.GetStruct().Fields, 1, "unions must have exactly one field")
+	}
+}
+
+// assertMatchesSpec checks that the given value is of the given type, and
+// that structs in it set all their required fields.
+func assertMatchesSpec(t *testing.T, spec compile.TypeSpec, v wire.Value) {
+	require.Equal(t, spec.TypeCode(), v.Type(), "type of %v", spec.ThriftName())
+
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.EnumSpec:
+		var values []int32
+		for _, item := range s.Items {
+			values = append(values, item.Value)
+		}
+		assert.Contains(t, values, v.GetI32(), "unknown value of %v", s.Name)
+	case *compile.StructSpec:
+		fields := make(map[int16]wire.Value)
+		for _, f := range v.GetStruct().Fields {
+			fields[f.ID] = f.Value
+		}
+		for _, f := range s.Fields {
+			fv, ok := fields[f.ID]
+			if !ok {
+				assert.False(t, f.Required, "required field %v.%v must be set", s.Name, f.Name)
+				continue
+			}
+			assertMatchesSpec(t, f.Type, fv)
+		}
+	case *compile.ListSpec:
+		for _, item := range wire.ValueListToSlice(v.GetList()) {
+			assertMatchesSpec(t, s.ValueSpec, item)
+		}
+	case *compile.SetSpec:
+		for _, item := range wire.ValueListToSlice(v.GetSet()) {
+			assertMatchesSpec(t, s.ValueSpec, item)
+		}
+	case *compile.MapSpec:
+		for _, item := range wire.MapItemListToSlice(v.GetMap()) {
+			assertMatchesSpec(t, s.KeySpec, item.Key)
+			assertMatchesSpec(t, s.ValueSpec, item.Value)
+		}
+	}
+}
+
+func TestValueIsDeterministic(t *testing.T) {
+	item := compileTestThrift(t).Types["Item"]
+
+	r1 := rand.New(rand.NewSource(42))
+	r2 := rand.New(rand.NewSource(42))
+	for i := 0; i < 20; i++ {
+		assert.True(t, wire.ValuesAreEqual(Value(r1, item), Value(r2, item)))
+	}
+}
+
+func TestNewCorpus(t *testing.T) {
+	m := compileTestThrift(t)
+
+	corpus, err := newCorpus(m, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, corpus, 6)
+
+	var types []string
+	for _, e := range corpus {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []string{"Choice", "Choice", "Choice", "Item", "Item", "Item"}, types)
+
+	again, err := newCorpus(m, 1, 3)
+	require.NoError(t, err)
+	for i := range corpus {
+		assert.True(t, wire.ValuesAreEqual(corpus[i].Value, again[i].Value),
+			"corpus must be the same for the same seed")
+	}
+}
+
+func TestNewCorpusNoStructs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.thrift")
+	require.NoError(t, os.WriteFile(path, []byte("enum Color { RED }"), 0o644))
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	_, err = newCorpus(m, 1, 3)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not define any structs")
+}
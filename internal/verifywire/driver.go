@@ -0,0 +1,263 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifywire
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Entry is a value in the corpus.
+type Entry struct {
+	// Name of the Go type generated for the struct.
+	Type string
+
+	Value wire.Value
+}
+
+// WriteCorpus writes the given entries in the format read by the program
+// generated by Driver: one entry per line, holding the name of its type and
+// its value encoded with the Thrift Binary protocol in hex, separated by a
+// space.
+func WriteCorpus(w io.Writer, entries []Entry) error {
+	var buf bytes.Buffer
+	for _, e := range entries {
+		buf.Reset()
+		if err := binary.Default.Encode(e.Value, &buf); err != nil {
+			return fmt.Errorf("could not encode %v: %v", e.Type, err)
+		}
+		if _, err := fmt.Fprintf(w, "%v %x\n", e.Type, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Driver returns the source of a program which reads a corpus written by
+// WriteCorpus from its standard input, decodes each entry with FromWire of
+// the named type in the package at the given import path, and writes the
+// result of encoding it again with ToWire to its standard output, one line
+// per entry.
+//
+// Lines of the output hold either "ok" followed by the encoded value in
+// hex, or "error" followed by the error that occurred.
+//
+// The program uses only APIs which ThriftRW has provided since its first
+// release so that it builds against code generated by any version.
+func Driver(importPath string, types []string) ([]byte, error) {
+	seen := make(map[string]struct{}, len(types))
+	unique := make([]string, 0, len(types))
+	for _, t := range types {
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			unique = append(unique, t)
+		}
+	}
+	sort.Strings(unique)
+
+	var buf bytes.Buffer
+	err := _driverTemplate.Execute(&buf, struct {
+		ImportPath string
+		Types      []string
+	}{ImportPath: importPath, Types: unique})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+var _driverTemplate = template.Must(template.New("driver").Parse(`// Code generated by thriftrw verify-wire. DO NOT EDIT.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	t "{{.ImportPath}}"
+)
+
+type thriftStruct interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+}
+
+var types = map[string]func() thriftStruct{
+{{- range .Types}}
+	"{{.}}": func() thriftStruct { return new(t.{{.}}) },
+{{- end}}
+}
+
+func main() {
+	in := bufio.NewScanner(os.Stdin)
+	in.Buffer(nil, 64<<20)
+	out := bufio.NewWriter(os.Stdout)
+	for in.Scan() {
+		fmt.Fprintln(out, reencode(in.Text()))
+	}
+	if err := out.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := in.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func reencode(line string) string {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return "error invalid corpus entry"
+	}
+
+	newStruct, ok := types[parts[0]]
+	if !ok {
+		return "error unknown type " + parts[0]
+	}
+
+	bs, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return "error " + err.Error()
+	}
+
+	out, err := roundTrip(newStruct(), bs)
+	if err != nil {
+		return "error " + strings.Replace(err.Error(), "\n", " ", -1)
+	}
+	return "ok " + hex.EncodeToString(out)
+}
+
+func roundTrip(x thriftStruct, bs []byte) ([]byte, error) {
+	v, err := protocol.Binary.Decode(bytes.NewReader(bs), wire.TStruct)
+	if err != nil {
+		return nil, err
+	}
+	if err := x.FromWire(v); err != nil {
+		return nil, err
+	}
+
+	w, err := x.ToWire()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := protocol.Binary.Encode(w, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+`))
+
+// Mismatch is an entry of the corpus for which the outputs of two drivers
+// differ.
+type Mismatch struct {
+	// Index of the entry in the corpus.
+	Index int
+	Entry Entry
+
+	// Lines written by each driver for the entry.
+	Old, New string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("entry %d (%v):\n\told: %v\n\tnew: %v", m.Index, m.Entry.Type, m.Old, m.New)
+}
+
+// Compare compares the outputs of drivers built against the old and new
+// versions of generated code for the given corpus.
+//
+// Outputs match if their bytes are equal, if they hold the same values
+// with items of maps or sets in a different order, or if both drivers
+// failed to encode the entry.
+func Compare(corpus []Entry, oldOut, newOut []string) ([]Mismatch, error) {
+	if len(oldOut) != len(corpus) || len(newOut) != len(corpus) {
+		return nil, fmt.Errorf(
+			"expected %d results, got %d from the old version and %d from the new version",
+			len(corpus), len(oldOut), len(newOut))
+	}
+
+	var mismatches []Mismatch
+	for i, e := range corpus {
+		if !outputsMatch(oldOut[i], newOut[i]) {
+			mismatches = append(mismatches, Mismatch{Index: i, Entry: e, Old: oldOut[i], New: newOut[i]})
+		}
+	}
+	return mismatches, nil
+}
+
+func outputsMatch(l, r string) bool {
+	if l == r {
+		return true
+	}
+
+	lstatus, ldata := splitOutput(l)
+	rstatus, rdata := splitOutput(r)
+	if lstatus != rstatus {
+		return false
+	}
+	if lstatus == "error" {
+		return true
+	}
+
+	lv, err := decodeHex(ldata)
+	if err != nil {
+		return false
+	}
+	rv, err := decodeHex(rdata)
+	if err != nil {
+		return false
+	}
+	return wire.ValuesAreEqual(lv, rv)
+}
+
+func splitOutput(s string) (status, data string) {
+	parts := strings.SplitN(s, " ", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func decodeHex(s string) (wire.Value, error) {
+	bs, err := hex.DecodeString(s)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return binary.Default.Decode(bytes.NewReader(bs), wire.TStruct)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifywire
+
+import (
+	"bytes"
+	"encoding/hex"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCorpus(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCorpus(&buf, []Entry{
+		{Type: "Empty", Value: wire.NewValueStruct(wire.Struct{})},
+		{Type: "Name", Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+		}})},
+	}))
+	assert.Equal(t, "Empty 00\nName 0b000100000003666f6f00\n", buf.String())
+}
+
+func TestDriver(t *testing.T) {
+	src, err := Driver("example.com/gen/foo", []string{"Foo", "Bar", "Foo"})
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "main.go", src, 0)
+	require.NoError(t, err, "driver must be valid Go")
+
+	s := string(src)
+	assert.Contains(t, s, `t "example.com/gen/foo"`)
+	assert.Equal(t, 1, strings.Count(s, `"Foo": func() thriftStruct { return new(t.Foo) }`))
+	assert.Less(t, strings.Index(s, `"Bar"`), strings.Index(s, `"Foo"`), "types must be sorted")
+}
+
+// encodeHex returns the hex-encoded Binary representation of a struct
+// holding a map from string to i32 with the given items in order.
+func encodeHex(t *testing.T, items ...wire.MapItem) string {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, items))},
+	}})
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(v, &buf))
+	return hex.EncodeToString(buf.Bytes())
+}
+
+func TestCompare(t *testing.T) {
+	a := wire.MapItem{Key: wire.NewValueString("a"), Value: wire.NewValueI32(1)}
+	b := wire.MapItem{Key: wire.NewValueString("b"), Value: wire.NewValueI32(2)}
+	c := wire.MapItem{Key: wire.NewValueString("c"), Value: wire.NewValueI32(3)}
+
+	tests := []struct {
+		desc      string
+		old, new  string
+		wantMatch bool
+	}{
+		{
+			desc:      "identical",
+			old:       "ok " + encodeHex(t, a, b),
+			new:       "ok " + encodeHex(t, a, b),
+			wantMatch: true,
+		},
+		{
+			desc:      "map order",
+			old:       "ok " + encodeHex(t, a, b),
+			new:       "ok " + encodeHex(t, b, a),
+			wantMatch: true,
+		},
+		{
+			desc: "different values",
+			old:  "ok " + encodeHex(t, a, b),
+			new:  "ok " + encodeHex(t, a, c),
+		},
+		{
+			desc:      "both errors",
+			old:       "error missing required field",
+			new:       "error field Name is required",
+			wantMatch: true,
+		},
+		{
+			desc: "error in new version",
+			old:  "ok " + encodeHex(t, a),
+			new:  "error great sadness",
+		},
+		{
+			desc: "invalid output",
+			old:  "ok " + encodeHex(t, a),
+			new:  "ok zz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			entry := Entry{Type: "Foo", Value: wire.NewValueStruct(wire.Struct{})}
+			mismatches, err := Compare([]Entry{entry}, []string{tt.old}, []string{tt.new})
+			require.NoError(t, err)
+
+			if tt.wantMatch {
+				assert.Empty(t, mismatches)
+				return
+			}
+			assert.Equal(t, []Mismatch{{Index: 0, Entry: entry, Old: tt.old, New: tt.new}}, mismatches)
+		})
+	}
+}
+
+func TestCompareLengthMismatch(t *testing.T) {
+	_, err := Compare(make([]Entry, 2), []string{"ok 00", "ok 00"}, []string{"ok 00"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected 2 results, got 2 from the old version and 1 from the new version")
+}
+
+func TestMismatchString(t *testing.T) {
+	m := Mismatch{Index: 3, Entry: Entry{Type: "Foo"}, Old: "ok 00", New: "error great sadness"}
+	assert.Equal(t, "entry 3 (Foo):\n\told: ok 00\n\tnew: error great sadness", m.String())
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifywire
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+)
+
+// _modulePath is the path of the Go module in which drivers are built.
+const _modulePath = "verifywire"
+
+// Version is a version of ThriftRW to verify.
+type Version struct {
+	// Path to the thriftrw binary.
+	Binary string
+
+	// Directory holding the source of the ThriftRW library that code
+	// generated by Binary is built against. By default, the release of
+	// the library matching the version reported by Binary is used.
+	Library string
+}
+
+// Config configures Run.
+type Config struct {
+	// Thrift file defining the structs to verify.
+	ThriftFile string
+
+	Old, New Version
+
+	// Seed for the pseudo-random corpus, and the number of values in it
+	// for each struct.
+	Seed  int64
+	Count int
+
+	// Directory in which code is generated and built.
+	WorkDir string
+
+	// Output of the commands run by Run. Discarded if nil.
+	Log io.Writer
+}
+
+// Report is the result of Run.
+type Report struct {
+	// Number of entries in the corpus.
+	Entries int
+
+	// Entries for which the two versions disagreed.
+	Mismatches []Mismatch
+}
+
+// Run generates code with both versions of ThriftRW, encodes a corpus of
+// values with each, and reports the values for which they disagree.
+func Run(cfg *Config) (*Report, error) {
+	thriftFile, err := filepath.Abs(cfg.ThriftFile)
+	if err != nil {
+		return nil, err
+	}
+
+	module, err := compile.Compile(thriftFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile %q: %v", cfg.ThriftFile, err)
+	}
+
+	corpus, err := newCorpus(module, cfg.Seed, cfg.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCorpus(&buf, corpus); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(cfg.WorkDir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(cfg.WorkDir, "corpus.txt"), buf.Bytes(), 0o644); err != nil {
+		return nil, err
+	}
+
+	var types []string
+	for _, e := range corpus {
+		types = append(types, e.Type)
+	}
+
+	root, err := thriftRoot(module)
+	if err != nil {
+		return nil, err
+	}
+
+	b := builder{
+		ThriftFile: thriftFile,
+		ThriftRoot: root,
+		Types:      types,
+		Corpus:     buf.Bytes(),
+		Log:        cfg.Log,
+	}
+	if b.Log == nil {
+		b.Log = io.Discard
+	}
+
+	oldOut, err := b.Run(cfg.Old, filepath.Join(cfg.WorkDir, "old"))
+	if err != nil {
+		return nil, fmt.Errorf("old version %v: %v", cfg.Old.Binary, err)
+	}
+
+	newOut, err := b.Run(cfg.New, filepath.Join(cfg.WorkDir, "new"))
+	if err != nil {
+		return nil, fmt.Errorf("new version %v: %v", cfg.New.Binary, err)
+	}
+
+	mismatches, err := Compare(corpus, oldOut, newOut)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{Entries: len(corpus), Mismatches: mismatches}, nil
+}
+
+// newCorpus generates count values for each struct defined in the given
+// module, ordered by the names of their Go types.
+func newCorpus(m *compile.Module, seed int64, count int) ([]Entry, error) {
+	specs := make(map[string]*compile.StructSpec)
+	var names []string
+	for _, t := range m.Types {
+		spec, ok := t.(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+
+		name, err := gen.GoName(spec)
+		if err != nil {
+			return nil, err
+		}
+		specs[name] = spec
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%q does not define any structs", m.ThriftPath)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	entries := make([]Entry, 0, len(names)*count)
+	for _, name := range names {
+		for i := 0; i < count; i++ {
+			entries = append(entries, Entry{Type: name, Value: Value(r, specs[name])})
+		}
+	}
+	return entries, nil
+}
+
+// thriftRoot returns the deepest directory holding the given Thrift file
+// and all the files it includes, directly or transitively.
+func thriftRoot(m *compile.Module) (string, error) {
+	root := filepath.Dir(m.ThriftPath)
+	err := m.Walk(func(m *compile.Module) error {
+		for !isWithin(root, m.ThriftPath) {
+			parent := filepath.Dir(root)
+			if parent == root {
+				return fmt.Errorf("%q does not share an ancestor with the other Thrift files", m.ThriftPath)
+			}
+			root = parent
+		}
+		return nil
+	})
+	return root, err
+}
+
+func isWithin(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// builder generates, builds, and runs drivers.
+type builder struct {
+	ThriftFile string
+	ThriftRoot string
+	Types      []string
+	Corpus     []byte
+	Log        io.Writer
+}
+
+// Run generates code for the Thrift file with the given version of
+// ThriftRW inside dir, and returns the output of a driver built against it
+// for the corpus.
+func (b *builder) Run(v Version, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	libVersion, err := b.libraryVersion(v.Binary)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.command(dir, v.Binary,
+		"--out", filepath.Join(dir, "gen"),
+		"--pkg-prefix", _modulePath+"/gen",
+		"--thrift-root", b.ThriftRoot,
+		b.ThriftFile,
+	).Run()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate code: %v", err)
+	}
+
+	pkg, err := filepath.Rel(b.ThriftRoot, strings.TrimSuffix(b.ThriftFile, ".thrift"))
+	if err != nil {
+		return nil, err
+	}
+	src, err := Driver(_modulePath+"/gen/"+filepath.ToSlash(pkg), b.Types)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src, 0o644); err != nil {
+		return nil, err
+	}
+
+	var gomod bytes.Buffer
+	fmt.Fprintf(&gomod, "module %v\n\ngo 1.17\n\nrequire go.uber.org/thriftrw v%v\n", _modulePath, libVersion)
+	if v.Library != "" {
+		lib, err := filepath.Abs(v.Library)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&gomod, "\nreplace go.uber.org/thriftrw => %v\n", lib)
+
+		// Start with the checksums of the library's dependencies so that
+		// they need not be looked up again.
+		gosum, err := os.ReadFile(filepath.Join(lib, "go.sum"))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "go.sum"), gosum, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), gomod.Bytes(), 0o644); err != nil {
+		return nil, err
+	}
+
+	// -mod=mod adds requirements for the dependencies of the generated
+	// code without also resolving those of their tests, as go mod tidy
+	// would.
+	if err := b.command(dir, "go", "build", "-mod=mod", "-o", "driver", ".").Run(); err != nil {
+		return nil, fmt.Errorf("generated code does not build: %v", err)
+	}
+
+	cmd := b.command(dir, filepath.Join(dir, "driver"))
+	cmd.Stdin = bytes.NewReader(b.Corpus)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("could not encode the corpus: %v", err)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(&out)
+	scanner.Buffer(nil, 64<<20)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+// libraryVersion returns the version of the ThriftRW library matching the
+// given thriftrw binary.
+func (b *builder) libraryVersion(binary string) (string, error) {
+	cmd := b.command("", binary, "--version")
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("could not determine version: %v", err)
+	}
+
+	// thriftrw --version prints "thriftrw v1.2.3".
+	fields := strings.Fields(out.String())
+	if len(fields) == 0 {
+		return "", fmt.Errorf("could not determine version from %q", out.String())
+	}
+	return strings.TrimPrefix(fields[len(fields)-1], "v"), nil
+}
+
+func (b *builder) command(dir, name string, args ...string) *exec.Cmd {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = b.Log
+	cmd.Stderr = b.Log
+	return cmd
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifywire
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping: builds thriftrw and generated code")
+	}
+
+	lib, err := filepath.Abs("../..")
+	require.NoError(t, err)
+
+	bin := filepath.Join(t.TempDir(), "thriftrw")
+	build := exec.Command("go", "build", "-o", bin, ".")
+	build.Dir = lib
+	out, err := build.CombinedOutput()
+	require.NoError(t, err, "could not build thriftrw:\n%s", out)
+
+	dir := t.TempDir()
+	thriftFile := filepath.Join(dir, "idl", "test.thrift")
+	require.NoError(t, os.MkdirAll(filepath.Dir(thriftFile), 0o755))
+	require.NoError(t, os.WriteFile(thriftFile, []byte(_testThrift), 0o644))
+
+	version := Version{Binary: bin, Library: lib}
+	report, err := Run(&Config{
+		ThriftFile: thriftFile,
+		Old:        version,
+		New:        version,
+		Seed:       1,
+		Count:      5,
+		WorkDir:    filepath.Join(dir, "work"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10, report.Entries)
+	assert.Empty(t, report.Mismatches)
+}
+
+func TestThriftRoot(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "b"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b", "shared.thrift"),
+		[]byte("struct Shared { 1: optional string name }"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a", "main.thrift"),
+		[]byte(`include "../b/shared.thrift"
+		struct Main { 1: optional shared.Shared shared }`), 0o644))
+
+	m, err := compile.Compile(filepath.Join(dir, "a", "main.thrift"))
+	require.NoError(t, err)
+
+	root, err := thriftRoot(m)
+	require.NoError(t, err)
+	assert.Equal(t, dir, root)
+}
@@ -24,10 +24,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/gen"
@@ -37,6 +39,7 @@ import (
 
 	flags "github.com/jessevdk/go-flags"
 	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
 )
 
 type options struct {
@@ -52,15 +55,63 @@ type genOptions struct {
 	NoRecurse bool         `long:"no-recurse" description:"Don't generate code for included Thrift files."`
 	Plugins   plugin.Flags `long:"plugin" short:"p" value-name:"PLUGIN" description:"Code generation plugin for ThriftRW. This option may be provided multiple times to apply multiple plugins."`
 
-	GeneratePluginAPI     bool   `long:"generate-plugin-api" hidden:"true" description:"Generates code for the plugin API"`
-	NoVersionCheck        bool   `long:"no-version-check" hidden:"true" description:"Does not add library version checks to generated code."`
-	NoTypes               bool   `long:"no-types" description:"Do not generate code for types, implies --no-service-helpers."`
-	NoConstants           bool   `long:"no-constants" description:"Do not generate code for const declarations."`
-	NoServiceHelpers      bool   `long:"no-service-helpers" description:"Do not generate service helpers."`
-	NoEmbedIDL            bool   `long:"no-embed-idl" description:"Do not embed IDLs into the generated code."`
-	NoZap                 bool   `long:"no-zap" description:"Do not generate code for Zap logging."`
-	OutputFile            string `long:"output-file" value-name:"FILENAME" description:"Generates a single .go file as an output. Specifying an OutputFile prevents code generation for included Thrift Files."`
-	EnumTextMarshalStrict bool   `long:"enum-text-marshal-strict" hidden:"true" description:"Generate code to throw error on trying to marshal unknown enum"`
+	PluginSandbox      bool          `long:"plugin-sandbox" description:"Run plugins with only the PATH environment variable and those passed with --plugin-env, and without network access unless --plugin-allow-network is given. Network isolation requires Linux."`
+	PluginEnv          []string      `long:"plugin-env" value-name:"NAME" description:"Pass the environment variable NAME through to sandboxed plugins. This option may be provided multiple times."`
+	PluginAllowNetwork bool          `long:"plugin-allow-network" description:"Allow sandboxed plugins to access the network."`
+	PluginTimeout      time.Duration `long:"plugin-timeout" value-name:"DURATION" description:"Kill plugins which run for longer than DURATION, e.g. 30s."`
+	PluginCPUTime      time.Duration `long:"plugin-cpu-time" value-name:"DURATION" description:"Kill plugins which use more than DURATION of CPU time, rounded up to whole seconds. Requires Linux."`
+	PluginMemory       uint64        `long:"plugin-memory" value-name:"MB" description:"Limit the address space of plugins to MB megabytes. Requires Linux."`
+
+	GeneratePluginAPI     bool     `long:"generate-plugin-api" hidden:"true" description:"Generates code for the plugin API"`
+	NoVersionCheck        bool     `long:"no-version-check" hidden:"true" description:"Does not add library version checks to generated code."`
+	NoTypes               bool     `long:"no-types" description:"Do not generate code for types, implies --no-service-helpers."`
+	NoConstants           bool     `long:"no-constants" description:"Do not generate code for const declarations."`
+	NoServiceHelpers      bool     `long:"no-service-helpers" description:"Do not generate service helpers."`
+	NoEmbedIDL            bool     `long:"no-embed-idl" description:"Do not embed IDLs into the generated code."`
+	NoZap                 bool     `long:"no-zap" description:"Do not generate code for Zap logging."`
+	OutputFile            string   `long:"output-file" value-name:"FILENAME" description:"Generates a single .go file as an output. Specifying an OutputFile prevents code generation for included Thrift Files."`
+	EnumTextMarshalStrict bool     `long:"enum-text-marshal-strict" hidden:"true" description:"Generate code to throw error on trying to marshal unknown enum"`
+	OmitDefaults          bool     `long:"omit-defaults" description:"Do not write optional fields to the wire if they are set to their default values. Override per struct or field with the go.omit_default annotation."`
+	FieldTagTemplates     []string `long:"field-tag-template" value-name:"TEMPLATE" description:"Go template for struct tags added to every field of every struct, e.g. 'validate:\"{{if .Required}}required{{end}}\"'. Tags with empty values are dropped. This option may be provided multiple times."`
+	HTTPHandlers          bool     `long:"http-handlers" description:"Generate net/http handlers serving each service function at /Service/method. Exceptions are reported with the status code in their http.status annotation."`
+	Procedures            bool     `long:"procedures" description:"Generate an interface for each service and a function returning thriftrpc procedures named Service::method for its implementations."`
+	Router                bool     `long:"router" description:"Generate a NewRouter function serving all services of each Thrift file from one thriftrpc.Router keyed by envelope method names. Requires --procedures."`
+	RouterPrefix          bool     `long:"router-prefix" description:"Route methods by their names prefixed with the service name, as in Service:method, so that services with methods of the same name may be served together. Requires --router."`
+	PreserveUnknownFields bool     `long:"preserve-unknown-fields" description:"Retain fields of structs that are not recognized when decoding them, and write them back out when encoding them. Override per struct with the go.preserve_unknown_fields annotation."`
+	LazyStructs           bool     `long:"lazy-structs" description:"Generate a Name_Lazy type for each struct which decodes its fields from their binary encoding only when they are first accessed. Override per struct with the go.lazy annotation."`
+	AggregateErrors       bool     `long:"aggregate-errors" description:"Report all missing required fields and invalid unions in a struct and its nested structs when encoding it, instead of only the first one."`
+	TestHelpers           bool     `long:"test-helpers" description:"Generate NewNameWithDefaults constructors for structs, and MustNameFromWire and MustNameFromJSON functions which panic if decoding fails, for use in tests."`
+	DualEncode            bool     `long:"dual-encode" description:"Generate Encode methods which also encode structs with ToWire and report differences between the two to go.uber.org/thriftrw/dualencode. For use while migrating to streaming Encode; this triples the cost of encoding."`
+	Builders              bool     `long:"builders" description:"Generate a NameBuilder type for each struct with a chained setter for each field and a Build method which returns an error if required fields were not set. Builders start out with the default values defined in the Thrift file."`
+	PresenceBits          bool     `long:"presence-bits" description:"Store optional primitive fields of structs by value, tracking whether they are set in a hidden bitset, instead of as pointers. Fields are accessed with the GetName, HasName, SetName, and ClearName methods. Override per struct with the go.presence_bits annotation."`
+	SourceComments        bool     `long:"source-comments" description:"Add the Thrift file and line on which they were defined to the documentation of generated types, constants, and service functions."`
+	Setters               bool     `long:"setters" description:"Generate a SetName method for each field of each struct, taking care of wrapping values of optional fields in pointers."`
+	TypeSpecs             bool     `long:"type-specs" description:"Generate a NameTypeSpec variable describing the wire type, fields, and annotations of each type, and a TypeSpecs map holding all of them keyed by Thrift name, so that values may be decoded knowing only the name of their type."`
+	ServiceSpecs          bool     `long:"service-specs" description:"Generate a NameServiceSpec variable describing the functions, argument and result types, and annotations of each service, and a ServiceSpecs map holding all of them keyed by Thrift name. These marshal to JSON as service descriptors for service catalogs."`
+	StdlibOnly            bool     `long:"stdlib-only" description:"Generate code which depends only on the Go standard library and ThriftRW packages which do the same. Implies --no-zap. Fails if any generated file, including those from plugins, imports other packages."`
+	NoStreaming           bool     `long:"no-streaming" description:"Do not generate the streaming Encode and Decode methods of types, leaving ToWire and FromWire to serialize them. This shrinks generated code for builds that only use wire.Value. Cannot be combined with --dual-encode or --lazy-structs."`
+	PprofLabels           bool     `long:"pprof-labels" description:"Run the FromWire and Decode methods of structs under pprof labels naming the Thrift type and the method, so that CPU profiles attribute the cost of deserialization to each type. Override per struct with the go.pprof_labels annotation."`
+	ThriftJSON            bool     `long:"thrift-json" description:"Generate MarshalThriftJSON and UnmarshalThriftJSON methods for structs which encode them in Apache Thrift's TJSONProtocol, keyed by field identifiers, so that they may be exchanged with Apache Thrift services in other languages. Cannot be combined with --no-streaming."`
+	YAML                  bool     `long:"yaml" description:"Add yaml tags mirroring the json tags of struct fields, and generate MarshalYAML and UnmarshalYAML methods which represent enums by name and check that unions have exactly one field set."`
+	QuickGenerators       bool     `long:"quick-generators" description:"Generate Generate methods for structs, unions, exceptions, and enums which implement testing/quick.Generator, producing random values which are valid on the wire."`
+	Compare               bool     `long:"compare" description:"Generate Compare and Less methods for structs, unions, exceptions, and typedefs whose fields are all ordered, comparing fields in the order in which they are declared."`
+	ConstantFunctions     bool     `long:"constant-functions" description:"Generate constants of lists, sets, maps, binary, structs, unions, and exceptions as functions which return a new copy of the value on every call, instead of as variables which callers share and may modify."`
+	EncodedSize           bool     `long:"encoded-size" description:"Generate an EncodedSize method for each struct, union, exception, enum, and typedef which returns the length of its encoding with the Binary protocol without encoding it, for preallocating buffers and checking frame sizes before writing."`
+	AppendTo              bool     `long:"append-to" description:"Generate an AppendTo method for each struct, union, and exception which appends its encoding with the Binary protocol to a byte slice, growing it as needed. With --encoded-size, the slice is grown once up front. Cannot be combined with --no-streaming."`
+	PackageMaps           []string `long:"package-map" value-name:"SOURCE=DIR" description:"Generate the packages for Thrift files matching SOURCE into DIR, relative to the output directory and --pkg-prefix. SOURCE is a Thrift file or directory relative to --thrift-root, or namespace:NAME for Thrift files with 'namespace go NAME'. This option may be provided multiple times."`
+	PackageMapFile        string   `long:"package-map-file" value-name:"FILE" description:"YAML file listing package mappings, each with a namespace or thrift_path key, and the dir, package, and file of the generated code. See --package-map."`
+	Benchmarks            bool     `long:"benchmarks" description:"Generate a NAME_bench_test.go file alongside the code for each Thrift file, with a benchmark for each struct, union, and exception which round-trips a representative value of the type through each of its serialization methods."`
+	GoldenCorpus          bool     `long:"golden-corpus" description:"Generate a NAME_golden_test.go file alongside the code for each Thrift file, with a test for each struct, union, and exception which checks that a representative value of the type still encodes to the bytes recorded at generation time, with the Binary protocol and, with --thrift-json, TJSONProtocol. This catches changes to the wire format when upgrading the ThriftRW library."`
+	FuzzTargets           bool     `long:"fuzz-targets" description:"Generate a NAME_fuzz_test.go file alongside the code for each Thrift file, with a fuzz target for each struct, union, and exception which decodes arbitrary bytes into the type and checks that values which decode successfully encode and decode again to an equal value."`
+	OutputLayout          string   `long:"output-layout" value-name:"LAYOUT" choice:"multi-file" choice:"single-file" default:"multi-file" description:"Layout of generated files. With single-file, Go files generated by plugins in the package of a Thrift file are merged into the file generated for it, so that each Thrift file generates exactly one .go file in its package. Plugin files in other packages are left as they are."`
+	MissingRequired       string   `long:"missing-required" value-name:"ACTION" choice:"error" choice:"hook" choice:"zero" default:"error" description:"How decoding handles required fields missing from the wire. With hook, they are reported to the handler installed with requiredfield.SetHandler, which fails decoding by default. With zero, they are left zero-valued. Use these to accept payloads from producers which lag behind schema changes."`
+	Only                  string   `long:"only" value-name:"PART" choice:"types" choice:"clients" choice:"servers" description:"Generate only constants and types, with no code for services, or only the code for services used by clients or by servers. Plugins are asked to skip code for the other side, and are not run with types."`
+	Target                string   `long:"target" value-name:"TOOLCHAIN" choice:"go" choice:"tinygo" default:"go" description:"Toolchain for which code is generated. With tinygo, generated code avoids Zap, encoding/json, reflect, and goroutines so that it builds with TinyGo for WebAssembly. Implies --no-zap."`
+	ImplicitFieldIDs      bool     `long:"implicit-field-ids" description:"Allow fields without field identifiers, assigning them negative identifiers in declaration order as Apache Thrift does. Thrift files may override this with 'namespace thriftrw.implicit_field_ids allow' or 'deny'."`
+	Preprocess            bool     `long:"preprocess" description:"Expand templates and macros in Thrift files before compiling them. Declare templates between '#@template Name(Param, ...)' and '#@end' lines and expand them with '#@expand Name(arg, ...)'. '${NAME}' is replaced by the value of a macro defined with '#@define NAME value' or --define."`
+	Defines               []string `long:"define" value-name:"NAME=VALUE" description:"Define a macro for --preprocess, overriding definitions in Thrift files. Implies --preprocess. This option may be provided multiple times."`
+	GoNames               []string `long:"go-name" value-name:"THRIFT=GO" description:"Use the Go name GO for the Thrift type THRIFT, or for a field or service function specified as Type.field or Service.function. Takes precedence over go.name annotations and plugins. This option may be provided multiple times."`
+	MaxWarnings           int      `long:"max-warnings" value-name:"N" default:"-1" description:"Fail if more than N warnings are reported. Informational warnings, such as unused includes, are not counted. Warnings may be suppressed with the thriftrw.suppress annotation. By default, there is no limit."`
 
 	// TODO(abg): Detailed help with examples of --thrift-root, --pkg-prefix,
 	// and --plugin
@@ -78,10 +129,23 @@ func main() {
 func do() (err error) {
 	log.SetFlags(0) // don't include timestamps, etc. in the output
 
+	if len(os.Args) > 1 && os.Args[1] == "serve-mock" {
+		return serveMock(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == "verify-wire" {
+		return verifyWire(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		return exportIDL(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == plugin.SandboxCommand {
+		return plugin.ExecSandboxed(os.Args[2:])
+	}
+
 	var opts options
 
 	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
-	parser.Usage = "[OPTIONS] FILE"
+	parser.Usage = "[OPTIONS] FILE\n  thriftrw [OPTIONS] init NAME\n  thriftrw serve-mock [OPTIONS] FILE RESPONSES\n  thriftrw verify-wire [OPTIONS] FILE"
 
 	args, err := parser.Parse()
 	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
@@ -96,6 +160,10 @@ func do() (err error) {
 		return nil
 	}
 
+	if len(args) == 2 && args[0] == "init" {
+		return initProject(args[1], opts.GOpts)
+	}
+
 	if len(args) != 1 {
 		var buffer bytes.Buffer
 		parser.WriteHelp(&buffer)
@@ -129,7 +197,19 @@ func do() (err error) {
 		}
 	}
 
-	module, err := compile.Compile(inputFile)
+	var compileOpts []compile.Option
+	if gopts.ImplicitFieldIDs {
+		compileOpts = append(compileOpts, compile.ImplicitFieldIDs())
+	}
+	if gopts.Preprocess || len(gopts.Defines) > 0 {
+		defines, err := parseDefines(gopts.Defines)
+		if err != nil {
+			return err
+		}
+		compileOpts = append(compileOpts, compile.Preprocess(defines))
+	}
+
+	module, err := compile.Compile(inputFile, compileOpts...)
 	if err != nil {
 		// TODO(abg): For nested compile errors, split causal chain across
 		// multiple lines.
@@ -161,6 +241,18 @@ func do() (err error) {
 		return fmt.Errorf("output-file value: %q invalid. A {FILENAME}.go name must be provided", gopts.OutputFile)
 	}
 
+	err = gopts.Plugins.Sandbox(plugin.Sandbox{
+		Timeout:      gopts.PluginTimeout,
+		CPUTime:      gopts.PluginCPUTime,
+		Memory:       gopts.PluginMemory << 20,
+		Isolate:      gopts.PluginSandbox,
+		Env:          gopts.PluginEnv,
+		AllowNetwork: gopts.PluginAllowNetwork,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to initialize plugins: %+v", err)
+	}
+
 	pluginHandle, err := gopts.Plugins.Handle()
 	if err != nil {
 		return fmt.Errorf("Failed to initialize plugins: %+v", err)
@@ -174,8 +266,20 @@ func do() (err error) {
 		err = multierr.Append(err, pluginHandle.Close())
 	}()
 
+	goNames, err := parseGoNames(gopts.GoNames)
+	if err != nil {
+		return err
+	}
+
+	packageMappings, err := parsePackageMappings(gopts.PackageMapFile, gopts.PackageMaps)
+	if err != nil {
+		return err
+	}
+
+	var warnings int
 	codeGenerator := gen.CodeGenerator{
 		ServiceGenerator: pluginHandle.ServiceGenerator(),
+		NameResolver:     pluginHandle.NameResolver(),
 	}
 	generatorOptions := gen.Options{
 		OutputDir:             gopts.OutputDirectory,
@@ -191,10 +295,58 @@ func do() (err error) {
 		NoZap:                 gopts.NoZap,
 		OutputFile:            gopts.OutputFile,
 		EnumTextMarshalStrict: gopts.EnumTextMarshalStrict,
+		OmitDefaults:          gopts.OmitDefaults,
+		FieldTagTemplates:     gopts.FieldTagTemplates,
+		HTTPHandlers:          gopts.HTTPHandlers,
+		Procedures:            gopts.Procedures,
+		Router:                gopts.Router,
+		RouterPrefix:          gopts.RouterPrefix,
+		PreserveUnknownFields: gopts.PreserveUnknownFields,
+		LazyStructs:           gopts.LazyStructs,
+		AggregateErrors:       gopts.AggregateErrors,
+		TestHelpers:           gopts.TestHelpers,
+		DualEncode:            gopts.DualEncode,
+		Builders:              gopts.Builders,
+		Setters:               gopts.Setters,
+		PresenceBits:          gopts.PresenceBits,
+		SourceComments:        gopts.SourceComments,
+		TypeSpecs:             gopts.TypeSpecs,
+		ServiceSpecs:          gopts.ServiceSpecs,
+		StdlibOnly:            gopts.StdlibOnly,
+		Only:                  gopts.Only,
+		MissingRequired:       gopts.MissingRequired,
+		NoStreaming:           gopts.NoStreaming,
+		PprofLabels:           gopts.PprofLabels,
+		ThriftJSON:            gopts.ThriftJSON,
+		YAML:                  gopts.YAML,
+		QuickGenerators:       gopts.QuickGenerators,
+		Compare:               gopts.Compare,
+		ConstantFunctions:     gopts.ConstantFunctions,
+		EncodedSize:           gopts.EncodedSize,
+		AppendTo:              gopts.AppendTo,
+		GoldenCorpus:          gopts.GoldenCorpus,
+		FuzzTargets:           gopts.FuzzTargets,
+		OutputLayout:          gopts.OutputLayout,
+		Benchmarks:            gopts.Benchmarks,
+		PackageMappings:       packageMappings,
+		Target:                gopts.Target,
+		GoNames:               goNames,
+		Progress: func(e gen.Event) {
+			if e.Type != gen.Warning {
+				return
+			}
+			log.Print(e.Warning)
+			if e.Warning.Severity >= compile.SeverityWarning {
+				warnings++
+			}
+		},
 	}
 	if err := gen.Generate(module, &generatorOptions); err != nil {
 		return fmt.Errorf("Failed to generate code: %+v", err)
 	}
+	if gopts.MaxWarnings >= 0 && warnings > gopts.MaxWarnings {
+		return fmt.Errorf("Found %d warnings, more than the --max-warnings limit of %d", warnings, gopts.MaxWarnings)
+	}
 	return nil
 }
 
@@ -219,6 +371,83 @@ func verifyAncestry(m *compile.Module, root string) error {
 	})
 }
 
+// parseDefines parses the NAME=VALUE arguments of --define.
+func parseDefines(args []string) (map[string]string, error) {
+	defines := make(map[string]string, len(args))
+	for _, arg := range args {
+		i := strings.IndexByte(arg, '=')
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid --define %q: expected NAME=VALUE", arg)
+		}
+		defines[arg[:i]] = arg[i+1:]
+	}
+	return defines, nil
+}
+
+// parseGoNames parses the THRIFT=GO arguments of --go-name.
+func parseGoNames(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	names := make(map[string]string, len(args))
+	for _, arg := range args {
+		i := strings.IndexByte(arg, '=')
+		if i <= 0 || i == len(arg)-1 {
+			return nil, fmt.Errorf("invalid --go-name %q: expected THRIFT=GO", arg)
+		}
+		names[arg[:i]] = arg[i+1:]
+	}
+	return names, nil
+}
+
+// packageMappingFile is an entry of a --package-map-file.
+type packageMappingFile struct {
+	Namespace  string `yaml:"namespace"`
+	ThriftPath string `yaml:"thrift_path"`
+	Dir        string `yaml:"dir"`
+	Package    string `yaml:"package"`
+	File       string `yaml:"file"`
+}
+
+// parsePackageMappings parses the mappings in the given --package-map-file,
+// if any, followed by those passed with --package-map.
+func parsePackageMappings(file string, args []string) ([]gen.PackageMapping, error) {
+	var mappings []gen.PackageMapping
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read package map file: %v", err)
+		}
+		defer f.Close()
+
+		var entries []packageMappingFile
+		dec := yaml.NewDecoder(f)
+		dec.KnownFields(true)
+		if err := dec.Decode(&entries); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("could not parse package map file %q: %v", file, err)
+		}
+		for _, e := range entries {
+			mappings = append(mappings, gen.PackageMapping(e))
+		}
+	}
+
+	for _, arg := range args {
+		i := strings.IndexByte(arg, '=')
+		if i <= 0 || i == len(arg)-1 {
+			return nil, fmt.Errorf("invalid --package-map %q: expected SOURCE=DIR", arg)
+		}
+		m := gen.PackageMapping{Dir: arg[i+1:]}
+		if ns := strings.TrimPrefix(arg[:i], "namespace:"); ns != arg[:i] {
+			m.Namespace = ns
+		} else {
+			m.ThriftPath = arg[:i]
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, nil
+}
+
 // findCommonAncestor finds the deepest common ancestor for the given module
 // and all modules imported by it.
 func findCommonAncestor(m *compile.Module) (string, error) {
@@ -27,6 +27,7 @@ import (
 	"testing"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -437,3 +438,84 @@ func TestFindCommonAncestor(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDefines(t *testing.T) {
+	defines, err := parseDefines([]string{"region=us_east", "suffix=", "expr=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"region": "us_east",
+		"suffix": "",
+		"expr":   "a=b",
+	}, defines)
+
+	_, err = parseDefines([]string{"region"})
+	assert.EqualError(t, err, `invalid --define "region": expected NAME=VALUE`)
+
+	_, err = parseDefines([]string{"=foo"})
+	assert.EqualError(t, err, `invalid --define "=foo": expected NAME=VALUE`)
+}
+
+func TestParseGoNames(t *testing.T) {
+	names, err := parseGoNames([]string{"User=Account", "Users.getUser=FetchUser"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"User":          "Account",
+		"Users.getUser": "FetchUser",
+	}, names)
+
+	names, err = parseGoNames(nil)
+	require.NoError(t, err)
+	assert.Nil(t, names)
+
+	for _, arg := range []string{"User", "=Account", "User="} {
+		_, err := parseGoNames([]string{arg})
+		assert.EqualError(t, err, `invalid --go-name "`+arg+`": expected THRIFT=GO`)
+	}
+}
+
+func TestParsePackageMappings(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "packages.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+- namespace: payments.ledger
+  dir: ledger/v1
+  package: ledgerv1
+  file: ledger.gen.go
+- thrift_path: idl/common
+  dir: common
+`), 0644))
+
+	mappings, err := parsePackageMappings(file, []string{
+		"namespace:payments.audit=audit",
+		"idl/users.thrift=accounts/users",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []gen.PackageMapping{
+		{Namespace: "payments.ledger", Dir: "ledger/v1", Package: "ledgerv1", File: "ledger.gen.go"},
+		{ThriftPath: "idl/common", Dir: "common"},
+		{Namespace: "payments.audit", Dir: "audit"},
+		{ThriftPath: "idl/users.thrift", Dir: "accounts/users"},
+	}, mappings)
+
+	mappings, err = parsePackageMappings("", nil)
+	require.NoError(t, err)
+	assert.Empty(t, mappings)
+
+	for _, arg := range []string{"idl", "=dir", "idl="} {
+		_, err := parsePackageMappings("", []string{arg})
+		assert.EqualError(t, err, `invalid --package-map "`+arg+`": expected SOURCE=DIR`)
+	}
+
+	t.Run("unknown key", func(t *testing.T) {
+		bad := filepath.Join(t.TempDir(), "bad.yaml")
+		require.NoError(t, ioutil.WriteFile(bad, []byte("- directory: foo\n"), 0644))
+		_, err := parsePackageMappings(bad, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not parse package map file")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := parsePackageMappings(filepath.Join(t.TempDir(), "missing.yaml"), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not read package map file")
+	})
+}
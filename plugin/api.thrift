@@ -65,6 +65,10 @@ enum SimpleType {
     FLOAT64,      // float64
     STRING,       // string
     STRUCT_EMPTY, // struct{}
+    UINT8,        // uint8
+    UINT16,       // uint16
+    UINT32,       // uint32
+    UINT64,       // uint64
 }
 
 /**
@@ -280,6 +284,13 @@ enum Feature {
      * service.
      */
     SERVICE_GENERATOR = 1,
+    /**
+     * NAME_RESOLVER specifies that the plugin may override the Go names
+     * chosen for types, fields, and functions.
+     *
+     * If a plugin provides this, it MUST implement the NameResolver service.
+     */
+    NAME_RESOLVER = 2,
 
     // TODO: TAGGER for struct-tagging plugins
 }
@@ -383,6 +394,16 @@ struct GenerateServiceRequest {
      *  only be generated for module IDs listed here.
      */
     6: optional list<ModuleID> rootModules
+    /**
+     * Whether code for clients of the root services should be skipped, as
+     * it is with --only=servers.
+     */
+    7: optional bool noClients
+    /**
+     * Whether code for servers of the root services should be skipped, as
+     * it is with --only=clients.
+     */
+    8: optional bool noServers
 }
 
 /**
@@ -412,3 +433,78 @@ service ServiceGenerator {
      */
     GenerateServiceResponse generate(1: GenerateServiceRequest request)
 }
+
+//////////////////////////////////////////////////////////////////////////////
+
+/**
+ * EntityKind is the kind of a Thrift entity that is given a Go name.
+ */
+enum EntityKind {
+    TYPE = 1, // struct, union, exception, enum, or typedef
+    FIELD,    // field of a struct, union, or exception
+    FUNCTION, // function of a service
+}
+
+/**
+ * Entity is a Thrift entity that is given a Go name.
+ */
+struct Entity {
+    1: required EntityKind kind
+    /**
+     * Name of the entity as defined in the Thrift file.
+     */
+    2: required string thriftName
+    /**
+     * Name of the type or service to which this field or function belongs,
+     * as defined in the Thrift file. This is not set for types.
+     */
+    3: optional string parent
+    /**
+     * Path to the Thrift file in which this entity was defined.
+     */
+    4: required string thriftFilePath
+    /**
+     * Go name that will be used for this entity unless it is overridden.
+     * This takes go.name annotations and prior plugins into account.
+     */
+    5: required string goName
+    /**
+     * Annotations defined on this entity.
+     */
+    6: optional map<string, string> annotations
+}
+
+/**
+ * ResolveNamesRequest is a request to choose Go names for Thrift entities.
+ */
+struct ResolveNamesRequest {
+    1: required list<Entity> entities
+}
+
+/**
+ * ResolveNamesResponse is the response to a ResolveNamesRequest.
+ */
+struct ResolveNamesResponse {
+    /**
+     * Go names for the requested entities, in the same order. An empty
+     * string keeps the name the entity already has. If this is not set, no
+     * names are changed.
+     *
+     * Names MUST be exported Go identifiers and MUST NOT conflict with the
+     * names of other entities of the same kind in the same type, service, or
+     * Thrift file, or the request will fail.
+     */
+    1: optional list<string> names
+}
+
+/**
+ * NameResolver overrides the Go names chosen for Thrift entities.
+ *
+ * This MUST be implemented if the NAME_RESOLVER feature is enabled.
+ */
+service NameResolver {
+    /**
+     * Chooses Go names for the given entities.
+     */
+    ResolveNamesResponse resolveNames(1: ResolveNamesRequest request)
+}
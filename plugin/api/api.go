@@ -30,13 +30,17 @@ import (
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
+	binary "go.uber.org/thriftrw/protocol/binary"
 	stream "go.uber.org/thriftrw/protocol/stream"
+	thrifthash "go.uber.org/thriftrw/thrifthash"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
 	math "math"
+	runtime "runtime"
 	strconv "strconv"
 	strings "strings"
+	sync "sync"
 )
 
 // API_VERSION is the version of the plugin API.
@@ -506,6 +510,65 @@ func (v *Argument) Equals(rhs *Argument) bool {
 	return true
 }
 
+func _Map_String_String_Copy(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Argument.
+func (v *Argument) Copy() *Argument {
+	if v == nil {
+		return nil
+	}
+
+	var o Argument
+	o.Name = v.Name
+	o.Type = v.Type.Copy()
+	o.Annotations = _Map_String_String_Copy(v.Annotations)
+	return &o
+}
+
+func _Map_String_String_Hash(v map[string]string) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.String(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
+}
+
+// Hash returns a hash of this Argument which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Argument) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Uint64(v.Type.Hash())
+	h.Field(3)
+	h.Uint64(_Map_String_String_Hash(v.Annotations))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Argument so that it may be reused.
+func (v *Argument) Reset() {
+	*v = Argument{}
+}
+
 type _Map_String_String_Zapper map[string]string
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
@@ -569,1078 +632,1127 @@ func (v *Argument) IsSetAnnotations() bool {
 	return v != nil && v.Annotations != nil
 }
 
-// Feature is a functionality offered by a ThriftRW plugin.
-type Feature int32
+// Entity is a Thrift entity that is given a Go name.
+type Entity struct {
+	Kind EntityKind `json:"kind,required"`
+	// Name of the entity as defined in the Thrift file.
+	ThriftName string `json:"thriftName,required"`
+	// Name of the type or service to which this field or function belongs,
+	// as defined in the Thrift file. This is not set for types.
+	Parent *string `json:"parent,omitempty"`
+	// Path to the Thrift file in which this entity was defined.
+	ThriftFilePath string `json:"thriftFilePath,required"`
+	// Go name that will be used for this entity unless it is overridden.
+	// This takes go.name annotations and prior plugins into account.
+	GoName string `json:"goName,required"`
+	// Annotations defined on this entity.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
 
-const (
-	// SERVICE_GENERATOR specifies that the plugin may generate arbitrary code
-	// for services defined in the Thrift file.
-	//
-	// If a plugin provides this, it MUST implement the ServiceGenerator
-	// service.
-	FeatureServiceGenerator Feature = 1
-)
+// ToWire translates a Entity struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Entity) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// Feature_Values returns all recognized values of Feature.
-func Feature_Values() []Feature {
-	return []Feature{
-		FeatureServiceGenerator,
+	w, err = v.Kind.ToWire()
+	if err != nil {
+		return w, err
 	}
-}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
-// UnmarshalText tries to decode Feature from a byte slice
-// containing its name.
-//
-//   var v Feature
-//   err := v.UnmarshalText([]byte("SERVICE_GENERATOR"))
-func (v *Feature) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "SERVICE_GENERATOR":
-		*v = FeatureServiceGenerator
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Parent != nil {
+		w, err = wire.NewValueString(*(v.Parent)), error(nil)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Feature", err)
+			return w, err
 		}
-		*v = Feature(val)
-		return nil
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
 	}
-}
 
-// MarshalText encodes Feature to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v Feature) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return []byte("SERVICE_GENERATOR"), nil
+	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
+	if err != nil {
+		return w, err
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Feature.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v Feature) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 1:
-		enc.AddString("name", "SERVICE_GENERATOR")
+	w, err = wire.NewValueString(v.GoName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
 	}
-	return nil
-}
 
-// Ptr returns a pointer to this enum value.
-func (v Feature) Ptr() *Feature {
-	return &v
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// Encode encodes Feature directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v Feature
-//   return v.Encode(sWriter)
-func (v Feature) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
+func _EntityKind_Read(w wire.Value) (EntityKind, error) {
+	var v EntityKind
+	err := v.FromWire(w)
+	return v, err
 }
 
-// ToWire translates Feature into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// FromWire deserializes a Entity struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v Feature) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes Feature from its Thrift-level
-// representation.
+// An error is returned if we were unable to build a Entity struct
+// from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
 //   if err != nil {
-//     return Feature(0), err
+//     return nil, err
 //   }
 //
-//   var v Feature
+//   var v Entity
 //   if err := v.FromWire(x); err != nil {
-//     return Feature(0), err
+//     return nil, err
 //   }
-//   return v, nil
-func (v *Feature) FromWire(w wire.Value) error {
-	*v = (Feature)(w.GetI32())
+//   return &v, nil
+func (v *Entity) FromWire(w wire.Value) error {
+	var err error
+
+	kindIsSet := false
+	thriftNameIsSet := false
+
+	thriftFilePathIsSet := false
+	goNameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Kind, err = _EntityKind_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				kindIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftNameIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Parent = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftFilePathIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				v.GoName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				goNameIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !kindIsSet {
+		return errors.New("field Kind of Entity is required")
+	}
+
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Entity is required")
+	}
+
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Entity is required")
+	}
+
+	if !goNameIsSet {
+		return errors.New("field GoName of Entity is required")
+	}
+
 	return nil
 }
 
-// Decode reads off the encoded Feature directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
+// Encode serializes a Entity struct directly into bytes, without going
+// through an intermediary type.
 //
-//   var v Feature
-//   if err := v.Decode(sReader); err != nil {
-//     return Feature(0), err
-//   }
-//   return v, nil
-func (v *Feature) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
+// An error is returned if a Entity struct could not be encoded.
+func (v *Entity) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
-	*v = (Feature)(i)
-	return nil
-}
 
-// String returns a readable string representation of Feature.
-func (v Feature) String() string {
-	w := int32(v)
-	switch w {
-	case 1:
-		return "SERVICE_GENERATOR"
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+		return err
 	}
-	return fmt.Sprintf("Feature(%d)", w)
-}
-
-// Equals returns true if this Feature value matches the provided
-// value.
-func (v Feature) Equals(rhs Feature) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes Feature into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v Feature) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return ([]byte)("\"SERVICE_GENERATOR\""), nil
+	if err := v.Kind.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
 
-// UnmarshalJSON attempts to decode Feature from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *Feature) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ThriftName); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
 		return err
 	}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
+	if v.Parent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Feature")
+		if err := sw.WriteString(*(v.Parent)); err != nil {
+			return err
 		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Feature")
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		*v = (Feature)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Feature")
 	}
-}
 
-// Function is a single function on a Thrift service.
-type Function struct {
-	// Name of the Go function.
-	Name string `json:"name,required"`
-	// Name of the function as defined in the Thrift file.
-	ThriftName string `json:"thriftName,required"`
-	// List of arguments accepted by the function.
-	//
-	// This list is in the order specified by the user in the Thrift file.
-	Arguments []*Argument `json:"arguments,required"`
-	// Return type of the function, if any. If this is not set, the function
-	// is a void function.
-	ReturnType *Type `json:"returnType,omitempty"`
-	// List of exceptions raised by the function.
-	//
-	// This list is in the order specified by the user in the Thrift file.
-	Exceptions []*Argument `json:"exceptions,omitempty"`
-	// Whether this function is oneway or not. This should be assumed to be
-	// false unless explicitly stated otherwise. If this is true, the
-	// returnType and exceptions will be null or empty.
-	OneWay *bool `json:"oneWay,omitempty"`
-	// Annotations defined on this function.
-	//
-	// Given,
-	//
-	//   void setValue(1: SetValueRequest req) (cache = "false")
-	//
-	// The annotations will be,
-	//
-	//  {
-	//    "cache": "false",
-	//  }
-	Annotations map[string]string `json:"annotations,omitempty"`
-}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ThriftFilePath); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
 
-type _List_Argument_ValueList []*Argument
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.GoName); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
 
-func (v _List_Argument_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*Argument', index [%v]: value is nil", i)
+	if v.Annotations != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TMap}); err != nil {
+			return err
 		}
-		w, err := x.ToWire()
-		if err != nil {
+		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
 			return err
 		}
-		err = f(w)
-		if err != nil {
+		if err := sw.WriteFieldEnd(); err != nil {
 			return err
 		}
 	}
-	return nil
-}
 
-func (v _List_Argument_ValueList) Size() int {
-	return len(v)
+	return sw.WriteStructEnd()
 }
 
-func (_List_Argument_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+func _EntityKind_Decode(sr stream.Reader) (EntityKind, error) {
+	var v EntityKind
+	err := v.Decode(sr)
+	return v, err
 }
 
-func (_List_Argument_ValueList) Close() {}
-
-// ToWire translates a Function struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// Decode deserializes a Entity struct directly from its Thrift-level
+// representation, without going through an intemediary type.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *Function) ToWire() (wire.Value, error) {
-	var (
-		fields [7]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// An error is returned if a Entity struct could not be generated from the wire
+// representation.
+func (v *Entity) Decode(sr stream.Reader) error {
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
+	kindIsSet := false
+	thriftNameIsSet := false
 
-	w, err = wire.NewValueString(v.ThriftName), error(nil)
-	if err != nil {
-		return w, err
+	thriftFilePathIsSet := false
+	goNameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
-	w, err = wire.NewValueList(_List_Argument_ValueList(v.Arguments)), error(nil)
+	fh, ok, err := sr.ReadFieldBegin()
 	if err != nil {
-		return w, err
+		return err
 	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
-	if v.ReturnType != nil {
-		w, err = v.ReturnType.ToWire()
-		if err != nil {
-			return w, err
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.Kind, err = _EntityKind_Decode(sr)
+			if err != nil {
+				return err
+			}
+			kindIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ThriftName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftNameIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Parent = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			v.ThriftFilePath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftFilePathIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			v.GoName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			goNameIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.Exceptions != nil {
-		w, err = wire.NewValueList(_List_Argument_ValueList(v.Exceptions)), error(nil)
-		if err != nil {
-			return w, err
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.OneWay != nil {
-		w, err = wire.NewValueBool(*(v.OneWay)), error(nil)
-		if err != nil {
-			return w, err
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
 	}
-	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 7, Value: w}
-		i++
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _Argument_Read(w wire.Value) (*Argument, error) {
-	var v Argument
-	err := v.FromWire(w)
-	return &v, err
-}
 
-func _List_Argument_Read(l wire.ValueList) ([]*Argument, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
-	o := make([]*Argument, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Argument_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a Function struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a Function struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v Function
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *Function) FromWire(w wire.Value) error {
-	var err error
-
-	nameIsSet := false
-	thriftNameIsSet := false
-	argumentsIsSet := false
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				nameIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.ThriftName, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				thriftNameIsSet = true
-			}
-		case 3:
-			if field.Value.Type() == wire.TList {
-				v.Arguments, err = _List_Argument_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-				argumentsIsSet = true
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.ReturnType, err = _Type_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.Exceptions, err = _List_Argument_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 6:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.OneWay = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 7:
-			if field.Value.Type() == wire.TMap {
-				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		}
+	if !kindIsSet {
+		return errors.New("field Kind of Entity is required")
 	}
 
-	if !nameIsSet {
-		return errors.New("field Name of Function is required")
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Entity is required")
 	}
 
-	if !thriftNameIsSet {
-		return errors.New("field ThriftName of Function is required")
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Entity is required")
 	}
 
-	if !argumentsIsSet {
-		return errors.New("field Arguments of Function is required")
+	if !goNameIsSet {
+		return errors.New("field GoName of Entity is required")
 	}
 
 	return nil
 }
 
-func _List_Argument_Encode(val []*Argument, sw stream.Writer) error {
+// String returns a readable string representation of a Entity
+// struct.
+func (v *Entity) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("Kind: %v", v.Kind)
+	i++
+	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
+	i++
+	if v.Parent != nil {
+		fields[i] = fmt.Sprintf("Parent: %v", *(v.Parent))
+		i++
 	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
+	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	i++
+	fields[i] = fmt.Sprintf("GoName: %v", v.GoName)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
 	}
 
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*Argument', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
+	return fmt.Sprintf("Entity{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
 	}
-	return sw.WriteListEnd()
+	return lhs == nil && rhs == nil
 }
 
-// Encode serializes a Function struct directly into bytes, without going
-// through an intermediary type.
+// Equals returns true if all the fields of this Entity match the
+// provided Entity.
 //
-// An error is returned if a Function struct could not be encoded.
-func (v *Function) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
+// This function performs a deep comparison.
+func (v *Entity) Equals(rhs *Entity) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
+	if !v.Kind.Equals(rhs.Kind) {
+		return false
 	}
-	if err := sw.WriteString(v.Name); err != nil {
-		return err
+	if !(v.ThriftName == rhs.ThriftName) {
+		return false
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	if !_String_EqualsPtr(v.Parent, rhs.Parent) {
+		return false
 	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
-		return err
+	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+		return false
 	}
-	if err := sw.WriteString(v.ThriftName); err != nil {
-		return err
+	if !(v.GoName == rhs.GoName) {
+		return false
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
-		return err
-	}
-	if err := _List_Argument_Encode(v.Arguments, sw); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
+	return true
+}
 
-	if v.ReturnType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ReturnType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+func _String_CopyPtr(v *string) *string {
+	if v == nil {
+		return nil
 	}
 
-	if v.Exceptions != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_Argument_Encode(v.Exceptions, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Entity.
+func (v *Entity) Copy() *Entity {
+	if v == nil {
+		return nil
 	}
 
-	if v.OneWay != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.OneWay)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	var o Entity
+	o.Kind = v.Kind
+	o.ThriftName = v.ThriftName
+	o.Parent = _String_CopyPtr(v.Parent)
+	o.ThriftFilePath = v.ThriftFilePath
+	o.GoName = v.GoName
+	o.Annotations = _Map_String_String_Copy(v.Annotations)
+	return &o
+}
+
+// Hash returns a hash of this Entity which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Entity) Hash() uint64 {
+	if v == nil {
+		return 0
 	}
 
-	if v.Annotations != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
-			return err
-		}
-		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	h := thrifthash.New()
+	h.Field(1)
+	h.Int32(int32(v.Kind))
+	h.Field(2)
+	h.String(v.ThriftName)
+	if v.Parent != nil {
+		h.Field(3)
+		h.String(*v.Parent)
 	}
+	h.Field(4)
+	h.String(v.ThriftFilePath)
+	h.Field(5)
+	h.String(v.GoName)
+	h.Field(6)
+	h.Uint64(_Map_String_String_Hash(v.Annotations))
+	return h.Sum64()
+}
 
-	return sw.WriteStructEnd()
+// Reset zeroes all fields of this Entity so that it may be reused.
+func (v *Entity) Reset() {
+	*v = Entity{}
 }
 
-func _Argument_Decode(sr stream.Reader) (*Argument, error) {
-	var v Argument
-	err := v.Decode(sr)
-	return &v, err
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Entity.
+func (v *Entity) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("kind", v.Kind))
+	enc.AddString("thriftName", v.ThriftName)
+	if v.Parent != nil {
+		enc.AddString("parent", *v.Parent)
+	}
+	enc.AddString("thriftFilePath", v.ThriftFilePath)
+	enc.AddString("goName", v.GoName)
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	return err
 }
 
-func _List_Argument_Decode(sr stream.Reader) ([]*Argument, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
+// GetKind returns the value of Kind if it is set or its
+// zero value if it is unset.
+func (v *Entity) GetKind() (o EntityKind) {
+	if v != nil {
+		o = v.Kind
 	}
+	return
+}
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+func (v *Entity) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
 	}
+	return
+}
 
-	o := make([]*Argument, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _Argument_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
+// GetParent returns the value of Parent if it is set or its
+// zero value if it is unset.
+func (v *Entity) GetParent() (o string) {
+	if v != nil && v.Parent != nil {
+		return *v.Parent
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+	return
 }
 
-// Decode deserializes a Function struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a Function struct could not be generated from the wire
-// representation.
-func (v *Function) Decode(sr stream.Reader) error {
-
-	nameIsSet := false
-	thriftNameIsSet := false
-	argumentsIsSet := false
+// IsSetParent returns true if Parent is not nil.
+func (v *Entity) IsSetParent() bool {
+	return v != nil && v.Parent != nil
+}
 
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
+// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
+// zero value if it is unset.
+func (v *Entity) GetThriftFilePath() (o string) {
+	if v != nil {
+		o = v.ThriftFilePath
 	}
+	return
+}
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+// GetGoName returns the value of GoName if it is set or its
+// zero value if it is unset.
+func (v *Entity) GetGoName() (o string) {
+	if v != nil {
+		o = v.GoName
 	}
+	return
+}
 
-	for ok {
-		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Name, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			nameIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TBinary:
-			v.ThriftName, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			thriftNameIsSet = true
-		case fh.ID == 3 && fh.Type == wire.TList:
-			v.Arguments, err = _List_Argument_Decode(sr)
-			if err != nil {
-				return err
-			}
-			argumentsIsSet = true
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.ReturnType, err = _Type_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 5 && fh.Type == wire.TList:
-			v.Exceptions, err = _List_Argument_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 6 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.OneWay = &x
-			if err != nil {
-				return err
-			}
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *Entity) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
 
-		case fh.ID == 7 && fh.Type == wire.TMap:
-			v.Annotations, err = _Map_String_String_Decode(sr)
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Entity) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
 
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
+// EntityKind is the kind of a Thrift entity that is given a Go name.
+type EntityKind int32
 
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
-	}
+const (
+	EntityKindType     EntityKind = 1
+	EntityKindField    EntityKind = 2
+	EntityKindFunction EntityKind = 3
+)
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
+// EntityKind_Values returns all recognized values of EntityKind.
+func EntityKind_Values() []EntityKind {
+	return []EntityKind{
+		EntityKindType,
+		EntityKindField,
+		EntityKindFunction,
 	}
+}
 
-	if !nameIsSet {
-		return errors.New("field Name of Function is required")
+// EntityKind_Names returns the names of all recognized values of
+// EntityKind, in the same order as EntityKind_Values.
+func EntityKind_Names() []string {
+	return []string{
+		"TYPE",
+		"FIELD",
+		"FUNCTION",
 	}
+}
 
-	if !thriftNameIsSet {
-		return errors.New("field ThriftName of Function is required")
+// IsValid returns true if this EntityKind is one of its recognized
+// values.
+func (v EntityKind) IsValid() bool {
+	switch int32(v) {
+	case 1, 2, 3:
+		return true
 	}
+	return false
+}
 
-	if !argumentsIsSet {
-		return errors.New("field Arguments of Function is required")
+// UnmarshalText tries to decode EntityKind from a byte slice
+// containing its name.
+//
+//   var v EntityKind
+//   err := v.UnmarshalText([]byte("TYPE"))
+func (v *EntityKind) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "TYPE":
+		*v = EntityKindType
+		return nil
+	case "FIELD":
+		*v = EntityKindField
+		return nil
+	case "FUNCTION":
+		*v = EntityKindFunction
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "EntityKind", err)
+		}
+		*v = EntityKind(val)
+		return nil
 	}
-
-	return nil
 }
 
-// String returns a readable string representation of a Function
-// struct.
-func (v *Function) String() string {
-	if v == nil {
-		return "<nil>"
+// MarshalText encodes EntityKind to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v EntityKind) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return []byte("TYPE"), nil
+	case 2:
+		return []byte("FIELD"), nil
+	case 3:
+		return []byte("FUNCTION"), nil
 	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
 
-	var fields [7]string
-	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
-	i++
-	fields[i] = fmt.Sprintf("Arguments: %v", v.Arguments)
-	i++
-	if v.ReturnType != nil {
-		fields[i] = fmt.Sprintf("ReturnType: %v", v.ReturnType)
-		i++
-	}
-	if v.Exceptions != nil {
-		fields[i] = fmt.Sprintf("Exceptions: %v", v.Exceptions)
-		i++
-	}
-	if v.OneWay != nil {
-		fields[i] = fmt.Sprintf("OneWay: %v", *(v.OneWay))
-		i++
-	}
-	if v.Annotations != nil {
-		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
-		i++
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EntityKind.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v EntityKind) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "TYPE")
+	case 2:
+		enc.AddString("name", "FIELD")
+	case 3:
+		enc.AddString("name", "FUNCTION")
 	}
-
-	return fmt.Sprintf("Function{%v}", strings.Join(fields[:i], ", "))
+	return nil
 }
 
-func _List_Argument_Equals(lhs, rhs []*Argument) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+// Ptr returns a pointer to this enum value.
+func (v EntityKind) Ptr() *EntityKind {
+	return &v
+}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
+// Set sets EntityKind from its name or integer value.
+//
+// This implements flag.Value, allowing EntityKind to be used as a
+// command line flag.
+func (v *EntityKind) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
 
-	return true
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v EntityKind) Type() string {
+	return "EntityKind"
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
+// Encode encodes EntityKind directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v EntityKind
+//   return v.Encode(sWriter)
+func (v EntityKind) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+// ToWire translates EntityKind into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v EntityKind) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
 }
 
-// Equals returns true if all the fields of this Function match the
-// provided Function.
+// FromWire deserializes EntityKind from its Thrift-level
+// representation.
 //
-// This function performs a deep comparison.
-func (v *Function) Equals(rhs *Function) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !(v.ThriftName == rhs.ThriftName) {
-		return false
-	}
-	if !_List_Argument_Equals(v.Arguments, rhs.Arguments) {
-		return false
-	}
-	if !((v.ReturnType == nil && rhs.ReturnType == nil) || (v.ReturnType != nil && rhs.ReturnType != nil && v.ReturnType.Equals(rhs.ReturnType))) {
-		return false
-	}
-	if !((v.Exceptions == nil && rhs.Exceptions == nil) || (v.Exceptions != nil && rhs.Exceptions != nil && _List_Argument_Equals(v.Exceptions, rhs.Exceptions))) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.OneWay, rhs.OneWay) {
-		return false
-	}
-	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
-		return false
-	}
-
-	return true
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return EntityKind(0), err
+//   }
+//
+//   var v EntityKind
+//   if err := v.FromWire(x); err != nil {
+//     return EntityKind(0), err
+//   }
+//   return v, nil
+func (v *EntityKind) FromWire(w wire.Value) error {
+	*v = (EntityKind)(w.GetI32())
+	return nil
 }
 
-type _List_Argument_Zapper []*Argument
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Argument_Zapper.
-func (l _List_Argument_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+// Decode reads off the encoded EntityKind directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EntityKind
+//   if err := v.Decode(sReader); err != nil {
+//     return EntityKind(0), err
+//   }
+//   return v, nil
+func (v *EntityKind) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
 	}
-	return err
+	*v = (EntityKind)(i)
+	return nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Function.
-func (v *Function) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	enc.AddString("name", v.Name)
-	enc.AddString("thriftName", v.ThriftName)
-	err = multierr.Append(err, enc.AddArray("arguments", (_List_Argument_Zapper)(v.Arguments)))
-	if v.ReturnType != nil {
-		err = multierr.Append(err, enc.AddObject("returnType", v.ReturnType))
-	}
-	if v.Exceptions != nil {
-		err = multierr.Append(err, enc.AddArray("exceptions", (_List_Argument_Zapper)(v.Exceptions)))
-	}
-	if v.OneWay != nil {
-		enc.AddBool("oneWay", *v.OneWay)
-	}
-	if v.Annotations != nil {
-		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+// String returns a readable string representation of EntityKind.
+func (v EntityKind) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "TYPE"
+	case 2:
+		return "FIELD"
+	case 3:
+		return "FUNCTION"
 	}
-	return err
+	return fmt.Sprintf("EntityKind(%d)", w)
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *Function) GetName() (o string) {
-	if v != nil {
-		o = v.Name
-	}
-	return
+// Equals returns true if this EntityKind value matches the provided
+// value.
+func (v EntityKind) Equals(rhs EntityKind) bool {
+	return v == rhs
 }
 
-// GetThriftName returns the value of ThriftName if it is set or its
-// zero value if it is unset.
-func (v *Function) GetThriftName() (o string) {
-	if v != nil {
-		o = v.ThriftName
+// MarshalJSON serializes EntityKind into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v EntityKind) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"TYPE\""), nil
+	case 2:
+		return ([]byte)("\"FIELD\""), nil
+	case 3:
+		return ([]byte)("\"FUNCTION\""), nil
 	}
-	return
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// GetArguments returns the value of Arguments if it is set or its
-// zero value if it is unset.
-func (v *Function) GetArguments() (o []*Argument) {
-	if v != nil {
-		o = v.Arguments
+// UnmarshalJSON attempts to decode EntityKind from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *EntityKind) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
-	return
-}
 
-// IsSetArguments returns true if Arguments is not nil.
-func (v *Function) IsSetArguments() bool {
-	return v != nil && v.Arguments != nil
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "EntityKind")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "EntityKind")
+		}
+		*v = (EntityKind)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "EntityKind")
+	}
 }
 
-// GetReturnType returns the value of ReturnType if it is set or its
-// zero value if it is unset.
-func (v *Function) GetReturnType() (o *Type) {
-	if v != nil && v.ReturnType != nil {
-		return v.ReturnType
-	}
+// Feature is a functionality offered by a ThriftRW plugin.
+type Feature int32
 
-	return
-}
+const (
+	// SERVICE_GENERATOR specifies that the plugin may generate arbitrary code
+	// for services defined in the Thrift file.
+	//
+	// If a plugin provides this, it MUST implement the ServiceGenerator
+	// service.
+	FeatureServiceGenerator Feature = 1
+	// NAME_RESOLVER specifies that the plugin may override the Go names
+	// chosen for types, fields, and functions.
+	//
+	// If a plugin provides this, it MUST implement the NameResolver service.
+	FeatureNameResolver Feature = 2
+)
 
-// IsSetReturnType returns true if ReturnType is not nil.
-func (v *Function) IsSetReturnType() bool {
-	return v != nil && v.ReturnType != nil
+// Feature_Values returns all recognized values of Feature.
+func Feature_Values() []Feature {
+	return []Feature{
+		FeatureServiceGenerator,
+		FeatureNameResolver,
+	}
 }
 
-// GetExceptions returns the value of Exceptions if it is set or its
-// zero value if it is unset.
-func (v *Function) GetExceptions() (o []*Argument) {
-	if v != nil && v.Exceptions != nil {
-		return v.Exceptions
+// Feature_Names returns the names of all recognized values of
+// Feature, in the same order as Feature_Values.
+func Feature_Names() []string {
+	return []string{
+		"SERVICE_GENERATOR",
+		"NAME_RESOLVER",
 	}
-
-	return
 }
 
-// IsSetExceptions returns true if Exceptions is not nil.
-func (v *Function) IsSetExceptions() bool {
-	return v != nil && v.Exceptions != nil
+// IsValid returns true if this Feature is one of its recognized
+// values.
+func (v Feature) IsValid() bool {
+	switch int32(v) {
+	case 1, 2:
+		return true
+	}
+	return false
 }
 
-// GetOneWay returns the value of OneWay if it is set or its
-// zero value if it is unset.
-func (v *Function) GetOneWay() (o bool) {
-	if v != nil && v.OneWay != nil {
-		return *v.OneWay
+// UnmarshalText tries to decode Feature from a byte slice
+// containing its name.
+//
+//   var v Feature
+//   err := v.UnmarshalText([]byte("SERVICE_GENERATOR"))
+func (v *Feature) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "SERVICE_GENERATOR":
+		*v = FeatureServiceGenerator
+		return nil
+	case "NAME_RESOLVER":
+		*v = FeatureNameResolver
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Feature", err)
+		}
+		*v = Feature(val)
+		return nil
 	}
-
-	return
 }
 
-// IsSetOneWay returns true if OneWay is not nil.
-func (v *Function) IsSetOneWay() bool {
-	return v != nil && v.OneWay != nil
+// MarshalText encodes Feature to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Feature) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return []byte("SERVICE_GENERATOR"), nil
+	case 2:
+		return []byte("NAME_RESOLVER"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// GetAnnotations returns the value of Annotations if it is set or its
-// zero value if it is unset.
-func (v *Function) GetAnnotations() (o map[string]string) {
-	if v != nil && v.Annotations != nil {
-		return v.Annotations
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Feature.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Feature) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "SERVICE_GENERATOR")
+	case 2:
+		enc.AddString("name", "NAME_RESOLVER")
 	}
+	return nil
+}
 
-	return
+// Ptr returns a pointer to this enum value.
+func (v Feature) Ptr() *Feature {
+	return &v
 }
 
-// IsSetAnnotations returns true if Annotations is not nil.
-func (v *Function) IsSetAnnotations() bool {
-	return v != nil && v.Annotations != nil
+// Set sets Feature from its name or integer value.
+//
+// This implements flag.Value, allowing Feature to be used as a
+// command line flag.
+func (v *Feature) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
 }
 
-// GenerateServiceRequest is a request to generate code for zero or more
-// Thrift services.
-type GenerateServiceRequest struct {
-	// IDs of services for which code should be generated.
-	//
-	// Note that the services map contains information about both, the
-	// services being generated and their transitive dependencies. Code should
-	// only be generated for service IDs listed here.
-	RootServices []ServiceID `json:"rootServices,required"`
-	// Map of service ID to service.
-	//
-	// Any service IDs present in this request will have a corresponding
-	// service definition in this map, including services for which code does
-	// not need to be generated.
-	Services map[ServiceID]*Service `json:"services,required"`
-	// Map of module ID to module.
-	//
-	// Any module IDs present in the request will have a corresponding module
-	// definition in this map.
-	Modules map[ModuleID]*Module `json:"modules,required"`
-	// Prefix for import paths of generated module. In general, plugins should
-	// not need to use the package prefix unless instantiating a new
-	// Generator for more custom plugin generation.
-	PackagePrefix string `json:"packagePrefix,required"`
-	// Directory whose descendants contain all Thrift files. In general,
-	// plugins should not need to use the thrift root unless instantiating a
-	// new Generator for more custom plugin generation.
-	ThriftRoot string `json:"thriftRoot,required"`
-	// IDs of Modules for which code should be generated.
-	//
-	// Note that the modules map contains information about both, the
-	// modules being generated and their transitive dependencies. Code should
-	// only be generated for module IDs listed here.
-	RootModules []ModuleID `json:"rootModules,omitempty"`
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v Feature) Type() string {
+	return "Feature"
 }
 
-type _List_ServiceID_ValueList []ServiceID
-
-func (v _List_ServiceID_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+// Encode encodes Feature directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v Feature
+//   return v.Encode(sWriter)
+func (v Feature) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
 }
 
-func (v _List_ServiceID_ValueList) Size() int {
-	return len(v)
+// ToWire translates Feature into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Feature) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
 }
 
-func (_List_ServiceID_ValueList) ValueType() wire.Type {
-	return wire.TI32
+// FromWire deserializes Feature from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Feature(0), err
+//   }
+//
+//   var v Feature
+//   if err := v.FromWire(x); err != nil {
+//     return Feature(0), err
+//   }
+//   return v, nil
+func (v *Feature) FromWire(w wire.Value) error {
+	*v = (Feature)(w.GetI32())
+	return nil
 }
 
-func (_List_ServiceID_ValueList) Close() {}
-
-type _Map_ServiceID_Service_MapItemList map[ServiceID]*Service
-
-func (m _Map_ServiceID_Service_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[ServiceID]*Service', key [%v]: value is nil", k)
-		}
-		kw, err := k.ToWire()
-		if err != nil {
-			return err
-		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
+// Decode reads off the encoded Feature directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Feature
+//   if err := v.Decode(sReader); err != nil {
+//     return Feature(0), err
+//   }
+//   return v, nil
+func (v *Feature) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
 	}
+	*v = (Feature)(i)
 	return nil
 }
 
-func (m _Map_ServiceID_Service_MapItemList) Size() int {
-	return len(m)
+// String returns a readable string representation of Feature.
+func (v Feature) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "SERVICE_GENERATOR"
+	case 2:
+		return "NAME_RESOLVER"
+	}
+	return fmt.Sprintf("Feature(%d)", w)
 }
 
-func (_Map_ServiceID_Service_MapItemList) KeyType() wire.Type {
-	return wire.TI32
+// Equals returns true if this Feature value matches the provided
+// value.
+func (v Feature) Equals(rhs Feature) bool {
+	return v == rhs
 }
 
-func (_Map_ServiceID_Service_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// MarshalJSON serializes Feature into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Feature) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"SERVICE_GENERATOR\""), nil
+	case 2:
+		return ([]byte)("\"NAME_RESOLVER\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (_Map_ServiceID_Service_MapItemList) Close() {}
-
-type _Map_ModuleID_Module_MapItemList map[ModuleID]*Module
+// UnmarshalJSON attempts to decode Feature from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Feature) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
 
-func (m _Map_ModuleID_Module_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[ModuleID]*Module', key [%v]: value is nil", k)
-		}
-		kw, err := k.ToWire()
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
 		if err != nil {
 			return err
 		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Feature")
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Feature")
 		}
+		*v = (Feature)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Feature")
 	}
-	return nil
-}
-
-func (m _Map_ModuleID_Module_MapItemList) Size() int {
-	return len(m)
 }
 
-func (_Map_ModuleID_Module_MapItemList) KeyType() wire.Type {
-	return wire.TI32
-}
-
-func (_Map_ModuleID_Module_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// Function is a single function on a Thrift service.
+type Function struct {
+	// Name of the Go function.
+	Name string `json:"name,required"`
+	// Name of the function as defined in the Thrift file.
+	ThriftName string `json:"thriftName,required"`
+	// List of arguments accepted by the function.
+	//
+	// This list is in the order specified by the user in the Thrift file.
+	Arguments []*Argument `json:"arguments,required"`
+	// Return type of the function, if any. If this is not set, the function
+	// is a void function.
+	ReturnType *Type `json:"returnType,omitempty"`
+	// List of exceptions raised by the function.
+	//
+	// This list is in the order specified by the user in the Thrift file.
+	Exceptions []*Argument `json:"exceptions,omitempty"`
+	// Whether this function is oneway or not. This should be assumed to be
+	// false unless explicitly stated otherwise. If this is true, the
+	// returnType and exceptions will be null or empty.
+	OneWay *bool `json:"oneWay,omitempty"`
+	// Annotations defined on this function.
+	//
+	// Given,
+	//
+	//   void setValue(1: SetValueRequest req) (cache = "false")
+	//
+	// The annotations will be,
+	//
+	//  {
+	//    "cache": "false",
+	//  }
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
-func (_Map_ModuleID_Module_MapItemList) Close() {}
-
-type _List_ModuleID_ValueList []ModuleID
+type _List_Argument_ValueList []*Argument
 
-func (v _List_ModuleID_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
+func (v _List_Argument_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Argument', index [%v]: value is nil", i)
+		}
 		w, err := x.ToWire()
 		if err != nil {
 			return err
@@ -1653,17 +1765,17 @@ func (v _List_ModuleID_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_ModuleID_ValueList) Size() int {
+func (v _List_Argument_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_ModuleID_ValueList) ValueType() wire.Type {
-	return wire.TI32
+func (_List_Argument_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-func (_List_ModuleID_ValueList) Close() {}
+func (_List_Argument_ValueList) Close() {}
 
-// ToWire translates a GenerateServiceRequest struct into a Thrift-level intermediate
+// ToWire translates a Function struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1678,170 +1790,84 @@ func (_List_ModuleID_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GenerateServiceRequest) ToWire() (wire.Value, error) {
+func (v *Function) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueList(_List_ServiceID_ValueList(v.RootServices)), error(nil)
+	w, err = wire.NewValueString(v.Name), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-	if v.Services == nil {
-		return w, errors.New("field Services of GenerateServiceRequest is required")
-	}
-	w, err = wire.NewValueMap(_Map_ServiceID_Service_MapItemList(v.Services)), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-	if v.Modules == nil {
-		return w, errors.New("field Modules of GenerateServiceRequest is required")
-	}
-	w, err = wire.NewValueMap(_Map_ModuleID_Module_MapItemList(v.Modules)), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
 
-	w, err = wire.NewValueString(v.PackagePrefix), error(nil)
+	w, err = wire.NewValueString(v.ThriftName), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 4, Value: w}
+	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.ThriftRoot), error(nil)
+	w, err = wire.NewValueList(_List_Argument_ValueList(v.Arguments)), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 5, Value: w}
+	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
-	if v.RootModules != nil {
-		w, err = wire.NewValueList(_List_ModuleID_ValueList(v.RootModules)), error(nil)
+	if v.ReturnType != nil {
+		w, err = v.ReturnType.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ServiceID_Read(w wire.Value) (ServiceID, error) {
-	var x ServiceID
-	err := x.FromWire(w)
-	return x, err
-}
-
-func _List_ServiceID_Read(l wire.ValueList) ([]ServiceID, error) {
-	if l.ValueType() != wire.TI32 {
-		return nil, nil
-	}
-
-	o := make([]ServiceID, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ServiceID_Read(x)
+	if v.Exceptions != nil {
+		w, err = wire.NewValueList(_List_Argument_ValueList(v.Exceptions)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _Service_Read(w wire.Value) (*Service, error) {
-	var v Service
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _Map_ServiceID_Service_Read(m wire.MapItemList) (map[ServiceID]*Service, error) {
-	if m.KeyType() != wire.TI32 {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
 	}
-
-	o := make(map[ServiceID]*Service, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := _ServiceID_Read(x.Key)
+	if v.OneWay != nil {
+		w, err = wire.NewValueBool(*(v.OneWay)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-
-		v, err := _Service_Read(x.Value)
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-func _ModuleID_Read(w wire.Value) (ModuleID, error) {
-	var x ModuleID
-	err := x.FromWire(w)
-	return x, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Module_Read(w wire.Value) (*Module, error) {
-	var v Module
+func _Argument_Read(w wire.Value) (*Argument, error) {
+	var v Argument
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _Map_ModuleID_Module_Read(m wire.MapItemList) (map[ModuleID]*Module, error) {
-	if m.KeyType() != wire.TI32 {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[ModuleID]*Module, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := _ModuleID_Read(x.Key)
-		if err != nil {
-			return err
-		}
-
-		v, err := _Module_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-func _List_ModuleID_Read(l wire.ValueList) ([]ModuleID, error) {
-	if l.ValueType() != wire.TI32 {
+func _List_Argument_Read(l wire.ValueList) ([]*Argument, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]ModuleID, 0, l.Size())
+	o := make([]*Argument, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ModuleID_Read(x)
+		i, err := _Argument_Read(x)
 		if err != nil {
 			return err
 		}
@@ -1852,11 +1878,11 @@ func _List_ModuleID_Read(l wire.ValueList) ([]ModuleID, error) {
 	return o, err
 }
 
-// FromWire deserializes a GenerateServiceRequest struct from its Thrift-level
+// FromWire deserializes a Function struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GenerateServiceRequest struct
+// An error is returned if we were unable to build a Function struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1864,254 +1890,245 @@ func _List_ModuleID_Read(l wire.ValueList) ([]ModuleID, error) {
 //     return nil, err
 //   }
 //
-//   var v GenerateServiceRequest
+//   var v Function
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GenerateServiceRequest) FromWire(w wire.Value) error {
+func (v *Function) FromWire(w wire.Value) error {
 	var err error
 
-	rootServicesIsSet := false
-	servicesIsSet := false
-	modulesIsSet := false
-	packagePrefixIsSet := false
-	thriftRootIsSet := false
+	nameIsSet := false
+	thriftNameIsSet := false
+	argumentsIsSet := false
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TList {
-				v.RootServices, err = _List_ServiceID_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				rootServicesIsSet = true
+				nameIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TMap {
-				v.Services, err = _Map_ServiceID_Service_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftName, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				servicesIsSet = true
+				thriftNameIsSet = true
 			}
 		case 3:
-			if field.Value.Type() == wire.TMap {
-				v.Modules, err = _Map_ModuleID_Module_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TList {
+				v.Arguments, err = _List_Argument_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
-				modulesIsSet = true
+				argumentsIsSet = true
 			}
 		case 4:
-			if field.Value.Type() == wire.TBinary {
-				v.PackagePrefix, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TStruct {
+				v.ReturnType, err = _Type_Read(field.Value)
 				if err != nil {
 					return err
 				}
-				packagePrefixIsSet = true
+
 			}
 		case 5:
-			if field.Value.Type() == wire.TBinary {
-				v.ThriftRoot, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TList {
+				v.Exceptions, err = _List_Argument_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
-				thriftRootIsSet = true
+
 			}
 		case 6:
-			if field.Value.Type() == wire.TList {
-				v.RootModules, err = _List_ModuleID_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.OneWay = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
-
-	if !rootServicesIsSet {
-		return errors.New("field RootServices of GenerateServiceRequest is required")
-	}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
 
-	if !servicesIsSet {
-		return errors.New("field Services of GenerateServiceRequest is required")
+			}
+		}
 	}
 
-	if !modulesIsSet {
-		return errors.New("field Modules of GenerateServiceRequest is required")
+	if !nameIsSet {
+		return errors.New("field Name of Function is required")
 	}
 
-	if !packagePrefixIsSet {
-		return errors.New("field PackagePrefix of GenerateServiceRequest is required")
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Function is required")
 	}
 
-	if !thriftRootIsSet {
-		return errors.New("field ThriftRoot of GenerateServiceRequest is required")
+	if !argumentsIsSet {
+		return errors.New("field Arguments of Function is required")
 	}
 
 	return nil
 }
 
-func _List_ServiceID_Encode(val []ServiceID, sw stream.Writer) error {
+func _List_Argument_Encode(val []*Argument, sw stream.Writer) error {
 
 	lh := stream.ListHeader{
-		Type:   wire.TI32,
+		Type:   wire.TStruct,
 		Length: len(val),
 	}
 	if err := sw.WriteListBegin(lh); err != nil {
 		return err
 	}
-
-	for _, v := range val {
-		if err := v.Encode(sw); err != nil {
-			return err
+	type chunk struct {
+		idx    int
+		val    []*Argument
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Argument', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
 		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
 	}
 	return sw.WriteListEnd()
 }
 
-func _Map_ServiceID_Service_Encode(val map[ServiceID]*Service, sw stream.Writer) error {
+// Encode serializes a Function struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Function struct could not be encoded.
+func (v *Function) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
 
-	mh := stream.MapHeader{
-		KeyType:   wire.TI32,
-		ValueType: wire.TStruct,
-		Length:    len(val),
-	}
-	if err := sw.WriteMapBegin(mh); err != nil {
-		return err
-	}
-
-	for k, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[ServiceID]*Service', key [%v]: value is nil", k)
-		}
-		if err := k.Encode(sw); err != nil {
-			return err
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteMapEnd()
-}
-
-func _Map_ModuleID_Module_Encode(val map[ModuleID]*Module, sw stream.Writer) error {
-
-	mh := stream.MapHeader{
-		KeyType:   wire.TI32,
-		ValueType: wire.TStruct,
-		Length:    len(val),
-	}
-	if err := sw.WriteMapBegin(mh); err != nil {
-		return err
-	}
-
-	for k, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[ModuleID]*Module', key [%v]: value is nil", k)
-		}
-		if err := k.Encode(sw); err != nil {
-			return err
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteMapEnd()
-}
-
-func _List_ModuleID_Encode(val []ModuleID, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TI32,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for _, v := range val {
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a GenerateServiceRequest struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a GenerateServiceRequest struct could not be encoded.
-func (v *GenerateServiceRequest) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
 		return err
 	}
-	if err := _List_ServiceID_Encode(v.RootServices, sw); err != nil {
+	if err := sw.WriteString(v.Name); err != nil {
 		return err
 	}
 	if err := sw.WriteFieldEnd(); err != nil {
 		return err
 	}
 
-	if v.Services == nil {
-		return errors.New("field Services of GenerateServiceRequest is required")
-	}
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TMap}); err != nil {
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
 		return err
 	}
-	if err := _Map_ServiceID_Service_Encode(v.Services, sw); err != nil {
+	if err := sw.WriteString(v.ThriftName); err != nil {
 		return err
 	}
 	if err := sw.WriteFieldEnd(); err != nil {
 		return err
 	}
 
-	if v.Modules == nil {
-		return errors.New("field Modules of GenerateServiceRequest is required")
-	}
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
 		return err
 	}
-	if err := _Map_ModuleID_Module_Encode(v.Modules, sw); err != nil {
+	if err := _List_Argument_Encode(v.Arguments, sw); err != nil {
 		return err
 	}
 	if err := sw.WriteFieldEnd(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.PackagePrefix); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	if v.ReturnType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ReturnType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.ThriftRoot); err != nil {
-		return err
+	if v.Exceptions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_Argument_Encode(v.Exceptions, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.OneWay != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.OneWay)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if v.RootModules != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TList}); err != nil {
+	if v.Annotations != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TMap}); err != nil {
 			return err
 		}
-		if err := _List_ModuleID_Encode(v.RootModules, sw); err != nil {
+		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2122,19 +2139,19 @@ func (v *GenerateServiceRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ServiceID_Decode(sr stream.Reader) (ServiceID, error) {
-	var x ServiceID
-	err := x.Decode(sr)
-	return x, err
+func _Argument_Decode(sr stream.Reader) (*Argument, error) {
+	var v Argument
+	err := v.Decode(sr)
+	return &v, err
 }
 
-func _List_ServiceID_Decode(sr stream.Reader) ([]ServiceID, error) {
+func _List_Argument_Decode(sr stream.Reader) ([]*Argument, error) {
 	lh, err := sr.ReadListBegin()
 	if err != nil {
 		return nil, err
 	}
 
-	if lh.Type != wire.TI32 {
+	if lh.Type != wire.TStruct {
 		for i := 0; i < lh.Length; i++ {
 			if err := sr.Skip(lh.Type); err != nil {
 				return nil, err
@@ -2143,9 +2160,9 @@ func _List_ServiceID_Decode(sr stream.Reader) ([]ServiceID, error) {
 		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]ServiceID, 0, lh.Length)
+	o := make([]*Argument, 0, lh.Length)
 	for i := 0; i < lh.Length; i++ {
-		v, err := _ServiceID_Decode(sr)
+		v, err := _Argument_Decode(sr)
 		if err != nil {
 			return nil, err
 		}
@@ -2158,271 +2175,149 @@ func _List_ServiceID_Decode(sr stream.Reader) ([]ServiceID, error) {
 	return o, err
 }
 
-func _Service_Decode(sr stream.Reader) (*Service, error) {
-	var v Service
-	err := v.Decode(sr)
-	return &v, err
-}
+// Decode deserializes a Function struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Function struct could not be generated from the wire
+// representation.
+func (v *Function) Decode(sr stream.Reader) error {
 
-func _Map_ServiceID_Service_Decode(sr stream.Reader) (map[ServiceID]*Service, error) {
-	mh, err := sr.ReadMapBegin()
+	nameIsSet := false
+	thriftNameIsSet := false
+	argumentsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
-		for i := 0; i < mh.Length; i++ {
-			if err := sr.Skip(mh.KeyType); err != nil {
-				return nil, err
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ThriftName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftNameIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Arguments, err = _List_Argument_Decode(sr)
+			if err != nil {
+				return err
+			}
+			argumentsIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ReturnType, err = _Type_Decode(sr)
+			if err != nil {
+				return err
 			}
 
-			if err := sr.Skip(mh.ValueType); err != nil {
-				return nil, err
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Exceptions, err = _List_Argument_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.OneWay = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
 		}
-		return nil, sr.ReadMapEnd()
-	}
 
-	o := make(map[ServiceID]*Service, mh.Length)
-	for i := 0; i < mh.Length; i++ {
-		k, err := _ServiceID_Decode(sr)
-		if err != nil {
-			return nil, err
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
 		}
 
-		v, err := _Service_Decode(sr)
-		if err != nil {
-			return nil, err
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
 		}
+	}
 
-		o[k] = v
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
-	if err = sr.ReadMapEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-func _ModuleID_Decode(sr stream.Reader) (ModuleID, error) {
-	var x ModuleID
-	err := x.Decode(sr)
-	return x, err
-}
-
-func _Module_Decode(sr stream.Reader) (*Module, error) {
-	var v Module
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _Map_ModuleID_Module_Decode(sr stream.Reader) (map[ModuleID]*Module, error) {
-	mh, err := sr.ReadMapBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
-		for i := 0; i < mh.Length; i++ {
-			if err := sr.Skip(mh.KeyType); err != nil {
-				return nil, err
-			}
-
-			if err := sr.Skip(mh.ValueType); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadMapEnd()
-	}
-
-	o := make(map[ModuleID]*Module, mh.Length)
-	for i := 0; i < mh.Length; i++ {
-		k, err := _ModuleID_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-
-		v, err := _Module_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-
-		o[k] = v
-	}
-
-	if err = sr.ReadMapEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-func _List_ModuleID_Decode(sr stream.Reader) ([]ModuleID, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TI32 {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]ModuleID, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _ModuleID_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-// Decode deserializes a GenerateServiceRequest struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a GenerateServiceRequest struct could not be generated from the wire
-// representation.
-func (v *GenerateServiceRequest) Decode(sr stream.Reader) error {
-
-	rootServicesIsSet := false
-	servicesIsSet := false
-	modulesIsSet := false
-	packagePrefixIsSet := false
-	thriftRootIsSet := false
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
-	}
-
-	for ok {
-		switch {
-		case fh.ID == 1 && fh.Type == wire.TList:
-			v.RootServices, err = _List_ServiceID_Decode(sr)
-			if err != nil {
-				return err
-			}
-			rootServicesIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TMap:
-			v.Services, err = _Map_ServiceID_Service_Decode(sr)
-			if err != nil {
-				return err
-			}
-			servicesIsSet = true
-		case fh.ID == 3 && fh.Type == wire.TMap:
-			v.Modules, err = _Map_ModuleID_Module_Decode(sr)
-			if err != nil {
-				return err
-			}
-			modulesIsSet = true
-		case fh.ID == 4 && fh.Type == wire.TBinary:
-			v.PackagePrefix, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			packagePrefixIsSet = true
-		case fh.ID == 5 && fh.Type == wire.TBinary:
-			v.ThriftRoot, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			thriftRootIsSet = true
-		case fh.ID == 6 && fh.Type == wire.TList:
-			v.RootModules, err = _List_ModuleID_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
-	}
-
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
-
-	if !rootServicesIsSet {
-		return errors.New("field RootServices of GenerateServiceRequest is required")
-	}
-
-	if !servicesIsSet {
-		return errors.New("field Services of GenerateServiceRequest is required")
-	}
-
-	if !modulesIsSet {
-		return errors.New("field Modules of GenerateServiceRequest is required")
+	if !nameIsSet {
+		return errors.New("field Name of Function is required")
 	}
 
-	if !packagePrefixIsSet {
-		return errors.New("field PackagePrefix of GenerateServiceRequest is required")
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Function is required")
 	}
 
-	if !thriftRootIsSet {
-		return errors.New("field ThriftRoot of GenerateServiceRequest is required")
+	if !argumentsIsSet {
+		return errors.New("field Arguments of Function is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a GenerateServiceRequest
+// String returns a readable string representation of a Function
 // struct.
-func (v *GenerateServiceRequest) String() string {
+func (v *Function) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
-	fields[i] = fmt.Sprintf("RootServices: %v", v.RootServices)
-	i++
-	fields[i] = fmt.Sprintf("Services: %v", v.Services)
-	i++
-	fields[i] = fmt.Sprintf("Modules: %v", v.Modules)
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
-	fields[i] = fmt.Sprintf("PackagePrefix: %v", v.PackagePrefix)
+	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
 	i++
-	fields[i] = fmt.Sprintf("ThriftRoot: %v", v.ThriftRoot)
+	fields[i] = fmt.Sprintf("Arguments: %v", v.Arguments)
 	i++
-	if v.RootModules != nil {
-		fields[i] = fmt.Sprintf("RootModules: %v", v.RootModules)
+	if v.ReturnType != nil {
+		fields[i] = fmt.Sprintf("ReturnType: %v", v.ReturnType)
+		i++
+	}
+	if v.Exceptions != nil {
+		fields[i] = fmt.Sprintf("Exceptions: %v", v.Exceptions)
+		i++
+	}
+	if v.OneWay != nil {
+		fields[i] = fmt.Sprintf("OneWay: %v", *(v.OneWay))
+		i++
+	}
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
 		i++
 	}
 
-	return fmt.Sprintf("GenerateServiceRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Function{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_ServiceID_Equals(lhs, rhs []ServiceID) bool {
+func _List_Argument_Equals(lhs, rhs []*Argument) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
 	for i, lv := range lhs {
 		rv := rhs[i]
-		if !(lv == rv) {
+		if !lv.Equals(rv) {
 			return false
 		}
 	}
@@ -2430,274 +2325,344 @@ func _List_ServiceID_Equals(lhs, rhs []ServiceID) bool {
 	return true
 }
 
-func _Map_ServiceID_Service_Equals(lhs, rhs map[ServiceID]*Service) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
+		x := *lhs
+		y := *rhs
+		return (x == y)
 	}
-	return true
+	return lhs == nil && rhs == nil
 }
 
-func _Map_ModuleID_Module_Equals(lhs, rhs map[ModuleID]*Module) bool {
-	if len(lhs) != len(rhs) {
+// Equals returns true if all the fields of this Function match the
+// provided Function.
+//
+// This function performs a deep comparison.
+func (v *Function) Equals(rhs *Function) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
 		return false
 	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
-}
-
-func _List_ModuleID_Equals(lhs, rhs []ModuleID) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
-// Equals returns true if all the fields of this GenerateServiceRequest match the
-// provided GenerateServiceRequest.
-//
-// This function performs a deep comparison.
-func (v *GenerateServiceRequest) Equals(rhs *GenerateServiceRequest) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
+	if !(v.Name == rhs.Name) {
 		return false
 	}
-	if !_List_ServiceID_Equals(v.RootServices, rhs.RootServices) {
+	if !(v.ThriftName == rhs.ThriftName) {
 		return false
 	}
-	if !_Map_ServiceID_Service_Equals(v.Services, rhs.Services) {
+	if !_List_Argument_Equals(v.Arguments, rhs.Arguments) {
 		return false
 	}
-	if !_Map_ModuleID_Module_Equals(v.Modules, rhs.Modules) {
+	if !((v.ReturnType == nil && rhs.ReturnType == nil) || (v.ReturnType != nil && rhs.ReturnType != nil && v.ReturnType.Equals(rhs.ReturnType))) {
 		return false
 	}
-	if !(v.PackagePrefix == rhs.PackagePrefix) {
+	if !((v.Exceptions == nil && rhs.Exceptions == nil) || (v.Exceptions != nil && rhs.Exceptions != nil && _List_Argument_Equals(v.Exceptions, rhs.Exceptions))) {
 		return false
 	}
-	if !(v.ThriftRoot == rhs.ThriftRoot) {
+	if !_Bool_EqualsPtr(v.OneWay, rhs.OneWay) {
 		return false
 	}
-	if !((v.RootModules == nil && rhs.RootModules == nil) || (v.RootModules != nil && rhs.RootModules != nil && _List_ModuleID_Equals(v.RootModules, rhs.RootModules))) {
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_ServiceID_Zapper []ServiceID
+func _List_Argument_Copy(v []*Argument) []*Argument {
+	if v == nil {
+		return nil
+	}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ServiceID_Zapper.
-func (l _List_ServiceID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendInt32((int32)(v))
+	o := make([]*Argument, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
 	}
-	return err
+	return o
 }
 
-type _Map_ServiceID_Service_Item_Zapper struct {
-	Key   ServiceID
-	Value *Service
-}
+func _Bool_CopyPtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ServiceID_Service_Item_Zapper.
-func (v _Map_ServiceID_Service_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	enc.AddInt32("key", (int32)(v.Key))
-	err = multierr.Append(err, enc.AddObject("value", v.Value))
-	return err
+	x := *v
+	return &x
 }
 
-type _Map_ServiceID_Service_Zapper map[ServiceID]*Service
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ServiceID_Service_Zapper.
-func (m _Map_ServiceID_Service_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AppendObject(_Map_ServiceID_Service_Item_Zapper{Key: k, Value: v}))
+// Copy returns a deep copy of this Function.
+func (v *Function) Copy() *Function {
+	if v == nil {
+		return nil
 	}
-	return err
-}
 
-type _Map_ModuleID_Module_Item_Zapper struct {
-	Key   ModuleID
-	Value *Module
+	var o Function
+	o.Name = v.Name
+	o.ThriftName = v.ThriftName
+	o.Arguments = _List_Argument_Copy(v.Arguments)
+	o.ReturnType = v.ReturnType.Copy()
+	o.Exceptions = _List_Argument_Copy(v.Exceptions)
+	o.OneWay = _Bool_CopyPtr(v.OneWay)
+	o.Annotations = _Map_String_String_Copy(v.Annotations)
+	return &o
 }
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ModuleID_Module_Item_Zapper.
-func (v _Map_ModuleID_Module_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	enc.AddInt32("key", (int32)(v.Key))
-	err = multierr.Append(err, enc.AddObject("value", v.Value))
-	return err
+func _List_Argument_Hash(v []*Argument) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
 }
 
-type _Map_ModuleID_Module_Zapper map[ModuleID]*Module
+// Hash returns a hash of this Function which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Function) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.String(v.ThriftName)
+	h.Field(3)
+	h.Uint64(_List_Argument_Hash(v.Arguments))
+	h.Field(4)
+	h.Uint64(v.ReturnType.Hash())
+	h.Field(5)
+	h.Uint64(_List_Argument_Hash(v.Exceptions))
+	if v.OneWay != nil {
+		h.Field(6)
+		h.Bool(*v.OneWay)
+	}
+	h.Field(7)
+	h.Uint64(_Map_String_String_Hash(v.Annotations))
+	return h.Sum64()
+}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ModuleID_Module_Zapper.
-func (m _Map_ModuleID_Module_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AppendObject(_Map_ModuleID_Module_Item_Zapper{Key: k, Value: v}))
+// Reset zeroes all fields of this Function so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Function) Reset() {
+	*v = Function{
+		Arguments: v.Arguments[:0],
 	}
-	return err
 }
 
-type _List_ModuleID_Zapper []ModuleID
+type _List_Argument_Zapper []*Argument
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ModuleID_Zapper.
-func (l _List_ModuleID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_Argument_Zapper.
+func (l _List_Argument_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
-		enc.AppendInt32((int32)(v))
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GenerateServiceRequest.
-func (v *GenerateServiceRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Function.
+func (v *Function) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	err = multierr.Append(err, enc.AddArray("rootServices", (_List_ServiceID_Zapper)(v.RootServices)))
-	err = multierr.Append(err, enc.AddArray("services", (_Map_ServiceID_Service_Zapper)(v.Services)))
-	err = multierr.Append(err, enc.AddArray("modules", (_Map_ModuleID_Module_Zapper)(v.Modules)))
-	enc.AddString("packagePrefix", v.PackagePrefix)
-	enc.AddString("thriftRoot", v.ThriftRoot)
-	if v.RootModules != nil {
-		err = multierr.Append(err, enc.AddArray("rootModules", (_List_ModuleID_Zapper)(v.RootModules)))
+	enc.AddString("name", v.Name)
+	enc.AddString("thriftName", v.ThriftName)
+	err = multierr.Append(err, enc.AddArray("arguments", (_List_Argument_Zapper)(v.Arguments)))
+	if v.ReturnType != nil {
+		err = multierr.Append(err, enc.AddObject("returnType", v.ReturnType))
+	}
+	if v.Exceptions != nil {
+		err = multierr.Append(err, enc.AddArray("exceptions", (_List_Argument_Zapper)(v.Exceptions)))
+	}
+	if v.OneWay != nil {
+		enc.AddBool("oneWay", *v.OneWay)
+	}
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
 	}
 	return err
 }
 
-// GetRootServices returns the value of RootServices if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetRootServices() (o []ServiceID) {
+func (v *Function) GetName() (o string) {
 	if v != nil {
-		o = v.RootServices
+		o = v.Name
 	}
 	return
 }
 
-// IsSetRootServices returns true if RootServices is not nil.
-func (v *GenerateServiceRequest) IsSetRootServices() bool {
-	return v != nil && v.RootServices != nil
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+func (v *Function) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
+	}
+	return
 }
 
-// GetServices returns the value of Services if it is set or its
+// GetArguments returns the value of Arguments if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetServices() (o map[ServiceID]*Service) {
+func (v *Function) GetArguments() (o []*Argument) {
 	if v != nil {
-		o = v.Services
+		o = v.Arguments
 	}
 	return
 }
 
-// IsSetServices returns true if Services is not nil.
-func (v *GenerateServiceRequest) IsSetServices() bool {
-	return v != nil && v.Services != nil
+// IsSetArguments returns true if Arguments is not nil.
+func (v *Function) IsSetArguments() bool {
+	return v != nil && v.Arguments != nil
 }
 
-// GetModules returns the value of Modules if it is set or its
+// GetReturnType returns the value of ReturnType if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetModules() (o map[ModuleID]*Module) {
-	if v != nil {
-		o = v.Modules
+func (v *Function) GetReturnType() (o *Type) {
+	if v != nil && v.ReturnType != nil {
+		return v.ReturnType
 	}
+
 	return
 }
 
-// IsSetModules returns true if Modules is not nil.
-func (v *GenerateServiceRequest) IsSetModules() bool {
-	return v != nil && v.Modules != nil
+// IsSetReturnType returns true if ReturnType is not nil.
+func (v *Function) IsSetReturnType() bool {
+	return v != nil && v.ReturnType != nil
 }
 
-// GetPackagePrefix returns the value of PackagePrefix if it is set or its
+// GetExceptions returns the value of Exceptions if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetPackagePrefix() (o string) {
-	if v != nil {
-		o = v.PackagePrefix
+func (v *Function) GetExceptions() (o []*Argument) {
+	if v != nil && v.Exceptions != nil {
+		return v.Exceptions
 	}
+
 	return
 }
 
-// GetThriftRoot returns the value of ThriftRoot if it is set or its
+// IsSetExceptions returns true if Exceptions is not nil.
+func (v *Function) IsSetExceptions() bool {
+	return v != nil && v.Exceptions != nil
+}
+
+// GetOneWay returns the value of OneWay if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetThriftRoot() (o string) {
-	if v != nil {
-		o = v.ThriftRoot
+func (v *Function) GetOneWay() (o bool) {
+	if v != nil && v.OneWay != nil {
+		return *v.OneWay
 	}
+
 	return
 }
 
-// GetRootModules returns the value of RootModules if it is set or its
+// IsSetOneWay returns true if OneWay is not nil.
+func (v *Function) IsSetOneWay() bool {
+	return v != nil && v.OneWay != nil
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetRootModules() (o []ModuleID) {
-	if v != nil && v.RootModules != nil {
-		return v.RootModules
+func (v *Function) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
 	}
 
 	return
 }
 
-// IsSetRootModules returns true if RootModules is not nil.
-func (v *GenerateServiceRequest) IsSetRootModules() bool {
-	return v != nil && v.RootModules != nil
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Function) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
 }
 
-// GenerateServiceResponse is response to a GenerateServiceRequest.
-type GenerateServiceResponse struct {
-	// Map of file path to file contents.
+// GenerateServiceRequest is a request to generate code for zero or more
+// Thrift services.
+type GenerateServiceRequest struct {
+	// IDs of services for which code should be generated.
 	//
-	// All paths MUST be relative to the output directory into which ThriftRW
-	// is generating code. Plugins SHOULD NOT make any assumptions about the
-	// absolute location of the directory.
+	// Note that the services map contains information about both, the
+	// services being generated and their transitive dependencies. Code should
+	// only be generated for service IDs listed here.
+	RootServices []ServiceID `json:"rootServices,required"`
+	// Map of service ID to service.
 	//
-	// The paths MUST NOT contain the string ".." or the request will fail.
-	Files map[string][]byte `json:"files,omitempty"`
-}
-
-type _Map_String_Binary_MapItemList map[string][]byte
-
-func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := wire.NewValueBinary(v), error(nil)
+	// Any service IDs present in this request will have a corresponding
+	// service definition in this map, including services for which code does
+	// not need to be generated.
+	Services map[ServiceID]*Service `json:"services,required"`
+	// Map of module ID to module.
+	//
+	// Any module IDs present in the request will have a corresponding module
+	// definition in this map.
+	Modules map[ModuleID]*Module `json:"modules,required"`
+	// Prefix for import paths of generated module. In general, plugins should
+	// not need to use the package prefix unless instantiating a new
+	// Generator for more custom plugin generation.
+	PackagePrefix string `json:"packagePrefix,required"`
+	// Directory whose descendants contain all Thrift files. In general,
+	// plugins should not need to use the thrift root unless instantiating a
+	// new Generator for more custom plugin generation.
+	ThriftRoot string `json:"thriftRoot,required"`
+	// IDs of Modules for which code should be generated.
+	//
+	// Note that the modules map contains information about both, the
+	// modules being generated and their transitive dependencies. Code should
+	// only be generated for module IDs listed here.
+	RootModules []ModuleID `json:"rootModules,omitempty"`
+	// Whether code for clients of the root services should be skipped, as
+	// it is with --only=servers.
+	NoClients *bool `json:"noClients,omitempty"`
+	// Whether code for servers of the root services should be skipped, as
+	// it is with --only=clients.
+	NoServers *bool `json:"noServers,omitempty"`
+}
+
+type _List_ServiceID_ValueList []ServiceID
+
+func (v _List_ServiceID_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ServiceID_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ServiceID_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_ServiceID_ValueList) Close() {}
+
+type _Map_ServiceID_Service_MapItemList map[ServiceID]*Service
+
+func (m _Map_ServiceID_Service_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[ServiceID]*Service', key [%v]: value is nil", k)
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
 		if err != nil {
 			return err
 		}
@@ -2709,21 +2674,85 @@ func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) erro
 	return nil
 }
 
-func (m _Map_String_Binary_MapItemList) Size() int {
+func (m _Map_ServiceID_Service_MapItemList) Size() int {
 	return len(m)
 }
 
-func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
+func (_Map_ServiceID_Service_MapItemList) KeyType() wire.Type {
+	return wire.TI32
 }
 
-func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
-	return wire.TBinary
+func (_Map_ServiceID_Service_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-func (_Map_String_Binary_MapItemList) Close() {}
+func (_Map_ServiceID_Service_MapItemList) Close() {}
 
-// ToWire translates a GenerateServiceResponse struct into a Thrift-level intermediate
+type _Map_ModuleID_Module_MapItemList map[ModuleID]*Module
+
+func (m _Map_ModuleID_Module_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[ModuleID]*Module', key [%v]: value is nil", k)
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_ModuleID_Module_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_ModuleID_Module_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_ModuleID_Module_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_ModuleID_Module_MapItemList) Close() {}
+
+type _List_ModuleID_ValueList []ModuleID
+
+func (v _List_ModuleID_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ModuleID_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ModuleID_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_ModuleID_ValueList) Close() {}
+
+// ToWire translates a GenerateServiceRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2738,43 +2767,127 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
+func (v *GenerateServiceRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Files != nil {
-		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
+	w, err = wire.NewValueList(_List_ServiceID_ValueList(v.RootServices)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Services == nil {
+		return w, errors.New("field Services of GenerateServiceRequest is required")
+	}
+	w, err = wire.NewValueMap(_Map_ServiceID_Service_MapItemList(v.Services)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Modules == nil {
+		return w, errors.New("field Modules of GenerateServiceRequest is required")
+	}
+	w, err = wire.NewValueMap(_Map_ModuleID_Module_MapItemList(v.Modules)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.PackagePrefix), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ThriftRoot), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+	if v.RootModules != nil {
+		w, err = wire.NewValueList(_List_ModuleID_ValueList(v.RootModules)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.NoClients != nil {
+		w, err = wire.NewValueBool(*(v.NoClients)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.NoServers != nil {
+		w, err = wire.NewValueBool(*(v.NoServers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
-	if m.KeyType() != wire.TBinary {
+func _ServiceID_Read(w wire.Value) (ServiceID, error) {
+	var x ServiceID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_ServiceID_Read(l wire.ValueList) ([]ServiceID, error) {
+	if l.ValueType() != wire.TI32 {
 		return nil, nil
 	}
 
-	if m.ValueType() != wire.TBinary {
+	o := make([]ServiceID, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ServiceID_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Service_Read(w wire.Value) (*Service, error) {
+	var v Service
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_ServiceID_Service_Read(m wire.MapItemList) (map[ServiceID]*Service, error) {
+	if m.KeyType() != wire.TI32 {
 		return nil, nil
 	}
 
-	o := make(map[string][]byte, m.Size())
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[ServiceID]*Service, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
+		k, err := _ServiceID_Read(x.Key)
 		if err != nil {
 			return err
 		}
 
-		v, err := x.Value.GetBinary(), error(nil)
+		v, err := _Service_Read(x.Value)
 		if err != nil {
 			return err
 		}
@@ -2786,31 +2899,155 @@ func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
 	return o, err
 }
 
-// FromWire deserializes a GenerateServiceResponse struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a GenerateServiceResponse struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v GenerateServiceResponse
+func _ModuleID_Read(w wire.Value) (ModuleID, error) {
+	var x ModuleID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Module_Read(w wire.Value) (*Module, error) {
+	var v Module
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_ModuleID_Module_Read(m wire.MapItemList) (map[ModuleID]*Module, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[ModuleID]*Module, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _ModuleID_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Module_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _List_ModuleID_Read(l wire.ValueList) ([]ModuleID, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]ModuleID, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ModuleID_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GenerateServiceRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GenerateServiceRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GenerateServiceRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GenerateServiceResponse) FromWire(w wire.Value) error {
+func (v *GenerateServiceRequest) FromWire(w wire.Value) error {
 	var err error
 
+	rootServicesIsSet := false
+	servicesIsSet := false
+	modulesIsSet := false
+	packagePrefixIsSet := false
+	thriftRootIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
+			if field.Value.Type() == wire.TList {
+				v.RootServices, err = _List_ServiceID_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				rootServicesIsSet = true
+			}
+		case 2:
 			if field.Value.Type() == wire.TMap {
-				v.Files, err = _Map_String_Binary_Read(field.Value.GetMap())
+				v.Services, err = _Map_ServiceID_Service_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				servicesIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Modules, err = _Map_ModuleID_Module_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				modulesIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.PackagePrefix, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				packagePrefixIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftRoot, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftRootIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TList {
+				v.RootModules, err = _List_ModuleID_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.NoClients = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.NoServers = &x
 				if err != nil {
 					return err
 				}
@@ -2819,14 +3056,99 @@ func (v *GenerateServiceResponse) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !rootServicesIsSet {
+		return errors.New("field RootServices of GenerateServiceRequest is required")
+	}
+
+	if !servicesIsSet {
+		return errors.New("field Services of GenerateServiceRequest is required")
+	}
+
+	if !modulesIsSet {
+		return errors.New("field Modules of GenerateServiceRequest is required")
+	}
+
+	if !packagePrefixIsSet {
+		return errors.New("field PackagePrefix of GenerateServiceRequest is required")
+	}
+
+	if !thriftRootIsSet {
+		return errors.New("field ThriftRoot of GenerateServiceRequest is required")
+	}
+
 	return nil
 }
 
-func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
+func _List_ServiceID_Encode(val []ServiceID, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []ServiceID
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+func _Map_ServiceID_Service_Encode(val map[ServiceID]*Service, sw stream.Writer) error {
 
 	mh := stream.MapHeader{
-		KeyType:   wire.TBinary,
-		ValueType: wire.TBinary,
+		KeyType:   wire.TI32,
+		ValueType: wire.TStruct,
 		Length:    len(val),
 	}
 	if err := sw.WriteMapBegin(mh); err != nil {
@@ -2835,12 +3157,12 @@ func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
 
 	for k, v := range val {
 		if v == nil {
-			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+			return fmt.Errorf("invalid map 'map[ServiceID]*Service', key [%v]: value is nil", k)
 		}
-		if err := sw.WriteString(k); err != nil {
+		if err := k.Encode(sw); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
@@ -2848,279 +3170,377 @@ func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
 	return sw.WriteMapEnd()
 }
 
-// Encode serializes a GenerateServiceResponse struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a GenerateServiceResponse struct could not be encoded.
-func (v *GenerateServiceResponse) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
+func _Map_ModuleID_Module_Encode(val map[ModuleID]*Module, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
 		return err
 	}
 
-	if v.Files != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TMap}); err != nil {
-			return err
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[ModuleID]*Module', key [%v]: value is nil", k)
 		}
-		if err := _Map_String_Binary_Encode(v.Files, sw); err != nil {
+		if err := k.Encode(sw); err != nil {
 			return err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
 
-	return sw.WriteStructEnd()
+	return sw.WriteMapEnd()
 }
 
-func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
-	mh, err := sr.ReadMapBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
-		for i := 0; i < mh.Length; i++ {
-			if err := sr.Skip(mh.KeyType); err != nil {
-				return nil, err
-			}
+func _List_ModuleID_Encode(val []ModuleID, sw stream.Writer) error {
 
-			if err := sr.Skip(mh.ValueType); err != nil {
-				return nil, err
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []ModuleID
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
 			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
 		}
-		return nil, sr.ReadMapEnd()
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
 	}
+	return sw.WriteListEnd()
+}
 
-	o := make(map[string][]byte, mh.Length)
-	for i := 0; i < mh.Length; i++ {
-		k, err := sr.ReadString()
-		if err != nil {
-			return nil, err
-		}
-
-		v, err := sr.ReadBinary()
-		if err != nil {
-			return nil, err
-		}
-
-		o[k] = v
+// Encode serializes a GenerateServiceRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GenerateServiceRequest struct could not be encoded.
+func (v *GenerateServiceRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if err = sr.ReadMapEnd(); err != nil {
-		return nil, err
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_ServiceID_Encode(v.RootServices, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
-	return o, err
-}
 
-// Decode deserializes a GenerateServiceResponse struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a GenerateServiceResponse struct could not be generated from the wire
-// representation.
-func (v *GenerateServiceResponse) Decode(sr stream.Reader) error {
+	if v.Services == nil {
+		return errors.New("field Services of GenerateServiceRequest is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TMap}); err != nil {
+		return err
+	}
+	if err := _Map_ServiceID_Service_Encode(v.Services, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
 
-	if err := sr.ReadStructBegin(); err != nil {
+	if v.Modules == nil {
+		return errors.New("field Modules of GenerateServiceRequest is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+		return err
+	}
+	if err := _Map_ModuleID_Module_Encode(v.Modules, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
 		return err
 	}
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.PackagePrefix); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
 		return err
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 1 && fh.Type == wire.TMap:
-			v.Files, err = _Map_String_Binary_Decode(sr)
-			if err != nil {
-				return err
-			}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ThriftRoot); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
+	if v.RootModules != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TList}); err != nil {
+			return err
 		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
+		if err := _List_ModuleID_Encode(v.RootModules, sw); err != nil {
 			return err
 		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+		if err := sw.WriteFieldEnd(); err != nil {
 			return err
 		}
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
+	if v.NoClients != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.NoClients)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return nil
-}
-
-// String returns a readable string representation of a GenerateServiceResponse
-// struct.
-func (v *GenerateServiceResponse) String() string {
-	if v == nil {
-		return "<nil>"
+	if v.NoServers != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.NoServers)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	var fields [1]string
-	i := 0
-	if v.Files != nil {
-		fields[i] = fmt.Sprintf("Files: %v", v.Files)
-		i++
-	}
+	return sw.WriteStructEnd()
+}
 
-	return fmt.Sprintf("GenerateServiceResponse{%v}", strings.Join(fields[:i], ", "))
+func _ServiceID_Decode(sr stream.Reader) (ServiceID, error) {
+	var x ServiceID
+	err := x.Decode(sr)
+	return x, err
 }
 
-func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_ServiceID_Decode(sr stream.Reader) ([]ServiceID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !bytes.Equal(lv, rv) {
-			return false
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadListEnd()
 	}
-	return true
-}
 
-// Equals returns true if all the fields of this GenerateServiceResponse match the
-// provided GenerateServiceResponse.
-//
-// This function performs a deep comparison.
-func (v *GenerateServiceResponse) Equals(rhs *GenerateServiceResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !((v.Files == nil && rhs.Files == nil) || (v.Files != nil && rhs.Files != nil && _Map_String_Binary_Equals(v.Files, rhs.Files))) {
-		return false
+	o := make([]ServiceID, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ServiceID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-type _Map_String_Binary_Zapper map[string][]byte
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_Binary_Zapper.
-func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
-	}
-	return err
+func _Service_Decode(sr stream.Reader) (*Service, error) {
+	var v Service
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GenerateServiceResponse.
-func (v *GenerateServiceResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+func _Map_ServiceID_Service_Decode(sr stream.Reader) (map[ServiceID]*Service, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
-	if v.Files != nil {
-		err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
 	}
-	return err
-}
 
-// GetFiles returns the value of Files if it is set or its
-// zero value if it is unset.
-func (v *GenerateServiceResponse) GetFiles() (o map[string][]byte) {
-	if v != nil && v.Files != nil {
-		return v.Files
+	o := make(map[ServiceID]*Service, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _ServiceID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Service_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
 	}
 
-	return
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// IsSetFiles returns true if Files is not nil.
-func (v *GenerateServiceResponse) IsSetFiles() bool {
-	return v != nil && v.Files != nil
+func _ModuleID_Decode(sr stream.Reader) (ModuleID, error) {
+	var x ModuleID
+	err := x.Decode(sr)
+	return x, err
 }
 
-// HandshakeRequest is the initial request sent to the plugin as part of
-// establishing communication and feature negotiation.
-type HandshakeRequest struct {
+func _Module_Decode(sr stream.Reader) (*Module, error) {
+	var v Module
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// ToWire translates a HandshakeRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *HandshakeRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [0]wire.Field
-		i      int = 0
-	)
+func _Map_ModuleID_Module_Decode(sr stream.Reader) (map[ModuleID]*Module, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
 
-// FromWire deserializes a HandshakeRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a HandshakeRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v HandshakeRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *HandshakeRequest) FromWire(w wire.Value) error {
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
+	o := make(map[ModuleID]*Module, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _ModuleID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Module_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+
+		o[k] = v
 	}
 
-	return nil
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Encode serializes a HandshakeRequest struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a HandshakeRequest struct could not be encoded.
-func (v *HandshakeRequest) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
+func _List_ModuleID_Decode(sr stream.Reader) ([]ModuleID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	return sw.WriteStructEnd()
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]ModuleID, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ModuleID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a HandshakeRequest struct directly from its Thrift-level
+// Decode deserializes a GenerateServiceRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a HandshakeRequest struct could not be generated from the wire
+// An error is returned if a GenerateServiceRequest struct could not be generated from the wire
 // representation.
-func (v *HandshakeRequest) Decode(sr stream.Reader) error {
+func (v *GenerateServiceRequest) Decode(sr stream.Reader) error {
+
+	rootServicesIsSet := false
+	servicesIsSet := false
+	modulesIsSet := false
+	packagePrefixIsSet := false
+	thriftRootIsSet := false
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -3133,6 +3553,58 @@ func (v *HandshakeRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.RootServices, err = _List_ServiceID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			rootServicesIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.Services, err = _Map_ServiceID_Service_Decode(sr)
+			if err != nil {
+				return err
+			}
+			servicesIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Modules, err = _Map_ModuleID_Module_Decode(sr)
+			if err != nil {
+				return err
+			}
+			modulesIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			v.PackagePrefix, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			packagePrefixIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			v.ThriftRoot, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftRootIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TList:
+			v.RootModules, err = _List_ModuleID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.NoClients = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.NoServers = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -3152,600 +3624,574 @@ func (v *HandshakeRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	if !rootServicesIsSet {
+		return errors.New("field RootServices of GenerateServiceRequest is required")
+	}
+
+	if !servicesIsSet {
+		return errors.New("field Services of GenerateServiceRequest is required")
+	}
+
+	if !modulesIsSet {
+		return errors.New("field Modules of GenerateServiceRequest is required")
+	}
+
+	if !packagePrefixIsSet {
+		return errors.New("field PackagePrefix of GenerateServiceRequest is required")
+	}
+
+	if !thriftRootIsSet {
+		return errors.New("field ThriftRoot of GenerateServiceRequest is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a HandshakeRequest
+// String returns a readable string representation of a GenerateServiceRequest
 // struct.
-func (v *HandshakeRequest) String() string {
+func (v *GenerateServiceRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [8]string
 	i := 0
+	fields[i] = fmt.Sprintf("RootServices: %v", v.RootServices)
+	i++
+	fields[i] = fmt.Sprintf("Services: %v", v.Services)
+	i++
+	fields[i] = fmt.Sprintf("Modules: %v", v.Modules)
+	i++
+	fields[i] = fmt.Sprintf("PackagePrefix: %v", v.PackagePrefix)
+	i++
+	fields[i] = fmt.Sprintf("ThriftRoot: %v", v.ThriftRoot)
+	i++
+	if v.RootModules != nil {
+		fields[i] = fmt.Sprintf("RootModules: %v", v.RootModules)
+		i++
+	}
+	if v.NoClients != nil {
+		fields[i] = fmt.Sprintf("NoClients: %v", *(v.NoClients))
+		i++
+	}
+	if v.NoServers != nil {
+		fields[i] = fmt.Sprintf("NoServers: %v", *(v.NoServers))
+		i++
+	}
 
-	return fmt.Sprintf("HandshakeRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GenerateServiceRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this HandshakeRequest match the
-// provided HandshakeRequest.
-//
-// This function performs a deep comparison.
-func (v *HandshakeRequest) Equals(rhs *HandshakeRequest) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
+func _List_ServiceID_Equals(lhs, rhs []ServiceID) bool {
+	if len(lhs) != len(rhs) {
 		return false
 	}
 
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HandshakeRequest.
-func (v *HandshakeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+func _Map_ServiceID_Service_Equals(lhs, rhs map[ServiceID]*Service) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	return err
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
 }
 
-// HandshakeResponse is the response from the plugin for a HandshakeRequest.
-type HandshakeResponse struct {
-	// Name of the plugin. This MUST match the name of the plugin specified
-	// over the command line or the program will fail.
-	Name string `json:"name,required"`
-	// Version of the plugin API.
-	//
-	// This MUST be set to API_VERSION by the plugin.
-	APIVersion int32 `json:"apiVersion,required"`
-	// List of features the plugin provides.
-	Features []Feature `json:"features,required"`
-	// Version of ThriftRW with which the plugin was built.
-	//
-	// This MUST be set to go.uber.org/thriftrw/version.Version by the plugin
-	// explicitly.
-	LibraryVersion *string `json:"libraryVersion,omitempty"`
-}
-
-type _List_Feature_ValueList []Feature
+func _Map_ModuleID_Module_Equals(lhs, rhs map[ModuleID]*Module) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-func (v _List_Feature_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := x.ToWire()
-		if err != nil {
-			return err
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
 		}
-		err = f(w)
-		if err != nil {
-			return err
+		if !lv.Equals(rv) {
+			return false
 		}
 	}
-	return nil
+	return true
 }
 
-func (v _List_Feature_ValueList) Size() int {
-	return len(v)
-}
+func _List_ModuleID_Equals(lhs, rhs []ModuleID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-func (_List_Feature_ValueList) ValueType() wire.Type {
-	return wire.TI32
-}
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
 
-func (_List_Feature_ValueList) Close() {}
+	return true
+}
 
-// ToWire translates a HandshakeResponse struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// Equals returns true if all the fields of this GenerateServiceRequest match the
+// provided GenerateServiceRequest.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *HandshakeResponse) ToWire() (wire.Value, error) {
-	var (
-		fields [4]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+// This function performs a deep comparison.
+func (v *GenerateServiceRequest) Equals(rhs *GenerateServiceRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	w, err = wire.NewValueI32(v.APIVersion), error(nil)
-	if err != nil {
-		return w, err
+	if !_List_ServiceID_Equals(v.RootServices, rhs.RootServices) {
+		return false
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-
-	w, err = wire.NewValueList(_List_Feature_ValueList(v.Features)), error(nil)
-	if err != nil {
-		return w, err
+	if !_Map_ServiceID_Service_Equals(v.Services, rhs.Services) {
+		return false
 	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
-	if v.LibraryVersion != nil {
-		w, err = wire.NewValueString(*(v.LibraryVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
+	if !_Map_ModuleID_Module_Equals(v.Modules, rhs.Modules) {
+		return false
+	}
+	if !(v.PackagePrefix == rhs.PackagePrefix) {
+		return false
+	}
+	if !(v.ThriftRoot == rhs.ThriftRoot) {
+		return false
+	}
+	if !((v.RootModules == nil && rhs.RootModules == nil) || (v.RootModules != nil && rhs.RootModules != nil && _List_ModuleID_Equals(v.RootModules, rhs.RootModules))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.NoClients, rhs.NoClients) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.NoServers, rhs.NoServers) {
+		return false
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _Feature_Read(w wire.Value) (Feature, error) {
-	var v Feature
-	err := v.FromWire(w)
-	return v, err
+	return true
 }
 
-func _List_Feature_Read(l wire.ValueList) ([]Feature, error) {
-	if l.ValueType() != wire.TI32 {
-		return nil, nil
+func _List_ServiceID_Copy(v []ServiceID) []ServiceID {
+	if v == nil {
+		return nil
 	}
 
-	o := make([]Feature, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Feature_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+	o := make([]ServiceID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
 }
 
-// FromWire deserializes a HandshakeResponse struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a HandshakeResponse struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v HandshakeResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *HandshakeResponse) FromWire(w wire.Value) error {
-	var err error
+func _Map_ServiceID_Service_Copy(v map[ServiceID]*Service) map[ServiceID]*Service {
+	if v == nil {
+		return nil
+	}
 
-	nameIsSet := false
-	apiVersionIsSet := false
-	featuresIsSet := false
+	o := make(map[ServiceID]*Service, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
+	}
+	return o
+}
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				nameIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TI32 {
-				v.APIVersion, err = field.Value.GetI32(), error(nil)
-				if err != nil {
-					return err
-				}
-				apiVersionIsSet = true
-			}
-		case 3:
-			if field.Value.Type() == wire.TList {
-				v.Features, err = _List_Feature_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-				featuresIsSet = true
-			}
-		case 4:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.LibraryVersion = &x
-				if err != nil {
-					return err
-				}
+func _Map_ModuleID_Module_Copy(v map[ModuleID]*Module) map[ModuleID]*Module {
+	if v == nil {
+		return nil
+	}
 
-			}
-		}
+	o := make(map[ModuleID]*Module, len(v))
+	for k, x := range v {
+		o[k] = x.Copy()
 	}
+	return o
+}
 
-	if !nameIsSet {
-		return errors.New("field Name of HandshakeResponse is required")
+func _List_ModuleID_Copy(v []ModuleID) []ModuleID {
+	if v == nil {
+		return nil
 	}
 
-	if !apiVersionIsSet {
-		return errors.New("field APIVersion of HandshakeResponse is required")
+	o := make([]ModuleID, len(v))
+	for i, x := range v {
+		o[i] = x
 	}
+	return o
+}
 
-	if !featuresIsSet {
-		return errors.New("field Features of HandshakeResponse is required")
+// Copy returns a deep copy of this GenerateServiceRequest.
+func (v *GenerateServiceRequest) Copy() *GenerateServiceRequest {
+	if v == nil {
+		return nil
 	}
 
-	return nil
+	var o GenerateServiceRequest
+	o.RootServices = _List_ServiceID_Copy(v.RootServices)
+	o.Services = _Map_ServiceID_Service_Copy(v.Services)
+	o.Modules = _Map_ModuleID_Module_Copy(v.Modules)
+	o.PackagePrefix = v.PackagePrefix
+	o.ThriftRoot = v.ThriftRoot
+	o.RootModules = _List_ModuleID_Copy(v.RootModules)
+	o.NoClients = _Bool_CopyPtr(v.NoClients)
+	o.NoServers = _Bool_CopyPtr(v.NoServers)
+	return &o
 }
 
-func _List_Feature_Encode(val []Feature, sw stream.Writer) error {
+func _List_ServiceID_Hash(v []ServiceID) uint64 {
 
-	lh := stream.ListHeader{
-		Type:   wire.TI32,
-		Length: len(val),
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(int32(x))
 	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
+	return h.Sum64()
+}
+
+func _Map_ServiceID_Service_Hash(v map[ServiceID]*Service) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(k))
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
 	}
+	return u.Sum64()
+}
 
-	for _, v := range val {
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
+func _Map_ModuleID_Module_Hash(v map[ModuleID]*Module) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.Int32(int32(k))
+		h.Uint64(x.Hash())
+		u.Add(h.Sum64())
 	}
-	return sw.WriteListEnd()
+	return u.Sum64()
 }
 
-// Encode serializes a HandshakeResponse struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a HandshakeResponse struct could not be encoded.
-func (v *HandshakeResponse) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
+func _List_ModuleID_Hash(v []ModuleID) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(int32(x))
 	}
+	return h.Sum64()
+}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Name); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+// Hash returns a hash of this GenerateServiceRequest which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *GenerateServiceRequest) Hash() uint64 {
+	if v == nil {
+		return 0
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
-		return err
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_ServiceID_Hash(v.RootServices))
+	h.Field(2)
+	h.Uint64(_Map_ServiceID_Service_Hash(v.Services))
+	h.Field(3)
+	h.Uint64(_Map_ModuleID_Module_Hash(v.Modules))
+	h.Field(4)
+	h.String(v.PackagePrefix)
+	h.Field(5)
+	h.String(v.ThriftRoot)
+	h.Field(6)
+	h.Uint64(_List_ModuleID_Hash(v.RootModules))
+	if v.NoClients != nil {
+		h.Field(7)
+		h.Bool(*v.NoClients)
 	}
-	if err := sw.WriteInt32(v.APIVersion); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	if v.NoServers != nil {
+		h.Field(8)
+		h.Bool(*v.NoServers)
 	}
+	return h.Sum64()
+}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
-		return err
+// Reset zeroes all fields of this GenerateServiceRequest so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *GenerateServiceRequest) Reset() {
+	for k := range v.Services {
+		delete(v.Services, k)
 	}
-	if err := _List_Feature_Encode(v.Features, sw); err != nil {
-		return err
+	for k := range v.Modules {
+		delete(v.Modules, k)
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	*v = GenerateServiceRequest{
+		RootServices: v.RootServices[:0],
+		Services:     v.Services,
+		Modules:      v.Modules,
 	}
+}
 
-	if v.LibraryVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.LibraryVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+type _List_ServiceID_Zapper []ServiceID
 
-	return sw.WriteStructEnd()
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ServiceID_Zapper.
+func (l _List_ServiceID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32((int32)(v))
+	}
+	return err
 }
 
-func _Feature_Decode(sr stream.Reader) (Feature, error) {
-	var v Feature
-	err := v.Decode(sr)
-	return v, err
+type _Map_ServiceID_Service_Item_Zapper struct {
+	Key   ServiceID
+	Value *Service
 }
 
-func _List_Feature_Decode(sr stream.Reader) ([]Feature, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TI32 {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ServiceID_Service_Item_Zapper.
+func (v _Map_ServiceID_Service_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", (int32)(v.Key))
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
 
-	o := make([]Feature, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _Feature_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
+type _Map_ServiceID_Service_Zapper map[ServiceID]*Service
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ServiceID_Service_Zapper.
+func (m _Map_ServiceID_Service_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_ServiceID_Service_Item_Zapper{Key: k, Value: v}))
 	}
-	return o, err
+	return err
 }
 
-// Decode deserializes a HandshakeResponse struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a HandshakeResponse struct could not be generated from the wire
-// representation.
-func (v *HandshakeResponse) Decode(sr stream.Reader) error {
+type _Map_ModuleID_Module_Item_Zapper struct {
+	Key   ModuleID
+	Value *Module
+}
 
-	nameIsSet := false
-	apiVersionIsSet := false
-	featuresIsSet := false
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ModuleID_Module_Item_Zapper.
+func (v _Map_ModuleID_Module_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", (int32)(v.Key))
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
 
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
+type _Map_ModuleID_Module_Zapper map[ModuleID]*Module
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ModuleID_Module_Zapper.
+func (m _Map_ModuleID_Module_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_ModuleID_Module_Item_Zapper{Key: k, Value: v}))
 	}
+	return err
+}
 
-	for ok {
-		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Name, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			nameIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TI32:
-			v.APIVersion, err = sr.ReadInt32()
-			if err != nil {
-				return err
-			}
-			apiVersionIsSet = true
-		case fh.ID == 3 && fh.Type == wire.TList:
-			v.Features, err = _List_Feature_Decode(sr)
-			if err != nil {
-				return err
-			}
-			featuresIsSet = true
-		case fh.ID == 4 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.LibraryVersion = &x
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
+type _List_ModuleID_Zapper []ModuleID
 
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ModuleID_Zapper.
+func (l _List_ModuleID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32((int32)(v))
 	}
+	return err
+}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GenerateServiceRequest.
+func (v *GenerateServiceRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-
-	if !nameIsSet {
-		return errors.New("field Name of HandshakeResponse is required")
+	err = multierr.Append(err, enc.AddArray("rootServices", (_List_ServiceID_Zapper)(v.RootServices)))
+	err = multierr.Append(err, enc.AddArray("services", (_Map_ServiceID_Service_Zapper)(v.Services)))
+	err = multierr.Append(err, enc.AddArray("modules", (_Map_ModuleID_Module_Zapper)(v.Modules)))
+	enc.AddString("packagePrefix", v.PackagePrefix)
+	enc.AddString("thriftRoot", v.ThriftRoot)
+	if v.RootModules != nil {
+		err = multierr.Append(err, enc.AddArray("rootModules", (_List_ModuleID_Zapper)(v.RootModules)))
 	}
-
-	if !apiVersionIsSet {
-		return errors.New("field APIVersion of HandshakeResponse is required")
+	if v.NoClients != nil {
+		enc.AddBool("noClients", *v.NoClients)
 	}
-
-	if !featuresIsSet {
-		return errors.New("field Features of HandshakeResponse is required")
+	if v.NoServers != nil {
+		enc.AddBool("noServers", *v.NoServers)
 	}
-
-	return nil
+	return err
 }
 
-// String returns a readable string representation of a HandshakeResponse
-// struct.
-func (v *HandshakeResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [4]string
-	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("APIVersion: %v", v.APIVersion)
-	i++
-	fields[i] = fmt.Sprintf("Features: %v", v.Features)
-	i++
-	if v.LibraryVersion != nil {
-		fields[i] = fmt.Sprintf("LibraryVersion: %v", *(v.LibraryVersion))
-		i++
+// GetRootServices returns the value of RootServices if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetRootServices() (o []ServiceID) {
+	if v != nil {
+		o = v.RootServices
 	}
+	return
+}
 
-	return fmt.Sprintf("HandshakeResponse{%v}", strings.Join(fields[:i], ", "))
+// IsSetRootServices returns true if RootServices is not nil.
+func (v *GenerateServiceRequest) IsSetRootServices() bool {
+	return v != nil && v.RootServices != nil
 }
 
-func _List_Feature_Equals(lhs, rhs []Feature) bool {
-	if len(lhs) != len(rhs) {
-		return false
+// GetServices returns the value of Services if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetServices() (o map[ServiceID]*Service) {
+	if v != nil {
+		o = v.Services
 	}
+	return
+}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+// IsSetServices returns true if Services is not nil.
+func (v *GenerateServiceRequest) IsSetServices() bool {
+	return v != nil && v.Services != nil
+}
+
+// GetModules returns the value of Modules if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetModules() (o map[ModuleID]*Module) {
+	if v != nil {
+		o = v.Modules
 	}
+	return
+}
 
-	return true
+// IsSetModules returns true if Modules is not nil.
+func (v *GenerateServiceRequest) IsSetModules() bool {
+	return v != nil && v.Modules != nil
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-// Equals returns true if all the fields of this HandshakeResponse match the
-// provided HandshakeResponse.
-//
-// This function performs a deep comparison.
-func (v *HandshakeResponse) Equals(rhs *HandshakeResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !(v.APIVersion == rhs.APIVersion) {
-		return false
-	}
-	if !_List_Feature_Equals(v.Features, rhs.Features) {
-		return false
-	}
-	if !_String_EqualsPtr(v.LibraryVersion, rhs.LibraryVersion) {
-		return false
-	}
-
-	return true
-}
-
-type _List_Feature_Zapper []Feature
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Feature_Zapper.
-func (l _List_Feature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HandshakeResponse.
-func (v *HandshakeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	enc.AddString("name", v.Name)
-	enc.AddInt32("apiVersion", v.APIVersion)
-	err = multierr.Append(err, enc.AddArray("features", (_List_Feature_Zapper)(v.Features)))
-	if v.LibraryVersion != nil {
-		enc.AddString("libraryVersion", *v.LibraryVersion)
+// GetPackagePrefix returns the value of PackagePrefix if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetPackagePrefix() (o string) {
+	if v != nil {
+		o = v.PackagePrefix
 	}
-	return err
+	return
 }
 
-// GetName returns the value of Name if it is set or its
+// GetThriftRoot returns the value of ThriftRoot if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetName() (o string) {
+func (v *GenerateServiceRequest) GetThriftRoot() (o string) {
 	if v != nil {
-		o = v.Name
+		o = v.ThriftRoot
 	}
 	return
 }
 
-// GetAPIVersion returns the value of APIVersion if it is set or its
+// GetRootModules returns the value of RootModules if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetAPIVersion() (o int32) {
-	if v != nil {
-		o = v.APIVersion
+func (v *GenerateServiceRequest) GetRootModules() (o []ModuleID) {
+	if v != nil && v.RootModules != nil {
+		return v.RootModules
 	}
+
 	return
 }
 
-// GetFeatures returns the value of Features if it is set or its
+// IsSetRootModules returns true if RootModules is not nil.
+func (v *GenerateServiceRequest) IsSetRootModules() bool {
+	return v != nil && v.RootModules != nil
+}
+
+// GetNoClients returns the value of NoClients if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetFeatures() (o []Feature) {
-	if v != nil {
-		o = v.Features
+func (v *GenerateServiceRequest) GetNoClients() (o bool) {
+	if v != nil && v.NoClients != nil {
+		return *v.NoClients
 	}
+
 	return
 }
 
-// IsSetFeatures returns true if Features is not nil.
-func (v *HandshakeResponse) IsSetFeatures() bool {
-	return v != nil && v.Features != nil
+// IsSetNoClients returns true if NoClients is not nil.
+func (v *GenerateServiceRequest) IsSetNoClients() bool {
+	return v != nil && v.NoClients != nil
 }
 
-// GetLibraryVersion returns the value of LibraryVersion if it is set or its
+// GetNoServers returns the value of NoServers if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetLibraryVersion() (o string) {
-	if v != nil && v.LibraryVersion != nil {
-		return *v.LibraryVersion
+func (v *GenerateServiceRequest) GetNoServers() (o bool) {
+	if v != nil && v.NoServers != nil {
+		return *v.NoServers
 	}
 
 	return
 }
 
-// IsSetLibraryVersion returns true if LibraryVersion is not nil.
-func (v *HandshakeResponse) IsSetLibraryVersion() bool {
-	return v != nil && v.LibraryVersion != nil
+// IsSetNoServers returns true if NoServers is not nil.
+func (v *GenerateServiceRequest) IsSetNoServers() bool {
+	return v != nil && v.NoServers != nil
 }
 
-// Module is a module generated from a single Thrift file. Each module
-// corresponds to exactly one Thrift file and contains all the types and
-// constants defined in that Thrift file.
-type Module struct {
-	// Import path for the package defining the types for this module.
-	ImportPath string `json:"importPath,required"`
-	// Path to the directory containing the code for this module.
+// GenerateServiceResponse is response to a GenerateServiceRequest.
+type GenerateServiceResponse struct {
+	// Map of file path to file contents.
 	//
-	// The path is relative to the output directory into which ThriftRW is
-	// generating code. Plugins SHOULD NOT make any assumptions about the
+	// All paths MUST be relative to the output directory into which ThriftRW
+	// is generating code. Plugins SHOULD NOT make any assumptions about the
 	// absolute location of the directory.
-	Directory string `json:"directory,required"`
-	// Path to the Thrift file from which this module was generated.
-	ThriftFilePath string `json:"thriftFilePath,required"`
+	//
+	// The paths MUST NOT contain the string ".." or the request will fail.
+	Files map[string][]byte `json:"files,omitempty"`
 }
 
-// ToWire translates a Module struct into a Thrift-level intermediate
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
+// ToWire translates a GenerateServiceResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3760,43 +4206,59 @@ type Module struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Module) ToWire() (wire.Value, error) {
+func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.ImportPath), error(nil)
-	if err != nil {
-		return w, err
+	if v.Files != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
-	w, err = wire.NewValueString(v.Directory), error(nil)
-	if err != nil {
-		return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
-	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
-	if err != nil {
-		return w, err
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
 	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-// FromWire deserializes a Module struct from its Thrift-level
+// FromWire deserializes a GenerateServiceResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Module struct
+// An error is returned if we were unable to build a GenerateServiceResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3804,114 +4266,126 @@ func (v *Module) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Module
+//   var v GenerateServiceResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Module) FromWire(w wire.Value) error {
+func (v *GenerateServiceResponse) FromWire(w wire.Value) error {
 	var err error
 
-	importPathIsSet := false
-	directoryIsSet := false
-	thriftFilePathIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.ImportPath, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				importPathIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.Directory, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				directoryIsSet = true
-			}
-		case 3:
-			if field.Value.Type() == wire.TBinary {
-				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TMap {
+				v.Files, err = _Map_String_Binary_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
-				thriftFilePathIsSet = true
+
 			}
 		}
 	}
 
-	if !importPathIsSet {
-		return errors.New("field ImportPath of Module is required")
-	}
+	return nil
+}
 
-	if !directoryIsSet {
-		return errors.New("field Directory of Module is required")
-	}
+func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
 
-	if !thriftFilePathIsSet {
-		return errors.New("field ThriftFilePath of Module is required")
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
 	}
 
-	return nil
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
 }
 
-// Encode serializes a Module struct directly into bytes, without going
+// Encode serializes a GenerateServiceResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a Module struct could not be encoded.
-func (v *Module) Encode(sw stream.Writer) error {
+// An error is returned if a GenerateServiceResponse struct could not be encoded.
+func (v *GenerateServiceResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.ImportPath); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	if v.Files != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Binary_Encode(v.Files, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Directory); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
+	return sw.WriteStructEnd()
+}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
-		return err
+func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
-	if err := sw.WriteString(v.ThriftFilePath); err != nil {
-		return err
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	o := make(map[string][]byte, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
 	}
 
-	return sw.WriteStructEnd()
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a Module struct directly from its Thrift-level
+// Decode deserializes a GenerateServiceResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a Module struct could not be generated from the wire
+// An error is returned if a GenerateServiceResponse struct could not be generated from the wire
 // representation.
-func (v *Module) Decode(sr stream.Reader) error {
-
-	importPathIsSet := false
-	directoryIsSet := false
-	thriftFilePathIsSet := false
+func (v *GenerateServiceResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -3924,24 +4398,12 @@ func (v *Module) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.ImportPath, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			importPathIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TBinary:
-			v.Directory, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			directoryIsSet = true
-		case fh.ID == 3 && fh.Type == wire.TBinary:
-			v.ThriftFilePath, err = sr.ReadString()
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Files, err = _Map_String_Binary_Decode(sr)
 			if err != nil {
 				return err
 			}
-			thriftFilePathIsSet = true
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -3961,210 +4423,167 @@ func (v *Module) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !importPathIsSet {
-		return errors.New("field ImportPath of Module is required")
-	}
-
-	if !directoryIsSet {
-		return errors.New("field Directory of Module is required")
-	}
-
-	if !thriftFilePathIsSet {
-		return errors.New("field ThriftFilePath of Module is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a Module
+// String returns a readable string representation of a GenerateServiceResponse
 // struct.
-func (v *Module) String() string {
+func (v *GenerateServiceResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
-	i++
-	fields[i] = fmt.Sprintf("Directory: %v", v.Directory)
-	i++
-	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
-	i++
+	if v.Files != nil {
+		fields[i] = fmt.Sprintf("Files: %v", v.Files)
+		i++
+	}
 
-	return fmt.Sprintf("Module{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GenerateServiceResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Module match the
-// provided Module.
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GenerateServiceResponse match the
+// provided GenerateServiceResponse.
 //
 // This function performs a deep comparison.
-func (v *Module) Equals(rhs *Module) bool {
+func (v *GenerateServiceResponse) Equals(rhs *GenerateServiceResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.ImportPath == rhs.ImportPath) {
-		return false
-	}
-	if !(v.Directory == rhs.Directory) {
-		return false
-	}
-	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+	if !((v.Files == nil && rhs.Files == nil) || (v.Files != nil && rhs.Files != nil && _Map_String_Binary_Equals(v.Files, rhs.Files))) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Module.
-func (v *Module) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+func _Binary_Copy(v []byte) []byte {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("importPath", v.ImportPath)
-	enc.AddString("directory", v.Directory)
-	enc.AddString("thriftFilePath", v.ThriftFilePath)
-	return err
-}
 
-// GetImportPath returns the value of ImportPath if it is set or its
-// zero value if it is unset.
-func (v *Module) GetImportPath() (o string) {
-	if v != nil {
-		o = v.ImportPath
-	}
-	return
+	o := make([]byte, len(v))
+	copy(o, v)
+	return o
 }
 
-// GetDirectory returns the value of Directory if it is set or its
-// zero value if it is unset.
-func (v *Module) GetDirectory() (o string) {
-	if v != nil {
-		o = v.Directory
+func _Map_String_Binary_Copy(v map[string][]byte) map[string][]byte {
+	if v == nil {
+		return nil
 	}
-	return
-}
 
-// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
-// zero value if it is unset.
-func (v *Module) GetThriftFilePath() (o string) {
-	if v != nil {
-		o = v.ThriftFilePath
+	o := make(map[string][]byte, len(v))
+	for k, x := range v {
+		o[k] = _Binary_Copy(x)
 	}
-	return
+	return o
 }
 
-// ModuleID is an arbitrary unique identifier to reference the different
-// modules in this request.
-type ModuleID int32
+// Copy returns a deep copy of this GenerateServiceResponse.
+func (v *GenerateServiceResponse) Copy() *GenerateServiceResponse {
+	if v == nil {
+		return nil
+	}
 
-// ModuleIDPtr returns a pointer to a ModuleID
-func (v ModuleID) Ptr() *ModuleID {
-	return &v
+	var o GenerateServiceResponse
+	o.Files = _Map_String_Binary_Copy(v.Files)
+	return &o
 }
 
-// ToWire translates ModuleID into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-func (v ModuleID) ToWire() (wire.Value, error) {
-	x := (int32)(v)
-	return wire.NewValueI32(x), error(nil)
+func _Map_String_Binary_Hash(v map[string][]byte) uint64 {
+
+	var u thrifthash.Unordered
+	for k, x := range v {
+		h := thrifthash.New()
+		h.String(k)
+		h.Binary(x)
+		u.Add(h.Sum64())
+	}
+	return u.Sum64()
 }
 
-// String returns a readable string representation of ModuleID.
-func (v ModuleID) String() string {
-	x := (int32)(v)
+// Hash returns a hash of this GenerateServiceResponse which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *GenerateServiceResponse) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
 
-	return fmt.Sprint(x)
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_Map_String_Binary_Hash(v.Files))
+	return h.Sum64()
 }
 
-func (v ModuleID) Encode(sw stream.Writer) error {
-	x := (int32)(v)
-	return sw.WriteInt32(x)
+// Reset zeroes all fields of this GenerateServiceResponse so that it may be reused.
+func (v *GenerateServiceResponse) Reset() {
+	*v = GenerateServiceResponse{}
 }
 
-// FromWire deserializes ModuleID from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-func (v *ModuleID) FromWire(w wire.Value) error {
-	x, err := w.GetI32(), error(nil)
-	*v = (ModuleID)(x)
-	return err
-}
+type _Map_String_Binary_Zapper map[string][]byte
 
-// Decode deserializes ModuleID directly off the wire.
-func (v *ModuleID) Decode(sr stream.Reader) error {
-	x, err := sr.ReadInt32()
-	*v = (ModuleID)(x)
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
 	return err
 }
 
-// Equals returns true if this ModuleID is equal to the provided
-// ModuleID.
-func (lhs ModuleID) Equals(rhs ModuleID) bool {
-	return ((int32)(lhs) == (int32)(rhs))
-}
-
-// Service is a service defined by the user in the Thrift file.
-type Service struct {
-	// Name of the Thrift service in Go code.
-	Name string `json:"name,required"`
-	// Name of the service as defined in the Thrift file.
-	ThriftName string `json:"thriftName,required"`
-	// ID of the parent service.
-	ParentID *ServiceID `json:"parentID,omitempty"`
-	// List of functions defined for this service.
-	Functions []*Function `json:"functions,required"`
-	// ID of the module where this service was declared.
-	ModuleID ModuleID `json:"moduleID,required"`
-	// Annotations defined on this service.
-	//
-	// Given,
-	//
-	//   service KeyValue {
-	//   } (private = "true")
-	//
-	// The annotations will be,
-	//
-	//  {
-	//    "private": "true",
-	//  }
-	Annotations map[string]string `json:"annotations,omitempty"`
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GenerateServiceResponse.
+func (v *GenerateServiceResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Files != nil {
+		err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
+	}
+	return err
 }
 
-type _List_Function_ValueList []*Function
-
-func (v _List_Function_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*Function', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetFiles returns the value of Files if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceResponse) GetFiles() (o map[string][]byte) {
+	if v != nil && v.Files != nil {
+		return v.Files
 	}
-	return nil
-}
 
-func (v _List_Function_ValueList) Size() int {
-	return len(v)
+	return
 }
 
-func (_List_Function_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// IsSetFiles returns true if Files is not nil.
+func (v *GenerateServiceResponse) IsSetFiles() bool {
+	return v != nil && v.Files != nil
 }
 
-func (_List_Function_ValueList) Close() {}
+// HandshakeRequest is the initial request sent to the plugin as part of
+// establishing communication and feature negotiation.
+type HandshakeRequest struct {
+}
 
-// ToWire translates a Service struct into a Thrift-level intermediate
+// ToWire translates a HandshakeRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4179,90 +4598,20 @@ func (_List_Function_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Service) ToWire() (wire.Value, error) {
+func (v *HandshakeRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [0]wire.Field
 		i      int = 0
-		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 7, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.ThriftName), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-	if v.ParentID != nil {
-		w, err = v.ParentID.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-
-	w, err = wire.NewValueList(_List_Function_ValueList(v.Functions)), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 5, Value: w}
-	i++
-
-	w, err = v.ModuleID.ToWire()
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 6, Value: w}
-	i++
-	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 8, Value: w}
-		i++
-	}
-
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Function_Read(w wire.Value) (*Function, error) {
-	var v Function
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*Function, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Function_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a Service struct from its Thrift-level
+// FromWire deserializes a HandshakeRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Service struct
+// An error is returned if we were unable to build a HandshakeRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4270,878 +4619,4544 @@ func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
 //     return nil, err
 //   }
 //
-//   var v Service
+//   var v HandshakeRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Service) FromWire(w wire.Value) error {
-	var err error
-
-	nameIsSet := false
-	thriftNameIsSet := false
-
-	functionsIsSet := false
-	moduleIDIsSet := false
+func (v *HandshakeRequest) FromWire(w wire.Value) error {
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 7:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				nameIsSet = true
-			}
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.ThriftName, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				thriftNameIsSet = true
-			}
-		case 4:
-			if field.Value.Type() == wire.TI32 {
-				var x ServiceID
-				x, err = _ServiceID_Read(field.Value)
-				v.ParentID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.Functions, err = _List_Function_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-				functionsIsSet = true
-			}
-		case 6:
-			if field.Value.Type() == wire.TI32 {
-				v.ModuleID, err = _ModuleID_Read(field.Value)
-				if err != nil {
-					return err
-				}
-				moduleIDIsSet = true
-			}
-		case 8:
-			if field.Value.Type() == wire.TMap {
-				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
 		}
 	}
 
-	if !nameIsSet {
-		return errors.New("field Name of Service is required")
-	}
+	return nil
+}
 
-	if !thriftNameIsSet {
-		return errors.New("field ThriftName of Service is required")
+// Encode serializes a HandshakeRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a HandshakeRequest struct could not be encoded.
+func (v *HandshakeRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if !functionsIsSet {
-		return errors.New("field Functions of Service is required")
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a HandshakeRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a HandshakeRequest struct could not be generated from the wire
+// representation.
+func (v *HandshakeRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	if !moduleIDIsSet {
-		return errors.New("field ModuleID of Service is required")
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return nil
-}
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-func _List_Function_Encode(val []*Function, sw stream.Writer) error {
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteListBegin(lh); err != nil {
+
+	if err := sr.ReadStructEnd(); err != nil {
 		return err
 	}
 
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*Function', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
+	return nil
+}
+
+// String returns a readable string representation of a HandshakeRequest
+// struct.
+func (v *HandshakeRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return sw.WriteListEnd()
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("HandshakeRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Encode serializes a Service struct directly into bytes, without going
-// through an intermediary type.
+// Equals returns true if all the fields of this HandshakeRequest match the
+// provided HandshakeRequest.
 //
-// An error is returned if a Service struct could not be encoded.
-func (v *Service) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
+// This function performs a deep comparison.
+func (v *HandshakeRequest) Equals(rhs *HandshakeRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Name); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
+	return true
+}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.ThriftName); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+// Copy returns a deep copy of this HandshakeRequest.
+func (v *HandshakeRequest) Copy() *HandshakeRequest {
+	if v == nil {
+		return nil
 	}
 
-	if v.ParentID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.ParentID.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+	var o HandshakeRequest
+	return &o
+}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
-		return err
-	}
-	if err := _List_Function_Encode(v.Functions, sw); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+// Hash returns a hash of this HandshakeRequest which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *HandshakeRequest) Hash() uint64 {
+	if v == nil {
+		return 0
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TI32}); err != nil {
-		return err
-	}
-	if err := v.ModuleID.Encode(sw); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this HandshakeRequest so that it may be reused.
+func (v *HandshakeRequest) Reset() {
+	*v = HandshakeRequest{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HandshakeRequest.
+func (v *HandshakeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
+	return err
+}
 
-	if v.Annotations != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TMap}); err != nil {
-			return err
-		}
-		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
+// HandshakeResponse is the response from the plugin for a HandshakeRequest.
+type HandshakeResponse struct {
+	// Name of the plugin. This MUST match the name of the plugin specified
+	// over the command line or the program will fail.
+	Name string `json:"name,required"`
+	// Version of the plugin API.
+	//
+	// This MUST be set to API_VERSION by the plugin.
+	APIVersion int32 `json:"apiVersion,required"`
+	// List of features the plugin provides.
+	Features []Feature `json:"features,required"`
+	// Version of ThriftRW with which the plugin was built.
+	//
+	// This MUST be set to go.uber.org/thriftrw/version.Version by the plugin
+	// explicitly.
+	LibraryVersion *string `json:"libraryVersion,omitempty"`
+}
+
+type _List_Feature_ValueList []Feature
+
+func (v _List_Feature_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
 			return err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		err = f(w)
+		if err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
-	return sw.WriteStructEnd()
+func (v _List_Feature_ValueList) Size() int {
+	return len(v)
 }
 
-func _Function_Decode(sr stream.Reader) (*Function, error) {
-	var v Function
-	err := v.Decode(sr)
-	return &v, err
+func (_List_Feature_ValueList) ValueType() wire.Type {
+	return wire.TI32
 }
 
-func _List_Function_Decode(sr stream.Reader) ([]*Function, error) {
-	lh, err := sr.ReadListBegin()
+func (_List_Feature_ValueList) Close() {}
+
+// ToWire translates a HandshakeResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HandshakeResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
 	if err != nil {
-		return nil, err
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
+	w, err = wire.NewValueI32(v.APIVersion), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
 
-	o := make([]*Function, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _Function_Decode(sr)
+	w, err = wire.NewValueList(_List_Feature_ValueList(v.Features)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.LibraryVersion != nil {
+		w, err = wire.NewValueString(*(v.LibraryVersion)), error(nil)
 		if err != nil {
-			return nil, err
+			return w, err
 		}
-		o = append(o, v)
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// Decode deserializes a Service struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a Service struct could not be generated from the wire
-// representation.
-func (v *Service) Decode(sr stream.Reader) error {
-
-	nameIsSet := false
-	thriftNameIsSet := false
-
-	functionsIsSet := false
-	moduleIDIsSet := false
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
+func _Feature_Read(w wire.Value) (Feature, error) {
+	var v Feature
+	err := v.FromWire(w)
+	return v, err
+}
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+func _List_Feature_Read(l wire.ValueList) ([]Feature, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 7 && fh.Type == wire.TBinary:
-			v.Name, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			nameIsSet = true
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.ThriftName, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			thriftNameIsSet = true
-		case fh.ID == 4 && fh.Type == wire.TI32:
-			var x ServiceID
-			x, err = _ServiceID_Decode(sr)
-			v.ParentID = &x
-			if err != nil {
-				return err
-			}
+	o := make([]Feature, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Feature_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
 
-		case fh.ID == 5 && fh.Type == wire.TList:
-			v.Functions, err = _List_Function_Decode(sr)
-			if err != nil {
-				return err
+// FromWire deserializes a HandshakeResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HandshakeResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HandshakeResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HandshakeResponse) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	apiVersionIsSet := false
+	featuresIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
 			}
-			functionsIsSet = true
-		case fh.ID == 6 && fh.Type == wire.TI32:
-			v.ModuleID, err = _ModuleID_Decode(sr)
-			if err != nil {
-				return err
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.APIVersion, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				apiVersionIsSet = true
 			}
-			moduleIDIsSet = true
-		case fh.ID == 8 && fh.Type == wire.TMap:
-			v.Annotations, err = _Map_String_String_Decode(sr)
-			if err != nil {
-				return err
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Features, err = _List_Feature_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				featuresIsSet = true
 			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LibraryVersion = &x
+				if err != nil {
+					return err
+				}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
 			}
 		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
-	}
-
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
 	}
 
 	if !nameIsSet {
-		return errors.New("field Name of Service is required")
-	}
-
-	if !thriftNameIsSet {
-		return errors.New("field ThriftName of Service is required")
+		return errors.New("field Name of HandshakeResponse is required")
 	}
 
-	if !functionsIsSet {
-		return errors.New("field Functions of Service is required")
+	if !apiVersionIsSet {
+		return errors.New("field APIVersion of HandshakeResponse is required")
 	}
 
-	if !moduleIDIsSet {
-		return errors.New("field ModuleID of Service is required")
+	if !featuresIsSet {
+		return errors.New("field Features of HandshakeResponse is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Service
-// struct.
-func (v *Service) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+func _List_Feature_Encode(val []Feature, sw stream.Writer) error {
 
-	var fields [6]string
-	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
-	i++
-	if v.ParentID != nil {
-		fields[i] = fmt.Sprintf("ParentID: %v", *(v.ParentID))
-		i++
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
 	}
-	fields[i] = fmt.Sprintf("Functions: %v", v.Functions)
-	i++
-	fields[i] = fmt.Sprintf("ModuleID: %v", v.ModuleID)
-	i++
-	if v.Annotations != nil {
-		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
-		i++
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
 	}
-
-	return fmt.Sprintf("Service{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _ServiceID_EqualsPtr(lhs, rhs *ServiceID) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	type chunk struct {
+		idx    int
+		val    []Feature
+		buffer *bytes.Buffer
+		err    error
 	}
-	return lhs == nil && rhs == nil
-}
-
-func _List_Function_Equals(lhs, rhs []*Function) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
 	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
 		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
 	}
-
-	return true
+	return sw.WriteListEnd()
 }
 
-// Equals returns true if all the fields of this Service match the
-// provided Service.
+// Encode serializes a HandshakeResponse struct directly into bytes, without going
+// through an intermediary type.
 //
-// This function performs a deep comparison.
-func (v *Service) Equals(rhs *Service) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+// An error is returned if a HandshakeResponse struct could not be encoded.
+func (v *HandshakeResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
-	if !(v.Name == rhs.Name) {
-		return false
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
 	}
-	if !(v.ThriftName == rhs.ThriftName) {
-		return false
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
 	}
-	if !_ServiceID_EqualsPtr(v.ParentID, rhs.ParentID) {
-		return false
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
-	if !_List_Function_Equals(v.Functions, rhs.Functions) {
-		return false
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI32}); err != nil {
+		return err
 	}
-	if !(v.ModuleID == rhs.ModuleID) {
-		return false
+	if err := sw.WriteInt32(v.APIVersion); err != nil {
+		return err
 	}
-	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
-		return false
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
 
-	return true
-}
-
-type _List_Function_Zapper []*Function
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_Feature_Encode(v.Features, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Function_Zapper.
-func (l _List_Function_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+	if v.LibraryVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LibraryVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return err
+
+	return sw.WriteStructEnd()
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Service.
-func (v *Service) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+func _Feature_Decode(sr stream.Reader) (Feature, error) {
+	var v Feature
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_Feature_Decode(sr stream.Reader) ([]Feature, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
-	enc.AddString("name", v.Name)
-	enc.AddString("thriftName", v.ThriftName)
-	if v.ParentID != nil {
-		enc.AddInt32("parentID", (int32)(*v.ParentID))
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
-	err = multierr.Append(err, enc.AddArray("functions", (_List_Function_Zapper)(v.Functions)))
-	enc.AddInt32("moduleID", (int32)(v.ModuleID))
-	if v.Annotations != nil {
-		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+
+	o := make([]Feature, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Feature_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
 	}
-	return err
-}
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *Service) GetName() (o string) {
-	if v != nil {
-		o = v.Name
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
 	}
-	return
+	return o, err
 }
 
-// GetThriftName returns the value of ThriftName if it is set or its
-// zero value if it is unset.
-func (v *Service) GetThriftName() (o string) {
-	if v != nil {
-		o = v.ThriftName
+// Decode deserializes a HandshakeResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a HandshakeResponse struct could not be generated from the wire
+// representation.
+func (v *HandshakeResponse) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+	apiVersionIsSet := false
+	featuresIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	return
-}
 
-// GetParentID returns the value of ParentID if it is set or its
-// zero value if it is unset.
-func (v *Service) GetParentID() (o ServiceID) {
-	if v != nil && v.ParentID != nil {
-		return *v.ParentID
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.APIVersion, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			apiVersionIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Features, err = _List_Feature_Decode(sr)
+			if err != nil {
+				return err
+			}
+			featuresIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LibraryVersion = &x
+			if err != nil {
+				return err
+			}
 
-// IsSetParentID returns true if ParentID is not nil.
-func (v *Service) IsSetParentID() bool {
-	return v != nil && v.ParentID != nil
-}
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// GetFunctions returns the value of Functions if it is set or its
-// zero value if it is unset.
-func (v *Service) GetFunctions() (o []*Function) {
-	if v != nil {
-		o = v.Functions
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	return
-}
 
-// IsSetFunctions returns true if Functions is not nil.
-func (v *Service) IsSetFunctions() bool {
-	return v != nil && v.Functions != nil
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of HandshakeResponse is required")
+	}
+
+	if !apiVersionIsSet {
+		return errors.New("field APIVersion of HandshakeResponse is required")
+	}
+
+	if !featuresIsSet {
+		return errors.New("field Features of HandshakeResponse is required")
+	}
+
+	return nil
 }
 
-// GetModuleID returns the value of ModuleID if it is set or its
-// zero value if it is unset.
-func (v *Service) GetModuleID() (o ModuleID) {
-	if v != nil {
-		o = v.ModuleID
+// String returns a readable string representation of a HandshakeResponse
+// struct.
+func (v *HandshakeResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("APIVersion: %v", v.APIVersion)
+	i++
+	fields[i] = fmt.Sprintf("Features: %v", v.Features)
+	i++
+	if v.LibraryVersion != nil {
+		fields[i] = fmt.Sprintf("LibraryVersion: %v", *(v.LibraryVersion))
+		i++
+	}
+
+	return fmt.Sprintf("HandshakeResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetAnnotations returns the value of Annotations if it is set or its
-// zero value if it is unset.
-func (v *Service) GetAnnotations() (o map[string]string) {
-	if v != nil && v.Annotations != nil {
-		return v.Annotations
+func _List_Feature_Equals(lhs, rhs []Feature) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// IsSetAnnotations returns true if Annotations is not nil.
-func (v *Service) IsSetAnnotations() bool {
-	return v != nil && v.Annotations != nil
+// Equals returns true if all the fields of this HandshakeResponse match the
+// provided HandshakeResponse.
+//
+// This function performs a deep comparison.
+func (v *HandshakeResponse) Equals(rhs *HandshakeResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.APIVersion == rhs.APIVersion) {
+		return false
+	}
+	if !_List_Feature_Equals(v.Features, rhs.Features) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LibraryVersion, rhs.LibraryVersion) {
+		return false
+	}
+
+	return true
 }
 
-// ServiceID is an arbitrary unique identifier to reference the different
-// services in this request.
-type ServiceID int32
+func _List_Feature_Copy(v []Feature) []Feature {
+	if v == nil {
+		return nil
+	}
 
-// ServiceIDPtr returns a pointer to a ServiceID
-func (v ServiceID) Ptr() *ServiceID {
-	return &v
+	o := make([]Feature, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
 }
 
-// ToWire translates ServiceID into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-func (v ServiceID) ToWire() (wire.Value, error) {
-	x := (int32)(v)
-	return wire.NewValueI32(x), error(nil)
+// Copy returns a deep copy of this HandshakeResponse.
+func (v *HandshakeResponse) Copy() *HandshakeResponse {
+	if v == nil {
+		return nil
+	}
+
+	var o HandshakeResponse
+	o.Name = v.Name
+	o.APIVersion = v.APIVersion
+	o.Features = _List_Feature_Copy(v.Features)
+	o.LibraryVersion = _String_CopyPtr(v.LibraryVersion)
+	return &o
 }
 
-// String returns a readable string representation of ServiceID.
-func (v ServiceID) String() string {
-	x := (int32)(v)
+func _List_Feature_Hash(v []Feature) uint64 {
 
-	return fmt.Sprint(x)
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Int32(int32(x))
+	}
+	return h.Sum64()
 }
 
-func (v ServiceID) Encode(sw stream.Writer) error {
-	x := (int32)(v)
-	return sw.WriteInt32(x)
+// Hash returns a hash of this HandshakeResponse which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *HandshakeResponse) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.Int32(v.APIVersion)
+	h.Field(3)
+	h.Uint64(_List_Feature_Hash(v.Features))
+	if v.LibraryVersion != nil {
+		h.Field(4)
+		h.String(*v.LibraryVersion)
+	}
+	return h.Sum64()
 }
 
-// FromWire deserializes ServiceID from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-func (v *ServiceID) FromWire(w wire.Value) error {
-	x, err := w.GetI32(), error(nil)
-	*v = (ServiceID)(x)
-	return err
+// Reset zeroes all fields of this HandshakeResponse so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *HandshakeResponse) Reset() {
+	*v = HandshakeResponse{
+		Features: v.Features[:0],
+	}
 }
 
-// Decode deserializes ServiceID directly off the wire.
-func (v *ServiceID) Decode(sr stream.Reader) error {
-	x, err := sr.ReadInt32()
-	*v = (ServiceID)(x)
+type _List_Feature_Zapper []Feature
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Feature_Zapper.
+func (l _List_Feature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
 	return err
 }
 
-// Equals returns true if this ServiceID is equal to the provided
-// ServiceID.
-func (lhs ServiceID) Equals(rhs ServiceID) bool {
-	return ((int32)(lhs) == (int32)(rhs))
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HandshakeResponse.
+func (v *HandshakeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddInt32("apiVersion", v.APIVersion)
+	err = multierr.Append(err, enc.AddArray("features", (_List_Feature_Zapper)(v.Features)))
+	if v.LibraryVersion != nil {
+		enc.AddString("libraryVersion", *v.LibraryVersion)
+	}
+	return err
 }
 
-// SimpleType is a standalone native Go type.
-type SimpleType int32
-
-const (
-	SimpleTypeBool        SimpleType = 1
-	SimpleTypeByte        SimpleType = 2
-	SimpleTypeInt8        SimpleType = 3
-	SimpleTypeInt16       SimpleType = 4
-	SimpleTypeInt32       SimpleType = 5
-	SimpleTypeInt64       SimpleType = 6
-	SimpleTypeFloat64     SimpleType = 7
-	SimpleTypeString      SimpleType = 8
-	SimpleTypeStructEmpty SimpleType = 9
-)
-
-// SimpleType_Values returns all recognized values of SimpleType.
-func SimpleType_Values() []SimpleType {
-	return []SimpleType{
-		SimpleTypeBool,
-		SimpleTypeByte,
-		SimpleTypeInt8,
-		SimpleTypeInt16,
-		SimpleTypeInt32,
-		SimpleTypeInt64,
-		SimpleTypeFloat64,
-		SimpleTypeString,
-		SimpleTypeStructEmpty,
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetName() (o string) {
+	if v != nil {
+		o = v.Name
 	}
+	return
 }
 
-// UnmarshalText tries to decode SimpleType from a byte slice
-// containing its name.
-//
-//   var v SimpleType
-//   err := v.UnmarshalText([]byte("BOOL"))
-func (v *SimpleType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "BOOL":
-		*v = SimpleTypeBool
-		return nil
-	case "BYTE":
-		*v = SimpleTypeByte
-		return nil
-	case "INT8":
-		*v = SimpleTypeInt8
-		return nil
-	case "INT16":
-		*v = SimpleTypeInt16
-		return nil
-	case "INT32":
-		*v = SimpleTypeInt32
-		return nil
-	case "INT64":
-		*v = SimpleTypeInt64
-		return nil
-	case "FLOAT64":
-		*v = SimpleTypeFloat64
-		return nil
-	case "STRING":
-		*v = SimpleTypeString
-		return nil
-	case "STRUCT_EMPTY":
-		*v = SimpleTypeStructEmpty
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "SimpleType", err)
-		}
-		*v = SimpleType(val)
-		return nil
+// GetAPIVersion returns the value of APIVersion if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetAPIVersion() (o int32) {
+	if v != nil {
+		o = v.APIVersion
 	}
+	return
 }
 
-// MarshalText encodes SimpleType to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v SimpleType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return []byte("BOOL"), nil
-	case 2:
-		return []byte("BYTE"), nil
-	case 3:
-		return []byte("INT8"), nil
-	case 4:
-		return []byte("INT16"), nil
-	case 5:
-		return []byte("INT32"), nil
-	case 6:
-		return []byte("INT64"), nil
-	case 7:
-		return []byte("FLOAT64"), nil
-	case 8:
-		return []byte("STRING"), nil
-	case 9:
-		return []byte("STRUCT_EMPTY"), nil
+// GetFeatures returns the value of Features if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetFeatures() (o []Feature) {
+	if v != nil {
+		o = v.Features
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SimpleType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v SimpleType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 1:
-		enc.AddString("name", "BOOL")
-	case 2:
-		enc.AddString("name", "BYTE")
-	case 3:
-		enc.AddString("name", "INT8")
-	case 4:
-		enc.AddString("name", "INT16")
-	case 5:
-		enc.AddString("name", "INT32")
-	case 6:
-		enc.AddString("name", "INT64")
-	case 7:
-		enc.AddString("name", "FLOAT64")
-	case 8:
-		enc.AddString("name", "STRING")
-	case 9:
-		enc.AddString("name", "STRUCT_EMPTY")
+// IsSetFeatures returns true if Features is not nil.
+func (v *HandshakeResponse) IsSetFeatures() bool {
+	return v != nil && v.Features != nil
+}
+
+// GetLibraryVersion returns the value of LibraryVersion if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetLibraryVersion() (o string) {
+	if v != nil && v.LibraryVersion != nil {
+		return *v.LibraryVersion
 	}
-	return nil
+
+	return
 }
 
-// Ptr returns a pointer to this enum value.
-func (v SimpleType) Ptr() *SimpleType {
-	return &v
+// IsSetLibraryVersion returns true if LibraryVersion is not nil.
+func (v *HandshakeResponse) IsSetLibraryVersion() bool {
+	return v != nil && v.LibraryVersion != nil
 }
 
-// Encode encodes SimpleType directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v SimpleType
-//   return v.Encode(sWriter)
-func (v SimpleType) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
+// Module is a module generated from a single Thrift file. Each module
+// corresponds to exactly one Thrift file and contains all the types and
+// constants defined in that Thrift file.
+type Module struct {
+	// Import path for the package defining the types for this module.
+	ImportPath string `json:"importPath,required"`
+	// Path to the directory containing the code for this module.
+	//
+	// The path is relative to the output directory into which ThriftRW is
+	// generating code. Plugins SHOULD NOT make any assumptions about the
+	// absolute location of the directory.
+	Directory string `json:"directory,required"`
+	// Path to the Thrift file from which this module was generated.
+	ThriftFilePath string `json:"thriftFilePath,required"`
 }
 
-// ToWire translates SimpleType into a Thrift-level intermediate
+// ToWire translates a Module struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v SimpleType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes SimpleType from its Thrift-level
-// representation.
+// An error is returned if the struct or any of its fields failed to
+// validate.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   x, err := v.ToWire()
 //   if err != nil {
-//     return SimpleType(0), err
+//     return err
 //   }
 //
-//   var v SimpleType
-//   if err := v.FromWire(x); err != nil {
-//     return SimpleType(0), err
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
 //   }
-//   return v, nil
-func (v *SimpleType) FromWire(w wire.Value) error {
-	*v = (SimpleType)(w.GetI32())
-	return nil
-}
+func (v *Module) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// Decode reads off the encoded SimpleType directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v SimpleType
-//   if err := v.Decode(sReader); err != nil {
-//     return SimpleType(0), err
-//   }
+	w, err = wire.NewValueString(v.ImportPath), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.Directory), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Module struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Module struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Module
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Module) FromWire(w wire.Value) error {
+	var err error
+
+	importPathIsSet := false
+	directoryIsSet := false
+	thriftFilePathIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ImportPath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				importPathIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Directory, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				directoryIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftFilePathIsSet = true
+			}
+		}
+	}
+
+	if !importPathIsSet {
+		return errors.New("field ImportPath of Module is required")
+	}
+
+	if !directoryIsSet {
+		return errors.New("field Directory of Module is required")
+	}
+
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Module is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a Module struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Module struct could not be encoded.
+func (v *Module) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ImportPath); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Directory); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ThriftFilePath); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Module struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Module struct could not be generated from the wire
+// representation.
+func (v *Module) Decode(sr stream.Reader) error {
+
+	importPathIsSet := false
+	directoryIsSet := false
+	thriftFilePathIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ImportPath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			importPathIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Directory, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			directoryIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.ThriftFilePath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftFilePathIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !importPathIsSet {
+		return errors.New("field ImportPath of Module is required")
+	}
+
+	if !directoryIsSet {
+		return errors.New("field Directory of Module is required")
+	}
+
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Module is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Module
+// struct.
+func (v *Module) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
+	i++
+	fields[i] = fmt.Sprintf("Directory: %v", v.Directory)
+	i++
+	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	i++
+
+	return fmt.Sprintf("Module{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Module match the
+// provided Module.
+//
+// This function performs a deep comparison.
+func (v *Module) Equals(rhs *Module) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ImportPath == rhs.ImportPath) {
+		return false
+	}
+	if !(v.Directory == rhs.Directory) {
+		return false
+	}
+	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this Module.
+func (v *Module) Copy() *Module {
+	if v == nil {
+		return nil
+	}
+
+	var o Module
+	o.ImportPath = v.ImportPath
+	o.Directory = v.Directory
+	o.ThriftFilePath = v.ThriftFilePath
+	return &o
+}
+
+// Hash returns a hash of this Module which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Module) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.ImportPath)
+	h.Field(2)
+	h.String(v.Directory)
+	h.Field(3)
+	h.String(v.ThriftFilePath)
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Module so that it may be reused.
+func (v *Module) Reset() {
+	*v = Module{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Module.
+func (v *Module) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("importPath", v.ImportPath)
+	enc.AddString("directory", v.Directory)
+	enc.AddString("thriftFilePath", v.ThriftFilePath)
+	return err
+}
+
+// GetImportPath returns the value of ImportPath if it is set or its
+// zero value if it is unset.
+func (v *Module) GetImportPath() (o string) {
+	if v != nil {
+		o = v.ImportPath
+	}
+	return
+}
+
+// GetDirectory returns the value of Directory if it is set or its
+// zero value if it is unset.
+func (v *Module) GetDirectory() (o string) {
+	if v != nil {
+		o = v.Directory
+	}
+	return
+}
+
+// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
+// zero value if it is unset.
+func (v *Module) GetThriftFilePath() (o string) {
+	if v != nil {
+		o = v.ThriftFilePath
+	}
+	return
+}
+
+// ModuleID is an arbitrary unique identifier to reference the different
+// modules in this request.
+type ModuleID int32
+
+// ModuleIDPtr returns a pointer to a ModuleID
+func (v ModuleID) Ptr() *ModuleID {
+	return &v
+}
+
+// ToWire translates ModuleID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v ModuleID) ToWire() (wire.Value, error) {
+	x := (int32)(v)
+	return wire.NewValueI32(x), error(nil)
+}
+
+// String returns a readable string representation of ModuleID.
+func (v ModuleID) String() string {
+	x := (int32)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v ModuleID) Encode(sw stream.Writer) error {
+	x := (int32)(v)
+	return sw.WriteInt32(x)
+}
+
+// FromWire deserializes ModuleID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *ModuleID) FromWire(w wire.Value) error {
+	x, err := w.GetI32(), error(nil)
+	*v = (ModuleID)(x)
+	return err
+}
+
+// Decode deserializes ModuleID directly off the wire.
+func (v *ModuleID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt32()
+	*v = (ModuleID)(x)
+	return err
+}
+
+// Equals returns true if this ModuleID is equal to the provided
+// ModuleID.
+func (lhs ModuleID) Equals(rhs ModuleID) bool {
+	return ((int32)(lhs) == (int32)(rhs))
+}
+
+// Hash returns a hash of this ModuleID which is stable across
+// processes.
+func (v ModuleID) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int32((int32)(v))
+	return h.Sum64()
+}
+
+// ResolveNamesRequest is a request to choose Go names for Thrift entities.
+type ResolveNamesRequest struct {
+	Entities []*Entity `json:"entities,required"`
+}
+
+type _List_Entity_ValueList []*Entity
+
+func (v _List_Entity_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Entity', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Entity_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Entity_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Entity_ValueList) Close() {}
+
+// ToWire translates a ResolveNamesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResolveNamesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueList(_List_Entity_ValueList(v.Entities)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Entity_Read(w wire.Value) (*Entity, error) {
+	var v Entity
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Entity_Read(l wire.ValueList) ([]*Entity, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Entity, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Entity_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ResolveNamesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResolveNamesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResolveNamesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResolveNamesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	entitiesIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Entities, err = _List_Entity_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				entitiesIsSet = true
+			}
+		}
+	}
+
+	if !entitiesIsSet {
+		return errors.New("field Entities of ResolveNamesRequest is required")
+	}
+
+	return nil
+}
+
+func _List_Entity_Encode(val []*Entity, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Entity
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Entity', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ResolveNamesRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ResolveNamesRequest struct could not be encoded.
+func (v *ResolveNamesRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_Entity_Encode(v.Entities, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Entity_Decode(sr stream.Reader) (*Entity, error) {
+	var v Entity
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Entity_Decode(sr stream.Reader) ([]*Entity, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Entity, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Entity_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ResolveNamesRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ResolveNamesRequest struct could not be generated from the wire
+// representation.
+func (v *ResolveNamesRequest) Decode(sr stream.Reader) error {
+
+	entitiesIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Entities, err = _List_Entity_Decode(sr)
+			if err != nil {
+				return err
+			}
+			entitiesIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !entitiesIsSet {
+		return errors.New("field Entities of ResolveNamesRequest is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResolveNamesRequest
+// struct.
+func (v *ResolveNamesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Entities: %v", v.Entities)
+	i++
+
+	return fmt.Sprintf("ResolveNamesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Entity_Equals(lhs, rhs []*Entity) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ResolveNamesRequest match the
+// provided ResolveNamesRequest.
+//
+// This function performs a deep comparison.
+func (v *ResolveNamesRequest) Equals(rhs *ResolveNamesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_List_Entity_Equals(v.Entities, rhs.Entities) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Entity_Copy(v []*Entity) []*Entity {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Entity, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this ResolveNamesRequest.
+func (v *ResolveNamesRequest) Copy() *ResolveNamesRequest {
+	if v == nil {
+		return nil
+	}
+
+	var o ResolveNamesRequest
+	o.Entities = _List_Entity_Copy(v.Entities)
+	return &o
+}
+
+func _List_Entity_Hash(v []*Entity) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this ResolveNamesRequest which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ResolveNamesRequest) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_Entity_Hash(v.Entities))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ResolveNamesRequest so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *ResolveNamesRequest) Reset() {
+	*v = ResolveNamesRequest{
+		Entities: v.Entities[:0],
+	}
+}
+
+type _List_Entity_Zapper []*Entity
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Entity_Zapper.
+func (l _List_Entity_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResolveNamesRequest.
+func (v *ResolveNamesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddArray("entities", (_List_Entity_Zapper)(v.Entities)))
+	return err
+}
+
+// GetEntities returns the value of Entities if it is set or its
+// zero value if it is unset.
+func (v *ResolveNamesRequest) GetEntities() (o []*Entity) {
+	if v != nil {
+		o = v.Entities
+	}
+	return
+}
+
+// IsSetEntities returns true if Entities is not nil.
+func (v *ResolveNamesRequest) IsSetEntities() bool {
+	return v != nil && v.Entities != nil
+}
+
+// ResolveNamesResponse is the response to a ResolveNamesRequest.
+type ResolveNamesResponse struct {
+	// Go names for the requested entities, in the same order. An empty
+	// string keeps the name the entity already has. If this is not set, no
+	// names are changed.
+	//
+	// Names MUST be exported Go identifiers and MUST NOT conflict with the
+	// names of other entities of the same kind in the same type, service, or
+	// Thrift file, or the request will fail.
+	Names []string `json:"names,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a ResolveNamesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResolveNamesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Names != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Names)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ResolveNamesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResolveNamesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResolveNamesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResolveNamesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Names, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []string
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if err := writer.WriteString(v); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ResolveNamesResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ResolveNamesResponse struct could not be encoded.
+func (v *ResolveNamesResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Names != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Names, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ResolveNamesResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ResolveNamesResponse struct could not be generated from the wire
+// representation.
+func (v *ResolveNamesResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Names, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResolveNamesResponse
+// struct.
+func (v *ResolveNamesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Names != nil {
+		fields[i] = fmt.Sprintf("Names: %v", v.Names)
+		i++
+	}
+
+	return fmt.Sprintf("ResolveNamesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ResolveNamesResponse match the
+// provided ResolveNamesResponse.
+//
+// This function performs a deep comparison.
+func (v *ResolveNamesResponse) Equals(rhs *ResolveNamesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Names == nil && rhs.Names == nil) || (v.Names != nil && rhs.Names != nil && _List_String_Equals(v.Names, rhs.Names))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Copy(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Copy returns a deep copy of this ResolveNamesResponse.
+func (v *ResolveNamesResponse) Copy() *ResolveNamesResponse {
+	if v == nil {
+		return nil
+	}
+
+	var o ResolveNamesResponse
+	o.Names = _List_String_Copy(v.Names)
+	return &o
+}
+
+func _List_String_Hash(v []string) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.String(x)
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this ResolveNamesResponse which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ResolveNamesResponse) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(_List_String_Hash(v.Names))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ResolveNamesResponse so that it may be reused.
+func (v *ResolveNamesResponse) Reset() {
+	*v = ResolveNamesResponse{}
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResolveNamesResponse.
+func (v *ResolveNamesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Names != nil {
+		err = multierr.Append(err, enc.AddArray("names", (_List_String_Zapper)(v.Names)))
+	}
+	return err
+}
+
+// GetNames returns the value of Names if it is set or its
+// zero value if it is unset.
+func (v *ResolveNamesResponse) GetNames() (o []string) {
+	if v != nil && v.Names != nil {
+		return v.Names
+	}
+
+	return
+}
+
+// IsSetNames returns true if Names is not nil.
+func (v *ResolveNamesResponse) IsSetNames() bool {
+	return v != nil && v.Names != nil
+}
+
+// Service is a service defined by the user in the Thrift file.
+type Service struct {
+	// Name of the Thrift service in Go code.
+	Name string `json:"name,required"`
+	// Name of the service as defined in the Thrift file.
+	ThriftName string `json:"thriftName,required"`
+	// ID of the parent service.
+	ParentID *ServiceID `json:"parentID,omitempty"`
+	// List of functions defined for this service.
+	Functions []*Function `json:"functions,required"`
+	// ID of the module where this service was declared.
+	ModuleID ModuleID `json:"moduleID,required"`
+	// Annotations defined on this service.
+	//
+	// Given,
+	//
+	//   service KeyValue {
+	//   } (private = "true")
+	//
+	// The annotations will be,
+	//
+	//  {
+	//    "private": "true",
+	//  }
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type _List_Function_ValueList []*Function
+
+func (v _List_Function_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Function', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Function_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Function_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Function_ValueList) Close() {}
+
+// ToWire translates a Service struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Service) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.ParentID != nil {
+		w, err = v.ParentID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	w, err = wire.NewValueList(_List_Function_ValueList(v.Functions)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+
+	w, err = v.ModuleID.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 6, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Function_Read(w wire.Value) (*Function, error) {
+	var v Function
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Function, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Function_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Service struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Service struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Service
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Service) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	thriftNameIsSet := false
+
+	functionsIsSet := false
+	moduleIDIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftNameIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x ServiceID
+				x, err = _ServiceID_Read(field.Value)
+				v.ParentID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Functions, err = _List_Function_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				functionsIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TI32 {
+				v.ModuleID, err = _ModuleID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				moduleIDIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Service is required")
+	}
+
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Service is required")
+	}
+
+	if !functionsIsSet {
+		return errors.New("field Functions of Service is required")
+	}
+
+	if !moduleIDIsSet {
+		return errors.New("field ModuleID of Service is required")
+	}
+
+	return nil
+}
+
+func _List_Function_Encode(val []*Function, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+	type chunk struct {
+		idx    int
+		val    []*Function
+		buffer *bytes.Buffer
+		err    error
+	}
+	numChunks := runtime.GOMAXPROCS(0)
+	if numChunks > len(val) {
+		numChunks = len(val)
+	}
+	if numChunks == 0 {
+		numChunks = 1
+	}
+	chunkSize := (len(val) + numChunks - 1) / numChunks
+	chunks := make([]*chunk, 0, numChunks)
+	i := 0
+	for {
+		if i >= len(val) {
+			break
+		}
+		j := i + chunkSize
+		if j > len(val) {
+			j = len(val)
+		}
+		chunks = append(chunks, &chunk{idx: i, val: val[i:j], buffer: binary.BufferPool.Get().(*bytes.Buffer)})
+		i += chunkSize
+	}
+	var wg sync.WaitGroup
+	for i := range chunks {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := chunks[i]
+			writer := binary.Default.Writer(c.buffer)
+			for i := range c.val {
+				v := c.val[i]
+				if v == nil {
+					c.err = fmt.Errorf("invalid list '[]*Function', index [%v]: value is nil", (i + c.idx))
+					break
+				}
+				if err := v.Encode(writer); err != nil {
+					c.err = err
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, c := range chunks {
+		if c.err != nil {
+			return c.err
+		}
+		c.buffer.WriteTo(sw)
+		c.buffer.Reset()
+		binary.BufferPool.Put(c.buffer)
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a Service struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Service struct could not be encoded.
+func (v *Service) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ThriftName); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.ParentID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.ParentID.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_Function_Encode(v.Functions, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TI32}); err != nil {
+		return err
+	}
+	if err := v.ModuleID.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Annotations != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 8, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _Function_Decode(sr stream.Reader) (*Function, error) {
+	var v Function
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Function_Decode(sr stream.Reader) ([]*Function, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Function, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Function_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a Service struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Service struct could not be generated from the wire
+// representation.
+func (v *Service) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+	thriftNameIsSet := false
+
+	functionsIsSet := false
+	moduleIDIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ThriftName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftNameIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x ServiceID
+			x, err = _ServiceID_Decode(sr)
+			v.ParentID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Functions, err = _List_Function_Decode(sr)
+			if err != nil {
+				return err
+			}
+			functionsIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TI32:
+			v.ModuleID, err = _ModuleID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			moduleIDIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Service is required")
+	}
+
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Service is required")
+	}
+
+	if !functionsIsSet {
+		return errors.New("field Functions of Service is required")
+	}
+
+	if !moduleIDIsSet {
+		return errors.New("field ModuleID of Service is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Service
+// struct.
+func (v *Service) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
+	i++
+	if v.ParentID != nil {
+		fields[i] = fmt.Sprintf("ParentID: %v", *(v.ParentID))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Functions: %v", v.Functions)
+	i++
+	fields[i] = fmt.Sprintf("ModuleID: %v", v.ModuleID)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+
+	return fmt.Sprintf("Service{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ServiceID_EqualsPtr(lhs, rhs *ServiceID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Function_Equals(lhs, rhs []*Function) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Service match the
+// provided Service.
+//
+// This function performs a deep comparison.
+func (v *Service) Equals(rhs *Service) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.ThriftName == rhs.ThriftName) {
+		return false
+	}
+	if !_ServiceID_EqualsPtr(v.ParentID, rhs.ParentID) {
+		return false
+	}
+	if !_List_Function_Equals(v.Functions, rhs.Functions) {
+		return false
+	}
+	if !(v.ModuleID == rhs.ModuleID) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+
+	return true
+}
+
+func _ServiceID_CopyPtr(v *ServiceID) *ServiceID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_Function_Copy(v []*Function) []*Function {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Function, len(v))
+	for i, x := range v {
+		o[i] = x.Copy()
+	}
+	return o
+}
+
+// Copy returns a deep copy of this Service.
+func (v *Service) Copy() *Service {
+	if v == nil {
+		return nil
+	}
+
+	var o Service
+	o.Name = v.Name
+	o.ThriftName = v.ThriftName
+	o.ParentID = _ServiceID_CopyPtr(v.ParentID)
+	o.Functions = _List_Function_Copy(v.Functions)
+	o.ModuleID = v.ModuleID
+	o.Annotations = _Map_String_String_Copy(v.Annotations)
+	return &o
+}
+
+func _List_Function_Hash(v []*Function) uint64 {
+
+	h := thrifthash.New()
+	h.Len(len(v))
+	for _, x := range v {
+		h.Uint64(x.Hash())
+	}
+	return h.Sum64()
+}
+
+// Hash returns a hash of this Service which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Service) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(7)
+	h.String(v.Name)
+	h.Field(1)
+	h.String(v.ThriftName)
+	if v.ParentID != nil {
+		h.Field(4)
+		h.Int32(int32(*v.ParentID))
+	}
+	h.Field(5)
+	h.Uint64(_List_Function_Hash(v.Functions))
+	h.Field(6)
+	h.Int32(int32(v.ModuleID))
+	h.Field(8)
+	h.Uint64(_Map_String_String_Hash(v.Annotations))
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Service so that it may be reused.
+//
+// Required lists, sets, maps, and binary fields are emptied rather
+// than released so that their capacity may be reused.
+func (v *Service) Reset() {
+	*v = Service{
+		Functions: v.Functions[:0],
+	}
+}
+
+type _List_Function_Zapper []*Function
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Function_Zapper.
+func (l _List_Function_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Service.
+func (v *Service) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddString("thriftName", v.ThriftName)
+	if v.ParentID != nil {
+		enc.AddInt32("parentID", (int32)(*v.ParentID))
+	}
+	err = multierr.Append(err, enc.AddArray("functions", (_List_Function_Zapper)(v.Functions)))
+	enc.AddInt32("moduleID", (int32)(v.ModuleID))
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Service) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+func (v *Service) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
+	}
+	return
+}
+
+// GetParentID returns the value of ParentID if it is set or its
+// zero value if it is unset.
+func (v *Service) GetParentID() (o ServiceID) {
+	if v != nil && v.ParentID != nil {
+		return *v.ParentID
+	}
+
+	return
+}
+
+// IsSetParentID returns true if ParentID is not nil.
+func (v *Service) IsSetParentID() bool {
+	return v != nil && v.ParentID != nil
+}
+
+// GetFunctions returns the value of Functions if it is set or its
+// zero value if it is unset.
+func (v *Service) GetFunctions() (o []*Function) {
+	if v != nil {
+		o = v.Functions
+	}
+	return
+}
+
+// IsSetFunctions returns true if Functions is not nil.
+func (v *Service) IsSetFunctions() bool {
+	return v != nil && v.Functions != nil
+}
+
+// GetModuleID returns the value of ModuleID if it is set or its
+// zero value if it is unset.
+func (v *Service) GetModuleID() (o ModuleID) {
+	if v != nil {
+		o = v.ModuleID
+	}
+	return
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *Service) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Service) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// ServiceID is an arbitrary unique identifier to reference the different
+// services in this request.
+type ServiceID int32
+
+// ServiceIDPtr returns a pointer to a ServiceID
+func (v ServiceID) Ptr() *ServiceID {
+	return &v
+}
+
+// ToWire translates ServiceID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v ServiceID) ToWire() (wire.Value, error) {
+	x := (int32)(v)
+	return wire.NewValueI32(x), error(nil)
+}
+
+// String returns a readable string representation of ServiceID.
+func (v ServiceID) String() string {
+	x := (int32)(v)
+
+	return fmt.Sprint(x)
+}
+
+func (v ServiceID) Encode(sw stream.Writer) error {
+	x := (int32)(v)
+	return sw.WriteInt32(x)
+}
+
+// FromWire deserializes ServiceID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *ServiceID) FromWire(w wire.Value) error {
+	x, err := w.GetI32(), error(nil)
+	*v = (ServiceID)(x)
+	return err
+}
+
+// Decode deserializes ServiceID directly off the wire.
+func (v *ServiceID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt32()
+	*v = (ServiceID)(x)
+	return err
+}
+
+// Equals returns true if this ServiceID is equal to the provided
+// ServiceID.
+func (lhs ServiceID) Equals(rhs ServiceID) bool {
+	return ((int32)(lhs) == (int32)(rhs))
+}
+
+// Hash returns a hash of this ServiceID which is stable across
+// processes.
+func (v ServiceID) Hash() uint64 {
+	h := thrifthash.New()
+	h.Int32((int32)(v))
+	return h.Sum64()
+}
+
+// SimpleType is a standalone native Go type.
+type SimpleType int32
+
+const (
+	SimpleTypeBool        SimpleType = 1
+	SimpleTypeByte        SimpleType = 2
+	SimpleTypeInt8        SimpleType = 3
+	SimpleTypeInt16       SimpleType = 4
+	SimpleTypeInt32       SimpleType = 5
+	SimpleTypeInt64       SimpleType = 6
+	SimpleTypeFloat64     SimpleType = 7
+	SimpleTypeString      SimpleType = 8
+	SimpleTypeStructEmpty SimpleType = 9
+	SimpleTypeUint8       SimpleType = 10
+	SimpleTypeUint16      SimpleType = 11
+	SimpleTypeUint32      SimpleType = 12
+	SimpleTypeUint64      SimpleType = 13
+)
+
+// SimpleType_Values returns all recognized values of SimpleType.
+func SimpleType_Values() []SimpleType {
+	return []SimpleType{
+		SimpleTypeBool,
+		SimpleTypeByte,
+		SimpleTypeInt8,
+		SimpleTypeInt16,
+		SimpleTypeInt32,
+		SimpleTypeInt64,
+		SimpleTypeFloat64,
+		SimpleTypeString,
+		SimpleTypeStructEmpty,
+		SimpleTypeUint8,
+		SimpleTypeUint16,
+		SimpleTypeUint32,
+		SimpleTypeUint64,
+	}
+}
+
+// SimpleType_Names returns the names of all recognized values of
+// SimpleType, in the same order as SimpleType_Values.
+func SimpleType_Names() []string {
+	return []string{
+		"BOOL",
+		"BYTE",
+		"INT8",
+		"INT16",
+		"INT32",
+		"INT64",
+		"FLOAT64",
+		"STRING",
+		"STRUCT_EMPTY",
+		"UINT8",
+		"UINT16",
+		"UINT32",
+		"UINT64",
+	}
+}
+
+// IsValid returns true if this SimpleType is one of its recognized
+// values.
+func (v SimpleType) IsValid() bool {
+	switch int32(v) {
+	case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13:
+		return true
+	}
+	return false
+}
+
+// UnmarshalText tries to decode SimpleType from a byte slice
+// containing its name.
+//
+//   var v SimpleType
+//   err := v.UnmarshalText([]byte("BOOL"))
+func (v *SimpleType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "BOOL":
+		*v = SimpleTypeBool
+		return nil
+	case "BYTE":
+		*v = SimpleTypeByte
+		return nil
+	case "INT8":
+		*v = SimpleTypeInt8
+		return nil
+	case "INT16":
+		*v = SimpleTypeInt16
+		return nil
+	case "INT32":
+		*v = SimpleTypeInt32
+		return nil
+	case "INT64":
+		*v = SimpleTypeInt64
+		return nil
+	case "FLOAT64":
+		*v = SimpleTypeFloat64
+		return nil
+	case "STRING":
+		*v = SimpleTypeString
+		return nil
+	case "STRUCT_EMPTY":
+		*v = SimpleTypeStructEmpty
+		return nil
+	case "UINT8":
+		*v = SimpleTypeUint8
+		return nil
+	case "UINT16":
+		*v = SimpleTypeUint16
+		return nil
+	case "UINT32":
+		*v = SimpleTypeUint32
+		return nil
+	case "UINT64":
+		*v = SimpleTypeUint64
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "SimpleType", err)
+		}
+		*v = SimpleType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes SimpleType to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v SimpleType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return []byte("BOOL"), nil
+	case 2:
+		return []byte("BYTE"), nil
+	case 3:
+		return []byte("INT8"), nil
+	case 4:
+		return []byte("INT16"), nil
+	case 5:
+		return []byte("INT32"), nil
+	case 6:
+		return []byte("INT64"), nil
+	case 7:
+		return []byte("FLOAT64"), nil
+	case 8:
+		return []byte("STRING"), nil
+	case 9:
+		return []byte("STRUCT_EMPTY"), nil
+	case 10:
+		return []byte("UINT8"), nil
+	case 11:
+		return []byte("UINT16"), nil
+	case 12:
+		return []byte("UINT32"), nil
+	case 13:
+		return []byte("UINT64"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SimpleType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v SimpleType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "BOOL")
+	case 2:
+		enc.AddString("name", "BYTE")
+	case 3:
+		enc.AddString("name", "INT8")
+	case 4:
+		enc.AddString("name", "INT16")
+	case 5:
+		enc.AddString("name", "INT32")
+	case 6:
+		enc.AddString("name", "INT64")
+	case 7:
+		enc.AddString("name", "FLOAT64")
+	case 8:
+		enc.AddString("name", "STRING")
+	case 9:
+		enc.AddString("name", "STRUCT_EMPTY")
+	case 10:
+		enc.AddString("name", "UINT8")
+	case 11:
+		enc.AddString("name", "UINT16")
+	case 12:
+		enc.AddString("name", "UINT32")
+	case 13:
+		enc.AddString("name", "UINT64")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v SimpleType) Ptr() *SimpleType {
+	return &v
+}
+
+// Set sets SimpleType from its name or integer value.
+//
+// This implements flag.Value, allowing SimpleType to be used as a
+// command line flag.
+func (v *SimpleType) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of this enum type for use in the help
+// messages of command line flags.
+//
+// This implements pflag.Value.
+func (v SimpleType) Type() string {
+	return "SimpleType"
+}
+
+// Encode encodes SimpleType directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v SimpleType
+//   return v.Encode(sWriter)
+func (v SimpleType) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates SimpleType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v SimpleType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes SimpleType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return SimpleType(0), err
+//   }
+//
+//   var v SimpleType
+//   if err := v.FromWire(x); err != nil {
+//     return SimpleType(0), err
+//   }
+//   return v, nil
+func (v *SimpleType) FromWire(w wire.Value) error {
+	*v = (SimpleType)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded SimpleType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v SimpleType
+//   if err := v.Decode(sReader); err != nil {
+//     return SimpleType(0), err
+//   }
 //   return v, nil
 func (v *SimpleType) Decode(sr stream.Reader) error {
 	i, err := sr.ReadInt32()
 	if err != nil {
 		return err
 	}
-	*v = (SimpleType)(i)
+	*v = (SimpleType)(i)
+	return nil
+}
+
+// String returns a readable string representation of SimpleType.
+func (v SimpleType) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "BOOL"
+	case 2:
+		return "BYTE"
+	case 3:
+		return "INT8"
+	case 4:
+		return "INT16"
+	case 5:
+		return "INT32"
+	case 6:
+		return "INT64"
+	case 7:
+		return "FLOAT64"
+	case 8:
+		return "STRING"
+	case 9:
+		return "STRUCT_EMPTY"
+	case 10:
+		return "UINT8"
+	case 11:
+		return "UINT16"
+	case 12:
+		return "UINT32"
+	case 13:
+		return "UINT64"
+	}
+	return fmt.Sprintf("SimpleType(%d)", w)
+}
+
+// Equals returns true if this SimpleType value matches the provided
+// value.
+func (v SimpleType) Equals(rhs SimpleType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes SimpleType into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v SimpleType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"BOOL\""), nil
+	case 2:
+		return ([]byte)("\"BYTE\""), nil
+	case 3:
+		return ([]byte)("\"INT8\""), nil
+	case 4:
+		return ([]byte)("\"INT16\""), nil
+	case 5:
+		return ([]byte)("\"INT32\""), nil
+	case 6:
+		return ([]byte)("\"INT64\""), nil
+	case 7:
+		return ([]byte)("\"FLOAT64\""), nil
+	case 8:
+		return ([]byte)("\"STRING\""), nil
+	case 9:
+		return ([]byte)("\"STRUCT_EMPTY\""), nil
+	case 10:
+		return ([]byte)("\"UINT8\""), nil
+	case 11:
+		return ([]byte)("\"UINT16\""), nil
+	case 12:
+		return ([]byte)("\"UINT32\""), nil
+	case 13:
+		return ([]byte)("\"UINT64\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode SimpleType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *SimpleType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "SimpleType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "SimpleType")
+		}
+		*v = (SimpleType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "SimpleType")
+	}
+}
+
+// Type is a reference to a Go type which may be native or user defined.
+type Type struct {
+	SimpleType *SimpleType `json:"simpleType,omitempty"`
+	// Slice of a type
+	//
+	// []$sliceType
+	SliceType *Type `json:"sliceType,omitempty"`
+	// Slice of key-value pairs of a pair of types.
+	//
+	// []struct{Key $left, Value $right}
+	KeyValueSliceType *TypePair `json:"keyValueSliceType,omitempty"`
+	// Map of a pair of types.
+	//
+	// map[$left]$right
+	MapType *TypePair `json:"mapType,omitempty"`
+	// Reference to a user-defined type.
+	ReferenceType *TypeReference `json:"referenceType,omitempty"`
+	// Pointer to a type.
+	PointerType *Type `json:"pointerType,omitempty"`
+}
+
+// ToWire translates a Type struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Type) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SimpleType != nil {
+		w, err = v.SimpleType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.SliceType != nil {
+		w, err = v.SliceType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.KeyValueSliceType != nil {
+		w, err = v.KeyValueSliceType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.MapType != nil {
+		w, err = v.MapType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.ReferenceType != nil {
+		w, err = v.ReferenceType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.PointerType != nil {
+		w, err = v.PointerType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Type should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SimpleType_Read(w wire.Value) (SimpleType, error) {
+	var v SimpleType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _TypePair_Read(w wire.Value) (*TypePair, error) {
+	var v TypePair
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TypeReference_Read(w wire.Value) (*TypeReference, error) {
+	var v TypeReference
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Type struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Type struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Type
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Type) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x SimpleType
+				x, err = _SimpleType_Read(field.Value)
+				v.SimpleType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.SliceType, err = _Type_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.KeyValueSliceType, err = _TypePair_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.MapType, err = _TypePair_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.ReferenceType, err = _TypeReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.PointerType, err = _Type_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.SimpleType != nil {
+		count++
+	}
+	if v.SliceType != nil {
+		count++
+	}
+	if v.KeyValueSliceType != nil {
+		count++
+	}
+	if v.MapType != nil {
+		count++
+	}
+	if v.ReferenceType != nil {
+		count++
+	}
+	if v.PointerType != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a Type struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Type struct could not be encoded.
+func (v *Type) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.SimpleType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.SimpleType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SliceType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SliceType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.KeyValueSliceType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.KeyValueSliceType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MapType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.MapType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReferenceType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ReferenceType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PointerType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.PointerType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.SimpleType != nil {
+		count++
+	}
+	if v.SliceType != nil {
+		count++
+	}
+	if v.KeyValueSliceType != nil {
+		count++
+	}
+	if v.MapType != nil {
+		count++
+	}
+	if v.ReferenceType != nil {
+		count++
+	}
+	if v.PointerType != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _SimpleType_Decode(sr stream.Reader) (SimpleType, error) {
+	var v SimpleType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _TypePair_Decode(sr stream.Reader) (*TypePair, error) {
+	var v TypePair
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TypeReference_Decode(sr stream.Reader) (*TypeReference, error) {
+	var v TypeReference
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a Type struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Type struct could not be generated from the wire
+// representation.
+func (v *Type) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x SimpleType
+			x, err = _SimpleType_Decode(sr)
+			v.SimpleType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.SliceType, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.KeyValueSliceType, err = _TypePair_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.MapType, err = _TypePair_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.ReferenceType, err = _TypeReference_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.PointerType, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.SimpleType != nil {
+		count++
+	}
+	if v.SliceType != nil {
+		count++
+	}
+	if v.KeyValueSliceType != nil {
+		count++
+	}
+	if v.MapType != nil {
+		count++
+	}
+	if v.ReferenceType != nil {
+		count++
+	}
+	if v.PointerType != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Type
+// struct.
+func (v *Type) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.SimpleType != nil {
+		fields[i] = fmt.Sprintf("SimpleType: %v", *(v.SimpleType))
+		i++
+	}
+	if v.SliceType != nil {
+		fields[i] = fmt.Sprintf("SliceType: %v", v.SliceType)
+		i++
+	}
+	if v.KeyValueSliceType != nil {
+		fields[i] = fmt.Sprintf("KeyValueSliceType: %v", v.KeyValueSliceType)
+		i++
+	}
+	if v.MapType != nil {
+		fields[i] = fmt.Sprintf("MapType: %v", v.MapType)
+		i++
+	}
+	if v.ReferenceType != nil {
+		fields[i] = fmt.Sprintf("ReferenceType: %v", v.ReferenceType)
+		i++
+	}
+	if v.PointerType != nil {
+		fields[i] = fmt.Sprintf("PointerType: %v", v.PointerType)
+		i++
+	}
+
+	return fmt.Sprintf("Type{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _SimpleType_EqualsPtr(lhs, rhs *SimpleType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Type match the
+// provided Type.
+//
+// This function performs a deep comparison.
+func (v *Type) Equals(rhs *Type) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_SimpleType_EqualsPtr(v.SimpleType, rhs.SimpleType) {
+		return false
+	}
+	if !((v.SliceType == nil && rhs.SliceType == nil) || (v.SliceType != nil && rhs.SliceType != nil && v.SliceType.Equals(rhs.SliceType))) {
+		return false
+	}
+	if !((v.KeyValueSliceType == nil && rhs.KeyValueSliceType == nil) || (v.KeyValueSliceType != nil && rhs.KeyValueSliceType != nil && v.KeyValueSliceType.Equals(rhs.KeyValueSliceType))) {
+		return false
+	}
+	if !((v.MapType == nil && rhs.MapType == nil) || (v.MapType != nil && rhs.MapType != nil && v.MapType.Equals(rhs.MapType))) {
+		return false
+	}
+	if !((v.ReferenceType == nil && rhs.ReferenceType == nil) || (v.ReferenceType != nil && rhs.ReferenceType != nil && v.ReferenceType.Equals(rhs.ReferenceType))) {
+		return false
+	}
+	if !((v.PointerType == nil && rhs.PointerType == nil) || (v.PointerType != nil && rhs.PointerType != nil && v.PointerType.Equals(rhs.PointerType))) {
+		return false
+	}
+
+	return true
+}
+
+func _SimpleType_CopyPtr(v *SimpleType) *SimpleType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Copy returns a deep copy of this Type.
+func (v *Type) Copy() *Type {
+	if v == nil {
+		return nil
+	}
+
+	var o Type
+	o.SimpleType = _SimpleType_CopyPtr(v.SimpleType)
+	o.SliceType = v.SliceType.Copy()
+	o.KeyValueSliceType = v.KeyValueSliceType.Copy()
+	o.MapType = v.MapType.Copy()
+	o.ReferenceType = v.ReferenceType.Copy()
+	o.PointerType = v.PointerType.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Type which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Type) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	if v.SimpleType != nil {
+		h.Field(1)
+		h.Int32(int32(*v.SimpleType))
+	}
+	h.Field(2)
+	h.Uint64(v.SliceType.Hash())
+	h.Field(3)
+	h.Uint64(v.KeyValueSliceType.Hash())
+	h.Field(4)
+	h.Uint64(v.MapType.Hash())
+	h.Field(5)
+	h.Uint64(v.ReferenceType.Hash())
+	h.Field(6)
+	h.Uint64(v.PointerType.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Type so that it may be reused.
+func (v *Type) Reset() {
+	*v = Type{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Type.
+func (v *Type) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SimpleType != nil {
+		err = multierr.Append(err, enc.AddObject("simpleType", *v.SimpleType))
+	}
+	if v.SliceType != nil {
+		err = multierr.Append(err, enc.AddObject("sliceType", v.SliceType))
+	}
+	if v.KeyValueSliceType != nil {
+		err = multierr.Append(err, enc.AddObject("keyValueSliceType", v.KeyValueSliceType))
+	}
+	if v.MapType != nil {
+		err = multierr.Append(err, enc.AddObject("mapType", v.MapType))
+	}
+	if v.ReferenceType != nil {
+		err = multierr.Append(err, enc.AddObject("referenceType", v.ReferenceType))
+	}
+	if v.PointerType != nil {
+		err = multierr.Append(err, enc.AddObject("pointerType", v.PointerType))
+	}
+	return err
+}
+
+// GetSimpleType returns the value of SimpleType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetSimpleType() (o SimpleType) {
+	if v != nil && v.SimpleType != nil {
+		return *v.SimpleType
+	}
+
+	return
+}
+
+// IsSetSimpleType returns true if SimpleType is not nil.
+func (v *Type) IsSetSimpleType() bool {
+	return v != nil && v.SimpleType != nil
+}
+
+// LookupSimpleType returns the value of SimpleType and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Type) LookupSimpleType() (o SimpleType, _ bool) {
+	if v != nil && v.SimpleType != nil {
+		return *v.SimpleType, true
+	}
+	return o, false
+}
+
+// SetSimpleType sets the value of SimpleType and unsets all other
+// fields of this Type.
+func (v *Type) SetSimpleType(x SimpleType) {
+	*v = Type{}
+	v.SimpleType = &x
+}
+
+// GetSliceType returns the value of SliceType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetSliceType() (o *Type) {
+	if v != nil && v.SliceType != nil {
+		return v.SliceType
+	}
+
+	return
+}
+
+// IsSetSliceType returns true if SliceType is not nil.
+func (v *Type) IsSetSliceType() bool {
+	return v != nil && v.SliceType != nil
+}
+
+// LookupSliceType returns the value of SliceType and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Type) LookupSliceType() (o *Type, _ bool) {
+	if v != nil && v.SliceType != nil {
+		return v.SliceType, true
+	}
+	return o, false
+}
+
+// SetSliceType sets the value of SliceType and unsets all other
+// fields of this Type.
+func (v *Type) SetSliceType(x *Type) {
+	*v = Type{}
+	v.SliceType = x
+}
+
+// GetKeyValueSliceType returns the value of KeyValueSliceType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetKeyValueSliceType() (o *TypePair) {
+	if v != nil && v.KeyValueSliceType != nil {
+		return v.KeyValueSliceType
+	}
+
+	return
+}
+
+// IsSetKeyValueSliceType returns true if KeyValueSliceType is not nil.
+func (v *Type) IsSetKeyValueSliceType() bool {
+	return v != nil && v.KeyValueSliceType != nil
+}
+
+// LookupKeyValueSliceType returns the value of KeyValueSliceType and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Type) LookupKeyValueSliceType() (o *TypePair, _ bool) {
+	if v != nil && v.KeyValueSliceType != nil {
+		return v.KeyValueSliceType, true
+	}
+	return o, false
+}
+
+// SetKeyValueSliceType sets the value of KeyValueSliceType and unsets all other
+// fields of this Type.
+func (v *Type) SetKeyValueSliceType(x *TypePair) {
+	*v = Type{}
+	v.KeyValueSliceType = x
+}
+
+// GetMapType returns the value of MapType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetMapType() (o *TypePair) {
+	if v != nil && v.MapType != nil {
+		return v.MapType
+	}
+
+	return
+}
+
+// IsSetMapType returns true if MapType is not nil.
+func (v *Type) IsSetMapType() bool {
+	return v != nil && v.MapType != nil
+}
+
+// LookupMapType returns the value of MapType and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Type) LookupMapType() (o *TypePair, _ bool) {
+	if v != nil && v.MapType != nil {
+		return v.MapType, true
+	}
+	return o, false
+}
+
+// SetMapType sets the value of MapType and unsets all other
+// fields of this Type.
+func (v *Type) SetMapType(x *TypePair) {
+	*v = Type{}
+	v.MapType = x
+}
+
+// GetReferenceType returns the value of ReferenceType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetReferenceType() (o *TypeReference) {
+	if v != nil && v.ReferenceType != nil {
+		return v.ReferenceType
+	}
+
+	return
+}
+
+// IsSetReferenceType returns true if ReferenceType is not nil.
+func (v *Type) IsSetReferenceType() bool {
+	return v != nil && v.ReferenceType != nil
+}
+
+// LookupReferenceType returns the value of ReferenceType and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Type) LookupReferenceType() (o *TypeReference, _ bool) {
+	if v != nil && v.ReferenceType != nil {
+		return v.ReferenceType, true
+	}
+	return o, false
+}
+
+// SetReferenceType sets the value of ReferenceType and unsets all other
+// fields of this Type.
+func (v *Type) SetReferenceType(x *TypeReference) {
+	*v = Type{}
+	v.ReferenceType = x
+}
+
+// GetPointerType returns the value of PointerType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetPointerType() (o *Type) {
+	if v != nil && v.PointerType != nil {
+		return v.PointerType
+	}
+
+	return
+}
+
+// IsSetPointerType returns true if PointerType is not nil.
+func (v *Type) IsSetPointerType() bool {
+	return v != nil && v.PointerType != nil
+}
+
+// LookupPointerType returns the value of PointerType and true if it is
+// set, or its zero value and false if another field is set.
+func (v *Type) LookupPointerType() (o *Type, _ bool) {
+	if v != nil && v.PointerType != nil {
+		return v.PointerType, true
+	}
+	return o, false
+}
+
+// SetPointerType sets the value of PointerType and unsets all other
+// fields of this Type.
+func (v *Type) SetPointerType(x *Type) {
+	*v = Type{}
+	v.PointerType = x
+}
+
+// Which returns the ID of the field of this Type which is set, or
+// 0 if no field is set.
+func (v *Type) Which() int16 {
+	if v == nil {
+		return 0
+	}
+	if v.SimpleType != nil {
+		return 1
+	}
+	if v.SliceType != nil {
+		return 2
+	}
+	if v.KeyValueSliceType != nil {
+		return 3
+	}
+	if v.MapType != nil {
+		return 4
+	}
+	if v.ReferenceType != nil {
+		return 5
+	}
+	if v.PointerType != nil {
+		return 6
+	}
+	return 0
+}
+
+// Type_Visitor visits the field of a Type which is set.
+//
+// Fields added to Type add methods to Type_Visitor, so that
+// implementations which do not handle them fail to compile.
+type Type_Visitor interface {
+	// VisitSimpleType is called with the value of SimpleType if it is set.
+	VisitSimpleType(SimpleType) error
+
+	// VisitSliceType is called with the value of SliceType if it is set.
+	VisitSliceType(*Type) error
+
+	// VisitKeyValueSliceType is called with the value of KeyValueSliceType if it is set.
+	VisitKeyValueSliceType(*TypePair) error
+
+	// VisitMapType is called with the value of MapType if it is set.
+	VisitMapType(*TypePair) error
+
+	// VisitReferenceType is called with the value of ReferenceType if it is set.
+	VisitReferenceType(*TypeReference) error
+
+	// VisitPointerType is called with the value of PointerType if it is set.
+	VisitPointerType(*Type) error
+
+	// Default is called if no field of Type is set, for example
+	// because it was decoded from a peer which knows about fields
+	// that this code does not.
+	Default() error
+}
+
+// Match calls the method of visitor for the field of this Type
+// which is set, or Default if none is, and returns its error.
+func (v *Type) Match(visitor Type_Visitor) error {
+	if v != nil {
+		if v.SimpleType != nil {
+			return visitor.VisitSimpleType(*v.SimpleType)
+		}
+		if v.SliceType != nil {
+			return visitor.VisitSliceType(v.SliceType)
+		}
+		if v.KeyValueSliceType != nil {
+			return visitor.VisitKeyValueSliceType(v.KeyValueSliceType)
+		}
+		if v.MapType != nil {
+			return visitor.VisitMapType(v.MapType)
+		}
+		if v.ReferenceType != nil {
+			return visitor.VisitReferenceType(v.ReferenceType)
+		}
+		if v.PointerType != nil {
+			return visitor.VisitPointerType(v.PointerType)
+		}
+	}
+	return visitor.Default()
+}
+
+// TypePair is a pair of two types.
+type TypePair struct {
+	Left  *Type `json:"left,required"`
+	Right *Type `json:"right,required"`
+}
+
+// ToWire translates a TypePair struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TypePair) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Left == nil {
+		return w, errors.New("field Left of TypePair is required")
+	}
+	w, err = v.Left.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Right == nil {
+		return w, errors.New("field Right of TypePair is required")
+	}
+	w, err = v.Right.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TypePair struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TypePair struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TypePair
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TypePair) FromWire(w wire.Value) error {
+	var err error
+
+	leftIsSet := false
+	rightIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Left, err = _Type_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				leftIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Right, err = _Type_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				rightIsSet = true
+			}
+		}
+	}
+
+	if !leftIsSet {
+		return errors.New("field Left of TypePair is required")
+	}
+
+	if !rightIsSet {
+		return errors.New("field Right of TypePair is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a TypePair struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TypePair struct could not be encoded.
+func (v *TypePair) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Left == nil {
+		return errors.New("field Left of TypePair is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Left.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if v.Right == nil {
+		return errors.New("field Right of TypePair is required")
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+		return err
+	}
+	if err := v.Right.Encode(sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TypePair struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TypePair struct could not be generated from the wire
+// representation.
+func (v *TypePair) Decode(sr stream.Reader) error {
+
+	leftIsSet := false
+	rightIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Left, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			leftIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Right, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			rightIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !leftIsSet {
+		return errors.New("field Left of TypePair is required")
+	}
+
+	if !rightIsSet {
+		return errors.New("field Right of TypePair is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of SimpleType.
-func (v SimpleType) String() string {
-	w := int32(v)
-	switch w {
-	case 1:
-		return "BOOL"
-	case 2:
-		return "BYTE"
-	case 3:
-		return "INT8"
-	case 4:
-		return "INT16"
-	case 5:
-		return "INT32"
-	case 6:
-		return "INT64"
-	case 7:
-		return "FLOAT64"
-	case 8:
-		return "STRING"
-	case 9:
-		return "STRUCT_EMPTY"
+// String returns a readable string representation of a TypePair
+// struct.
+func (v *TypePair) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return fmt.Sprintf("SimpleType(%d)", w)
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Left: %v", v.Left)
+	i++
+	fields[i] = fmt.Sprintf("Right: %v", v.Right)
+	i++
+
+	return fmt.Sprintf("TypePair{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TypePair match the
+// provided TypePair.
+//
+// This function performs a deep comparison.
+func (v *TypePair) Equals(rhs *TypePair) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Left.Equals(rhs.Left) {
+		return false
+	}
+	if !v.Right.Equals(rhs.Right) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of this TypePair.
+func (v *TypePair) Copy() *TypePair {
+	if v == nil {
+		return nil
+	}
+
+	var o TypePair
+	o.Left = v.Left.Copy()
+	o.Right = v.Right.Copy()
+	return &o
+}
+
+// Hash returns a hash of this TypePair which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *TypePair) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Left.Hash())
+	h.Field(2)
+	h.Uint64(v.Right.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this TypePair so that it may be reused.
+func (v *TypePair) Reset() {
+	*v = TypePair{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TypePair.
+func (v *TypePair) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("left", v.Left))
+	err = multierr.Append(err, enc.AddObject("right", v.Right))
+	return err
 }
 
-// Equals returns true if this SimpleType value matches the provided
-// value.
-func (v SimpleType) Equals(rhs SimpleType) bool {
-	return v == rhs
+// GetLeft returns the value of Left if it is set or its
+// zero value if it is unset.
+func (v *TypePair) GetLeft() (o *Type) {
+	if v != nil {
+		o = v.Left
+	}
+	return
 }
 
-// MarshalJSON serializes SimpleType into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v SimpleType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return ([]byte)("\"BOOL\""), nil
-	case 2:
-		return ([]byte)("\"BYTE\""), nil
-	case 3:
-		return ([]byte)("\"INT8\""), nil
-	case 4:
-		return ([]byte)("\"INT16\""), nil
-	case 5:
-		return ([]byte)("\"INT32\""), nil
-	case 6:
-		return ([]byte)("\"INT64\""), nil
-	case 7:
-		return ([]byte)("\"FLOAT64\""), nil
-	case 8:
-		return ([]byte)("\"STRING\""), nil
-	case 9:
-		return ([]byte)("\"STRUCT_EMPTY\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+// IsSetLeft returns true if Left is not nil.
+func (v *TypePair) IsSetLeft() bool {
+	return v != nil && v.Left != nil
 }
 
-// UnmarshalJSON attempts to decode SimpleType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *SimpleType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// GetRight returns the value of Right if it is set or its
+// zero value if it is unset.
+func (v *TypePair) GetRight() (o *Type) {
+	if v != nil {
+		o = v.Right
 	}
+	return
+}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "SimpleType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "SimpleType")
-		}
-		*v = (SimpleType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "SimpleType")
-	}
+// IsSetRight returns true if Right is not nil.
+func (v *TypePair) IsSetRight() bool {
+	return v != nil && v.Right != nil
 }
 
-// Type is a reference to a Go type which may be native or user defined.
-type Type struct {
-	SimpleType *SimpleType `json:"simpleType,omitempty"`
-	// Slice of a type
+// TypeReference is a reference to a user-defined type.
+type TypeReference struct {
+	Name string `json:"name,required"`
+	// Import path for the package defining this type.
+	ImportPath string `json:"importPath,required"`
+	// Annotations defined on this type.
 	//
-	// []$sliceType
-	SliceType *Type `json:"sliceType,omitempty"`
-	// Slice of key-value pairs of a pair of types.
+	// Note that these are the Thrift annotations listed after the type
+	// declaration in the Thrift file.
 	//
-	// []struct{Key $left, Value $right}
-	KeyValueSliceType *TypePair `json:"keyValueSliceType,omitempty"`
-	// Map of a pair of types.
+	// Given,
 	//
-	// map[$left]$right
-	MapType *TypePair `json:"mapType,omitempty"`
-	// Reference to a user-defined type.
-	ReferenceType *TypeReference `json:"referenceType,omitempty"`
-	// Pointer to a type.
-	PointerType *Type `json:"pointerType,omitempty"`
+	//   struct User {
+	//     1: required i32 id
+	//     2: required string name
+	//   } (key = "id", validate)
+	//
+	// The annotations will be,
+	//
+	//   {
+	//     "key": "id",
+	//     "validate": "",
+	//   }
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
-// ToWire translates a Type struct into a Thrift-level intermediate
+// ToWire translates a TypeReference struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5156,93 +9171,44 @@ type Type struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Type) ToWire() (wire.Value, error) {
+func (v *TypeReference) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SimpleType != nil {
-		w, err = v.SimpleType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
 	}
-	if v.SliceType != nil {
-		w, err = v.SliceType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ImportPath), error(nil)
+	if err != nil {
+		return w, err
 	}
-	if v.KeyValueSliceType != nil {
-		w, err = v.KeyValueSliceType.ToWire()
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.MapType != nil {
-		w, err = v.MapType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.ReferenceType != nil {
-		w, err = v.ReferenceType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.PointerType != nil {
-		w, err = v.PointerType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
-	}
-
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("Type should have exactly one field: got %v fields", i)
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SimpleType_Read(w wire.Value) (SimpleType, error) {
-	var v SimpleType
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _TypePair_Read(w wire.Value) (*TypePair, error) {
-	var v TypePair
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TypeReference_Read(w wire.Value) (*TypeReference, error) {
-	var v TypeReference
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a Type struct from its Thrift-level
+// FromWire deserializes a TypeReference struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Type struct
+// An error is returned if we were unable to build a TypeReference struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5250,61 +9216,38 @@ func _TypeReference_Read(w wire.Value) (*TypeReference, error) {
 //     return nil, err
 //   }
 //
-//   var v Type
+//   var v TypeReference
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Type) FromWire(w wire.Value) error {
+func (v *TypeReference) FromWire(w wire.Value) error {
 	var err error
 
+	nameIsSet := false
+	importPathIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TI32 {
-				var x SimpleType
-				x, err = _SimpleType_Read(field.Value)
-				v.SimpleType = &x
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				nameIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TStruct {
-				v.SliceType, err = _Type_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.KeyValueSliceType, err = _TypePair_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.MapType, err = _TypePair_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.ReferenceType, err = _TypeReference_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				v.ImportPath, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
-			}
-		case 6:
-			if field.Value.Type() == wire.TStruct {
-				v.PointerType, err = _Type_Read(field.Value)
+				importPathIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
@@ -5313,106 +9256,51 @@ func (v *Type) FromWire(w wire.Value) error {
 		}
 	}
 
-	count := 0
-	if v.SimpleType != nil {
-		count++
-	}
-	if v.SliceType != nil {
-		count++
-	}
-	if v.KeyValueSliceType != nil {
-		count++
-	}
-	if v.MapType != nil {
-		count++
-	}
-	if v.ReferenceType != nil {
-		count++
-	}
-	if v.PointerType != nil {
-		count++
+	if !nameIsSet {
+		return errors.New("field Name of TypeReference is required")
 	}
-	if count != 1 {
-		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+
+	if !importPathIsSet {
+		return errors.New("field ImportPath of TypeReference is required")
 	}
 
 	return nil
 }
 
-// Encode serializes a Type struct directly into bytes, without going
+// Encode serializes a TypeReference struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a Type struct could not be encoded.
-func (v *Type) Encode(sw stream.Writer) error {
+// An error is returned if a TypeReference struct could not be encoded.
+func (v *TypeReference) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.SimpleType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.SimpleType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
 	}
-
-	if v.SliceType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.SliceType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteString(v.Name); err != nil {
+		return err
 	}
-
-	if v.KeyValueSliceType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.KeyValueSliceType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
 
-	if v.MapType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.MapType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
 	}
-
-	if v.ReferenceType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ReferenceType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteString(v.ImportPath); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
 
-	if v.PointerType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+	if v.Annotations != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
 			return err
 		}
-		if err := v.PointerType.Encode(sw); err != nil {
+		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5420,57 +9308,18 @@ func (v *Type) Encode(sw stream.Writer) error {
 		}
 	}
 
-	count := 0
-	if v.SimpleType != nil {
-		count++
-	}
-	if v.SliceType != nil {
-		count++
-	}
-	if v.KeyValueSliceType != nil {
-		count++
-	}
-	if v.MapType != nil {
-		count++
-	}
-	if v.ReferenceType != nil {
-		count++
-	}
-	if v.PointerType != nil {
-		count++
-	}
-
-	if count != 1 {
-		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
-	}
-
 	return sw.WriteStructEnd()
 }
 
-func _SimpleType_Decode(sr stream.Reader) (SimpleType, error) {
-	var v SimpleType
-	err := v.Decode(sr)
-	return v, err
-}
-
-func _TypePair_Decode(sr stream.Reader) (*TypePair, error) {
-	var v TypePair
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _TypeReference_Decode(sr stream.Reader) (*TypeReference, error) {
-	var v TypeReference
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a Type struct directly from its Thrift-level
+// Decode deserializes a TypeReference struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a Type struct could not be generated from the wire
+// An error is returned if a TypeReference struct could not be generated from the wire
 // representation.
-func (v *Type) Decode(sr stream.Reader) error {
+func (v *TypeReference) Decode(sr stream.Reader) error {
+
+	nameIsSet := false
+	importPathIsSet := false
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5483,40 +9332,20 @@ func (v *Type) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TI32:
-			var x SimpleType
-			x, err = _SimpleType_Decode(sr)
-			v.SimpleType = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.SliceType, err = _Type_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.KeyValueSliceType, err = _TypePair_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.MapType, err = _TypePair_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
 			if err != nil {
 				return err
 			}
-
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.ReferenceType, err = _TypeReference_Decode(sr)
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ImportPath, err = sr.ReadString()
 			if err != nil {
 				return err
 			}
-
-		case fh.ID == 6 && fh.Type == wire.TStruct:
-			v.PointerType, err = _Type_Decode(sr)
+			importPathIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -5540,235 +9369,164 @@ func (v *Type) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	count := 0
-	if v.SimpleType != nil {
-		count++
-	}
-	if v.SliceType != nil {
-		count++
-	}
-	if v.KeyValueSliceType != nil {
-		count++
-	}
-	if v.MapType != nil {
-		count++
-	}
-	if v.ReferenceType != nil {
-		count++
-	}
-	if v.PointerType != nil {
-		count++
-	}
-	if count != 1 {
-		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a Type
-// struct.
-func (v *Type) String() string {
-	if v == nil {
-		return "<nil>"
+	if !nameIsSet {
+		return errors.New("field Name of TypeReference is required")
 	}
 
-	var fields [6]string
-	i := 0
-	if v.SimpleType != nil {
-		fields[i] = fmt.Sprintf("SimpleType: %v", *(v.SimpleType))
-		i++
-	}
-	if v.SliceType != nil {
-		fields[i] = fmt.Sprintf("SliceType: %v", v.SliceType)
-		i++
-	}
-	if v.KeyValueSliceType != nil {
-		fields[i] = fmt.Sprintf("KeyValueSliceType: %v", v.KeyValueSliceType)
-		i++
-	}
-	if v.MapType != nil {
-		fields[i] = fmt.Sprintf("MapType: %v", v.MapType)
-		i++
-	}
-	if v.ReferenceType != nil {
-		fields[i] = fmt.Sprintf("ReferenceType: %v", v.ReferenceType)
-		i++
-	}
-	if v.PointerType != nil {
-		fields[i] = fmt.Sprintf("PointerType: %v", v.PointerType)
-		i++
+	if !importPathIsSet {
+		return errors.New("field ImportPath of TypeReference is required")
 	}
 
-	return fmt.Sprintf("Type{%v}", strings.Join(fields[:i], ", "))
+	return nil
 }
 
-func _SimpleType_EqualsPtr(lhs, rhs *SimpleType) bool {
-	if lhs != nil && rhs != nil {
+// String returns a readable string representation of a TypeReference
+// struct.
+func (v *TypeReference) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
 	}
-	return lhs == nil && rhs == nil
+
+	return fmt.Sprintf("TypeReference{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Type match the
-// provided Type.
+// Equals returns true if all the fields of this TypeReference match the
+// provided TypeReference.
 //
 // This function performs a deep comparison.
-func (v *Type) Equals(rhs *Type) bool {
+func (v *TypeReference) Equals(rhs *TypeReference) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_SimpleType_EqualsPtr(v.SimpleType, rhs.SimpleType) {
-		return false
-	}
-	if !((v.SliceType == nil && rhs.SliceType == nil) || (v.SliceType != nil && rhs.SliceType != nil && v.SliceType.Equals(rhs.SliceType))) {
-		return false
-	}
-	if !((v.KeyValueSliceType == nil && rhs.KeyValueSliceType == nil) || (v.KeyValueSliceType != nil && rhs.KeyValueSliceType != nil && v.KeyValueSliceType.Equals(rhs.KeyValueSliceType))) {
-		return false
-	}
-	if !((v.MapType == nil && rhs.MapType == nil) || (v.MapType != nil && rhs.MapType != nil && v.MapType.Equals(rhs.MapType))) {
+	if !(v.Name == rhs.Name) {
 		return false
 	}
-	if !((v.ReferenceType == nil && rhs.ReferenceType == nil) || (v.ReferenceType != nil && rhs.ReferenceType != nil && v.ReferenceType.Equals(rhs.ReferenceType))) {
+	if !(v.ImportPath == rhs.ImportPath) {
 		return false
 	}
-	if !((v.PointerType == nil && rhs.PointerType == nil) || (v.PointerType != nil && rhs.PointerType != nil && v.PointerType.Equals(rhs.PointerType))) {
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Type.
-func (v *Type) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// Copy returns a deep copy of this TypeReference.
+func (v *TypeReference) Copy() *TypeReference {
 	if v == nil {
 		return nil
 	}
-	if v.SimpleType != nil {
-		err = multierr.Append(err, enc.AddObject("simpleType", *v.SimpleType))
-	}
-	if v.SliceType != nil {
-		err = multierr.Append(err, enc.AddObject("sliceType", v.SliceType))
-	}
-	if v.KeyValueSliceType != nil {
-		err = multierr.Append(err, enc.AddObject("keyValueSliceType", v.KeyValueSliceType))
-	}
-	if v.MapType != nil {
-		err = multierr.Append(err, enc.AddObject("mapType", v.MapType))
-	}
-	if v.ReferenceType != nil {
-		err = multierr.Append(err, enc.AddObject("referenceType", v.ReferenceType))
-	}
-	if v.PointerType != nil {
-		err = multierr.Append(err, enc.AddObject("pointerType", v.PointerType))
-	}
-	return err
+
+	var o TypeReference
+	o.Name = v.Name
+	o.ImportPath = v.ImportPath
+	o.Annotations = _Map_String_String_Copy(v.Annotations)
+	return &o
 }
 
-// GetSimpleType returns the value of SimpleType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetSimpleType() (o SimpleType) {
-	if v != nil && v.SimpleType != nil {
-		return *v.SimpleType
+// Hash returns a hash of this TypeReference which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *TypeReference) Hash() uint64 {
+	if v == nil {
+		return 0
 	}
 
-	return
+	h := thrifthash.New()
+	h.Field(1)
+	h.String(v.Name)
+	h.Field(2)
+	h.String(v.ImportPath)
+	h.Field(3)
+	h.Uint64(_Map_String_String_Hash(v.Annotations))
+	return h.Sum64()
 }
 
-// IsSetSimpleType returns true if SimpleType is not nil.
-func (v *Type) IsSetSimpleType() bool {
-	return v != nil && v.SimpleType != nil
+// Reset zeroes all fields of this TypeReference so that it may be reused.
+func (v *TypeReference) Reset() {
+	*v = TypeReference{}
 }
 
-// GetSliceType returns the value of SliceType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetSliceType() (o *Type) {
-	if v != nil && v.SliceType != nil {
-		return v.SliceType
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TypeReference.
+func (v *TypeReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-
-	return
-}
-
-// IsSetSliceType returns true if SliceType is not nil.
-func (v *Type) IsSetSliceType() bool {
-	return v != nil && v.SliceType != nil
+	enc.AddString("name", v.Name)
+	enc.AddString("importPath", v.ImportPath)
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	return err
 }
 
-// GetKeyValueSliceType returns the value of KeyValueSliceType if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *Type) GetKeyValueSliceType() (o *TypePair) {
-	if v != nil && v.KeyValueSliceType != nil {
-		return v.KeyValueSliceType
+func (v *TypeReference) GetName() (o string) {
+	if v != nil {
+		o = v.Name
 	}
-
 	return
 }
 
-// IsSetKeyValueSliceType returns true if KeyValueSliceType is not nil.
-func (v *Type) IsSetKeyValueSliceType() bool {
-	return v != nil && v.KeyValueSliceType != nil
-}
-
-// GetMapType returns the value of MapType if it is set or its
+// GetImportPath returns the value of ImportPath if it is set or its
 // zero value if it is unset.
-func (v *Type) GetMapType() (o *TypePair) {
-	if v != nil && v.MapType != nil {
-		return v.MapType
+func (v *TypeReference) GetImportPath() (o string) {
+	if v != nil {
+		o = v.ImportPath
 	}
-
 	return
 }
 
-// IsSetMapType returns true if MapType is not nil.
-func (v *Type) IsSetMapType() bool {
-	return v != nil && v.MapType != nil
-}
-
-// GetReferenceType returns the value of ReferenceType if it is set or its
+// GetAnnotations returns the value of Annotations if it is set or its
 // zero value if it is unset.
-func (v *Type) GetReferenceType() (o *TypeReference) {
-	if v != nil && v.ReferenceType != nil {
-		return v.ReferenceType
+func (v *TypeReference) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
 	}
 
 	return
 }
 
-// IsSetReferenceType returns true if ReferenceType is not nil.
-func (v *Type) IsSetReferenceType() bool {
-	return v != nil && v.ReferenceType != nil
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *TypeReference) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
 }
 
-// GetPointerType returns the value of PointerType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetPointerType() (o *Type) {
-	if v != nil && v.PointerType != nil {
-		return v.PointerType
-	}
-
-	return
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "api",
+	Package:  "go.uber.org/thriftrw/plugin/api",
+	FilePath: "api.thrift",
+	SHA1:     "f909169a1a79e137bb3f6a8342caa17e8d42f97f",
+	Raw:      rawIDL,
 }
 
-// IsSetPointerType returns true if PointerType is not nil.
-func (v *Type) IsSetPointerType() bool {
-	return v != nil && v.PointerType != nil
-}
+const rawIDL = "/**\n * API_VERSION is the version of the plugin API.\n *\n * This MUST be provided in the HandshakeResponse.\n */\nconst i32 API_VERSION = 4\n\n/**\n * ServiceID is an arbitrary unique identifier to reference the different\n * services in this request.\n */\ntypedef i32 ServiceID\n\n/**\n * ModuleID is an arbitrary unique identifier to reference the different\n * modules in this request.\n */\ntypedef i32 ModuleID\n\n/**\n * TypeReference is a reference to a user-defined type.\n */\nstruct TypeReference {\n    1: required string name\n    /**\n     * Import path for the package defining this type.\n     */\n    2: required string importPath\n\n    /**\n     * Annotations defined on this type.\n     *\n     * Note that these are the Thrift annotations listed after the type\n     * declaration in the Thrift file.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required i32 id\n     *     2: required string name\n     *   } (key = \"id\", validate)\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"key\": \"id\",\n     *     \"validate\": \"\",\n     *   }\n     */\n    3: optional map<string, string> annotations\n\n    // TODO(abg): Should this just be using ModuleID instead of a package?\n}\n\n/**\n * SimpleType is a standalone native Go type.\n */\nenum SimpleType {\n    BOOL = 1,     // bool\n    BYTE,         // byte\n    INT8,         // int8\n    INT16,        // int16\n    INT32,        // int32\n    INT64,        // int64\n    FLOAT64,      // float64\n    STRING,       // string\n    STRUCT_EMPTY, // struct{}\n    UINT8,        // uint8\n    UINT16,       // uint16\n    UINT32,       // uint32\n    UINT64,       // uint64\n}\n\n/**\n * TypePair is a pair of two types.\n */\nstruct TypePair {\n    1: required Type left\n    2: required Type right\n}\n\n/**\n * Type is a reference to a Go type which may be native or user defined.\n */\nunion Type {\n    1: SimpleType simpleType\n    /**\n     * Slice of a type\n     *\n     * []$sliceType\n     */\n    2: Type sliceType\n    /**\n     * Slice of key-value pairs of a pair of types.\n     *\n     * []struct{Key $left, Value $right}\n     */\n    3: TypePair keyValueSliceType\n    /**\n     * Map of a pair of types.\n     *\n     * map[$left]$right\n     */\n    4: TypePair mapType\n    /**\n     * Reference to a user-defined type.\n     */\n    5: TypeReference referenceType\n    /**\n     * Pointer to a type.\n     */\n    6: Type pointerType\n}\n\n/**\n * Argument is a single Argument inside a Function.\n * For,\n *\n *      void setValue(1: string key, 2: string value)\n *\n * You get the arguments,\n *\n *      Argument{Name: \"Key\", Type: Type{SimpleType: SimpleTypeString}}\n *\n *      Argument{Name: \"Value\", Type: Type{SimpleType: SimpleTypeString}}\n */\nstruct Argument {\n    /**\n     * Name of the argument. This is also the name of the argument field\n     * inside the args/result struct for that function.\n     */\n    1: required string name\n    /**\n     * Argument type.\n     */\n    2: required Type type\n    /**\n     * Annotations defined on this argument.\n     *\n     * Given,\n     *\n     *   void setValue(\n     *     1: SetValueRequest req\n     *   ) throws (\n     *     1: BadRequestError badRequestError (cache = \"false\")\n     *   )\n     *\n     * The annotations for the Argument representing badRequestError will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    3: optional map<string, string> annotations;\n}\n\n/**\n * Function is a single function on a Thrift service.\n */\nstruct Function {\n    /**\n     * Name of the Go function.\n     */\n    1: required string name\n    /**\n     * Name of the function as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * List of arguments accepted by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    3: required list<Argument> arguments\n    /**\n     * Return type of the function, if any. If this is not set, the function\n     * is a void function.\n     */\n    4: optional Type returnType\n    /**\n     * List of exceptions raised by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    5: optional list<Argument> exceptions\n    /**\n     * Whether this function is oneway or not. This should be assumed to be\n     * false unless explicitly stated otherwise. If this is true, the\n     * returnType and exceptions will be null or empty.\n     */\n    6: optional bool oneWay\n    /**\n     * Annotations defined on this function.\n     *\n     * Given,\n     *\n     *   void setValue(1: SetValueRequest req) (cache = \"false\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    7: optional map<string, string> annotations;\n}\n\n/**\n * Service is a service defined by the user in the Thrift file.\n */\nstruct Service {\n    /**\n     * Name of the Thrift service in Go code.\n     */\n    7: required string name\n    /**\n     * Name of the service as defined in the Thrift file.\n     */\n    1: required string thriftName\n    /**\n     * ID of the parent service.\n     */\n    4: optional ServiceID parentID\n    /**\n     * List of functions defined for this service.\n     */\n    5: required list<Function> functions\n    /**\n     * ID of the module where this service was declared.\n     */\n    6: required ModuleID moduleID\n    /**\n     * Annotations defined on this service.\n     *\n     * Given,\n     *\n     *   service KeyValue {\n     *   } (private = \"true\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"private\": \"true\",\n     *  }\n     */\n    8: optional map<string, string> annotations;\n}\n\n/**\n * Module is a module generated from a single Thrift file. Each module\n * corresponds to exactly one Thrift file and contains all the types and\n * constants defined in that Thrift file.\n */\nstruct Module {\n    /**\n     * Import path for the package defining the types for this module.\n     */\n    1: required string importPath\n    /**\n     * Path to the directory containing the code for this module.\n     *\n     * The path is relative to the output directory into which ThriftRW is\n     * generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     */\n    2: required string directory\n    /**\n     * Path to the Thrift file from which this module was generated.\n     */\n    3: required string thriftFilePath\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * Feature is a functionality offered by a ThriftRW plugin.\n */\nenum Feature {\n    /**\n     * SERVICE_GENERATOR specifies that the plugin may generate arbitrary code\n     * for services defined in the Thrift file.\n     *\n     * If a plugin provides this, it MUST implement the ServiceGenerator\n     * service.\n     */\n    SERVICE_GENERATOR = 1,\n    /**\n     * NAME_RESOLVER specifies that the plugin may override the Go names\n     * chosen for types, fields, and functions.\n     *\n     * If a plugin provides this, it MUST implement the NameResolver service.\n     */\n    NAME_RESOLVER = 2,\n\n    // TODO: TAGGER for struct-tagging plugins\n}\n\n/**\n * HandshakeRequest is the initial request sent to the plugin as part of\n * establishing communication and feature negotiation.\n */\nstruct HandshakeRequest {\n}\n\n/**\n * HandshakeResponse is the response from the plugin for a HandshakeRequest.\n */\nstruct HandshakeResponse {\n    /**\n     * Name of the plugin. This MUST match the name of the plugin specified\n     * over the command line or the program will fail.\n     */\n    1: required string name\n    /**\n     * Version of the plugin API.\n     *\n     * This MUST be set to API_VERSION by the plugin.\n     */\n    2: required i32 apiVersion (go.name = \"APIVersion\")\n    /**\n     * List of features the plugin provides.\n     */\n    3: required list<Feature> features\n    /**\n     * Version of ThriftRW with which the plugin was built.\n     *\n     * This MUST be set to go.uber.org/thriftrw/version.Version by the plugin\n     * explicitly.\n     */\n    4: optional string libraryVersion\n}\n\nservice Plugin {\n    /**\n     * handshake performs a handshake with the plugin to negotiate the\n     * features provided by it and the version of the plugin API it expects.\n     */\n    HandshakeResponse handshake(1: HandshakeRequest request)\n\n    /**\n     * Informs the plugin process that it will not receive any more requests\n     * and it is safe for it to exit.\n     */\n    void goodbye()\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * GenerateServiceRequest is a request to generate code for zero or more\n * Thrift services.\n */\nstruct GenerateServiceRequest {\n    /**\n     * IDs of services for which code should be generated.\n     *\n     * Note that the services map contains information about both, the\n     * services being generated and their transitive dependencies. Code should\n     * only be generated for service IDs listed here.\n     */\n    1: required list<ServiceID> rootServices\n    /**\n     * Map of service ID to service.\n     *\n     * Any service IDs present in this request will have a corresponding\n     * service definition in this map, including services for which code does\n     * not need to be generated.\n     */\n    2: required map<ServiceID, Service> services\n    /**\n     * Map of module ID to module.\n     *\n     * Any module IDs present in the request will have a corresponding module\n     * definition in this map.\n     */\n    3: required map<ModuleID, Module> modules\n    /**\n     * Prefix for import paths of generated module. In general, plugins should\n     * not need to use the package prefix unless instantiating a new\n     * Generator for more custom plugin generation.\n     */\n    4: required string packagePrefix\n    /**\n     * Directory whose descendants contain all Thrift files. In general,\n     * plugins should not need to use the thrift root unless instantiating a\n     * new Generator for more custom plugin generation.\n     */\n    5: required string thriftRoot\n    /**\n     *  IDs of Modules for which code should be generated.\n     *\n     *  Note that the modules map contains information about both, the\n     *  modules being generated and their transitive dependencies. Code should\n     *  only be generated for module IDs listed here.\n     */\n    6: optional list<ModuleID> rootModules\n    /**\n     * Whether code for clients of the root services should be skipped, as\n     * it is with --only=servers.\n     */\n    7: optional bool noClients\n    /**\n     * Whether code for servers of the root services should be skipped, as\n     * it is with --only=clients.\n     */\n    8: optional bool noServers\n}\n\n/**\n * GenerateServiceResponse is response to a GenerateServiceRequest.\n */\nstruct GenerateServiceResponse {\n    /**\n     * Map of file path to file contents.\n     *\n     * All paths MUST be relative to the output directory into which ThriftRW\n     * is generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n}\n\n/**\n * ServiceGenerator generates arbitrary code for services.\n *\n * This MUST be implemented if the SERVICE_GENERATOR feature is enabled.\n */\nservice ServiceGenerator {\n    /**\n     * Generates code for requested services.\n     */\n    GenerateServiceResponse generate(1: GenerateServiceRequest request)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * EntityKind is the kind of a Thrift entity that is given a Go name.\n */\nenum EntityKind {\n    TYPE = 1, // struct, union, exception, enum, or typedef\n    FIELD,    // field of a struct, union, or exception\n    FUNCTION, // function of a service\n}\n\n/**\n * Entity is a Thrift entity that is given a Go name.\n */\nstruct Entity {\n    1: required EntityKind kind\n    /**\n     * Name of the entity as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * Name of the type or service to which this field or function belongs,\n     * as defined in the Thrift file. This is not set for types.\n     */\n    3: optional string parent\n    /**\n     * Path to the Thrift file in which this entity was defined.\n     */\n    4: required string thriftFilePath\n    /**\n     * Go name that will be used for this entity unless it is overridden.\n     * This takes go.name annotations and prior plugins into account.\n     */\n    5: required string goName\n    /**\n     * Annotations defined on this entity.\n     */\n    6: optional map<string, string> annotations\n}\n\n/**\n * ResolveNamesRequest is a request to choose Go names for Thrift entities.\n */\nstruct ResolveNamesRequest {\n    1: required list<Entity> entities\n}\n\n/**\n * ResolveNamesResponse is the response to a ResolveNamesRequest.\n */\nstruct ResolveNamesResponse {\n    /**\n     * Go names for the requested entities, in the same order. An empty\n     * string keeps the name the entity already has. If this is not set, no\n     * names are changed.\n     *\n     * Names MUST be exported Go identifiers and MUST NOT conflict with the\n     * names of other entities of the same kind in the same type, service, or\n     * Thrift file, or the request will fail.\n     */\n    1: optional list<string> names\n}\n\n/**\n * NameResolver overrides the Go names chosen for Thrift entities.\n *\n * This MUST be implemented if the NAME_RESOLVER feature is enabled.\n */\nservice NameResolver {\n    /**\n     * Chooses Go names for the given entities.\n     */\n    ResolveNamesResponse resolveNames(1: ResolveNamesRequest request)\n}\n"
 
-// TypePair is a pair of two types.
-type TypePair struct {
-	Left  *Type `json:"left,required"`
-	Right *Type `json:"right,required"`
+// NameResolver_ResolveNames_Args represents the arguments for the NameResolver.resolveNames function.
+//
+// The arguments for resolveNames are sent and received over the wire as this struct.
+//
+// Chooses Go names for the given entities.
+type NameResolver_ResolveNames_Args struct {
+	Request *ResolveNamesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a TypePair struct into a Thrift-level intermediate
+// ToWire translates a NameResolver_ResolveNames_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5783,41 +9541,37 @@ type TypePair struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TypePair) ToWire() (wire.Value, error) {
+func (v *NameResolver_ResolveNames_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Left == nil {
-		return w, errors.New("field Left of TypePair is required")
-	}
-	w, err = v.Left.ToWire()
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-	if v.Right == nil {
-		return w, errors.New("field Right of TypePair is required")
-	}
-	w, err = v.Right.ToWire()
-	if err != nil {
-		return w, err
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TypePair struct from its Thrift-level
+func _ResolveNamesRequest_Read(w wire.Value) (*ResolveNamesRequest, error) {
+	var v ResolveNamesRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a NameResolver_ResolveNames_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TypePair struct
+// An error is returned if we were unable to build a NameResolver_ResolveNames_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5825,96 +9579,66 @@ func (v *TypePair) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TypePair
+//   var v NameResolver_ResolveNames_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TypePair) FromWire(w wire.Value) error {
+func (v *NameResolver_ResolveNames_Args) FromWire(w wire.Value) error {
 	var err error
 
-	leftIsSet := false
-	rightIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Left, err = _Type_Read(field.Value)
-				if err != nil {
-					return err
-				}
-				leftIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TStruct {
-				v.Right, err = _Type_Read(field.Value)
+				v.Request, err = _ResolveNamesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
-				rightIsSet = true
-			}
-		}
-	}
-
-	if !leftIsSet {
-		return errors.New("field Left of TypePair is required")
-	}
-
-	if !rightIsSet {
-		return errors.New("field Right of TypePair is required")
-	}
-
-	return nil
-}
-
-// Encode serializes a TypePair struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a TypePair struct could not be encoded.
-func (v *TypePair) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.Left == nil {
-		return errors.New("field Left of TypePair is required")
-	}
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
-		return err
-	}
-	if err := v.Left.Encode(sw); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
 
-	if v.Right == nil {
-		return errors.New("field Right of TypePair is required")
-	}
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
-		return err
+			}
+		}
 	}
-	if err := v.Right.Encode(sw); err != nil {
+
+	return nil
+}
+
+// Encode serializes a NameResolver_ResolveNames_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a NameResolver_ResolveNames_Args struct could not be encoded.
+func (v *NameResolver_ResolveNames_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a TypePair struct directly from its Thrift-level
+func _ResolveNamesRequest_Decode(sr stream.Reader) (*ResolveNamesRequest, error) {
+	var v ResolveNamesRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a NameResolver_ResolveNames_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a TypePair struct could not be generated from the wire
+// An error is returned if a NameResolver_ResolveNames_Args struct could not be generated from the wire
 // representation.
-func (v *TypePair) Decode(sr stream.Reader) error {
-
-	leftIsSet := false
-	rightIsSet := false
+func (v *NameResolver_ResolveNames_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5928,17 +9652,11 @@ func (v *TypePair) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Left, err = _Type_Decode(sr)
-			if err != nil {
-				return err
-			}
-			leftIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.Right, err = _Type_Decode(sr)
+			v.Request, err = _ResolveNamesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
-			rightIsSet = true
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -5958,120 +9676,208 @@ func (v *TypePair) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !leftIsSet {
-		return errors.New("field Left of TypePair is required")
-	}
-
-	if !rightIsSet {
-		return errors.New("field Right of TypePair is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a TypePair
+// String returns a readable string representation of a NameResolver_ResolveNames_Args
 // struct.
-func (v *TypePair) String() string {
+func (v *NameResolver_ResolveNames_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("Left: %v", v.Left)
-	i++
-	fields[i] = fmt.Sprintf("Right: %v", v.Right)
-	i++
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
 
-	return fmt.Sprintf("TypePair{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("NameResolver_ResolveNames_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TypePair match the
-// provided TypePair.
+// Equals returns true if all the fields of this NameResolver_ResolveNames_Args match the
+// provided NameResolver_ResolveNames_Args.
 //
 // This function performs a deep comparison.
-func (v *TypePair) Equals(rhs *TypePair) bool {
+func (v *NameResolver_ResolveNames_Args) Equals(rhs *NameResolver_ResolveNames_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !v.Left.Equals(rhs.Left) {
-		return false
-	}
-	if !v.Right.Equals(rhs.Right) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
 	return true
 }
 
+// Copy returns a deep copy of this NameResolver_ResolveNames_Args.
+func (v *NameResolver_ResolveNames_Args) Copy() *NameResolver_ResolveNames_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o NameResolver_ResolveNames_Args
+	o.Request = v.Request.Copy()
+	return &o
+}
+
+// Hash returns a hash of this NameResolver_ResolveNames_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NameResolver_ResolveNames_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Request.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this NameResolver_ResolveNames_Args so that it may be reused.
+func (v *NameResolver_ResolveNames_Args) Reset() {
+	*v = NameResolver_ResolveNames_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TypePair.
-func (v *TypePair) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of NameResolver_ResolveNames_Args.
+func (v *NameResolver_ResolveNames_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	err = multierr.Append(err, enc.AddObject("left", v.Left))
-	err = multierr.Append(err, enc.AddObject("right", v.Right))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
 	return err
 }
 
-// GetLeft returns the value of Left if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *TypePair) GetLeft() (o *Type) {
-	if v != nil {
-		o = v.Left
+func (v *NameResolver_ResolveNames_Args) GetRequest() (o *ResolveNamesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
+
 	return
 }
 
-// IsSetLeft returns true if Left is not nil.
-func (v *TypePair) IsSetLeft() bool {
-	return v != nil && v.Left != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *NameResolver_ResolveNames_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-// GetRight returns the value of Right if it is set or its
-// zero value if it is unset.
-func (v *TypePair) GetRight() (o *Type) {
-	if v != nil {
-		o = v.Right
-	}
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "resolveNames" for this struct.
+func (v *NameResolver_ResolveNames_Args) MethodName() string {
+	return "resolveNames"
 }
 
-// IsSetRight returns true if Right is not nil.
-func (v *TypePair) IsSetRight() bool {
-	return v != nil && v.Right != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *NameResolver_ResolveNames_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// TypeReference is a reference to a user-defined type.
-type TypeReference struct {
-	Name string `json:"name,required"`
-	// Import path for the package defining this type.
-	ImportPath string `json:"importPath,required"`
-	// Annotations defined on this type.
+// NameResolver_ResolveNames_Helper provides functions that aid in handling the
+// parameters and return values of the NameResolver.resolveNames
+// function.
+//
+// Chooses Go names for the given entities.
+var NameResolver_ResolveNames_Helper = struct {
+	// Args accepts the parameters of resolveNames in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ResolveNamesRequest,
+	) *NameResolver_ResolveNames_Args
+
+	// IsException returns true if the given error can be thrown
+	// by resolveNames.
 	//
-	// Note that these are the Thrift annotations listed after the type
-	// declaration in the Thrift file.
+	// An error can be thrown by resolveNames only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for resolveNames
+	// given its return value and error.
 	//
-	// Given,
+	// This allows mapping values and errors returned by
+	// resolveNames into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by resolveNames
 	//
-	//   struct User {
-	//     1: required i32 id
-	//     2: required string name
-	//   } (key = "id", validate)
+	//   value, err := resolveNames(args)
+	//   result, err := NameResolver_ResolveNames_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from resolveNames: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*ResolveNamesResponse, error) (*NameResolver_ResolveNames_Result, error)
+
+	// UnwrapResponse takes the result struct for resolveNames
+	// and returns the value or error returned by it.
 	//
-	// The annotations will be,
+	// The error is non-nil only if resolveNames threw an
+	// exception.
 	//
-	//   {
-	//     "key": "id",
-	//     "validate": "",
-	//   }
-	Annotations map[string]string `json:"annotations,omitempty"`
+	//   result := deserialize(bytes)
+	//   value, err := NameResolver_ResolveNames_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*NameResolver_ResolveNames_Result) (*ResolveNamesResponse, error)
+}{}
+
+func init() {
+	NameResolver_ResolveNames_Helper.Args = func(
+		request *ResolveNamesRequest,
+	) *NameResolver_ResolveNames_Args {
+		return &NameResolver_ResolveNames_Args{
+			Request: request,
+		}
+	}
+
+	NameResolver_ResolveNames_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	NameResolver_ResolveNames_Helper.WrapResponse = func(success *ResolveNamesResponse, err error) (*NameResolver_ResolveNames_Result, error) {
+		if err == nil {
+			return &NameResolver_ResolveNames_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	NameResolver_ResolveNames_Helper.UnwrapResponse = func(result *NameResolver_ResolveNames_Result) (success *ResolveNamesResponse, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
 }
 
-// ToWire translates a TypeReference struct into a Thrift-level intermediate
+// NameResolver_ResolveNames_Result represents the result of a NameResolver.resolveNames function call.
+//
+// The result of a resolveNames execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type NameResolver_ResolveNames_Result struct {
+	// Value returned by resolveNames after a successful execution.
+	Success *ResolveNamesResponse `json:"success,omitempty"`
+}
+
+// ToWire translates a NameResolver_ResolveNames_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6086,44 +9892,41 @@ type TypeReference struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TypeReference) ToWire() (wire.Value, error) {
+func (v *NameResolver_ResolveNames_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.ImportPath), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
 
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("NameResolver_ResolveNames_Result should have exactly one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TypeReference struct from its Thrift-level
+func _ResolveNamesResponse_Read(w wire.Value) (*ResolveNamesResponse, error) {
+	var v ResolveNamesResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a NameResolver_ResolveNames_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TypeReference struct
+// An error is returned if we were unable to build a NameResolver_ResolveNames_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6131,38 +9934,19 @@ func (v *TypeReference) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TypeReference
+//   var v NameResolver_ResolveNames_Result
 //   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *TypeReference) FromWire(w wire.Value) error {
-	var err error
-
-	nameIsSet := false
-	importPathIsSet := false
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				nameIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.ImportPath, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				importPathIsSet = true
-			}
-		case 3:
-			if field.Value.Type() == wire.TMap {
-				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NameResolver_ResolveNames_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ResolveNamesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6171,51 +9955,31 @@ func (v *TypeReference) FromWire(w wire.Value) error {
 		}
 	}
 
-	if !nameIsSet {
-		return errors.New("field Name of TypeReference is required")
+	count := 0
+	if v.Success != nil {
+		count++
 	}
-
-	if !importPathIsSet {
-		return errors.New("field ImportPath of TypeReference is required")
+	if count != 1 {
+		return fmt.Errorf("NameResolver_ResolveNames_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a TypeReference struct directly into bytes, without going
+// Encode serializes a NameResolver_ResolveNames_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a TypeReference struct could not be encoded.
-func (v *TypeReference) Encode(sw stream.Writer) error {
+// An error is returned if a NameResolver_ResolveNames_Result struct could not be encoded.
+func (v *NameResolver_ResolveNames_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Name); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.ImportPath); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	if v.Annotations != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TMap}); err != nil {
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _Map_String_String_Encode(v.Annotations, sw); err != nil {
+		if err := v.Success.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6223,18 +9987,30 @@ func (v *TypeReference) Encode(sw stream.Writer) error {
 		}
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("NameResolver_ResolveNames_Result should have exactly one field: got %v fields", count)
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a TypeReference struct directly from its Thrift-level
+func _ResolveNamesResponse_Decode(sr stream.Reader) (*ResolveNamesResponse, error) {
+	var v ResolveNamesResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a NameResolver_ResolveNames_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a TypeReference struct could not be generated from the wire
+// An error is returned if a NameResolver_ResolveNames_Result struct could not be generated from the wire
 // representation.
-func (v *TypeReference) Decode(sr stream.Reader) error {
-
-	nameIsSet := false
-	importPathIsSet := false
+func (v *NameResolver_ResolveNames_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6247,20 +10023,8 @@ func (v *TypeReference) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Name, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			nameIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TBinary:
-			v.ImportPath, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			importPathIsSet = true
-		case fh.ID == 3 && fh.Type == wire.TMap:
-			v.Annotations, err = _Map_String_String_Decode(sr)
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ResolveNamesResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -6284,122 +10048,128 @@ func (v *TypeReference) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !nameIsSet {
-		return errors.New("field Name of TypeReference is required")
+	count := 0
+	if v.Success != nil {
+		count++
 	}
-
-	if !importPathIsSet {
-		return errors.New("field ImportPath of TypeReference is required")
+	if count != 1 {
+		return fmt.Errorf("NameResolver_ResolveNames_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a TypeReference
+// String returns a readable string representation of a NameResolver_ResolveNames_Result
 // struct.
-func (v *TypeReference) String() string {
+func (v *NameResolver_ResolveNames_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
-	i++
-	if v.Annotations != nil {
-		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
 
-	return fmt.Sprintf("TypeReference{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("NameResolver_ResolveNames_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TypeReference match the
-// provided TypeReference.
+// Equals returns true if all the fields of this NameResolver_ResolveNames_Result match the
+// provided NameResolver_ResolveNames_Result.
 //
 // This function performs a deep comparison.
-func (v *TypeReference) Equals(rhs *TypeReference) bool {
+func (v *NameResolver_ResolveNames_Result) Equals(rhs *NameResolver_ResolveNames_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !(v.ImportPath == rhs.ImportPath) {
-		return false
-	}
-	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TypeReference.
-func (v *TypeReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// Copy returns a deep copy of this NameResolver_ResolveNames_Result.
+func (v *NameResolver_ResolveNames_Result) Copy() *NameResolver_ResolveNames_Result {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("name", v.Name)
-	enc.AddString("importPath", v.ImportPath)
-	if v.Annotations != nil {
-		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
-	}
-	return err
+
+	var o NameResolver_ResolveNames_Result
+	o.Success = v.Success.Copy()
+	return &o
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *TypeReference) GetName() (o string) {
-	if v != nil {
-		o = v.Name
+// Hash returns a hash of this NameResolver_ResolveNames_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *NameResolver_ResolveNames_Result) Hash() uint64 {
+	if v == nil {
+		return 0
 	}
-	return
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
 }
 
-// GetImportPath returns the value of ImportPath if it is set or its
-// zero value if it is unset.
-func (v *TypeReference) GetImportPath() (o string) {
-	if v != nil {
-		o = v.ImportPath
+// Reset zeroes all fields of this NameResolver_ResolveNames_Result so that it may be reused.
+func (v *NameResolver_ResolveNames_Result) Reset() {
+	*v = NameResolver_ResolveNames_Result{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NameResolver_ResolveNames_Result.
+func (v *NameResolver_ResolveNames_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	return
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
 }
 
-// GetAnnotations returns the value of Annotations if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *TypeReference) GetAnnotations() (o map[string]string) {
-	if v != nil && v.Annotations != nil {
-		return v.Annotations
+func (v *NameResolver_ResolveNames_Result) GetSuccess() (o *ResolveNamesResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetAnnotations returns true if Annotations is not nil.
-func (v *TypeReference) IsSetAnnotations() bool {
-	return v != nil && v.Annotations != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *NameResolver_ResolveNames_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "api",
-	Package:  "go.uber.org/thriftrw/plugin/api",
-	FilePath: "api.thrift",
-	SHA1:     "f7eeea00e3002a17188f595c7117b9359ab244d3",
-	Raw:      rawIDL,
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "resolveNames" for this struct.
+func (v *NameResolver_ResolveNames_Result) MethodName() string {
+	return "resolveNames"
 }
 
-const rawIDL = "/**\n * API_VERSION is the version of the plugin API.\n *\n * This MUST be provided in the HandshakeResponse.\n */\nconst i32 API_VERSION = 4\n\n/**\n * ServiceID is an arbitrary unique identifier to reference the different\n * services in this request.\n */\ntypedef i32 ServiceID\n\n/**\n * ModuleID is an arbitrary unique identifier to reference the different\n * modules in this request.\n */\ntypedef i32 ModuleID\n\n/**\n * TypeReference is a reference to a user-defined type.\n */\nstruct TypeReference {\n    1: required string name\n    /**\n     * Import path for the package defining this type.\n     */\n    2: required string importPath\n\n    /**\n     * Annotations defined on this type.\n     *\n     * Note that these are the Thrift annotations listed after the type\n     * declaration in the Thrift file.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required i32 id\n     *     2: required string name\n     *   } (key = \"id\", validate)\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"key\": \"id\",\n     *     \"validate\": \"\",\n     *   }\n     */\n    3: optional map<string, string> annotations\n\n    // TODO(abg): Should this just be using ModuleID instead of a package?\n}\n\n/**\n * SimpleType is a standalone native Go type.\n */\nenum SimpleType {\n    BOOL = 1,     // bool\n    BYTE,         // byte\n    INT8,         // int8\n    INT16,        // int16\n    INT32,        // int32\n    INT64,        // int64\n    FLOAT64,      // float64\n    STRING,       // string\n    STRUCT_EMPTY, // struct{}\n}\n\n/**\n * TypePair is a pair of two types.\n */\nstruct TypePair {\n    1: required Type left\n    2: required Type right\n}\n\n/**\n * Type is a reference to a Go type which may be native or user defined.\n */\nunion Type {\n    1: SimpleType simpleType\n    /**\n     * Slice of a type\n     *\n     * []$sliceType\n     */\n    2: Type sliceType\n    /**\n     * Slice of key-value pairs of a pair of types.\n     *\n     * []struct{Key $left, Value $right}\n     */\n    3: TypePair keyValueSliceType\n    /**\n     * Map of a pair of types.\n     *\n     * map[$left]$right\n     */\n    4: TypePair mapType\n    /**\n     * Reference to a user-defined type.\n     */\n    5: TypeReference referenceType\n    /**\n     * Pointer to a type.\n     */\n    6: Type pointerType\n}\n\n/**\n * Argument is a single Argument inside a Function.\n * For,\n *\n *      void setValue(1: string key, 2: string value)\n *\n * You get the arguments,\n *\n *      Argument{Name: \"Key\", Type: Type{SimpleType: SimpleTypeString}}\n *\n *      Argument{Name: \"Value\", Type: Type{SimpleType: SimpleTypeString}}\n */\nstruct Argument {\n    /**\n     * Name of the argument. This is also the name of the argument field\n     * inside the args/result struct for that function.\n     */\n    1: required string name\n    /**\n     * Argument type.\n     */\n    2: required Type type\n    /**\n     * Annotations defined on this argument.\n     *\n     * Given,\n     *\n     *   void setValue(\n     *     1: SetValueRequest req\n     *   ) throws (\n     *     1: BadRequestError badRequestError (cache = \"false\")\n     *   )\n     *\n     * The annotations for the Argument representing badRequestError will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    3: optional map<string, string> annotations;\n}\n\n/**\n * Function is a single function on a Thrift service.\n */\nstruct Function {\n    /**\n     * Name of the Go function.\n     */\n    1: required string name\n    /**\n     * Name of the function as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * List of arguments accepted by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    3: required list<Argument> arguments\n    /**\n     * Return type of the function, if any. If this is not set, the function\n     * is a void function.\n     */\n    4: optional Type returnType\n    /**\n     * List of exceptions raised by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    5: optional list<Argument> exceptions\n    /**\n     * Whether this function is oneway or not. This should be assumed to be\n     * false unless explicitly stated otherwise. If this is true, the\n     * returnType and exceptions will be null or empty.\n     */\n    6: optional bool oneWay\n    /**\n     * Annotations defined on this function.\n     *\n     * Given,\n     *\n     *   void setValue(1: SetValueRequest req) (cache = \"false\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    7: optional map<string, string> annotations;\n}\n\n/**\n * Service is a service defined by the user in the Thrift file.\n */\nstruct Service {\n    /**\n     * Name of the Thrift service in Go code.\n     */\n    7: required string name\n    /**\n     * Name of the service as defined in the Thrift file.\n     */\n    1: required string thriftName\n    /**\n     * ID of the parent service.\n     */\n    4: optional ServiceID parentID\n    /**\n     * List of functions defined for this service.\n     */\n    5: required list<Function> functions\n    /**\n     * ID of the module where this service was declared.\n     */\n    6: required ModuleID moduleID\n    /**\n     * Annotations defined on this service.\n     *\n     * Given,\n     *\n     *   service KeyValue {\n     *   } (private = \"true\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"private\": \"true\",\n     *  }\n     */\n    8: optional map<string, string> annotations;\n}\n\n/**\n * Module is a module generated from a single Thrift file. Each module\n * corresponds to exactly one Thrift file and contains all the types and\n * constants defined in that Thrift file.\n */\nstruct Module {\n    /**\n     * Import path for the package defining the types for this module.\n     */\n    1: required string importPath\n    /**\n     * Path to the directory containing the code for this module.\n     *\n     * The path is relative to the output directory into which ThriftRW is\n     * generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     */\n    2: required string directory\n    /**\n     * Path to the Thrift file from which this module was generated.\n     */\n    3: required string thriftFilePath\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * Feature is a functionality offered by a ThriftRW plugin.\n */\nenum Feature {\n    /**\n     * SERVICE_GENERATOR specifies that the plugin may generate arbitrary code\n     * for services defined in the Thrift file.\n     *\n     * If a plugin provides this, it MUST implement the ServiceGenerator\n     * service.\n     */\n    SERVICE_GENERATOR = 1,\n\n    // TODO: TAGGER for struct-tagging plugins\n}\n\n/**\n * HandshakeRequest is the initial request sent to the plugin as part of\n * establishing communication and feature negotiation.\n */\nstruct HandshakeRequest {\n}\n\n/**\n * HandshakeResponse is the response from the plugin for a HandshakeRequest.\n */\nstruct HandshakeResponse {\n    /**\n     * Name of the plugin. This MUST match the name of the plugin specified\n     * over the command line or the program will fail.\n     */\n    1: required string name\n    /**\n     * Version of the plugin API.\n     *\n     * This MUST be set to API_VERSION by the plugin.\n     */\n    2: required i32 apiVersion (go.name = \"APIVersion\")\n    /**\n     * List of features the plugin provides.\n     */\n    3: required list<Feature> features\n    /**\n     * Version of ThriftRW with which the plugin was built.\n     *\n     * This MUST be set to go.uber.org/thriftrw/version.Version by the plugin\n     * explicitly.\n     */\n    4: optional string libraryVersion\n}\n\nservice Plugin {\n    /**\n     * handshake performs a handshake with the plugin to negotiate the\n     * features provided by it and the version of the plugin API it expects.\n     */\n    HandshakeResponse handshake(1: HandshakeRequest request)\n\n    /**\n     * Informs the plugin process that it will not receive any more requests\n     * and it is safe for it to exit.\n     */\n    void goodbye()\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * GenerateServiceRequest is a request to generate code for zero or more\n * Thrift services.\n */\nstruct GenerateServiceRequest {\n    /**\n     * IDs of services for which code should be generated.\n     *\n     * Note that the services map contains information about both, the\n     * services being generated and their transitive dependencies. Code should\n     * only be generated for service IDs listed here.\n     */\n    1: required list<ServiceID> rootServices\n    /**\n     * Map of service ID to service.\n     *\n     * Any service IDs present in this request will have a corresponding\n     * service definition in this map, including services for which code does\n     * not need to be generated.\n     */\n    2: required map<ServiceID, Service> services\n    /**\n     * Map of module ID to module.\n     *\n     * Any module IDs present in the request will have a corresponding module\n     * definition in this map.\n     */\n    3: required map<ModuleID, Module> modules\n    /**\n     * Prefix for import paths of generated module. In general, plugins should\n     * not need to use the package prefix unless instantiating a new\n     * Generator for more custom plugin generation.\n     */\n    4: required string packagePrefix\n    /**\n     * Directory whose descendants contain all Thrift files. In general,\n     * plugins should not need to use the thrift root unless instantiating a\n     * new Generator for more custom plugin generation.\n     */\n    5: required string thriftRoot\n    /**\n     *  IDs of Modules for which code should be generated.\n     *\n     *  Note that the modules map contains information about both, the\n     *  modules being generated and their transitive dependencies. Code should\n     *  only be generated for module IDs listed here.\n     */\n    6: optional list<ModuleID> rootModules\n}\n\n/**\n * GenerateServiceResponse is response to a GenerateServiceRequest.\n */\nstruct GenerateServiceResponse {\n    /**\n     * Map of file path to file contents.\n     *\n     * All paths MUST be relative to the output directory into which ThriftRW\n     * is generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n}\n\n/**\n * ServiceGenerator generates arbitrary code for services.\n *\n * This MUST be implemented if the SERVICE_GENERATOR feature is enabled.\n */\nservice ServiceGenerator {\n    /**\n     * Generates code for requested services.\n     */\n    GenerateServiceResponse generate(1: GenerateServiceRequest request)\n}\n"
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *NameResolver_ResolveNames_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
 
 // Plugin_Goodbye_Args represents the arguments for the Plugin.goodbye function.
 //
 // The arguments for goodbye are sent and received over the wire as this struct.
+//
+// Informs the plugin process that it will not receive any more requests
+// and it is safe for it to exit.
 type Plugin_Goodbye_Args struct {
 }
 
@@ -6533,6 +10303,32 @@ func (v *Plugin_Goodbye_Args) Equals(rhs *Plugin_Goodbye_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Plugin_Goodbye_Args.
+func (v *Plugin_Goodbye_Args) Copy() *Plugin_Goodbye_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Plugin_Goodbye_Args
+	return &o
+}
+
+// Hash returns a hash of this Plugin_Goodbye_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Plugin_Goodbye_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Plugin_Goodbye_Args so that it may be reused.
+func (v *Plugin_Goodbye_Args) Reset() {
+	*v = Plugin_Goodbye_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Plugin_Goodbye_Args.
 func (v *Plugin_Goodbye_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6560,6 +10356,9 @@ func (v *Plugin_Goodbye_Args) EnvelopeType() wire.EnvelopeType {
 // Plugin_Goodbye_Helper provides functions that aid in handling the
 // parameters and return values of the Plugin.goodbye
 // function.
+//
+// Informs the plugin process that it will not receive any more requests
+// and it is safe for it to exit.
 var Plugin_Goodbye_Helper = struct {
 	// Args accepts the parameters of goodbye in-order and returns
 	// the arguments struct for the function.
@@ -6762,6 +10561,32 @@ func (v *Plugin_Goodbye_Result) Equals(rhs *Plugin_Goodbye_Result) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Plugin_Goodbye_Result.
+func (v *Plugin_Goodbye_Result) Copy() *Plugin_Goodbye_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Plugin_Goodbye_Result
+	return &o
+}
+
+// Hash returns a hash of this Plugin_Goodbye_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Plugin_Goodbye_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Plugin_Goodbye_Result so that it may be reused.
+func (v *Plugin_Goodbye_Result) Reset() {
+	*v = Plugin_Goodbye_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Plugin_Goodbye_Result.
 func (v *Plugin_Goodbye_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6789,6 +10614,9 @@ func (v *Plugin_Goodbye_Result) EnvelopeType() wire.EnvelopeType {
 // Plugin_Handshake_Args represents the arguments for the Plugin.handshake function.
 //
 // The arguments for handshake are sent and received over the wire as this struct.
+//
+// handshake performs a handshake with the plugin to negotiate the
+// features provided by it and the version of the plugin API it expects.
 type Plugin_Handshake_Args struct {
 	Request *HandshakeRequest `json:"request,omitempty"`
 }
@@ -6980,6 +10808,35 @@ func (v *Plugin_Handshake_Args) Equals(rhs *Plugin_Handshake_Args) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Plugin_Handshake_Args.
+func (v *Plugin_Handshake_Args) Copy() *Plugin_Handshake_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o Plugin_Handshake_Args
+	o.Request = v.Request.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Plugin_Handshake_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Plugin_Handshake_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Request.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Plugin_Handshake_Args so that it may be reused.
+func (v *Plugin_Handshake_Args) Reset() {
+	*v = Plugin_Handshake_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Plugin_Handshake_Args.
 func (v *Plugin_Handshake_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -7025,6 +10882,9 @@ func (v *Plugin_Handshake_Args) EnvelopeType() wire.EnvelopeType {
 // Plugin_Handshake_Helper provides functions that aid in handling the
 // parameters and return values of the Plugin.handshake
 // function.
+//
+// handshake performs a handshake with the plugin to negotiate the
+// features provided by it and the version of the plugin API it expects.
 var Plugin_Handshake_Helper = struct {
 	// Args accepts the parameters of handshake in-order and returns
 	// the arguments struct for the function.
@@ -7329,6 +11189,35 @@ func (v *Plugin_Handshake_Result) Equals(rhs *Plugin_Handshake_Result) bool {
 	return true
 }
 
+// Copy returns a deep copy of this Plugin_Handshake_Result.
+func (v *Plugin_Handshake_Result) Copy() *Plugin_Handshake_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o Plugin_Handshake_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this Plugin_Handshake_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *Plugin_Handshake_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this Plugin_Handshake_Result so that it may be reused.
+func (v *Plugin_Handshake_Result) Reset() {
+	*v = Plugin_Handshake_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Plugin_Handshake_Result.
 func (v *Plugin_Handshake_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -7374,6 +11263,8 @@ func (v *Plugin_Handshake_Result) EnvelopeType() wire.EnvelopeType {
 // ServiceGenerator_Generate_Args represents the arguments for the ServiceGenerator.generate function.
 //
 // The arguments for generate are sent and received over the wire as this struct.
+//
+// Generates code for requested services.
 type ServiceGenerator_Generate_Args struct {
 	Request *GenerateServiceRequest `json:"request,omitempty"`
 }
@@ -7565,6 +11456,35 @@ func (v *ServiceGenerator_Generate_Args) Equals(rhs *ServiceGenerator_Generate_A
 	return true
 }
 
+// Copy returns a deep copy of this ServiceGenerator_Generate_Args.
+func (v *ServiceGenerator_Generate_Args) Copy() *ServiceGenerator_Generate_Args {
+	if v == nil {
+		return nil
+	}
+
+	var o ServiceGenerator_Generate_Args
+	o.Request = v.Request.Copy()
+	return &o
+}
+
+// Hash returns a hash of this ServiceGenerator_Generate_Args which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ServiceGenerator_Generate_Args) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(1)
+	h.Uint64(v.Request.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ServiceGenerator_Generate_Args so that it may be reused.
+func (v *ServiceGenerator_Generate_Args) Reset() {
+	*v = ServiceGenerator_Generate_Args{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ServiceGenerator_Generate_Args.
 func (v *ServiceGenerator_Generate_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -7610,6 +11530,8 @@ func (v *ServiceGenerator_Generate_Args) EnvelopeType() wire.EnvelopeType {
 // ServiceGenerator_Generate_Helper provides functions that aid in handling the
 // parameters and return values of the ServiceGenerator.generate
 // function.
+//
+// Generates code for requested services.
 var ServiceGenerator_Generate_Helper = struct {
 	// Args accepts the parameters of generate in-order and returns
 	// the arguments struct for the function.
@@ -7914,6 +11836,35 @@ func (v *ServiceGenerator_Generate_Result) Equals(rhs *ServiceGenerator_Generate
 	return true
 }
 
+// Copy returns a deep copy of this ServiceGenerator_Generate_Result.
+func (v *ServiceGenerator_Generate_Result) Copy() *ServiceGenerator_Generate_Result {
+	if v == nil {
+		return nil
+	}
+
+	var o ServiceGenerator_Generate_Result
+	o.Success = v.Success.Copy()
+	return &o
+}
+
+// Hash returns a hash of this ServiceGenerator_Generate_Result which is stable across
+// processes. Values which are equal per Equals have the same hash.
+func (v *ServiceGenerator_Generate_Result) Hash() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	h := thrifthash.New()
+	h.Field(0)
+	h.Uint64(v.Success.Hash())
+	return h.Sum64()
+}
+
+// Reset zeroes all fields of this ServiceGenerator_Generate_Result so that it may be reused.
+func (v *ServiceGenerator_Generate_Result) Reset() {
+	*v = ServiceGenerator_Generate_Result{}
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ServiceGenerator_Generate_Result.
 func (v *ServiceGenerator_Generate_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -0,0 +1,69 @@
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package apitest is a generated GoMock package.
+package apitest
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	api "go.uber.org/thriftrw/plugin/api"
+	reflect "reflect"
+)
+
+// MockNameResolver is a mock of NameResolver interface.
+type MockNameResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockNameResolverMockRecorder
+}
+
+// MockNameResolverMockRecorder is the mock recorder for MockNameResolver.
+type MockNameResolverMockRecorder struct {
+	mock *MockNameResolver
+}
+
+// NewMockNameResolver creates a new mock instance.
+func NewMockNameResolver(ctrl *gomock.Controller) *MockNameResolver {
+	mock := &MockNameResolver{ctrl: ctrl}
+	mock.recorder = &MockNameResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNameResolver) EXPECT() *MockNameResolverMockRecorder {
+	return m.recorder
+}
+
+// ResolveNames mocks base method.
+func (m *MockNameResolver) ResolveNames(arg0 *api.ResolveNamesRequest) (*api.ResolveNamesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveNames", arg0)
+	ret0, _ := ret[0].(*api.ResolveNamesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveNames indicates an expected call of ResolveNames.
+func (mr *MockNameResolverMockRecorder) ResolveNames(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveNames", reflect.TypeOf((*MockNameResolver)(nil).ResolveNames), arg0)
+}
@@ -1,4 +1,5 @@
-// Code generated by MockGen. DO NOT EDIT.
+// Code generated by thriftrw --generate-plugin-api
+// @generated
 
 // Copyright (c) 2023 Uber Technologies, Inc.
 //
@@ -19,16 +20,14 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
-// Source: go.uber.org/thriftrw/plugin/api (interfaces: Plugin,ServiceGenerator)
 
-// Package plugintest is a generated GoMock package.
-package plugintest
+// Package apitest is a generated GoMock package.
+package apitest
 
 import (
-	reflect "reflect"
-
 	gomock "github.com/golang/mock/gomock"
 	api "go.uber.org/thriftrw/plugin/api"
+	reflect "reflect"
 )
 
 // MockPlugin is a mock of Plugin interface.
@@ -82,41 +81,3 @@ func (mr *MockPluginMockRecorder) Handshake(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handshake", reflect.TypeOf((*MockPlugin)(nil).Handshake), arg0)
 }
-
-// MockServiceGenerator is a mock of ServiceGenerator interface.
-type MockServiceGenerator struct {
-	ctrl     *gomock.Controller
-	recorder *MockServiceGeneratorMockRecorder
-}
-
-// MockServiceGeneratorMockRecorder is the mock recorder for MockServiceGenerator.
-type MockServiceGeneratorMockRecorder struct {
-	mock *MockServiceGenerator
-}
-
-// NewMockServiceGenerator creates a new mock instance.
-func NewMockServiceGenerator(ctrl *gomock.Controller) *MockServiceGenerator {
-	mock := &MockServiceGenerator{ctrl: ctrl}
-	mock.recorder = &MockServiceGeneratorMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockServiceGenerator) EXPECT() *MockServiceGeneratorMockRecorder {
-	return m.recorder
-}
-
-// Generate mocks base method.
-func (m *MockServiceGenerator) Generate(arg0 *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Generate", arg0)
-	ret0, _ := ret[0].(*api.GenerateServiceResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Generate indicates an expected call of Generate.
-func (mr *MockServiceGeneratorMockRecorder) Generate(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockServiceGenerator)(nil).Generate), arg0)
-}
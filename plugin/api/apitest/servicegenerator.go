@@ -0,0 +1,69 @@
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package apitest is a generated GoMock package.
+package apitest
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	api "go.uber.org/thriftrw/plugin/api"
+	reflect "reflect"
+)
+
+// MockServiceGenerator is a mock of ServiceGenerator interface.
+type MockServiceGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceGeneratorMockRecorder
+}
+
+// MockServiceGeneratorMockRecorder is the mock recorder for MockServiceGenerator.
+type MockServiceGeneratorMockRecorder struct {
+	mock *MockServiceGenerator
+}
+
+// NewMockServiceGenerator creates a new mock instance.
+func NewMockServiceGenerator(ctrl *gomock.Controller) *MockServiceGenerator {
+	mock := &MockServiceGenerator{ctrl: ctrl}
+	mock.recorder = &MockServiceGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceGenerator) EXPECT() *MockServiceGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockServiceGenerator) Generate(arg0 *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", arg0)
+	ret0, _ := ret[0].(*api.GenerateServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockServiceGeneratorMockRecorder) Generate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockServiceGenerator)(nil).Generate), arg0)
+}
@@ -50,6 +50,12 @@ type Plugin struct {
 	// functionality.
 	ServiceGenerator api.ServiceGenerator
 
+	// Plugins can implement a NameResolver to override the Go names chosen
+	// for types, fields, and functions, for example to match existing
+	// hand-written APIs. This may be nil if the plugin does not provide this
+	// functionality.
+	NameResolver api.NameResolver
+
 	// Reader and Writer may be specified to change the communication channel
 	// this plugin uses. By default, plugins listen on stdin and write to
 	// stdout.
@@ -82,6 +88,11 @@ func Main(p *Plugin) {
 		mainHandler.Put("ServiceGenerator", api.NewServiceGeneratorHandler(p.ServiceGenerator))
 	}
 
+	if p.NameResolver != nil {
+		features = append(features, api.FeatureNameResolver)
+		mainHandler.Put("NameResolver", api.NewNameResolverHandler(p.NameResolver))
+	}
+
 	// TODO(abg): Check for other features and register handlers here.
 	reader := p.Reader
 	if reader == nil {
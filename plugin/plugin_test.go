@@ -121,3 +121,49 @@ func TestServiceGenerator(t *testing.T) {
 		assert.Equal(t, res, gotRes)
 	}
 }
+
+func TestNameResolver(t *testing.T) {
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	transport := fakeEnvelopeClient(stdinWriter, stdoutReader)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	nameResolver := plugintest.NewMockNameResolver(mockCtrl)
+
+	go Main(&Plugin{
+		Name:         "hello",
+		NameResolver: nameResolver,
+		Writer:       stdoutWriter,
+		Reader:       stdinReader,
+	})
+
+	pluginClient := api.NewPluginClient(multiplex.NewClient("Plugin", transport))
+	defer pluginClient.Goodbye()
+
+	handshake, err := pluginClient.Handshake(&api.HandshakeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []api.Feature{api.FeatureNameResolver}, handshake.Features)
+
+	nrClient := api.NewNameResolverClient(multiplex.NewClient("NameResolver", transport))
+	req := &api.ResolveNamesRequest{
+		Entities: []*api.Entity{
+			{
+				Kind:           api.EntityKindFunction,
+				ThriftName:     "getValue",
+				Parent:         ptr.String("KeyValue"),
+				ThriftFilePath: "idl/kv.thrift",
+				GoName:         "GetValue",
+				Annotations:    map[string]string{"legacy": "true"},
+			},
+		},
+	}
+	res := &api.ResolveNamesResponse{Names: []string{"Get"}}
+
+	nameResolver.EXPECT().ResolveNames(req).Return(res, nil)
+	gotRes, err := nrClient.ResolveNames(req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, res, gotRes)
+	}
+}
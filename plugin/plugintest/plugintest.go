@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package plugintest provides mocks for the interfaces of the plugin API.
+//
+// The mocks are generated alongside the plugin API in
+// go.uber.org/thriftrw/plugin/api/apitest. This package makes them
+// available under their original import path.
+package plugintest
+
+import "go.uber.org/thriftrw/plugin/api/apitest"
+
+type (
+	// MockPlugin is a mock of Plugin interface.
+	MockPlugin = apitest.MockPlugin
+
+	// MockPluginMockRecorder is the mock recorder for MockPlugin.
+	MockPluginMockRecorder = apitest.MockPluginMockRecorder
+
+	// MockServiceGenerator is a mock of ServiceGenerator interface.
+	MockServiceGenerator = apitest.MockServiceGenerator
+
+	// MockServiceGeneratorMockRecorder is the mock recorder for
+	// MockServiceGenerator.
+	MockServiceGeneratorMockRecorder = apitest.MockServiceGeneratorMockRecorder
+
+	// MockNameResolver is a mock of NameResolver interface.
+	MockNameResolver = apitest.MockNameResolver
+
+	// MockNameResolverMockRecorder is the mock recorder for
+	// MockNameResolver.
+	MockNameResolverMockRecorder = apitest.MockNameResolverMockRecorder
+)
+
+var (
+	// NewMockPlugin creates a new mock instance.
+	NewMockPlugin = apitest.NewMockPlugin
+
+	// NewMockServiceGenerator creates a new mock instance.
+	NewMockServiceGenerator = apitest.NewMockServiceGenerator
+
+	// NewMockNameResolver creates a new mock instance.
+	NewMockNameResolver = apitest.NewMockNameResolver
+)
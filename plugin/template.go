@@ -166,6 +166,14 @@ func (g *goFileGenerator) FormatType(t *api.Type) (string, error) {
 			return "int32", nil
 		case api.SimpleTypeInt64:
 			return "int64", nil
+		case api.SimpleTypeUint8:
+			return "uint8", nil
+		case api.SimpleTypeUint16:
+			return "uint16", nil
+		case api.SimpleTypeUint32:
+			return "uint32", nil
+		case api.SimpleTypeUint64:
+			return "uint64", nil
 		case api.SimpleTypeFloat64:
 			return "float64", nil
 		case api.SimpleTypeString:
@@ -104,6 +104,8 @@ func init() {
 	EnvelopeAgnosticBinary = binary.Default
 }
 
+var _ OffsetDecoder = binary.Default
+
 // NoEnvelopeResponder responds to a request without an envelope.
 //
 // Deprecated: Don't use this directly. Use DecodeRequest.
@@ -0,0 +1,238 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"math"
+	"sync"
+
+	"go.uber.org/thriftrw/protocol/stream"
+)
+
+// AppendWriter implements stream.Writer for the Thrift Binary Protocol by
+// appending to a byte slice, growing it as needed, rather than writing to an
+// io.Writer.
+//
+//	w := binary.NewAppendWriter(buf)
+//	defer w.Close()
+//	if err := v.Encode(w); err != nil {
+//		return err
+//	}
+//	buf = w.Bytes()
+//
+// Code generated with --append-to uses this to implement AppendTo methods.
+type AppendWriter struct {
+	buf []byte
+}
+
+var _ stream.Writer = (*AppendWriter)(nil)
+
+var appendWriterPool = sync.Pool{
+	New: func() interface{} {
+		return &AppendWriter{}
+	}}
+
+// NewAppendWriter fetches an AppendWriter from the system that will append
+// to the given slice.
+//
+// This AppendWriter must be returned back with Close.
+func NewAppendWriter(buf []byte) *AppendWriter {
+	w := appendWriterPool.Get().(*AppendWriter)
+	w.buf = buf
+	return w
+}
+
+// Bytes returns the slice with everything written so far appended to it.
+func (w *AppendWriter) Bytes() []byte {
+	return w.buf
+}
+
+// Grow grows the slice, if necessary, so that n more bytes may be written
+// without another allocation.
+func (w *AppendWriter) Grow(n int) {
+	if n <= cap(w.buf)-len(w.buf) {
+		return
+	}
+	buf := make([]byte, len(w.buf), len(w.buf)+n)
+	copy(buf, w.buf)
+	w.buf = buf
+}
+
+// Write appends the given bytes as-is.
+func (w *AppendWriter) Write(bs []byte) (int, error) {
+	w.buf = append(w.buf, bs...)
+	return len(bs), nil
+}
+
+func (w *AppendWriter) appendUint16(i uint16) {
+	w.buf = append(w.buf, byte(i>>8), byte(i))
+}
+
+func (w *AppendWriter) appendUint32(i uint32) {
+	w.buf = append(w.buf, byte(i>>24), byte(i>>16), byte(i>>8), byte(i))
+}
+
+func (w *AppendWriter) appendUint64(i uint64) {
+	w.buf = append(w.buf,
+		byte(i>>56), byte(i>>48), byte(i>>40), byte(i>>32),
+		byte(i>>24), byte(i>>16), byte(i>>8), byte(i))
+}
+
+// WriteBool encodes a boolean
+func (w *AppendWriter) WriteBool(b bool) error {
+	if b {
+		w.buf = append(w.buf, 1)
+	} else {
+		w.buf = append(w.buf, 0)
+	}
+	return nil
+}
+
+// WriteInt8 encodes an int8
+func (w *AppendWriter) WriteInt8(i int8) error {
+	w.buf = append(w.buf, byte(i))
+	return nil
+}
+
+// WriteInt16 encodes an int16
+func (w *AppendWriter) WriteInt16(i int16) error {
+	w.appendUint16(uint16(i))
+	return nil
+}
+
+// WriteInt32 encodes an int32
+func (w *AppendWriter) WriteInt32(i int32) error {
+	w.appendUint32(uint32(i))
+	return nil
+}
+
+// WriteInt64 encodes an int64
+func (w *AppendWriter) WriteInt64(i int64) error {
+	w.appendUint64(uint64(i))
+	return nil
+}
+
+// WriteString encodes a string
+func (w *AppendWriter) WriteString(s string) error {
+	w.appendUint32(uint32(len(s)))
+	w.buf = append(w.buf, s...)
+	return nil
+}
+
+// WriteDouble encodes a double
+func (w *AppendWriter) WriteDouble(d float64) error {
+	w.appendUint64(math.Float64bits(d))
+	return nil
+}
+
+// WriteBinary encodes binary
+func (w *AppendWriter) WriteBinary(b []byte) error {
+	w.appendUint32(uint32(len(b)))
+	w.buf = append(w.buf, b...)
+	return nil
+}
+
+// WriteFieldBegin marks the beginning of a new field in a struct. The first
+// byte denotes the type and the next two bytes denote the field id.
+func (w *AppendWriter) WriteFieldBegin(f stream.FieldHeader) error {
+	w.buf = append(w.buf, byte(f.Type))
+	w.appendUint16(uint16(f.ID))
+	return nil
+}
+
+// WriteFieldEnd denotes the end of a field. No-op.
+func (w *AppendWriter) WriteFieldEnd() error {
+	return nil
+}
+
+// WriteStructBegin denotes the beginning of a struct. No-op.
+func (w *AppendWriter) WriteStructBegin() error {
+	return nil
+}
+
+// WriteStructEnd uses the zero byte to mark the end of a struct.
+func (w *AppendWriter) WriteStructEnd() error {
+	w.buf = append(w.buf, 0) // end struct
+	return nil
+}
+
+// WriteListBegin marks the beginning of a new list. The first byte denotes
+// the type of the items and the next four bytes denote the length of the list.
+func (w *AppendWriter) WriteListBegin(l stream.ListHeader) error {
+	w.buf = append(w.buf, byte(l.Type))
+	w.appendUint32(uint32(l.Length))
+	return nil
+}
+
+// WriteListEnd marks the end of a list. No-op.
+func (w *AppendWriter) WriteListEnd() error {
+	return nil
+}
+
+// WriteSetBegin marks the beginning of a new set. The first byte denotes
+// the type of the items and the next four bytes denote the length of the set.
+func (w *AppendWriter) WriteSetBegin(s stream.SetHeader) error {
+	w.buf = append(w.buf, byte(s.Type))
+	w.appendUint32(uint32(s.Length))
+	return nil
+}
+
+// WriteSetEnd marks the end of a set. No-op.
+func (w *AppendWriter) WriteSetEnd() error {
+	return nil
+}
+
+// WriteMapBegin marks the beginning of a new map. The first byte denotes
+// the type of the keys, the second byte denotes the type of the values,
+// and the next four bytes denote the length of the map.
+func (w *AppendWriter) WriteMapBegin(m stream.MapHeader) error {
+	w.buf = append(w.buf, byte(m.KeyType), byte(m.ValueType))
+	w.appendUint32(uint32(m.Length))
+	return nil
+}
+
+// WriteMapEnd marks the end of a map. No-op.
+func (w *AppendWriter) WriteMapEnd() error {
+	return nil
+}
+
+// WriteEnvelopeBegin writes the start of a strict envelope (contains an envelope version).
+func (w *AppendWriter) WriteEnvelopeBegin(eh stream.EnvelopeHeader) error {
+	w.appendUint32(uint32(version1) | uint32(eh.Type))
+	if err := w.WriteString(eh.Name); err != nil {
+		return err
+	}
+	return w.WriteInt32(eh.SeqID)
+}
+
+// WriteEnvelopeEnd writes the "end" of an envelope. Since there is no ending
+// to an envelope, this is a no-op.
+func (w *AppendWriter) WriteEnvelopeEnd() error {
+	return nil
+}
+
+// Close returns the AppendWriter back to the pool. Retrieve the slice with
+// Bytes before calling Close.
+func (w *AppendWriter) Close() error {
+	w.buf = nil
+	appendWriterPool.Put(w)
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestAppendWriterMatchesStreamWriter(t *testing.T) {
+	write := func(sw stream.Writer) {
+		require.NoError(t, sw.WriteEnvelopeBegin(stream.EnvelopeHeader{Name: "get", Type: wire.Call, SeqID: 42}))
+		require.NoError(t, sw.WriteStructBegin())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBool}))
+		require.NoError(t, sw.WriteBool(true))
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: -2, Type: wire.TList}))
+		require.NoError(t, sw.WriteListBegin(stream.ListHeader{Type: wire.TI8, Length: 2}))
+		require.NoError(t, sw.WriteInt8(-1))
+		require.NoError(t, sw.WriteInt8(127))
+		require.NoError(t, sw.WriteListEnd())
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TSet}))
+		require.NoError(t, sw.WriteSetBegin(stream.SetHeader{Type: wire.TI16, Length: 1}))
+		require.NoError(t, sw.WriteInt16(-300))
+		require.NoError(t, sw.WriteSetEnd())
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TMap}))
+		require.NoError(t, sw.WriteMapBegin(stream.MapHeader{KeyType: wire.TBinary, ValueType: wire.TI64, Length: 1}))
+		require.NoError(t, sw.WriteString("hello"))
+		require.NoError(t, sw.WriteInt64(-1<<40))
+		require.NoError(t, sw.WriteMapEnd())
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TDouble}))
+		require.NoError(t, sw.WriteDouble(3.14))
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TBinary}))
+		require.NoError(t, sw.WriteBinary([]byte{1, 2, 3}))
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TI32}))
+		require.NoError(t, sw.WriteInt32(-70000))
+		require.NoError(t, sw.WriteFieldEnd())
+		_, err := sw.Write([]byte{0xff})
+		require.NoError(t, err)
+		require.NoError(t, sw.WriteStructEnd())
+		require.NoError(t, sw.WriteEnvelopeEnd())
+	}
+
+	var want bytes.Buffer
+	sw := NewStreamWriter(&want)
+	write(sw)
+	require.NoError(t, sw.Close())
+
+	prefix := []byte("prefix")
+	w := NewAppendWriter(prefix)
+	write(w)
+	got := w.Bytes()
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, append([]byte("prefix"), want.Bytes()...), got)
+}
+
+func TestAppendWriterGrow(t *testing.T) {
+	w := NewAppendWriter([]byte("ab"))
+	defer w.Close()
+
+	w.Grow(10)
+	assert.Equal(t, []byte("ab"), w.Bytes())
+	assert.GreaterOrEqual(t, cap(w.Bytes()), 12)
+
+	before := cap(w.Bytes())
+	require.NoError(t, w.WriteInt64(1))
+	assert.Equal(t, before, cap(w.Bytes()), "must not grow again")
+
+	w.Grow(0)
+	assert.Equal(t, before, cap(w.Bytes()))
+}
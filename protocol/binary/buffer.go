@@ -0,0 +1,195 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrBufferReleased is returned when reading from a Buffer after all its
+// references have been released.
+var ErrBufferReleased = errors.New("binary: read from released Buffer")
+
+// retainer is implemented by io.ReaderAts whose lifetime is
+// reference-counted, like *Buffer. Lazily decoded lists and maps retain their
+// reader until they are closed.
+type retainer interface {
+	Retain()
+	Release()
+}
+
+func retain(r io.ReaderAt) {
+	if rt, ok := r.(retainer); ok {
+		rt.Retain()
+	}
+}
+
+func release(r io.ReaderAt) {
+	if rt, ok := r.(retainer); ok {
+		rt.Release()
+	}
+}
+
+// BufferOption customizes a Buffer.
+type BufferOption func(*Buffer)
+
+// DetectUseAfterRelease makes a Buffer remember where it was released and
+// panic with that location if it is used afterwards, instead of failing
+// reads with ErrBufferReleased.
+//
+// This captures a stack trace on release and is meant for tests and
+// debugging.
+func DetectUseAfterRelease() BufferOption {
+	return func(b *Buffer) {
+		b.debug = true
+	}
+}
+
+// Buffer is a reference-counted io.ReaderAt over a byte slice, such as a
+// memory-mapped file or a slice borrowed from a pool, from which values may
+// be decoded lazily.
+//
+// Lists, sets, and maps decoded from a Buffer read their items from it on
+// demand, so each retains the Buffer until it is closed. Once the last
+// reference is released, the Buffer calls its release function, after which
+// the bytes may be unmapped or reused.
+//
+//	buf := binary.NewBuffer(data, func(b []byte) { syscall.Munmap(b) })
+//	v, err := binary.Default.Decode(buf, wire.TStruct)
+//	...
+//	err = x.FromWire(v) // closes lists as it reads them
+//	buf.Release()
+//
+// Values which are discarded without closing their lists keep the Buffer
+// alive. Use wire.EvaluateValue to close them without decoding.
+type Buffer struct {
+	b       []byte
+	refs    int32 // atomic
+	release func([]byte)
+
+	debug      bool
+	mu         sync.Mutex
+	releasedAt []byte
+}
+
+var _ io.ReaderAt = (*Buffer)(nil)
+
+// NewBuffer builds a Buffer over the given bytes holding a single reference.
+//
+// release, if non-nil, is called with the bytes once all references to the
+// Buffer have been released.
+func NewBuffer(b []byte, release func([]byte), opts ...BufferOption) *Buffer {
+	buf := &Buffer{b: b, refs: 1, release: release}
+	for _, opt := range opts {
+		opt(buf)
+	}
+	return buf
+}
+
+// Bytes returns the bytes of the Buffer. They are valid only until the
+// Buffer is released.
+func (b *Buffer) Bytes() []byte {
+	b.check()
+	return b.b
+}
+
+// Len returns the number of bytes in the Buffer.
+func (b *Buffer) Len() int {
+	return len(b.b)
+}
+
+// Retain adds a reference to the Buffer. Each call to Retain must be paired
+// with a call to Release.
+func (b *Buffer) Retain() {
+	if atomic.AddInt32(&b.refs, 1) <= 1 {
+		b.panicReleased("retain")
+	}
+}
+
+// Release drops a reference to the Buffer, releasing the bytes if it was the
+// last one.
+func (b *Buffer) Release() {
+	refs := atomic.AddInt32(&b.refs, -1)
+	switch {
+	case refs > 0:
+		return
+	case refs < 0:
+		b.panicReleased("release")
+	}
+
+	if b.debug {
+		b.mu.Lock()
+		b.releasedAt = debug.Stack()
+		b.mu.Unlock()
+	}
+	if b.release != nil {
+		b.release(b.b)
+	}
+}
+
+// ReadAt implements io.ReaderAt.
+func (b *Buffer) ReadAt(p []byte, off int64) (int, error) {
+	if !b.check() {
+		return 0, ErrBufferReleased
+	}
+
+	if off < 0 {
+		return 0, errors.New("binary: negative offset")
+	}
+	if off >= int64(len(b.b)) {
+		return 0, io.EOF
+	}
+
+	n := copy(p, b.b[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// check reports whether the Buffer is still alive, panicking in debug mode
+// if it is not.
+func (b *Buffer) check() bool {
+	if atomic.LoadInt32(&b.refs) > 0 {
+		return true
+	}
+	if b.debug {
+		b.panicReleased("use")
+	}
+	return false
+}
+
+func (b *Buffer) panicReleased(op string) {
+	msg := fmt.Sprintf("binary: %v of released Buffer", op)
+	if b.debug {
+		b.mu.Lock()
+		if b.releasedAt != nil {
+			msg += fmt.Sprintf("; released at:\n%s", b.releasedAt)
+		}
+		b.mu.Unlock()
+	}
+	panic(msg)
+}
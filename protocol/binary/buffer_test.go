@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/wire"
+)
+
+// struct {1: list<i32> = [1, 2]}
+var bufferTestStruct = []byte{
+	0x0f, 0x00, 0x01, // type:1 = list, id:2 = 1
+	0x08, 0x00, 0x00, 0x00, 0x02, // type:1 = i32, length:4 = 2
+	0x00, 0x00, 0x00, 0x01,
+	0x00, 0x00, 0x00, 0x02,
+	0x00, // stop
+}
+
+func TestBufferLazyList(t *testing.T) {
+	var released bool
+	buf := NewBuffer(bufferTestStruct, func(b []byte) {
+		assert.Equal(t, bufferTestStruct, b)
+		released = true
+	})
+
+	v, err := Default.Decode(buf, wire.TStruct)
+	require.NoError(t, err)
+
+	buf.Release()
+	assert.False(t, released, "must not release while the list is open")
+
+	l := v.GetStruct().Fields[0].Value.GetList()
+	assert.Equal(t, []wire.Value{wire.NewValueI32(1), wire.NewValueI32(2)}, wire.ValueListToSlice(l))
+
+	l.Close()
+	assert.True(t, released, "must release once the list is closed")
+
+	_, err = buf.ReadAt(make([]byte, 1), 0)
+	assert.Equal(t, ErrBufferReleased, err)
+}
+
+func TestBufferDecodeError(t *testing.T) {
+	// The list is followed by a field of an unknown type.
+	bs := append(append([]byte{}, bufferTestStruct[:len(bufferTestStruct)-1]...), 0x42, 0x00, 0x02)
+
+	var released bool
+	buf := NewBuffer(bs, func([]byte) { released = true })
+
+	_, err := Default.Decode(buf, wire.TStruct)
+	require.Error(t, err)
+
+	buf.Release()
+	assert.True(t, released, "lists of failed decodes must be closed")
+}
+
+func TestBufferReadAt(t *testing.T) {
+	buf := NewBuffer([]byte("hello"), nil)
+	defer buf.Release()
+
+	p := make([]byte, 3)
+	n, err := buf.ReadAt(p, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "ell", string(p[:n]))
+
+	n, err = buf.ReadAt(p, 3)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "lo", string(p[:n]))
+
+	_, err = buf.ReadAt(p, 5)
+	assert.Equal(t, io.EOF, err)
+
+	_, err = buf.ReadAt(p, -1)
+	assert.Error(t, err)
+
+	assert.Equal(t, 5, buf.Len())
+	assert.Equal(t, "hello", string(buf.Bytes()))
+}
+
+func TestBufferMisuse(t *testing.T) {
+	t.Run("release twice", func(t *testing.T) {
+		buf := NewBuffer(nil, nil)
+		buf.Release()
+		assert.PanicsWithValue(t, "binary: release of released Buffer", buf.Release)
+	})
+
+	t.Run("retain after release", func(t *testing.T) {
+		buf := NewBuffer(nil, nil)
+		buf.Release()
+		assert.PanicsWithValue(t, "binary: retain of released Buffer", buf.Retain)
+	})
+
+	t.Run("debug", func(t *testing.T) {
+		buf := NewBuffer(bufferTestStruct, nil, DetectUseAfterRelease())
+		buf.Retain()
+		buf.Release()
+		assert.NotPanics(t, func() { buf.Bytes() })
+
+		buf.Release()
+		defer func() {
+			msg, _ := recover().(string)
+			assert.Contains(t, msg, "binary: use of released Buffer; released at:")
+			assert.Contains(t, msg, "TestBufferMisuse")
+		}()
+		Default.Decode(buf, wire.TStruct)
+		t.Fatal("expected panic")
+	})
+}
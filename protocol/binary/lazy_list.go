@@ -85,6 +85,7 @@ func (ll *lazyValueList) ForEach(f func(wire.Value) error) error {
 }
 
 func (ll *lazyValueList) Close() {
+	release(ll.readerAt)
 	ll.readerAt = nil
 	lazyValueListPool.Put(ll)
 }
@@ -140,6 +141,7 @@ func (lm *lazyMapItemList) ForEach(f func(wire.MapItem) error) error {
 }
 
 func (lm *lazyMapItemList) Close() {
+	release(lm.readerAt)
 	lm.readerAt = nil
 	lazyMapItemListPool.Put(lm)
 }
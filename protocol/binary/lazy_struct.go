@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// LazyStruct is a struct encoded with the Thrift Binary Protocol whose
+// fields are located only when they are first requested.
+//
+// Code generated with the go.lazy annotation or the --lazy-structs option
+// uses LazyStruct to decode individual fields of a struct on demand.
+//
+// A LazyStruct is not safe for concurrent use.
+type LazyStruct struct {
+	raw     []byte
+	indexed bool
+	fields  []lazyField
+}
+
+type lazyField struct {
+	ID   int16
+	Type wire.Type
+
+	// Offset of the value of the field in raw.
+	Offset int
+}
+
+// Reset discards the state of s and makes it read from the given bytes.
+//
+// The bytes must not be modified while s is in use.
+func (s *LazyStruct) Reset(raw []byte) {
+	s.raw = raw
+	s.indexed = false
+	s.fields = s.fields[:0]
+}
+
+// Bytes returns the encoded struct.
+func (s *LazyStruct) Bytes() []byte {
+	return s.raw
+}
+
+// Field returns a Reader positioned at the value of the field with the
+// given ID, or false if the struct does not have a field with that ID and
+// type. If the struct has multiple such fields, the last one is returned.
+//
+// The Reader must be closed after use.
+func (s *LazyStruct) Field(id int16, t wire.Type) (_ stream.Reader, ok bool, err error) {
+	if !s.indexed {
+		if err := s.index(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	for i := len(s.fields) - 1; i >= 0; i-- {
+		f := s.fields[i]
+		if f.ID == id && f.Type == t {
+			return NewStreamReader(bytes.NewReader(s.raw[f.Offset:])), true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// index records the location of every field of the struct.
+func (s *LazyStruct) index() error {
+	br := bytes.NewReader(s.raw)
+	sr := NewStreamReader(br)
+	defer sr.Close()
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fields := s.fields[:0]
+	for {
+		fh, ok, err := sr.ReadFieldBegin()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		fields = append(fields, lazyField{
+			ID:     fh.ID,
+			Type:   fh.Type,
+			Offset: len(s.raw) - br.Len(),
+		})
+		if err := sr.Skip(fh.Type); err != nil {
+			return err
+		}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	s.fields = fields
+	s.indexed = true
+	return nil
+}
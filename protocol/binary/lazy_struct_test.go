@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestLazyStruct(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+			wire.NewValueI32(1), wire.NewValueI32(2),
+		}))},
+		{ID: 3, Value: wire.NewValueI64(42)},
+		{ID: 3, Value: wire.NewValueI64(43)},
+	}})
+	var buf bytes.Buffer
+	require.NoError(t, Default.Encode(v, &buf))
+
+	var s LazyStruct
+	s.Reset(buf.Bytes())
+	assert.Equal(t, buf.Bytes(), s.Bytes())
+
+	t.Run("found", func(t *testing.T) {
+		sr, ok, err := s.Field(1, wire.TBinary)
+		require.NoError(t, err)
+		require.True(t, ok)
+		defer sr.Close()
+
+		got, err := sr.ReadString()
+		require.NoError(t, err)
+		assert.Equal(t, "foo", got)
+	})
+
+	t.Run("last wins", func(t *testing.T) {
+		sr, ok, err := s.Field(3, wire.TI64)
+		require.NoError(t, err)
+		require.True(t, ok)
+		defer sr.Close()
+
+		got, err := sr.ReadInt64()
+		require.NoError(t, err)
+		assert.Equal(t, int64(43), got)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, ok, err := s.Field(2, wire.TSet)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, ok, err := s.Field(4, wire.TI32)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestLazyStructReset(t *testing.T) {
+	var s LazyStruct
+	s.Reset([]byte{0x08, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00})
+	_, ok, err := s.Field(1, wire.TI32)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	s.Reset([]byte{0x00})
+	_, ok, err = s.Field(1, wire.TI32)
+	require.NoError(t, err)
+	assert.False(t, ok, "Reset must discard indexed fields")
+}
+
+func TestLazyStructInvalid(t *testing.T) {
+	tests := []struct {
+		desc string
+		give []byte
+	}{
+		{desc: "empty", give: []byte{}},
+		{desc: "truncated field", give: []byte{0x08, 0x00, 0x01, 0x00}},
+		{desc: "missing stop", give: []byte{0x08, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var s LazyStruct
+			s.Reset(tt.give)
+			_, _, err := s.Field(1, wire.TI32)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -55,6 +55,24 @@ func (*Protocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
 	return value, err
 }
 
+// DecodeAt reads a Value of the given type from the given Reader, starting
+// at the given offset. It returns the offset immediately after the value,
+// which is where the next value starts if the Reader holds a sequence of
+// concatenated values.
+//
+//	for off := int64(0); off < size; {
+//		v, off, err = binary.Default.DecodeAt(r, off, wire.TStruct)
+//		...
+//	}
+//
+// Lists, sets, and maps inside the returned Value are decoded lazily from the
+// Reader at their own offsets, so the Reader must remain readable for as long
+// as the Value is in use.
+func (*Protocol) DecodeAt(r io.ReaderAt, off int64, t wire.Type) (wire.Value, int64, error) {
+	reader := NewReader(r)
+	return reader.ReadValue(t, off)
+}
+
 // Writer builds a stream writer that writes to the provided stream using the
 // Thrift Binary Protocol.
 func (*Protocol) Writer(w io.Writer) stream.Writer {
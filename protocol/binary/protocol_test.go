@@ -6,6 +6,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/thriftrw/protocol/binary"
 	"go.uber.org/thriftrw/protocol/stream"
@@ -144,3 +145,64 @@ func (r *sliceReader) Read(bs []byte) (int, error) {
 	r.idx += n
 	return n, nil
 }
+
+func TestDecodeAt(t *testing.T) {
+	records := []wire.Value{
+		wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+		}}),
+		wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("bar")},
+			{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+				wire.NewValueI32(1),
+				wire.NewValueI32(2),
+			}))},
+		}}),
+		wire.NewValueStruct(wire.Struct{}),
+	}
+
+	// Records are preceded by unrelated data to verify that offsets are
+	// absolute.
+	buff := bytes.NewBufferString("header")
+	var offsets []int64
+	for _, r := range records {
+		offsets = append(offsets, int64(buff.Len()))
+		require.NoError(t, binary.Default.Encode(r, buff))
+	}
+	data := buff.Bytes()
+	r := bytes.NewReader(data)
+
+	t.Run("sequential", func(t *testing.T) {
+		off := offsets[0]
+		for i, want := range records {
+			var (
+				got wire.Value
+				err error
+			)
+			assert.Equal(t, offsets[i], off, "offset of record %d", i)
+			got, off, err = binary.Default.DecodeAt(r, off, wire.TStruct)
+			require.NoError(t, err, "record %d", i)
+			assert.True(t, wire.ValuesAreEqual(want, got), "record %d: expected %v, got %v", i, want, got)
+		}
+		assert.Equal(t, int64(len(data)), off, "must end at the end of the data")
+	})
+
+	t.Run("random access", func(t *testing.T) {
+		// The lazily decoded list must be read from its own offset even if
+		// other records are decoded in the meantime.
+		second, _, err := binary.Default.DecodeAt(r, offsets[1], wire.TStruct)
+		require.NoError(t, err)
+
+		first, _, err := binary.Default.DecodeAt(r, offsets[0], wire.TStruct)
+		require.NoError(t, err)
+
+		assert.True(t, wire.ValuesAreEqual(records[1], second), "expected %v, got %v", records[1], second)
+		assert.True(t, wire.ValuesAreEqual(records[0], first), "expected %v, got %v", records[0], first)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		truncated := bytes.NewReader(data[:len(data)-1])
+		_, _, err := binary.Default.DecodeAt(truncated, offsets[2], wire.TStruct)
+		assert.Error(t, err)
+	})
+}
@@ -76,8 +76,17 @@ func newReader(r io.ReaderAt, off int64) reader {
 	}
 }
 
-func (r *reader) readStructStream() (wire.Struct, error) {
+func (r *reader) readStructStream() (_ wire.Struct, err error) {
 	var fields []wire.Field
+	defer func() {
+		// Lazy collections hold a reference to the reader. Close the ones
+		// we won't be returning.
+		if err != nil {
+			for _, f := range fields {
+				closeValue(f.Value)
+			}
+		}
+	}()
 
 	if err := r.sr.ReadStructBegin(); err != nil {
 		return wire.Struct{}, err
@@ -132,6 +141,7 @@ func (r *reader) readMapStream() (wire.MapItemList, error) {
 	items.count = int32(mh.Length)
 	items.readerAt = r.or.reader
 	items.startOffset = start
+	retain(items.readerAt)
 
 	return items, nil
 }
@@ -156,6 +166,7 @@ func (r *reader) readListStream() (wire.ValueList, error) {
 	items.typ = lh.Type
 	items.readerAt = r.or.reader
 	items.startOffset = start
+	retain(items.readerAt)
 
 	return items, nil
 }
@@ -180,10 +191,27 @@ func (r *reader) readSetStream() (wire.ValueList, error) {
 	items.typ = sh.Type
 	items.readerAt = r.or.reader
 	items.startOffset = start
+	retain(items.readerAt)
 
 	return items, nil
 }
 
+// closeValue closes the lazy collections held by the given value.
+func closeValue(v wire.Value) {
+	switch v.Type() {
+	case wire.TStruct:
+		for _, f := range v.GetStruct().Fields {
+			closeValue(f.Value)
+		}
+	case wire.TMap:
+		v.GetMap().Close()
+	case wire.TSet:
+		v.GetSet().Close()
+	case wire.TList:
+		v.GetList().Close()
+	}
+}
+
 func (r *reader) close() error {
 	err := r.sr.Close()
 	r.sr = nil
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// UnknownField is a field of a struct that was not recognized when it was
+// decoded. Its value is retained encoded with the Thrift Binary Protocol.
+type UnknownField struct {
+	ID    int16
+	Type  wire.Type
+	Value []byte
+}
+
+// UnknownFields holds the fields of a struct that were not recognized when
+// it was decoded, so that they may be written back out when the struct is
+// encoded.
+//
+// Code generated with the go.preserve_unknown_fields annotation or the
+// --preserve-unknown-fields option keeps these in a hidden member of each
+// struct.
+type UnknownFields []UnknownField
+
+// FromWire retains the given field.
+func (u *UnknownFields) FromWire(f wire.Field) error {
+	var buf bytes.Buffer
+	if err := Default.Encode(f.Value, &buf); err != nil {
+		return err
+	}
+	*u = append(*u, UnknownField{ID: f.ID, Type: f.Value.Type(), Value: buf.Bytes()})
+	return nil
+}
+
+// Decode retains the value of the field with the given header from the
+// reader.
+func (u *UnknownFields) Decode(fh stream.FieldHeader, sr stream.Reader) error {
+	var buf bytes.Buffer
+	sw := NewStreamWriter(&buf)
+	defer sw.Close()
+
+	if err := stream.Copy(sw, sr, fh.Type); err != nil {
+		return err
+	}
+	*u = append(*u, UnknownField{ID: fh.ID, Type: fh.Type, Value: buf.Bytes()})
+	return nil
+}
+
+// ToWire appends the retained fields to the given fields and returns the
+// result.
+func (u UnknownFields) ToWire(fields []wire.Field) ([]wire.Field, error) {
+	for _, f := range u {
+		v, err := Default.Decode(bytes.NewReader(f.Value), f.Type)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: v})
+	}
+	return fields, nil
+}
+
+// Copy returns a copy of the retained fields. Values of the fields are
+// shared because they are never modified.
+func (u UnknownFields) Copy() UnknownFields {
+	if u == nil {
+		return nil
+	}
+	return append(make(UnknownFields, 0, len(u)), u...)
+}
+
+// Encode writes the retained fields to the given writer.
+func (u UnknownFields) Encode(sw stream.Writer) error {
+	for _, f := range u {
+		if err := u.encodeField(f, sw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Size returns the number of bytes that Encode writes for the retained
+// fields with the Binary protocol.
+func (u UnknownFields) Size() int {
+	n := 0
+	for _, f := range u {
+		// type:1 id:2 value
+		n += 3 + len(f.Value)
+	}
+	return n
+}
+
+func (UnknownFields) encodeField(f UnknownField, sw stream.Writer) error {
+	sr := NewStreamReader(bytes.NewReader(f.Value))
+	defer sr.Close()
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: f.ID, Type: f.Type}); err != nil {
+		return err
+	}
+	if err := stream.Copy(sw, sr, f.Type); err != nil {
+		return err
+	}
+	return sw.WriteFieldEnd()
+}
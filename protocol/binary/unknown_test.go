@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestUnknownFields(t *testing.T) {
+	fields := []wire.Field{
+		{ID: 2, Value: wire.NewValueI32(42)},
+		{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("a"), wire.NewValueString("b"),
+		}))},
+		{ID: 4, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI64, []wire.MapItem{
+				{Key: wire.NewValueString("x"), Value: wire.NewValueI64(1)},
+			}))},
+			{ID: 2, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBool, []wire.Value{
+				wire.NewValueBool(true),
+			}))},
+			{ID: 3, Value: wire.NewValueDouble(1.5)},
+			{ID: 4, Value: wire.NewValueI8(1)},
+			{ID: 5, Value: wire.NewValueI16(2)},
+		}})},
+	}
+	known := []wire.Field{{ID: 1, Value: wire.NewValueString("known")}}
+	want := wire.NewValueStruct(wire.Struct{Fields: append(known, fields...)})
+
+	t.Run("FromWire", func(t *testing.T) {
+		var u UnknownFields
+		for _, f := range fields {
+			require.NoError(t, u.FromWire(f))
+		}
+
+		got, err := u.ToWire(known)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(want, wire.NewValueStruct(wire.Struct{Fields: got})))
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Default.Encode(wire.NewValueStruct(wire.Struct{Fields: fields}), &buf))
+
+		sr := NewStreamReader(bytes.NewReader(buf.Bytes()))
+		defer sr.Close()
+
+		var u UnknownFields
+		require.NoError(t, sr.ReadStructBegin())
+		for {
+			fh, ok, err := sr.ReadFieldBegin()
+			require.NoError(t, err)
+			if !ok {
+				break
+			}
+			require.NoError(t, u.Decode(fh, sr))
+			require.NoError(t, sr.ReadFieldEnd())
+		}
+		require.NoError(t, sr.ReadStructEnd())
+
+		var out bytes.Buffer
+		sw := NewStreamWriter(&out)
+		require.NoError(t, sw.WriteStructBegin())
+		require.NoError(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}))
+		require.NoError(t, sw.WriteString("known"))
+		require.NoError(t, sw.WriteFieldEnd())
+		require.NoError(t, u.Encode(sw))
+		require.NoError(t, sw.WriteStructEnd())
+		require.NoError(t, sw.Close())
+
+		got, err := Default.Decode(bytes.NewReader(out.Bytes()), wire.TStruct)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(want, got))
+	})
+
+	t.Run("Size", func(t *testing.T) {
+		var u UnknownFields
+		for _, f := range fields {
+			require.NoError(t, u.FromWire(f))
+		}
+
+		var out bytes.Buffer
+		sw := NewStreamWriter(&out)
+		require.NoError(t, u.Encode(sw))
+		require.NoError(t, sw.Close())
+		assert.Equal(t, out.Len(), u.Size())
+	})
+
+	t.Run("Decode error", func(t *testing.T) {
+		sr := NewStreamReader(bytes.NewReader([]byte{0x00, 0x00}))
+		defer sr.Close()
+
+		var u UnknownFields
+		assert.Error(t, u.Decode(stream.FieldHeader{ID: 1, Type: wire.TI32}, sr))
+		assert.Empty(t, u)
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"io"
+	"time"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Op identifies the kind of operation reported in a Measurement.
+type Op int
+
+const (
+	// OpEncode is reported for Encode and EncodeEnveloped.
+	OpEncode Op = iota + 1
+
+	// OpDecode is reported for Decode and DecodeEnveloped.
+	OpDecode
+)
+
+func (o Op) String() string {
+	switch o {
+	case OpEncode:
+		return "encode"
+	case OpDecode:
+		return "decode"
+	default:
+		return "unknown"
+	}
+}
+
+// Measurement describes a single encode or decode performed by an
+// instrumented Protocol.
+type Measurement struct {
+	Op Op
+
+	// Method is the name of the enveloped method, or an empty string if the
+	// value was not enveloped.
+	Method string
+
+	// Bytes is the number of bytes written or read.
+	//
+	// For decodes, this is the furthest offset read from the ReaderAt by
+	// the time Decode returned.
+	Bytes int64
+
+	// Values counts the number of values of each type that were encoded or
+	// decoded, including the top-level value. This is nil if the operation
+	// failed, or if the Protocol was not built with CountValues.
+	Values map[wire.Type]int
+
+	Duration time.Duration
+
+	// Err is the error returned by the operation, if any.
+	Err error
+}
+
+// Instrumentation receives measurements from a Protocol built with
+// WithInstrumentation.
+//
+// Observe may be called concurrently.
+type Instrumentation interface {
+	Observe(Measurement)
+}
+
+// InstrumentOption customizes a Protocol built with WithInstrumentation.
+type InstrumentOption func(*instrumentMiddleware)
+
+// CountValues reports the number of values of each type in every value
+// encoded or decoded in Measurement.Values.
+//
+// Counting walks the whole value after it has been encoded or decoded, which
+// takes time proportional to its size, so it is disabled by default.
+func CountValues() InstrumentOption {
+	return func(mw *instrumentMiddleware) {
+		mw.countValues = true
+	}
+}
+
+// WithInstrumentation returns a Protocol that reports every encode and
+// decode performed by p to the given Instrumentation.
+//
+// The returned Protocol implements only the Protocol interface. See
+// WithEncodeMiddleware.
+func WithInstrumentation(p Protocol, i Instrumentation, opts ...InstrumentOption) Protocol {
+	mw := instrumentMiddleware{i: i}
+	for _, opt := range opts {
+		opt(&mw)
+	}
+	return WithDecodeMiddleware(WithEncodeMiddleware(p, mw), mw)
+}
+
+type instrumentMiddleware struct {
+	i           Instrumentation
+	countValues bool
+}
+
+var _timeNow = time.Now
+
+func (m instrumentMiddleware) observe(op Op, method string, start time.Time, n int64, v wire.Value, err error) {
+	measurement := Measurement{
+		Op:       op,
+		Method:   method,
+		Bytes:    n,
+		Duration: _timeNow().Sub(start),
+		Err:      err,
+	}
+	if err == nil && m.countValues {
+		measurement.Values = make(map[wire.Type]int)
+		countValues(v, measurement.Values)
+	}
+	m.i.Observe(measurement)
+}
+
+func (m instrumentMiddleware) Encode(v wire.Value, w io.Writer, next Protocol) error {
+	start := _timeNow()
+	cw := countingWriter{w: w}
+	err := next.Encode(v, &cw)
+	m.observe(OpEncode, "", start, cw.n, v, err)
+	return err
+}
+
+func (m instrumentMiddleware) EncodeEnveloped(e wire.Envelope, w io.Writer, next Protocol) error {
+	start := _timeNow()
+	cw := countingWriter{w: w}
+	err := next.EncodeEnveloped(e, &cw)
+	m.observe(OpEncode, e.Name, start, cw.n, e.Value, err)
+	return err
+}
+
+func (m instrumentMiddleware) Decode(r io.ReaderAt, t wire.Type, next Protocol) (wire.Value, error) {
+	start := _timeNow()
+	cr := countingReaderAt{r: r}
+	v, err := next.Decode(&cr, t)
+	m.observe(OpDecode, "", start, cr.max, v, err)
+	return v, err
+}
+
+func (m instrumentMiddleware) DecodeEnveloped(r io.ReaderAt, next Protocol) (wire.Envelope, error) {
+	start := _timeNow()
+	cr := countingReaderAt{r: r}
+	e, err := next.DecodeEnveloped(&cr)
+	m.observe(OpDecode, e.Name, start, cr.max, e.Value, err)
+	return e, err
+}
+
+// countValues adds the number of values of each type in v to counts.
+func countValues(v wire.Value, counts map[wire.Type]int) {
+	counts[v.Type()]++
+	switch v.Type() {
+	case wire.TStruct:
+		for _, f := range v.GetStruct().Fields {
+			countValues(f.Value, counts)
+		}
+	case wire.TMap:
+		_ = v.GetMap().ForEach(func(item wire.MapItem) error {
+			countValues(item.Key, counts)
+			countValues(item.Value, counts)
+			return nil
+		})
+	case wire.TSet:
+		_ = v.GetSet().ForEach(func(item wire.Value) error {
+			countValues(item, counts)
+			return nil
+		})
+	case wire.TList:
+		_ = v.GetList().ForEach(func(item wire.Value) error {
+			countValues(item, counts)
+			return nil
+		})
+	}
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
+
+type countingReaderAt struct {
+	r   io.ReaderAt
+	max int64
+}
+
+func (cr *countingReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	n, err := cr.r.ReadAt(b, off)
+	if end := off + int64(n); end > cr.max {
+		cr.max = end
+	}
+	return n, err
+}
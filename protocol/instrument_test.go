@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+type instrumentationFunc func(Measurement)
+
+func (f instrumentationFunc) Observe(m Measurement) { f(m) }
+
+func TestWithInstrumentation(t *testing.T) {
+	defer func(now func() time.Time) { _timeNow = now }(_timeNow)
+	var tick time.Duration
+	_timeNow = func() time.Time {
+		tick += time.Millisecond
+		return time.Unix(0, int64(tick))
+	}
+
+	var got []Measurement
+	p := WithInstrumentation(binary.Default, instrumentationFunc(func(m Measurement) {
+		got = append(got, m)
+	}), CountValues())
+
+	val := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+			wire.NewValueI32(1),
+			wire.NewValueI32(2),
+		}))},
+	}})
+	wantValues := map[wire.Type]int{wire.TStruct: 1, wire.TBinary: 1, wire.TList: 1, wire.TI32: 2}
+
+	var buf bytes.Buffer
+	require.NoError(t, p.EncodeEnveloped(wire.Envelope{Name: "hello", Type: wire.Call, Value: val}, &buf))
+	size := int64(buf.Len())
+
+	_, err := p.DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	_, err = p.Decode(bytes.NewReader([]byte{0xff}), wire.TStruct)
+	require.Error(t, err)
+
+	require.Len(t, got, 3)
+
+	assert.Equal(t, Measurement{
+		Op:       OpEncode,
+		Method:   "hello",
+		Bytes:    size,
+		Values:   wantValues,
+		Duration: time.Millisecond,
+	}, got[0])
+
+	assert.Equal(t, Measurement{
+		Op:       OpDecode,
+		Method:   "hello",
+		Bytes:    size,
+		Values:   wantValues,
+		Duration: time.Millisecond,
+	}, got[1])
+
+	assert.Equal(t, OpDecode, got[2].Op)
+	assert.Empty(t, got[2].Method)
+	assert.Nil(t, got[2].Values)
+	assert.Error(t, got[2].Err)
+}
+
+func TestWithInstrumentationNoValues(t *testing.T) {
+	var got []Measurement
+	p := WithInstrumentation(binary.Default, instrumentationFunc(func(m Measurement) {
+		got = append(got, m)
+	}))
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Encode(wire.NewValueString("foo"), &buf))
+	_, err := p.Decode(bytes.NewReader(buf.Bytes()), wire.TBinary)
+	require.NoError(t, err)
+
+	require.Len(t, got, 2)
+	for _, m := range got {
+		assert.Equal(t, int64(buf.Len()), m.Bytes)
+		assert.Nil(t, m.Values, "values must not be counted unless requested")
+	}
+}
+
+func TestWithInstrumentationEncodeError(t *testing.T) {
+	var got []Measurement
+	p := WithInstrumentation(binary.Default, instrumentationFunc(func(m Measurement) {
+		got = append(got, m)
+	}))
+
+	err := p.Encode(wire.NewValueString("foo"), errWriter{errors.New("great sadness")})
+	require.Error(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, OpEncode, got[0].Op)
+	assert.Equal(t, err, got[0].Err)
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestOpString(t *testing.T) {
+	assert.Equal(t, "encode", OpEncode.String())
+	assert.Equal(t, "decode", OpDecode.String())
+	assert.Equal(t, "unknown", Op(0).String())
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// EncodeMiddleware intercepts values being encoded by a Protocol.
+//
+// Implementations must call the provided Protocol to proceed with encoding,
+// and may inspect the value or wrap the writer before doing so.
+type EncodeMiddleware interface {
+	Encode(v wire.Value, w io.Writer, next Protocol) error
+	EncodeEnveloped(e wire.Envelope, w io.Writer, next Protocol) error
+}
+
+// DecodeMiddleware intercepts values being decoded by a Protocol.
+//
+// Implementations must call the provided Protocol to proceed with decoding,
+// and may inspect the decoded value or wrap the reader before doing so.
+type DecodeMiddleware interface {
+	Decode(r io.ReaderAt, t wire.Type, next Protocol) (wire.Value, error)
+	DecodeEnveloped(r io.ReaderAt, next Protocol) (wire.Envelope, error)
+}
+
+// WithEncodeMiddleware returns a Protocol which runs the given middleware
+// around every Encode and EncodeEnveloped call made to p. Middleware is
+// applied in the order given: the first middleware sees the call first.
+//
+// The returned Protocol implements only the Protocol interface. It cannot be
+// upcast to EnvelopeAgnosticProtocol or stream.Protocol even if p can.
+func WithEncodeMiddleware(p Protocol, mws ...EncodeMiddleware) Protocol {
+	for i := len(mws) - 1; i >= 0; i-- {
+		p = encodeMiddlewareProtocol{Protocol: p, mw: mws[i]}
+	}
+	return p
+}
+
+// WithDecodeMiddleware returns a Protocol which runs the given middleware
+// around every Decode and DecodeEnveloped call made to p. Middleware is
+// applied in the order given: the first middleware sees the call first.
+//
+// The returned Protocol implements only the Protocol interface. It cannot be
+// upcast to EnvelopeAgnosticProtocol or stream.Protocol even if p can.
+func WithDecodeMiddleware(p Protocol, mws ...DecodeMiddleware) Protocol {
+	for i := len(mws) - 1; i >= 0; i-- {
+		p = decodeMiddlewareProtocol{Protocol: p, mw: mws[i]}
+	}
+	return p
+}
+
+type encodeMiddlewareProtocol struct {
+	Protocol
+
+	mw EncodeMiddleware
+}
+
+func (p encodeMiddlewareProtocol) Encode(v wire.Value, w io.Writer) error {
+	return p.mw.Encode(v, w, p.Protocol)
+}
+
+func (p encodeMiddlewareProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+	return p.mw.EncodeEnveloped(e, w, p.Protocol)
+}
+
+type decodeMiddlewareProtocol struct {
+	Protocol
+
+	mw DecodeMiddleware
+}
+
+func (p decodeMiddlewareProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	return p.mw.Decode(r, t, p.Protocol)
+}
+
+func (p decodeMiddlewareProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	return p.mw.DecodeEnveloped(r, p.Protocol)
+}
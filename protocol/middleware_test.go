@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// recordingMiddleware records the names of the calls made through it.
+type recordingMiddleware struct {
+	name  string
+	calls *[]string
+}
+
+func (m recordingMiddleware) record(call string) {
+	*m.calls = append(*m.calls, m.name+"."+call)
+}
+
+func (m recordingMiddleware) Encode(v wire.Value, w io.Writer, next Protocol) error {
+	m.record("Encode")
+	return next.Encode(v, w)
+}
+
+func (m recordingMiddleware) EncodeEnveloped(e wire.Envelope, w io.Writer, next Protocol) error {
+	m.record("EncodeEnveloped")
+	return next.EncodeEnveloped(e, w)
+}
+
+func (m recordingMiddleware) Decode(r io.ReaderAt, t wire.Type, next Protocol) (wire.Value, error) {
+	m.record("Decode")
+	return next.Decode(r, t)
+}
+
+func (m recordingMiddleware) DecodeEnveloped(r io.ReaderAt, next Protocol) (wire.Envelope, error) {
+	m.record("DecodeEnveloped")
+	return next.DecodeEnveloped(r)
+}
+
+func (m recordingMiddleware) Writer(w io.Writer, next stream.Protocol) stream.Writer {
+	m.record("Writer")
+	return next.Writer(w)
+}
+
+func (m recordingMiddleware) Reader(r io.Reader, next stream.Protocol) stream.Reader {
+	m.record("Reader")
+	return next.Reader(r)
+}
+
+func TestEncodeDecodeMiddleware(t *testing.T) {
+	var calls []string
+	a := recordingMiddleware{name: "a", calls: &calls}
+	b := recordingMiddleware{name: "b", calls: &calls}
+
+	p := WithDecodeMiddleware(WithEncodeMiddleware(binary.Default, a, b), b, a)
+
+	val := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+	}})
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Encode(val, &buf))
+	got, err := p.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(val, got))
+
+	buf.Reset()
+	env := wire.Envelope{Name: "foo", Type: wire.Call, SeqID: 1, Value: val}
+	require.NoError(t, p.EncodeEnveloped(env, &buf))
+	gotEnv, err := p.DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, "foo", gotEnv.Name)
+
+	assert.Equal(t, []string{
+		"a.Encode", "b.Encode",
+		"b.Decode", "a.Decode",
+		"a.EncodeEnveloped", "b.EncodeEnveloped",
+		"b.DecodeEnveloped", "a.DecodeEnveloped",
+	}, calls)
+}
+
+func TestStreamEncodeDecodeMiddleware(t *testing.T) {
+	var calls []string
+	a := recordingMiddleware{name: "a", calls: &calls}
+	b := recordingMiddleware{name: "b", calls: &calls}
+
+	var p stream.Protocol = binary.Default
+	p = stream.WithEncodeMiddleware(p, a, b)
+	p = stream.WithDecodeMiddleware(p, b, a)
+
+	var buf bytes.Buffer
+	w := p.Writer(&buf)
+	require.NoError(t, w.WriteString("hello"))
+	require.NoError(t, w.Close())
+
+	r := p.Reader(bytes.NewReader(buf.Bytes()))
+	s, err := r.ReadString()
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "hello", s)
+
+	assert.Equal(t, []string{"a.Writer", "b.Writer", "b.Reader", "a.Reader"}, calls)
+}
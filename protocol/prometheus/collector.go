@@ -0,0 +1,232 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package prometheus provides a protocol.Instrumentation which exposes
+// serialization metrics in the Prometheus text exposition format.
+//
+// The Collector does not depend on the Prometheus client library. It may be
+// mounted directly as an HTTP handler,
+//
+//	c := prometheus.NewCollector()
+//	proto := protocol.WithInstrumentation(binary.Default, c)
+//	http.Handle("/metrics/thrift", c)
+//
+// The values_total counter is only reported for Protocols instrumented with
+// protocol.CountValues.
+//
+// Or its output may be appended to an existing metrics endpoint with
+// WriteTo.
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the duration histogram
+// buckets used if none are specified.
+var DefaultBuckets = []float64{
+	.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1, .5, 1,
+}
+
+// CollectorOption customizes a Collector.
+type CollectorOption func(*Collector)
+
+// Namespace sets the prefix used for all metric names. Defaults to
+// "thriftrw".
+func Namespace(ns string) CollectorOption {
+	return func(c *Collector) {
+		c.namespace = ns
+	}
+}
+
+// Buckets sets the upper bounds, in seconds, of the duration histogram
+// buckets. The bounds must be sorted in increasing order.
+func Buckets(bs ...float64) CollectorOption {
+	return func(c *Collector) {
+		c.buckets = bs
+	}
+}
+
+// Collector aggregates measurements reported by an instrumented
+// protocol.Protocol.
+type Collector struct {
+	namespace string
+	buckets   []float64
+
+	mu     sync.Mutex
+	series map[seriesKey]*series
+}
+
+var (
+	_ protocol.Instrumentation = (*Collector)(nil)
+	_ http.Handler             = (*Collector)(nil)
+	_ io.WriterTo              = (*Collector)(nil)
+)
+
+// NewCollector builds a new Collector.
+func NewCollector(opts ...CollectorOption) *Collector {
+	c := Collector{
+		namespace: "thriftrw",
+		buckets:   DefaultBuckets,
+		series:    make(map[seriesKey]*series),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+type seriesKey struct {
+	Op     protocol.Op
+	Method string
+}
+
+type series struct {
+	ops      int64
+	errors   int64
+	bytes    int64
+	values   map[wire.Type]int64
+	buckets  []int64 // cumulative counts are computed on output
+	duration float64 // sum in seconds
+}
+
+// Observe records the given measurement.
+func (c *Collector) Observe(m protocol.Measurement) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := seriesKey{Op: m.Op, Method: m.Method}
+	s, ok := c.series[key]
+	if !ok {
+		s = &series{
+			values:  make(map[wire.Type]int64),
+			buckets: make([]int64, len(c.buckets)),
+		}
+		c.series[key] = s
+	}
+
+	s.ops++
+	s.bytes += m.Bytes
+	if m.Err != nil {
+		s.errors++
+	}
+	for t, n := range m.Values {
+		s.values[t] += int64(n)
+	}
+
+	secs := m.Duration.Seconds()
+	s.duration += secs
+	if i := sort.SearchFloat64s(c.buckets, secs); i < len(s.buckets) {
+		s.buckets[i]++
+	}
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = c.WriteTo(w)
+}
+
+// WriteTo writes all metrics to w in the Prometheus text exposition format.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	c.mu.Lock()
+	keys := make([]seriesKey, 0, len(c.series))
+	for k := range c.series {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Op != keys[j].Op {
+			return keys[i].Op < keys[j].Op
+		}
+		return keys[i].Method < keys[j].Method
+	})
+
+	c.writeCounter(&buf, "operations_total", "Number of encode and decode operations.", keys,
+		func(s *series) int64 { return s.ops })
+	c.writeCounter(&buf, "errors_total", "Number of encode and decode operations that failed.", keys,
+		func(s *series) int64 { return s.errors })
+	c.writeCounter(&buf, "bytes_total", "Number of bytes encoded and decoded.", keys,
+		func(s *series) int64 { return s.bytes })
+
+	name := c.namespace + "_values_total"
+	fmt.Fprintf(&buf, "# HELP %v Number of Thrift values encoded and decoded, by type.\n", name)
+	fmt.Fprintf(&buf, "# TYPE %v counter\n", name)
+	for _, k := range keys {
+		s := c.series[k]
+		types := make([]wire.Type, 0, len(s.values))
+		for t := range s.values {
+			types = append(types, t)
+		}
+		sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+		for _, t := range types {
+			fmt.Fprintf(&buf, "%v{%v,type=%q} %d\n", name, labels(k), typeLabel(t), s.values[t])
+		}
+	}
+
+	name = c.namespace + "_duration_seconds"
+	fmt.Fprintf(&buf, "# HELP %v Time spent encoding and decoding.\n", name)
+	fmt.Fprintf(&buf, "# TYPE %v histogram\n", name)
+	for _, k := range keys {
+		s := c.series[k]
+		var cumulative int64
+		for i, le := range c.buckets {
+			cumulative += s.buckets[i]
+			fmt.Fprintf(&buf, "%v_bucket{%v,le=%q} %d\n",
+				name, labels(k), strconv.FormatFloat(le, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(&buf, "%v_bucket{%v,le=\"+Inf\"} %d\n", name, labels(k), s.ops)
+		fmt.Fprintf(&buf, "%v_sum{%v} %v\n", name, labels(k), strconv.FormatFloat(s.duration, 'g', -1, 64))
+		fmt.Fprintf(&buf, "%v_count{%v} %d\n", name, labels(k), s.ops)
+	}
+	c.mu.Unlock()
+
+	return buf.WriteTo(w)
+}
+
+func (c *Collector) writeCounter(buf *bytes.Buffer, name, help string, keys []seriesKey, get func(*series) int64) {
+	name = c.namespace + "_" + name
+	fmt.Fprintf(buf, "# HELP %v %v\n", name, help)
+	fmt.Fprintf(buf, "# TYPE %v counter\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%v{%v} %d\n", name, labels(k), get(c.series[k]))
+	}
+}
+
+func labels(k seriesKey) string {
+	return fmt.Sprintf("op=%q,method=%q", k.Op.String(), k.Method)
+}
+
+// typeLabel returns the label used for a wire.Type, e.g. "struct" for
+// wire.TStruct.
+func typeLabel(t wire.Type) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "T"))
+}
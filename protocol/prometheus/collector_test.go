@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector(Namespace("test"), Buckets(0.001, 0.01))
+
+	c.Observe(protocol.Measurement{
+		Op:       protocol.OpEncode,
+		Method:   "getValue",
+		Bytes:    10,
+		Values:   map[wire.Type]int{wire.TStruct: 1, wire.TI32: 2},
+		Duration: 500 * time.Microsecond,
+	})
+	c.Observe(protocol.Measurement{
+		Op:       protocol.OpEncode,
+		Method:   "getValue",
+		Bytes:    12,
+		Values:   map[wire.Type]int{wire.TStruct: 1},
+		Duration: 5 * time.Millisecond,
+	})
+	c.Observe(protocol.Measurement{
+		Op:       protocol.OpDecode,
+		Bytes:    3,
+		Duration: time.Second,
+		Err:      errors.New("great sadness"),
+	})
+
+	var buf bytes.Buffer
+	_, err := c.WriteTo(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, `# HELP test_operations_total Number of encode and decode operations.
+# TYPE test_operations_total counter
+test_operations_total{op="encode",method="getValue"} 2
+test_operations_total{op="decode",method=""} 1
+# HELP test_errors_total Number of encode and decode operations that failed.
+# TYPE test_errors_total counter
+test_errors_total{op="encode",method="getValue"} 0
+test_errors_total{op="decode",method=""} 1
+# HELP test_bytes_total Number of bytes encoded and decoded.
+# TYPE test_bytes_total counter
+test_bytes_total{op="encode",method="getValue"} 22
+test_bytes_total{op="decode",method=""} 3
+# HELP test_values_total Number of Thrift values encoded and decoded, by type.
+# TYPE test_values_total counter
+test_values_total{op="encode",method="getValue",type="i32"} 2
+test_values_total{op="encode",method="getValue",type="struct"} 2
+# HELP test_duration_seconds Time spent encoding and decoding.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{op="encode",method="getValue",le="0.001"} 1
+test_duration_seconds_bucket{op="encode",method="getValue",le="0.01"} 2
+test_duration_seconds_bucket{op="encode",method="getValue",le="+Inf"} 2
+test_duration_seconds_sum{op="encode",method="getValue"} 0.0055
+test_duration_seconds_count{op="encode",method="getValue"} 2
+test_duration_seconds_bucket{op="decode",method="",le="0.001"} 0
+test_duration_seconds_bucket{op="decode",method="",le="0.01"} 0
+test_duration_seconds_bucket{op="decode",method="",le="+Inf"} 1
+test_duration_seconds_sum{op="decode",method=""} 1
+test_duration_seconds_count{op="decode",method=""} 1
+`, buf.String())
+}
+
+func TestCollectorServeHTTP(t *testing.T) {
+	c := NewCollector()
+	c.Observe(protocol.Measurement{Op: protocol.OpEncode, Bytes: 1})
+
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	assert.Equal(t, "text/plain; version=0.0.4", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `thriftrw_bytes_total{op="encode",method=""} 1`)
+}
@@ -46,6 +46,19 @@ type Protocol interface {
 	DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error)
 }
 
+// OffsetDecoder is implemented by protocols that can decode values at
+// arbitrary offsets of an io.ReaderAt. This allows decoding records from a
+// memory-mapped file of concatenated values without wrapping or seeking the
+// reader.
+//
+// The Binary protocol in particular can be upcast to OffsetDecoder.
+type OffsetDecoder interface {
+	// DecodeAt reads a Value of the given type starting at the given offset
+	// of the given ReaderAt. It returns the offset immediately after the
+	// value.
+	DecodeAt(r io.ReaderAt, off int64, t wire.Type) (v wire.Value, next int64, err error)
+}
+
 // EnvelopeAgnosticProtocol defines a specific way for a Thrift value to be
 // encoded or decoded, additionally being able to decode requests without prior
 // knowledge of whether the request is enveloped.
@@ -0,0 +1,334 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stream
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Difference describes where two values compared with Compare differ.
+type Difference struct {
+	// Path to the differing value from the top-level value. Struct fields
+	// are identified by their field IDs, items of lists and sets by their
+	// index, and entries of maps by their index followed by ".key" or
+	// ".value", as in "3[2].value.1". Empty if the top-level values differ.
+	Path string
+
+	// Reason describes the difference.
+	Reason string
+}
+
+func (d *Difference) String() string {
+	if d.Path == "" {
+		return d.Reason
+	}
+	return d.Path + ": " + d.Reason
+}
+
+// Compare reads a value of the given type from each of the given Readers
+// and reports the first difference between them, or nil if they are equal.
+//
+// Values are read side by side and compared as they are read without
+// decoding them into wire.Values, so memory use does not grow with the size
+// of the values. This makes Compare suitable for verifying large payloads,
+// such as entries of replicated logs.
+//
+// Because values are not buffered, struct fields, set items, and map entries
+// are compared in the order in which they were encoded. Equal values encoded
+// in a different order are reported as different. Values encoded by
+// generated code always list struct fields in the same order. Doubles are
+// equal if their bits are equal, so NaNs are equal to themselves.
+//
+// An error is returned only if either value could not be read. The Readers
+// are left positioned in the middle of the values if a difference is found.
+func Compare(a, b Reader, t wire.Type) (*Difference, error) {
+	return compareValue(a, b, t)
+}
+
+func differf(format string, args ...interface{}) *Difference {
+	return &Difference{Reason: fmt.Sprintf(format, args...)}
+}
+
+// within prefixes the path of the given Difference with the given segment.
+func within(d *Difference, segment string) *Difference {
+	switch {
+	case d == nil:
+	case d.Path == "":
+		d.Path = segment
+	case d.Path[0] == '[':
+		d.Path = segment + d.Path
+	default:
+		d.Path = segment + "." + d.Path
+	}
+	return d
+}
+
+func compareValue(a, b Reader, t wire.Type) (*Difference, error) {
+	switch t {
+	case wire.TBool:
+		x, err := a.ReadBool()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadBool()
+		if err != nil || x == y {
+			return nil, err
+		}
+		return differf("%v != %v", x, y), nil
+
+	case wire.TI8:
+		x, err := a.ReadInt8()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadInt8()
+		if err != nil || x == y {
+			return nil, err
+		}
+		return differf("%v != %v", x, y), nil
+
+	case wire.TDouble:
+		x, err := a.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadDouble()
+		if err != nil || math.Float64bits(x) == math.Float64bits(y) {
+			return nil, err
+		}
+		return differf("%v != %v", x, y), nil
+
+	case wire.TI16:
+		x, err := a.ReadInt16()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadInt16()
+		if err != nil || x == y {
+			return nil, err
+		}
+		return differf("%v != %v", x, y), nil
+
+	case wire.TI32:
+		x, err := a.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadInt32()
+		if err != nil || x == y {
+			return nil, err
+		}
+		return differf("%v != %v", x, y), nil
+
+	case wire.TI64:
+		x, err := a.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadInt64()
+		if err != nil || x == y {
+			return nil, err
+		}
+		return differf("%v != %v", x, y), nil
+
+	case wire.TBinary:
+		x, err := a.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+		y, err := b.ReadBinary()
+		if err != nil || bytes.Equal(x, y) {
+			return nil, err
+		}
+		return differf("%q != %q", x, y), nil
+
+	case wire.TStruct:
+		return compareStruct(a, b)
+
+	case wire.TMap:
+		return compareMap(a, b)
+
+	case wire.TSet:
+		return compareSet(a, b)
+
+	case wire.TList:
+		return compareList(a, b)
+
+	default:
+		return nil, fmt.Errorf("unknown ttype %v", t)
+	}
+}
+
+func compareStruct(a, b Reader) (*Difference, error) {
+	if err := a.ReadStructBegin(); err != nil {
+		return nil, err
+	}
+	if err := b.ReadStructBegin(); err != nil {
+		return nil, err
+	}
+
+	for {
+		x, xok, err := a.ReadFieldBegin()
+		if err != nil {
+			return nil, err
+		}
+		y, yok, err := b.ReadFieldBegin()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !xok && !yok:
+			if err := a.ReadStructEnd(); err != nil {
+				return nil, err
+			}
+			return nil, b.ReadStructEnd()
+		case !yok:
+			return differf("field %v is only in the first value", x.ID), nil
+		case !xok:
+			return differf("field %v is only in the second value", y.ID), nil
+		case x.ID != y.ID:
+			return differf("found field %v in the first value and field %v in the second", x.ID, y.ID), nil
+		}
+
+		segment := strconv.Itoa(int(x.ID))
+		if x.Type != y.Type {
+			return within(differf("type %v != %v", x.Type, y.Type), segment), nil
+		}
+		if d, err := compareValue(a, b, x.Type); d != nil || err != nil {
+			return within(d, segment), err
+		}
+
+		if err := a.ReadFieldEnd(); err != nil {
+			return nil, err
+		}
+		if err := b.ReadFieldEnd(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func compareMap(a, b Reader) (*Difference, error) {
+	x, err := a.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+	y, err := b.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case x.KeyType != y.KeyType:
+		return differf("key type %v != %v", x.KeyType, y.KeyType), nil
+	case x.ValueType != y.ValueType:
+		return differf("value type %v != %v", x.ValueType, y.ValueType), nil
+	case x.Length != y.Length:
+		return differf("length %v != %v", x.Length, y.Length), nil
+	}
+
+	for i := 0; i < x.Length; i++ {
+		if d, err := compareValue(a, b, x.KeyType); d != nil || err != nil {
+			return within(d, "["+strconv.Itoa(i)+"].key"), err
+		}
+		if d, err := compareValue(a, b, x.ValueType); d != nil || err != nil {
+			return within(d, "["+strconv.Itoa(i)+"].value"), err
+		}
+	}
+
+	if err := a.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return nil, b.ReadMapEnd()
+}
+
+func compareSet(a, b Reader) (*Difference, error) {
+	x, err := a.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+	y, err := b.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if d := compareItems(x.Type, y.Type, x.Length, y.Length); d != nil {
+		return d, nil
+	}
+	if d, err := compareEach(a, b, x.Type, x.Length); d != nil || err != nil {
+		return d, err
+	}
+
+	if err := a.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return nil, b.ReadSetEnd()
+}
+
+func compareList(a, b Reader) (*Difference, error) {
+	x, err := a.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+	y, err := b.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if d := compareItems(x.Type, y.Type, x.Length, y.Length); d != nil {
+		return d, nil
+	}
+	if d, err := compareEach(a, b, x.Type, x.Length); d != nil || err != nil {
+		return d, err
+	}
+
+	if err := a.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return nil, b.ReadListEnd()
+}
+
+// compareItems compares the headers of two lists or sets.
+func compareItems(xType, yType wire.Type, xLen, yLen int) *Difference {
+	switch {
+	case xType != yType:
+		return differf("item type %v != %v", xType, yType)
+	case xLen != yLen:
+		return differf("length %v != %v", xLen, yLen)
+	default:
+		return nil
+	}
+}
+
+// compareEach compares n items of the given type from both Readers.
+func compareEach(a, b Reader, t wire.Type, n int) (*Difference, error) {
+	for i := 0; i < n; i++ {
+		if d, err := compareValue(a, b, t); d != nil || err != nil {
+			return within(d, "["+strconv.Itoa(i)+"]"), err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stream_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+func encode(t *testing.T, v wire.Value) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(v, &buf))
+	return buf.Bytes()
+}
+
+func compareValues(t *testing.T, x, y wire.Value) (*stream.Difference, error) {
+	return stream.Compare(
+		binary.Default.Reader(bytes.NewReader(encode(t, x))),
+		binary.Default.Reader(bytes.NewReader(encode(t, y))),
+		x.Type(),
+	)
+}
+
+func structValue(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func listValue(typ wire.Type, items ...wire.Value) wire.Value {
+	return wire.NewValueList(wire.ValueListFromSlice(typ, items))
+}
+
+func TestCompare(t *testing.T) {
+	record := func(name string, tags ...wire.Value) wire.Value {
+		return structValue(
+			wire.Field{ID: 1, Value: wire.NewValueString(name)},
+			wire.Field{ID: 2, Value: listValue(wire.TStruct,
+				structValue(wire.Field{ID: 1, Value: wire.NewValueI32(1)}),
+				structValue(wire.Field{ID: 1, Value: listValue(wire.TBinary, tags...)}),
+			)},
+			wire.Field{ID: 3, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TDouble, []wire.MapItem{
+				{Key: wire.NewValueString("score"), Value: wire.NewValueDouble(math.NaN())},
+			}))},
+		)
+	}
+
+	tests := []struct {
+		desc string
+		x, y wire.Value
+		want *stream.Difference
+	}{
+		{
+			desc: "equal primitives",
+			x:    wire.NewValueI64(42),
+			y:    wire.NewValueI64(42),
+		},
+		{
+			desc: "different primitives",
+			x:    wire.NewValueBool(true),
+			y:    wire.NewValueBool(false),
+			want: &stream.Difference{Reason: "true != false"},
+		},
+		{
+			desc: "equal structs",
+			x:    record("foo", wire.NewValueString("a")),
+			y:    record("foo", wire.NewValueString("a")),
+		},
+		{
+			desc: "nested difference",
+			x:    record("foo", wire.NewValueString("a"), wire.NewValueString("b")),
+			y:    record("foo", wire.NewValueString("a"), wire.NewValueString("c")),
+			want: &stream.Difference{Path: "2[1].1[1]", Reason: `"b" != "c"`},
+		},
+		{
+			desc: "different lengths",
+			x:    record("foo", wire.NewValueString("a")),
+			y:    record("foo"),
+			want: &stream.Difference{Path: "2[1].1", Reason: "length 1 != 0"},
+		},
+		{
+			desc: "different field types",
+			x:    structValue(wire.Field{ID: 1, Value: wire.NewValueI32(1)}),
+			y:    structValue(wire.Field{ID: 1, Value: wire.NewValueI64(1)}),
+			want: &stream.Difference{Path: "1", Reason: "type TI32 != TI64"},
+		},
+		{
+			desc: "missing field",
+			x: structValue(
+				wire.Field{ID: 1, Value: wire.NewValueI32(1)},
+				wire.Field{ID: 2, Value: wire.NewValueI32(2)},
+			),
+			y:    structValue(wire.Field{ID: 1, Value: wire.NewValueI32(1)}),
+			want: &stream.Difference{Reason: "field 2 is only in the first value"},
+		},
+		{
+			desc: "extra field",
+			x:    structValue(),
+			y:    structValue(wire.Field{ID: 3, Value: wire.NewValueI32(1)}),
+			want: &stream.Difference{Reason: "field 3 is only in the second value"},
+		},
+		{
+			desc: "different field order",
+			x: structValue(
+				wire.Field{ID: 1, Value: wire.NewValueI32(1)},
+				wire.Field{ID: 2, Value: wire.NewValueI32(2)},
+			),
+			y: structValue(
+				wire.Field{ID: 2, Value: wire.NewValueI32(2)},
+				wire.Field{ID: 1, Value: wire.NewValueI32(1)},
+			),
+			want: &stream.Difference{Reason: "found field 1 in the first value and field 2 in the second"},
+		},
+		{
+			desc: "map key",
+			x: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI16, wire.TBool, []wire.MapItem{
+				{Key: wire.NewValueI16(1), Value: wire.NewValueBool(true)},
+			})),
+			y: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI16, wire.TBool, []wire.MapItem{
+				{Key: wire.NewValueI16(2), Value: wire.NewValueBool(true)},
+			})),
+			want: &stream.Difference{Path: "[0].key", Reason: "1 != 2"},
+		},
+		{
+			desc: "map value type",
+			x:    wire.NewValueMap(wire.MapItemListFromSlice(wire.TI16, wire.TBool, nil)),
+			y:    wire.NewValueMap(wire.MapItemListFromSlice(wire.TI16, wire.TI8, nil)),
+			want: &stream.Difference{Reason: "value type TBool != TI8"},
+		},
+		{
+			desc: "set item",
+			x:    wire.NewValueSet(wire.ValueListFromSlice(wire.TI8, []wire.Value{wire.NewValueI8(1), wire.NewValueI8(2)})),
+			y:    wire.NewValueSet(wire.ValueListFromSlice(wire.TI8, []wire.Value{wire.NewValueI8(1), wire.NewValueI8(3)})),
+			want: &stream.Difference{Path: "[1]", Reason: "2 != 3"},
+		},
+		{
+			desc: "list item type",
+			x:    listValue(wire.TI32),
+			y:    listValue(wire.TI64),
+			want: &stream.Difference{Reason: "item type TI32 != TI64"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := compareValues(t, tt.x, tt.y)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareReadsWholeValues(t *testing.T) {
+	v := structValue(wire.Field{ID: 1, Value: listValue(wire.TI32, wire.NewValueI32(1))})
+	payload := append(encode(t, v), encode(t, wire.NewValueI32(7))...)
+
+	a := binary.Default.Reader(bytes.NewReader(payload))
+	b := binary.Default.Reader(bytes.NewReader(payload))
+
+	// Values in a log may be compared one after another.
+	d, err := stream.Compare(a, b, wire.TStruct)
+	require.NoError(t, err)
+	assert.Nil(t, d)
+
+	d, err = stream.Compare(a, b, wire.TI32)
+	require.NoError(t, err)
+	assert.Nil(t, d)
+}
+
+func TestCompareErrors(t *testing.T) {
+	full := encode(t, structValue(wire.Field{ID: 1, Value: wire.NewValueString("hello")}))
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := stream.Compare(
+			binary.Default.Reader(bytes.NewReader(full)),
+			binary.Default.Reader(bytes.NewReader(full[:len(full)-3])),
+			wire.TStruct,
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := stream.Compare(
+			binary.Default.Reader(bytes.NewReader(full)),
+			binary.Default.Reader(bytes.NewReader(full)),
+			wire.Type(42),
+		)
+		assert.Equal(t, errors.New("unknown ttype Type(42)"), err)
+	})
+}
+
+func TestDifferenceString(t *testing.T) {
+	assert.Equal(t, "1 != 2", (&stream.Difference{Reason: "1 != 2"}).String())
+	assert.Equal(t, `3[2].value: "a" != "b"`,
+		(&stream.Difference{Path: "3[2].value", Reason: `"a" != "b"`}).String())
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stream
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Copy reads a value of the given type from r and writes it to w.
+//
+// The Reader and Writer may use different protocols.
+func Copy(w Writer, r Reader, t wire.Type) error {
+	switch t {
+	case wire.TBool:
+		v, err := r.ReadBool()
+		if err != nil {
+			return err
+		}
+		return w.WriteBool(v)
+
+	case wire.TI8:
+		v, err := r.ReadInt8()
+		if err != nil {
+			return err
+		}
+		return w.WriteInt8(v)
+
+	case wire.TDouble:
+		v, err := r.ReadDouble()
+		if err != nil {
+			return err
+		}
+		return w.WriteDouble(v)
+
+	case wire.TI16:
+		v, err := r.ReadInt16()
+		if err != nil {
+			return err
+		}
+		return w.WriteInt16(v)
+
+	case wire.TI32:
+		v, err := r.ReadInt32()
+		if err != nil {
+			return err
+		}
+		return w.WriteInt32(v)
+
+	case wire.TI64:
+		v, err := r.ReadInt64()
+		if err != nil {
+			return err
+		}
+		return w.WriteInt64(v)
+
+	case wire.TBinary:
+		v, err := r.ReadBinary()
+		if err != nil {
+			return err
+		}
+		return w.WriteBinary(v)
+
+	case wire.TStruct:
+		return copyStruct(w, r)
+
+	case wire.TMap:
+		return copyMap(w, r)
+
+	case wire.TSet:
+		return copySet(w, r)
+
+	case wire.TList:
+		return copyList(w, r)
+
+	default:
+		return fmt.Errorf("unknown ttype %v", t)
+	}
+}
+
+func copyStruct(w Writer, r Reader) error {
+	if err := r.ReadStructBegin(); err != nil {
+		return err
+	}
+	if err := w.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	for {
+		fh, ok, err := r.ReadFieldBegin()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		if err := w.WriteFieldBegin(fh); err != nil {
+			return err
+		}
+		if err := Copy(w, r, fh.Type); err != nil {
+			return err
+		}
+		if err := r.ReadFieldEnd(); err != nil {
+			return err
+		}
+		if err := w.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if err := r.ReadStructEnd(); err != nil {
+		return err
+	}
+	return w.WriteStructEnd()
+}
+
+func copyMap(w Writer, r Reader) error {
+	mh, err := r.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	if err := w.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for i := 0; i < mh.Length; i++ {
+		if err := Copy(w, r, mh.KeyType); err != nil {
+			return err
+		}
+		if err := Copy(w, r, mh.ValueType); err != nil {
+			return err
+		}
+	}
+
+	if err := r.ReadMapEnd(); err != nil {
+		return err
+	}
+	return w.WriteMapEnd()
+}
+
+func copySet(w Writer, r Reader) error {
+	sh, err := r.ReadSetBegin()
+	if err != nil {
+		return err
+	}
+	if err := w.WriteSetBegin(sh); err != nil {
+		return err
+	}
+
+	for i := 0; i < sh.Length; i++ {
+		if err := Copy(w, r, sh.Type); err != nil {
+			return err
+		}
+	}
+
+	if err := r.ReadSetEnd(); err != nil {
+		return err
+	}
+	return w.WriteSetEnd()
+}
+
+func copyList(w Writer, r Reader) error {
+	lh, err := r.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	if err := w.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i := 0; i < lh.Length; i++ {
+		if err := Copy(w, r, lh.Type); err != nil {
+			return err
+		}
+	}
+
+	if err := r.ReadListEnd(); err != nil {
+		return err
+	}
+	return w.WriteListEnd()
+}
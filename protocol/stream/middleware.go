@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stream
+
+import "io"
+
+// EncodeMiddleware intercepts streaming encodes performed by a Protocol.
+type EncodeMiddleware interface {
+	// Writer returns a Writer for w. Implementations must obtain the
+	// underlying Writer from next, and may wrap it to observe or alter
+	// every value written.
+	Writer(w io.Writer, next Protocol) Writer
+}
+
+// DecodeMiddleware intercepts streaming decodes performed by a Protocol.
+type DecodeMiddleware interface {
+	// Reader returns a Reader for r. Implementations must obtain the
+	// underlying Reader from next, and may wrap it to observe or alter
+	// every value read.
+	Reader(r io.Reader, next Protocol) Reader
+}
+
+// WithEncodeMiddleware returns a Protocol which runs the given middleware
+// whenever a Writer is requested from p. Middleware is applied in the order
+// given: the first middleware sees the call first.
+func WithEncodeMiddleware(p Protocol, mws ...EncodeMiddleware) Protocol {
+	for i := len(mws) - 1; i >= 0; i-- {
+		p = encodeMiddlewareProtocol{Protocol: p, mw: mws[i]}
+	}
+	return p
+}
+
+// WithDecodeMiddleware returns a Protocol which runs the given middleware
+// whenever a Reader is requested from p. Middleware is applied in the order
+// given: the first middleware sees the call first.
+func WithDecodeMiddleware(p Protocol, mws ...DecodeMiddleware) Protocol {
+	for i := len(mws) - 1; i >= 0; i-- {
+		p = decodeMiddlewareProtocol{Protocol: p, mw: mws[i]}
+	}
+	return p
+}
+
+type encodeMiddlewareProtocol struct {
+	Protocol
+
+	mw EncodeMiddleware
+}
+
+func (p encodeMiddlewareProtocol) Writer(w io.Writer) Writer {
+	return p.mw.Writer(w, p.Protocol)
+}
+
+type decodeMiddlewareProtocol struct {
+	Protocol
+
+	mw DecodeMiddleware
+}
+
+func (p decodeMiddlewareProtocol) Reader(r io.Reader) Reader {
+	return p.mw.Reader(r, p.Protocol)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stream_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+)
+
+// recordingMiddleware records the names of the calls made through it.
+type recordingMiddleware struct {
+	name  string
+	calls *[]string
+}
+
+func (m recordingMiddleware) Writer(w io.Writer, next stream.Protocol) stream.Writer {
+	*m.calls = append(*m.calls, m.name+".Writer")
+	return next.Writer(w)
+}
+
+func (m recordingMiddleware) Reader(r io.Reader, next stream.Protocol) stream.Reader {
+	*m.calls = append(*m.calls, m.name+".Reader")
+	return next.Reader(r)
+}
+
+// failingMiddleware returns streams which fail every call with err,
+// without consulting the next Protocol.
+type failingMiddleware struct{ err error }
+
+func (m failingMiddleware) Writer(io.Writer, stream.Protocol) stream.Writer {
+	return failingWriter{err: m.err}
+}
+
+func (m failingMiddleware) Reader(io.Reader, stream.Protocol) stream.Reader {
+	return failingReader{err: m.err}
+}
+
+type failingWriter struct {
+	stream.Writer
+
+	err error
+}
+
+func (w failingWriter) WriteString(string) error { return w.err }
+
+type failingReader struct {
+	stream.Reader
+
+	err error
+}
+
+func (r failingReader) ReadString() (string, error) { return "", r.err }
+
+func TestMiddlewareOrder(t *testing.T) {
+	var calls []string
+	a := recordingMiddleware{name: "a", calls: &calls}
+	b := recordingMiddleware{name: "b", calls: &calls}
+	c := recordingMiddleware{name: "c", calls: &calls}
+
+	var p stream.Protocol = binary.Default
+	p = stream.WithEncodeMiddleware(p, a, b, c)
+	p = stream.WithDecodeMiddleware(p, c, b, a)
+
+	var buf bytes.Buffer
+	w := p.Writer(&buf)
+	require.NoError(t, w.WriteString("hello"))
+	require.NoError(t, w.Close())
+
+	r := p.Reader(bytes.NewReader(buf.Bytes()))
+	s, err := r.ReadString()
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "hello", s)
+
+	assert.Equal(t, []string{
+		"a.Writer", "b.Writer", "c.Writer",
+		"c.Reader", "b.Reader", "a.Reader",
+	}, calls)
+}
+
+func TestMiddlewarePassThrough(t *testing.T) {
+	t.Run("no middleware", func(t *testing.T) {
+		var p stream.Protocol = binary.Default
+		assert.Equal(t, p, stream.WithEncodeMiddleware(p))
+		assert.Equal(t, p, stream.WithDecodeMiddleware(p))
+	})
+
+	t.Run("other direction", func(t *testing.T) {
+		var calls []string
+		mw := recordingMiddleware{name: "mw", calls: &calls}
+
+		// Readers of a Protocol with only encode middleware, and Writers
+		// of one with only decode middleware, are not intercepted.
+		enc := stream.WithEncodeMiddleware(binary.Default, mw)
+		dec := stream.WithDecodeMiddleware(binary.Default, mw)
+
+		var buf bytes.Buffer
+		w := dec.Writer(&buf)
+		require.NoError(t, w.WriteString("hello"))
+		require.NoError(t, w.Close())
+
+		r := enc.Reader(bytes.NewReader(buf.Bytes()))
+		s, err := r.ReadString()
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "hello", s)
+
+		assert.Empty(t, calls)
+	})
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	errSadness := errors.New("great sadness")
+
+	var calls []string
+	before := recordingMiddleware{name: "before", calls: &calls}
+	after := recordingMiddleware{name: "after", calls: &calls}
+	fail := failingMiddleware{err: errSadness}
+
+	var p stream.Protocol = binary.Default
+	p = stream.WithEncodeMiddleware(p, before, fail, after)
+	p = stream.WithDecodeMiddleware(p, before, fail, after)
+
+	var buf bytes.Buffer
+	assert.Equal(t, errSadness, p.Writer(&buf).WriteString("hello"))
+	assert.Zero(t, buf.Len(), "nothing must be written")
+
+	_, err := p.Reader(bytes.NewReader(nil)).ReadString()
+	assert.Equal(t, errSadness, err)
+
+	// Middleware after the failing one is never reached.
+	assert.Equal(t, []string{"before.Writer", "before.Reader"}, calls)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tjson
+
+import "fmt"
+
+type encodeError struct {
+	message string
+}
+
+func (e encodeError) Error() string {
+	return e.message
+}
+
+func encodeErrorf(f string, args ...interface{}) encodeError {
+	return encodeError{message: fmt.Sprintf(f, args...)}
+}
+
+type decodeError struct {
+	message string
+}
+
+func (e decodeError) Error() string {
+	return e.message
+}
+
+func decodeErrorf(f string, args ...interface{}) decodeError {
+	return decodeError{message: fmt.Sprintf(f, args...)}
+}
+
+// IsDecodeError checks if an error is a TJSONProtocol decode error.
+func IsDecodeError(e error) bool {
+	_, isDecodeError := e.(decodeError)
+	return isDecodeError
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package tjson implements the JSON protocol of Apache Thrift, TJSONProtocol.
+//
+// TJSONProtocol keys the fields of structs by their identifiers and tags
+// each value with its Thrift type, so payloads round-trip with Apache Thrift
+// implementations in other languages, such as Python and Java services
+// speaking JSON. This is unrelated to the encoding/json support of
+// generated types, which uses Go field names.
+//
+// Strings and binary data share a wire.Type but are encoded differently in
+// TJSONProtocol, so this package only implements the streaming API, through
+// which generated code tells the two apart.
+package tjson
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Default is the TJSONProtocol implementation.
+var Default = protocol{}
+
+// protocol implements stream.Protocol for TJSONProtocol.
+type protocol struct{}
+
+var _ stream.Protocol = protocol{}
+
+// Writer returns a stream.Writer which writes TJSONProtocol to w.
+func (protocol) Writer(w io.Writer) stream.Writer {
+	return NewStreamWriter(w)
+}
+
+// Reader returns a stream.Reader which reads TJSONProtocol from r.
+func (protocol) Reader(r io.Reader) stream.Reader {
+	return NewStreamReader(r)
+}
+
+// version is the version of TJSONProtocol written in envelopes.
+const version = 1
+
+// Names of wire types in TJSONProtocol.
+var typeNames = map[wire.Type]string{
+	wire.TBool:   "tf",
+	wire.TI8:     "i8",
+	wire.TI16:    "i16",
+	wire.TI32:    "i32",
+	wire.TI64:    "i64",
+	wire.TDouble: "dbl",
+	wire.TBinary: "str",
+	wire.TStruct: "rec",
+	wire.TMap:    "map",
+	wire.TSet:    "set",
+	wire.TList:   "lst",
+}
+
+func typeName(t wire.Type) (string, error) {
+	if name, ok := typeNames[t]; ok {
+		return name, nil
+	}
+	return "", encodeErrorf("unknown wire type %v", t)
+}
+
+func parseTypeName(name string) (wire.Type, error) {
+	for t, n := range typeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+	return 0, decodeErrorf("unknown type name %q", name)
+}
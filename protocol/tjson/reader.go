@@ -0,0 +1,369 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tjson
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// StreamReader reads TJSONProtocol from an io.Reader.
+type StreamReader struct {
+	decoder *json.Decoder
+}
+
+var _ stream.Reader = (*StreamReader)(nil)
+
+// NewStreamReader builds a StreamReader which reads its input from the
+// given io.Reader.
+func NewStreamReader(r io.Reader) *StreamReader {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	return &StreamReader{decoder: d}
+}
+
+func (sr *StreamReader) token() (json.Token, error) {
+	t, err := sr.decoder.Token()
+	switch err.(type) {
+	case nil:
+		return t, nil
+	case *json.SyntaxError:
+		return nil, decodeErrorf("invalid TJSONProtocol input: %v", err)
+	}
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return nil, err
+}
+
+func (sr *StreamReader) expect(delim json.Delim) error {
+	t, err := sr.token()
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return decodeErrorf("expected %q, got %v", delim, t)
+	}
+	return nil
+}
+
+func (sr *StreamReader) readString() (string, error) {
+	t, err := sr.token()
+	if err != nil {
+		return "", err
+	}
+	s, ok := t.(string)
+	if !ok {
+		return "", decodeErrorf("expected a string, got %v", t)
+	}
+	return s, nil
+}
+
+// readNumber reads a number, which is quoted if it is the key of an object.
+func (sr *StreamReader) readNumber() (string, error) {
+	t, err := sr.token()
+	if err != nil {
+		return "", err
+	}
+	switch n := t.(type) {
+	case json.Number:
+		return string(n), nil
+	case string:
+		return n, nil
+	default:
+		return "", decodeErrorf("expected a number, got %v", t)
+	}
+}
+
+func (sr *StreamReader) readInt(bitSize int) (int64, error) {
+	s, err := sr.readNumber()
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		return 0, decodeErrorf("invalid i%d %q", bitSize, s)
+	}
+	return i, nil
+}
+
+func (sr *StreamReader) readType() (wire.Type, error) {
+	name, err := sr.readString()
+	if err != nil {
+		return 0, err
+	}
+	return parseTypeName(name)
+}
+
+func (sr *StreamReader) readLength() (int, error) {
+	n, err := sr.readInt(32)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, decodeErrorf("invalid negative length %d", n)
+	}
+	return int(n), nil
+}
+
+// ReadBool reads a boolean written as 1 or 0.
+func (sr *StreamReader) ReadBool() (bool, error) {
+	t, err := sr.token()
+	if err != nil {
+		return false, err
+	}
+	switch v := t.(type) {
+	case bool:
+		return v, nil
+	case json.Number, string:
+		switch v {
+		case json.Number("1"), "1":
+			return true, nil
+		case json.Number("0"), "0":
+			return false, nil
+		}
+	}
+	return false, decodeErrorf("invalid bool %v", t)
+}
+
+// ReadInt8 reads an int8
+func (sr *StreamReader) ReadInt8() (int8, error) {
+	i, err := sr.readInt(8)
+	return int8(i), err
+}
+
+// ReadInt16 reads an int16
+func (sr *StreamReader) ReadInt16() (int16, error) {
+	i, err := sr.readInt(16)
+	return int16(i), err
+}
+
+// ReadInt32 reads an int32
+func (sr *StreamReader) ReadInt32() (int32, error) {
+	i, err := sr.readInt(32)
+	return int32(i), err
+}
+
+// ReadInt64 reads an int64
+func (sr *StreamReader) ReadInt64() (int64, error) {
+	return sr.readInt(64)
+}
+
+// ReadString reads a string
+func (sr *StreamReader) ReadString() (string, error) {
+	return sr.readString()
+}
+
+// ReadDouble reads a double, including NaN and infinities written as
+// strings.
+func (sr *StreamReader) ReadDouble() (float64, error) {
+	s, err := sr.readNumber()
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, decodeErrorf("invalid double %q", s)
+	}
+	return f, nil
+}
+
+// ReadBinary reads binary data encoded in base64, with or without padding.
+func (sr *StreamReader) ReadBinary() ([]byte, error) {
+	s, err := sr.readString()
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, decodeErrorf("invalid base64 binary: %v", err)
+	}
+	return b, nil
+}
+
+// ReadStructBegin reads the start of a struct.
+func (sr *StreamReader) ReadStructBegin() error {
+	return sr.expect('{')
+}
+
+// ReadStructEnd reads the end of a struct.
+func (sr *StreamReader) ReadStructEnd() error {
+	return sr.expect('}')
+}
+
+// ReadFieldBegin reads the identifier and type of the next field, or returns
+// false if there are no more fields in the struct.
+func (sr *StreamReader) ReadFieldBegin() (stream.FieldHeader, bool, error) {
+	if !sr.decoder.More() {
+		return stream.FieldHeader{}, false, nil
+	}
+
+	id, err := sr.readInt(16)
+	if err != nil {
+		return stream.FieldHeader{}, false, err
+	}
+	if err := sr.expect('{'); err != nil {
+		return stream.FieldHeader{}, false, err
+	}
+	t, err := sr.readType()
+	if err != nil {
+		return stream.FieldHeader{}, false, err
+	}
+	return stream.FieldHeader{ID: int16(id), Type: t}, true, nil
+}
+
+// ReadFieldEnd reads the end of a field.
+func (sr *StreamReader) ReadFieldEnd() error {
+	return sr.expect('}')
+}
+
+// ReadListBegin reads the element type and length of a list.
+func (sr *StreamReader) ReadListBegin() (stream.ListHeader, error) {
+	t, n, err := sr.readCollectionBegin()
+	return stream.ListHeader{Type: t, Length: n}, err
+}
+
+// ReadListEnd reads the end of a list.
+func (sr *StreamReader) ReadListEnd() error {
+	return sr.expect(']')
+}
+
+// ReadSetBegin reads the element type and length of a set.
+func (sr *StreamReader) ReadSetBegin() (stream.SetHeader, error) {
+	t, n, err := sr.readCollectionBegin()
+	return stream.SetHeader{Type: t, Length: n}, err
+}
+
+// ReadSetEnd reads the end of a set.
+func (sr *StreamReader) ReadSetEnd() error {
+	return sr.expect(']')
+}
+
+func (sr *StreamReader) readCollectionBegin() (wire.Type, int, error) {
+	if err := sr.expect('['); err != nil {
+		return 0, 0, err
+	}
+	t, err := sr.readType()
+	if err != nil {
+		return 0, 0, err
+	}
+	n, err := sr.readLength()
+	return t, n, err
+}
+
+// ReadMapBegin reads the key type, value type, and length of a map.
+func (sr *StreamReader) ReadMapBegin() (stream.MapHeader, error) {
+	if err := sr.expect('['); err != nil {
+		return stream.MapHeader{}, err
+	}
+	kt, err := sr.readType()
+	if err != nil {
+		return stream.MapHeader{}, err
+	}
+	vt, err := sr.readType()
+	if err != nil {
+		return stream.MapHeader{}, err
+	}
+	n, err := sr.readLength()
+	if err != nil {
+		return stream.MapHeader{}, err
+	}
+	if err := sr.expect('{'); err != nil {
+		return stream.MapHeader{}, err
+	}
+	return stream.MapHeader{KeyType: kt, ValueType: vt, Length: n}, nil
+}
+
+// ReadMapEnd reads the end of a map.
+func (sr *StreamReader) ReadMapEnd() error {
+	if err := sr.expect('}'); err != nil {
+		return err
+	}
+	return sr.expect(']')
+}
+
+// ReadEnvelopeBegin reads the version, method name, message type, and
+// sequence ID of an envelope.
+func (sr *StreamReader) ReadEnvelopeBegin() (stream.EnvelopeHeader, error) {
+	var eh stream.EnvelopeHeader
+	if err := sr.expect('['); err != nil {
+		return eh, err
+	}
+
+	v, err := sr.readInt(32)
+	if err != nil {
+		return eh, err
+	}
+	if v != version {
+		return eh, decodeErrorf("unsupported TJSONProtocol version %d", v)
+	}
+
+	if eh.Name, err = sr.readString(); err != nil {
+		return eh, err
+	}
+
+	t, err := sr.readInt(8)
+	if err != nil {
+		return eh, err
+	}
+	eh.Type = wire.EnvelopeType(t)
+
+	seqID, err := sr.readInt(32)
+	eh.SeqID = int32(seqID)
+	return eh, err
+}
+
+// ReadEnvelopeEnd reads the end of an envelope.
+func (sr *StreamReader) ReadEnvelopeEnd() error {
+	return sr.expect(']')
+}
+
+// Skip skips over the next value. Values in TJSONProtocol carry their own
+// structure, so the type is not needed.
+func (sr *StreamReader) Skip(wire.Type) error {
+	var depth int
+	for {
+		t, err := sr.token()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// Close is a no-op. It is present to satisfy stream.Reader.
+func (sr *StreamReader) Close() error {
+	return nil
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tjson
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// writeExample writes a struct exercising every kind of value, as written
+// by generated code for
+//
+//	struct Example {
+//	  1: bool flag
+//	  2: string name
+//	  3: binary data
+//	  4: double ratio
+//	  5: list<i64> ids
+//	  6: map<i32, string> labels
+//	  7: set<string> tags
+//	}
+func writeExample(sw stream.Writer) error {
+	steps := []func() error{
+		sw.WriteStructBegin,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBool}) },
+		func() error { return sw.WriteBool(true) },
+		sw.WriteFieldEnd,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}) },
+		func() error { return sw.WriteString("héllo \"world\"\n") },
+		sw.WriteFieldEnd,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}) },
+		func() error { return sw.WriteBinary([]byte{0xde, 0xad, 0xbe, 0xef}) },
+		sw.WriteFieldEnd,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TDouble}) },
+		func() error { return sw.WriteDouble(0.5) },
+		sw.WriteFieldEnd,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}) },
+		func() error { return sw.WriteListBegin(stream.ListHeader{Type: wire.TI64, Length: 2}) },
+		func() error { return sw.WriteInt64(math.MaxInt64) },
+		func() error { return sw.WriteInt64(-1) },
+		sw.WriteListEnd,
+		sw.WriteFieldEnd,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TMap}) },
+		func() error {
+			return sw.WriteMapBegin(stream.MapHeader{KeyType: wire.TI32, ValueType: wire.TBinary, Length: 2})
+		},
+		func() error { return sw.WriteInt32(1) },
+		func() error { return sw.WriteString("one") },
+		func() error { return sw.WriteInt32(2) },
+		func() error { return sw.WriteString("two") },
+		sw.WriteMapEnd,
+		sw.WriteFieldEnd,
+		func() error { return sw.WriteFieldBegin(stream.FieldHeader{ID: 7, Type: wire.TSet}) },
+		func() error { return sw.WriteSetBegin(stream.SetHeader{Type: wire.TBinary, Length: 0}) },
+		sw.WriteSetEnd,
+		sw.WriteFieldEnd,
+		sw.WriteStructEnd,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const exampleJSON = `{"1":{"tf":1},"2":{"str":"héllo \"world\"\n"},"3":{"str":"3q2+7w=="},` +
+	`"4":{"dbl":0.5},"5":{"lst":["i64",2,9223372036854775807,-1]},` +
+	`"6":{"map":["i32","str",2,{"1":"one","2":"two"}]},"7":{"set":["str",0]}}`
+
+func TestWriter(t *testing.T) {
+	var buff bytes.Buffer
+	sw := Default.Writer(&buff)
+	require.NoError(t, writeExample(sw))
+	require.NoError(t, sw.Close())
+	assert.Equal(t, exampleJSON, buff.String())
+}
+
+func TestReader(t *testing.T) {
+	sr := Default.Reader(strings.NewReader(exampleJSON))
+	require.NoError(t, sr.ReadStructBegin())
+
+	readField := func(id int16, typ wire.Type) {
+		fh, ok, err := sr.ReadFieldBegin()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, stream.FieldHeader{ID: id, Type: typ}, fh)
+	}
+
+	readField(1, wire.TBool)
+	b, err := sr.ReadBool()
+	require.NoError(t, err)
+	assert.True(t, b)
+	require.NoError(t, sr.ReadFieldEnd())
+
+	readField(2, wire.TBinary)
+	s, err := sr.ReadString()
+	require.NoError(t, err)
+	assert.Equal(t, "héllo \"world\"\n", s)
+	require.NoError(t, sr.ReadFieldEnd())
+
+	readField(3, wire.TBinary)
+	bs, err := sr.ReadBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, bs)
+	require.NoError(t, sr.ReadFieldEnd())
+
+	readField(4, wire.TDouble)
+	d, err := sr.ReadDouble()
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, d)
+	require.NoError(t, sr.ReadFieldEnd())
+
+	readField(5, wire.TList)
+	lh, err := sr.ReadListBegin()
+	require.NoError(t, err)
+	assert.Equal(t, stream.ListHeader{Type: wire.TI64, Length: 2}, lh)
+	i, err := sr.ReadInt64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), i)
+	i, err = sr.ReadInt64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), i)
+	require.NoError(t, sr.ReadListEnd())
+	require.NoError(t, sr.ReadFieldEnd())
+
+	readField(6, wire.TMap)
+	mh, err := sr.ReadMapBegin()
+	require.NoError(t, err)
+	assert.Equal(t, stream.MapHeader{KeyType: wire.TI32, ValueType: wire.TBinary, Length: 2}, mh)
+	for _, want := range []string{"one", "two"} {
+		_, err := sr.ReadInt32()
+		require.NoError(t, err)
+		v, err := sr.ReadString()
+		require.NoError(t, err)
+		assert.Equal(t, want, v)
+	}
+	require.NoError(t, sr.ReadMapEnd())
+	require.NoError(t, sr.ReadFieldEnd())
+
+	// Skip the set.
+	readField(7, wire.TSet)
+	require.NoError(t, sr.Skip(wire.TSet))
+	require.NoError(t, sr.ReadFieldEnd())
+
+	_, ok, err := sr.ReadFieldBegin()
+	require.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, sr.ReadStructEnd())
+	require.NoError(t, sr.Close())
+}
+
+func TestSkip(t *testing.T) {
+	sr := Default.Reader(strings.NewReader(exampleJSON + `"next"`))
+	require.NoError(t, sr.Skip(wire.TStruct))
+	s, err := sr.ReadString()
+	require.NoError(t, err)
+	assert.Equal(t, "next", s, "must skip exactly one value")
+}
+
+func TestDoubles(t *testing.T) {
+	tests := []struct {
+		give float64
+		want string
+	}{
+		{give: 1, want: "1"},
+		{give: -2.5e-10, want: "-2.5e-10"},
+		{give: math.Inf(1), want: `"Infinity"`},
+		{give: math.Inf(-1), want: `"-Infinity"`},
+		{give: math.NaN(), want: `"NaN"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, NewStreamWriter(&buff).WriteDouble(tt.give))
+			assert.Equal(t, tt.want, buff.String())
+
+			got, err := NewStreamReader(&buff).ReadDouble()
+			require.NoError(t, err)
+			if math.IsNaN(tt.give) {
+				assert.True(t, math.IsNaN(got))
+			} else {
+				assert.Equal(t, tt.give, got)
+			}
+		})
+	}
+}
+
+func TestBinaryPadding(t *testing.T) {
+	// Some implementations write base64 without padding.
+	got, err := NewStreamReader(strings.NewReader(`"3q2+7w"`)).ReadBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, got)
+}
+
+func TestMapKeys(t *testing.T) {
+	var buff bytes.Buffer
+	sw := NewStreamWriter(&buff)
+	require.NoError(t, sw.WriteMapBegin(stream.MapHeader{KeyType: wire.TBool, ValueType: wire.TDouble, Length: 1}))
+	require.NoError(t, sw.WriteBool(true))
+	require.NoError(t, sw.WriteDouble(1.5))
+	require.NoError(t, sw.WriteMapEnd())
+	assert.Equal(t, `["tf","dbl",1,{"1":1.5}]`, buff.String())
+
+	sr := NewStreamReader(&buff)
+	_, err := sr.ReadMapBegin()
+	require.NoError(t, err)
+	k, err := sr.ReadBool()
+	require.NoError(t, err)
+	assert.True(t, k)
+
+	buff.Reset()
+	sw = NewStreamWriter(&buff)
+	require.NoError(t, sw.WriteMapBegin(stream.MapHeader{KeyType: wire.TStruct, ValueType: wire.TI32, Length: 1}))
+	err = sw.WriteStructBegin()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "map keys must be strings, numbers, or booleans")
+}
+
+func TestEnvelope(t *testing.T) {
+	var buff bytes.Buffer
+	sw := NewStreamWriter(&buff)
+	require.NoError(t, sw.WriteEnvelopeBegin(stream.EnvelopeHeader{Name: "getValue", Type: wire.Reply, SeqID: 42}))
+	require.NoError(t, sw.WriteStructBegin())
+	require.NoError(t, sw.WriteStructEnd())
+	require.NoError(t, sw.WriteEnvelopeEnd())
+	require.NoError(t, sw.Close())
+	assert.Equal(t, `[1,"getValue",2,42,{}]`, buff.String())
+
+	sr := NewStreamReader(&buff)
+	eh, err := sr.ReadEnvelopeBegin()
+	require.NoError(t, err)
+	assert.Equal(t, stream.EnvelopeHeader{Name: "getValue", Type: wire.Reply, SeqID: 42}, eh)
+	require.NoError(t, sr.Skip(wire.TStruct))
+	require.NoError(t, sr.ReadEnvelopeEnd())
+}
+
+func TestWriterErrors(t *testing.T) {
+	var buff bytes.Buffer
+	sw := NewStreamWriter(&buff)
+	require.NoError(t, sw.WriteStructBegin())
+	assert.EqualError(t, sw.Close(), "incomplete TJSONProtocol output: 1 values were not ended")
+	assert.Error(t, sw.WriteListEnd(), "mismatched delimiter")
+	assert.Error(t, sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.Type(42)}))
+}
+
+func TestReaderErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		read func(stream.Reader) error
+		want string
+	}{
+		{
+			desc: "unexpected delimiter",
+			give: `{"1":`,
+			read: func(sr stream.Reader) error {
+				_, err := sr.ReadListBegin()
+				return err
+			},
+			want: `expected "[", got {`,
+		},
+		{
+			desc: "unknown type",
+			give: `{"1":{"foo":1}}`,
+			read: func(sr stream.Reader) error {
+				if err := sr.ReadStructBegin(); err != nil {
+					return err
+				}
+				_, _, err := sr.ReadFieldBegin()
+				return err
+			},
+			want: `unknown type name "foo"`,
+		},
+		{
+			desc: "overflow",
+			give: `300`,
+			read: func(sr stream.Reader) error {
+				_, err := sr.ReadInt8()
+				return err
+			},
+			want: `invalid i8 "300"`,
+		},
+		{
+			desc: "bool",
+			give: `2`,
+			read: func(sr stream.Reader) error {
+				_, err := sr.ReadBool()
+				return err
+			},
+			want: "invalid bool 2",
+		},
+		{
+			desc: "negative length",
+			give: `["i32",-1]`,
+			read: func(sr stream.Reader) error {
+				_, err := sr.ReadListBegin()
+				return err
+			},
+			want: "invalid negative length -1",
+		},
+		{
+			desc: "version",
+			give: `[2,"foo",1,1,{}]`,
+			read: func(sr stream.Reader) error {
+				_, err := sr.ReadEnvelopeBegin()
+				return err
+			},
+			want: "unsupported TJSONProtocol version 2",
+		},
+		{
+			desc: "syntax",
+			give: `{"1"}`,
+			read: func(sr stream.Reader) error {
+				if err := sr.ReadStructBegin(); err != nil {
+					return err
+				}
+				_, _, err := sr.ReadFieldBegin()
+				return err
+			},
+			want: "invalid TJSONProtocol input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.read(Default.Reader(strings.NewReader(tt.give)))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+			assert.True(t, IsDecodeError(err), "must be a decode error")
+		})
+	}
+}
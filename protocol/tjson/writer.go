@@ -0,0 +1,363 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tjson
+
+import (
+	"encoding/base64"
+	"io"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// context tracks the separators needed between the values of a JSON array
+// or object being written.
+type context struct {
+	// Whether this is an object, whose values alternate between keys and
+	// values, rather than an array.
+	object bool
+
+	// Number of values written so far.
+	n int
+}
+
+// StreamWriter writes TJSONProtocol to an io.Writer.
+type StreamWriter struct {
+	writer io.Writer
+	stack  []context
+
+	// This buffer is re-used to build each token.
+	buffer []byte
+}
+
+var _ stream.Writer = (*StreamWriter)(nil)
+
+// NewStreamWriter builds a StreamWriter which writes its output to the
+// given io.Writer.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{writer: w}
+}
+
+func (sw *StreamWriter) Write(bs []byte) (int, error) {
+	return sw.writer.Write(bs)
+}
+
+func (sw *StreamWriter) flush() error {
+	_, err := sw.writer.Write(sw.buffer)
+	sw.buffer = sw.buffer[:0]
+	return err
+}
+
+// separate appends the separator needed before the next value, and reports
+// whether that value is the key of an object.
+func (sw *StreamWriter) separate() (key bool) {
+	if len(sw.stack) == 0 {
+		return false
+	}
+
+	c := &sw.stack[len(sw.stack)-1]
+	switch {
+	case c.n == 0:
+	case c.object && c.n%2 == 1:
+		sw.buffer = append(sw.buffer, ':')
+	default:
+		sw.buffer = append(sw.buffer, ',')
+	}
+	key = c.object && c.n%2 == 0
+	c.n++
+	return key
+}
+
+func (sw *StreamWriter) begin(delim byte, object bool) error {
+	if sw.separate() {
+		return encodeErrorf("TJSONProtocol map keys must be strings, numbers, or booleans")
+	}
+	sw.buffer = append(sw.buffer, delim)
+	sw.stack = append(sw.stack, context{object: object})
+	return nil
+}
+
+func (sw *StreamWriter) end(delim byte, object bool) error {
+	if len(sw.stack) == 0 || sw.stack[len(sw.stack)-1].object != object {
+		return encodeErrorf("unexpected %q: no matching opening delimiter", delim)
+	}
+	sw.stack = sw.stack[:len(sw.stack)-1]
+	sw.buffer = append(sw.buffer, delim)
+	return sw.flush()
+}
+
+// writeNumber writes the given number, quoting it if it is the key of an
+// object.
+func (sw *StreamWriter) writeNumber(s string) error {
+	if sw.separate() {
+		sw.buffer = append(sw.buffer, '"')
+		sw.buffer = append(sw.buffer, s...)
+		sw.buffer = append(sw.buffer, '"')
+	} else {
+		sw.buffer = append(sw.buffer, s...)
+	}
+	return sw.flush()
+}
+
+func (sw *StreamWriter) writeString(s string) error {
+	sw.separate()
+	sw.buffer = appendQuoted(sw.buffer, s)
+	return sw.flush()
+}
+
+// WriteBool encodes a boolean as 1 or 0.
+func (sw *StreamWriter) WriteBool(b bool) error {
+	if b {
+		return sw.writeNumber("1")
+	}
+	return sw.writeNumber("0")
+}
+
+// WriteInt8 encodes an int8
+func (sw *StreamWriter) WriteInt8(i int8) error {
+	return sw.writeNumber(strconv.FormatInt(int64(i), 10))
+}
+
+// WriteInt16 encodes an int16
+func (sw *StreamWriter) WriteInt16(i int16) error {
+	return sw.writeNumber(strconv.FormatInt(int64(i), 10))
+}
+
+// WriteInt32 encodes an int32
+func (sw *StreamWriter) WriteInt32(i int32) error {
+	return sw.writeNumber(strconv.FormatInt(int64(i), 10))
+}
+
+// WriteInt64 encodes an int64
+func (sw *StreamWriter) WriteInt64(i int64) error {
+	return sw.writeNumber(strconv.FormatInt(i, 10))
+}
+
+// WriteString encodes a string
+func (sw *StreamWriter) WriteString(s string) error {
+	return sw.writeString(s)
+}
+
+// WriteDouble encodes a double. NaN and infinities, which JSON numbers
+// cannot represent, are written as the strings "NaN", "Infinity", and
+// "-Infinity".
+func (sw *StreamWriter) WriteDouble(d float64) error {
+	switch {
+	case math.IsNaN(d):
+		return sw.writeString("NaN")
+	case math.IsInf(d, 1):
+		return sw.writeString("Infinity")
+	case math.IsInf(d, -1):
+		return sw.writeString("-Infinity")
+	default:
+		return sw.writeNumber(strconv.FormatFloat(d, 'g', -1, 64))
+	}
+}
+
+// WriteBinary encodes binary data in base64.
+func (sw *StreamWriter) WriteBinary(b []byte) error {
+	sw.separate()
+	sw.buffer = append(sw.buffer, '"')
+	sw.buffer = append(sw.buffer, base64.StdEncoding.EncodeToString(b)...)
+	sw.buffer = append(sw.buffer, '"')
+	return sw.flush()
+}
+
+// WriteStructBegin begins a struct.
+func (sw *StreamWriter) WriteStructBegin() error {
+	return sw.begin('{', true)
+}
+
+// WriteStructEnd ends a struct.
+func (sw *StreamWriter) WriteStructEnd() error {
+	return sw.end('}', true)
+}
+
+// WriteFieldBegin begins a field, keyed by its identifier and holding an
+// object which maps its type to its value.
+func (sw *StreamWriter) WriteFieldBegin(f stream.FieldHeader) error {
+	name, err := typeName(f.Type)
+	if err != nil {
+		return err
+	}
+	if err := sw.writeString(strconv.Itoa(int(f.ID))); err != nil {
+		return err
+	}
+	if err := sw.begin('{', true); err != nil {
+		return err
+	}
+	return sw.writeString(name)
+}
+
+// WriteFieldEnd ends a field.
+func (sw *StreamWriter) WriteFieldEnd() error {
+	return sw.end('}', true)
+}
+
+// WriteMapBegin begins a map as an array holding the key type, the value
+// type, the length, and an object with the items.
+func (sw *StreamWriter) WriteMapBegin(m stream.MapHeader) error {
+	kname, err := typeName(m.KeyType)
+	if err != nil {
+		return err
+	}
+	vname, err := typeName(m.ValueType)
+	if err != nil {
+		return err
+	}
+
+	if err := sw.begin('[', false); err != nil {
+		return err
+	}
+	if err := sw.writeString(kname); err != nil {
+		return err
+	}
+	if err := sw.writeString(vname); err != nil {
+		return err
+	}
+	if err := sw.writeNumber(strconv.Itoa(m.Length)); err != nil {
+		return err
+	}
+	return sw.begin('{', true)
+}
+
+// WriteMapEnd ends a map.
+func (sw *StreamWriter) WriteMapEnd() error {
+	if err := sw.end('}', true); err != nil {
+		return err
+	}
+	return sw.end(']', false)
+}
+
+// WriteSetBegin begins a set as an array holding the element type, the
+// length, and the elements.
+func (sw *StreamWriter) WriteSetBegin(s stream.SetHeader) error {
+	return sw.writeCollectionBegin(s.Type, s.Length)
+}
+
+// WriteSetEnd ends a set.
+func (sw *StreamWriter) WriteSetEnd() error {
+	return sw.end(']', false)
+}
+
+// WriteListBegin begins a list as an array holding the element type, the
+// length, and the elements.
+func (sw *StreamWriter) WriteListBegin(l stream.ListHeader) error {
+	return sw.writeCollectionBegin(l.Type, l.Length)
+}
+
+// WriteListEnd ends a list.
+func (sw *StreamWriter) WriteListEnd() error {
+	return sw.end(']', false)
+}
+
+func (sw *StreamWriter) writeCollectionBegin(t wire.Type, length int) error {
+	name, err := typeName(t)
+	if err != nil {
+		return err
+	}
+	if err := sw.begin('[', false); err != nil {
+		return err
+	}
+	if err := sw.writeString(name); err != nil {
+		return err
+	}
+	return sw.writeNumber(strconv.Itoa(length))
+}
+
+// WriteEnvelopeBegin begins an envelope as an array holding the protocol
+// version, the method name, the message type, the sequence ID, and the
+// message.
+func (sw *StreamWriter) WriteEnvelopeBegin(eh stream.EnvelopeHeader) error {
+	if err := sw.begin('[', false); err != nil {
+		return err
+	}
+	if err := sw.writeNumber(strconv.Itoa(version)); err != nil {
+		return err
+	}
+	if err := sw.writeString(eh.Name); err != nil {
+		return err
+	}
+	if err := sw.writeNumber(strconv.Itoa(int(eh.Type))); err != nil {
+		return err
+	}
+	return sw.writeNumber(strconv.FormatInt(int64(eh.SeqID), 10))
+}
+
+// WriteEnvelopeEnd ends an envelope.
+func (sw *StreamWriter) WriteEnvelopeEnd() error {
+	return sw.end(']', false)
+}
+
+// Close checks that every value begun was also ended.
+func (sw *StreamWriter) Close() error {
+	if len(sw.stack) > 0 {
+		return encodeErrorf("incomplete TJSONProtocol output: %d values were not ended", len(sw.stack))
+	}
+	return nil
+}
+
+// appendQuoted appends s to b as a JSON string. Invalid UTF-8 is replaced
+// with U+FFFD.
+func appendQuoted(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			default:
+				if c < 0x20 {
+					b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+				} else {
+					b = append(b, c)
+				}
+			}
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, "\ufffd"...)
+		} else {
+			b = append(b, s[i:i+size]...)
+		}
+		i += size
+	}
+	return append(b, '"')
+}
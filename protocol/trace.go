@@ -0,0 +1,231 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Names of spans started by traced protocols.
+const (
+	SpanEncode        = "thriftrw.encode"
+	SpanDecode        = "thriftrw.decode"
+	SpanEnvelopeWrite = "thriftrw.envelope.write"
+	SpanEnvelopeRead  = "thriftrw.envelope.read"
+	SpanStreamEncode  = "thriftrw.stream.encode"
+	SpanStreamDecode  = "thriftrw.stream.decode"
+)
+
+// Attributes set on spans started by traced protocols.
+const (
+	// AttrProtocol is the name of the protocol, as provided to WithTracer
+	// or WithStreamTracer.
+	AttrProtocol = "thrift.protocol"
+
+	// AttrMethod is the name of the enveloped method. It is set only for
+	// enveloped values.
+	AttrMethod = "thrift.method"
+
+	// AttrEnvelopeType is the wire.EnvelopeType of enveloped values.
+	AttrEnvelopeType = "thrift.envelope_type"
+
+	// AttrPayloadSize is the number of bytes written or read.
+	AttrPayloadSize = "thrift.payload_size"
+)
+
+// Tracer starts spans around serialization performed by a traced protocol.
+//
+// Tracer may be implemented on top of OpenTelemetry or any other tracing
+// library. Protocol methods do not accept a context, so to parent the spans
+// to a request, build a traced protocol per request with a Tracer bound to
+// that request's context.
+type Tracer interface {
+	StartSpan(name string) Span
+}
+
+// Span is a single operation started by a Tracer.
+type Span interface {
+	// SetAttribute records an attribute on the span. Values are strings,
+	// int64s, or int32s.
+	SetAttribute(key string, value interface{})
+
+	// RecordError marks the span as failed with the given error.
+	RecordError(err error)
+
+	// End completes the span.
+	End()
+}
+
+// WithTracer returns a Protocol which starts a span around every encode and
+// decode performed by p. protocolName is recorded on every span.
+//
+// The returned Protocol implements only the Protocol interface. See
+// WithEncodeMiddleware.
+func WithTracer(p Protocol, protocolName string, t Tracer) Protocol {
+	mw := tracingMiddleware{name: protocolName, t: t}
+	return WithDecodeMiddleware(WithEncodeMiddleware(p, mw), mw)
+}
+
+// WithStreamTracer returns a stream.Protocol which starts a span for every
+// Writer and Reader built by p. The span ends when the Writer or Reader is
+// closed. protocolName is recorded on every span.
+func WithStreamTracer(p stream.Protocol, protocolName string, t Tracer) stream.Protocol {
+	mw := tracingMiddleware{name: protocolName, t: t}
+	return stream.WithDecodeMiddleware(stream.WithEncodeMiddleware(p, mw), mw)
+}
+
+type tracingMiddleware struct {
+	name string
+	t    Tracer
+}
+
+var (
+	_ EncodeMiddleware        = tracingMiddleware{}
+	_ DecodeMiddleware        = tracingMiddleware{}
+	_ stream.EncodeMiddleware = tracingMiddleware{}
+	_ stream.DecodeMiddleware = tracingMiddleware{}
+)
+
+func (m tracingMiddleware) start(name string) Span {
+	span := m.t.StartSpan(name)
+	span.SetAttribute(AttrProtocol, m.name)
+	return span
+}
+
+func endSpan(span Span, size int64, err error) {
+	span.SetAttribute(AttrPayloadSize, size)
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+func setEnvelopeAttributes(span Span, name string, t wire.EnvelopeType) {
+	span.SetAttribute(AttrMethod, name)
+	span.SetAttribute(AttrEnvelopeType, t.String())
+}
+
+func (m tracingMiddleware) Encode(v wire.Value, w io.Writer, next Protocol) error {
+	span := m.start(SpanEncode)
+	cw := countingWriter{w: w}
+	err := next.Encode(v, &cw)
+	endSpan(span, cw.n, err)
+	return err
+}
+
+func (m tracingMiddleware) EncodeEnveloped(e wire.Envelope, w io.Writer, next Protocol) error {
+	span := m.start(SpanEnvelopeWrite)
+	setEnvelopeAttributes(span, e.Name, e.Type)
+	cw := countingWriter{w: w}
+	err := next.EncodeEnveloped(e, &cw)
+	endSpan(span, cw.n, err)
+	return err
+}
+
+func (m tracingMiddleware) Decode(r io.ReaderAt, t wire.Type, next Protocol) (wire.Value, error) {
+	span := m.start(SpanDecode)
+	cr := countingReaderAt{r: r}
+	v, err := next.Decode(&cr, t)
+	endSpan(span, cr.max, err)
+	return v, err
+}
+
+func (m tracingMiddleware) DecodeEnveloped(r io.ReaderAt, next Protocol) (wire.Envelope, error) {
+	span := m.start(SpanEnvelopeRead)
+	cr := countingReaderAt{r: r}
+	e, err := next.DecodeEnveloped(&cr)
+	if err == nil {
+		setEnvelopeAttributes(span, e.Name, e.Type)
+	}
+	endSpan(span, cr.max, err)
+	return e, err
+}
+
+func (m tracingMiddleware) Writer(w io.Writer, next stream.Protocol) stream.Writer {
+	cw := &countingWriter{w: w}
+	return &tracingWriter{
+		Writer: next.Writer(cw),
+		span:   m.start(SpanStreamEncode),
+		cw:     cw,
+	}
+}
+
+func (m tracingMiddleware) Reader(r io.Reader, next stream.Protocol) stream.Reader {
+	cr := &countingReader{r: r}
+	return &tracingReader{
+		Reader: next.Reader(cr),
+		span:   m.start(SpanStreamDecode),
+		cr:     cr,
+	}
+}
+
+type tracingWriter struct {
+	stream.Writer
+
+	span Span
+	cw   *countingWriter
+}
+
+func (w *tracingWriter) WriteEnvelopeBegin(eh stream.EnvelopeHeader) error {
+	setEnvelopeAttributes(w.span, eh.Name, eh.Type)
+	return w.Writer.WriteEnvelopeBegin(eh)
+}
+
+func (w *tracingWriter) Close() error {
+	err := w.Writer.Close()
+	endSpan(w.span, w.cw.n, err)
+	return err
+}
+
+type tracingReader struct {
+	stream.Reader
+
+	span Span
+	cr   *countingReader
+}
+
+func (r *tracingReader) ReadEnvelopeBegin() (stream.EnvelopeHeader, error) {
+	eh, err := r.Reader.ReadEnvelopeBegin()
+	if err == nil {
+		setEnvelopeAttributes(r.span, eh.Name, eh.Type)
+	}
+	return eh, err
+}
+
+func (r *tracingReader) Close() error {
+	err := r.Reader.Close()
+	endSpan(r.span, r.cr.n, err)
+	return err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(b []byte) (int, error) {
+	n, err := cr.r.Read(b)
+	cr.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+type fakeSpan struct {
+	Name  string
+	Attrs map[string]interface{}
+	Err   error
+	Ended bool
+}
+
+func (s *fakeSpan) SetAttribute(k string, v interface{}) { s.Attrs[k] = v }
+func (s *fakeSpan) RecordError(err error)                { s.Err = err }
+func (s *fakeSpan) End()                                 { s.Ended = true }
+
+type fakeTracer struct{ spans []*fakeSpan }
+
+func (t *fakeTracer) StartSpan(name string) Span {
+	s := &fakeSpan{Name: name, Attrs: make(map[string]interface{})}
+	t.spans = append(t.spans, s)
+	return s
+}
+
+func TestWithTracer(t *testing.T) {
+	var tracer fakeTracer
+	p := WithTracer(binary.Default, "binary", &tracer)
+
+	val := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueI32(42)},
+	}})
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Encode(val, &buf))
+	size := int64(buf.Len())
+	_, err := p.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+
+	buf.Reset()
+	require.NoError(t, p.EncodeEnveloped(wire.Envelope{Name: "foo", Type: wire.Call, Value: val}, &buf))
+	envSize := int64(buf.Len())
+	_, err = p.DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	_, err = p.DecodeEnveloped(bytes.NewReader([]byte{0x80}))
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 5)
+	assert.Equal(t, &fakeSpan{
+		Name:  SpanEncode,
+		Attrs: map[string]interface{}{AttrProtocol: "binary", AttrPayloadSize: size},
+		Ended: true,
+	}, tracer.spans[0])
+	assert.Equal(t, &fakeSpan{
+		Name:  SpanDecode,
+		Attrs: map[string]interface{}{AttrProtocol: "binary", AttrPayloadSize: size},
+		Ended: true,
+	}, tracer.spans[1])
+
+	envAttrs := map[string]interface{}{
+		AttrProtocol:     "binary",
+		AttrPayloadSize:  envSize,
+		AttrMethod:       "foo",
+		AttrEnvelopeType: "Call",
+	}
+	assert.Equal(t, &fakeSpan{Name: SpanEnvelopeWrite, Attrs: envAttrs, Ended: true}, tracer.spans[2])
+	assert.Equal(t, &fakeSpan{Name: SpanEnvelopeRead, Attrs: envAttrs, Ended: true}, tracer.spans[3])
+
+	failed := tracer.spans[4]
+	assert.Equal(t, SpanEnvelopeRead, failed.Name)
+	assert.Error(t, failed.Err)
+	assert.NotContains(t, failed.Attrs, AttrMethod)
+	assert.True(t, failed.Ended)
+}
+
+func TestWithStreamTracer(t *testing.T) {
+	var tracer fakeTracer
+	p := WithStreamTracer(binary.Default, "binary", &tracer)
+
+	var buf bytes.Buffer
+	w := p.Writer(&buf)
+	require.NoError(t, w.WriteEnvelopeBegin(stream.EnvelopeHeader{Name: "foo", Type: wire.Reply}))
+	require.NoError(t, w.WriteStructBegin())
+	require.NoError(t, w.WriteStructEnd())
+	require.NoError(t, w.WriteEnvelopeEnd())
+	require.NoError(t, w.Close())
+
+	r := p.Reader(bytes.NewReader(buf.Bytes()))
+	_, err := r.ReadEnvelopeBegin()
+	require.NoError(t, err)
+	require.NoError(t, r.Skip(wire.TStruct))
+	require.NoError(t, r.ReadEnvelopeEnd())
+	require.NoError(t, r.Close())
+
+	attrs := map[string]interface{}{
+		AttrProtocol:     "binary",
+		AttrPayloadSize:  int64(buf.Len()),
+		AttrMethod:       "foo",
+		AttrEnvelopeType: "Reply",
+	}
+	require.Len(t, tracer.spans, 2)
+	assert.Equal(t, &fakeSpan{Name: SpanStreamEncode, Attrs: attrs, Ended: true}, tracer.spans[0])
+	assert.Equal(t, &fakeSpan{Name: SpanStreamDecode, Attrs: attrs, Ended: true}, tracer.spans[1])
+}
@@ -0,0 +1,420 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// ValidateOption customizes the checks made by Validate.
+type ValidateOption func(validateLimits) validateLimits
+
+// validateLimits holds the limits enforced by Validate. Options operate on
+// it by value so that Validate does not allocate.
+type validateLimits struct {
+	maxDepth  int
+	maxLength int
+}
+
+// MaxDepth limits how deeply structs and collections may be nested in a
+// payload. The top-level value is at depth 1.
+func MaxDepth(n int) ValidateOption {
+	return func(l validateLimits) validateLimits {
+		l.maxDepth = n
+		return l
+	}
+}
+
+// MaxLength limits the length of strings and binary values, and the number
+// of items in lists, sets, and maps.
+func MaxLength(n int) ValidateOption {
+	return func(l validateLimits) validateLimits {
+		l.maxLength = n
+		return l
+	}
+}
+
+// ValidationError is returned by Validate if a payload does not hold a valid
+// value of the expected type.
+type ValidationError struct {
+	// Path to the invalid value from the top-level value, like
+	// "items[2].key". Empty if the top-level value is invalid.
+	Path string
+
+	// Offset of the invalid value in the payload.
+	Offset int
+
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("invalid payload at offset %d: %s", e.Offset, e.Reason)
+	}
+	return fmt.Sprintf("invalid payload at offset %d: %s: %s", e.Offset, e.Path, e.Reason)
+}
+
+// Validate checks that b holds exactly one value of the given type encoded
+// with the Thrift Binary protocol, without decoding it into Go values.
+//
+// Validate verifies that the payload is well-formed, that known struct
+// fields, list items, and map entries have the types specified by the spec,
+// that required fields are present, that unions have exactly one field set,
+// and that the payload stays within the limits given as options. Unknown
+// fields are checked only for well-formedness.
+//
+// This is meant for cheap admission checks, for example, before enqueueing
+// a payload for processing later. A nil error does not guarantee that the
+// payload will decode successfully: generated code may impose additional
+// constraints.
+//
+// Errors are of type *ValidationError.
+func Validate(b []byte, spec compile.TypeSpec, opts ...ValidateOption) error {
+	v := validator{b: b}
+	for _, opt := range opts {
+		v.validateLimits = opt(v.validateLimits)
+	}
+
+	if err := v.value(compile.RootTypeSpec(spec).TypeCode(), spec, 1); err != nil {
+		return err
+	}
+	if v.off != len(b) {
+		return v.errorf("unexpected %d bytes after value", len(b)-v.off)
+	}
+	return nil
+}
+
+// validator walks a Binary-encoded payload.
+type validator struct {
+	validateLimits
+
+	b   []byte
+	off int
+}
+
+func (v *validator) errorf(format string, args ...interface{}) error {
+	return &ValidationError{Offset: v.off, Reason: fmt.Sprintf(format, args...)}
+}
+
+// withPath prefixes the path of the given ValidationError with the given
+// segment.
+func withPath(err error, segment string) error {
+	e, ok := err.(*ValidationError)
+	if !ok {
+		return err
+	}
+	switch {
+	case e.Path == "":
+		e.Path = segment
+	case strings.HasPrefix(e.Path, "["):
+		e.Path = segment + e.Path
+	default:
+		e.Path = segment + "." + e.Path
+	}
+	return e
+}
+
+// checkType verifies that the type header at the given offset matches the
+// given spec, if any.
+func (v *validator) checkType(off int, spec compile.TypeSpec, got wire.Type) error {
+	if spec == nil {
+		return nil
+	}
+	if want := compile.RootTypeSpec(spec).TypeCode(); got != want {
+		return &ValidationError{Offset: off, Reason: fmt.Sprintf("expected %v, got %v", want, got)}
+	}
+	return nil
+}
+
+// next consumes n bytes from the payload.
+func (v *validator) next(n int) ([]byte, error) {
+	if n > len(v.b)-v.off {
+		return nil, v.errorf("unexpected end of payload")
+	}
+	bs := v.b[v.off : v.off+n]
+	v.off += n
+	return bs, nil
+}
+
+func (v *validator) readType() (wire.Type, error) {
+	bs, err := v.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return wire.Type(bs[0]), nil
+}
+
+func (v *validator) readLength() (int, error) {
+	bs, err := v.next(4)
+	if err != nil {
+		return 0, err
+	}
+
+	n := int32(binary.BigEndian.Uint32(bs))
+	switch {
+	case n < 0:
+		v.off -= 4
+		return 0, v.errorf("negative length %d", n)
+	case v.maxLength > 0 && int(n) > v.maxLength:
+		v.off -= 4
+		return 0, v.errorf("length %d exceeds limit %d", n, v.maxLength)
+	}
+	return int(n), nil
+}
+
+// value validates a value of type t. spec may be nil for unknown values, in
+// which case only well-formedness is checked. The caller must have verified
+// that t matches spec.
+func (v *validator) value(t wire.Type, spec compile.TypeSpec, depth int) error {
+	if spec != nil {
+		spec = compile.RootTypeSpec(spec)
+	}
+
+	switch t {
+	case wire.TBool:
+		bs, err := v.next(1)
+		if err != nil {
+			return err
+		}
+		if bs[0] > 1 {
+			v.off--
+			return v.errorf("invalid bool value %d", bs[0])
+		}
+		return nil
+	case wire.TI8:
+		_, err := v.next(1)
+		return err
+	case wire.TI16:
+		_, err := v.next(2)
+		return err
+	case wire.TI32:
+		_, err := v.next(4)
+		return err
+	case wire.TI64, wire.TDouble:
+		_, err := v.next(8)
+		return err
+	case wire.TBinary:
+		n, err := v.readLength()
+		if err != nil {
+			return err
+		}
+		_, err = v.next(n)
+		return err
+	}
+
+	if v.maxDepth > 0 && depth > v.maxDepth {
+		return v.errorf("nesting depth exceeds limit %d", v.maxDepth)
+	}
+
+	switch t {
+	case wire.TStruct:
+		s, _ := spec.(*compile.StructSpec)
+		return v.structure(s, depth)
+	case wire.TList, wire.TSet:
+		var itemSpec compile.TypeSpec
+		switch s := spec.(type) {
+		case *compile.ListSpec:
+			itemSpec = s.ValueSpec
+		case *compile.SetSpec:
+			itemSpec = s.ValueSpec
+		}
+		return v.list(itemSpec, depth)
+	case wire.TMap:
+		s, _ := spec.(*compile.MapSpec)
+		return v.mapping(s, depth)
+	default:
+		return v.errorf("unknown type %v", t)
+	}
+}
+
+func (v *validator) structure(spec *compile.StructSpec, depth int) error {
+	var fields compile.FieldGroup
+	if spec != nil {
+		fields = spec.Fields
+	}
+
+	// Track which known fields were seen, by their index in fields, without
+	// allocating for structs of up to 64 fields.
+	var (
+		seenSmall uint64
+		seenLarge []bool
+	)
+	if len(fields) > 64 {
+		seenLarge = make([]bool, len(fields))
+	}
+
+	set := 0
+	for {
+		start := v.off
+		t, err := v.readType()
+		if err != nil {
+			return err
+		}
+		if t == 0 { // stop
+			break
+		}
+
+		bs, err := v.next(2)
+		if err != nil {
+			return err
+		}
+		id := int16(binary.BigEndian.Uint16(bs))
+
+		idx := -1
+		for i, f := range fields {
+			if f.ID == id {
+				idx = i
+				break
+			}
+		}
+
+		if idx < 0 {
+			if err := v.value(t, nil, depth+1); err != nil {
+				return withPath(err, "#"+strconv.Itoa(int(id)))
+			}
+			continue
+		}
+
+		f := fields[idx]
+		if err := v.checkType(start, f.Type, t); err != nil {
+			return withPath(err, f.Name)
+		}
+		if err := v.value(t, f.Type, depth+1); err != nil {
+			return withPath(err, f.Name)
+		}
+
+		if seenLarge != nil {
+			if !seenLarge[idx] {
+				set++
+			}
+			seenLarge[idx] = true
+		} else {
+			if seenSmall&(1<<uint(idx)) == 0 {
+				set++
+			}
+			seenSmall |= 1 << uint(idx)
+		}
+	}
+
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Type == ast.UnionType && set != 1 {
+		return v.errorf("%v should have exactly one field: got %v fields", spec.Name, set)
+	}
+
+	for i, f := range fields {
+		if !f.Required {
+			continue
+		}
+		if seenLarge != nil && seenLarge[i] || seenLarge == nil && seenSmall&(1<<uint(i)) != 0 {
+			continue
+		}
+		return v.errorf("required field %q of %v is missing", f.Name, spec.Name)
+	}
+	return nil
+}
+
+func (v *validator) list(itemSpec compile.TypeSpec, depth int) error {
+	start := v.off
+	t, err := v.readType()
+	if err != nil {
+		return err
+	}
+	n, err := v.readLength()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		// Writers may leave the item type of empty collections unset.
+		return nil
+	}
+	if err := v.checkType(start, itemSpec, t); err != nil {
+		return err
+	}
+
+	// Every item takes at least one byte. Reject lengths that cannot fit
+	// before walking them.
+	if n > len(v.b)-v.off {
+		return v.errorf("unexpected end of payload")
+	}
+
+	for i := 0; i < n; i++ {
+		if err := v.value(t, itemSpec, depth+1); err != nil {
+			return withPath(err, "["+strconv.Itoa(i)+"]")
+		}
+	}
+	return nil
+}
+
+func (v *validator) mapping(spec *compile.MapSpec, depth int) error {
+	start := v.off
+	kt, err := v.readType()
+	if err != nil {
+		return err
+	}
+	vt, err := v.readType()
+	if err != nil {
+		return err
+	}
+	n, err := v.readLength()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return nil
+	}
+	if n > (len(v.b)-v.off)/2 {
+		return v.errorf("unexpected end of payload")
+	}
+
+	var keySpec, valueSpec compile.TypeSpec
+	if spec != nil {
+		keySpec, valueSpec = spec.KeySpec, spec.ValueSpec
+	}
+	if err := v.checkType(start, keySpec, kt); err != nil {
+		return withPath(err, "[key]")
+	}
+	if err := v.checkType(start+1, valueSpec, vt); err != nil {
+		return withPath(err, "[value]")
+	}
+
+	for i := 0; i < n; i++ {
+		if err := v.value(kt, keySpec, depth+1); err != nil {
+			return withPath(err, "[key "+strconv.Itoa(i)+"]")
+		}
+		if err := v.value(vt, valueSpec, depth+1); err != nil {
+			return withPath(err, "[value "+strconv.Itoa(i)+"]")
+		}
+	}
+	return nil
+}
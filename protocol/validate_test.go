@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/thriftreflect"
+	"go.uber.org/thriftrw/wire"
+)
+
+const _validateIDL = `
+	enum Color { RED, GREEN }
+
+	typedef string Key
+
+	struct Item {
+		1: required Key key
+		2: optional binary value
+		3: optional list<Item> children
+		4: optional map<string, Color> colors
+		5: optional set<i64> tags
+		6: optional bool enabled
+	}
+
+	union Value {
+		1: string str
+		2: i32 num
+	}
+`
+
+func compileValidateIDL(t *testing.T) *compile.Module {
+	m, err := compile.CompileEmbedded(&thriftreflect.ThriftModule{
+		Name:     "validate",
+		FilePath: "validate.thrift",
+		Raw:      _validateIDL,
+	})
+	require.NoError(t, err)
+	return m
+}
+
+func encodeValue(t testing.TB, v wire.Value) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(v, &buf))
+	return buf.Bytes()
+}
+
+func TestValidate(t *testing.T) {
+	m := compileValidateIDL(t)
+	item := m.Types["Item"]
+	value := m.Types["Value"]
+
+	validItem := vstruct(
+		vfield(1, wire.NewValueString("foo")),
+		vfield(2, wire.NewValueBinary([]byte("bar"))),
+		vfield(3, wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			vstruct(vfield(1, wire.NewValueString("child"))),
+		}))),
+		vfield(4, wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{
+			{Key: wire.NewValueString("a"), Value: wire.NewValueI32(1)},
+		}))),
+		vfield(5, wire.NewValueSet(wire.ValueListFromSlice(wire.TI64, []wire.Value{wire.NewValueI64(42)}))),
+		vfield(6, wire.NewValueBool(true)),
+		vfield(99, wire.NewValueList(wire.ValueListFromSlice(wire.TI16, []wire.Value{wire.NewValueI16(1)}))),
+	)
+
+	tests := []struct {
+		desc    string
+		spec    compile.TypeSpec
+		give    []byte
+		opts    []ValidateOption
+		wantErr string
+	}{
+		{
+			desc: "valid struct",
+			spec: item,
+			give: encodeValue(t, validItem),
+		},
+		{
+			desc: "valid union",
+			spec: value,
+			give: encodeValue(t, vstruct(vfield(2, wire.NewValueI32(1)))),
+		},
+		{
+			desc: "empty collections of any type",
+			spec: item,
+			give: []byte{
+				0x0b, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, // key = ""
+				0x0f, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, // children = []
+				0x00,
+			},
+		},
+		{
+			desc:    "missing required field",
+			spec:    item,
+			give:    encodeValue(t, vstruct(vfield(2, wire.NewValueBinary(nil)))),
+			wantErr: `invalid payload at offset 8: required field "key" of Item is missing`,
+		},
+		{
+			desc: "missing required field in nested struct",
+			spec: item,
+			give: encodeValue(t, vstruct(
+				vfield(1, wire.NewValueString("foo")),
+				vfield(3, wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					vstruct(vfield(1, wire.NewValueString("a"))),
+					vstruct(),
+				}))),
+			)),
+			wantErr: `invalid payload at offset 28: children[1]: required field "key" of Item is missing`,
+		},
+		{
+			desc:    "field type mismatch",
+			spec:    item,
+			give:    encodeValue(t, vstruct(vfield(1, wire.NewValueI32(1)))),
+			wantErr: "invalid payload at offset 0: key: expected TBinary, got TI32",
+		},
+		{
+			desc: "map value type mismatch",
+			spec: item,
+			give: encodeValue(t, vstruct(
+				vfield(1, wire.NewValueString("foo")),
+				vfield(4, wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI64, []wire.MapItem{
+					{Key: wire.NewValueString("a"), Value: wire.NewValueI64(1)},
+				}))),
+			)),
+			wantErr: "invalid payload at offset 14: colors[value]: expected TI32, got TI64",
+		},
+		{
+			desc:    "union with no fields",
+			spec:    value,
+			give:    []byte{0x00},
+			wantErr: "invalid payload at offset 1: Value should have exactly one field: got 0 fields",
+		},
+		{
+			desc: "union with two fields",
+			spec: value,
+			give: encodeValue(t, vstruct(
+				vfield(1, wire.NewValueString("a")),
+				vfield(2, wire.NewValueI32(1)),
+			)),
+			wantErr: "invalid payload at offset 16: Value should have exactly one field: got 2 fields",
+		},
+		{
+			desc:    "invalid bool",
+			spec:    item,
+			give:    []byte{0x02, 0x00, 0x06, 0x02, 0x00},
+			wantErr: "invalid payload at offset 3: enabled: invalid bool value 2",
+		},
+		{
+			desc:    "truncated",
+			spec:    item,
+			give:    encodeValue(t, validItem)[:18],
+			wantErr: "invalid payload at offset 17: value: unexpected end of payload",
+		},
+		{
+			desc:    "trailing bytes",
+			spec:    value,
+			give:    append(encodeValue(t, vstruct(vfield(2, wire.NewValueI32(1)))), 0x00),
+			wantErr: "invalid payload at offset 8: unexpected 1 bytes after value",
+		},
+		{
+			desc:    "unknown type in unknown field",
+			spec:    item,
+			give:    []byte{0x42, 0x00, 0x10, 0x00},
+			wantErr: "invalid payload at offset 3: #16: unknown type Type(66)",
+		},
+		{
+			desc:    "length beyond payload",
+			spec:    item,
+			give:    []byte{0x0f, 0x00, 0x03, 0x0c, 0x7f, 0xff, 0xff, 0xff, 0x00},
+			wantErr: "invalid payload at offset 8: children: unexpected end of payload",
+		},
+		{
+			desc:    "negative length",
+			spec:    item,
+			give:    []byte{0x0b, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0x00},
+			wantErr: "invalid payload at offset 3: key: negative length -1",
+		},
+		{
+			desc:    "max length",
+			spec:    item,
+			give:    encodeValue(t, validItem),
+			opts:    []ValidateOption{MaxLength(2)},
+			wantErr: "invalid payload at offset 3: key: length 3 exceeds limit 2",
+		},
+		{
+			desc:    "max depth",
+			spec:    item,
+			give:    encodeValue(t, validItem),
+			opts:    []ValidateOption{MaxDepth(2)},
+			wantErr: "invalid payload at offset 28: children[0]: nesting depth exceeds limit 2",
+		},
+		{
+			desc: "max depth allows shallow payloads",
+			spec: item,
+			give: encodeValue(t, vstruct(vfield(1, wire.NewValueString("foo")))),
+			opts: []ValidateOption{MaxDepth(1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := Validate(tt.give, tt.spec, tt.opts...)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.IsType(t, &ValidationError{}, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
+
+func TestValidateDoesNotAllocate(t *testing.T) {
+	m := compileValidateIDL(t)
+	spec := m.Types["Item"]
+	payload := encodeValue(t, vstruct(
+		vfield(1, wire.NewValueString("foo")),
+		vfield(3, wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			vstruct(vfield(1, wire.NewValueString("child"))),
+		}))),
+	))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := Validate(payload, spec, MaxDepth(8), MaxLength(64)); err != nil {
+			t.Fatal(err)
+		}
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proxy
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Call is a single call received by a Proxy.
+type Call struct {
+	// Name of the service which defines the function. This differs from the
+	// proxied service if the function was inherited.
+	Service string
+
+	// Name of the method as it appeared in the request envelope.
+	Method string
+
+	// Sequence ID of the request.
+	SeqID int32
+
+	// Function being called.
+	Function *compile.FunctionSpec
+
+	// Arguments struct of the call. This has been checked against the
+	// arguments of Function.
+	Args wire.Value
+}
+
+// Arg returns the value of the argument with the given name, and whether it
+// was set.
+func (c *Call) Arg(name string) (wire.Value, bool) {
+	spec, err := compile.FieldGroup(c.Function.ArgsSpec).FindByName(name)
+	if err != nil {
+		return wire.Value{}, false
+	}
+
+	for _, f := range c.Args.GetStruct().Fields {
+		if f.ID == spec.ID {
+			return f.Value, true
+		}
+	}
+	return wire.Value{}, false
+}
+
+// SetArg changes the value of the argument with the given name.
+//
+// An error is returned if the function does not accept an argument with
+// that name or if the value does not match its type.
+func (c *Call) SetArg(name string, v wire.Value) error {
+	spec, err := compile.FieldGroup(c.Function.ArgsSpec).FindByName(name)
+	if err != nil {
+		return fmt.Errorf("proxy: %q does not accept argument %q", c.Method, name)
+	}
+
+	if err := checkValue(spec.Type, v); err != nil {
+		return fmt.Errorf("proxy: invalid value for argument %q of %q: %v", name, c.Method, err)
+	}
+
+	old := c.Args.GetStruct().Fields
+	fields := make([]wire.Field, 0, len(old)+1)
+	for _, f := range old {
+		if f.ID != spec.ID {
+			fields = append(fields, f)
+		}
+	}
+	fields = append(fields, wire.Field{ID: spec.ID, Value: v})
+
+	c.Args = wire.NewValueStruct(wire.Struct{Fields: fields})
+	return nil
+}
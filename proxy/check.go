@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proxy
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// checkValue verifies that the given value matches the given type.
+func checkValue(spec compile.TypeSpec, v wire.Value) error {
+	spec = compile.RootTypeSpec(spec)
+	if want := spec.TypeCode(); v.Type() != want {
+		return fmt.Errorf("expected %v, got %v", want, v.Type())
+	}
+
+	switch s := spec.(type) {
+	case *compile.StructSpec:
+		return checkStruct(s.Fields, v)
+
+	case *compile.ListSpec:
+		return checkItems(s.ValueSpec, v.GetList())
+
+	case *compile.SetSpec:
+		return checkItems(s.ValueSpec, v.GetSet())
+
+	case *compile.MapSpec:
+		m := v.GetMap()
+		if err := checkItemType(s.KeySpec, m.KeyType()); err != nil {
+			return fmt.Errorf("map key: %v", err)
+		}
+		if err := checkItemType(s.ValueSpec, m.ValueType()); err != nil {
+			return fmt.Errorf("map value: %v", err)
+		}
+		return m.ForEach(func(item wire.MapItem) error {
+			if err := checkValue(s.KeySpec, item.Key); err != nil {
+				return fmt.Errorf("map key: %v", err)
+			}
+			if err := checkValue(s.ValueSpec, item.Value); err != nil {
+				return fmt.Errorf("map value: %v", err)
+			}
+			return nil
+		})
+	}
+
+	return nil
+}
+
+// checkStruct verifies that the fields of the given struct match the given
+// field specifications. Unknown fields are ignored.
+func checkStruct(fields compile.FieldGroup, v wire.Value) error {
+	if v.Type() != wire.TStruct {
+		return fmt.Errorf("expected %v, got %v", wire.TStruct, v.Type())
+	}
+
+	byID := make(map[int16]*compile.FieldSpec, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	seen := make(map[int16]struct{}, len(fields))
+	for _, f := range v.GetStruct().Fields {
+		spec, ok := byID[f.ID]
+		if !ok {
+			continue
+		}
+		if err := checkValue(spec.Type, f.Value); err != nil {
+			return fmt.Errorf("field %q: %v", spec.Name, err)
+		}
+		seen[f.ID] = struct{}{}
+	}
+
+	for _, f := range fields {
+		if _, ok := seen[f.ID]; f.Required && !ok {
+			return fmt.Errorf("required field %q is missing", f.Name)
+		}
+	}
+
+	return nil
+}
+
+type valueItems interface {
+	ValueType() wire.Type
+	ForEach(func(wire.Value) error) error
+}
+
+func checkItems(spec compile.TypeSpec, items valueItems) error {
+	if err := checkItemType(spec, items.ValueType()); err != nil {
+		return err
+	}
+	return items.ForEach(func(v wire.Value) error {
+		return checkValue(spec, v)
+	})
+}
+
+func checkItemType(spec compile.TypeSpec, got wire.Type) error {
+	if want := compile.RootTypeSpec(spec).TypeCode(); got != want {
+		return fmt.Errorf("expected items of type %v, got %v", want, got)
+	}
+	return nil
+}
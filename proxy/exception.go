@@ -0,0 +1,56 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proxy
+
+import "go.uber.org/thriftrw/internal/envelope/exception"
+
+// ApplicationException is the TApplicationException with which Thrift
+// servers report calls that failed for reasons other than the exceptions
+// declared in the IDL.
+//
+// A Proxy responds with an ApplicationException to requests it cannot
+// handle, and to calls for which its Handler fails. Handlers may return an
+// *ApplicationException to choose its type; Forward does so with those
+// raised by the upstream server.
+//
+//	var exc *proxy.ApplicationException
+//	if errors.As(err, &exc) && exc.GetType() == proxy.ExceptionTypeUnknownMethod {
+//		...
+//	}
+type ApplicationException = exception.TApplicationException
+
+// ExceptionType is the type of an ApplicationException.
+type ExceptionType = exception.ExceptionType
+
+// Types of ApplicationExceptions.
+const (
+	ExceptionTypeUnknown               = exception.ExceptionTypeUnknown
+	ExceptionTypeUnknownMethod         = exception.ExceptionTypeUnknownMethod
+	ExceptionTypeInvalidMessageType    = exception.ExceptionTypeInvalidMessageType
+	ExceptionTypeWrongMethodName       = exception.ExceptionTypeWrongMethodName
+	ExceptionTypeBadSequenceID         = exception.ExceptionTypeBadSequenceID
+	ExceptionTypeMissingResult         = exception.ExceptionTypeMissingResult
+	ExceptionTypeInternalError         = exception.ExceptionTypeInternalError
+	ExceptionTypeProtocolError         = exception.ExceptionTypeProtocolError
+	ExceptionTypeInvalidTransform      = exception.ExceptionTypeInvalidTransform
+	ExceptionTypeInvalidProtocol       = exception.ExceptionTypeInvalidProtocol
+	ExceptionTypeUnsupportedClientType = exception.ExceptionTypeUnsupportedClientType
+)
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Transport sends enveloped requests to an upstream server.
+type Transport interface {
+	// Send sends the given request and returns the response to it.
+	//
+	// The response is ignored for oneway requests.
+	Send(ctx context.Context, request []byte) ([]byte, error)
+}
+
+// Forward builds a Handler which forwards calls, including any changes made
+// to their arguments, to an upstream server over the given Transport.
+//
+// ApplicationExceptions raised by the upstream server are returned as
+// *ApplicationException errors, which the Proxy passes through to its
+// caller.
+func Forward(p protocol.Protocol, t Transport) Handler {
+	return forwarder{p: p, t: t}
+}
+
+type forwarder struct {
+	p protocol.Protocol
+	t Transport
+}
+
+func (f forwarder) Handle(ctx context.Context, call *Call) (wire.Value, error) {
+	var buff bytes.Buffer
+	err := f.p.EncodeEnveloped(wire.Envelope{
+		Name:  call.Method,
+		Type:  call.Function.CallType(),
+		SeqID: call.SeqID,
+		Value: call.Args,
+	}, &buff)
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	res, err := f.t.Send(ctx, buff.Bytes())
+	if err != nil || call.Function.OneWay {
+		return wire.Value{}, err
+	}
+
+	e, err := f.p.DecodeEnveloped(bytes.NewReader(res))
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	switch e.Type {
+	case wire.Reply:
+		return e.Value, nil
+	case wire.Exception:
+		var exc ApplicationException
+		if err := exc.FromWire(e.Value); err != nil {
+			return wire.Value{}, err
+		}
+		return wire.Value{}, &exc
+	default:
+		return wire.Value{}, fmt.Errorf(
+			"unexpected envelope type %v in response for %q: expected Reply", e.Type, call.Method)
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package proxy implements a schema-aware Thrift proxy which does not require
+// generated code.
+//
+// A Proxy is built from a compiled Thrift module, which may be compiled from
+// the IDL embedded in a generated package.
+//
+//	m, err := compile.CompileEmbedded(keyvalue.ThriftModule)
+//	...
+//	p, err := proxy.New(m, "KeyValue", proxy.Forward(protocol.Binary, upstream))
+//	...
+//	response, err := p.Handle(ctx, request)
+//
+// Requests are checked against the IDL before they reach the Handler, which
+// may inspect or modify the arguments of each call before forwarding it.
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Handler handles calls received by a Proxy.
+type Handler interface {
+	// Handle handles the given call and returns the result struct for it.
+	//
+	// The returned value is ignored for oneway calls.
+	Handle(ctx context.Context, call *Call) (wire.Value, error)
+}
+
+// HandlerFunc is a Handler implemented as a function.
+type HandlerFunc func(ctx context.Context, call *Call) (wire.Value, error)
+
+// Handle calls f.
+func (f HandlerFunc) Handle(ctx context.Context, call *Call) (wire.Value, error) {
+	return f(ctx, call)
+}
+
+// Option customizes a Proxy.
+type Option func(*Proxy)
+
+// Protocol specifies the protocol used to decode requests and encode
+// responses. Defaults to protocol.Binary.
+func Protocol(p protocol.Protocol) Option {
+	return func(px *Proxy) {
+		px.p = p
+	}
+}
+
+// Proxy accepts enveloped requests for the functions of a Thrift service and
+// passes them to a Handler.
+type Proxy struct {
+	p         protocol.Protocol
+	h         Handler
+	service   string
+	functions map[string]*function
+}
+
+type function struct {
+	service string
+	spec    *compile.FunctionSpec
+	result  compile.FieldGroup
+}
+
+// New builds a Proxy for the service with the given name in the given
+// module. Functions inherited by the service are also proxied.
+func New(m *compile.Module, service string, h Handler, opts ...Option) (*Proxy, error) {
+	spec, err := m.LookupService(service)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unknown service %q: %v", service, err)
+	}
+
+	px := &Proxy{
+		p:         protocol.Binary,
+		h:         h,
+		service:   service,
+		functions: make(map[string]*function),
+	}
+	for _, opt := range opts {
+		opt(px)
+	}
+
+	for s := spec; s != nil; s = s.Parent {
+		for _, f := range s.Functions {
+			name := f.MethodName()
+			if _, ok := px.functions[name]; ok {
+				// Overridden by a child service.
+				continue
+			}
+			px.functions[name] = &function{
+				service: s.Name,
+				spec:    f,
+				result:  resultFields(f),
+			}
+		}
+	}
+
+	return px, nil
+}
+
+// resultFields returns the fields of the result struct for the given
+// function.
+func resultFields(f *compile.FunctionSpec) compile.FieldGroup {
+	if f.ResultSpec == nil {
+		return nil
+	}
+
+	var fields compile.FieldGroup
+	if f.ResultSpec.ReturnType != nil {
+		fields = append(fields, &compile.FieldSpec{
+			ID:   0,
+			Name: "success",
+			Type: f.ResultSpec.ReturnType,
+		})
+	}
+	return append(fields, f.ResultSpec.Exceptions...)
+}
+
+// Handle handles an enveloped request and returns the enveloped response.
+//
+// Requests for unknown methods, and requests whose arguments do not match
+// the IDL, receive an ApplicationException in response. No response is produced for
+// oneway requests.
+func (px *Proxy) Handle(ctx context.Context, request []byte) ([]byte, error) {
+	req, err := px.p.DecodeEnveloped(bytes.NewReader(request))
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := px.functions[req.Name]
+	if !ok {
+		return px.exception(req, ExceptionTypeUnknownMethod,
+			fmt.Errorf("unknown method %q for service %q", req.Name, px.service))
+	}
+
+	if req.Type != f.spec.CallType() {
+		return px.exception(req, ExceptionTypeInvalidMessageType,
+			fmt.Errorf("unexpected envelope type %v for %q: expected %v",
+				req.Type, req.Name, f.spec.CallType()))
+	}
+
+	if err := checkStruct(compile.FieldGroup(f.spec.ArgsSpec), req.Value); err != nil {
+		return px.exception(req, ExceptionTypeProtocolError,
+			fmt.Errorf("invalid arguments for %q: %v", req.Name, err))
+	}
+
+	call := &Call{
+		Service:  f.service,
+		Method:   req.Name,
+		SeqID:    req.SeqID,
+		Function: f.spec,
+		Args:     req.Value,
+	}
+	result, err := px.h.Handle(ctx, call)
+	if f.spec.OneWay {
+		return nil, nil
+	}
+	if err != nil {
+		return px.exception(req, ExceptionTypeInternalError, err)
+	}
+
+	if err := checkStruct(f.result, result); err != nil {
+		return px.exception(req, ExceptionTypeMissingResult,
+			fmt.Errorf("invalid result for %q: %v", req.Name, err))
+	}
+
+	return px.respond(wire.Envelope{
+		Name:  req.Name,
+		Type:  wire.Reply,
+		SeqID: req.SeqID,
+		Value: result,
+	})
+}
+
+// exception builds a response to the given request carrying an
+// ApplicationException.
+//
+// ApplicationExceptions returned by the Handler, for example those
+// forwarded from an upstream server, are passed through unchanged.
+func (px *Proxy) exception(req wire.Envelope, typ ExceptionType, err error) ([]byte, error) {
+	if req.Type == wire.OneWay {
+		return nil, nil
+	}
+
+	var exc *ApplicationException
+	if !errors.As(err, &exc) {
+		exc = &ApplicationException{
+			Message: ptr.String(err.Error()),
+			Type:    &typ,
+		}
+	}
+
+	v, err := exc.ToWire()
+	if err != nil {
+		return nil, err
+	}
+
+	return px.respond(wire.Envelope{
+		Name:  req.Name,
+		Type:  wire.Exception,
+		SeqID: req.SeqID,
+		Value: v,
+	})
+}
+
+func (px *Proxy) respond(e wire.Envelope) ([]byte, error) {
+	var buff bytes.Buffer
+	if err := px.p.EncodeEnveloped(e, &buff); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
@@ -0,0 +1,410 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/thriftreflect"
+	"go.uber.org/thriftrw/wire"
+)
+
+const _testIDL = `
+	struct Item {
+		1: required string key
+		2: optional list<i32> values
+	}
+
+	exception DoesNotExist {
+		1: optional string key
+	}
+
+	service Base {
+		string ping()
+	}
+
+	service KeyValue extends Base {
+		Item getValue(1: required string key) throws (1: DoesNotExist notFound)
+		oneway void flush(1: i64 deadline)
+	}
+`
+
+func newTestProxy(t *testing.T, h Handler) *Proxy {
+	m, err := compile.CompileEmbedded(&thriftreflect.ThriftModule{
+		Name:     "kv",
+		FilePath: "kv.thrift",
+		Raw:      _testIDL,
+	})
+	require.NoError(t, err)
+
+	px, err := New(m, "KeyValue", h)
+	require.NoError(t, err)
+	return px
+}
+
+func vstring(s string) wire.Value { return wire.NewValueString(s) }
+
+func vstruct(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func encodeRequest(t *testing.T, e wire.Envelope) []byte {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(e, &buff))
+	return buff.Bytes()
+}
+
+// decodeResponse decodes the given response, returning an error if it holds
+// an exception.
+func decodeResponse(t *testing.T, res []byte) (wire.Value, error) {
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(res))
+	require.NoError(t, err)
+	if e.Type != wire.Exception {
+		require.Equal(t, wire.Reply, e.Type)
+		return e.Value, nil
+	}
+
+	var exc ApplicationException
+	require.NoError(t, exc.FromWire(e.Value))
+	return wire.Value{}, &exc
+}
+
+func TestProxyHandle(t *testing.T) {
+	item := vstruct(
+		wire.Field{ID: 1, Value: vstring("foo")},
+		wire.Field{ID: 2, Value: wire.NewValueList(
+			wire.ValueListFromSlice(wire.TI32, []wire.Value{wire.NewValueI32(42)}),
+		)},
+	)
+
+	tests := []struct {
+		desc    string
+		give    wire.Envelope
+		handler HandlerFunc
+
+		want     wire.Value
+		wantExc  ExceptionType
+		wantErr  string
+		wantNone bool // no response is expected
+	}{
+		{
+			desc: "success",
+			give: wire.Envelope{
+				Name:  "getValue",
+				Type:  wire.Call,
+				SeqID: 42,
+				Value: vstruct(wire.Field{ID: 1, Value: vstring("foo")}),
+			},
+			handler: func(ctx context.Context, call *Call) (wire.Value, error) {
+				assert.Equal(t, "KeyValue", call.Service)
+				assert.Equal(t, int32(42), call.SeqID)
+				key, ok := call.Arg("key")
+				require.True(t, ok, "key must be set")
+				assert.Equal(t, "foo", key.GetString())
+				return vstruct(wire.Field{ID: 0, Value: item}), nil
+			},
+			want: vstruct(wire.Field{ID: 0, Value: item}),
+		},
+		{
+			desc: "inherited function",
+			give: wire.Envelope{Name: "ping", Type: wire.Call, Value: vstruct()},
+			handler: func(ctx context.Context, call *Call) (wire.Value, error) {
+				assert.Equal(t, "Base", call.Service)
+				return vstruct(wire.Field{ID: 0, Value: vstring("pong")}), nil
+			},
+			want: vstruct(wire.Field{ID: 0, Value: vstring("pong")}),
+		},
+		{
+			desc: "declared exception",
+			give: wire.Envelope{
+				Name:  "getValue",
+				Type:  wire.Call,
+				Value: vstruct(wire.Field{ID: 1, Value: vstring("foo")}),
+			},
+			handler: func(ctx context.Context, call *Call) (wire.Value, error) {
+				return vstruct(wire.Field{ID: 1, Value: vstruct(
+					wire.Field{ID: 1, Value: vstring("foo")},
+				)}), nil
+			},
+			want: vstruct(wire.Field{ID: 1, Value: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+			)}),
+		},
+		{
+			desc:    "unknown method",
+			give:    wire.Envelope{Name: "setValue", Type: wire.Call, Value: vstruct()},
+			wantExc: ExceptionTypeUnknownMethod,
+			wantErr: `unknown method "setValue" for service "KeyValue"`,
+		},
+		{
+			desc:    "wrong envelope type",
+			give:    wire.Envelope{Name: "flush", Type: wire.Call, Value: vstruct()},
+			wantExc: ExceptionTypeInvalidMessageType,
+			wantErr: `unexpected envelope type Call for "flush": expected OneWay`,
+		},
+		{
+			desc:    "missing required argument",
+			give:    wire.Envelope{Name: "getValue", Type: wire.Call, Value: vstruct()},
+			wantExc: ExceptionTypeProtocolError,
+			wantErr: `invalid arguments for "getValue": required field "key" is missing`,
+		},
+		{
+			desc: "argument type mismatch",
+			give: wire.Envelope{
+				Name:  "getValue",
+				Type:  wire.Call,
+				Value: vstruct(wire.Field{ID: 1, Value: wire.NewValueI32(1)}),
+			},
+			wantExc: ExceptionTypeProtocolError,
+			wantErr: `invalid arguments for "getValue": field "key": expected TBinary, got TI32`,
+		},
+		{
+			desc: "handler error",
+			give: wire.Envelope{Name: "ping", Type: wire.Call, Value: vstruct()},
+			handler: func(ctx context.Context, call *Call) (wire.Value, error) {
+				return wire.Value{}, errors.New("great sadness")
+			},
+			wantExc: ExceptionTypeInternalError,
+			wantErr: "great sadness",
+		},
+		{
+			desc: "invalid result",
+			give: wire.Envelope{Name: "ping", Type: wire.Call, Value: vstruct()},
+			handler: func(ctx context.Context, call *Call) (wire.Value, error) {
+				return vstruct(wire.Field{ID: 0, Value: wire.NewValueBool(true)}), nil
+			},
+			wantExc: ExceptionTypeMissingResult,
+			wantErr: `invalid result for "ping": field "success": expected TBinary, got TBool`,
+		},
+		{
+			desc: "oneway",
+			give: wire.Envelope{
+				Name:  "flush",
+				Type:  wire.OneWay,
+				Value: vstruct(wire.Field{ID: 1, Value: wire.NewValueI64(100)}),
+			},
+			handler: func(ctx context.Context, call *Call) (wire.Value, error) {
+				return wire.Value{}, errors.New("ignored")
+			},
+			wantNone: true,
+		},
+		{
+			desc:     "invalid oneway",
+			give:     wire.Envelope{Name: "flush", Type: wire.OneWay, Value: vstruct(wire.Field{ID: 1, Value: vstring("x")})},
+			wantNone: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var called bool
+			px := newTestProxy(t, HandlerFunc(func(ctx context.Context, call *Call) (wire.Value, error) {
+				called = true
+				require.NotNil(t, tt.handler, "handler must not be called")
+				return tt.handler(ctx, call)
+			}))
+
+			res, err := px.Handle(context.Background(), encodeRequest(t, tt.give))
+			require.NoError(t, err)
+
+			if tt.wantNone {
+				assert.Empty(t, res)
+				assert.Equal(t, tt.handler != nil, called)
+				return
+			}
+
+			got, err := decodeResponse(t, res)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				exc := err.(*ApplicationException)
+				assert.Equal(t, tt.wantExc, exc.GetType())
+				assert.Equal(t, tt.wantErr, exc.GetMessage())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(tt.want, got), "expected %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestNewUnknownService(t *testing.T) {
+	m, err := compile.CompileEmbedded(&thriftreflect.ThriftModule{
+		FilePath: "kv.thrift",
+		Raw:      _testIDL,
+	})
+	require.NoError(t, err)
+
+	_, err = New(m, "Cache", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `proxy: unknown service "Cache"`)
+}
+
+func TestCallSetArg(t *testing.T) {
+	px := newTestProxy(t, nil)
+	call := &Call{
+		Method:   "getValue",
+		Function: px.functions["getValue"].spec,
+		Args:     vstruct(wire.Field{ID: 1, Value: vstring("foo")}),
+	}
+
+	require.NoError(t, call.SetArg("key", vstring("bar")))
+	key, ok := call.Arg("key")
+	require.True(t, ok)
+	assert.Equal(t, "bar", key.GetString())
+	assert.Len(t, call.Args.GetStruct().Fields, 1)
+
+	err := call.SetArg("value", vstring("bar"))
+	assert.EqualError(t, err, `proxy: "getValue" does not accept argument "value"`)
+
+	err = call.SetArg("key", wire.NewValueI32(1))
+	assert.EqualError(t, err, `proxy: invalid value for argument "key" of "getValue": expected TBinary, got TI32`)
+
+	_, ok = call.Arg("value")
+	assert.False(t, ok)
+}
+
+type transportFunc func(ctx context.Context, request []byte) ([]byte, error)
+
+func (f transportFunc) Send(ctx context.Context, request []byte) ([]byte, error) {
+	return f(ctx, request)
+}
+
+func TestForward(t *testing.T) {
+	// The upstream server is another proxy which serves the calls directly.
+	var flushed bool
+	upstream := newTestProxy(t, HandlerFunc(func(ctx context.Context, call *Call) (wire.Value, error) {
+		switch call.Method {
+		case "getValue":
+			key, _ := call.Arg("key")
+			if key.GetString() != "bar" {
+				return vstruct(wire.Field{ID: 1, Value: vstruct(
+					wire.Field{ID: 1, Value: key},
+				)}), nil
+			}
+			return vstruct(wire.Field{ID: 0, Value: vstruct(
+				wire.Field{ID: 1, Value: key},
+			)}), nil
+		case "flush":
+			flushed = true
+			return wire.Value{}, nil
+		default:
+			return wire.Value{}, errors.New("great sadness")
+		}
+	}))
+	transport := transportFunc(upstream.Handle)
+
+	// The gateway rewrites the key of every getValue call.
+	forward := Forward(protocol.Binary, transport)
+	px := newTestProxy(t, HandlerFunc(func(ctx context.Context, call *Call) (wire.Value, error) {
+		if call.Method == "getValue" {
+			if err := call.SetArg("key", vstring("bar")); err != nil {
+				return wire.Value{}, err
+			}
+		}
+		return forward.Handle(ctx, call)
+	}))
+
+	t.Run("transformed", func(t *testing.T) {
+		res, err := px.Handle(context.Background(), encodeRequest(t, wire.Envelope{
+			Name:  "getValue",
+			Type:  wire.Call,
+			SeqID: 1,
+			Value: vstruct(wire.Field{ID: 1, Value: vstring("foo")}),
+		}))
+		require.NoError(t, err)
+
+		got, err := decodeResponse(t, res)
+		require.NoError(t, err)
+		want := vstruct(wire.Field{ID: 0, Value: vstruct(
+			wire.Field{ID: 1, Value: vstring("bar")},
+		)})
+		assert.True(t, wire.ValuesAreEqual(want, got), "expected %v, got %v", want, got)
+	})
+
+	t.Run("upstream exception", func(t *testing.T) {
+		res, err := px.Handle(context.Background(), encodeRequest(t, wire.Envelope{
+			Name:  "ping",
+			Type:  wire.Call,
+			SeqID: 1,
+			Value: vstruct(),
+		}))
+		require.NoError(t, err)
+
+		_, err = decodeResponse(t, res)
+		require.Error(t, err)
+		exc := err.(*ApplicationException)
+		assert.Equal(t, ExceptionTypeInternalError, exc.GetType())
+		assert.Equal(t, "great sadness", exc.GetMessage())
+	})
+
+	t.Run("upstream exception error", func(t *testing.T) {
+		ping := newTestProxy(t, nil).functions["ping"]
+		require.NotNil(t, ping)
+
+		_, err := forward.Handle(context.Background(), &Call{
+			Service:  "KeyValue",
+			Method:   "ping",
+			SeqID:    1,
+			Function: ping.spec,
+			Args:     vstruct(),
+		})
+
+		// Errors from Forward can be matched outside this package, and
+		// pass through handlers which wrap them.
+		var exc *ApplicationException
+		require.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &exc), "unexpected error %v", err)
+		assert.Equal(t, ExceptionTypeInternalError, exc.GetType())
+		assert.Equal(t, "great sadness", exc.GetMessage())
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		res, err := px.Handle(context.Background(), encodeRequest(t, wire.Envelope{
+			Name:  "flush",
+			Type:  wire.OneWay,
+			SeqID: 1,
+			Value: vstruct(),
+		}))
+		require.NoError(t, err)
+		assert.Empty(t, res)
+		assert.True(t, flushed, "flush must be forwarded")
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		failing := Forward(protocol.Binary, transportFunc(func(context.Context, []byte) ([]byte, error) {
+			return nil, errors.New("connection refused")
+		}))
+		_, err := failing.Handle(context.Background(), &Call{
+			Method:   "ping",
+			Function: px.functions["ping"].spec,
+			Args:     vstruct(),
+		})
+		assert.EqualError(t, err, "connection refused")
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package requiredfield decides what happens when a required field is
+// missing from a struct being decoded.
+//
+// Code generated with --missing-required=hook reports each missing required
+// field to the Handler installed with SetHandler. By default, decoding fails
+// with a *MissingError just as it does for code generated without the
+// option. Install a different Handler to tolerate payloads from producers
+// which lag behind changes to the schema, for example, to log missing fields
+// and leave them zero-valued:
+//
+//	requiredfield.SetHandler(func(err *requiredfield.MissingError) error {
+//		logger.Warn("missing required field", zap.Error(err))
+//		return nil
+//	})
+package requiredfield
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MissingError describes a required field which was missing from a struct
+// being decoded.
+//
+// Code generated with --missing-required=hook or zero also returns it when
+// encoding a struct whose required field is unset.
+type MissingError struct {
+	// Go names of the struct and of the field.
+	Struct string
+	Field  string
+}
+
+func (e *MissingError) Error() string {
+	return fmt.Sprintf("field %v of %v is required", e.Field, e.Struct)
+}
+
+// Handler is called with each required field missing from a struct being
+// decoded. Decoding fails with the error it returns, if any. Otherwise, the
+// field is left set to its zero value.
+type Handler func(*MissingError) error
+
+// Reject is the default Handler. It fails decoding with the MissingError.
+func Reject(err *MissingError) error {
+	return err
+}
+
+// Ignore is a Handler which leaves missing required fields zero-valued.
+func Ignore(*MissingError) error {
+	return nil
+}
+
+var (
+	_handlerMu sync.RWMutex
+	_handler   Handler = Reject
+)
+
+// SetHandler installs the Handler called for missing required fields. It
+// replaces any previously installed handler.
+//
+// Passing nil restores the default handler, Reject.
+func SetHandler(h Handler) {
+	if h == nil {
+		h = Reject
+	}
+
+	_handlerMu.Lock()
+	_handler = h
+	_handlerMu.Unlock()
+}
+
+func handler() Handler {
+	_handlerMu.RLock()
+	defer _handlerMu.RUnlock()
+	return _handler
+}
+
+// Missing reports that the field with the given Go name is missing from the
+// struct with the given Go name, and returns the error with which decoding
+// must fail, if any.
+//
+// This is intended to be called by generated code only.
+func Missing(structName, fieldName string) error {
+	return handler()(&MissingError{Struct: structName, Field: fieldName})
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package requiredfield
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingRejectsByDefault(t *testing.T) {
+	err := Missing("User", "ID")
+
+	var missing *MissingError
+	if assert.True(t, errors.As(err, &missing)) {
+		assert.Equal(t, &MissingError{Struct: "User", Field: "ID"}, missing)
+	}
+	assert.EqualError(t, err, "field ID of User is required")
+}
+
+func TestSetHandler(t *testing.T) {
+	defer SetHandler(nil)
+
+	var got []*MissingError
+	SetHandler(func(err *MissingError) error {
+		got = append(got, err)
+		return nil
+	})
+	assert.NoError(t, Missing("User", "ID"))
+	assert.NoError(t, Missing("User", "Name"))
+	assert.Equal(t, []*MissingError{
+		{Struct: "User", Field: "ID"},
+		{Struct: "User", Field: "Name"},
+	}, got)
+
+	SetHandler(Ignore)
+	assert.NoError(t, Missing("User", "ID"))
+
+	SetHandler(nil)
+	assert.Error(t, Missing("User", "ID"), "nil must restore Reject")
+}
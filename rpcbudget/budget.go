@@ -0,0 +1,222 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rpcbudget bounds the resources servers spend on Thrift requests.
+//
+// A Budget limits the size of requests and the time spent decoding them,
+// optionally per method. Middleware enforces a Budget in front of a Handler
+// of binary-encoded enveloped requests, such as a proxy.Proxy.
+//
+//	h := rpcbudget.Middleware(rpcbudget.Budget{
+//		Default: rpcbudget.Limits{MaxRequestBytes: 1 << 20},
+//		Methods: map[string]rpcbudget.Limits{
+//			"upload": {MaxRequestBytes: 64 << 20, DecodeTimeout: time.Second},
+//		},
+//	}, px)
+//
+// Requests which exceed their budget receive a TApplicationException
+// instead of reaching the Handler.
+package rpcbudget
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Limits bounds the resources spent on a single request.
+type Limits struct {
+	// Largest request accepted, in bytes, including its envelope. Requests
+	// are not bounded in size if this is zero.
+	MaxRequestBytes int
+
+	// Maximum duration spent decoding a request. Decoding is not bounded
+	// if this is zero.
+	DecodeTimeout time.Duration
+}
+
+// Budget holds the limits for the methods of a service.
+type Budget struct {
+	// Limits for methods not listed in Methods.
+	Default Limits
+
+	// Limits for individual methods, keyed by the method name in the
+	// envelope. These replace the Default limits entirely.
+	Methods map[string]Limits
+}
+
+// For returns the limits for the given method.
+func (b Budget) For(method string) Limits {
+	if l, ok := b.Methods[method]; ok {
+		return l
+	}
+	return b.Default
+}
+
+// Handler handles binary-encoded enveloped requests. *proxy.Proxy is a
+// Handler.
+type Handler interface {
+	// Handle handles the given request and returns the enveloped
+	// response, or nil for oneway requests.
+	Handle(ctx context.Context, request []byte) ([]byte, error)
+}
+
+// HandlerFunc is a Handler implemented as a function.
+type HandlerFunc func(ctx context.Context, request []byte) ([]byte, error)
+
+// Handle calls f.
+func (f HandlerFunc) Handle(ctx context.Context, request []byte) ([]byte, error) {
+	return f(ctx, request)
+}
+
+// ExceededError is reported for requests which exceed their budget.
+type ExceededError struct {
+	Method string
+
+	// Size of the request in bytes, if it was too large.
+	Size int
+
+	// Limits that were exceeded.
+	Limits Limits
+
+	// DeadlineExceeded is true if the request could not be decoded within
+	// its DecodeTimeout.
+	DeadlineExceeded bool
+}
+
+func (e *ExceededError) Error() string {
+	if e.DeadlineExceeded {
+		return fmt.Sprintf("request for %q could not be decoded within %v", e.Method, e.Limits.DecodeTimeout)
+	}
+	return fmt.Sprintf("request for %q of %d bytes exceeds the limit of %d bytes",
+		e.Method, e.Size, e.Limits.MaxRequestBytes)
+}
+
+// Middleware returns a Handler which enforces the given Budget on requests
+// before passing them to h.
+//
+// The envelope of each request is read to determine its method. If the
+// request is larger than the limit for the method, or if its arguments
+// cannot be read within the decode timeout for it, the request is rejected
+// with a TApplicationException of type PROTOCOL_ERROR without reaching h.
+// Oneway requests never receive a response; the ExceededError is returned
+// for them instead.
+//
+// Arguments are read without being retained, so requests with a
+// DecodeTimeout are read twice: once by Middleware, and once by h.
+// Requests whose envelope cannot be decoded are passed to h unchanged.
+func Middleware(b Budget, h Handler) Handler {
+	return HandlerFunc(func(ctx context.Context, request []byte) ([]byte, error) {
+		return handle(ctx, b, h, request)
+	})
+}
+
+func handle(ctx context.Context, b Budget, h Handler, request []byte) ([]byte, error) {
+	dr := deadlineReader{ctx: ctx, r: bytes.NewReader(request)}
+	sr := binary.NewStreamReader(&dr)
+	defer sr.Close()
+
+	eh, err := sr.ReadEnvelopeBegin()
+	if err != nil {
+		return h.Handle(ctx, request)
+	}
+
+	limits := b.For(eh.Name)
+	if limits.MaxRequestBytes > 0 && len(request) > limits.MaxRequestBytes {
+		return reject(eh.Name, eh.Type, eh.SeqID, &ExceededError{
+			Method: eh.Name,
+			Size:   len(request),
+			Limits: limits,
+		})
+	}
+
+	if limits.DecodeTimeout > 0 {
+		dr.deadline = _timeNow().Add(limits.DecodeTimeout)
+		if err := sr.Skip(wire.TStruct); errors.Is(err, errDeadlineExceeded) {
+			return reject(eh.Name, eh.Type, eh.SeqID, &ExceededError{
+				Method:           eh.Name,
+				Limits:           limits,
+				DeadlineExceeded: true,
+			})
+		}
+		// Other decoding errors are left for h to report.
+	}
+
+	return h.Handle(ctx, request)
+}
+
+// reject builds the response to a request which exceeded its budget.
+func reject(name string, et wire.EnvelopeType, seqID int32, err *ExceededError) ([]byte, error) {
+	if et == wire.OneWay {
+		return nil, err
+	}
+
+	typ := exception.ExceptionTypeProtocolError
+	v, verr := (&exception.TApplicationException{
+		Message: ptr.String(err.Error()),
+		Type:    &typ,
+	}).ToWire()
+	if verr != nil {
+		return nil, verr
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Default.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  wire.Exception,
+		SeqID: seqID,
+		Value: v,
+	}, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var (
+	_timeNow = time.Now
+
+	errDeadlineExceeded = errors.New("decode deadline exceeded")
+)
+
+// deadlineReader is an io.Reader which fails once its deadline passes or
+// its context is done.
+type deadlineReader struct {
+	ctx      context.Context
+	r        io.Reader
+	deadline time.Time // zero if unbounded
+}
+
+func (dr *deadlineReader) Read(b []byte) (int, error) {
+	if !dr.deadline.IsZero() && !_timeNow().Before(dr.deadline) {
+		return 0, errDeadlineExceeded
+	}
+	if err := dr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return dr.r.Read(b)
+}
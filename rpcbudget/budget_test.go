@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpcbudget
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeRequest(t *testing.T, name string, et wire.EnvelopeType, items int) []byte {
+	vs := make([]wire.Value, items)
+	for i := range vs {
+		vs[i] = wire.NewValueString("item")
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  et,
+		SeqID: 42,
+		Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, vs))},
+		}}),
+	}, &buf))
+	return buf.Bytes()
+}
+
+func decodeException(t *testing.T, res []byte) (wire.Envelope, *exception.TApplicationException) {
+	e, err := binary.Default.DecodeEnveloped(bytes.NewReader(res))
+	require.NoError(t, err)
+	require.Equal(t, wire.Exception, e.Type)
+
+	var exc exception.TApplicationException
+	require.NoError(t, exc.FromWire(e.Value))
+	return e, &exc
+}
+
+var okHandler = HandlerFunc(func(context.Context, []byte) ([]byte, error) {
+	return []byte("ok"), nil
+})
+
+func TestBudgetFor(t *testing.T) {
+	b := Budget{
+		Default: Limits{MaxRequestBytes: 10},
+		Methods: map[string]Limits{"upload": {DecodeTimeout: time.Second}},
+	}
+	assert.Equal(t, Limits{MaxRequestBytes: 10}, b.For("get"))
+	assert.Equal(t, Limits{DecodeTimeout: time.Second}, b.For("upload"))
+	assert.Equal(t, Limits{}, Budget{}.For("get"))
+}
+
+func TestMiddlewareMaxRequestBytes(t *testing.T) {
+	h := Middleware(Budget{
+		Default: Limits{MaxRequestBytes: 64},
+		Methods: map[string]Limits{"upload": {MaxRequestBytes: 1024}},
+	}, okHandler)
+
+	t.Run("within budget", func(t *testing.T) {
+		res, err := h.Handle(context.Background(), encodeRequest(t, "get", wire.Call, 1))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(res))
+	})
+
+	t.Run("method budget", func(t *testing.T) {
+		res, err := h.Handle(context.Background(), encodeRequest(t, "upload", wire.Call, 20))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(res))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		req := encodeRequest(t, "get", wire.Call, 20)
+		res, err := h.Handle(context.Background(), req)
+		require.NoError(t, err)
+
+		e, exc := decodeException(t, res)
+		assert.Equal(t, "get", e.Name)
+		assert.Equal(t, int32(42), e.SeqID)
+		assert.Equal(t, exception.ExceptionTypeProtocolError, exc.GetType())
+		assert.Contains(t, exc.GetMessage(), `request for "get" of `)
+		assert.Contains(t, exc.GetMessage(), "exceeds the limit of 64 bytes")
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		req := encodeRequest(t, "get", wire.OneWay, 20)
+		res, err := h.Handle(context.Background(), req)
+		assert.Nil(t, res)
+
+		var exceeded *ExceededError
+		require.True(t, errors.As(err, &exceeded), "expected ExceededError, got %v", err)
+		assert.Equal(t, &ExceededError{
+			Method: "get",
+			Size:   len(req),
+			Limits: Limits{MaxRequestBytes: 64},
+		}, exceeded)
+	})
+}
+
+func TestMiddlewareDecodeTimeout(t *testing.T) {
+	defer func(f func() time.Time) { _timeNow = f }(_timeNow)
+
+	// Every read advances the clock by a millisecond.
+	now := time.Unix(1000, 0)
+	_timeNow = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+
+	h := Middleware(Budget{
+		Default: Limits{DecodeTimeout: 10 * time.Millisecond},
+	}, okHandler)
+
+	t.Run("within budget", func(t *testing.T) {
+		res, err := h.Handle(context.Background(), encodeRequest(t, "get", wire.Call, 1))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(res))
+	})
+
+	t.Run("too slow", func(t *testing.T) {
+		res, err := h.Handle(context.Background(), encodeRequest(t, "get", wire.Call, 100))
+		require.NoError(t, err)
+
+		_, exc := decodeException(t, res)
+		assert.Equal(t, exception.ExceptionTypeProtocolError, exc.GetType())
+		assert.Equal(t, `request for "get" could not be decoded within 10ms`, exc.GetMessage())
+	})
+}
+
+func TestMiddlewarePassesThrough(t *testing.T) {
+	var got []byte
+	h := Middleware(Budget{
+		Default: Limits{DecodeTimeout: time.Second},
+	}, HandlerFunc(func(_ context.Context, req []byte) ([]byte, error) {
+		got = req
+		return nil, errors.New("great sadness")
+	}))
+
+	t.Run("invalid envelope", func(t *testing.T) {
+		req := []byte{0x0b, 0x00}
+		_, err := h.Handle(context.Background(), req)
+		assert.EqualError(t, err, "great sadness")
+		assert.Equal(t, req, got)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		req := encodeRequest(t, "get", wire.Call, 1)
+		req = req[:len(req)-4]
+		_, err := h.Handle(context.Background(), req)
+		assert.EqualError(t, err, "great sadness")
+		assert.Equal(t, req, got)
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rpcpolicy implements retry and timeout policies declared on Thrift
+// service functions.
+//
+// Policies are declared with annotations on the function in the Thrift file.
+//
+//	service KeyValue {
+//	  binary getValue(1: string key) (rpc.timeout = "500ms", rpc.retries = "3")
+//	}
+//
+// The generated helper for such a function exposes the policy,
+//
+//	KeyValue_GetValue_Helper.Policy // Policy{Timeout: 500ms, Retries: 3}
+//
+// RPC runtimes may use Policy.Call to honor it.
+package rpcpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// TimeoutAnnotation is the annotation that declares the maximum duration
+	// of each attempt of a call. Its value is parsed with time.ParseDuration.
+	TimeoutAnnotation = "rpc.timeout"
+
+	// RetriesAnnotation is the annotation that declares how many times a
+	// failed call may be retried.
+	RetriesAnnotation = "rpc.retries"
+)
+
+// Policy is the retry and timeout policy for a function.
+type Policy struct {
+	// Maximum duration of each attempt. Attempts are not bounded if this is
+	// zero.
+	Timeout time.Duration
+
+	// Number of times a failed call is retried. The call is attempted at
+	// most Retries+1 times.
+	Retries int
+
+	// IsException returns true if the given error is an exception declared
+	// for the function in the Thrift file. Such errors are results of the
+	// call rather than failures to make it, so they are not retried.
+	//
+	// Generated helpers set this to their IsException function. All errors
+	// are retried if this is nil.
+	IsException func(error) bool
+}
+
+// FromAnnotations builds a Policy from the annotations of a function.
+//
+// A zero Policy is returned if neither annotation is present.
+func FromAnnotations(annotations map[string]string) (Policy, error) {
+	var p Policy
+	if v, ok := annotations[TimeoutAnnotation]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return p, fmt.Errorf("invalid %v annotation %q: %v", TimeoutAnnotation, v, err)
+		}
+		if d <= 0 {
+			return p, fmt.Errorf("invalid %v annotation %q: must be positive", TimeoutAnnotation, v)
+		}
+		p.Timeout = d
+	}
+
+	if v, ok := annotations[RetriesAnnotation]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return p, fmt.Errorf("invalid %v annotation %q: not an integer", RetriesAnnotation, v)
+		}
+		if n < 0 {
+			return p, fmt.Errorf("invalid %v annotation %q: must not be negative", RetriesAnnotation, v)
+		}
+		p.Retries = n
+	}
+
+	return p, nil
+}
+
+// IsZero returns true if this policy neither bounds nor retries calls.
+func (p Policy) IsZero() bool {
+	return p.Timeout == 0 && p.Retries == 0
+}
+
+// Call invokes the given function, applying this policy to it.
+//
+// Each attempt receives a context bounded by the policy's timeout. Failed
+// attempts are retried until the retries are exhausted, the parent context
+// is done, or the function returns a declared exception, per IsException,
+// or an error wrapped with Permanent. The error from the last attempt is
+// returned.
+func (p Policy) Call(ctx context.Context, call func(context.Context) error) error {
+	var err error
+	for attempt := 0; attempt <= p.Retries; attempt++ {
+		err = p.attempt(ctx, call)
+		if err == nil {
+			return nil
+		}
+
+		if p.IsException != nil && p.IsException(err) {
+			return err
+		}
+
+		var perm *permanentError
+		if errors.As(err, &perm) {
+			return perm.err
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return err
+}
+
+func (p Policy) attempt(ctx context.Context, call func(context.Context) error) error {
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+	return call(ctx)
+}
+
+// Permanent marks an error as one which must not be retried by Policy.Call.
+// Exceptions declared in the Thrift file are not retried if the Policy's
+// IsException recognizes them, without being marked.
+//
+// Permanent returns nil if err is nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+type permanentError struct{ err error }
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
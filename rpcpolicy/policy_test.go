@@ -0,0 +1,206 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpcpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromAnnotations(t *testing.T) {
+	tests := []struct {
+		desc        string
+		annotations map[string]string
+		want        Policy
+		wantErr     string
+	}{
+		{desc: "no annotations"},
+		{
+			desc: "unrelated annotations",
+			annotations: map[string]string{
+				"go.name": "Foo",
+			},
+		},
+		{
+			desc: "timeout and retries",
+			annotations: map[string]string{
+				TimeoutAnnotation: "500ms",
+				RetriesAnnotation: "3",
+			},
+			want: Policy{Timeout: 500 * time.Millisecond, Retries: 3},
+		},
+		{
+			desc:        "invalid timeout",
+			annotations: map[string]string{TimeoutAnnotation: "soon"},
+			wantErr:     `invalid rpc.timeout annotation "soon"`,
+		},
+		{
+			desc:        "zero timeout",
+			annotations: map[string]string{TimeoutAnnotation: "0s"},
+			wantErr:     `invalid rpc.timeout annotation "0s": must be positive`,
+		},
+		{
+			desc:        "invalid retries",
+			annotations: map[string]string{RetriesAnnotation: "many"},
+			wantErr:     `invalid rpc.retries annotation "many": not an integer`,
+		},
+		{
+			desc:        "negative retries",
+			annotations: map[string]string{RetriesAnnotation: "-1"},
+			wantErr:     `invalid rpc.retries annotation "-1": must not be negative`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := FromAnnotations(tt.annotations)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Timeout == 0 && tt.want.Retries == 0, got.IsZero())
+		})
+	}
+}
+
+func TestCall(t *testing.T) {
+	errFailed := errors.New("great sadness")
+
+	tests := []struct {
+		desc         string
+		policy       Policy
+		failures     int
+		permanent    bool
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			desc:         "success",
+			wantAttempts: 1,
+		},
+		{
+			desc:         "no retries",
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      errFailed,
+		},
+		{
+			desc:         "succeeds after retries",
+			policy:       Policy{Retries: 3},
+			failures:     2,
+			wantAttempts: 3,
+		},
+		{
+			desc:         "retries exhausted",
+			policy:       Policy{Retries: 2},
+			failures:     5,
+			wantAttempts: 3,
+			wantErr:      errFailed,
+		},
+		{
+			desc:         "permanent error",
+			policy:       Policy{Retries: 2},
+			failures:     5,
+			permanent:    true,
+			wantAttempts: 1,
+			wantErr:      errFailed,
+		},
+		{
+			desc: "declared exception",
+			policy: Policy{
+				Retries:     2,
+				IsException: func(err error) bool { return err == errFailed },
+			},
+			failures:     5,
+			wantAttempts: 1,
+			wantErr:      errFailed,
+		},
+		{
+			desc: "undeclared error",
+			policy: Policy{
+				Retries:     2,
+				IsException: func(error) bool { return false },
+			},
+			failures:     5,
+			wantAttempts: 3,
+			wantErr:      errFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var attempts int
+			err := tt.policy.Call(context.Background(), func(context.Context) error {
+				attempts++
+				if attempts > tt.failures {
+					return nil
+				}
+				if tt.permanent {
+					return Permanent(errFailed)
+				}
+				return errFailed
+			})
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	p := Policy{Timeout: time.Millisecond, Retries: 1}
+
+	var attempts int
+	err := p.Call(context.Background(), func(ctx context.Context) error {
+		attempts++
+		_, ok := ctx.Deadline()
+		assert.True(t, ok, "attempt must have a deadline")
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 2, attempts, "timed out attempts must be retried")
+}
+
+func TestCallParentDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var attempts int
+	err := Policy{Retries: 3}.Call(ctx, func(ctx context.Context) error {
+		attempts++
+		return ctx.Err()
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, attempts, "must not retry once the parent context is done")
+}
+
+func TestPermanentNil(t *testing.T) {
+	assert.NoError(t, Permanent(nil))
+}
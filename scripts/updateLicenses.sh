@@ -6,5 +6,5 @@ set -x
 python3 "$(dirname $0)"/updateLicense.py \
 	$(go list -json ./... \
 	| jq -r '.Dir + "/" + (.GoFiles | .[])' \
-	| grep -v /gen/internal/tests/ \
+	| grep -v -e /gen/internal/tests/ -e /thriftrw-plugin-scaffold/internal/tests/ -e /thriftrw-plugin-grpc/testdata/ -e /thriftrw-plugin-cli/internal/tests/ -e /internal/examples/ \
 	)
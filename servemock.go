@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/mockserver"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type serveMockOptions struct {
+	Listen  string `long:"listen" short:"l" value-name:"ADDR" default:"127.0.0.1:9090" description:"Address on which the mock server listens."`
+	HTTP    bool   `long:"http" description:"Serve requests over HTTP instead of framed TCP. Request and response bodies hold enveloped Thrift messages."`
+	Service string `long:"service" value-name:"NAME" description:"Name of the service to mock. Required if the Thrift file defines more than one service."`
+
+	Args struct {
+		ThriftFile string `positional-arg-name:"FILE" description:"Thrift file defining the service."`
+		Responses  string `positional-arg-name:"RESPONSES" description:"YAML or JSON file with canned responses, keyed by method name."`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// serveMock implements "thriftrw serve-mock", which runs a server replying
+// to calls with canned responses. Responses use the binary protocol.
+func serveMock(args []string) error {
+	var opts serveMockOptions
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw serve-mock"
+	parser.Usage = "[OPTIONS]"
+
+	if _, err := parser.ParseArgs(args); err != nil {
+		if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+			parser.WriteHelp(os.Stdout)
+			return nil
+		}
+		return err
+	}
+
+	server, service, err := newMockServer(&opts)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", opts.Listen)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+
+	if opts.HTTP {
+		log.Printf("Serving mock %v over HTTP on %v", service, ln.Addr())
+		return http.Serve(ln, server)
+	}
+
+	log.Printf("Serving mock %v over framed TCP on %v", service, ln.Addr())
+	return server.ServeFramed(ln)
+}
+
+// newMockServer builds the mock server for the given options and returns
+// it with the name of the mocked service.
+func newMockServer(opts *serveMockOptions) (*mockserver.Server, string, error) {
+	thriftFile := opts.Args.ThriftFile
+	module, err := compile.Compile(thriftFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to compile %q: %+v", thriftFile, err)
+	}
+
+	service := opts.Service
+	if service == "" {
+		service, err = onlyService(module)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	responses, err := os.ReadFile(opts.Args.Responses)
+	if err != nil {
+		return nil, "", err
+	}
+
+	server, err := mockserver.New(module, service, responses)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to load responses from %q: %v", opts.Args.Responses, err)
+	}
+	return server, service, nil
+}
+
+// onlyService returns the name of the only service defined by the given
+// module.
+func onlyService(m *compile.Module) (string, error) {
+	names := make([]string, 0, len(m.Services))
+	for name := range m.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	switch len(names) {
+	case 0:
+		return "", errors.New("the Thrift file does not define any services")
+	case 1:
+		return names[0], nil
+	default:
+		return "", fmt.Errorf(
+			"the Thrift file defines multiple services: use --service to pick one of %v",
+			strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMockServer(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+		return path
+	}
+
+	single := write("single.thrift", "service KeyValue { string getValue(1: string key) }")
+	multiple := write("multiple.thrift", "service Foo {}\nservice Bar {}")
+	none := write("none.thrift", "struct Foo {}")
+	responses := write("responses.json", `{"getValue": {"success": "bar"}}`)
+	empty := write("empty.yaml", "")
+
+	tests := []struct {
+		desc        string
+		thriftFile  string
+		service     string
+		responses   string
+		wantService string
+		wantErr     string
+	}{
+		{
+			desc:        "only service",
+			thriftFile:  single,
+			responses:   responses,
+			wantService: "KeyValue",
+		},
+		{
+			desc:        "explicit service",
+			thriftFile:  multiple,
+			service:     "Bar",
+			responses:   empty,
+			wantService: "Bar",
+		},
+		{
+			desc:       "multiple services",
+			thriftFile: multiple,
+			responses:  empty,
+			wantErr:    "use --service to pick one of Bar, Foo",
+		},
+		{
+			desc:       "no services",
+			thriftFile: none,
+			responses:  empty,
+			wantErr:    "the Thrift file does not define any services",
+		},
+		{
+			desc:       "missing responses",
+			thriftFile: single,
+			responses:  filepath.Join(dir, "missing.yaml"),
+			wantErr:    "no such file or directory",
+		},
+		{
+			desc:       "invalid responses",
+			thriftFile: multiple,
+			service:    "Foo",
+			responses:  responses,
+			wantErr:    `service "Foo" does not have a method "getValue"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var opts serveMockOptions
+			opts.Service = tt.service
+			opts.Args.ThriftFile = tt.thriftFile
+			opts.Args.Responses = tt.responses
+
+			server, service, err := newMockServer(&opts)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, server)
+			assert.Equal(t, tt.wantService, service)
+		})
+	}
+}
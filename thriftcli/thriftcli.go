@@ -0,0 +1,332 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftcli implements command line clients for Thrift services.
+//
+// Code generated by thriftrw-plugin-cli describes the functions of a service
+// as Methods and passes them to Main. The resulting command calls a single
+// function per invocation:
+//
+//	keyvaluecli [flags] METHOD [ARGS]
+//
+// ARGS is a JSON object holding the arguments of the function keyed by their
+// names in the Thrift file. It is read from stdin if it is omitted or "-".
+// The result struct of the function is printed as JSON, with the return
+// value under "success" or an exception under its name.
+//
+// Requests are encoded with the Thrift binary protocol and sent to the peer
+// given with -peer over framed TCP, or over HTTP if the peer is an http://
+// or https:// URL. HTTP requests are sent to PEER/Service/method.
+package thriftcli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Method is a function of a Thrift service callable from the command line.
+type Method struct {
+	// Service that declares the function. This differs from the service
+	// passed to Main for inherited functions.
+	Service string
+
+	// Name of the function in the Thrift file.
+	Name string
+
+	// Args is a pointer to the zero value of the arguments struct of the
+	// function. Its JSON field names are listed in usage.
+	Args interface{}
+
+	// OneWay is true if the function does not reply.
+	OneWay bool
+
+	// Call decodes the JSON-encoded arguments of the function, calls it with
+	// the given Caller, and returns its result struct.
+	//
+	// If the function throws an exception, Call returns the result struct
+	// holding the exception along with the exception. Oneway functions
+	// return a nil result.
+	Call func(ctx context.Context, c Caller, args []byte) (result interface{}, err error)
+}
+
+// Caller sends requests to a Thrift service.
+type Caller interface {
+	// Call sends the given arguments and decodes the response into result.
+	Call(ctx context.Context, args envelope.Enveloper, result interface{ FromWire(wire.Value) error }) error
+
+	// CallOneWay sends the given arguments without waiting for a response.
+	CallOneWay(ctx context.Context, args envelope.Enveloper) error
+}
+
+// Main runs the command line client for the given service with the
+// command line arguments of the process, and exits.
+func Main(service string, methods []Method) {
+	os.Exit(Run(service, methods, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// Run runs the command line client for the given service with the given
+// arguments and returns its exit status.
+func Run(service string, methods []Method, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	name := strings.ToLower(service) + "cli"
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	peer := flags.String("peer", "127.0.0.1:9090", "`address` of the service, or an http:// URL")
+	timeout := flags.Duration("timeout", 5*time.Second, "timeout for the request")
+	noEnvelope := flags.Bool("no-envelope", false, "send requests without envelopes")
+
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %v [flags] METHOD [ARGS]\n\n", name)
+		fmt.Fprintf(stderr, "Calls METHOD of the %v service with the JSON object ARGS, read from\n", service)
+		fmt.Fprintf(stderr, "stdin if omitted or \"-\", and prints the result as JSON.\n\n")
+		fmt.Fprintf(stderr, "methods:\n")
+		for _, m := range methods {
+			fmt.Fprintf(stderr, "  %v(%v)", m.Name, strings.Join(argNames(m.Args), ", "))
+			if m.OneWay {
+				fmt.Fprintf(stderr, " oneway")
+			}
+			fmt.Fprintln(stderr)
+		}
+		fmt.Fprintf(stderr, "\nflags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		flags.Usage()
+		return 2
+	}
+
+	var method *Method
+	for i, m := range methods {
+		if m.Name == flags.Arg(0) {
+			method = &methods[i]
+			break
+		}
+	}
+	if method == nil {
+		fmt.Fprintf(stderr, "unknown method %q for service %q\n", flags.Arg(0), service)
+		return 2
+	}
+
+	in, err := readArgs(method, flags.Arg(1), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to read arguments: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	c := &caller{
+		Peer:       *peer,
+		Service:    method.Service,
+		NoEnvelope: *noEnvelope,
+	}
+	result, err := method.Call(ctx, c, in)
+	if result != nil {
+		out, jerr := json.MarshalIndent(result, "", "  ")
+		if jerr != nil {
+			fmt.Fprintf(stderr, "failed to encode result: %v\n", jerr)
+			return 1
+		}
+		fmt.Fprintf(stdout, "%s\n", out)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%v failed: %v\n", method.Name, err)
+		return 1
+	}
+	return 0
+}
+
+// readArgs reads the JSON-encoded arguments for a method from the command
+// line or stdin.
+func readArgs(m *Method, arg string, stdin io.Reader) ([]byte, error) {
+	switch {
+	case arg == "" && len(argNames(m.Args)) == 0:
+		return []byte("{}"), nil
+	case arg == "" || arg == "-":
+		return io.ReadAll(stdin)
+	default:
+		return []byte(arg), nil
+	}
+}
+
+// argNames returns the JSON names of the fields of the given struct.
+func argNames(args interface{}) []string {
+	t := reflect.TypeOf(args)
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		switch {
+		case name == "-" || f.PkgPath != "":
+			continue
+		case name == "":
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// caller is a Caller over framed TCP or HTTP.
+type caller struct {
+	Peer       string
+	Service    string
+	NoEnvelope bool
+}
+
+var _ Caller = (*caller)(nil)
+
+func (c *caller) Call(ctx context.Context, args envelope.Enveloper, result interface{ FromWire(wire.Value) error }) error {
+	req, err := c.encode(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.send(ctx, args.MethodName(), req, false)
+	if err != nil {
+		return err
+	}
+
+	var v wire.Value
+	if c.NoEnvelope {
+		v, err = binary.Default.Decode(bytes.NewReader(res), wire.TStruct)
+	} else {
+		v, _, err = envelope.ReadReply(binary.Default, bytes.NewReader(res))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return result.FromWire(v)
+}
+
+func (c *caller) CallOneWay(ctx context.Context, args envelope.Enveloper) error {
+	req, err := c.encode(args)
+	if err != nil {
+		return err
+	}
+	_, err = c.send(ctx, args.MethodName(), req, true)
+	return err
+}
+
+func (c *caller) encode(args envelope.Enveloper) ([]byte, error) {
+	var buf bytes.Buffer
+	if c.NoEnvelope {
+		if err := envelope.WriteNoEnvelope(binary.Default, &buf, args); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := envelope.Write(binary.Default, &buf, 1, args); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *caller) send(ctx context.Context, method string, req []byte, oneway bool) ([]byte, error) {
+	if strings.HasPrefix(c.Peer, "http://") || strings.HasPrefix(c.Peer, "https://") {
+		return c.sendHTTP(ctx, method, req)
+	}
+	return c.sendFramed(ctx, req, oneway)
+}
+
+func (c *caller) sendHTTP(ctx context.Context, method string, req []byte) ([]byte, error) {
+	url := strings.TrimSuffix(c.Peer, "/") + "/" + c.Service + "/" + method
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/vnd.apache.thrift.binary")
+
+	res, err := http.DefaultClient.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers may report exceptions with non-2xx statuses, but their
+	// bodies are still Thrift responses.
+	if res.StatusCode >= 300 && !strings.HasPrefix(res.Header.Get("Content-Type"), "application/vnd.apache.thrift") {
+		return nil, fmt.Errorf("%v: %s", res.Status, bytes.TrimSpace(body))
+	}
+	return body, nil
+}
+
+func (c *caller) sendFramed(ctx context.Context, req []byte, oneway bool) ([]byte, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := frame.NewWriter(conn).Write(req); err != nil {
+		return nil, err
+	}
+	if oneway {
+		return nil, nil
+	}
+	return frame.NewReader(conn).Read()
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftcli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// echoArgs are the arguments of echo(1: string value).
+type echoArgs struct {
+	Value string `json:"value"`
+}
+
+func (a *echoArgs) MethodName() string              { return "echo" }
+func (a *echoArgs) EnvelopeType() wire.EnvelopeType { return wire.Call }
+
+func (a *echoArgs) ToWire() (wire.Value, error) {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(a.Value)},
+	}}), nil
+}
+
+// echoResult is the result of echo, holding the value it returned.
+type echoResult struct {
+	Success string `json:"success"`
+}
+
+func (r *echoResult) FromWire(v wire.Value) error {
+	for _, f := range v.GetStruct().Fields {
+		if f.ID == 0 {
+			r.Success = f.Value.GetString()
+		}
+	}
+	return nil
+}
+
+var echoMethod = Method{
+	Service: "Echo",
+	Name:    "echo",
+	Args:    &echoArgs{},
+	Call: func(ctx context.Context, c Caller, in []byte) (interface{}, error) {
+		var args echoArgs
+		if err := json.Unmarshal(in, &args); err != nil {
+			return nil, err
+		}
+		var result echoResult
+		if err := c.Call(ctx, &args, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	},
+}
+
+// echoResponse builds the response of echo for an encoded request.
+func echoResponse(t *testing.T, req []byte, noEnvelope bool) []byte {
+	var (
+		v   wire.Value
+		err error
+	)
+	if noEnvelope {
+		v, err = envelope.ReadNoEnvelope(binary.Default, bytes.NewReader(req))
+	} else {
+		var e wire.Envelope
+		e, err = binary.Default.DecodeEnveloped(bytes.NewReader(req))
+		v = e.Value
+	}
+	require.NoError(t, err)
+
+	value := v.GetStruct().Fields[0].Value.GetString()
+	res := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 0, Value: wire.NewValueString(strings.ToUpper(value))},
+	}})
+
+	var buf bytes.Buffer
+	if noEnvelope {
+		require.NoError(t, binary.Default.Encode(res, &buf))
+	} else {
+		require.NoError(t, binary.Default.EncodeEnveloped(wire.Envelope{
+			Name:  "echo",
+			Type:  wire.Reply,
+			SeqID: 1,
+			Value: res,
+		}, &buf))
+	}
+	return buf.Bytes()
+}
+
+type handlerFunc func([]byte) ([]byte, error)
+
+func (f handlerFunc) Handle(b []byte) ([]byte, error) { return f(b) }
+
+// serveFramed serves echo over framed TCP and returns its address.
+func serveFramed(t *testing.T, noEnvelope bool) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				frame.NewServer(conn, conn).Serve(handlerFunc(func(req []byte) ([]byte, error) {
+					return echoResponse(t, req, noEnvelope), nil
+				}))
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func run(methods []Method, stdin string, args ...string) (status int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	status = Run("Echo", methods, args, strings.NewReader(stdin), &out, &errOut)
+	return status, out.String(), errOut.String()
+}
+
+func TestRunFramed(t *testing.T) {
+	t.Run("envelope", func(t *testing.T) {
+		status, stdout, stderr := run([]Method{echoMethod}, "",
+			"-peer", serveFramed(t, false), "echo", `{"value": "hello"}`)
+		require.Equal(t, 0, status, stderr)
+		assert.JSONEq(t, `{"success": "HELLO"}`, stdout)
+	})
+
+	t.Run("no envelope", func(t *testing.T) {
+		status, stdout, stderr := run([]Method{echoMethod}, `{"value": "hi"}`,
+			"-peer", serveFramed(t, true), "-no-envelope", "echo", "-")
+		require.Equal(t, 0, status, stderr)
+		assert.JSONEq(t, `{"success": "HI"}`, stdout)
+	})
+}
+
+func TestRunHTTP(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if r.URL.Path != "/Echo/echo" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		var buf bytes.Buffer
+		_, err := buf.ReadFrom(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/vnd.apache.thrift.binary")
+		w.Write(echoResponse(t, buf.Bytes(), false))
+	}))
+	defer srv.Close()
+
+	t.Run("success", func(t *testing.T) {
+		status, stdout, stderr := run([]Method{echoMethod}, `{"value": "hello"}`,
+			"-peer", srv.URL, "echo")
+		require.Equal(t, 0, status, stderr)
+		assert.Equal(t, "/Echo/echo", gotPath)
+		assert.JSONEq(t, `{"success": "HELLO"}`, stdout)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		m := echoMethod
+		m.Service = "Other"
+		status, stdout, stderr := run([]Method{m}, "", "-peer", srv.URL, "echo", `{}`)
+		assert.Equal(t, 1, status)
+		assert.Empty(t, stdout)
+		assert.Contains(t, stderr, "echo failed: 404 Not Found: not found")
+	})
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		desc       string
+		args       []string
+		wantStatus int
+		wantStderr string
+	}{
+		{
+			desc:       "help",
+			args:       []string{"-h"},
+			wantStatus: 0,
+			wantStderr: "  echo(value)\n  ping() oneway\n",
+		},
+		{
+			desc:       "missing method",
+			wantStatus: 2,
+			wantStderr: "usage: echocli [flags] METHOD [ARGS]",
+		},
+		{
+			desc:       "unknown method",
+			args:       []string{"foo"},
+			wantStatus: 2,
+			wantStderr: `unknown method "foo" for service "Echo"`,
+		},
+		{
+			desc:       "invalid arguments",
+			args:       []string{"echo", "{"},
+			wantStatus: 1,
+			wantStderr: "echo failed: unexpected end of JSON input",
+		},
+		{
+			desc:       "unreachable peer",
+			args:       []string{"-peer", "127.0.0.1:1", "ping"},
+			wantStatus: 1,
+			wantStderr: "ping failed: ",
+		},
+	}
+
+	ping := Method{
+		Service: "Echo",
+		Name:    "ping",
+		OneWay:  true,
+		Call: func(ctx context.Context, c Caller, in []byte) (interface{}, error) {
+			return nil, c.CallOneWay(ctx, &echoArgs{})
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			status, _, stderr := run([]Method{echoMethod, ping}, "", tt.args...)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Contains(t, stderr, tt.wantStderr)
+		})
+	}
+}
+
+func TestArgNames(t *testing.T) {
+	type args struct {
+		Key      *string `json:"key,omitempty"`
+		NoTag    int
+		Skipped  bool `json:"-"`
+		internal int
+	}
+
+	assert.Equal(t, []string{"key", "NoTag"}, argNames(&args{}))
+	assert.Equal(t, []string{"key", "NoTag"}, argNames(args{}))
+	assert.Empty(t, argNames(nil))
+	assert.Empty(t, argNames(42))
+}
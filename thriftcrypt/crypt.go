@@ -0,0 +1,194 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftcrypt encrypts the values of struct fields annotated with
+// encrypt on behalf of code generated by ThriftRW.
+//
+// Fields may name the key with which they are encrypted,
+//
+//	struct Payment {
+//	    1: required string id
+//	    2: optional string cardNumber (encrypt = "payments-key")
+//	}
+//
+// Generated code encodes the values of such fields with the Thrift Binary
+// protocol, encrypts them with the registered Provider, and sends them as
+// binary fields in their place. Decoding reverses this. Register a Provider
+// backed by your key management service before encoding or decoding such
+// structs.
+//
+//	thriftcrypt.RegisterProvider(kmsProvider)
+package thriftcrypt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Annotation is the annotation used on Thrift struct fields to specify the
+// alias of the key with which their values are encrypted.
+const Annotation = "encrypt"
+
+// ErrNoProvider is returned when encoding or decoding a struct with
+// encrypted fields before a Provider has been registered.
+var ErrNoProvider = errors.New(
+	"thriftcrypt: no provider registered: use thriftcrypt.RegisterProvider")
+
+// Provider encrypts and decrypts the encoded values of fields on behalf of
+// generated code.
+type Provider interface {
+	// Encrypt encrypts the given bytes with the key with the given alias.
+	Encrypt(keyAlias string, plaintext []byte) ([]byte, error)
+
+	// Decrypt decrypts bytes produced by Encrypt with the same key alias.
+	Decrypt(keyAlias string, ciphertext []byte) ([]byte, error)
+}
+
+var (
+	_providerMu sync.RWMutex
+	_provider   Provider
+)
+
+// RegisterProvider installs the Provider used by generated code. It
+// replaces any previously registered provider.
+//
+// Passing nil unregisters the current provider.
+func RegisterProvider(p Provider) {
+	_providerMu.Lock()
+	_provider = p
+	_providerMu.Unlock()
+}
+
+func provider() (Provider, error) {
+	_providerMu.RLock()
+	p := _provider
+	_providerMu.RUnlock()
+
+	if p == nil {
+		return nil, ErrNoProvider
+	}
+	return p, nil
+}
+
+// Seal encodes the given value and encrypts it with the key with the given
+// alias, returning the result as a binary value.
+//
+// This is intended to be called by generated code only.
+func Seal(keyAlias string, v wire.Value) (wire.Value, error) {
+	var buf bytes.Buffer
+	if err := binary.Default.Encode(v, &buf); err != nil {
+		return wire.Value{}, err
+	}
+
+	bs, err := encrypt(keyAlias, buf.Bytes())
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueBinary(bs), nil
+}
+
+// Open reverses Seal, decrypting the given binary value and decoding a value
+// of the given type from it.
+//
+// This is intended to be called by generated code only.
+func Open(keyAlias string, w wire.Value, t wire.Type) (wire.Value, error) {
+	bs, err := decrypt(keyAlias, w.GetBinary())
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return binary.Default.Decode(bytes.NewReader(bs), t)
+}
+
+// EncodeSealed writes the value written by the given function to the
+// stream, encrypted with the key with the given alias, as a binary value.
+//
+// This is intended to be called by generated code only.
+func EncodeSealed(sw stream.Writer, keyAlias string, encode func(stream.Writer) error) error {
+	var buf bytes.Buffer
+	esw := binary.NewStreamWriter(&buf)
+	err := encode(esw)
+	if cerr := esw.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	bs, err := encrypt(keyAlias, buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return sw.WriteBinary(bs)
+}
+
+// DecodeSealed reverses EncodeSealed, reading a binary value from the stream
+// and decrypting it for the given function to decode.
+//
+// This is intended to be called by generated code only.
+func DecodeSealed(sr stream.Reader, keyAlias string, decode func(stream.Reader) error) error {
+	ciphertext, err := sr.ReadBinary()
+	if err != nil {
+		return err
+	}
+
+	bs, err := decrypt(keyAlias, ciphertext)
+	if err != nil {
+		return err
+	}
+
+	dsr := binary.NewStreamReader(bytes.NewReader(bs))
+	err = decode(dsr)
+	if cerr := dsr.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func encrypt(keyAlias string, bs []byte) ([]byte, error) {
+	p, err := provider()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := p.Encrypt(keyAlias, bs)
+	if err != nil {
+		return nil, fmt.Errorf("thriftcrypt: could not encrypt with key %q: %v", keyAlias, err)
+	}
+	return out, nil
+}
+
+func decrypt(keyAlias string, bs []byte) ([]byte, error) {
+	p, err := provider()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := p.Decrypt(keyAlias, bs)
+	if err != nil {
+		return nil, fmt.Errorf("thriftcrypt: could not decrypt with key %q: %v", keyAlias, err)
+	}
+	return out, nil
+}
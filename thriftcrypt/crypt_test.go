@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftcrypt
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// xorProvider "encrypts" bytes by XORing them with the length of the key
+// alias.
+type xorProvider struct{ err error }
+
+func (p xorProvider) Encrypt(keyAlias string, plaintext []byte) ([]byte, error) {
+	return p.xor(keyAlias, plaintext)
+}
+
+func (p xorProvider) Decrypt(keyAlias string, ciphertext []byte) ([]byte, error) {
+	return p.xor(keyAlias, ciphertext)
+}
+
+func (p xorProvider) xor(keyAlias string, bs []byte) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	out := make([]byte, len(bs))
+	for i, b := range bs {
+		out[i] = b ^ byte(len(keyAlias))
+	}
+	return out, nil
+}
+
+func TestSealOpen(t *testing.T) {
+	RegisterProvider(xorProvider{})
+	defer RegisterProvider(nil)
+
+	give := wire.NewValueString("hello")
+	sealed, err := Seal("key", give)
+	require.NoError(t, err)
+	assert.Equal(t, wire.TBinary, sealed.Type())
+	assert.NotEqual(t, []byte("hello"), sealed.GetBinary()[4:])
+
+	got, err := Open("key", sealed, wire.TBinary)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(give, got))
+}
+
+func TestSealedStreams(t *testing.T) {
+	RegisterProvider(xorProvider{})
+	defer RegisterProvider(nil)
+
+	var buf bytes.Buffer
+	sw := binary.NewStreamWriter(&buf)
+	require.NoError(t, EncodeSealed(sw, "key", func(esw stream.Writer) error {
+		return esw.WriteInt32(42)
+	}))
+	require.NoError(t, sw.Close())
+
+	t.Run("DecodeSealed", func(t *testing.T) {
+		sr := binary.NewStreamReader(bytes.NewReader(buf.Bytes()))
+		defer sr.Close()
+
+		var got int32
+		require.NoError(t, DecodeSealed(sr, "key", func(dsr stream.Reader) (err error) {
+			got, err = dsr.ReadInt32()
+			return err
+		}))
+		assert.Equal(t, int32(42), got)
+	})
+
+	t.Run("Open", func(t *testing.T) {
+		w, err := binary.Default.Decode(bytes.NewReader(buf.Bytes()), wire.TBinary)
+		require.NoError(t, err)
+
+		got, err := Open("key", w, wire.TI32)
+		require.NoError(t, err)
+		assert.Equal(t, int32(42), got.GetI32())
+	})
+}
+
+func TestProviderErrors(t *testing.T) {
+	t.Run("no provider", func(t *testing.T) {
+		RegisterProvider(nil)
+
+		_, err := Seal("key", wire.NewValueI32(1))
+		assert.Equal(t, ErrNoProvider, err)
+
+		_, err = Open("key", wire.NewValueBinary(nil), wire.TI32)
+		assert.Equal(t, ErrNoProvider, err)
+	})
+
+	t.Run("provider failure", func(t *testing.T) {
+		RegisterProvider(xorProvider{err: errors.New("great sadness")})
+		defer RegisterProvider(nil)
+
+		_, err := Seal("key", wire.NewValueI32(1))
+		assert.EqualError(t, err, `thriftcrypt: could not encrypt with key "key": great sadness`)
+
+		_, err = Open("key", wire.NewValueBinary(nil), wire.TI32)
+		assert.EqualError(t, err, `thriftcrypt: could not decrypt with key "key": great sadness`)
+
+		var buf bytes.Buffer
+		sw := binary.NewStreamWriter(&buf)
+		defer sw.Close()
+		err = EncodeSealed(sw, "key", func(esw stream.Writer) error {
+			return esw.WriteInt32(1)
+		})
+		assert.EqualError(t, err, `thriftcrypt: could not encrypt with key "key": great sadness`)
+	})
+
+	t.Run("encode failure", func(t *testing.T) {
+		RegisterProvider(xorProvider{})
+		defer RegisterProvider(nil)
+
+		var buf bytes.Buffer
+		sw := binary.NewStreamWriter(&buf)
+		defer sw.Close()
+		err := EncodeSealed(sw, "key", func(stream.Writer) error {
+			return errors.New("great sadness")
+		})
+		assert.EqualError(t, err, "great sadness")
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftgrpc carries Thrift-encoded messages over gRPC.
+//
+// Code generated by thriftrw-plugin-grpc registers Codec with gRPC so that
+// the arguments and results of Thrift functions are sent as gRPC messages
+// encoded with the Thrift binary protocol, with the content type
+// "application/grpc+thrift".
+//
+// This package does not depend on gRPC: Codec satisfies the
+// google.golang.org/grpc/encoding.Codec interface structurally.
+package thriftgrpc
+
+import (
+	"bytes"
+	"fmt"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// ContentSubtype is the gRPC content subtype for Thrift messages and the name
+// of Codec.
+const ContentSubtype = "thrift"
+
+// Codec is a gRPC codec for types generated by ThriftRW. It encodes messages
+// with the Thrift binary protocol, without envelopes.
+type Codec struct{}
+
+// Name returns the name of the codec, ContentSubtype.
+func (Codec) Name() string {
+	return ContentSubtype
+}
+
+// Marshal encodes the given Thrift struct.
+func (Codec) Marshal(v interface{}) ([]byte, error) {
+	x, ok := v.(interface {
+		ToWire() (wire.Value, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("thriftgrpc: cannot marshal %T: not a Thrift type", v)
+	}
+
+	w, err := x.ToWire()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Default.Encode(w, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes a Thrift struct into v.
+func (Codec) Unmarshal(data []byte, v interface{}) error {
+	x, ok := v.(interface {
+		FromWire(wire.Value) error
+	})
+	if !ok {
+		return fmt.Errorf("thriftgrpc: cannot unmarshal into %T: not a Thrift type", v)
+	}
+
+	w, err := binary.Default.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return err
+	}
+	return x.FromWire(w)
+}
+
+// Empty is the message with which oneway functions reply over gRPC, which
+// has no oneway calls.
+type Empty struct{}
+
+// ToWire encodes Empty as an empty struct.
+func (*Empty) ToWire() (wire.Value, error) {
+	return wire.NewValueStruct(wire.Struct{}), nil
+}
+
+// FromWire accepts any struct.
+func (*Empty) FromWire(wire.Value) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftgrpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/wire"
+)
+
+type fakeStruct struct {
+	Value wire.Value
+	Err   error
+}
+
+func (s *fakeStruct) ToWire() (wire.Value, error) {
+	return s.Value, s.Err
+}
+
+func (s *fakeStruct) FromWire(w wire.Value) error {
+	s.Value = w
+	return wire.EvaluateValue(w)
+}
+
+func TestCodec(t *testing.T) {
+	var codec Codec
+	assert.Equal(t, "thrift", codec.Name())
+
+	give := &fakeStruct{Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+	}})}
+
+	data, err := codec.Marshal(give)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{
+		0x0b, 0x00, 0x01, // type:1 = string, id:2 = 1
+		0x00, 0x00, 0x00, 0x03, 'f', 'o', 'o',
+		0x00, // stop
+	}, data)
+
+	var got fakeStruct
+	require.NoError(t, codec.Unmarshal(data, &got))
+	assert.True(t, wire.ValuesAreEqual(give.Value, got.Value))
+}
+
+func TestCodecErrors(t *testing.T) {
+	var codec Codec
+
+	_, err := codec.Marshal("foo")
+	assert.EqualError(t, err, "thriftgrpc: cannot marshal string: not a Thrift type")
+
+	_, err = codec.Marshal(&fakeStruct{Err: errors.New("great sadness")})
+	assert.EqualError(t, err, "great sadness")
+
+	var s string
+	assert.EqualError(t, codec.Unmarshal([]byte{0x00}, &s),
+		"thriftgrpc: cannot unmarshal into *string: not a Thrift type")
+
+	assert.Error(t, codec.Unmarshal([]byte{0x0b}, &fakeStruct{}))
+}
+
+func TestEmpty(t *testing.T) {
+	var codec Codec
+
+	data, err := codec.Marshal(&Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00}, data)
+	assert.NoError(t, codec.Unmarshal(data, &Empty{}))
+}
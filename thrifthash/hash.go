@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thrifthash computes the hashes returned by the Hash methods of
+// code generated by ThriftRW.
+//
+// Hashes are 64-bit FNV-1a over a canonical encoding of the values, so they
+// are stable across processes and releases, and values which are equal by
+// their Equals methods have equal hashes. Entries of sets and maps are
+// hashed independently and combined with Unordered so that their iteration
+// order does not matter.
+//
+// Hashes are not cryptographically secure and must not be relied upon to
+// tell values apart.
+package thrifthash
+
+import "math"
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// Hasher accumulates the hash of a value.
+type Hasher struct {
+	sum uint64
+}
+
+// New returns a Hasher for a new value.
+func New() Hasher {
+	return Hasher{sum: offset64}
+}
+
+// Sum64 returns the hash of the values written so far.
+func (h *Hasher) Sum64() uint64 {
+	return h.sum
+}
+
+func (h *Hasher) writeByte(b byte) {
+	h.sum ^= uint64(b)
+	h.sum *= prime64
+}
+
+// Uint64 writes an unsigned 64-bit integer, such as the hash of a nested
+// value.
+func (h *Hasher) Uint64(v uint64) {
+	for i := 56; i >= 0; i -= 8 {
+		h.writeByte(byte(v >> uint(i)))
+	}
+}
+
+// Field marks the start of the field with the given ID in a struct.
+func (h *Hasher) Field(id int16) {
+	h.writeByte(0xff)
+	h.Int16(id)
+}
+
+// Len writes the length of a list, set, map, string or binary value.
+func (h *Hasher) Len(n int) {
+	h.Uint64(uint64(n))
+}
+
+// Bool writes a boolean.
+func (h *Hasher) Bool(v bool) {
+	if v {
+		h.writeByte(1)
+	} else {
+		h.writeByte(0)
+	}
+}
+
+// Int8 writes an 8-bit integer.
+func (h *Hasher) Int8(v int8) {
+	h.writeByte(byte(v))
+}
+
+// Int16 writes a 16-bit integer.
+func (h *Hasher) Int16(v int16) {
+	h.writeByte(byte(v >> 8))
+	h.writeByte(byte(v))
+}
+
+// Int32 writes a 32-bit integer.
+func (h *Hasher) Int32(v int32) {
+	for i := 24; i >= 0; i -= 8 {
+		h.writeByte(byte(v >> uint(i)))
+	}
+}
+
+// Int64 writes a 64-bit integer.
+func (h *Hasher) Int64(v int64) {
+	h.Uint64(uint64(v))
+}
+
+// Double writes a floating point number. Positive and negative zero, which
+// compare equal, have the same hash.
+func (h *Hasher) Double(v float64) {
+	if v == 0 {
+		v = 0
+	}
+	h.Uint64(math.Float64bits(v))
+}
+
+// String writes a string.
+func (h *Hasher) String(v string) {
+	h.Len(len(v))
+	for i := 0; i < len(v); i++ {
+		h.writeByte(v[i])
+	}
+}
+
+// Binary writes a binary value. Nil and empty values have the same hash.
+func (h *Hasher) Binary(v []byte) {
+	h.Len(len(v))
+	for _, b := range v {
+		h.writeByte(b)
+	}
+}
+
+// Unordered combines the hashes of the entries of a set or map regardless
+// of the order in which they are added.
+type Unordered struct {
+	n   int
+	sum uint64
+}
+
+// Add adds the hash of an entry.
+func (u *Unordered) Add(hash uint64) {
+	u.n++
+	u.sum += hash
+}
+
+// Sum64 returns the hash of the entries added so far.
+func (u *Unordered) Sum64() uint64 {
+	h := New()
+	h.Len(u.n)
+	h.Uint64(u.sum)
+	return h.Sum64()
+}
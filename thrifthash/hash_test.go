@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package thrifthash
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasherStable(t *testing.T) {
+	h := New()
+	h.Field(1)
+	h.String("foo")
+	h.Field(2)
+	h.Int32(42)
+
+	// Hashes must not change between releases since they may be
+	// persisted, for example in consistent hashing rings.
+	assert.Equal(t, uint64(0x2b5c29be7348dc5d), h.Sum64())
+}
+
+func TestHasherEmpty(t *testing.T) {
+	h := New()
+	assert.Equal(t, uint64(offset64), h.Sum64())
+}
+
+func TestHasherDouble(t *testing.T) {
+	pos, neg := New(), New()
+	pos.Double(0)
+	neg.Double(math.Copysign(0, -1))
+	assert.Equal(t, pos.Sum64(), neg.Sum64())
+}
+
+func TestHasherBoundaries(t *testing.T) {
+	ab, a := New(), New()
+	ab.String("ab")
+	ab.String("")
+	a.String("a")
+	a.String("b")
+	assert.NotEqual(t, ab.Sum64(), a.Sum64())
+}
+
+func TestUnordered(t *testing.T) {
+	var u1, u2 Unordered
+	for _, v := range []uint64{1, 2, 3} {
+		u1.Add(v)
+	}
+	for _, v := range []uint64{3, 1, 2} {
+		u2.Add(v)
+	}
+	assert.Equal(t, u1.Sum64(), u2.Sum64())
+
+	var u3 Unordered
+	u3.Add(6)
+	assert.NotEqual(t, u1.Sum64(), u3.Sum64(), "entry counts must be hashed")
+
+	var empty Unordered
+	assert.NotEqual(t, u1.Sum64(), empty.Sum64())
+}
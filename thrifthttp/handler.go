@@ -0,0 +1,181 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thrifthttp serves Thrift functions over HTTP.
+//
+// Code generated by ThriftRW with the --http-handlers option builds a
+// Function for each function of each service. Functions are http.Handlers
+// which accept binary-encoded requests with or without envelopes and reply
+// in kind.
+//
+//	mux := http.NewServeMux()
+//	thrifthttp.Register(mux,
+//		kv.KeyValue_GetValue_HTTPHandler(h.GetValue),
+//		kv.KeyValue_SetValue_HTTPHandler(h.SetValue),
+//	)
+//
+// Requests must use the POST method. Responses are written with the
+// following status codes.
+//
+//	200  The function succeeded.
+//	204  The function is oneway and the request was accepted.
+//	400  The request could not be decoded.
+//	405  The request did not use the POST method.
+//	500  The function failed with an error not declared in the IDL.
+//
+// Exceptions declared in the IDL are written as regular Thrift responses
+// with the status code specified by the http.status annotation on the
+// exception, or 500 if the annotation is absent.
+//
+//	exception KeyDoesNotExist {
+//		1: required string key
+//	} (http.status = "404")
+package thrifthttp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// ContentType is the Content-Type of Thrift responses.
+const ContentType = "application/vnd.apache.thrift.binary"
+
+// Function is a Thrift function served over HTTP.
+type Function struct {
+	// Path at which the function is served. This is "/Service/method" for
+	// generated functions.
+	Path string
+
+	// OneWay is true if the function does not reply.
+	OneWay bool
+
+	// Call decodes the arguments of the function from the given struct,
+	// calls the function, and returns its response.
+	//
+	// Failures to decode the arguments should be reported with an
+	// ArgumentsError.
+	Call func(ctx context.Context, args wire.Value) (Response, error)
+}
+
+var _ http.Handler = Function{}
+
+// Response is a response to a Thrift function call.
+type Response struct {
+	// Result struct of the function. This is nil for oneway functions.
+	Result interface {
+		ToWire() (wire.Value, error)
+	}
+
+	// Status is the HTTP status code of the response. Defaults to 200 if
+	// unset.
+	Status int
+}
+
+// ArgumentsError is returned by Function.Call if the arguments of a request
+// could not be decoded.
+type ArgumentsError struct {
+	Err error
+}
+
+func (e *ArgumentsError) Error() string {
+	return fmt.Sprintf("invalid arguments: %v", e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ArgumentsError) Unwrap() error {
+	return e.Err
+}
+
+// Register registers the given functions on the given ServeMux at their
+// paths.
+func Register(mux *http.ServeMux, fs ...Function) {
+	for _, f := range fs {
+		mux.Handle(f.Path, f)
+	}
+}
+
+// ServeHTTP handles a request for this function.
+func (f Function) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, fmt.Sprintf("method %v not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	et := wire.Call
+	if f.OneWay {
+		et = wire.OneWay
+	}
+
+	args, responder, err := binary.Default.DecodeRequest(et, bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := f.Call(r.Context(), args)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var argsErr *ArgumentsError
+		if errors.As(err, &argsErr) {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if f.OneWay || res.Result == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	v, err := res.Result.ToWire()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := responder.EncodeResponse(v, wire.Reply, &buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status := res.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thrifthttp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/thriftrw/wire"
+)
+
+type fakeResult struct{ Value wire.Value }
+
+func (r fakeResult) ToWire() (wire.Value, error) { return r.Value, nil }
+
+func TestFunction(t *testing.T) {
+	empty := wire.NewValueStruct(wire.Struct{})
+
+	tests := []struct {
+		desc       string
+		method     string
+		body       []byte
+		call       func(context.Context, wire.Value) (Response, error)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			desc:       "success",
+			method:     http.MethodPost,
+			body:       []byte{0x00},
+			call:       func(context.Context, wire.Value) (Response, error) { return Response{Result: fakeResult{empty}}, nil },
+			wantStatus: http.StatusOK,
+			wantBody:   "\x00",
+		},
+		{
+			desc:   "status",
+			method: http.MethodPost,
+			body:   []byte{0x00},
+			call: func(context.Context, wire.Value) (Response, error) {
+				return Response{Result: fakeResult{empty}, Status: http.StatusConflict}, nil
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   "\x00",
+		},
+		{
+			desc:       "wrong method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "method GET not allowed\n",
+		},
+		{
+			desc:       "invalid body",
+			method:     http.MethodPost,
+			body:       []byte{0x0b, 0x00},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			desc:   "invalid arguments",
+			method: http.MethodPost,
+			body:   []byte{0x00},
+			call: func(context.Context, wire.Value) (Response, error) {
+				return Response{}, &ArgumentsError{Err: errors.New("missing key")}
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid arguments: missing key\n",
+		},
+		{
+			desc:   "failure",
+			method: http.MethodPost,
+			body:   []byte{0x00},
+			call: func(context.Context, wire.Value) (Response, error) {
+				return Response{}, errors.New("great sadness")
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "great sadness\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f := Function{Path: "/Foo/bar", Call: tt.call}
+
+			w := httptest.NewRecorder()
+			f.ServeHTTP(w, httptest.NewRequest(tt.method, "/Foo/bar", bytes.NewReader(tt.body)))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+// ServiceSpec describes a service defined in a Thrift file for service
+// catalogs and other tooling. It marshals to JSON as a machine-readable
+// service descriptor.
+//
+// Code generated with --service-specs exposes a ServiceSpec for each service
+// as <Name>ServiceSpec, and all of them keyed by their Thrift names as
+// ServiceSpecs.
+type ServiceSpec struct {
+	// The name of the service in the Thrift file.
+	Name string `json:"name"`
+
+	// The Thrift file defining the service, relative to --thrift-root.
+	File string `json:"file"`
+
+	// The name of the service this service extends, if any, qualified with
+	// the name of its Thrift file if that was included.
+	Extends string `json:"extends,omitempty"`
+
+	// Functions defined by the service, sorted by name. Functions inherited
+	// from the service it extends are not included.
+	Functions []FunctionSpec `json:"functions"`
+
+	// Annotations on the service, such as its owner.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// FunctionSpec describes a function of a service.
+type FunctionSpec struct {
+	// The name of the function in the Thrift file.
+	Name string `json:"name"`
+
+	// Whether the function is oneway.
+	OneWay bool `json:"oneway,omitempty"`
+
+	// Arguments of the function in the order in which they were declared.
+	Args []ParamSpec `json:"args"`
+
+	// The Thrift type of the result, or an empty string if the function
+	// returns void or is oneway.
+	Result string `json:"result,omitempty"`
+
+	// Exceptions raised by the function.
+	Exceptions []ParamSpec `json:"exceptions,omitempty"`
+
+	// Annotations on the function, such as its SLO.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ParamSpec describes an argument or exception of a function.
+type ParamSpec struct {
+	ID       int16  `json:"id"`                 // The field identifier.
+	Name     string `json:"name"`               // The name in the Thrift file.
+	Type     string `json:"type"`               // The Thrift type.
+	Required bool   `json:"required,omitempty"` // Whether it is required.
+
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// FunctionByName returns the function with the given name in the Thrift
+// file. Functions inherited from other services are not considered.
+func (s *ServiceSpec) FunctionByName(name string) (FunctionSpec, bool) {
+	for _, f := range s.Functions {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FunctionSpec{}, false
+}
@@ -22,6 +22,8 @@ package thriftreflect
 
 // ThriftModule is used by the generated code to expose the embedded IDL
 // source.
+//
+// compile.CompileEmbedded compiles the embedded IDL at runtime.
 type ThriftModule struct {
 	Name     string          // The name of the thrift module.
 	Package  string          // The go package (full path).
@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Kind is the kind of a type defined in a Thrift file.
+type Kind int
+
+// Kinds of types defined in Thrift files.
+const (
+	KindStruct Kind = iota + 1
+	KindUnion
+	KindException
+	KindEnum
+	KindTypedef
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindStruct:
+		return "struct"
+	case KindUnion:
+		return "union"
+	case KindException:
+		return "exception"
+	case KindEnum:
+		return "enum"
+	case KindTypedef:
+		return "typedef"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Value is a value of a generated type which may be converted to and from
+// its Thrift-level representation.
+type Value interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+}
+
+// TypeSpec describes a type defined in a Thrift file so that its values may
+// be decoded and inspected without reflection or the compiler.
+//
+// Code generated with --type-specs exposes a TypeSpec for each type as
+// <Name>TypeSpec, and all of them keyed by their Thrift names as TypeSpecs.
+type TypeSpec struct {
+	Name     string    // The name of the type in the Thrift file.
+	Kind     Kind      // The kind of the type.
+	WireType wire.Type // The type of its values on the wire.
+
+	// Fields of structs, unions, and exceptions in the order in which they
+	// were declared. Nil for other kinds.
+	Fields []FieldSpec
+
+	// New returns a pointer to a new zero value of the type.
+	New func() Value
+
+	// Annotations on the type in the Thrift file.
+	Annotations map[string]string
+}
+
+// FieldSpec describes a field of a struct, union, or exception.
+type FieldSpec struct {
+	ID       int16     // The field identifier.
+	Name     string    // The name of the field in the Thrift file.
+	Type     string    // The Thrift type, as written in the Thrift file.
+	WireType wire.Type // The type of its values on the wire.
+	Required bool      // Whether the field is required.
+
+	// Annotations on the field in the Thrift file.
+	Annotations map[string]string
+}
+
+// FieldByID returns the field with the given identifier.
+func (t *TypeSpec) FieldByID(id int16) (FieldSpec, bool) {
+	for _, f := range t.Fields {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return FieldSpec{}, false
+}
+
+// FieldByName returns the field with the given name in the Thrift file.
+func (t *TypeSpec) FieldByName(name string) (FieldSpec, bool) {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FieldSpec{}, false
+}
+
+// FromWire decodes a value of this type from its Thrift-level
+// representation.
+func (t *TypeSpec) FromWire(w wire.Value) (Value, error) {
+	if w.Type() != t.WireType {
+		return nil, fmt.Errorf("cannot decode %v from %v: expected %v", t.Name, w.Type(), t.WireType)
+	}
+
+	v := t.New()
+	if err := v.FromWire(w); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
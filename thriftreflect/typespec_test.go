@@ -0,0 +1,107 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// name is a Value holding a string.
+type name string
+
+func (n name) ToWire() (wire.Value, error) {
+	return wire.NewValueString(string(n)), nil
+}
+
+func (n *name) FromWire(w wire.Value) error {
+	if w.GetString() == "" {
+		return errors.New("name must not be empty")
+	}
+	*n = name(w.GetString())
+	return nil
+}
+
+var nameSpec = &TypeSpec{
+	Name:     "Name",
+	Kind:     KindTypedef,
+	WireType: wire.TBinary,
+	New:      func() Value { return new(name) },
+}
+
+func TestTypeSpecFromWire(t *testing.T) {
+	v, err := nameSpec.FromWire(wire.NewValueString("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", string(*v.(*name)))
+
+	_, err = nameSpec.FromWire(wire.NewValueString(""))
+	assert.EqualError(t, err, "name must not be empty")
+
+	_, err = nameSpec.FromWire(wire.NewValueI32(1))
+	assert.EqualError(t, err, "cannot decode Name from TI32: expected TBinary")
+}
+
+func TestTypeSpecFields(t *testing.T) {
+	spec := &TypeSpec{
+		Name:     "User",
+		Kind:     KindStruct,
+		WireType: wire.TStruct,
+		Fields: []FieldSpec{
+			{ID: 1, Name: "id", WireType: wire.TBinary, Required: true},
+			{ID: 3, Name: "age", WireType: wire.TI32},
+		},
+	}
+
+	f, ok := spec.FieldByID(3)
+	require.True(t, ok)
+	assert.Equal(t, "age", f.Name)
+
+	f, ok = spec.FieldByName("id")
+	require.True(t, ok)
+	assert.Equal(t, int16(1), f.ID)
+
+	_, ok = spec.FieldByID(2)
+	assert.False(t, ok)
+	_, ok = spec.FieldByName("name")
+	assert.False(t, ok)
+}
+
+func TestKindString(t *testing.T) {
+	tests := []struct {
+		give Kind
+		want string
+	}{
+		{KindStruct, "struct"},
+		{KindUnion, "union"},
+		{KindException, "exception"},
+		{KindEnum, "enum"},
+		{KindTypedef, "typedef"},
+		{Kind(42), "Kind(42)"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.give.String())
+	}
+}
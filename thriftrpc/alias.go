@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package thriftrpc
+
+import "go.uber.org/thriftrw/envelope"
+
+// Aliased returns an Enveloper which encodes the same request as e under
+// the method name of the given alias, as "Service::method" or "method".
+//
+// Clients use this to call a renamed function by its former name, listed in
+// its thrift.alias annotation, while servers which only know that name are
+// still deployed.
+//
+//	args := kv.Store_Get_Helper.Args(&key)
+//	req, err := thriftrpc.EncodeRequest(thriftrpc.Aliased(args, "KeyValue::getValue"), true)
+func Aliased(e envelope.Enveloper, alias string) envelope.Enveloper {
+	_, method := splitProcedureName(alias)
+	return aliasedEnveloper{Enveloper: e, name: method}
+}
+
+type aliasedEnveloper struct {
+	envelope.Enveloper
+
+	name string
+}
+
+func (e aliasedEnveloper) MethodName() string { return e.name }
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftrpc
+
+import "go.uber.org/thriftrw/protocol/stream"
+
+// ArgsReader reads the arguments struct of a request field by field, for
+// functions with the go.lazy_args annotation. Implementations decode only
+// the fields they need and the rest are skipped once they return.
+//
+//	for {
+//		fh, ok, err := args.Next()
+//		if err != nil {
+//			return nil, err
+//		}
+//		if !ok {
+//			break
+//		}
+//		if fh.ID != 1 {
+//			continue // skipped by Next
+//		}
+//		if key, err = args.Reader().ReadString(); err != nil {
+//			return nil, &thriftrpc.ArgumentsError{Err: err}
+//		}
+//	}
+//
+// Errors returned by Next are reported as ArgumentsErrors if the function
+// fails after one. Functions should wrap errors from decoding field values
+// in an ArgumentsError themselves.
+//
+// The ArgsReader is valid only until the function returns.
+type ArgsReader struct {
+	r   stream.Reader
+	err error // first error returned by Next
+
+	// Header of the field returned by Next, if its value may not have
+	// been read yet.
+	field   stream.FieldHeader
+	inField bool
+
+	// valueRead is true if Reader was called for the current field.
+	valueRead bool
+	done      bool
+}
+
+// NewArgsReader builds an ArgsReader which reads fields from the given
+// stream.Reader. The beginning of the arguments struct must have been read
+// already.
+func NewArgsReader(r stream.Reader) *ArgsReader {
+	return &ArgsReader{r: r}
+}
+
+// Next advances to the next field of the arguments struct and returns its
+// header. It returns false once all fields have been read.
+//
+// The value of the previous field is skipped if Reader was not called for
+// it. Otherwise it must have been decoded completely.
+func (a *ArgsReader) Next() (stream.FieldHeader, bool, error) {
+	fh, ok, err := a.next()
+	if err != nil && a.err == nil {
+		a.err = err
+	}
+	return fh, ok, err
+}
+
+func (a *ArgsReader) next() (stream.FieldHeader, bool, error) {
+	if a.done {
+		return stream.FieldHeader{}, false, nil
+	}
+
+	if err := a.endField(); err != nil {
+		return stream.FieldHeader{}, false, err
+	}
+
+	fh, ok, err := a.r.ReadFieldBegin()
+	if err != nil {
+		return fh, false, err
+	}
+	if !ok {
+		a.done = true
+		return fh, false, a.r.ReadStructEnd()
+	}
+
+	a.field = fh
+	a.inField = true
+	a.valueRead = false
+	return fh, true, nil
+}
+
+// Reader returns the stream.Reader from which the value of the field
+// returned by Next is decoded.
+func (a *ArgsReader) Reader() stream.Reader {
+	a.valueRead = true
+	return a.r
+}
+
+// Discard skips the remaining fields of the arguments struct.
+func (a *ArgsReader) Discard() error {
+	for {
+		_, ok, err := a.Next()
+		if err != nil || !ok {
+			return err
+		}
+	}
+}
+
+func (a *ArgsReader) endField() error {
+	if !a.inField {
+		return nil
+	}
+	a.inField = false
+
+	if !a.valueRead {
+		if err := a.r.Skip(a.field.Type); err != nil {
+			return err
+		}
+	}
+	return a.r.ReadFieldEnd()
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftrpc
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestArgsReader encodes the given struct and returns an ArgsReader
+// positioned after its beginning.
+func newTestArgsReader(t *testing.T, v wire.Value) *ArgsReader {
+	var buf bytes.Buffer
+	require.NoError(t, binary.Default.Encode(v, &buf))
+
+	sr := binary.Default.Reader(bytes.NewReader(buf.Bytes()))
+	t.Cleanup(func() { sr.Close() })
+	require.NoError(t, sr.ReadStructBegin())
+	return NewArgsReader(sr)
+}
+
+func TestArgsReaderNext(t *testing.T) {
+	args := newTestArgsReader(t, stringStruct(1, "a", "b", "c"))
+
+	var got []string
+	for {
+		fh, ok, err := args.Next()
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		if fh.ID == 2 {
+			continue // skipped by Next
+		}
+		s, err := args.Reader().ReadString()
+		require.NoError(t, err)
+		got = append(got, s)
+	}
+	assert.Equal(t, []string{"a", "c"}, got)
+
+	_, ok, err := args.Next()
+	require.NoError(t, err)
+	assert.False(t, ok, "Next must keep returning false once done")
+}
+
+func TestArgsReaderDiscard(t *testing.T) {
+	args := newTestArgsReader(t, stringStruct(1, "a", "b"))
+
+	fh, ok, err := args.Next()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, int16(1), fh.ID)
+
+	require.NoError(t, args.Discard())
+	assert.NoError(t, args.err)
+}
+
+func TestArgsReaderError(t *testing.T) {
+	sr := binary.Default.Reader(bytes.NewReader([]byte{
+		0x0b,       // type:1 = string
+		0x00, 0x01, // id:2 = 1
+		0x00, 0x00, 0x00, 0x05, 'a', // truncated value
+	}))
+	defer sr.Close()
+
+	args := NewArgsReader(sr)
+	_, ok, err := args.Next()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	assert.Error(t, args.Discard())
+	assert.Error(t, args.err, "the first error must be recorded")
+}
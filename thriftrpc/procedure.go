@@ -0,0 +1,289 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftrpc adapts Thrift services to RPC frameworks which route
+// requests to procedures by name, such as YARPC.
+//
+// Code generated by ThriftRW with the --procedures option declares, for each
+// service, an interface for implementations of the service and a function
+// which returns a Procedure for each of its functions.
+//
+//	for _, p := range kv.KeyValue_Procedures(handler) {
+//		router.Register(p.Name, p.Serve)
+//	}
+//
+// Procedures are named "Service::method" following the YARPC convention.
+// Functions inherited from a parent service are named after the parent.
+//
+// Serve accepts binary-encoded requests with or without envelopes and
+// replies in kind. Frameworks that decode requests themselves may call
+// Handle, and encode its Response with EncodeResponse.
+//
+// Functions with the go.lazy_args annotation receive an ArgsReader instead
+// of their decoded arguments, so that they may decode only the fields they
+// need from large requests. Their procedures have a StreamHandler instead of
+// a Handler. Clients encode requests with EncodeRequest and decode
+// their responses with DecodeResponse.
+//
+// Router serves the procedures of several services from one listener by
+// the method names in request envelopes, optionally prefixed with the name
+// of the service as in "Service:method". The --router option generates a
+// NewRouter function for the services of a Thrift file.
+//
+// Functions with the thrift.alias annotation list their former names:
+//
+//	string get(1: string key) (thrift.alias = "KeyValue::getValue")
+//
+// Their procedures are also routed by these names, and clients may send
+// them with Aliased until all servers know the new name.
+package thriftrpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Procedure is a function of a Thrift service served by name.
+type Procedure struct {
+	// Name of the procedure. This is "Service::method" for generated
+	// procedures.
+	Name string
+
+	// Aliases are former names of the procedure, as "Service::method",
+	// from the thrift.alias annotation of its function. Routers also serve
+	// the procedure under these names so that clients which have not yet
+	// picked up a renamed service or method can still reach it.
+	Aliases []string
+
+	// OneWay is true if the procedure does not reply.
+	OneWay bool
+
+	// Handler decodes the arguments of the function from the given
+	// struct, calls the function, and returns its response.
+	//
+	// Failures to decode the arguments are reported with an
+	// ArgumentsError.
+	Handler func(ctx context.Context, args wire.Value) (Response, error)
+
+	// StreamHandler, if set instead of Handler, calls the function with
+	// the arguments struct of the request as it is read. Fields not read
+	// by the function are skipped after it returns.
+	StreamHandler func(ctx context.Context, args *ArgsReader) (Response, error)
+}
+
+// Response is a response to a Thrift procedure call.
+type Response struct {
+	// Body is the result struct of the function. This is nil for oneway
+	// procedures.
+	Body envelope.Enveloper
+
+	// IsApplicationError is true if Body holds an exception declared in
+	// the Thrift file.
+	IsApplicationError bool
+}
+
+// ArgumentsError is returned by Procedure handlers if the arguments of a
+// request could not be decoded.
+type ArgumentsError struct {
+	Err error
+}
+
+func (e *ArgumentsError) Error() string {
+	return fmt.Sprintf("invalid arguments: %v", e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ArgumentsError) Unwrap() error {
+	return e.Err
+}
+
+// ProcedureName returns the name of the procedure for the given function of
+// the given service.
+func ProcedureName(service, method string) string {
+	return service + "::" + method
+}
+
+// Serve handles a binary-encoded request to this procedure. The response is
+// enveloped only if the request was. Oneway procedures return a nil
+// response.
+func (p Procedure) Serve(ctx context.Context, req []byte) (res []byte, isApplicationError bool, err error) {
+	et := wire.Call
+	if p.OneWay {
+		et = wire.OneWay
+	}
+
+	var (
+		r         Response
+		responder binary.Responder
+	)
+	if p.StreamHandler != nil {
+		body := streamBody{ctx: ctx, p: p}
+		rw, err := binary.Default.ReadRequest(ctx, et, bytes.NewReader(req), &body)
+		if err != nil {
+			return nil, false, &ArgumentsError{Err: err}
+		}
+		if body.err != nil {
+			return nil, false, body.err
+		}
+
+		// All responders returned by the binary protocol can also encode
+		// wire.Values.
+		r, responder = body.res, rw.(binary.Responder)
+	} else {
+		args, rsp, err := binary.Default.DecodeRequest(et, bytes.NewReader(req))
+		if err != nil {
+			return nil, false, &ArgumentsError{Err: err}
+		}
+		responder = rsp
+
+		r, err = p.handle(ctx, args)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if p.OneWay || r.Body == nil {
+		return nil, false, nil
+	}
+
+	v, err := r.Body.ToWire()
+	if err != nil {
+		return nil, false, err
+	}
+
+	var buf bytes.Buffer
+	if err := responder.EncodeResponse(v, wire.Reply, &buf); err != nil {
+		return nil, false, err
+	}
+	return buf.Bytes(), r.IsApplicationError, nil
+}
+
+// Handle calls the procedure with the given arguments struct.
+func (p Procedure) Handle(ctx context.Context, args wire.Value) (Response, error) {
+	if p.StreamHandler == nil {
+		return p.handle(ctx, args)
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Default.Encode(args, &buf); err != nil {
+		return Response{}, &ArgumentsError{Err: err}
+	}
+
+	sr := binary.Default.Reader(&buf)
+	defer sr.Close()
+	return p.handleStream(ctx, sr)
+}
+
+func (p Procedure) handle(ctx context.Context, args wire.Value) (Response, error) {
+	if p.Handler == nil {
+		return Response{}, fmt.Errorf("procedure %q does not have a handler", p.Name)
+	}
+	return p.Handler(ctx, args)
+}
+
+// handleStream calls the StreamHandler with the arguments struct at the
+// head of the given reader, and skips the fields it did not read.
+func (p Procedure) handleStream(ctx context.Context, sr stream.Reader) (Response, error) {
+	if err := sr.ReadStructBegin(); err != nil {
+		return Response{}, &ArgumentsError{Err: err}
+	}
+
+	args := NewArgsReader(sr)
+	r, err := p.StreamHandler(ctx, args)
+	if err != nil {
+		if _, ok := err.(*ArgumentsError); !ok && args.err != nil {
+			err = &ArgumentsError{Err: err}
+		}
+		return Response{}, err
+	}
+
+	if err := args.Discard(); err != nil {
+		return Response{}, &ArgumentsError{Err: err}
+	}
+	return r, nil
+}
+
+// streamBody is a stream.BodyReader which calls a StreamHandler with the
+// arguments struct of a request.
+type streamBody struct {
+	ctx context.Context
+	p   Procedure
+
+	res Response
+	err error
+}
+
+func (b *streamBody) Decode(sr stream.Reader) error {
+	// Errors from the handler are not decoding errors, and must not stop
+	// the rest of the request from being read.
+	b.res, b.err = b.p.handleStream(b.ctx, sr)
+	return nil
+}
+
+// EncodeResponse encodes the body of a response without an envelope.
+func EncodeResponse(r Response) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := envelope.WriteNoEnvelope(binary.Default, &buf, r.Body); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeRequest encodes the arguments of a call to a procedure, with an
+// envelope if enveloped is true. Options such as
+// envelope.WithIdempotencyToken are applied to the request.
+func EncodeRequest(args envelope.Enveloper, enveloped bool, opts ...envelope.WriteOption) ([]byte, error) {
+	var buf bytes.Buffer
+	if enveloped {
+		if err := envelope.Write(binary.Default, &buf, 1, args, opts...); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := envelope.WriteNoEnvelope(binary.Default, &buf, args, opts...); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeResponse decodes the response to a call to a procedure into the
+// result struct of the function. The response must have an envelope if
+// enveloped is true.
+func DecodeResponse(res []byte, enveloped bool, result interface{ FromWire(wire.Value) error }) error {
+	var (
+		v   wire.Value
+		err error
+	)
+	if enveloped {
+		v, _, err = envelope.ReadReply(binary.Default, bytes.NewReader(res))
+	} else {
+		v, err = envelope.ReadNoEnvelope(binary.Default, bytes.NewReader(res))
+	}
+	if err != nil {
+		return err
+	}
+	return result.FromWire(v)
+}
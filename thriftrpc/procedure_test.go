@@ -0,0 +1,332 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEnveloper struct {
+	Name  string
+	Type  wire.EnvelopeType
+	Value wire.Value
+}
+
+func (e fakeEnveloper) MethodName() string { return e.Name }
+
+func (e fakeEnveloper) EnvelopeType() wire.EnvelopeType { return e.Type }
+
+func (e fakeEnveloper) ToWire() (wire.Value, error) { return e.Value, nil }
+
+// wireResult records the struct decoded by DecodeResponse.
+type wireResult struct{ Value wire.Value }
+
+func (r *wireResult) FromWire(w wire.Value) error {
+	r.Value = w
+	return nil
+}
+
+// stringStruct builds a struct holding the given strings in fields
+// numbered from id.
+func stringStruct(id int16, values ...string) wire.Value {
+	fields := make([]wire.Field, len(values))
+	for i, v := range values {
+		fields[i] = wire.Field{ID: id + int16(i), Value: wire.NewValueString(v)}
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+// echoProcedure is a procedure for
+//
+//	string echo(1: string value)
+//
+// which returns its argument.
+var echoProcedure = Procedure{
+	Name: "Store::echo",
+	Handler: func(ctx context.Context, args wire.Value) (Response, error) {
+		fields := args.GetStruct().Fields
+		if len(fields) == 0 {
+			return Response{}, &ArgumentsError{Err: errors.New("value is required")}
+		}
+		return Response{Body: fakeEnveloper{
+			Name:  "echo",
+			Type:  wire.Reply,
+			Value: stringStruct(0, fields[0].Value.GetString()),
+		}}, nil
+	},
+}
+
+// streamEchoProcedure is echoProcedure with a StreamHandler which reads
+// only the first field of the arguments.
+var streamEchoProcedure = Procedure{
+	Name: "Store::echo",
+	StreamHandler: func(ctx context.Context, args *ArgsReader) (Response, error) {
+		var value string
+		for {
+			fh, ok, err := args.Next()
+			if err != nil {
+				return Response{}, err
+			}
+			if !ok {
+				break
+			}
+			if fh.ID != 1 {
+				continue
+			}
+			if value, err = args.Reader().ReadString(); err != nil {
+				return Response{}, &ArgumentsError{Err: err}
+			}
+		}
+		return Response{Body: fakeEnveloper{
+			Name:  "echo",
+			Type:  wire.Reply,
+			Value: stringStruct(0, value),
+		}}, nil
+	},
+}
+
+func TestProcedureServe(t *testing.T) {
+	tests := []struct {
+		desc string
+		give Procedure
+	}{
+		{desc: "handler", give: echoProcedure},
+		{desc: "stream handler", give: streamEchoProcedure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			for _, enveloped := range []bool{true, false} {
+				req, err := EncodeRequest(fakeEnveloper{
+					Name:  "echo",
+					Type:  wire.Call,
+					Value: stringStruct(1, "hello", "ignored"),
+				}, enveloped)
+				require.NoError(t, err)
+
+				res, isAppErr, err := tt.give.Serve(context.Background(), req)
+				require.NoError(t, err, "enveloped: %v", enveloped)
+				assert.False(t, isAppErr)
+
+				var got wireResult
+				require.NoError(t, DecodeResponse(res, enveloped, &got), "enveloped: %v", enveloped)
+				assert.True(t, wire.ValuesAreEqual(stringStruct(0, "hello"), got.Value),
+					"enveloped: %v: unexpected result %v", enveloped, got.Value)
+			}
+		})
+	}
+}
+
+func TestProcedureServeEnvelope(t *testing.T) {
+	req, err := EncodeRequest(fakeEnveloper{
+		Name:  "echo",
+		Type:  wire.Call,
+		Value: stringStruct(1, "hello"),
+	}, true)
+	require.NoError(t, err)
+
+	res, _, err := echoProcedure.Serve(context.Background(), req)
+	require.NoError(t, err)
+
+	e, err := binary.Default.DecodeEnveloped(bytes.NewReader(res))
+	require.NoError(t, err)
+	assert.Equal(t, "echo", e.Name)
+	assert.Equal(t, wire.Reply, e.Type)
+	assert.Equal(t, int32(1), e.SeqID)
+}
+
+func TestProcedureServeOneWay(t *testing.T) {
+	var called bool
+	p := Procedure{
+		Name:   "Store::ping",
+		OneWay: true,
+		Handler: func(ctx context.Context, args wire.Value) (Response, error) {
+			called = true
+			return Response{}, nil
+		},
+	}
+
+	req, err := EncodeRequest(fakeEnveloper{
+		Name:  "ping",
+		Type:  wire.OneWay,
+		Value: stringStruct(1),
+	}, true)
+	require.NoError(t, err)
+
+	res, isAppErr, err := p.Serve(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, called, "handler must be called")
+	assert.Nil(t, res, "oneway procedures must not reply")
+	assert.False(t, isAppErr)
+}
+
+func TestProcedureServeApplicationError(t *testing.T) {
+	p := Procedure{
+		Name: "Store::fail",
+		Handler: func(ctx context.Context, args wire.Value) (Response, error) {
+			return Response{
+				Body: fakeEnveloper{
+					Name:  "fail",
+					Type:  wire.Reply,
+					Value: stringStruct(1, "not found"),
+				},
+				IsApplicationError: true,
+			}, nil
+		},
+	}
+
+	req, err := EncodeRequest(fakeEnveloper{Name: "fail", Type: wire.Call, Value: stringStruct(1)}, false)
+	require.NoError(t, err)
+
+	res, isAppErr, err := p.Serve(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, isAppErr)
+
+	var got wireResult
+	require.NoError(t, DecodeResponse(res, false, &got))
+	assert.True(t, wire.ValuesAreEqual(stringStruct(1, "not found"), got.Value))
+}
+
+func TestProcedureServeErrors(t *testing.T) {
+	errSadness := errors.New("great sadness")
+	failing := Procedure{
+		Name: "Store::fail",
+		Handler: func(context.Context, wire.Value) (Response, error) {
+			return Response{}, errSadness
+		},
+	}
+	streamFailing := Procedure{
+		Name: "Store::fail",
+		StreamHandler: func(context.Context, *ArgsReader) (Response, error) {
+			return Response{}, errSadness
+		},
+	}
+
+	validReq, err := EncodeRequest(fakeEnveloper{Name: "fail", Type: wire.Call, Value: stringStruct(1, "x")}, true)
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc     string
+		give     Procedure
+		req      []byte
+		wantErr  error
+		wantArgs bool // whether an ArgumentsError is expected
+	}{
+		{desc: "handler error", give: failing, req: validReq, wantErr: errSadness},
+		{desc: "stream handler error", give: streamFailing, req: validReq, wantErr: errSadness},
+		{desc: "invalid request", give: echoProcedure, req: []byte{0xff}, wantArgs: true},
+		{desc: "invalid request to stream handler", give: streamEchoProcedure, req: []byte{0xff}, wantArgs: true},
+		{desc: "arguments error from handler", give: echoProcedure, req: validReqWithoutArgs(t), wantArgs: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			res, _, err := tt.give.Serve(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Nil(t, res)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "unexpected error %v", err)
+			}
+			var argsErr *ArgumentsError
+			assert.Equal(t, tt.wantArgs, errors.As(err, &argsErr), "unexpected error %v", err)
+		})
+	}
+}
+
+func validReqWithoutArgs(t *testing.T) []byte {
+	req, err := EncodeRequest(fakeEnveloper{Name: "echo", Type: wire.Call, Value: stringStruct(1)}, true)
+	require.NoError(t, err)
+	return req
+}
+
+func TestProcedureHandle(t *testing.T) {
+	for _, p := range []Procedure{echoProcedure, streamEchoProcedure} {
+		r, err := p.Handle(context.Background(), stringStruct(1, "hello", "ignored"))
+		require.NoError(t, err)
+
+		got, err := r.Body.ToWire()
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(stringStruct(0, "hello"), got))
+	}
+
+	_, err := Procedure{Name: "Store::nothing"}.Handle(context.Background(), stringStruct(1))
+	assert.EqualError(t, err, `procedure "Store::nothing" does not have a handler`)
+}
+
+func TestProcedureStreamHandlerArgumentsError(t *testing.T) {
+	// A struct whose only field is cut off after its header.
+	truncated := []byte{
+		0x0b,       // type:1 = string
+		0x00, 0x01, // id:2 = 1
+	}
+
+	errSadness := errors.New("great sadness")
+	p := Procedure{
+		Name: "Store::fail",
+		StreamHandler: func(ctx context.Context, args *ArgsReader) (Response, error) {
+			for {
+				_, ok, err := args.Next()
+				if err != nil {
+					// Fail with an unrelated error after a failed read.
+					return Response{}, errSadness
+				}
+				if !ok {
+					return Response{}, nil
+				}
+			}
+		},
+	}
+
+	sr := binary.Default.Reader(bytes.NewReader(truncated))
+	defer sr.Close()
+
+	_, err := p.handleStream(context.Background(), sr)
+	var argsErr *ArgumentsError
+	require.True(t, errors.As(err, &argsErr), "unexpected error %v", err)
+	assert.Equal(t, errSadness, argsErr.Err)
+}
+
+func TestEncodeResponse(t *testing.T) {
+	res, err := EncodeResponse(Response{Body: fakeEnveloper{
+		Name:  "echo",
+		Type:  wire.Reply,
+		Value: stringStruct(0, "hello"),
+	}})
+	require.NoError(t, err)
+
+	var got wireResult
+	require.NoError(t, DecodeResponse(res, false, &got))
+	assert.True(t, wire.ValuesAreEqual(stringStruct(0, "hello"), got.Value))
+}
+
+func TestProcedureName(t *testing.T) {
+	assert.Equal(t, "Store::echo", ProcedureName("Store", "echo"))
+	assert.Equal(t, "echo", echoProcedure.MethodName())
+}
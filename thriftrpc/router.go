@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftrpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+// MultiplexedName returns the envelope name for the given method of the
+// given service used by routers with service prefixes. This matches the
+// names sent by Apache Thrift's TMultiplexedProtocol.
+func MultiplexedName(service, method string) string {
+	return service + ":" + method
+}
+
+// MethodName returns the name of the function served by this procedure,
+// without the name of its service.
+func (p Procedure) MethodName() string {
+	_, method := splitProcedureName(p.Name)
+	return method
+}
+
+// RouteNames returns the envelope names under which routers serve this
+// procedure: its method name followed by the method names of its aliases.
+//
+// If service is non-empty, the names are multiplexed: the method name is
+// prefixed with the given service, and each alias with the service it
+// names.
+func (p Procedure) RouteNames(service string) []string {
+	names := make([]string, 0, len(p.Aliases)+1)
+	if service == "" {
+		names = append(names, p.MethodName())
+	} else {
+		names = append(names, MultiplexedName(service, p.MethodName()))
+	}
+
+	for _, alias := range p.Aliases {
+		aliasService, method := splitProcedureName(alias)
+		if service == "" || aliasService == "" {
+			names = append(names, method)
+		} else {
+			names = append(names, MultiplexedName(aliasService, method))
+		}
+	}
+	return names
+}
+
+// splitProcedureName splits a "Service::method" procedure name into its
+// service and method. The service is empty if the name has no service.
+func splitProcedureName(name string) (service, method string) {
+	if i := strings.LastIndex(name, "::"); i >= 0 {
+		return name[:i], name[i+2:]
+	}
+	return "", name
+}
+
+// Router serves requests to procedures of multiple services from one
+// listener, dispatching each request by the method name in its envelope.
+//
+// Code generated by ThriftRW with the --router option declares a NewRouter
+// function which builds a Router for the services of a Thrift file.
+type Router struct {
+	routes map[string]Procedure
+}
+
+// NewRouter builds a Router which serves requests whose envelope names a
+// key of the given map with the corresponding procedure.
+func NewRouter(routes map[string]Procedure) *Router {
+	r := Router{routes: make(map[string]Procedure, len(routes))}
+	for name, p := range routes {
+		r.routes[name] = p
+	}
+	return &r
+}
+
+// Procedure returns the procedure serving requests with the given envelope
+// name.
+func (r *Router) Procedure(name string) (Procedure, bool) {
+	p, ok := r.routes[name]
+	return p, ok
+}
+
+// Serve handles a binary-encoded, enveloped request to one of the
+// procedures of this router.
+//
+// Requests for unknown methods are answered with a TApplicationException
+// of type UNKNOWN_METHOD, except for oneway requests, for which an
+// UnknownMethodError is returned.
+func (r *Router) Serve(ctx context.Context, req []byte) (res []byte, isApplicationError bool, err error) {
+	sr := binary.NewStreamReader(bytes.NewReader(req))
+	eh, err := sr.ReadEnvelopeBegin()
+	sr.Close()
+	if err != nil {
+		return nil, false, &ArgumentsError{Err: err}
+	}
+
+	p, ok := r.routes[eh.Name]
+	if !ok {
+		res, err := unknownMethod(eh.Name, eh.Type, eh.SeqID)
+		return res, false, err
+	}
+	return p.Serve(ctx, req)
+}
+
+// UnknownMethodError is returned by Router.Serve for oneway requests to
+// methods it does not serve.
+type UnknownMethodError struct {
+	Method string
+}
+
+func (e *UnknownMethodError) Error() string {
+	return fmt.Sprintf("unknown method %q", e.Method)
+}
+
+func unknownMethod(name string, et wire.EnvelopeType, seqID int32) ([]byte, error) {
+	err := &UnknownMethodError{Method: name}
+	if et == wire.OneWay {
+		return nil, err
+	}
+
+	typ := exception.ExceptionTypeUnknownMethod
+	v, verr := (&exception.TApplicationException{
+		Message: ptr.String(err.Error()),
+		Type:    &typ,
+	}).ToWire()
+	if verr != nil {
+		return nil, verr
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Default.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  wire.Exception,
+		SeqID: seqID,
+		Value: v,
+	}, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
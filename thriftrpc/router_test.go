@@ -0,0 +1,189 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteNames(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    Procedure
+		service string
+		want    []string
+	}{
+		{
+			desc: "no service",
+			give: Procedure{Name: "Store::get"},
+			want: []string{"get"},
+		},
+		{
+			desc:    "service",
+			give:    Procedure{Name: "Store::get"},
+			service: "Store",
+			want:    []string{"Store:get"},
+		},
+		{
+			desc: "aliases without service",
+			give: Procedure{Name: "Store::get", Aliases: []string{"Cache::fetch", "lookup"}},
+			want: []string{"get", "fetch", "lookup"},
+		},
+		{
+			desc:    "aliases with service",
+			give:    Procedure{Name: "Store::get", Aliases: []string{"Cache::fetch", "lookup"}},
+			service: "Store",
+			want:    []string{"Store:get", "Cache:fetch", "lookup"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.RouteNames(tt.service))
+		})
+	}
+}
+
+// newTestRouter builds a Router which serves the given procedures under
+// all their route names.
+func newTestRouter(service string, procs ...Procedure) *Router {
+	routes := make(map[string]Procedure)
+	for _, p := range procs {
+		for _, name := range p.RouteNames(service) {
+			routes[name] = p
+		}
+	}
+	return NewRouter(routes)
+}
+
+func TestRouterServe(t *testing.T) {
+	echo := echoProcedure
+	echo.Aliases = []string{"Legacy::say"}
+	r := newTestRouter("Store", echo)
+
+	for _, name := range []string{"Store:echo", "Legacy:say"} {
+		t.Run(name, func(t *testing.T) {
+			req, err := EncodeRequest(fakeEnveloper{
+				Name:  name,
+				Type:  wire.Call,
+				Value: stringStruct(1, "hello"),
+			}, true)
+			require.NoError(t, err)
+
+			res, isAppErr, err := r.Serve(context.Background(), req)
+			require.NoError(t, err)
+			assert.False(t, isAppErr)
+
+			var got wireResult
+			require.NoError(t, DecodeResponse(res, true, &got))
+			assert.True(t, wire.ValuesAreEqual(stringStruct(0, "hello"), got.Value),
+				"unexpected result %v", got.Value)
+		})
+	}
+}
+
+func TestRouterProcedure(t *testing.T) {
+	r := newTestRouter("", echoProcedure)
+
+	p, ok := r.Procedure("echo")
+	require.True(t, ok)
+	assert.Equal(t, "Store::echo", p.Name)
+
+	_, ok = r.Procedure("Store:echo")
+	assert.False(t, ok)
+}
+
+func TestRouterUnknownMethod(t *testing.T) {
+	r := newTestRouter("", echoProcedure)
+
+	req, err := EncodeRequest(fakeEnveloper{
+		Name:  "missing",
+		Type:  wire.Call,
+		Value: stringStruct(1),
+	}, true)
+	require.NoError(t, err)
+
+	res, isAppErr, err := r.Serve(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, isAppErr)
+
+	e, err := binary.Default.DecodeEnveloped(bytes.NewReader(res))
+	require.NoError(t, err)
+	assert.Equal(t, "missing", e.Name)
+	assert.Equal(t, wire.Exception, e.Type)
+	assert.Equal(t, int32(1), e.SeqID)
+
+	var exc exception.TApplicationException
+	require.NoError(t, exc.FromWire(e.Value))
+	assert.Equal(t, exception.ExceptionTypeUnknownMethod, exc.GetType())
+	assert.Equal(t, `unknown method "missing"`, exc.GetMessage())
+}
+
+func TestRouterUnknownOneWayMethod(t *testing.T) {
+	r := newTestRouter("", echoProcedure)
+
+	req, err := EncodeRequest(fakeEnveloper{
+		Name:  "missing",
+		Type:  wire.OneWay,
+		Value: stringStruct(1),
+	}, true)
+	require.NoError(t, err)
+
+	res, _, err := r.Serve(context.Background(), req)
+	assert.Nil(t, res)
+
+	var unknown *UnknownMethodError
+	require.True(t, errors.As(err, &unknown), "unexpected error %v", err)
+	assert.Equal(t, "missing", unknown.Method)
+}
+
+func TestRouterInvalidRequest(t *testing.T) {
+	r := newTestRouter("", echoProcedure)
+
+	_, _, err := r.Serve(context.Background(), []byte{0x00})
+	var argsErr *ArgumentsError
+	assert.True(t, errors.As(err, &argsErr), "unexpected error %v", err)
+}
+
+func TestAliased(t *testing.T) {
+	e := Aliased(fakeEnveloper{
+		Name:  "echo",
+		Type:  wire.Reply,
+		Value: stringStruct(0, "hello"),
+	}, "say")
+
+	assert.Equal(t, "say", e.MethodName())
+	assert.Equal(t, wire.Reply, e.EnvelopeType())
+
+	v, err := e.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(stringStruct(0, "hello"), v))
+}
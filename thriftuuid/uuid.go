@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftuuid provides the Go representation of the Thrift uuid type.
+//
+// UUIDs are sent over the wire as 16 bytes of binary data, and written in
+// Thrift files, JSON, and logs in their canonical textual form,
+//
+//	123e4567-e89b-12d3-a456-426614174000
+package thriftuuid
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// Size is the number of bytes in a UUID.
+const Size = 16
+
+// UUID is a value of the Thrift uuid type. Generated code uses it for
+// uuid fields unless they are annotated with another Go type whose
+// underlying type is [16]byte.
+type UUID [Size]byte
+
+// Parse parses a UUID from its canonical textual form. Hexadecimal digits
+// may be in either case.
+func Parse(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("invalid UUID %q: expected the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", s)
+	}
+
+	var j int
+	for i := 0; i < len(s); i += 2 {
+		if s[i] == '-' { // skip separators, which are at even offsets
+			i++
+		}
+		if _, err := hex.Decode(u[j:j+1], []byte(s[i:i+2])); err != nil {
+			return UUID{}, fmt.Errorf("invalid UUID %q: %v", s, err)
+		}
+		j++
+	}
+	return u, nil
+}
+
+// MustParse is like Parse but panics if the UUID is invalid. It is used to
+// define UUID constants.
+func MustParse(s string) UUID {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// FromBytes builds a UUID from its binary representation, as sent over the
+// wire.
+func FromBytes(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) != Size {
+		return u, fmt.Errorf("invalid UUID: expected %d bytes, got %d", Size, len(b))
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// Bytes returns the binary representation of the UUID, as sent over the
+// wire.
+func (u UUID) Bytes() []byte {
+	return u[:]
+}
+
+// String returns the canonical textual form of the UUID.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// MarshalText encodes the UUID in its canonical textual form, as it is
+// written in JSON.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText decodes a UUID from its canonical textual form.
+func (u *UUID) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
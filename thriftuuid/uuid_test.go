@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftuuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	want := UUID{
+		0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3,
+		0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00,
+	}
+
+	u, err := Parse("123e4567-e89b-12d3-a456-426614174000")
+	require.NoError(t, err)
+	assert.Equal(t, want, u)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", u.String())
+
+	u, err = Parse("123E4567-E89B-12D3-A456-426614174000")
+	require.NoError(t, err)
+	assert.Equal(t, want, u)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		give    string
+		wantErr string
+	}{
+		{"", "expected the form"},
+		{"123e4567e89b12d3a456426614174000", "expected the form"},
+		{"123e4567-e89b-12d3-a456-42661417400", "expected the form"},
+		{"123e4567-e89b-12d3-a456_426614174000", "expected the form"},
+		{"123e4567-e89b-12d3-a456-42661417400g", "invalid byte"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			_, err := Parse(tt.give)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Panics(t, func() { MustParse(tt.give) })
+		})
+	}
+}
+
+func TestFromBytes(t *testing.T) {
+	u := MustParse("00112233-4455-6677-8899-aabbccddeeff")
+
+	got, err := FromBytes(u.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, u, got)
+
+	_, err = FromBytes(u[:15])
+	assert.EqualError(t, err, "invalid UUID: expected 16 bytes, got 15")
+}
+
+func TestJSON(t *testing.T) {
+	u := MustParse("00112233-4455-6677-8899-aabbccddeeff")
+
+	b, err := json.Marshal(u)
+	require.NoError(t, err)
+	assert.Equal(t, `"00112233-4455-6677-8899-aabbccddeeff"`, string(b))
+
+	var got UUID
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, u, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`"foo"`), &got))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// ConstMutation reports modifications of constants declared by generated
+// packages.
+//
+// ThriftRW generates Thrift constants as package-level variables, so
+// containers and structs held by them are shared by every user of the
+// package. This reports assignments and increments through them, deletions
+// from them, and calls to their Reset method. Modify a copy instead.
+var ConstMutation = &analysis.Analyzer{
+	Name:     "constmutation",
+	Doc:      "report modifications of constants generated by ThriftRW",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runConstMutation,
+}
+
+func runConstMutation(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodes := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.IncDecStmt)(nil),
+		(*ast.CallExpr)(nil),
+	}
+	inspect.Preorder(nodes, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				return
+			}
+			for _, lhs := range n.Lhs {
+				checkConstMutation(pass, lhs)
+			}
+		case *ast.IncDecStmt:
+			checkConstMutation(pass, n.X)
+		case *ast.CallExpr:
+			switch fun := astutil.Unparen(n.Fun).(type) {
+			case *ast.Ident:
+				if b, ok := pass.TypesInfo.Uses[fun].(*types.Builtin); ok && b.Name() == "delete" && len(n.Args) > 0 {
+					checkConstMutation(pass, n.Args[0])
+				}
+			case *ast.SelectorExpr:
+				if fun.Sel.Name != "Reset" {
+					return
+				}
+				if sel, ok := pass.TypesInfo.Selections[fun]; ok && sel.Kind() == types.MethodVal {
+					checkConstMutation(pass, fun.X)
+				}
+			}
+		}
+	})
+	return nil, nil
+}
+
+// checkConstMutation reports a diagnostic if the given expression, which is
+// being modified, refers to a generated constant or to a value reachable
+// from one.
+func checkConstMutation(pass *analysis.Pass, expr ast.Expr) {
+	v, ok := rootVar(pass, expr)
+	if !ok || v.Name() == "ThriftModule" || isHelper(v.Name()) {
+		return
+	}
+	if v.Pkg() == nil || v.Parent() != v.Pkg().Scope() || !v.Exported() || !isGeneratedPackage(v.Pkg()) {
+		return
+	}
+
+	pass.Reportf(expr.Pos(), "modification of generated constant %v.%v: modify a copy instead",
+		v.Pkg().Name(), v.Name())
+}
+
+// rootVar returns the variable that the given expression indexes into or
+// selects fields from.
+func rootVar(pass *analysis.Pass, expr ast.Expr) (*types.Var, bool) {
+	for {
+		switch e := expr.(type) {
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.SelectorExpr:
+			sel, ok := pass.TypesInfo.Selections[e]
+			if !ok {
+				// Qualified identifier.
+				v, ok := pass.TypesInfo.Uses[e.Sel].(*types.Var)
+				return v, ok
+			}
+			if sel.Kind() != types.FieldVal {
+				return nil, false
+			}
+			expr = e.X
+		case *ast.Ident:
+			v, ok := pass.TypesInfo.Uses[e].(*types.Var)
+			return v, ok
+		default:
+			return nil, false
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftvet
+
+import (
+	"go/types"
+	"strings"
+)
+
+const (
+	_streamPath        = "go.uber.org/thriftrw/protocol/stream"
+	_binaryPath        = "go.uber.org/thriftrw/protocol/binary"
+	_thriftreflectPath = "go.uber.org/thriftrw/thriftreflect"
+)
+
+// isGeneratedPackage reports whether the given package was generated by
+// ThriftRW. Generated packages declare a ThriftModule variable describing
+// the Thrift file they were generated from.
+func isGeneratedPackage(pkg *types.Package) bool {
+	if pkg == nil {
+		return false
+	}
+	v, ok := pkg.Scope().Lookup("ThriftModule").(*types.Var)
+	if !ok {
+		return false
+	}
+	ptr, ok := v.Type().(*types.Pointer)
+	return ok && isNamed(ptr.Elem(), _thriftreflectPath, "ThriftModule")
+}
+
+// generatedStruct returns the named type if the given type is a struct,
+// union, or exception generated by ThriftRW.
+func generatedStruct(t types.Type) (*types.Named, bool) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, false
+	}
+	if !isGeneratedPackage(named.Obj().Pkg()) {
+		return nil, false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), false, named.Obj().Pkg(), "Equals")
+	_, ok = obj.(*types.Func)
+	return named, ok
+}
+
+// isStream reports whether the given type is a stream reader or writer which
+// must be closed once the caller is done with it.
+func isStream(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return isNamed(ptr.Elem(), _binaryPath, "StreamWriter") ||
+			isNamed(ptr.Elem(), _binaryPath, "StreamReader")
+	}
+	return isNamed(t, _streamPath, "Writer") || isNamed(t, _streamPath, "Reader")
+}
+
+func isNamed(t types.Type, pkgPath, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// qualifier returns a types.Qualifier which refers to packages other than
+// the current one by name.
+func qualifier(current *types.Package) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == current {
+			return ""
+		}
+		return p.Name()
+	}
+}
+
+// isHelper reports whether the given name is that of a generated
+// <Service>_<Function>_Helper variable.
+func isHelper(name string) bool {
+	return strings.HasSuffix(name, "_Helper")
+}
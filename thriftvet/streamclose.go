@@ -0,0 +1,207 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftvet
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// StreamClose reports stream readers and writers which are never closed.
+//
+// Streams returned by binary.NewStreamWriter, binary.NewStreamReader, and
+// the Writer and Reader methods of a stream.Protocol are pooled and must be
+// closed once the caller is done with them. Writers also flush buffered
+// output on Close.
+//
+// A stream stored in a local variable is considered handled if its Close
+// method is referenced anywhere in the enclosing function, or if the
+// variable is returned, assigned elsewhere, sent on a channel, or placed in
+// a composite literal.
+var StreamClose = &analysis.Analyzer{
+	Name:     "streamclose",
+	Doc:      "report ThriftRW stream readers and writers that are never closed",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runStreamClose,
+}
+
+func runStreamClose(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		call := n.(*ast.CallExpr)
+		idx, kind, ok := streamResult(pass, call)
+		if !ok {
+			return true
+		}
+
+		var lhs ast.Expr
+		switch parent := stack[len(stack)-2].(type) {
+		case *ast.ExprStmt:
+			pass.Reportf(call.Pos(), "stream %v returned by %v is discarded without being closed",
+				kind, types.ExprString(call.Fun))
+			return true
+		case *ast.AssignStmt:
+			lhs = assignedTo(parent.Lhs, parent.Rhs, call, idx)
+		case *ast.ValueSpec:
+			var names []ast.Expr
+			for _, name := range parent.Names {
+				names = append(names, name)
+			}
+			lhs = assignedTo(names, parent.Values, call, idx)
+		}
+
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			// Passed to a function, returned, or stored elsewhere.
+			return true
+		}
+		if isBlank(ident) {
+			pass.Reportf(call.Pos(), "stream %v returned by %v is discarded without being closed",
+				kind, types.ExprString(call.Fun))
+			return true
+		}
+
+		v, ok := pass.TypesInfo.ObjectOf(ident).(*types.Var)
+		if !ok || v.Parent() == pass.Pkg.Scope() {
+			return true
+		}
+
+		if body := enclosingFuncBody(stack); body != nil && !isClosedOrEscapes(pass, body, v) {
+			pass.Reportf(call.Pos(), "stream %v %v is never closed", kind, v.Name())
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// streamResult returns the index of the stream returned by the given call,
+// if any, and whether it is a "reader" or a "writer".
+func streamResult(pass *analysis.Pass, call *ast.CallExpr) (idx int, kind string, ok bool) {
+	if tv := pass.TypesInfo.Types[call.Fun]; tv.IsType() {
+		return 0, "", false // conversion
+	}
+
+	results := []types.Type{pass.TypesInfo.TypeOf(call)}
+	if tuple, ok := results[0].(*types.Tuple); ok {
+		results = results[:0]
+		for i := 0; i < tuple.Len(); i++ {
+			results = append(results, tuple.At(i).Type())
+		}
+	}
+
+	for i, t := range results {
+		if isStream(t) {
+			kind = "reader"
+			if strings.HasSuffix(types.TypeString(t, nil), "Writer") {
+				kind = "writer"
+			}
+			return i, kind, true
+		}
+	}
+	return 0, "", false
+}
+
+// assignedTo returns the expression that the idx-th result of the given call
+// is assigned to.
+func assignedTo(lhs, rhs []ast.Expr, call *ast.CallExpr, idx int) ast.Expr {
+	if len(rhs) == 1 && len(lhs) > idx {
+		return lhs[idx]
+	}
+	for i, e := range rhs {
+		if e == call && i < len(lhs) {
+			return lhs[i]
+		}
+	}
+	return nil
+}
+
+// enclosingFuncBody returns the body of the top-level function declaration
+// in the given stack. Closures are searched with their enclosing function
+// because they may close streams created outside them, and vice versa.
+func enclosingFuncBody(stack []ast.Node) *ast.BlockStmt {
+	for _, n := range stack {
+		if decl, ok := n.(*ast.FuncDecl); ok {
+			return decl.Body
+		}
+	}
+	return nil
+}
+
+// isClosedOrEscapes reports whether the Close method of the given variable
+// is referenced in the given function body, or the variable is handed off
+// somewhere that becomes responsible for closing it.
+func isClosedOrEscapes(pass *analysis.Pass, body *ast.BlockStmt, v *types.Var) bool {
+	is := func(e ast.Expr) bool {
+		ident, ok := e.(*ast.Ident)
+		return ok && pass.TypesInfo.ObjectOf(ident) == v
+	}
+	anyIs := func(es []ast.Expr) bool {
+		for _, e := range es {
+			if kv, ok := e.(*ast.KeyValueExpr); ok {
+				e = kv.Value
+			}
+			if is(e) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var found bool
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			found = n.Sel.Name == "Close" && is(n.X)
+		case *ast.ReturnStmt:
+			found = anyIs(n.Results)
+		case *ast.AssignStmt:
+			for i, rhs := range n.Rhs {
+				blank := len(n.Lhs) == len(n.Rhs) && isBlank(n.Lhs[i])
+				found = found || (is(rhs) && !blank)
+			}
+		case *ast.ValueSpec:
+			found = anyIs(n.Values)
+		case *ast.CompositeLit:
+			found = anyIs(n.Elts)
+		case *ast.SendStmt:
+			found = is(n.Value)
+		}
+		return !found
+	})
+	return found
+}
+
+func isBlank(e ast.Expr) bool {
+	ident, ok := e.(*ast.Ident)
+	return ok && ident.Name == "_"
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// StructCompare reports comparisons of generated structs with == or !=.
+//
+// Comparing values compares every field by value, including pointers to
+// optional fields, so two structs holding the same data may compare unequal.
+// Comparing pointers compares identity. Generated structs have an Equals
+// method which compares them by their contents.
+var StructCompare = &analysis.Analyzer{
+	Name:     "structcompare",
+	Doc:      "report comparisons of ThriftRW structs with == or !=",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runStructCompare,
+}
+
+func runStructCompare(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.BinaryExpr)(nil)}, func(n ast.Node) {
+		expr := n.(*ast.BinaryExpr)
+		if expr.Op != token.EQL && expr.Op != token.NEQ {
+			return
+		}
+		if isNil(pass, expr.X) || isNil(pass, expr.Y) {
+			return
+		}
+
+		t := pass.TypesInfo.TypeOf(expr.X)
+		kind := "values"
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem()
+			kind = "pointers"
+		}
+		named, ok := generatedStruct(t)
+		if !ok {
+			return
+		}
+
+		pass.Reportf(expr.OpPos, "comparison of %v %v with %v: use Equals instead",
+			types.TypeString(named, qualifier(pass.Pkg)), kind, expr.Op)
+	})
+	return nil, nil
+}
+
+func isNil(pass *analysis.Pass, expr ast.Expr) bool {
+	return pass.TypesInfo.Types[expr].IsNil()
+}
@@ -0,0 +1,30 @@
+package constmutation
+
+import "kv"
+
+func mutate(i int) {
+	kv.DefaultItem.Key = "foo"    // want `modification of generated constant kv.DefaultItem: modify a copy instead`
+	kv.DefaultKeys[i] = "c"       // want `modification of generated constant kv.DefaultKeys: modify a copy instead`
+	kv.Limits["a"]++              // want `modification of generated constant kv.Limits: modify a copy instead`
+	delete(kv.Limits, "a")        // want `modification of generated constant kv.Limits: modify a copy instead`
+	kv.DefaultItem.Reset()        // want `modification of generated constant kv.DefaultItem: modify a copy instead`
+	kv.Total = 1                  // want `modification of generated constant kv.Total: modify a copy instead`
+	(*kv.DefaultItem).Value = nil // want `modification of generated constant kv.DefaultItem: modify a copy instead`
+}
+
+var local = map[kv.Key]int32{}
+
+func allowed(i int) {
+	item := *kv.DefaultItem
+	item.Key = "foo"
+	item.Reset()
+
+	keys := append([]kv.Key(nil), kv.DefaultKeys...)
+	keys[i] = "c"
+
+	local["a"] = kv.Limits["a"]
+	delete(local, "a")
+
+	kv.KeyValue_Get_Helper.Args = nil
+	kv.ThriftModule.Name = "other"
+}
@@ -0,0 +1,27 @@
+package binary
+
+import "go.uber.org/thriftrw/protocol/stream"
+
+type StreamWriter struct{ w stream.IOWriter }
+
+func NewStreamWriter(w stream.IOWriter) *StreamWriter { return &StreamWriter{w: w} }
+
+func (sw *StreamWriter) WriteBool(bool) error { return nil }
+
+func (sw *StreamWriter) Close() error { return nil }
+
+type StreamReader struct{ r stream.IOReader }
+
+func NewStreamReader(r stream.IOReader) *StreamReader { return &StreamReader{r: r} }
+
+func (sr *StreamReader) ReadBool() (bool, error) { return false, nil }
+
+func (sr *StreamReader) Close() error { return nil }
+
+type protocol struct{}
+
+var Default stream.Protocol = protocol{}
+
+func (protocol) Writer(w stream.IOWriter) stream.Writer { return NewStreamWriter(w) }
+
+func (protocol) Reader(r stream.IOReader) stream.Reader { return NewStreamReader(r) }
@@ -0,0 +1,25 @@
+package stream
+
+type Protocol interface {
+	Writer(w IOWriter) Writer
+	Reader(r IOReader) Reader
+}
+
+// IOWriter and IOReader stand in for io.Writer and io.Reader.
+type IOWriter interface {
+	Write([]byte) (int, error)
+}
+
+type IOReader interface {
+	Read([]byte) (int, error)
+}
+
+type Writer interface {
+	WriteBool(bool) error
+	Close() error
+}
+
+type Reader interface {
+	ReadBool() (bool, error)
+	Close() error
+}
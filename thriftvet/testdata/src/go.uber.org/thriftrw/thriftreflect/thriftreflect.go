@@ -0,0 +1,5 @@
+package thriftreflect
+
+type ThriftModule struct {
+	Name string
+}
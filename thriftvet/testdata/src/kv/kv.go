@@ -0,0 +1,63 @@
+// Package kv resembles a package generated by ThriftRW.
+package kv
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+type Item struct {
+	Key   string  `json:"key,required"`
+	Value *string `json:"value,omitempty"`
+}
+
+func (v *Item) ToWire() (string, error) { return v.Key, nil }
+
+func (v *Item) Equals(rhs *Item) bool { return v.Key == rhs.Key }
+
+func (v *Item) Reset() { *v = Item{} }
+
+type Value struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *int64  `json:"intValue,omitempty"`
+	ItemValue   *Item   `json:"itemValue,omitempty"`
+}
+
+func (v *Value) ToWire() (string, error) {
+	var i int32
+	if v.StringValue != nil {
+		i++
+	}
+	if v.IntValue != nil {
+		i++
+	}
+	if v.ItemValue != nil {
+		i++
+	}
+	if i != 1 {
+		return "", errorf("Value should have exactly one field: got %v fields", i)
+	}
+	return "", nil
+}
+
+func (v *Value) Equals(rhs *Value) bool { return true }
+
+type valueError string
+
+func (e valueError) Error() string { return string(e) }
+
+// errorf stands in for fmt.Errorf.
+func errorf(format string, args ...interface{}) error { return valueError(format) }
+
+type Key string
+
+var DefaultItem *Item = &Item{Key: "default"}
+
+var DefaultKeys []Key = []Key{"a", "b"}
+
+var Limits map[Key]int32 = map[Key]int32{"a": 1}
+
+var Total int32 = 42
+
+var KeyValue_Get_Helper = struct {
+	Args func(key Key) *Item
+}{}
+
+var ThriftModule = &thriftreflect.ThriftModule{Name: "kv"}
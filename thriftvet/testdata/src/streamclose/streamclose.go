@@ -0,0 +1,82 @@
+package streamclose
+
+import (
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+)
+
+type buffer struct{}
+
+func (buffer) Write(b []byte) (int, error) { return len(b), nil }
+
+func closed() error {
+	var buf buffer
+	sw := binary.NewStreamWriter(buf)
+	defer sw.Close()
+	return sw.WriteBool(true)
+}
+
+func closedInClosure(r stream.IOReader) (bool, error) {
+	sr := binary.Default.Reader(r)
+	defer func() {
+		_ = sr.Close()
+	}()
+	return sr.ReadBool()
+}
+
+func closedLater(w stream.IOWriter) error {
+	var sw stream.Writer = binary.Default.Writer(w)
+	if err := sw.WriteBool(true); err != nil {
+		sw.Close()
+		return err
+	}
+	return sw.Close()
+}
+
+func returned(w stream.IOWriter) stream.Writer {
+	sw := binary.NewStreamWriter(w)
+	return sw
+}
+
+type holder struct{ sw stream.Writer }
+
+func stored(w stream.IOWriter) *holder {
+	sw := binary.NewStreamWriter(w)
+	return &holder{sw: sw}
+}
+
+func (h *holder) field(w stream.IOWriter) {
+	h.sw = binary.NewStreamWriter(w)
+}
+
+func notClosed(w stream.IOWriter) error {
+	sw := binary.NewStreamWriter(w) // want `stream writer sw is never closed`
+	return sw.WriteBool(true)
+}
+
+func readerNotClosed(r stream.IOReader) (bool, error) {
+	var sr = binary.Default.Reader(r) // want `stream reader sr is never closed`
+	return sr.ReadBool()
+}
+
+func discarded(w stream.IOWriter) {
+	binary.NewStreamWriter(w)    // want `stream writer returned by binary.NewStreamWriter is discarded without being closed`
+	_ = binary.Default.Writer(w) // want `stream writer returned by binary.Default.Writer is discarded without being closed`
+}
+
+func newWriter(w stream.IOWriter) (stream.Writer, error) {
+	return binary.NewStreamWriter(w), nil
+}
+
+func tuple(w stream.IOWriter) error {
+	sw, err := newWriter(w) // want `stream writer sw is never closed`
+	if err != nil {
+		return err
+	}
+	return sw.WriteBool(false)
+}
+
+func ignored(w stream.IOWriter) {
+	sw := binary.NewStreamWriter(w) // want `stream writer sw is never closed`
+	_ = sw
+}
@@ -0,0 +1,18 @@
+package structcompare
+
+import "kv"
+
+type local struct{ Key string }
+
+func compare(a, b kv.Item, p, q *kv.Item, l, m local) []bool {
+	return []bool{
+		a == b,   // want `comparison of kv.Item values with ==: use Equals instead`
+		p != q,   // want `comparison of kv.Item pointers with !=: use Equals instead`
+		*p == *q, // want `comparison of kv.Item values with ==: use Equals instead`
+		p == nil,
+		nil != q,
+		a.Equals(&b),
+		l == m,
+		a.Key == b.Key,
+	}
+}
@@ -0,0 +1,18 @@
+package unionfields
+
+import "kv"
+
+func literals(s string, i int64) []*kv.Value {
+	return []*kv.Value{
+		{StringValue: &s},
+		{IntValue: &i, StringValue: nil},
+		{},
+		{StringValue: &s, IntValue: &i}, // want `union kv.Value must have exactly one field set: got StringValue, IntValue`
+		&kv.Value{IntValue: &i, ItemValue: &kv.Item{Key: s}}, // want `union kv.Value must have exactly one field set: got IntValue, ItemValue`
+		{&s, nil, &kv.Item{}},                                // want `union kv.Value must have exactly one field set: got StringValue, ItemValue`
+	}
+}
+
+func structs(s string) kv.Item {
+	return kv.Item{Key: s, Value: &s}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftvet provides analyzers which report common misuse of code
+// generated by ThriftRW.
+//
+//   - streamclose reports stream readers and writers that are never closed.
+//   - structcompare reports comparisons of generated structs with == or !=.
+//   - constmutation reports writes to constants declared by generated
+//     packages.
+//   - unionfields reports literals of generated unions which set more than
+//     one field.
+//
+// Analyzers holds all of these alongside the enumcheck analyzer. Use
+// cmd/thriftrw-vet to run them, or add them to a multichecker.
+package thriftvet
+
+import (
+	"go.uber.org/thriftrw/enumcheck"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzers is the list of analyzers run by thriftrw-vet.
+var Analyzers = []*analysis.Analyzer{
+	ConstMutation,
+	enumcheck.Analyzer,
+	StreamClose,
+	StructCompare,
+	UnionFields,
+}
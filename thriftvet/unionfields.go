@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// UnionFields reports literals of generated unions which set more than one
+// field. Such values fail to serialize.
+//
+// Unions are recognized while analyzing the generated package by the check
+// in their ToWire method, so the generated package must be analyzed
+// alongside its users. Drivers like go vet and multichecker do this.
+var UnionFields = &analysis.Analyzer{
+	Name:      "unionfields",
+	Doc:       "report literals of ThriftRW unions that set more than one field",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       runUnionFields,
+	FactTypes: []analysis.Fact{new(isUnion)},
+}
+
+// isUnion is exported for the type names of generated unions.
+type isUnion bool
+
+func (*isUnion) AFact() {}
+
+func (*isUnion) String() string { return "union" }
+
+// _unionCheck is part of the error message returned by the ToWire method of
+// generated unions that do not have exactly one field set.
+const _unionCheck = " should have exactly one field: got %v fields"
+
+func runUnionFields(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	if isGeneratedPackage(pass.Pkg) {
+		exportUnions(pass, inspect)
+	}
+
+	inspect.Preorder([]ast.Node{(*ast.CompositeLit)(nil)}, func(n ast.Node) {
+		lit := n.(*ast.CompositeLit)
+		t := pass.TypesInfo.TypeOf(lit)
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem() // &T elided in a container literal
+		}
+		named, ok := generatedStruct(t)
+		if !ok || !pass.ImportObjectFact(named.Obj(), new(isUnion)) {
+			return
+		}
+
+		st := named.Underlying().(*types.Struct)
+		var fields []string
+		for i, elt := range lit.Elts {
+			name := ""
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+				name = kv.Key.(*ast.Ident).Name
+			} else if i < st.NumFields() {
+				name = st.Field(i).Name()
+			}
+			if !isNil(pass, elt) {
+				fields = append(fields, name)
+			}
+		}
+
+		if len(fields) > 1 {
+			pass.Reportf(lit.Pos(), "union %v must have exactly one field set: got %v",
+				types.TypeString(named, qualifier(pass.Pkg)), strings.Join(fields, ", "))
+		}
+	})
+	return nil, nil
+}
+
+// exportUnions exports an isUnion fact for every type in the current package
+// whose ToWire method checks that it has exactly one field set.
+func exportUnions(pass *analysis.Pass, inspect *inspector.Inspector) {
+	inspect.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		decl := n.(*ast.FuncDecl)
+		if decl.Recv == nil || decl.Body == nil || decl.Name.Name != "ToWire" {
+			return
+		}
+
+		t := pass.TypesInfo.TypeOf(decl.Recv.List[0].Type)
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		named, ok := t.(*types.Named)
+		if !ok {
+			return
+		}
+
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if ok && lit.Kind == token.STRING && strings.Contains(lit.Value, _unionCheck) {
+				u := isUnion(true)
+				pass.ExportObjectFact(named.Obj(), &u)
+				return false
+			}
+			return true
+		})
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftws carries enveloped Thrift messages over WebSocket
+// connections, one message per binary frame.
+//
+// Messages are encoded with the Thrift binary protocol. A Client may have
+// many calls in flight over one connection: replies are matched to calls by
+// their sequence IDs, and the Server handles the requests of a connection
+// concurrently.
+//
+//	http.Handle("/thrift", thriftws.NewServer(handler))
+//
+//	client, err := thriftws.Dial(ctx, "ws://localhost:8080/thrift", "http://localhost/")
+//	if err != nil {
+//		return err
+//	}
+//	defer client.Close()
+//	res, err := client.Call(ctx, "getValue", args)
+package thriftws
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+	"golang.org/x/net/websocket"
+)
+
+// ErrClientClosed is returned by calls made on a closed Client, and by calls
+// still waiting for a reply when the Client is closed.
+var ErrClientClosed = errors.New("thriftws: client is closed")
+
+var noDeadline time.Time
+
+// Client sends enveloped requests over a WebSocket connection.
+//
+// Client is safe for concurrent use.
+type Client struct {
+	ws *websocket.Conn
+
+	mu      sync.Mutex
+	seqID   int32
+	pending map[int32]chan reply
+	err     error // set once the connection is unusable
+}
+
+type reply struct {
+	Value wire.Value
+	Err   error
+}
+
+// Dial opens a WebSocket connection to the given ws:// or wss:// URL and
+// returns a Client for it. The origin is sent to the server as the Origin
+// header of the handshake.
+//
+// The context bounds only the connection and the handshake.
+func Dial(ctx context.Context, url, origin string) (*Client, error) {
+	config, err := websocket.NewConfig(url, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	var conn net.Conn
+	switch loc := config.Location; loc.Scheme {
+	case "ws":
+		var d net.Dialer
+		conn, err = d.DialContext(ctx, "tcp", hostPort(loc.Hostname(), loc.Port(), "80"))
+	case "wss":
+		d := tls.Dialer{Config: &tls.Config{ServerName: loc.Hostname()}}
+		conn, err = d.DialContext(ctx, "tcp", hostPort(loc.Hostname(), loc.Port(), "443"))
+	default:
+		return nil, fmt.Errorf("thriftws: unsupported URL scheme %q", loc.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// websocket.NewClient doesn't accept a context, so the handshake is
+	// bounded with the deadline of the context instead.
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	ws, err := websocket.NewClient(config, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.SetDeadline(noDeadline); err != nil {
+		ws.Close()
+		return nil, err
+	}
+
+	return NewClient(ws), nil
+}
+
+func hostPort(host, port, defaultPort string) string {
+	if port == "" {
+		port = defaultPort
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// NewClient builds a Client over an established WebSocket connection. The
+// Client takes ownership of the connection.
+func NewClient(ws *websocket.Conn) *Client {
+	c := &Client{
+		ws:      ws,
+		pending: make(map[int32]chan reply),
+	}
+	go c.readLoop()
+	return c
+}
+
+// Call sends a request to the method with the given name and body, and
+// waits for its reply.
+//
+// TApplicationExceptions sent by the server are returned as errors.
+func (c *Client) Call(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	ch := make(chan reply, 1)
+
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return wire.Value{}, c.err
+	}
+	seqID := c.nextSeqID()
+	c.pending[seqID] = ch
+	c.mu.Unlock()
+
+	err := c.write(wire.Envelope{
+		Name:  name,
+		Type:  wire.Call,
+		SeqID: seqID,
+		Value: body,
+	})
+	if err != nil {
+		c.forget(seqID)
+		return wire.Value{}, err
+	}
+
+	select {
+	case r := <-ch:
+		return r.Value, r.Err
+	case <-ctx.Done():
+		c.forget(seqID)
+		return wire.Value{}, ctx.Err()
+	}
+}
+
+// CallOneWay sends a request to the oneway method with the given name and
+// body. No reply is expected for oneway requests.
+func (c *Client) CallOneWay(ctx context.Context, name string, body wire.Value) error {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return c.err
+	}
+	seqID := c.nextSeqID()
+	c.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return c.write(wire.Envelope{
+		Name:  name,
+		Type:  wire.OneWay,
+		SeqID: seqID,
+		Value: body,
+	})
+}
+
+// Close closes the connection. Calls waiting for a reply fail with
+// ErrClientClosed.
+func (c *Client) Close() error {
+	c.fail(ErrClientClosed)
+	return c.ws.Close()
+}
+
+// nextSeqID returns the sequence ID for a new request. c.mu must be held.
+func (c *Client) nextSeqID() int32 {
+	c.seqID++
+	if c.seqID <= 0 {
+		// Sequence IDs wrapped around. Zero is skipped so that it may
+		// denote replies without a usable sequence ID.
+		c.seqID = 1
+	}
+	return c.seqID
+}
+
+func (c *Client) forget(seqID int32) {
+	c.mu.Lock()
+	delete(c.pending, seqID)
+	c.mu.Unlock()
+}
+
+func (c *Client) write(e wire.Envelope) error {
+	var buf bytes.Buffer
+	if err := binary.Default.EncodeEnveloped(e, &buf); err != nil {
+		return err
+	}
+	return websocket.Message.Send(c.ws, buf.Bytes())
+}
+
+// fail marks the Client unusable with the given error, unless it already
+// is, and fails all pending calls.
+func (c *Client) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err == nil {
+		c.err = err
+	}
+	for seqID, ch := range c.pending {
+		ch <- reply{Err: c.err}
+		delete(c.pending, seqID)
+	}
+}
+
+func (c *Client) readLoop() {
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(c.ws, &msg); err != nil {
+			c.fail(fmt.Errorf("thriftws: connection failed: %v", err))
+			return
+		}
+
+		v, seqID, err := envelope.ReadReply(binary.Default, bytes.NewReader(msg))
+		if seqID == 0 {
+			if err == nil {
+				err = errors.New("sequence ID 0 was not used by any request")
+			}
+			c.fail(fmt.Errorf("thriftws: invalid reply: %v", err))
+			c.ws.Close()
+			return
+		}
+
+		c.mu.Lock()
+		ch, ok := c.pending[seqID]
+		delete(c.pending, seqID)
+		c.mu.Unlock()
+
+		// Replies to calls that were abandoned are dropped.
+		if ok {
+			ch <- reply{Value: v, Err: err}
+		}
+	}
+}
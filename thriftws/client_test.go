@@ -0,0 +1,219 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+func stringStruct(s string) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(s)},
+	}})
+}
+
+// startServer starts a WebSocket server for the given Handler and returns its URL.
+func startServer(t *testing.T, h Handler, opts ...ServerOption) string {
+	srv := httptest.NewServer(NewServer(h, opts...))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func dial(t *testing.T, url string) *Client {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	c, err := Dial(ctx, url, "http://localhost/")
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func echoHandler(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	switch name {
+	case "echo":
+		return body, nil
+	case "fail":
+		return wire.Value{}, errors.New("great sadness")
+	default:
+		return wire.Value{}, ErrUnknownMethod(name)
+	}
+}
+
+func TestCall(t *testing.T) {
+	c := dial(t, startServer(t, HandlerFunc(echoHandler)))
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		got, err := c.Call(ctx, "echo", stringStruct("hello"))
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(stringStruct("hello"), got))
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		_, err := c.Call(ctx, "fail", stringStruct("hello"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+		assert.Contains(t, err.Error(), "INTERNAL_ERROR")
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		_, err := c.Call(ctx, "foo", stringStruct("hello"))
+		require.Error(t, err)
+		assert.EqualError(t, err, `TApplicationException{Message: unknown method "foo", Type: UNKNOWN_METHOD}`)
+	})
+}
+
+func TestConcurrentCalls(t *testing.T) {
+	// Requests are held until all of them have arrived, so replies can
+	// only be received if requests are handled concurrently.
+	const n = 10
+	var arrived sync.WaitGroup
+	arrived.Add(n)
+	h := HandlerFunc(func(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+		arrived.Done()
+		arrived.Wait()
+		return body, nil
+	})
+
+	c := dial(t, startServer(t, h))
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			want := stringStruct(strings.Repeat("x", i))
+			got, err := c.Call(context.Background(), "echo", want)
+			if assert.NoError(t, err) {
+				assert.True(t, wire.ValuesAreEqual(want, got), "reply must match request %d", i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCallOneWay(t *testing.T) {
+	received := make(chan wire.Value, 1)
+	h := HandlerFunc(func(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+		assert.Equal(t, "notify", name)
+		received <- body
+		return wire.Value{}, errors.New("ignored")
+	})
+
+	c := dial(t, startServer(t, h))
+	require.NoError(t, c.CallOneWay(context.Background(), "notify", stringStruct("hi")))
+
+	select {
+	case got := <-received:
+		assert.True(t, wire.ValuesAreEqual(stringStruct("hi"), got))
+	case <-time.After(time.Second):
+		t.Fatal("oneway request was not received")
+	}
+}
+
+func TestCallCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	h := HandlerFunc(func(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+		<-release
+		return body, nil
+	})
+
+	c := dial(t, startServer(t, h))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := c.Call(ctx, "echo", stringStruct("hi"))
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestClientClose(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	h := HandlerFunc(func(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+		<-release
+		return body, nil
+	})
+
+	c := dial(t, startServer(t, h))
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := c.Call(context.Background(), "echo", stringStruct("hi"))
+		errc <- err
+	}()
+
+	// Wait for the call to be sent before closing the client.
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.pending) == 1
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, c.Close())
+	assert.Equal(t, ErrClientClosed, <-errc)
+
+	_, err := c.Call(context.Background(), "echo", stringStruct("hi"))
+	assert.Equal(t, ErrClientClosed, err)
+	assert.Equal(t, ErrClientClosed, c.CallOneWay(context.Background(), "echo", stringStruct("hi")))
+}
+
+func TestCheckOrigin(t *testing.T) {
+	url := startServer(t, HandlerFunc(echoHandler), CheckOrigin(func(r *http.Request) bool {
+		return r.Header.Get("Origin") == "https://example.com"
+	}))
+
+	ctx := context.Background()
+
+	_, err := Dial(ctx, url, "https://evil.example.com")
+	assert.Error(t, err)
+
+	c, err := Dial(ctx, url, "https://example.com")
+	require.NoError(t, err)
+	defer c.Close()
+
+	_, err = c.Call(ctx, "echo", stringStruct("hi"))
+	assert.NoError(t, err)
+}
+
+func TestDialErrors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := Dial(ctx, "http://localhost/", "http://localhost/")
+	assert.EqualError(t, err, `thriftws: unsupported URL scheme "http"`)
+
+	_, err = Dial(ctx, "ws://127.0.0.1:1/", "http://localhost/")
+	assert.Error(t, err)
+}
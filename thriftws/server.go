@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftws
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+	"golang.org/x/net/websocket"
+)
+
+// ErrUnknownMethod is returned by Handlers to indicate that the given method
+// is invalid.
+type ErrUnknownMethod string
+
+func (e ErrUnknownMethod) Error() string {
+	return fmt.Sprintf("unknown method %q", string(e))
+}
+
+// Handler handles enveloped requests received by a Server.
+type Handler interface {
+	// Handle receives a request to the method with the given name and
+	// returns the body of its reply.
+	//
+	// Errors are sent to the client as TApplicationExceptions.
+	// Implementations should return ErrUnknownMethod if the method is
+	// invalid. Replies to oneway requests are discarded.
+	//
+	// The context is canceled when the connection is closed.
+	Handle(ctx context.Context, name string, body wire.Value) (wire.Value, error)
+}
+
+// HandlerFunc is a Handler implemented as a function.
+type HandlerFunc func(ctx context.Context, name string, body wire.Value) (wire.Value, error)
+
+// Handle calls f.
+func (f HandlerFunc) Handle(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	return f(ctx, name, body)
+}
+
+// ServerOption customizes a Server.
+type ServerOption func(*serverConfig)
+
+type serverConfig struct {
+	checkOrigin func(*http.Request) bool
+}
+
+// CheckOrigin rejects WebSocket handshakes for which the given function
+// returns false. Servers accept connections from all origins by default,
+// so servers reachable from browsers should check the Origin header.
+func CheckOrigin(f func(r *http.Request) bool) ServerOption {
+	return func(c *serverConfig) {
+		c.checkOrigin = f
+	}
+}
+
+// NewServer builds an http.Handler which upgrades requests to WebSocket
+// connections and serves enveloped requests received over them with the
+// given Handler.
+func NewServer(h Handler, opts ...ServerOption) http.Handler {
+	var cfg serverConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return websocket.Server{
+		Handshake: func(_ *websocket.Config, r *http.Request) error {
+			if cfg.checkOrigin != nil && !cfg.checkOrigin(r) {
+				return fmt.Errorf("thriftws: origin %q is not allowed", r.Header.Get("Origin"))
+			}
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			serve(ws, h)
+		},
+	}
+}
+
+// serve handles requests received over the given connection until it is
+// closed or an invalid request is received.
+func serve(ws *websocket.Conn, h Handler) {
+	ctx, cancel := context.WithCancel(ws.Request().Context())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			return
+		}
+
+		req, err := binary.Default.DecodeEnveloped(bytes.NewReader(msg))
+		if err != nil {
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handle(ctx, ws, h, req)
+		}()
+	}
+}
+
+func handle(ctx context.Context, ws *websocket.Conn, h Handler, req wire.Envelope) {
+	// Oneway requests never receive a response, not even when they fail.
+	if req.Type == wire.OneWay {
+		_, _ = h.Handle(ctx, req.Name, req.Value)
+		return
+	}
+
+	res := wire.Envelope{
+		Name:  req.Name,
+		Type:  wire.Reply,
+		SeqID: req.SeqID,
+	}
+
+	var err error
+	res.Value, err = h.Handle(ctx, req.Name, req.Value)
+	if err != nil {
+		typ := exception.ExceptionTypeInternalError
+		if _, ok := err.(ErrUnknownMethod); ok {
+			typ = exception.ExceptionTypeUnknownMethod
+		}
+
+		res.Type = wire.Exception
+		res.Value, err = (&exception.TApplicationException{
+			Message: ptr.String(err.Error()),
+			Type:    &typ,
+		}).ToWire()
+		if err != nil {
+			return
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Default.EncodeEnveloped(res, &buf); err != nil {
+		return
+	}
+	_ = websocket.Message.Send(ws, buf.Bytes())
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ViolationCollector is implemented by structs generated with the
+// --aggregate-errors option.
+type ViolationCollector interface {
+	// CollectViolations records every reason the value is invalid in the
+	// given Collector, including those of nested structs.
+	CollectViolations(*Collector)
+}
+
+// Collect returns an error reporting every violation found in the given
+// value, or nil if it is valid.
+//
+// The returned error is the violation itself if only one was found, and an
+// *AggregateError otherwise.
+func Collect(v ViolationCollector) error {
+	var c Collector
+	v.CollectViolations(&c)
+	return c.Err()
+}
+
+// Violation is a single reason why a value is invalid.
+type Violation struct {
+	// Dot-separated Thrift names of the fields leading to the invalid
+	// value from the value being validated. This is empty if the value
+	// being validated is itself invalid.
+	Path string
+
+	Err error
+}
+
+func (v *Violation) Error() string {
+	if v.Path == "" {
+		return v.Err.Error()
+	}
+	return v.Path + ": " + v.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (v *Violation) Unwrap() error {
+	return v.Err
+}
+
+// AggregateError reports multiple violations found in a value.
+type AggregateError struct {
+	Violations []*Violation
+}
+
+func (e *AggregateError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d violations: ", len(e.Violations))
+	for i, v := range e.Violations {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(v.Error())
+	}
+	return sb.String()
+}
+
+// Collector accumulates violations found while walking a value.
+//
+// This is intended to be used by generated code only.
+type Collector struct {
+	path       []string
+	violations []*Violation
+}
+
+// Add records a violation at the current position.
+func (c *Collector) Add(err error) {
+	c.violations = append(c.violations, &Violation{
+		Path: strings.Join(c.path, "."),
+		Err:  err,
+	})
+}
+
+// Field collects the violations of the value of the field with the given
+// Thrift name. Values which do not implement ViolationCollector are
+// ignored.
+func (c *Collector) Field(name string, v interface{}) {
+	vc, ok := v.(ViolationCollector)
+	if !ok {
+		return
+	}
+
+	c.path = append(c.path, name)
+	vc.CollectViolations(c)
+	c.path = c.path[:len(c.path)-1]
+}
+
+// Err returns an error reporting the collected violations, or nil if
+// there were none.
+func (c *Collector) Err() error {
+	switch len(c.violations) {
+	case 0:
+		return nil
+	case 1:
+		return c.violations[0]
+	default:
+		return &AggregateError{Violations: c.violations}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCollector struct {
+	errs   []error
+	fields map[string]interface{}
+}
+
+func (f *fakeCollector) CollectViolations(c *Collector) {
+	for _, err := range f.errs {
+		c.Add(err)
+	}
+	for name, v := range f.fields {
+		c.Field(name, v)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	errFoo := errors.New("foo")
+	errBar := errors.New("bar")
+
+	tests := []struct {
+		desc    string
+		give    *fakeCollector
+		wantErr string
+	}{
+		{desc: "valid", give: &fakeCollector{}},
+		{
+			desc:    "single",
+			give:    &fakeCollector{errs: []error{errFoo}},
+			wantErr: "foo",
+		},
+		{
+			desc:    "multiple",
+			give:    &fakeCollector{errs: []error{errFoo, errBar}},
+			wantErr: "2 violations: foo; bar",
+		},
+		{
+			desc: "nested",
+			give: &fakeCollector{fields: map[string]interface{}{
+				"a": &fakeCollector{fields: map[string]interface{}{
+					"b": &fakeCollector{errs: []error{errFoo}},
+				}},
+			}},
+			wantErr: "a.b: foo",
+		},
+		{
+			desc: "not a collector",
+			give: &fakeCollector{fields: map[string]interface{}{
+				"a": "foo",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := Collect(tt.give)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestViolationUnwrap(t *testing.T) {
+	errFoo := errors.New("foo")
+	err := Collect(&fakeCollector{fields: map[string]interface{}{
+		"a": &fakeCollector{errs: []error{errFoo}},
+	}})
+	assert.True(t, errors.Is(err, errFoo))
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// CELAnnotation is the annotation used on Thrift structs to specify a CEL
+// expression that must hold for values of that struct.
+const CELAnnotation = "validate.cel"
+
+// ErrNoCELEvaluator is returned when validating a struct with a CEL
+// expression before a CELEvaluator has been registered.
+var ErrNoCELEvaluator = errors.New(
+	"validate: no CEL evaluator registered: use validate.RegisterCELEvaluator")
+
+// CELEvaluator evaluates CEL expressions on behalf of generated code.
+type CELEvaluator interface {
+	// EvalCEL evaluates the given boolean expression with the variable
+	// "this" bound to the provided fields, keyed by their Thrift names.
+	//
+	// Unset optional fields are absent from the map.
+	EvalCEL(expr string, this map[string]interface{}) (bool, error)
+}
+
+var (
+	_celMu        sync.RWMutex
+	_celEvaluator CELEvaluator
+)
+
+// RegisterCELEvaluator installs the CELEvaluator used by generated Validate
+// methods. It replaces any previously registered evaluator.
+//
+// Passing nil unregisters the current evaluator.
+func RegisterCELEvaluator(e CELEvaluator) {
+	_celMu.Lock()
+	_celEvaluator = e
+	_celMu.Unlock()
+}
+
+// CEL evaluates the CEL expression declared on the Thrift type with the
+// given name. An error is returned if the expression does not hold or could
+// not be evaluated.
+//
+// This is intended to be called by generated code only.
+func CEL(typeName, expr string, this map[string]interface{}) error {
+	_celMu.RLock()
+	e := _celEvaluator
+	_celMu.RUnlock()
+
+	if e == nil {
+		return ErrNoCELEvaluator
+	}
+
+	ok, err := e.EvalCEL(expr, this)
+	if err != nil {
+		return fmt.Errorf("validate: could not evaluate %q for %v: %v", expr, typeName, err)
+	}
+	if !ok {
+		return &CELError{TypeName: typeName, Expr: expr}
+	}
+	return nil
+}
+
+// CELError is returned when a value violates the CEL expression declared on
+// its type.
+type CELError struct {
+	// Name of the Thrift type.
+	TypeName string
+
+	// Expression that did not hold.
+	Expr string
+}
+
+func (e *CELError) Error() string {
+	return fmt.Sprintf("validate: %v does not satisfy %q", e.TypeName, e.Expr)
+}
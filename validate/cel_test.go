@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticEvaluator struct {
+	ok  bool
+	err error
+}
+
+func (e staticEvaluator) EvalCEL(string, map[string]interface{}) (bool, error) {
+	return e.ok, e.err
+}
+
+func TestCEL(t *testing.T) {
+	defer RegisterCELEvaluator(nil)
+
+	tests := []struct {
+		desc      string
+		evaluator CELEvaluator
+		wantErr   string
+	}{
+		{
+			desc:    "no evaluator",
+			wantErr: ErrNoCELEvaluator.Error(),
+		},
+		{
+			desc:      "success",
+			evaluator: staticEvaluator{ok: true},
+		},
+		{
+			desc:      "expression does not hold",
+			evaluator: staticEvaluator{ok: false},
+			wantErr:   `validate: Foo does not satisfy "this.x > 0"`,
+		},
+		{
+			desc:      "evaluation error",
+			evaluator: staticEvaluator{err: errors.New("great sadness")},
+			wantErr:   `validate: could not evaluate "this.x > 0" for Foo: great sadness`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			RegisterCELEvaluator(tt.evaluator)
+			err := CEL("Foo", "this.x > 0", map[string]interface{}{"x": 1})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
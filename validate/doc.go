@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package validate provides runtime support for validation code generated by
+// ThriftRW.
+//
+// Structs annotated with validate.cel get a Validate method which evaluates
+// the expression against the struct.
+//
+//	struct Range {
+//	    1: required i32 min
+//	    2: required i32 max
+//	} (validate.cel = "this.min <= this.max")
+//
+// ThriftRW does not evaluate CEL expressions itself. Programs that call
+// Validate on such structs must register an evaluator, typically backed by
+// github.com/google/cel-go, with RegisterCELEvaluator.
+//
+// Fields may declare rules with the validate.min, validate.max,
+// validate.pattern, and validate.required_non_empty annotations, which are
+// checked by the Validate method of their struct before any CEL expression.
+// Violations are reported as FieldErrors.
+//
+//	struct User {
+//	    1: required string name (validate.pattern = "^[a-z]+$")
+//	    2: optional i32 age (validate.min = "0", validate.max = "150")
+//	    3: optional list<string> emails (validate.required_non_empty)
+//	}
+//
+// Structs generated with the --aggregate-errors option report every missing
+// required field and invalid union found in them and their nested structs
+// when they are encoded, rather than only the first one. Use Collect to
+// check such a value without encoding it.
+package validate
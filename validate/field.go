@@ -0,0 +1,59 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package validate
+
+import "fmt"
+
+// Annotations on Thrift fields which declare validation rules enforced by
+// generated Validate methods.
+const (
+	// MinAnnotation specifies the minimum value of a numeric field, or the
+	// minimum length of a string, binary, list, set, or map field.
+	MinAnnotation = "validate.min"
+
+	// MaxAnnotation specifies the maximum value of a numeric field, or the
+	// maximum length of a string, binary, list, set, or map field.
+	MaxAnnotation = "validate.max"
+
+	// PatternAnnotation specifies a regular expression, in the syntax of
+	// the regexp package, which string fields must match.
+	PatternAnnotation = "validate.pattern"
+
+	// NonEmptyAnnotation requires string, binary, list, set, and map
+	// fields to be set and non-empty.
+	NonEmptyAnnotation = "validate.required_non_empty"
+)
+
+// FieldError is returned when a field violates a rule declared on it with
+// an annotation.
+type FieldError struct {
+	// Name of the Thrift type.
+	TypeName string
+
+	// Name of the field in the Thrift file.
+	Field string
+
+	// Rule the field did not satisfy, for example "must be at least 1".
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("validate: %v.%v %v", e.TypeName, e.Field, e.Reason)
+}
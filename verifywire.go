@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"go.uber.org/thriftrw/internal/verifywire"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type verifyWireOptions struct {
+	Against        string `long:"against" value-name:"BINARY" required:"yes" description:"Path to the thriftrw binary to compare with."`
+	Library        string `long:"library" value-name:"DIR" description:"Directory holding the source of the ThriftRW library that code generated by this binary is built against. By default, the release matching this binary's version is used."`
+	AgainstLibrary string `long:"against-library" value-name:"DIR" description:"Directory holding the source of the ThriftRW library that code generated by the --against binary is built against. By default, the release matching its version is used."`
+	Seed           int64  `long:"seed" default:"1" description:"Seed for the pseudo-random corpus."`
+	Count          int    `long:"count" default:"100" description:"Number of values in the corpus for each struct."`
+	WorkDir        string `long:"work-dir" value-name:"DIR" description:"Directory in which code is generated and built. By default, a temporary directory is used and removed afterwards."`
+
+	Args struct {
+		ThriftFile string `positional-arg-name:"FILE" description:"Thrift file defining the structs to verify."`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// verifyWire implements "thriftrw verify-wire", which checks that code
+// generated by this binary encodes values to the same bytes as code
+// generated by another version of ThriftRW.
+func verifyWire(args []string) error {
+	var opts verifyWireOptions
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw verify-wire"
+	parser.Usage = "[OPTIONS] FILE"
+
+	if _, err := parser.ParseArgs(args); err != nil {
+		if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+			parser.WriteHelp(os.Stdout)
+			return nil
+		}
+		return err
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	workDir := opts.WorkDir
+	if workDir == "" {
+		workDir, err = os.MkdirTemp("", "thriftrw-verify-wire")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(workDir)
+	}
+
+	report, err := verifywire.Run(&verifywire.Config{
+		ThriftFile: opts.Args.ThriftFile,
+		Old:        verifywire.Version{Binary: opts.Against, Library: opts.AgainstLibrary},
+		New:        verifywire.Version{Binary: self, Library: opts.Library},
+		Seed:       opts.Seed,
+		Count:      opts.Count,
+		WorkDir:    workDir,
+		Log:        os.Stderr,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range report.Mismatches {
+		fmt.Println(m)
+	}
+	if len(report.Mismatches) > 0 {
+		return fmt.Errorf("%d of %d values were encoded differently", len(report.Mismatches), report.Entries)
+	}
+
+	log.Printf("All %d values were encoded identically", report.Entries)
+	return nil
+}
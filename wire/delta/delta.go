@@ -0,0 +1,319 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package delta computes and applies differences between Thrift structs at
+// the wire level.
+//
+// This is useful for streams of mostly-unchanged records, such as periodic
+// telemetry, where sending only the fields that changed since the previous
+// record is significantly cheaper than sending the whole record.
+//
+//	var enc delta.Encoder
+//	for _, v := range values {
+//		p, err := enc.Next(v)
+//		...
+//		send(p.ToWire())
+//	}
+//
+// The receiving side reconstructs the original values with a Decoder.
+//
+//	var dec delta.Decoder
+//	for w := range received {
+//		p, err := delta.PatchFromWire(w)
+//		...
+//		v, err := dec.Next(p)
+//	}
+package delta
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Patch describes how to turn one struct into another.
+type Patch struct {
+	// Set holds fields that were added, or whose values changed and
+	// could not be described with a nested patch.
+	Set []wire.Field
+
+	// Nested holds patches for fields whose values are structs in both
+	// the base and the new value.
+	Nested map[int16]Patch
+
+	// Removed holds IDs of fields that are absent from the new value.
+	Removed []int16
+}
+
+// IsEmpty returns true if applying this patch leaves a struct unchanged.
+func (p Patch) IsEmpty() bool {
+	return len(p.Set) == 0 && len(p.Nested) == 0 && len(p.Removed) == 0
+}
+
+// Diff computes a Patch which turns base into updated. Both values must be
+// structs.
+func Diff(base, updated wire.Value) (Patch, error) {
+	if base.Type() != wire.TStruct || updated.Type() != wire.TStruct {
+		return Patch{}, fmt.Errorf(
+			"delta: can only diff structs: got %v and %v", base.Type(), updated.Type())
+	}
+	return diffStructs(base.GetStruct(), updated.GetStruct()), nil
+}
+
+func diffStructs(base, updated wire.Struct) Patch {
+	baseFields := make(map[int16]wire.Value, len(base.Fields))
+	for _, f := range base.Fields {
+		baseFields[f.ID] = f.Value
+	}
+
+	var p Patch
+	for _, f := range updated.Fields {
+		old, ok := baseFields[f.ID]
+		delete(baseFields, f.ID)
+
+		switch {
+		case !ok:
+			p.Set = append(p.Set, f)
+		case old.Type() == wire.TStruct && f.Value.Type() == wire.TStruct:
+			nested := diffStructs(old.GetStruct(), f.Value.GetStruct())
+			if nested.IsEmpty() {
+				continue
+			}
+			if p.Nested == nil {
+				p.Nested = make(map[int16]Patch)
+			}
+			p.Nested[f.ID] = nested
+		case !wire.ValuesAreEqual(old, f.Value):
+			p.Set = append(p.Set, f)
+		}
+	}
+
+	for id := range baseFields {
+		p.Removed = append(p.Removed, id)
+	}
+	sort.Slice(p.Removed, func(i, j int) bool { return p.Removed[i] < p.Removed[j] })
+	return p
+}
+
+// Apply applies the patch to base, which must be a struct, returning the
+// patched value. base is not modified.
+func (p Patch) Apply(base wire.Value) (wire.Value, error) {
+	if base.Type() != wire.TStruct {
+		return wire.Value{}, fmt.Errorf("delta: can only patch structs: got %v", base.Type())
+	}
+	s, err := p.applyStruct(base.GetStruct())
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueStruct(s), nil
+}
+
+func (p Patch) applyStruct(base wire.Struct) (wire.Struct, error) {
+	removed := make(map[int16]struct{}, len(p.Removed)+len(p.Set))
+	for _, id := range p.Removed {
+		removed[id] = struct{}{}
+	}
+	for _, f := range p.Set {
+		removed[f.ID] = struct{}{}
+	}
+
+	fields := make([]wire.Field, 0, len(base.Fields)+len(p.Set))
+	found := make(map[int16]struct{}, len(p.Nested))
+	for _, f := range base.Fields {
+		if _, ok := removed[f.ID]; ok {
+			continue
+		}
+
+		if nested, ok := p.Nested[f.ID]; ok {
+			if f.Value.Type() != wire.TStruct {
+				return wire.Struct{}, fmt.Errorf(
+					"delta: cannot apply nested patch to field %d: got %v, want a struct",
+					f.ID, f.Value.Type())
+			}
+			s, err := nested.applyStruct(f.Value.GetStruct())
+			if err != nil {
+				return wire.Struct{}, fmt.Errorf("delta: field %d: %v", f.ID, err)
+			}
+			f.Value = wire.NewValueStruct(s)
+			found[f.ID] = struct{}{}
+		}
+
+		fields = append(fields, f)
+	}
+
+	for id := range p.Nested {
+		if _, ok := found[id]; !ok {
+			return wire.Struct{}, fmt.Errorf(
+				"delta: cannot apply nested patch to field %d: field is not set", id)
+		}
+	}
+
+	fields = append(fields, p.Set...)
+	return wire.Struct{Fields: fields}, nil
+}
+
+// Field IDs used by the wire representation of a Patch.
+const (
+	patchSetID     = 1
+	patchRemovedID = 2
+	patchNestedID  = 3
+)
+
+// ToWire returns the wire representation of this patch so that it may be
+// serialized with a Protocol. Patches are represented as,
+//
+//	struct Patch {
+//	    1: optional Struct set   // a struct made up of the Set fields
+//	    2: optional list<i16> removed
+//	    3: optional map<i16, Patch> nested
+//	}
+func (p Patch) ToWire() wire.Value {
+	var fields []wire.Field
+	if len(p.Set) > 0 {
+		fields = append(fields, wire.Field{
+			ID:    patchSetID,
+			Value: wire.NewValueStruct(wire.Struct{Fields: p.Set}),
+		})
+	}
+
+	if len(p.Removed) > 0 {
+		ids := make([]wire.Value, len(p.Removed))
+		for i, id := range p.Removed {
+			ids[i] = wire.NewValueI16(id)
+		}
+		fields = append(fields, wire.Field{
+			ID:    patchRemovedID,
+			Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI16, ids)),
+		})
+	}
+
+	if len(p.Nested) > 0 {
+		ids := make([]int16, 0, len(p.Nested))
+		for id := range p.Nested {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		items := make([]wire.MapItem, len(ids))
+		for i, id := range ids {
+			items[i] = wire.MapItem{
+				Key:   wire.NewValueI16(id),
+				Value: p.Nested[id].ToWire(),
+			}
+		}
+		fields = append(fields, wire.Field{
+			ID:    patchNestedID,
+			Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI16, wire.TStruct, items)),
+		})
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+// PatchFromWire builds a Patch from its wire representation.
+func PatchFromWire(w wire.Value) (Patch, error) {
+	if w.Type() != wire.TStruct {
+		return Patch{}, fmt.Errorf("delta: patch must be a struct: got %v", w.Type())
+	}
+
+	var p Patch
+	for _, f := range w.GetStruct().Fields {
+		switch {
+		case f.ID == patchSetID && f.Value.Type() == wire.TStruct:
+			p.Set = f.Value.GetStruct().Fields
+
+		case f.ID == patchRemovedID && f.Value.Type() == wire.TList:
+			err := f.Value.GetList().ForEach(func(v wire.Value) error {
+				if v.Type() != wire.TI16 {
+					return fmt.Errorf("delta: removed field IDs must be i16: got %v", v.Type())
+				}
+				p.Removed = append(p.Removed, v.GetI16())
+				return nil
+			})
+			if err != nil {
+				return Patch{}, err
+			}
+
+		case f.ID == patchNestedID && f.Value.Type() == wire.TMap:
+			p.Nested = make(map[int16]Patch, f.Value.GetMap().Size())
+			err := f.Value.GetMap().ForEach(func(item wire.MapItem) error {
+				if item.Key.Type() != wire.TI16 {
+					return fmt.Errorf("delta: nested patch keys must be i16: got %v", item.Key.Type())
+				}
+				nested, err := PatchFromWire(item.Value)
+				if err != nil {
+					return err
+				}
+				p.Nested[item.Key.GetI16()] = nested
+				return nil
+			})
+			if err != nil {
+				return Patch{}, err
+			}
+		}
+	}
+	return p, nil
+}
+
+// Encoder produces patches for a sequence of structs, each relative to the
+// struct before it. The zero value is ready to use.
+type Encoder struct {
+	prev    wire.Value
+	hasPrev bool
+}
+
+// Next returns a patch which turns the previous value passed to Next into v.
+// The first patch turns an empty struct into v.
+func (e *Encoder) Next(v wire.Value) (Patch, error) {
+	base := e.prev
+	if !e.hasPrev {
+		base = wire.NewValueStruct(wire.Struct{})
+	}
+
+	p, err := Diff(base, v)
+	if err != nil {
+		return Patch{}, err
+	}
+	e.prev, e.hasPrev = v, true
+	return p, nil
+}
+
+// Decoder reconstructs the sequence of structs fed to an Encoder from the
+// patches it produced. The zero value is ready to use.
+type Decoder struct {
+	prev    wire.Value
+	hasPrev bool
+}
+
+// Next applies p to the previously decoded value and returns the result.
+func (d *Decoder) Next(p Patch) (wire.Value, error) {
+	base := d.prev
+	if !d.hasPrev {
+		base = wire.NewValueStruct(wire.Struct{})
+	}
+
+	v, err := p.Apply(base)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	d.prev, d.hasPrev = v, true
+	return v, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delta
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+func vstruct(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func vi32(id int16, v int32) wire.Field {
+	return wire.Field{ID: id, Value: wire.NewValueI32(v)}
+}
+
+func TestDiffApply(t *testing.T) {
+	tests := []struct {
+		desc      string
+		base      wire.Value
+		updated   wire.Value
+		wantPatch Patch
+	}{
+		{
+			desc:    "unchanged",
+			base:    vstruct(vi32(1, 1), vi32(2, 2)),
+			updated: vstruct(vi32(2, 2), vi32(1, 1)),
+		},
+		{
+			desc:      "changed, added, and removed fields",
+			base:      vstruct(vi32(1, 1), vi32(2, 2), vi32(3, 3)),
+			updated:   vstruct(vi32(1, 1), vi32(2, 20), vi32(4, 4)),
+			wantPatch: Patch{Set: []wire.Field{vi32(2, 20), vi32(4, 4)}, Removed: []int16{3}},
+		},
+		{
+			desc: "nested struct",
+			base: vstruct(
+				vi32(1, 1),
+				wire.Field{ID: 2, Value: vstruct(vi32(1, 1), vi32(2, 2))},
+			),
+			updated: vstruct(
+				vi32(1, 1),
+				wire.Field{ID: 2, Value: vstruct(vi32(1, 1), vi32(2, 3))},
+			),
+			wantPatch: Patch{Nested: map[int16]Patch{
+				2: {Set: []wire.Field{vi32(2, 3)}},
+			}},
+		},
+		{
+			desc:      "field type changed",
+			base:      vstruct(wire.Field{ID: 1, Value: vstruct()}),
+			updated:   vstruct(vi32(1, 1)),
+			wantPatch: Patch{Set: []wire.Field{vi32(1, 1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p, err := Diff(tt.base, tt.updated)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPatch, p)
+			assert.Equal(t, tt.wantPatch.IsEmpty(), p.IsEmpty())
+
+			// Round trip the patch through the Binary protocol.
+			var buf bytes.Buffer
+			require.NoError(t, binary.Default.Encode(p.ToWire(), &buf))
+			w, err := binary.Default.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+			require.NoError(t, err)
+			p, err = PatchFromWire(w)
+			require.NoError(t, err)
+
+			got, err := p.Apply(tt.base)
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(tt.updated, got),
+				"expected %v, got %v", tt.updated, got)
+		})
+	}
+}
+
+func TestDiffApplyErrors(t *testing.T) {
+	_, err := Diff(wire.NewValueI32(1), vstruct())
+	assert.EqualError(t, err, "delta: can only diff structs: got TI32 and TStruct")
+
+	_, err = Patch{}.Apply(wire.NewValueI32(1))
+	assert.EqualError(t, err, "delta: can only patch structs: got TI32")
+
+	nested := Patch{Nested: map[int16]Patch{1: {Removed: []int16{1}}}}
+
+	_, err = nested.Apply(vstruct())
+	assert.EqualError(t, err, "delta: cannot apply nested patch to field 1: field is not set")
+
+	_, err = nested.Apply(vstruct(vi32(1, 1)))
+	assert.EqualError(t, err, "delta: cannot apply nested patch to field 1: got TI32, want a struct")
+
+	_, err = PatchFromWire(wire.NewValueI32(1))
+	assert.EqualError(t, err, "delta: patch must be a struct: got TI32")
+
+	_, err = PatchFromWire(vstruct(wire.Field{
+		ID:    patchRemovedID,
+		Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{wire.NewValueI32(1)})),
+	}))
+	assert.EqualError(t, err, "delta: removed field IDs must be i16: got TI32")
+}
+
+func TestEncoderDecoder(t *testing.T) {
+	values := []wire.Value{
+		vstruct(vi32(1, 1), vi32(2, 2)),
+		vstruct(vi32(1, 1), vi32(2, 3)),
+		vstruct(vi32(1, 1), vi32(2, 3)),
+		vstruct(vi32(1, 5)),
+	}
+
+	var (
+		enc Encoder
+		dec Decoder
+	)
+	for i, v := range values {
+		p, err := enc.Next(v)
+		require.NoError(t, err)
+		if i == 2 {
+			assert.True(t, p.IsEmpty(), "patch for an unchanged value must be empty")
+		}
+
+		got, err := dec.Next(p)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(v, got), "value %d: expected %v, got %v", i, v, got)
+	}
+
+	_, err := enc.Next(wire.NewValueI32(1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,351 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package wiredict builds compression dictionaries for Binary-encoded Thrift
+// payloads, and compresses payloads with them.
+//
+// Small payloads compress poorly on their own because the compressor has no
+// history to draw matches from. A dictionary primes the compressor with byte
+// sequences common to payloads of a type: field headers, enum values,
+// booleans and repeated strings. The Builder samples these sequences at field
+// boundaries of a corpus of payloads rather than at arbitrary offsets.
+//
+//	b := wiredict.NewBuilder(spec)
+//	for _, payload := range corpus {
+//		if err := b.Add(payload); err != nil {
+//			return err
+//		}
+//	}
+//	dict := b.Dictionary(16 * 1024)
+//
+// Dictionaries are raw content dictionaries: plain bytes without a header.
+// Codec uses them as preset dictionaries for DEFLATE. They may also be
+// loaded as raw content dictionaries by zstd implementations.
+package wiredict
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+const (
+	// maxSegmentLen is the longest byte sequence sampled from a field.
+	// Longer values contribute only their field header and length.
+	maxSegmentLen = 64
+
+	// maxSegments bounds the number of distinct sequences tracked by a
+	// Builder. Once reached, only sequences already seen are counted.
+	maxSegments = 1 << 16
+)
+
+// Builder samples payloads of a single Thrift type and builds a compression
+// dictionary from them.
+type Builder struct {
+	spec     compile.TypeSpec
+	payloads int64
+	total    int64
+	segments map[string]int64
+}
+
+// NewBuilder builds a Builder for Binary-encoded payloads of the given type.
+func NewBuilder(spec compile.TypeSpec) *Builder {
+	return &Builder{
+		spec:     spec,
+		segments: make(map[string]int64),
+	}
+}
+
+// Payloads returns the number of payloads added to this Builder.
+func (b *Builder) Payloads() int64 {
+	return b.payloads
+}
+
+// TotalBytes returns the total size of all payloads added to this Builder.
+func (b *Builder) TotalBytes() int64 {
+	return b.total
+}
+
+// Add samples a single encoded payload.
+//
+// Every field known to the TypeSpec contributes its encoding: the field
+// header along with the value for scalar fields, and the field header along
+// with the collection header for lists, sets and maps. Scalar elements of
+// collections contribute their values. Fields unknown to the
+// TypeSpec are skipped. Payloads that fail to decode do not affect the
+// Builder.
+func (b *Builder) Add(payload []byte) error {
+	r := bytes.NewReader(payload)
+	sr := binary.NewStreamReader(r)
+	defer sr.Close()
+
+	s := sampler{
+		reader:  sr,
+		payload: payload,
+		pos:     func() int { return len(payload) - r.Len() },
+	}
+	root := compile.RootTypeSpec(b.spec)
+	if err := s.walk(root, root.TypeCode()); err != nil {
+		return fmt.Errorf("could not sample payload for %v: %v", b.spec.ThriftName(), err)
+	}
+
+	b.payloads++
+	b.total += int64(len(payload))
+	for _, seg := range s.segments {
+		if _, ok := b.segments[string(seg)]; ok || len(b.segments) < maxSegments {
+			b.segments[string(seg)]++
+		}
+	}
+	return nil
+}
+
+// Dictionary returns a dictionary of at most size bytes made of the
+// sequences which cover the most bytes across the sampled payloads.
+// Sequences seen only once are left out.
+//
+// Compressors find matches closer to the data more cheaply, so the most
+// valuable sequences are placed at the end of the dictionary.
+func (b *Builder) Dictionary(size int) []byte {
+	type scored struct {
+		data  string
+		score int64
+	}
+
+	candidates := make([]scored, 0, len(b.segments))
+	for seg, count := range b.segments {
+		if count < 2 {
+			continue
+		}
+		candidates = append(candidates, scored{data: seg, score: count * int64(len(seg))})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].data < candidates[j].data
+	})
+
+	var (
+		chosen []string
+		n      int
+		all    []byte
+	)
+	for _, c := range candidates {
+		if n+len(c.data) > size {
+			continue
+		}
+		if bytes.Contains(all, []byte(c.data)) {
+			continue
+		}
+		chosen = append(chosen, c.data)
+		all = append(all, c.data...)
+		n += len(c.data)
+	}
+
+	dict := make([]byte, 0, n)
+	for i := len(chosen) - 1; i >= 0; i-- {
+		dict = append(dict, chosen[i]...)
+	}
+	return dict
+}
+
+type sampler struct {
+	reader   stream.Reader
+	payload  []byte
+	pos      func() int
+	segments [][]byte
+}
+
+func (s *sampler) sample(start, end int) {
+	if end-start > maxSegmentLen {
+		return
+	}
+	s.segments = append(s.segments, s.payload[start:end])
+}
+
+// walk reads a value of type t, sampling the fields of any structs inside
+// it. spec is nil if the value's type is unknown.
+func (s *sampler) walk(spec compile.TypeSpec, t wire.Type) error {
+	switch t {
+	case wire.TStruct:
+		st, _ := spec.(*compile.StructSpec)
+		return s.walkStruct(st)
+	case wire.TList:
+		var elem compile.TypeSpec
+		if l, ok := spec.(*compile.ListSpec); ok {
+			elem = l.ValueSpec
+		}
+		h, err := s.reader.ReadListBegin()
+		if err != nil {
+			return err
+		}
+		if err := s.walkElements(elem, h.Type, h.Length); err != nil {
+			return err
+		}
+		return s.reader.ReadListEnd()
+	case wire.TSet:
+		var elem compile.TypeSpec
+		if st, ok := spec.(*compile.SetSpec); ok {
+			elem = st.ValueSpec
+		}
+		h, err := s.reader.ReadSetBegin()
+		if err != nil {
+			return err
+		}
+		if err := s.walkElements(elem, h.Type, h.Length); err != nil {
+			return err
+		}
+		return s.reader.ReadSetEnd()
+	case wire.TMap:
+		var key, value compile.TypeSpec
+		if m, ok := spec.(*compile.MapSpec); ok {
+			key, value = m.KeySpec, m.ValueSpec
+		}
+		h, err := s.reader.ReadMapBegin()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < h.Length; i++ {
+			if err := s.walkElements(key, h.KeyType, 1); err != nil {
+				return err
+			}
+			if err := s.walkElements(value, h.ValueType, 1); err != nil {
+				return err
+			}
+		}
+		return s.reader.ReadMapEnd()
+	default:
+		return s.reader.Skip(t)
+	}
+}
+
+func (s *sampler) walkElements(spec compile.TypeSpec, t wire.Type, n int) error {
+	if spec != nil {
+		spec = compile.RootTypeSpec(spec)
+		if spec.TypeCode() != t {
+			spec = nil
+		}
+	}
+	for i := 0; i < n; i++ {
+		start := s.pos()
+		if err := s.walk(spec, t); err != nil {
+			return err
+		}
+		if spec != nil && isScalar(t) {
+			s.sample(start, s.pos())
+		}
+	}
+	return nil
+}
+
+func isScalar(t wire.Type) bool {
+	switch t {
+	case wire.TStruct, wire.TList, wire.TSet, wire.TMap:
+		return false
+	default:
+		return true
+	}
+}
+
+func (s *sampler) walkStruct(spec *compile.StructSpec) error {
+	if err := s.reader.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	for {
+		start := s.pos()
+		fh, ok, err := s.reader.ReadFieldBegin()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		var fieldSpec compile.TypeSpec
+		if spec != nil {
+			for _, f := range spec.Fields {
+				if f.ID != fh.ID {
+					continue
+				}
+				if t := compile.RootTypeSpec(f.Type); t.TypeCode() == fh.Type {
+					fieldSpec = t
+				}
+				break
+			}
+		}
+
+		switch {
+		case fieldSpec == nil:
+			// Unknown fields are likely rare, so they're not worth
+			// space in the dictionary.
+			if err := s.reader.Skip(fh.Type); err != nil {
+				return err
+			}
+		case fh.Type == wire.TStruct:
+			s.sample(start, s.pos())
+			if err := s.walk(fieldSpec, fh.Type); err != nil {
+				return err
+			}
+		case fh.Type == wire.TList || fh.Type == wire.TSet || fh.Type == wire.TMap:
+			if err := s.walkCollection(start, fieldSpec, fh.Type); err != nil {
+				return err
+			}
+		default:
+			if err := s.walk(fieldSpec, fh.Type); err != nil {
+				return err
+			}
+			if end := s.pos(); end-start <= maxSegmentLen {
+				s.sample(start, end)
+			} else if fh.Type == wire.TBinary {
+				// Keep the header and length of long strings.
+				s.sample(start, start+7)
+			}
+		}
+
+		if err := s.reader.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return s.reader.ReadStructEnd()
+}
+
+// walkCollection walks a list, set or map field whose field header started
+// at the given offset, sampling the field header along with the collection
+// header.
+func (s *sampler) walkCollection(start int, spec compile.TypeSpec, t wire.Type) error {
+	// Field header (3 bytes) followed by the collection header: the
+	// element type and length for lists and sets, the key type, value type
+	// and length for maps.
+	end := start + 3 + 5
+	if t == wire.TMap {
+		end++
+	}
+	if end <= len(s.payload) {
+		s.sample(start, end)
+	}
+	return s.walk(spec, t)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wiredict
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+var _eventSpec = &compile.StructSpec{
+	Name: "Event",
+	Fields: compile.FieldGroup{
+		{ID: 1, Name: "id", Type: &compile.I64Spec{}},
+		{ID: 2, Name: "kind", Type: &compile.StringSpec{}},
+		{ID: 3, Name: "success", Type: &compile.BoolSpec{}},
+		{ID: 4, Name: "tags", Type: &compile.ListSpec{ValueSpec: &compile.StringSpec{}}},
+	},
+}
+
+func encode(t testing.TB, fields ...wire.Field) []byte {
+	var buf bytes.Buffer
+	v := wire.NewValueStruct(wire.Struct{Fields: fields})
+	require.NoError(t, binary.Default.Encode(v, &buf))
+	return buf.Bytes()
+}
+
+// event encodes an Event with a unique ID and a small set of repeated
+// strings, like most real traffic.
+func event(t testing.TB, i int) []byte {
+	kinds := []string{"user.login", "user.logout", "order.created"}
+	return encode(t,
+		wire.Field{ID: 1, Value: wire.NewValueI64(int64(i) * 7919)},
+		wire.Field{ID: 2, Value: wire.NewValueString(kinds[i%len(kinds)])},
+		wire.Field{ID: 3, Value: wire.NewValueBool(i%5 != 0)},
+		wire.Field{ID: 4, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("region:us-east"),
+			wire.NewValueString(fmt.Sprintf("shard:%d", i%3)),
+		}))},
+		wire.Field{ID: 9, Value: wire.NewValueString(fmt.Sprintf("unknown-%d", i%2))},
+	)
+}
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(_eventSpec)
+	var total int64
+	for i := 0; i < 100; i++ {
+		payload := event(t, i)
+		total += int64(len(payload))
+		require.NoError(t, b.Add(payload))
+	}
+	assert.Equal(t, int64(100), b.Payloads())
+	assert.Equal(t, total, b.TotalBytes())
+
+	dict := b.Dictionary(1024)
+	assert.NotEmpty(t, dict)
+	assert.LessOrEqual(t, len(dict), 1024)
+
+	kind := encode(t, wire.Field{ID: 2, Value: wire.NewValueString("user.login")})
+	assert.True(t, bytes.Contains(dict, kind[:len(kind)-1]), "must contain repeated string fields")
+
+	tags := []byte{0x0f, 0x00, 0x04, 0x0b, 0x00, 0x00, 0x00, 0x02}
+	assert.True(t, bytes.Contains(dict, tags), "must contain collection headers")
+
+	assert.False(t, bytes.Contains(dict, []byte("unknown-")), "must not contain unknown fields")
+
+	// The region tag appears in every payload, so it covers the most bytes.
+	assert.True(t, bytes.HasSuffix(dict, []byte("\x00\x00\x00\x0eregion:us-east")),
+		"most valuable sequences must be at the end")
+
+	t.Run("limit", func(t *testing.T) {
+		small := b.Dictionary(16)
+		assert.NotEmpty(t, small)
+		assert.LessOrEqual(t, len(small), 16)
+	})
+}
+
+func TestBuilderSkipsRareSequences(t *testing.T) {
+	b := NewBuilder(_eventSpec)
+	require.NoError(t, b.Add(event(t, 0)))
+	assert.Empty(t, b.Dictionary(1024))
+}
+
+func TestBuilderInvalidPayload(t *testing.T) {
+	b := NewBuilder(_eventSpec)
+	err := b.Add([]byte{0x0b, 0x00, 0x02, 0x00, 0x00})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not sample payload for Event")
+	assert.Equal(t, int64(0), b.Payloads())
+	assert.Empty(t, b.segments)
+}
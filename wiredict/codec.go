@@ -0,0 +1,206 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wiredict
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Dictionary is a compression dictionary for payloads of a Thrift type.
+type Dictionary struct {
+	// ID identifies the dictionary in compressed payloads. Peers must
+	// agree on the dictionary for each ID. ID 0 is reserved for payloads
+	// compressed without a dictionary.
+	ID uint32
+
+	// Type is the name of the Thrift type whose payloads use this
+	// dictionary.
+	Type string
+
+	// Data holds the contents of the dictionary, as built by
+	// Builder.Dictionary.
+	Data []byte
+}
+
+// Codec compresses payloads with DEFLATE at its best compression level, using
+// a preset dictionary for each type.
+//
+// Compressed payloads start with the ID of their dictionary as an unsigned
+// varint, followed by the DEFLATE stream. Payloads of types without a
+// dictionary are compressed with ID 0.
+type Codec struct {
+	byType map[string]Dictionary
+	byID   map[uint32][]byte
+}
+
+// NewCodec builds a Codec with the given dictionaries.
+func NewCodec(dicts ...Dictionary) (*Codec, error) {
+	c := &Codec{
+		byType: make(map[string]Dictionary, len(dicts)),
+		byID:   make(map[uint32][]byte, len(dicts)),
+	}
+	for _, d := range dicts {
+		if d.ID == 0 {
+			return nil, fmt.Errorf("dictionary for %q: ID 0 is reserved", d.Type)
+		}
+		if _, ok := c.byID[d.ID]; ok {
+			return nil, fmt.Errorf("dictionary for %q: ID %d is already in use", d.Type, d.ID)
+		}
+		if _, ok := c.byType[d.Type]; ok {
+			return nil, fmt.Errorf("type %q already has a dictionary", d.Type)
+		}
+		c.byType[d.Type] = d
+		c.byID[d.ID] = d.Data
+	}
+	return c, nil
+}
+
+// Compress compresses a payload of the named type.
+func (c *Codec) Compress(typeName string, payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.compress(&buf, typeName, payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *Codec) compress(w *bytes.Buffer, typeName string, payload []byte) error {
+	d := c.byType[typeName]
+
+	var id [binary.MaxVarintLen32]byte
+	w.Write(id[:binary.PutUvarint(id[:], uint64(d.ID))])
+
+	// Lower levels of compress/flate do not search the preset dictionary.
+	fw, err := flate.NewWriterDict(w, flate.BestCompression, d.Data)
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(payload); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// Decompress decompresses a payload compressed by a Codec with the same
+// dictionaries.
+func (c *Codec) Decompress(b []byte) ([]byte, error) {
+	id, n := binary.Uvarint(b)
+	if n <= 0 || id > math.MaxUint32 {
+		return nil, errors.New("wiredict: invalid dictionary ID")
+	}
+
+	var dict []byte
+	if id != 0 {
+		var ok bool
+		dict, ok = c.byID[uint32(id)]
+		if !ok {
+			return nil, fmt.Errorf("wiredict: unknown dictionary ID %d", id)
+		}
+	}
+
+	fr := flate.NewReaderDict(bytes.NewReader(b[n:]), dict)
+	defer fr.Close()
+
+	out, err := io.ReadAll(fr)
+	if err != nil {
+		return nil, fmt.Errorf("wiredict: %v", err)
+	}
+	return out, nil
+}
+
+// Protocol returns a Protocol which compresses values encoded by p as
+// payloads of the named type, and decompresses payloads before decoding them
+// with p.
+//
+// Payloads being decoded may use any dictionary known to the Codec,
+// regardless of the type given here.
+//
+// The returned Protocol implements only the Protocol interface. It cannot be
+// upcast to EnvelopeAgnosticProtocol or stream.Protocol even if p can.
+func (c *Codec) Protocol(typeName string, p protocol.Protocol) protocol.Protocol {
+	return codecProtocol{codec: c, typeName: typeName, p: p}
+}
+
+type codecProtocol struct {
+	codec    *Codec
+	typeName string
+	p        protocol.Protocol
+}
+
+var _ protocol.Protocol = codecProtocol{}
+
+func (p codecProtocol) Encode(v wire.Value, w io.Writer) error {
+	var buf bytes.Buffer
+	if err := p.p.Encode(v, &buf); err != nil {
+		return err
+	}
+	return p.write(buf.Bytes(), w)
+}
+
+func (p codecProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+	var buf bytes.Buffer
+	if err := p.p.EncodeEnveloped(e, &buf); err != nil {
+		return err
+	}
+	return p.write(buf.Bytes(), w)
+}
+
+func (p codecProtocol) write(payload []byte, w io.Writer) error {
+	var buf bytes.Buffer
+	if err := p.codec.compress(&buf, p.typeName, payload); err != nil {
+		return err
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func (p codecProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	payload, err := p.read(r)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return p.p.Decode(bytes.NewReader(payload), t)
+}
+
+func (p codecProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	payload, err := p.read(r)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+	return p.p.DecodeEnveloped(bytes.NewReader(payload))
+}
+
+func (p codecProtocol) read(r io.ReaderAt) ([]byte, error) {
+	b, err := io.ReadAll(io.NewSectionReader(r, 0, math.MaxInt64))
+	if err != nil {
+		return nil, err
+	}
+	return p.codec.Decompress(b)
+}
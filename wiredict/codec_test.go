@@ -0,0 +1,161 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wiredict
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+func newEventCodec(t *testing.T) *Codec {
+	b := NewBuilder(_eventSpec)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, b.Add(event(t, i)))
+	}
+
+	c, err := NewCodec(Dictionary{ID: 1, Type: "Event", Data: b.Dictionary(4096)})
+	require.NoError(t, err)
+	return c
+}
+
+func TestCodec(t *testing.T) {
+	c := newEventCodec(t)
+
+	var withDict, withoutDict int
+	for i := 100; i < 200; i++ {
+		payload := event(t, i)
+
+		compressed, err := c.Compress("Event", payload)
+		require.NoError(t, err)
+		assert.Equal(t, byte(1), compressed[0], "dictionary ID")
+		withDict += len(compressed)
+
+		got, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		assert.Equal(t, payload, got)
+
+		plain, err := c.Compress("Other", payload)
+		require.NoError(t, err)
+		assert.Equal(t, byte(0), plain[0], "dictionary ID")
+		withoutDict += len(plain)
+
+		got, err = c.Decompress(plain)
+		require.NoError(t, err)
+		assert.Equal(t, payload, got)
+	}
+
+	assert.Less(t, withDict*2, withoutDict,
+		"dictionary must at least halve the size of small payloads")
+}
+
+func TestNewCodecErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		dicts   []Dictionary
+		wantErr string
+	}{
+		{
+			desc:    "reserved ID",
+			dicts:   []Dictionary{{ID: 0, Type: "Foo"}},
+			wantErr: `dictionary for "Foo": ID 0 is reserved`,
+		},
+		{
+			desc:    "duplicate ID",
+			dicts:   []Dictionary{{ID: 1, Type: "Foo"}, {ID: 1, Type: "Bar"}},
+			wantErr: `dictionary for "Bar": ID 1 is already in use`,
+		},
+		{
+			desc:    "duplicate type",
+			dicts:   []Dictionary{{ID: 1, Type: "Foo"}, {ID: 2, Type: "Foo"}},
+			wantErr: `type "Foo" already has a dictionary`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := NewCodec(tt.dicts...)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDecompressErrors(t *testing.T) {
+	c := newEventCodec(t)
+
+	tests := []struct {
+		desc    string
+		give    []byte
+		wantErr string
+	}{
+		{desc: "empty", wantErr: "wiredict: invalid dictionary ID"},
+		{desc: "unknown ID", give: []byte{0x02}, wantErr: "wiredict: unknown dictionary ID 2"},
+		{desc: "corrupt", give: []byte{0x01, 0xff, 0xff}, wantErr: "wiredict: "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := c.Decompress(tt.give)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCodecProtocol(t *testing.T) {
+	c := newEventCodec(t)
+	p := c.Protocol("Event", binary.Default)
+
+	v, err := binary.Default.Decode(bytes.NewReader(event(t, 7)), wire.TStruct)
+	require.NoError(t, err)
+
+	t.Run("value", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, p.Encode(v, &buf))
+		assert.Equal(t, byte(1), buf.Bytes()[0], "dictionary ID")
+
+		got, err := p.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(v, got))
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		e := wire.Envelope{Name: "emit", Type: wire.Call, SeqID: 42, Value: v}
+
+		var buf bytes.Buffer
+		require.NoError(t, p.EncodeEnveloped(e, &buf))
+
+		got, err := p.DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, "emit", got.Name)
+		assert.Equal(t, int32(42), got.SeqID)
+		assert.True(t, wire.ValuesAreEqual(v, got.Value))
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := p.Decode(bytes.NewReader([]byte{0x05}), wire.TStruct)
+		assert.EqualError(t, err, "wiredict: unknown dictionary ID 5")
+	})
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package wireprofile measures how many bytes each field of a Thrift type
+// contributes to its Binary-encoded payloads.
+//
+// Feed a corpus of encoded payloads to a Profile and inspect the Report to
+// find the fields responsible for most of the bytes on the wire.
+//
+//	p := wireprofile.New(spec)
+//	for _, payload := range corpus {
+//		if err := p.Add(payload); err != nil {
+//			return err
+//		}
+//	}
+//	for _, f := range p.Report() {
+//		fmt.Printf("%v\t%.2f%%\n", f.Path, f.Percent)
+//	}
+package wireprofile
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// FieldStats reports the bytes attributed to a single field path.
+//
+// Paths are made of Thrift field names separated by ".". Elements of lists
+// and sets are denoted with "[]", and keys and values of maps with "{key}"
+// and "{value}". For example, "users[].name" is the name field of structs
+// inside the users list. Fields not known to the TypeSpec are named by
+// their field ID, e.g. "#7".
+type FieldStats struct {
+	Path string
+
+	// Bytes is the total number of bytes used by this field across all
+	// payloads, including the field header and any nested fields.
+	Bytes int64
+
+	// Count is the number of times this field appeared.
+	Count int64
+
+	// Percent is the share of the total payload bytes used by this
+	// field.
+	Percent float64
+}
+
+// Profile accumulates per-field byte usage for payloads of a single Thrift
+// type.
+type Profile struct {
+	spec     compile.TypeSpec
+	payloads int64
+	total    int64
+	fields   map[string]*FieldStats
+}
+
+// New builds a Profile for Binary-encoded payloads of the given type.
+func New(spec compile.TypeSpec) *Profile {
+	return &Profile{
+		spec:   spec,
+		fields: make(map[string]*FieldStats),
+	}
+}
+
+// Payloads returns the number of payloads added to this Profile.
+func (p *Profile) Payloads() int64 {
+	return p.payloads
+}
+
+// TotalBytes returns the total size of all payloads added to this Profile.
+func (p *Profile) TotalBytes() int64 {
+	return p.total
+}
+
+// Add records the byte usage of a single encoded payload.
+//
+// Payloads that fail to decode do not affect the Profile.
+func (p *Profile) Add(payload []byte) error {
+	r := bytes.NewReader(payload)
+	sr := binary.NewStreamReader(r)
+	defer sr.Close()
+
+	w := walker{
+		reader: sr,
+		pos:    func() int64 { return int64(len(payload) - r.Len()) },
+		fields: make(map[string]*FieldStats),
+	}
+	root := compile.RootTypeSpec(p.spec)
+	if err := w.walk(root, root.TypeCode(), ""); err != nil {
+		return fmt.Errorf("could not profile payload for %v: %v", p.spec.ThriftName(), err)
+	}
+
+	p.payloads++
+	p.total += int64(len(payload))
+	for path, s := range w.fields {
+		dst, ok := p.fields[path]
+		if !ok {
+			dst = &FieldStats{Path: path}
+			p.fields[path] = dst
+		}
+		dst.Bytes += s.Bytes
+		dst.Count += s.Count
+	}
+	return nil
+}
+
+// Report returns statistics for every field path seen so far, ordered by the
+// number of bytes used, largest first.
+func (p *Profile) Report() []FieldStats {
+	stats := make([]FieldStats, 0, len(p.fields))
+	for _, s := range p.fields {
+		s := *s
+		if p.total > 0 {
+			s.Percent = float64(s.Bytes) * 100 / float64(p.total)
+		}
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Bytes != stats[j].Bytes {
+			return stats[i].Bytes > stats[j].Bytes
+		}
+		return stats[i].Path < stats[j].Path
+	})
+	return stats
+}
+
+type walker struct {
+	reader stream.Reader
+	pos    func() int64
+	fields map[string]*FieldStats
+}
+
+func (w *walker) record(path string, n int64) {
+	s, ok := w.fields[path]
+	if !ok {
+		s = &FieldStats{Path: path}
+		w.fields[path] = s
+	}
+	s.Bytes += n
+	s.Count++
+}
+
+// walk reads a value of type t, attributing the bytes of any nested fields
+// to paths under the given prefix. spec is nil if the value's type is
+// unknown.
+func (w *walker) walk(spec compile.TypeSpec, t wire.Type, prefix string) error {
+	switch t {
+	case wire.TStruct:
+		s, _ := spec.(*compile.StructSpec)
+		return w.walkStruct(s, prefix)
+	case wire.TList:
+		var elem compile.TypeSpec
+		if l, ok := spec.(*compile.ListSpec); ok {
+			elem = l.ValueSpec
+		}
+		h, err := w.reader.ReadListBegin()
+		if err != nil {
+			return err
+		}
+		if err := w.walkElements(elem, h.Type, h.Length, prefix+"[]"); err != nil {
+			return err
+		}
+		return w.reader.ReadListEnd()
+	case wire.TSet:
+		var elem compile.TypeSpec
+		if s, ok := spec.(*compile.SetSpec); ok {
+			elem = s.ValueSpec
+		}
+		h, err := w.reader.ReadSetBegin()
+		if err != nil {
+			return err
+		}
+		if err := w.walkElements(elem, h.Type, h.Length, prefix+"[]"); err != nil {
+			return err
+		}
+		return w.reader.ReadSetEnd()
+	case wire.TMap:
+		var key, value compile.TypeSpec
+		if m, ok := spec.(*compile.MapSpec); ok {
+			key, value = m.KeySpec, m.ValueSpec
+		}
+		h, err := w.reader.ReadMapBegin()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < h.Length; i++ {
+			if err := w.walkElements(key, h.KeyType, 1, prefix+"{key}"); err != nil {
+				return err
+			}
+			if err := w.walkElements(value, h.ValueType, 1, prefix+"{value}"); err != nil {
+				return err
+			}
+		}
+		return w.reader.ReadMapEnd()
+	default:
+		return w.reader.Skip(t)
+	}
+}
+
+func (w *walker) walkElements(spec compile.TypeSpec, t wire.Type, n int, prefix string) error {
+	if spec != nil {
+		spec = compile.RootTypeSpec(spec)
+		if spec.TypeCode() != t {
+			spec = nil
+		}
+	}
+	for i := 0; i < n; i++ {
+		if err := w.walk(spec, t, prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *walker) walkStruct(spec *compile.StructSpec, prefix string) error {
+	if err := w.reader.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	for {
+		start := w.pos()
+		fh, ok, err := w.reader.ReadFieldBegin()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		name := fmt.Sprintf("#%d", fh.ID)
+		var fieldSpec compile.TypeSpec
+		if spec != nil {
+			for _, f := range spec.Fields {
+				if f.ID != fh.ID {
+					continue
+				}
+				if t := compile.RootTypeSpec(f.Type); t.TypeCode() == fh.Type {
+					name = f.Name
+					fieldSpec = t
+				}
+				break
+			}
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		if err := w.walk(fieldSpec, fh.Type, path); err != nil {
+			return err
+		}
+		if err := w.reader.ReadFieldEnd(); err != nil {
+			return err
+		}
+		w.record(path, w.pos()-start)
+	}
+
+	return w.reader.ReadStructEnd()
+}
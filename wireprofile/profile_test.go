@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wireprofile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+func encode(t *testing.T, fields ...wire.Field) []byte {
+	var buf bytes.Buffer
+	v := wire.NewValueStruct(wire.Struct{Fields: fields})
+	require.NoError(t, binary.Default.Encode(v, &buf))
+	return buf.Bytes()
+}
+
+func TestProfile(t *testing.T) {
+	point := &compile.StructSpec{
+		Name: "Point",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "x", Type: &compile.I32Spec{}},
+			{ID: 2, Name: "y", Type: &compile.I32Spec{}},
+		},
+	}
+	shape := &compile.StructSpec{
+		Name: "Shape",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "name", Type: &compile.StringSpec{}},
+			{ID: 2, Name: "points", Type: &compile.ListSpec{ValueSpec: point}},
+		},
+	}
+
+	pointValue := func(x, y int32) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueI32(x)},
+			{ID: 2, Value: wire.NewValueI32(y)},
+		}})
+	}
+
+	p := New(shape)
+	require.NoError(t, p.Add(encode(t,
+		wire.Field{ID: 1, Value: wire.NewValueString("square")},
+		wire.Field{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			pointValue(0, 0),
+			pointValue(1, 1),
+		}))},
+	)))
+	require.NoError(t, p.Add(encode(t,
+		wire.Field{ID: 1, Value: wire.NewValueString("dot")},
+		wire.Field{ID: 7, Value: wire.NewValueBool(true)},
+	)))
+
+	assert.Equal(t, int64(2), p.Payloads())
+
+	// square: name (3+4+6=13), points (3+5+2*(2*7+1)=38), stop (1) = 52
+	// dot: name (3+4+3=10), #7 (3+1=4), stop (1) = 15
+	assert.Equal(t, int64(67), p.TotalBytes())
+
+	got := make(map[string]FieldStats)
+	for _, s := range p.Report() {
+		s.Percent = 0
+		got[s.Path] = s
+	}
+	assert.Equal(t, map[string]FieldStats{
+		"points":     {Path: "points", Bytes: 38, Count: 1},
+		"name":       {Path: "name", Bytes: 23, Count: 2},
+		"points[].x": {Path: "points[].x", Bytes: 14, Count: 2},
+		"points[].y": {Path: "points[].y", Bytes: 14, Count: 2},
+		"#7":         {Path: "#7", Bytes: 4, Count: 1},
+	}, got)
+
+	report := p.Report()
+	assert.Equal(t, "points", report[0].Path)
+	assert.InDelta(t, 38*100/67.0, report[0].Percent, 0.001)
+}
+
+func TestProfileMaps(t *testing.T) {
+	spec := &compile.StructSpec{
+		Name: "Attrs",
+		Fields: compile.FieldGroup{
+			{ID: 1, Name: "attrs", Type: &compile.MapSpec{
+				KeySpec: &compile.StringSpec{},
+				ValueSpec: &compile.StructSpec{
+					Name:   "Value",
+					Fields: compile.FieldGroup{{ID: 1, Name: "v", Type: &compile.I64Spec{}}},
+				},
+			}},
+		},
+	}
+
+	p := New(spec)
+	require.NoError(t, p.Add(encode(t,
+		wire.Field{ID: 1, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TStruct, []wire.MapItem{
+			{
+				Key: wire.NewValueString("a"),
+				Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueI64(1)},
+				}}),
+			},
+		}))},
+	)))
+
+	paths := make(map[string]int64)
+	for _, s := range p.Report() {
+		paths[s.Path] = s.Bytes
+	}
+	assert.Equal(t, map[string]int64{
+		"attrs":          3 + 6 + 5 + 12,
+		"attrs{value}.v": 11,
+	}, paths)
+}
+
+func TestProfileInvalidPayload(t *testing.T) {
+	p := New(&compile.StructSpec{Name: "Empty"})
+	err := p.Add([]byte{0x08, 0x00})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not profile payload for Empty")
+	assert.Equal(t, int64(0), p.Payloads())
+	assert.Empty(t, p.Report())
+}